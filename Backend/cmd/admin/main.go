@@ -0,0 +1,57 @@
+// cmd/admin/main.go
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+
+	"github.com/UnoraApp/be/internal/admin/dto"
+	"github.com/UnoraApp/be/internal/admin/services"
+	"github.com/UnoraApp/be/internal/config"
+	"github.com/UnoraApp/be/pkg/database"
+	"github.com/UnoraApp/be/pkg/logger"
+)
+
+// Creates an admin account from the command line, e.g. the first
+// superadmin, who then creates the others through the admin API
+func main() {
+	email := flag.String("email", "", "Admin email")
+	name := flag.String("name", "", "Admin name")
+	role := flag.String("role", "superadmin", "Admin role: support, moderator, finance or superadmin")
+	flag.Parse()
+
+	if *email == "" || *name == "" {
+		log.Fatal("Usage: admin -email <email> -name <name> [-role <role>]")
+	}
+
+	logger.InitLogger("dev")
+	logAdmin := logger.GetLogger("admin")
+
+	// Load config
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		log.Fatalf("Failed to load configuration: %v", err)
+	}
+
+	// Setup database connection
+	entClient, err := database.SetupEntClient(cfg)
+	if err != nil {
+		log.Fatalf("Failed to setup DB client: %v", err)
+	}
+	defer entClient.Close()
+
+	accountService := services.NewAdminAccountService(entClient)
+	result, err := accountService.CreateAdmin(context.Background(), "", &dto.CreateAdminRequest{
+		Email: *email,
+		Name:  *name,
+		Role:  *role,
+	})
+	if err != nil {
+		log.Fatalf("Failed to create admin: %v", err)
+	}
+
+	logAdmin.Info().Str("admin_id", result.Admin.ID).Str("role", result.Admin.Role).Msg("Admin created")
+	fmt.Printf("API key for %s (shown only once): %s\n", result.Admin.Email, result.APIKey)
+}
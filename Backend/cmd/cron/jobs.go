@@ -0,0 +1,185 @@
+// cmd/cron/jobs.go
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/robfig/cron/v3"
+
+	accountservices "github.com/UnoraApp/be/internal/account/services"
+	"github.com/UnoraApp/be/internal/config"
+	monetizationservices "github.com/UnoraApp/be/internal/monetization/services"
+	"github.com/UnoraApp/be/pkg/logger"
+)
+
+// subscriptionExpirySchedule runs often enough that a lapsed grace period
+// is enforced within the hour
+const subscriptionExpirySchedule = "@hourly"
+
+const (
+	// orderSweepSchedule polls orders awaiting payment; an order is expired
+	// within minutes of its 30 minute window closing
+	orderSweepSchedule = "@every 5m"
+	// paymentReportSchedule reports on the previous day, late enough after
+	// midnight for that day's webhooks to have landed
+	paymentReportSchedule = "30 0 * * *"
+	// rejectedWebhookPurgeSchedule keeps forged webhook deliveries to about
+	// their retention period
+	rejectedWebhookPurgeSchedule = "@hourly"
+)
+
+const (
+	// accountDeletionSchedule completes deletions within the hour of their
+	// cooling-off period ending
+	accountDeletionSchedule = "@hourly"
+	// dataExportSchedule picks up export requests while the user is likely
+	// still waiting for them
+	dataExportSchedule = "@every 1m"
+)
+
+// scheduleJobs adds the background jobs to c, except those calling the
+// payment gateway. Reconciliation runs on cfg.Cron.Schedule.
+func scheduleJobs(
+	c *cron.Cron,
+	cfg *config.Config,
+	reconciliationService *monetizationservices.ReconciliationService,
+	subscriptionService *monetizationservices.SubscriptionService,
+	webhookService *monetizationservices.WebhookService,
+	deletionService *accountservices.DeletionService,
+	exportService *accountservices.ExportService,
+) {
+	log := logger.GetLogger("jobs")
+
+	_, err := c.AddFunc(cfg.Cron.Schedule, func() {
+		report, err := reconciliationService.Reconcile(context.Background())
+		if err != nil {
+			log.Error().Err(err).Msg("Credit ledger reconciliation failed")
+			return
+		}
+
+		event := log.Info()
+		if len(report.Drifts) > 0 || report.LedgerImbalance != 0 {
+			event = log.Warn()
+		}
+		event.
+			Int("users_checked", report.UsersChecked).
+			Int("open_drifts", len(report.Drifts)).
+			Int("detected", report.Detected).
+			Int("resolved", report.Resolved).
+			Int("ledger_imbalance", report.LedgerImbalance).
+			Dur("duration", report.FinishedAt.Sub(report.StartedAt)).
+			Msg("Credit ledger reconciliation finished")
+	})
+	if err != nil {
+		log.Error().Err(err).Str("schedule", cfg.Cron.Schedule).Msg("Invalid cron schedule, reconciliation disabled")
+	}
+
+	_, err = c.AddFunc(subscriptionExpirySchedule, func() {
+		expired, err := subscriptionService.ExpireLapsed(context.Background())
+		if err != nil {
+			log.Error().Err(err).Msg("Subscription expiry failed")
+			return
+		}
+		if expired > 0 {
+			log.Info().Int("expired", expired).Msg("Expired lapsed subscriptions")
+		}
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to schedule subscription expiry")
+	}
+
+	_, err = c.AddFunc(rejectedWebhookPurgeSchedule, func() {
+		deleted, err := webhookService.PurgeRejected(context.Background())
+		if err != nil {
+			log.Error().Err(err).Msg("Rejected webhook purge failed")
+			return
+		}
+		if deleted > 0 {
+			log.Info().Int("deleted", deleted).Msg("Purged rejected webhook deliveries")
+		}
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to schedule rejected webhook purge")
+	}
+
+	_, err = c.AddFunc(accountDeletionSchedule, func() {
+		completed, err := deletionService.CompleteDue(context.Background())
+		if err != nil {
+			log.Error().Err(err).Msg("Account deletion run failed")
+			return
+		}
+		if completed > 0 {
+			log.Info().Int("completed", completed).Msg("Completed scheduled account deletions")
+		}
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to schedule account deletion")
+	}
+
+	_, err = c.AddFunc(dataExportSchedule, func() {
+		ctx := context.Background()
+		built, err := exportService.ProcessPending(ctx)
+		if err != nil {
+			log.Error().Err(err).Msg("Data export run failed")
+		}
+		expired, err := exportService.ExpireOld(ctx)
+		if err != nil {
+			log.Error().Err(err).Msg("Data export expiry failed")
+		}
+		if built > 0 || expired > 0 {
+			log.Info().Int("built", built).Int("expired", expired).Msg("Processed data exports")
+		}
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to schedule data exports")
+	}
+
+}
+
+// schedulePaymentJobs adds the jobs that check payment orders against the
+// payment gateway: the pending order sweep and the daily report
+func schedulePaymentJobs(c *cron.Cron, paymentReconciliationService *monetizationservices.PaymentReconciliationService) {
+	log := logger.GetLogger("jobs")
+
+	_, err := c.AddFunc(orderSweepSchedule, func() {
+		result, err := paymentReconciliationService.SweepPendingOrders(context.Background())
+		if err != nil {
+			log.Error().Err(err).Msg("Pending order sweep failed")
+			return
+		}
+		if result.Fulfilled > 0 || result.Expired > 0 || result.Errors > 0 {
+			log.Info().
+				Int("checked", result.Checked).
+				Int("fulfilled", result.Fulfilled).
+				Int("expired", result.Expired).
+				Int("errors", result.Errors).
+				Msg("Swept pending payment orders")
+		}
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to schedule pending order sweep")
+	}
+
+	_, err = c.AddFunc(paymentReportSchedule, func() {
+		report, err := paymentReconciliationService.GenerateDailyReport(context.Background(), time.Now().AddDate(0, 0, -1))
+		if err != nil {
+			log.Error().Err(err).Msg("Payment reconciliation report failed")
+			return
+		}
+
+		event := log.Info()
+		if report.DiscrepancyCount > 0 {
+			event = log.Warn()
+		}
+		event.
+			Str("date", report.ReportDate).
+			Int("orders_checked", report.OrdersChecked).
+			Int("gateway_payments", report.GatewayPayments).
+			Int("discrepancies", report.DiscrepancyCount).
+			Msg("Payment reconciliation report generated")
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to schedule payment reconciliation report")
+	}
+}
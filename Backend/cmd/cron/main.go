@@ -0,0 +1,97 @@
+// cmd/cron/main.go
+package main
+
+import (
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/robfig/cron/v3"
+
+	accountservices "github.com/UnoraApp/be/internal/account/services"
+	chatservices "github.com/UnoraApp/be/internal/chat/services"
+	"github.com/UnoraApp/be/internal/config"
+	"github.com/UnoraApp/be/internal/di"
+	monetizationservices "github.com/UnoraApp/be/internal/monetization/services"
+	notificationservices "github.com/UnoraApp/be/internal/notification/services"
+	"github.com/UnoraApp/be/pkg/database"
+	"github.com/UnoraApp/be/pkg/logger"
+	"github.com/UnoraApp/be/pkg/redis"
+	"github.com/UnoraApp/be/pkg/storage"
+)
+
+// Runs the scheduled jobs (credit ledger and payment reconciliation, order
+// expiry, subscription grace periods, rejected webhook purge, account
+// deletion, data exports) in one process, apart from the API servers, so
+// that each job runs once however many servers there are
+func main() {
+	// Load config
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		log.Fatalf("Failed to load configuration: %v", err)
+	}
+
+	logger.InitLogger(cfg.Server.Mode)
+	logCron := logger.GetLogger("cron")
+
+	// Setup database connection
+	entClient, err := database.SetupEntClient(cfg)
+	if err != nil {
+		log.Fatalf("Failed to setup DB client: %v", err)
+	}
+	defer entClient.Close()
+
+	redisClient, err := redis.NewRedisClient(cfg)
+	if err != nil {
+		log.Fatalf("Failed to setup Redis client: %v", err)
+	}
+	defer redisClient.Close()
+
+	storageClient, err := storage.NewClientFromConfig(cfg)
+	if err != nil {
+		log.Fatalf("Failed to setup storage client: %v", err)
+	}
+
+	paymentGateway, err := monetizationservices.NewPaymentGateway(&cfg.Payment)
+	if err != nil {
+		log.Fatalf("Invalid payment configuration: %v", err)
+	}
+	creditsService := monetizationservices.NewCreditsService(entClient)
+	promoService := monetizationservices.NewPromoService(entClient, creditsService)
+	invoiceService := monetizationservices.NewInvoiceService(entClient, storageClient, &cfg.Invoice)
+	paymentService := monetizationservices.NewPaymentService(entClient, paymentGateway, creditsService, promoService, invoiceService)
+	subscriptionService := monetizationservices.NewSubscriptionService(entClient, paymentGateway)
+	paymentReconciliationService := monetizationservices.NewPaymentReconciliationService(entClient, paymentGateway, paymentService)
+	reconciliationService := monetizationservices.NewReconciliationService(entClient)
+	refundService := monetizationservices.NewRefundService(entClient, paymentGateway, creditsService)
+	webhookService := monetizationservices.NewWebhookService(entClient, paymentGateway, paymentService, subscriptionService, refundService)
+
+	// Deleting an account ends its sessions and conversations and notifies
+	// the partners; chat events reach the servers' clients through Redis
+	authService := di.InitializeAuthService(entClient, redisClient, cfg)
+	chatModeration := chatservices.NewModerationPipelineFromConfig(&cfg.Moderation)
+	chatService := chatservices.NewChatService(entClient, chatservices.NewHub(redisClient), chatModeration)
+	notificationService := notificationservices.NewNotificationService(entClient)
+	exportService := accountservices.NewExportService(entClient, storageClient, cfg)
+	deletionService := accountservices.NewDeletionService(entClient, storageClient, authService, chatService, notificationService, exportService, cfg)
+
+	c := cron.New()
+	scheduleJobs(c, cfg, reconciliationService, subscriptionService, webhookService, deletionService, exportService)
+	// The fake gateway keeps its orders in the memory of the server that
+	// created them, so this process cannot look them up
+	if cfg.Payment.Provider != monetizationservices.PaymentProviderFake {
+		schedulePaymentJobs(c, paymentReconciliationService)
+	} else {
+		logCron.Warn().Msg("PAYMENT_PROVIDER=fake: pending order sweep and payment report disabled")
+	}
+	c.Start()
+	logCron.Info().Int("jobs", len(c.Entries())).Msg("Cron started")
+
+	// Wait for running jobs to finish before exiting
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	<-quit
+	logCron.Info().Msg("Stopping cron...")
+	<-c.Stop().Done()
+}
@@ -156,6 +156,7 @@ export type ErrorCode =
   | 'INVALID_REQUEST'     // 400 - Malformed request body
   | 'UNAUTHORIZED'        // 401 - Missing or invalid authentication
   | 'FORBIDDEN'           // 403 - Access denied
+  | 'ACCOUNT_SUSPENDED'   // 403 - Account suspended
   | 'NOT_FOUND'           // 404 - Resource not found
   | 'CONFLICT'            // 409 - Resource conflict (e.g., already exists)
   | 'RATE_LIMITED'        // 429 - Too many requests / at capacity
@@ -0,0 +1,170 @@
+// Code generated by ent, DO NOT EDIT.
+
+package accountdeletion
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the accountdeletion type in the database.
+	Label = "account_deletion"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldDeletionStatus holds the string denoting the deletion_status field in the database.
+	FieldDeletionStatus = "deletion_status"
+	// FieldRequestedBy holds the string denoting the requested_by field in the database.
+	FieldRequestedBy = "requested_by"
+	// FieldReason holds the string denoting the reason field in the database.
+	FieldReason = "reason"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldScheduledFor holds the string denoting the scheduled_for field in the database.
+	FieldScheduledFor = "scheduled_for"
+	// FieldCancelledAt holds the string denoting the cancelled_at field in the database.
+	FieldCancelledAt = "cancelled_at"
+	// FieldCompletedAt holds the string denoting the completed_at field in the database.
+	FieldCompletedAt = "completed_at"
+	// Table holds the table name of the accountdeletion in the database.
+	Table = "account_deletions"
+)
+
+// Columns holds all SQL columns for accountdeletion fields.
+var Columns = []string{
+	FieldID,
+	FieldUserID,
+	FieldDeletionStatus,
+	FieldRequestedBy,
+	FieldReason,
+	FieldCreatedAt,
+	FieldScheduledFor,
+	FieldCancelledAt,
+	FieldCompletedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	UserIDValidator func(string) error
+	// ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
+	ReasonValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
+
+// DeletionStatus defines the type for the "deletion_status" enum field.
+type DeletionStatus string
+
+// DeletionStatusScheduled is the default value of the DeletionStatus enum.
+const DefaultDeletionStatus = DeletionStatusScheduled
+
+// DeletionStatus values.
+const (
+	DeletionStatusScheduled DeletionStatus = "scheduled"
+	DeletionStatusCancelled DeletionStatus = "cancelled"
+	DeletionStatusCompleted DeletionStatus = "completed"
+)
+
+func (ds DeletionStatus) String() string {
+	return string(ds)
+}
+
+// DeletionStatusValidator is a validator for the "deletion_status" field enum values. It is called by the builders before save.
+func DeletionStatusValidator(ds DeletionStatus) error {
+	switch ds {
+	case DeletionStatusScheduled, DeletionStatusCancelled, DeletionStatusCompleted:
+		return nil
+	default:
+		return fmt.Errorf("accountdeletion: invalid enum value for deletion_status field: %q", ds)
+	}
+}
+
+// RequestedBy defines the type for the "requested_by" enum field.
+type RequestedBy string
+
+// RequestedByUser is the default value of the RequestedBy enum.
+const DefaultRequestedBy = RequestedByUser
+
+// RequestedBy values.
+const (
+	RequestedByUser  RequestedBy = "user"
+	RequestedByAdmin RequestedBy = "admin"
+)
+
+func (rb RequestedBy) String() string {
+	return string(rb)
+}
+
+// RequestedByValidator is a validator for the "requested_by" field enum values. It is called by the builders before save.
+func RequestedByValidator(rb RequestedBy) error {
+	switch rb {
+	case RequestedByUser, RequestedByAdmin:
+		return nil
+	default:
+		return fmt.Errorf("accountdeletion: invalid enum value for requested_by field: %q", rb)
+	}
+}
+
+// OrderOption defines the ordering options for the AccountDeletion queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByDeletionStatus orders the results by the deletion_status field.
+func ByDeletionStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletionStatus, opts...).ToFunc()
+}
+
+// ByRequestedBy orders the results by the requested_by field.
+func ByRequestedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequestedBy, opts...).ToFunc()
+}
+
+// ByReason orders the results by the reason field.
+func ByReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReason, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByScheduledFor orders the results by the scheduled_for field.
+func ByScheduledFor(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScheduledFor, opts...).ToFunc()
+}
+
+// ByCancelledAt orders the results by the cancelled_at field.
+func ByCancelledAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCancelledAt, opts...).ToFunc()
+}
+
+// ByCompletedAt orders the results by the completed_at field.
+func ByCompletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCompletedAt, opts...).ToFunc()
+}
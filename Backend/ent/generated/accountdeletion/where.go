@@ -0,0 +1,470 @@
+// Code generated by ent, DO NOT EDIT.
+
+package accountdeletion
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/UnoraApp/be/ent/generated/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldContainsFold(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldEQ(FieldUserID, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldEQ(FieldReason, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ScheduledFor applies equality check predicate on the "scheduled_for" field. It's identical to ScheduledForEQ.
+func ScheduledFor(v time.Time) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldEQ(FieldScheduledFor, v))
+}
+
+// CancelledAt applies equality check predicate on the "cancelled_at" field. It's identical to CancelledAtEQ.
+func CancelledAt(v time.Time) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldEQ(FieldCancelledAt, v))
+}
+
+// CompletedAt applies equality check predicate on the "completed_at" field. It's identical to CompletedAtEQ.
+func CompletedAt(v time.Time) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldEQ(FieldCompletedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// DeletionStatusEQ applies the EQ predicate on the "deletion_status" field.
+func DeletionStatusEQ(v DeletionStatus) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldEQ(FieldDeletionStatus, v))
+}
+
+// DeletionStatusNEQ applies the NEQ predicate on the "deletion_status" field.
+func DeletionStatusNEQ(v DeletionStatus) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldNEQ(FieldDeletionStatus, v))
+}
+
+// DeletionStatusIn applies the In predicate on the "deletion_status" field.
+func DeletionStatusIn(vs ...DeletionStatus) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldIn(FieldDeletionStatus, vs...))
+}
+
+// DeletionStatusNotIn applies the NotIn predicate on the "deletion_status" field.
+func DeletionStatusNotIn(vs ...DeletionStatus) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldNotIn(FieldDeletionStatus, vs...))
+}
+
+// RequestedByEQ applies the EQ predicate on the "requested_by" field.
+func RequestedByEQ(v RequestedBy) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldEQ(FieldRequestedBy, v))
+}
+
+// RequestedByNEQ applies the NEQ predicate on the "requested_by" field.
+func RequestedByNEQ(v RequestedBy) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldNEQ(FieldRequestedBy, v))
+}
+
+// RequestedByIn applies the In predicate on the "requested_by" field.
+func RequestedByIn(vs ...RequestedBy) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldIn(FieldRequestedBy, vs...))
+}
+
+// RequestedByNotIn applies the NotIn predicate on the "requested_by" field.
+func RequestedByNotIn(vs ...RequestedBy) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldNotIn(FieldRequestedBy, vs...))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonIsNil applies the IsNil predicate on the "reason" field.
+func ReasonIsNil() predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldIsNull(FieldReason))
+}
+
+// ReasonNotNil applies the NotNil predicate on the "reason" field.
+func ReasonNotNil() predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldNotNull(FieldReason))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldContainsFold(FieldReason, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// ScheduledForEQ applies the EQ predicate on the "scheduled_for" field.
+func ScheduledForEQ(v time.Time) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldEQ(FieldScheduledFor, v))
+}
+
+// ScheduledForNEQ applies the NEQ predicate on the "scheduled_for" field.
+func ScheduledForNEQ(v time.Time) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldNEQ(FieldScheduledFor, v))
+}
+
+// ScheduledForIn applies the In predicate on the "scheduled_for" field.
+func ScheduledForIn(vs ...time.Time) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldIn(FieldScheduledFor, vs...))
+}
+
+// ScheduledForNotIn applies the NotIn predicate on the "scheduled_for" field.
+func ScheduledForNotIn(vs ...time.Time) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldNotIn(FieldScheduledFor, vs...))
+}
+
+// ScheduledForGT applies the GT predicate on the "scheduled_for" field.
+func ScheduledForGT(v time.Time) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldGT(FieldScheduledFor, v))
+}
+
+// ScheduledForGTE applies the GTE predicate on the "scheduled_for" field.
+func ScheduledForGTE(v time.Time) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldGTE(FieldScheduledFor, v))
+}
+
+// ScheduledForLT applies the LT predicate on the "scheduled_for" field.
+func ScheduledForLT(v time.Time) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldLT(FieldScheduledFor, v))
+}
+
+// ScheduledForLTE applies the LTE predicate on the "scheduled_for" field.
+func ScheduledForLTE(v time.Time) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldLTE(FieldScheduledFor, v))
+}
+
+// CancelledAtEQ applies the EQ predicate on the "cancelled_at" field.
+func CancelledAtEQ(v time.Time) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldEQ(FieldCancelledAt, v))
+}
+
+// CancelledAtNEQ applies the NEQ predicate on the "cancelled_at" field.
+func CancelledAtNEQ(v time.Time) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldNEQ(FieldCancelledAt, v))
+}
+
+// CancelledAtIn applies the In predicate on the "cancelled_at" field.
+func CancelledAtIn(vs ...time.Time) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldIn(FieldCancelledAt, vs...))
+}
+
+// CancelledAtNotIn applies the NotIn predicate on the "cancelled_at" field.
+func CancelledAtNotIn(vs ...time.Time) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldNotIn(FieldCancelledAt, vs...))
+}
+
+// CancelledAtGT applies the GT predicate on the "cancelled_at" field.
+func CancelledAtGT(v time.Time) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldGT(FieldCancelledAt, v))
+}
+
+// CancelledAtGTE applies the GTE predicate on the "cancelled_at" field.
+func CancelledAtGTE(v time.Time) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldGTE(FieldCancelledAt, v))
+}
+
+// CancelledAtLT applies the LT predicate on the "cancelled_at" field.
+func CancelledAtLT(v time.Time) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldLT(FieldCancelledAt, v))
+}
+
+// CancelledAtLTE applies the LTE predicate on the "cancelled_at" field.
+func CancelledAtLTE(v time.Time) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldLTE(FieldCancelledAt, v))
+}
+
+// CancelledAtIsNil applies the IsNil predicate on the "cancelled_at" field.
+func CancelledAtIsNil() predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldIsNull(FieldCancelledAt))
+}
+
+// CancelledAtNotNil applies the NotNil predicate on the "cancelled_at" field.
+func CancelledAtNotNil() predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldNotNull(FieldCancelledAt))
+}
+
+// CompletedAtEQ applies the EQ predicate on the "completed_at" field.
+func CompletedAtEQ(v time.Time) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldEQ(FieldCompletedAt, v))
+}
+
+// CompletedAtNEQ applies the NEQ predicate on the "completed_at" field.
+func CompletedAtNEQ(v time.Time) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldNEQ(FieldCompletedAt, v))
+}
+
+// CompletedAtIn applies the In predicate on the "completed_at" field.
+func CompletedAtIn(vs ...time.Time) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldIn(FieldCompletedAt, vs...))
+}
+
+// CompletedAtNotIn applies the NotIn predicate on the "completed_at" field.
+func CompletedAtNotIn(vs ...time.Time) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldNotIn(FieldCompletedAt, vs...))
+}
+
+// CompletedAtGT applies the GT predicate on the "completed_at" field.
+func CompletedAtGT(v time.Time) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldGT(FieldCompletedAt, v))
+}
+
+// CompletedAtGTE applies the GTE predicate on the "completed_at" field.
+func CompletedAtGTE(v time.Time) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldGTE(FieldCompletedAt, v))
+}
+
+// CompletedAtLT applies the LT predicate on the "completed_at" field.
+func CompletedAtLT(v time.Time) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldLT(FieldCompletedAt, v))
+}
+
+// CompletedAtLTE applies the LTE predicate on the "completed_at" field.
+func CompletedAtLTE(v time.Time) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldLTE(FieldCompletedAt, v))
+}
+
+// CompletedAtIsNil applies the IsNil predicate on the "completed_at" field.
+func CompletedAtIsNil() predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldIsNull(FieldCompletedAt))
+}
+
+// CompletedAtNotNil applies the NotNil predicate on the "completed_at" field.
+func CompletedAtNotNil() predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldNotNull(FieldCompletedAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AccountDeletion) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AccountDeletion) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AccountDeletion) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.NotPredicates(p))
+}
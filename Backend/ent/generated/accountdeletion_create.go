@@ -0,0 +1,847 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/UnoraApp/be/ent/generated/accountdeletion"
+)
+
+// AccountDeletionCreate is the builder for creating a AccountDeletion entity.
+type AccountDeletionCreate struct {
+	config
+	mutation *AccountDeletionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *AccountDeletionCreate) SetUserID(v string) *AccountDeletionCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetDeletionStatus sets the "deletion_status" field.
+func (_c *AccountDeletionCreate) SetDeletionStatus(v accountdeletion.DeletionStatus) *AccountDeletionCreate {
+	_c.mutation.SetDeletionStatus(v)
+	return _c
+}
+
+// SetNillableDeletionStatus sets the "deletion_status" field if the given value is not nil.
+func (_c *AccountDeletionCreate) SetNillableDeletionStatus(v *accountdeletion.DeletionStatus) *AccountDeletionCreate {
+	if v != nil {
+		_c.SetDeletionStatus(*v)
+	}
+	return _c
+}
+
+// SetRequestedBy sets the "requested_by" field.
+func (_c *AccountDeletionCreate) SetRequestedBy(v accountdeletion.RequestedBy) *AccountDeletionCreate {
+	_c.mutation.SetRequestedBy(v)
+	return _c
+}
+
+// SetNillableRequestedBy sets the "requested_by" field if the given value is not nil.
+func (_c *AccountDeletionCreate) SetNillableRequestedBy(v *accountdeletion.RequestedBy) *AccountDeletionCreate {
+	if v != nil {
+		_c.SetRequestedBy(*v)
+	}
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *AccountDeletionCreate) SetReason(v string) *AccountDeletionCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_c *AccountDeletionCreate) SetNillableReason(v *string) *AccountDeletionCreate {
+	if v != nil {
+		_c.SetReason(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *AccountDeletionCreate) SetCreatedAt(v time.Time) *AccountDeletionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *AccountDeletionCreate) SetNillableCreatedAt(v *time.Time) *AccountDeletionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetScheduledFor sets the "scheduled_for" field.
+func (_c *AccountDeletionCreate) SetScheduledFor(v time.Time) *AccountDeletionCreate {
+	_c.mutation.SetScheduledFor(v)
+	return _c
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (_c *AccountDeletionCreate) SetCancelledAt(v time.Time) *AccountDeletionCreate {
+	_c.mutation.SetCancelledAt(v)
+	return _c
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (_c *AccountDeletionCreate) SetNillableCancelledAt(v *time.Time) *AccountDeletionCreate {
+	if v != nil {
+		_c.SetCancelledAt(*v)
+	}
+	return _c
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_c *AccountDeletionCreate) SetCompletedAt(v time.Time) *AccountDeletionCreate {
+	_c.mutation.SetCompletedAt(v)
+	return _c
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_c *AccountDeletionCreate) SetNillableCompletedAt(v *time.Time) *AccountDeletionCreate {
+	if v != nil {
+		_c.SetCompletedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *AccountDeletionCreate) SetID(v string) *AccountDeletionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the AccountDeletionMutation object of the builder.
+func (_c *AccountDeletionCreate) Mutation() *AccountDeletionMutation {
+	return _c.mutation
+}
+
+// Save creates the AccountDeletion in the database.
+func (_c *AccountDeletionCreate) Save(ctx context.Context) (*AccountDeletion, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AccountDeletionCreate) SaveX(ctx context.Context) *AccountDeletion {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AccountDeletionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AccountDeletionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AccountDeletionCreate) defaults() {
+	if _, ok := _c.mutation.DeletionStatus(); !ok {
+		v := accountdeletion.DefaultDeletionStatus
+		_c.mutation.SetDeletionStatus(v)
+	}
+	if _, ok := _c.mutation.RequestedBy(); !ok {
+		v := accountdeletion.DefaultRequestedBy
+		_c.mutation.SetRequestedBy(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := accountdeletion.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AccountDeletionCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`generated: missing required field "AccountDeletion.user_id"`)}
+	}
+	if v, ok := _c.mutation.UserID(); ok {
+		if err := accountdeletion.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`generated: validator failed for field "AccountDeletion.user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.DeletionStatus(); !ok {
+		return &ValidationError{Name: "deletion_status", err: errors.New(`generated: missing required field "AccountDeletion.deletion_status"`)}
+	}
+	if v, ok := _c.mutation.DeletionStatus(); ok {
+		if err := accountdeletion.DeletionStatusValidator(v); err != nil {
+			return &ValidationError{Name: "deletion_status", err: fmt.Errorf(`generated: validator failed for field "AccountDeletion.deletion_status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RequestedBy(); !ok {
+		return &ValidationError{Name: "requested_by", err: errors.New(`generated: missing required field "AccountDeletion.requested_by"`)}
+	}
+	if v, ok := _c.mutation.RequestedBy(); ok {
+		if err := accountdeletion.RequestedByValidator(v); err != nil {
+			return &ValidationError{Name: "requested_by", err: fmt.Errorf(`generated: validator failed for field "AccountDeletion.requested_by": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Reason(); ok {
+		if err := accountdeletion.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`generated: validator failed for field "AccountDeletion.reason": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "AccountDeletion.created_at"`)}
+	}
+	if _, ok := _c.mutation.ScheduledFor(); !ok {
+		return &ValidationError{Name: "scheduled_for", err: errors.New(`generated: missing required field "AccountDeletion.scheduled_for"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := accountdeletion.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`generated: validator failed for field "AccountDeletion.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *AccountDeletionCreate) sqlSave(ctx context.Context) (*AccountDeletion, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected AccountDeletion.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AccountDeletionCreate) createSpec() (*AccountDeletion, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AccountDeletion{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(accountdeletion.Table, sqlgraph.NewFieldSpec(accountdeletion.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(accountdeletion.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.DeletionStatus(); ok {
+		_spec.SetField(accountdeletion.FieldDeletionStatus, field.TypeEnum, value)
+		_node.DeletionStatus = value
+	}
+	if value, ok := _c.mutation.RequestedBy(); ok {
+		_spec.SetField(accountdeletion.FieldRequestedBy, field.TypeEnum, value)
+		_node.RequestedBy = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(accountdeletion.FieldReason, field.TypeString, value)
+		_node.Reason = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(accountdeletion.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.ScheduledFor(); ok {
+		_spec.SetField(accountdeletion.FieldScheduledFor, field.TypeTime, value)
+		_node.ScheduledFor = value
+	}
+	if value, ok := _c.mutation.CancelledAt(); ok {
+		_spec.SetField(accountdeletion.FieldCancelledAt, field.TypeTime, value)
+		_node.CancelledAt = &value
+	}
+	if value, ok := _c.mutation.CompletedAt(); ok {
+		_spec.SetField(accountdeletion.FieldCompletedAt, field.TypeTime, value)
+		_node.CompletedAt = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AccountDeletion.Create().
+//		SetUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AccountDeletionUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *AccountDeletionCreate) OnConflict(opts ...sql.ConflictOption) *AccountDeletionUpsertOne {
+	_c.conflict = opts
+	return &AccountDeletionUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AccountDeletion.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *AccountDeletionCreate) OnConflictColumns(columns ...string) *AccountDeletionUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &AccountDeletionUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// AccountDeletionUpsertOne is the builder for "upsert"-ing
+	//  one AccountDeletion node.
+	AccountDeletionUpsertOne struct {
+		create *AccountDeletionCreate
+	}
+
+	// AccountDeletionUpsert is the "OnConflict" setter.
+	AccountDeletionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetDeletionStatus sets the "deletion_status" field.
+func (u *AccountDeletionUpsert) SetDeletionStatus(v accountdeletion.DeletionStatus) *AccountDeletionUpsert {
+	u.Set(accountdeletion.FieldDeletionStatus, v)
+	return u
+}
+
+// UpdateDeletionStatus sets the "deletion_status" field to the value that was provided on create.
+func (u *AccountDeletionUpsert) UpdateDeletionStatus() *AccountDeletionUpsert {
+	u.SetExcluded(accountdeletion.FieldDeletionStatus)
+	return u
+}
+
+// SetScheduledFor sets the "scheduled_for" field.
+func (u *AccountDeletionUpsert) SetScheduledFor(v time.Time) *AccountDeletionUpsert {
+	u.Set(accountdeletion.FieldScheduledFor, v)
+	return u
+}
+
+// UpdateScheduledFor sets the "scheduled_for" field to the value that was provided on create.
+func (u *AccountDeletionUpsert) UpdateScheduledFor() *AccountDeletionUpsert {
+	u.SetExcluded(accountdeletion.FieldScheduledFor)
+	return u
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (u *AccountDeletionUpsert) SetCancelledAt(v time.Time) *AccountDeletionUpsert {
+	u.Set(accountdeletion.FieldCancelledAt, v)
+	return u
+}
+
+// UpdateCancelledAt sets the "cancelled_at" field to the value that was provided on create.
+func (u *AccountDeletionUpsert) UpdateCancelledAt() *AccountDeletionUpsert {
+	u.SetExcluded(accountdeletion.FieldCancelledAt)
+	return u
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (u *AccountDeletionUpsert) ClearCancelledAt() *AccountDeletionUpsert {
+	u.SetNull(accountdeletion.FieldCancelledAt)
+	return u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (u *AccountDeletionUpsert) SetCompletedAt(v time.Time) *AccountDeletionUpsert {
+	u.Set(accountdeletion.FieldCompletedAt, v)
+	return u
+}
+
+// UpdateCompletedAt sets the "completed_at" field to the value that was provided on create.
+func (u *AccountDeletionUpsert) UpdateCompletedAt() *AccountDeletionUpsert {
+	u.SetExcluded(accountdeletion.FieldCompletedAt)
+	return u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (u *AccountDeletionUpsert) ClearCompletedAt() *AccountDeletionUpsert {
+	u.SetNull(accountdeletion.FieldCompletedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.AccountDeletion.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(accountdeletion.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AccountDeletionUpsertOne) UpdateNewValues() *AccountDeletionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(accountdeletion.FieldID)
+		}
+		if _, exists := u.create.mutation.UserID(); exists {
+			s.SetIgnore(accountdeletion.FieldUserID)
+		}
+		if _, exists := u.create.mutation.RequestedBy(); exists {
+			s.SetIgnore(accountdeletion.FieldRequestedBy)
+		}
+		if _, exists := u.create.mutation.Reason(); exists {
+			s.SetIgnore(accountdeletion.FieldReason)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(accountdeletion.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AccountDeletion.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *AccountDeletionUpsertOne) Ignore() *AccountDeletionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AccountDeletionUpsertOne) DoNothing() *AccountDeletionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AccountDeletionCreate.OnConflict
+// documentation for more info.
+func (u *AccountDeletionUpsertOne) Update(set func(*AccountDeletionUpsert)) *AccountDeletionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AccountDeletionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetDeletionStatus sets the "deletion_status" field.
+func (u *AccountDeletionUpsertOne) SetDeletionStatus(v accountdeletion.DeletionStatus) *AccountDeletionUpsertOne {
+	return u.Update(func(s *AccountDeletionUpsert) {
+		s.SetDeletionStatus(v)
+	})
+}
+
+// UpdateDeletionStatus sets the "deletion_status" field to the value that was provided on create.
+func (u *AccountDeletionUpsertOne) UpdateDeletionStatus() *AccountDeletionUpsertOne {
+	return u.Update(func(s *AccountDeletionUpsert) {
+		s.UpdateDeletionStatus()
+	})
+}
+
+// SetScheduledFor sets the "scheduled_for" field.
+func (u *AccountDeletionUpsertOne) SetScheduledFor(v time.Time) *AccountDeletionUpsertOne {
+	return u.Update(func(s *AccountDeletionUpsert) {
+		s.SetScheduledFor(v)
+	})
+}
+
+// UpdateScheduledFor sets the "scheduled_for" field to the value that was provided on create.
+func (u *AccountDeletionUpsertOne) UpdateScheduledFor() *AccountDeletionUpsertOne {
+	return u.Update(func(s *AccountDeletionUpsert) {
+		s.UpdateScheduledFor()
+	})
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (u *AccountDeletionUpsertOne) SetCancelledAt(v time.Time) *AccountDeletionUpsertOne {
+	return u.Update(func(s *AccountDeletionUpsert) {
+		s.SetCancelledAt(v)
+	})
+}
+
+// UpdateCancelledAt sets the "cancelled_at" field to the value that was provided on create.
+func (u *AccountDeletionUpsertOne) UpdateCancelledAt() *AccountDeletionUpsertOne {
+	return u.Update(func(s *AccountDeletionUpsert) {
+		s.UpdateCancelledAt()
+	})
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (u *AccountDeletionUpsertOne) ClearCancelledAt() *AccountDeletionUpsertOne {
+	return u.Update(func(s *AccountDeletionUpsert) {
+		s.ClearCancelledAt()
+	})
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (u *AccountDeletionUpsertOne) SetCompletedAt(v time.Time) *AccountDeletionUpsertOne {
+	return u.Update(func(s *AccountDeletionUpsert) {
+		s.SetCompletedAt(v)
+	})
+}
+
+// UpdateCompletedAt sets the "completed_at" field to the value that was provided on create.
+func (u *AccountDeletionUpsertOne) UpdateCompletedAt() *AccountDeletionUpsertOne {
+	return u.Update(func(s *AccountDeletionUpsert) {
+		s.UpdateCompletedAt()
+	})
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (u *AccountDeletionUpsertOne) ClearCompletedAt() *AccountDeletionUpsertOne {
+	return u.Update(func(s *AccountDeletionUpsert) {
+		s.ClearCompletedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *AccountDeletionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("generated: missing options for AccountDeletionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AccountDeletionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *AccountDeletionUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("generated: AccountDeletionUpsertOne.ID is not supported by MySQL driver. Use AccountDeletionUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *AccountDeletionUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// AccountDeletionCreateBulk is the builder for creating many AccountDeletion entities in bulk.
+type AccountDeletionCreateBulk struct {
+	config
+	err      error
+	builders []*AccountDeletionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the AccountDeletion entities in the database.
+func (_c *AccountDeletionCreateBulk) Save(ctx context.Context) ([]*AccountDeletion, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AccountDeletion, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AccountDeletionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AccountDeletionCreateBulk) SaveX(ctx context.Context) []*AccountDeletion {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AccountDeletionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AccountDeletionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AccountDeletion.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AccountDeletionUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *AccountDeletionCreateBulk) OnConflict(opts ...sql.ConflictOption) *AccountDeletionUpsertBulk {
+	_c.conflict = opts
+	return &AccountDeletionUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AccountDeletion.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *AccountDeletionCreateBulk) OnConflictColumns(columns ...string) *AccountDeletionUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &AccountDeletionUpsertBulk{
+		create: _c,
+	}
+}
+
+// AccountDeletionUpsertBulk is the builder for "upsert"-ing
+// a bulk of AccountDeletion nodes.
+type AccountDeletionUpsertBulk struct {
+	create *AccountDeletionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.AccountDeletion.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(accountdeletion.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AccountDeletionUpsertBulk) UpdateNewValues() *AccountDeletionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(accountdeletion.FieldID)
+			}
+			if _, exists := b.mutation.UserID(); exists {
+				s.SetIgnore(accountdeletion.FieldUserID)
+			}
+			if _, exists := b.mutation.RequestedBy(); exists {
+				s.SetIgnore(accountdeletion.FieldRequestedBy)
+			}
+			if _, exists := b.mutation.Reason(); exists {
+				s.SetIgnore(accountdeletion.FieldReason)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(accountdeletion.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AccountDeletion.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *AccountDeletionUpsertBulk) Ignore() *AccountDeletionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AccountDeletionUpsertBulk) DoNothing() *AccountDeletionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AccountDeletionCreateBulk.OnConflict
+// documentation for more info.
+func (u *AccountDeletionUpsertBulk) Update(set func(*AccountDeletionUpsert)) *AccountDeletionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AccountDeletionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetDeletionStatus sets the "deletion_status" field.
+func (u *AccountDeletionUpsertBulk) SetDeletionStatus(v accountdeletion.DeletionStatus) *AccountDeletionUpsertBulk {
+	return u.Update(func(s *AccountDeletionUpsert) {
+		s.SetDeletionStatus(v)
+	})
+}
+
+// UpdateDeletionStatus sets the "deletion_status" field to the value that was provided on create.
+func (u *AccountDeletionUpsertBulk) UpdateDeletionStatus() *AccountDeletionUpsertBulk {
+	return u.Update(func(s *AccountDeletionUpsert) {
+		s.UpdateDeletionStatus()
+	})
+}
+
+// SetScheduledFor sets the "scheduled_for" field.
+func (u *AccountDeletionUpsertBulk) SetScheduledFor(v time.Time) *AccountDeletionUpsertBulk {
+	return u.Update(func(s *AccountDeletionUpsert) {
+		s.SetScheduledFor(v)
+	})
+}
+
+// UpdateScheduledFor sets the "scheduled_for" field to the value that was provided on create.
+func (u *AccountDeletionUpsertBulk) UpdateScheduledFor() *AccountDeletionUpsertBulk {
+	return u.Update(func(s *AccountDeletionUpsert) {
+		s.UpdateScheduledFor()
+	})
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (u *AccountDeletionUpsertBulk) SetCancelledAt(v time.Time) *AccountDeletionUpsertBulk {
+	return u.Update(func(s *AccountDeletionUpsert) {
+		s.SetCancelledAt(v)
+	})
+}
+
+// UpdateCancelledAt sets the "cancelled_at" field to the value that was provided on create.
+func (u *AccountDeletionUpsertBulk) UpdateCancelledAt() *AccountDeletionUpsertBulk {
+	return u.Update(func(s *AccountDeletionUpsert) {
+		s.UpdateCancelledAt()
+	})
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (u *AccountDeletionUpsertBulk) ClearCancelledAt() *AccountDeletionUpsertBulk {
+	return u.Update(func(s *AccountDeletionUpsert) {
+		s.ClearCancelledAt()
+	})
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (u *AccountDeletionUpsertBulk) SetCompletedAt(v time.Time) *AccountDeletionUpsertBulk {
+	return u.Update(func(s *AccountDeletionUpsert) {
+		s.SetCompletedAt(v)
+	})
+}
+
+// UpdateCompletedAt sets the "completed_at" field to the value that was provided on create.
+func (u *AccountDeletionUpsertBulk) UpdateCompletedAt() *AccountDeletionUpsertBulk {
+	return u.Update(func(s *AccountDeletionUpsert) {
+		s.UpdateCompletedAt()
+	})
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (u *AccountDeletionUpsertBulk) ClearCompletedAt() *AccountDeletionUpsertBulk {
+	return u.Update(func(s *AccountDeletionUpsert) {
+		s.ClearCompletedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *AccountDeletionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("generated: OnConflict was set for builder %d. Set it on the AccountDeletionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("generated: missing options for AccountDeletionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AccountDeletionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
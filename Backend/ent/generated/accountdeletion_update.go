@@ -0,0 +1,380 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/UnoraApp/be/ent/generated/accountdeletion"
+	"github.com/UnoraApp/be/ent/generated/predicate"
+)
+
+// AccountDeletionUpdate is the builder for updating AccountDeletion entities.
+type AccountDeletionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AccountDeletionMutation
+}
+
+// Where appends a list predicates to the AccountDeletionUpdate builder.
+func (_u *AccountDeletionUpdate) Where(ps ...predicate.AccountDeletion) *AccountDeletionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetDeletionStatus sets the "deletion_status" field.
+func (_u *AccountDeletionUpdate) SetDeletionStatus(v accountdeletion.DeletionStatus) *AccountDeletionUpdate {
+	_u.mutation.SetDeletionStatus(v)
+	return _u
+}
+
+// SetNillableDeletionStatus sets the "deletion_status" field if the given value is not nil.
+func (_u *AccountDeletionUpdate) SetNillableDeletionStatus(v *accountdeletion.DeletionStatus) *AccountDeletionUpdate {
+	if v != nil {
+		_u.SetDeletionStatus(*v)
+	}
+	return _u
+}
+
+// SetScheduledFor sets the "scheduled_for" field.
+func (_u *AccountDeletionUpdate) SetScheduledFor(v time.Time) *AccountDeletionUpdate {
+	_u.mutation.SetScheduledFor(v)
+	return _u
+}
+
+// SetNillableScheduledFor sets the "scheduled_for" field if the given value is not nil.
+func (_u *AccountDeletionUpdate) SetNillableScheduledFor(v *time.Time) *AccountDeletionUpdate {
+	if v != nil {
+		_u.SetScheduledFor(*v)
+	}
+	return _u
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (_u *AccountDeletionUpdate) SetCancelledAt(v time.Time) *AccountDeletionUpdate {
+	_u.mutation.SetCancelledAt(v)
+	return _u
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (_u *AccountDeletionUpdate) SetNillableCancelledAt(v *time.Time) *AccountDeletionUpdate {
+	if v != nil {
+		_u.SetCancelledAt(*v)
+	}
+	return _u
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (_u *AccountDeletionUpdate) ClearCancelledAt() *AccountDeletionUpdate {
+	_u.mutation.ClearCancelledAt()
+	return _u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_u *AccountDeletionUpdate) SetCompletedAt(v time.Time) *AccountDeletionUpdate {
+	_u.mutation.SetCompletedAt(v)
+	return _u
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_u *AccountDeletionUpdate) SetNillableCompletedAt(v *time.Time) *AccountDeletionUpdate {
+	if v != nil {
+		_u.SetCompletedAt(*v)
+	}
+	return _u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (_u *AccountDeletionUpdate) ClearCompletedAt() *AccountDeletionUpdate {
+	_u.mutation.ClearCompletedAt()
+	return _u
+}
+
+// Mutation returns the AccountDeletionMutation object of the builder.
+func (_u *AccountDeletionUpdate) Mutation() *AccountDeletionMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AccountDeletionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AccountDeletionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AccountDeletionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AccountDeletionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AccountDeletionUpdate) check() error {
+	if v, ok := _u.mutation.DeletionStatus(); ok {
+		if err := accountdeletion.DeletionStatusValidator(v); err != nil {
+			return &ValidationError{Name: "deletion_status", err: fmt.Errorf(`generated: validator failed for field "AccountDeletion.deletion_status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *AccountDeletionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(accountdeletion.Table, accountdeletion.Columns, sqlgraph.NewFieldSpec(accountdeletion.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.DeletionStatus(); ok {
+		_spec.SetField(accountdeletion.FieldDeletionStatus, field.TypeEnum, value)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(accountdeletion.FieldReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.ScheduledFor(); ok {
+		_spec.SetField(accountdeletion.FieldScheduledFor, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.CancelledAt(); ok {
+		_spec.SetField(accountdeletion.FieldCancelledAt, field.TypeTime, value)
+	}
+	if _u.mutation.CancelledAtCleared() {
+		_spec.ClearField(accountdeletion.FieldCancelledAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CompletedAt(); ok {
+		_spec.SetField(accountdeletion.FieldCompletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CompletedAtCleared() {
+		_spec.ClearField(accountdeletion.FieldCompletedAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{accountdeletion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AccountDeletionUpdateOne is the builder for updating a single AccountDeletion entity.
+type AccountDeletionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AccountDeletionMutation
+}
+
+// SetDeletionStatus sets the "deletion_status" field.
+func (_u *AccountDeletionUpdateOne) SetDeletionStatus(v accountdeletion.DeletionStatus) *AccountDeletionUpdateOne {
+	_u.mutation.SetDeletionStatus(v)
+	return _u
+}
+
+// SetNillableDeletionStatus sets the "deletion_status" field if the given value is not nil.
+func (_u *AccountDeletionUpdateOne) SetNillableDeletionStatus(v *accountdeletion.DeletionStatus) *AccountDeletionUpdateOne {
+	if v != nil {
+		_u.SetDeletionStatus(*v)
+	}
+	return _u
+}
+
+// SetScheduledFor sets the "scheduled_for" field.
+func (_u *AccountDeletionUpdateOne) SetScheduledFor(v time.Time) *AccountDeletionUpdateOne {
+	_u.mutation.SetScheduledFor(v)
+	return _u
+}
+
+// SetNillableScheduledFor sets the "scheduled_for" field if the given value is not nil.
+func (_u *AccountDeletionUpdateOne) SetNillableScheduledFor(v *time.Time) *AccountDeletionUpdateOne {
+	if v != nil {
+		_u.SetScheduledFor(*v)
+	}
+	return _u
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (_u *AccountDeletionUpdateOne) SetCancelledAt(v time.Time) *AccountDeletionUpdateOne {
+	_u.mutation.SetCancelledAt(v)
+	return _u
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (_u *AccountDeletionUpdateOne) SetNillableCancelledAt(v *time.Time) *AccountDeletionUpdateOne {
+	if v != nil {
+		_u.SetCancelledAt(*v)
+	}
+	return _u
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (_u *AccountDeletionUpdateOne) ClearCancelledAt() *AccountDeletionUpdateOne {
+	_u.mutation.ClearCancelledAt()
+	return _u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_u *AccountDeletionUpdateOne) SetCompletedAt(v time.Time) *AccountDeletionUpdateOne {
+	_u.mutation.SetCompletedAt(v)
+	return _u
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_u *AccountDeletionUpdateOne) SetNillableCompletedAt(v *time.Time) *AccountDeletionUpdateOne {
+	if v != nil {
+		_u.SetCompletedAt(*v)
+	}
+	return _u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (_u *AccountDeletionUpdateOne) ClearCompletedAt() *AccountDeletionUpdateOne {
+	_u.mutation.ClearCompletedAt()
+	return _u
+}
+
+// Mutation returns the AccountDeletionMutation object of the builder.
+func (_u *AccountDeletionUpdateOne) Mutation() *AccountDeletionMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the AccountDeletionUpdate builder.
+func (_u *AccountDeletionUpdateOne) Where(ps ...predicate.AccountDeletion) *AccountDeletionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AccountDeletionUpdateOne) Select(field string, fields ...string) *AccountDeletionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AccountDeletion entity.
+func (_u *AccountDeletionUpdateOne) Save(ctx context.Context) (*AccountDeletion, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AccountDeletionUpdateOne) SaveX(ctx context.Context) *AccountDeletion {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AccountDeletionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AccountDeletionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AccountDeletionUpdateOne) check() error {
+	if v, ok := _u.mutation.DeletionStatus(); ok {
+		if err := accountdeletion.DeletionStatusValidator(v); err != nil {
+			return &ValidationError{Name: "deletion_status", err: fmt.Errorf(`generated: validator failed for field "AccountDeletion.deletion_status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *AccountDeletionUpdateOne) sqlSave(ctx context.Context) (_node *AccountDeletion, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(accountdeletion.Table, accountdeletion.Columns, sqlgraph.NewFieldSpec(accountdeletion.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "AccountDeletion.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, accountdeletion.FieldID)
+		for _, f := range fields {
+			if !accountdeletion.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != accountdeletion.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.DeletionStatus(); ok {
+		_spec.SetField(accountdeletion.FieldDeletionStatus, field.TypeEnum, value)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(accountdeletion.FieldReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.ScheduledFor(); ok {
+		_spec.SetField(accountdeletion.FieldScheduledFor, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.CancelledAt(); ok {
+		_spec.SetField(accountdeletion.FieldCancelledAt, field.TypeTime, value)
+	}
+	if _u.mutation.CancelledAtCleared() {
+		_spec.ClearField(accountdeletion.FieldCancelledAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CompletedAt(); ok {
+		_spec.SetField(accountdeletion.FieldCompletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CompletedAtCleared() {
+		_spec.ClearField(accountdeletion.FieldCompletedAt, field.TypeTime)
+	}
+	_node = &AccountDeletion{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{accountdeletion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,219 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/UnoraApp/be/ent/generated/adminuser"
+)
+
+// AdminUser is the model entity for the AdminUser schema.
+type AdminUser struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Email holds the value of the "email" field.
+	Email string `json:"email,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Role holds the value of the "role" field.
+	Role adminuser.Role `json:"role,omitempty"`
+	// AdminStatus holds the value of the "admin_status" field.
+	AdminStatus adminuser.AdminStatus `json:"admin_status,omitempty"`
+	// APIKeyHash holds the value of the "api_key_hash" field.
+	APIKeyHash string `json:"-"`
+	// APIKeyPrefix holds the value of the "api_key_prefix" field.
+	APIKeyPrefix string `json:"api_key_prefix,omitempty"`
+	// APIKeyRotatedAt holds the value of the "api_key_rotated_at" field.
+	APIKeyRotatedAt time.Time `json:"api_key_rotated_at,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy *string `json:"created_by,omitempty"`
+	// LastUsedAt holds the value of the "last_used_at" field.
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AdminUser) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case adminuser.FieldID, adminuser.FieldEmail, adminuser.FieldName, adminuser.FieldRole, adminuser.FieldAdminStatus, adminuser.FieldAPIKeyHash, adminuser.FieldAPIKeyPrefix, adminuser.FieldCreatedBy:
+			values[i] = new(sql.NullString)
+		case adminuser.FieldAPIKeyRotatedAt, adminuser.FieldLastUsedAt, adminuser.FieldCreatedAt, adminuser.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AdminUser fields.
+func (_m *AdminUser) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case adminuser.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case adminuser.FieldEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field email", values[i])
+			} else if value.Valid {
+				_m.Email = value.String
+			}
+		case adminuser.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case adminuser.FieldRole:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field role", values[i])
+			} else if value.Valid {
+				_m.Role = adminuser.Role(value.String)
+			}
+		case adminuser.FieldAdminStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field admin_status", values[i])
+			} else if value.Valid {
+				_m.AdminStatus = adminuser.AdminStatus(value.String)
+			}
+		case adminuser.FieldAPIKeyHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field api_key_hash", values[i])
+			} else if value.Valid {
+				_m.APIKeyHash = value.String
+			}
+		case adminuser.FieldAPIKeyPrefix:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field api_key_prefix", values[i])
+			} else if value.Valid {
+				_m.APIKeyPrefix = value.String
+			}
+		case adminuser.FieldAPIKeyRotatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field api_key_rotated_at", values[i])
+			} else if value.Valid {
+				_m.APIKeyRotatedAt = value.Time
+			}
+		case adminuser.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				_m.CreatedBy = new(string)
+				*_m.CreatedBy = value.String
+			}
+		case adminuser.FieldLastUsedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_used_at", values[i])
+			} else if value.Valid {
+				_m.LastUsedAt = new(time.Time)
+				*_m.LastUsedAt = value.Time
+			}
+		case adminuser.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case adminuser.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AdminUser.
+// This includes values selected through modifiers, order, etc.
+func (_m *AdminUser) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this AdminUser.
+// Note that you need to call AdminUser.Unwrap() before calling this method if this AdminUser
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *AdminUser) Update() *AdminUserUpdateOne {
+	return NewAdminUserClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the AdminUser entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *AdminUser) Unwrap() *AdminUser {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("generated: AdminUser is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *AdminUser) String() string {
+	var builder strings.Builder
+	builder.WriteString("AdminUser(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("email=")
+	builder.WriteString(_m.Email)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("role=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Role))
+	builder.WriteString(", ")
+	builder.WriteString("admin_status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AdminStatus))
+	builder.WriteString(", ")
+	builder.WriteString("api_key_hash=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("api_key_prefix=")
+	builder.WriteString(_m.APIKeyPrefix)
+	builder.WriteString(", ")
+	builder.WriteString("api_key_rotated_at=")
+	builder.WriteString(_m.APIKeyRotatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.CreatedBy; v != nil {
+		builder.WriteString("created_by=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.LastUsedAt; v != nil {
+		builder.WriteString("last_used_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AdminUsers is a parsable slice of AdminUser.
+type AdminUsers []*AdminUser
@@ -0,0 +1,204 @@
+// Code generated by ent, DO NOT EDIT.
+
+package adminuser
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the adminuser type in the database.
+	Label = "admin_user"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldEmail holds the string denoting the email field in the database.
+	FieldEmail = "email"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldRole holds the string denoting the role field in the database.
+	FieldRole = "role"
+	// FieldAdminStatus holds the string denoting the admin_status field in the database.
+	FieldAdminStatus = "admin_status"
+	// FieldAPIKeyHash holds the string denoting the api_key_hash field in the database.
+	FieldAPIKeyHash = "api_key_hash"
+	// FieldAPIKeyPrefix holds the string denoting the api_key_prefix field in the database.
+	FieldAPIKeyPrefix = "api_key_prefix"
+	// FieldAPIKeyRotatedAt holds the string denoting the api_key_rotated_at field in the database.
+	FieldAPIKeyRotatedAt = "api_key_rotated_at"
+	// FieldCreatedBy holds the string denoting the created_by field in the database.
+	FieldCreatedBy = "created_by"
+	// FieldLastUsedAt holds the string denoting the last_used_at field in the database.
+	FieldLastUsedAt = "last_used_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the adminuser in the database.
+	Table = "admin_users"
+)
+
+// Columns holds all SQL columns for adminuser fields.
+var Columns = []string{
+	FieldID,
+	FieldEmail,
+	FieldName,
+	FieldRole,
+	FieldAdminStatus,
+	FieldAPIKeyHash,
+	FieldAPIKeyPrefix,
+	FieldAPIKeyRotatedAt,
+	FieldCreatedBy,
+	FieldLastUsedAt,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	EmailValidator func(string) error
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// APIKeyHashValidator is a validator for the "api_key_hash" field. It is called by the builders before save.
+	APIKeyHashValidator func(string) error
+	// APIKeyPrefixValidator is a validator for the "api_key_prefix" field. It is called by the builders before save.
+	APIKeyPrefixValidator func(string) error
+	// DefaultAPIKeyRotatedAt holds the default value on creation for the "api_key_rotated_at" field.
+	DefaultAPIKeyRotatedAt func() time.Time
+	// CreatedByValidator is a validator for the "created_by" field. It is called by the builders before save.
+	CreatedByValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
+
+// Role defines the type for the "role" enum field.
+type Role string
+
+// Role values.
+const (
+	RoleSupport    Role = "support"
+	RoleModerator  Role = "moderator"
+	RoleFinance    Role = "finance"
+	RoleSuperadmin Role = "superadmin"
+)
+
+func (r Role) String() string {
+	return string(r)
+}
+
+// RoleValidator is a validator for the "role" field enum values. It is called by the builders before save.
+func RoleValidator(r Role) error {
+	switch r {
+	case RoleSupport, RoleModerator, RoleFinance, RoleSuperadmin:
+		return nil
+	default:
+		return fmt.Errorf("adminuser: invalid enum value for role field: %q", r)
+	}
+}
+
+// AdminStatus defines the type for the "admin_status" enum field.
+type AdminStatus string
+
+// AdminStatusActive is the default value of the AdminStatus enum.
+const DefaultAdminStatus = AdminStatusActive
+
+// AdminStatus values.
+const (
+	AdminStatusActive   AdminStatus = "active"
+	AdminStatusDisabled AdminStatus = "disabled"
+)
+
+func (as AdminStatus) String() string {
+	return string(as)
+}
+
+// AdminStatusValidator is a validator for the "admin_status" field enum values. It is called by the builders before save.
+func AdminStatusValidator(as AdminStatus) error {
+	switch as {
+	case AdminStatusActive, AdminStatusDisabled:
+		return nil
+	default:
+		return fmt.Errorf("adminuser: invalid enum value for admin_status field: %q", as)
+	}
+}
+
+// OrderOption defines the ordering options for the AdminUser queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByEmail orders the results by the email field.
+func ByEmail(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEmail, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByRole orders the results by the role field.
+func ByRole(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRole, opts...).ToFunc()
+}
+
+// ByAdminStatus orders the results by the admin_status field.
+func ByAdminStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAdminStatus, opts...).ToFunc()
+}
+
+// ByAPIKeyHash orders the results by the api_key_hash field.
+func ByAPIKeyHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAPIKeyHash, opts...).ToFunc()
+}
+
+// ByAPIKeyPrefix orders the results by the api_key_prefix field.
+func ByAPIKeyPrefix(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAPIKeyPrefix, opts...).ToFunc()
+}
+
+// ByAPIKeyRotatedAt orders the results by the api_key_rotated_at field.
+func ByAPIKeyRotatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAPIKeyRotatedAt, opts...).ToFunc()
+}
+
+// ByCreatedBy orders the results by the created_by field.
+func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedBy, opts...).ToFunc()
+}
+
+// ByLastUsedAt orders the results by the last_used_at field.
+func ByLastUsedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastUsedAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
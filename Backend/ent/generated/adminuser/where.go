@@ -0,0 +1,670 @@
+// Code generated by ent, DO NOT EDIT.
+
+package adminuser
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/UnoraApp/be/ent/generated/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldContainsFold(FieldID, id))
+}
+
+// Email applies equality check predicate on the "email" field. It's identical to EmailEQ.
+func Email(v string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldEQ(FieldEmail, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldEQ(FieldName, v))
+}
+
+// APIKeyHash applies equality check predicate on the "api_key_hash" field. It's identical to APIKeyHashEQ.
+func APIKeyHash(v string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldEQ(FieldAPIKeyHash, v))
+}
+
+// APIKeyPrefix applies equality check predicate on the "api_key_prefix" field. It's identical to APIKeyPrefixEQ.
+func APIKeyPrefix(v string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldEQ(FieldAPIKeyPrefix, v))
+}
+
+// APIKeyRotatedAt applies equality check predicate on the "api_key_rotated_at" field. It's identical to APIKeyRotatedAtEQ.
+func APIKeyRotatedAt(v time.Time) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldEQ(FieldAPIKeyRotatedAt, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// LastUsedAt applies equality check predicate on the "last_used_at" field. It's identical to LastUsedAtEQ.
+func LastUsedAt(v time.Time) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldEQ(FieldLastUsedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// EmailEQ applies the EQ predicate on the "email" field.
+func EmailEQ(v string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldEQ(FieldEmail, v))
+}
+
+// EmailNEQ applies the NEQ predicate on the "email" field.
+func EmailNEQ(v string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldNEQ(FieldEmail, v))
+}
+
+// EmailIn applies the In predicate on the "email" field.
+func EmailIn(vs ...string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldIn(FieldEmail, vs...))
+}
+
+// EmailNotIn applies the NotIn predicate on the "email" field.
+func EmailNotIn(vs ...string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldNotIn(FieldEmail, vs...))
+}
+
+// EmailGT applies the GT predicate on the "email" field.
+func EmailGT(v string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldGT(FieldEmail, v))
+}
+
+// EmailGTE applies the GTE predicate on the "email" field.
+func EmailGTE(v string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldGTE(FieldEmail, v))
+}
+
+// EmailLT applies the LT predicate on the "email" field.
+func EmailLT(v string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldLT(FieldEmail, v))
+}
+
+// EmailLTE applies the LTE predicate on the "email" field.
+func EmailLTE(v string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldLTE(FieldEmail, v))
+}
+
+// EmailContains applies the Contains predicate on the "email" field.
+func EmailContains(v string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldContains(FieldEmail, v))
+}
+
+// EmailHasPrefix applies the HasPrefix predicate on the "email" field.
+func EmailHasPrefix(v string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldHasPrefix(FieldEmail, v))
+}
+
+// EmailHasSuffix applies the HasSuffix predicate on the "email" field.
+func EmailHasSuffix(v string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldHasSuffix(FieldEmail, v))
+}
+
+// EmailEqualFold applies the EqualFold predicate on the "email" field.
+func EmailEqualFold(v string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldEqualFold(FieldEmail, v))
+}
+
+// EmailContainsFold applies the ContainsFold predicate on the "email" field.
+func EmailContainsFold(v string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldContainsFold(FieldEmail, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldContainsFold(FieldName, v))
+}
+
+// RoleEQ applies the EQ predicate on the "role" field.
+func RoleEQ(v Role) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldEQ(FieldRole, v))
+}
+
+// RoleNEQ applies the NEQ predicate on the "role" field.
+func RoleNEQ(v Role) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldNEQ(FieldRole, v))
+}
+
+// RoleIn applies the In predicate on the "role" field.
+func RoleIn(vs ...Role) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldIn(FieldRole, vs...))
+}
+
+// RoleNotIn applies the NotIn predicate on the "role" field.
+func RoleNotIn(vs ...Role) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldNotIn(FieldRole, vs...))
+}
+
+// AdminStatusEQ applies the EQ predicate on the "admin_status" field.
+func AdminStatusEQ(v AdminStatus) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldEQ(FieldAdminStatus, v))
+}
+
+// AdminStatusNEQ applies the NEQ predicate on the "admin_status" field.
+func AdminStatusNEQ(v AdminStatus) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldNEQ(FieldAdminStatus, v))
+}
+
+// AdminStatusIn applies the In predicate on the "admin_status" field.
+func AdminStatusIn(vs ...AdminStatus) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldIn(FieldAdminStatus, vs...))
+}
+
+// AdminStatusNotIn applies the NotIn predicate on the "admin_status" field.
+func AdminStatusNotIn(vs ...AdminStatus) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldNotIn(FieldAdminStatus, vs...))
+}
+
+// APIKeyHashEQ applies the EQ predicate on the "api_key_hash" field.
+func APIKeyHashEQ(v string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldEQ(FieldAPIKeyHash, v))
+}
+
+// APIKeyHashNEQ applies the NEQ predicate on the "api_key_hash" field.
+func APIKeyHashNEQ(v string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldNEQ(FieldAPIKeyHash, v))
+}
+
+// APIKeyHashIn applies the In predicate on the "api_key_hash" field.
+func APIKeyHashIn(vs ...string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldIn(FieldAPIKeyHash, vs...))
+}
+
+// APIKeyHashNotIn applies the NotIn predicate on the "api_key_hash" field.
+func APIKeyHashNotIn(vs ...string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldNotIn(FieldAPIKeyHash, vs...))
+}
+
+// APIKeyHashGT applies the GT predicate on the "api_key_hash" field.
+func APIKeyHashGT(v string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldGT(FieldAPIKeyHash, v))
+}
+
+// APIKeyHashGTE applies the GTE predicate on the "api_key_hash" field.
+func APIKeyHashGTE(v string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldGTE(FieldAPIKeyHash, v))
+}
+
+// APIKeyHashLT applies the LT predicate on the "api_key_hash" field.
+func APIKeyHashLT(v string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldLT(FieldAPIKeyHash, v))
+}
+
+// APIKeyHashLTE applies the LTE predicate on the "api_key_hash" field.
+func APIKeyHashLTE(v string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldLTE(FieldAPIKeyHash, v))
+}
+
+// APIKeyHashContains applies the Contains predicate on the "api_key_hash" field.
+func APIKeyHashContains(v string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldContains(FieldAPIKeyHash, v))
+}
+
+// APIKeyHashHasPrefix applies the HasPrefix predicate on the "api_key_hash" field.
+func APIKeyHashHasPrefix(v string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldHasPrefix(FieldAPIKeyHash, v))
+}
+
+// APIKeyHashHasSuffix applies the HasSuffix predicate on the "api_key_hash" field.
+func APIKeyHashHasSuffix(v string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldHasSuffix(FieldAPIKeyHash, v))
+}
+
+// APIKeyHashEqualFold applies the EqualFold predicate on the "api_key_hash" field.
+func APIKeyHashEqualFold(v string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldEqualFold(FieldAPIKeyHash, v))
+}
+
+// APIKeyHashContainsFold applies the ContainsFold predicate on the "api_key_hash" field.
+func APIKeyHashContainsFold(v string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldContainsFold(FieldAPIKeyHash, v))
+}
+
+// APIKeyPrefixEQ applies the EQ predicate on the "api_key_prefix" field.
+func APIKeyPrefixEQ(v string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldEQ(FieldAPIKeyPrefix, v))
+}
+
+// APIKeyPrefixNEQ applies the NEQ predicate on the "api_key_prefix" field.
+func APIKeyPrefixNEQ(v string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldNEQ(FieldAPIKeyPrefix, v))
+}
+
+// APIKeyPrefixIn applies the In predicate on the "api_key_prefix" field.
+func APIKeyPrefixIn(vs ...string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldIn(FieldAPIKeyPrefix, vs...))
+}
+
+// APIKeyPrefixNotIn applies the NotIn predicate on the "api_key_prefix" field.
+func APIKeyPrefixNotIn(vs ...string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldNotIn(FieldAPIKeyPrefix, vs...))
+}
+
+// APIKeyPrefixGT applies the GT predicate on the "api_key_prefix" field.
+func APIKeyPrefixGT(v string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldGT(FieldAPIKeyPrefix, v))
+}
+
+// APIKeyPrefixGTE applies the GTE predicate on the "api_key_prefix" field.
+func APIKeyPrefixGTE(v string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldGTE(FieldAPIKeyPrefix, v))
+}
+
+// APIKeyPrefixLT applies the LT predicate on the "api_key_prefix" field.
+func APIKeyPrefixLT(v string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldLT(FieldAPIKeyPrefix, v))
+}
+
+// APIKeyPrefixLTE applies the LTE predicate on the "api_key_prefix" field.
+func APIKeyPrefixLTE(v string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldLTE(FieldAPIKeyPrefix, v))
+}
+
+// APIKeyPrefixContains applies the Contains predicate on the "api_key_prefix" field.
+func APIKeyPrefixContains(v string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldContains(FieldAPIKeyPrefix, v))
+}
+
+// APIKeyPrefixHasPrefix applies the HasPrefix predicate on the "api_key_prefix" field.
+func APIKeyPrefixHasPrefix(v string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldHasPrefix(FieldAPIKeyPrefix, v))
+}
+
+// APIKeyPrefixHasSuffix applies the HasSuffix predicate on the "api_key_prefix" field.
+func APIKeyPrefixHasSuffix(v string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldHasSuffix(FieldAPIKeyPrefix, v))
+}
+
+// APIKeyPrefixEqualFold applies the EqualFold predicate on the "api_key_prefix" field.
+func APIKeyPrefixEqualFold(v string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldEqualFold(FieldAPIKeyPrefix, v))
+}
+
+// APIKeyPrefixContainsFold applies the ContainsFold predicate on the "api_key_prefix" field.
+func APIKeyPrefixContainsFold(v string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldContainsFold(FieldAPIKeyPrefix, v))
+}
+
+// APIKeyRotatedAtEQ applies the EQ predicate on the "api_key_rotated_at" field.
+func APIKeyRotatedAtEQ(v time.Time) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldEQ(FieldAPIKeyRotatedAt, v))
+}
+
+// APIKeyRotatedAtNEQ applies the NEQ predicate on the "api_key_rotated_at" field.
+func APIKeyRotatedAtNEQ(v time.Time) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldNEQ(FieldAPIKeyRotatedAt, v))
+}
+
+// APIKeyRotatedAtIn applies the In predicate on the "api_key_rotated_at" field.
+func APIKeyRotatedAtIn(vs ...time.Time) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldIn(FieldAPIKeyRotatedAt, vs...))
+}
+
+// APIKeyRotatedAtNotIn applies the NotIn predicate on the "api_key_rotated_at" field.
+func APIKeyRotatedAtNotIn(vs ...time.Time) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldNotIn(FieldAPIKeyRotatedAt, vs...))
+}
+
+// APIKeyRotatedAtGT applies the GT predicate on the "api_key_rotated_at" field.
+func APIKeyRotatedAtGT(v time.Time) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldGT(FieldAPIKeyRotatedAt, v))
+}
+
+// APIKeyRotatedAtGTE applies the GTE predicate on the "api_key_rotated_at" field.
+func APIKeyRotatedAtGTE(v time.Time) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldGTE(FieldAPIKeyRotatedAt, v))
+}
+
+// APIKeyRotatedAtLT applies the LT predicate on the "api_key_rotated_at" field.
+func APIKeyRotatedAtLT(v time.Time) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldLT(FieldAPIKeyRotatedAt, v))
+}
+
+// APIKeyRotatedAtLTE applies the LTE predicate on the "api_key_rotated_at" field.
+func APIKeyRotatedAtLTE(v time.Time) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldLTE(FieldAPIKeyRotatedAt, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByContains applies the Contains predicate on the "created_by" field.
+func CreatedByContains(v string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldContains(FieldCreatedBy, v))
+}
+
+// CreatedByHasPrefix applies the HasPrefix predicate on the "created_by" field.
+func CreatedByHasPrefix(v string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldHasPrefix(FieldCreatedBy, v))
+}
+
+// CreatedByHasSuffix applies the HasSuffix predicate on the "created_by" field.
+func CreatedByHasSuffix(v string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldHasSuffix(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// CreatedByEqualFold applies the EqualFold predicate on the "created_by" field.
+func CreatedByEqualFold(v string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldEqualFold(FieldCreatedBy, v))
+}
+
+// CreatedByContainsFold applies the ContainsFold predicate on the "created_by" field.
+func CreatedByContainsFold(v string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldContainsFold(FieldCreatedBy, v))
+}
+
+// LastUsedAtEQ applies the EQ predicate on the "last_used_at" field.
+func LastUsedAtEQ(v time.Time) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldEQ(FieldLastUsedAt, v))
+}
+
+// LastUsedAtNEQ applies the NEQ predicate on the "last_used_at" field.
+func LastUsedAtNEQ(v time.Time) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldNEQ(FieldLastUsedAt, v))
+}
+
+// LastUsedAtIn applies the In predicate on the "last_used_at" field.
+func LastUsedAtIn(vs ...time.Time) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldIn(FieldLastUsedAt, vs...))
+}
+
+// LastUsedAtNotIn applies the NotIn predicate on the "last_used_at" field.
+func LastUsedAtNotIn(vs ...time.Time) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldNotIn(FieldLastUsedAt, vs...))
+}
+
+// LastUsedAtGT applies the GT predicate on the "last_used_at" field.
+func LastUsedAtGT(v time.Time) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldGT(FieldLastUsedAt, v))
+}
+
+// LastUsedAtGTE applies the GTE predicate on the "last_used_at" field.
+func LastUsedAtGTE(v time.Time) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldGTE(FieldLastUsedAt, v))
+}
+
+// LastUsedAtLT applies the LT predicate on the "last_used_at" field.
+func LastUsedAtLT(v time.Time) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldLT(FieldLastUsedAt, v))
+}
+
+// LastUsedAtLTE applies the LTE predicate on the "last_used_at" field.
+func LastUsedAtLTE(v time.Time) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldLTE(FieldLastUsedAt, v))
+}
+
+// LastUsedAtIsNil applies the IsNil predicate on the "last_used_at" field.
+func LastUsedAtIsNil() predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldIsNull(FieldLastUsedAt))
+}
+
+// LastUsedAtNotNil applies the NotNil predicate on the "last_used_at" field.
+func LastUsedAtNotNil() predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldNotNull(FieldLastUsedAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AdminUser) predicate.AdminUser {
+	return predicate.AdminUser(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AdminUser) predicate.AdminUser {
+	return predicate.AdminUser(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AdminUser) predicate.AdminUser {
+	return predicate.AdminUser(sql.NotPredicates(p))
+}
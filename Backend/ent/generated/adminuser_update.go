@@ -0,0 +1,584 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/UnoraApp/be/ent/generated/adminuser"
+	"github.com/UnoraApp/be/ent/generated/predicate"
+)
+
+// AdminUserUpdate is the builder for updating AdminUser entities.
+type AdminUserUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AdminUserMutation
+}
+
+// Where appends a list predicates to the AdminUserUpdate builder.
+func (_u *AdminUserUpdate) Where(ps ...predicate.AdminUser) *AdminUserUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetEmail sets the "email" field.
+func (_u *AdminUserUpdate) SetEmail(v string) *AdminUserUpdate {
+	_u.mutation.SetEmail(v)
+	return _u
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_u *AdminUserUpdate) SetNillableEmail(v *string) *AdminUserUpdate {
+	if v != nil {
+		_u.SetEmail(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *AdminUserUpdate) SetName(v string) *AdminUserUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *AdminUserUpdate) SetNillableName(v *string) *AdminUserUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetRole sets the "role" field.
+func (_u *AdminUserUpdate) SetRole(v adminuser.Role) *AdminUserUpdate {
+	_u.mutation.SetRole(v)
+	return _u
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_u *AdminUserUpdate) SetNillableRole(v *adminuser.Role) *AdminUserUpdate {
+	if v != nil {
+		_u.SetRole(*v)
+	}
+	return _u
+}
+
+// SetAdminStatus sets the "admin_status" field.
+func (_u *AdminUserUpdate) SetAdminStatus(v adminuser.AdminStatus) *AdminUserUpdate {
+	_u.mutation.SetAdminStatus(v)
+	return _u
+}
+
+// SetNillableAdminStatus sets the "admin_status" field if the given value is not nil.
+func (_u *AdminUserUpdate) SetNillableAdminStatus(v *adminuser.AdminStatus) *AdminUserUpdate {
+	if v != nil {
+		_u.SetAdminStatus(*v)
+	}
+	return _u
+}
+
+// SetAPIKeyHash sets the "api_key_hash" field.
+func (_u *AdminUserUpdate) SetAPIKeyHash(v string) *AdminUserUpdate {
+	_u.mutation.SetAPIKeyHash(v)
+	return _u
+}
+
+// SetNillableAPIKeyHash sets the "api_key_hash" field if the given value is not nil.
+func (_u *AdminUserUpdate) SetNillableAPIKeyHash(v *string) *AdminUserUpdate {
+	if v != nil {
+		_u.SetAPIKeyHash(*v)
+	}
+	return _u
+}
+
+// SetAPIKeyPrefix sets the "api_key_prefix" field.
+func (_u *AdminUserUpdate) SetAPIKeyPrefix(v string) *AdminUserUpdate {
+	_u.mutation.SetAPIKeyPrefix(v)
+	return _u
+}
+
+// SetNillableAPIKeyPrefix sets the "api_key_prefix" field if the given value is not nil.
+func (_u *AdminUserUpdate) SetNillableAPIKeyPrefix(v *string) *AdminUserUpdate {
+	if v != nil {
+		_u.SetAPIKeyPrefix(*v)
+	}
+	return _u
+}
+
+// SetAPIKeyRotatedAt sets the "api_key_rotated_at" field.
+func (_u *AdminUserUpdate) SetAPIKeyRotatedAt(v time.Time) *AdminUserUpdate {
+	_u.mutation.SetAPIKeyRotatedAt(v)
+	return _u
+}
+
+// SetNillableAPIKeyRotatedAt sets the "api_key_rotated_at" field if the given value is not nil.
+func (_u *AdminUserUpdate) SetNillableAPIKeyRotatedAt(v *time.Time) *AdminUserUpdate {
+	if v != nil {
+		_u.SetAPIKeyRotatedAt(*v)
+	}
+	return _u
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (_u *AdminUserUpdate) SetLastUsedAt(v time.Time) *AdminUserUpdate {
+	_u.mutation.SetLastUsedAt(v)
+	return _u
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (_u *AdminUserUpdate) SetNillableLastUsedAt(v *time.Time) *AdminUserUpdate {
+	if v != nil {
+		_u.SetLastUsedAt(*v)
+	}
+	return _u
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (_u *AdminUserUpdate) ClearLastUsedAt() *AdminUserUpdate {
+	_u.mutation.ClearLastUsedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AdminUserUpdate) SetUpdatedAt(v time.Time) *AdminUserUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the AdminUserMutation object of the builder.
+func (_u *AdminUserUpdate) Mutation() *AdminUserMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AdminUserUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AdminUserUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AdminUserUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AdminUserUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *AdminUserUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := adminuser.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AdminUserUpdate) check() error {
+	if v, ok := _u.mutation.Email(); ok {
+		if err := adminuser.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`generated: validator failed for field "AdminUser.email": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := adminuser.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`generated: validator failed for field "AdminUser.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Role(); ok {
+		if err := adminuser.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`generated: validator failed for field "AdminUser.role": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AdminStatus(); ok {
+		if err := adminuser.AdminStatusValidator(v); err != nil {
+			return &ValidationError{Name: "admin_status", err: fmt.Errorf(`generated: validator failed for field "AdminUser.admin_status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.APIKeyHash(); ok {
+		if err := adminuser.APIKeyHashValidator(v); err != nil {
+			return &ValidationError{Name: "api_key_hash", err: fmt.Errorf(`generated: validator failed for field "AdminUser.api_key_hash": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.APIKeyPrefix(); ok {
+		if err := adminuser.APIKeyPrefixValidator(v); err != nil {
+			return &ValidationError{Name: "api_key_prefix", err: fmt.Errorf(`generated: validator failed for field "AdminUser.api_key_prefix": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *AdminUserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(adminuser.Table, adminuser.Columns, sqlgraph.NewFieldSpec(adminuser.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Email(); ok {
+		_spec.SetField(adminuser.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(adminuser.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Role(); ok {
+		_spec.SetField(adminuser.FieldRole, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.AdminStatus(); ok {
+		_spec.SetField(adminuser.FieldAdminStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.APIKeyHash(); ok {
+		_spec.SetField(adminuser.FieldAPIKeyHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.APIKeyPrefix(); ok {
+		_spec.SetField(adminuser.FieldAPIKeyPrefix, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.APIKeyRotatedAt(); ok {
+		_spec.SetField(adminuser.FieldAPIKeyRotatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(adminuser.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := _u.mutation.LastUsedAt(); ok {
+		_spec.SetField(adminuser.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastUsedAtCleared() {
+		_spec.ClearField(adminuser.FieldLastUsedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(adminuser.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{adminuser.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AdminUserUpdateOne is the builder for updating a single AdminUser entity.
+type AdminUserUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AdminUserMutation
+}
+
+// SetEmail sets the "email" field.
+func (_u *AdminUserUpdateOne) SetEmail(v string) *AdminUserUpdateOne {
+	_u.mutation.SetEmail(v)
+	return _u
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_u *AdminUserUpdateOne) SetNillableEmail(v *string) *AdminUserUpdateOne {
+	if v != nil {
+		_u.SetEmail(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *AdminUserUpdateOne) SetName(v string) *AdminUserUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *AdminUserUpdateOne) SetNillableName(v *string) *AdminUserUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetRole sets the "role" field.
+func (_u *AdminUserUpdateOne) SetRole(v adminuser.Role) *AdminUserUpdateOne {
+	_u.mutation.SetRole(v)
+	return _u
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_u *AdminUserUpdateOne) SetNillableRole(v *adminuser.Role) *AdminUserUpdateOne {
+	if v != nil {
+		_u.SetRole(*v)
+	}
+	return _u
+}
+
+// SetAdminStatus sets the "admin_status" field.
+func (_u *AdminUserUpdateOne) SetAdminStatus(v adminuser.AdminStatus) *AdminUserUpdateOne {
+	_u.mutation.SetAdminStatus(v)
+	return _u
+}
+
+// SetNillableAdminStatus sets the "admin_status" field if the given value is not nil.
+func (_u *AdminUserUpdateOne) SetNillableAdminStatus(v *adminuser.AdminStatus) *AdminUserUpdateOne {
+	if v != nil {
+		_u.SetAdminStatus(*v)
+	}
+	return _u
+}
+
+// SetAPIKeyHash sets the "api_key_hash" field.
+func (_u *AdminUserUpdateOne) SetAPIKeyHash(v string) *AdminUserUpdateOne {
+	_u.mutation.SetAPIKeyHash(v)
+	return _u
+}
+
+// SetNillableAPIKeyHash sets the "api_key_hash" field if the given value is not nil.
+func (_u *AdminUserUpdateOne) SetNillableAPIKeyHash(v *string) *AdminUserUpdateOne {
+	if v != nil {
+		_u.SetAPIKeyHash(*v)
+	}
+	return _u
+}
+
+// SetAPIKeyPrefix sets the "api_key_prefix" field.
+func (_u *AdminUserUpdateOne) SetAPIKeyPrefix(v string) *AdminUserUpdateOne {
+	_u.mutation.SetAPIKeyPrefix(v)
+	return _u
+}
+
+// SetNillableAPIKeyPrefix sets the "api_key_prefix" field if the given value is not nil.
+func (_u *AdminUserUpdateOne) SetNillableAPIKeyPrefix(v *string) *AdminUserUpdateOne {
+	if v != nil {
+		_u.SetAPIKeyPrefix(*v)
+	}
+	return _u
+}
+
+// SetAPIKeyRotatedAt sets the "api_key_rotated_at" field.
+func (_u *AdminUserUpdateOne) SetAPIKeyRotatedAt(v time.Time) *AdminUserUpdateOne {
+	_u.mutation.SetAPIKeyRotatedAt(v)
+	return _u
+}
+
+// SetNillableAPIKeyRotatedAt sets the "api_key_rotated_at" field if the given value is not nil.
+func (_u *AdminUserUpdateOne) SetNillableAPIKeyRotatedAt(v *time.Time) *AdminUserUpdateOne {
+	if v != nil {
+		_u.SetAPIKeyRotatedAt(*v)
+	}
+	return _u
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (_u *AdminUserUpdateOne) SetLastUsedAt(v time.Time) *AdminUserUpdateOne {
+	_u.mutation.SetLastUsedAt(v)
+	return _u
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (_u *AdminUserUpdateOne) SetNillableLastUsedAt(v *time.Time) *AdminUserUpdateOne {
+	if v != nil {
+		_u.SetLastUsedAt(*v)
+	}
+	return _u
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (_u *AdminUserUpdateOne) ClearLastUsedAt() *AdminUserUpdateOne {
+	_u.mutation.ClearLastUsedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AdminUserUpdateOne) SetUpdatedAt(v time.Time) *AdminUserUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the AdminUserMutation object of the builder.
+func (_u *AdminUserUpdateOne) Mutation() *AdminUserMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the AdminUserUpdate builder.
+func (_u *AdminUserUpdateOne) Where(ps ...predicate.AdminUser) *AdminUserUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AdminUserUpdateOne) Select(field string, fields ...string) *AdminUserUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AdminUser entity.
+func (_u *AdminUserUpdateOne) Save(ctx context.Context) (*AdminUser, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AdminUserUpdateOne) SaveX(ctx context.Context) *AdminUser {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AdminUserUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AdminUserUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *AdminUserUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := adminuser.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AdminUserUpdateOne) check() error {
+	if v, ok := _u.mutation.Email(); ok {
+		if err := adminuser.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`generated: validator failed for field "AdminUser.email": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := adminuser.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`generated: validator failed for field "AdminUser.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Role(); ok {
+		if err := adminuser.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`generated: validator failed for field "AdminUser.role": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AdminStatus(); ok {
+		if err := adminuser.AdminStatusValidator(v); err != nil {
+			return &ValidationError{Name: "admin_status", err: fmt.Errorf(`generated: validator failed for field "AdminUser.admin_status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.APIKeyHash(); ok {
+		if err := adminuser.APIKeyHashValidator(v); err != nil {
+			return &ValidationError{Name: "api_key_hash", err: fmt.Errorf(`generated: validator failed for field "AdminUser.api_key_hash": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.APIKeyPrefix(); ok {
+		if err := adminuser.APIKeyPrefixValidator(v); err != nil {
+			return &ValidationError{Name: "api_key_prefix", err: fmt.Errorf(`generated: validator failed for field "AdminUser.api_key_prefix": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *AdminUserUpdateOne) sqlSave(ctx context.Context) (_node *AdminUser, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(adminuser.Table, adminuser.Columns, sqlgraph.NewFieldSpec(adminuser.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "AdminUser.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, adminuser.FieldID)
+		for _, f := range fields {
+			if !adminuser.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != adminuser.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Email(); ok {
+		_spec.SetField(adminuser.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(adminuser.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Role(); ok {
+		_spec.SetField(adminuser.FieldRole, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.AdminStatus(); ok {
+		_spec.SetField(adminuser.FieldAdminStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.APIKeyHash(); ok {
+		_spec.SetField(adminuser.FieldAPIKeyHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.APIKeyPrefix(); ok {
+		_spec.SetField(adminuser.FieldAPIKeyPrefix, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.APIKeyRotatedAt(); ok {
+		_spec.SetField(adminuser.FieldAPIKeyRotatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(adminuser.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := _u.mutation.LastUsedAt(); ok {
+		_spec.SetField(adminuser.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastUsedAtCleared() {
+		_spec.ClearField(adminuser.FieldLastUsedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(adminuser.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &AdminUser{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{adminuser.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
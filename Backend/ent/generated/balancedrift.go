@@ -0,0 +1,204 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/UnoraApp/be/ent/generated/balancedrift"
+	"github.com/UnoraApp/be/ent/generated/user"
+)
+
+// BalanceDrift is the model entity for the BalanceDrift schema.
+type BalanceDrift struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID string `json:"user_id,omitempty"`
+	// StoredBalance holds the value of the "stored_balance" field.
+	StoredBalance int `json:"stored_balance,omitempty"`
+	// LedgerBalance holds the value of the "ledger_balance" field.
+	LedgerBalance int `json:"ledger_balance,omitempty"`
+	// stored_balance - ledger_balance
+	Difference int `json:"difference,omitempty"`
+	// DetectedAt holds the value of the "detected_at" field.
+	DetectedAt time.Time `json:"detected_at,omitempty"`
+	// LastCheckedAt holds the value of the "last_checked_at" field.
+	LastCheckedAt time.Time `json:"last_checked_at,omitempty"`
+	// ResolvedAt holds the value of the "resolved_at" field.
+	ResolvedAt *time.Time `json:"resolved_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the BalanceDriftQuery when eager-loading is set.
+	Edges        BalanceDriftEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// BalanceDriftEdges holds the relations/edges for other nodes in the graph.
+type BalanceDriftEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BalanceDriftEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BalanceDrift) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case balancedrift.FieldStoredBalance, balancedrift.FieldLedgerBalance, balancedrift.FieldDifference:
+			values[i] = new(sql.NullInt64)
+		case balancedrift.FieldID, balancedrift.FieldUserID:
+			values[i] = new(sql.NullString)
+		case balancedrift.FieldDetectedAt, balancedrift.FieldLastCheckedAt, balancedrift.FieldResolvedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BalanceDrift fields.
+func (_m *BalanceDrift) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case balancedrift.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case balancedrift.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = value.String
+			}
+		case balancedrift.FieldStoredBalance:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field stored_balance", values[i])
+			} else if value.Valid {
+				_m.StoredBalance = int(value.Int64)
+			}
+		case balancedrift.FieldLedgerBalance:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field ledger_balance", values[i])
+			} else if value.Valid {
+				_m.LedgerBalance = int(value.Int64)
+			}
+		case balancedrift.FieldDifference:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field difference", values[i])
+			} else if value.Valid {
+				_m.Difference = int(value.Int64)
+			}
+		case balancedrift.FieldDetectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field detected_at", values[i])
+			} else if value.Valid {
+				_m.DetectedAt = value.Time
+			}
+		case balancedrift.FieldLastCheckedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_checked_at", values[i])
+			} else if value.Valid {
+				_m.LastCheckedAt = value.Time
+			}
+		case balancedrift.FieldResolvedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field resolved_at", values[i])
+			} else if value.Valid {
+				_m.ResolvedAt = new(time.Time)
+				*_m.ResolvedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BalanceDrift.
+// This includes values selected through modifiers, order, etc.
+func (_m *BalanceDrift) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the BalanceDrift entity.
+func (_m *BalanceDrift) QueryUser() *UserQuery {
+	return NewBalanceDriftClient(_m.config).QueryUser(_m)
+}
+
+// Update returns a builder for updating this BalanceDrift.
+// Note that you need to call BalanceDrift.Unwrap() before calling this method if this BalanceDrift
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BalanceDrift) Update() *BalanceDriftUpdateOne {
+	return NewBalanceDriftClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BalanceDrift entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BalanceDrift) Unwrap() *BalanceDrift {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("generated: BalanceDrift is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BalanceDrift) String() string {
+	var builder strings.Builder
+	builder.WriteString("BalanceDrift(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(_m.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("stored_balance=")
+	builder.WriteString(fmt.Sprintf("%v", _m.StoredBalance))
+	builder.WriteString(", ")
+	builder.WriteString("ledger_balance=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LedgerBalance))
+	builder.WriteString(", ")
+	builder.WriteString("difference=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Difference))
+	builder.WriteString(", ")
+	builder.WriteString("detected_at=")
+	builder.WriteString(_m.DetectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("last_checked_at=")
+	builder.WriteString(_m.LastCheckedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.ResolvedAt; v != nil {
+		builder.WriteString("resolved_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BalanceDrifts is a parsable slice of BalanceDrift.
+type BalanceDrifts []*BalanceDrift
@@ -0,0 +1,454 @@
+// Code generated by ent, DO NOT EDIT.
+
+package balancedrift
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/UnoraApp/be/ent/generated/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldContainsFold(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldEQ(FieldUserID, v))
+}
+
+// StoredBalance applies equality check predicate on the "stored_balance" field. It's identical to StoredBalanceEQ.
+func StoredBalance(v int) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldEQ(FieldStoredBalance, v))
+}
+
+// LedgerBalance applies equality check predicate on the "ledger_balance" field. It's identical to LedgerBalanceEQ.
+func LedgerBalance(v int) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldEQ(FieldLedgerBalance, v))
+}
+
+// Difference applies equality check predicate on the "difference" field. It's identical to DifferenceEQ.
+func Difference(v int) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldEQ(FieldDifference, v))
+}
+
+// DetectedAt applies equality check predicate on the "detected_at" field. It's identical to DetectedAtEQ.
+func DetectedAt(v time.Time) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldEQ(FieldDetectedAt, v))
+}
+
+// LastCheckedAt applies equality check predicate on the "last_checked_at" field. It's identical to LastCheckedAtEQ.
+func LastCheckedAt(v time.Time) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldEQ(FieldLastCheckedAt, v))
+}
+
+// ResolvedAt applies equality check predicate on the "resolved_at" field. It's identical to ResolvedAtEQ.
+func ResolvedAt(v time.Time) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldEQ(FieldResolvedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// StoredBalanceEQ applies the EQ predicate on the "stored_balance" field.
+func StoredBalanceEQ(v int) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldEQ(FieldStoredBalance, v))
+}
+
+// StoredBalanceNEQ applies the NEQ predicate on the "stored_balance" field.
+func StoredBalanceNEQ(v int) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldNEQ(FieldStoredBalance, v))
+}
+
+// StoredBalanceIn applies the In predicate on the "stored_balance" field.
+func StoredBalanceIn(vs ...int) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldIn(FieldStoredBalance, vs...))
+}
+
+// StoredBalanceNotIn applies the NotIn predicate on the "stored_balance" field.
+func StoredBalanceNotIn(vs ...int) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldNotIn(FieldStoredBalance, vs...))
+}
+
+// StoredBalanceGT applies the GT predicate on the "stored_balance" field.
+func StoredBalanceGT(v int) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldGT(FieldStoredBalance, v))
+}
+
+// StoredBalanceGTE applies the GTE predicate on the "stored_balance" field.
+func StoredBalanceGTE(v int) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldGTE(FieldStoredBalance, v))
+}
+
+// StoredBalanceLT applies the LT predicate on the "stored_balance" field.
+func StoredBalanceLT(v int) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldLT(FieldStoredBalance, v))
+}
+
+// StoredBalanceLTE applies the LTE predicate on the "stored_balance" field.
+func StoredBalanceLTE(v int) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldLTE(FieldStoredBalance, v))
+}
+
+// LedgerBalanceEQ applies the EQ predicate on the "ledger_balance" field.
+func LedgerBalanceEQ(v int) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldEQ(FieldLedgerBalance, v))
+}
+
+// LedgerBalanceNEQ applies the NEQ predicate on the "ledger_balance" field.
+func LedgerBalanceNEQ(v int) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldNEQ(FieldLedgerBalance, v))
+}
+
+// LedgerBalanceIn applies the In predicate on the "ledger_balance" field.
+func LedgerBalanceIn(vs ...int) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldIn(FieldLedgerBalance, vs...))
+}
+
+// LedgerBalanceNotIn applies the NotIn predicate on the "ledger_balance" field.
+func LedgerBalanceNotIn(vs ...int) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldNotIn(FieldLedgerBalance, vs...))
+}
+
+// LedgerBalanceGT applies the GT predicate on the "ledger_balance" field.
+func LedgerBalanceGT(v int) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldGT(FieldLedgerBalance, v))
+}
+
+// LedgerBalanceGTE applies the GTE predicate on the "ledger_balance" field.
+func LedgerBalanceGTE(v int) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldGTE(FieldLedgerBalance, v))
+}
+
+// LedgerBalanceLT applies the LT predicate on the "ledger_balance" field.
+func LedgerBalanceLT(v int) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldLT(FieldLedgerBalance, v))
+}
+
+// LedgerBalanceLTE applies the LTE predicate on the "ledger_balance" field.
+func LedgerBalanceLTE(v int) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldLTE(FieldLedgerBalance, v))
+}
+
+// DifferenceEQ applies the EQ predicate on the "difference" field.
+func DifferenceEQ(v int) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldEQ(FieldDifference, v))
+}
+
+// DifferenceNEQ applies the NEQ predicate on the "difference" field.
+func DifferenceNEQ(v int) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldNEQ(FieldDifference, v))
+}
+
+// DifferenceIn applies the In predicate on the "difference" field.
+func DifferenceIn(vs ...int) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldIn(FieldDifference, vs...))
+}
+
+// DifferenceNotIn applies the NotIn predicate on the "difference" field.
+func DifferenceNotIn(vs ...int) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldNotIn(FieldDifference, vs...))
+}
+
+// DifferenceGT applies the GT predicate on the "difference" field.
+func DifferenceGT(v int) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldGT(FieldDifference, v))
+}
+
+// DifferenceGTE applies the GTE predicate on the "difference" field.
+func DifferenceGTE(v int) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldGTE(FieldDifference, v))
+}
+
+// DifferenceLT applies the LT predicate on the "difference" field.
+func DifferenceLT(v int) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldLT(FieldDifference, v))
+}
+
+// DifferenceLTE applies the LTE predicate on the "difference" field.
+func DifferenceLTE(v int) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldLTE(FieldDifference, v))
+}
+
+// DetectedAtEQ applies the EQ predicate on the "detected_at" field.
+func DetectedAtEQ(v time.Time) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldEQ(FieldDetectedAt, v))
+}
+
+// DetectedAtNEQ applies the NEQ predicate on the "detected_at" field.
+func DetectedAtNEQ(v time.Time) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldNEQ(FieldDetectedAt, v))
+}
+
+// DetectedAtIn applies the In predicate on the "detected_at" field.
+func DetectedAtIn(vs ...time.Time) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldIn(FieldDetectedAt, vs...))
+}
+
+// DetectedAtNotIn applies the NotIn predicate on the "detected_at" field.
+func DetectedAtNotIn(vs ...time.Time) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldNotIn(FieldDetectedAt, vs...))
+}
+
+// DetectedAtGT applies the GT predicate on the "detected_at" field.
+func DetectedAtGT(v time.Time) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldGT(FieldDetectedAt, v))
+}
+
+// DetectedAtGTE applies the GTE predicate on the "detected_at" field.
+func DetectedAtGTE(v time.Time) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldGTE(FieldDetectedAt, v))
+}
+
+// DetectedAtLT applies the LT predicate on the "detected_at" field.
+func DetectedAtLT(v time.Time) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldLT(FieldDetectedAt, v))
+}
+
+// DetectedAtLTE applies the LTE predicate on the "detected_at" field.
+func DetectedAtLTE(v time.Time) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldLTE(FieldDetectedAt, v))
+}
+
+// LastCheckedAtEQ applies the EQ predicate on the "last_checked_at" field.
+func LastCheckedAtEQ(v time.Time) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldEQ(FieldLastCheckedAt, v))
+}
+
+// LastCheckedAtNEQ applies the NEQ predicate on the "last_checked_at" field.
+func LastCheckedAtNEQ(v time.Time) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldNEQ(FieldLastCheckedAt, v))
+}
+
+// LastCheckedAtIn applies the In predicate on the "last_checked_at" field.
+func LastCheckedAtIn(vs ...time.Time) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldIn(FieldLastCheckedAt, vs...))
+}
+
+// LastCheckedAtNotIn applies the NotIn predicate on the "last_checked_at" field.
+func LastCheckedAtNotIn(vs ...time.Time) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldNotIn(FieldLastCheckedAt, vs...))
+}
+
+// LastCheckedAtGT applies the GT predicate on the "last_checked_at" field.
+func LastCheckedAtGT(v time.Time) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldGT(FieldLastCheckedAt, v))
+}
+
+// LastCheckedAtGTE applies the GTE predicate on the "last_checked_at" field.
+func LastCheckedAtGTE(v time.Time) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldGTE(FieldLastCheckedAt, v))
+}
+
+// LastCheckedAtLT applies the LT predicate on the "last_checked_at" field.
+func LastCheckedAtLT(v time.Time) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldLT(FieldLastCheckedAt, v))
+}
+
+// LastCheckedAtLTE applies the LTE predicate on the "last_checked_at" field.
+func LastCheckedAtLTE(v time.Time) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldLTE(FieldLastCheckedAt, v))
+}
+
+// ResolvedAtEQ applies the EQ predicate on the "resolved_at" field.
+func ResolvedAtEQ(v time.Time) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldEQ(FieldResolvedAt, v))
+}
+
+// ResolvedAtNEQ applies the NEQ predicate on the "resolved_at" field.
+func ResolvedAtNEQ(v time.Time) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldNEQ(FieldResolvedAt, v))
+}
+
+// ResolvedAtIn applies the In predicate on the "resolved_at" field.
+func ResolvedAtIn(vs ...time.Time) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldIn(FieldResolvedAt, vs...))
+}
+
+// ResolvedAtNotIn applies the NotIn predicate on the "resolved_at" field.
+func ResolvedAtNotIn(vs ...time.Time) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldNotIn(FieldResolvedAt, vs...))
+}
+
+// ResolvedAtGT applies the GT predicate on the "resolved_at" field.
+func ResolvedAtGT(v time.Time) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldGT(FieldResolvedAt, v))
+}
+
+// ResolvedAtGTE applies the GTE predicate on the "resolved_at" field.
+func ResolvedAtGTE(v time.Time) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldGTE(FieldResolvedAt, v))
+}
+
+// ResolvedAtLT applies the LT predicate on the "resolved_at" field.
+func ResolvedAtLT(v time.Time) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldLT(FieldResolvedAt, v))
+}
+
+// ResolvedAtLTE applies the LTE predicate on the "resolved_at" field.
+func ResolvedAtLTE(v time.Time) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldLTE(FieldResolvedAt, v))
+}
+
+// ResolvedAtIsNil applies the IsNil predicate on the "resolved_at" field.
+func ResolvedAtIsNil() predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldIsNull(FieldResolvedAt))
+}
+
+// ResolvedAtNotNil applies the NotNil predicate on the "resolved_at" field.
+func ResolvedAtNotNil() predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.FieldNotNull(FieldResolvedAt))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.BalanceDrift {
+	return predicate.BalanceDrift(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.BalanceDrift {
+	return predicate.BalanceDrift(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BalanceDrift) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BalanceDrift) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BalanceDrift) predicate.BalanceDrift {
+	return predicate.BalanceDrift(sql.NotPredicates(p))
+}
@@ -0,0 +1,887 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/UnoraApp/be/ent/generated/balancedrift"
+	"github.com/UnoraApp/be/ent/generated/user"
+)
+
+// BalanceDriftCreate is the builder for creating a BalanceDrift entity.
+type BalanceDriftCreate struct {
+	config
+	mutation *BalanceDriftMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *BalanceDriftCreate) SetUserID(v string) *BalanceDriftCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetStoredBalance sets the "stored_balance" field.
+func (_c *BalanceDriftCreate) SetStoredBalance(v int) *BalanceDriftCreate {
+	_c.mutation.SetStoredBalance(v)
+	return _c
+}
+
+// SetLedgerBalance sets the "ledger_balance" field.
+func (_c *BalanceDriftCreate) SetLedgerBalance(v int) *BalanceDriftCreate {
+	_c.mutation.SetLedgerBalance(v)
+	return _c
+}
+
+// SetDifference sets the "difference" field.
+func (_c *BalanceDriftCreate) SetDifference(v int) *BalanceDriftCreate {
+	_c.mutation.SetDifference(v)
+	return _c
+}
+
+// SetDetectedAt sets the "detected_at" field.
+func (_c *BalanceDriftCreate) SetDetectedAt(v time.Time) *BalanceDriftCreate {
+	_c.mutation.SetDetectedAt(v)
+	return _c
+}
+
+// SetNillableDetectedAt sets the "detected_at" field if the given value is not nil.
+func (_c *BalanceDriftCreate) SetNillableDetectedAt(v *time.Time) *BalanceDriftCreate {
+	if v != nil {
+		_c.SetDetectedAt(*v)
+	}
+	return _c
+}
+
+// SetLastCheckedAt sets the "last_checked_at" field.
+func (_c *BalanceDriftCreate) SetLastCheckedAt(v time.Time) *BalanceDriftCreate {
+	_c.mutation.SetLastCheckedAt(v)
+	return _c
+}
+
+// SetNillableLastCheckedAt sets the "last_checked_at" field if the given value is not nil.
+func (_c *BalanceDriftCreate) SetNillableLastCheckedAt(v *time.Time) *BalanceDriftCreate {
+	if v != nil {
+		_c.SetLastCheckedAt(*v)
+	}
+	return _c
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (_c *BalanceDriftCreate) SetResolvedAt(v time.Time) *BalanceDriftCreate {
+	_c.mutation.SetResolvedAt(v)
+	return _c
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (_c *BalanceDriftCreate) SetNillableResolvedAt(v *time.Time) *BalanceDriftCreate {
+	if v != nil {
+		_c.SetResolvedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BalanceDriftCreate) SetID(v string) *BalanceDriftCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *BalanceDriftCreate) SetUser(v *User) *BalanceDriftCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the BalanceDriftMutation object of the builder.
+func (_c *BalanceDriftCreate) Mutation() *BalanceDriftMutation {
+	return _c.mutation
+}
+
+// Save creates the BalanceDrift in the database.
+func (_c *BalanceDriftCreate) Save(ctx context.Context) (*BalanceDrift, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BalanceDriftCreate) SaveX(ctx context.Context) *BalanceDrift {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BalanceDriftCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BalanceDriftCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *BalanceDriftCreate) defaults() {
+	if _, ok := _c.mutation.DetectedAt(); !ok {
+		v := balancedrift.DefaultDetectedAt()
+		_c.mutation.SetDetectedAt(v)
+	}
+	if _, ok := _c.mutation.LastCheckedAt(); !ok {
+		v := balancedrift.DefaultLastCheckedAt()
+		_c.mutation.SetLastCheckedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BalanceDriftCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`generated: missing required field "BalanceDrift.user_id"`)}
+	}
+	if v, ok := _c.mutation.UserID(); ok {
+		if err := balancedrift.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`generated: validator failed for field "BalanceDrift.user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.StoredBalance(); !ok {
+		return &ValidationError{Name: "stored_balance", err: errors.New(`generated: missing required field "BalanceDrift.stored_balance"`)}
+	}
+	if _, ok := _c.mutation.LedgerBalance(); !ok {
+		return &ValidationError{Name: "ledger_balance", err: errors.New(`generated: missing required field "BalanceDrift.ledger_balance"`)}
+	}
+	if _, ok := _c.mutation.Difference(); !ok {
+		return &ValidationError{Name: "difference", err: errors.New(`generated: missing required field "BalanceDrift.difference"`)}
+	}
+	if _, ok := _c.mutation.DetectedAt(); !ok {
+		return &ValidationError{Name: "detected_at", err: errors.New(`generated: missing required field "BalanceDrift.detected_at"`)}
+	}
+	if _, ok := _c.mutation.LastCheckedAt(); !ok {
+		return &ValidationError{Name: "last_checked_at", err: errors.New(`generated: missing required field "BalanceDrift.last_checked_at"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := balancedrift.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`generated: validator failed for field "BalanceDrift.id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`generated: missing required edge "BalanceDrift.user"`)}
+	}
+	return nil
+}
+
+func (_c *BalanceDriftCreate) sqlSave(ctx context.Context) (*BalanceDrift, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected BalanceDrift.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BalanceDriftCreate) createSpec() (*BalanceDrift, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BalanceDrift{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(balancedrift.Table, sqlgraph.NewFieldSpec(balancedrift.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.StoredBalance(); ok {
+		_spec.SetField(balancedrift.FieldStoredBalance, field.TypeInt, value)
+		_node.StoredBalance = value
+	}
+	if value, ok := _c.mutation.LedgerBalance(); ok {
+		_spec.SetField(balancedrift.FieldLedgerBalance, field.TypeInt, value)
+		_node.LedgerBalance = value
+	}
+	if value, ok := _c.mutation.Difference(); ok {
+		_spec.SetField(balancedrift.FieldDifference, field.TypeInt, value)
+		_node.Difference = value
+	}
+	if value, ok := _c.mutation.DetectedAt(); ok {
+		_spec.SetField(balancedrift.FieldDetectedAt, field.TypeTime, value)
+		_node.DetectedAt = value
+	}
+	if value, ok := _c.mutation.LastCheckedAt(); ok {
+		_spec.SetField(balancedrift.FieldLastCheckedAt, field.TypeTime, value)
+		_node.LastCheckedAt = value
+	}
+	if value, ok := _c.mutation.ResolvedAt(); ok {
+		_spec.SetField(balancedrift.FieldResolvedAt, field.TypeTime, value)
+		_node.ResolvedAt = &value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   balancedrift.UserTable,
+			Columns: []string{balancedrift.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.BalanceDrift.Create().
+//		SetUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BalanceDriftUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *BalanceDriftCreate) OnConflict(opts ...sql.ConflictOption) *BalanceDriftUpsertOne {
+	_c.conflict = opts
+	return &BalanceDriftUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.BalanceDrift.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *BalanceDriftCreate) OnConflictColumns(columns ...string) *BalanceDriftUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &BalanceDriftUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// BalanceDriftUpsertOne is the builder for "upsert"-ing
+	//  one BalanceDrift node.
+	BalanceDriftUpsertOne struct {
+		create *BalanceDriftCreate
+	}
+
+	// BalanceDriftUpsert is the "OnConflict" setter.
+	BalanceDriftUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetStoredBalance sets the "stored_balance" field.
+func (u *BalanceDriftUpsert) SetStoredBalance(v int) *BalanceDriftUpsert {
+	u.Set(balancedrift.FieldStoredBalance, v)
+	return u
+}
+
+// UpdateStoredBalance sets the "stored_balance" field to the value that was provided on create.
+func (u *BalanceDriftUpsert) UpdateStoredBalance() *BalanceDriftUpsert {
+	u.SetExcluded(balancedrift.FieldStoredBalance)
+	return u
+}
+
+// AddStoredBalance adds v to the "stored_balance" field.
+func (u *BalanceDriftUpsert) AddStoredBalance(v int) *BalanceDriftUpsert {
+	u.Add(balancedrift.FieldStoredBalance, v)
+	return u
+}
+
+// SetLedgerBalance sets the "ledger_balance" field.
+func (u *BalanceDriftUpsert) SetLedgerBalance(v int) *BalanceDriftUpsert {
+	u.Set(balancedrift.FieldLedgerBalance, v)
+	return u
+}
+
+// UpdateLedgerBalance sets the "ledger_balance" field to the value that was provided on create.
+func (u *BalanceDriftUpsert) UpdateLedgerBalance() *BalanceDriftUpsert {
+	u.SetExcluded(balancedrift.FieldLedgerBalance)
+	return u
+}
+
+// AddLedgerBalance adds v to the "ledger_balance" field.
+func (u *BalanceDriftUpsert) AddLedgerBalance(v int) *BalanceDriftUpsert {
+	u.Add(balancedrift.FieldLedgerBalance, v)
+	return u
+}
+
+// SetDifference sets the "difference" field.
+func (u *BalanceDriftUpsert) SetDifference(v int) *BalanceDriftUpsert {
+	u.Set(balancedrift.FieldDifference, v)
+	return u
+}
+
+// UpdateDifference sets the "difference" field to the value that was provided on create.
+func (u *BalanceDriftUpsert) UpdateDifference() *BalanceDriftUpsert {
+	u.SetExcluded(balancedrift.FieldDifference)
+	return u
+}
+
+// AddDifference adds v to the "difference" field.
+func (u *BalanceDriftUpsert) AddDifference(v int) *BalanceDriftUpsert {
+	u.Add(balancedrift.FieldDifference, v)
+	return u
+}
+
+// SetLastCheckedAt sets the "last_checked_at" field.
+func (u *BalanceDriftUpsert) SetLastCheckedAt(v time.Time) *BalanceDriftUpsert {
+	u.Set(balancedrift.FieldLastCheckedAt, v)
+	return u
+}
+
+// UpdateLastCheckedAt sets the "last_checked_at" field to the value that was provided on create.
+func (u *BalanceDriftUpsert) UpdateLastCheckedAt() *BalanceDriftUpsert {
+	u.SetExcluded(balancedrift.FieldLastCheckedAt)
+	return u
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (u *BalanceDriftUpsert) SetResolvedAt(v time.Time) *BalanceDriftUpsert {
+	u.Set(balancedrift.FieldResolvedAt, v)
+	return u
+}
+
+// UpdateResolvedAt sets the "resolved_at" field to the value that was provided on create.
+func (u *BalanceDriftUpsert) UpdateResolvedAt() *BalanceDriftUpsert {
+	u.SetExcluded(balancedrift.FieldResolvedAt)
+	return u
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (u *BalanceDriftUpsert) ClearResolvedAt() *BalanceDriftUpsert {
+	u.SetNull(balancedrift.FieldResolvedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.BalanceDrift.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(balancedrift.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *BalanceDriftUpsertOne) UpdateNewValues() *BalanceDriftUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(balancedrift.FieldID)
+		}
+		if _, exists := u.create.mutation.UserID(); exists {
+			s.SetIgnore(balancedrift.FieldUserID)
+		}
+		if _, exists := u.create.mutation.DetectedAt(); exists {
+			s.SetIgnore(balancedrift.FieldDetectedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.BalanceDrift.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *BalanceDriftUpsertOne) Ignore() *BalanceDriftUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BalanceDriftUpsertOne) DoNothing() *BalanceDriftUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BalanceDriftCreate.OnConflict
+// documentation for more info.
+func (u *BalanceDriftUpsertOne) Update(set func(*BalanceDriftUpsert)) *BalanceDriftUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BalanceDriftUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetStoredBalance sets the "stored_balance" field.
+func (u *BalanceDriftUpsertOne) SetStoredBalance(v int) *BalanceDriftUpsertOne {
+	return u.Update(func(s *BalanceDriftUpsert) {
+		s.SetStoredBalance(v)
+	})
+}
+
+// AddStoredBalance adds v to the "stored_balance" field.
+func (u *BalanceDriftUpsertOne) AddStoredBalance(v int) *BalanceDriftUpsertOne {
+	return u.Update(func(s *BalanceDriftUpsert) {
+		s.AddStoredBalance(v)
+	})
+}
+
+// UpdateStoredBalance sets the "stored_balance" field to the value that was provided on create.
+func (u *BalanceDriftUpsertOne) UpdateStoredBalance() *BalanceDriftUpsertOne {
+	return u.Update(func(s *BalanceDriftUpsert) {
+		s.UpdateStoredBalance()
+	})
+}
+
+// SetLedgerBalance sets the "ledger_balance" field.
+func (u *BalanceDriftUpsertOne) SetLedgerBalance(v int) *BalanceDriftUpsertOne {
+	return u.Update(func(s *BalanceDriftUpsert) {
+		s.SetLedgerBalance(v)
+	})
+}
+
+// AddLedgerBalance adds v to the "ledger_balance" field.
+func (u *BalanceDriftUpsertOne) AddLedgerBalance(v int) *BalanceDriftUpsertOne {
+	return u.Update(func(s *BalanceDriftUpsert) {
+		s.AddLedgerBalance(v)
+	})
+}
+
+// UpdateLedgerBalance sets the "ledger_balance" field to the value that was provided on create.
+func (u *BalanceDriftUpsertOne) UpdateLedgerBalance() *BalanceDriftUpsertOne {
+	return u.Update(func(s *BalanceDriftUpsert) {
+		s.UpdateLedgerBalance()
+	})
+}
+
+// SetDifference sets the "difference" field.
+func (u *BalanceDriftUpsertOne) SetDifference(v int) *BalanceDriftUpsertOne {
+	return u.Update(func(s *BalanceDriftUpsert) {
+		s.SetDifference(v)
+	})
+}
+
+// AddDifference adds v to the "difference" field.
+func (u *BalanceDriftUpsertOne) AddDifference(v int) *BalanceDriftUpsertOne {
+	return u.Update(func(s *BalanceDriftUpsert) {
+		s.AddDifference(v)
+	})
+}
+
+// UpdateDifference sets the "difference" field to the value that was provided on create.
+func (u *BalanceDriftUpsertOne) UpdateDifference() *BalanceDriftUpsertOne {
+	return u.Update(func(s *BalanceDriftUpsert) {
+		s.UpdateDifference()
+	})
+}
+
+// SetLastCheckedAt sets the "last_checked_at" field.
+func (u *BalanceDriftUpsertOne) SetLastCheckedAt(v time.Time) *BalanceDriftUpsertOne {
+	return u.Update(func(s *BalanceDriftUpsert) {
+		s.SetLastCheckedAt(v)
+	})
+}
+
+// UpdateLastCheckedAt sets the "last_checked_at" field to the value that was provided on create.
+func (u *BalanceDriftUpsertOne) UpdateLastCheckedAt() *BalanceDriftUpsertOne {
+	return u.Update(func(s *BalanceDriftUpsert) {
+		s.UpdateLastCheckedAt()
+	})
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (u *BalanceDriftUpsertOne) SetResolvedAt(v time.Time) *BalanceDriftUpsertOne {
+	return u.Update(func(s *BalanceDriftUpsert) {
+		s.SetResolvedAt(v)
+	})
+}
+
+// UpdateResolvedAt sets the "resolved_at" field to the value that was provided on create.
+func (u *BalanceDriftUpsertOne) UpdateResolvedAt() *BalanceDriftUpsertOne {
+	return u.Update(func(s *BalanceDriftUpsert) {
+		s.UpdateResolvedAt()
+	})
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (u *BalanceDriftUpsertOne) ClearResolvedAt() *BalanceDriftUpsertOne {
+	return u.Update(func(s *BalanceDriftUpsert) {
+		s.ClearResolvedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *BalanceDriftUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("generated: missing options for BalanceDriftCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BalanceDriftUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *BalanceDriftUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("generated: BalanceDriftUpsertOne.ID is not supported by MySQL driver. Use BalanceDriftUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *BalanceDriftUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// BalanceDriftCreateBulk is the builder for creating many BalanceDrift entities in bulk.
+type BalanceDriftCreateBulk struct {
+	config
+	err      error
+	builders []*BalanceDriftCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the BalanceDrift entities in the database.
+func (_c *BalanceDriftCreateBulk) Save(ctx context.Context) ([]*BalanceDrift, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BalanceDrift, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BalanceDriftMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BalanceDriftCreateBulk) SaveX(ctx context.Context) []*BalanceDrift {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BalanceDriftCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BalanceDriftCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.BalanceDrift.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BalanceDriftUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *BalanceDriftCreateBulk) OnConflict(opts ...sql.ConflictOption) *BalanceDriftUpsertBulk {
+	_c.conflict = opts
+	return &BalanceDriftUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.BalanceDrift.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *BalanceDriftCreateBulk) OnConflictColumns(columns ...string) *BalanceDriftUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &BalanceDriftUpsertBulk{
+		create: _c,
+	}
+}
+
+// BalanceDriftUpsertBulk is the builder for "upsert"-ing
+// a bulk of BalanceDrift nodes.
+type BalanceDriftUpsertBulk struct {
+	create *BalanceDriftCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.BalanceDrift.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(balancedrift.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *BalanceDriftUpsertBulk) UpdateNewValues() *BalanceDriftUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(balancedrift.FieldID)
+			}
+			if _, exists := b.mutation.UserID(); exists {
+				s.SetIgnore(balancedrift.FieldUserID)
+			}
+			if _, exists := b.mutation.DetectedAt(); exists {
+				s.SetIgnore(balancedrift.FieldDetectedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.BalanceDrift.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *BalanceDriftUpsertBulk) Ignore() *BalanceDriftUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BalanceDriftUpsertBulk) DoNothing() *BalanceDriftUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BalanceDriftCreateBulk.OnConflict
+// documentation for more info.
+func (u *BalanceDriftUpsertBulk) Update(set func(*BalanceDriftUpsert)) *BalanceDriftUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BalanceDriftUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetStoredBalance sets the "stored_balance" field.
+func (u *BalanceDriftUpsertBulk) SetStoredBalance(v int) *BalanceDriftUpsertBulk {
+	return u.Update(func(s *BalanceDriftUpsert) {
+		s.SetStoredBalance(v)
+	})
+}
+
+// AddStoredBalance adds v to the "stored_balance" field.
+func (u *BalanceDriftUpsertBulk) AddStoredBalance(v int) *BalanceDriftUpsertBulk {
+	return u.Update(func(s *BalanceDriftUpsert) {
+		s.AddStoredBalance(v)
+	})
+}
+
+// UpdateStoredBalance sets the "stored_balance" field to the value that was provided on create.
+func (u *BalanceDriftUpsertBulk) UpdateStoredBalance() *BalanceDriftUpsertBulk {
+	return u.Update(func(s *BalanceDriftUpsert) {
+		s.UpdateStoredBalance()
+	})
+}
+
+// SetLedgerBalance sets the "ledger_balance" field.
+func (u *BalanceDriftUpsertBulk) SetLedgerBalance(v int) *BalanceDriftUpsertBulk {
+	return u.Update(func(s *BalanceDriftUpsert) {
+		s.SetLedgerBalance(v)
+	})
+}
+
+// AddLedgerBalance adds v to the "ledger_balance" field.
+func (u *BalanceDriftUpsertBulk) AddLedgerBalance(v int) *BalanceDriftUpsertBulk {
+	return u.Update(func(s *BalanceDriftUpsert) {
+		s.AddLedgerBalance(v)
+	})
+}
+
+// UpdateLedgerBalance sets the "ledger_balance" field to the value that was provided on create.
+func (u *BalanceDriftUpsertBulk) UpdateLedgerBalance() *BalanceDriftUpsertBulk {
+	return u.Update(func(s *BalanceDriftUpsert) {
+		s.UpdateLedgerBalance()
+	})
+}
+
+// SetDifference sets the "difference" field.
+func (u *BalanceDriftUpsertBulk) SetDifference(v int) *BalanceDriftUpsertBulk {
+	return u.Update(func(s *BalanceDriftUpsert) {
+		s.SetDifference(v)
+	})
+}
+
+// AddDifference adds v to the "difference" field.
+func (u *BalanceDriftUpsertBulk) AddDifference(v int) *BalanceDriftUpsertBulk {
+	return u.Update(func(s *BalanceDriftUpsert) {
+		s.AddDifference(v)
+	})
+}
+
+// UpdateDifference sets the "difference" field to the value that was provided on create.
+func (u *BalanceDriftUpsertBulk) UpdateDifference() *BalanceDriftUpsertBulk {
+	return u.Update(func(s *BalanceDriftUpsert) {
+		s.UpdateDifference()
+	})
+}
+
+// SetLastCheckedAt sets the "last_checked_at" field.
+func (u *BalanceDriftUpsertBulk) SetLastCheckedAt(v time.Time) *BalanceDriftUpsertBulk {
+	return u.Update(func(s *BalanceDriftUpsert) {
+		s.SetLastCheckedAt(v)
+	})
+}
+
+// UpdateLastCheckedAt sets the "last_checked_at" field to the value that was provided on create.
+func (u *BalanceDriftUpsertBulk) UpdateLastCheckedAt() *BalanceDriftUpsertBulk {
+	return u.Update(func(s *BalanceDriftUpsert) {
+		s.UpdateLastCheckedAt()
+	})
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (u *BalanceDriftUpsertBulk) SetResolvedAt(v time.Time) *BalanceDriftUpsertBulk {
+	return u.Update(func(s *BalanceDriftUpsert) {
+		s.SetResolvedAt(v)
+	})
+}
+
+// UpdateResolvedAt sets the "resolved_at" field to the value that was provided on create.
+func (u *BalanceDriftUpsertBulk) UpdateResolvedAt() *BalanceDriftUpsertBulk {
+	return u.Update(func(s *BalanceDriftUpsert) {
+		s.UpdateResolvedAt()
+	})
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (u *BalanceDriftUpsertBulk) ClearResolvedAt() *BalanceDriftUpsertBulk {
+	return u.Update(func(s *BalanceDriftUpsert) {
+		s.ClearResolvedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *BalanceDriftUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("generated: OnConflict was set for builder %d. Set it on the BalanceDriftCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("generated: missing options for BalanceDriftCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BalanceDriftUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
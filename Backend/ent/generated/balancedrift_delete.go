@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/UnoraApp/be/ent/generated/balancedrift"
+	"github.com/UnoraApp/be/ent/generated/predicate"
+)
+
+// BalanceDriftDelete is the builder for deleting a BalanceDrift entity.
+type BalanceDriftDelete struct {
+	config
+	hooks    []Hook
+	mutation *BalanceDriftMutation
+}
+
+// Where appends a list predicates to the BalanceDriftDelete builder.
+func (_d *BalanceDriftDelete) Where(ps ...predicate.BalanceDrift) *BalanceDriftDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *BalanceDriftDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BalanceDriftDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *BalanceDriftDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(balancedrift.Table, sqlgraph.NewFieldSpec(balancedrift.FieldID, field.TypeString))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// BalanceDriftDeleteOne is the builder for deleting a single BalanceDrift entity.
+type BalanceDriftDeleteOne struct {
+	_d *BalanceDriftDelete
+}
+
+// Where appends a list predicates to the BalanceDriftDelete builder.
+func (_d *BalanceDriftDeleteOne) Where(ps ...predicate.BalanceDrift) *BalanceDriftDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *BalanceDriftDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{balancedrift.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BalanceDriftDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
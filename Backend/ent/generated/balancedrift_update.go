@@ -0,0 +1,446 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/UnoraApp/be/ent/generated/balancedrift"
+	"github.com/UnoraApp/be/ent/generated/predicate"
+)
+
+// BalanceDriftUpdate is the builder for updating BalanceDrift entities.
+type BalanceDriftUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BalanceDriftMutation
+}
+
+// Where appends a list predicates to the BalanceDriftUpdate builder.
+func (_u *BalanceDriftUpdate) Where(ps ...predicate.BalanceDrift) *BalanceDriftUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetStoredBalance sets the "stored_balance" field.
+func (_u *BalanceDriftUpdate) SetStoredBalance(v int) *BalanceDriftUpdate {
+	_u.mutation.ResetStoredBalance()
+	_u.mutation.SetStoredBalance(v)
+	return _u
+}
+
+// SetNillableStoredBalance sets the "stored_balance" field if the given value is not nil.
+func (_u *BalanceDriftUpdate) SetNillableStoredBalance(v *int) *BalanceDriftUpdate {
+	if v != nil {
+		_u.SetStoredBalance(*v)
+	}
+	return _u
+}
+
+// AddStoredBalance adds value to the "stored_balance" field.
+func (_u *BalanceDriftUpdate) AddStoredBalance(v int) *BalanceDriftUpdate {
+	_u.mutation.AddStoredBalance(v)
+	return _u
+}
+
+// SetLedgerBalance sets the "ledger_balance" field.
+func (_u *BalanceDriftUpdate) SetLedgerBalance(v int) *BalanceDriftUpdate {
+	_u.mutation.ResetLedgerBalance()
+	_u.mutation.SetLedgerBalance(v)
+	return _u
+}
+
+// SetNillableLedgerBalance sets the "ledger_balance" field if the given value is not nil.
+func (_u *BalanceDriftUpdate) SetNillableLedgerBalance(v *int) *BalanceDriftUpdate {
+	if v != nil {
+		_u.SetLedgerBalance(*v)
+	}
+	return _u
+}
+
+// AddLedgerBalance adds value to the "ledger_balance" field.
+func (_u *BalanceDriftUpdate) AddLedgerBalance(v int) *BalanceDriftUpdate {
+	_u.mutation.AddLedgerBalance(v)
+	return _u
+}
+
+// SetDifference sets the "difference" field.
+func (_u *BalanceDriftUpdate) SetDifference(v int) *BalanceDriftUpdate {
+	_u.mutation.ResetDifference()
+	_u.mutation.SetDifference(v)
+	return _u
+}
+
+// SetNillableDifference sets the "difference" field if the given value is not nil.
+func (_u *BalanceDriftUpdate) SetNillableDifference(v *int) *BalanceDriftUpdate {
+	if v != nil {
+		_u.SetDifference(*v)
+	}
+	return _u
+}
+
+// AddDifference adds value to the "difference" field.
+func (_u *BalanceDriftUpdate) AddDifference(v int) *BalanceDriftUpdate {
+	_u.mutation.AddDifference(v)
+	return _u
+}
+
+// SetLastCheckedAt sets the "last_checked_at" field.
+func (_u *BalanceDriftUpdate) SetLastCheckedAt(v time.Time) *BalanceDriftUpdate {
+	_u.mutation.SetLastCheckedAt(v)
+	return _u
+}
+
+// SetNillableLastCheckedAt sets the "last_checked_at" field if the given value is not nil.
+func (_u *BalanceDriftUpdate) SetNillableLastCheckedAt(v *time.Time) *BalanceDriftUpdate {
+	if v != nil {
+		_u.SetLastCheckedAt(*v)
+	}
+	return _u
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (_u *BalanceDriftUpdate) SetResolvedAt(v time.Time) *BalanceDriftUpdate {
+	_u.mutation.SetResolvedAt(v)
+	return _u
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (_u *BalanceDriftUpdate) SetNillableResolvedAt(v *time.Time) *BalanceDriftUpdate {
+	if v != nil {
+		_u.SetResolvedAt(*v)
+	}
+	return _u
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (_u *BalanceDriftUpdate) ClearResolvedAt() *BalanceDriftUpdate {
+	_u.mutation.ClearResolvedAt()
+	return _u
+}
+
+// Mutation returns the BalanceDriftMutation object of the builder.
+func (_u *BalanceDriftUpdate) Mutation() *BalanceDriftMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BalanceDriftUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BalanceDriftUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BalanceDriftUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BalanceDriftUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BalanceDriftUpdate) check() error {
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "BalanceDrift.user"`)
+	}
+	return nil
+}
+
+func (_u *BalanceDriftUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(balancedrift.Table, balancedrift.Columns, sqlgraph.NewFieldSpec(balancedrift.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.StoredBalance(); ok {
+		_spec.SetField(balancedrift.FieldStoredBalance, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedStoredBalance(); ok {
+		_spec.AddField(balancedrift.FieldStoredBalance, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LedgerBalance(); ok {
+		_spec.SetField(balancedrift.FieldLedgerBalance, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLedgerBalance(); ok {
+		_spec.AddField(balancedrift.FieldLedgerBalance, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Difference(); ok {
+		_spec.SetField(balancedrift.FieldDifference, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDifference(); ok {
+		_spec.AddField(balancedrift.FieldDifference, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastCheckedAt(); ok {
+		_spec.SetField(balancedrift.FieldLastCheckedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ResolvedAt(); ok {
+		_spec.SetField(balancedrift.FieldResolvedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ResolvedAtCleared() {
+		_spec.ClearField(balancedrift.FieldResolvedAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{balancedrift.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BalanceDriftUpdateOne is the builder for updating a single BalanceDrift entity.
+type BalanceDriftUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BalanceDriftMutation
+}
+
+// SetStoredBalance sets the "stored_balance" field.
+func (_u *BalanceDriftUpdateOne) SetStoredBalance(v int) *BalanceDriftUpdateOne {
+	_u.mutation.ResetStoredBalance()
+	_u.mutation.SetStoredBalance(v)
+	return _u
+}
+
+// SetNillableStoredBalance sets the "stored_balance" field if the given value is not nil.
+func (_u *BalanceDriftUpdateOne) SetNillableStoredBalance(v *int) *BalanceDriftUpdateOne {
+	if v != nil {
+		_u.SetStoredBalance(*v)
+	}
+	return _u
+}
+
+// AddStoredBalance adds value to the "stored_balance" field.
+func (_u *BalanceDriftUpdateOne) AddStoredBalance(v int) *BalanceDriftUpdateOne {
+	_u.mutation.AddStoredBalance(v)
+	return _u
+}
+
+// SetLedgerBalance sets the "ledger_balance" field.
+func (_u *BalanceDriftUpdateOne) SetLedgerBalance(v int) *BalanceDriftUpdateOne {
+	_u.mutation.ResetLedgerBalance()
+	_u.mutation.SetLedgerBalance(v)
+	return _u
+}
+
+// SetNillableLedgerBalance sets the "ledger_balance" field if the given value is not nil.
+func (_u *BalanceDriftUpdateOne) SetNillableLedgerBalance(v *int) *BalanceDriftUpdateOne {
+	if v != nil {
+		_u.SetLedgerBalance(*v)
+	}
+	return _u
+}
+
+// AddLedgerBalance adds value to the "ledger_balance" field.
+func (_u *BalanceDriftUpdateOne) AddLedgerBalance(v int) *BalanceDriftUpdateOne {
+	_u.mutation.AddLedgerBalance(v)
+	return _u
+}
+
+// SetDifference sets the "difference" field.
+func (_u *BalanceDriftUpdateOne) SetDifference(v int) *BalanceDriftUpdateOne {
+	_u.mutation.ResetDifference()
+	_u.mutation.SetDifference(v)
+	return _u
+}
+
+// SetNillableDifference sets the "difference" field if the given value is not nil.
+func (_u *BalanceDriftUpdateOne) SetNillableDifference(v *int) *BalanceDriftUpdateOne {
+	if v != nil {
+		_u.SetDifference(*v)
+	}
+	return _u
+}
+
+// AddDifference adds value to the "difference" field.
+func (_u *BalanceDriftUpdateOne) AddDifference(v int) *BalanceDriftUpdateOne {
+	_u.mutation.AddDifference(v)
+	return _u
+}
+
+// SetLastCheckedAt sets the "last_checked_at" field.
+func (_u *BalanceDriftUpdateOne) SetLastCheckedAt(v time.Time) *BalanceDriftUpdateOne {
+	_u.mutation.SetLastCheckedAt(v)
+	return _u
+}
+
+// SetNillableLastCheckedAt sets the "last_checked_at" field if the given value is not nil.
+func (_u *BalanceDriftUpdateOne) SetNillableLastCheckedAt(v *time.Time) *BalanceDriftUpdateOne {
+	if v != nil {
+		_u.SetLastCheckedAt(*v)
+	}
+	return _u
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (_u *BalanceDriftUpdateOne) SetResolvedAt(v time.Time) *BalanceDriftUpdateOne {
+	_u.mutation.SetResolvedAt(v)
+	return _u
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (_u *BalanceDriftUpdateOne) SetNillableResolvedAt(v *time.Time) *BalanceDriftUpdateOne {
+	if v != nil {
+		_u.SetResolvedAt(*v)
+	}
+	return _u
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (_u *BalanceDriftUpdateOne) ClearResolvedAt() *BalanceDriftUpdateOne {
+	_u.mutation.ClearResolvedAt()
+	return _u
+}
+
+// Mutation returns the BalanceDriftMutation object of the builder.
+func (_u *BalanceDriftUpdateOne) Mutation() *BalanceDriftMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BalanceDriftUpdate builder.
+func (_u *BalanceDriftUpdateOne) Where(ps ...predicate.BalanceDrift) *BalanceDriftUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BalanceDriftUpdateOne) Select(field string, fields ...string) *BalanceDriftUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BalanceDrift entity.
+func (_u *BalanceDriftUpdateOne) Save(ctx context.Context) (*BalanceDrift, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BalanceDriftUpdateOne) SaveX(ctx context.Context) *BalanceDrift {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BalanceDriftUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BalanceDriftUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BalanceDriftUpdateOne) check() error {
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "BalanceDrift.user"`)
+	}
+	return nil
+}
+
+func (_u *BalanceDriftUpdateOne) sqlSave(ctx context.Context) (_node *BalanceDrift, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(balancedrift.Table, balancedrift.Columns, sqlgraph.NewFieldSpec(balancedrift.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "BalanceDrift.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, balancedrift.FieldID)
+		for _, f := range fields {
+			if !balancedrift.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != balancedrift.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.StoredBalance(); ok {
+		_spec.SetField(balancedrift.FieldStoredBalance, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedStoredBalance(); ok {
+		_spec.AddField(balancedrift.FieldStoredBalance, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LedgerBalance(); ok {
+		_spec.SetField(balancedrift.FieldLedgerBalance, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLedgerBalance(); ok {
+		_spec.AddField(balancedrift.FieldLedgerBalance, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Difference(); ok {
+		_spec.SetField(balancedrift.FieldDifference, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDifference(); ok {
+		_spec.AddField(balancedrift.FieldDifference, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastCheckedAt(); ok {
+		_spec.SetField(balancedrift.FieldLastCheckedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ResolvedAt(); ok {
+		_spec.SetField(balancedrift.FieldResolvedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ResolvedAtCleared() {
+		_spec.ClearField(balancedrift.FieldResolvedAt, field.TypeTime)
+	}
+	_node = &BalanceDrift{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{balancedrift.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
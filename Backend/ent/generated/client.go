@@ -15,29 +15,57 @@ import (
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/UnoraApp/be/ent/generated/accountdeletion"
+	"github.com/UnoraApp/be/ent/generated/adminuser"
 	"github.com/UnoraApp/be/ent/generated/auditlog"
+	"github.com/UnoraApp/be/ent/generated/balancedrift"
 	"github.com/UnoraApp/be/ent/generated/checkin"
 	"github.com/UnoraApp/be/ent/generated/connection"
+	"github.com/UnoraApp/be/ent/generated/conversation"
 	"github.com/UnoraApp/be/ent/generated/creditpackage"
 	"github.com/UnoraApp/be/ent/generated/credittransaction"
+	"github.com/UnoraApp/be/ent/generated/dataexport"
+	"github.com/UnoraApp/be/ent/generated/deviceriskflag"
 	"github.com/UnoraApp/be/ent/generated/discoverybatch"
 	"github.com/UnoraApp/be/ent/generated/discoverycard"
 	"github.com/UnoraApp/be/ent/generated/filter"
 	"github.com/UnoraApp/be/ent/generated/hobby"
 	"github.com/UnoraApp/be/ent/generated/hobbyoption"
 	"github.com/UnoraApp/be/ent/generated/interest"
+	"github.com/UnoraApp/be/ent/generated/invoice"
+	"github.com/UnoraApp/be/ent/generated/invoicesequence"
+	"github.com/UnoraApp/be/ent/generated/ledgerentry"
+	"github.com/UnoraApp/be/ent/generated/message"
+	"github.com/UnoraApp/be/ent/generated/moderationaction"
+	"github.com/UnoraApp/be/ent/generated/notification"
 	"github.com/UnoraApp/be/ent/generated/nudge"
+	"github.com/UnoraApp/be/ent/generated/paymentdiscrepancy"
 	"github.com/UnoraApp/be/ent/generated/paymentorder"
+	"github.com/UnoraApp/be/ent/generated/paymentreconciliationreport"
+	"github.com/UnoraApp/be/ent/generated/paymentrefund"
 	"github.com/UnoraApp/be/ent/generated/photo"
 	"github.com/UnoraApp/be/ent/generated/profile"
+	"github.com/UnoraApp/be/ent/generated/promocode"
+	"github.com/UnoraApp/be/ent/generated/promoredemption"
+	"github.com/UnoraApp/be/ent/generated/referral"
+	"github.com/UnoraApp/be/ent/generated/reportevidence"
 	"github.com/UnoraApp/be/ent/generated/reveal"
 	"github.com/UnoraApp/be/ent/generated/revealcontent"
+	"github.com/UnoraApp/be/ent/generated/revealgift"
 	"github.com/UnoraApp/be/ent/generated/revealmilestone"
+	"github.com/UnoraApp/be/ent/generated/revealview"
 	"github.com/UnoraApp/be/ent/generated/server"
 	"github.com/UnoraApp/be/ent/generated/streak"
+	"github.com/UnoraApp/be/ent/generated/subscription"
+	"github.com/UnoraApp/be/ent/generated/subscriptionplan"
+	"github.com/UnoraApp/be/ent/generated/tierentitlement"
 	"github.com/UnoraApp/be/ent/generated/user"
 	"github.com/UnoraApp/be/ent/generated/userblock"
+	"github.com/UnoraApp/be/ent/generated/userdevice"
+	"github.com/UnoraApp/be/ent/generated/useridentity"
 	"github.com/UnoraApp/be/ent/generated/userreport"
+	"github.com/UnoraApp/be/ent/generated/usersession"
+	"github.com/UnoraApp/be/ent/generated/webhookevent"
 )
 
 // Client is the client that holds all ent builders.
@@ -45,16 +73,28 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// AccountDeletion is the client for interacting with the AccountDeletion builders.
+	AccountDeletion *AccountDeletionClient
+	// AdminUser is the client for interacting with the AdminUser builders.
+	AdminUser *AdminUserClient
 	// AuditLog is the client for interacting with the AuditLog builders.
 	AuditLog *AuditLogClient
+	// BalanceDrift is the client for interacting with the BalanceDrift builders.
+	BalanceDrift *BalanceDriftClient
 	// CheckIn is the client for interacting with the CheckIn builders.
 	CheckIn *CheckInClient
 	// Connection is the client for interacting with the Connection builders.
 	Connection *ConnectionClient
+	// Conversation is the client for interacting with the Conversation builders.
+	Conversation *ConversationClient
 	// CreditPackage is the client for interacting with the CreditPackage builders.
 	CreditPackage *CreditPackageClient
 	// CreditTransaction is the client for interacting with the CreditTransaction builders.
 	CreditTransaction *CreditTransactionClient
+	// DataExport is the client for interacting with the DataExport builders.
+	DataExport *DataExportClient
+	// DeviceRiskFlag is the client for interacting with the DeviceRiskFlag builders.
+	DeviceRiskFlag *DeviceRiskFlagClient
 	// DiscoveryBatch is the client for interacting with the DiscoveryBatch builders.
 	DiscoveryBatch *DiscoveryBatchClient
 	// DiscoveryCard is the client for interacting with the DiscoveryCard builders.
@@ -67,30 +107,74 @@ type Client struct {
 	HobbyOption *HobbyOptionClient
 	// Interest is the client for interacting with the Interest builders.
 	Interest *InterestClient
+	// Invoice is the client for interacting with the Invoice builders.
+	Invoice *InvoiceClient
+	// InvoiceSequence is the client for interacting with the InvoiceSequence builders.
+	InvoiceSequence *InvoiceSequenceClient
+	// LedgerEntry is the client for interacting with the LedgerEntry builders.
+	LedgerEntry *LedgerEntryClient
+	// Message is the client for interacting with the Message builders.
+	Message *MessageClient
+	// ModerationAction is the client for interacting with the ModerationAction builders.
+	ModerationAction *ModerationActionClient
+	// Notification is the client for interacting with the Notification builders.
+	Notification *NotificationClient
 	// Nudge is the client for interacting with the Nudge builders.
 	Nudge *NudgeClient
+	// PaymentDiscrepancy is the client for interacting with the PaymentDiscrepancy builders.
+	PaymentDiscrepancy *PaymentDiscrepancyClient
 	// PaymentOrder is the client for interacting with the PaymentOrder builders.
 	PaymentOrder *PaymentOrderClient
+	// PaymentReconciliationReport is the client for interacting with the PaymentReconciliationReport builders.
+	PaymentReconciliationReport *PaymentReconciliationReportClient
+	// PaymentRefund is the client for interacting with the PaymentRefund builders.
+	PaymentRefund *PaymentRefundClient
 	// Photo is the client for interacting with the Photo builders.
 	Photo *PhotoClient
 	// Profile is the client for interacting with the Profile builders.
 	Profile *ProfileClient
+	// PromoCode is the client for interacting with the PromoCode builders.
+	PromoCode *PromoCodeClient
+	// PromoRedemption is the client for interacting with the PromoRedemption builders.
+	PromoRedemption *PromoRedemptionClient
+	// Referral is the client for interacting with the Referral builders.
+	Referral *ReferralClient
+	// ReportEvidence is the client for interacting with the ReportEvidence builders.
+	ReportEvidence *ReportEvidenceClient
 	// Reveal is the client for interacting with the Reveal builders.
 	Reveal *RevealClient
 	// RevealContent is the client for interacting with the RevealContent builders.
 	RevealContent *RevealContentClient
+	// RevealGift is the client for interacting with the RevealGift builders.
+	RevealGift *RevealGiftClient
 	// RevealMilestone is the client for interacting with the RevealMilestone builders.
 	RevealMilestone *RevealMilestoneClient
+	// RevealView is the client for interacting with the RevealView builders.
+	RevealView *RevealViewClient
 	// Server is the client for interacting with the Server builders.
 	Server *ServerClient
 	// Streak is the client for interacting with the Streak builders.
 	Streak *StreakClient
+	// Subscription is the client for interacting with the Subscription builders.
+	Subscription *SubscriptionClient
+	// SubscriptionPlan is the client for interacting with the SubscriptionPlan builders.
+	SubscriptionPlan *SubscriptionPlanClient
+	// TierEntitlement is the client for interacting with the TierEntitlement builders.
+	TierEntitlement *TierEntitlementClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 	// UserBlock is the client for interacting with the UserBlock builders.
 	UserBlock *UserBlockClient
+	// UserDevice is the client for interacting with the UserDevice builders.
+	UserDevice *UserDeviceClient
+	// UserIdentity is the client for interacting with the UserIdentity builders.
+	UserIdentity *UserIdentityClient
 	// UserReport is the client for interacting with the UserReport builders.
 	UserReport *UserReportClient
+	// UserSession is the client for interacting with the UserSession builders.
+	UserSession *UserSessionClient
+	// WebhookEvent is the client for interacting with the WebhookEvent builders.
+	WebhookEvent *WebhookEventClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -102,29 +186,57 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.AccountDeletion = NewAccountDeletionClient(c.config)
+	c.AdminUser = NewAdminUserClient(c.config)
 	c.AuditLog = NewAuditLogClient(c.config)
+	c.BalanceDrift = NewBalanceDriftClient(c.config)
 	c.CheckIn = NewCheckInClient(c.config)
 	c.Connection = NewConnectionClient(c.config)
+	c.Conversation = NewConversationClient(c.config)
 	c.CreditPackage = NewCreditPackageClient(c.config)
 	c.CreditTransaction = NewCreditTransactionClient(c.config)
+	c.DataExport = NewDataExportClient(c.config)
+	c.DeviceRiskFlag = NewDeviceRiskFlagClient(c.config)
 	c.DiscoveryBatch = NewDiscoveryBatchClient(c.config)
 	c.DiscoveryCard = NewDiscoveryCardClient(c.config)
 	c.Filter = NewFilterClient(c.config)
 	c.Hobby = NewHobbyClient(c.config)
 	c.HobbyOption = NewHobbyOptionClient(c.config)
 	c.Interest = NewInterestClient(c.config)
+	c.Invoice = NewInvoiceClient(c.config)
+	c.InvoiceSequence = NewInvoiceSequenceClient(c.config)
+	c.LedgerEntry = NewLedgerEntryClient(c.config)
+	c.Message = NewMessageClient(c.config)
+	c.ModerationAction = NewModerationActionClient(c.config)
+	c.Notification = NewNotificationClient(c.config)
 	c.Nudge = NewNudgeClient(c.config)
+	c.PaymentDiscrepancy = NewPaymentDiscrepancyClient(c.config)
 	c.PaymentOrder = NewPaymentOrderClient(c.config)
+	c.PaymentReconciliationReport = NewPaymentReconciliationReportClient(c.config)
+	c.PaymentRefund = NewPaymentRefundClient(c.config)
 	c.Photo = NewPhotoClient(c.config)
 	c.Profile = NewProfileClient(c.config)
+	c.PromoCode = NewPromoCodeClient(c.config)
+	c.PromoRedemption = NewPromoRedemptionClient(c.config)
+	c.Referral = NewReferralClient(c.config)
+	c.ReportEvidence = NewReportEvidenceClient(c.config)
 	c.Reveal = NewRevealClient(c.config)
 	c.RevealContent = NewRevealContentClient(c.config)
+	c.RevealGift = NewRevealGiftClient(c.config)
 	c.RevealMilestone = NewRevealMilestoneClient(c.config)
+	c.RevealView = NewRevealViewClient(c.config)
 	c.Server = NewServerClient(c.config)
 	c.Streak = NewStreakClient(c.config)
+	c.Subscription = NewSubscriptionClient(c.config)
+	c.SubscriptionPlan = NewSubscriptionPlanClient(c.config)
+	c.TierEntitlement = NewTierEntitlementClient(c.config)
 	c.User = NewUserClient(c.config)
 	c.UserBlock = NewUserBlockClient(c.config)
+	c.UserDevice = NewUserDeviceClient(c.config)
+	c.UserIdentity = NewUserIdentityClient(c.config)
 	c.UserReport = NewUserReportClient(c.config)
+	c.UserSession = NewUserSessionClient(c.config)
+	c.WebhookEvent = NewWebhookEventClient(c.config)
 }
 
 type (
@@ -215,31 +327,59 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:               ctx,
-		config:            cfg,
-		AuditLog:          NewAuditLogClient(cfg),
-		CheckIn:           NewCheckInClient(cfg),
-		Connection:        NewConnectionClient(cfg),
-		CreditPackage:     NewCreditPackageClient(cfg),
-		CreditTransaction: NewCreditTransactionClient(cfg),
-		DiscoveryBatch:    NewDiscoveryBatchClient(cfg),
-		DiscoveryCard:     NewDiscoveryCardClient(cfg),
-		Filter:            NewFilterClient(cfg),
-		Hobby:             NewHobbyClient(cfg),
-		HobbyOption:       NewHobbyOptionClient(cfg),
-		Interest:          NewInterestClient(cfg),
-		Nudge:             NewNudgeClient(cfg),
-		PaymentOrder:      NewPaymentOrderClient(cfg),
-		Photo:             NewPhotoClient(cfg),
-		Profile:           NewProfileClient(cfg),
-		Reveal:            NewRevealClient(cfg),
-		RevealContent:     NewRevealContentClient(cfg),
-		RevealMilestone:   NewRevealMilestoneClient(cfg),
-		Server:            NewServerClient(cfg),
-		Streak:            NewStreakClient(cfg),
-		User:              NewUserClient(cfg),
-		UserBlock:         NewUserBlockClient(cfg),
-		UserReport:        NewUserReportClient(cfg),
+		ctx:                         ctx,
+		config:                      cfg,
+		AccountDeletion:             NewAccountDeletionClient(cfg),
+		AdminUser:                   NewAdminUserClient(cfg),
+		AuditLog:                    NewAuditLogClient(cfg),
+		BalanceDrift:                NewBalanceDriftClient(cfg),
+		CheckIn:                     NewCheckInClient(cfg),
+		Connection:                  NewConnectionClient(cfg),
+		Conversation:                NewConversationClient(cfg),
+		CreditPackage:               NewCreditPackageClient(cfg),
+		CreditTransaction:           NewCreditTransactionClient(cfg),
+		DataExport:                  NewDataExportClient(cfg),
+		DeviceRiskFlag:              NewDeviceRiskFlagClient(cfg),
+		DiscoveryBatch:              NewDiscoveryBatchClient(cfg),
+		DiscoveryCard:               NewDiscoveryCardClient(cfg),
+		Filter:                      NewFilterClient(cfg),
+		Hobby:                       NewHobbyClient(cfg),
+		HobbyOption:                 NewHobbyOptionClient(cfg),
+		Interest:                    NewInterestClient(cfg),
+		Invoice:                     NewInvoiceClient(cfg),
+		InvoiceSequence:             NewInvoiceSequenceClient(cfg),
+		LedgerEntry:                 NewLedgerEntryClient(cfg),
+		Message:                     NewMessageClient(cfg),
+		ModerationAction:            NewModerationActionClient(cfg),
+		Notification:                NewNotificationClient(cfg),
+		Nudge:                       NewNudgeClient(cfg),
+		PaymentDiscrepancy:          NewPaymentDiscrepancyClient(cfg),
+		PaymentOrder:                NewPaymentOrderClient(cfg),
+		PaymentReconciliationReport: NewPaymentReconciliationReportClient(cfg),
+		PaymentRefund:               NewPaymentRefundClient(cfg),
+		Photo:                       NewPhotoClient(cfg),
+		Profile:                     NewProfileClient(cfg),
+		PromoCode:                   NewPromoCodeClient(cfg),
+		PromoRedemption:             NewPromoRedemptionClient(cfg),
+		Referral:                    NewReferralClient(cfg),
+		ReportEvidence:              NewReportEvidenceClient(cfg),
+		Reveal:                      NewRevealClient(cfg),
+		RevealContent:               NewRevealContentClient(cfg),
+		RevealGift:                  NewRevealGiftClient(cfg),
+		RevealMilestone:             NewRevealMilestoneClient(cfg),
+		RevealView:                  NewRevealViewClient(cfg),
+		Server:                      NewServerClient(cfg),
+		Streak:                      NewStreakClient(cfg),
+		Subscription:                NewSubscriptionClient(cfg),
+		SubscriptionPlan:            NewSubscriptionPlanClient(cfg),
+		TierEntitlement:             NewTierEntitlementClient(cfg),
+		User:                        NewUserClient(cfg),
+		UserBlock:                   NewUserBlockClient(cfg),
+		UserDevice:                  NewUserDeviceClient(cfg),
+		UserIdentity:                NewUserIdentityClient(cfg),
+		UserReport:                  NewUserReportClient(cfg),
+		UserSession:                 NewUserSessionClient(cfg),
+		WebhookEvent:                NewWebhookEventClient(cfg),
 	}, nil
 }
 
@@ -257,38 +397,66 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:               ctx,
-		config:            cfg,
-		AuditLog:          NewAuditLogClient(cfg),
-		CheckIn:           NewCheckInClient(cfg),
-		Connection:        NewConnectionClient(cfg),
-		CreditPackage:     NewCreditPackageClient(cfg),
-		CreditTransaction: NewCreditTransactionClient(cfg),
-		DiscoveryBatch:    NewDiscoveryBatchClient(cfg),
-		DiscoveryCard:     NewDiscoveryCardClient(cfg),
-		Filter:            NewFilterClient(cfg),
-		Hobby:             NewHobbyClient(cfg),
-		HobbyOption:       NewHobbyOptionClient(cfg),
-		Interest:          NewInterestClient(cfg),
-		Nudge:             NewNudgeClient(cfg),
-		PaymentOrder:      NewPaymentOrderClient(cfg),
-		Photo:             NewPhotoClient(cfg),
-		Profile:           NewProfileClient(cfg),
-		Reveal:            NewRevealClient(cfg),
-		RevealContent:     NewRevealContentClient(cfg),
-		RevealMilestone:   NewRevealMilestoneClient(cfg),
-		Server:            NewServerClient(cfg),
-		Streak:            NewStreakClient(cfg),
-		User:              NewUserClient(cfg),
-		UserBlock:         NewUserBlockClient(cfg),
-		UserReport:        NewUserReportClient(cfg),
+		ctx:                         ctx,
+		config:                      cfg,
+		AccountDeletion:             NewAccountDeletionClient(cfg),
+		AdminUser:                   NewAdminUserClient(cfg),
+		AuditLog:                    NewAuditLogClient(cfg),
+		BalanceDrift:                NewBalanceDriftClient(cfg),
+		CheckIn:                     NewCheckInClient(cfg),
+		Connection:                  NewConnectionClient(cfg),
+		Conversation:                NewConversationClient(cfg),
+		CreditPackage:               NewCreditPackageClient(cfg),
+		CreditTransaction:           NewCreditTransactionClient(cfg),
+		DataExport:                  NewDataExportClient(cfg),
+		DeviceRiskFlag:              NewDeviceRiskFlagClient(cfg),
+		DiscoveryBatch:              NewDiscoveryBatchClient(cfg),
+		DiscoveryCard:               NewDiscoveryCardClient(cfg),
+		Filter:                      NewFilterClient(cfg),
+		Hobby:                       NewHobbyClient(cfg),
+		HobbyOption:                 NewHobbyOptionClient(cfg),
+		Interest:                    NewInterestClient(cfg),
+		Invoice:                     NewInvoiceClient(cfg),
+		InvoiceSequence:             NewInvoiceSequenceClient(cfg),
+		LedgerEntry:                 NewLedgerEntryClient(cfg),
+		Message:                     NewMessageClient(cfg),
+		ModerationAction:            NewModerationActionClient(cfg),
+		Notification:                NewNotificationClient(cfg),
+		Nudge:                       NewNudgeClient(cfg),
+		PaymentDiscrepancy:          NewPaymentDiscrepancyClient(cfg),
+		PaymentOrder:                NewPaymentOrderClient(cfg),
+		PaymentReconciliationReport: NewPaymentReconciliationReportClient(cfg),
+		PaymentRefund:               NewPaymentRefundClient(cfg),
+		Photo:                       NewPhotoClient(cfg),
+		Profile:                     NewProfileClient(cfg),
+		PromoCode:                   NewPromoCodeClient(cfg),
+		PromoRedemption:             NewPromoRedemptionClient(cfg),
+		Referral:                    NewReferralClient(cfg),
+		ReportEvidence:              NewReportEvidenceClient(cfg),
+		Reveal:                      NewRevealClient(cfg),
+		RevealContent:               NewRevealContentClient(cfg),
+		RevealGift:                  NewRevealGiftClient(cfg),
+		RevealMilestone:             NewRevealMilestoneClient(cfg),
+		RevealView:                  NewRevealViewClient(cfg),
+		Server:                      NewServerClient(cfg),
+		Streak:                      NewStreakClient(cfg),
+		Subscription:                NewSubscriptionClient(cfg),
+		SubscriptionPlan:            NewSubscriptionPlanClient(cfg),
+		TierEntitlement:             NewTierEntitlementClient(cfg),
+		User:                        NewUserClient(cfg),
+		UserBlock:                   NewUserBlockClient(cfg),
+		UserDevice:                  NewUserDeviceClient(cfg),
+		UserIdentity:                NewUserIdentityClient(cfg),
+		UserReport:                  NewUserReportClient(cfg),
+		UserSession:                 NewUserSessionClient(cfg),
+		WebhookEvent:                NewWebhookEventClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		AuditLog.
+//		AccountDeletion.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -311,11 +479,17 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.AuditLog, c.CheckIn, c.Connection, c.CreditPackage, c.CreditTransaction,
-		c.DiscoveryBatch, c.DiscoveryCard, c.Filter, c.Hobby, c.HobbyOption,
-		c.Interest, c.Nudge, c.PaymentOrder, c.Photo, c.Profile, c.Reveal,
-		c.RevealContent, c.RevealMilestone, c.Server, c.Streak, c.User, c.UserBlock,
-		c.UserReport,
+		c.AccountDeletion, c.AdminUser, c.AuditLog, c.BalanceDrift, c.CheckIn,
+		c.Connection, c.Conversation, c.CreditPackage, c.CreditTransaction,
+		c.DataExport, c.DeviceRiskFlag, c.DiscoveryBatch, c.DiscoveryCard, c.Filter,
+		c.Hobby, c.HobbyOption, c.Interest, c.Invoice, c.InvoiceSequence,
+		c.LedgerEntry, c.Message, c.ModerationAction, c.Notification, c.Nudge,
+		c.PaymentDiscrepancy, c.PaymentOrder, c.PaymentReconciliationReport,
+		c.PaymentRefund, c.Photo, c.Profile, c.PromoCode, c.PromoRedemption,
+		c.Referral, c.ReportEvidence, c.Reveal, c.RevealContent, c.RevealGift,
+		c.RevealMilestone, c.RevealView, c.Server, c.Streak, c.Subscription,
+		c.SubscriptionPlan, c.TierEntitlement, c.User, c.UserBlock, c.UserDevice,
+		c.UserIdentity, c.UserReport, c.UserSession, c.WebhookEvent,
 	} {
 		n.Use(hooks...)
 	}
@@ -325,11 +499,17 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.AuditLog, c.CheckIn, c.Connection, c.CreditPackage, c.CreditTransaction,
-		c.DiscoveryBatch, c.DiscoveryCard, c.Filter, c.Hobby, c.HobbyOption,
-		c.Interest, c.Nudge, c.PaymentOrder, c.Photo, c.Profile, c.Reveal,
-		c.RevealContent, c.RevealMilestone, c.Server, c.Streak, c.User, c.UserBlock,
-		c.UserReport,
+		c.AccountDeletion, c.AdminUser, c.AuditLog, c.BalanceDrift, c.CheckIn,
+		c.Connection, c.Conversation, c.CreditPackage, c.CreditTransaction,
+		c.DataExport, c.DeviceRiskFlag, c.DiscoveryBatch, c.DiscoveryCard, c.Filter,
+		c.Hobby, c.HobbyOption, c.Interest, c.Invoice, c.InvoiceSequence,
+		c.LedgerEntry, c.Message, c.ModerationAction, c.Notification, c.Nudge,
+		c.PaymentDiscrepancy, c.PaymentOrder, c.PaymentReconciliationReport,
+		c.PaymentRefund, c.Photo, c.Profile, c.PromoCode, c.PromoRedemption,
+		c.Referral, c.ReportEvidence, c.Reveal, c.RevealContent, c.RevealGift,
+		c.RevealMilestone, c.RevealView, c.Server, c.Streak, c.Subscription,
+		c.SubscriptionPlan, c.TierEntitlement, c.User, c.UserBlock, c.UserDevice,
+		c.UserIdentity, c.UserReport, c.UserSession, c.WebhookEvent,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -338,16 +518,28 @@ func (c *Client) Intercept(interceptors ...Interceptor) {
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *AccountDeletionMutation:
+		return c.AccountDeletion.mutate(ctx, m)
+	case *AdminUserMutation:
+		return c.AdminUser.mutate(ctx, m)
 	case *AuditLogMutation:
 		return c.AuditLog.mutate(ctx, m)
+	case *BalanceDriftMutation:
+		return c.BalanceDrift.mutate(ctx, m)
 	case *CheckInMutation:
 		return c.CheckIn.mutate(ctx, m)
 	case *ConnectionMutation:
 		return c.Connection.mutate(ctx, m)
+	case *ConversationMutation:
+		return c.Conversation.mutate(ctx, m)
 	case *CreditPackageMutation:
 		return c.CreditPackage.mutate(ctx, m)
 	case *CreditTransactionMutation:
 		return c.CreditTransaction.mutate(ctx, m)
+	case *DataExportMutation:
+		return c.DataExport.mutate(ctx, m)
+	case *DeviceRiskFlagMutation:
+		return c.DeviceRiskFlag.mutate(ctx, m)
 	case *DiscoveryBatchMutation:
 		return c.DiscoveryBatch.mutate(ctx, m)
 	case *DiscoveryCardMutation:
@@ -360,35 +552,345 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.HobbyOption.mutate(ctx, m)
 	case *InterestMutation:
 		return c.Interest.mutate(ctx, m)
+	case *InvoiceMutation:
+		return c.Invoice.mutate(ctx, m)
+	case *InvoiceSequenceMutation:
+		return c.InvoiceSequence.mutate(ctx, m)
+	case *LedgerEntryMutation:
+		return c.LedgerEntry.mutate(ctx, m)
+	case *MessageMutation:
+		return c.Message.mutate(ctx, m)
+	case *ModerationActionMutation:
+		return c.ModerationAction.mutate(ctx, m)
+	case *NotificationMutation:
+		return c.Notification.mutate(ctx, m)
 	case *NudgeMutation:
 		return c.Nudge.mutate(ctx, m)
+	case *PaymentDiscrepancyMutation:
+		return c.PaymentDiscrepancy.mutate(ctx, m)
 	case *PaymentOrderMutation:
 		return c.PaymentOrder.mutate(ctx, m)
+	case *PaymentReconciliationReportMutation:
+		return c.PaymentReconciliationReport.mutate(ctx, m)
+	case *PaymentRefundMutation:
+		return c.PaymentRefund.mutate(ctx, m)
 	case *PhotoMutation:
 		return c.Photo.mutate(ctx, m)
 	case *ProfileMutation:
 		return c.Profile.mutate(ctx, m)
+	case *PromoCodeMutation:
+		return c.PromoCode.mutate(ctx, m)
+	case *PromoRedemptionMutation:
+		return c.PromoRedemption.mutate(ctx, m)
+	case *ReferralMutation:
+		return c.Referral.mutate(ctx, m)
+	case *ReportEvidenceMutation:
+		return c.ReportEvidence.mutate(ctx, m)
 	case *RevealMutation:
 		return c.Reveal.mutate(ctx, m)
 	case *RevealContentMutation:
 		return c.RevealContent.mutate(ctx, m)
+	case *RevealGiftMutation:
+		return c.RevealGift.mutate(ctx, m)
 	case *RevealMilestoneMutation:
 		return c.RevealMilestone.mutate(ctx, m)
+	case *RevealViewMutation:
+		return c.RevealView.mutate(ctx, m)
 	case *ServerMutation:
 		return c.Server.mutate(ctx, m)
 	case *StreakMutation:
 		return c.Streak.mutate(ctx, m)
+	case *SubscriptionMutation:
+		return c.Subscription.mutate(ctx, m)
+	case *SubscriptionPlanMutation:
+		return c.SubscriptionPlan.mutate(ctx, m)
+	case *TierEntitlementMutation:
+		return c.TierEntitlement.mutate(ctx, m)
 	case *UserMutation:
 		return c.User.mutate(ctx, m)
 	case *UserBlockMutation:
 		return c.UserBlock.mutate(ctx, m)
+	case *UserDeviceMutation:
+		return c.UserDevice.mutate(ctx, m)
+	case *UserIdentityMutation:
+		return c.UserIdentity.mutate(ctx, m)
 	case *UserReportMutation:
 		return c.UserReport.mutate(ctx, m)
+	case *UserSessionMutation:
+		return c.UserSession.mutate(ctx, m)
+	case *WebhookEventMutation:
+		return c.WebhookEvent.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("generated: unknown mutation type %T", m)
 	}
 }
 
+// AccountDeletionClient is a client for the AccountDeletion schema.
+type AccountDeletionClient struct {
+	config
+}
+
+// NewAccountDeletionClient returns a client for the AccountDeletion from the given config.
+func NewAccountDeletionClient(c config) *AccountDeletionClient {
+	return &AccountDeletionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `accountdeletion.Hooks(f(g(h())))`.
+func (c *AccountDeletionClient) Use(hooks ...Hook) {
+	c.hooks.AccountDeletion = append(c.hooks.AccountDeletion, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `accountdeletion.Intercept(f(g(h())))`.
+func (c *AccountDeletionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AccountDeletion = append(c.inters.AccountDeletion, interceptors...)
+}
+
+// Create returns a builder for creating a AccountDeletion entity.
+func (c *AccountDeletionClient) Create() *AccountDeletionCreate {
+	mutation := newAccountDeletionMutation(c.config, OpCreate)
+	return &AccountDeletionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AccountDeletion entities.
+func (c *AccountDeletionClient) CreateBulk(builders ...*AccountDeletionCreate) *AccountDeletionCreateBulk {
+	return &AccountDeletionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AccountDeletionClient) MapCreateBulk(slice any, setFunc func(*AccountDeletionCreate, int)) *AccountDeletionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AccountDeletionCreateBulk{err: fmt.Errorf("calling to AccountDeletionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AccountDeletionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AccountDeletionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AccountDeletion.
+func (c *AccountDeletionClient) Update() *AccountDeletionUpdate {
+	mutation := newAccountDeletionMutation(c.config, OpUpdate)
+	return &AccountDeletionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AccountDeletionClient) UpdateOne(_m *AccountDeletion) *AccountDeletionUpdateOne {
+	mutation := newAccountDeletionMutation(c.config, OpUpdateOne, withAccountDeletion(_m))
+	return &AccountDeletionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AccountDeletionClient) UpdateOneID(id string) *AccountDeletionUpdateOne {
+	mutation := newAccountDeletionMutation(c.config, OpUpdateOne, withAccountDeletionID(id))
+	return &AccountDeletionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AccountDeletion.
+func (c *AccountDeletionClient) Delete() *AccountDeletionDelete {
+	mutation := newAccountDeletionMutation(c.config, OpDelete)
+	return &AccountDeletionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AccountDeletionClient) DeleteOne(_m *AccountDeletion) *AccountDeletionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AccountDeletionClient) DeleteOneID(id string) *AccountDeletionDeleteOne {
+	builder := c.Delete().Where(accountdeletion.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AccountDeletionDeleteOne{builder}
+}
+
+// Query returns a query builder for AccountDeletion.
+func (c *AccountDeletionClient) Query() *AccountDeletionQuery {
+	return &AccountDeletionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAccountDeletion},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AccountDeletion entity by its id.
+func (c *AccountDeletionClient) Get(ctx context.Context, id string) (*AccountDeletion, error) {
+	return c.Query().Where(accountdeletion.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AccountDeletionClient) GetX(ctx context.Context, id string) *AccountDeletion {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AccountDeletionClient) Hooks() []Hook {
+	return c.hooks.AccountDeletion
+}
+
+// Interceptors returns the client interceptors.
+func (c *AccountDeletionClient) Interceptors() []Interceptor {
+	return c.inters.AccountDeletion
+}
+
+func (c *AccountDeletionClient) mutate(ctx context.Context, m *AccountDeletionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AccountDeletionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AccountDeletionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AccountDeletionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AccountDeletionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown AccountDeletion mutation op: %q", m.Op())
+	}
+}
+
+// AdminUserClient is a client for the AdminUser schema.
+type AdminUserClient struct {
+	config
+}
+
+// NewAdminUserClient returns a client for the AdminUser from the given config.
+func NewAdminUserClient(c config) *AdminUserClient {
+	return &AdminUserClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `adminuser.Hooks(f(g(h())))`.
+func (c *AdminUserClient) Use(hooks ...Hook) {
+	c.hooks.AdminUser = append(c.hooks.AdminUser, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `adminuser.Intercept(f(g(h())))`.
+func (c *AdminUserClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AdminUser = append(c.inters.AdminUser, interceptors...)
+}
+
+// Create returns a builder for creating a AdminUser entity.
+func (c *AdminUserClient) Create() *AdminUserCreate {
+	mutation := newAdminUserMutation(c.config, OpCreate)
+	return &AdminUserCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AdminUser entities.
+func (c *AdminUserClient) CreateBulk(builders ...*AdminUserCreate) *AdminUserCreateBulk {
+	return &AdminUserCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AdminUserClient) MapCreateBulk(slice any, setFunc func(*AdminUserCreate, int)) *AdminUserCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AdminUserCreateBulk{err: fmt.Errorf("calling to AdminUserClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AdminUserCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AdminUserCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AdminUser.
+func (c *AdminUserClient) Update() *AdminUserUpdate {
+	mutation := newAdminUserMutation(c.config, OpUpdate)
+	return &AdminUserUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AdminUserClient) UpdateOne(_m *AdminUser) *AdminUserUpdateOne {
+	mutation := newAdminUserMutation(c.config, OpUpdateOne, withAdminUser(_m))
+	return &AdminUserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AdminUserClient) UpdateOneID(id string) *AdminUserUpdateOne {
+	mutation := newAdminUserMutation(c.config, OpUpdateOne, withAdminUserID(id))
+	return &AdminUserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AdminUser.
+func (c *AdminUserClient) Delete() *AdminUserDelete {
+	mutation := newAdminUserMutation(c.config, OpDelete)
+	return &AdminUserDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AdminUserClient) DeleteOne(_m *AdminUser) *AdminUserDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AdminUserClient) DeleteOneID(id string) *AdminUserDeleteOne {
+	builder := c.Delete().Where(adminuser.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AdminUserDeleteOne{builder}
+}
+
+// Query returns a query builder for AdminUser.
+func (c *AdminUserClient) Query() *AdminUserQuery {
+	return &AdminUserQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAdminUser},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AdminUser entity by its id.
+func (c *AdminUserClient) Get(ctx context.Context, id string) (*AdminUser, error) {
+	return c.Query().Where(adminuser.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AdminUserClient) GetX(ctx context.Context, id string) *AdminUser {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AdminUserClient) Hooks() []Hook {
+	return c.hooks.AdminUser
+}
+
+// Interceptors returns the client interceptors.
+func (c *AdminUserClient) Interceptors() []Interceptor {
+	return c.inters.AdminUser
+}
+
+func (c *AdminUserClient) mutate(ctx context.Context, m *AdminUserMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AdminUserCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AdminUserUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AdminUserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AdminUserDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown AdminUser mutation op: %q", m.Op())
+	}
+}
+
 // AuditLogClient is a client for the AuditLog schema.
 type AuditLogClient struct {
 	config
@@ -522,6 +1024,155 @@ func (c *AuditLogClient) mutate(ctx context.Context, m *AuditLogMutation) (Value
 	}
 }
 
+// BalanceDriftClient is a client for the BalanceDrift schema.
+type BalanceDriftClient struct {
+	config
+}
+
+// NewBalanceDriftClient returns a client for the BalanceDrift from the given config.
+func NewBalanceDriftClient(c config) *BalanceDriftClient {
+	return &BalanceDriftClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `balancedrift.Hooks(f(g(h())))`.
+func (c *BalanceDriftClient) Use(hooks ...Hook) {
+	c.hooks.BalanceDrift = append(c.hooks.BalanceDrift, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `balancedrift.Intercept(f(g(h())))`.
+func (c *BalanceDriftClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BalanceDrift = append(c.inters.BalanceDrift, interceptors...)
+}
+
+// Create returns a builder for creating a BalanceDrift entity.
+func (c *BalanceDriftClient) Create() *BalanceDriftCreate {
+	mutation := newBalanceDriftMutation(c.config, OpCreate)
+	return &BalanceDriftCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BalanceDrift entities.
+func (c *BalanceDriftClient) CreateBulk(builders ...*BalanceDriftCreate) *BalanceDriftCreateBulk {
+	return &BalanceDriftCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BalanceDriftClient) MapCreateBulk(slice any, setFunc func(*BalanceDriftCreate, int)) *BalanceDriftCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BalanceDriftCreateBulk{err: fmt.Errorf("calling to BalanceDriftClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BalanceDriftCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BalanceDriftCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BalanceDrift.
+func (c *BalanceDriftClient) Update() *BalanceDriftUpdate {
+	mutation := newBalanceDriftMutation(c.config, OpUpdate)
+	return &BalanceDriftUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BalanceDriftClient) UpdateOne(_m *BalanceDrift) *BalanceDriftUpdateOne {
+	mutation := newBalanceDriftMutation(c.config, OpUpdateOne, withBalanceDrift(_m))
+	return &BalanceDriftUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BalanceDriftClient) UpdateOneID(id string) *BalanceDriftUpdateOne {
+	mutation := newBalanceDriftMutation(c.config, OpUpdateOne, withBalanceDriftID(id))
+	return &BalanceDriftUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BalanceDrift.
+func (c *BalanceDriftClient) Delete() *BalanceDriftDelete {
+	mutation := newBalanceDriftMutation(c.config, OpDelete)
+	return &BalanceDriftDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BalanceDriftClient) DeleteOne(_m *BalanceDrift) *BalanceDriftDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BalanceDriftClient) DeleteOneID(id string) *BalanceDriftDeleteOne {
+	builder := c.Delete().Where(balancedrift.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BalanceDriftDeleteOne{builder}
+}
+
+// Query returns a query builder for BalanceDrift.
+func (c *BalanceDriftClient) Query() *BalanceDriftQuery {
+	return &BalanceDriftQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBalanceDrift},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BalanceDrift entity by its id.
+func (c *BalanceDriftClient) Get(ctx context.Context, id string) (*BalanceDrift, error) {
+	return c.Query().Where(balancedrift.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BalanceDriftClient) GetX(ctx context.Context, id string) *BalanceDrift {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a BalanceDrift.
+func (c *BalanceDriftClient) QueryUser(_m *BalanceDrift) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(balancedrift.Table, balancedrift.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, balancedrift.UserTable, balancedrift.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *BalanceDriftClient) Hooks() []Hook {
+	return c.hooks.BalanceDrift
+}
+
+// Interceptors returns the client interceptors.
+func (c *BalanceDriftClient) Interceptors() []Interceptor {
+	return c.inters.BalanceDrift
+}
+
+func (c *BalanceDriftClient) mutate(ctx context.Context, m *BalanceDriftMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BalanceDriftCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BalanceDriftUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BalanceDriftUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BalanceDriftDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown BalanceDrift mutation op: %q", m.Op())
+	}
+}
+
 // CheckInClient is a client for the CheckIn schema.
 type CheckInClient struct {
 	config
@@ -859,18 +1510,50 @@ func (c *ConnectionClient) QueryReveals(_m *Connection) *RevealQuery {
 	return query
 }
 
-// Hooks returns the client hooks.
-func (c *ConnectionClient) Hooks() []Hook {
-	return c.hooks.Connection
-}
-
-// Interceptors returns the client interceptors.
-func (c *ConnectionClient) Interceptors() []Interceptor {
-	return c.inters.Connection
+// QueryRevealGifts queries the reveal_gifts edge of a Connection.
+func (c *ConnectionClient) QueryRevealGifts(_m *Connection) *RevealGiftQuery {
+	query := (&RevealGiftClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(connection.Table, connection.FieldID, id),
+			sqlgraph.To(revealgift.Table, revealgift.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, connection.RevealGiftsTable, connection.RevealGiftsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
 }
 
-func (c *ConnectionClient) mutate(ctx context.Context, m *ConnectionMutation) (Value, error) {
-	switch m.Op() {
+// QueryConversation queries the conversation edge of a Connection.
+func (c *ConnectionClient) QueryConversation(_m *Connection) *ConversationQuery {
+	query := (&ConversationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(connection.Table, connection.FieldID, id),
+			sqlgraph.To(conversation.Table, conversation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, connection.ConversationTable, connection.ConversationColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ConnectionClient) Hooks() []Hook {
+	return c.hooks.Connection
+}
+
+// Interceptors returns the client interceptors.
+func (c *ConnectionClient) Interceptors() []Interceptor {
+	return c.inters.Connection
+}
+
+func (c *ConnectionClient) mutate(ctx context.Context, m *ConnectionMutation) (Value, error) {
+	switch m.Op() {
 	case OpCreate:
 		return (&ConnectionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
@@ -884,6 +1567,171 @@ func (c *ConnectionClient) mutate(ctx context.Context, m *ConnectionMutation) (V
 	}
 }
 
+// ConversationClient is a client for the Conversation schema.
+type ConversationClient struct {
+	config
+}
+
+// NewConversationClient returns a client for the Conversation from the given config.
+func NewConversationClient(c config) *ConversationClient {
+	return &ConversationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `conversation.Hooks(f(g(h())))`.
+func (c *ConversationClient) Use(hooks ...Hook) {
+	c.hooks.Conversation = append(c.hooks.Conversation, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `conversation.Intercept(f(g(h())))`.
+func (c *ConversationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Conversation = append(c.inters.Conversation, interceptors...)
+}
+
+// Create returns a builder for creating a Conversation entity.
+func (c *ConversationClient) Create() *ConversationCreate {
+	mutation := newConversationMutation(c.config, OpCreate)
+	return &ConversationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Conversation entities.
+func (c *ConversationClient) CreateBulk(builders ...*ConversationCreate) *ConversationCreateBulk {
+	return &ConversationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ConversationClient) MapCreateBulk(slice any, setFunc func(*ConversationCreate, int)) *ConversationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ConversationCreateBulk{err: fmt.Errorf("calling to ConversationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ConversationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ConversationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Conversation.
+func (c *ConversationClient) Update() *ConversationUpdate {
+	mutation := newConversationMutation(c.config, OpUpdate)
+	return &ConversationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ConversationClient) UpdateOne(_m *Conversation) *ConversationUpdateOne {
+	mutation := newConversationMutation(c.config, OpUpdateOne, withConversation(_m))
+	return &ConversationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ConversationClient) UpdateOneID(id string) *ConversationUpdateOne {
+	mutation := newConversationMutation(c.config, OpUpdateOne, withConversationID(id))
+	return &ConversationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Conversation.
+func (c *ConversationClient) Delete() *ConversationDelete {
+	mutation := newConversationMutation(c.config, OpDelete)
+	return &ConversationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ConversationClient) DeleteOne(_m *Conversation) *ConversationDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ConversationClient) DeleteOneID(id string) *ConversationDeleteOne {
+	builder := c.Delete().Where(conversation.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ConversationDeleteOne{builder}
+}
+
+// Query returns a query builder for Conversation.
+func (c *ConversationClient) Query() *ConversationQuery {
+	return &ConversationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeConversation},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Conversation entity by its id.
+func (c *ConversationClient) Get(ctx context.Context, id string) (*Conversation, error) {
+	return c.Query().Where(conversation.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ConversationClient) GetX(ctx context.Context, id string) *Conversation {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryConnection queries the connection edge of a Conversation.
+func (c *ConversationClient) QueryConnection(_m *Conversation) *ConnectionQuery {
+	query := (&ConnectionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(conversation.Table, conversation.FieldID, id),
+			sqlgraph.To(connection.Table, connection.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, conversation.ConnectionTable, conversation.ConnectionColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMessages queries the messages edge of a Conversation.
+func (c *ConversationClient) QueryMessages(_m *Conversation) *MessageQuery {
+	query := (&MessageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(conversation.Table, conversation.FieldID, id),
+			sqlgraph.To(message.Table, message.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, conversation.MessagesTable, conversation.MessagesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ConversationClient) Hooks() []Hook {
+	return c.hooks.Conversation
+}
+
+// Interceptors returns the client interceptors.
+func (c *ConversationClient) Interceptors() []Interceptor {
+	return c.inters.Conversation
+}
+
+func (c *ConversationClient) mutate(ctx context.Context, m *ConversationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ConversationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ConversationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ConversationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ConversationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown Conversation mutation op: %q", m.Op())
+	}
+}
+
 // CreditPackageClient is a client for the CreditPackage schema.
 type CreditPackageClient struct {
 	config
@@ -1141,6 +1989,22 @@ func (c *CreditTransactionClient) QueryUser(_m *CreditTransaction) *UserQuery {
 	return query
 }
 
+// QueryEntries queries the entries edge of a CreditTransaction.
+func (c *CreditTransactionClient) QueryEntries(_m *CreditTransaction) *LedgerEntryQuery {
+	query := (&LedgerEntryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(credittransaction.Table, credittransaction.FieldID, id),
+			sqlgraph.To(ledgerentry.Table, ledgerentry.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, credittransaction.EntriesTable, credittransaction.EntriesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *CreditTransactionClient) Hooks() []Hook {
 	return c.hooks.CreditTransaction
@@ -1166,107 +2030,107 @@ func (c *CreditTransactionClient) mutate(ctx context.Context, m *CreditTransacti
 	}
 }
 
-// DiscoveryBatchClient is a client for the DiscoveryBatch schema.
-type DiscoveryBatchClient struct {
+// DataExportClient is a client for the DataExport schema.
+type DataExportClient struct {
 	config
 }
 
-// NewDiscoveryBatchClient returns a client for the DiscoveryBatch from the given config.
-func NewDiscoveryBatchClient(c config) *DiscoveryBatchClient {
-	return &DiscoveryBatchClient{config: c}
+// NewDataExportClient returns a client for the DataExport from the given config.
+func NewDataExportClient(c config) *DataExportClient {
+	return &DataExportClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `discoverybatch.Hooks(f(g(h())))`.
-func (c *DiscoveryBatchClient) Use(hooks ...Hook) {
-	c.hooks.DiscoveryBatch = append(c.hooks.DiscoveryBatch, hooks...)
+// A call to `Use(f, g, h)` equals to `dataexport.Hooks(f(g(h())))`.
+func (c *DataExportClient) Use(hooks ...Hook) {
+	c.hooks.DataExport = append(c.hooks.DataExport, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `discoverybatch.Intercept(f(g(h())))`.
-func (c *DiscoveryBatchClient) Intercept(interceptors ...Interceptor) {
-	c.inters.DiscoveryBatch = append(c.inters.DiscoveryBatch, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `dataexport.Intercept(f(g(h())))`.
+func (c *DataExportClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DataExport = append(c.inters.DataExport, interceptors...)
 }
 
-// Create returns a builder for creating a DiscoveryBatch entity.
-func (c *DiscoveryBatchClient) Create() *DiscoveryBatchCreate {
-	mutation := newDiscoveryBatchMutation(c.config, OpCreate)
-	return &DiscoveryBatchCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a DataExport entity.
+func (c *DataExportClient) Create() *DataExportCreate {
+	mutation := newDataExportMutation(c.config, OpCreate)
+	return &DataExportCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of DiscoveryBatch entities.
-func (c *DiscoveryBatchClient) CreateBulk(builders ...*DiscoveryBatchCreate) *DiscoveryBatchCreateBulk {
-	return &DiscoveryBatchCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of DataExport entities.
+func (c *DataExportClient) CreateBulk(builders ...*DataExportCreate) *DataExportCreateBulk {
+	return &DataExportCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *DiscoveryBatchClient) MapCreateBulk(slice any, setFunc func(*DiscoveryBatchCreate, int)) *DiscoveryBatchCreateBulk {
+func (c *DataExportClient) MapCreateBulk(slice any, setFunc func(*DataExportCreate, int)) *DataExportCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &DiscoveryBatchCreateBulk{err: fmt.Errorf("calling to DiscoveryBatchClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &DataExportCreateBulk{err: fmt.Errorf("calling to DataExportClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*DiscoveryBatchCreate, rv.Len())
+	builders := make([]*DataExportCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &DiscoveryBatchCreateBulk{config: c.config, builders: builders}
+	return &DataExportCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for DiscoveryBatch.
-func (c *DiscoveryBatchClient) Update() *DiscoveryBatchUpdate {
-	mutation := newDiscoveryBatchMutation(c.config, OpUpdate)
-	return &DiscoveryBatchUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for DataExport.
+func (c *DataExportClient) Update() *DataExportUpdate {
+	mutation := newDataExportMutation(c.config, OpUpdate)
+	return &DataExportUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *DiscoveryBatchClient) UpdateOne(_m *DiscoveryBatch) *DiscoveryBatchUpdateOne {
-	mutation := newDiscoveryBatchMutation(c.config, OpUpdateOne, withDiscoveryBatch(_m))
-	return &DiscoveryBatchUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *DataExportClient) UpdateOne(_m *DataExport) *DataExportUpdateOne {
+	mutation := newDataExportMutation(c.config, OpUpdateOne, withDataExport(_m))
+	return &DataExportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *DiscoveryBatchClient) UpdateOneID(id string) *DiscoveryBatchUpdateOne {
-	mutation := newDiscoveryBatchMutation(c.config, OpUpdateOne, withDiscoveryBatchID(id))
-	return &DiscoveryBatchUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *DataExportClient) UpdateOneID(id string) *DataExportUpdateOne {
+	mutation := newDataExportMutation(c.config, OpUpdateOne, withDataExportID(id))
+	return &DataExportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for DiscoveryBatch.
-func (c *DiscoveryBatchClient) Delete() *DiscoveryBatchDelete {
-	mutation := newDiscoveryBatchMutation(c.config, OpDelete)
-	return &DiscoveryBatchDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for DataExport.
+func (c *DataExportClient) Delete() *DataExportDelete {
+	mutation := newDataExportMutation(c.config, OpDelete)
+	return &DataExportDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *DiscoveryBatchClient) DeleteOne(_m *DiscoveryBatch) *DiscoveryBatchDeleteOne {
+func (c *DataExportClient) DeleteOne(_m *DataExport) *DataExportDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *DiscoveryBatchClient) DeleteOneID(id string) *DiscoveryBatchDeleteOne {
-	builder := c.Delete().Where(discoverybatch.ID(id))
+func (c *DataExportClient) DeleteOneID(id string) *DataExportDeleteOne {
+	builder := c.Delete().Where(dataexport.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &DiscoveryBatchDeleteOne{builder}
+	return &DataExportDeleteOne{builder}
 }
 
-// Query returns a query builder for DiscoveryBatch.
-func (c *DiscoveryBatchClient) Query() *DiscoveryBatchQuery {
-	return &DiscoveryBatchQuery{
+// Query returns a query builder for DataExport.
+func (c *DataExportClient) Query() *DataExportQuery {
+	return &DataExportQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeDiscoveryBatch},
+		ctx:    &QueryContext{Type: TypeDataExport},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a DiscoveryBatch entity by its id.
-func (c *DiscoveryBatchClient) Get(ctx context.Context, id string) (*DiscoveryBatch, error) {
-	return c.Query().Where(discoverybatch.ID(id)).Only(ctx)
+// Get returns a DataExport entity by its id.
+func (c *DataExportClient) Get(ctx context.Context, id string) (*DataExport, error) {
+	return c.Query().Where(dataexport.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *DiscoveryBatchClient) GetX(ctx context.Context, id string) *DiscoveryBatch {
+func (c *DataExportClient) GetX(ctx context.Context, id string) *DataExport {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1274,164 +2138,132 @@ func (c *DiscoveryBatchClient) GetX(ctx context.Context, id string) *DiscoveryBa
 	return obj
 }
 
-// QueryUser queries the user edge of a DiscoveryBatch.
-func (c *DiscoveryBatchClient) QueryUser(_m *DiscoveryBatch) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(discoverybatch.Table, discoverybatch.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, discoverybatch.UserTable, discoverybatch.UserColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryCards queries the cards edge of a DiscoveryBatch.
-func (c *DiscoveryBatchClient) QueryCards(_m *DiscoveryBatch) *DiscoveryCardQuery {
-	query := (&DiscoveryCardClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(discoverybatch.Table, discoverybatch.FieldID, id),
-			sqlgraph.To(discoverycard.Table, discoverycard.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, discoverybatch.CardsTable, discoverybatch.CardsColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *DiscoveryBatchClient) Hooks() []Hook {
-	return c.hooks.DiscoveryBatch
+func (c *DataExportClient) Hooks() []Hook {
+	return c.hooks.DataExport
 }
 
 // Interceptors returns the client interceptors.
-func (c *DiscoveryBatchClient) Interceptors() []Interceptor {
-	return c.inters.DiscoveryBatch
+func (c *DataExportClient) Interceptors() []Interceptor {
+	return c.inters.DataExport
 }
 
-func (c *DiscoveryBatchClient) mutate(ctx context.Context, m *DiscoveryBatchMutation) (Value, error) {
+func (c *DataExportClient) mutate(ctx context.Context, m *DataExportMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&DiscoveryBatchCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DataExportCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&DiscoveryBatchUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DataExportUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&DiscoveryBatchUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DataExportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&DiscoveryBatchDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&DataExportDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("generated: unknown DiscoveryBatch mutation op: %q", m.Op())
+		return nil, fmt.Errorf("generated: unknown DataExport mutation op: %q", m.Op())
 	}
 }
 
-// DiscoveryCardClient is a client for the DiscoveryCard schema.
-type DiscoveryCardClient struct {
+// DeviceRiskFlagClient is a client for the DeviceRiskFlag schema.
+type DeviceRiskFlagClient struct {
 	config
 }
 
-// NewDiscoveryCardClient returns a client for the DiscoveryCard from the given config.
-func NewDiscoveryCardClient(c config) *DiscoveryCardClient {
-	return &DiscoveryCardClient{config: c}
+// NewDeviceRiskFlagClient returns a client for the DeviceRiskFlag from the given config.
+func NewDeviceRiskFlagClient(c config) *DeviceRiskFlagClient {
+	return &DeviceRiskFlagClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `discoverycard.Hooks(f(g(h())))`.
-func (c *DiscoveryCardClient) Use(hooks ...Hook) {
-	c.hooks.DiscoveryCard = append(c.hooks.DiscoveryCard, hooks...)
+// A call to `Use(f, g, h)` equals to `deviceriskflag.Hooks(f(g(h())))`.
+func (c *DeviceRiskFlagClient) Use(hooks ...Hook) {
+	c.hooks.DeviceRiskFlag = append(c.hooks.DeviceRiskFlag, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `discoverycard.Intercept(f(g(h())))`.
-func (c *DiscoveryCardClient) Intercept(interceptors ...Interceptor) {
-	c.inters.DiscoveryCard = append(c.inters.DiscoveryCard, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `deviceriskflag.Intercept(f(g(h())))`.
+func (c *DeviceRiskFlagClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DeviceRiskFlag = append(c.inters.DeviceRiskFlag, interceptors...)
 }
 
-// Create returns a builder for creating a DiscoveryCard entity.
-func (c *DiscoveryCardClient) Create() *DiscoveryCardCreate {
-	mutation := newDiscoveryCardMutation(c.config, OpCreate)
-	return &DiscoveryCardCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a DeviceRiskFlag entity.
+func (c *DeviceRiskFlagClient) Create() *DeviceRiskFlagCreate {
+	mutation := newDeviceRiskFlagMutation(c.config, OpCreate)
+	return &DeviceRiskFlagCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of DiscoveryCard entities.
-func (c *DiscoveryCardClient) CreateBulk(builders ...*DiscoveryCardCreate) *DiscoveryCardCreateBulk {
-	return &DiscoveryCardCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of DeviceRiskFlag entities.
+func (c *DeviceRiskFlagClient) CreateBulk(builders ...*DeviceRiskFlagCreate) *DeviceRiskFlagCreateBulk {
+	return &DeviceRiskFlagCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *DiscoveryCardClient) MapCreateBulk(slice any, setFunc func(*DiscoveryCardCreate, int)) *DiscoveryCardCreateBulk {
+func (c *DeviceRiskFlagClient) MapCreateBulk(slice any, setFunc func(*DeviceRiskFlagCreate, int)) *DeviceRiskFlagCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &DiscoveryCardCreateBulk{err: fmt.Errorf("calling to DiscoveryCardClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &DeviceRiskFlagCreateBulk{err: fmt.Errorf("calling to DeviceRiskFlagClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*DiscoveryCardCreate, rv.Len())
+	builders := make([]*DeviceRiskFlagCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &DiscoveryCardCreateBulk{config: c.config, builders: builders}
+	return &DeviceRiskFlagCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for DiscoveryCard.
-func (c *DiscoveryCardClient) Update() *DiscoveryCardUpdate {
-	mutation := newDiscoveryCardMutation(c.config, OpUpdate)
-	return &DiscoveryCardUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for DeviceRiskFlag.
+func (c *DeviceRiskFlagClient) Update() *DeviceRiskFlagUpdate {
+	mutation := newDeviceRiskFlagMutation(c.config, OpUpdate)
+	return &DeviceRiskFlagUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *DiscoveryCardClient) UpdateOne(_m *DiscoveryCard) *DiscoveryCardUpdateOne {
-	mutation := newDiscoveryCardMutation(c.config, OpUpdateOne, withDiscoveryCard(_m))
-	return &DiscoveryCardUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *DeviceRiskFlagClient) UpdateOne(_m *DeviceRiskFlag) *DeviceRiskFlagUpdateOne {
+	mutation := newDeviceRiskFlagMutation(c.config, OpUpdateOne, withDeviceRiskFlag(_m))
+	return &DeviceRiskFlagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *DiscoveryCardClient) UpdateOneID(id string) *DiscoveryCardUpdateOne {
-	mutation := newDiscoveryCardMutation(c.config, OpUpdateOne, withDiscoveryCardID(id))
-	return &DiscoveryCardUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *DeviceRiskFlagClient) UpdateOneID(id string) *DeviceRiskFlagUpdateOne {
+	mutation := newDeviceRiskFlagMutation(c.config, OpUpdateOne, withDeviceRiskFlagID(id))
+	return &DeviceRiskFlagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for DiscoveryCard.
-func (c *DiscoveryCardClient) Delete() *DiscoveryCardDelete {
-	mutation := newDiscoveryCardMutation(c.config, OpDelete)
-	return &DiscoveryCardDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for DeviceRiskFlag.
+func (c *DeviceRiskFlagClient) Delete() *DeviceRiskFlagDelete {
+	mutation := newDeviceRiskFlagMutation(c.config, OpDelete)
+	return &DeviceRiskFlagDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *DiscoveryCardClient) DeleteOne(_m *DiscoveryCard) *DiscoveryCardDeleteOne {
+func (c *DeviceRiskFlagClient) DeleteOne(_m *DeviceRiskFlag) *DeviceRiskFlagDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *DiscoveryCardClient) DeleteOneID(id string) *DiscoveryCardDeleteOne {
-	builder := c.Delete().Where(discoverycard.ID(id))
+func (c *DeviceRiskFlagClient) DeleteOneID(id string) *DeviceRiskFlagDeleteOne {
+	builder := c.Delete().Where(deviceriskflag.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &DiscoveryCardDeleteOne{builder}
+	return &DeviceRiskFlagDeleteOne{builder}
 }
 
-// Query returns a query builder for DiscoveryCard.
-func (c *DiscoveryCardClient) Query() *DiscoveryCardQuery {
-	return &DiscoveryCardQuery{
+// Query returns a query builder for DeviceRiskFlag.
+func (c *DeviceRiskFlagClient) Query() *DeviceRiskFlagQuery {
+	return &DeviceRiskFlagQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeDiscoveryCard},
+		ctx:    &QueryContext{Type: TypeDeviceRiskFlag},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a DiscoveryCard entity by its id.
-func (c *DiscoveryCardClient) Get(ctx context.Context, id string) (*DiscoveryCard, error) {
-	return c.Query().Where(discoverycard.ID(id)).Only(ctx)
+// Get returns a DeviceRiskFlag entity by its id.
+func (c *DeviceRiskFlagClient) Get(ctx context.Context, id string) (*DeviceRiskFlag, error) {
+	return c.Query().Where(deviceriskflag.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *DiscoveryCardClient) GetX(ctx context.Context, id string) *DiscoveryCard {
+func (c *DeviceRiskFlagClient) GetX(ctx context.Context, id string) *DeviceRiskFlag {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1439,180 +2271,132 @@ func (c *DiscoveryCardClient) GetX(ctx context.Context, id string) *DiscoveryCar
 	return obj
 }
 
-// QueryBatch queries the batch edge of a DiscoveryCard.
-func (c *DiscoveryCardClient) QueryBatch(_m *DiscoveryCard) *DiscoveryBatchQuery {
-	query := (&DiscoveryBatchClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(discoverycard.Table, discoverycard.FieldID, id),
-			sqlgraph.To(discoverybatch.Table, discoverybatch.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, discoverycard.BatchTable, discoverycard.BatchColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryCandidate queries the candidate edge of a DiscoveryCard.
-func (c *DiscoveryCardClient) QueryCandidate(_m *DiscoveryCard) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(discoverycard.Table, discoverycard.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, discoverycard.CandidateTable, discoverycard.CandidateColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryInterests queries the interests edge of a DiscoveryCard.
-func (c *DiscoveryCardClient) QueryInterests(_m *DiscoveryCard) *InterestQuery {
-	query := (&InterestClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(discoverycard.Table, discoverycard.FieldID, id),
-			sqlgraph.To(interest.Table, interest.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, discoverycard.InterestsTable, discoverycard.InterestsColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *DiscoveryCardClient) Hooks() []Hook {
-	return c.hooks.DiscoveryCard
+func (c *DeviceRiskFlagClient) Hooks() []Hook {
+	return c.hooks.DeviceRiskFlag
 }
 
 // Interceptors returns the client interceptors.
-func (c *DiscoveryCardClient) Interceptors() []Interceptor {
-	return c.inters.DiscoveryCard
+func (c *DeviceRiskFlagClient) Interceptors() []Interceptor {
+	return c.inters.DeviceRiskFlag
 }
 
-func (c *DiscoveryCardClient) mutate(ctx context.Context, m *DiscoveryCardMutation) (Value, error) {
+func (c *DeviceRiskFlagClient) mutate(ctx context.Context, m *DeviceRiskFlagMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&DiscoveryCardCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DeviceRiskFlagCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&DiscoveryCardUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DeviceRiskFlagUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&DiscoveryCardUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DeviceRiskFlagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&DiscoveryCardDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&DeviceRiskFlagDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("generated: unknown DiscoveryCard mutation op: %q", m.Op())
+		return nil, fmt.Errorf("generated: unknown DeviceRiskFlag mutation op: %q", m.Op())
 	}
 }
 
-// FilterClient is a client for the Filter schema.
-type FilterClient struct {
+// DiscoveryBatchClient is a client for the DiscoveryBatch schema.
+type DiscoveryBatchClient struct {
 	config
 }
 
-// NewFilterClient returns a client for the Filter from the given config.
-func NewFilterClient(c config) *FilterClient {
-	return &FilterClient{config: c}
+// NewDiscoveryBatchClient returns a client for the DiscoveryBatch from the given config.
+func NewDiscoveryBatchClient(c config) *DiscoveryBatchClient {
+	return &DiscoveryBatchClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `filter.Hooks(f(g(h())))`.
-func (c *FilterClient) Use(hooks ...Hook) {
-	c.hooks.Filter = append(c.hooks.Filter, hooks...)
+// A call to `Use(f, g, h)` equals to `discoverybatch.Hooks(f(g(h())))`.
+func (c *DiscoveryBatchClient) Use(hooks ...Hook) {
+	c.hooks.DiscoveryBatch = append(c.hooks.DiscoveryBatch, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `filter.Intercept(f(g(h())))`.
-func (c *FilterClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Filter = append(c.inters.Filter, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `discoverybatch.Intercept(f(g(h())))`.
+func (c *DiscoveryBatchClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DiscoveryBatch = append(c.inters.DiscoveryBatch, interceptors...)
 }
 
-// Create returns a builder for creating a Filter entity.
-func (c *FilterClient) Create() *FilterCreate {
-	mutation := newFilterMutation(c.config, OpCreate)
-	return &FilterCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a DiscoveryBatch entity.
+func (c *DiscoveryBatchClient) Create() *DiscoveryBatchCreate {
+	mutation := newDiscoveryBatchMutation(c.config, OpCreate)
+	return &DiscoveryBatchCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Filter entities.
-func (c *FilterClient) CreateBulk(builders ...*FilterCreate) *FilterCreateBulk {
-	return &FilterCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of DiscoveryBatch entities.
+func (c *DiscoveryBatchClient) CreateBulk(builders ...*DiscoveryBatchCreate) *DiscoveryBatchCreateBulk {
+	return &DiscoveryBatchCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *FilterClient) MapCreateBulk(slice any, setFunc func(*FilterCreate, int)) *FilterCreateBulk {
+func (c *DiscoveryBatchClient) MapCreateBulk(slice any, setFunc func(*DiscoveryBatchCreate, int)) *DiscoveryBatchCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &FilterCreateBulk{err: fmt.Errorf("calling to FilterClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &DiscoveryBatchCreateBulk{err: fmt.Errorf("calling to DiscoveryBatchClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*FilterCreate, rv.Len())
+	builders := make([]*DiscoveryBatchCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &FilterCreateBulk{config: c.config, builders: builders}
+	return &DiscoveryBatchCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Filter.
-func (c *FilterClient) Update() *FilterUpdate {
-	mutation := newFilterMutation(c.config, OpUpdate)
-	return &FilterUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for DiscoveryBatch.
+func (c *DiscoveryBatchClient) Update() *DiscoveryBatchUpdate {
+	mutation := newDiscoveryBatchMutation(c.config, OpUpdate)
+	return &DiscoveryBatchUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *FilterClient) UpdateOne(_m *Filter) *FilterUpdateOne {
-	mutation := newFilterMutation(c.config, OpUpdateOne, withFilter(_m))
-	return &FilterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *DiscoveryBatchClient) UpdateOne(_m *DiscoveryBatch) *DiscoveryBatchUpdateOne {
+	mutation := newDiscoveryBatchMutation(c.config, OpUpdateOne, withDiscoveryBatch(_m))
+	return &DiscoveryBatchUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *FilterClient) UpdateOneID(id string) *FilterUpdateOne {
-	mutation := newFilterMutation(c.config, OpUpdateOne, withFilterID(id))
-	return &FilterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *DiscoveryBatchClient) UpdateOneID(id string) *DiscoveryBatchUpdateOne {
+	mutation := newDiscoveryBatchMutation(c.config, OpUpdateOne, withDiscoveryBatchID(id))
+	return &DiscoveryBatchUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Filter.
-func (c *FilterClient) Delete() *FilterDelete {
-	mutation := newFilterMutation(c.config, OpDelete)
-	return &FilterDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for DiscoveryBatch.
+func (c *DiscoveryBatchClient) Delete() *DiscoveryBatchDelete {
+	mutation := newDiscoveryBatchMutation(c.config, OpDelete)
+	return &DiscoveryBatchDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *FilterClient) DeleteOne(_m *Filter) *FilterDeleteOne {
+func (c *DiscoveryBatchClient) DeleteOne(_m *DiscoveryBatch) *DiscoveryBatchDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *FilterClient) DeleteOneID(id string) *FilterDeleteOne {
-	builder := c.Delete().Where(filter.ID(id))
+func (c *DiscoveryBatchClient) DeleteOneID(id string) *DiscoveryBatchDeleteOne {
+	builder := c.Delete().Where(discoverybatch.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &FilterDeleteOne{builder}
+	return &DiscoveryBatchDeleteOne{builder}
 }
 
-// Query returns a query builder for Filter.
-func (c *FilterClient) Query() *FilterQuery {
-	return &FilterQuery{
+// Query returns a query builder for DiscoveryBatch.
+func (c *DiscoveryBatchClient) Query() *DiscoveryBatchQuery {
+	return &DiscoveryBatchQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeFilter},
+		ctx:    &QueryContext{Type: TypeDiscoveryBatch},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Filter entity by its id.
-func (c *FilterClient) Get(ctx context.Context, id string) (*Filter, error) {
-	return c.Query().Where(filter.ID(id)).Only(ctx)
+// Get returns a DiscoveryBatch entity by its id.
+func (c *DiscoveryBatchClient) Get(ctx context.Context, id string) (*DiscoveryBatch, error) {
+	return c.Query().Where(discoverybatch.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *FilterClient) GetX(ctx context.Context, id string) *Filter {
+func (c *DiscoveryBatchClient) GetX(ctx context.Context, id string) *DiscoveryBatch {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1620,15 +2404,31 @@ func (c *FilterClient) GetX(ctx context.Context, id string) *Filter {
 	return obj
 }
 
-// QueryUser queries the user edge of a Filter.
-func (c *FilterClient) QueryUser(_m *Filter) *UserQuery {
+// QueryUser queries the user edge of a DiscoveryBatch.
+func (c *DiscoveryBatchClient) QueryUser(_m *DiscoveryBatch) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(filter.Table, filter.FieldID, id),
+			sqlgraph.From(discoverybatch.Table, discoverybatch.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, filter.UserTable, filter.UserColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, discoverybatch.UserTable, discoverybatch.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCards queries the cards edge of a DiscoveryBatch.
+func (c *DiscoveryBatchClient) QueryCards(_m *DiscoveryBatch) *DiscoveryCardQuery {
+	query := (&DiscoveryCardClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(discoverybatch.Table, discoverybatch.FieldID, id),
+			sqlgraph.To(discoverycard.Table, discoverycard.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, discoverybatch.CardsTable, discoverybatch.CardsColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1637,131 +2437,131 @@ func (c *FilterClient) QueryUser(_m *Filter) *UserQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *FilterClient) Hooks() []Hook {
-	return c.hooks.Filter
+func (c *DiscoveryBatchClient) Hooks() []Hook {
+	return c.hooks.DiscoveryBatch
 }
 
 // Interceptors returns the client interceptors.
-func (c *FilterClient) Interceptors() []Interceptor {
-	return c.inters.Filter
+func (c *DiscoveryBatchClient) Interceptors() []Interceptor {
+	return c.inters.DiscoveryBatch
 }
 
-func (c *FilterClient) mutate(ctx context.Context, m *FilterMutation) (Value, error) {
+func (c *DiscoveryBatchClient) mutate(ctx context.Context, m *DiscoveryBatchMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&FilterCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DiscoveryBatchCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&FilterUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DiscoveryBatchUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&FilterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DiscoveryBatchUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&FilterDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&DiscoveryBatchDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("generated: unknown Filter mutation op: %q", m.Op())
+		return nil, fmt.Errorf("generated: unknown DiscoveryBatch mutation op: %q", m.Op())
 	}
 }
 
-// HobbyClient is a client for the Hobby schema.
-type HobbyClient struct {
+// DiscoveryCardClient is a client for the DiscoveryCard schema.
+type DiscoveryCardClient struct {
 	config
 }
 
-// NewHobbyClient returns a client for the Hobby from the given config.
-func NewHobbyClient(c config) *HobbyClient {
-	return &HobbyClient{config: c}
+// NewDiscoveryCardClient returns a client for the DiscoveryCard from the given config.
+func NewDiscoveryCardClient(c config) *DiscoveryCardClient {
+	return &DiscoveryCardClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `hobby.Hooks(f(g(h())))`.
-func (c *HobbyClient) Use(hooks ...Hook) {
-	c.hooks.Hobby = append(c.hooks.Hobby, hooks...)
+// A call to `Use(f, g, h)` equals to `discoverycard.Hooks(f(g(h())))`.
+func (c *DiscoveryCardClient) Use(hooks ...Hook) {
+	c.hooks.DiscoveryCard = append(c.hooks.DiscoveryCard, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `hobby.Intercept(f(g(h())))`.
-func (c *HobbyClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Hobby = append(c.inters.Hobby, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `discoverycard.Intercept(f(g(h())))`.
+func (c *DiscoveryCardClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DiscoveryCard = append(c.inters.DiscoveryCard, interceptors...)
 }
 
-// Create returns a builder for creating a Hobby entity.
-func (c *HobbyClient) Create() *HobbyCreate {
-	mutation := newHobbyMutation(c.config, OpCreate)
-	return &HobbyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a DiscoveryCard entity.
+func (c *DiscoveryCardClient) Create() *DiscoveryCardCreate {
+	mutation := newDiscoveryCardMutation(c.config, OpCreate)
+	return &DiscoveryCardCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Hobby entities.
-func (c *HobbyClient) CreateBulk(builders ...*HobbyCreate) *HobbyCreateBulk {
-	return &HobbyCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of DiscoveryCard entities.
+func (c *DiscoveryCardClient) CreateBulk(builders ...*DiscoveryCardCreate) *DiscoveryCardCreateBulk {
+	return &DiscoveryCardCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *HobbyClient) MapCreateBulk(slice any, setFunc func(*HobbyCreate, int)) *HobbyCreateBulk {
+func (c *DiscoveryCardClient) MapCreateBulk(slice any, setFunc func(*DiscoveryCardCreate, int)) *DiscoveryCardCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &HobbyCreateBulk{err: fmt.Errorf("calling to HobbyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &DiscoveryCardCreateBulk{err: fmt.Errorf("calling to DiscoveryCardClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*HobbyCreate, rv.Len())
+	builders := make([]*DiscoveryCardCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &HobbyCreateBulk{config: c.config, builders: builders}
+	return &DiscoveryCardCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Hobby.
-func (c *HobbyClient) Update() *HobbyUpdate {
-	mutation := newHobbyMutation(c.config, OpUpdate)
-	return &HobbyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for DiscoveryCard.
+func (c *DiscoveryCardClient) Update() *DiscoveryCardUpdate {
+	mutation := newDiscoveryCardMutation(c.config, OpUpdate)
+	return &DiscoveryCardUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *HobbyClient) UpdateOne(_m *Hobby) *HobbyUpdateOne {
-	mutation := newHobbyMutation(c.config, OpUpdateOne, withHobby(_m))
-	return &HobbyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *DiscoveryCardClient) UpdateOne(_m *DiscoveryCard) *DiscoveryCardUpdateOne {
+	mutation := newDiscoveryCardMutation(c.config, OpUpdateOne, withDiscoveryCard(_m))
+	return &DiscoveryCardUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *HobbyClient) UpdateOneID(id string) *HobbyUpdateOne {
-	mutation := newHobbyMutation(c.config, OpUpdateOne, withHobbyID(id))
-	return &HobbyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *DiscoveryCardClient) UpdateOneID(id string) *DiscoveryCardUpdateOne {
+	mutation := newDiscoveryCardMutation(c.config, OpUpdateOne, withDiscoveryCardID(id))
+	return &DiscoveryCardUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Hobby.
-func (c *HobbyClient) Delete() *HobbyDelete {
-	mutation := newHobbyMutation(c.config, OpDelete)
-	return &HobbyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for DiscoveryCard.
+func (c *DiscoveryCardClient) Delete() *DiscoveryCardDelete {
+	mutation := newDiscoveryCardMutation(c.config, OpDelete)
+	return &DiscoveryCardDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *HobbyClient) DeleteOne(_m *Hobby) *HobbyDeleteOne {
+func (c *DiscoveryCardClient) DeleteOne(_m *DiscoveryCard) *DiscoveryCardDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *HobbyClient) DeleteOneID(id string) *HobbyDeleteOne {
-	builder := c.Delete().Where(hobby.ID(id))
+func (c *DiscoveryCardClient) DeleteOneID(id string) *DiscoveryCardDeleteOne {
+	builder := c.Delete().Where(discoverycard.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &HobbyDeleteOne{builder}
+	return &DiscoveryCardDeleteOne{builder}
 }
 
-// Query returns a query builder for Hobby.
-func (c *HobbyClient) Query() *HobbyQuery {
-	return &HobbyQuery{
+// Query returns a query builder for DiscoveryCard.
+func (c *DiscoveryCardClient) Query() *DiscoveryCardQuery {
+	return &DiscoveryCardQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeHobby},
+		ctx:    &QueryContext{Type: TypeDiscoveryCard},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Hobby entity by its id.
-func (c *HobbyClient) Get(ctx context.Context, id string) (*Hobby, error) {
-	return c.Query().Where(hobby.ID(id)).Only(ctx)
+// Get returns a DiscoveryCard entity by its id.
+func (c *DiscoveryCardClient) Get(ctx context.Context, id string) (*DiscoveryCard, error) {
+	return c.Query().Where(discoverycard.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *HobbyClient) GetX(ctx context.Context, id string) *Hobby {
+func (c *DiscoveryCardClient) GetX(ctx context.Context, id string) *DiscoveryCard {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1769,15 +2569,31 @@ func (c *HobbyClient) GetX(ctx context.Context, id string) *Hobby {
 	return obj
 }
 
-// QueryUser queries the user edge of a Hobby.
-func (c *HobbyClient) QueryUser(_m *Hobby) *UserQuery {
+// QueryBatch queries the batch edge of a DiscoveryCard.
+func (c *DiscoveryCardClient) QueryBatch(_m *DiscoveryCard) *DiscoveryBatchQuery {
+	query := (&DiscoveryBatchClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(discoverycard.Table, discoverycard.FieldID, id),
+			sqlgraph.To(discoverybatch.Table, discoverybatch.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, discoverycard.BatchTable, discoverycard.BatchColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCandidate queries the candidate edge of a DiscoveryCard.
+func (c *DiscoveryCardClient) QueryCandidate(_m *DiscoveryCard) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(hobby.Table, hobby.FieldID, id),
+			sqlgraph.From(discoverycard.Table, discoverycard.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, hobby.UserTable, hobby.UserColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, discoverycard.CandidateTable, discoverycard.CandidateColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1785,15 +2601,15 @@ func (c *HobbyClient) QueryUser(_m *Hobby) *UserQuery {
 	return query
 }
 
-// QueryHobbyOption queries the hobby_option edge of a Hobby.
-func (c *HobbyClient) QueryHobbyOption(_m *Hobby) *HobbyOptionQuery {
-	query := (&HobbyOptionClient{config: c.config}).Query()
+// QueryInterests queries the interests edge of a DiscoveryCard.
+func (c *DiscoveryCardClient) QueryInterests(_m *DiscoveryCard) *InterestQuery {
+	query := (&InterestClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(hobby.Table, hobby.FieldID, id),
-			sqlgraph.To(hobbyoption.Table, hobbyoption.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, hobby.HobbyOptionTable, hobby.HobbyOptionColumn),
+			sqlgraph.From(discoverycard.Table, discoverycard.FieldID, id),
+			sqlgraph.To(interest.Table, interest.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, discoverycard.InterestsTable, discoverycard.InterestsColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1802,131 +2618,131 @@ func (c *HobbyClient) QueryHobbyOption(_m *Hobby) *HobbyOptionQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *HobbyClient) Hooks() []Hook {
-	return c.hooks.Hobby
+func (c *DiscoveryCardClient) Hooks() []Hook {
+	return c.hooks.DiscoveryCard
 }
 
 // Interceptors returns the client interceptors.
-func (c *HobbyClient) Interceptors() []Interceptor {
-	return c.inters.Hobby
+func (c *DiscoveryCardClient) Interceptors() []Interceptor {
+	return c.inters.DiscoveryCard
 }
 
-func (c *HobbyClient) mutate(ctx context.Context, m *HobbyMutation) (Value, error) {
+func (c *DiscoveryCardClient) mutate(ctx context.Context, m *DiscoveryCardMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&HobbyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DiscoveryCardCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&HobbyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DiscoveryCardUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&HobbyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DiscoveryCardUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&HobbyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&DiscoveryCardDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("generated: unknown Hobby mutation op: %q", m.Op())
+		return nil, fmt.Errorf("generated: unknown DiscoveryCard mutation op: %q", m.Op())
 	}
 }
 
-// HobbyOptionClient is a client for the HobbyOption schema.
-type HobbyOptionClient struct {
+// FilterClient is a client for the Filter schema.
+type FilterClient struct {
 	config
 }
 
-// NewHobbyOptionClient returns a client for the HobbyOption from the given config.
-func NewHobbyOptionClient(c config) *HobbyOptionClient {
-	return &HobbyOptionClient{config: c}
+// NewFilterClient returns a client for the Filter from the given config.
+func NewFilterClient(c config) *FilterClient {
+	return &FilterClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `hobbyoption.Hooks(f(g(h())))`.
-func (c *HobbyOptionClient) Use(hooks ...Hook) {
-	c.hooks.HobbyOption = append(c.hooks.HobbyOption, hooks...)
+// A call to `Use(f, g, h)` equals to `filter.Hooks(f(g(h())))`.
+func (c *FilterClient) Use(hooks ...Hook) {
+	c.hooks.Filter = append(c.hooks.Filter, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `hobbyoption.Intercept(f(g(h())))`.
-func (c *HobbyOptionClient) Intercept(interceptors ...Interceptor) {
-	c.inters.HobbyOption = append(c.inters.HobbyOption, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `filter.Intercept(f(g(h())))`.
+func (c *FilterClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Filter = append(c.inters.Filter, interceptors...)
 }
 
-// Create returns a builder for creating a HobbyOption entity.
-func (c *HobbyOptionClient) Create() *HobbyOptionCreate {
-	mutation := newHobbyOptionMutation(c.config, OpCreate)
-	return &HobbyOptionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Filter entity.
+func (c *FilterClient) Create() *FilterCreate {
+	mutation := newFilterMutation(c.config, OpCreate)
+	return &FilterCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of HobbyOption entities.
-func (c *HobbyOptionClient) CreateBulk(builders ...*HobbyOptionCreate) *HobbyOptionCreateBulk {
-	return &HobbyOptionCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Filter entities.
+func (c *FilterClient) CreateBulk(builders ...*FilterCreate) *FilterCreateBulk {
+	return &FilterCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *HobbyOptionClient) MapCreateBulk(slice any, setFunc func(*HobbyOptionCreate, int)) *HobbyOptionCreateBulk {
+func (c *FilterClient) MapCreateBulk(slice any, setFunc func(*FilterCreate, int)) *FilterCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &HobbyOptionCreateBulk{err: fmt.Errorf("calling to HobbyOptionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &FilterCreateBulk{err: fmt.Errorf("calling to FilterClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*HobbyOptionCreate, rv.Len())
+	builders := make([]*FilterCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &HobbyOptionCreateBulk{config: c.config, builders: builders}
+	return &FilterCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for HobbyOption.
-func (c *HobbyOptionClient) Update() *HobbyOptionUpdate {
-	mutation := newHobbyOptionMutation(c.config, OpUpdate)
-	return &HobbyOptionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Filter.
+func (c *FilterClient) Update() *FilterUpdate {
+	mutation := newFilterMutation(c.config, OpUpdate)
+	return &FilterUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *HobbyOptionClient) UpdateOne(_m *HobbyOption) *HobbyOptionUpdateOne {
-	mutation := newHobbyOptionMutation(c.config, OpUpdateOne, withHobbyOption(_m))
-	return &HobbyOptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *FilterClient) UpdateOne(_m *Filter) *FilterUpdateOne {
+	mutation := newFilterMutation(c.config, OpUpdateOne, withFilter(_m))
+	return &FilterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *HobbyOptionClient) UpdateOneID(id string) *HobbyOptionUpdateOne {
-	mutation := newHobbyOptionMutation(c.config, OpUpdateOne, withHobbyOptionID(id))
-	return &HobbyOptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *FilterClient) UpdateOneID(id string) *FilterUpdateOne {
+	mutation := newFilterMutation(c.config, OpUpdateOne, withFilterID(id))
+	return &FilterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for HobbyOption.
-func (c *HobbyOptionClient) Delete() *HobbyOptionDelete {
-	mutation := newHobbyOptionMutation(c.config, OpDelete)
-	return &HobbyOptionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Filter.
+func (c *FilterClient) Delete() *FilterDelete {
+	mutation := newFilterMutation(c.config, OpDelete)
+	return &FilterDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *HobbyOptionClient) DeleteOne(_m *HobbyOption) *HobbyOptionDeleteOne {
+func (c *FilterClient) DeleteOne(_m *Filter) *FilterDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *HobbyOptionClient) DeleteOneID(id string) *HobbyOptionDeleteOne {
-	builder := c.Delete().Where(hobbyoption.ID(id))
+func (c *FilterClient) DeleteOneID(id string) *FilterDeleteOne {
+	builder := c.Delete().Where(filter.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &HobbyOptionDeleteOne{builder}
+	return &FilterDeleteOne{builder}
 }
 
-// Query returns a query builder for HobbyOption.
-func (c *HobbyOptionClient) Query() *HobbyOptionQuery {
-	return &HobbyOptionQuery{
+// Query returns a query builder for Filter.
+func (c *FilterClient) Query() *FilterQuery {
+	return &FilterQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeHobbyOption},
+		ctx:    &QueryContext{Type: TypeFilter},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a HobbyOption entity by its id.
-func (c *HobbyOptionClient) Get(ctx context.Context, id string) (*HobbyOption, error) {
-	return c.Query().Where(hobbyoption.ID(id)).Only(ctx)
+// Get returns a Filter entity by its id.
+func (c *FilterClient) Get(ctx context.Context, id string) (*Filter, error) {
+	return c.Query().Where(filter.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *HobbyOptionClient) GetX(ctx context.Context, id string) *HobbyOption {
+func (c *FilterClient) GetX(ctx context.Context, id string) *Filter {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1934,15 +2750,15 @@ func (c *HobbyOptionClient) GetX(ctx context.Context, id string) *HobbyOption {
 	return obj
 }
 
-// QueryHobbies queries the hobbies edge of a HobbyOption.
-func (c *HobbyOptionClient) QueryHobbies(_m *HobbyOption) *HobbyQuery {
-	query := (&HobbyClient{config: c.config}).Query()
+// QueryUser queries the user edge of a Filter.
+func (c *FilterClient) QueryUser(_m *Filter) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(hobbyoption.Table, hobbyoption.FieldID, id),
-			sqlgraph.To(hobby.Table, hobby.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, hobbyoption.HobbiesTable, hobbyoption.HobbiesColumn),
+			sqlgraph.From(filter.Table, filter.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, filter.UserTable, filter.UserColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1951,131 +2767,131 @@ func (c *HobbyOptionClient) QueryHobbies(_m *HobbyOption) *HobbyQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *HobbyOptionClient) Hooks() []Hook {
-	return c.hooks.HobbyOption
+func (c *FilterClient) Hooks() []Hook {
+	return c.hooks.Filter
 }
 
 // Interceptors returns the client interceptors.
-func (c *HobbyOptionClient) Interceptors() []Interceptor {
-	return c.inters.HobbyOption
+func (c *FilterClient) Interceptors() []Interceptor {
+	return c.inters.Filter
 }
 
-func (c *HobbyOptionClient) mutate(ctx context.Context, m *HobbyOptionMutation) (Value, error) {
+func (c *FilterClient) mutate(ctx context.Context, m *FilterMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&HobbyOptionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&FilterCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&HobbyOptionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&FilterUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&HobbyOptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&FilterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&HobbyOptionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&FilterDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("generated: unknown HobbyOption mutation op: %q", m.Op())
+		return nil, fmt.Errorf("generated: unknown Filter mutation op: %q", m.Op())
 	}
 }
 
-// InterestClient is a client for the Interest schema.
-type InterestClient struct {
+// HobbyClient is a client for the Hobby schema.
+type HobbyClient struct {
 	config
 }
 
-// NewInterestClient returns a client for the Interest from the given config.
-func NewInterestClient(c config) *InterestClient {
-	return &InterestClient{config: c}
+// NewHobbyClient returns a client for the Hobby from the given config.
+func NewHobbyClient(c config) *HobbyClient {
+	return &HobbyClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `interest.Hooks(f(g(h())))`.
-func (c *InterestClient) Use(hooks ...Hook) {
-	c.hooks.Interest = append(c.hooks.Interest, hooks...)
+// A call to `Use(f, g, h)` equals to `hobby.Hooks(f(g(h())))`.
+func (c *HobbyClient) Use(hooks ...Hook) {
+	c.hooks.Hobby = append(c.hooks.Hobby, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `interest.Intercept(f(g(h())))`.
-func (c *InterestClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Interest = append(c.inters.Interest, interceptors...)
-}
-
-// Create returns a builder for creating a Interest entity.
-func (c *InterestClient) Create() *InterestCreate {
-	mutation := newInterestMutation(c.config, OpCreate)
-	return &InterestCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// A call to `Intercept(f, g, h)` equals to `hobby.Intercept(f(g(h())))`.
+func (c *HobbyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Hobby = append(c.inters.Hobby, interceptors...)
 }
 
-// CreateBulk returns a builder for creating a bulk of Interest entities.
-func (c *InterestClient) CreateBulk(builders ...*InterestCreate) *InterestCreateBulk {
-	return &InterestCreateBulk{config: c.config, builders: builders}
+// Create returns a builder for creating a Hobby entity.
+func (c *HobbyClient) Create() *HobbyCreate {
+	mutation := newHobbyMutation(c.config, OpCreate)
+	return &HobbyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Hobby entities.
+func (c *HobbyClient) CreateBulk(builders ...*HobbyCreate) *HobbyCreateBulk {
+	return &HobbyCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *InterestClient) MapCreateBulk(slice any, setFunc func(*InterestCreate, int)) *InterestCreateBulk {
+func (c *HobbyClient) MapCreateBulk(slice any, setFunc func(*HobbyCreate, int)) *HobbyCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &InterestCreateBulk{err: fmt.Errorf("calling to InterestClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &HobbyCreateBulk{err: fmt.Errorf("calling to HobbyClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*InterestCreate, rv.Len())
+	builders := make([]*HobbyCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &InterestCreateBulk{config: c.config, builders: builders}
+	return &HobbyCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Interest.
-func (c *InterestClient) Update() *InterestUpdate {
-	mutation := newInterestMutation(c.config, OpUpdate)
-	return &InterestUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Hobby.
+func (c *HobbyClient) Update() *HobbyUpdate {
+	mutation := newHobbyMutation(c.config, OpUpdate)
+	return &HobbyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *InterestClient) UpdateOne(_m *Interest) *InterestUpdateOne {
-	mutation := newInterestMutation(c.config, OpUpdateOne, withInterest(_m))
-	return &InterestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *HobbyClient) UpdateOne(_m *Hobby) *HobbyUpdateOne {
+	mutation := newHobbyMutation(c.config, OpUpdateOne, withHobby(_m))
+	return &HobbyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *InterestClient) UpdateOneID(id string) *InterestUpdateOne {
-	mutation := newInterestMutation(c.config, OpUpdateOne, withInterestID(id))
-	return &InterestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *HobbyClient) UpdateOneID(id string) *HobbyUpdateOne {
+	mutation := newHobbyMutation(c.config, OpUpdateOne, withHobbyID(id))
+	return &HobbyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Interest.
-func (c *InterestClient) Delete() *InterestDelete {
-	mutation := newInterestMutation(c.config, OpDelete)
-	return &InterestDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Hobby.
+func (c *HobbyClient) Delete() *HobbyDelete {
+	mutation := newHobbyMutation(c.config, OpDelete)
+	return &HobbyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *InterestClient) DeleteOne(_m *Interest) *InterestDeleteOne {
+func (c *HobbyClient) DeleteOne(_m *Hobby) *HobbyDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *InterestClient) DeleteOneID(id string) *InterestDeleteOne {
-	builder := c.Delete().Where(interest.ID(id))
+func (c *HobbyClient) DeleteOneID(id string) *HobbyDeleteOne {
+	builder := c.Delete().Where(hobby.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &InterestDeleteOne{builder}
+	return &HobbyDeleteOne{builder}
 }
 
-// Query returns a query builder for Interest.
-func (c *InterestClient) Query() *InterestQuery {
-	return &InterestQuery{
+// Query returns a query builder for Hobby.
+func (c *HobbyClient) Query() *HobbyQuery {
+	return &HobbyQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeInterest},
+		ctx:    &QueryContext{Type: TypeHobby},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Interest entity by its id.
-func (c *InterestClient) Get(ctx context.Context, id string) (*Interest, error) {
-	return c.Query().Where(interest.ID(id)).Only(ctx)
+// Get returns a Hobby entity by its id.
+func (c *HobbyClient) Get(ctx context.Context, id string) (*Hobby, error) {
+	return c.Query().Where(hobby.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *InterestClient) GetX(ctx context.Context, id string) *Interest {
+func (c *HobbyClient) GetX(ctx context.Context, id string) *Hobby {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2083,31 +2899,15 @@ func (c *InterestClient) GetX(ctx context.Context, id string) *Interest {
 	return obj
 }
 
-// QuerySender queries the sender edge of a Interest.
-func (c *InterestClient) QuerySender(_m *Interest) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(interest.Table, interest.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, interest.SenderTable, interest.SenderColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryReceiver queries the receiver edge of a Interest.
-func (c *InterestClient) QueryReceiver(_m *Interest) *UserQuery {
+// QueryUser queries the user edge of a Hobby.
+func (c *HobbyClient) QueryUser(_m *Hobby) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(interest.Table, interest.FieldID, id),
+			sqlgraph.From(hobby.Table, hobby.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, interest.ReceiverTable, interest.ReceiverColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, hobby.UserTable, hobby.UserColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -2115,15 +2915,15 @@ func (c *InterestClient) QueryReceiver(_m *Interest) *UserQuery {
 	return query
 }
 
-// QueryDiscoveryCard queries the discovery_card edge of a Interest.
-func (c *InterestClient) QueryDiscoveryCard(_m *Interest) *DiscoveryCardQuery {
-	query := (&DiscoveryCardClient{config: c.config}).Query()
+// QueryHobbyOption queries the hobby_option edge of a Hobby.
+func (c *HobbyClient) QueryHobbyOption(_m *Hobby) *HobbyOptionQuery {
+	query := (&HobbyOptionClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(interest.Table, interest.FieldID, id),
-			sqlgraph.To(discoverycard.Table, discoverycard.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, interest.DiscoveryCardTable, interest.DiscoveryCardColumn),
+			sqlgraph.From(hobby.Table, hobby.FieldID, id),
+			sqlgraph.To(hobbyoption.Table, hobbyoption.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, hobby.HobbyOptionTable, hobby.HobbyOptionColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -2132,131 +2932,131 @@ func (c *InterestClient) QueryDiscoveryCard(_m *Interest) *DiscoveryCardQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *InterestClient) Hooks() []Hook {
-	return c.hooks.Interest
+func (c *HobbyClient) Hooks() []Hook {
+	return c.hooks.Hobby
 }
 
 // Interceptors returns the client interceptors.
-func (c *InterestClient) Interceptors() []Interceptor {
-	return c.inters.Interest
+func (c *HobbyClient) Interceptors() []Interceptor {
+	return c.inters.Hobby
 }
 
-func (c *InterestClient) mutate(ctx context.Context, m *InterestMutation) (Value, error) {
+func (c *HobbyClient) mutate(ctx context.Context, m *HobbyMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&InterestCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&HobbyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&InterestUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&HobbyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&InterestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&HobbyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&InterestDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&HobbyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("generated: unknown Interest mutation op: %q", m.Op())
+		return nil, fmt.Errorf("generated: unknown Hobby mutation op: %q", m.Op())
 	}
 }
 
-// NudgeClient is a client for the Nudge schema.
-type NudgeClient struct {
+// HobbyOptionClient is a client for the HobbyOption schema.
+type HobbyOptionClient struct {
 	config
 }
 
-// NewNudgeClient returns a client for the Nudge from the given config.
-func NewNudgeClient(c config) *NudgeClient {
-	return &NudgeClient{config: c}
+// NewHobbyOptionClient returns a client for the HobbyOption from the given config.
+func NewHobbyOptionClient(c config) *HobbyOptionClient {
+	return &HobbyOptionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `nudge.Hooks(f(g(h())))`.
-func (c *NudgeClient) Use(hooks ...Hook) {
-	c.hooks.Nudge = append(c.hooks.Nudge, hooks...)
+// A call to `Use(f, g, h)` equals to `hobbyoption.Hooks(f(g(h())))`.
+func (c *HobbyOptionClient) Use(hooks ...Hook) {
+	c.hooks.HobbyOption = append(c.hooks.HobbyOption, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `nudge.Intercept(f(g(h())))`.
-func (c *NudgeClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Nudge = append(c.inters.Nudge, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `hobbyoption.Intercept(f(g(h())))`.
+func (c *HobbyOptionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.HobbyOption = append(c.inters.HobbyOption, interceptors...)
 }
 
-// Create returns a builder for creating a Nudge entity.
-func (c *NudgeClient) Create() *NudgeCreate {
-	mutation := newNudgeMutation(c.config, OpCreate)
-	return &NudgeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a HobbyOption entity.
+func (c *HobbyOptionClient) Create() *HobbyOptionCreate {
+	mutation := newHobbyOptionMutation(c.config, OpCreate)
+	return &HobbyOptionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Nudge entities.
-func (c *NudgeClient) CreateBulk(builders ...*NudgeCreate) *NudgeCreateBulk {
-	return &NudgeCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of HobbyOption entities.
+func (c *HobbyOptionClient) CreateBulk(builders ...*HobbyOptionCreate) *HobbyOptionCreateBulk {
+	return &HobbyOptionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *NudgeClient) MapCreateBulk(slice any, setFunc func(*NudgeCreate, int)) *NudgeCreateBulk {
+func (c *HobbyOptionClient) MapCreateBulk(slice any, setFunc func(*HobbyOptionCreate, int)) *HobbyOptionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &NudgeCreateBulk{err: fmt.Errorf("calling to NudgeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &HobbyOptionCreateBulk{err: fmt.Errorf("calling to HobbyOptionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*NudgeCreate, rv.Len())
+	builders := make([]*HobbyOptionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &NudgeCreateBulk{config: c.config, builders: builders}
+	return &HobbyOptionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Nudge.
-func (c *NudgeClient) Update() *NudgeUpdate {
-	mutation := newNudgeMutation(c.config, OpUpdate)
-	return &NudgeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for HobbyOption.
+func (c *HobbyOptionClient) Update() *HobbyOptionUpdate {
+	mutation := newHobbyOptionMutation(c.config, OpUpdate)
+	return &HobbyOptionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *NudgeClient) UpdateOne(_m *Nudge) *NudgeUpdateOne {
-	mutation := newNudgeMutation(c.config, OpUpdateOne, withNudge(_m))
-	return &NudgeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *HobbyOptionClient) UpdateOne(_m *HobbyOption) *HobbyOptionUpdateOne {
+	mutation := newHobbyOptionMutation(c.config, OpUpdateOne, withHobbyOption(_m))
+	return &HobbyOptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *NudgeClient) UpdateOneID(id string) *NudgeUpdateOne {
-	mutation := newNudgeMutation(c.config, OpUpdateOne, withNudgeID(id))
-	return &NudgeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *HobbyOptionClient) UpdateOneID(id string) *HobbyOptionUpdateOne {
+	mutation := newHobbyOptionMutation(c.config, OpUpdateOne, withHobbyOptionID(id))
+	return &HobbyOptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Nudge.
-func (c *NudgeClient) Delete() *NudgeDelete {
-	mutation := newNudgeMutation(c.config, OpDelete)
-	return &NudgeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for HobbyOption.
+func (c *HobbyOptionClient) Delete() *HobbyOptionDelete {
+	mutation := newHobbyOptionMutation(c.config, OpDelete)
+	return &HobbyOptionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *NudgeClient) DeleteOne(_m *Nudge) *NudgeDeleteOne {
+func (c *HobbyOptionClient) DeleteOne(_m *HobbyOption) *HobbyOptionDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *NudgeClient) DeleteOneID(id string) *NudgeDeleteOne {
-	builder := c.Delete().Where(nudge.ID(id))
+func (c *HobbyOptionClient) DeleteOneID(id string) *HobbyOptionDeleteOne {
+	builder := c.Delete().Where(hobbyoption.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &NudgeDeleteOne{builder}
+	return &HobbyOptionDeleteOne{builder}
 }
 
-// Query returns a query builder for Nudge.
-func (c *NudgeClient) Query() *NudgeQuery {
-	return &NudgeQuery{
+// Query returns a query builder for HobbyOption.
+func (c *HobbyOptionClient) Query() *HobbyOptionQuery {
+	return &HobbyOptionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeNudge},
+		ctx:    &QueryContext{Type: TypeHobbyOption},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Nudge entity by its id.
-func (c *NudgeClient) Get(ctx context.Context, id string) (*Nudge, error) {
-	return c.Query().Where(nudge.ID(id)).Only(ctx)
+// Get returns a HobbyOption entity by its id.
+func (c *HobbyOptionClient) Get(ctx context.Context, id string) (*HobbyOption, error) {
+	return c.Query().Where(hobbyoption.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *NudgeClient) GetX(ctx context.Context, id string) *Nudge {
+func (c *HobbyOptionClient) GetX(ctx context.Context, id string) *HobbyOption {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2264,47 +3064,15 @@ func (c *NudgeClient) GetX(ctx context.Context, id string) *Nudge {
 	return obj
 }
 
-// QueryStreak queries the streak edge of a Nudge.
-func (c *NudgeClient) QueryStreak(_m *Nudge) *StreakQuery {
-	query := (&StreakClient{config: c.config}).Query()
+// QueryHobbies queries the hobbies edge of a HobbyOption.
+func (c *HobbyOptionClient) QueryHobbies(_m *HobbyOption) *HobbyQuery {
+	query := (&HobbyClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(nudge.Table, nudge.FieldID, id),
-			sqlgraph.To(streak.Table, streak.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, nudge.StreakTable, nudge.StreakColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QuerySender queries the sender edge of a Nudge.
-func (c *NudgeClient) QuerySender(_m *Nudge) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(nudge.Table, nudge.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, nudge.SenderTable, nudge.SenderColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryReceiver queries the receiver edge of a Nudge.
-func (c *NudgeClient) QueryReceiver(_m *Nudge) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(nudge.Table, nudge.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, nudge.ReceiverTable, nudge.ReceiverColumn),
+			sqlgraph.From(hobbyoption.Table, hobbyoption.FieldID, id),
+			sqlgraph.To(hobby.Table, hobby.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, hobbyoption.HobbiesTable, hobbyoption.HobbiesColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -2313,131 +3081,131 @@ func (c *NudgeClient) QueryReceiver(_m *Nudge) *UserQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *NudgeClient) Hooks() []Hook {
-	return c.hooks.Nudge
+func (c *HobbyOptionClient) Hooks() []Hook {
+	return c.hooks.HobbyOption
 }
 
 // Interceptors returns the client interceptors.
-func (c *NudgeClient) Interceptors() []Interceptor {
-	return c.inters.Nudge
+func (c *HobbyOptionClient) Interceptors() []Interceptor {
+	return c.inters.HobbyOption
 }
 
-func (c *NudgeClient) mutate(ctx context.Context, m *NudgeMutation) (Value, error) {
+func (c *HobbyOptionClient) mutate(ctx context.Context, m *HobbyOptionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&NudgeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&HobbyOptionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&NudgeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&HobbyOptionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&NudgeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&HobbyOptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&NudgeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&HobbyOptionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("generated: unknown Nudge mutation op: %q", m.Op())
+		return nil, fmt.Errorf("generated: unknown HobbyOption mutation op: %q", m.Op())
 	}
 }
 
-// PaymentOrderClient is a client for the PaymentOrder schema.
-type PaymentOrderClient struct {
+// InterestClient is a client for the Interest schema.
+type InterestClient struct {
 	config
 }
 
-// NewPaymentOrderClient returns a client for the PaymentOrder from the given config.
-func NewPaymentOrderClient(c config) *PaymentOrderClient {
-	return &PaymentOrderClient{config: c}
+// NewInterestClient returns a client for the Interest from the given config.
+func NewInterestClient(c config) *InterestClient {
+	return &InterestClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `paymentorder.Hooks(f(g(h())))`.
-func (c *PaymentOrderClient) Use(hooks ...Hook) {
-	c.hooks.PaymentOrder = append(c.hooks.PaymentOrder, hooks...)
+// A call to `Use(f, g, h)` equals to `interest.Hooks(f(g(h())))`.
+func (c *InterestClient) Use(hooks ...Hook) {
+	c.hooks.Interest = append(c.hooks.Interest, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `paymentorder.Intercept(f(g(h())))`.
-func (c *PaymentOrderClient) Intercept(interceptors ...Interceptor) {
-	c.inters.PaymentOrder = append(c.inters.PaymentOrder, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `interest.Intercept(f(g(h())))`.
+func (c *InterestClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Interest = append(c.inters.Interest, interceptors...)
 }
 
-// Create returns a builder for creating a PaymentOrder entity.
-func (c *PaymentOrderClient) Create() *PaymentOrderCreate {
-	mutation := newPaymentOrderMutation(c.config, OpCreate)
-	return &PaymentOrderCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Interest entity.
+func (c *InterestClient) Create() *InterestCreate {
+	mutation := newInterestMutation(c.config, OpCreate)
+	return &InterestCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of PaymentOrder entities.
-func (c *PaymentOrderClient) CreateBulk(builders ...*PaymentOrderCreate) *PaymentOrderCreateBulk {
-	return &PaymentOrderCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Interest entities.
+func (c *InterestClient) CreateBulk(builders ...*InterestCreate) *InterestCreateBulk {
+	return &InterestCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PaymentOrderClient) MapCreateBulk(slice any, setFunc func(*PaymentOrderCreate, int)) *PaymentOrderCreateBulk {
+func (c *InterestClient) MapCreateBulk(slice any, setFunc func(*InterestCreate, int)) *InterestCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PaymentOrderCreateBulk{err: fmt.Errorf("calling to PaymentOrderClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &InterestCreateBulk{err: fmt.Errorf("calling to InterestClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PaymentOrderCreate, rv.Len())
+	builders := make([]*InterestCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PaymentOrderCreateBulk{config: c.config, builders: builders}
+	return &InterestCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for PaymentOrder.
-func (c *PaymentOrderClient) Update() *PaymentOrderUpdate {
-	mutation := newPaymentOrderMutation(c.config, OpUpdate)
-	return &PaymentOrderUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Interest.
+func (c *InterestClient) Update() *InterestUpdate {
+	mutation := newInterestMutation(c.config, OpUpdate)
+	return &InterestUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PaymentOrderClient) UpdateOne(_m *PaymentOrder) *PaymentOrderUpdateOne {
-	mutation := newPaymentOrderMutation(c.config, OpUpdateOne, withPaymentOrder(_m))
-	return &PaymentOrderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *InterestClient) UpdateOne(_m *Interest) *InterestUpdateOne {
+	mutation := newInterestMutation(c.config, OpUpdateOne, withInterest(_m))
+	return &InterestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *PaymentOrderClient) UpdateOneID(id string) *PaymentOrderUpdateOne {
-	mutation := newPaymentOrderMutation(c.config, OpUpdateOne, withPaymentOrderID(id))
-	return &PaymentOrderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *InterestClient) UpdateOneID(id string) *InterestUpdateOne {
+	mutation := newInterestMutation(c.config, OpUpdateOne, withInterestID(id))
+	return &InterestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for PaymentOrder.
-func (c *PaymentOrderClient) Delete() *PaymentOrderDelete {
-	mutation := newPaymentOrderMutation(c.config, OpDelete)
-	return &PaymentOrderDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Interest.
+func (c *InterestClient) Delete() *InterestDelete {
+	mutation := newInterestMutation(c.config, OpDelete)
+	return &InterestDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PaymentOrderClient) DeleteOne(_m *PaymentOrder) *PaymentOrderDeleteOne {
+func (c *InterestClient) DeleteOne(_m *Interest) *InterestDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PaymentOrderClient) DeleteOneID(id string) *PaymentOrderDeleteOne {
-	builder := c.Delete().Where(paymentorder.ID(id))
+func (c *InterestClient) DeleteOneID(id string) *InterestDeleteOne {
+	builder := c.Delete().Where(interest.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PaymentOrderDeleteOne{builder}
+	return &InterestDeleteOne{builder}
 }
 
-// Query returns a query builder for PaymentOrder.
-func (c *PaymentOrderClient) Query() *PaymentOrderQuery {
-	return &PaymentOrderQuery{
+// Query returns a query builder for Interest.
+func (c *InterestClient) Query() *InterestQuery {
+	return &InterestQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePaymentOrder},
+		ctx:    &QueryContext{Type: TypeInterest},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a PaymentOrder entity by its id.
-func (c *PaymentOrderClient) Get(ctx context.Context, id string) (*PaymentOrder, error) {
-	return c.Query().Where(paymentorder.ID(id)).Only(ctx)
+// Get returns a Interest entity by its id.
+func (c *InterestClient) Get(ctx context.Context, id string) (*Interest, error) {
+	return c.Query().Where(interest.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *PaymentOrderClient) GetX(ctx context.Context, id string) *PaymentOrder {
+func (c *InterestClient) GetX(ctx context.Context, id string) *Interest {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2445,15 +3213,47 @@ func (c *PaymentOrderClient) GetX(ctx context.Context, id string) *PaymentOrder
 	return obj
 }
 
-// QueryUser queries the user edge of a PaymentOrder.
-func (c *PaymentOrderClient) QueryUser(_m *PaymentOrder) *UserQuery {
+// QuerySender queries the sender edge of a Interest.
+func (c *InterestClient) QuerySender(_m *Interest) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(paymentorder.Table, paymentorder.FieldID, id),
+			sqlgraph.From(interest.Table, interest.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, paymentorder.UserTable, paymentorder.UserColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, interest.SenderTable, interest.SenderColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReceiver queries the receiver edge of a Interest.
+func (c *InterestClient) QueryReceiver(_m *Interest) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(interest.Table, interest.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, interest.ReceiverTable, interest.ReceiverColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDiscoveryCard queries the discovery_card edge of a Interest.
+func (c *InterestClient) QueryDiscoveryCard(_m *Interest) *DiscoveryCardQuery {
+	query := (&DiscoveryCardClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(interest.Table, interest.FieldID, id),
+			sqlgraph.To(discoverycard.Table, discoverycard.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, interest.DiscoveryCardTable, interest.DiscoveryCardColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -2462,131 +3262,131 @@ func (c *PaymentOrderClient) QueryUser(_m *PaymentOrder) *UserQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *PaymentOrderClient) Hooks() []Hook {
-	return c.hooks.PaymentOrder
+func (c *InterestClient) Hooks() []Hook {
+	return c.hooks.Interest
 }
 
 // Interceptors returns the client interceptors.
-func (c *PaymentOrderClient) Interceptors() []Interceptor {
-	return c.inters.PaymentOrder
+func (c *InterestClient) Interceptors() []Interceptor {
+	return c.inters.Interest
 }
 
-func (c *PaymentOrderClient) mutate(ctx context.Context, m *PaymentOrderMutation) (Value, error) {
+func (c *InterestClient) mutate(ctx context.Context, m *InterestMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PaymentOrderCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&InterestCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PaymentOrderUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&InterestUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PaymentOrderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&InterestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PaymentOrderDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&InterestDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("generated: unknown PaymentOrder mutation op: %q", m.Op())
+		return nil, fmt.Errorf("generated: unknown Interest mutation op: %q", m.Op())
 	}
 }
 
-// PhotoClient is a client for the Photo schema.
-type PhotoClient struct {
+// InvoiceClient is a client for the Invoice schema.
+type InvoiceClient struct {
 	config
 }
 
-// NewPhotoClient returns a client for the Photo from the given config.
-func NewPhotoClient(c config) *PhotoClient {
-	return &PhotoClient{config: c}
+// NewInvoiceClient returns a client for the Invoice from the given config.
+func NewInvoiceClient(c config) *InvoiceClient {
+	return &InvoiceClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `photo.Hooks(f(g(h())))`.
-func (c *PhotoClient) Use(hooks ...Hook) {
-	c.hooks.Photo = append(c.hooks.Photo, hooks...)
+// A call to `Use(f, g, h)` equals to `invoice.Hooks(f(g(h())))`.
+func (c *InvoiceClient) Use(hooks ...Hook) {
+	c.hooks.Invoice = append(c.hooks.Invoice, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `photo.Intercept(f(g(h())))`.
-func (c *PhotoClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Photo = append(c.inters.Photo, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `invoice.Intercept(f(g(h())))`.
+func (c *InvoiceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Invoice = append(c.inters.Invoice, interceptors...)
 }
 
-// Create returns a builder for creating a Photo entity.
-func (c *PhotoClient) Create() *PhotoCreate {
-	mutation := newPhotoMutation(c.config, OpCreate)
-	return &PhotoCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Invoice entity.
+func (c *InvoiceClient) Create() *InvoiceCreate {
+	mutation := newInvoiceMutation(c.config, OpCreate)
+	return &InvoiceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Photo entities.
-func (c *PhotoClient) CreateBulk(builders ...*PhotoCreate) *PhotoCreateBulk {
-	return &PhotoCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Invoice entities.
+func (c *InvoiceClient) CreateBulk(builders ...*InvoiceCreate) *InvoiceCreateBulk {
+	return &InvoiceCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PhotoClient) MapCreateBulk(slice any, setFunc func(*PhotoCreate, int)) *PhotoCreateBulk {
+func (c *InvoiceClient) MapCreateBulk(slice any, setFunc func(*InvoiceCreate, int)) *InvoiceCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PhotoCreateBulk{err: fmt.Errorf("calling to PhotoClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &InvoiceCreateBulk{err: fmt.Errorf("calling to InvoiceClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PhotoCreate, rv.Len())
+	builders := make([]*InvoiceCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PhotoCreateBulk{config: c.config, builders: builders}
+	return &InvoiceCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Photo.
-func (c *PhotoClient) Update() *PhotoUpdate {
-	mutation := newPhotoMutation(c.config, OpUpdate)
-	return &PhotoUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Invoice.
+func (c *InvoiceClient) Update() *InvoiceUpdate {
+	mutation := newInvoiceMutation(c.config, OpUpdate)
+	return &InvoiceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PhotoClient) UpdateOne(_m *Photo) *PhotoUpdateOne {
-	mutation := newPhotoMutation(c.config, OpUpdateOne, withPhoto(_m))
-	return &PhotoUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *InvoiceClient) UpdateOne(_m *Invoice) *InvoiceUpdateOne {
+	mutation := newInvoiceMutation(c.config, OpUpdateOne, withInvoice(_m))
+	return &InvoiceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *PhotoClient) UpdateOneID(id string) *PhotoUpdateOne {
-	mutation := newPhotoMutation(c.config, OpUpdateOne, withPhotoID(id))
-	return &PhotoUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *InvoiceClient) UpdateOneID(id string) *InvoiceUpdateOne {
+	mutation := newInvoiceMutation(c.config, OpUpdateOne, withInvoiceID(id))
+	return &InvoiceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Photo.
-func (c *PhotoClient) Delete() *PhotoDelete {
-	mutation := newPhotoMutation(c.config, OpDelete)
-	return &PhotoDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Invoice.
+func (c *InvoiceClient) Delete() *InvoiceDelete {
+	mutation := newInvoiceMutation(c.config, OpDelete)
+	return &InvoiceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PhotoClient) DeleteOne(_m *Photo) *PhotoDeleteOne {
+func (c *InvoiceClient) DeleteOne(_m *Invoice) *InvoiceDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PhotoClient) DeleteOneID(id string) *PhotoDeleteOne {
-	builder := c.Delete().Where(photo.ID(id))
+func (c *InvoiceClient) DeleteOneID(id string) *InvoiceDeleteOne {
+	builder := c.Delete().Where(invoice.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PhotoDeleteOne{builder}
+	return &InvoiceDeleteOne{builder}
 }
 
-// Query returns a query builder for Photo.
-func (c *PhotoClient) Query() *PhotoQuery {
-	return &PhotoQuery{
+// Query returns a query builder for Invoice.
+func (c *InvoiceClient) Query() *InvoiceQuery {
+	return &InvoiceQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePhoto},
+		ctx:    &QueryContext{Type: TypeInvoice},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Photo entity by its id.
-func (c *PhotoClient) Get(ctx context.Context, id string) (*Photo, error) {
-	return c.Query().Where(photo.ID(id)).Only(ctx)
+// Get returns a Invoice entity by its id.
+func (c *InvoiceClient) Get(ctx context.Context, id string) (*Invoice, error) {
+	return c.Query().Where(invoice.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *PhotoClient) GetX(ctx context.Context, id string) *Photo {
+func (c *InvoiceClient) GetX(ctx context.Context, id string) *Invoice {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2594,15 +3394,15 @@ func (c *PhotoClient) GetX(ctx context.Context, id string) *Photo {
 	return obj
 }
 
-// QueryUser queries the user edge of a Photo.
-func (c *PhotoClient) QueryUser(_m *Photo) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
+// QueryOrder queries the order edge of a Invoice.
+func (c *InvoiceClient) QueryOrder(_m *Invoice) *PaymentOrderQuery {
+	query := (&PaymentOrderClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(photo.Table, photo.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, photo.UserTable, photo.UserColumn),
+			sqlgraph.From(invoice.Table, invoice.FieldID, id),
+			sqlgraph.To(paymentorder.Table, paymentorder.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, invoice.OrderTable, invoice.OrderColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -2611,131 +3411,131 @@ func (c *PhotoClient) QueryUser(_m *Photo) *UserQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *PhotoClient) Hooks() []Hook {
-	return c.hooks.Photo
+func (c *InvoiceClient) Hooks() []Hook {
+	return c.hooks.Invoice
 }
 
 // Interceptors returns the client interceptors.
-func (c *PhotoClient) Interceptors() []Interceptor {
-	return c.inters.Photo
+func (c *InvoiceClient) Interceptors() []Interceptor {
+	return c.inters.Invoice
 }
 
-func (c *PhotoClient) mutate(ctx context.Context, m *PhotoMutation) (Value, error) {
+func (c *InvoiceClient) mutate(ctx context.Context, m *InvoiceMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PhotoCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&InvoiceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PhotoUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&InvoiceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PhotoUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&InvoiceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PhotoDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&InvoiceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("generated: unknown Photo mutation op: %q", m.Op())
+		return nil, fmt.Errorf("generated: unknown Invoice mutation op: %q", m.Op())
 	}
 }
 
-// ProfileClient is a client for the Profile schema.
-type ProfileClient struct {
+// InvoiceSequenceClient is a client for the InvoiceSequence schema.
+type InvoiceSequenceClient struct {
 	config
 }
 
-// NewProfileClient returns a client for the Profile from the given config.
-func NewProfileClient(c config) *ProfileClient {
-	return &ProfileClient{config: c}
+// NewInvoiceSequenceClient returns a client for the InvoiceSequence from the given config.
+func NewInvoiceSequenceClient(c config) *InvoiceSequenceClient {
+	return &InvoiceSequenceClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `profile.Hooks(f(g(h())))`.
-func (c *ProfileClient) Use(hooks ...Hook) {
-	c.hooks.Profile = append(c.hooks.Profile, hooks...)
+// A call to `Use(f, g, h)` equals to `invoicesequence.Hooks(f(g(h())))`.
+func (c *InvoiceSequenceClient) Use(hooks ...Hook) {
+	c.hooks.InvoiceSequence = append(c.hooks.InvoiceSequence, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `profile.Intercept(f(g(h())))`.
-func (c *ProfileClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Profile = append(c.inters.Profile, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `invoicesequence.Intercept(f(g(h())))`.
+func (c *InvoiceSequenceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.InvoiceSequence = append(c.inters.InvoiceSequence, interceptors...)
 }
 
-// Create returns a builder for creating a Profile entity.
-func (c *ProfileClient) Create() *ProfileCreate {
-	mutation := newProfileMutation(c.config, OpCreate)
-	return &ProfileCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a InvoiceSequence entity.
+func (c *InvoiceSequenceClient) Create() *InvoiceSequenceCreate {
+	mutation := newInvoiceSequenceMutation(c.config, OpCreate)
+	return &InvoiceSequenceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Profile entities.
-func (c *ProfileClient) CreateBulk(builders ...*ProfileCreate) *ProfileCreateBulk {
-	return &ProfileCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of InvoiceSequence entities.
+func (c *InvoiceSequenceClient) CreateBulk(builders ...*InvoiceSequenceCreate) *InvoiceSequenceCreateBulk {
+	return &InvoiceSequenceCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ProfileClient) MapCreateBulk(slice any, setFunc func(*ProfileCreate, int)) *ProfileCreateBulk {
+func (c *InvoiceSequenceClient) MapCreateBulk(slice any, setFunc func(*InvoiceSequenceCreate, int)) *InvoiceSequenceCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ProfileCreateBulk{err: fmt.Errorf("calling to ProfileClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &InvoiceSequenceCreateBulk{err: fmt.Errorf("calling to InvoiceSequenceClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ProfileCreate, rv.Len())
+	builders := make([]*InvoiceSequenceCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ProfileCreateBulk{config: c.config, builders: builders}
+	return &InvoiceSequenceCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Profile.
-func (c *ProfileClient) Update() *ProfileUpdate {
-	mutation := newProfileMutation(c.config, OpUpdate)
-	return &ProfileUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for InvoiceSequence.
+func (c *InvoiceSequenceClient) Update() *InvoiceSequenceUpdate {
+	mutation := newInvoiceSequenceMutation(c.config, OpUpdate)
+	return &InvoiceSequenceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ProfileClient) UpdateOne(_m *Profile) *ProfileUpdateOne {
-	mutation := newProfileMutation(c.config, OpUpdateOne, withProfile(_m))
-	return &ProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *InvoiceSequenceClient) UpdateOne(_m *InvoiceSequence) *InvoiceSequenceUpdateOne {
+	mutation := newInvoiceSequenceMutation(c.config, OpUpdateOne, withInvoiceSequence(_m))
+	return &InvoiceSequenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ProfileClient) UpdateOneID(id string) *ProfileUpdateOne {
-	mutation := newProfileMutation(c.config, OpUpdateOne, withProfileID(id))
-	return &ProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *InvoiceSequenceClient) UpdateOneID(id string) *InvoiceSequenceUpdateOne {
+	mutation := newInvoiceSequenceMutation(c.config, OpUpdateOne, withInvoiceSequenceID(id))
+	return &InvoiceSequenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Profile.
-func (c *ProfileClient) Delete() *ProfileDelete {
-	mutation := newProfileMutation(c.config, OpDelete)
-	return &ProfileDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for InvoiceSequence.
+func (c *InvoiceSequenceClient) Delete() *InvoiceSequenceDelete {
+	mutation := newInvoiceSequenceMutation(c.config, OpDelete)
+	return &InvoiceSequenceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ProfileClient) DeleteOne(_m *Profile) *ProfileDeleteOne {
+func (c *InvoiceSequenceClient) DeleteOne(_m *InvoiceSequence) *InvoiceSequenceDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ProfileClient) DeleteOneID(id string) *ProfileDeleteOne {
-	builder := c.Delete().Where(profile.ID(id))
+func (c *InvoiceSequenceClient) DeleteOneID(id string) *InvoiceSequenceDeleteOne {
+	builder := c.Delete().Where(invoicesequence.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ProfileDeleteOne{builder}
+	return &InvoiceSequenceDeleteOne{builder}
 }
 
-// Query returns a query builder for Profile.
-func (c *ProfileClient) Query() *ProfileQuery {
-	return &ProfileQuery{
+// Query returns a query builder for InvoiceSequence.
+func (c *InvoiceSequenceClient) Query() *InvoiceSequenceQuery {
+	return &InvoiceSequenceQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeProfile},
+		ctx:    &QueryContext{Type: TypeInvoiceSequence},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Profile entity by its id.
-func (c *ProfileClient) Get(ctx context.Context, id string) (*Profile, error) {
-	return c.Query().Where(profile.ID(id)).Only(ctx)
+// Get returns a InvoiceSequence entity by its id.
+func (c *InvoiceSequenceClient) Get(ctx context.Context, id string) (*InvoiceSequence, error) {
+	return c.Query().Where(invoicesequence.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ProfileClient) GetX(ctx context.Context, id string) *Profile {
+func (c *InvoiceSequenceClient) GetX(ctx context.Context, id string) *InvoiceSequence {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2743,148 +3543,132 @@ func (c *ProfileClient) GetX(ctx context.Context, id string) *Profile {
 	return obj
 }
 
-// QueryUser queries the user edge of a Profile.
-func (c *ProfileClient) QueryUser(_m *Profile) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(profile.Table, profile.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, true, profile.UserTable, profile.UserColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *ProfileClient) Hooks() []Hook {
-	return c.hooks.Profile
+func (c *InvoiceSequenceClient) Hooks() []Hook {
+	return c.hooks.InvoiceSequence
 }
 
 // Interceptors returns the client interceptors.
-func (c *ProfileClient) Interceptors() []Interceptor {
-	return c.inters.Profile
+func (c *InvoiceSequenceClient) Interceptors() []Interceptor {
+	return c.inters.InvoiceSequence
 }
 
-func (c *ProfileClient) mutate(ctx context.Context, m *ProfileMutation) (Value, error) {
+func (c *InvoiceSequenceClient) mutate(ctx context.Context, m *InvoiceSequenceMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ProfileCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&InvoiceSequenceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ProfileUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&InvoiceSequenceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&InvoiceSequenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ProfileDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&InvoiceSequenceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("generated: unknown Profile mutation op: %q", m.Op())
+		return nil, fmt.Errorf("generated: unknown InvoiceSequence mutation op: %q", m.Op())
 	}
 }
 
-// RevealClient is a client for the Reveal schema.
-type RevealClient struct {
+// LedgerEntryClient is a client for the LedgerEntry schema.
+type LedgerEntryClient struct {
 	config
 }
 
-// NewRevealClient returns a client for the Reveal from the given config.
-func NewRevealClient(c config) *RevealClient {
-	return &RevealClient{config: c}
+// NewLedgerEntryClient returns a client for the LedgerEntry from the given config.
+func NewLedgerEntryClient(c config) *LedgerEntryClient {
+	return &LedgerEntryClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `reveal.Hooks(f(g(h())))`.
-func (c *RevealClient) Use(hooks ...Hook) {
-	c.hooks.Reveal = append(c.hooks.Reveal, hooks...)
+// A call to `Use(f, g, h)` equals to `ledgerentry.Hooks(f(g(h())))`.
+func (c *LedgerEntryClient) Use(hooks ...Hook) {
+	c.hooks.LedgerEntry = append(c.hooks.LedgerEntry, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `reveal.Intercept(f(g(h())))`.
-func (c *RevealClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Reveal = append(c.inters.Reveal, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `ledgerentry.Intercept(f(g(h())))`.
+func (c *LedgerEntryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LedgerEntry = append(c.inters.LedgerEntry, interceptors...)
 }
 
-// Create returns a builder for creating a Reveal entity.
-func (c *RevealClient) Create() *RevealCreate {
-	mutation := newRevealMutation(c.config, OpCreate)
-	return &RevealCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a LedgerEntry entity.
+func (c *LedgerEntryClient) Create() *LedgerEntryCreate {
+	mutation := newLedgerEntryMutation(c.config, OpCreate)
+	return &LedgerEntryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Reveal entities.
-func (c *RevealClient) CreateBulk(builders ...*RevealCreate) *RevealCreateBulk {
-	return &RevealCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of LedgerEntry entities.
+func (c *LedgerEntryClient) CreateBulk(builders ...*LedgerEntryCreate) *LedgerEntryCreateBulk {
+	return &LedgerEntryCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *RevealClient) MapCreateBulk(slice any, setFunc func(*RevealCreate, int)) *RevealCreateBulk {
+func (c *LedgerEntryClient) MapCreateBulk(slice any, setFunc func(*LedgerEntryCreate, int)) *LedgerEntryCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &RevealCreateBulk{err: fmt.Errorf("calling to RevealClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &LedgerEntryCreateBulk{err: fmt.Errorf("calling to LedgerEntryClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*RevealCreate, rv.Len())
+	builders := make([]*LedgerEntryCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &RevealCreateBulk{config: c.config, builders: builders}
+	return &LedgerEntryCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Reveal.
-func (c *RevealClient) Update() *RevealUpdate {
-	mutation := newRevealMutation(c.config, OpUpdate)
-	return &RevealUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for LedgerEntry.
+func (c *LedgerEntryClient) Update() *LedgerEntryUpdate {
+	mutation := newLedgerEntryMutation(c.config, OpUpdate)
+	return &LedgerEntryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *RevealClient) UpdateOne(_m *Reveal) *RevealUpdateOne {
-	mutation := newRevealMutation(c.config, OpUpdateOne, withReveal(_m))
-	return &RevealUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *LedgerEntryClient) UpdateOne(_m *LedgerEntry) *LedgerEntryUpdateOne {
+	mutation := newLedgerEntryMutation(c.config, OpUpdateOne, withLedgerEntry(_m))
+	return &LedgerEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *RevealClient) UpdateOneID(id string) *RevealUpdateOne {
-	mutation := newRevealMutation(c.config, OpUpdateOne, withRevealID(id))
-	return &RevealUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *LedgerEntryClient) UpdateOneID(id string) *LedgerEntryUpdateOne {
+	mutation := newLedgerEntryMutation(c.config, OpUpdateOne, withLedgerEntryID(id))
+	return &LedgerEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Reveal.
-func (c *RevealClient) Delete() *RevealDelete {
-	mutation := newRevealMutation(c.config, OpDelete)
-	return &RevealDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for LedgerEntry.
+func (c *LedgerEntryClient) Delete() *LedgerEntryDelete {
+	mutation := newLedgerEntryMutation(c.config, OpDelete)
+	return &LedgerEntryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *RevealClient) DeleteOne(_m *Reveal) *RevealDeleteOne {
+func (c *LedgerEntryClient) DeleteOne(_m *LedgerEntry) *LedgerEntryDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *RevealClient) DeleteOneID(id string) *RevealDeleteOne {
-	builder := c.Delete().Where(reveal.ID(id))
+func (c *LedgerEntryClient) DeleteOneID(id string) *LedgerEntryDeleteOne {
+	builder := c.Delete().Where(ledgerentry.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &RevealDeleteOne{builder}
+	return &LedgerEntryDeleteOne{builder}
 }
 
-// Query returns a query builder for Reveal.
-func (c *RevealClient) Query() *RevealQuery {
-	return &RevealQuery{
+// Query returns a query builder for LedgerEntry.
+func (c *LedgerEntryClient) Query() *LedgerEntryQuery {
+	return &LedgerEntryQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeReveal},
+		ctx:    &QueryContext{Type: TypeLedgerEntry},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Reveal entity by its id.
-func (c *RevealClient) Get(ctx context.Context, id string) (*Reveal, error) {
-	return c.Query().Where(reveal.ID(id)).Only(ctx)
+// Get returns a LedgerEntry entity by its id.
+func (c *LedgerEntryClient) Get(ctx context.Context, id string) (*LedgerEntry, error) {
+	return c.Query().Where(ledgerentry.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *RevealClient) GetX(ctx context.Context, id string) *Reveal {
+func (c *LedgerEntryClient) GetX(ctx context.Context, id string) *LedgerEntry {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2892,47 +3676,15 @@ func (c *RevealClient) GetX(ctx context.Context, id string) *Reveal {
 	return obj
 }
 
-// QueryConnection queries the connection edge of a Reveal.
-func (c *RevealClient) QueryConnection(_m *Reveal) *ConnectionQuery {
-	query := (&ConnectionClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(reveal.Table, reveal.FieldID, id),
-			sqlgraph.To(connection.Table, connection.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, reveal.ConnectionTable, reveal.ConnectionColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryMilestone queries the milestone edge of a Reveal.
-func (c *RevealClient) QueryMilestone(_m *Reveal) *RevealMilestoneQuery {
-	query := (&RevealMilestoneClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(reveal.Table, reveal.FieldID, id),
-			sqlgraph.To(revealmilestone.Table, revealmilestone.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, reveal.MilestoneTable, reveal.MilestoneColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryContent queries the content edge of a Reveal.
-func (c *RevealClient) QueryContent(_m *Reveal) *RevealContentQuery {
-	query := (&RevealContentClient{config: c.config}).Query()
+// QueryTransaction queries the transaction edge of a LedgerEntry.
+func (c *LedgerEntryClient) QueryTransaction(_m *LedgerEntry) *CreditTransactionQuery {
+	query := (&CreditTransactionClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(reveal.Table, reveal.FieldID, id),
-			sqlgraph.To(revealcontent.Table, revealcontent.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, false, reveal.ContentTable, reveal.ContentColumn),
+			sqlgraph.From(ledgerentry.Table, ledgerentry.FieldID, id),
+			sqlgraph.To(credittransaction.Table, credittransaction.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ledgerentry.TransactionTable, ledgerentry.TransactionColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -2941,131 +3693,131 @@ func (c *RevealClient) QueryContent(_m *Reveal) *RevealContentQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *RevealClient) Hooks() []Hook {
-	return c.hooks.Reveal
+func (c *LedgerEntryClient) Hooks() []Hook {
+	return c.hooks.LedgerEntry
 }
 
 // Interceptors returns the client interceptors.
-func (c *RevealClient) Interceptors() []Interceptor {
-	return c.inters.Reveal
+func (c *LedgerEntryClient) Interceptors() []Interceptor {
+	return c.inters.LedgerEntry
 }
 
-func (c *RevealClient) mutate(ctx context.Context, m *RevealMutation) (Value, error) {
+func (c *LedgerEntryClient) mutate(ctx context.Context, m *LedgerEntryMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&RevealCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&LedgerEntryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&RevealUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&LedgerEntryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&RevealUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&LedgerEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&RevealDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&LedgerEntryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("generated: unknown Reveal mutation op: %q", m.Op())
+		return nil, fmt.Errorf("generated: unknown LedgerEntry mutation op: %q", m.Op())
 	}
 }
 
-// RevealContentClient is a client for the RevealContent schema.
-type RevealContentClient struct {
+// MessageClient is a client for the Message schema.
+type MessageClient struct {
 	config
 }
 
-// NewRevealContentClient returns a client for the RevealContent from the given config.
-func NewRevealContentClient(c config) *RevealContentClient {
-	return &RevealContentClient{config: c}
+// NewMessageClient returns a client for the Message from the given config.
+func NewMessageClient(c config) *MessageClient {
+	return &MessageClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `revealcontent.Hooks(f(g(h())))`.
-func (c *RevealContentClient) Use(hooks ...Hook) {
-	c.hooks.RevealContent = append(c.hooks.RevealContent, hooks...)
+// A call to `Use(f, g, h)` equals to `message.Hooks(f(g(h())))`.
+func (c *MessageClient) Use(hooks ...Hook) {
+	c.hooks.Message = append(c.hooks.Message, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `revealcontent.Intercept(f(g(h())))`.
-func (c *RevealContentClient) Intercept(interceptors ...Interceptor) {
-	c.inters.RevealContent = append(c.inters.RevealContent, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `message.Intercept(f(g(h())))`.
+func (c *MessageClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Message = append(c.inters.Message, interceptors...)
 }
 
-// Create returns a builder for creating a RevealContent entity.
-func (c *RevealContentClient) Create() *RevealContentCreate {
-	mutation := newRevealContentMutation(c.config, OpCreate)
-	return &RevealContentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Message entity.
+func (c *MessageClient) Create() *MessageCreate {
+	mutation := newMessageMutation(c.config, OpCreate)
+	return &MessageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of RevealContent entities.
-func (c *RevealContentClient) CreateBulk(builders ...*RevealContentCreate) *RevealContentCreateBulk {
-	return &RevealContentCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Message entities.
+func (c *MessageClient) CreateBulk(builders ...*MessageCreate) *MessageCreateBulk {
+	return &MessageCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *RevealContentClient) MapCreateBulk(slice any, setFunc func(*RevealContentCreate, int)) *RevealContentCreateBulk {
+func (c *MessageClient) MapCreateBulk(slice any, setFunc func(*MessageCreate, int)) *MessageCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &RevealContentCreateBulk{err: fmt.Errorf("calling to RevealContentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &MessageCreateBulk{err: fmt.Errorf("calling to MessageClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*RevealContentCreate, rv.Len())
+	builders := make([]*MessageCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &RevealContentCreateBulk{config: c.config, builders: builders}
+	return &MessageCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for RevealContent.
-func (c *RevealContentClient) Update() *RevealContentUpdate {
-	mutation := newRevealContentMutation(c.config, OpUpdate)
-	return &RevealContentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Message.
+func (c *MessageClient) Update() *MessageUpdate {
+	mutation := newMessageMutation(c.config, OpUpdate)
+	return &MessageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *RevealContentClient) UpdateOne(_m *RevealContent) *RevealContentUpdateOne {
-	mutation := newRevealContentMutation(c.config, OpUpdateOne, withRevealContent(_m))
-	return &RevealContentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *MessageClient) UpdateOne(_m *Message) *MessageUpdateOne {
+	mutation := newMessageMutation(c.config, OpUpdateOne, withMessage(_m))
+	return &MessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *RevealContentClient) UpdateOneID(id string) *RevealContentUpdateOne {
-	mutation := newRevealContentMutation(c.config, OpUpdateOne, withRevealContentID(id))
-	return &RevealContentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *MessageClient) UpdateOneID(id string) *MessageUpdateOne {
+	mutation := newMessageMutation(c.config, OpUpdateOne, withMessageID(id))
+	return &MessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for RevealContent.
-func (c *RevealContentClient) Delete() *RevealContentDelete {
-	mutation := newRevealContentMutation(c.config, OpDelete)
-	return &RevealContentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Message.
+func (c *MessageClient) Delete() *MessageDelete {
+	mutation := newMessageMutation(c.config, OpDelete)
+	return &MessageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *RevealContentClient) DeleteOne(_m *RevealContent) *RevealContentDeleteOne {
+func (c *MessageClient) DeleteOne(_m *Message) *MessageDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *RevealContentClient) DeleteOneID(id string) *RevealContentDeleteOne {
-	builder := c.Delete().Where(revealcontent.ID(id))
+func (c *MessageClient) DeleteOneID(id string) *MessageDeleteOne {
+	builder := c.Delete().Where(message.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &RevealContentDeleteOne{builder}
+	return &MessageDeleteOne{builder}
 }
 
-// Query returns a query builder for RevealContent.
-func (c *RevealContentClient) Query() *RevealContentQuery {
-	return &RevealContentQuery{
+// Query returns a query builder for Message.
+func (c *MessageClient) Query() *MessageQuery {
+	return &MessageQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeRevealContent},
+		ctx:    &QueryContext{Type: TypeMessage},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a RevealContent entity by its id.
-func (c *RevealContentClient) Get(ctx context.Context, id string) (*RevealContent, error) {
-	return c.Query().Where(revealcontent.ID(id)).Only(ctx)
+// Get returns a Message entity by its id.
+func (c *MessageClient) Get(ctx context.Context, id string) (*Message, error) {
+	return c.Query().Where(message.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *RevealContentClient) GetX(ctx context.Context, id string) *RevealContent {
+func (c *MessageClient) GetX(ctx context.Context, id string) *Message {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -3073,15 +3825,15 @@ func (c *RevealContentClient) GetX(ctx context.Context, id string) *RevealConten
 	return obj
 }
 
-// QueryReveal queries the reveal edge of a RevealContent.
-func (c *RevealContentClient) QueryReveal(_m *RevealContent) *RevealQuery {
-	query := (&RevealClient{config: c.config}).Query()
+// QueryConversation queries the conversation edge of a Message.
+func (c *MessageClient) QueryConversation(_m *Message) *ConversationQuery {
+	query := (&ConversationClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(revealcontent.Table, revealcontent.FieldID, id),
-			sqlgraph.To(reveal.Table, reveal.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, true, revealcontent.RevealTable, revealcontent.RevealColumn),
+			sqlgraph.From(message.Table, message.FieldID, id),
+			sqlgraph.To(conversation.Table, conversation.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, message.ConversationTable, message.ConversationColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -3089,132 +3841,164 @@ func (c *RevealContentClient) QueryReveal(_m *RevealContent) *RevealQuery {
 	return query
 }
 
-// Hooks returns the client hooks.
-func (c *RevealContentClient) Hooks() []Hook {
-	return c.hooks.RevealContent
+// QuerySender queries the sender edge of a Message.
+func (c *MessageClient) QuerySender(_m *Message) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, message.SenderTable, message.SenderColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
 }
 
-// Interceptors returns the client interceptors.
-func (c *RevealContentClient) Interceptors() []Interceptor {
-	return c.inters.RevealContent
+// QueryModerationActions queries the moderation_actions edge of a Message.
+func (c *MessageClient) QueryModerationActions(_m *Message) *ModerationActionQuery {
+	query := (&ModerationActionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, id),
+			sqlgraph.To(moderationaction.Table, moderationaction.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, message.ModerationActionsTable, message.ModerationActionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
 }
 
-func (c *RevealContentClient) mutate(ctx context.Context, m *RevealContentMutation) (Value, error) {
+// Hooks returns the client hooks.
+func (c *MessageClient) Hooks() []Hook {
+	return c.hooks.Message
+}
+
+// Interceptors returns the client interceptors.
+func (c *MessageClient) Interceptors() []Interceptor {
+	return c.inters.Message
+}
+
+func (c *MessageClient) mutate(ctx context.Context, m *MessageMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&RevealContentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&MessageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&RevealContentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&MessageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&RevealContentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&MessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&RevealContentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&MessageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("generated: unknown RevealContent mutation op: %q", m.Op())
+		return nil, fmt.Errorf("generated: unknown Message mutation op: %q", m.Op())
 	}
 }
 
-// RevealMilestoneClient is a client for the RevealMilestone schema.
-type RevealMilestoneClient struct {
+// ModerationActionClient is a client for the ModerationAction schema.
+type ModerationActionClient struct {
 	config
 }
 
-// NewRevealMilestoneClient returns a client for the RevealMilestone from the given config.
-func NewRevealMilestoneClient(c config) *RevealMilestoneClient {
-	return &RevealMilestoneClient{config: c}
+// NewModerationActionClient returns a client for the ModerationAction from the given config.
+func NewModerationActionClient(c config) *ModerationActionClient {
+	return &ModerationActionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `revealmilestone.Hooks(f(g(h())))`.
-func (c *RevealMilestoneClient) Use(hooks ...Hook) {
-	c.hooks.RevealMilestone = append(c.hooks.RevealMilestone, hooks...)
+// A call to `Use(f, g, h)` equals to `moderationaction.Hooks(f(g(h())))`.
+func (c *ModerationActionClient) Use(hooks ...Hook) {
+	c.hooks.ModerationAction = append(c.hooks.ModerationAction, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `revealmilestone.Intercept(f(g(h())))`.
-func (c *RevealMilestoneClient) Intercept(interceptors ...Interceptor) {
-	c.inters.RevealMilestone = append(c.inters.RevealMilestone, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `moderationaction.Intercept(f(g(h())))`.
+func (c *ModerationActionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ModerationAction = append(c.inters.ModerationAction, interceptors...)
 }
 
-// Create returns a builder for creating a RevealMilestone entity.
-func (c *RevealMilestoneClient) Create() *RevealMilestoneCreate {
-	mutation := newRevealMilestoneMutation(c.config, OpCreate)
-	return &RevealMilestoneCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ModerationAction entity.
+func (c *ModerationActionClient) Create() *ModerationActionCreate {
+	mutation := newModerationActionMutation(c.config, OpCreate)
+	return &ModerationActionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of RevealMilestone entities.
-func (c *RevealMilestoneClient) CreateBulk(builders ...*RevealMilestoneCreate) *RevealMilestoneCreateBulk {
-	return &RevealMilestoneCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ModerationAction entities.
+func (c *ModerationActionClient) CreateBulk(builders ...*ModerationActionCreate) *ModerationActionCreateBulk {
+	return &ModerationActionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *RevealMilestoneClient) MapCreateBulk(slice any, setFunc func(*RevealMilestoneCreate, int)) *RevealMilestoneCreateBulk {
+func (c *ModerationActionClient) MapCreateBulk(slice any, setFunc func(*ModerationActionCreate, int)) *ModerationActionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &RevealMilestoneCreateBulk{err: fmt.Errorf("calling to RevealMilestoneClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ModerationActionCreateBulk{err: fmt.Errorf("calling to ModerationActionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*RevealMilestoneCreate, rv.Len())
+	builders := make([]*ModerationActionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &RevealMilestoneCreateBulk{config: c.config, builders: builders}
+	return &ModerationActionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for RevealMilestone.
-func (c *RevealMilestoneClient) Update() *RevealMilestoneUpdate {
-	mutation := newRevealMilestoneMutation(c.config, OpUpdate)
-	return &RevealMilestoneUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ModerationAction.
+func (c *ModerationActionClient) Update() *ModerationActionUpdate {
+	mutation := newModerationActionMutation(c.config, OpUpdate)
+	return &ModerationActionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *RevealMilestoneClient) UpdateOne(_m *RevealMilestone) *RevealMilestoneUpdateOne {
-	mutation := newRevealMilestoneMutation(c.config, OpUpdateOne, withRevealMilestone(_m))
-	return &RevealMilestoneUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ModerationActionClient) UpdateOne(_m *ModerationAction) *ModerationActionUpdateOne {
+	mutation := newModerationActionMutation(c.config, OpUpdateOne, withModerationAction(_m))
+	return &ModerationActionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *RevealMilestoneClient) UpdateOneID(id string) *RevealMilestoneUpdateOne {
-	mutation := newRevealMilestoneMutation(c.config, OpUpdateOne, withRevealMilestoneID(id))
-	return &RevealMilestoneUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ModerationActionClient) UpdateOneID(id string) *ModerationActionUpdateOne {
+	mutation := newModerationActionMutation(c.config, OpUpdateOne, withModerationActionID(id))
+	return &ModerationActionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for RevealMilestone.
-func (c *RevealMilestoneClient) Delete() *RevealMilestoneDelete {
-	mutation := newRevealMilestoneMutation(c.config, OpDelete)
-	return &RevealMilestoneDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ModerationAction.
+func (c *ModerationActionClient) Delete() *ModerationActionDelete {
+	mutation := newModerationActionMutation(c.config, OpDelete)
+	return &ModerationActionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *RevealMilestoneClient) DeleteOne(_m *RevealMilestone) *RevealMilestoneDeleteOne {
+func (c *ModerationActionClient) DeleteOne(_m *ModerationAction) *ModerationActionDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *RevealMilestoneClient) DeleteOneID(id string) *RevealMilestoneDeleteOne {
-	builder := c.Delete().Where(revealmilestone.ID(id))
+func (c *ModerationActionClient) DeleteOneID(id string) *ModerationActionDeleteOne {
+	builder := c.Delete().Where(moderationaction.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &RevealMilestoneDeleteOne{builder}
+	return &ModerationActionDeleteOne{builder}
 }
 
-// Query returns a query builder for RevealMilestone.
-func (c *RevealMilestoneClient) Query() *RevealMilestoneQuery {
-	return &RevealMilestoneQuery{
+// Query returns a query builder for ModerationAction.
+func (c *ModerationActionClient) Query() *ModerationActionQuery {
+	return &ModerationActionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeRevealMilestone},
+		ctx:    &QueryContext{Type: TypeModerationAction},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a RevealMilestone entity by its id.
-func (c *RevealMilestoneClient) Get(ctx context.Context, id string) (*RevealMilestone, error) {
-	return c.Query().Where(revealmilestone.ID(id)).Only(ctx)
+// Get returns a ModerationAction entity by its id.
+func (c *ModerationActionClient) Get(ctx context.Context, id string) (*ModerationAction, error) {
+	return c.Query().Where(moderationaction.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *RevealMilestoneClient) GetX(ctx context.Context, id string) *RevealMilestone {
+func (c *ModerationActionClient) GetX(ctx context.Context, id string) *ModerationAction {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -3222,15 +4006,31 @@ func (c *RevealMilestoneClient) GetX(ctx context.Context, id string) *RevealMile
 	return obj
 }
 
-// QueryReveals queries the reveals edge of a RevealMilestone.
-func (c *RevealMilestoneClient) QueryReveals(_m *RevealMilestone) *RevealQuery {
-	query := (&RevealClient{config: c.config}).Query()
+// QueryUser queries the user edge of a ModerationAction.
+func (c *ModerationActionClient) QueryUser(_m *ModerationAction) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(revealmilestone.Table, revealmilestone.FieldID, id),
-			sqlgraph.To(reveal.Table, reveal.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, revealmilestone.RevealsTable, revealmilestone.RevealsColumn),
+			sqlgraph.From(moderationaction.Table, moderationaction.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, moderationaction.UserTable, moderationaction.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMessage queries the message edge of a ModerationAction.
+func (c *ModerationActionClient) QueryMessage(_m *ModerationAction) *MessageQuery {
+	query := (&MessageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(moderationaction.Table, moderationaction.FieldID, id),
+			sqlgraph.To(message.Table, message.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, moderationaction.MessageTable, moderationaction.MessageColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -3239,131 +4039,131 @@ func (c *RevealMilestoneClient) QueryReveals(_m *RevealMilestone) *RevealQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *RevealMilestoneClient) Hooks() []Hook {
-	return c.hooks.RevealMilestone
+func (c *ModerationActionClient) Hooks() []Hook {
+	return c.hooks.ModerationAction
 }
 
 // Interceptors returns the client interceptors.
-func (c *RevealMilestoneClient) Interceptors() []Interceptor {
-	return c.inters.RevealMilestone
+func (c *ModerationActionClient) Interceptors() []Interceptor {
+	return c.inters.ModerationAction
 }
 
-func (c *RevealMilestoneClient) mutate(ctx context.Context, m *RevealMilestoneMutation) (Value, error) {
+func (c *ModerationActionClient) mutate(ctx context.Context, m *ModerationActionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&RevealMilestoneCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ModerationActionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&RevealMilestoneUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ModerationActionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&RevealMilestoneUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ModerationActionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&RevealMilestoneDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ModerationActionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("generated: unknown RevealMilestone mutation op: %q", m.Op())
+		return nil, fmt.Errorf("generated: unknown ModerationAction mutation op: %q", m.Op())
 	}
 }
 
-// ServerClient is a client for the Server schema.
-type ServerClient struct {
+// NotificationClient is a client for the Notification schema.
+type NotificationClient struct {
 	config
 }
 
-// NewServerClient returns a client for the Server from the given config.
-func NewServerClient(c config) *ServerClient {
-	return &ServerClient{config: c}
+// NewNotificationClient returns a client for the Notification from the given config.
+func NewNotificationClient(c config) *NotificationClient {
+	return &NotificationClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `server.Hooks(f(g(h())))`.
-func (c *ServerClient) Use(hooks ...Hook) {
-	c.hooks.Server = append(c.hooks.Server, hooks...)
+// A call to `Use(f, g, h)` equals to `notification.Hooks(f(g(h())))`.
+func (c *NotificationClient) Use(hooks ...Hook) {
+	c.hooks.Notification = append(c.hooks.Notification, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `server.Intercept(f(g(h())))`.
-func (c *ServerClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Server = append(c.inters.Server, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `notification.Intercept(f(g(h())))`.
+func (c *NotificationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Notification = append(c.inters.Notification, interceptors...)
 }
 
-// Create returns a builder for creating a Server entity.
-func (c *ServerClient) Create() *ServerCreate {
-	mutation := newServerMutation(c.config, OpCreate)
-	return &ServerCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Notification entity.
+func (c *NotificationClient) Create() *NotificationCreate {
+	mutation := newNotificationMutation(c.config, OpCreate)
+	return &NotificationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Server entities.
-func (c *ServerClient) CreateBulk(builders ...*ServerCreate) *ServerCreateBulk {
-	return &ServerCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Notification entities.
+func (c *NotificationClient) CreateBulk(builders ...*NotificationCreate) *NotificationCreateBulk {
+	return &NotificationCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ServerClient) MapCreateBulk(slice any, setFunc func(*ServerCreate, int)) *ServerCreateBulk {
+func (c *NotificationClient) MapCreateBulk(slice any, setFunc func(*NotificationCreate, int)) *NotificationCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ServerCreateBulk{err: fmt.Errorf("calling to ServerClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &NotificationCreateBulk{err: fmt.Errorf("calling to NotificationClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ServerCreate, rv.Len())
+	builders := make([]*NotificationCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ServerCreateBulk{config: c.config, builders: builders}
+	return &NotificationCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Server.
-func (c *ServerClient) Update() *ServerUpdate {
-	mutation := newServerMutation(c.config, OpUpdate)
-	return &ServerUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Notification.
+func (c *NotificationClient) Update() *NotificationUpdate {
+	mutation := newNotificationMutation(c.config, OpUpdate)
+	return &NotificationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ServerClient) UpdateOne(_m *Server) *ServerUpdateOne {
-	mutation := newServerMutation(c.config, OpUpdateOne, withServer(_m))
-	return &ServerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *NotificationClient) UpdateOne(_m *Notification) *NotificationUpdateOne {
+	mutation := newNotificationMutation(c.config, OpUpdateOne, withNotification(_m))
+	return &NotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ServerClient) UpdateOneID(id string) *ServerUpdateOne {
-	mutation := newServerMutation(c.config, OpUpdateOne, withServerID(id))
-	return &ServerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *NotificationClient) UpdateOneID(id string) *NotificationUpdateOne {
+	mutation := newNotificationMutation(c.config, OpUpdateOne, withNotificationID(id))
+	return &NotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Server.
-func (c *ServerClient) Delete() *ServerDelete {
-	mutation := newServerMutation(c.config, OpDelete)
-	return &ServerDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Notification.
+func (c *NotificationClient) Delete() *NotificationDelete {
+	mutation := newNotificationMutation(c.config, OpDelete)
+	return &NotificationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ServerClient) DeleteOne(_m *Server) *ServerDeleteOne {
+func (c *NotificationClient) DeleteOne(_m *Notification) *NotificationDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ServerClient) DeleteOneID(id string) *ServerDeleteOne {
-	builder := c.Delete().Where(server.ID(id))
+func (c *NotificationClient) DeleteOneID(id string) *NotificationDeleteOne {
+	builder := c.Delete().Where(notification.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ServerDeleteOne{builder}
+	return &NotificationDeleteOne{builder}
 }
 
-// Query returns a query builder for Server.
-func (c *ServerClient) Query() *ServerQuery {
-	return &ServerQuery{
+// Query returns a query builder for Notification.
+func (c *NotificationClient) Query() *NotificationQuery {
+	return &NotificationQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeServer},
+		ctx:    &QueryContext{Type: TypeNotification},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Server entity by its id.
-func (c *ServerClient) Get(ctx context.Context, id string) (*Server, error) {
-	return c.Query().Where(server.ID(id)).Only(ctx)
+// Get returns a Notification entity by its id.
+func (c *NotificationClient) Get(ctx context.Context, id string) (*Notification, error) {
+	return c.Query().Where(notification.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ServerClient) GetX(ctx context.Context, id string) *Server {
+func (c *NotificationClient) GetX(ctx context.Context, id string) *Notification {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -3371,132 +4171,148 @@ func (c *ServerClient) GetX(ctx context.Context, id string) *Server {
 	return obj
 }
 
+// QueryUser queries the user edge of a Notification.
+func (c *NotificationClient) QueryUser(_m *Notification) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(notification.Table, notification.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, notification.UserTable, notification.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
-func (c *ServerClient) Hooks() []Hook {
-	return c.hooks.Server
+func (c *NotificationClient) Hooks() []Hook {
+	return c.hooks.Notification
 }
 
 // Interceptors returns the client interceptors.
-func (c *ServerClient) Interceptors() []Interceptor {
-	return c.inters.Server
+func (c *NotificationClient) Interceptors() []Interceptor {
+	return c.inters.Notification
 }
 
-func (c *ServerClient) mutate(ctx context.Context, m *ServerMutation) (Value, error) {
+func (c *NotificationClient) mutate(ctx context.Context, m *NotificationMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ServerCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&NotificationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ServerUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&NotificationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ServerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&NotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ServerDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&NotificationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("generated: unknown Server mutation op: %q", m.Op())
+		return nil, fmt.Errorf("generated: unknown Notification mutation op: %q", m.Op())
 	}
 }
 
-// StreakClient is a client for the Streak schema.
-type StreakClient struct {
+// NudgeClient is a client for the Nudge schema.
+type NudgeClient struct {
 	config
 }
 
-// NewStreakClient returns a client for the Streak from the given config.
-func NewStreakClient(c config) *StreakClient {
-	return &StreakClient{config: c}
+// NewNudgeClient returns a client for the Nudge from the given config.
+func NewNudgeClient(c config) *NudgeClient {
+	return &NudgeClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `streak.Hooks(f(g(h())))`.
-func (c *StreakClient) Use(hooks ...Hook) {
-	c.hooks.Streak = append(c.hooks.Streak, hooks...)
+// A call to `Use(f, g, h)` equals to `nudge.Hooks(f(g(h())))`.
+func (c *NudgeClient) Use(hooks ...Hook) {
+	c.hooks.Nudge = append(c.hooks.Nudge, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `streak.Intercept(f(g(h())))`.
-func (c *StreakClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Streak = append(c.inters.Streak, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `nudge.Intercept(f(g(h())))`.
+func (c *NudgeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Nudge = append(c.inters.Nudge, interceptors...)
 }
 
-// Create returns a builder for creating a Streak entity.
-func (c *StreakClient) Create() *StreakCreate {
-	mutation := newStreakMutation(c.config, OpCreate)
-	return &StreakCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Nudge entity.
+func (c *NudgeClient) Create() *NudgeCreate {
+	mutation := newNudgeMutation(c.config, OpCreate)
+	return &NudgeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Streak entities.
-func (c *StreakClient) CreateBulk(builders ...*StreakCreate) *StreakCreateBulk {
-	return &StreakCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Nudge entities.
+func (c *NudgeClient) CreateBulk(builders ...*NudgeCreate) *NudgeCreateBulk {
+	return &NudgeCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *StreakClient) MapCreateBulk(slice any, setFunc func(*StreakCreate, int)) *StreakCreateBulk {
+func (c *NudgeClient) MapCreateBulk(slice any, setFunc func(*NudgeCreate, int)) *NudgeCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &StreakCreateBulk{err: fmt.Errorf("calling to StreakClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &NudgeCreateBulk{err: fmt.Errorf("calling to NudgeClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*StreakCreate, rv.Len())
+	builders := make([]*NudgeCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &StreakCreateBulk{config: c.config, builders: builders}
+	return &NudgeCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Streak.
-func (c *StreakClient) Update() *StreakUpdate {
-	mutation := newStreakMutation(c.config, OpUpdate)
-	return &StreakUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Nudge.
+func (c *NudgeClient) Update() *NudgeUpdate {
+	mutation := newNudgeMutation(c.config, OpUpdate)
+	return &NudgeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *StreakClient) UpdateOne(_m *Streak) *StreakUpdateOne {
-	mutation := newStreakMutation(c.config, OpUpdateOne, withStreak(_m))
-	return &StreakUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *NudgeClient) UpdateOne(_m *Nudge) *NudgeUpdateOne {
+	mutation := newNudgeMutation(c.config, OpUpdateOne, withNudge(_m))
+	return &NudgeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *StreakClient) UpdateOneID(id string) *StreakUpdateOne {
-	mutation := newStreakMutation(c.config, OpUpdateOne, withStreakID(id))
-	return &StreakUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *NudgeClient) UpdateOneID(id string) *NudgeUpdateOne {
+	mutation := newNudgeMutation(c.config, OpUpdateOne, withNudgeID(id))
+	return &NudgeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Streak.
-func (c *StreakClient) Delete() *StreakDelete {
-	mutation := newStreakMutation(c.config, OpDelete)
-	return &StreakDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Nudge.
+func (c *NudgeClient) Delete() *NudgeDelete {
+	mutation := newNudgeMutation(c.config, OpDelete)
+	return &NudgeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *StreakClient) DeleteOne(_m *Streak) *StreakDeleteOne {
+func (c *NudgeClient) DeleteOne(_m *Nudge) *NudgeDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *StreakClient) DeleteOneID(id string) *StreakDeleteOne {
-	builder := c.Delete().Where(streak.ID(id))
+func (c *NudgeClient) DeleteOneID(id string) *NudgeDeleteOne {
+	builder := c.Delete().Where(nudge.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &StreakDeleteOne{builder}
+	return &NudgeDeleteOne{builder}
 }
 
-// Query returns a query builder for Streak.
-func (c *StreakClient) Query() *StreakQuery {
-	return &StreakQuery{
+// Query returns a query builder for Nudge.
+func (c *NudgeClient) Query() *NudgeQuery {
+	return &NudgeQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeStreak},
+		ctx:    &QueryContext{Type: TypeNudge},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Streak entity by its id.
-func (c *StreakClient) Get(ctx context.Context, id string) (*Streak, error) {
-	return c.Query().Where(streak.ID(id)).Only(ctx)
+// Get returns a Nudge entity by its id.
+func (c *NudgeClient) Get(ctx context.Context, id string) (*Nudge, error) {
+	return c.Query().Where(nudge.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *StreakClient) GetX(ctx context.Context, id string) *Streak {
+func (c *NudgeClient) GetX(ctx context.Context, id string) *Nudge {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -3504,15 +4320,15 @@ func (c *StreakClient) GetX(ctx context.Context, id string) *Streak {
 	return obj
 }
 
-// QueryConnection queries the connection edge of a Streak.
-func (c *StreakClient) QueryConnection(_m *Streak) *ConnectionQuery {
-	query := (&ConnectionClient{config: c.config}).Query()
+// QueryStreak queries the streak edge of a Nudge.
+func (c *NudgeClient) QueryStreak(_m *Nudge) *StreakQuery {
+	query := (&StreakClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(streak.Table, streak.FieldID, id),
-			sqlgraph.To(connection.Table, connection.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, true, streak.ConnectionTable, streak.ConnectionColumn),
+			sqlgraph.From(nudge.Table, nudge.FieldID, id),
+			sqlgraph.To(streak.Table, streak.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, nudge.StreakTable, nudge.StreakColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -3520,15 +4336,15 @@ func (c *StreakClient) QueryConnection(_m *Streak) *ConnectionQuery {
 	return query
 }
 
-// QueryBreaker queries the breaker edge of a Streak.
-func (c *StreakClient) QueryBreaker(_m *Streak) *UserQuery {
+// QuerySender queries the sender edge of a Nudge.
+func (c *NudgeClient) QuerySender(_m *Nudge) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(streak.Table, streak.FieldID, id),
+			sqlgraph.From(nudge.Table, nudge.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, streak.BreakerTable, streak.BreakerColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, nudge.SenderTable, nudge.SenderColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -3536,15 +4352,15 @@ func (c *StreakClient) QueryBreaker(_m *Streak) *UserQuery {
 	return query
 }
 
-// QueryCheckIns queries the check_ins edge of a Streak.
-func (c *StreakClient) QueryCheckIns(_m *Streak) *CheckInQuery {
-	query := (&CheckInClient{config: c.config}).Query()
+// QueryReceiver queries the receiver edge of a Nudge.
+func (c *NudgeClient) QueryReceiver(_m *Nudge) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(streak.Table, streak.FieldID, id),
-			sqlgraph.To(checkin.Table, checkin.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, streak.CheckInsTable, streak.CheckInsColumn),
+			sqlgraph.From(nudge.Table, nudge.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, nudge.ReceiverTable, nudge.ReceiverColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -3552,15 +4368,148 @@ func (c *StreakClient) QueryCheckIns(_m *Streak) *CheckInQuery {
 	return query
 }
 
-// QueryNudges queries the nudges edge of a Streak.
-func (c *StreakClient) QueryNudges(_m *Streak) *NudgeQuery {
-	query := (&NudgeClient{config: c.config}).Query()
+// Hooks returns the client hooks.
+func (c *NudgeClient) Hooks() []Hook {
+	return c.hooks.Nudge
+}
+
+// Interceptors returns the client interceptors.
+func (c *NudgeClient) Interceptors() []Interceptor {
+	return c.inters.Nudge
+}
+
+func (c *NudgeClient) mutate(ctx context.Context, m *NudgeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&NudgeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&NudgeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&NudgeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&NudgeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown Nudge mutation op: %q", m.Op())
+	}
+}
+
+// PaymentDiscrepancyClient is a client for the PaymentDiscrepancy schema.
+type PaymentDiscrepancyClient struct {
+	config
+}
+
+// NewPaymentDiscrepancyClient returns a client for the PaymentDiscrepancy from the given config.
+func NewPaymentDiscrepancyClient(c config) *PaymentDiscrepancyClient {
+	return &PaymentDiscrepancyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `paymentdiscrepancy.Hooks(f(g(h())))`.
+func (c *PaymentDiscrepancyClient) Use(hooks ...Hook) {
+	c.hooks.PaymentDiscrepancy = append(c.hooks.PaymentDiscrepancy, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `paymentdiscrepancy.Intercept(f(g(h())))`.
+func (c *PaymentDiscrepancyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PaymentDiscrepancy = append(c.inters.PaymentDiscrepancy, interceptors...)
+}
+
+// Create returns a builder for creating a PaymentDiscrepancy entity.
+func (c *PaymentDiscrepancyClient) Create() *PaymentDiscrepancyCreate {
+	mutation := newPaymentDiscrepancyMutation(c.config, OpCreate)
+	return &PaymentDiscrepancyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PaymentDiscrepancy entities.
+func (c *PaymentDiscrepancyClient) CreateBulk(builders ...*PaymentDiscrepancyCreate) *PaymentDiscrepancyCreateBulk {
+	return &PaymentDiscrepancyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PaymentDiscrepancyClient) MapCreateBulk(slice any, setFunc func(*PaymentDiscrepancyCreate, int)) *PaymentDiscrepancyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PaymentDiscrepancyCreateBulk{err: fmt.Errorf("calling to PaymentDiscrepancyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PaymentDiscrepancyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PaymentDiscrepancyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PaymentDiscrepancy.
+func (c *PaymentDiscrepancyClient) Update() *PaymentDiscrepancyUpdate {
+	mutation := newPaymentDiscrepancyMutation(c.config, OpUpdate)
+	return &PaymentDiscrepancyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PaymentDiscrepancyClient) UpdateOne(_m *PaymentDiscrepancy) *PaymentDiscrepancyUpdateOne {
+	mutation := newPaymentDiscrepancyMutation(c.config, OpUpdateOne, withPaymentDiscrepancy(_m))
+	return &PaymentDiscrepancyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PaymentDiscrepancyClient) UpdateOneID(id string) *PaymentDiscrepancyUpdateOne {
+	mutation := newPaymentDiscrepancyMutation(c.config, OpUpdateOne, withPaymentDiscrepancyID(id))
+	return &PaymentDiscrepancyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PaymentDiscrepancy.
+func (c *PaymentDiscrepancyClient) Delete() *PaymentDiscrepancyDelete {
+	mutation := newPaymentDiscrepancyMutation(c.config, OpDelete)
+	return &PaymentDiscrepancyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PaymentDiscrepancyClient) DeleteOne(_m *PaymentDiscrepancy) *PaymentDiscrepancyDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PaymentDiscrepancyClient) DeleteOneID(id string) *PaymentDiscrepancyDeleteOne {
+	builder := c.Delete().Where(paymentdiscrepancy.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PaymentDiscrepancyDeleteOne{builder}
+}
+
+// Query returns a query builder for PaymentDiscrepancy.
+func (c *PaymentDiscrepancyClient) Query() *PaymentDiscrepancyQuery {
+	return &PaymentDiscrepancyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePaymentDiscrepancy},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PaymentDiscrepancy entity by its id.
+func (c *PaymentDiscrepancyClient) Get(ctx context.Context, id string) (*PaymentDiscrepancy, error) {
+	return c.Query().Where(paymentdiscrepancy.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PaymentDiscrepancyClient) GetX(ctx context.Context, id string) *PaymentDiscrepancy {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryReport queries the report edge of a PaymentDiscrepancy.
+func (c *PaymentDiscrepancyClient) QueryReport(_m *PaymentDiscrepancy) *PaymentReconciliationReportQuery {
+	query := (&PaymentReconciliationReportClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(streak.Table, streak.FieldID, id),
-			sqlgraph.To(nudge.Table, nudge.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, streak.NudgesTable, streak.NudgesColumn),
+			sqlgraph.From(paymentdiscrepancy.Table, paymentdiscrepancy.FieldID, id),
+			sqlgraph.To(paymentreconciliationreport.Table, paymentreconciliationreport.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, paymentdiscrepancy.ReportTable, paymentdiscrepancy.ReportColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -3569,131 +4518,131 @@ func (c *StreakClient) QueryNudges(_m *Streak) *NudgeQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *StreakClient) Hooks() []Hook {
-	return c.hooks.Streak
+func (c *PaymentDiscrepancyClient) Hooks() []Hook {
+	return c.hooks.PaymentDiscrepancy
 }
 
 // Interceptors returns the client interceptors.
-func (c *StreakClient) Interceptors() []Interceptor {
-	return c.inters.Streak
+func (c *PaymentDiscrepancyClient) Interceptors() []Interceptor {
+	return c.inters.PaymentDiscrepancy
 }
 
-func (c *StreakClient) mutate(ctx context.Context, m *StreakMutation) (Value, error) {
+func (c *PaymentDiscrepancyClient) mutate(ctx context.Context, m *PaymentDiscrepancyMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&StreakCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PaymentDiscrepancyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&StreakUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PaymentDiscrepancyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&StreakUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PaymentDiscrepancyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&StreakDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&PaymentDiscrepancyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("generated: unknown Streak mutation op: %q", m.Op())
+		return nil, fmt.Errorf("generated: unknown PaymentDiscrepancy mutation op: %q", m.Op())
 	}
 }
 
-// UserClient is a client for the User schema.
-type UserClient struct {
+// PaymentOrderClient is a client for the PaymentOrder schema.
+type PaymentOrderClient struct {
 	config
 }
 
-// NewUserClient returns a client for the User from the given config.
-func NewUserClient(c config) *UserClient {
-	return &UserClient{config: c}
+// NewPaymentOrderClient returns a client for the PaymentOrder from the given config.
+func NewPaymentOrderClient(c config) *PaymentOrderClient {
+	return &PaymentOrderClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `user.Hooks(f(g(h())))`.
-func (c *UserClient) Use(hooks ...Hook) {
-	c.hooks.User = append(c.hooks.User, hooks...)
+// A call to `Use(f, g, h)` equals to `paymentorder.Hooks(f(g(h())))`.
+func (c *PaymentOrderClient) Use(hooks ...Hook) {
+	c.hooks.PaymentOrder = append(c.hooks.PaymentOrder, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `user.Intercept(f(g(h())))`.
-func (c *UserClient) Intercept(interceptors ...Interceptor) {
-	c.inters.User = append(c.inters.User, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `paymentorder.Intercept(f(g(h())))`.
+func (c *PaymentOrderClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PaymentOrder = append(c.inters.PaymentOrder, interceptors...)
 }
 
-// Create returns a builder for creating a User entity.
-func (c *UserClient) Create() *UserCreate {
-	mutation := newUserMutation(c.config, OpCreate)
-	return &UserCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a PaymentOrder entity.
+func (c *PaymentOrderClient) Create() *PaymentOrderCreate {
+	mutation := newPaymentOrderMutation(c.config, OpCreate)
+	return &PaymentOrderCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of User entities.
-func (c *UserClient) CreateBulk(builders ...*UserCreate) *UserCreateBulk {
-	return &UserCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of PaymentOrder entities.
+func (c *PaymentOrderClient) CreateBulk(builders ...*PaymentOrderCreate) *PaymentOrderCreateBulk {
+	return &PaymentOrderCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *UserClient) MapCreateBulk(slice any, setFunc func(*UserCreate, int)) *UserCreateBulk {
+func (c *PaymentOrderClient) MapCreateBulk(slice any, setFunc func(*PaymentOrderCreate, int)) *PaymentOrderCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &UserCreateBulk{err: fmt.Errorf("calling to UserClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &PaymentOrderCreateBulk{err: fmt.Errorf("calling to PaymentOrderClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*UserCreate, rv.Len())
+	builders := make([]*PaymentOrderCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &UserCreateBulk{config: c.config, builders: builders}
+	return &PaymentOrderCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for User.
-func (c *UserClient) Update() *UserUpdate {
-	mutation := newUserMutation(c.config, OpUpdate)
-	return &UserUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for PaymentOrder.
+func (c *PaymentOrderClient) Update() *PaymentOrderUpdate {
+	mutation := newPaymentOrderMutation(c.config, OpUpdate)
+	return &PaymentOrderUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *UserClient) UpdateOne(_m *User) *UserUpdateOne {
-	mutation := newUserMutation(c.config, OpUpdateOne, withUser(_m))
-	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *PaymentOrderClient) UpdateOne(_m *PaymentOrder) *PaymentOrderUpdateOne {
+	mutation := newPaymentOrderMutation(c.config, OpUpdateOne, withPaymentOrder(_m))
+	return &PaymentOrderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *UserClient) UpdateOneID(id string) *UserUpdateOne {
-	mutation := newUserMutation(c.config, OpUpdateOne, withUserID(id))
-	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *PaymentOrderClient) UpdateOneID(id string) *PaymentOrderUpdateOne {
+	mutation := newPaymentOrderMutation(c.config, OpUpdateOne, withPaymentOrderID(id))
+	return &PaymentOrderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for User.
-func (c *UserClient) Delete() *UserDelete {
-	mutation := newUserMutation(c.config, OpDelete)
-	return &UserDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for PaymentOrder.
+func (c *PaymentOrderClient) Delete() *PaymentOrderDelete {
+	mutation := newPaymentOrderMutation(c.config, OpDelete)
+	return &PaymentOrderDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *UserClient) DeleteOne(_m *User) *UserDeleteOne {
+func (c *PaymentOrderClient) DeleteOne(_m *PaymentOrder) *PaymentOrderDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *UserClient) DeleteOneID(id string) *UserDeleteOne {
-	builder := c.Delete().Where(user.ID(id))
+func (c *PaymentOrderClient) DeleteOneID(id string) *PaymentOrderDeleteOne {
+	builder := c.Delete().Where(paymentorder.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &UserDeleteOne{builder}
+	return &PaymentOrderDeleteOne{builder}
 }
 
-// Query returns a query builder for User.
-func (c *UserClient) Query() *UserQuery {
-	return &UserQuery{
+// Query returns a query builder for PaymentOrder.
+func (c *PaymentOrderClient) Query() *PaymentOrderQuery {
+	return &PaymentOrderQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeUser},
+		ctx:    &QueryContext{Type: TypePaymentOrder},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a User entity by its id.
-func (c *UserClient) Get(ctx context.Context, id string) (*User, error) {
-	return c.Query().Where(user.ID(id)).Only(ctx)
+// Get returns a PaymentOrder entity by its id.
+func (c *PaymentOrderClient) Get(ctx context.Context, id string) (*PaymentOrder, error) {
+	return c.Query().Where(paymentorder.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *UserClient) GetX(ctx context.Context, id string) *User {
+func (c *PaymentOrderClient) GetX(ctx context.Context, id string) *PaymentOrder {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -3701,15 +4650,15 @@ func (c *UserClient) GetX(ctx context.Context, id string) *User {
 	return obj
 }
 
-// QueryProfile queries the profile edge of a User.
-func (c *UserClient) QueryProfile(_m *User) *ProfileQuery {
-	query := (&ProfileClient{config: c.config}).Query()
+// QueryUser queries the user edge of a PaymentOrder.
+func (c *PaymentOrderClient) QueryUser(_m *PaymentOrder) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(user.Table, user.FieldID, id),
-			sqlgraph.To(profile.Table, profile.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, false, user.ProfileTable, user.ProfileColumn),
+			sqlgraph.From(paymentorder.Table, paymentorder.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, paymentorder.UserTable, paymentorder.UserColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -3717,15 +4666,15 @@ func (c *UserClient) QueryProfile(_m *User) *ProfileQuery {
 	return query
 }
 
-// QueryPhotos queries the photos edge of a User.
-func (c *UserClient) QueryPhotos(_m *User) *PhotoQuery {
-	query := (&PhotoClient{config: c.config}).Query()
+// QueryRefunds queries the refunds edge of a PaymentOrder.
+func (c *PaymentOrderClient) QueryRefunds(_m *PaymentOrder) *PaymentRefundQuery {
+	query := (&PaymentRefundClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(user.Table, user.FieldID, id),
-			sqlgraph.To(photo.Table, photo.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, user.PhotosTable, user.PhotosColumn),
+			sqlgraph.From(paymentorder.Table, paymentorder.FieldID, id),
+			sqlgraph.To(paymentrefund.Table, paymentrefund.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, paymentorder.RefundsTable, paymentorder.RefundsColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -3733,15 +4682,15 @@ func (c *UserClient) QueryPhotos(_m *User) *PhotoQuery {
 	return query
 }
 
-// QueryHobbies queries the hobbies edge of a User.
-func (c *UserClient) QueryHobbies(_m *User) *HobbyQuery {
-	query := (&HobbyClient{config: c.config}).Query()
+// QueryInvoice queries the invoice edge of a PaymentOrder.
+func (c *PaymentOrderClient) QueryInvoice(_m *PaymentOrder) *InvoiceQuery {
+	query := (&InvoiceClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(user.Table, user.FieldID, id),
-			sqlgraph.To(hobby.Table, hobby.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, user.HobbiesTable, user.HobbiesColumn),
+			sqlgraph.From(paymentorder.Table, paymentorder.FieldID, id),
+			sqlgraph.To(invoice.Table, invoice.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, paymentorder.InvoiceTable, paymentorder.InvoiceColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -3749,10 +4698,3017 @@ func (c *UserClient) QueryHobbies(_m *User) *HobbyQuery {
 	return query
 }
 
-// QueryFilters queries the filters edge of a User.
-func (c *UserClient) QueryFilters(_m *User) *FilterQuery {
-	query := (&FilterClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+// Hooks returns the client hooks.
+func (c *PaymentOrderClient) Hooks() []Hook {
+	return c.hooks.PaymentOrder
+}
+
+// Interceptors returns the client interceptors.
+func (c *PaymentOrderClient) Interceptors() []Interceptor {
+	return c.inters.PaymentOrder
+}
+
+func (c *PaymentOrderClient) mutate(ctx context.Context, m *PaymentOrderMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PaymentOrderCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PaymentOrderUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PaymentOrderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PaymentOrderDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown PaymentOrder mutation op: %q", m.Op())
+	}
+}
+
+// PaymentReconciliationReportClient is a client for the PaymentReconciliationReport schema.
+type PaymentReconciliationReportClient struct {
+	config
+}
+
+// NewPaymentReconciliationReportClient returns a client for the PaymentReconciliationReport from the given config.
+func NewPaymentReconciliationReportClient(c config) *PaymentReconciliationReportClient {
+	return &PaymentReconciliationReportClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `paymentreconciliationreport.Hooks(f(g(h())))`.
+func (c *PaymentReconciliationReportClient) Use(hooks ...Hook) {
+	c.hooks.PaymentReconciliationReport = append(c.hooks.PaymentReconciliationReport, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `paymentreconciliationreport.Intercept(f(g(h())))`.
+func (c *PaymentReconciliationReportClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PaymentReconciliationReport = append(c.inters.PaymentReconciliationReport, interceptors...)
+}
+
+// Create returns a builder for creating a PaymentReconciliationReport entity.
+func (c *PaymentReconciliationReportClient) Create() *PaymentReconciliationReportCreate {
+	mutation := newPaymentReconciliationReportMutation(c.config, OpCreate)
+	return &PaymentReconciliationReportCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PaymentReconciliationReport entities.
+func (c *PaymentReconciliationReportClient) CreateBulk(builders ...*PaymentReconciliationReportCreate) *PaymentReconciliationReportCreateBulk {
+	return &PaymentReconciliationReportCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PaymentReconciliationReportClient) MapCreateBulk(slice any, setFunc func(*PaymentReconciliationReportCreate, int)) *PaymentReconciliationReportCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PaymentReconciliationReportCreateBulk{err: fmt.Errorf("calling to PaymentReconciliationReportClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PaymentReconciliationReportCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PaymentReconciliationReportCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PaymentReconciliationReport.
+func (c *PaymentReconciliationReportClient) Update() *PaymentReconciliationReportUpdate {
+	mutation := newPaymentReconciliationReportMutation(c.config, OpUpdate)
+	return &PaymentReconciliationReportUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PaymentReconciliationReportClient) UpdateOne(_m *PaymentReconciliationReport) *PaymentReconciliationReportUpdateOne {
+	mutation := newPaymentReconciliationReportMutation(c.config, OpUpdateOne, withPaymentReconciliationReport(_m))
+	return &PaymentReconciliationReportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PaymentReconciliationReportClient) UpdateOneID(id string) *PaymentReconciliationReportUpdateOne {
+	mutation := newPaymentReconciliationReportMutation(c.config, OpUpdateOne, withPaymentReconciliationReportID(id))
+	return &PaymentReconciliationReportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PaymentReconciliationReport.
+func (c *PaymentReconciliationReportClient) Delete() *PaymentReconciliationReportDelete {
+	mutation := newPaymentReconciliationReportMutation(c.config, OpDelete)
+	return &PaymentReconciliationReportDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PaymentReconciliationReportClient) DeleteOne(_m *PaymentReconciliationReport) *PaymentReconciliationReportDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PaymentReconciliationReportClient) DeleteOneID(id string) *PaymentReconciliationReportDeleteOne {
+	builder := c.Delete().Where(paymentreconciliationreport.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PaymentReconciliationReportDeleteOne{builder}
+}
+
+// Query returns a query builder for PaymentReconciliationReport.
+func (c *PaymentReconciliationReportClient) Query() *PaymentReconciliationReportQuery {
+	return &PaymentReconciliationReportQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePaymentReconciliationReport},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PaymentReconciliationReport entity by its id.
+func (c *PaymentReconciliationReportClient) Get(ctx context.Context, id string) (*PaymentReconciliationReport, error) {
+	return c.Query().Where(paymentreconciliationreport.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PaymentReconciliationReportClient) GetX(ctx context.Context, id string) *PaymentReconciliationReport {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryDiscrepancies queries the discrepancies edge of a PaymentReconciliationReport.
+func (c *PaymentReconciliationReportClient) QueryDiscrepancies(_m *PaymentReconciliationReport) *PaymentDiscrepancyQuery {
+	query := (&PaymentDiscrepancyClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(paymentreconciliationreport.Table, paymentreconciliationreport.FieldID, id),
+			sqlgraph.To(paymentdiscrepancy.Table, paymentdiscrepancy.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, paymentreconciliationreport.DiscrepanciesTable, paymentreconciliationreport.DiscrepanciesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PaymentReconciliationReportClient) Hooks() []Hook {
+	return c.hooks.PaymentReconciliationReport
+}
+
+// Interceptors returns the client interceptors.
+func (c *PaymentReconciliationReportClient) Interceptors() []Interceptor {
+	return c.inters.PaymentReconciliationReport
+}
+
+func (c *PaymentReconciliationReportClient) mutate(ctx context.Context, m *PaymentReconciliationReportMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PaymentReconciliationReportCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PaymentReconciliationReportUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PaymentReconciliationReportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PaymentReconciliationReportDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown PaymentReconciliationReport mutation op: %q", m.Op())
+	}
+}
+
+// PaymentRefundClient is a client for the PaymentRefund schema.
+type PaymentRefundClient struct {
+	config
+}
+
+// NewPaymentRefundClient returns a client for the PaymentRefund from the given config.
+func NewPaymentRefundClient(c config) *PaymentRefundClient {
+	return &PaymentRefundClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `paymentrefund.Hooks(f(g(h())))`.
+func (c *PaymentRefundClient) Use(hooks ...Hook) {
+	c.hooks.PaymentRefund = append(c.hooks.PaymentRefund, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `paymentrefund.Intercept(f(g(h())))`.
+func (c *PaymentRefundClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PaymentRefund = append(c.inters.PaymentRefund, interceptors...)
+}
+
+// Create returns a builder for creating a PaymentRefund entity.
+func (c *PaymentRefundClient) Create() *PaymentRefundCreate {
+	mutation := newPaymentRefundMutation(c.config, OpCreate)
+	return &PaymentRefundCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PaymentRefund entities.
+func (c *PaymentRefundClient) CreateBulk(builders ...*PaymentRefundCreate) *PaymentRefundCreateBulk {
+	return &PaymentRefundCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PaymentRefundClient) MapCreateBulk(slice any, setFunc func(*PaymentRefundCreate, int)) *PaymentRefundCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PaymentRefundCreateBulk{err: fmt.Errorf("calling to PaymentRefundClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PaymentRefundCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PaymentRefundCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PaymentRefund.
+func (c *PaymentRefundClient) Update() *PaymentRefundUpdate {
+	mutation := newPaymentRefundMutation(c.config, OpUpdate)
+	return &PaymentRefundUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PaymentRefundClient) UpdateOne(_m *PaymentRefund) *PaymentRefundUpdateOne {
+	mutation := newPaymentRefundMutation(c.config, OpUpdateOne, withPaymentRefund(_m))
+	return &PaymentRefundUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PaymentRefundClient) UpdateOneID(id string) *PaymentRefundUpdateOne {
+	mutation := newPaymentRefundMutation(c.config, OpUpdateOne, withPaymentRefundID(id))
+	return &PaymentRefundUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PaymentRefund.
+func (c *PaymentRefundClient) Delete() *PaymentRefundDelete {
+	mutation := newPaymentRefundMutation(c.config, OpDelete)
+	return &PaymentRefundDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PaymentRefundClient) DeleteOne(_m *PaymentRefund) *PaymentRefundDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PaymentRefundClient) DeleteOneID(id string) *PaymentRefundDeleteOne {
+	builder := c.Delete().Where(paymentrefund.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PaymentRefundDeleteOne{builder}
+}
+
+// Query returns a query builder for PaymentRefund.
+func (c *PaymentRefundClient) Query() *PaymentRefundQuery {
+	return &PaymentRefundQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePaymentRefund},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PaymentRefund entity by its id.
+func (c *PaymentRefundClient) Get(ctx context.Context, id string) (*PaymentRefund, error) {
+	return c.Query().Where(paymentrefund.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PaymentRefundClient) GetX(ctx context.Context, id string) *PaymentRefund {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOrder queries the order edge of a PaymentRefund.
+func (c *PaymentRefundClient) QueryOrder(_m *PaymentRefund) *PaymentOrderQuery {
+	query := (&PaymentOrderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(paymentrefund.Table, paymentrefund.FieldID, id),
+			sqlgraph.To(paymentorder.Table, paymentorder.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, paymentrefund.OrderTable, paymentrefund.OrderColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PaymentRefundClient) Hooks() []Hook {
+	return c.hooks.PaymentRefund
+}
+
+// Interceptors returns the client interceptors.
+func (c *PaymentRefundClient) Interceptors() []Interceptor {
+	return c.inters.PaymentRefund
+}
+
+func (c *PaymentRefundClient) mutate(ctx context.Context, m *PaymentRefundMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PaymentRefundCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PaymentRefundUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PaymentRefundUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PaymentRefundDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown PaymentRefund mutation op: %q", m.Op())
+	}
+}
+
+// PhotoClient is a client for the Photo schema.
+type PhotoClient struct {
+	config
+}
+
+// NewPhotoClient returns a client for the Photo from the given config.
+func NewPhotoClient(c config) *PhotoClient {
+	return &PhotoClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `photo.Hooks(f(g(h())))`.
+func (c *PhotoClient) Use(hooks ...Hook) {
+	c.hooks.Photo = append(c.hooks.Photo, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `photo.Intercept(f(g(h())))`.
+func (c *PhotoClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Photo = append(c.inters.Photo, interceptors...)
+}
+
+// Create returns a builder for creating a Photo entity.
+func (c *PhotoClient) Create() *PhotoCreate {
+	mutation := newPhotoMutation(c.config, OpCreate)
+	return &PhotoCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Photo entities.
+func (c *PhotoClient) CreateBulk(builders ...*PhotoCreate) *PhotoCreateBulk {
+	return &PhotoCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PhotoClient) MapCreateBulk(slice any, setFunc func(*PhotoCreate, int)) *PhotoCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PhotoCreateBulk{err: fmt.Errorf("calling to PhotoClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PhotoCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PhotoCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Photo.
+func (c *PhotoClient) Update() *PhotoUpdate {
+	mutation := newPhotoMutation(c.config, OpUpdate)
+	return &PhotoUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PhotoClient) UpdateOne(_m *Photo) *PhotoUpdateOne {
+	mutation := newPhotoMutation(c.config, OpUpdateOne, withPhoto(_m))
+	return &PhotoUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PhotoClient) UpdateOneID(id string) *PhotoUpdateOne {
+	mutation := newPhotoMutation(c.config, OpUpdateOne, withPhotoID(id))
+	return &PhotoUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Photo.
+func (c *PhotoClient) Delete() *PhotoDelete {
+	mutation := newPhotoMutation(c.config, OpDelete)
+	return &PhotoDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PhotoClient) DeleteOne(_m *Photo) *PhotoDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PhotoClient) DeleteOneID(id string) *PhotoDeleteOne {
+	builder := c.Delete().Where(photo.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PhotoDeleteOne{builder}
+}
+
+// Query returns a query builder for Photo.
+func (c *PhotoClient) Query() *PhotoQuery {
+	return &PhotoQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePhoto},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Photo entity by its id.
+func (c *PhotoClient) Get(ctx context.Context, id string) (*Photo, error) {
+	return c.Query().Where(photo.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PhotoClient) GetX(ctx context.Context, id string) *Photo {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a Photo.
+func (c *PhotoClient) QueryUser(_m *Photo) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(photo.Table, photo.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, photo.UserTable, photo.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PhotoClient) Hooks() []Hook {
+	return c.hooks.Photo
+}
+
+// Interceptors returns the client interceptors.
+func (c *PhotoClient) Interceptors() []Interceptor {
+	return c.inters.Photo
+}
+
+func (c *PhotoClient) mutate(ctx context.Context, m *PhotoMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PhotoCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PhotoUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PhotoUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PhotoDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown Photo mutation op: %q", m.Op())
+	}
+}
+
+// ProfileClient is a client for the Profile schema.
+type ProfileClient struct {
+	config
+}
+
+// NewProfileClient returns a client for the Profile from the given config.
+func NewProfileClient(c config) *ProfileClient {
+	return &ProfileClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `profile.Hooks(f(g(h())))`.
+func (c *ProfileClient) Use(hooks ...Hook) {
+	c.hooks.Profile = append(c.hooks.Profile, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `profile.Intercept(f(g(h())))`.
+func (c *ProfileClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Profile = append(c.inters.Profile, interceptors...)
+}
+
+// Create returns a builder for creating a Profile entity.
+func (c *ProfileClient) Create() *ProfileCreate {
+	mutation := newProfileMutation(c.config, OpCreate)
+	return &ProfileCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Profile entities.
+func (c *ProfileClient) CreateBulk(builders ...*ProfileCreate) *ProfileCreateBulk {
+	return &ProfileCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ProfileClient) MapCreateBulk(slice any, setFunc func(*ProfileCreate, int)) *ProfileCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ProfileCreateBulk{err: fmt.Errorf("calling to ProfileClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ProfileCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ProfileCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Profile.
+func (c *ProfileClient) Update() *ProfileUpdate {
+	mutation := newProfileMutation(c.config, OpUpdate)
+	return &ProfileUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ProfileClient) UpdateOne(_m *Profile) *ProfileUpdateOne {
+	mutation := newProfileMutation(c.config, OpUpdateOne, withProfile(_m))
+	return &ProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ProfileClient) UpdateOneID(id string) *ProfileUpdateOne {
+	mutation := newProfileMutation(c.config, OpUpdateOne, withProfileID(id))
+	return &ProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Profile.
+func (c *ProfileClient) Delete() *ProfileDelete {
+	mutation := newProfileMutation(c.config, OpDelete)
+	return &ProfileDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ProfileClient) DeleteOne(_m *Profile) *ProfileDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ProfileClient) DeleteOneID(id string) *ProfileDeleteOne {
+	builder := c.Delete().Where(profile.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ProfileDeleteOne{builder}
+}
+
+// Query returns a query builder for Profile.
+func (c *ProfileClient) Query() *ProfileQuery {
+	return &ProfileQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeProfile},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Profile entity by its id.
+func (c *ProfileClient) Get(ctx context.Context, id string) (*Profile, error) {
+	return c.Query().Where(profile.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ProfileClient) GetX(ctx context.Context, id string) *Profile {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a Profile.
+func (c *ProfileClient) QueryUser(_m *Profile) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profile.Table, profile.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, profile.UserTable, profile.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ProfileClient) Hooks() []Hook {
+	return c.hooks.Profile
+}
+
+// Interceptors returns the client interceptors.
+func (c *ProfileClient) Interceptors() []Interceptor {
+	return c.inters.Profile
+}
+
+func (c *ProfileClient) mutate(ctx context.Context, m *ProfileMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ProfileCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ProfileUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ProfileDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown Profile mutation op: %q", m.Op())
+	}
+}
+
+// PromoCodeClient is a client for the PromoCode schema.
+type PromoCodeClient struct {
+	config
+}
+
+// NewPromoCodeClient returns a client for the PromoCode from the given config.
+func NewPromoCodeClient(c config) *PromoCodeClient {
+	return &PromoCodeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `promocode.Hooks(f(g(h())))`.
+func (c *PromoCodeClient) Use(hooks ...Hook) {
+	c.hooks.PromoCode = append(c.hooks.PromoCode, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `promocode.Intercept(f(g(h())))`.
+func (c *PromoCodeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PromoCode = append(c.inters.PromoCode, interceptors...)
+}
+
+// Create returns a builder for creating a PromoCode entity.
+func (c *PromoCodeClient) Create() *PromoCodeCreate {
+	mutation := newPromoCodeMutation(c.config, OpCreate)
+	return &PromoCodeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PromoCode entities.
+func (c *PromoCodeClient) CreateBulk(builders ...*PromoCodeCreate) *PromoCodeCreateBulk {
+	return &PromoCodeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PromoCodeClient) MapCreateBulk(slice any, setFunc func(*PromoCodeCreate, int)) *PromoCodeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PromoCodeCreateBulk{err: fmt.Errorf("calling to PromoCodeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PromoCodeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PromoCodeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PromoCode.
+func (c *PromoCodeClient) Update() *PromoCodeUpdate {
+	mutation := newPromoCodeMutation(c.config, OpUpdate)
+	return &PromoCodeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PromoCodeClient) UpdateOne(_m *PromoCode) *PromoCodeUpdateOne {
+	mutation := newPromoCodeMutation(c.config, OpUpdateOne, withPromoCode(_m))
+	return &PromoCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PromoCodeClient) UpdateOneID(id string) *PromoCodeUpdateOne {
+	mutation := newPromoCodeMutation(c.config, OpUpdateOne, withPromoCodeID(id))
+	return &PromoCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PromoCode.
+func (c *PromoCodeClient) Delete() *PromoCodeDelete {
+	mutation := newPromoCodeMutation(c.config, OpDelete)
+	return &PromoCodeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PromoCodeClient) DeleteOne(_m *PromoCode) *PromoCodeDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PromoCodeClient) DeleteOneID(id string) *PromoCodeDeleteOne {
+	builder := c.Delete().Where(promocode.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PromoCodeDeleteOne{builder}
+}
+
+// Query returns a query builder for PromoCode.
+func (c *PromoCodeClient) Query() *PromoCodeQuery {
+	return &PromoCodeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePromoCode},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PromoCode entity by its id.
+func (c *PromoCodeClient) Get(ctx context.Context, id string) (*PromoCode, error) {
+	return c.Query().Where(promocode.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PromoCodeClient) GetX(ctx context.Context, id string) *PromoCode {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryRedemptions queries the redemptions edge of a PromoCode.
+func (c *PromoCodeClient) QueryRedemptions(_m *PromoCode) *PromoRedemptionQuery {
+	query := (&PromoRedemptionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(promocode.Table, promocode.FieldID, id),
+			sqlgraph.To(promoredemption.Table, promoredemption.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, promocode.RedemptionsTable, promocode.RedemptionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PromoCodeClient) Hooks() []Hook {
+	return c.hooks.PromoCode
+}
+
+// Interceptors returns the client interceptors.
+func (c *PromoCodeClient) Interceptors() []Interceptor {
+	return c.inters.PromoCode
+}
+
+func (c *PromoCodeClient) mutate(ctx context.Context, m *PromoCodeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PromoCodeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PromoCodeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PromoCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PromoCodeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown PromoCode mutation op: %q", m.Op())
+	}
+}
+
+// PromoRedemptionClient is a client for the PromoRedemption schema.
+type PromoRedemptionClient struct {
+	config
+}
+
+// NewPromoRedemptionClient returns a client for the PromoRedemption from the given config.
+func NewPromoRedemptionClient(c config) *PromoRedemptionClient {
+	return &PromoRedemptionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `promoredemption.Hooks(f(g(h())))`.
+func (c *PromoRedemptionClient) Use(hooks ...Hook) {
+	c.hooks.PromoRedemption = append(c.hooks.PromoRedemption, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `promoredemption.Intercept(f(g(h())))`.
+func (c *PromoRedemptionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PromoRedemption = append(c.inters.PromoRedemption, interceptors...)
+}
+
+// Create returns a builder for creating a PromoRedemption entity.
+func (c *PromoRedemptionClient) Create() *PromoRedemptionCreate {
+	mutation := newPromoRedemptionMutation(c.config, OpCreate)
+	return &PromoRedemptionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PromoRedemption entities.
+func (c *PromoRedemptionClient) CreateBulk(builders ...*PromoRedemptionCreate) *PromoRedemptionCreateBulk {
+	return &PromoRedemptionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PromoRedemptionClient) MapCreateBulk(slice any, setFunc func(*PromoRedemptionCreate, int)) *PromoRedemptionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PromoRedemptionCreateBulk{err: fmt.Errorf("calling to PromoRedemptionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PromoRedemptionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PromoRedemptionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PromoRedemption.
+func (c *PromoRedemptionClient) Update() *PromoRedemptionUpdate {
+	mutation := newPromoRedemptionMutation(c.config, OpUpdate)
+	return &PromoRedemptionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PromoRedemptionClient) UpdateOne(_m *PromoRedemption) *PromoRedemptionUpdateOne {
+	mutation := newPromoRedemptionMutation(c.config, OpUpdateOne, withPromoRedemption(_m))
+	return &PromoRedemptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PromoRedemptionClient) UpdateOneID(id string) *PromoRedemptionUpdateOne {
+	mutation := newPromoRedemptionMutation(c.config, OpUpdateOne, withPromoRedemptionID(id))
+	return &PromoRedemptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PromoRedemption.
+func (c *PromoRedemptionClient) Delete() *PromoRedemptionDelete {
+	mutation := newPromoRedemptionMutation(c.config, OpDelete)
+	return &PromoRedemptionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PromoRedemptionClient) DeleteOne(_m *PromoRedemption) *PromoRedemptionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PromoRedemptionClient) DeleteOneID(id string) *PromoRedemptionDeleteOne {
+	builder := c.Delete().Where(promoredemption.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PromoRedemptionDeleteOne{builder}
+}
+
+// Query returns a query builder for PromoRedemption.
+func (c *PromoRedemptionClient) Query() *PromoRedemptionQuery {
+	return &PromoRedemptionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePromoRedemption},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PromoRedemption entity by its id.
+func (c *PromoRedemptionClient) Get(ctx context.Context, id string) (*PromoRedemption, error) {
+	return c.Query().Where(promoredemption.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PromoRedemptionClient) GetX(ctx context.Context, id string) *PromoRedemption {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPromoCode queries the promo_code edge of a PromoRedemption.
+func (c *PromoRedemptionClient) QueryPromoCode(_m *PromoRedemption) *PromoCodeQuery {
+	query := (&PromoCodeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(promoredemption.Table, promoredemption.FieldID, id),
+			sqlgraph.To(promocode.Table, promocode.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, promoredemption.PromoCodeTable, promoredemption.PromoCodeColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PromoRedemptionClient) Hooks() []Hook {
+	return c.hooks.PromoRedemption
+}
+
+// Interceptors returns the client interceptors.
+func (c *PromoRedemptionClient) Interceptors() []Interceptor {
+	return c.inters.PromoRedemption
+}
+
+func (c *PromoRedemptionClient) mutate(ctx context.Context, m *PromoRedemptionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PromoRedemptionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PromoRedemptionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PromoRedemptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PromoRedemptionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown PromoRedemption mutation op: %q", m.Op())
+	}
+}
+
+// ReferralClient is a client for the Referral schema.
+type ReferralClient struct {
+	config
+}
+
+// NewReferralClient returns a client for the Referral from the given config.
+func NewReferralClient(c config) *ReferralClient {
+	return &ReferralClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `referral.Hooks(f(g(h())))`.
+func (c *ReferralClient) Use(hooks ...Hook) {
+	c.hooks.Referral = append(c.hooks.Referral, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `referral.Intercept(f(g(h())))`.
+func (c *ReferralClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Referral = append(c.inters.Referral, interceptors...)
+}
+
+// Create returns a builder for creating a Referral entity.
+func (c *ReferralClient) Create() *ReferralCreate {
+	mutation := newReferralMutation(c.config, OpCreate)
+	return &ReferralCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Referral entities.
+func (c *ReferralClient) CreateBulk(builders ...*ReferralCreate) *ReferralCreateBulk {
+	return &ReferralCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ReferralClient) MapCreateBulk(slice any, setFunc func(*ReferralCreate, int)) *ReferralCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ReferralCreateBulk{err: fmt.Errorf("calling to ReferralClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ReferralCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ReferralCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Referral.
+func (c *ReferralClient) Update() *ReferralUpdate {
+	mutation := newReferralMutation(c.config, OpUpdate)
+	return &ReferralUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ReferralClient) UpdateOne(_m *Referral) *ReferralUpdateOne {
+	mutation := newReferralMutation(c.config, OpUpdateOne, withReferral(_m))
+	return &ReferralUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ReferralClient) UpdateOneID(id string) *ReferralUpdateOne {
+	mutation := newReferralMutation(c.config, OpUpdateOne, withReferralID(id))
+	return &ReferralUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Referral.
+func (c *ReferralClient) Delete() *ReferralDelete {
+	mutation := newReferralMutation(c.config, OpDelete)
+	return &ReferralDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ReferralClient) DeleteOne(_m *Referral) *ReferralDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ReferralClient) DeleteOneID(id string) *ReferralDeleteOne {
+	builder := c.Delete().Where(referral.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ReferralDeleteOne{builder}
+}
+
+// Query returns a query builder for Referral.
+func (c *ReferralClient) Query() *ReferralQuery {
+	return &ReferralQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeReferral},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Referral entity by its id.
+func (c *ReferralClient) Get(ctx context.Context, id string) (*Referral, error) {
+	return c.Query().Where(referral.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ReferralClient) GetX(ctx context.Context, id string) *Referral {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ReferralClient) Hooks() []Hook {
+	return c.hooks.Referral
+}
+
+// Interceptors returns the client interceptors.
+func (c *ReferralClient) Interceptors() []Interceptor {
+	return c.inters.Referral
+}
+
+func (c *ReferralClient) mutate(ctx context.Context, m *ReferralMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ReferralCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ReferralUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ReferralUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ReferralDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown Referral mutation op: %q", m.Op())
+	}
+}
+
+// ReportEvidenceClient is a client for the ReportEvidence schema.
+type ReportEvidenceClient struct {
+	config
+}
+
+// NewReportEvidenceClient returns a client for the ReportEvidence from the given config.
+func NewReportEvidenceClient(c config) *ReportEvidenceClient {
+	return &ReportEvidenceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `reportevidence.Hooks(f(g(h())))`.
+func (c *ReportEvidenceClient) Use(hooks ...Hook) {
+	c.hooks.ReportEvidence = append(c.hooks.ReportEvidence, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `reportevidence.Intercept(f(g(h())))`.
+func (c *ReportEvidenceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ReportEvidence = append(c.inters.ReportEvidence, interceptors...)
+}
+
+// Create returns a builder for creating a ReportEvidence entity.
+func (c *ReportEvidenceClient) Create() *ReportEvidenceCreate {
+	mutation := newReportEvidenceMutation(c.config, OpCreate)
+	return &ReportEvidenceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ReportEvidence entities.
+func (c *ReportEvidenceClient) CreateBulk(builders ...*ReportEvidenceCreate) *ReportEvidenceCreateBulk {
+	return &ReportEvidenceCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ReportEvidenceClient) MapCreateBulk(slice any, setFunc func(*ReportEvidenceCreate, int)) *ReportEvidenceCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ReportEvidenceCreateBulk{err: fmt.Errorf("calling to ReportEvidenceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ReportEvidenceCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ReportEvidenceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ReportEvidence.
+func (c *ReportEvidenceClient) Update() *ReportEvidenceUpdate {
+	mutation := newReportEvidenceMutation(c.config, OpUpdate)
+	return &ReportEvidenceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ReportEvidenceClient) UpdateOne(_m *ReportEvidence) *ReportEvidenceUpdateOne {
+	mutation := newReportEvidenceMutation(c.config, OpUpdateOne, withReportEvidence(_m))
+	return &ReportEvidenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ReportEvidenceClient) UpdateOneID(id string) *ReportEvidenceUpdateOne {
+	mutation := newReportEvidenceMutation(c.config, OpUpdateOne, withReportEvidenceID(id))
+	return &ReportEvidenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ReportEvidence.
+func (c *ReportEvidenceClient) Delete() *ReportEvidenceDelete {
+	mutation := newReportEvidenceMutation(c.config, OpDelete)
+	return &ReportEvidenceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ReportEvidenceClient) DeleteOne(_m *ReportEvidence) *ReportEvidenceDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ReportEvidenceClient) DeleteOneID(id string) *ReportEvidenceDeleteOne {
+	builder := c.Delete().Where(reportevidence.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ReportEvidenceDeleteOne{builder}
+}
+
+// Query returns a query builder for ReportEvidence.
+func (c *ReportEvidenceClient) Query() *ReportEvidenceQuery {
+	return &ReportEvidenceQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeReportEvidence},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ReportEvidence entity by its id.
+func (c *ReportEvidenceClient) Get(ctx context.Context, id string) (*ReportEvidence, error) {
+	return c.Query().Where(reportevidence.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ReportEvidenceClient) GetX(ctx context.Context, id string) *ReportEvidence {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryReport queries the report edge of a ReportEvidence.
+func (c *ReportEvidenceClient) QueryReport(_m *ReportEvidence) *UserReportQuery {
+	query := (&UserReportClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(reportevidence.Table, reportevidence.FieldID, id),
+			sqlgraph.To(userreport.Table, userreport.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, reportevidence.ReportTable, reportevidence.ReportColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ReportEvidenceClient) Hooks() []Hook {
+	return c.hooks.ReportEvidence
+}
+
+// Interceptors returns the client interceptors.
+func (c *ReportEvidenceClient) Interceptors() []Interceptor {
+	return c.inters.ReportEvidence
+}
+
+func (c *ReportEvidenceClient) mutate(ctx context.Context, m *ReportEvidenceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ReportEvidenceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ReportEvidenceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ReportEvidenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ReportEvidenceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown ReportEvidence mutation op: %q", m.Op())
+	}
+}
+
+// RevealClient is a client for the Reveal schema.
+type RevealClient struct {
+	config
+}
+
+// NewRevealClient returns a client for the Reveal from the given config.
+func NewRevealClient(c config) *RevealClient {
+	return &RevealClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `reveal.Hooks(f(g(h())))`.
+func (c *RevealClient) Use(hooks ...Hook) {
+	c.hooks.Reveal = append(c.hooks.Reveal, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `reveal.Intercept(f(g(h())))`.
+func (c *RevealClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Reveal = append(c.inters.Reveal, interceptors...)
+}
+
+// Create returns a builder for creating a Reveal entity.
+func (c *RevealClient) Create() *RevealCreate {
+	mutation := newRevealMutation(c.config, OpCreate)
+	return &RevealCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Reveal entities.
+func (c *RevealClient) CreateBulk(builders ...*RevealCreate) *RevealCreateBulk {
+	return &RevealCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RevealClient) MapCreateBulk(slice any, setFunc func(*RevealCreate, int)) *RevealCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RevealCreateBulk{err: fmt.Errorf("calling to RevealClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RevealCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RevealCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Reveal.
+func (c *RevealClient) Update() *RevealUpdate {
+	mutation := newRevealMutation(c.config, OpUpdate)
+	return &RevealUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RevealClient) UpdateOne(_m *Reveal) *RevealUpdateOne {
+	mutation := newRevealMutation(c.config, OpUpdateOne, withReveal(_m))
+	return &RevealUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RevealClient) UpdateOneID(id string) *RevealUpdateOne {
+	mutation := newRevealMutation(c.config, OpUpdateOne, withRevealID(id))
+	return &RevealUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Reveal.
+func (c *RevealClient) Delete() *RevealDelete {
+	mutation := newRevealMutation(c.config, OpDelete)
+	return &RevealDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RevealClient) DeleteOne(_m *Reveal) *RevealDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RevealClient) DeleteOneID(id string) *RevealDeleteOne {
+	builder := c.Delete().Where(reveal.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RevealDeleteOne{builder}
+}
+
+// Query returns a query builder for Reveal.
+func (c *RevealClient) Query() *RevealQuery {
+	return &RevealQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeReveal},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Reveal entity by its id.
+func (c *RevealClient) Get(ctx context.Context, id string) (*Reveal, error) {
+	return c.Query().Where(reveal.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RevealClient) GetX(ctx context.Context, id string) *Reveal {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryConnection queries the connection edge of a Reveal.
+func (c *RevealClient) QueryConnection(_m *Reveal) *ConnectionQuery {
+	query := (&ConnectionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(reveal.Table, reveal.FieldID, id),
+			sqlgraph.To(connection.Table, connection.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, reveal.ConnectionTable, reveal.ConnectionColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMilestone queries the milestone edge of a Reveal.
+func (c *RevealClient) QueryMilestone(_m *Reveal) *RevealMilestoneQuery {
+	query := (&RevealMilestoneClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(reveal.Table, reveal.FieldID, id),
+			sqlgraph.To(revealmilestone.Table, revealmilestone.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, reveal.MilestoneTable, reveal.MilestoneColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryContent queries the content edge of a Reveal.
+func (c *RevealClient) QueryContent(_m *Reveal) *RevealContentQuery {
+	query := (&RevealContentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(reveal.Table, reveal.FieldID, id),
+			sqlgraph.To(revealcontent.Table, revealcontent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, reveal.ContentTable, reveal.ContentColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryViews queries the views edge of a Reveal.
+func (c *RevealClient) QueryViews(_m *Reveal) *RevealViewQuery {
+	query := (&RevealViewClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(reveal.Table, reveal.FieldID, id),
+			sqlgraph.To(revealview.Table, revealview.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, reveal.ViewsTable, reveal.ViewsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RevealClient) Hooks() []Hook {
+	return c.hooks.Reveal
+}
+
+// Interceptors returns the client interceptors.
+func (c *RevealClient) Interceptors() []Interceptor {
+	return c.inters.Reveal
+}
+
+func (c *RevealClient) mutate(ctx context.Context, m *RevealMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RevealCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RevealUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RevealUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RevealDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown Reveal mutation op: %q", m.Op())
+	}
+}
+
+// RevealContentClient is a client for the RevealContent schema.
+type RevealContentClient struct {
+	config
+}
+
+// NewRevealContentClient returns a client for the RevealContent from the given config.
+func NewRevealContentClient(c config) *RevealContentClient {
+	return &RevealContentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `revealcontent.Hooks(f(g(h())))`.
+func (c *RevealContentClient) Use(hooks ...Hook) {
+	c.hooks.RevealContent = append(c.hooks.RevealContent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `revealcontent.Intercept(f(g(h())))`.
+func (c *RevealContentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RevealContent = append(c.inters.RevealContent, interceptors...)
+}
+
+// Create returns a builder for creating a RevealContent entity.
+func (c *RevealContentClient) Create() *RevealContentCreate {
+	mutation := newRevealContentMutation(c.config, OpCreate)
+	return &RevealContentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RevealContent entities.
+func (c *RevealContentClient) CreateBulk(builders ...*RevealContentCreate) *RevealContentCreateBulk {
+	return &RevealContentCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RevealContentClient) MapCreateBulk(slice any, setFunc func(*RevealContentCreate, int)) *RevealContentCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RevealContentCreateBulk{err: fmt.Errorf("calling to RevealContentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RevealContentCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RevealContentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RevealContent.
+func (c *RevealContentClient) Update() *RevealContentUpdate {
+	mutation := newRevealContentMutation(c.config, OpUpdate)
+	return &RevealContentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RevealContentClient) UpdateOne(_m *RevealContent) *RevealContentUpdateOne {
+	mutation := newRevealContentMutation(c.config, OpUpdateOne, withRevealContent(_m))
+	return &RevealContentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RevealContentClient) UpdateOneID(id string) *RevealContentUpdateOne {
+	mutation := newRevealContentMutation(c.config, OpUpdateOne, withRevealContentID(id))
+	return &RevealContentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RevealContent.
+func (c *RevealContentClient) Delete() *RevealContentDelete {
+	mutation := newRevealContentMutation(c.config, OpDelete)
+	return &RevealContentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RevealContentClient) DeleteOne(_m *RevealContent) *RevealContentDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RevealContentClient) DeleteOneID(id string) *RevealContentDeleteOne {
+	builder := c.Delete().Where(revealcontent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RevealContentDeleteOne{builder}
+}
+
+// Query returns a query builder for RevealContent.
+func (c *RevealContentClient) Query() *RevealContentQuery {
+	return &RevealContentQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRevealContent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RevealContent entity by its id.
+func (c *RevealContentClient) Get(ctx context.Context, id string) (*RevealContent, error) {
+	return c.Query().Where(revealcontent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RevealContentClient) GetX(ctx context.Context, id string) *RevealContent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryReveal queries the reveal edge of a RevealContent.
+func (c *RevealContentClient) QueryReveal(_m *RevealContent) *RevealQuery {
+	query := (&RevealClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(revealcontent.Table, revealcontent.FieldID, id),
+			sqlgraph.To(reveal.Table, reveal.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, revealcontent.RevealTable, revealcontent.RevealColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RevealContentClient) Hooks() []Hook {
+	return c.hooks.RevealContent
+}
+
+// Interceptors returns the client interceptors.
+func (c *RevealContentClient) Interceptors() []Interceptor {
+	return c.inters.RevealContent
+}
+
+func (c *RevealContentClient) mutate(ctx context.Context, m *RevealContentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RevealContentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RevealContentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RevealContentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RevealContentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown RevealContent mutation op: %q", m.Op())
+	}
+}
+
+// RevealGiftClient is a client for the RevealGift schema.
+type RevealGiftClient struct {
+	config
+}
+
+// NewRevealGiftClient returns a client for the RevealGift from the given config.
+func NewRevealGiftClient(c config) *RevealGiftClient {
+	return &RevealGiftClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `revealgift.Hooks(f(g(h())))`.
+func (c *RevealGiftClient) Use(hooks ...Hook) {
+	c.hooks.RevealGift = append(c.hooks.RevealGift, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `revealgift.Intercept(f(g(h())))`.
+func (c *RevealGiftClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RevealGift = append(c.inters.RevealGift, interceptors...)
+}
+
+// Create returns a builder for creating a RevealGift entity.
+func (c *RevealGiftClient) Create() *RevealGiftCreate {
+	mutation := newRevealGiftMutation(c.config, OpCreate)
+	return &RevealGiftCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RevealGift entities.
+func (c *RevealGiftClient) CreateBulk(builders ...*RevealGiftCreate) *RevealGiftCreateBulk {
+	return &RevealGiftCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RevealGiftClient) MapCreateBulk(slice any, setFunc func(*RevealGiftCreate, int)) *RevealGiftCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RevealGiftCreateBulk{err: fmt.Errorf("calling to RevealGiftClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RevealGiftCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RevealGiftCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RevealGift.
+func (c *RevealGiftClient) Update() *RevealGiftUpdate {
+	mutation := newRevealGiftMutation(c.config, OpUpdate)
+	return &RevealGiftUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RevealGiftClient) UpdateOne(_m *RevealGift) *RevealGiftUpdateOne {
+	mutation := newRevealGiftMutation(c.config, OpUpdateOne, withRevealGift(_m))
+	return &RevealGiftUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RevealGiftClient) UpdateOneID(id string) *RevealGiftUpdateOne {
+	mutation := newRevealGiftMutation(c.config, OpUpdateOne, withRevealGiftID(id))
+	return &RevealGiftUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RevealGift.
+func (c *RevealGiftClient) Delete() *RevealGiftDelete {
+	mutation := newRevealGiftMutation(c.config, OpDelete)
+	return &RevealGiftDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RevealGiftClient) DeleteOne(_m *RevealGift) *RevealGiftDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RevealGiftClient) DeleteOneID(id string) *RevealGiftDeleteOne {
+	builder := c.Delete().Where(revealgift.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RevealGiftDeleteOne{builder}
+}
+
+// Query returns a query builder for RevealGift.
+func (c *RevealGiftClient) Query() *RevealGiftQuery {
+	return &RevealGiftQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRevealGift},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RevealGift entity by its id.
+func (c *RevealGiftClient) Get(ctx context.Context, id string) (*RevealGift, error) {
+	return c.Query().Where(revealgift.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RevealGiftClient) GetX(ctx context.Context, id string) *RevealGift {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryConnection queries the connection edge of a RevealGift.
+func (c *RevealGiftClient) QueryConnection(_m *RevealGift) *ConnectionQuery {
+	query := (&ConnectionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(revealgift.Table, revealgift.FieldID, id),
+			sqlgraph.To(connection.Table, connection.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, revealgift.ConnectionTable, revealgift.ConnectionColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMilestone queries the milestone edge of a RevealGift.
+func (c *RevealGiftClient) QueryMilestone(_m *RevealGift) *RevealMilestoneQuery {
+	query := (&RevealMilestoneClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(revealgift.Table, revealgift.FieldID, id),
+			sqlgraph.To(revealmilestone.Table, revealmilestone.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, revealgift.MilestoneTable, revealgift.MilestoneColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryGifter queries the gifter edge of a RevealGift.
+func (c *RevealGiftClient) QueryGifter(_m *RevealGift) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(revealgift.Table, revealgift.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, revealgift.GifterTable, revealgift.GifterColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRecipient queries the recipient edge of a RevealGift.
+func (c *RevealGiftClient) QueryRecipient(_m *RevealGift) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(revealgift.Table, revealgift.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, revealgift.RecipientTable, revealgift.RecipientColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RevealGiftClient) Hooks() []Hook {
+	return c.hooks.RevealGift
+}
+
+// Interceptors returns the client interceptors.
+func (c *RevealGiftClient) Interceptors() []Interceptor {
+	return c.inters.RevealGift
+}
+
+func (c *RevealGiftClient) mutate(ctx context.Context, m *RevealGiftMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RevealGiftCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RevealGiftUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RevealGiftUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RevealGiftDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown RevealGift mutation op: %q", m.Op())
+	}
+}
+
+// RevealMilestoneClient is a client for the RevealMilestone schema.
+type RevealMilestoneClient struct {
+	config
+}
+
+// NewRevealMilestoneClient returns a client for the RevealMilestone from the given config.
+func NewRevealMilestoneClient(c config) *RevealMilestoneClient {
+	return &RevealMilestoneClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `revealmilestone.Hooks(f(g(h())))`.
+func (c *RevealMilestoneClient) Use(hooks ...Hook) {
+	c.hooks.RevealMilestone = append(c.hooks.RevealMilestone, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `revealmilestone.Intercept(f(g(h())))`.
+func (c *RevealMilestoneClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RevealMilestone = append(c.inters.RevealMilestone, interceptors...)
+}
+
+// Create returns a builder for creating a RevealMilestone entity.
+func (c *RevealMilestoneClient) Create() *RevealMilestoneCreate {
+	mutation := newRevealMilestoneMutation(c.config, OpCreate)
+	return &RevealMilestoneCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RevealMilestone entities.
+func (c *RevealMilestoneClient) CreateBulk(builders ...*RevealMilestoneCreate) *RevealMilestoneCreateBulk {
+	return &RevealMilestoneCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RevealMilestoneClient) MapCreateBulk(slice any, setFunc func(*RevealMilestoneCreate, int)) *RevealMilestoneCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RevealMilestoneCreateBulk{err: fmt.Errorf("calling to RevealMilestoneClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RevealMilestoneCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RevealMilestoneCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RevealMilestone.
+func (c *RevealMilestoneClient) Update() *RevealMilestoneUpdate {
+	mutation := newRevealMilestoneMutation(c.config, OpUpdate)
+	return &RevealMilestoneUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RevealMilestoneClient) UpdateOne(_m *RevealMilestone) *RevealMilestoneUpdateOne {
+	mutation := newRevealMilestoneMutation(c.config, OpUpdateOne, withRevealMilestone(_m))
+	return &RevealMilestoneUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RevealMilestoneClient) UpdateOneID(id string) *RevealMilestoneUpdateOne {
+	mutation := newRevealMilestoneMutation(c.config, OpUpdateOne, withRevealMilestoneID(id))
+	return &RevealMilestoneUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RevealMilestone.
+func (c *RevealMilestoneClient) Delete() *RevealMilestoneDelete {
+	mutation := newRevealMilestoneMutation(c.config, OpDelete)
+	return &RevealMilestoneDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RevealMilestoneClient) DeleteOne(_m *RevealMilestone) *RevealMilestoneDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RevealMilestoneClient) DeleteOneID(id string) *RevealMilestoneDeleteOne {
+	builder := c.Delete().Where(revealmilestone.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RevealMilestoneDeleteOne{builder}
+}
+
+// Query returns a query builder for RevealMilestone.
+func (c *RevealMilestoneClient) Query() *RevealMilestoneQuery {
+	return &RevealMilestoneQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRevealMilestone},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RevealMilestone entity by its id.
+func (c *RevealMilestoneClient) Get(ctx context.Context, id string) (*RevealMilestone, error) {
+	return c.Query().Where(revealmilestone.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RevealMilestoneClient) GetX(ctx context.Context, id string) *RevealMilestone {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryReveals queries the reveals edge of a RevealMilestone.
+func (c *RevealMilestoneClient) QueryReveals(_m *RevealMilestone) *RevealQuery {
+	query := (&RevealClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(revealmilestone.Table, revealmilestone.FieldID, id),
+			sqlgraph.To(reveal.Table, reveal.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, revealmilestone.RevealsTable, revealmilestone.RevealsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryGifts queries the gifts edge of a RevealMilestone.
+func (c *RevealMilestoneClient) QueryGifts(_m *RevealMilestone) *RevealGiftQuery {
+	query := (&RevealGiftClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(revealmilestone.Table, revealmilestone.FieldID, id),
+			sqlgraph.To(revealgift.Table, revealgift.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, revealmilestone.GiftsTable, revealmilestone.GiftsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RevealMilestoneClient) Hooks() []Hook {
+	return c.hooks.RevealMilestone
+}
+
+// Interceptors returns the client interceptors.
+func (c *RevealMilestoneClient) Interceptors() []Interceptor {
+	return c.inters.RevealMilestone
+}
+
+func (c *RevealMilestoneClient) mutate(ctx context.Context, m *RevealMilestoneMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RevealMilestoneCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RevealMilestoneUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RevealMilestoneUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RevealMilestoneDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown RevealMilestone mutation op: %q", m.Op())
+	}
+}
+
+// RevealViewClient is a client for the RevealView schema.
+type RevealViewClient struct {
+	config
+}
+
+// NewRevealViewClient returns a client for the RevealView from the given config.
+func NewRevealViewClient(c config) *RevealViewClient {
+	return &RevealViewClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `revealview.Hooks(f(g(h())))`.
+func (c *RevealViewClient) Use(hooks ...Hook) {
+	c.hooks.RevealView = append(c.hooks.RevealView, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `revealview.Intercept(f(g(h())))`.
+func (c *RevealViewClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RevealView = append(c.inters.RevealView, interceptors...)
+}
+
+// Create returns a builder for creating a RevealView entity.
+func (c *RevealViewClient) Create() *RevealViewCreate {
+	mutation := newRevealViewMutation(c.config, OpCreate)
+	return &RevealViewCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RevealView entities.
+func (c *RevealViewClient) CreateBulk(builders ...*RevealViewCreate) *RevealViewCreateBulk {
+	return &RevealViewCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RevealViewClient) MapCreateBulk(slice any, setFunc func(*RevealViewCreate, int)) *RevealViewCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RevealViewCreateBulk{err: fmt.Errorf("calling to RevealViewClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RevealViewCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RevealViewCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RevealView.
+func (c *RevealViewClient) Update() *RevealViewUpdate {
+	mutation := newRevealViewMutation(c.config, OpUpdate)
+	return &RevealViewUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RevealViewClient) UpdateOne(_m *RevealView) *RevealViewUpdateOne {
+	mutation := newRevealViewMutation(c.config, OpUpdateOne, withRevealView(_m))
+	return &RevealViewUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RevealViewClient) UpdateOneID(id string) *RevealViewUpdateOne {
+	mutation := newRevealViewMutation(c.config, OpUpdateOne, withRevealViewID(id))
+	return &RevealViewUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RevealView.
+func (c *RevealViewClient) Delete() *RevealViewDelete {
+	mutation := newRevealViewMutation(c.config, OpDelete)
+	return &RevealViewDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RevealViewClient) DeleteOne(_m *RevealView) *RevealViewDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RevealViewClient) DeleteOneID(id string) *RevealViewDeleteOne {
+	builder := c.Delete().Where(revealview.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RevealViewDeleteOne{builder}
+}
+
+// Query returns a query builder for RevealView.
+func (c *RevealViewClient) Query() *RevealViewQuery {
+	return &RevealViewQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRevealView},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RevealView entity by its id.
+func (c *RevealViewClient) Get(ctx context.Context, id string) (*RevealView, error) {
+	return c.Query().Where(revealview.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RevealViewClient) GetX(ctx context.Context, id string) *RevealView {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryReveal queries the reveal edge of a RevealView.
+func (c *RevealViewClient) QueryReveal(_m *RevealView) *RevealQuery {
+	query := (&RevealClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(revealview.Table, revealview.FieldID, id),
+			sqlgraph.To(reveal.Table, reveal.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, revealview.RevealTable, revealview.RevealColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryViewer queries the viewer edge of a RevealView.
+func (c *RevealViewClient) QueryViewer(_m *RevealView) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(revealview.Table, revealview.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, revealview.ViewerTable, revealview.ViewerColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RevealViewClient) Hooks() []Hook {
+	return c.hooks.RevealView
+}
+
+// Interceptors returns the client interceptors.
+func (c *RevealViewClient) Interceptors() []Interceptor {
+	return c.inters.RevealView
+}
+
+func (c *RevealViewClient) mutate(ctx context.Context, m *RevealViewMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RevealViewCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RevealViewUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RevealViewUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RevealViewDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown RevealView mutation op: %q", m.Op())
+	}
+}
+
+// ServerClient is a client for the Server schema.
+type ServerClient struct {
+	config
+}
+
+// NewServerClient returns a client for the Server from the given config.
+func NewServerClient(c config) *ServerClient {
+	return &ServerClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `server.Hooks(f(g(h())))`.
+func (c *ServerClient) Use(hooks ...Hook) {
+	c.hooks.Server = append(c.hooks.Server, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `server.Intercept(f(g(h())))`.
+func (c *ServerClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Server = append(c.inters.Server, interceptors...)
+}
+
+// Create returns a builder for creating a Server entity.
+func (c *ServerClient) Create() *ServerCreate {
+	mutation := newServerMutation(c.config, OpCreate)
+	return &ServerCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Server entities.
+func (c *ServerClient) CreateBulk(builders ...*ServerCreate) *ServerCreateBulk {
+	return &ServerCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ServerClient) MapCreateBulk(slice any, setFunc func(*ServerCreate, int)) *ServerCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ServerCreateBulk{err: fmt.Errorf("calling to ServerClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ServerCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ServerCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Server.
+func (c *ServerClient) Update() *ServerUpdate {
+	mutation := newServerMutation(c.config, OpUpdate)
+	return &ServerUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ServerClient) UpdateOne(_m *Server) *ServerUpdateOne {
+	mutation := newServerMutation(c.config, OpUpdateOne, withServer(_m))
+	return &ServerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ServerClient) UpdateOneID(id string) *ServerUpdateOne {
+	mutation := newServerMutation(c.config, OpUpdateOne, withServerID(id))
+	return &ServerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Server.
+func (c *ServerClient) Delete() *ServerDelete {
+	mutation := newServerMutation(c.config, OpDelete)
+	return &ServerDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ServerClient) DeleteOne(_m *Server) *ServerDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ServerClient) DeleteOneID(id string) *ServerDeleteOne {
+	builder := c.Delete().Where(server.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ServerDeleteOne{builder}
+}
+
+// Query returns a query builder for Server.
+func (c *ServerClient) Query() *ServerQuery {
+	return &ServerQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeServer},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Server entity by its id.
+func (c *ServerClient) Get(ctx context.Context, id string) (*Server, error) {
+	return c.Query().Where(server.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ServerClient) GetX(ctx context.Context, id string) *Server {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ServerClient) Hooks() []Hook {
+	return c.hooks.Server
+}
+
+// Interceptors returns the client interceptors.
+func (c *ServerClient) Interceptors() []Interceptor {
+	return c.inters.Server
+}
+
+func (c *ServerClient) mutate(ctx context.Context, m *ServerMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ServerCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ServerUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ServerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ServerDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown Server mutation op: %q", m.Op())
+	}
+}
+
+// StreakClient is a client for the Streak schema.
+type StreakClient struct {
+	config
+}
+
+// NewStreakClient returns a client for the Streak from the given config.
+func NewStreakClient(c config) *StreakClient {
+	return &StreakClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `streak.Hooks(f(g(h())))`.
+func (c *StreakClient) Use(hooks ...Hook) {
+	c.hooks.Streak = append(c.hooks.Streak, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `streak.Intercept(f(g(h())))`.
+func (c *StreakClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Streak = append(c.inters.Streak, interceptors...)
+}
+
+// Create returns a builder for creating a Streak entity.
+func (c *StreakClient) Create() *StreakCreate {
+	mutation := newStreakMutation(c.config, OpCreate)
+	return &StreakCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Streak entities.
+func (c *StreakClient) CreateBulk(builders ...*StreakCreate) *StreakCreateBulk {
+	return &StreakCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *StreakClient) MapCreateBulk(slice any, setFunc func(*StreakCreate, int)) *StreakCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &StreakCreateBulk{err: fmt.Errorf("calling to StreakClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*StreakCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &StreakCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Streak.
+func (c *StreakClient) Update() *StreakUpdate {
+	mutation := newStreakMutation(c.config, OpUpdate)
+	return &StreakUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *StreakClient) UpdateOne(_m *Streak) *StreakUpdateOne {
+	mutation := newStreakMutation(c.config, OpUpdateOne, withStreak(_m))
+	return &StreakUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *StreakClient) UpdateOneID(id string) *StreakUpdateOne {
+	mutation := newStreakMutation(c.config, OpUpdateOne, withStreakID(id))
+	return &StreakUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Streak.
+func (c *StreakClient) Delete() *StreakDelete {
+	mutation := newStreakMutation(c.config, OpDelete)
+	return &StreakDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *StreakClient) DeleteOne(_m *Streak) *StreakDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *StreakClient) DeleteOneID(id string) *StreakDeleteOne {
+	builder := c.Delete().Where(streak.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &StreakDeleteOne{builder}
+}
+
+// Query returns a query builder for Streak.
+func (c *StreakClient) Query() *StreakQuery {
+	return &StreakQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeStreak},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Streak entity by its id.
+func (c *StreakClient) Get(ctx context.Context, id string) (*Streak, error) {
+	return c.Query().Where(streak.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *StreakClient) GetX(ctx context.Context, id string) *Streak {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryConnection queries the connection edge of a Streak.
+func (c *StreakClient) QueryConnection(_m *Streak) *ConnectionQuery {
+	query := (&ConnectionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(streak.Table, streak.FieldID, id),
+			sqlgraph.To(connection.Table, connection.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, streak.ConnectionTable, streak.ConnectionColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBreaker queries the breaker edge of a Streak.
+func (c *StreakClient) QueryBreaker(_m *Streak) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(streak.Table, streak.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, streak.BreakerTable, streak.BreakerColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCheckIns queries the check_ins edge of a Streak.
+func (c *StreakClient) QueryCheckIns(_m *Streak) *CheckInQuery {
+	query := (&CheckInClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(streak.Table, streak.FieldID, id),
+			sqlgraph.To(checkin.Table, checkin.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, streak.CheckInsTable, streak.CheckInsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryNudges queries the nudges edge of a Streak.
+func (c *StreakClient) QueryNudges(_m *Streak) *NudgeQuery {
+	query := (&NudgeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(streak.Table, streak.FieldID, id),
+			sqlgraph.To(nudge.Table, nudge.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, streak.NudgesTable, streak.NudgesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *StreakClient) Hooks() []Hook {
+	return c.hooks.Streak
+}
+
+// Interceptors returns the client interceptors.
+func (c *StreakClient) Interceptors() []Interceptor {
+	return c.inters.Streak
+}
+
+func (c *StreakClient) mutate(ctx context.Context, m *StreakMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&StreakCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&StreakUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&StreakUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&StreakDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown Streak mutation op: %q", m.Op())
+	}
+}
+
+// SubscriptionClient is a client for the Subscription schema.
+type SubscriptionClient struct {
+	config
+}
+
+// NewSubscriptionClient returns a client for the Subscription from the given config.
+func NewSubscriptionClient(c config) *SubscriptionClient {
+	return &SubscriptionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `subscription.Hooks(f(g(h())))`.
+func (c *SubscriptionClient) Use(hooks ...Hook) {
+	c.hooks.Subscription = append(c.hooks.Subscription, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `subscription.Intercept(f(g(h())))`.
+func (c *SubscriptionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Subscription = append(c.inters.Subscription, interceptors...)
+}
+
+// Create returns a builder for creating a Subscription entity.
+func (c *SubscriptionClient) Create() *SubscriptionCreate {
+	mutation := newSubscriptionMutation(c.config, OpCreate)
+	return &SubscriptionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Subscription entities.
+func (c *SubscriptionClient) CreateBulk(builders ...*SubscriptionCreate) *SubscriptionCreateBulk {
+	return &SubscriptionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SubscriptionClient) MapCreateBulk(slice any, setFunc func(*SubscriptionCreate, int)) *SubscriptionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SubscriptionCreateBulk{err: fmt.Errorf("calling to SubscriptionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SubscriptionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SubscriptionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Subscription.
+func (c *SubscriptionClient) Update() *SubscriptionUpdate {
+	mutation := newSubscriptionMutation(c.config, OpUpdate)
+	return &SubscriptionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SubscriptionClient) UpdateOne(_m *Subscription) *SubscriptionUpdateOne {
+	mutation := newSubscriptionMutation(c.config, OpUpdateOne, withSubscription(_m))
+	return &SubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SubscriptionClient) UpdateOneID(id string) *SubscriptionUpdateOne {
+	mutation := newSubscriptionMutation(c.config, OpUpdateOne, withSubscriptionID(id))
+	return &SubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Subscription.
+func (c *SubscriptionClient) Delete() *SubscriptionDelete {
+	mutation := newSubscriptionMutation(c.config, OpDelete)
+	return &SubscriptionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SubscriptionClient) DeleteOne(_m *Subscription) *SubscriptionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SubscriptionClient) DeleteOneID(id string) *SubscriptionDeleteOne {
+	builder := c.Delete().Where(subscription.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SubscriptionDeleteOne{builder}
+}
+
+// Query returns a query builder for Subscription.
+func (c *SubscriptionClient) Query() *SubscriptionQuery {
+	return &SubscriptionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSubscription},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Subscription entity by its id.
+func (c *SubscriptionClient) Get(ctx context.Context, id string) (*Subscription, error) {
+	return c.Query().Where(subscription.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SubscriptionClient) GetX(ctx context.Context, id string) *Subscription {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a Subscription.
+func (c *SubscriptionClient) QueryUser(_m *Subscription) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(subscription.Table, subscription.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, subscription.UserTable, subscription.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPlan queries the plan edge of a Subscription.
+func (c *SubscriptionClient) QueryPlan(_m *Subscription) *SubscriptionPlanQuery {
+	query := (&SubscriptionPlanClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(subscription.Table, subscription.FieldID, id),
+			sqlgraph.To(subscriptionplan.Table, subscriptionplan.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, subscription.PlanTable, subscription.PlanColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SubscriptionClient) Hooks() []Hook {
+	return c.hooks.Subscription
+}
+
+// Interceptors returns the client interceptors.
+func (c *SubscriptionClient) Interceptors() []Interceptor {
+	return c.inters.Subscription
+}
+
+func (c *SubscriptionClient) mutate(ctx context.Context, m *SubscriptionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SubscriptionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SubscriptionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SubscriptionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown Subscription mutation op: %q", m.Op())
+	}
+}
+
+// SubscriptionPlanClient is a client for the SubscriptionPlan schema.
+type SubscriptionPlanClient struct {
+	config
+}
+
+// NewSubscriptionPlanClient returns a client for the SubscriptionPlan from the given config.
+func NewSubscriptionPlanClient(c config) *SubscriptionPlanClient {
+	return &SubscriptionPlanClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `subscriptionplan.Hooks(f(g(h())))`.
+func (c *SubscriptionPlanClient) Use(hooks ...Hook) {
+	c.hooks.SubscriptionPlan = append(c.hooks.SubscriptionPlan, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `subscriptionplan.Intercept(f(g(h())))`.
+func (c *SubscriptionPlanClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SubscriptionPlan = append(c.inters.SubscriptionPlan, interceptors...)
+}
+
+// Create returns a builder for creating a SubscriptionPlan entity.
+func (c *SubscriptionPlanClient) Create() *SubscriptionPlanCreate {
+	mutation := newSubscriptionPlanMutation(c.config, OpCreate)
+	return &SubscriptionPlanCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SubscriptionPlan entities.
+func (c *SubscriptionPlanClient) CreateBulk(builders ...*SubscriptionPlanCreate) *SubscriptionPlanCreateBulk {
+	return &SubscriptionPlanCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SubscriptionPlanClient) MapCreateBulk(slice any, setFunc func(*SubscriptionPlanCreate, int)) *SubscriptionPlanCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SubscriptionPlanCreateBulk{err: fmt.Errorf("calling to SubscriptionPlanClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SubscriptionPlanCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SubscriptionPlanCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SubscriptionPlan.
+func (c *SubscriptionPlanClient) Update() *SubscriptionPlanUpdate {
+	mutation := newSubscriptionPlanMutation(c.config, OpUpdate)
+	return &SubscriptionPlanUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SubscriptionPlanClient) UpdateOne(_m *SubscriptionPlan) *SubscriptionPlanUpdateOne {
+	mutation := newSubscriptionPlanMutation(c.config, OpUpdateOne, withSubscriptionPlan(_m))
+	return &SubscriptionPlanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SubscriptionPlanClient) UpdateOneID(id string) *SubscriptionPlanUpdateOne {
+	mutation := newSubscriptionPlanMutation(c.config, OpUpdateOne, withSubscriptionPlanID(id))
+	return &SubscriptionPlanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SubscriptionPlan.
+func (c *SubscriptionPlanClient) Delete() *SubscriptionPlanDelete {
+	mutation := newSubscriptionPlanMutation(c.config, OpDelete)
+	return &SubscriptionPlanDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SubscriptionPlanClient) DeleteOne(_m *SubscriptionPlan) *SubscriptionPlanDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SubscriptionPlanClient) DeleteOneID(id string) *SubscriptionPlanDeleteOne {
+	builder := c.Delete().Where(subscriptionplan.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SubscriptionPlanDeleteOne{builder}
+}
+
+// Query returns a query builder for SubscriptionPlan.
+func (c *SubscriptionPlanClient) Query() *SubscriptionPlanQuery {
+	return &SubscriptionPlanQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSubscriptionPlan},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SubscriptionPlan entity by its id.
+func (c *SubscriptionPlanClient) Get(ctx context.Context, id string) (*SubscriptionPlan, error) {
+	return c.Query().Where(subscriptionplan.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SubscriptionPlanClient) GetX(ctx context.Context, id string) *SubscriptionPlan {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySubscriptions queries the subscriptions edge of a SubscriptionPlan.
+func (c *SubscriptionPlanClient) QuerySubscriptions(_m *SubscriptionPlan) *SubscriptionQuery {
+	query := (&SubscriptionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(subscriptionplan.Table, subscriptionplan.FieldID, id),
+			sqlgraph.To(subscription.Table, subscription.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, subscriptionplan.SubscriptionsTable, subscriptionplan.SubscriptionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SubscriptionPlanClient) Hooks() []Hook {
+	return c.hooks.SubscriptionPlan
+}
+
+// Interceptors returns the client interceptors.
+func (c *SubscriptionPlanClient) Interceptors() []Interceptor {
+	return c.inters.SubscriptionPlan
+}
+
+func (c *SubscriptionPlanClient) mutate(ctx context.Context, m *SubscriptionPlanMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SubscriptionPlanCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SubscriptionPlanUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SubscriptionPlanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SubscriptionPlanDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown SubscriptionPlan mutation op: %q", m.Op())
+	}
+}
+
+// TierEntitlementClient is a client for the TierEntitlement schema.
+type TierEntitlementClient struct {
+	config
+}
+
+// NewTierEntitlementClient returns a client for the TierEntitlement from the given config.
+func NewTierEntitlementClient(c config) *TierEntitlementClient {
+	return &TierEntitlementClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `tierentitlement.Hooks(f(g(h())))`.
+func (c *TierEntitlementClient) Use(hooks ...Hook) {
+	c.hooks.TierEntitlement = append(c.hooks.TierEntitlement, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `tierentitlement.Intercept(f(g(h())))`.
+func (c *TierEntitlementClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TierEntitlement = append(c.inters.TierEntitlement, interceptors...)
+}
+
+// Create returns a builder for creating a TierEntitlement entity.
+func (c *TierEntitlementClient) Create() *TierEntitlementCreate {
+	mutation := newTierEntitlementMutation(c.config, OpCreate)
+	return &TierEntitlementCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TierEntitlement entities.
+func (c *TierEntitlementClient) CreateBulk(builders ...*TierEntitlementCreate) *TierEntitlementCreateBulk {
+	return &TierEntitlementCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TierEntitlementClient) MapCreateBulk(slice any, setFunc func(*TierEntitlementCreate, int)) *TierEntitlementCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TierEntitlementCreateBulk{err: fmt.Errorf("calling to TierEntitlementClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TierEntitlementCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TierEntitlementCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TierEntitlement.
+func (c *TierEntitlementClient) Update() *TierEntitlementUpdate {
+	mutation := newTierEntitlementMutation(c.config, OpUpdate)
+	return &TierEntitlementUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TierEntitlementClient) UpdateOne(_m *TierEntitlement) *TierEntitlementUpdateOne {
+	mutation := newTierEntitlementMutation(c.config, OpUpdateOne, withTierEntitlement(_m))
+	return &TierEntitlementUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TierEntitlementClient) UpdateOneID(id string) *TierEntitlementUpdateOne {
+	mutation := newTierEntitlementMutation(c.config, OpUpdateOne, withTierEntitlementID(id))
+	return &TierEntitlementUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TierEntitlement.
+func (c *TierEntitlementClient) Delete() *TierEntitlementDelete {
+	mutation := newTierEntitlementMutation(c.config, OpDelete)
+	return &TierEntitlementDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TierEntitlementClient) DeleteOne(_m *TierEntitlement) *TierEntitlementDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TierEntitlementClient) DeleteOneID(id string) *TierEntitlementDeleteOne {
+	builder := c.Delete().Where(tierentitlement.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TierEntitlementDeleteOne{builder}
+}
+
+// Query returns a query builder for TierEntitlement.
+func (c *TierEntitlementClient) Query() *TierEntitlementQuery {
+	return &TierEntitlementQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTierEntitlement},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TierEntitlement entity by its id.
+func (c *TierEntitlementClient) Get(ctx context.Context, id string) (*TierEntitlement, error) {
+	return c.Query().Where(tierentitlement.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TierEntitlementClient) GetX(ctx context.Context, id string) *TierEntitlement {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *TierEntitlementClient) Hooks() []Hook {
+	return c.hooks.TierEntitlement
+}
+
+// Interceptors returns the client interceptors.
+func (c *TierEntitlementClient) Interceptors() []Interceptor {
+	return c.inters.TierEntitlement
+}
+
+func (c *TierEntitlementClient) mutate(ctx context.Context, m *TierEntitlementMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TierEntitlementCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TierEntitlementUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TierEntitlementUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TierEntitlementDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown TierEntitlement mutation op: %q", m.Op())
+	}
+}
+
+// UserClient is a client for the User schema.
+type UserClient struct {
+	config
+}
+
+// NewUserClient returns a client for the User from the given config.
+func NewUserClient(c config) *UserClient {
+	return &UserClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `user.Hooks(f(g(h())))`.
+func (c *UserClient) Use(hooks ...Hook) {
+	c.hooks.User = append(c.hooks.User, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `user.Intercept(f(g(h())))`.
+func (c *UserClient) Intercept(interceptors ...Interceptor) {
+	c.inters.User = append(c.inters.User, interceptors...)
+}
+
+// Create returns a builder for creating a User entity.
+func (c *UserClient) Create() *UserCreate {
+	mutation := newUserMutation(c.config, OpCreate)
+	return &UserCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of User entities.
+func (c *UserClient) CreateBulk(builders ...*UserCreate) *UserCreateBulk {
+	return &UserCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UserClient) MapCreateBulk(slice any, setFunc func(*UserCreate, int)) *UserCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UserCreateBulk{err: fmt.Errorf("calling to UserClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UserCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UserCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for User.
+func (c *UserClient) Update() *UserUpdate {
+	mutation := newUserMutation(c.config, OpUpdate)
+	return &UserUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UserClient) UpdateOne(_m *User) *UserUpdateOne {
+	mutation := newUserMutation(c.config, OpUpdateOne, withUser(_m))
+	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UserClient) UpdateOneID(id string) *UserUpdateOne {
+	mutation := newUserMutation(c.config, OpUpdateOne, withUserID(id))
+	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for User.
+func (c *UserClient) Delete() *UserDelete {
+	mutation := newUserMutation(c.config, OpDelete)
+	return &UserDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UserClient) DeleteOne(_m *User) *UserDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UserClient) DeleteOneID(id string) *UserDeleteOne {
+	builder := c.Delete().Where(user.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UserDeleteOne{builder}
+}
+
+// Query returns a query builder for User.
+func (c *UserClient) Query() *UserQuery {
+	return &UserQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUser},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a User entity by its id.
+func (c *UserClient) Get(ctx context.Context, id string) (*User, error) {
+	return c.Query().Where(user.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UserClient) GetX(ctx context.Context, id string) *User {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryProfile queries the profile edge of a User.
+func (c *UserClient) QueryProfile(_m *User) *ProfileQuery {
+	query := (&ProfileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(profile.Table, profile.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, user.ProfileTable, user.ProfileColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPhotos queries the photos edge of a User.
+func (c *UserClient) QueryPhotos(_m *User) *PhotoQuery {
+	query := (&PhotoClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(photo.Table, photo.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.PhotosTable, user.PhotosColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryHobbies queries the hobbies edge of a User.
+func (c *UserClient) QueryHobbies(_m *User) *HobbyQuery {
+	query := (&HobbyClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(hobby.Table, hobby.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.HobbiesTable, user.HobbiesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryFilters queries the filters edge of a User.
+func (c *UserClient) QueryFilters(_m *User) *FilterQuery {
+	query := (&FilterClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(user.Table, user.FieldID, id),
@@ -3957,6 +7913,118 @@ func (c *UserClient) QueryPaymentOrders(_m *User) *PaymentOrderQuery {
 	return query
 }
 
+// QueryBalanceDrifts queries the balance_drifts edge of a User.
+func (c *UserClient) QueryBalanceDrifts(_m *User) *BalanceDriftQuery {
+	query := (&BalanceDriftClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(balancedrift.Table, balancedrift.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.BalanceDriftsTable, user.BalanceDriftsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySubscriptions queries the subscriptions edge of a User.
+func (c *UserClient) QuerySubscriptions(_m *User) *SubscriptionQuery {
+	query := (&SubscriptionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(subscription.Table, subscription.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.SubscriptionsTable, user.SubscriptionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRevealViews queries the reveal_views edge of a User.
+func (c *UserClient) QueryRevealViews(_m *User) *RevealViewQuery {
+	query := (&RevealViewClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(revealview.Table, revealview.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.RevealViewsTable, user.RevealViewsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRevealGiftsSent queries the reveal_gifts_sent edge of a User.
+func (c *UserClient) QueryRevealGiftsSent(_m *User) *RevealGiftQuery {
+	query := (&RevealGiftClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(revealgift.Table, revealgift.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.RevealGiftsSentTable, user.RevealGiftsSentColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRevealGiftsReceived queries the reveal_gifts_received edge of a User.
+func (c *UserClient) QueryRevealGiftsReceived(_m *User) *RevealGiftQuery {
+	query := (&RevealGiftClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(revealgift.Table, revealgift.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.RevealGiftsReceivedTable, user.RevealGiftsReceivedColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryChatMessages queries the chat_messages edge of a User.
+func (c *UserClient) QueryChatMessages(_m *User) *MessageQuery {
+	query := (&MessageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(message.Table, message.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ChatMessagesTable, user.ChatMessagesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryModerationActions queries the moderation_actions edge of a User.
+func (c *UserClient) QueryModerationActions(_m *User) *ModerationActionQuery {
+	query := (&ModerationActionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(moderationaction.Table, moderationaction.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ModerationActionsTable, user.ModerationActionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // QueryBlocksGiven queries the blocks_given edge of a User.
 func (c *UserClient) QueryBlocksGiven(_m *User) *UserBlockQuery {
 	query := (&UserBlockClient{config: c.config}).Query()
@@ -4021,6 +8089,22 @@ func (c *UserClient) QueryReportsReceived(_m *User) *UserReportQuery {
 	return query
 }
 
+// QueryNotifications queries the notifications edge of a User.
+func (c *UserClient) QueryNotifications(_m *User) *NotificationQuery {
+	query := (&NotificationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(notification.Table, notification.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.NotificationsTable, user.NotificationsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *UserClient) Hooks() []Hook {
 	return c.hooks.User
@@ -4034,119 +8118,417 @@ func (c *UserClient) Interceptors() []Interceptor {
 func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&UserCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&UserCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UserUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UserDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown User mutation op: %q", m.Op())
+	}
+}
+
+// UserBlockClient is a client for the UserBlock schema.
+type UserBlockClient struct {
+	config
+}
+
+// NewUserBlockClient returns a client for the UserBlock from the given config.
+func NewUserBlockClient(c config) *UserBlockClient {
+	return &UserBlockClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `userblock.Hooks(f(g(h())))`.
+func (c *UserBlockClient) Use(hooks ...Hook) {
+	c.hooks.UserBlock = append(c.hooks.UserBlock, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `userblock.Intercept(f(g(h())))`.
+func (c *UserBlockClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UserBlock = append(c.inters.UserBlock, interceptors...)
+}
+
+// Create returns a builder for creating a UserBlock entity.
+func (c *UserBlockClient) Create() *UserBlockCreate {
+	mutation := newUserBlockMutation(c.config, OpCreate)
+	return &UserBlockCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of UserBlock entities.
+func (c *UserBlockClient) CreateBulk(builders ...*UserBlockCreate) *UserBlockCreateBulk {
+	return &UserBlockCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UserBlockClient) MapCreateBulk(slice any, setFunc func(*UserBlockCreate, int)) *UserBlockCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UserBlockCreateBulk{err: fmt.Errorf("calling to UserBlockClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UserBlockCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UserBlockCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for UserBlock.
+func (c *UserBlockClient) Update() *UserBlockUpdate {
+	mutation := newUserBlockMutation(c.config, OpUpdate)
+	return &UserBlockUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UserBlockClient) UpdateOne(_m *UserBlock) *UserBlockUpdateOne {
+	mutation := newUserBlockMutation(c.config, OpUpdateOne, withUserBlock(_m))
+	return &UserBlockUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UserBlockClient) UpdateOneID(id string) *UserBlockUpdateOne {
+	mutation := newUserBlockMutation(c.config, OpUpdateOne, withUserBlockID(id))
+	return &UserBlockUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for UserBlock.
+func (c *UserBlockClient) Delete() *UserBlockDelete {
+	mutation := newUserBlockMutation(c.config, OpDelete)
+	return &UserBlockDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UserBlockClient) DeleteOne(_m *UserBlock) *UserBlockDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UserBlockClient) DeleteOneID(id string) *UserBlockDeleteOne {
+	builder := c.Delete().Where(userblock.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UserBlockDeleteOne{builder}
+}
+
+// Query returns a query builder for UserBlock.
+func (c *UserBlockClient) Query() *UserBlockQuery {
+	return &UserBlockQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUserBlock},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a UserBlock entity by its id.
+func (c *UserBlockClient) Get(ctx context.Context, id string) (*UserBlock, error) {
+	return c.Query().Where(userblock.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UserBlockClient) GetX(ctx context.Context, id string) *UserBlock {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryBlocker queries the blocker edge of a UserBlock.
+func (c *UserBlockClient) QueryBlocker(_m *UserBlock) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(userblock.Table, userblock.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, userblock.BlockerTable, userblock.BlockerColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBlocked queries the blocked edge of a UserBlock.
+func (c *UserBlockClient) QueryBlocked(_m *UserBlock) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(userblock.Table, userblock.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, userblock.BlockedTable, userblock.BlockedColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *UserBlockClient) Hooks() []Hook {
+	return c.hooks.UserBlock
+}
+
+// Interceptors returns the client interceptors.
+func (c *UserBlockClient) Interceptors() []Interceptor {
+	return c.inters.UserBlock
+}
+
+func (c *UserBlockClient) mutate(ctx context.Context, m *UserBlockMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UserBlockCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UserBlockUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UserBlockUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UserBlockDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown UserBlock mutation op: %q", m.Op())
+	}
+}
+
+// UserDeviceClient is a client for the UserDevice schema.
+type UserDeviceClient struct {
+	config
+}
+
+// NewUserDeviceClient returns a client for the UserDevice from the given config.
+func NewUserDeviceClient(c config) *UserDeviceClient {
+	return &UserDeviceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `userdevice.Hooks(f(g(h())))`.
+func (c *UserDeviceClient) Use(hooks ...Hook) {
+	c.hooks.UserDevice = append(c.hooks.UserDevice, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `userdevice.Intercept(f(g(h())))`.
+func (c *UserDeviceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UserDevice = append(c.inters.UserDevice, interceptors...)
+}
+
+// Create returns a builder for creating a UserDevice entity.
+func (c *UserDeviceClient) Create() *UserDeviceCreate {
+	mutation := newUserDeviceMutation(c.config, OpCreate)
+	return &UserDeviceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of UserDevice entities.
+func (c *UserDeviceClient) CreateBulk(builders ...*UserDeviceCreate) *UserDeviceCreateBulk {
+	return &UserDeviceCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UserDeviceClient) MapCreateBulk(slice any, setFunc func(*UserDeviceCreate, int)) *UserDeviceCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UserDeviceCreateBulk{err: fmt.Errorf("calling to UserDeviceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UserDeviceCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UserDeviceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for UserDevice.
+func (c *UserDeviceClient) Update() *UserDeviceUpdate {
+	mutation := newUserDeviceMutation(c.config, OpUpdate)
+	return &UserDeviceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UserDeviceClient) UpdateOne(_m *UserDevice) *UserDeviceUpdateOne {
+	mutation := newUserDeviceMutation(c.config, OpUpdateOne, withUserDevice(_m))
+	return &UserDeviceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UserDeviceClient) UpdateOneID(id string) *UserDeviceUpdateOne {
+	mutation := newUserDeviceMutation(c.config, OpUpdateOne, withUserDeviceID(id))
+	return &UserDeviceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for UserDevice.
+func (c *UserDeviceClient) Delete() *UserDeviceDelete {
+	mutation := newUserDeviceMutation(c.config, OpDelete)
+	return &UserDeviceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UserDeviceClient) DeleteOne(_m *UserDevice) *UserDeviceDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UserDeviceClient) DeleteOneID(id string) *UserDeviceDeleteOne {
+	builder := c.Delete().Where(userdevice.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UserDeviceDeleteOne{builder}
+}
+
+// Query returns a query builder for UserDevice.
+func (c *UserDeviceClient) Query() *UserDeviceQuery {
+	return &UserDeviceQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUserDevice},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a UserDevice entity by its id.
+func (c *UserDeviceClient) Get(ctx context.Context, id string) (*UserDevice, error) {
+	return c.Query().Where(userdevice.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UserDeviceClient) GetX(ctx context.Context, id string) *UserDevice {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *UserDeviceClient) Hooks() []Hook {
+	return c.hooks.UserDevice
+}
+
+// Interceptors returns the client interceptors.
+func (c *UserDeviceClient) Interceptors() []Interceptor {
+	return c.inters.UserDevice
+}
+
+func (c *UserDeviceClient) mutate(ctx context.Context, m *UserDeviceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UserDeviceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&UserUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&UserDeviceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&UserDeviceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&UserDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&UserDeviceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("generated: unknown User mutation op: %q", m.Op())
+		return nil, fmt.Errorf("generated: unknown UserDevice mutation op: %q", m.Op())
 	}
 }
 
-// UserBlockClient is a client for the UserBlock schema.
-type UserBlockClient struct {
+// UserIdentityClient is a client for the UserIdentity schema.
+type UserIdentityClient struct {
 	config
 }
 
-// NewUserBlockClient returns a client for the UserBlock from the given config.
-func NewUserBlockClient(c config) *UserBlockClient {
-	return &UserBlockClient{config: c}
+// NewUserIdentityClient returns a client for the UserIdentity from the given config.
+func NewUserIdentityClient(c config) *UserIdentityClient {
+	return &UserIdentityClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `userblock.Hooks(f(g(h())))`.
-func (c *UserBlockClient) Use(hooks ...Hook) {
-	c.hooks.UserBlock = append(c.hooks.UserBlock, hooks...)
+// A call to `Use(f, g, h)` equals to `useridentity.Hooks(f(g(h())))`.
+func (c *UserIdentityClient) Use(hooks ...Hook) {
+	c.hooks.UserIdentity = append(c.hooks.UserIdentity, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `userblock.Intercept(f(g(h())))`.
-func (c *UserBlockClient) Intercept(interceptors ...Interceptor) {
-	c.inters.UserBlock = append(c.inters.UserBlock, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `useridentity.Intercept(f(g(h())))`.
+func (c *UserIdentityClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UserIdentity = append(c.inters.UserIdentity, interceptors...)
 }
 
-// Create returns a builder for creating a UserBlock entity.
-func (c *UserBlockClient) Create() *UserBlockCreate {
-	mutation := newUserBlockMutation(c.config, OpCreate)
-	return &UserBlockCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a UserIdentity entity.
+func (c *UserIdentityClient) Create() *UserIdentityCreate {
+	mutation := newUserIdentityMutation(c.config, OpCreate)
+	return &UserIdentityCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of UserBlock entities.
-func (c *UserBlockClient) CreateBulk(builders ...*UserBlockCreate) *UserBlockCreateBulk {
-	return &UserBlockCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of UserIdentity entities.
+func (c *UserIdentityClient) CreateBulk(builders ...*UserIdentityCreate) *UserIdentityCreateBulk {
+	return &UserIdentityCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *UserBlockClient) MapCreateBulk(slice any, setFunc func(*UserBlockCreate, int)) *UserBlockCreateBulk {
+func (c *UserIdentityClient) MapCreateBulk(slice any, setFunc func(*UserIdentityCreate, int)) *UserIdentityCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &UserBlockCreateBulk{err: fmt.Errorf("calling to UserBlockClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &UserIdentityCreateBulk{err: fmt.Errorf("calling to UserIdentityClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*UserBlockCreate, rv.Len())
+	builders := make([]*UserIdentityCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &UserBlockCreateBulk{config: c.config, builders: builders}
+	return &UserIdentityCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for UserBlock.
-func (c *UserBlockClient) Update() *UserBlockUpdate {
-	mutation := newUserBlockMutation(c.config, OpUpdate)
-	return &UserBlockUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for UserIdentity.
+func (c *UserIdentityClient) Update() *UserIdentityUpdate {
+	mutation := newUserIdentityMutation(c.config, OpUpdate)
+	return &UserIdentityUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *UserBlockClient) UpdateOne(_m *UserBlock) *UserBlockUpdateOne {
-	mutation := newUserBlockMutation(c.config, OpUpdateOne, withUserBlock(_m))
-	return &UserBlockUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *UserIdentityClient) UpdateOne(_m *UserIdentity) *UserIdentityUpdateOne {
+	mutation := newUserIdentityMutation(c.config, OpUpdateOne, withUserIdentity(_m))
+	return &UserIdentityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *UserBlockClient) UpdateOneID(id string) *UserBlockUpdateOne {
-	mutation := newUserBlockMutation(c.config, OpUpdateOne, withUserBlockID(id))
-	return &UserBlockUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *UserIdentityClient) UpdateOneID(id string) *UserIdentityUpdateOne {
+	mutation := newUserIdentityMutation(c.config, OpUpdateOne, withUserIdentityID(id))
+	return &UserIdentityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for UserBlock.
-func (c *UserBlockClient) Delete() *UserBlockDelete {
-	mutation := newUserBlockMutation(c.config, OpDelete)
-	return &UserBlockDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for UserIdentity.
+func (c *UserIdentityClient) Delete() *UserIdentityDelete {
+	mutation := newUserIdentityMutation(c.config, OpDelete)
+	return &UserIdentityDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *UserBlockClient) DeleteOne(_m *UserBlock) *UserBlockDeleteOne {
+func (c *UserIdentityClient) DeleteOne(_m *UserIdentity) *UserIdentityDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *UserBlockClient) DeleteOneID(id string) *UserBlockDeleteOne {
-	builder := c.Delete().Where(userblock.ID(id))
+func (c *UserIdentityClient) DeleteOneID(id string) *UserIdentityDeleteOne {
+	builder := c.Delete().Where(useridentity.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &UserBlockDeleteOne{builder}
+	return &UserIdentityDeleteOne{builder}
 }
 
-// Query returns a query builder for UserBlock.
-func (c *UserBlockClient) Query() *UserBlockQuery {
-	return &UserBlockQuery{
+// Query returns a query builder for UserIdentity.
+func (c *UserIdentityClient) Query() *UserIdentityQuery {
+	return &UserIdentityQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeUserBlock},
+		ctx:    &QueryContext{Type: TypeUserIdentity},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a UserBlock entity by its id.
-func (c *UserBlockClient) Get(ctx context.Context, id string) (*UserBlock, error) {
-	return c.Query().Where(userblock.ID(id)).Only(ctx)
+// Get returns a UserIdentity entity by its id.
+func (c *UserIdentityClient) Get(ctx context.Context, id string) (*UserIdentity, error) {
+	return c.Query().Where(useridentity.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *UserBlockClient) GetX(ctx context.Context, id string) *UserBlock {
+func (c *UserIdentityClient) GetX(ctx context.Context, id string) *UserIdentity {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -4154,60 +8536,28 @@ func (c *UserBlockClient) GetX(ctx context.Context, id string) *UserBlock {
 	return obj
 }
 
-// QueryBlocker queries the blocker edge of a UserBlock.
-func (c *UserBlockClient) QueryBlocker(_m *UserBlock) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(userblock.Table, userblock.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, userblock.BlockerTable, userblock.BlockerColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryBlocked queries the blocked edge of a UserBlock.
-func (c *UserBlockClient) QueryBlocked(_m *UserBlock) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(userblock.Table, userblock.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, userblock.BlockedTable, userblock.BlockedColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *UserBlockClient) Hooks() []Hook {
-	return c.hooks.UserBlock
+func (c *UserIdentityClient) Hooks() []Hook {
+	return c.hooks.UserIdentity
 }
 
 // Interceptors returns the client interceptors.
-func (c *UserBlockClient) Interceptors() []Interceptor {
-	return c.inters.UserBlock
+func (c *UserIdentityClient) Interceptors() []Interceptor {
+	return c.inters.UserIdentity
 }
 
-func (c *UserBlockClient) mutate(ctx context.Context, m *UserBlockMutation) (Value, error) {
+func (c *UserIdentityClient) mutate(ctx context.Context, m *UserIdentityMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&UserBlockCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&UserIdentityCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&UserBlockUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&UserIdentityUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&UserBlockUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&UserIdentityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&UserBlockDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&UserIdentityDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("generated: unknown UserBlock mutation op: %q", m.Op())
+		return nil, fmt.Errorf("generated: unknown UserIdentity mutation op: %q", m.Op())
 	}
 }
 
@@ -4351,6 +8701,22 @@ func (c *UserReportClient) QueryReported(_m *UserReport) *UserQuery {
 	return query
 }
 
+// QueryEvidence queries the evidence edge of a UserReport.
+func (c *UserReportClient) QueryEvidence(_m *UserReport) *ReportEvidenceQuery {
+	query := (&ReportEvidenceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(userreport.Table, userreport.FieldID, id),
+			sqlgraph.To(reportevidence.Table, reportevidence.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, userreport.EvidenceTable, userreport.EvidenceColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *UserReportClient) Hooks() []Hook {
 	return c.hooks.UserReport
@@ -4376,18 +8742,294 @@ func (c *UserReportClient) mutate(ctx context.Context, m *UserReportMutation) (V
 	}
 }
 
+// UserSessionClient is a client for the UserSession schema.
+type UserSessionClient struct {
+	config
+}
+
+// NewUserSessionClient returns a client for the UserSession from the given config.
+func NewUserSessionClient(c config) *UserSessionClient {
+	return &UserSessionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `usersession.Hooks(f(g(h())))`.
+func (c *UserSessionClient) Use(hooks ...Hook) {
+	c.hooks.UserSession = append(c.hooks.UserSession, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `usersession.Intercept(f(g(h())))`.
+func (c *UserSessionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UserSession = append(c.inters.UserSession, interceptors...)
+}
+
+// Create returns a builder for creating a UserSession entity.
+func (c *UserSessionClient) Create() *UserSessionCreate {
+	mutation := newUserSessionMutation(c.config, OpCreate)
+	return &UserSessionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of UserSession entities.
+func (c *UserSessionClient) CreateBulk(builders ...*UserSessionCreate) *UserSessionCreateBulk {
+	return &UserSessionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UserSessionClient) MapCreateBulk(slice any, setFunc func(*UserSessionCreate, int)) *UserSessionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UserSessionCreateBulk{err: fmt.Errorf("calling to UserSessionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UserSessionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UserSessionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for UserSession.
+func (c *UserSessionClient) Update() *UserSessionUpdate {
+	mutation := newUserSessionMutation(c.config, OpUpdate)
+	return &UserSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UserSessionClient) UpdateOne(_m *UserSession) *UserSessionUpdateOne {
+	mutation := newUserSessionMutation(c.config, OpUpdateOne, withUserSession(_m))
+	return &UserSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UserSessionClient) UpdateOneID(id string) *UserSessionUpdateOne {
+	mutation := newUserSessionMutation(c.config, OpUpdateOne, withUserSessionID(id))
+	return &UserSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for UserSession.
+func (c *UserSessionClient) Delete() *UserSessionDelete {
+	mutation := newUserSessionMutation(c.config, OpDelete)
+	return &UserSessionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UserSessionClient) DeleteOne(_m *UserSession) *UserSessionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UserSessionClient) DeleteOneID(id string) *UserSessionDeleteOne {
+	builder := c.Delete().Where(usersession.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UserSessionDeleteOne{builder}
+}
+
+// Query returns a query builder for UserSession.
+func (c *UserSessionClient) Query() *UserSessionQuery {
+	return &UserSessionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUserSession},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a UserSession entity by its id.
+func (c *UserSessionClient) Get(ctx context.Context, id string) (*UserSession, error) {
+	return c.Query().Where(usersession.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UserSessionClient) GetX(ctx context.Context, id string) *UserSession {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *UserSessionClient) Hooks() []Hook {
+	return c.hooks.UserSession
+}
+
+// Interceptors returns the client interceptors.
+func (c *UserSessionClient) Interceptors() []Interceptor {
+	return c.inters.UserSession
+}
+
+func (c *UserSessionClient) mutate(ctx context.Context, m *UserSessionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UserSessionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UserSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UserSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UserSessionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown UserSession mutation op: %q", m.Op())
+	}
+}
+
+// WebhookEventClient is a client for the WebhookEvent schema.
+type WebhookEventClient struct {
+	config
+}
+
+// NewWebhookEventClient returns a client for the WebhookEvent from the given config.
+func NewWebhookEventClient(c config) *WebhookEventClient {
+	return &WebhookEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `webhookevent.Hooks(f(g(h())))`.
+func (c *WebhookEventClient) Use(hooks ...Hook) {
+	c.hooks.WebhookEvent = append(c.hooks.WebhookEvent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `webhookevent.Intercept(f(g(h())))`.
+func (c *WebhookEventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WebhookEvent = append(c.inters.WebhookEvent, interceptors...)
+}
+
+// Create returns a builder for creating a WebhookEvent entity.
+func (c *WebhookEventClient) Create() *WebhookEventCreate {
+	mutation := newWebhookEventMutation(c.config, OpCreate)
+	return &WebhookEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WebhookEvent entities.
+func (c *WebhookEventClient) CreateBulk(builders ...*WebhookEventCreate) *WebhookEventCreateBulk {
+	return &WebhookEventCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WebhookEventClient) MapCreateBulk(slice any, setFunc func(*WebhookEventCreate, int)) *WebhookEventCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WebhookEventCreateBulk{err: fmt.Errorf("calling to WebhookEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WebhookEventCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WebhookEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WebhookEvent.
+func (c *WebhookEventClient) Update() *WebhookEventUpdate {
+	mutation := newWebhookEventMutation(c.config, OpUpdate)
+	return &WebhookEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WebhookEventClient) UpdateOne(_m *WebhookEvent) *WebhookEventUpdateOne {
+	mutation := newWebhookEventMutation(c.config, OpUpdateOne, withWebhookEvent(_m))
+	return &WebhookEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WebhookEventClient) UpdateOneID(id string) *WebhookEventUpdateOne {
+	mutation := newWebhookEventMutation(c.config, OpUpdateOne, withWebhookEventID(id))
+	return &WebhookEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WebhookEvent.
+func (c *WebhookEventClient) Delete() *WebhookEventDelete {
+	mutation := newWebhookEventMutation(c.config, OpDelete)
+	return &WebhookEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WebhookEventClient) DeleteOne(_m *WebhookEvent) *WebhookEventDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WebhookEventClient) DeleteOneID(id string) *WebhookEventDeleteOne {
+	builder := c.Delete().Where(webhookevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WebhookEventDeleteOne{builder}
+}
+
+// Query returns a query builder for WebhookEvent.
+func (c *WebhookEventClient) Query() *WebhookEventQuery {
+	return &WebhookEventQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWebhookEvent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WebhookEvent entity by its id.
+func (c *WebhookEventClient) Get(ctx context.Context, id string) (*WebhookEvent, error) {
+	return c.Query().Where(webhookevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WebhookEventClient) GetX(ctx context.Context, id string) *WebhookEvent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *WebhookEventClient) Hooks() []Hook {
+	return c.hooks.WebhookEvent
+}
+
+// Interceptors returns the client interceptors.
+func (c *WebhookEventClient) Interceptors() []Interceptor {
+	return c.inters.WebhookEvent
+}
+
+func (c *WebhookEventClient) mutate(ctx context.Context, m *WebhookEventMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WebhookEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WebhookEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WebhookEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WebhookEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown WebhookEvent mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		AuditLog, CheckIn, Connection, CreditPackage, CreditTransaction, DiscoveryBatch,
-		DiscoveryCard, Filter, Hobby, HobbyOption, Interest, Nudge, PaymentOrder,
-		Photo, Profile, Reveal, RevealContent, RevealMilestone, Server, Streak, User,
-		UserBlock, UserReport []ent.Hook
+		AccountDeletion, AdminUser, AuditLog, BalanceDrift, CheckIn, Connection,
+		Conversation, CreditPackage, CreditTransaction, DataExport, DeviceRiskFlag,
+		DiscoveryBatch, DiscoveryCard, Filter, Hobby, HobbyOption, Interest, Invoice,
+		InvoiceSequence, LedgerEntry, Message, ModerationAction, Notification, Nudge,
+		PaymentDiscrepancy, PaymentOrder, PaymentReconciliationReport, PaymentRefund,
+		Photo, Profile, PromoCode, PromoRedemption, Referral, ReportEvidence, Reveal,
+		RevealContent, RevealGift, RevealMilestone, RevealView, Server, Streak,
+		Subscription, SubscriptionPlan, TierEntitlement, User, UserBlock, UserDevice,
+		UserIdentity, UserReport, UserSession, WebhookEvent []ent.Hook
 	}
 	inters struct {
-		AuditLog, CheckIn, Connection, CreditPackage, CreditTransaction, DiscoveryBatch,
-		DiscoveryCard, Filter, Hobby, HobbyOption, Interest, Nudge, PaymentOrder,
-		Photo, Profile, Reveal, RevealContent, RevealMilestone, Server, Streak, User,
-		UserBlock, UserReport []ent.Interceptor
+		AccountDeletion, AdminUser, AuditLog, BalanceDrift, CheckIn, Connection,
+		Conversation, CreditPackage, CreditTransaction, DataExport, DeviceRiskFlag,
+		DiscoveryBatch, DiscoveryCard, Filter, Hobby, HobbyOption, Interest, Invoice,
+		InvoiceSequence, LedgerEntry, Message, ModerationAction, Notification, Nudge,
+		PaymentDiscrepancy, PaymentOrder, PaymentReconciliationReport, PaymentRefund,
+		Photo, Profile, PromoCode, PromoRedemption, Referral, ReportEvidence, Reveal,
+		RevealContent, RevealGift, RevealMilestone, RevealView, Server, Streak,
+		Subscription, SubscriptionPlan, TierEntitlement, User, UserBlock, UserDevice,
+		UserIdentity, UserReport, UserSession, WebhookEvent []ent.Interceptor
 	}
 )
@@ -10,6 +10,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/UnoraApp/be/ent/generated/connection"
+	"github.com/UnoraApp/be/ent/generated/conversation"
 	"github.com/UnoraApp/be/ent/generated/streak"
 	"github.com/UnoraApp/be/ent/generated/user"
 )
@@ -49,9 +50,13 @@ type ConnectionEdges struct {
 	Streak *Streak `json:"streak,omitempty"`
 	// Reveals holds the value of the reveals edge.
 	Reveals []*Reveal `json:"reveals,omitempty"`
+	// RevealGifts holds the value of the reveal_gifts edge.
+	RevealGifts []*RevealGift `json:"reveal_gifts,omitempty"`
+	// Conversation holds the value of the conversation edge.
+	Conversation *Conversation `json:"conversation,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [4]bool
+	loadedTypes [6]bool
 }
 
 // UserAOrErr returns the UserA value or an error if the edge
@@ -96,6 +101,26 @@ func (e ConnectionEdges) RevealsOrErr() ([]*Reveal, error) {
 	return nil, &NotLoadedError{edge: "reveals"}
 }
 
+// RevealGiftsOrErr returns the RevealGifts value or an error if the edge
+// was not loaded in eager-loading.
+func (e ConnectionEdges) RevealGiftsOrErr() ([]*RevealGift, error) {
+	if e.loadedTypes[4] {
+		return e.RevealGifts, nil
+	}
+	return nil, &NotLoadedError{edge: "reveal_gifts"}
+}
+
+// ConversationOrErr returns the Conversation value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ConnectionEdges) ConversationOrErr() (*Conversation, error) {
+	if e.Conversation != nil {
+		return e.Conversation, nil
+	} else if e.loadedTypes[5] {
+		return nil, &NotFoundError{label: conversation.Label}
+	}
+	return nil, &NotLoadedError{edge: "conversation"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Connection) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -203,6 +228,16 @@ func (_m *Connection) QueryReveals() *RevealQuery {
 	return NewConnectionClient(_m.config).QueryReveals(_m)
 }
 
+// QueryRevealGifts queries the "reveal_gifts" edge of the Connection entity.
+func (_m *Connection) QueryRevealGifts() *RevealGiftQuery {
+	return NewConnectionClient(_m.config).QueryRevealGifts(_m)
+}
+
+// QueryConversation queries the "conversation" edge of the Connection entity.
+func (_m *Connection) QueryConversation() *ConversationQuery {
+	return NewConnectionClient(_m.config).QueryConversation(_m)
+}
+
 // Update returns a builder for updating this Connection.
 // Note that you need to call Connection.Unwrap() before calling this method if this Connection
 // was returned from a transaction, and the transaction was committed or rolled back.
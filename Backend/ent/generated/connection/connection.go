@@ -37,6 +37,10 @@ const (
 	EdgeStreak = "streak"
 	// EdgeReveals holds the string denoting the reveals edge name in mutations.
 	EdgeReveals = "reveals"
+	// EdgeRevealGifts holds the string denoting the reveal_gifts edge name in mutations.
+	EdgeRevealGifts = "reveal_gifts"
+	// EdgeConversation holds the string denoting the conversation edge name in mutations.
+	EdgeConversation = "conversation"
 	// Table holds the table name of the connection in the database.
 	Table = "connections"
 	// UserATable is the table that holds the user_a relation/edge.
@@ -67,6 +71,20 @@ const (
 	RevealsInverseTable = "reveals"
 	// RevealsColumn is the table column denoting the reveals relation/edge.
 	RevealsColumn = "connection_id"
+	// RevealGiftsTable is the table that holds the reveal_gifts relation/edge.
+	RevealGiftsTable = "reveal_gifts"
+	// RevealGiftsInverseTable is the table name for the RevealGift entity.
+	// It exists in this package in order to avoid circular dependency with the "revealgift" package.
+	RevealGiftsInverseTable = "reveal_gifts"
+	// RevealGiftsColumn is the table column denoting the reveal_gifts relation/edge.
+	RevealGiftsColumn = "connection_id"
+	// ConversationTable is the table that holds the conversation relation/edge.
+	ConversationTable = "conversations"
+	// ConversationInverseTable is the table name for the Conversation entity.
+	// It exists in this package in order to avoid circular dependency with the "conversation" package.
+	ConversationInverseTable = "conversations"
+	// ConversationColumn is the table column denoting the conversation relation/edge.
+	ConversationColumn = "connection_id"
 )
 
 // Columns holds all SQL columns for connection fields.
@@ -229,6 +247,27 @@ func ByReveals(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newRevealsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByRevealGiftsCount orders the results by reveal_gifts count.
+func ByRevealGiftsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRevealGiftsStep(), opts...)
+	}
+}
+
+// ByRevealGifts orders the results by reveal_gifts terms.
+func ByRevealGifts(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRevealGiftsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByConversationField orders the results by conversation field.
+func ByConversationField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newConversationStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newUserAStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -257,3 +296,17 @@ func newRevealsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, RevealsTable, RevealsColumn),
 	)
 }
+func newRevealGiftsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RevealGiftsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RevealGiftsTable, RevealGiftsColumn),
+	)
+}
+func newConversationStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ConversationInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, ConversationTable, ConversationColumn),
+	)
+}
@@ -13,7 +13,9 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/UnoraApp/be/ent/generated/connection"
+	"github.com/UnoraApp/be/ent/generated/conversation"
 	"github.com/UnoraApp/be/ent/generated/reveal"
+	"github.com/UnoraApp/be/ent/generated/revealgift"
 	"github.com/UnoraApp/be/ent/generated/streak"
 	"github.com/UnoraApp/be/ent/generated/user"
 )
@@ -150,6 +152,40 @@ func (_c *ConnectionCreate) AddReveals(v ...*Reveal) *ConnectionCreate {
 	return _c.AddRevealIDs(ids...)
 }
 
+// AddRevealGiftIDs adds the "reveal_gifts" edge to the RevealGift entity by IDs.
+func (_c *ConnectionCreate) AddRevealGiftIDs(ids ...string) *ConnectionCreate {
+	_c.mutation.AddRevealGiftIDs(ids...)
+	return _c
+}
+
+// AddRevealGifts adds the "reveal_gifts" edges to the RevealGift entity.
+func (_c *ConnectionCreate) AddRevealGifts(v ...*RevealGift) *ConnectionCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRevealGiftIDs(ids...)
+}
+
+// SetConversationID sets the "conversation" edge to the Conversation entity by ID.
+func (_c *ConnectionCreate) SetConversationID(id string) *ConnectionCreate {
+	_c.mutation.SetConversationID(id)
+	return _c
+}
+
+// SetNillableConversationID sets the "conversation" edge to the Conversation entity by ID if the given value is not nil.
+func (_c *ConnectionCreate) SetNillableConversationID(id *string) *ConnectionCreate {
+	if id != nil {
+		_c = _c.SetConversationID(*id)
+	}
+	return _c
+}
+
+// SetConversation sets the "conversation" edge to the Conversation entity.
+func (_c *ConnectionCreate) SetConversation(v *Conversation) *ConnectionCreate {
+	return _c.SetConversationID(v.ID)
+}
+
 // Mutation returns the ConnectionMutation object of the builder.
 func (_c *ConnectionCreate) Mutation() *ConnectionMutation {
 	return _c.mutation
@@ -365,6 +401,38 @@ func (_c *ConnectionCreate) createSpec() (*Connection, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.RevealGiftsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   connection.RevealGiftsTable,
+			Columns: []string{connection.RevealGiftsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(revealgift.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ConversationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   connection.ConversationTable,
+			Columns: []string{connection.ConversationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(conversation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -9,12 +9,15 @@ import (
 	"math"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/UnoraApp/be/ent/generated/connection"
+	"github.com/UnoraApp/be/ent/generated/conversation"
 	"github.com/UnoraApp/be/ent/generated/predicate"
 	"github.com/UnoraApp/be/ent/generated/reveal"
+	"github.com/UnoraApp/be/ent/generated/revealgift"
 	"github.com/UnoraApp/be/ent/generated/streak"
 	"github.com/UnoraApp/be/ent/generated/user"
 )
@@ -22,14 +25,17 @@ import (
 // ConnectionQuery is the builder for querying Connection entities.
 type ConnectionQuery struct {
 	config
-	ctx         *QueryContext
-	order       []connection.OrderOption
-	inters      []Interceptor
-	predicates  []predicate.Connection
-	withUserA   *UserQuery
-	withUserB   *UserQuery
-	withStreak  *StreakQuery
-	withReveals *RevealQuery
+	ctx              *QueryContext
+	order            []connection.OrderOption
+	inters           []Interceptor
+	predicates       []predicate.Connection
+	withUserA        *UserQuery
+	withUserB        *UserQuery
+	withStreak       *StreakQuery
+	withReveals      *RevealQuery
+	withRevealGifts  *RevealGiftQuery
+	withConversation *ConversationQuery
+	modifiers        []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -154,6 +160,50 @@ func (_q *ConnectionQuery) QueryReveals() *RevealQuery {
 	return query
 }
 
+// QueryRevealGifts chains the current query on the "reveal_gifts" edge.
+func (_q *ConnectionQuery) QueryRevealGifts() *RevealGiftQuery {
+	query := (&RevealGiftClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(connection.Table, connection.FieldID, selector),
+			sqlgraph.To(revealgift.Table, revealgift.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, connection.RevealGiftsTable, connection.RevealGiftsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryConversation chains the current query on the "conversation" edge.
+func (_q *ConnectionQuery) QueryConversation() *ConversationQuery {
+	query := (&ConversationClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(connection.Table, connection.FieldID, selector),
+			sqlgraph.To(conversation.Table, conversation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, connection.ConversationTable, connection.ConversationColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Connection entity from the query.
 // Returns a *NotFoundError when no Connection was found.
 func (_q *ConnectionQuery) First(ctx context.Context) (*Connection, error) {
@@ -341,15 +391,17 @@ func (_q *ConnectionQuery) Clone() *ConnectionQuery {
 		return nil
 	}
 	return &ConnectionQuery{
-		config:      _q.config,
-		ctx:         _q.ctx.Clone(),
-		order:       append([]connection.OrderOption{}, _q.order...),
-		inters:      append([]Interceptor{}, _q.inters...),
-		predicates:  append([]predicate.Connection{}, _q.predicates...),
-		withUserA:   _q.withUserA.Clone(),
-		withUserB:   _q.withUserB.Clone(),
-		withStreak:  _q.withStreak.Clone(),
-		withReveals: _q.withReveals.Clone(),
+		config:           _q.config,
+		ctx:              _q.ctx.Clone(),
+		order:            append([]connection.OrderOption{}, _q.order...),
+		inters:           append([]Interceptor{}, _q.inters...),
+		predicates:       append([]predicate.Connection{}, _q.predicates...),
+		withUserA:        _q.withUserA.Clone(),
+		withUserB:        _q.withUserB.Clone(),
+		withStreak:       _q.withStreak.Clone(),
+		withReveals:      _q.withReveals.Clone(),
+		withRevealGifts:  _q.withRevealGifts.Clone(),
+		withConversation: _q.withConversation.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -400,6 +452,28 @@ func (_q *ConnectionQuery) WithReveals(opts ...func(*RevealQuery)) *ConnectionQu
 	return _q
 }
 
+// WithRevealGifts tells the query-builder to eager-load the nodes that are connected to
+// the "reveal_gifts" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ConnectionQuery) WithRevealGifts(opts ...func(*RevealGiftQuery)) *ConnectionQuery {
+	query := (&RevealGiftClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withRevealGifts = query
+	return _q
+}
+
+// WithConversation tells the query-builder to eager-load the nodes that are connected to
+// the "conversation" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ConnectionQuery) WithConversation(opts ...func(*ConversationQuery)) *ConnectionQuery {
+	query := (&ConversationClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withConversation = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -478,11 +552,13 @@ func (_q *ConnectionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*C
 	var (
 		nodes       = []*Connection{}
 		_spec       = _q.querySpec()
-		loadedTypes = [4]bool{
+		loadedTypes = [6]bool{
 			_q.withUserA != nil,
 			_q.withUserB != nil,
 			_q.withStreak != nil,
 			_q.withReveals != nil,
+			_q.withRevealGifts != nil,
+			_q.withConversation != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -494,6 +570,9 @@ func (_q *ConnectionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*C
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -528,6 +607,19 @@ func (_q *ConnectionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*C
 			return nil, err
 		}
 	}
+	if query := _q.withRevealGifts; query != nil {
+		if err := _q.loadRevealGifts(ctx, query, nodes,
+			func(n *Connection) { n.Edges.RevealGifts = []*RevealGift{} },
+			func(n *Connection, e *RevealGift) { n.Edges.RevealGifts = append(n.Edges.RevealGifts, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withConversation; query != nil {
+		if err := _q.loadConversation(ctx, query, nodes, nil,
+			func(n *Connection, e *Conversation) { n.Edges.Conversation = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -646,9 +738,69 @@ func (_q *ConnectionQuery) loadReveals(ctx context.Context, query *RevealQuery,
 	}
 	return nil
 }
+func (_q *ConnectionQuery) loadRevealGifts(ctx context.Context, query *RevealGiftQuery, nodes []*Connection, init func(*Connection), assign func(*Connection, *RevealGift)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Connection)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(revealgift.FieldConnectionID)
+	}
+	query.Where(predicate.RevealGift(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(connection.RevealGiftsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ConnectionID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "connection_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *ConnectionQuery) loadConversation(ctx context.Context, query *ConversationQuery, nodes []*Connection, init func(*Connection), assign func(*Connection, *Conversation)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Connection)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(conversation.FieldConnectionID)
+	}
+	query.Where(predicate.Conversation(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(connection.ConversationColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ConnectionID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "connection_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *ConnectionQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -717,6 +869,9 @@ func (_q *ConnectionQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -734,6 +889,32 @@ func (_q *ConnectionQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (_q *ConnectionQuery) ForUpdate(opts ...sql.LockOption) *ConnectionQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return _q
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (_q *ConnectionQuery) ForShare(opts ...sql.LockOption) *ConnectionQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return _q
+}
+
 // ConnectionGroupBy is the group-by builder for Connection entities.
 type ConnectionGroupBy struct {
 	selector
@@ -12,8 +12,10 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/UnoraApp/be/ent/generated/connection"
+	"github.com/UnoraApp/be/ent/generated/conversation"
 	"github.com/UnoraApp/be/ent/generated/predicate"
 	"github.com/UnoraApp/be/ent/generated/reveal"
+	"github.com/UnoraApp/be/ent/generated/revealgift"
 	"github.com/UnoraApp/be/ent/generated/streak"
 	"github.com/UnoraApp/be/ent/generated/user"
 )
@@ -171,6 +173,40 @@ func (_u *ConnectionUpdate) AddReveals(v ...*Reveal) *ConnectionUpdate {
 	return _u.AddRevealIDs(ids...)
 }
 
+// AddRevealGiftIDs adds the "reveal_gifts" edge to the RevealGift entity by IDs.
+func (_u *ConnectionUpdate) AddRevealGiftIDs(ids ...string) *ConnectionUpdate {
+	_u.mutation.AddRevealGiftIDs(ids...)
+	return _u
+}
+
+// AddRevealGifts adds the "reveal_gifts" edges to the RevealGift entity.
+func (_u *ConnectionUpdate) AddRevealGifts(v ...*RevealGift) *ConnectionUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRevealGiftIDs(ids...)
+}
+
+// SetConversationID sets the "conversation" edge to the Conversation entity by ID.
+func (_u *ConnectionUpdate) SetConversationID(id string) *ConnectionUpdate {
+	_u.mutation.SetConversationID(id)
+	return _u
+}
+
+// SetNillableConversationID sets the "conversation" edge to the Conversation entity by ID if the given value is not nil.
+func (_u *ConnectionUpdate) SetNillableConversationID(id *string) *ConnectionUpdate {
+	if id != nil {
+		_u = _u.SetConversationID(*id)
+	}
+	return _u
+}
+
+// SetConversation sets the "conversation" edge to the Conversation entity.
+func (_u *ConnectionUpdate) SetConversation(v *Conversation) *ConnectionUpdate {
+	return _u.SetConversationID(v.ID)
+}
+
 // Mutation returns the ConnectionMutation object of the builder.
 func (_u *ConnectionUpdate) Mutation() *ConnectionMutation {
 	return _u.mutation
@@ -215,6 +251,33 @@ func (_u *ConnectionUpdate) RemoveReveals(v ...*Reveal) *ConnectionUpdate {
 	return _u.RemoveRevealIDs(ids...)
 }
 
+// ClearRevealGifts clears all "reveal_gifts" edges to the RevealGift entity.
+func (_u *ConnectionUpdate) ClearRevealGifts() *ConnectionUpdate {
+	_u.mutation.ClearRevealGifts()
+	return _u
+}
+
+// RemoveRevealGiftIDs removes the "reveal_gifts" edge to RevealGift entities by IDs.
+func (_u *ConnectionUpdate) RemoveRevealGiftIDs(ids ...string) *ConnectionUpdate {
+	_u.mutation.RemoveRevealGiftIDs(ids...)
+	return _u
+}
+
+// RemoveRevealGifts removes "reveal_gifts" edges to RevealGift entities.
+func (_u *ConnectionUpdate) RemoveRevealGifts(v ...*RevealGift) *ConnectionUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRevealGiftIDs(ids...)
+}
+
+// ClearConversation clears the "conversation" edge to the Conversation entity.
+func (_u *ConnectionUpdate) ClearConversation() *ConnectionUpdate {
+	_u.mutation.ClearConversation()
+	return _u
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *ConnectionUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -435,6 +498,80 @@ func (_u *ConnectionUpdate) sqlSave(ctx context.Context) (_node int, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.RevealGiftsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   connection.RevealGiftsTable,
+			Columns: []string{connection.RevealGiftsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(revealgift.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRevealGiftsIDs(); len(nodes) > 0 && !_u.mutation.RevealGiftsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   connection.RevealGiftsTable,
+			Columns: []string{connection.RevealGiftsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(revealgift.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RevealGiftsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   connection.RevealGiftsTable,
+			Columns: []string{connection.RevealGiftsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(revealgift.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ConversationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   connection.ConversationTable,
+			Columns: []string{connection.ConversationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(conversation.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ConversationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   connection.ConversationTable,
+			Columns: []string{connection.ConversationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(conversation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{connection.Label}
@@ -595,6 +732,40 @@ func (_u *ConnectionUpdateOne) AddReveals(v ...*Reveal) *ConnectionUpdateOne {
 	return _u.AddRevealIDs(ids...)
 }
 
+// AddRevealGiftIDs adds the "reveal_gifts" edge to the RevealGift entity by IDs.
+func (_u *ConnectionUpdateOne) AddRevealGiftIDs(ids ...string) *ConnectionUpdateOne {
+	_u.mutation.AddRevealGiftIDs(ids...)
+	return _u
+}
+
+// AddRevealGifts adds the "reveal_gifts" edges to the RevealGift entity.
+func (_u *ConnectionUpdateOne) AddRevealGifts(v ...*RevealGift) *ConnectionUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRevealGiftIDs(ids...)
+}
+
+// SetConversationID sets the "conversation" edge to the Conversation entity by ID.
+func (_u *ConnectionUpdateOne) SetConversationID(id string) *ConnectionUpdateOne {
+	_u.mutation.SetConversationID(id)
+	return _u
+}
+
+// SetNillableConversationID sets the "conversation" edge to the Conversation entity by ID if the given value is not nil.
+func (_u *ConnectionUpdateOne) SetNillableConversationID(id *string) *ConnectionUpdateOne {
+	if id != nil {
+		_u = _u.SetConversationID(*id)
+	}
+	return _u
+}
+
+// SetConversation sets the "conversation" edge to the Conversation entity.
+func (_u *ConnectionUpdateOne) SetConversation(v *Conversation) *ConnectionUpdateOne {
+	return _u.SetConversationID(v.ID)
+}
+
 // Mutation returns the ConnectionMutation object of the builder.
 func (_u *ConnectionUpdateOne) Mutation() *ConnectionMutation {
 	return _u.mutation
@@ -639,6 +810,33 @@ func (_u *ConnectionUpdateOne) RemoveReveals(v ...*Reveal) *ConnectionUpdateOne
 	return _u.RemoveRevealIDs(ids...)
 }
 
+// ClearRevealGifts clears all "reveal_gifts" edges to the RevealGift entity.
+func (_u *ConnectionUpdateOne) ClearRevealGifts() *ConnectionUpdateOne {
+	_u.mutation.ClearRevealGifts()
+	return _u
+}
+
+// RemoveRevealGiftIDs removes the "reveal_gifts" edge to RevealGift entities by IDs.
+func (_u *ConnectionUpdateOne) RemoveRevealGiftIDs(ids ...string) *ConnectionUpdateOne {
+	_u.mutation.RemoveRevealGiftIDs(ids...)
+	return _u
+}
+
+// RemoveRevealGifts removes "reveal_gifts" edges to RevealGift entities.
+func (_u *ConnectionUpdateOne) RemoveRevealGifts(v ...*RevealGift) *ConnectionUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRevealGiftIDs(ids...)
+}
+
+// ClearConversation clears the "conversation" edge to the Conversation entity.
+func (_u *ConnectionUpdateOne) ClearConversation() *ConnectionUpdateOne {
+	_u.mutation.ClearConversation()
+	return _u
+}
+
 // Where appends a list predicates to the ConnectionUpdate builder.
 func (_u *ConnectionUpdateOne) Where(ps ...predicate.Connection) *ConnectionUpdateOne {
 	_u.mutation.Where(ps...)
@@ -889,6 +1087,80 @@ func (_u *ConnectionUpdateOne) sqlSave(ctx context.Context) (_node *Connection,
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.RevealGiftsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   connection.RevealGiftsTable,
+			Columns: []string{connection.RevealGiftsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(revealgift.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRevealGiftsIDs(); len(nodes) > 0 && !_u.mutation.RevealGiftsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   connection.RevealGiftsTable,
+			Columns: []string{connection.RevealGiftsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(revealgift.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RevealGiftsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   connection.RevealGiftsTable,
+			Columns: []string{connection.RevealGiftsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(revealgift.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ConversationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   connection.ConversationTable,
+			Columns: []string{connection.ConversationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(conversation.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ConversationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   connection.ConversationTable,
+			Columns: []string{connection.ConversationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(conversation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Connection{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,235 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/UnoraApp/be/ent/generated/connection"
+	"github.com/UnoraApp/be/ent/generated/conversation"
+)
+
+// Conversation is the model entity for the Conversation schema.
+type Conversation struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// ConnectionID holds the value of the "connection_id" field.
+	ConnectionID string `json:"connection_id,omitempty"`
+	// UserAID holds the value of the "user_a_id" field.
+	UserAID string `json:"user_a_id,omitempty"`
+	// UserBID holds the value of the "user_b_id" field.
+	UserBID string `json:"user_b_id,omitempty"`
+	// ConversationStatus holds the value of the "conversation_status" field.
+	ConversationStatus conversation.ConversationStatus `json:"conversation_status,omitempty"`
+	// ClosedReason holds the value of the "closed_reason" field.
+	ClosedReason *conversation.ClosedReason `json:"closed_reason,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// LastMessageAt holds the value of the "last_message_at" field.
+	LastMessageAt *time.Time `json:"last_message_at,omitempty"`
+	// ClosedAt holds the value of the "closed_at" field.
+	ClosedAt *time.Time `json:"closed_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ConversationQuery when eager-loading is set.
+	Edges        ConversationEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ConversationEdges holds the relations/edges for other nodes in the graph.
+type ConversationEdges struct {
+	// Connection holds the value of the connection edge.
+	Connection *Connection `json:"connection,omitempty"`
+	// Messages holds the value of the messages edge.
+	Messages []*Message `json:"messages,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// ConnectionOrErr returns the Connection value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ConversationEdges) ConnectionOrErr() (*Connection, error) {
+	if e.Connection != nil {
+		return e.Connection, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: connection.Label}
+	}
+	return nil, &NotLoadedError{edge: "connection"}
+}
+
+// MessagesOrErr returns the Messages value or an error if the edge
+// was not loaded in eager-loading.
+func (e ConversationEdges) MessagesOrErr() ([]*Message, error) {
+	if e.loadedTypes[1] {
+		return e.Messages, nil
+	}
+	return nil, &NotLoadedError{edge: "messages"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Conversation) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case conversation.FieldID, conversation.FieldConnectionID, conversation.FieldUserAID, conversation.FieldUserBID, conversation.FieldConversationStatus, conversation.FieldClosedReason:
+			values[i] = new(sql.NullString)
+		case conversation.FieldCreatedAt, conversation.FieldLastMessageAt, conversation.FieldClosedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Conversation fields.
+func (_m *Conversation) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case conversation.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case conversation.FieldConnectionID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field connection_id", values[i])
+			} else if value.Valid {
+				_m.ConnectionID = value.String
+			}
+		case conversation.FieldUserAID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_a_id", values[i])
+			} else if value.Valid {
+				_m.UserAID = value.String
+			}
+		case conversation.FieldUserBID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_b_id", values[i])
+			} else if value.Valid {
+				_m.UserBID = value.String
+			}
+		case conversation.FieldConversationStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field conversation_status", values[i])
+			} else if value.Valid {
+				_m.ConversationStatus = conversation.ConversationStatus(value.String)
+			}
+		case conversation.FieldClosedReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field closed_reason", values[i])
+			} else if value.Valid {
+				_m.ClosedReason = new(conversation.ClosedReason)
+				*_m.ClosedReason = conversation.ClosedReason(value.String)
+			}
+		case conversation.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case conversation.FieldLastMessageAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_message_at", values[i])
+			} else if value.Valid {
+				_m.LastMessageAt = new(time.Time)
+				*_m.LastMessageAt = value.Time
+			}
+		case conversation.FieldClosedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field closed_at", values[i])
+			} else if value.Valid {
+				_m.ClosedAt = new(time.Time)
+				*_m.ClosedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Conversation.
+// This includes values selected through modifiers, order, etc.
+func (_m *Conversation) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryConnection queries the "connection" edge of the Conversation entity.
+func (_m *Conversation) QueryConnection() *ConnectionQuery {
+	return NewConversationClient(_m.config).QueryConnection(_m)
+}
+
+// QueryMessages queries the "messages" edge of the Conversation entity.
+func (_m *Conversation) QueryMessages() *MessageQuery {
+	return NewConversationClient(_m.config).QueryMessages(_m)
+}
+
+// Update returns a builder for updating this Conversation.
+// Note that you need to call Conversation.Unwrap() before calling this method if this Conversation
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Conversation) Update() *ConversationUpdateOne {
+	return NewConversationClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Conversation entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Conversation) Unwrap() *Conversation {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("generated: Conversation is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Conversation) String() string {
+	var builder strings.Builder
+	builder.WriteString("Conversation(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("connection_id=")
+	builder.WriteString(_m.ConnectionID)
+	builder.WriteString(", ")
+	builder.WriteString("user_a_id=")
+	builder.WriteString(_m.UserAID)
+	builder.WriteString(", ")
+	builder.WriteString("user_b_id=")
+	builder.WriteString(_m.UserBID)
+	builder.WriteString(", ")
+	builder.WriteString("conversation_status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ConversationStatus))
+	builder.WriteString(", ")
+	if v := _m.ClosedReason; v != nil {
+		builder.WriteString("closed_reason=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.LastMessageAt; v != nil {
+		builder.WriteString("last_message_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.ClosedAt; v != nil {
+		builder.WriteString("closed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Conversations is a parsable slice of Conversation.
+type Conversations []*Conversation
@@ -0,0 +1,222 @@
+// Code generated by ent, DO NOT EDIT.
+
+package conversation
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the conversation type in the database.
+	Label = "conversation"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldConnectionID holds the string denoting the connection_id field in the database.
+	FieldConnectionID = "connection_id"
+	// FieldUserAID holds the string denoting the user_a_id field in the database.
+	FieldUserAID = "user_a_id"
+	// FieldUserBID holds the string denoting the user_b_id field in the database.
+	FieldUserBID = "user_b_id"
+	// FieldConversationStatus holds the string denoting the conversation_status field in the database.
+	FieldConversationStatus = "conversation_status"
+	// FieldClosedReason holds the string denoting the closed_reason field in the database.
+	FieldClosedReason = "closed_reason"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldLastMessageAt holds the string denoting the last_message_at field in the database.
+	FieldLastMessageAt = "last_message_at"
+	// FieldClosedAt holds the string denoting the closed_at field in the database.
+	FieldClosedAt = "closed_at"
+	// EdgeConnection holds the string denoting the connection edge name in mutations.
+	EdgeConnection = "connection"
+	// EdgeMessages holds the string denoting the messages edge name in mutations.
+	EdgeMessages = "messages"
+	// Table holds the table name of the conversation in the database.
+	Table = "conversations"
+	// ConnectionTable is the table that holds the connection relation/edge.
+	ConnectionTable = "conversations"
+	// ConnectionInverseTable is the table name for the Connection entity.
+	// It exists in this package in order to avoid circular dependency with the "connection" package.
+	ConnectionInverseTable = "connections"
+	// ConnectionColumn is the table column denoting the connection relation/edge.
+	ConnectionColumn = "connection_id"
+	// MessagesTable is the table that holds the messages relation/edge.
+	MessagesTable = "messages"
+	// MessagesInverseTable is the table name for the Message entity.
+	// It exists in this package in order to avoid circular dependency with the "message" package.
+	MessagesInverseTable = "messages"
+	// MessagesColumn is the table column denoting the messages relation/edge.
+	MessagesColumn = "conversation_id"
+)
+
+// Columns holds all SQL columns for conversation fields.
+var Columns = []string{
+	FieldID,
+	FieldConnectionID,
+	FieldUserAID,
+	FieldUserBID,
+	FieldConversationStatus,
+	FieldClosedReason,
+	FieldCreatedAt,
+	FieldLastMessageAt,
+	FieldClosedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// ConnectionIDValidator is a validator for the "connection_id" field. It is called by the builders before save.
+	ConnectionIDValidator func(string) error
+	// UserAIDValidator is a validator for the "user_a_id" field. It is called by the builders before save.
+	UserAIDValidator func(string) error
+	// UserBIDValidator is a validator for the "user_b_id" field. It is called by the builders before save.
+	UserBIDValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
+
+// ConversationStatus defines the type for the "conversation_status" enum field.
+type ConversationStatus string
+
+// ConversationStatusOpen is the default value of the ConversationStatus enum.
+const DefaultConversationStatus = ConversationStatusOpen
+
+// ConversationStatus values.
+const (
+	ConversationStatusOpen   ConversationStatus = "open"
+	ConversationStatusClosed ConversationStatus = "closed"
+)
+
+func (cs ConversationStatus) String() string {
+	return string(cs)
+}
+
+// ConversationStatusValidator is a validator for the "conversation_status" field enum values. It is called by the builders before save.
+func ConversationStatusValidator(cs ConversationStatus) error {
+	switch cs {
+	case ConversationStatusOpen, ConversationStatusClosed:
+		return nil
+	default:
+		return fmt.Errorf("conversation: invalid enum value for conversation_status field: %q", cs)
+	}
+}
+
+// ClosedReason defines the type for the "closed_reason" enum field.
+type ClosedReason string
+
+// ClosedReason values.
+const (
+	ClosedReasonBlocked    ClosedReason = "blocked"
+	ClosedReasonTerminated ClosedReason = "terminated"
+)
+
+func (cr ClosedReason) String() string {
+	return string(cr)
+}
+
+// ClosedReasonValidator is a validator for the "closed_reason" field enum values. It is called by the builders before save.
+func ClosedReasonValidator(cr ClosedReason) error {
+	switch cr {
+	case ClosedReasonBlocked, ClosedReasonTerminated:
+		return nil
+	default:
+		return fmt.Errorf("conversation: invalid enum value for closed_reason field: %q", cr)
+	}
+}
+
+// OrderOption defines the ordering options for the Conversation queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByConnectionID orders the results by the connection_id field.
+func ByConnectionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldConnectionID, opts...).ToFunc()
+}
+
+// ByUserAID orders the results by the user_a_id field.
+func ByUserAID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserAID, opts...).ToFunc()
+}
+
+// ByUserBID orders the results by the user_b_id field.
+func ByUserBID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserBID, opts...).ToFunc()
+}
+
+// ByConversationStatus orders the results by the conversation_status field.
+func ByConversationStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldConversationStatus, opts...).ToFunc()
+}
+
+// ByClosedReason orders the results by the closed_reason field.
+func ByClosedReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClosedReason, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByLastMessageAt orders the results by the last_message_at field.
+func ByLastMessageAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastMessageAt, opts...).ToFunc()
+}
+
+// ByClosedAt orders the results by the closed_at field.
+func ByClosedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClosedAt, opts...).ToFunc()
+}
+
+// ByConnectionField orders the results by connection field.
+func ByConnectionField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newConnectionStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByMessagesCount orders the results by messages count.
+func ByMessagesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMessagesStep(), opts...)
+	}
+}
+
+// ByMessages orders the results by messages terms.
+func ByMessages(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMessagesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newConnectionStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ConnectionInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, true, ConnectionTable, ConnectionColumn),
+	)
+}
+func newMessagesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MessagesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, MessagesTable, MessagesColumn),
+	)
+}
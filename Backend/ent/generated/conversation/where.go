@@ -0,0 +1,542 @@
+// Code generated by ent, DO NOT EDIT.
+
+package conversation
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/UnoraApp/be/ent/generated/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldContainsFold(FieldID, id))
+}
+
+// ConnectionID applies equality check predicate on the "connection_id" field. It's identical to ConnectionIDEQ.
+func ConnectionID(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldEQ(FieldConnectionID, v))
+}
+
+// UserAID applies equality check predicate on the "user_a_id" field. It's identical to UserAIDEQ.
+func UserAID(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldEQ(FieldUserAID, v))
+}
+
+// UserBID applies equality check predicate on the "user_b_id" field. It's identical to UserBIDEQ.
+func UserBID(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldEQ(FieldUserBID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Conversation {
+	return predicate.Conversation(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// LastMessageAt applies equality check predicate on the "last_message_at" field. It's identical to LastMessageAtEQ.
+func LastMessageAt(v time.Time) predicate.Conversation {
+	return predicate.Conversation(sql.FieldEQ(FieldLastMessageAt, v))
+}
+
+// ClosedAt applies equality check predicate on the "closed_at" field. It's identical to ClosedAtEQ.
+func ClosedAt(v time.Time) predicate.Conversation {
+	return predicate.Conversation(sql.FieldEQ(FieldClosedAt, v))
+}
+
+// ConnectionIDEQ applies the EQ predicate on the "connection_id" field.
+func ConnectionIDEQ(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldEQ(FieldConnectionID, v))
+}
+
+// ConnectionIDNEQ applies the NEQ predicate on the "connection_id" field.
+func ConnectionIDNEQ(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldNEQ(FieldConnectionID, v))
+}
+
+// ConnectionIDIn applies the In predicate on the "connection_id" field.
+func ConnectionIDIn(vs ...string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldIn(FieldConnectionID, vs...))
+}
+
+// ConnectionIDNotIn applies the NotIn predicate on the "connection_id" field.
+func ConnectionIDNotIn(vs ...string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldNotIn(FieldConnectionID, vs...))
+}
+
+// ConnectionIDGT applies the GT predicate on the "connection_id" field.
+func ConnectionIDGT(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldGT(FieldConnectionID, v))
+}
+
+// ConnectionIDGTE applies the GTE predicate on the "connection_id" field.
+func ConnectionIDGTE(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldGTE(FieldConnectionID, v))
+}
+
+// ConnectionIDLT applies the LT predicate on the "connection_id" field.
+func ConnectionIDLT(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldLT(FieldConnectionID, v))
+}
+
+// ConnectionIDLTE applies the LTE predicate on the "connection_id" field.
+func ConnectionIDLTE(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldLTE(FieldConnectionID, v))
+}
+
+// ConnectionIDContains applies the Contains predicate on the "connection_id" field.
+func ConnectionIDContains(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldContains(FieldConnectionID, v))
+}
+
+// ConnectionIDHasPrefix applies the HasPrefix predicate on the "connection_id" field.
+func ConnectionIDHasPrefix(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldHasPrefix(FieldConnectionID, v))
+}
+
+// ConnectionIDHasSuffix applies the HasSuffix predicate on the "connection_id" field.
+func ConnectionIDHasSuffix(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldHasSuffix(FieldConnectionID, v))
+}
+
+// ConnectionIDEqualFold applies the EqualFold predicate on the "connection_id" field.
+func ConnectionIDEqualFold(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldEqualFold(FieldConnectionID, v))
+}
+
+// ConnectionIDContainsFold applies the ContainsFold predicate on the "connection_id" field.
+func ConnectionIDContainsFold(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldContainsFold(FieldConnectionID, v))
+}
+
+// UserAIDEQ applies the EQ predicate on the "user_a_id" field.
+func UserAIDEQ(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldEQ(FieldUserAID, v))
+}
+
+// UserAIDNEQ applies the NEQ predicate on the "user_a_id" field.
+func UserAIDNEQ(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldNEQ(FieldUserAID, v))
+}
+
+// UserAIDIn applies the In predicate on the "user_a_id" field.
+func UserAIDIn(vs ...string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldIn(FieldUserAID, vs...))
+}
+
+// UserAIDNotIn applies the NotIn predicate on the "user_a_id" field.
+func UserAIDNotIn(vs ...string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldNotIn(FieldUserAID, vs...))
+}
+
+// UserAIDGT applies the GT predicate on the "user_a_id" field.
+func UserAIDGT(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldGT(FieldUserAID, v))
+}
+
+// UserAIDGTE applies the GTE predicate on the "user_a_id" field.
+func UserAIDGTE(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldGTE(FieldUserAID, v))
+}
+
+// UserAIDLT applies the LT predicate on the "user_a_id" field.
+func UserAIDLT(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldLT(FieldUserAID, v))
+}
+
+// UserAIDLTE applies the LTE predicate on the "user_a_id" field.
+func UserAIDLTE(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldLTE(FieldUserAID, v))
+}
+
+// UserAIDContains applies the Contains predicate on the "user_a_id" field.
+func UserAIDContains(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldContains(FieldUserAID, v))
+}
+
+// UserAIDHasPrefix applies the HasPrefix predicate on the "user_a_id" field.
+func UserAIDHasPrefix(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldHasPrefix(FieldUserAID, v))
+}
+
+// UserAIDHasSuffix applies the HasSuffix predicate on the "user_a_id" field.
+func UserAIDHasSuffix(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldHasSuffix(FieldUserAID, v))
+}
+
+// UserAIDEqualFold applies the EqualFold predicate on the "user_a_id" field.
+func UserAIDEqualFold(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldEqualFold(FieldUserAID, v))
+}
+
+// UserAIDContainsFold applies the ContainsFold predicate on the "user_a_id" field.
+func UserAIDContainsFold(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldContainsFold(FieldUserAID, v))
+}
+
+// UserBIDEQ applies the EQ predicate on the "user_b_id" field.
+func UserBIDEQ(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldEQ(FieldUserBID, v))
+}
+
+// UserBIDNEQ applies the NEQ predicate on the "user_b_id" field.
+func UserBIDNEQ(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldNEQ(FieldUserBID, v))
+}
+
+// UserBIDIn applies the In predicate on the "user_b_id" field.
+func UserBIDIn(vs ...string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldIn(FieldUserBID, vs...))
+}
+
+// UserBIDNotIn applies the NotIn predicate on the "user_b_id" field.
+func UserBIDNotIn(vs ...string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldNotIn(FieldUserBID, vs...))
+}
+
+// UserBIDGT applies the GT predicate on the "user_b_id" field.
+func UserBIDGT(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldGT(FieldUserBID, v))
+}
+
+// UserBIDGTE applies the GTE predicate on the "user_b_id" field.
+func UserBIDGTE(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldGTE(FieldUserBID, v))
+}
+
+// UserBIDLT applies the LT predicate on the "user_b_id" field.
+func UserBIDLT(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldLT(FieldUserBID, v))
+}
+
+// UserBIDLTE applies the LTE predicate on the "user_b_id" field.
+func UserBIDLTE(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldLTE(FieldUserBID, v))
+}
+
+// UserBIDContains applies the Contains predicate on the "user_b_id" field.
+func UserBIDContains(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldContains(FieldUserBID, v))
+}
+
+// UserBIDHasPrefix applies the HasPrefix predicate on the "user_b_id" field.
+func UserBIDHasPrefix(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldHasPrefix(FieldUserBID, v))
+}
+
+// UserBIDHasSuffix applies the HasSuffix predicate on the "user_b_id" field.
+func UserBIDHasSuffix(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldHasSuffix(FieldUserBID, v))
+}
+
+// UserBIDEqualFold applies the EqualFold predicate on the "user_b_id" field.
+func UserBIDEqualFold(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldEqualFold(FieldUserBID, v))
+}
+
+// UserBIDContainsFold applies the ContainsFold predicate on the "user_b_id" field.
+func UserBIDContainsFold(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldContainsFold(FieldUserBID, v))
+}
+
+// ConversationStatusEQ applies the EQ predicate on the "conversation_status" field.
+func ConversationStatusEQ(v ConversationStatus) predicate.Conversation {
+	return predicate.Conversation(sql.FieldEQ(FieldConversationStatus, v))
+}
+
+// ConversationStatusNEQ applies the NEQ predicate on the "conversation_status" field.
+func ConversationStatusNEQ(v ConversationStatus) predicate.Conversation {
+	return predicate.Conversation(sql.FieldNEQ(FieldConversationStatus, v))
+}
+
+// ConversationStatusIn applies the In predicate on the "conversation_status" field.
+func ConversationStatusIn(vs ...ConversationStatus) predicate.Conversation {
+	return predicate.Conversation(sql.FieldIn(FieldConversationStatus, vs...))
+}
+
+// ConversationStatusNotIn applies the NotIn predicate on the "conversation_status" field.
+func ConversationStatusNotIn(vs ...ConversationStatus) predicate.Conversation {
+	return predicate.Conversation(sql.FieldNotIn(FieldConversationStatus, vs...))
+}
+
+// ClosedReasonEQ applies the EQ predicate on the "closed_reason" field.
+func ClosedReasonEQ(v ClosedReason) predicate.Conversation {
+	return predicate.Conversation(sql.FieldEQ(FieldClosedReason, v))
+}
+
+// ClosedReasonNEQ applies the NEQ predicate on the "closed_reason" field.
+func ClosedReasonNEQ(v ClosedReason) predicate.Conversation {
+	return predicate.Conversation(sql.FieldNEQ(FieldClosedReason, v))
+}
+
+// ClosedReasonIn applies the In predicate on the "closed_reason" field.
+func ClosedReasonIn(vs ...ClosedReason) predicate.Conversation {
+	return predicate.Conversation(sql.FieldIn(FieldClosedReason, vs...))
+}
+
+// ClosedReasonNotIn applies the NotIn predicate on the "closed_reason" field.
+func ClosedReasonNotIn(vs ...ClosedReason) predicate.Conversation {
+	return predicate.Conversation(sql.FieldNotIn(FieldClosedReason, vs...))
+}
+
+// ClosedReasonIsNil applies the IsNil predicate on the "closed_reason" field.
+func ClosedReasonIsNil() predicate.Conversation {
+	return predicate.Conversation(sql.FieldIsNull(FieldClosedReason))
+}
+
+// ClosedReasonNotNil applies the NotNil predicate on the "closed_reason" field.
+func ClosedReasonNotNil() predicate.Conversation {
+	return predicate.Conversation(sql.FieldNotNull(FieldClosedReason))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Conversation {
+	return predicate.Conversation(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Conversation {
+	return predicate.Conversation(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Conversation {
+	return predicate.Conversation(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Conversation {
+	return predicate.Conversation(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Conversation {
+	return predicate.Conversation(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Conversation {
+	return predicate.Conversation(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Conversation {
+	return predicate.Conversation(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Conversation {
+	return predicate.Conversation(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// LastMessageAtEQ applies the EQ predicate on the "last_message_at" field.
+func LastMessageAtEQ(v time.Time) predicate.Conversation {
+	return predicate.Conversation(sql.FieldEQ(FieldLastMessageAt, v))
+}
+
+// LastMessageAtNEQ applies the NEQ predicate on the "last_message_at" field.
+func LastMessageAtNEQ(v time.Time) predicate.Conversation {
+	return predicate.Conversation(sql.FieldNEQ(FieldLastMessageAt, v))
+}
+
+// LastMessageAtIn applies the In predicate on the "last_message_at" field.
+func LastMessageAtIn(vs ...time.Time) predicate.Conversation {
+	return predicate.Conversation(sql.FieldIn(FieldLastMessageAt, vs...))
+}
+
+// LastMessageAtNotIn applies the NotIn predicate on the "last_message_at" field.
+func LastMessageAtNotIn(vs ...time.Time) predicate.Conversation {
+	return predicate.Conversation(sql.FieldNotIn(FieldLastMessageAt, vs...))
+}
+
+// LastMessageAtGT applies the GT predicate on the "last_message_at" field.
+func LastMessageAtGT(v time.Time) predicate.Conversation {
+	return predicate.Conversation(sql.FieldGT(FieldLastMessageAt, v))
+}
+
+// LastMessageAtGTE applies the GTE predicate on the "last_message_at" field.
+func LastMessageAtGTE(v time.Time) predicate.Conversation {
+	return predicate.Conversation(sql.FieldGTE(FieldLastMessageAt, v))
+}
+
+// LastMessageAtLT applies the LT predicate on the "last_message_at" field.
+func LastMessageAtLT(v time.Time) predicate.Conversation {
+	return predicate.Conversation(sql.FieldLT(FieldLastMessageAt, v))
+}
+
+// LastMessageAtLTE applies the LTE predicate on the "last_message_at" field.
+func LastMessageAtLTE(v time.Time) predicate.Conversation {
+	return predicate.Conversation(sql.FieldLTE(FieldLastMessageAt, v))
+}
+
+// LastMessageAtIsNil applies the IsNil predicate on the "last_message_at" field.
+func LastMessageAtIsNil() predicate.Conversation {
+	return predicate.Conversation(sql.FieldIsNull(FieldLastMessageAt))
+}
+
+// LastMessageAtNotNil applies the NotNil predicate on the "last_message_at" field.
+func LastMessageAtNotNil() predicate.Conversation {
+	return predicate.Conversation(sql.FieldNotNull(FieldLastMessageAt))
+}
+
+// ClosedAtEQ applies the EQ predicate on the "closed_at" field.
+func ClosedAtEQ(v time.Time) predicate.Conversation {
+	return predicate.Conversation(sql.FieldEQ(FieldClosedAt, v))
+}
+
+// ClosedAtNEQ applies the NEQ predicate on the "closed_at" field.
+func ClosedAtNEQ(v time.Time) predicate.Conversation {
+	return predicate.Conversation(sql.FieldNEQ(FieldClosedAt, v))
+}
+
+// ClosedAtIn applies the In predicate on the "closed_at" field.
+func ClosedAtIn(vs ...time.Time) predicate.Conversation {
+	return predicate.Conversation(sql.FieldIn(FieldClosedAt, vs...))
+}
+
+// ClosedAtNotIn applies the NotIn predicate on the "closed_at" field.
+func ClosedAtNotIn(vs ...time.Time) predicate.Conversation {
+	return predicate.Conversation(sql.FieldNotIn(FieldClosedAt, vs...))
+}
+
+// ClosedAtGT applies the GT predicate on the "closed_at" field.
+func ClosedAtGT(v time.Time) predicate.Conversation {
+	return predicate.Conversation(sql.FieldGT(FieldClosedAt, v))
+}
+
+// ClosedAtGTE applies the GTE predicate on the "closed_at" field.
+func ClosedAtGTE(v time.Time) predicate.Conversation {
+	return predicate.Conversation(sql.FieldGTE(FieldClosedAt, v))
+}
+
+// ClosedAtLT applies the LT predicate on the "closed_at" field.
+func ClosedAtLT(v time.Time) predicate.Conversation {
+	return predicate.Conversation(sql.FieldLT(FieldClosedAt, v))
+}
+
+// ClosedAtLTE applies the LTE predicate on the "closed_at" field.
+func ClosedAtLTE(v time.Time) predicate.Conversation {
+	return predicate.Conversation(sql.FieldLTE(FieldClosedAt, v))
+}
+
+// ClosedAtIsNil applies the IsNil predicate on the "closed_at" field.
+func ClosedAtIsNil() predicate.Conversation {
+	return predicate.Conversation(sql.FieldIsNull(FieldClosedAt))
+}
+
+// ClosedAtNotNil applies the NotNil predicate on the "closed_at" field.
+func ClosedAtNotNil() predicate.Conversation {
+	return predicate.Conversation(sql.FieldNotNull(FieldClosedAt))
+}
+
+// HasConnection applies the HasEdge predicate on the "connection" edge.
+func HasConnection() predicate.Conversation {
+	return predicate.Conversation(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, ConnectionTable, ConnectionColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasConnectionWith applies the HasEdge predicate on the "connection" edge with a given conditions (other predicates).
+func HasConnectionWith(preds ...predicate.Connection) predicate.Conversation {
+	return predicate.Conversation(func(s *sql.Selector) {
+		step := newConnectionStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMessages applies the HasEdge predicate on the "messages" edge.
+func HasMessages() predicate.Conversation {
+	return predicate.Conversation(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, MessagesTable, MessagesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMessagesWith applies the HasEdge predicate on the "messages" edge with a given conditions (other predicates).
+func HasMessagesWith(preds ...predicate.Message) predicate.Conversation {
+	return predicate.Conversation(func(s *sql.Selector) {
+		step := newMessagesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Conversation) predicate.Conversation {
+	return predicate.Conversation(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Conversation) predicate.Conversation {
+	return predicate.Conversation(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Conversation) predicate.Conversation {
+	return predicate.Conversation(sql.NotPredicates(p))
+}
@@ -0,0 +1,914 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/UnoraApp/be/ent/generated/connection"
+	"github.com/UnoraApp/be/ent/generated/conversation"
+	"github.com/UnoraApp/be/ent/generated/message"
+)
+
+// ConversationCreate is the builder for creating a Conversation entity.
+type ConversationCreate struct {
+	config
+	mutation *ConversationMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetConnectionID sets the "connection_id" field.
+func (_c *ConversationCreate) SetConnectionID(v string) *ConversationCreate {
+	_c.mutation.SetConnectionID(v)
+	return _c
+}
+
+// SetUserAID sets the "user_a_id" field.
+func (_c *ConversationCreate) SetUserAID(v string) *ConversationCreate {
+	_c.mutation.SetUserAID(v)
+	return _c
+}
+
+// SetUserBID sets the "user_b_id" field.
+func (_c *ConversationCreate) SetUserBID(v string) *ConversationCreate {
+	_c.mutation.SetUserBID(v)
+	return _c
+}
+
+// SetConversationStatus sets the "conversation_status" field.
+func (_c *ConversationCreate) SetConversationStatus(v conversation.ConversationStatus) *ConversationCreate {
+	_c.mutation.SetConversationStatus(v)
+	return _c
+}
+
+// SetNillableConversationStatus sets the "conversation_status" field if the given value is not nil.
+func (_c *ConversationCreate) SetNillableConversationStatus(v *conversation.ConversationStatus) *ConversationCreate {
+	if v != nil {
+		_c.SetConversationStatus(*v)
+	}
+	return _c
+}
+
+// SetClosedReason sets the "closed_reason" field.
+func (_c *ConversationCreate) SetClosedReason(v conversation.ClosedReason) *ConversationCreate {
+	_c.mutation.SetClosedReason(v)
+	return _c
+}
+
+// SetNillableClosedReason sets the "closed_reason" field if the given value is not nil.
+func (_c *ConversationCreate) SetNillableClosedReason(v *conversation.ClosedReason) *ConversationCreate {
+	if v != nil {
+		_c.SetClosedReason(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ConversationCreate) SetCreatedAt(v time.Time) *ConversationCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ConversationCreate) SetNillableCreatedAt(v *time.Time) *ConversationCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetLastMessageAt sets the "last_message_at" field.
+func (_c *ConversationCreate) SetLastMessageAt(v time.Time) *ConversationCreate {
+	_c.mutation.SetLastMessageAt(v)
+	return _c
+}
+
+// SetNillableLastMessageAt sets the "last_message_at" field if the given value is not nil.
+func (_c *ConversationCreate) SetNillableLastMessageAt(v *time.Time) *ConversationCreate {
+	if v != nil {
+		_c.SetLastMessageAt(*v)
+	}
+	return _c
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (_c *ConversationCreate) SetClosedAt(v time.Time) *ConversationCreate {
+	_c.mutation.SetClosedAt(v)
+	return _c
+}
+
+// SetNillableClosedAt sets the "closed_at" field if the given value is not nil.
+func (_c *ConversationCreate) SetNillableClosedAt(v *time.Time) *ConversationCreate {
+	if v != nil {
+		_c.SetClosedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ConversationCreate) SetID(v string) *ConversationCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetConnection sets the "connection" edge to the Connection entity.
+func (_c *ConversationCreate) SetConnection(v *Connection) *ConversationCreate {
+	return _c.SetConnectionID(v.ID)
+}
+
+// AddMessageIDs adds the "messages" edge to the Message entity by IDs.
+func (_c *ConversationCreate) AddMessageIDs(ids ...string) *ConversationCreate {
+	_c.mutation.AddMessageIDs(ids...)
+	return _c
+}
+
+// AddMessages adds the "messages" edges to the Message entity.
+func (_c *ConversationCreate) AddMessages(v ...*Message) *ConversationCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddMessageIDs(ids...)
+}
+
+// Mutation returns the ConversationMutation object of the builder.
+func (_c *ConversationCreate) Mutation() *ConversationMutation {
+	return _c.mutation
+}
+
+// Save creates the Conversation in the database.
+func (_c *ConversationCreate) Save(ctx context.Context) (*Conversation, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ConversationCreate) SaveX(ctx context.Context) *Conversation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ConversationCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ConversationCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ConversationCreate) defaults() {
+	if _, ok := _c.mutation.ConversationStatus(); !ok {
+		v := conversation.DefaultConversationStatus
+		_c.mutation.SetConversationStatus(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := conversation.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ConversationCreate) check() error {
+	if _, ok := _c.mutation.ConnectionID(); !ok {
+		return &ValidationError{Name: "connection_id", err: errors.New(`generated: missing required field "Conversation.connection_id"`)}
+	}
+	if v, ok := _c.mutation.ConnectionID(); ok {
+		if err := conversation.ConnectionIDValidator(v); err != nil {
+			return &ValidationError{Name: "connection_id", err: fmt.Errorf(`generated: validator failed for field "Conversation.connection_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.UserAID(); !ok {
+		return &ValidationError{Name: "user_a_id", err: errors.New(`generated: missing required field "Conversation.user_a_id"`)}
+	}
+	if v, ok := _c.mutation.UserAID(); ok {
+		if err := conversation.UserAIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_a_id", err: fmt.Errorf(`generated: validator failed for field "Conversation.user_a_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.UserBID(); !ok {
+		return &ValidationError{Name: "user_b_id", err: errors.New(`generated: missing required field "Conversation.user_b_id"`)}
+	}
+	if v, ok := _c.mutation.UserBID(); ok {
+		if err := conversation.UserBIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_b_id", err: fmt.Errorf(`generated: validator failed for field "Conversation.user_b_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ConversationStatus(); !ok {
+		return &ValidationError{Name: "conversation_status", err: errors.New(`generated: missing required field "Conversation.conversation_status"`)}
+	}
+	if v, ok := _c.mutation.ConversationStatus(); ok {
+		if err := conversation.ConversationStatusValidator(v); err != nil {
+			return &ValidationError{Name: "conversation_status", err: fmt.Errorf(`generated: validator failed for field "Conversation.conversation_status": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ClosedReason(); ok {
+		if err := conversation.ClosedReasonValidator(v); err != nil {
+			return &ValidationError{Name: "closed_reason", err: fmt.Errorf(`generated: validator failed for field "Conversation.closed_reason": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "Conversation.created_at"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := conversation.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`generated: validator failed for field "Conversation.id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.ConnectionIDs()) == 0 {
+		return &ValidationError{Name: "connection", err: errors.New(`generated: missing required edge "Conversation.connection"`)}
+	}
+	return nil
+}
+
+func (_c *ConversationCreate) sqlSave(ctx context.Context) (*Conversation, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Conversation.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ConversationCreate) createSpec() (*Conversation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Conversation{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(conversation.Table, sqlgraph.NewFieldSpec(conversation.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.UserAID(); ok {
+		_spec.SetField(conversation.FieldUserAID, field.TypeString, value)
+		_node.UserAID = value
+	}
+	if value, ok := _c.mutation.UserBID(); ok {
+		_spec.SetField(conversation.FieldUserBID, field.TypeString, value)
+		_node.UserBID = value
+	}
+	if value, ok := _c.mutation.ConversationStatus(); ok {
+		_spec.SetField(conversation.FieldConversationStatus, field.TypeEnum, value)
+		_node.ConversationStatus = value
+	}
+	if value, ok := _c.mutation.ClosedReason(); ok {
+		_spec.SetField(conversation.FieldClosedReason, field.TypeEnum, value)
+		_node.ClosedReason = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(conversation.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.LastMessageAt(); ok {
+		_spec.SetField(conversation.FieldLastMessageAt, field.TypeTime, value)
+		_node.LastMessageAt = &value
+	}
+	if value, ok := _c.mutation.ClosedAt(); ok {
+		_spec.SetField(conversation.FieldClosedAt, field.TypeTime, value)
+		_node.ClosedAt = &value
+	}
+	if nodes := _c.mutation.ConnectionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   conversation.ConnectionTable,
+			Columns: []string{conversation.ConnectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(connection.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ConnectionID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.MessagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   conversation.MessagesTable,
+			Columns: []string{conversation.MessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Conversation.Create().
+//		SetConnectionID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ConversationUpsert) {
+//			SetConnectionID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ConversationCreate) OnConflict(opts ...sql.ConflictOption) *ConversationUpsertOne {
+	_c.conflict = opts
+	return &ConversationUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Conversation.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ConversationCreate) OnConflictColumns(columns ...string) *ConversationUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ConversationUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ConversationUpsertOne is the builder for "upsert"-ing
+	//  one Conversation node.
+	ConversationUpsertOne struct {
+		create *ConversationCreate
+	}
+
+	// ConversationUpsert is the "OnConflict" setter.
+	ConversationUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetConversationStatus sets the "conversation_status" field.
+func (u *ConversationUpsert) SetConversationStatus(v conversation.ConversationStatus) *ConversationUpsert {
+	u.Set(conversation.FieldConversationStatus, v)
+	return u
+}
+
+// UpdateConversationStatus sets the "conversation_status" field to the value that was provided on create.
+func (u *ConversationUpsert) UpdateConversationStatus() *ConversationUpsert {
+	u.SetExcluded(conversation.FieldConversationStatus)
+	return u
+}
+
+// SetClosedReason sets the "closed_reason" field.
+func (u *ConversationUpsert) SetClosedReason(v conversation.ClosedReason) *ConversationUpsert {
+	u.Set(conversation.FieldClosedReason, v)
+	return u
+}
+
+// UpdateClosedReason sets the "closed_reason" field to the value that was provided on create.
+func (u *ConversationUpsert) UpdateClosedReason() *ConversationUpsert {
+	u.SetExcluded(conversation.FieldClosedReason)
+	return u
+}
+
+// ClearClosedReason clears the value of the "closed_reason" field.
+func (u *ConversationUpsert) ClearClosedReason() *ConversationUpsert {
+	u.SetNull(conversation.FieldClosedReason)
+	return u
+}
+
+// SetLastMessageAt sets the "last_message_at" field.
+func (u *ConversationUpsert) SetLastMessageAt(v time.Time) *ConversationUpsert {
+	u.Set(conversation.FieldLastMessageAt, v)
+	return u
+}
+
+// UpdateLastMessageAt sets the "last_message_at" field to the value that was provided on create.
+func (u *ConversationUpsert) UpdateLastMessageAt() *ConversationUpsert {
+	u.SetExcluded(conversation.FieldLastMessageAt)
+	return u
+}
+
+// ClearLastMessageAt clears the value of the "last_message_at" field.
+func (u *ConversationUpsert) ClearLastMessageAt() *ConversationUpsert {
+	u.SetNull(conversation.FieldLastMessageAt)
+	return u
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (u *ConversationUpsert) SetClosedAt(v time.Time) *ConversationUpsert {
+	u.Set(conversation.FieldClosedAt, v)
+	return u
+}
+
+// UpdateClosedAt sets the "closed_at" field to the value that was provided on create.
+func (u *ConversationUpsert) UpdateClosedAt() *ConversationUpsert {
+	u.SetExcluded(conversation.FieldClosedAt)
+	return u
+}
+
+// ClearClosedAt clears the value of the "closed_at" field.
+func (u *ConversationUpsert) ClearClosedAt() *ConversationUpsert {
+	u.SetNull(conversation.FieldClosedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Conversation.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(conversation.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ConversationUpsertOne) UpdateNewValues() *ConversationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(conversation.FieldID)
+		}
+		if _, exists := u.create.mutation.ConnectionID(); exists {
+			s.SetIgnore(conversation.FieldConnectionID)
+		}
+		if _, exists := u.create.mutation.UserAID(); exists {
+			s.SetIgnore(conversation.FieldUserAID)
+		}
+		if _, exists := u.create.mutation.UserBID(); exists {
+			s.SetIgnore(conversation.FieldUserBID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(conversation.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Conversation.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ConversationUpsertOne) Ignore() *ConversationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ConversationUpsertOne) DoNothing() *ConversationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ConversationCreate.OnConflict
+// documentation for more info.
+func (u *ConversationUpsertOne) Update(set func(*ConversationUpsert)) *ConversationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ConversationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetConversationStatus sets the "conversation_status" field.
+func (u *ConversationUpsertOne) SetConversationStatus(v conversation.ConversationStatus) *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.SetConversationStatus(v)
+	})
+}
+
+// UpdateConversationStatus sets the "conversation_status" field to the value that was provided on create.
+func (u *ConversationUpsertOne) UpdateConversationStatus() *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.UpdateConversationStatus()
+	})
+}
+
+// SetClosedReason sets the "closed_reason" field.
+func (u *ConversationUpsertOne) SetClosedReason(v conversation.ClosedReason) *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.SetClosedReason(v)
+	})
+}
+
+// UpdateClosedReason sets the "closed_reason" field to the value that was provided on create.
+func (u *ConversationUpsertOne) UpdateClosedReason() *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.UpdateClosedReason()
+	})
+}
+
+// ClearClosedReason clears the value of the "closed_reason" field.
+func (u *ConversationUpsertOne) ClearClosedReason() *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.ClearClosedReason()
+	})
+}
+
+// SetLastMessageAt sets the "last_message_at" field.
+func (u *ConversationUpsertOne) SetLastMessageAt(v time.Time) *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.SetLastMessageAt(v)
+	})
+}
+
+// UpdateLastMessageAt sets the "last_message_at" field to the value that was provided on create.
+func (u *ConversationUpsertOne) UpdateLastMessageAt() *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.UpdateLastMessageAt()
+	})
+}
+
+// ClearLastMessageAt clears the value of the "last_message_at" field.
+func (u *ConversationUpsertOne) ClearLastMessageAt() *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.ClearLastMessageAt()
+	})
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (u *ConversationUpsertOne) SetClosedAt(v time.Time) *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.SetClosedAt(v)
+	})
+}
+
+// UpdateClosedAt sets the "closed_at" field to the value that was provided on create.
+func (u *ConversationUpsertOne) UpdateClosedAt() *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.UpdateClosedAt()
+	})
+}
+
+// ClearClosedAt clears the value of the "closed_at" field.
+func (u *ConversationUpsertOne) ClearClosedAt() *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.ClearClosedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ConversationUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("generated: missing options for ConversationCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ConversationUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ConversationUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("generated: ConversationUpsertOne.ID is not supported by MySQL driver. Use ConversationUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ConversationUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ConversationCreateBulk is the builder for creating many Conversation entities in bulk.
+type ConversationCreateBulk struct {
+	config
+	err      error
+	builders []*ConversationCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Conversation entities in the database.
+func (_c *ConversationCreateBulk) Save(ctx context.Context) ([]*Conversation, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Conversation, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ConversationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ConversationCreateBulk) SaveX(ctx context.Context) []*Conversation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ConversationCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ConversationCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Conversation.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ConversationUpsert) {
+//			SetConnectionID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ConversationCreateBulk) OnConflict(opts ...sql.ConflictOption) *ConversationUpsertBulk {
+	_c.conflict = opts
+	return &ConversationUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Conversation.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ConversationCreateBulk) OnConflictColumns(columns ...string) *ConversationUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ConversationUpsertBulk{
+		create: _c,
+	}
+}
+
+// ConversationUpsertBulk is the builder for "upsert"-ing
+// a bulk of Conversation nodes.
+type ConversationUpsertBulk struct {
+	create *ConversationCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Conversation.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(conversation.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ConversationUpsertBulk) UpdateNewValues() *ConversationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(conversation.FieldID)
+			}
+			if _, exists := b.mutation.ConnectionID(); exists {
+				s.SetIgnore(conversation.FieldConnectionID)
+			}
+			if _, exists := b.mutation.UserAID(); exists {
+				s.SetIgnore(conversation.FieldUserAID)
+			}
+			if _, exists := b.mutation.UserBID(); exists {
+				s.SetIgnore(conversation.FieldUserBID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(conversation.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Conversation.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ConversationUpsertBulk) Ignore() *ConversationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ConversationUpsertBulk) DoNothing() *ConversationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ConversationCreateBulk.OnConflict
+// documentation for more info.
+func (u *ConversationUpsertBulk) Update(set func(*ConversationUpsert)) *ConversationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ConversationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetConversationStatus sets the "conversation_status" field.
+func (u *ConversationUpsertBulk) SetConversationStatus(v conversation.ConversationStatus) *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.SetConversationStatus(v)
+	})
+}
+
+// UpdateConversationStatus sets the "conversation_status" field to the value that was provided on create.
+func (u *ConversationUpsertBulk) UpdateConversationStatus() *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.UpdateConversationStatus()
+	})
+}
+
+// SetClosedReason sets the "closed_reason" field.
+func (u *ConversationUpsertBulk) SetClosedReason(v conversation.ClosedReason) *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.SetClosedReason(v)
+	})
+}
+
+// UpdateClosedReason sets the "closed_reason" field to the value that was provided on create.
+func (u *ConversationUpsertBulk) UpdateClosedReason() *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.UpdateClosedReason()
+	})
+}
+
+// ClearClosedReason clears the value of the "closed_reason" field.
+func (u *ConversationUpsertBulk) ClearClosedReason() *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.ClearClosedReason()
+	})
+}
+
+// SetLastMessageAt sets the "last_message_at" field.
+func (u *ConversationUpsertBulk) SetLastMessageAt(v time.Time) *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.SetLastMessageAt(v)
+	})
+}
+
+// UpdateLastMessageAt sets the "last_message_at" field to the value that was provided on create.
+func (u *ConversationUpsertBulk) UpdateLastMessageAt() *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.UpdateLastMessageAt()
+	})
+}
+
+// ClearLastMessageAt clears the value of the "last_message_at" field.
+func (u *ConversationUpsertBulk) ClearLastMessageAt() *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.ClearLastMessageAt()
+	})
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (u *ConversationUpsertBulk) SetClosedAt(v time.Time) *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.SetClosedAt(v)
+	})
+}
+
+// UpdateClosedAt sets the "closed_at" field to the value that was provided on create.
+func (u *ConversationUpsertBulk) UpdateClosedAt() *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.UpdateClosedAt()
+	})
+}
+
+// ClearClosedAt clears the value of the "closed_at" field.
+func (u *ConversationUpsertBulk) ClearClosedAt() *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.ClearClosedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ConversationUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("generated: OnConflict was set for builder %d. Set it on the ConversationCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("generated: missing options for ConversationCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ConversationUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
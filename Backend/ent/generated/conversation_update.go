@@ -0,0 +1,571 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/UnoraApp/be/ent/generated/conversation"
+	"github.com/UnoraApp/be/ent/generated/message"
+	"github.com/UnoraApp/be/ent/generated/predicate"
+)
+
+// ConversationUpdate is the builder for updating Conversation entities.
+type ConversationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ConversationMutation
+}
+
+// Where appends a list predicates to the ConversationUpdate builder.
+func (_u *ConversationUpdate) Where(ps ...predicate.Conversation) *ConversationUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetConversationStatus sets the "conversation_status" field.
+func (_u *ConversationUpdate) SetConversationStatus(v conversation.ConversationStatus) *ConversationUpdate {
+	_u.mutation.SetConversationStatus(v)
+	return _u
+}
+
+// SetNillableConversationStatus sets the "conversation_status" field if the given value is not nil.
+func (_u *ConversationUpdate) SetNillableConversationStatus(v *conversation.ConversationStatus) *ConversationUpdate {
+	if v != nil {
+		_u.SetConversationStatus(*v)
+	}
+	return _u
+}
+
+// SetClosedReason sets the "closed_reason" field.
+func (_u *ConversationUpdate) SetClosedReason(v conversation.ClosedReason) *ConversationUpdate {
+	_u.mutation.SetClosedReason(v)
+	return _u
+}
+
+// SetNillableClosedReason sets the "closed_reason" field if the given value is not nil.
+func (_u *ConversationUpdate) SetNillableClosedReason(v *conversation.ClosedReason) *ConversationUpdate {
+	if v != nil {
+		_u.SetClosedReason(*v)
+	}
+	return _u
+}
+
+// ClearClosedReason clears the value of the "closed_reason" field.
+func (_u *ConversationUpdate) ClearClosedReason() *ConversationUpdate {
+	_u.mutation.ClearClosedReason()
+	return _u
+}
+
+// SetLastMessageAt sets the "last_message_at" field.
+func (_u *ConversationUpdate) SetLastMessageAt(v time.Time) *ConversationUpdate {
+	_u.mutation.SetLastMessageAt(v)
+	return _u
+}
+
+// SetNillableLastMessageAt sets the "last_message_at" field if the given value is not nil.
+func (_u *ConversationUpdate) SetNillableLastMessageAt(v *time.Time) *ConversationUpdate {
+	if v != nil {
+		_u.SetLastMessageAt(*v)
+	}
+	return _u
+}
+
+// ClearLastMessageAt clears the value of the "last_message_at" field.
+func (_u *ConversationUpdate) ClearLastMessageAt() *ConversationUpdate {
+	_u.mutation.ClearLastMessageAt()
+	return _u
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (_u *ConversationUpdate) SetClosedAt(v time.Time) *ConversationUpdate {
+	_u.mutation.SetClosedAt(v)
+	return _u
+}
+
+// SetNillableClosedAt sets the "closed_at" field if the given value is not nil.
+func (_u *ConversationUpdate) SetNillableClosedAt(v *time.Time) *ConversationUpdate {
+	if v != nil {
+		_u.SetClosedAt(*v)
+	}
+	return _u
+}
+
+// ClearClosedAt clears the value of the "closed_at" field.
+func (_u *ConversationUpdate) ClearClosedAt() *ConversationUpdate {
+	_u.mutation.ClearClosedAt()
+	return _u
+}
+
+// AddMessageIDs adds the "messages" edge to the Message entity by IDs.
+func (_u *ConversationUpdate) AddMessageIDs(ids ...string) *ConversationUpdate {
+	_u.mutation.AddMessageIDs(ids...)
+	return _u
+}
+
+// AddMessages adds the "messages" edges to the Message entity.
+func (_u *ConversationUpdate) AddMessages(v ...*Message) *ConversationUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMessageIDs(ids...)
+}
+
+// Mutation returns the ConversationMutation object of the builder.
+func (_u *ConversationUpdate) Mutation() *ConversationMutation {
+	return _u.mutation
+}
+
+// ClearMessages clears all "messages" edges to the Message entity.
+func (_u *ConversationUpdate) ClearMessages() *ConversationUpdate {
+	_u.mutation.ClearMessages()
+	return _u
+}
+
+// RemoveMessageIDs removes the "messages" edge to Message entities by IDs.
+func (_u *ConversationUpdate) RemoveMessageIDs(ids ...string) *ConversationUpdate {
+	_u.mutation.RemoveMessageIDs(ids...)
+	return _u
+}
+
+// RemoveMessages removes "messages" edges to Message entities.
+func (_u *ConversationUpdate) RemoveMessages(v ...*Message) *ConversationUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMessageIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ConversationUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ConversationUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ConversationUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ConversationUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ConversationUpdate) check() error {
+	if v, ok := _u.mutation.ConversationStatus(); ok {
+		if err := conversation.ConversationStatusValidator(v); err != nil {
+			return &ValidationError{Name: "conversation_status", err: fmt.Errorf(`generated: validator failed for field "Conversation.conversation_status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ClosedReason(); ok {
+		if err := conversation.ClosedReasonValidator(v); err != nil {
+			return &ValidationError{Name: "closed_reason", err: fmt.Errorf(`generated: validator failed for field "Conversation.closed_reason": %w`, err)}
+		}
+	}
+	if _u.mutation.ConnectionCleared() && len(_u.mutation.ConnectionIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "Conversation.connection"`)
+	}
+	return nil
+}
+
+func (_u *ConversationUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(conversation.Table, conversation.Columns, sqlgraph.NewFieldSpec(conversation.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ConversationStatus(); ok {
+		_spec.SetField(conversation.FieldConversationStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ClosedReason(); ok {
+		_spec.SetField(conversation.FieldClosedReason, field.TypeEnum, value)
+	}
+	if _u.mutation.ClosedReasonCleared() {
+		_spec.ClearField(conversation.FieldClosedReason, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.LastMessageAt(); ok {
+		_spec.SetField(conversation.FieldLastMessageAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastMessageAtCleared() {
+		_spec.ClearField(conversation.FieldLastMessageAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ClosedAt(); ok {
+		_spec.SetField(conversation.FieldClosedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ClosedAtCleared() {
+		_spec.ClearField(conversation.FieldClosedAt, field.TypeTime)
+	}
+	if _u.mutation.MessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   conversation.MessagesTable,
+			Columns: []string{conversation.MessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMessagesIDs(); len(nodes) > 0 && !_u.mutation.MessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   conversation.MessagesTable,
+			Columns: []string{conversation.MessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MessagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   conversation.MessagesTable,
+			Columns: []string{conversation.MessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{conversation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ConversationUpdateOne is the builder for updating a single Conversation entity.
+type ConversationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ConversationMutation
+}
+
+// SetConversationStatus sets the "conversation_status" field.
+func (_u *ConversationUpdateOne) SetConversationStatus(v conversation.ConversationStatus) *ConversationUpdateOne {
+	_u.mutation.SetConversationStatus(v)
+	return _u
+}
+
+// SetNillableConversationStatus sets the "conversation_status" field if the given value is not nil.
+func (_u *ConversationUpdateOne) SetNillableConversationStatus(v *conversation.ConversationStatus) *ConversationUpdateOne {
+	if v != nil {
+		_u.SetConversationStatus(*v)
+	}
+	return _u
+}
+
+// SetClosedReason sets the "closed_reason" field.
+func (_u *ConversationUpdateOne) SetClosedReason(v conversation.ClosedReason) *ConversationUpdateOne {
+	_u.mutation.SetClosedReason(v)
+	return _u
+}
+
+// SetNillableClosedReason sets the "closed_reason" field if the given value is not nil.
+func (_u *ConversationUpdateOne) SetNillableClosedReason(v *conversation.ClosedReason) *ConversationUpdateOne {
+	if v != nil {
+		_u.SetClosedReason(*v)
+	}
+	return _u
+}
+
+// ClearClosedReason clears the value of the "closed_reason" field.
+func (_u *ConversationUpdateOne) ClearClosedReason() *ConversationUpdateOne {
+	_u.mutation.ClearClosedReason()
+	return _u
+}
+
+// SetLastMessageAt sets the "last_message_at" field.
+func (_u *ConversationUpdateOne) SetLastMessageAt(v time.Time) *ConversationUpdateOne {
+	_u.mutation.SetLastMessageAt(v)
+	return _u
+}
+
+// SetNillableLastMessageAt sets the "last_message_at" field if the given value is not nil.
+func (_u *ConversationUpdateOne) SetNillableLastMessageAt(v *time.Time) *ConversationUpdateOne {
+	if v != nil {
+		_u.SetLastMessageAt(*v)
+	}
+	return _u
+}
+
+// ClearLastMessageAt clears the value of the "last_message_at" field.
+func (_u *ConversationUpdateOne) ClearLastMessageAt() *ConversationUpdateOne {
+	_u.mutation.ClearLastMessageAt()
+	return _u
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (_u *ConversationUpdateOne) SetClosedAt(v time.Time) *ConversationUpdateOne {
+	_u.mutation.SetClosedAt(v)
+	return _u
+}
+
+// SetNillableClosedAt sets the "closed_at" field if the given value is not nil.
+func (_u *ConversationUpdateOne) SetNillableClosedAt(v *time.Time) *ConversationUpdateOne {
+	if v != nil {
+		_u.SetClosedAt(*v)
+	}
+	return _u
+}
+
+// ClearClosedAt clears the value of the "closed_at" field.
+func (_u *ConversationUpdateOne) ClearClosedAt() *ConversationUpdateOne {
+	_u.mutation.ClearClosedAt()
+	return _u
+}
+
+// AddMessageIDs adds the "messages" edge to the Message entity by IDs.
+func (_u *ConversationUpdateOne) AddMessageIDs(ids ...string) *ConversationUpdateOne {
+	_u.mutation.AddMessageIDs(ids...)
+	return _u
+}
+
+// AddMessages adds the "messages" edges to the Message entity.
+func (_u *ConversationUpdateOne) AddMessages(v ...*Message) *ConversationUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMessageIDs(ids...)
+}
+
+// Mutation returns the ConversationMutation object of the builder.
+func (_u *ConversationUpdateOne) Mutation() *ConversationMutation {
+	return _u.mutation
+}
+
+// ClearMessages clears all "messages" edges to the Message entity.
+func (_u *ConversationUpdateOne) ClearMessages() *ConversationUpdateOne {
+	_u.mutation.ClearMessages()
+	return _u
+}
+
+// RemoveMessageIDs removes the "messages" edge to Message entities by IDs.
+func (_u *ConversationUpdateOne) RemoveMessageIDs(ids ...string) *ConversationUpdateOne {
+	_u.mutation.RemoveMessageIDs(ids...)
+	return _u
+}
+
+// RemoveMessages removes "messages" edges to Message entities.
+func (_u *ConversationUpdateOne) RemoveMessages(v ...*Message) *ConversationUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMessageIDs(ids...)
+}
+
+// Where appends a list predicates to the ConversationUpdate builder.
+func (_u *ConversationUpdateOne) Where(ps ...predicate.Conversation) *ConversationUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ConversationUpdateOne) Select(field string, fields ...string) *ConversationUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Conversation entity.
+func (_u *ConversationUpdateOne) Save(ctx context.Context) (*Conversation, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ConversationUpdateOne) SaveX(ctx context.Context) *Conversation {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ConversationUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ConversationUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ConversationUpdateOne) check() error {
+	if v, ok := _u.mutation.ConversationStatus(); ok {
+		if err := conversation.ConversationStatusValidator(v); err != nil {
+			return &ValidationError{Name: "conversation_status", err: fmt.Errorf(`generated: validator failed for field "Conversation.conversation_status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ClosedReason(); ok {
+		if err := conversation.ClosedReasonValidator(v); err != nil {
+			return &ValidationError{Name: "closed_reason", err: fmt.Errorf(`generated: validator failed for field "Conversation.closed_reason": %w`, err)}
+		}
+	}
+	if _u.mutation.ConnectionCleared() && len(_u.mutation.ConnectionIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "Conversation.connection"`)
+	}
+	return nil
+}
+
+func (_u *ConversationUpdateOne) sqlSave(ctx context.Context) (_node *Conversation, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(conversation.Table, conversation.Columns, sqlgraph.NewFieldSpec(conversation.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "Conversation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, conversation.FieldID)
+		for _, f := range fields {
+			if !conversation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != conversation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ConversationStatus(); ok {
+		_spec.SetField(conversation.FieldConversationStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ClosedReason(); ok {
+		_spec.SetField(conversation.FieldClosedReason, field.TypeEnum, value)
+	}
+	if _u.mutation.ClosedReasonCleared() {
+		_spec.ClearField(conversation.FieldClosedReason, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.LastMessageAt(); ok {
+		_spec.SetField(conversation.FieldLastMessageAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastMessageAtCleared() {
+		_spec.ClearField(conversation.FieldLastMessageAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ClosedAt(); ok {
+		_spec.SetField(conversation.FieldClosedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ClosedAtCleared() {
+		_spec.ClearField(conversation.FieldClosedAt, field.TypeTime)
+	}
+	if _u.mutation.MessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   conversation.MessagesTable,
+			Columns: []string{conversation.MessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMessagesIDs(); len(nodes) > 0 && !_u.mutation.MessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   conversation.MessagesTable,
+			Columns: []string{conversation.MessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MessagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   conversation.MessagesTable,
+			Columns: []string{conversation.MessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Conversation{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{conversation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -32,6 +32,8 @@ type CreditTransaction struct {
 	ReferenceID *string `json:"reference_id,omitempty"`
 	// Description holds the value of the "description" field.
 	Description *string `json:"description,omitempty"`
+	// IdempotencyKey holds the value of the "idempotency_key" field.
+	IdempotencyKey *string `json:"idempotency_key,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -44,9 +46,11 @@ type CreditTransaction struct {
 type CreditTransactionEdges struct {
 	// User holds the value of the user edge.
 	User *User `json:"user,omitempty"`
+	// Entries holds the value of the entries edge.
+	Entries []*LedgerEntry `json:"entries,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [2]bool
 }
 
 // UserOrErr returns the User value or an error if the edge
@@ -60,6 +64,15 @@ func (e CreditTransactionEdges) UserOrErr() (*User, error) {
 	return nil, &NotLoadedError{edge: "user"}
 }
 
+// EntriesOrErr returns the Entries value or an error if the edge
+// was not loaded in eager-loading.
+func (e CreditTransactionEdges) EntriesOrErr() ([]*LedgerEntry, error) {
+	if e.loadedTypes[1] {
+		return e.Entries, nil
+	}
+	return nil, &NotLoadedError{edge: "entries"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*CreditTransaction) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -67,7 +80,7 @@ func (*CreditTransaction) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case credittransaction.FieldCreditAmount, credittransaction.FieldBalanceAfter:
 			values[i] = new(sql.NullInt64)
-		case credittransaction.FieldID, credittransaction.FieldUserID, credittransaction.FieldTransactionType, credittransaction.FieldReferenceType, credittransaction.FieldReferenceID, credittransaction.FieldDescription:
+		case credittransaction.FieldID, credittransaction.FieldUserID, credittransaction.FieldTransactionType, credittransaction.FieldReferenceType, credittransaction.FieldReferenceID, credittransaction.FieldDescription, credittransaction.FieldIdempotencyKey:
 			values[i] = new(sql.NullString)
 		case credittransaction.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
@@ -137,6 +150,13 @@ func (_m *CreditTransaction) assignValues(columns []string, values []any) error
 				_m.Description = new(string)
 				*_m.Description = value.String
 			}
+		case credittransaction.FieldIdempotencyKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field idempotency_key", values[i])
+			} else if value.Valid {
+				_m.IdempotencyKey = new(string)
+				*_m.IdempotencyKey = value.String
+			}
 		case credittransaction.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -161,6 +181,11 @@ func (_m *CreditTransaction) QueryUser() *UserQuery {
 	return NewCreditTransactionClient(_m.config).QueryUser(_m)
 }
 
+// QueryEntries queries the "entries" edge of the CreditTransaction entity.
+func (_m *CreditTransaction) QueryEntries() *LedgerEntryQuery {
+	return NewCreditTransactionClient(_m.config).QueryEntries(_m)
+}
+
 // Update returns a builder for updating this CreditTransaction.
 // Note that you need to call CreditTransaction.Unwrap() before calling this method if this CreditTransaction
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -211,6 +236,11 @@ func (_m *CreditTransaction) String() string {
 		builder.WriteString(*v)
 	}
 	builder.WriteString(", ")
+	if v := _m.IdempotencyKey; v != nil {
+		builder.WriteString("idempotency_key=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
@@ -29,10 +29,14 @@ const (
 	FieldReferenceID = "reference_id"
 	// FieldDescription holds the string denoting the description field in the database.
 	FieldDescription = "description"
+	// FieldIdempotencyKey holds the string denoting the idempotency_key field in the database.
+	FieldIdempotencyKey = "idempotency_key"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// EdgeUser holds the string denoting the user edge name in mutations.
 	EdgeUser = "user"
+	// EdgeEntries holds the string denoting the entries edge name in mutations.
+	EdgeEntries = "entries"
 	// Table holds the table name of the credittransaction in the database.
 	Table = "credit_transactions"
 	// UserTable is the table that holds the user relation/edge.
@@ -42,6 +46,13 @@ const (
 	UserInverseTable = "users"
 	// UserColumn is the table column denoting the user relation/edge.
 	UserColumn = "user_id"
+	// EntriesTable is the table that holds the entries relation/edge.
+	EntriesTable = "ledger_entries"
+	// EntriesInverseTable is the table name for the LedgerEntry entity.
+	// It exists in this package in order to avoid circular dependency with the "ledgerentry" package.
+	EntriesInverseTable = "ledger_entries"
+	// EntriesColumn is the table column denoting the entries relation/edge.
+	EntriesColumn = "transaction_id"
 )
 
 // Columns holds all SQL columns for credittransaction fields.
@@ -54,6 +65,7 @@ var Columns = []string{
 	FieldReferenceType,
 	FieldReferenceID,
 	FieldDescription,
+	FieldIdempotencyKey,
 	FieldCreatedAt,
 }
 
@@ -74,6 +86,8 @@ var (
 	ReferenceTypeValidator func(string) error
 	// ReferenceIDValidator is a validator for the "reference_id" field. It is called by the builders before save.
 	ReferenceIDValidator func(string) error
+	// IdempotencyKeyValidator is a validator for the "idempotency_key" field. It is called by the builders before save.
+	IdempotencyKeyValidator func(string) error
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// IDValidator is a validator for the "id" field. It is called by the builders before save.
@@ -88,8 +102,10 @@ const (
 	TransactionTypePurchase        TransactionType = "purchase"
 	TransactionTypeStreakRecovery  TransactionType = "streak_recovery"
 	TransactionTypeEarlyReveal     TransactionType = "early_reveal"
+	TransactionTypeRevealGift      TransactionType = "reveal_gift"
 	TransactionTypeReferralBonus   TransactionType = "referral_bonus"
 	TransactionTypeWelcomeBonus    TransactionType = "welcome_bonus"
+	TransactionTypePromoCode       TransactionType = "promo_code"
 	TransactionTypeRefund          TransactionType = "refund"
 	TransactionTypeAdminAdjustment TransactionType = "admin_adjustment"
 )
@@ -101,7 +117,7 @@ func (tt TransactionType) String() string {
 // TransactionTypeValidator is a validator for the "transaction_type" field enum values. It is called by the builders before save.
 func TransactionTypeValidator(tt TransactionType) error {
 	switch tt {
-	case TransactionTypePurchase, TransactionTypeStreakRecovery, TransactionTypeEarlyReveal, TransactionTypeReferralBonus, TransactionTypeWelcomeBonus, TransactionTypeRefund, TransactionTypeAdminAdjustment:
+	case TransactionTypePurchase, TransactionTypeStreakRecovery, TransactionTypeEarlyReveal, TransactionTypeRevealGift, TransactionTypeReferralBonus, TransactionTypeWelcomeBonus, TransactionTypePromoCode, TransactionTypeRefund, TransactionTypeAdminAdjustment:
 		return nil
 	default:
 		return fmt.Errorf("credittransaction: invalid enum value for transaction_type field: %q", tt)
@@ -151,6 +167,11 @@ func ByDescription(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDescription, opts...).ToFunc()
 }
 
+// ByIdempotencyKey orders the results by the idempotency_key field.
+func ByIdempotencyKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIdempotencyKey, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -162,6 +183,20 @@ func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByEntriesCount orders the results by entries count.
+func ByEntriesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newEntriesStep(), opts...)
+	}
+}
+
+// ByEntries orders the results by entries terms.
+func ByEntries(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newEntriesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newUserStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -169,3 +204,10 @@ func newUserStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
 	)
 }
+func newEntriesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(EntriesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, EntriesTable, EntriesColumn),
+	)
+}
@@ -95,6 +95,11 @@ func Description(v string) predicate.CreditTransaction {
 	return predicate.CreditTransaction(sql.FieldEQ(FieldDescription, v))
 }
 
+// IdempotencyKey applies equality check predicate on the "idempotency_key" field. It's identical to IdempotencyKeyEQ.
+func IdempotencyKey(v string) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldEQ(FieldIdempotencyKey, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.CreditTransaction {
 	return predicate.CreditTransaction(sql.FieldEQ(FieldCreatedAt, v))
@@ -490,6 +495,81 @@ func DescriptionContainsFold(v string) predicate.CreditTransaction {
 	return predicate.CreditTransaction(sql.FieldContainsFold(FieldDescription, v))
 }
 
+// IdempotencyKeyEQ applies the EQ predicate on the "idempotency_key" field.
+func IdempotencyKeyEQ(v string) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldEQ(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyNEQ applies the NEQ predicate on the "idempotency_key" field.
+func IdempotencyKeyNEQ(v string) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldNEQ(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyIn applies the In predicate on the "idempotency_key" field.
+func IdempotencyKeyIn(vs ...string) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldIn(FieldIdempotencyKey, vs...))
+}
+
+// IdempotencyKeyNotIn applies the NotIn predicate on the "idempotency_key" field.
+func IdempotencyKeyNotIn(vs ...string) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldNotIn(FieldIdempotencyKey, vs...))
+}
+
+// IdempotencyKeyGT applies the GT predicate on the "idempotency_key" field.
+func IdempotencyKeyGT(v string) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldGT(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyGTE applies the GTE predicate on the "idempotency_key" field.
+func IdempotencyKeyGTE(v string) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldGTE(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyLT applies the LT predicate on the "idempotency_key" field.
+func IdempotencyKeyLT(v string) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldLT(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyLTE applies the LTE predicate on the "idempotency_key" field.
+func IdempotencyKeyLTE(v string) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldLTE(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyContains applies the Contains predicate on the "idempotency_key" field.
+func IdempotencyKeyContains(v string) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldContains(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyHasPrefix applies the HasPrefix predicate on the "idempotency_key" field.
+func IdempotencyKeyHasPrefix(v string) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldHasPrefix(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyHasSuffix applies the HasSuffix predicate on the "idempotency_key" field.
+func IdempotencyKeyHasSuffix(v string) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldHasSuffix(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyIsNil applies the IsNil predicate on the "idempotency_key" field.
+func IdempotencyKeyIsNil() predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldIsNull(FieldIdempotencyKey))
+}
+
+// IdempotencyKeyNotNil applies the NotNil predicate on the "idempotency_key" field.
+func IdempotencyKeyNotNil() predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldNotNull(FieldIdempotencyKey))
+}
+
+// IdempotencyKeyEqualFold applies the EqualFold predicate on the "idempotency_key" field.
+func IdempotencyKeyEqualFold(v string) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldEqualFold(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyContainsFold applies the ContainsFold predicate on the "idempotency_key" field.
+func IdempotencyKeyContainsFold(v string) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldContainsFold(FieldIdempotencyKey, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.CreditTransaction {
 	return predicate.CreditTransaction(sql.FieldEQ(FieldCreatedAt, v))
@@ -553,6 +633,29 @@ func HasUserWith(preds ...predicate.User) predicate.CreditTransaction {
 	})
 }
 
+// HasEntries applies the HasEdge predicate on the "entries" edge.
+func HasEntries() predicate.CreditTransaction {
+	return predicate.CreditTransaction(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, EntriesTable, EntriesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEntriesWith applies the HasEdge predicate on the "entries" edge with a given conditions (other predicates).
+func HasEntriesWith(preds ...predicate.LedgerEntry) predicate.CreditTransaction {
+	return predicate.CreditTransaction(func(s *sql.Selector) {
+		step := newEntriesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.CreditTransaction) predicate.CreditTransaction {
 	return predicate.CreditTransaction(sql.AndPredicates(predicates...))
@@ -13,6 +13,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/UnoraApp/be/ent/generated/credittransaction"
+	"github.com/UnoraApp/be/ent/generated/ledgerentry"
 	"github.com/UnoraApp/be/ent/generated/user"
 )
 
@@ -90,6 +91,20 @@ func (_c *CreditTransactionCreate) SetNillableDescription(v *string) *CreditTran
 	return _c
 }
 
+// SetIdempotencyKey sets the "idempotency_key" field.
+func (_c *CreditTransactionCreate) SetIdempotencyKey(v string) *CreditTransactionCreate {
+	_c.mutation.SetIdempotencyKey(v)
+	return _c
+}
+
+// SetNillableIdempotencyKey sets the "idempotency_key" field if the given value is not nil.
+func (_c *CreditTransactionCreate) SetNillableIdempotencyKey(v *string) *CreditTransactionCreate {
+	if v != nil {
+		_c.SetIdempotencyKey(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *CreditTransactionCreate) SetCreatedAt(v time.Time) *CreditTransactionCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -115,6 +130,21 @@ func (_c *CreditTransactionCreate) SetUser(v *User) *CreditTransactionCreate {
 	return _c.SetUserID(v.ID)
 }
 
+// AddEntryIDs adds the "entries" edge to the LedgerEntry entity by IDs.
+func (_c *CreditTransactionCreate) AddEntryIDs(ids ...string) *CreditTransactionCreate {
+	_c.mutation.AddEntryIDs(ids...)
+	return _c
+}
+
+// AddEntries adds the "entries" edges to the LedgerEntry entity.
+func (_c *CreditTransactionCreate) AddEntries(v ...*LedgerEntry) *CreditTransactionCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddEntryIDs(ids...)
+}
+
 // Mutation returns the CreditTransactionMutation object of the builder.
 func (_c *CreditTransactionCreate) Mutation() *CreditTransactionMutation {
 	return _c.mutation
@@ -190,6 +220,11 @@ func (_c *CreditTransactionCreate) check() error {
 			return &ValidationError{Name: "reference_id", err: fmt.Errorf(`generated: validator failed for field "CreditTransaction.reference_id": %w`, err)}
 		}
 	}
+	if v, ok := _c.mutation.IdempotencyKey(); ok {
+		if err := credittransaction.IdempotencyKeyValidator(v); err != nil {
+			return &ValidationError{Name: "idempotency_key", err: fmt.Errorf(`generated: validator failed for field "CreditTransaction.idempotency_key": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "CreditTransaction.created_at"`)}
 	}
@@ -261,6 +296,10 @@ func (_c *CreditTransactionCreate) createSpec() (*CreditTransaction, *sqlgraph.C
 		_spec.SetField(credittransaction.FieldDescription, field.TypeString, value)
 		_node.Description = &value
 	}
+	if value, ok := _c.mutation.IdempotencyKey(); ok {
+		_spec.SetField(credittransaction.FieldIdempotencyKey, field.TypeString, value)
+		_node.IdempotencyKey = &value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(credittransaction.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -282,6 +321,22 @@ func (_c *CreditTransactionCreate) createSpec() (*CreditTransaction, *sqlgraph.C
 		_node.UserID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.EntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   credittransaction.EntriesTable,
+			Columns: []string{credittransaction.EntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledgerentry.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -448,6 +503,24 @@ func (u *CreditTransactionUpsert) ClearDescription() *CreditTransactionUpsert {
 	return u
 }
 
+// SetIdempotencyKey sets the "idempotency_key" field.
+func (u *CreditTransactionUpsert) SetIdempotencyKey(v string) *CreditTransactionUpsert {
+	u.Set(credittransaction.FieldIdempotencyKey, v)
+	return u
+}
+
+// UpdateIdempotencyKey sets the "idempotency_key" field to the value that was provided on create.
+func (u *CreditTransactionUpsert) UpdateIdempotencyKey() *CreditTransactionUpsert {
+	u.SetExcluded(credittransaction.FieldIdempotencyKey)
+	return u
+}
+
+// ClearIdempotencyKey clears the value of the "idempotency_key" field.
+func (u *CreditTransactionUpsert) ClearIdempotencyKey() *CreditTransactionUpsert {
+	u.SetNull(credittransaction.FieldIdempotencyKey)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -632,6 +705,27 @@ func (u *CreditTransactionUpsertOne) ClearDescription() *CreditTransactionUpsert
 	})
 }
 
+// SetIdempotencyKey sets the "idempotency_key" field.
+func (u *CreditTransactionUpsertOne) SetIdempotencyKey(v string) *CreditTransactionUpsertOne {
+	return u.Update(func(s *CreditTransactionUpsert) {
+		s.SetIdempotencyKey(v)
+	})
+}
+
+// UpdateIdempotencyKey sets the "idempotency_key" field to the value that was provided on create.
+func (u *CreditTransactionUpsertOne) UpdateIdempotencyKey() *CreditTransactionUpsertOne {
+	return u.Update(func(s *CreditTransactionUpsert) {
+		s.UpdateIdempotencyKey()
+	})
+}
+
+// ClearIdempotencyKey clears the value of the "idempotency_key" field.
+func (u *CreditTransactionUpsertOne) ClearIdempotencyKey() *CreditTransactionUpsertOne {
+	return u.Update(func(s *CreditTransactionUpsert) {
+		s.ClearIdempotencyKey()
+	})
+}
+
 // Exec executes the query.
 func (u *CreditTransactionUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -983,6 +1077,27 @@ func (u *CreditTransactionUpsertBulk) ClearDescription() *CreditTransactionUpser
 	})
 }
 
+// SetIdempotencyKey sets the "idempotency_key" field.
+func (u *CreditTransactionUpsertBulk) SetIdempotencyKey(v string) *CreditTransactionUpsertBulk {
+	return u.Update(func(s *CreditTransactionUpsert) {
+		s.SetIdempotencyKey(v)
+	})
+}
+
+// UpdateIdempotencyKey sets the "idempotency_key" field to the value that was provided on create.
+func (u *CreditTransactionUpsertBulk) UpdateIdempotencyKey() *CreditTransactionUpsertBulk {
+	return u.Update(func(s *CreditTransactionUpsert) {
+		s.UpdateIdempotencyKey()
+	})
+}
+
+// ClearIdempotencyKey clears the value of the "idempotency_key" field.
+func (u *CreditTransactionUpsertBulk) ClearIdempotencyKey() *CreditTransactionUpsertBulk {
+	return u.Update(func(s *CreditTransactionUpsert) {
+		s.ClearIdempotencyKey()
+	})
+}
+
 // Exec executes the query.
 func (u *CreditTransactionUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
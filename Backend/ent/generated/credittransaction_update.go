@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/UnoraApp/be/ent/generated/credittransaction"
+	"github.com/UnoraApp/be/ent/generated/ledgerentry"
 	"github.com/UnoraApp/be/ent/generated/predicate"
 	"github.com/UnoraApp/be/ent/generated/user"
 )
@@ -158,11 +159,46 @@ func (_u *CreditTransactionUpdate) ClearDescription() *CreditTransactionUpdate {
 	return _u
 }
 
+// SetIdempotencyKey sets the "idempotency_key" field.
+func (_u *CreditTransactionUpdate) SetIdempotencyKey(v string) *CreditTransactionUpdate {
+	_u.mutation.SetIdempotencyKey(v)
+	return _u
+}
+
+// SetNillableIdempotencyKey sets the "idempotency_key" field if the given value is not nil.
+func (_u *CreditTransactionUpdate) SetNillableIdempotencyKey(v *string) *CreditTransactionUpdate {
+	if v != nil {
+		_u.SetIdempotencyKey(*v)
+	}
+	return _u
+}
+
+// ClearIdempotencyKey clears the value of the "idempotency_key" field.
+func (_u *CreditTransactionUpdate) ClearIdempotencyKey() *CreditTransactionUpdate {
+	_u.mutation.ClearIdempotencyKey()
+	return _u
+}
+
 // SetUser sets the "user" edge to the User entity.
 func (_u *CreditTransactionUpdate) SetUser(v *User) *CreditTransactionUpdate {
 	return _u.SetUserID(v.ID)
 }
 
+// AddEntryIDs adds the "entries" edge to the LedgerEntry entity by IDs.
+func (_u *CreditTransactionUpdate) AddEntryIDs(ids ...string) *CreditTransactionUpdate {
+	_u.mutation.AddEntryIDs(ids...)
+	return _u
+}
+
+// AddEntries adds the "entries" edges to the LedgerEntry entity.
+func (_u *CreditTransactionUpdate) AddEntries(v ...*LedgerEntry) *CreditTransactionUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddEntryIDs(ids...)
+}
+
 // Mutation returns the CreditTransactionMutation object of the builder.
 func (_u *CreditTransactionUpdate) Mutation() *CreditTransactionMutation {
 	return _u.mutation
@@ -174,6 +210,27 @@ func (_u *CreditTransactionUpdate) ClearUser() *CreditTransactionUpdate {
 	return _u
 }
 
+// ClearEntries clears all "entries" edges to the LedgerEntry entity.
+func (_u *CreditTransactionUpdate) ClearEntries() *CreditTransactionUpdate {
+	_u.mutation.ClearEntries()
+	return _u
+}
+
+// RemoveEntryIDs removes the "entries" edge to LedgerEntry entities by IDs.
+func (_u *CreditTransactionUpdate) RemoveEntryIDs(ids ...string) *CreditTransactionUpdate {
+	_u.mutation.RemoveEntryIDs(ids...)
+	return _u
+}
+
+// RemoveEntries removes "entries" edges to LedgerEntry entities.
+func (_u *CreditTransactionUpdate) RemoveEntries(v ...*LedgerEntry) *CreditTransactionUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveEntryIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *CreditTransactionUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -223,6 +280,11 @@ func (_u *CreditTransactionUpdate) check() error {
 			return &ValidationError{Name: "reference_id", err: fmt.Errorf(`generated: validator failed for field "CreditTransaction.reference_id": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.IdempotencyKey(); ok {
+		if err := credittransaction.IdempotencyKeyValidator(v); err != nil {
+			return &ValidationError{Name: "idempotency_key", err: fmt.Errorf(`generated: validator failed for field "CreditTransaction.idempotency_key": %w`, err)}
+		}
+	}
 	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
 		return errors.New(`generated: clearing a required unique edge "CreditTransaction.user"`)
 	}
@@ -274,6 +336,12 @@ func (_u *CreditTransactionUpdate) sqlSave(ctx context.Context) (_node int, err
 	if _u.mutation.DescriptionCleared() {
 		_spec.ClearField(credittransaction.FieldDescription, field.TypeString)
 	}
+	if value, ok := _u.mutation.IdempotencyKey(); ok {
+		_spec.SetField(credittransaction.FieldIdempotencyKey, field.TypeString, value)
+	}
+	if _u.mutation.IdempotencyKeyCleared() {
+		_spec.ClearField(credittransaction.FieldIdempotencyKey, field.TypeString)
+	}
 	if _u.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -303,6 +371,51 @@ func (_u *CreditTransactionUpdate) sqlSave(ctx context.Context) (_node int, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.EntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   credittransaction.EntriesTable,
+			Columns: []string{credittransaction.EntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledgerentry.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedEntriesIDs(); len(nodes) > 0 && !_u.mutation.EntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   credittransaction.EntriesTable,
+			Columns: []string{credittransaction.EntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledgerentry.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   credittransaction.EntriesTable,
+			Columns: []string{credittransaction.EntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledgerentry.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{credittransaction.Label}
@@ -453,11 +566,46 @@ func (_u *CreditTransactionUpdateOne) ClearDescription() *CreditTransactionUpdat
 	return _u
 }
 
+// SetIdempotencyKey sets the "idempotency_key" field.
+func (_u *CreditTransactionUpdateOne) SetIdempotencyKey(v string) *CreditTransactionUpdateOne {
+	_u.mutation.SetIdempotencyKey(v)
+	return _u
+}
+
+// SetNillableIdempotencyKey sets the "idempotency_key" field if the given value is not nil.
+func (_u *CreditTransactionUpdateOne) SetNillableIdempotencyKey(v *string) *CreditTransactionUpdateOne {
+	if v != nil {
+		_u.SetIdempotencyKey(*v)
+	}
+	return _u
+}
+
+// ClearIdempotencyKey clears the value of the "idempotency_key" field.
+func (_u *CreditTransactionUpdateOne) ClearIdempotencyKey() *CreditTransactionUpdateOne {
+	_u.mutation.ClearIdempotencyKey()
+	return _u
+}
+
 // SetUser sets the "user" edge to the User entity.
 func (_u *CreditTransactionUpdateOne) SetUser(v *User) *CreditTransactionUpdateOne {
 	return _u.SetUserID(v.ID)
 }
 
+// AddEntryIDs adds the "entries" edge to the LedgerEntry entity by IDs.
+func (_u *CreditTransactionUpdateOne) AddEntryIDs(ids ...string) *CreditTransactionUpdateOne {
+	_u.mutation.AddEntryIDs(ids...)
+	return _u
+}
+
+// AddEntries adds the "entries" edges to the LedgerEntry entity.
+func (_u *CreditTransactionUpdateOne) AddEntries(v ...*LedgerEntry) *CreditTransactionUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddEntryIDs(ids...)
+}
+
 // Mutation returns the CreditTransactionMutation object of the builder.
 func (_u *CreditTransactionUpdateOne) Mutation() *CreditTransactionMutation {
 	return _u.mutation
@@ -469,6 +617,27 @@ func (_u *CreditTransactionUpdateOne) ClearUser() *CreditTransactionUpdateOne {
 	return _u
 }
 
+// ClearEntries clears all "entries" edges to the LedgerEntry entity.
+func (_u *CreditTransactionUpdateOne) ClearEntries() *CreditTransactionUpdateOne {
+	_u.mutation.ClearEntries()
+	return _u
+}
+
+// RemoveEntryIDs removes the "entries" edge to LedgerEntry entities by IDs.
+func (_u *CreditTransactionUpdateOne) RemoveEntryIDs(ids ...string) *CreditTransactionUpdateOne {
+	_u.mutation.RemoveEntryIDs(ids...)
+	return _u
+}
+
+// RemoveEntries removes "entries" edges to LedgerEntry entities.
+func (_u *CreditTransactionUpdateOne) RemoveEntries(v ...*LedgerEntry) *CreditTransactionUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveEntryIDs(ids...)
+}
+
 // Where appends a list predicates to the CreditTransactionUpdate builder.
 func (_u *CreditTransactionUpdateOne) Where(ps ...predicate.CreditTransaction) *CreditTransactionUpdateOne {
 	_u.mutation.Where(ps...)
@@ -531,6 +700,11 @@ func (_u *CreditTransactionUpdateOne) check() error {
 			return &ValidationError{Name: "reference_id", err: fmt.Errorf(`generated: validator failed for field "CreditTransaction.reference_id": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.IdempotencyKey(); ok {
+		if err := credittransaction.IdempotencyKeyValidator(v); err != nil {
+			return &ValidationError{Name: "idempotency_key", err: fmt.Errorf(`generated: validator failed for field "CreditTransaction.idempotency_key": %w`, err)}
+		}
+	}
 	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
 		return errors.New(`generated: clearing a required unique edge "CreditTransaction.user"`)
 	}
@@ -599,6 +773,12 @@ func (_u *CreditTransactionUpdateOne) sqlSave(ctx context.Context) (_node *Credi
 	if _u.mutation.DescriptionCleared() {
 		_spec.ClearField(credittransaction.FieldDescription, field.TypeString)
 	}
+	if value, ok := _u.mutation.IdempotencyKey(); ok {
+		_spec.SetField(credittransaction.FieldIdempotencyKey, field.TypeString, value)
+	}
+	if _u.mutation.IdempotencyKeyCleared() {
+		_spec.ClearField(credittransaction.FieldIdempotencyKey, field.TypeString)
+	}
 	if _u.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -628,6 +808,51 @@ func (_u *CreditTransactionUpdateOne) sqlSave(ctx context.Context) (_node *Credi
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.EntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   credittransaction.EntriesTable,
+			Columns: []string{credittransaction.EntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledgerentry.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedEntriesIDs(); len(nodes) > 0 && !_u.mutation.EntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   credittransaction.EntriesTable,
+			Columns: []string{credittransaction.EntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledgerentry.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   credittransaction.EntriesTable,
+			Columns: []string{credittransaction.EntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledgerentry.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &CreditTransaction{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,1071 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/UnoraApp/be/ent/generated/dataexport"
+)
+
+// DataExportCreate is the builder for creating a DataExport entity.
+type DataExportCreate struct {
+	config
+	mutation *DataExportMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *DataExportCreate) SetUserID(v string) *DataExportCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetExportStatus sets the "export_status" field.
+func (_c *DataExportCreate) SetExportStatus(v dataexport.ExportStatus) *DataExportCreate {
+	_c.mutation.SetExportStatus(v)
+	return _c
+}
+
+// SetNillableExportStatus sets the "export_status" field if the given value is not nil.
+func (_c *DataExportCreate) SetNillableExportStatus(v *dataexport.ExportStatus) *DataExportCreate {
+	if v != nil {
+		_c.SetExportStatus(*v)
+	}
+	return _c
+}
+
+// SetStorageKey sets the "storage_key" field.
+func (_c *DataExportCreate) SetStorageKey(v string) *DataExportCreate {
+	_c.mutation.SetStorageKey(v)
+	return _c
+}
+
+// SetNillableStorageKey sets the "storage_key" field if the given value is not nil.
+func (_c *DataExportCreate) SetNillableStorageKey(v *string) *DataExportCreate {
+	if v != nil {
+		_c.SetStorageKey(*v)
+	}
+	return _c
+}
+
+// SetSizeBytes sets the "size_bytes" field.
+func (_c *DataExportCreate) SetSizeBytes(v int64) *DataExportCreate {
+	_c.mutation.SetSizeBytes(v)
+	return _c
+}
+
+// SetNillableSizeBytes sets the "size_bytes" field if the given value is not nil.
+func (_c *DataExportCreate) SetNillableSizeBytes(v *int64) *DataExportCreate {
+	if v != nil {
+		_c.SetSizeBytes(*v)
+	}
+	return _c
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (_c *DataExportCreate) SetErrorMessage(v string) *DataExportCreate {
+	_c.mutation.SetErrorMessage(v)
+	return _c
+}
+
+// SetNillableErrorMessage sets the "error_message" field if the given value is not nil.
+func (_c *DataExportCreate) SetNillableErrorMessage(v *string) *DataExportCreate {
+	if v != nil {
+		_c.SetErrorMessage(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *DataExportCreate) SetCreatedAt(v time.Time) *DataExportCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *DataExportCreate) SetNillableCreatedAt(v *time.Time) *DataExportCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_c *DataExportCreate) SetStartedAt(v time.Time) *DataExportCreate {
+	_c.mutation.SetStartedAt(v)
+	return _c
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_c *DataExportCreate) SetNillableStartedAt(v *time.Time) *DataExportCreate {
+	if v != nil {
+		_c.SetStartedAt(*v)
+	}
+	return _c
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_c *DataExportCreate) SetCompletedAt(v time.Time) *DataExportCreate {
+	_c.mutation.SetCompletedAt(v)
+	return _c
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_c *DataExportCreate) SetNillableCompletedAt(v *time.Time) *DataExportCreate {
+	if v != nil {
+		_c.SetCompletedAt(*v)
+	}
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *DataExportCreate) SetExpiresAt(v time.Time) *DataExportCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_c *DataExportCreate) SetNillableExpiresAt(v *time.Time) *DataExportCreate {
+	if v != nil {
+		_c.SetExpiresAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *DataExportCreate) SetID(v string) *DataExportCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the DataExportMutation object of the builder.
+func (_c *DataExportCreate) Mutation() *DataExportMutation {
+	return _c.mutation
+}
+
+// Save creates the DataExport in the database.
+func (_c *DataExportCreate) Save(ctx context.Context) (*DataExport, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *DataExportCreate) SaveX(ctx context.Context) *DataExport {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DataExportCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DataExportCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *DataExportCreate) defaults() {
+	if _, ok := _c.mutation.ExportStatus(); !ok {
+		v := dataexport.DefaultExportStatus
+		_c.mutation.SetExportStatus(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := dataexport.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *DataExportCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`generated: missing required field "DataExport.user_id"`)}
+	}
+	if v, ok := _c.mutation.UserID(); ok {
+		if err := dataexport.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`generated: validator failed for field "DataExport.user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ExportStatus(); !ok {
+		return &ValidationError{Name: "export_status", err: errors.New(`generated: missing required field "DataExport.export_status"`)}
+	}
+	if v, ok := _c.mutation.ExportStatus(); ok {
+		if err := dataexport.ExportStatusValidator(v); err != nil {
+			return &ValidationError{Name: "export_status", err: fmt.Errorf(`generated: validator failed for field "DataExport.export_status": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.StorageKey(); ok {
+		if err := dataexport.StorageKeyValidator(v); err != nil {
+			return &ValidationError{Name: "storage_key", err: fmt.Errorf(`generated: validator failed for field "DataExport.storage_key": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ErrorMessage(); ok {
+		if err := dataexport.ErrorMessageValidator(v); err != nil {
+			return &ValidationError{Name: "error_message", err: fmt.Errorf(`generated: validator failed for field "DataExport.error_message": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "DataExport.created_at"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := dataexport.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`generated: validator failed for field "DataExport.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *DataExportCreate) sqlSave(ctx context.Context) (*DataExport, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected DataExport.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *DataExportCreate) createSpec() (*DataExport, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DataExport{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(dataexport.Table, sqlgraph.NewFieldSpec(dataexport.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(dataexport.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.ExportStatus(); ok {
+		_spec.SetField(dataexport.FieldExportStatus, field.TypeEnum, value)
+		_node.ExportStatus = value
+	}
+	if value, ok := _c.mutation.StorageKey(); ok {
+		_spec.SetField(dataexport.FieldStorageKey, field.TypeString, value)
+		_node.StorageKey = &value
+	}
+	if value, ok := _c.mutation.SizeBytes(); ok {
+		_spec.SetField(dataexport.FieldSizeBytes, field.TypeInt64, value)
+		_node.SizeBytes = &value
+	}
+	if value, ok := _c.mutation.ErrorMessage(); ok {
+		_spec.SetField(dataexport.FieldErrorMessage, field.TypeString, value)
+		_node.ErrorMessage = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(dataexport.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.StartedAt(); ok {
+		_spec.SetField(dataexport.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = &value
+	}
+	if value, ok := _c.mutation.CompletedAt(); ok {
+		_spec.SetField(dataexport.FieldCompletedAt, field.TypeTime, value)
+		_node.CompletedAt = &value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(dataexport.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DataExport.Create().
+//		SetUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DataExportUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *DataExportCreate) OnConflict(opts ...sql.ConflictOption) *DataExportUpsertOne {
+	_c.conflict = opts
+	return &DataExportUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DataExport.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *DataExportCreate) OnConflictColumns(columns ...string) *DataExportUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &DataExportUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// DataExportUpsertOne is the builder for "upsert"-ing
+	//  one DataExport node.
+	DataExportUpsertOne struct {
+		create *DataExportCreate
+	}
+
+	// DataExportUpsert is the "OnConflict" setter.
+	DataExportUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetExportStatus sets the "export_status" field.
+func (u *DataExportUpsert) SetExportStatus(v dataexport.ExportStatus) *DataExportUpsert {
+	u.Set(dataexport.FieldExportStatus, v)
+	return u
+}
+
+// UpdateExportStatus sets the "export_status" field to the value that was provided on create.
+func (u *DataExportUpsert) UpdateExportStatus() *DataExportUpsert {
+	u.SetExcluded(dataexport.FieldExportStatus)
+	return u
+}
+
+// SetStorageKey sets the "storage_key" field.
+func (u *DataExportUpsert) SetStorageKey(v string) *DataExportUpsert {
+	u.Set(dataexport.FieldStorageKey, v)
+	return u
+}
+
+// UpdateStorageKey sets the "storage_key" field to the value that was provided on create.
+func (u *DataExportUpsert) UpdateStorageKey() *DataExportUpsert {
+	u.SetExcluded(dataexport.FieldStorageKey)
+	return u
+}
+
+// ClearStorageKey clears the value of the "storage_key" field.
+func (u *DataExportUpsert) ClearStorageKey() *DataExportUpsert {
+	u.SetNull(dataexport.FieldStorageKey)
+	return u
+}
+
+// SetSizeBytes sets the "size_bytes" field.
+func (u *DataExportUpsert) SetSizeBytes(v int64) *DataExportUpsert {
+	u.Set(dataexport.FieldSizeBytes, v)
+	return u
+}
+
+// UpdateSizeBytes sets the "size_bytes" field to the value that was provided on create.
+func (u *DataExportUpsert) UpdateSizeBytes() *DataExportUpsert {
+	u.SetExcluded(dataexport.FieldSizeBytes)
+	return u
+}
+
+// AddSizeBytes adds v to the "size_bytes" field.
+func (u *DataExportUpsert) AddSizeBytes(v int64) *DataExportUpsert {
+	u.Add(dataexport.FieldSizeBytes, v)
+	return u
+}
+
+// ClearSizeBytes clears the value of the "size_bytes" field.
+func (u *DataExportUpsert) ClearSizeBytes() *DataExportUpsert {
+	u.SetNull(dataexport.FieldSizeBytes)
+	return u
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (u *DataExportUpsert) SetErrorMessage(v string) *DataExportUpsert {
+	u.Set(dataexport.FieldErrorMessage, v)
+	return u
+}
+
+// UpdateErrorMessage sets the "error_message" field to the value that was provided on create.
+func (u *DataExportUpsert) UpdateErrorMessage() *DataExportUpsert {
+	u.SetExcluded(dataexport.FieldErrorMessage)
+	return u
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (u *DataExportUpsert) ClearErrorMessage() *DataExportUpsert {
+	u.SetNull(dataexport.FieldErrorMessage)
+	return u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *DataExportUpsert) SetStartedAt(v time.Time) *DataExportUpsert {
+	u.Set(dataexport.FieldStartedAt, v)
+	return u
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *DataExportUpsert) UpdateStartedAt() *DataExportUpsert {
+	u.SetExcluded(dataexport.FieldStartedAt)
+	return u
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (u *DataExportUpsert) ClearStartedAt() *DataExportUpsert {
+	u.SetNull(dataexport.FieldStartedAt)
+	return u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (u *DataExportUpsert) SetCompletedAt(v time.Time) *DataExportUpsert {
+	u.Set(dataexport.FieldCompletedAt, v)
+	return u
+}
+
+// UpdateCompletedAt sets the "completed_at" field to the value that was provided on create.
+func (u *DataExportUpsert) UpdateCompletedAt() *DataExportUpsert {
+	u.SetExcluded(dataexport.FieldCompletedAt)
+	return u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (u *DataExportUpsert) ClearCompletedAt() *DataExportUpsert {
+	u.SetNull(dataexport.FieldCompletedAt)
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *DataExportUpsert) SetExpiresAt(v time.Time) *DataExportUpsert {
+	u.Set(dataexport.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *DataExportUpsert) UpdateExpiresAt() *DataExportUpsert {
+	u.SetExcluded(dataexport.FieldExpiresAt)
+	return u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *DataExportUpsert) ClearExpiresAt() *DataExportUpsert {
+	u.SetNull(dataexport.FieldExpiresAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.DataExport.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(dataexport.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DataExportUpsertOne) UpdateNewValues() *DataExportUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(dataexport.FieldID)
+		}
+		if _, exists := u.create.mutation.UserID(); exists {
+			s.SetIgnore(dataexport.FieldUserID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(dataexport.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DataExport.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *DataExportUpsertOne) Ignore() *DataExportUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DataExportUpsertOne) DoNothing() *DataExportUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DataExportCreate.OnConflict
+// documentation for more info.
+func (u *DataExportUpsertOne) Update(set func(*DataExportUpsert)) *DataExportUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DataExportUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetExportStatus sets the "export_status" field.
+func (u *DataExportUpsertOne) SetExportStatus(v dataexport.ExportStatus) *DataExportUpsertOne {
+	return u.Update(func(s *DataExportUpsert) {
+		s.SetExportStatus(v)
+	})
+}
+
+// UpdateExportStatus sets the "export_status" field to the value that was provided on create.
+func (u *DataExportUpsertOne) UpdateExportStatus() *DataExportUpsertOne {
+	return u.Update(func(s *DataExportUpsert) {
+		s.UpdateExportStatus()
+	})
+}
+
+// SetStorageKey sets the "storage_key" field.
+func (u *DataExportUpsertOne) SetStorageKey(v string) *DataExportUpsertOne {
+	return u.Update(func(s *DataExportUpsert) {
+		s.SetStorageKey(v)
+	})
+}
+
+// UpdateStorageKey sets the "storage_key" field to the value that was provided on create.
+func (u *DataExportUpsertOne) UpdateStorageKey() *DataExportUpsertOne {
+	return u.Update(func(s *DataExportUpsert) {
+		s.UpdateStorageKey()
+	})
+}
+
+// ClearStorageKey clears the value of the "storage_key" field.
+func (u *DataExportUpsertOne) ClearStorageKey() *DataExportUpsertOne {
+	return u.Update(func(s *DataExportUpsert) {
+		s.ClearStorageKey()
+	})
+}
+
+// SetSizeBytes sets the "size_bytes" field.
+func (u *DataExportUpsertOne) SetSizeBytes(v int64) *DataExportUpsertOne {
+	return u.Update(func(s *DataExportUpsert) {
+		s.SetSizeBytes(v)
+	})
+}
+
+// AddSizeBytes adds v to the "size_bytes" field.
+func (u *DataExportUpsertOne) AddSizeBytes(v int64) *DataExportUpsertOne {
+	return u.Update(func(s *DataExportUpsert) {
+		s.AddSizeBytes(v)
+	})
+}
+
+// UpdateSizeBytes sets the "size_bytes" field to the value that was provided on create.
+func (u *DataExportUpsertOne) UpdateSizeBytes() *DataExportUpsertOne {
+	return u.Update(func(s *DataExportUpsert) {
+		s.UpdateSizeBytes()
+	})
+}
+
+// ClearSizeBytes clears the value of the "size_bytes" field.
+func (u *DataExportUpsertOne) ClearSizeBytes() *DataExportUpsertOne {
+	return u.Update(func(s *DataExportUpsert) {
+		s.ClearSizeBytes()
+	})
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (u *DataExportUpsertOne) SetErrorMessage(v string) *DataExportUpsertOne {
+	return u.Update(func(s *DataExportUpsert) {
+		s.SetErrorMessage(v)
+	})
+}
+
+// UpdateErrorMessage sets the "error_message" field to the value that was provided on create.
+func (u *DataExportUpsertOne) UpdateErrorMessage() *DataExportUpsertOne {
+	return u.Update(func(s *DataExportUpsert) {
+		s.UpdateErrorMessage()
+	})
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (u *DataExportUpsertOne) ClearErrorMessage() *DataExportUpsertOne {
+	return u.Update(func(s *DataExportUpsert) {
+		s.ClearErrorMessage()
+	})
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *DataExportUpsertOne) SetStartedAt(v time.Time) *DataExportUpsertOne {
+	return u.Update(func(s *DataExportUpsert) {
+		s.SetStartedAt(v)
+	})
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *DataExportUpsertOne) UpdateStartedAt() *DataExportUpsertOne {
+	return u.Update(func(s *DataExportUpsert) {
+		s.UpdateStartedAt()
+	})
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (u *DataExportUpsertOne) ClearStartedAt() *DataExportUpsertOne {
+	return u.Update(func(s *DataExportUpsert) {
+		s.ClearStartedAt()
+	})
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (u *DataExportUpsertOne) SetCompletedAt(v time.Time) *DataExportUpsertOne {
+	return u.Update(func(s *DataExportUpsert) {
+		s.SetCompletedAt(v)
+	})
+}
+
+// UpdateCompletedAt sets the "completed_at" field to the value that was provided on create.
+func (u *DataExportUpsertOne) UpdateCompletedAt() *DataExportUpsertOne {
+	return u.Update(func(s *DataExportUpsert) {
+		s.UpdateCompletedAt()
+	})
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (u *DataExportUpsertOne) ClearCompletedAt() *DataExportUpsertOne {
+	return u.Update(func(s *DataExportUpsert) {
+		s.ClearCompletedAt()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *DataExportUpsertOne) SetExpiresAt(v time.Time) *DataExportUpsertOne {
+	return u.Update(func(s *DataExportUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *DataExportUpsertOne) UpdateExpiresAt() *DataExportUpsertOne {
+	return u.Update(func(s *DataExportUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *DataExportUpsertOne) ClearExpiresAt() *DataExportUpsertOne {
+	return u.Update(func(s *DataExportUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// Exec executes the query.
+func (u *DataExportUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("generated: missing options for DataExportCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DataExportUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *DataExportUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("generated: DataExportUpsertOne.ID is not supported by MySQL driver. Use DataExportUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *DataExportUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// DataExportCreateBulk is the builder for creating many DataExport entities in bulk.
+type DataExportCreateBulk struct {
+	config
+	err      error
+	builders []*DataExportCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the DataExport entities in the database.
+func (_c *DataExportCreateBulk) Save(ctx context.Context) ([]*DataExport, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*DataExport, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DataExportMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *DataExportCreateBulk) SaveX(ctx context.Context) []*DataExport {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DataExportCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DataExportCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DataExport.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DataExportUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *DataExportCreateBulk) OnConflict(opts ...sql.ConflictOption) *DataExportUpsertBulk {
+	_c.conflict = opts
+	return &DataExportUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DataExport.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *DataExportCreateBulk) OnConflictColumns(columns ...string) *DataExportUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &DataExportUpsertBulk{
+		create: _c,
+	}
+}
+
+// DataExportUpsertBulk is the builder for "upsert"-ing
+// a bulk of DataExport nodes.
+type DataExportUpsertBulk struct {
+	create *DataExportCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.DataExport.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(dataexport.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DataExportUpsertBulk) UpdateNewValues() *DataExportUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(dataexport.FieldID)
+			}
+			if _, exists := b.mutation.UserID(); exists {
+				s.SetIgnore(dataexport.FieldUserID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(dataexport.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DataExport.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *DataExportUpsertBulk) Ignore() *DataExportUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DataExportUpsertBulk) DoNothing() *DataExportUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DataExportCreateBulk.OnConflict
+// documentation for more info.
+func (u *DataExportUpsertBulk) Update(set func(*DataExportUpsert)) *DataExportUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DataExportUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetExportStatus sets the "export_status" field.
+func (u *DataExportUpsertBulk) SetExportStatus(v dataexport.ExportStatus) *DataExportUpsertBulk {
+	return u.Update(func(s *DataExportUpsert) {
+		s.SetExportStatus(v)
+	})
+}
+
+// UpdateExportStatus sets the "export_status" field to the value that was provided on create.
+func (u *DataExportUpsertBulk) UpdateExportStatus() *DataExportUpsertBulk {
+	return u.Update(func(s *DataExportUpsert) {
+		s.UpdateExportStatus()
+	})
+}
+
+// SetStorageKey sets the "storage_key" field.
+func (u *DataExportUpsertBulk) SetStorageKey(v string) *DataExportUpsertBulk {
+	return u.Update(func(s *DataExportUpsert) {
+		s.SetStorageKey(v)
+	})
+}
+
+// UpdateStorageKey sets the "storage_key" field to the value that was provided on create.
+func (u *DataExportUpsertBulk) UpdateStorageKey() *DataExportUpsertBulk {
+	return u.Update(func(s *DataExportUpsert) {
+		s.UpdateStorageKey()
+	})
+}
+
+// ClearStorageKey clears the value of the "storage_key" field.
+func (u *DataExportUpsertBulk) ClearStorageKey() *DataExportUpsertBulk {
+	return u.Update(func(s *DataExportUpsert) {
+		s.ClearStorageKey()
+	})
+}
+
+// SetSizeBytes sets the "size_bytes" field.
+func (u *DataExportUpsertBulk) SetSizeBytes(v int64) *DataExportUpsertBulk {
+	return u.Update(func(s *DataExportUpsert) {
+		s.SetSizeBytes(v)
+	})
+}
+
+// AddSizeBytes adds v to the "size_bytes" field.
+func (u *DataExportUpsertBulk) AddSizeBytes(v int64) *DataExportUpsertBulk {
+	return u.Update(func(s *DataExportUpsert) {
+		s.AddSizeBytes(v)
+	})
+}
+
+// UpdateSizeBytes sets the "size_bytes" field to the value that was provided on create.
+func (u *DataExportUpsertBulk) UpdateSizeBytes() *DataExportUpsertBulk {
+	return u.Update(func(s *DataExportUpsert) {
+		s.UpdateSizeBytes()
+	})
+}
+
+// ClearSizeBytes clears the value of the "size_bytes" field.
+func (u *DataExportUpsertBulk) ClearSizeBytes() *DataExportUpsertBulk {
+	return u.Update(func(s *DataExportUpsert) {
+		s.ClearSizeBytes()
+	})
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (u *DataExportUpsertBulk) SetErrorMessage(v string) *DataExportUpsertBulk {
+	return u.Update(func(s *DataExportUpsert) {
+		s.SetErrorMessage(v)
+	})
+}
+
+// UpdateErrorMessage sets the "error_message" field to the value that was provided on create.
+func (u *DataExportUpsertBulk) UpdateErrorMessage() *DataExportUpsertBulk {
+	return u.Update(func(s *DataExportUpsert) {
+		s.UpdateErrorMessage()
+	})
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (u *DataExportUpsertBulk) ClearErrorMessage() *DataExportUpsertBulk {
+	return u.Update(func(s *DataExportUpsert) {
+		s.ClearErrorMessage()
+	})
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *DataExportUpsertBulk) SetStartedAt(v time.Time) *DataExportUpsertBulk {
+	return u.Update(func(s *DataExportUpsert) {
+		s.SetStartedAt(v)
+	})
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *DataExportUpsertBulk) UpdateStartedAt() *DataExportUpsertBulk {
+	return u.Update(func(s *DataExportUpsert) {
+		s.UpdateStartedAt()
+	})
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (u *DataExportUpsertBulk) ClearStartedAt() *DataExportUpsertBulk {
+	return u.Update(func(s *DataExportUpsert) {
+		s.ClearStartedAt()
+	})
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (u *DataExportUpsertBulk) SetCompletedAt(v time.Time) *DataExportUpsertBulk {
+	return u.Update(func(s *DataExportUpsert) {
+		s.SetCompletedAt(v)
+	})
+}
+
+// UpdateCompletedAt sets the "completed_at" field to the value that was provided on create.
+func (u *DataExportUpsertBulk) UpdateCompletedAt() *DataExportUpsertBulk {
+	return u.Update(func(s *DataExportUpsert) {
+		s.UpdateCompletedAt()
+	})
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (u *DataExportUpsertBulk) ClearCompletedAt() *DataExportUpsertBulk {
+	return u.Update(func(s *DataExportUpsert) {
+		s.ClearCompletedAt()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *DataExportUpsertBulk) SetExpiresAt(v time.Time) *DataExportUpsertBulk {
+	return u.Update(func(s *DataExportUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *DataExportUpsertBulk) UpdateExpiresAt() *DataExportUpsertBulk {
+	return u.Update(func(s *DataExportUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *DataExportUpsertBulk) ClearExpiresAt() *DataExportUpsertBulk {
+	return u.Update(func(s *DataExportUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// Exec executes the query.
+func (u *DataExportUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("generated: OnConflict was set for builder %d. Set it on the DataExportCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("generated: missing options for DataExportCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DataExportUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,588 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/UnoraApp/be/ent/generated/dataexport"
+	"github.com/UnoraApp/be/ent/generated/predicate"
+)
+
+// DataExportUpdate is the builder for updating DataExport entities.
+type DataExportUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DataExportMutation
+}
+
+// Where appends a list predicates to the DataExportUpdate builder.
+func (_u *DataExportUpdate) Where(ps ...predicate.DataExport) *DataExportUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetExportStatus sets the "export_status" field.
+func (_u *DataExportUpdate) SetExportStatus(v dataexport.ExportStatus) *DataExportUpdate {
+	_u.mutation.SetExportStatus(v)
+	return _u
+}
+
+// SetNillableExportStatus sets the "export_status" field if the given value is not nil.
+func (_u *DataExportUpdate) SetNillableExportStatus(v *dataexport.ExportStatus) *DataExportUpdate {
+	if v != nil {
+		_u.SetExportStatus(*v)
+	}
+	return _u
+}
+
+// SetStorageKey sets the "storage_key" field.
+func (_u *DataExportUpdate) SetStorageKey(v string) *DataExportUpdate {
+	_u.mutation.SetStorageKey(v)
+	return _u
+}
+
+// SetNillableStorageKey sets the "storage_key" field if the given value is not nil.
+func (_u *DataExportUpdate) SetNillableStorageKey(v *string) *DataExportUpdate {
+	if v != nil {
+		_u.SetStorageKey(*v)
+	}
+	return _u
+}
+
+// ClearStorageKey clears the value of the "storage_key" field.
+func (_u *DataExportUpdate) ClearStorageKey() *DataExportUpdate {
+	_u.mutation.ClearStorageKey()
+	return _u
+}
+
+// SetSizeBytes sets the "size_bytes" field.
+func (_u *DataExportUpdate) SetSizeBytes(v int64) *DataExportUpdate {
+	_u.mutation.ResetSizeBytes()
+	_u.mutation.SetSizeBytes(v)
+	return _u
+}
+
+// SetNillableSizeBytes sets the "size_bytes" field if the given value is not nil.
+func (_u *DataExportUpdate) SetNillableSizeBytes(v *int64) *DataExportUpdate {
+	if v != nil {
+		_u.SetSizeBytes(*v)
+	}
+	return _u
+}
+
+// AddSizeBytes adds value to the "size_bytes" field.
+func (_u *DataExportUpdate) AddSizeBytes(v int64) *DataExportUpdate {
+	_u.mutation.AddSizeBytes(v)
+	return _u
+}
+
+// ClearSizeBytes clears the value of the "size_bytes" field.
+func (_u *DataExportUpdate) ClearSizeBytes() *DataExportUpdate {
+	_u.mutation.ClearSizeBytes()
+	return _u
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (_u *DataExportUpdate) SetErrorMessage(v string) *DataExportUpdate {
+	_u.mutation.SetErrorMessage(v)
+	return _u
+}
+
+// SetNillableErrorMessage sets the "error_message" field if the given value is not nil.
+func (_u *DataExportUpdate) SetNillableErrorMessage(v *string) *DataExportUpdate {
+	if v != nil {
+		_u.SetErrorMessage(*v)
+	}
+	return _u
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (_u *DataExportUpdate) ClearErrorMessage() *DataExportUpdate {
+	_u.mutation.ClearErrorMessage()
+	return _u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_u *DataExportUpdate) SetStartedAt(v time.Time) *DataExportUpdate {
+	_u.mutation.SetStartedAt(v)
+	return _u
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_u *DataExportUpdate) SetNillableStartedAt(v *time.Time) *DataExportUpdate {
+	if v != nil {
+		_u.SetStartedAt(*v)
+	}
+	return _u
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (_u *DataExportUpdate) ClearStartedAt() *DataExportUpdate {
+	_u.mutation.ClearStartedAt()
+	return _u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_u *DataExportUpdate) SetCompletedAt(v time.Time) *DataExportUpdate {
+	_u.mutation.SetCompletedAt(v)
+	return _u
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_u *DataExportUpdate) SetNillableCompletedAt(v *time.Time) *DataExportUpdate {
+	if v != nil {
+		_u.SetCompletedAt(*v)
+	}
+	return _u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (_u *DataExportUpdate) ClearCompletedAt() *DataExportUpdate {
+	_u.mutation.ClearCompletedAt()
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *DataExportUpdate) SetExpiresAt(v time.Time) *DataExportUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *DataExportUpdate) SetNillableExpiresAt(v *time.Time) *DataExportUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *DataExportUpdate) ClearExpiresAt() *DataExportUpdate {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// Mutation returns the DataExportMutation object of the builder.
+func (_u *DataExportUpdate) Mutation() *DataExportMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *DataExportUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DataExportUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *DataExportUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DataExportUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DataExportUpdate) check() error {
+	if v, ok := _u.mutation.ExportStatus(); ok {
+		if err := dataexport.ExportStatusValidator(v); err != nil {
+			return &ValidationError{Name: "export_status", err: fmt.Errorf(`generated: validator failed for field "DataExport.export_status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.StorageKey(); ok {
+		if err := dataexport.StorageKeyValidator(v); err != nil {
+			return &ValidationError{Name: "storage_key", err: fmt.Errorf(`generated: validator failed for field "DataExport.storage_key": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ErrorMessage(); ok {
+		if err := dataexport.ErrorMessageValidator(v); err != nil {
+			return &ValidationError{Name: "error_message", err: fmt.Errorf(`generated: validator failed for field "DataExport.error_message": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *DataExportUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(dataexport.Table, dataexport.Columns, sqlgraph.NewFieldSpec(dataexport.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ExportStatus(); ok {
+		_spec.SetField(dataexport.FieldExportStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.StorageKey(); ok {
+		_spec.SetField(dataexport.FieldStorageKey, field.TypeString, value)
+	}
+	if _u.mutation.StorageKeyCleared() {
+		_spec.ClearField(dataexport.FieldStorageKey, field.TypeString)
+	}
+	if value, ok := _u.mutation.SizeBytes(); ok {
+		_spec.SetField(dataexport.FieldSizeBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSizeBytes(); ok {
+		_spec.AddField(dataexport.FieldSizeBytes, field.TypeInt64, value)
+	}
+	if _u.mutation.SizeBytesCleared() {
+		_spec.ClearField(dataexport.FieldSizeBytes, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.ErrorMessage(); ok {
+		_spec.SetField(dataexport.FieldErrorMessage, field.TypeString, value)
+	}
+	if _u.mutation.ErrorMessageCleared() {
+		_spec.ClearField(dataexport.FieldErrorMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.StartedAt(); ok {
+		_spec.SetField(dataexport.FieldStartedAt, field.TypeTime, value)
+	}
+	if _u.mutation.StartedAtCleared() {
+		_spec.ClearField(dataexport.FieldStartedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CompletedAt(); ok {
+		_spec.SetField(dataexport.FieldCompletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CompletedAtCleared() {
+		_spec.ClearField(dataexport.FieldCompletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(dataexport.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(dataexport.FieldExpiresAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{dataexport.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// DataExportUpdateOne is the builder for updating a single DataExport entity.
+type DataExportUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DataExportMutation
+}
+
+// SetExportStatus sets the "export_status" field.
+func (_u *DataExportUpdateOne) SetExportStatus(v dataexport.ExportStatus) *DataExportUpdateOne {
+	_u.mutation.SetExportStatus(v)
+	return _u
+}
+
+// SetNillableExportStatus sets the "export_status" field if the given value is not nil.
+func (_u *DataExportUpdateOne) SetNillableExportStatus(v *dataexport.ExportStatus) *DataExportUpdateOne {
+	if v != nil {
+		_u.SetExportStatus(*v)
+	}
+	return _u
+}
+
+// SetStorageKey sets the "storage_key" field.
+func (_u *DataExportUpdateOne) SetStorageKey(v string) *DataExportUpdateOne {
+	_u.mutation.SetStorageKey(v)
+	return _u
+}
+
+// SetNillableStorageKey sets the "storage_key" field if the given value is not nil.
+func (_u *DataExportUpdateOne) SetNillableStorageKey(v *string) *DataExportUpdateOne {
+	if v != nil {
+		_u.SetStorageKey(*v)
+	}
+	return _u
+}
+
+// ClearStorageKey clears the value of the "storage_key" field.
+func (_u *DataExportUpdateOne) ClearStorageKey() *DataExportUpdateOne {
+	_u.mutation.ClearStorageKey()
+	return _u
+}
+
+// SetSizeBytes sets the "size_bytes" field.
+func (_u *DataExportUpdateOne) SetSizeBytes(v int64) *DataExportUpdateOne {
+	_u.mutation.ResetSizeBytes()
+	_u.mutation.SetSizeBytes(v)
+	return _u
+}
+
+// SetNillableSizeBytes sets the "size_bytes" field if the given value is not nil.
+func (_u *DataExportUpdateOne) SetNillableSizeBytes(v *int64) *DataExportUpdateOne {
+	if v != nil {
+		_u.SetSizeBytes(*v)
+	}
+	return _u
+}
+
+// AddSizeBytes adds value to the "size_bytes" field.
+func (_u *DataExportUpdateOne) AddSizeBytes(v int64) *DataExportUpdateOne {
+	_u.mutation.AddSizeBytes(v)
+	return _u
+}
+
+// ClearSizeBytes clears the value of the "size_bytes" field.
+func (_u *DataExportUpdateOne) ClearSizeBytes() *DataExportUpdateOne {
+	_u.mutation.ClearSizeBytes()
+	return _u
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (_u *DataExportUpdateOne) SetErrorMessage(v string) *DataExportUpdateOne {
+	_u.mutation.SetErrorMessage(v)
+	return _u
+}
+
+// SetNillableErrorMessage sets the "error_message" field if the given value is not nil.
+func (_u *DataExportUpdateOne) SetNillableErrorMessage(v *string) *DataExportUpdateOne {
+	if v != nil {
+		_u.SetErrorMessage(*v)
+	}
+	return _u
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (_u *DataExportUpdateOne) ClearErrorMessage() *DataExportUpdateOne {
+	_u.mutation.ClearErrorMessage()
+	return _u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_u *DataExportUpdateOne) SetStartedAt(v time.Time) *DataExportUpdateOne {
+	_u.mutation.SetStartedAt(v)
+	return _u
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_u *DataExportUpdateOne) SetNillableStartedAt(v *time.Time) *DataExportUpdateOne {
+	if v != nil {
+		_u.SetStartedAt(*v)
+	}
+	return _u
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (_u *DataExportUpdateOne) ClearStartedAt() *DataExportUpdateOne {
+	_u.mutation.ClearStartedAt()
+	return _u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_u *DataExportUpdateOne) SetCompletedAt(v time.Time) *DataExportUpdateOne {
+	_u.mutation.SetCompletedAt(v)
+	return _u
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_u *DataExportUpdateOne) SetNillableCompletedAt(v *time.Time) *DataExportUpdateOne {
+	if v != nil {
+		_u.SetCompletedAt(*v)
+	}
+	return _u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (_u *DataExportUpdateOne) ClearCompletedAt() *DataExportUpdateOne {
+	_u.mutation.ClearCompletedAt()
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *DataExportUpdateOne) SetExpiresAt(v time.Time) *DataExportUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *DataExportUpdateOne) SetNillableExpiresAt(v *time.Time) *DataExportUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *DataExportUpdateOne) ClearExpiresAt() *DataExportUpdateOne {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// Mutation returns the DataExportMutation object of the builder.
+func (_u *DataExportUpdateOne) Mutation() *DataExportMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the DataExportUpdate builder.
+func (_u *DataExportUpdateOne) Where(ps ...predicate.DataExport) *DataExportUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *DataExportUpdateOne) Select(field string, fields ...string) *DataExportUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated DataExport entity.
+func (_u *DataExportUpdateOne) Save(ctx context.Context) (*DataExport, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DataExportUpdateOne) SaveX(ctx context.Context) *DataExport {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *DataExportUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DataExportUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DataExportUpdateOne) check() error {
+	if v, ok := _u.mutation.ExportStatus(); ok {
+		if err := dataexport.ExportStatusValidator(v); err != nil {
+			return &ValidationError{Name: "export_status", err: fmt.Errorf(`generated: validator failed for field "DataExport.export_status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.StorageKey(); ok {
+		if err := dataexport.StorageKeyValidator(v); err != nil {
+			return &ValidationError{Name: "storage_key", err: fmt.Errorf(`generated: validator failed for field "DataExport.storage_key": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ErrorMessage(); ok {
+		if err := dataexport.ErrorMessageValidator(v); err != nil {
+			return &ValidationError{Name: "error_message", err: fmt.Errorf(`generated: validator failed for field "DataExport.error_message": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *DataExportUpdateOne) sqlSave(ctx context.Context) (_node *DataExport, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(dataexport.Table, dataexport.Columns, sqlgraph.NewFieldSpec(dataexport.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "DataExport.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, dataexport.FieldID)
+		for _, f := range fields {
+			if !dataexport.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != dataexport.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ExportStatus(); ok {
+		_spec.SetField(dataexport.FieldExportStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.StorageKey(); ok {
+		_spec.SetField(dataexport.FieldStorageKey, field.TypeString, value)
+	}
+	if _u.mutation.StorageKeyCleared() {
+		_spec.ClearField(dataexport.FieldStorageKey, field.TypeString)
+	}
+	if value, ok := _u.mutation.SizeBytes(); ok {
+		_spec.SetField(dataexport.FieldSizeBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSizeBytes(); ok {
+		_spec.AddField(dataexport.FieldSizeBytes, field.TypeInt64, value)
+	}
+	if _u.mutation.SizeBytesCleared() {
+		_spec.ClearField(dataexport.FieldSizeBytes, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.ErrorMessage(); ok {
+		_spec.SetField(dataexport.FieldErrorMessage, field.TypeString, value)
+	}
+	if _u.mutation.ErrorMessageCleared() {
+		_spec.ClearField(dataexport.FieldErrorMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.StartedAt(); ok {
+		_spec.SetField(dataexport.FieldStartedAt, field.TypeTime, value)
+	}
+	if _u.mutation.StartedAtCleared() {
+		_spec.ClearField(dataexport.FieldStartedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CompletedAt(); ok {
+		_spec.SetField(dataexport.FieldCompletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CompletedAtCleared() {
+		_spec.ClearField(dataexport.FieldCompletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(dataexport.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(dataexport.FieldExpiresAt, field.TypeTime)
+	}
+	_node = &DataExport{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{dataexport.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
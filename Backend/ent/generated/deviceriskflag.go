@@ -0,0 +1,204 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/UnoraApp/be/ent/generated/deviceriskflag"
+)
+
+// DeviceRiskFlag is the model entity for the DeviceRiskFlag schema.
+type DeviceRiskFlag struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID string `json:"user_id,omitempty"`
+	// DeviceID holds the value of the "device_id" field.
+	DeviceID string `json:"device_id,omitempty"`
+	// Rule holds the value of the "rule" field.
+	Rule deviceriskflag.Rule `json:"rule,omitempty"`
+	// Details holds the value of the "details" field.
+	Details *string `json:"details,omitempty"`
+	// FlagStatus holds the value of the "flag_status" field.
+	FlagStatus deviceriskflag.FlagStatus `json:"flag_status,omitempty"`
+	// ReviewedBy holds the value of the "reviewed_by" field.
+	ReviewedBy *string `json:"reviewed_by,omitempty"`
+	// AdminNotes holds the value of the "admin_notes" field.
+	AdminNotes *string `json:"admin_notes,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// ReviewedAt holds the value of the "reviewed_at" field.
+	ReviewedAt   *time.Time `json:"reviewed_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DeviceRiskFlag) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case deviceriskflag.FieldID, deviceriskflag.FieldUserID, deviceriskflag.FieldDeviceID, deviceriskflag.FieldRule, deviceriskflag.FieldDetails, deviceriskflag.FieldFlagStatus, deviceriskflag.FieldReviewedBy, deviceriskflag.FieldAdminNotes:
+			values[i] = new(sql.NullString)
+		case deviceriskflag.FieldCreatedAt, deviceriskflag.FieldReviewedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DeviceRiskFlag fields.
+func (_m *DeviceRiskFlag) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case deviceriskflag.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case deviceriskflag.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = value.String
+			}
+		case deviceriskflag.FieldDeviceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field device_id", values[i])
+			} else if value.Valid {
+				_m.DeviceID = value.String
+			}
+		case deviceriskflag.FieldRule:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field rule", values[i])
+			} else if value.Valid {
+				_m.Rule = deviceriskflag.Rule(value.String)
+			}
+		case deviceriskflag.FieldDetails:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field details", values[i])
+			} else if value.Valid {
+				_m.Details = new(string)
+				*_m.Details = value.String
+			}
+		case deviceriskflag.FieldFlagStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field flag_status", values[i])
+			} else if value.Valid {
+				_m.FlagStatus = deviceriskflag.FlagStatus(value.String)
+			}
+		case deviceriskflag.FieldReviewedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewed_by", values[i])
+			} else if value.Valid {
+				_m.ReviewedBy = new(string)
+				*_m.ReviewedBy = value.String
+			}
+		case deviceriskflag.FieldAdminNotes:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field admin_notes", values[i])
+			} else if value.Valid {
+				_m.AdminNotes = new(string)
+				*_m.AdminNotes = value.String
+			}
+		case deviceriskflag.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case deviceriskflag.FieldReviewedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewed_at", values[i])
+			} else if value.Valid {
+				_m.ReviewedAt = new(time.Time)
+				*_m.ReviewedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DeviceRiskFlag.
+// This includes values selected through modifiers, order, etc.
+func (_m *DeviceRiskFlag) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this DeviceRiskFlag.
+// Note that you need to call DeviceRiskFlag.Unwrap() before calling this method if this DeviceRiskFlag
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *DeviceRiskFlag) Update() *DeviceRiskFlagUpdateOne {
+	return NewDeviceRiskFlagClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the DeviceRiskFlag entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *DeviceRiskFlag) Unwrap() *DeviceRiskFlag {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("generated: DeviceRiskFlag is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *DeviceRiskFlag) String() string {
+	var builder strings.Builder
+	builder.WriteString("DeviceRiskFlag(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(_m.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("device_id=")
+	builder.WriteString(_m.DeviceID)
+	builder.WriteString(", ")
+	builder.WriteString("rule=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Rule))
+	builder.WriteString(", ")
+	if v := _m.Details; v != nil {
+		builder.WriteString("details=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("flag_status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FlagStatus))
+	builder.WriteString(", ")
+	if v := _m.ReviewedBy; v != nil {
+		builder.WriteString("reviewed_by=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.AdminNotes; v != nil {
+		builder.WriteString("admin_notes=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.ReviewedAt; v != nil {
+		builder.WriteString("reviewed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DeviceRiskFlags is a parsable slice of DeviceRiskFlag.
+type DeviceRiskFlags []*DeviceRiskFlag
@@ -0,0 +1,179 @@
+// Code generated by ent, DO NOT EDIT.
+
+package deviceriskflag
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the deviceriskflag type in the database.
+	Label = "device_risk_flag"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldDeviceID holds the string denoting the device_id field in the database.
+	FieldDeviceID = "device_id"
+	// FieldRule holds the string denoting the rule field in the database.
+	FieldRule = "rule"
+	// FieldDetails holds the string denoting the details field in the database.
+	FieldDetails = "details"
+	// FieldFlagStatus holds the string denoting the flag_status field in the database.
+	FieldFlagStatus = "flag_status"
+	// FieldReviewedBy holds the string denoting the reviewed_by field in the database.
+	FieldReviewedBy = "reviewed_by"
+	// FieldAdminNotes holds the string denoting the admin_notes field in the database.
+	FieldAdminNotes = "admin_notes"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldReviewedAt holds the string denoting the reviewed_at field in the database.
+	FieldReviewedAt = "reviewed_at"
+	// Table holds the table name of the deviceriskflag in the database.
+	Table = "device_risk_flags"
+)
+
+// Columns holds all SQL columns for deviceriskflag fields.
+var Columns = []string{
+	FieldID,
+	FieldUserID,
+	FieldDeviceID,
+	FieldRule,
+	FieldDetails,
+	FieldFlagStatus,
+	FieldReviewedBy,
+	FieldAdminNotes,
+	FieldCreatedAt,
+	FieldReviewedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	UserIDValidator func(string) error
+	// DeviceIDValidator is a validator for the "device_id" field. It is called by the builders before save.
+	DeviceIDValidator func(string) error
+	// ReviewedByValidator is a validator for the "reviewed_by" field. It is called by the builders before save.
+	ReviewedByValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
+
+// Rule defines the type for the "rule" enum field.
+type Rule string
+
+// Rule values.
+const (
+	RuleMultiAccountDevice     Rule = "multi_account_device"
+	RuleDeviceChurn            Rule = "device_churn"
+	RuleSuspendedAccountDevice Rule = "suspended_account_device"
+)
+
+func (r Rule) String() string {
+	return string(r)
+}
+
+// RuleValidator is a validator for the "rule" field enum values. It is called by the builders before save.
+func RuleValidator(r Rule) error {
+	switch r {
+	case RuleMultiAccountDevice, RuleDeviceChurn, RuleSuspendedAccountDevice:
+		return nil
+	default:
+		return fmt.Errorf("deviceriskflag: invalid enum value for rule field: %q", r)
+	}
+}
+
+// FlagStatus defines the type for the "flag_status" enum field.
+type FlagStatus string
+
+// FlagStatusPending is the default value of the FlagStatus enum.
+const DefaultFlagStatus = FlagStatusPending
+
+// FlagStatus values.
+const (
+	FlagStatusPending     FlagStatus = "pending"
+	FlagStatusReviewed    FlagStatus = "reviewed"
+	FlagStatusActionTaken FlagStatus = "action_taken"
+	FlagStatusDismissed   FlagStatus = "dismissed"
+)
+
+func (fs FlagStatus) String() string {
+	return string(fs)
+}
+
+// FlagStatusValidator is a validator for the "flag_status" field enum values. It is called by the builders before save.
+func FlagStatusValidator(fs FlagStatus) error {
+	switch fs {
+	case FlagStatusPending, FlagStatusReviewed, FlagStatusActionTaken, FlagStatusDismissed:
+		return nil
+	default:
+		return fmt.Errorf("deviceriskflag: invalid enum value for flag_status field: %q", fs)
+	}
+}
+
+// OrderOption defines the ordering options for the DeviceRiskFlag queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByDeviceID orders the results by the device_id field.
+func ByDeviceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeviceID, opts...).ToFunc()
+}
+
+// ByRule orders the results by the rule field.
+func ByRule(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRule, opts...).ToFunc()
+}
+
+// ByDetails orders the results by the details field.
+func ByDetails(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDetails, opts...).ToFunc()
+}
+
+// ByFlagStatus orders the results by the flag_status field.
+func ByFlagStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFlagStatus, opts...).ToFunc()
+}
+
+// ByReviewedBy orders the results by the reviewed_by field.
+func ByReviewedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReviewedBy, opts...).ToFunc()
+}
+
+// ByAdminNotes orders the results by the admin_notes field.
+func ByAdminNotes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAdminNotes, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByReviewedAt orders the results by the reviewed_at field.
+func ByReviewedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReviewedAt, opts...).ToFunc()
+}
@@ -0,0 +1,600 @@
+// Code generated by ent, DO NOT EDIT.
+
+package deviceriskflag
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/UnoraApp/be/ent/generated/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldContainsFold(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldEQ(FieldUserID, v))
+}
+
+// DeviceID applies equality check predicate on the "device_id" field. It's identical to DeviceIDEQ.
+func DeviceID(v string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldEQ(FieldDeviceID, v))
+}
+
+// Details applies equality check predicate on the "details" field. It's identical to DetailsEQ.
+func Details(v string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldEQ(FieldDetails, v))
+}
+
+// ReviewedBy applies equality check predicate on the "reviewed_by" field. It's identical to ReviewedByEQ.
+func ReviewedBy(v string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldEQ(FieldReviewedBy, v))
+}
+
+// AdminNotes applies equality check predicate on the "admin_notes" field. It's identical to AdminNotesEQ.
+func AdminNotes(v string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldEQ(FieldAdminNotes, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ReviewedAt applies equality check predicate on the "reviewed_at" field. It's identical to ReviewedAtEQ.
+func ReviewedAt(v time.Time) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldEQ(FieldReviewedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// DeviceIDEQ applies the EQ predicate on the "device_id" field.
+func DeviceIDEQ(v string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldEQ(FieldDeviceID, v))
+}
+
+// DeviceIDNEQ applies the NEQ predicate on the "device_id" field.
+func DeviceIDNEQ(v string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldNEQ(FieldDeviceID, v))
+}
+
+// DeviceIDIn applies the In predicate on the "device_id" field.
+func DeviceIDIn(vs ...string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldIn(FieldDeviceID, vs...))
+}
+
+// DeviceIDNotIn applies the NotIn predicate on the "device_id" field.
+func DeviceIDNotIn(vs ...string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldNotIn(FieldDeviceID, vs...))
+}
+
+// DeviceIDGT applies the GT predicate on the "device_id" field.
+func DeviceIDGT(v string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldGT(FieldDeviceID, v))
+}
+
+// DeviceIDGTE applies the GTE predicate on the "device_id" field.
+func DeviceIDGTE(v string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldGTE(FieldDeviceID, v))
+}
+
+// DeviceIDLT applies the LT predicate on the "device_id" field.
+func DeviceIDLT(v string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldLT(FieldDeviceID, v))
+}
+
+// DeviceIDLTE applies the LTE predicate on the "device_id" field.
+func DeviceIDLTE(v string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldLTE(FieldDeviceID, v))
+}
+
+// DeviceIDContains applies the Contains predicate on the "device_id" field.
+func DeviceIDContains(v string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldContains(FieldDeviceID, v))
+}
+
+// DeviceIDHasPrefix applies the HasPrefix predicate on the "device_id" field.
+func DeviceIDHasPrefix(v string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldHasPrefix(FieldDeviceID, v))
+}
+
+// DeviceIDHasSuffix applies the HasSuffix predicate on the "device_id" field.
+func DeviceIDHasSuffix(v string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldHasSuffix(FieldDeviceID, v))
+}
+
+// DeviceIDEqualFold applies the EqualFold predicate on the "device_id" field.
+func DeviceIDEqualFold(v string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldEqualFold(FieldDeviceID, v))
+}
+
+// DeviceIDContainsFold applies the ContainsFold predicate on the "device_id" field.
+func DeviceIDContainsFold(v string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldContainsFold(FieldDeviceID, v))
+}
+
+// RuleEQ applies the EQ predicate on the "rule" field.
+func RuleEQ(v Rule) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldEQ(FieldRule, v))
+}
+
+// RuleNEQ applies the NEQ predicate on the "rule" field.
+func RuleNEQ(v Rule) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldNEQ(FieldRule, v))
+}
+
+// RuleIn applies the In predicate on the "rule" field.
+func RuleIn(vs ...Rule) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldIn(FieldRule, vs...))
+}
+
+// RuleNotIn applies the NotIn predicate on the "rule" field.
+func RuleNotIn(vs ...Rule) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldNotIn(FieldRule, vs...))
+}
+
+// DetailsEQ applies the EQ predicate on the "details" field.
+func DetailsEQ(v string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldEQ(FieldDetails, v))
+}
+
+// DetailsNEQ applies the NEQ predicate on the "details" field.
+func DetailsNEQ(v string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldNEQ(FieldDetails, v))
+}
+
+// DetailsIn applies the In predicate on the "details" field.
+func DetailsIn(vs ...string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldIn(FieldDetails, vs...))
+}
+
+// DetailsNotIn applies the NotIn predicate on the "details" field.
+func DetailsNotIn(vs ...string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldNotIn(FieldDetails, vs...))
+}
+
+// DetailsGT applies the GT predicate on the "details" field.
+func DetailsGT(v string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldGT(FieldDetails, v))
+}
+
+// DetailsGTE applies the GTE predicate on the "details" field.
+func DetailsGTE(v string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldGTE(FieldDetails, v))
+}
+
+// DetailsLT applies the LT predicate on the "details" field.
+func DetailsLT(v string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldLT(FieldDetails, v))
+}
+
+// DetailsLTE applies the LTE predicate on the "details" field.
+func DetailsLTE(v string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldLTE(FieldDetails, v))
+}
+
+// DetailsContains applies the Contains predicate on the "details" field.
+func DetailsContains(v string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldContains(FieldDetails, v))
+}
+
+// DetailsHasPrefix applies the HasPrefix predicate on the "details" field.
+func DetailsHasPrefix(v string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldHasPrefix(FieldDetails, v))
+}
+
+// DetailsHasSuffix applies the HasSuffix predicate on the "details" field.
+func DetailsHasSuffix(v string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldHasSuffix(FieldDetails, v))
+}
+
+// DetailsIsNil applies the IsNil predicate on the "details" field.
+func DetailsIsNil() predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldIsNull(FieldDetails))
+}
+
+// DetailsNotNil applies the NotNil predicate on the "details" field.
+func DetailsNotNil() predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldNotNull(FieldDetails))
+}
+
+// DetailsEqualFold applies the EqualFold predicate on the "details" field.
+func DetailsEqualFold(v string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldEqualFold(FieldDetails, v))
+}
+
+// DetailsContainsFold applies the ContainsFold predicate on the "details" field.
+func DetailsContainsFold(v string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldContainsFold(FieldDetails, v))
+}
+
+// FlagStatusEQ applies the EQ predicate on the "flag_status" field.
+func FlagStatusEQ(v FlagStatus) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldEQ(FieldFlagStatus, v))
+}
+
+// FlagStatusNEQ applies the NEQ predicate on the "flag_status" field.
+func FlagStatusNEQ(v FlagStatus) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldNEQ(FieldFlagStatus, v))
+}
+
+// FlagStatusIn applies the In predicate on the "flag_status" field.
+func FlagStatusIn(vs ...FlagStatus) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldIn(FieldFlagStatus, vs...))
+}
+
+// FlagStatusNotIn applies the NotIn predicate on the "flag_status" field.
+func FlagStatusNotIn(vs ...FlagStatus) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldNotIn(FieldFlagStatus, vs...))
+}
+
+// ReviewedByEQ applies the EQ predicate on the "reviewed_by" field.
+func ReviewedByEQ(v string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldEQ(FieldReviewedBy, v))
+}
+
+// ReviewedByNEQ applies the NEQ predicate on the "reviewed_by" field.
+func ReviewedByNEQ(v string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldNEQ(FieldReviewedBy, v))
+}
+
+// ReviewedByIn applies the In predicate on the "reviewed_by" field.
+func ReviewedByIn(vs ...string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldIn(FieldReviewedBy, vs...))
+}
+
+// ReviewedByNotIn applies the NotIn predicate on the "reviewed_by" field.
+func ReviewedByNotIn(vs ...string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldNotIn(FieldReviewedBy, vs...))
+}
+
+// ReviewedByGT applies the GT predicate on the "reviewed_by" field.
+func ReviewedByGT(v string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldGT(FieldReviewedBy, v))
+}
+
+// ReviewedByGTE applies the GTE predicate on the "reviewed_by" field.
+func ReviewedByGTE(v string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldGTE(FieldReviewedBy, v))
+}
+
+// ReviewedByLT applies the LT predicate on the "reviewed_by" field.
+func ReviewedByLT(v string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldLT(FieldReviewedBy, v))
+}
+
+// ReviewedByLTE applies the LTE predicate on the "reviewed_by" field.
+func ReviewedByLTE(v string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldLTE(FieldReviewedBy, v))
+}
+
+// ReviewedByContains applies the Contains predicate on the "reviewed_by" field.
+func ReviewedByContains(v string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldContains(FieldReviewedBy, v))
+}
+
+// ReviewedByHasPrefix applies the HasPrefix predicate on the "reviewed_by" field.
+func ReviewedByHasPrefix(v string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldHasPrefix(FieldReviewedBy, v))
+}
+
+// ReviewedByHasSuffix applies the HasSuffix predicate on the "reviewed_by" field.
+func ReviewedByHasSuffix(v string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldHasSuffix(FieldReviewedBy, v))
+}
+
+// ReviewedByIsNil applies the IsNil predicate on the "reviewed_by" field.
+func ReviewedByIsNil() predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldIsNull(FieldReviewedBy))
+}
+
+// ReviewedByNotNil applies the NotNil predicate on the "reviewed_by" field.
+func ReviewedByNotNil() predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldNotNull(FieldReviewedBy))
+}
+
+// ReviewedByEqualFold applies the EqualFold predicate on the "reviewed_by" field.
+func ReviewedByEqualFold(v string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldEqualFold(FieldReviewedBy, v))
+}
+
+// ReviewedByContainsFold applies the ContainsFold predicate on the "reviewed_by" field.
+func ReviewedByContainsFold(v string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldContainsFold(FieldReviewedBy, v))
+}
+
+// AdminNotesEQ applies the EQ predicate on the "admin_notes" field.
+func AdminNotesEQ(v string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldEQ(FieldAdminNotes, v))
+}
+
+// AdminNotesNEQ applies the NEQ predicate on the "admin_notes" field.
+func AdminNotesNEQ(v string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldNEQ(FieldAdminNotes, v))
+}
+
+// AdminNotesIn applies the In predicate on the "admin_notes" field.
+func AdminNotesIn(vs ...string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldIn(FieldAdminNotes, vs...))
+}
+
+// AdminNotesNotIn applies the NotIn predicate on the "admin_notes" field.
+func AdminNotesNotIn(vs ...string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldNotIn(FieldAdminNotes, vs...))
+}
+
+// AdminNotesGT applies the GT predicate on the "admin_notes" field.
+func AdminNotesGT(v string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldGT(FieldAdminNotes, v))
+}
+
+// AdminNotesGTE applies the GTE predicate on the "admin_notes" field.
+func AdminNotesGTE(v string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldGTE(FieldAdminNotes, v))
+}
+
+// AdminNotesLT applies the LT predicate on the "admin_notes" field.
+func AdminNotesLT(v string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldLT(FieldAdminNotes, v))
+}
+
+// AdminNotesLTE applies the LTE predicate on the "admin_notes" field.
+func AdminNotesLTE(v string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldLTE(FieldAdminNotes, v))
+}
+
+// AdminNotesContains applies the Contains predicate on the "admin_notes" field.
+func AdminNotesContains(v string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldContains(FieldAdminNotes, v))
+}
+
+// AdminNotesHasPrefix applies the HasPrefix predicate on the "admin_notes" field.
+func AdminNotesHasPrefix(v string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldHasPrefix(FieldAdminNotes, v))
+}
+
+// AdminNotesHasSuffix applies the HasSuffix predicate on the "admin_notes" field.
+func AdminNotesHasSuffix(v string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldHasSuffix(FieldAdminNotes, v))
+}
+
+// AdminNotesIsNil applies the IsNil predicate on the "admin_notes" field.
+func AdminNotesIsNil() predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldIsNull(FieldAdminNotes))
+}
+
+// AdminNotesNotNil applies the NotNil predicate on the "admin_notes" field.
+func AdminNotesNotNil() predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldNotNull(FieldAdminNotes))
+}
+
+// AdminNotesEqualFold applies the EqualFold predicate on the "admin_notes" field.
+func AdminNotesEqualFold(v string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldEqualFold(FieldAdminNotes, v))
+}
+
+// AdminNotesContainsFold applies the ContainsFold predicate on the "admin_notes" field.
+func AdminNotesContainsFold(v string) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldContainsFold(FieldAdminNotes, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// ReviewedAtEQ applies the EQ predicate on the "reviewed_at" field.
+func ReviewedAtEQ(v time.Time) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldEQ(FieldReviewedAt, v))
+}
+
+// ReviewedAtNEQ applies the NEQ predicate on the "reviewed_at" field.
+func ReviewedAtNEQ(v time.Time) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldNEQ(FieldReviewedAt, v))
+}
+
+// ReviewedAtIn applies the In predicate on the "reviewed_at" field.
+func ReviewedAtIn(vs ...time.Time) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldIn(FieldReviewedAt, vs...))
+}
+
+// ReviewedAtNotIn applies the NotIn predicate on the "reviewed_at" field.
+func ReviewedAtNotIn(vs ...time.Time) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldNotIn(FieldReviewedAt, vs...))
+}
+
+// ReviewedAtGT applies the GT predicate on the "reviewed_at" field.
+func ReviewedAtGT(v time.Time) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldGT(FieldReviewedAt, v))
+}
+
+// ReviewedAtGTE applies the GTE predicate on the "reviewed_at" field.
+func ReviewedAtGTE(v time.Time) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldGTE(FieldReviewedAt, v))
+}
+
+// ReviewedAtLT applies the LT predicate on the "reviewed_at" field.
+func ReviewedAtLT(v time.Time) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldLT(FieldReviewedAt, v))
+}
+
+// ReviewedAtLTE applies the LTE predicate on the "reviewed_at" field.
+func ReviewedAtLTE(v time.Time) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldLTE(FieldReviewedAt, v))
+}
+
+// ReviewedAtIsNil applies the IsNil predicate on the "reviewed_at" field.
+func ReviewedAtIsNil() predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldIsNull(FieldReviewedAt))
+}
+
+// ReviewedAtNotNil applies the NotNil predicate on the "reviewed_at" field.
+func ReviewedAtNotNil() predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.FieldNotNull(FieldReviewedAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.DeviceRiskFlag) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.DeviceRiskFlag) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.DeviceRiskFlag) predicate.DeviceRiskFlag {
+	return predicate.DeviceRiskFlag(sql.NotPredicates(p))
+}
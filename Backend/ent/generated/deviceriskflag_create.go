@@ -0,0 +1,884 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/UnoraApp/be/ent/generated/deviceriskflag"
+)
+
+// DeviceRiskFlagCreate is the builder for creating a DeviceRiskFlag entity.
+type DeviceRiskFlagCreate struct {
+	config
+	mutation *DeviceRiskFlagMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *DeviceRiskFlagCreate) SetUserID(v string) *DeviceRiskFlagCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetDeviceID sets the "device_id" field.
+func (_c *DeviceRiskFlagCreate) SetDeviceID(v string) *DeviceRiskFlagCreate {
+	_c.mutation.SetDeviceID(v)
+	return _c
+}
+
+// SetRule sets the "rule" field.
+func (_c *DeviceRiskFlagCreate) SetRule(v deviceriskflag.Rule) *DeviceRiskFlagCreate {
+	_c.mutation.SetRule(v)
+	return _c
+}
+
+// SetDetails sets the "details" field.
+func (_c *DeviceRiskFlagCreate) SetDetails(v string) *DeviceRiskFlagCreate {
+	_c.mutation.SetDetails(v)
+	return _c
+}
+
+// SetNillableDetails sets the "details" field if the given value is not nil.
+func (_c *DeviceRiskFlagCreate) SetNillableDetails(v *string) *DeviceRiskFlagCreate {
+	if v != nil {
+		_c.SetDetails(*v)
+	}
+	return _c
+}
+
+// SetFlagStatus sets the "flag_status" field.
+func (_c *DeviceRiskFlagCreate) SetFlagStatus(v deviceriskflag.FlagStatus) *DeviceRiskFlagCreate {
+	_c.mutation.SetFlagStatus(v)
+	return _c
+}
+
+// SetNillableFlagStatus sets the "flag_status" field if the given value is not nil.
+func (_c *DeviceRiskFlagCreate) SetNillableFlagStatus(v *deviceriskflag.FlagStatus) *DeviceRiskFlagCreate {
+	if v != nil {
+		_c.SetFlagStatus(*v)
+	}
+	return _c
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (_c *DeviceRiskFlagCreate) SetReviewedBy(v string) *DeviceRiskFlagCreate {
+	_c.mutation.SetReviewedBy(v)
+	return _c
+}
+
+// SetNillableReviewedBy sets the "reviewed_by" field if the given value is not nil.
+func (_c *DeviceRiskFlagCreate) SetNillableReviewedBy(v *string) *DeviceRiskFlagCreate {
+	if v != nil {
+		_c.SetReviewedBy(*v)
+	}
+	return _c
+}
+
+// SetAdminNotes sets the "admin_notes" field.
+func (_c *DeviceRiskFlagCreate) SetAdminNotes(v string) *DeviceRiskFlagCreate {
+	_c.mutation.SetAdminNotes(v)
+	return _c
+}
+
+// SetNillableAdminNotes sets the "admin_notes" field if the given value is not nil.
+func (_c *DeviceRiskFlagCreate) SetNillableAdminNotes(v *string) *DeviceRiskFlagCreate {
+	if v != nil {
+		_c.SetAdminNotes(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *DeviceRiskFlagCreate) SetCreatedAt(v time.Time) *DeviceRiskFlagCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *DeviceRiskFlagCreate) SetNillableCreatedAt(v *time.Time) *DeviceRiskFlagCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (_c *DeviceRiskFlagCreate) SetReviewedAt(v time.Time) *DeviceRiskFlagCreate {
+	_c.mutation.SetReviewedAt(v)
+	return _c
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (_c *DeviceRiskFlagCreate) SetNillableReviewedAt(v *time.Time) *DeviceRiskFlagCreate {
+	if v != nil {
+		_c.SetReviewedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *DeviceRiskFlagCreate) SetID(v string) *DeviceRiskFlagCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the DeviceRiskFlagMutation object of the builder.
+func (_c *DeviceRiskFlagCreate) Mutation() *DeviceRiskFlagMutation {
+	return _c.mutation
+}
+
+// Save creates the DeviceRiskFlag in the database.
+func (_c *DeviceRiskFlagCreate) Save(ctx context.Context) (*DeviceRiskFlag, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *DeviceRiskFlagCreate) SaveX(ctx context.Context) *DeviceRiskFlag {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DeviceRiskFlagCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DeviceRiskFlagCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *DeviceRiskFlagCreate) defaults() {
+	if _, ok := _c.mutation.FlagStatus(); !ok {
+		v := deviceriskflag.DefaultFlagStatus
+		_c.mutation.SetFlagStatus(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := deviceriskflag.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *DeviceRiskFlagCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`generated: missing required field "DeviceRiskFlag.user_id"`)}
+	}
+	if v, ok := _c.mutation.UserID(); ok {
+		if err := deviceriskflag.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`generated: validator failed for field "DeviceRiskFlag.user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.DeviceID(); !ok {
+		return &ValidationError{Name: "device_id", err: errors.New(`generated: missing required field "DeviceRiskFlag.device_id"`)}
+	}
+	if v, ok := _c.mutation.DeviceID(); ok {
+		if err := deviceriskflag.DeviceIDValidator(v); err != nil {
+			return &ValidationError{Name: "device_id", err: fmt.Errorf(`generated: validator failed for field "DeviceRiskFlag.device_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Rule(); !ok {
+		return &ValidationError{Name: "rule", err: errors.New(`generated: missing required field "DeviceRiskFlag.rule"`)}
+	}
+	if v, ok := _c.mutation.Rule(); ok {
+		if err := deviceriskflag.RuleValidator(v); err != nil {
+			return &ValidationError{Name: "rule", err: fmt.Errorf(`generated: validator failed for field "DeviceRiskFlag.rule": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.FlagStatus(); !ok {
+		return &ValidationError{Name: "flag_status", err: errors.New(`generated: missing required field "DeviceRiskFlag.flag_status"`)}
+	}
+	if v, ok := _c.mutation.FlagStatus(); ok {
+		if err := deviceriskflag.FlagStatusValidator(v); err != nil {
+			return &ValidationError{Name: "flag_status", err: fmt.Errorf(`generated: validator failed for field "DeviceRiskFlag.flag_status": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ReviewedBy(); ok {
+		if err := deviceriskflag.ReviewedByValidator(v); err != nil {
+			return &ValidationError{Name: "reviewed_by", err: fmt.Errorf(`generated: validator failed for field "DeviceRiskFlag.reviewed_by": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "DeviceRiskFlag.created_at"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := deviceriskflag.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`generated: validator failed for field "DeviceRiskFlag.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *DeviceRiskFlagCreate) sqlSave(ctx context.Context) (*DeviceRiskFlag, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected DeviceRiskFlag.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *DeviceRiskFlagCreate) createSpec() (*DeviceRiskFlag, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DeviceRiskFlag{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(deviceriskflag.Table, sqlgraph.NewFieldSpec(deviceriskflag.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(deviceriskflag.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.DeviceID(); ok {
+		_spec.SetField(deviceriskflag.FieldDeviceID, field.TypeString, value)
+		_node.DeviceID = value
+	}
+	if value, ok := _c.mutation.Rule(); ok {
+		_spec.SetField(deviceriskflag.FieldRule, field.TypeEnum, value)
+		_node.Rule = value
+	}
+	if value, ok := _c.mutation.Details(); ok {
+		_spec.SetField(deviceriskflag.FieldDetails, field.TypeString, value)
+		_node.Details = &value
+	}
+	if value, ok := _c.mutation.FlagStatus(); ok {
+		_spec.SetField(deviceriskflag.FieldFlagStatus, field.TypeEnum, value)
+		_node.FlagStatus = value
+	}
+	if value, ok := _c.mutation.ReviewedBy(); ok {
+		_spec.SetField(deviceriskflag.FieldReviewedBy, field.TypeString, value)
+		_node.ReviewedBy = &value
+	}
+	if value, ok := _c.mutation.AdminNotes(); ok {
+		_spec.SetField(deviceriskflag.FieldAdminNotes, field.TypeString, value)
+		_node.AdminNotes = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(deviceriskflag.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.ReviewedAt(); ok {
+		_spec.SetField(deviceriskflag.FieldReviewedAt, field.TypeTime, value)
+		_node.ReviewedAt = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DeviceRiskFlag.Create().
+//		SetUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DeviceRiskFlagUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *DeviceRiskFlagCreate) OnConflict(opts ...sql.ConflictOption) *DeviceRiskFlagUpsertOne {
+	_c.conflict = opts
+	return &DeviceRiskFlagUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DeviceRiskFlag.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *DeviceRiskFlagCreate) OnConflictColumns(columns ...string) *DeviceRiskFlagUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &DeviceRiskFlagUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// DeviceRiskFlagUpsertOne is the builder for "upsert"-ing
+	//  one DeviceRiskFlag node.
+	DeviceRiskFlagUpsertOne struct {
+		create *DeviceRiskFlagCreate
+	}
+
+	// DeviceRiskFlagUpsert is the "OnConflict" setter.
+	DeviceRiskFlagUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetFlagStatus sets the "flag_status" field.
+func (u *DeviceRiskFlagUpsert) SetFlagStatus(v deviceriskflag.FlagStatus) *DeviceRiskFlagUpsert {
+	u.Set(deviceriskflag.FieldFlagStatus, v)
+	return u
+}
+
+// UpdateFlagStatus sets the "flag_status" field to the value that was provided on create.
+func (u *DeviceRiskFlagUpsert) UpdateFlagStatus() *DeviceRiskFlagUpsert {
+	u.SetExcluded(deviceriskflag.FieldFlagStatus)
+	return u
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (u *DeviceRiskFlagUpsert) SetReviewedBy(v string) *DeviceRiskFlagUpsert {
+	u.Set(deviceriskflag.FieldReviewedBy, v)
+	return u
+}
+
+// UpdateReviewedBy sets the "reviewed_by" field to the value that was provided on create.
+func (u *DeviceRiskFlagUpsert) UpdateReviewedBy() *DeviceRiskFlagUpsert {
+	u.SetExcluded(deviceriskflag.FieldReviewedBy)
+	return u
+}
+
+// ClearReviewedBy clears the value of the "reviewed_by" field.
+func (u *DeviceRiskFlagUpsert) ClearReviewedBy() *DeviceRiskFlagUpsert {
+	u.SetNull(deviceriskflag.FieldReviewedBy)
+	return u
+}
+
+// SetAdminNotes sets the "admin_notes" field.
+func (u *DeviceRiskFlagUpsert) SetAdminNotes(v string) *DeviceRiskFlagUpsert {
+	u.Set(deviceriskflag.FieldAdminNotes, v)
+	return u
+}
+
+// UpdateAdminNotes sets the "admin_notes" field to the value that was provided on create.
+func (u *DeviceRiskFlagUpsert) UpdateAdminNotes() *DeviceRiskFlagUpsert {
+	u.SetExcluded(deviceriskflag.FieldAdminNotes)
+	return u
+}
+
+// ClearAdminNotes clears the value of the "admin_notes" field.
+func (u *DeviceRiskFlagUpsert) ClearAdminNotes() *DeviceRiskFlagUpsert {
+	u.SetNull(deviceriskflag.FieldAdminNotes)
+	return u
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (u *DeviceRiskFlagUpsert) SetReviewedAt(v time.Time) *DeviceRiskFlagUpsert {
+	u.Set(deviceriskflag.FieldReviewedAt, v)
+	return u
+}
+
+// UpdateReviewedAt sets the "reviewed_at" field to the value that was provided on create.
+func (u *DeviceRiskFlagUpsert) UpdateReviewedAt() *DeviceRiskFlagUpsert {
+	u.SetExcluded(deviceriskflag.FieldReviewedAt)
+	return u
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (u *DeviceRiskFlagUpsert) ClearReviewedAt() *DeviceRiskFlagUpsert {
+	u.SetNull(deviceriskflag.FieldReviewedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.DeviceRiskFlag.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(deviceriskflag.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DeviceRiskFlagUpsertOne) UpdateNewValues() *DeviceRiskFlagUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(deviceriskflag.FieldID)
+		}
+		if _, exists := u.create.mutation.UserID(); exists {
+			s.SetIgnore(deviceriskflag.FieldUserID)
+		}
+		if _, exists := u.create.mutation.DeviceID(); exists {
+			s.SetIgnore(deviceriskflag.FieldDeviceID)
+		}
+		if _, exists := u.create.mutation.Rule(); exists {
+			s.SetIgnore(deviceriskflag.FieldRule)
+		}
+		if _, exists := u.create.mutation.Details(); exists {
+			s.SetIgnore(deviceriskflag.FieldDetails)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(deviceriskflag.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DeviceRiskFlag.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *DeviceRiskFlagUpsertOne) Ignore() *DeviceRiskFlagUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DeviceRiskFlagUpsertOne) DoNothing() *DeviceRiskFlagUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DeviceRiskFlagCreate.OnConflict
+// documentation for more info.
+func (u *DeviceRiskFlagUpsertOne) Update(set func(*DeviceRiskFlagUpsert)) *DeviceRiskFlagUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DeviceRiskFlagUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetFlagStatus sets the "flag_status" field.
+func (u *DeviceRiskFlagUpsertOne) SetFlagStatus(v deviceriskflag.FlagStatus) *DeviceRiskFlagUpsertOne {
+	return u.Update(func(s *DeviceRiskFlagUpsert) {
+		s.SetFlagStatus(v)
+	})
+}
+
+// UpdateFlagStatus sets the "flag_status" field to the value that was provided on create.
+func (u *DeviceRiskFlagUpsertOne) UpdateFlagStatus() *DeviceRiskFlagUpsertOne {
+	return u.Update(func(s *DeviceRiskFlagUpsert) {
+		s.UpdateFlagStatus()
+	})
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (u *DeviceRiskFlagUpsertOne) SetReviewedBy(v string) *DeviceRiskFlagUpsertOne {
+	return u.Update(func(s *DeviceRiskFlagUpsert) {
+		s.SetReviewedBy(v)
+	})
+}
+
+// UpdateReviewedBy sets the "reviewed_by" field to the value that was provided on create.
+func (u *DeviceRiskFlagUpsertOne) UpdateReviewedBy() *DeviceRiskFlagUpsertOne {
+	return u.Update(func(s *DeviceRiskFlagUpsert) {
+		s.UpdateReviewedBy()
+	})
+}
+
+// ClearReviewedBy clears the value of the "reviewed_by" field.
+func (u *DeviceRiskFlagUpsertOne) ClearReviewedBy() *DeviceRiskFlagUpsertOne {
+	return u.Update(func(s *DeviceRiskFlagUpsert) {
+		s.ClearReviewedBy()
+	})
+}
+
+// SetAdminNotes sets the "admin_notes" field.
+func (u *DeviceRiskFlagUpsertOne) SetAdminNotes(v string) *DeviceRiskFlagUpsertOne {
+	return u.Update(func(s *DeviceRiskFlagUpsert) {
+		s.SetAdminNotes(v)
+	})
+}
+
+// UpdateAdminNotes sets the "admin_notes" field to the value that was provided on create.
+func (u *DeviceRiskFlagUpsertOne) UpdateAdminNotes() *DeviceRiskFlagUpsertOne {
+	return u.Update(func(s *DeviceRiskFlagUpsert) {
+		s.UpdateAdminNotes()
+	})
+}
+
+// ClearAdminNotes clears the value of the "admin_notes" field.
+func (u *DeviceRiskFlagUpsertOne) ClearAdminNotes() *DeviceRiskFlagUpsertOne {
+	return u.Update(func(s *DeviceRiskFlagUpsert) {
+		s.ClearAdminNotes()
+	})
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (u *DeviceRiskFlagUpsertOne) SetReviewedAt(v time.Time) *DeviceRiskFlagUpsertOne {
+	return u.Update(func(s *DeviceRiskFlagUpsert) {
+		s.SetReviewedAt(v)
+	})
+}
+
+// UpdateReviewedAt sets the "reviewed_at" field to the value that was provided on create.
+func (u *DeviceRiskFlagUpsertOne) UpdateReviewedAt() *DeviceRiskFlagUpsertOne {
+	return u.Update(func(s *DeviceRiskFlagUpsert) {
+		s.UpdateReviewedAt()
+	})
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (u *DeviceRiskFlagUpsertOne) ClearReviewedAt() *DeviceRiskFlagUpsertOne {
+	return u.Update(func(s *DeviceRiskFlagUpsert) {
+		s.ClearReviewedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *DeviceRiskFlagUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("generated: missing options for DeviceRiskFlagCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DeviceRiskFlagUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *DeviceRiskFlagUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("generated: DeviceRiskFlagUpsertOne.ID is not supported by MySQL driver. Use DeviceRiskFlagUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *DeviceRiskFlagUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// DeviceRiskFlagCreateBulk is the builder for creating many DeviceRiskFlag entities in bulk.
+type DeviceRiskFlagCreateBulk struct {
+	config
+	err      error
+	builders []*DeviceRiskFlagCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the DeviceRiskFlag entities in the database.
+func (_c *DeviceRiskFlagCreateBulk) Save(ctx context.Context) ([]*DeviceRiskFlag, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*DeviceRiskFlag, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DeviceRiskFlagMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *DeviceRiskFlagCreateBulk) SaveX(ctx context.Context) []*DeviceRiskFlag {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DeviceRiskFlagCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DeviceRiskFlagCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DeviceRiskFlag.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DeviceRiskFlagUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *DeviceRiskFlagCreateBulk) OnConflict(opts ...sql.ConflictOption) *DeviceRiskFlagUpsertBulk {
+	_c.conflict = opts
+	return &DeviceRiskFlagUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DeviceRiskFlag.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *DeviceRiskFlagCreateBulk) OnConflictColumns(columns ...string) *DeviceRiskFlagUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &DeviceRiskFlagUpsertBulk{
+		create: _c,
+	}
+}
+
+// DeviceRiskFlagUpsertBulk is the builder for "upsert"-ing
+// a bulk of DeviceRiskFlag nodes.
+type DeviceRiskFlagUpsertBulk struct {
+	create *DeviceRiskFlagCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.DeviceRiskFlag.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(deviceriskflag.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DeviceRiskFlagUpsertBulk) UpdateNewValues() *DeviceRiskFlagUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(deviceriskflag.FieldID)
+			}
+			if _, exists := b.mutation.UserID(); exists {
+				s.SetIgnore(deviceriskflag.FieldUserID)
+			}
+			if _, exists := b.mutation.DeviceID(); exists {
+				s.SetIgnore(deviceriskflag.FieldDeviceID)
+			}
+			if _, exists := b.mutation.Rule(); exists {
+				s.SetIgnore(deviceriskflag.FieldRule)
+			}
+			if _, exists := b.mutation.Details(); exists {
+				s.SetIgnore(deviceriskflag.FieldDetails)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(deviceriskflag.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DeviceRiskFlag.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *DeviceRiskFlagUpsertBulk) Ignore() *DeviceRiskFlagUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DeviceRiskFlagUpsertBulk) DoNothing() *DeviceRiskFlagUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DeviceRiskFlagCreateBulk.OnConflict
+// documentation for more info.
+func (u *DeviceRiskFlagUpsertBulk) Update(set func(*DeviceRiskFlagUpsert)) *DeviceRiskFlagUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DeviceRiskFlagUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetFlagStatus sets the "flag_status" field.
+func (u *DeviceRiskFlagUpsertBulk) SetFlagStatus(v deviceriskflag.FlagStatus) *DeviceRiskFlagUpsertBulk {
+	return u.Update(func(s *DeviceRiskFlagUpsert) {
+		s.SetFlagStatus(v)
+	})
+}
+
+// UpdateFlagStatus sets the "flag_status" field to the value that was provided on create.
+func (u *DeviceRiskFlagUpsertBulk) UpdateFlagStatus() *DeviceRiskFlagUpsertBulk {
+	return u.Update(func(s *DeviceRiskFlagUpsert) {
+		s.UpdateFlagStatus()
+	})
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (u *DeviceRiskFlagUpsertBulk) SetReviewedBy(v string) *DeviceRiskFlagUpsertBulk {
+	return u.Update(func(s *DeviceRiskFlagUpsert) {
+		s.SetReviewedBy(v)
+	})
+}
+
+// UpdateReviewedBy sets the "reviewed_by" field to the value that was provided on create.
+func (u *DeviceRiskFlagUpsertBulk) UpdateReviewedBy() *DeviceRiskFlagUpsertBulk {
+	return u.Update(func(s *DeviceRiskFlagUpsert) {
+		s.UpdateReviewedBy()
+	})
+}
+
+// ClearReviewedBy clears the value of the "reviewed_by" field.
+func (u *DeviceRiskFlagUpsertBulk) ClearReviewedBy() *DeviceRiskFlagUpsertBulk {
+	return u.Update(func(s *DeviceRiskFlagUpsert) {
+		s.ClearReviewedBy()
+	})
+}
+
+// SetAdminNotes sets the "admin_notes" field.
+func (u *DeviceRiskFlagUpsertBulk) SetAdminNotes(v string) *DeviceRiskFlagUpsertBulk {
+	return u.Update(func(s *DeviceRiskFlagUpsert) {
+		s.SetAdminNotes(v)
+	})
+}
+
+// UpdateAdminNotes sets the "admin_notes" field to the value that was provided on create.
+func (u *DeviceRiskFlagUpsertBulk) UpdateAdminNotes() *DeviceRiskFlagUpsertBulk {
+	return u.Update(func(s *DeviceRiskFlagUpsert) {
+		s.UpdateAdminNotes()
+	})
+}
+
+// ClearAdminNotes clears the value of the "admin_notes" field.
+func (u *DeviceRiskFlagUpsertBulk) ClearAdminNotes() *DeviceRiskFlagUpsertBulk {
+	return u.Update(func(s *DeviceRiskFlagUpsert) {
+		s.ClearAdminNotes()
+	})
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (u *DeviceRiskFlagUpsertBulk) SetReviewedAt(v time.Time) *DeviceRiskFlagUpsertBulk {
+	return u.Update(func(s *DeviceRiskFlagUpsert) {
+		s.SetReviewedAt(v)
+	})
+}
+
+// UpdateReviewedAt sets the "reviewed_at" field to the value that was provided on create.
+func (u *DeviceRiskFlagUpsertBulk) UpdateReviewedAt() *DeviceRiskFlagUpsertBulk {
+	return u.Update(func(s *DeviceRiskFlagUpsert) {
+		s.UpdateReviewedAt()
+	})
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (u *DeviceRiskFlagUpsertBulk) ClearReviewedAt() *DeviceRiskFlagUpsertBulk {
+	return u.Update(func(s *DeviceRiskFlagUpsert) {
+		s.ClearReviewedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *DeviceRiskFlagUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("generated: OnConflict was set for builder %d. Set it on the DeviceRiskFlagCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("generated: missing options for DeviceRiskFlagCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DeviceRiskFlagUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
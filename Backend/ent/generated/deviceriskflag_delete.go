@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/UnoraApp/be/ent/generated/deviceriskflag"
+	"github.com/UnoraApp/be/ent/generated/predicate"
+)
+
+// DeviceRiskFlagDelete is the builder for deleting a DeviceRiskFlag entity.
+type DeviceRiskFlagDelete struct {
+	config
+	hooks    []Hook
+	mutation *DeviceRiskFlagMutation
+}
+
+// Where appends a list predicates to the DeviceRiskFlagDelete builder.
+func (_d *DeviceRiskFlagDelete) Where(ps ...predicate.DeviceRiskFlag) *DeviceRiskFlagDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *DeviceRiskFlagDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *DeviceRiskFlagDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *DeviceRiskFlagDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(deviceriskflag.Table, sqlgraph.NewFieldSpec(deviceriskflag.FieldID, field.TypeString))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// DeviceRiskFlagDeleteOne is the builder for deleting a single DeviceRiskFlag entity.
+type DeviceRiskFlagDeleteOne struct {
+	_d *DeviceRiskFlagDelete
+}
+
+// Where appends a list predicates to the DeviceRiskFlagDelete builder.
+func (_d *DeviceRiskFlagDeleteOne) Where(ps ...predicate.DeviceRiskFlag) *DeviceRiskFlagDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *DeviceRiskFlagDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{deviceriskflag.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *DeviceRiskFlagDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
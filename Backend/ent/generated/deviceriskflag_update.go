@@ -0,0 +1,408 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/UnoraApp/be/ent/generated/deviceriskflag"
+	"github.com/UnoraApp/be/ent/generated/predicate"
+)
+
+// DeviceRiskFlagUpdate is the builder for updating DeviceRiskFlag entities.
+type DeviceRiskFlagUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DeviceRiskFlagMutation
+}
+
+// Where appends a list predicates to the DeviceRiskFlagUpdate builder.
+func (_u *DeviceRiskFlagUpdate) Where(ps ...predicate.DeviceRiskFlag) *DeviceRiskFlagUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetFlagStatus sets the "flag_status" field.
+func (_u *DeviceRiskFlagUpdate) SetFlagStatus(v deviceriskflag.FlagStatus) *DeviceRiskFlagUpdate {
+	_u.mutation.SetFlagStatus(v)
+	return _u
+}
+
+// SetNillableFlagStatus sets the "flag_status" field if the given value is not nil.
+func (_u *DeviceRiskFlagUpdate) SetNillableFlagStatus(v *deviceriskflag.FlagStatus) *DeviceRiskFlagUpdate {
+	if v != nil {
+		_u.SetFlagStatus(*v)
+	}
+	return _u
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (_u *DeviceRiskFlagUpdate) SetReviewedBy(v string) *DeviceRiskFlagUpdate {
+	_u.mutation.SetReviewedBy(v)
+	return _u
+}
+
+// SetNillableReviewedBy sets the "reviewed_by" field if the given value is not nil.
+func (_u *DeviceRiskFlagUpdate) SetNillableReviewedBy(v *string) *DeviceRiskFlagUpdate {
+	if v != nil {
+		_u.SetReviewedBy(*v)
+	}
+	return _u
+}
+
+// ClearReviewedBy clears the value of the "reviewed_by" field.
+func (_u *DeviceRiskFlagUpdate) ClearReviewedBy() *DeviceRiskFlagUpdate {
+	_u.mutation.ClearReviewedBy()
+	return _u
+}
+
+// SetAdminNotes sets the "admin_notes" field.
+func (_u *DeviceRiskFlagUpdate) SetAdminNotes(v string) *DeviceRiskFlagUpdate {
+	_u.mutation.SetAdminNotes(v)
+	return _u
+}
+
+// SetNillableAdminNotes sets the "admin_notes" field if the given value is not nil.
+func (_u *DeviceRiskFlagUpdate) SetNillableAdminNotes(v *string) *DeviceRiskFlagUpdate {
+	if v != nil {
+		_u.SetAdminNotes(*v)
+	}
+	return _u
+}
+
+// ClearAdminNotes clears the value of the "admin_notes" field.
+func (_u *DeviceRiskFlagUpdate) ClearAdminNotes() *DeviceRiskFlagUpdate {
+	_u.mutation.ClearAdminNotes()
+	return _u
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (_u *DeviceRiskFlagUpdate) SetReviewedAt(v time.Time) *DeviceRiskFlagUpdate {
+	_u.mutation.SetReviewedAt(v)
+	return _u
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (_u *DeviceRiskFlagUpdate) SetNillableReviewedAt(v *time.Time) *DeviceRiskFlagUpdate {
+	if v != nil {
+		_u.SetReviewedAt(*v)
+	}
+	return _u
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (_u *DeviceRiskFlagUpdate) ClearReviewedAt() *DeviceRiskFlagUpdate {
+	_u.mutation.ClearReviewedAt()
+	return _u
+}
+
+// Mutation returns the DeviceRiskFlagMutation object of the builder.
+func (_u *DeviceRiskFlagUpdate) Mutation() *DeviceRiskFlagMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *DeviceRiskFlagUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DeviceRiskFlagUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *DeviceRiskFlagUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DeviceRiskFlagUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DeviceRiskFlagUpdate) check() error {
+	if v, ok := _u.mutation.FlagStatus(); ok {
+		if err := deviceriskflag.FlagStatusValidator(v); err != nil {
+			return &ValidationError{Name: "flag_status", err: fmt.Errorf(`generated: validator failed for field "DeviceRiskFlag.flag_status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ReviewedBy(); ok {
+		if err := deviceriskflag.ReviewedByValidator(v); err != nil {
+			return &ValidationError{Name: "reviewed_by", err: fmt.Errorf(`generated: validator failed for field "DeviceRiskFlag.reviewed_by": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *DeviceRiskFlagUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(deviceriskflag.Table, deviceriskflag.Columns, sqlgraph.NewFieldSpec(deviceriskflag.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.DetailsCleared() {
+		_spec.ClearField(deviceriskflag.FieldDetails, field.TypeString)
+	}
+	if value, ok := _u.mutation.FlagStatus(); ok {
+		_spec.SetField(deviceriskflag.FieldFlagStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ReviewedBy(); ok {
+		_spec.SetField(deviceriskflag.FieldReviewedBy, field.TypeString, value)
+	}
+	if _u.mutation.ReviewedByCleared() {
+		_spec.ClearField(deviceriskflag.FieldReviewedBy, field.TypeString)
+	}
+	if value, ok := _u.mutation.AdminNotes(); ok {
+		_spec.SetField(deviceriskflag.FieldAdminNotes, field.TypeString, value)
+	}
+	if _u.mutation.AdminNotesCleared() {
+		_spec.ClearField(deviceriskflag.FieldAdminNotes, field.TypeString)
+	}
+	if value, ok := _u.mutation.ReviewedAt(); ok {
+		_spec.SetField(deviceriskflag.FieldReviewedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReviewedAtCleared() {
+		_spec.ClearField(deviceriskflag.FieldReviewedAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{deviceriskflag.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// DeviceRiskFlagUpdateOne is the builder for updating a single DeviceRiskFlag entity.
+type DeviceRiskFlagUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DeviceRiskFlagMutation
+}
+
+// SetFlagStatus sets the "flag_status" field.
+func (_u *DeviceRiskFlagUpdateOne) SetFlagStatus(v deviceriskflag.FlagStatus) *DeviceRiskFlagUpdateOne {
+	_u.mutation.SetFlagStatus(v)
+	return _u
+}
+
+// SetNillableFlagStatus sets the "flag_status" field if the given value is not nil.
+func (_u *DeviceRiskFlagUpdateOne) SetNillableFlagStatus(v *deviceriskflag.FlagStatus) *DeviceRiskFlagUpdateOne {
+	if v != nil {
+		_u.SetFlagStatus(*v)
+	}
+	return _u
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (_u *DeviceRiskFlagUpdateOne) SetReviewedBy(v string) *DeviceRiskFlagUpdateOne {
+	_u.mutation.SetReviewedBy(v)
+	return _u
+}
+
+// SetNillableReviewedBy sets the "reviewed_by" field if the given value is not nil.
+func (_u *DeviceRiskFlagUpdateOne) SetNillableReviewedBy(v *string) *DeviceRiskFlagUpdateOne {
+	if v != nil {
+		_u.SetReviewedBy(*v)
+	}
+	return _u
+}
+
+// ClearReviewedBy clears the value of the "reviewed_by" field.
+func (_u *DeviceRiskFlagUpdateOne) ClearReviewedBy() *DeviceRiskFlagUpdateOne {
+	_u.mutation.ClearReviewedBy()
+	return _u
+}
+
+// SetAdminNotes sets the "admin_notes" field.
+func (_u *DeviceRiskFlagUpdateOne) SetAdminNotes(v string) *DeviceRiskFlagUpdateOne {
+	_u.mutation.SetAdminNotes(v)
+	return _u
+}
+
+// SetNillableAdminNotes sets the "admin_notes" field if the given value is not nil.
+func (_u *DeviceRiskFlagUpdateOne) SetNillableAdminNotes(v *string) *DeviceRiskFlagUpdateOne {
+	if v != nil {
+		_u.SetAdminNotes(*v)
+	}
+	return _u
+}
+
+// ClearAdminNotes clears the value of the "admin_notes" field.
+func (_u *DeviceRiskFlagUpdateOne) ClearAdminNotes() *DeviceRiskFlagUpdateOne {
+	_u.mutation.ClearAdminNotes()
+	return _u
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (_u *DeviceRiskFlagUpdateOne) SetReviewedAt(v time.Time) *DeviceRiskFlagUpdateOne {
+	_u.mutation.SetReviewedAt(v)
+	return _u
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (_u *DeviceRiskFlagUpdateOne) SetNillableReviewedAt(v *time.Time) *DeviceRiskFlagUpdateOne {
+	if v != nil {
+		_u.SetReviewedAt(*v)
+	}
+	return _u
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (_u *DeviceRiskFlagUpdateOne) ClearReviewedAt() *DeviceRiskFlagUpdateOne {
+	_u.mutation.ClearReviewedAt()
+	return _u
+}
+
+// Mutation returns the DeviceRiskFlagMutation object of the builder.
+func (_u *DeviceRiskFlagUpdateOne) Mutation() *DeviceRiskFlagMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the DeviceRiskFlagUpdate builder.
+func (_u *DeviceRiskFlagUpdateOne) Where(ps ...predicate.DeviceRiskFlag) *DeviceRiskFlagUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *DeviceRiskFlagUpdateOne) Select(field string, fields ...string) *DeviceRiskFlagUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated DeviceRiskFlag entity.
+func (_u *DeviceRiskFlagUpdateOne) Save(ctx context.Context) (*DeviceRiskFlag, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DeviceRiskFlagUpdateOne) SaveX(ctx context.Context) *DeviceRiskFlag {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *DeviceRiskFlagUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DeviceRiskFlagUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DeviceRiskFlagUpdateOne) check() error {
+	if v, ok := _u.mutation.FlagStatus(); ok {
+		if err := deviceriskflag.FlagStatusValidator(v); err != nil {
+			return &ValidationError{Name: "flag_status", err: fmt.Errorf(`generated: validator failed for field "DeviceRiskFlag.flag_status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ReviewedBy(); ok {
+		if err := deviceriskflag.ReviewedByValidator(v); err != nil {
+			return &ValidationError{Name: "reviewed_by", err: fmt.Errorf(`generated: validator failed for field "DeviceRiskFlag.reviewed_by": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *DeviceRiskFlagUpdateOne) sqlSave(ctx context.Context) (_node *DeviceRiskFlag, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(deviceriskflag.Table, deviceriskflag.Columns, sqlgraph.NewFieldSpec(deviceriskflag.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "DeviceRiskFlag.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, deviceriskflag.FieldID)
+		for _, f := range fields {
+			if !deviceriskflag.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != deviceriskflag.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.DetailsCleared() {
+		_spec.ClearField(deviceriskflag.FieldDetails, field.TypeString)
+	}
+	if value, ok := _u.mutation.FlagStatus(); ok {
+		_spec.SetField(deviceriskflag.FieldFlagStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ReviewedBy(); ok {
+		_spec.SetField(deviceriskflag.FieldReviewedBy, field.TypeString, value)
+	}
+	if _u.mutation.ReviewedByCleared() {
+		_spec.ClearField(deviceriskflag.FieldReviewedBy, field.TypeString)
+	}
+	if value, ok := _u.mutation.AdminNotes(); ok {
+		_spec.SetField(deviceriskflag.FieldAdminNotes, field.TypeString, value)
+	}
+	if _u.mutation.AdminNotesCleared() {
+		_spec.ClearField(deviceriskflag.FieldAdminNotes, field.TypeString)
+	}
+	if value, ok := _u.mutation.ReviewedAt(); ok {
+		_spec.SetField(deviceriskflag.FieldReviewedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReviewedAtCleared() {
+		_spec.ClearField(deviceriskflag.FieldReviewedAt, field.TypeTime)
+	}
+	_node = &DeviceRiskFlag{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{deviceriskflag.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
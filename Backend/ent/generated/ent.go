@@ -12,29 +12,57 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/UnoraApp/be/ent/generated/accountdeletion"
+	"github.com/UnoraApp/be/ent/generated/adminuser"
 	"github.com/UnoraApp/be/ent/generated/auditlog"
+	"github.com/UnoraApp/be/ent/generated/balancedrift"
 	"github.com/UnoraApp/be/ent/generated/checkin"
 	"github.com/UnoraApp/be/ent/generated/connection"
+	"github.com/UnoraApp/be/ent/generated/conversation"
 	"github.com/UnoraApp/be/ent/generated/creditpackage"
 	"github.com/UnoraApp/be/ent/generated/credittransaction"
+	"github.com/UnoraApp/be/ent/generated/dataexport"
+	"github.com/UnoraApp/be/ent/generated/deviceriskflag"
 	"github.com/UnoraApp/be/ent/generated/discoverybatch"
 	"github.com/UnoraApp/be/ent/generated/discoverycard"
 	"github.com/UnoraApp/be/ent/generated/filter"
 	"github.com/UnoraApp/be/ent/generated/hobby"
 	"github.com/UnoraApp/be/ent/generated/hobbyoption"
 	"github.com/UnoraApp/be/ent/generated/interest"
+	"github.com/UnoraApp/be/ent/generated/invoice"
+	"github.com/UnoraApp/be/ent/generated/invoicesequence"
+	"github.com/UnoraApp/be/ent/generated/ledgerentry"
+	"github.com/UnoraApp/be/ent/generated/message"
+	"github.com/UnoraApp/be/ent/generated/moderationaction"
+	"github.com/UnoraApp/be/ent/generated/notification"
 	"github.com/UnoraApp/be/ent/generated/nudge"
+	"github.com/UnoraApp/be/ent/generated/paymentdiscrepancy"
 	"github.com/UnoraApp/be/ent/generated/paymentorder"
+	"github.com/UnoraApp/be/ent/generated/paymentreconciliationreport"
+	"github.com/UnoraApp/be/ent/generated/paymentrefund"
 	"github.com/UnoraApp/be/ent/generated/photo"
 	"github.com/UnoraApp/be/ent/generated/profile"
+	"github.com/UnoraApp/be/ent/generated/promocode"
+	"github.com/UnoraApp/be/ent/generated/promoredemption"
+	"github.com/UnoraApp/be/ent/generated/referral"
+	"github.com/UnoraApp/be/ent/generated/reportevidence"
 	"github.com/UnoraApp/be/ent/generated/reveal"
 	"github.com/UnoraApp/be/ent/generated/revealcontent"
+	"github.com/UnoraApp/be/ent/generated/revealgift"
 	"github.com/UnoraApp/be/ent/generated/revealmilestone"
+	"github.com/UnoraApp/be/ent/generated/revealview"
 	"github.com/UnoraApp/be/ent/generated/server"
 	"github.com/UnoraApp/be/ent/generated/streak"
+	"github.com/UnoraApp/be/ent/generated/subscription"
+	"github.com/UnoraApp/be/ent/generated/subscriptionplan"
+	"github.com/UnoraApp/be/ent/generated/tierentitlement"
 	"github.com/UnoraApp/be/ent/generated/user"
 	"github.com/UnoraApp/be/ent/generated/userblock"
+	"github.com/UnoraApp/be/ent/generated/userdevice"
+	"github.com/UnoraApp/be/ent/generated/useridentity"
 	"github.com/UnoraApp/be/ent/generated/userreport"
+	"github.com/UnoraApp/be/ent/generated/usersession"
+	"github.com/UnoraApp/be/ent/generated/webhookevent"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -95,29 +123,57 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			auditlog.Table:          auditlog.ValidColumn,
-			checkin.Table:           checkin.ValidColumn,
-			connection.Table:        connection.ValidColumn,
-			creditpackage.Table:     creditpackage.ValidColumn,
-			credittransaction.Table: credittransaction.ValidColumn,
-			discoverybatch.Table:    discoverybatch.ValidColumn,
-			discoverycard.Table:     discoverycard.ValidColumn,
-			filter.Table:            filter.ValidColumn,
-			hobby.Table:             hobby.ValidColumn,
-			hobbyoption.Table:       hobbyoption.ValidColumn,
-			interest.Table:          interest.ValidColumn,
-			nudge.Table:             nudge.ValidColumn,
-			paymentorder.Table:      paymentorder.ValidColumn,
-			photo.Table:             photo.ValidColumn,
-			profile.Table:           profile.ValidColumn,
-			reveal.Table:            reveal.ValidColumn,
-			revealcontent.Table:     revealcontent.ValidColumn,
-			revealmilestone.Table:   revealmilestone.ValidColumn,
-			server.Table:            server.ValidColumn,
-			streak.Table:            streak.ValidColumn,
-			user.Table:              user.ValidColumn,
-			userblock.Table:         userblock.ValidColumn,
-			userreport.Table:        userreport.ValidColumn,
+			accountdeletion.Table:             accountdeletion.ValidColumn,
+			adminuser.Table:                   adminuser.ValidColumn,
+			auditlog.Table:                    auditlog.ValidColumn,
+			balancedrift.Table:                balancedrift.ValidColumn,
+			checkin.Table:                     checkin.ValidColumn,
+			connection.Table:                  connection.ValidColumn,
+			conversation.Table:                conversation.ValidColumn,
+			creditpackage.Table:               creditpackage.ValidColumn,
+			credittransaction.Table:           credittransaction.ValidColumn,
+			dataexport.Table:                  dataexport.ValidColumn,
+			deviceriskflag.Table:              deviceriskflag.ValidColumn,
+			discoverybatch.Table:              discoverybatch.ValidColumn,
+			discoverycard.Table:               discoverycard.ValidColumn,
+			filter.Table:                      filter.ValidColumn,
+			hobby.Table:                       hobby.ValidColumn,
+			hobbyoption.Table:                 hobbyoption.ValidColumn,
+			interest.Table:                    interest.ValidColumn,
+			invoice.Table:                     invoice.ValidColumn,
+			invoicesequence.Table:             invoicesequence.ValidColumn,
+			ledgerentry.Table:                 ledgerentry.ValidColumn,
+			message.Table:                     message.ValidColumn,
+			moderationaction.Table:            moderationaction.ValidColumn,
+			notification.Table:                notification.ValidColumn,
+			nudge.Table:                       nudge.ValidColumn,
+			paymentdiscrepancy.Table:          paymentdiscrepancy.ValidColumn,
+			paymentorder.Table:                paymentorder.ValidColumn,
+			paymentreconciliationreport.Table: paymentreconciliationreport.ValidColumn,
+			paymentrefund.Table:               paymentrefund.ValidColumn,
+			photo.Table:                       photo.ValidColumn,
+			profile.Table:                     profile.ValidColumn,
+			promocode.Table:                   promocode.ValidColumn,
+			promoredemption.Table:             promoredemption.ValidColumn,
+			referral.Table:                    referral.ValidColumn,
+			reportevidence.Table:              reportevidence.ValidColumn,
+			reveal.Table:                      reveal.ValidColumn,
+			revealcontent.Table:               revealcontent.ValidColumn,
+			revealgift.Table:                  revealgift.ValidColumn,
+			revealmilestone.Table:             revealmilestone.ValidColumn,
+			revealview.Table:                  revealview.ValidColumn,
+			server.Table:                      server.ValidColumn,
+			streak.Table:                      streak.ValidColumn,
+			subscription.Table:                subscription.ValidColumn,
+			subscriptionplan.Table:            subscriptionplan.ValidColumn,
+			tierentitlement.Table:             tierentitlement.ValidColumn,
+			user.Table:                        user.ValidColumn,
+			userblock.Table:                   userblock.ValidColumn,
+			userdevice.Table:                  userdevice.ValidColumn,
+			useridentity.Table:                useridentity.ValidColumn,
+			userreport.Table:                  userreport.ValidColumn,
+			usersession.Table:                 usersession.ValidColumn,
+			webhookevent.Table:                webhookevent.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
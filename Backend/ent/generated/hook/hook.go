@@ -9,6 +9,30 @@ import (
 	"github.com/UnoraApp/be/ent/generated"
 )
 
+// The AccountDeletionFunc type is an adapter to allow the use of ordinary
+// function as AccountDeletion mutator.
+type AccountDeletionFunc func(context.Context, *generated.AccountDeletionMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AccountDeletionFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.AccountDeletionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.AccountDeletionMutation", m)
+}
+
+// The AdminUserFunc type is an adapter to allow the use of ordinary
+// function as AdminUser mutator.
+type AdminUserFunc func(context.Context, *generated.AdminUserMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AdminUserFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.AdminUserMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.AdminUserMutation", m)
+}
+
 // The AuditLogFunc type is an adapter to allow the use of ordinary
 // function as AuditLog mutator.
 type AuditLogFunc func(context.Context, *generated.AuditLogMutation) (generated.Value, error)
@@ -21,6 +45,18 @@ func (f AuditLogFunc) Mutate(ctx context.Context, m generated.Mutation) (generat
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.AuditLogMutation", m)
 }
 
+// The BalanceDriftFunc type is an adapter to allow the use of ordinary
+// function as BalanceDrift mutator.
+type BalanceDriftFunc func(context.Context, *generated.BalanceDriftMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BalanceDriftFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.BalanceDriftMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.BalanceDriftMutation", m)
+}
+
 // The CheckInFunc type is an adapter to allow the use of ordinary
 // function as CheckIn mutator.
 type CheckInFunc func(context.Context, *generated.CheckInMutation) (generated.Value, error)
@@ -45,6 +81,18 @@ func (f ConnectionFunc) Mutate(ctx context.Context, m generated.Mutation) (gener
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.ConnectionMutation", m)
 }
 
+// The ConversationFunc type is an adapter to allow the use of ordinary
+// function as Conversation mutator.
+type ConversationFunc func(context.Context, *generated.ConversationMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ConversationFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.ConversationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.ConversationMutation", m)
+}
+
 // The CreditPackageFunc type is an adapter to allow the use of ordinary
 // function as CreditPackage mutator.
 type CreditPackageFunc func(context.Context, *generated.CreditPackageMutation) (generated.Value, error)
@@ -69,6 +117,30 @@ func (f CreditTransactionFunc) Mutate(ctx context.Context, m generated.Mutation)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.CreditTransactionMutation", m)
 }
 
+// The DataExportFunc type is an adapter to allow the use of ordinary
+// function as DataExport mutator.
+type DataExportFunc func(context.Context, *generated.DataExportMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DataExportFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.DataExportMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.DataExportMutation", m)
+}
+
+// The DeviceRiskFlagFunc type is an adapter to allow the use of ordinary
+// function as DeviceRiskFlag mutator.
+type DeviceRiskFlagFunc func(context.Context, *generated.DeviceRiskFlagMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DeviceRiskFlagFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.DeviceRiskFlagMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.DeviceRiskFlagMutation", m)
+}
+
 // The DiscoveryBatchFunc type is an adapter to allow the use of ordinary
 // function as DiscoveryBatch mutator.
 type DiscoveryBatchFunc func(context.Context, *generated.DiscoveryBatchMutation) (generated.Value, error)
@@ -141,6 +213,78 @@ func (f InterestFunc) Mutate(ctx context.Context, m generated.Mutation) (generat
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.InterestMutation", m)
 }
 
+// The InvoiceFunc type is an adapter to allow the use of ordinary
+// function as Invoice mutator.
+type InvoiceFunc func(context.Context, *generated.InvoiceMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f InvoiceFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.InvoiceMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.InvoiceMutation", m)
+}
+
+// The InvoiceSequenceFunc type is an adapter to allow the use of ordinary
+// function as InvoiceSequence mutator.
+type InvoiceSequenceFunc func(context.Context, *generated.InvoiceSequenceMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f InvoiceSequenceFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.InvoiceSequenceMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.InvoiceSequenceMutation", m)
+}
+
+// The LedgerEntryFunc type is an adapter to allow the use of ordinary
+// function as LedgerEntry mutator.
+type LedgerEntryFunc func(context.Context, *generated.LedgerEntryMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LedgerEntryFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.LedgerEntryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.LedgerEntryMutation", m)
+}
+
+// The MessageFunc type is an adapter to allow the use of ordinary
+// function as Message mutator.
+type MessageFunc func(context.Context, *generated.MessageMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MessageFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.MessageMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.MessageMutation", m)
+}
+
+// The ModerationActionFunc type is an adapter to allow the use of ordinary
+// function as ModerationAction mutator.
+type ModerationActionFunc func(context.Context, *generated.ModerationActionMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ModerationActionFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.ModerationActionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.ModerationActionMutation", m)
+}
+
+// The NotificationFunc type is an adapter to allow the use of ordinary
+// function as Notification mutator.
+type NotificationFunc func(context.Context, *generated.NotificationMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f NotificationFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.NotificationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.NotificationMutation", m)
+}
+
 // The NudgeFunc type is an adapter to allow the use of ordinary
 // function as Nudge mutator.
 type NudgeFunc func(context.Context, *generated.NudgeMutation) (generated.Value, error)
@@ -153,6 +297,18 @@ func (f NudgeFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.NudgeMutation", m)
 }
 
+// The PaymentDiscrepancyFunc type is an adapter to allow the use of ordinary
+// function as PaymentDiscrepancy mutator.
+type PaymentDiscrepancyFunc func(context.Context, *generated.PaymentDiscrepancyMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PaymentDiscrepancyFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.PaymentDiscrepancyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.PaymentDiscrepancyMutation", m)
+}
+
 // The PaymentOrderFunc type is an adapter to allow the use of ordinary
 // function as PaymentOrder mutator.
 type PaymentOrderFunc func(context.Context, *generated.PaymentOrderMutation) (generated.Value, error)
@@ -165,6 +321,30 @@ func (f PaymentOrderFunc) Mutate(ctx context.Context, m generated.Mutation) (gen
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.PaymentOrderMutation", m)
 }
 
+// The PaymentReconciliationReportFunc type is an adapter to allow the use of ordinary
+// function as PaymentReconciliationReport mutator.
+type PaymentReconciliationReportFunc func(context.Context, *generated.PaymentReconciliationReportMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PaymentReconciliationReportFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.PaymentReconciliationReportMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.PaymentReconciliationReportMutation", m)
+}
+
+// The PaymentRefundFunc type is an adapter to allow the use of ordinary
+// function as PaymentRefund mutator.
+type PaymentRefundFunc func(context.Context, *generated.PaymentRefundMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PaymentRefundFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.PaymentRefundMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.PaymentRefundMutation", m)
+}
+
 // The PhotoFunc type is an adapter to allow the use of ordinary
 // function as Photo mutator.
 type PhotoFunc func(context.Context, *generated.PhotoMutation) (generated.Value, error)
@@ -189,6 +369,54 @@ func (f ProfileFunc) Mutate(ctx context.Context, m generated.Mutation) (generate
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.ProfileMutation", m)
 }
 
+// The PromoCodeFunc type is an adapter to allow the use of ordinary
+// function as PromoCode mutator.
+type PromoCodeFunc func(context.Context, *generated.PromoCodeMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PromoCodeFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.PromoCodeMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.PromoCodeMutation", m)
+}
+
+// The PromoRedemptionFunc type is an adapter to allow the use of ordinary
+// function as PromoRedemption mutator.
+type PromoRedemptionFunc func(context.Context, *generated.PromoRedemptionMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PromoRedemptionFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.PromoRedemptionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.PromoRedemptionMutation", m)
+}
+
+// The ReferralFunc type is an adapter to allow the use of ordinary
+// function as Referral mutator.
+type ReferralFunc func(context.Context, *generated.ReferralMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ReferralFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.ReferralMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.ReferralMutation", m)
+}
+
+// The ReportEvidenceFunc type is an adapter to allow the use of ordinary
+// function as ReportEvidence mutator.
+type ReportEvidenceFunc func(context.Context, *generated.ReportEvidenceMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ReportEvidenceFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.ReportEvidenceMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.ReportEvidenceMutation", m)
+}
+
 // The RevealFunc type is an adapter to allow the use of ordinary
 // function as Reveal mutator.
 type RevealFunc func(context.Context, *generated.RevealMutation) (generated.Value, error)
@@ -213,6 +441,18 @@ func (f RevealContentFunc) Mutate(ctx context.Context, m generated.Mutation) (ge
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.RevealContentMutation", m)
 }
 
+// The RevealGiftFunc type is an adapter to allow the use of ordinary
+// function as RevealGift mutator.
+type RevealGiftFunc func(context.Context, *generated.RevealGiftMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RevealGiftFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.RevealGiftMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.RevealGiftMutation", m)
+}
+
 // The RevealMilestoneFunc type is an adapter to allow the use of ordinary
 // function as RevealMilestone mutator.
 type RevealMilestoneFunc func(context.Context, *generated.RevealMilestoneMutation) (generated.Value, error)
@@ -225,6 +465,18 @@ func (f RevealMilestoneFunc) Mutate(ctx context.Context, m generated.Mutation) (
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.RevealMilestoneMutation", m)
 }
 
+// The RevealViewFunc type is an adapter to allow the use of ordinary
+// function as RevealView mutator.
+type RevealViewFunc func(context.Context, *generated.RevealViewMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RevealViewFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.RevealViewMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.RevealViewMutation", m)
+}
+
 // The ServerFunc type is an adapter to allow the use of ordinary
 // function as Server mutator.
 type ServerFunc func(context.Context, *generated.ServerMutation) (generated.Value, error)
@@ -249,6 +501,42 @@ func (f StreakFunc) Mutate(ctx context.Context, m generated.Mutation) (generated
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.StreakMutation", m)
 }
 
+// The SubscriptionFunc type is an adapter to allow the use of ordinary
+// function as Subscription mutator.
+type SubscriptionFunc func(context.Context, *generated.SubscriptionMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SubscriptionFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.SubscriptionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.SubscriptionMutation", m)
+}
+
+// The SubscriptionPlanFunc type is an adapter to allow the use of ordinary
+// function as SubscriptionPlan mutator.
+type SubscriptionPlanFunc func(context.Context, *generated.SubscriptionPlanMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SubscriptionPlanFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.SubscriptionPlanMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.SubscriptionPlanMutation", m)
+}
+
+// The TierEntitlementFunc type is an adapter to allow the use of ordinary
+// function as TierEntitlement mutator.
+type TierEntitlementFunc func(context.Context, *generated.TierEntitlementMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TierEntitlementFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.TierEntitlementMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.TierEntitlementMutation", m)
+}
+
 // The UserFunc type is an adapter to allow the use of ordinary
 // function as User mutator.
 type UserFunc func(context.Context, *generated.UserMutation) (generated.Value, error)
@@ -273,6 +561,30 @@ func (f UserBlockFunc) Mutate(ctx context.Context, m generated.Mutation) (genera
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.UserBlockMutation", m)
 }
 
+// The UserDeviceFunc type is an adapter to allow the use of ordinary
+// function as UserDevice mutator.
+type UserDeviceFunc func(context.Context, *generated.UserDeviceMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f UserDeviceFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.UserDeviceMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.UserDeviceMutation", m)
+}
+
+// The UserIdentityFunc type is an adapter to allow the use of ordinary
+// function as UserIdentity mutator.
+type UserIdentityFunc func(context.Context, *generated.UserIdentityMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f UserIdentityFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.UserIdentityMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.UserIdentityMutation", m)
+}
+
 // The UserReportFunc type is an adapter to allow the use of ordinary
 // function as UserReport mutator.
 type UserReportFunc func(context.Context, *generated.UserReportMutation) (generated.Value, error)
@@ -285,6 +597,30 @@ func (f UserReportFunc) Mutate(ctx context.Context, m generated.Mutation) (gener
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.UserReportMutation", m)
 }
 
+// The UserSessionFunc type is an adapter to allow the use of ordinary
+// function as UserSession mutator.
+type UserSessionFunc func(context.Context, *generated.UserSessionMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f UserSessionFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.UserSessionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.UserSessionMutation", m)
+}
+
+// The WebhookEventFunc type is an adapter to allow the use of ordinary
+// function as WebhookEvent mutator.
+type WebhookEventFunc func(context.Context, *generated.WebhookEventMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WebhookEventFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.WebhookEventMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.WebhookEventMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, generated.Mutation) bool
 
@@ -0,0 +1,399 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/UnoraApp/be/ent/generated/invoice"
+	"github.com/UnoraApp/be/ent/generated/paymentorder"
+)
+
+// Invoice is the model entity for the Invoice schema.
+type Invoice struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// InvoiceNumber holds the value of the "invoice_number" field.
+	InvoiceNumber string `json:"invoice_number,omitempty"`
+	// FinancialYear holds the value of the "financial_year" field.
+	FinancialYear string `json:"financial_year,omitempty"`
+	// SequenceNumber holds the value of the "sequence_number" field.
+	SequenceNumber int `json:"sequence_number,omitempty"`
+	// OrderID holds the value of the "order_id" field.
+	OrderID string `json:"order_id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID string `json:"user_id,omitempty"`
+	// SellerName holds the value of the "seller_name" field.
+	SellerName string `json:"seller_name,omitempty"`
+	// SellerAddress holds the value of the "seller_address" field.
+	SellerAddress string `json:"seller_address,omitempty"`
+	// SellerGstin holds the value of the "seller_gstin" field.
+	SellerGstin string `json:"seller_gstin,omitempty"`
+	// BuyerName holds the value of the "buyer_name" field.
+	BuyerName *string `json:"buyer_name,omitempty"`
+	// BuyerEmail holds the value of the "buyer_email" field.
+	BuyerEmail *string `json:"buyer_email,omitempty"`
+	// PlaceOfSupply holds the value of the "place_of_supply" field.
+	PlaceOfSupply string `json:"place_of_supply,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// SacCode holds the value of the "sac_code" field.
+	SacCode string `json:"sac_code,omitempty"`
+	// Currency holds the value of the "currency" field.
+	Currency string `json:"currency,omitempty"`
+	// DiscountAmount holds the value of the "discount_amount" field.
+	DiscountAmount int `json:"discount_amount,omitempty"`
+	// TaxableAmount holds the value of the "taxable_amount" field.
+	TaxableAmount int `json:"taxable_amount,omitempty"`
+	// Total GST rate in percent
+	GstRate float64 `json:"gst_rate,omitempty"`
+	// CgstAmount holds the value of the "cgst_amount" field.
+	CgstAmount int `json:"cgst_amount,omitempty"`
+	// SgstAmount holds the value of the "sgst_amount" field.
+	SgstAmount int `json:"sgst_amount,omitempty"`
+	// IgstAmount holds the value of the "igst_amount" field.
+	IgstAmount int `json:"igst_amount,omitempty"`
+	// TotalAmount holds the value of the "total_amount" field.
+	TotalAmount int `json:"total_amount,omitempty"`
+	// PdfStorageKey holds the value of the "pdf_storage_key" field.
+	PdfStorageKey *string `json:"pdf_storage_key,omitempty"`
+	// IssuedAt holds the value of the "issued_at" field.
+	IssuedAt time.Time `json:"issued_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the InvoiceQuery when eager-loading is set.
+	Edges        InvoiceEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// InvoiceEdges holds the relations/edges for other nodes in the graph.
+type InvoiceEdges struct {
+	// Order holds the value of the order edge.
+	Order *PaymentOrder `json:"order,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// OrderOrErr returns the Order value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e InvoiceEdges) OrderOrErr() (*PaymentOrder, error) {
+	if e.Order != nil {
+		return e.Order, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: paymentorder.Label}
+	}
+	return nil, &NotLoadedError{edge: "order"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Invoice) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case invoice.FieldGstRate:
+			values[i] = new(sql.NullFloat64)
+		case invoice.FieldSequenceNumber, invoice.FieldDiscountAmount, invoice.FieldTaxableAmount, invoice.FieldCgstAmount, invoice.FieldSgstAmount, invoice.FieldIgstAmount, invoice.FieldTotalAmount:
+			values[i] = new(sql.NullInt64)
+		case invoice.FieldID, invoice.FieldInvoiceNumber, invoice.FieldFinancialYear, invoice.FieldOrderID, invoice.FieldUserID, invoice.FieldSellerName, invoice.FieldSellerAddress, invoice.FieldSellerGstin, invoice.FieldBuyerName, invoice.FieldBuyerEmail, invoice.FieldPlaceOfSupply, invoice.FieldDescription, invoice.FieldSacCode, invoice.FieldCurrency, invoice.FieldPdfStorageKey:
+			values[i] = new(sql.NullString)
+		case invoice.FieldIssuedAt, invoice.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Invoice fields.
+func (_m *Invoice) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case invoice.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case invoice.FieldInvoiceNumber:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field invoice_number", values[i])
+			} else if value.Valid {
+				_m.InvoiceNumber = value.String
+			}
+		case invoice.FieldFinancialYear:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field financial_year", values[i])
+			} else if value.Valid {
+				_m.FinancialYear = value.String
+			}
+		case invoice.FieldSequenceNumber:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sequence_number", values[i])
+			} else if value.Valid {
+				_m.SequenceNumber = int(value.Int64)
+			}
+		case invoice.FieldOrderID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field order_id", values[i])
+			} else if value.Valid {
+				_m.OrderID = value.String
+			}
+		case invoice.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = value.String
+			}
+		case invoice.FieldSellerName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field seller_name", values[i])
+			} else if value.Valid {
+				_m.SellerName = value.String
+			}
+		case invoice.FieldSellerAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field seller_address", values[i])
+			} else if value.Valid {
+				_m.SellerAddress = value.String
+			}
+		case invoice.FieldSellerGstin:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field seller_gstin", values[i])
+			} else if value.Valid {
+				_m.SellerGstin = value.String
+			}
+		case invoice.FieldBuyerName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field buyer_name", values[i])
+			} else if value.Valid {
+				_m.BuyerName = new(string)
+				*_m.BuyerName = value.String
+			}
+		case invoice.FieldBuyerEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field buyer_email", values[i])
+			} else if value.Valid {
+				_m.BuyerEmail = new(string)
+				*_m.BuyerEmail = value.String
+			}
+		case invoice.FieldPlaceOfSupply:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field place_of_supply", values[i])
+			} else if value.Valid {
+				_m.PlaceOfSupply = value.String
+			}
+		case invoice.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = value.String
+			}
+		case invoice.FieldSacCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sac_code", values[i])
+			} else if value.Valid {
+				_m.SacCode = value.String
+			}
+		case invoice.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				_m.Currency = value.String
+			}
+		case invoice.FieldDiscountAmount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field discount_amount", values[i])
+			} else if value.Valid {
+				_m.DiscountAmount = int(value.Int64)
+			}
+		case invoice.FieldTaxableAmount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field taxable_amount", values[i])
+			} else if value.Valid {
+				_m.TaxableAmount = int(value.Int64)
+			}
+		case invoice.FieldGstRate:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field gst_rate", values[i])
+			} else if value.Valid {
+				_m.GstRate = value.Float64
+			}
+		case invoice.FieldCgstAmount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field cgst_amount", values[i])
+			} else if value.Valid {
+				_m.CgstAmount = int(value.Int64)
+			}
+		case invoice.FieldSgstAmount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sgst_amount", values[i])
+			} else if value.Valid {
+				_m.SgstAmount = int(value.Int64)
+			}
+		case invoice.FieldIgstAmount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field igst_amount", values[i])
+			} else if value.Valid {
+				_m.IgstAmount = int(value.Int64)
+			}
+		case invoice.FieldTotalAmount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field total_amount", values[i])
+			} else if value.Valid {
+				_m.TotalAmount = int(value.Int64)
+			}
+		case invoice.FieldPdfStorageKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field pdf_storage_key", values[i])
+			} else if value.Valid {
+				_m.PdfStorageKey = new(string)
+				*_m.PdfStorageKey = value.String
+			}
+		case invoice.FieldIssuedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field issued_at", values[i])
+			} else if value.Valid {
+				_m.IssuedAt = value.Time
+			}
+		case invoice.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Invoice.
+// This includes values selected through modifiers, order, etc.
+func (_m *Invoice) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryOrder queries the "order" edge of the Invoice entity.
+func (_m *Invoice) QueryOrder() *PaymentOrderQuery {
+	return NewInvoiceClient(_m.config).QueryOrder(_m)
+}
+
+// Update returns a builder for updating this Invoice.
+// Note that you need to call Invoice.Unwrap() before calling this method if this Invoice
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Invoice) Update() *InvoiceUpdateOne {
+	return NewInvoiceClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Invoice entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Invoice) Unwrap() *Invoice {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("generated: Invoice is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Invoice) String() string {
+	var builder strings.Builder
+	builder.WriteString("Invoice(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("invoice_number=")
+	builder.WriteString(_m.InvoiceNumber)
+	builder.WriteString(", ")
+	builder.WriteString("financial_year=")
+	builder.WriteString(_m.FinancialYear)
+	builder.WriteString(", ")
+	builder.WriteString("sequence_number=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SequenceNumber))
+	builder.WriteString(", ")
+	builder.WriteString("order_id=")
+	builder.WriteString(_m.OrderID)
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(_m.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("seller_name=")
+	builder.WriteString(_m.SellerName)
+	builder.WriteString(", ")
+	builder.WriteString("seller_address=")
+	builder.WriteString(_m.SellerAddress)
+	builder.WriteString(", ")
+	builder.WriteString("seller_gstin=")
+	builder.WriteString(_m.SellerGstin)
+	builder.WriteString(", ")
+	if v := _m.BuyerName; v != nil {
+		builder.WriteString("buyer_name=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.BuyerEmail; v != nil {
+		builder.WriteString("buyer_email=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("place_of_supply=")
+	builder.WriteString(_m.PlaceOfSupply)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(_m.Description)
+	builder.WriteString(", ")
+	builder.WriteString("sac_code=")
+	builder.WriteString(_m.SacCode)
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(_m.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("discount_amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DiscountAmount))
+	builder.WriteString(", ")
+	builder.WriteString("taxable_amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TaxableAmount))
+	builder.WriteString(", ")
+	builder.WriteString("gst_rate=")
+	builder.WriteString(fmt.Sprintf("%v", _m.GstRate))
+	builder.WriteString(", ")
+	builder.WriteString("cgst_amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CgstAmount))
+	builder.WriteString(", ")
+	builder.WriteString("sgst_amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SgstAmount))
+	builder.WriteString(", ")
+	builder.WriteString("igst_amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IgstAmount))
+	builder.WriteString(", ")
+	builder.WriteString("total_amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TotalAmount))
+	builder.WriteString(", ")
+	if v := _m.PdfStorageKey; v != nil {
+		builder.WriteString("pdf_storage_key=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("issued_at=")
+	builder.WriteString(_m.IssuedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Invoices is a parsable slice of Invoice.
+type Invoices []*Invoice
@@ -0,0 +1,316 @@
+// Code generated by ent, DO NOT EDIT.
+
+package invoice
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the invoice type in the database.
+	Label = "invoice"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldInvoiceNumber holds the string denoting the invoice_number field in the database.
+	FieldInvoiceNumber = "invoice_number"
+	// FieldFinancialYear holds the string denoting the financial_year field in the database.
+	FieldFinancialYear = "financial_year"
+	// FieldSequenceNumber holds the string denoting the sequence_number field in the database.
+	FieldSequenceNumber = "sequence_number"
+	// FieldOrderID holds the string denoting the order_id field in the database.
+	FieldOrderID = "order_id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldSellerName holds the string denoting the seller_name field in the database.
+	FieldSellerName = "seller_name"
+	// FieldSellerAddress holds the string denoting the seller_address field in the database.
+	FieldSellerAddress = "seller_address"
+	// FieldSellerGstin holds the string denoting the seller_gstin field in the database.
+	FieldSellerGstin = "seller_gstin"
+	// FieldBuyerName holds the string denoting the buyer_name field in the database.
+	FieldBuyerName = "buyer_name"
+	// FieldBuyerEmail holds the string denoting the buyer_email field in the database.
+	FieldBuyerEmail = "buyer_email"
+	// FieldPlaceOfSupply holds the string denoting the place_of_supply field in the database.
+	FieldPlaceOfSupply = "place_of_supply"
+	// FieldDescription holds the string denoting the description field in the database.
+	FieldDescription = "description"
+	// FieldSacCode holds the string denoting the sac_code field in the database.
+	FieldSacCode = "sac_code"
+	// FieldCurrency holds the string denoting the currency field in the database.
+	FieldCurrency = "currency"
+	// FieldDiscountAmount holds the string denoting the discount_amount field in the database.
+	FieldDiscountAmount = "discount_amount"
+	// FieldTaxableAmount holds the string denoting the taxable_amount field in the database.
+	FieldTaxableAmount = "taxable_amount"
+	// FieldGstRate holds the string denoting the gst_rate field in the database.
+	FieldGstRate = "gst_rate"
+	// FieldCgstAmount holds the string denoting the cgst_amount field in the database.
+	FieldCgstAmount = "cgst_amount"
+	// FieldSgstAmount holds the string denoting the sgst_amount field in the database.
+	FieldSgstAmount = "sgst_amount"
+	// FieldIgstAmount holds the string denoting the igst_amount field in the database.
+	FieldIgstAmount = "igst_amount"
+	// FieldTotalAmount holds the string denoting the total_amount field in the database.
+	FieldTotalAmount = "total_amount"
+	// FieldPdfStorageKey holds the string denoting the pdf_storage_key field in the database.
+	FieldPdfStorageKey = "pdf_storage_key"
+	// FieldIssuedAt holds the string denoting the issued_at field in the database.
+	FieldIssuedAt = "issued_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeOrder holds the string denoting the order edge name in mutations.
+	EdgeOrder = "order"
+	// Table holds the table name of the invoice in the database.
+	Table = "invoices"
+	// OrderTable is the table that holds the order relation/edge.
+	OrderTable = "invoices"
+	// OrderInverseTable is the table name for the PaymentOrder entity.
+	// It exists in this package in order to avoid circular dependency with the "paymentorder" package.
+	OrderInverseTable = "payment_orders"
+	// OrderColumn is the table column denoting the order relation/edge.
+	OrderColumn = "order_id"
+)
+
+// Columns holds all SQL columns for invoice fields.
+var Columns = []string{
+	FieldID,
+	FieldInvoiceNumber,
+	FieldFinancialYear,
+	FieldSequenceNumber,
+	FieldOrderID,
+	FieldUserID,
+	FieldSellerName,
+	FieldSellerAddress,
+	FieldSellerGstin,
+	FieldBuyerName,
+	FieldBuyerEmail,
+	FieldPlaceOfSupply,
+	FieldDescription,
+	FieldSacCode,
+	FieldCurrency,
+	FieldDiscountAmount,
+	FieldTaxableAmount,
+	FieldGstRate,
+	FieldCgstAmount,
+	FieldSgstAmount,
+	FieldIgstAmount,
+	FieldTotalAmount,
+	FieldPdfStorageKey,
+	FieldIssuedAt,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// InvoiceNumberValidator is a validator for the "invoice_number" field. It is called by the builders before save.
+	InvoiceNumberValidator func(string) error
+	// FinancialYearValidator is a validator for the "financial_year" field. It is called by the builders before save.
+	FinancialYearValidator func(string) error
+	// SequenceNumberValidator is a validator for the "sequence_number" field. It is called by the builders before save.
+	SequenceNumberValidator func(int) error
+	// OrderIDValidator is a validator for the "order_id" field. It is called by the builders before save.
+	OrderIDValidator func(string) error
+	// UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	UserIDValidator func(string) error
+	// SellerNameValidator is a validator for the "seller_name" field. It is called by the builders before save.
+	SellerNameValidator func(string) error
+	// SellerAddressValidator is a validator for the "seller_address" field. It is called by the builders before save.
+	SellerAddressValidator func(string) error
+	// SellerGstinValidator is a validator for the "seller_gstin" field. It is called by the builders before save.
+	SellerGstinValidator func(string) error
+	// BuyerNameValidator is a validator for the "buyer_name" field. It is called by the builders before save.
+	BuyerNameValidator func(string) error
+	// BuyerEmailValidator is a validator for the "buyer_email" field. It is called by the builders before save.
+	BuyerEmailValidator func(string) error
+	// PlaceOfSupplyValidator is a validator for the "place_of_supply" field. It is called by the builders before save.
+	PlaceOfSupplyValidator func(string) error
+	// DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	DescriptionValidator func(string) error
+	// SacCodeValidator is a validator for the "sac_code" field. It is called by the builders before save.
+	SacCodeValidator func(string) error
+	// DefaultCurrency holds the default value on creation for the "currency" field.
+	DefaultCurrency string
+	// CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	CurrencyValidator func(string) error
+	// DefaultDiscountAmount holds the default value on creation for the "discount_amount" field.
+	DefaultDiscountAmount int
+	// DiscountAmountValidator is a validator for the "discount_amount" field. It is called by the builders before save.
+	DiscountAmountValidator func(int) error
+	// TaxableAmountValidator is a validator for the "taxable_amount" field. It is called by the builders before save.
+	TaxableAmountValidator func(int) error
+	// DefaultCgstAmount holds the default value on creation for the "cgst_amount" field.
+	DefaultCgstAmount int
+	// CgstAmountValidator is a validator for the "cgst_amount" field. It is called by the builders before save.
+	CgstAmountValidator func(int) error
+	// DefaultSgstAmount holds the default value on creation for the "sgst_amount" field.
+	DefaultSgstAmount int
+	// SgstAmountValidator is a validator for the "sgst_amount" field. It is called by the builders before save.
+	SgstAmountValidator func(int) error
+	// DefaultIgstAmount holds the default value on creation for the "igst_amount" field.
+	DefaultIgstAmount int
+	// IgstAmountValidator is a validator for the "igst_amount" field. It is called by the builders before save.
+	IgstAmountValidator func(int) error
+	// TotalAmountValidator is a validator for the "total_amount" field. It is called by the builders before save.
+	TotalAmountValidator func(int) error
+	// PdfStorageKeyValidator is a validator for the "pdf_storage_key" field. It is called by the builders before save.
+	PdfStorageKeyValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the Invoice queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByInvoiceNumber orders the results by the invoice_number field.
+func ByInvoiceNumber(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInvoiceNumber, opts...).ToFunc()
+}
+
+// ByFinancialYear orders the results by the financial_year field.
+func ByFinancialYear(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFinancialYear, opts...).ToFunc()
+}
+
+// BySequenceNumber orders the results by the sequence_number field.
+func BySequenceNumber(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSequenceNumber, opts...).ToFunc()
+}
+
+// ByOrderID orders the results by the order_id field.
+func ByOrderID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrderID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// BySellerName orders the results by the seller_name field.
+func BySellerName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSellerName, opts...).ToFunc()
+}
+
+// BySellerAddress orders the results by the seller_address field.
+func BySellerAddress(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSellerAddress, opts...).ToFunc()
+}
+
+// BySellerGstin orders the results by the seller_gstin field.
+func BySellerGstin(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSellerGstin, opts...).ToFunc()
+}
+
+// ByBuyerName orders the results by the buyer_name field.
+func ByBuyerName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBuyerName, opts...).ToFunc()
+}
+
+// ByBuyerEmail orders the results by the buyer_email field.
+func ByBuyerEmail(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBuyerEmail, opts...).ToFunc()
+}
+
+// ByPlaceOfSupply orders the results by the place_of_supply field.
+func ByPlaceOfSupply(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPlaceOfSupply, opts...).ToFunc()
+}
+
+// ByDescription orders the results by the description field.
+func ByDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDescription, opts...).ToFunc()
+}
+
+// BySacCode orders the results by the sac_code field.
+func BySacCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSacCode, opts...).ToFunc()
+}
+
+// ByCurrency orders the results by the currency field.
+func ByCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrency, opts...).ToFunc()
+}
+
+// ByDiscountAmount orders the results by the discount_amount field.
+func ByDiscountAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDiscountAmount, opts...).ToFunc()
+}
+
+// ByTaxableAmount orders the results by the taxable_amount field.
+func ByTaxableAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTaxableAmount, opts...).ToFunc()
+}
+
+// ByGstRate orders the results by the gst_rate field.
+func ByGstRate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGstRate, opts...).ToFunc()
+}
+
+// ByCgstAmount orders the results by the cgst_amount field.
+func ByCgstAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCgstAmount, opts...).ToFunc()
+}
+
+// BySgstAmount orders the results by the sgst_amount field.
+func BySgstAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSgstAmount, opts...).ToFunc()
+}
+
+// ByIgstAmount orders the results by the igst_amount field.
+func ByIgstAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIgstAmount, opts...).ToFunc()
+}
+
+// ByTotalAmount orders the results by the total_amount field.
+func ByTotalAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotalAmount, opts...).ToFunc()
+}
+
+// ByPdfStorageKey orders the results by the pdf_storage_key field.
+func ByPdfStorageKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPdfStorageKey, opts...).ToFunc()
+}
+
+// ByIssuedAt orders the results by the issued_at field.
+func ByIssuedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIssuedAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByOrderField orders the results by order field.
+func ByOrderField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOrderStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newOrderStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OrderInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, true, OrderTable, OrderColumn),
+	)
+}
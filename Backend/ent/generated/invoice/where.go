@@ -0,0 +1,1564 @@
+// Code generated by ent, DO NOT EDIT.
+
+package invoice
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/UnoraApp/be/ent/generated/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContainsFold(FieldID, id))
+}
+
+// InvoiceNumber applies equality check predicate on the "invoice_number" field. It's identical to InvoiceNumberEQ.
+func InvoiceNumber(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldInvoiceNumber, v))
+}
+
+// FinancialYear applies equality check predicate on the "financial_year" field. It's identical to FinancialYearEQ.
+func FinancialYear(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldFinancialYear, v))
+}
+
+// SequenceNumber applies equality check predicate on the "sequence_number" field. It's identical to SequenceNumberEQ.
+func SequenceNumber(v int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldSequenceNumber, v))
+}
+
+// OrderID applies equality check predicate on the "order_id" field. It's identical to OrderIDEQ.
+func OrderID(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldOrderID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldUserID, v))
+}
+
+// SellerName applies equality check predicate on the "seller_name" field. It's identical to SellerNameEQ.
+func SellerName(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldSellerName, v))
+}
+
+// SellerAddress applies equality check predicate on the "seller_address" field. It's identical to SellerAddressEQ.
+func SellerAddress(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldSellerAddress, v))
+}
+
+// SellerGstin applies equality check predicate on the "seller_gstin" field. It's identical to SellerGstinEQ.
+func SellerGstin(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldSellerGstin, v))
+}
+
+// BuyerName applies equality check predicate on the "buyer_name" field. It's identical to BuyerNameEQ.
+func BuyerName(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldBuyerName, v))
+}
+
+// BuyerEmail applies equality check predicate on the "buyer_email" field. It's identical to BuyerEmailEQ.
+func BuyerEmail(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldBuyerEmail, v))
+}
+
+// PlaceOfSupply applies equality check predicate on the "place_of_supply" field. It's identical to PlaceOfSupplyEQ.
+func PlaceOfSupply(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldPlaceOfSupply, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldDescription, v))
+}
+
+// SacCode applies equality check predicate on the "sac_code" field. It's identical to SacCodeEQ.
+func SacCode(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldSacCode, v))
+}
+
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldCurrency, v))
+}
+
+// DiscountAmount applies equality check predicate on the "discount_amount" field. It's identical to DiscountAmountEQ.
+func DiscountAmount(v int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldDiscountAmount, v))
+}
+
+// TaxableAmount applies equality check predicate on the "taxable_amount" field. It's identical to TaxableAmountEQ.
+func TaxableAmount(v int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldTaxableAmount, v))
+}
+
+// GstRate applies equality check predicate on the "gst_rate" field. It's identical to GstRateEQ.
+func GstRate(v float64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldGstRate, v))
+}
+
+// CgstAmount applies equality check predicate on the "cgst_amount" field. It's identical to CgstAmountEQ.
+func CgstAmount(v int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldCgstAmount, v))
+}
+
+// SgstAmount applies equality check predicate on the "sgst_amount" field. It's identical to SgstAmountEQ.
+func SgstAmount(v int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldSgstAmount, v))
+}
+
+// IgstAmount applies equality check predicate on the "igst_amount" field. It's identical to IgstAmountEQ.
+func IgstAmount(v int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldIgstAmount, v))
+}
+
+// TotalAmount applies equality check predicate on the "total_amount" field. It's identical to TotalAmountEQ.
+func TotalAmount(v int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldTotalAmount, v))
+}
+
+// PdfStorageKey applies equality check predicate on the "pdf_storage_key" field. It's identical to PdfStorageKeyEQ.
+func PdfStorageKey(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldPdfStorageKey, v))
+}
+
+// IssuedAt applies equality check predicate on the "issued_at" field. It's identical to IssuedAtEQ.
+func IssuedAt(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldIssuedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// InvoiceNumberEQ applies the EQ predicate on the "invoice_number" field.
+func InvoiceNumberEQ(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldInvoiceNumber, v))
+}
+
+// InvoiceNumberNEQ applies the NEQ predicate on the "invoice_number" field.
+func InvoiceNumberNEQ(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldInvoiceNumber, v))
+}
+
+// InvoiceNumberIn applies the In predicate on the "invoice_number" field.
+func InvoiceNumberIn(vs ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldInvoiceNumber, vs...))
+}
+
+// InvoiceNumberNotIn applies the NotIn predicate on the "invoice_number" field.
+func InvoiceNumberNotIn(vs ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldInvoiceNumber, vs...))
+}
+
+// InvoiceNumberGT applies the GT predicate on the "invoice_number" field.
+func InvoiceNumberGT(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldInvoiceNumber, v))
+}
+
+// InvoiceNumberGTE applies the GTE predicate on the "invoice_number" field.
+func InvoiceNumberGTE(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldInvoiceNumber, v))
+}
+
+// InvoiceNumberLT applies the LT predicate on the "invoice_number" field.
+func InvoiceNumberLT(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldInvoiceNumber, v))
+}
+
+// InvoiceNumberLTE applies the LTE predicate on the "invoice_number" field.
+func InvoiceNumberLTE(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldInvoiceNumber, v))
+}
+
+// InvoiceNumberContains applies the Contains predicate on the "invoice_number" field.
+func InvoiceNumberContains(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContains(FieldInvoiceNumber, v))
+}
+
+// InvoiceNumberHasPrefix applies the HasPrefix predicate on the "invoice_number" field.
+func InvoiceNumberHasPrefix(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldHasPrefix(FieldInvoiceNumber, v))
+}
+
+// InvoiceNumberHasSuffix applies the HasSuffix predicate on the "invoice_number" field.
+func InvoiceNumberHasSuffix(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldHasSuffix(FieldInvoiceNumber, v))
+}
+
+// InvoiceNumberEqualFold applies the EqualFold predicate on the "invoice_number" field.
+func InvoiceNumberEqualFold(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEqualFold(FieldInvoiceNumber, v))
+}
+
+// InvoiceNumberContainsFold applies the ContainsFold predicate on the "invoice_number" field.
+func InvoiceNumberContainsFold(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContainsFold(FieldInvoiceNumber, v))
+}
+
+// FinancialYearEQ applies the EQ predicate on the "financial_year" field.
+func FinancialYearEQ(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldFinancialYear, v))
+}
+
+// FinancialYearNEQ applies the NEQ predicate on the "financial_year" field.
+func FinancialYearNEQ(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldFinancialYear, v))
+}
+
+// FinancialYearIn applies the In predicate on the "financial_year" field.
+func FinancialYearIn(vs ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldFinancialYear, vs...))
+}
+
+// FinancialYearNotIn applies the NotIn predicate on the "financial_year" field.
+func FinancialYearNotIn(vs ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldFinancialYear, vs...))
+}
+
+// FinancialYearGT applies the GT predicate on the "financial_year" field.
+func FinancialYearGT(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldFinancialYear, v))
+}
+
+// FinancialYearGTE applies the GTE predicate on the "financial_year" field.
+func FinancialYearGTE(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldFinancialYear, v))
+}
+
+// FinancialYearLT applies the LT predicate on the "financial_year" field.
+func FinancialYearLT(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldFinancialYear, v))
+}
+
+// FinancialYearLTE applies the LTE predicate on the "financial_year" field.
+func FinancialYearLTE(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldFinancialYear, v))
+}
+
+// FinancialYearContains applies the Contains predicate on the "financial_year" field.
+func FinancialYearContains(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContains(FieldFinancialYear, v))
+}
+
+// FinancialYearHasPrefix applies the HasPrefix predicate on the "financial_year" field.
+func FinancialYearHasPrefix(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldHasPrefix(FieldFinancialYear, v))
+}
+
+// FinancialYearHasSuffix applies the HasSuffix predicate on the "financial_year" field.
+func FinancialYearHasSuffix(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldHasSuffix(FieldFinancialYear, v))
+}
+
+// FinancialYearEqualFold applies the EqualFold predicate on the "financial_year" field.
+func FinancialYearEqualFold(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEqualFold(FieldFinancialYear, v))
+}
+
+// FinancialYearContainsFold applies the ContainsFold predicate on the "financial_year" field.
+func FinancialYearContainsFold(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContainsFold(FieldFinancialYear, v))
+}
+
+// SequenceNumberEQ applies the EQ predicate on the "sequence_number" field.
+func SequenceNumberEQ(v int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldSequenceNumber, v))
+}
+
+// SequenceNumberNEQ applies the NEQ predicate on the "sequence_number" field.
+func SequenceNumberNEQ(v int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldSequenceNumber, v))
+}
+
+// SequenceNumberIn applies the In predicate on the "sequence_number" field.
+func SequenceNumberIn(vs ...int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldSequenceNumber, vs...))
+}
+
+// SequenceNumberNotIn applies the NotIn predicate on the "sequence_number" field.
+func SequenceNumberNotIn(vs ...int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldSequenceNumber, vs...))
+}
+
+// SequenceNumberGT applies the GT predicate on the "sequence_number" field.
+func SequenceNumberGT(v int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldSequenceNumber, v))
+}
+
+// SequenceNumberGTE applies the GTE predicate on the "sequence_number" field.
+func SequenceNumberGTE(v int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldSequenceNumber, v))
+}
+
+// SequenceNumberLT applies the LT predicate on the "sequence_number" field.
+func SequenceNumberLT(v int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldSequenceNumber, v))
+}
+
+// SequenceNumberLTE applies the LTE predicate on the "sequence_number" field.
+func SequenceNumberLTE(v int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldSequenceNumber, v))
+}
+
+// OrderIDEQ applies the EQ predicate on the "order_id" field.
+func OrderIDEQ(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldOrderID, v))
+}
+
+// OrderIDNEQ applies the NEQ predicate on the "order_id" field.
+func OrderIDNEQ(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldOrderID, v))
+}
+
+// OrderIDIn applies the In predicate on the "order_id" field.
+func OrderIDIn(vs ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldOrderID, vs...))
+}
+
+// OrderIDNotIn applies the NotIn predicate on the "order_id" field.
+func OrderIDNotIn(vs ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldOrderID, vs...))
+}
+
+// OrderIDGT applies the GT predicate on the "order_id" field.
+func OrderIDGT(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldOrderID, v))
+}
+
+// OrderIDGTE applies the GTE predicate on the "order_id" field.
+func OrderIDGTE(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldOrderID, v))
+}
+
+// OrderIDLT applies the LT predicate on the "order_id" field.
+func OrderIDLT(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldOrderID, v))
+}
+
+// OrderIDLTE applies the LTE predicate on the "order_id" field.
+func OrderIDLTE(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldOrderID, v))
+}
+
+// OrderIDContains applies the Contains predicate on the "order_id" field.
+func OrderIDContains(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContains(FieldOrderID, v))
+}
+
+// OrderIDHasPrefix applies the HasPrefix predicate on the "order_id" field.
+func OrderIDHasPrefix(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldHasPrefix(FieldOrderID, v))
+}
+
+// OrderIDHasSuffix applies the HasSuffix predicate on the "order_id" field.
+func OrderIDHasSuffix(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldHasSuffix(FieldOrderID, v))
+}
+
+// OrderIDEqualFold applies the EqualFold predicate on the "order_id" field.
+func OrderIDEqualFold(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEqualFold(FieldOrderID, v))
+}
+
+// OrderIDContainsFold applies the ContainsFold predicate on the "order_id" field.
+func OrderIDContainsFold(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContainsFold(FieldOrderID, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// SellerNameEQ applies the EQ predicate on the "seller_name" field.
+func SellerNameEQ(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldSellerName, v))
+}
+
+// SellerNameNEQ applies the NEQ predicate on the "seller_name" field.
+func SellerNameNEQ(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldSellerName, v))
+}
+
+// SellerNameIn applies the In predicate on the "seller_name" field.
+func SellerNameIn(vs ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldSellerName, vs...))
+}
+
+// SellerNameNotIn applies the NotIn predicate on the "seller_name" field.
+func SellerNameNotIn(vs ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldSellerName, vs...))
+}
+
+// SellerNameGT applies the GT predicate on the "seller_name" field.
+func SellerNameGT(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldSellerName, v))
+}
+
+// SellerNameGTE applies the GTE predicate on the "seller_name" field.
+func SellerNameGTE(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldSellerName, v))
+}
+
+// SellerNameLT applies the LT predicate on the "seller_name" field.
+func SellerNameLT(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldSellerName, v))
+}
+
+// SellerNameLTE applies the LTE predicate on the "seller_name" field.
+func SellerNameLTE(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldSellerName, v))
+}
+
+// SellerNameContains applies the Contains predicate on the "seller_name" field.
+func SellerNameContains(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContains(FieldSellerName, v))
+}
+
+// SellerNameHasPrefix applies the HasPrefix predicate on the "seller_name" field.
+func SellerNameHasPrefix(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldHasPrefix(FieldSellerName, v))
+}
+
+// SellerNameHasSuffix applies the HasSuffix predicate on the "seller_name" field.
+func SellerNameHasSuffix(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldHasSuffix(FieldSellerName, v))
+}
+
+// SellerNameEqualFold applies the EqualFold predicate on the "seller_name" field.
+func SellerNameEqualFold(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEqualFold(FieldSellerName, v))
+}
+
+// SellerNameContainsFold applies the ContainsFold predicate on the "seller_name" field.
+func SellerNameContainsFold(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContainsFold(FieldSellerName, v))
+}
+
+// SellerAddressEQ applies the EQ predicate on the "seller_address" field.
+func SellerAddressEQ(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldSellerAddress, v))
+}
+
+// SellerAddressNEQ applies the NEQ predicate on the "seller_address" field.
+func SellerAddressNEQ(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldSellerAddress, v))
+}
+
+// SellerAddressIn applies the In predicate on the "seller_address" field.
+func SellerAddressIn(vs ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldSellerAddress, vs...))
+}
+
+// SellerAddressNotIn applies the NotIn predicate on the "seller_address" field.
+func SellerAddressNotIn(vs ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldSellerAddress, vs...))
+}
+
+// SellerAddressGT applies the GT predicate on the "seller_address" field.
+func SellerAddressGT(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldSellerAddress, v))
+}
+
+// SellerAddressGTE applies the GTE predicate on the "seller_address" field.
+func SellerAddressGTE(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldSellerAddress, v))
+}
+
+// SellerAddressLT applies the LT predicate on the "seller_address" field.
+func SellerAddressLT(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldSellerAddress, v))
+}
+
+// SellerAddressLTE applies the LTE predicate on the "seller_address" field.
+func SellerAddressLTE(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldSellerAddress, v))
+}
+
+// SellerAddressContains applies the Contains predicate on the "seller_address" field.
+func SellerAddressContains(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContains(FieldSellerAddress, v))
+}
+
+// SellerAddressHasPrefix applies the HasPrefix predicate on the "seller_address" field.
+func SellerAddressHasPrefix(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldHasPrefix(FieldSellerAddress, v))
+}
+
+// SellerAddressHasSuffix applies the HasSuffix predicate on the "seller_address" field.
+func SellerAddressHasSuffix(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldHasSuffix(FieldSellerAddress, v))
+}
+
+// SellerAddressEqualFold applies the EqualFold predicate on the "seller_address" field.
+func SellerAddressEqualFold(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEqualFold(FieldSellerAddress, v))
+}
+
+// SellerAddressContainsFold applies the ContainsFold predicate on the "seller_address" field.
+func SellerAddressContainsFold(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContainsFold(FieldSellerAddress, v))
+}
+
+// SellerGstinEQ applies the EQ predicate on the "seller_gstin" field.
+func SellerGstinEQ(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldSellerGstin, v))
+}
+
+// SellerGstinNEQ applies the NEQ predicate on the "seller_gstin" field.
+func SellerGstinNEQ(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldSellerGstin, v))
+}
+
+// SellerGstinIn applies the In predicate on the "seller_gstin" field.
+func SellerGstinIn(vs ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldSellerGstin, vs...))
+}
+
+// SellerGstinNotIn applies the NotIn predicate on the "seller_gstin" field.
+func SellerGstinNotIn(vs ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldSellerGstin, vs...))
+}
+
+// SellerGstinGT applies the GT predicate on the "seller_gstin" field.
+func SellerGstinGT(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldSellerGstin, v))
+}
+
+// SellerGstinGTE applies the GTE predicate on the "seller_gstin" field.
+func SellerGstinGTE(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldSellerGstin, v))
+}
+
+// SellerGstinLT applies the LT predicate on the "seller_gstin" field.
+func SellerGstinLT(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldSellerGstin, v))
+}
+
+// SellerGstinLTE applies the LTE predicate on the "seller_gstin" field.
+func SellerGstinLTE(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldSellerGstin, v))
+}
+
+// SellerGstinContains applies the Contains predicate on the "seller_gstin" field.
+func SellerGstinContains(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContains(FieldSellerGstin, v))
+}
+
+// SellerGstinHasPrefix applies the HasPrefix predicate on the "seller_gstin" field.
+func SellerGstinHasPrefix(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldHasPrefix(FieldSellerGstin, v))
+}
+
+// SellerGstinHasSuffix applies the HasSuffix predicate on the "seller_gstin" field.
+func SellerGstinHasSuffix(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldHasSuffix(FieldSellerGstin, v))
+}
+
+// SellerGstinEqualFold applies the EqualFold predicate on the "seller_gstin" field.
+func SellerGstinEqualFold(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEqualFold(FieldSellerGstin, v))
+}
+
+// SellerGstinContainsFold applies the ContainsFold predicate on the "seller_gstin" field.
+func SellerGstinContainsFold(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContainsFold(FieldSellerGstin, v))
+}
+
+// BuyerNameEQ applies the EQ predicate on the "buyer_name" field.
+func BuyerNameEQ(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldBuyerName, v))
+}
+
+// BuyerNameNEQ applies the NEQ predicate on the "buyer_name" field.
+func BuyerNameNEQ(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldBuyerName, v))
+}
+
+// BuyerNameIn applies the In predicate on the "buyer_name" field.
+func BuyerNameIn(vs ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldBuyerName, vs...))
+}
+
+// BuyerNameNotIn applies the NotIn predicate on the "buyer_name" field.
+func BuyerNameNotIn(vs ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldBuyerName, vs...))
+}
+
+// BuyerNameGT applies the GT predicate on the "buyer_name" field.
+func BuyerNameGT(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldBuyerName, v))
+}
+
+// BuyerNameGTE applies the GTE predicate on the "buyer_name" field.
+func BuyerNameGTE(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldBuyerName, v))
+}
+
+// BuyerNameLT applies the LT predicate on the "buyer_name" field.
+func BuyerNameLT(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldBuyerName, v))
+}
+
+// BuyerNameLTE applies the LTE predicate on the "buyer_name" field.
+func BuyerNameLTE(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldBuyerName, v))
+}
+
+// BuyerNameContains applies the Contains predicate on the "buyer_name" field.
+func BuyerNameContains(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContains(FieldBuyerName, v))
+}
+
+// BuyerNameHasPrefix applies the HasPrefix predicate on the "buyer_name" field.
+func BuyerNameHasPrefix(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldHasPrefix(FieldBuyerName, v))
+}
+
+// BuyerNameHasSuffix applies the HasSuffix predicate on the "buyer_name" field.
+func BuyerNameHasSuffix(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldHasSuffix(FieldBuyerName, v))
+}
+
+// BuyerNameIsNil applies the IsNil predicate on the "buyer_name" field.
+func BuyerNameIsNil() predicate.Invoice {
+	return predicate.Invoice(sql.FieldIsNull(FieldBuyerName))
+}
+
+// BuyerNameNotNil applies the NotNil predicate on the "buyer_name" field.
+func BuyerNameNotNil() predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotNull(FieldBuyerName))
+}
+
+// BuyerNameEqualFold applies the EqualFold predicate on the "buyer_name" field.
+func BuyerNameEqualFold(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEqualFold(FieldBuyerName, v))
+}
+
+// BuyerNameContainsFold applies the ContainsFold predicate on the "buyer_name" field.
+func BuyerNameContainsFold(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContainsFold(FieldBuyerName, v))
+}
+
+// BuyerEmailEQ applies the EQ predicate on the "buyer_email" field.
+func BuyerEmailEQ(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldBuyerEmail, v))
+}
+
+// BuyerEmailNEQ applies the NEQ predicate on the "buyer_email" field.
+func BuyerEmailNEQ(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldBuyerEmail, v))
+}
+
+// BuyerEmailIn applies the In predicate on the "buyer_email" field.
+func BuyerEmailIn(vs ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldBuyerEmail, vs...))
+}
+
+// BuyerEmailNotIn applies the NotIn predicate on the "buyer_email" field.
+func BuyerEmailNotIn(vs ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldBuyerEmail, vs...))
+}
+
+// BuyerEmailGT applies the GT predicate on the "buyer_email" field.
+func BuyerEmailGT(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldBuyerEmail, v))
+}
+
+// BuyerEmailGTE applies the GTE predicate on the "buyer_email" field.
+func BuyerEmailGTE(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldBuyerEmail, v))
+}
+
+// BuyerEmailLT applies the LT predicate on the "buyer_email" field.
+func BuyerEmailLT(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldBuyerEmail, v))
+}
+
+// BuyerEmailLTE applies the LTE predicate on the "buyer_email" field.
+func BuyerEmailLTE(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldBuyerEmail, v))
+}
+
+// BuyerEmailContains applies the Contains predicate on the "buyer_email" field.
+func BuyerEmailContains(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContains(FieldBuyerEmail, v))
+}
+
+// BuyerEmailHasPrefix applies the HasPrefix predicate on the "buyer_email" field.
+func BuyerEmailHasPrefix(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldHasPrefix(FieldBuyerEmail, v))
+}
+
+// BuyerEmailHasSuffix applies the HasSuffix predicate on the "buyer_email" field.
+func BuyerEmailHasSuffix(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldHasSuffix(FieldBuyerEmail, v))
+}
+
+// BuyerEmailIsNil applies the IsNil predicate on the "buyer_email" field.
+func BuyerEmailIsNil() predicate.Invoice {
+	return predicate.Invoice(sql.FieldIsNull(FieldBuyerEmail))
+}
+
+// BuyerEmailNotNil applies the NotNil predicate on the "buyer_email" field.
+func BuyerEmailNotNil() predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotNull(FieldBuyerEmail))
+}
+
+// BuyerEmailEqualFold applies the EqualFold predicate on the "buyer_email" field.
+func BuyerEmailEqualFold(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEqualFold(FieldBuyerEmail, v))
+}
+
+// BuyerEmailContainsFold applies the ContainsFold predicate on the "buyer_email" field.
+func BuyerEmailContainsFold(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContainsFold(FieldBuyerEmail, v))
+}
+
+// PlaceOfSupplyEQ applies the EQ predicate on the "place_of_supply" field.
+func PlaceOfSupplyEQ(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldPlaceOfSupply, v))
+}
+
+// PlaceOfSupplyNEQ applies the NEQ predicate on the "place_of_supply" field.
+func PlaceOfSupplyNEQ(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldPlaceOfSupply, v))
+}
+
+// PlaceOfSupplyIn applies the In predicate on the "place_of_supply" field.
+func PlaceOfSupplyIn(vs ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldPlaceOfSupply, vs...))
+}
+
+// PlaceOfSupplyNotIn applies the NotIn predicate on the "place_of_supply" field.
+func PlaceOfSupplyNotIn(vs ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldPlaceOfSupply, vs...))
+}
+
+// PlaceOfSupplyGT applies the GT predicate on the "place_of_supply" field.
+func PlaceOfSupplyGT(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldPlaceOfSupply, v))
+}
+
+// PlaceOfSupplyGTE applies the GTE predicate on the "place_of_supply" field.
+func PlaceOfSupplyGTE(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldPlaceOfSupply, v))
+}
+
+// PlaceOfSupplyLT applies the LT predicate on the "place_of_supply" field.
+func PlaceOfSupplyLT(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldPlaceOfSupply, v))
+}
+
+// PlaceOfSupplyLTE applies the LTE predicate on the "place_of_supply" field.
+func PlaceOfSupplyLTE(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldPlaceOfSupply, v))
+}
+
+// PlaceOfSupplyContains applies the Contains predicate on the "place_of_supply" field.
+func PlaceOfSupplyContains(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContains(FieldPlaceOfSupply, v))
+}
+
+// PlaceOfSupplyHasPrefix applies the HasPrefix predicate on the "place_of_supply" field.
+func PlaceOfSupplyHasPrefix(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldHasPrefix(FieldPlaceOfSupply, v))
+}
+
+// PlaceOfSupplyHasSuffix applies the HasSuffix predicate on the "place_of_supply" field.
+func PlaceOfSupplyHasSuffix(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldHasSuffix(FieldPlaceOfSupply, v))
+}
+
+// PlaceOfSupplyEqualFold applies the EqualFold predicate on the "place_of_supply" field.
+func PlaceOfSupplyEqualFold(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEqualFold(FieldPlaceOfSupply, v))
+}
+
+// PlaceOfSupplyContainsFold applies the ContainsFold predicate on the "place_of_supply" field.
+func PlaceOfSupplyContainsFold(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContainsFold(FieldPlaceOfSupply, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// SacCodeEQ applies the EQ predicate on the "sac_code" field.
+func SacCodeEQ(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldSacCode, v))
+}
+
+// SacCodeNEQ applies the NEQ predicate on the "sac_code" field.
+func SacCodeNEQ(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldSacCode, v))
+}
+
+// SacCodeIn applies the In predicate on the "sac_code" field.
+func SacCodeIn(vs ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldSacCode, vs...))
+}
+
+// SacCodeNotIn applies the NotIn predicate on the "sac_code" field.
+func SacCodeNotIn(vs ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldSacCode, vs...))
+}
+
+// SacCodeGT applies the GT predicate on the "sac_code" field.
+func SacCodeGT(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldSacCode, v))
+}
+
+// SacCodeGTE applies the GTE predicate on the "sac_code" field.
+func SacCodeGTE(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldSacCode, v))
+}
+
+// SacCodeLT applies the LT predicate on the "sac_code" field.
+func SacCodeLT(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldSacCode, v))
+}
+
+// SacCodeLTE applies the LTE predicate on the "sac_code" field.
+func SacCodeLTE(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldSacCode, v))
+}
+
+// SacCodeContains applies the Contains predicate on the "sac_code" field.
+func SacCodeContains(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContains(FieldSacCode, v))
+}
+
+// SacCodeHasPrefix applies the HasPrefix predicate on the "sac_code" field.
+func SacCodeHasPrefix(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldHasPrefix(FieldSacCode, v))
+}
+
+// SacCodeHasSuffix applies the HasSuffix predicate on the "sac_code" field.
+func SacCodeHasSuffix(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldHasSuffix(FieldSacCode, v))
+}
+
+// SacCodeEqualFold applies the EqualFold predicate on the "sac_code" field.
+func SacCodeEqualFold(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEqualFold(FieldSacCode, v))
+}
+
+// SacCodeContainsFold applies the ContainsFold predicate on the "sac_code" field.
+func SacCodeContainsFold(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContainsFold(FieldSacCode, v))
+}
+
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldCurrency, v))
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldCurrency, vs...))
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldCurrency, vs...))
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldCurrency, v))
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldCurrency, v))
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldCurrency, v))
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldCurrency, v))
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContains(FieldCurrency, v))
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldHasPrefix(FieldCurrency, v))
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldHasSuffix(FieldCurrency, v))
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEqualFold(FieldCurrency, v))
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContainsFold(FieldCurrency, v))
+}
+
+// DiscountAmountEQ applies the EQ predicate on the "discount_amount" field.
+func DiscountAmountEQ(v int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldDiscountAmount, v))
+}
+
+// DiscountAmountNEQ applies the NEQ predicate on the "discount_amount" field.
+func DiscountAmountNEQ(v int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldDiscountAmount, v))
+}
+
+// DiscountAmountIn applies the In predicate on the "discount_amount" field.
+func DiscountAmountIn(vs ...int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldDiscountAmount, vs...))
+}
+
+// DiscountAmountNotIn applies the NotIn predicate on the "discount_amount" field.
+func DiscountAmountNotIn(vs ...int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldDiscountAmount, vs...))
+}
+
+// DiscountAmountGT applies the GT predicate on the "discount_amount" field.
+func DiscountAmountGT(v int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldDiscountAmount, v))
+}
+
+// DiscountAmountGTE applies the GTE predicate on the "discount_amount" field.
+func DiscountAmountGTE(v int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldDiscountAmount, v))
+}
+
+// DiscountAmountLT applies the LT predicate on the "discount_amount" field.
+func DiscountAmountLT(v int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldDiscountAmount, v))
+}
+
+// DiscountAmountLTE applies the LTE predicate on the "discount_amount" field.
+func DiscountAmountLTE(v int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldDiscountAmount, v))
+}
+
+// TaxableAmountEQ applies the EQ predicate on the "taxable_amount" field.
+func TaxableAmountEQ(v int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldTaxableAmount, v))
+}
+
+// TaxableAmountNEQ applies the NEQ predicate on the "taxable_amount" field.
+func TaxableAmountNEQ(v int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldTaxableAmount, v))
+}
+
+// TaxableAmountIn applies the In predicate on the "taxable_amount" field.
+func TaxableAmountIn(vs ...int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldTaxableAmount, vs...))
+}
+
+// TaxableAmountNotIn applies the NotIn predicate on the "taxable_amount" field.
+func TaxableAmountNotIn(vs ...int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldTaxableAmount, vs...))
+}
+
+// TaxableAmountGT applies the GT predicate on the "taxable_amount" field.
+func TaxableAmountGT(v int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldTaxableAmount, v))
+}
+
+// TaxableAmountGTE applies the GTE predicate on the "taxable_amount" field.
+func TaxableAmountGTE(v int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldTaxableAmount, v))
+}
+
+// TaxableAmountLT applies the LT predicate on the "taxable_amount" field.
+func TaxableAmountLT(v int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldTaxableAmount, v))
+}
+
+// TaxableAmountLTE applies the LTE predicate on the "taxable_amount" field.
+func TaxableAmountLTE(v int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldTaxableAmount, v))
+}
+
+// GstRateEQ applies the EQ predicate on the "gst_rate" field.
+func GstRateEQ(v float64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldGstRate, v))
+}
+
+// GstRateNEQ applies the NEQ predicate on the "gst_rate" field.
+func GstRateNEQ(v float64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldGstRate, v))
+}
+
+// GstRateIn applies the In predicate on the "gst_rate" field.
+func GstRateIn(vs ...float64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldGstRate, vs...))
+}
+
+// GstRateNotIn applies the NotIn predicate on the "gst_rate" field.
+func GstRateNotIn(vs ...float64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldGstRate, vs...))
+}
+
+// GstRateGT applies the GT predicate on the "gst_rate" field.
+func GstRateGT(v float64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldGstRate, v))
+}
+
+// GstRateGTE applies the GTE predicate on the "gst_rate" field.
+func GstRateGTE(v float64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldGstRate, v))
+}
+
+// GstRateLT applies the LT predicate on the "gst_rate" field.
+func GstRateLT(v float64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldGstRate, v))
+}
+
+// GstRateLTE applies the LTE predicate on the "gst_rate" field.
+func GstRateLTE(v float64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldGstRate, v))
+}
+
+// CgstAmountEQ applies the EQ predicate on the "cgst_amount" field.
+func CgstAmountEQ(v int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldCgstAmount, v))
+}
+
+// CgstAmountNEQ applies the NEQ predicate on the "cgst_amount" field.
+func CgstAmountNEQ(v int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldCgstAmount, v))
+}
+
+// CgstAmountIn applies the In predicate on the "cgst_amount" field.
+func CgstAmountIn(vs ...int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldCgstAmount, vs...))
+}
+
+// CgstAmountNotIn applies the NotIn predicate on the "cgst_amount" field.
+func CgstAmountNotIn(vs ...int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldCgstAmount, vs...))
+}
+
+// CgstAmountGT applies the GT predicate on the "cgst_amount" field.
+func CgstAmountGT(v int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldCgstAmount, v))
+}
+
+// CgstAmountGTE applies the GTE predicate on the "cgst_amount" field.
+func CgstAmountGTE(v int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldCgstAmount, v))
+}
+
+// CgstAmountLT applies the LT predicate on the "cgst_amount" field.
+func CgstAmountLT(v int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldCgstAmount, v))
+}
+
+// CgstAmountLTE applies the LTE predicate on the "cgst_amount" field.
+func CgstAmountLTE(v int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldCgstAmount, v))
+}
+
+// SgstAmountEQ applies the EQ predicate on the "sgst_amount" field.
+func SgstAmountEQ(v int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldSgstAmount, v))
+}
+
+// SgstAmountNEQ applies the NEQ predicate on the "sgst_amount" field.
+func SgstAmountNEQ(v int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldSgstAmount, v))
+}
+
+// SgstAmountIn applies the In predicate on the "sgst_amount" field.
+func SgstAmountIn(vs ...int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldSgstAmount, vs...))
+}
+
+// SgstAmountNotIn applies the NotIn predicate on the "sgst_amount" field.
+func SgstAmountNotIn(vs ...int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldSgstAmount, vs...))
+}
+
+// SgstAmountGT applies the GT predicate on the "sgst_amount" field.
+func SgstAmountGT(v int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldSgstAmount, v))
+}
+
+// SgstAmountGTE applies the GTE predicate on the "sgst_amount" field.
+func SgstAmountGTE(v int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldSgstAmount, v))
+}
+
+// SgstAmountLT applies the LT predicate on the "sgst_amount" field.
+func SgstAmountLT(v int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldSgstAmount, v))
+}
+
+// SgstAmountLTE applies the LTE predicate on the "sgst_amount" field.
+func SgstAmountLTE(v int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldSgstAmount, v))
+}
+
+// IgstAmountEQ applies the EQ predicate on the "igst_amount" field.
+func IgstAmountEQ(v int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldIgstAmount, v))
+}
+
+// IgstAmountNEQ applies the NEQ predicate on the "igst_amount" field.
+func IgstAmountNEQ(v int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldIgstAmount, v))
+}
+
+// IgstAmountIn applies the In predicate on the "igst_amount" field.
+func IgstAmountIn(vs ...int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldIgstAmount, vs...))
+}
+
+// IgstAmountNotIn applies the NotIn predicate on the "igst_amount" field.
+func IgstAmountNotIn(vs ...int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldIgstAmount, vs...))
+}
+
+// IgstAmountGT applies the GT predicate on the "igst_amount" field.
+func IgstAmountGT(v int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldIgstAmount, v))
+}
+
+// IgstAmountGTE applies the GTE predicate on the "igst_amount" field.
+func IgstAmountGTE(v int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldIgstAmount, v))
+}
+
+// IgstAmountLT applies the LT predicate on the "igst_amount" field.
+func IgstAmountLT(v int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldIgstAmount, v))
+}
+
+// IgstAmountLTE applies the LTE predicate on the "igst_amount" field.
+func IgstAmountLTE(v int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldIgstAmount, v))
+}
+
+// TotalAmountEQ applies the EQ predicate on the "total_amount" field.
+func TotalAmountEQ(v int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldTotalAmount, v))
+}
+
+// TotalAmountNEQ applies the NEQ predicate on the "total_amount" field.
+func TotalAmountNEQ(v int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldTotalAmount, v))
+}
+
+// TotalAmountIn applies the In predicate on the "total_amount" field.
+func TotalAmountIn(vs ...int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldTotalAmount, vs...))
+}
+
+// TotalAmountNotIn applies the NotIn predicate on the "total_amount" field.
+func TotalAmountNotIn(vs ...int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldTotalAmount, vs...))
+}
+
+// TotalAmountGT applies the GT predicate on the "total_amount" field.
+func TotalAmountGT(v int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldTotalAmount, v))
+}
+
+// TotalAmountGTE applies the GTE predicate on the "total_amount" field.
+func TotalAmountGTE(v int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldTotalAmount, v))
+}
+
+// TotalAmountLT applies the LT predicate on the "total_amount" field.
+func TotalAmountLT(v int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldTotalAmount, v))
+}
+
+// TotalAmountLTE applies the LTE predicate on the "total_amount" field.
+func TotalAmountLTE(v int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldTotalAmount, v))
+}
+
+// PdfStorageKeyEQ applies the EQ predicate on the "pdf_storage_key" field.
+func PdfStorageKeyEQ(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldPdfStorageKey, v))
+}
+
+// PdfStorageKeyNEQ applies the NEQ predicate on the "pdf_storage_key" field.
+func PdfStorageKeyNEQ(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldPdfStorageKey, v))
+}
+
+// PdfStorageKeyIn applies the In predicate on the "pdf_storage_key" field.
+func PdfStorageKeyIn(vs ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldPdfStorageKey, vs...))
+}
+
+// PdfStorageKeyNotIn applies the NotIn predicate on the "pdf_storage_key" field.
+func PdfStorageKeyNotIn(vs ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldPdfStorageKey, vs...))
+}
+
+// PdfStorageKeyGT applies the GT predicate on the "pdf_storage_key" field.
+func PdfStorageKeyGT(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldPdfStorageKey, v))
+}
+
+// PdfStorageKeyGTE applies the GTE predicate on the "pdf_storage_key" field.
+func PdfStorageKeyGTE(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldPdfStorageKey, v))
+}
+
+// PdfStorageKeyLT applies the LT predicate on the "pdf_storage_key" field.
+func PdfStorageKeyLT(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldPdfStorageKey, v))
+}
+
+// PdfStorageKeyLTE applies the LTE predicate on the "pdf_storage_key" field.
+func PdfStorageKeyLTE(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldPdfStorageKey, v))
+}
+
+// PdfStorageKeyContains applies the Contains predicate on the "pdf_storage_key" field.
+func PdfStorageKeyContains(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContains(FieldPdfStorageKey, v))
+}
+
+// PdfStorageKeyHasPrefix applies the HasPrefix predicate on the "pdf_storage_key" field.
+func PdfStorageKeyHasPrefix(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldHasPrefix(FieldPdfStorageKey, v))
+}
+
+// PdfStorageKeyHasSuffix applies the HasSuffix predicate on the "pdf_storage_key" field.
+func PdfStorageKeyHasSuffix(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldHasSuffix(FieldPdfStorageKey, v))
+}
+
+// PdfStorageKeyIsNil applies the IsNil predicate on the "pdf_storage_key" field.
+func PdfStorageKeyIsNil() predicate.Invoice {
+	return predicate.Invoice(sql.FieldIsNull(FieldPdfStorageKey))
+}
+
+// PdfStorageKeyNotNil applies the NotNil predicate on the "pdf_storage_key" field.
+func PdfStorageKeyNotNil() predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotNull(FieldPdfStorageKey))
+}
+
+// PdfStorageKeyEqualFold applies the EqualFold predicate on the "pdf_storage_key" field.
+func PdfStorageKeyEqualFold(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEqualFold(FieldPdfStorageKey, v))
+}
+
+// PdfStorageKeyContainsFold applies the ContainsFold predicate on the "pdf_storage_key" field.
+func PdfStorageKeyContainsFold(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContainsFold(FieldPdfStorageKey, v))
+}
+
+// IssuedAtEQ applies the EQ predicate on the "issued_at" field.
+func IssuedAtEQ(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldIssuedAt, v))
+}
+
+// IssuedAtNEQ applies the NEQ predicate on the "issued_at" field.
+func IssuedAtNEQ(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldIssuedAt, v))
+}
+
+// IssuedAtIn applies the In predicate on the "issued_at" field.
+func IssuedAtIn(vs ...time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldIssuedAt, vs...))
+}
+
+// IssuedAtNotIn applies the NotIn predicate on the "issued_at" field.
+func IssuedAtNotIn(vs ...time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldIssuedAt, vs...))
+}
+
+// IssuedAtGT applies the GT predicate on the "issued_at" field.
+func IssuedAtGT(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldIssuedAt, v))
+}
+
+// IssuedAtGTE applies the GTE predicate on the "issued_at" field.
+func IssuedAtGTE(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldIssuedAt, v))
+}
+
+// IssuedAtLT applies the LT predicate on the "issued_at" field.
+func IssuedAtLT(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldIssuedAt, v))
+}
+
+// IssuedAtLTE applies the LTE predicate on the "issued_at" field.
+func IssuedAtLTE(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldIssuedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasOrder applies the HasEdge predicate on the "order" edge.
+func HasOrder() predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, OrderTable, OrderColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOrderWith applies the HasEdge predicate on the "order" edge with a given conditions (other predicates).
+func HasOrderWith(preds ...predicate.PaymentOrder) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		step := newOrderStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Invoice) predicate.Invoice {
+	return predicate.Invoice(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Invoice) predicate.Invoice {
+	return predicate.Invoice(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Invoice) predicate.Invoice {
+	return predicate.Invoice(sql.NotPredicates(p))
+}
@@ -0,0 +1,1172 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/UnoraApp/be/ent/generated/invoice"
+	"github.com/UnoraApp/be/ent/generated/paymentorder"
+)
+
+// InvoiceCreate is the builder for creating a Invoice entity.
+type InvoiceCreate struct {
+	config
+	mutation *InvoiceMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetInvoiceNumber sets the "invoice_number" field.
+func (_c *InvoiceCreate) SetInvoiceNumber(v string) *InvoiceCreate {
+	_c.mutation.SetInvoiceNumber(v)
+	return _c
+}
+
+// SetFinancialYear sets the "financial_year" field.
+func (_c *InvoiceCreate) SetFinancialYear(v string) *InvoiceCreate {
+	_c.mutation.SetFinancialYear(v)
+	return _c
+}
+
+// SetSequenceNumber sets the "sequence_number" field.
+func (_c *InvoiceCreate) SetSequenceNumber(v int) *InvoiceCreate {
+	_c.mutation.SetSequenceNumber(v)
+	return _c
+}
+
+// SetOrderID sets the "order_id" field.
+func (_c *InvoiceCreate) SetOrderID(v string) *InvoiceCreate {
+	_c.mutation.SetOrderID(v)
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *InvoiceCreate) SetUserID(v string) *InvoiceCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetSellerName sets the "seller_name" field.
+func (_c *InvoiceCreate) SetSellerName(v string) *InvoiceCreate {
+	_c.mutation.SetSellerName(v)
+	return _c
+}
+
+// SetSellerAddress sets the "seller_address" field.
+func (_c *InvoiceCreate) SetSellerAddress(v string) *InvoiceCreate {
+	_c.mutation.SetSellerAddress(v)
+	return _c
+}
+
+// SetSellerGstin sets the "seller_gstin" field.
+func (_c *InvoiceCreate) SetSellerGstin(v string) *InvoiceCreate {
+	_c.mutation.SetSellerGstin(v)
+	return _c
+}
+
+// SetBuyerName sets the "buyer_name" field.
+func (_c *InvoiceCreate) SetBuyerName(v string) *InvoiceCreate {
+	_c.mutation.SetBuyerName(v)
+	return _c
+}
+
+// SetNillableBuyerName sets the "buyer_name" field if the given value is not nil.
+func (_c *InvoiceCreate) SetNillableBuyerName(v *string) *InvoiceCreate {
+	if v != nil {
+		_c.SetBuyerName(*v)
+	}
+	return _c
+}
+
+// SetBuyerEmail sets the "buyer_email" field.
+func (_c *InvoiceCreate) SetBuyerEmail(v string) *InvoiceCreate {
+	_c.mutation.SetBuyerEmail(v)
+	return _c
+}
+
+// SetNillableBuyerEmail sets the "buyer_email" field if the given value is not nil.
+func (_c *InvoiceCreate) SetNillableBuyerEmail(v *string) *InvoiceCreate {
+	if v != nil {
+		_c.SetBuyerEmail(*v)
+	}
+	return _c
+}
+
+// SetPlaceOfSupply sets the "place_of_supply" field.
+func (_c *InvoiceCreate) SetPlaceOfSupply(v string) *InvoiceCreate {
+	_c.mutation.SetPlaceOfSupply(v)
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *InvoiceCreate) SetDescription(v string) *InvoiceCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetSacCode sets the "sac_code" field.
+func (_c *InvoiceCreate) SetSacCode(v string) *InvoiceCreate {
+	_c.mutation.SetSacCode(v)
+	return _c
+}
+
+// SetCurrency sets the "currency" field.
+func (_c *InvoiceCreate) SetCurrency(v string) *InvoiceCreate {
+	_c.mutation.SetCurrency(v)
+	return _c
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_c *InvoiceCreate) SetNillableCurrency(v *string) *InvoiceCreate {
+	if v != nil {
+		_c.SetCurrency(*v)
+	}
+	return _c
+}
+
+// SetDiscountAmount sets the "discount_amount" field.
+func (_c *InvoiceCreate) SetDiscountAmount(v int) *InvoiceCreate {
+	_c.mutation.SetDiscountAmount(v)
+	return _c
+}
+
+// SetNillableDiscountAmount sets the "discount_amount" field if the given value is not nil.
+func (_c *InvoiceCreate) SetNillableDiscountAmount(v *int) *InvoiceCreate {
+	if v != nil {
+		_c.SetDiscountAmount(*v)
+	}
+	return _c
+}
+
+// SetTaxableAmount sets the "taxable_amount" field.
+func (_c *InvoiceCreate) SetTaxableAmount(v int) *InvoiceCreate {
+	_c.mutation.SetTaxableAmount(v)
+	return _c
+}
+
+// SetGstRate sets the "gst_rate" field.
+func (_c *InvoiceCreate) SetGstRate(v float64) *InvoiceCreate {
+	_c.mutation.SetGstRate(v)
+	return _c
+}
+
+// SetCgstAmount sets the "cgst_amount" field.
+func (_c *InvoiceCreate) SetCgstAmount(v int) *InvoiceCreate {
+	_c.mutation.SetCgstAmount(v)
+	return _c
+}
+
+// SetNillableCgstAmount sets the "cgst_amount" field if the given value is not nil.
+func (_c *InvoiceCreate) SetNillableCgstAmount(v *int) *InvoiceCreate {
+	if v != nil {
+		_c.SetCgstAmount(*v)
+	}
+	return _c
+}
+
+// SetSgstAmount sets the "sgst_amount" field.
+func (_c *InvoiceCreate) SetSgstAmount(v int) *InvoiceCreate {
+	_c.mutation.SetSgstAmount(v)
+	return _c
+}
+
+// SetNillableSgstAmount sets the "sgst_amount" field if the given value is not nil.
+func (_c *InvoiceCreate) SetNillableSgstAmount(v *int) *InvoiceCreate {
+	if v != nil {
+		_c.SetSgstAmount(*v)
+	}
+	return _c
+}
+
+// SetIgstAmount sets the "igst_amount" field.
+func (_c *InvoiceCreate) SetIgstAmount(v int) *InvoiceCreate {
+	_c.mutation.SetIgstAmount(v)
+	return _c
+}
+
+// SetNillableIgstAmount sets the "igst_amount" field if the given value is not nil.
+func (_c *InvoiceCreate) SetNillableIgstAmount(v *int) *InvoiceCreate {
+	if v != nil {
+		_c.SetIgstAmount(*v)
+	}
+	return _c
+}
+
+// SetTotalAmount sets the "total_amount" field.
+func (_c *InvoiceCreate) SetTotalAmount(v int) *InvoiceCreate {
+	_c.mutation.SetTotalAmount(v)
+	return _c
+}
+
+// SetPdfStorageKey sets the "pdf_storage_key" field.
+func (_c *InvoiceCreate) SetPdfStorageKey(v string) *InvoiceCreate {
+	_c.mutation.SetPdfStorageKey(v)
+	return _c
+}
+
+// SetNillablePdfStorageKey sets the "pdf_storage_key" field if the given value is not nil.
+func (_c *InvoiceCreate) SetNillablePdfStorageKey(v *string) *InvoiceCreate {
+	if v != nil {
+		_c.SetPdfStorageKey(*v)
+	}
+	return _c
+}
+
+// SetIssuedAt sets the "issued_at" field.
+func (_c *InvoiceCreate) SetIssuedAt(v time.Time) *InvoiceCreate {
+	_c.mutation.SetIssuedAt(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *InvoiceCreate) SetCreatedAt(v time.Time) *InvoiceCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *InvoiceCreate) SetNillableCreatedAt(v *time.Time) *InvoiceCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *InvoiceCreate) SetID(v string) *InvoiceCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetOrder sets the "order" edge to the PaymentOrder entity.
+func (_c *InvoiceCreate) SetOrder(v *PaymentOrder) *InvoiceCreate {
+	return _c.SetOrderID(v.ID)
+}
+
+// Mutation returns the InvoiceMutation object of the builder.
+func (_c *InvoiceCreate) Mutation() *InvoiceMutation {
+	return _c.mutation
+}
+
+// Save creates the Invoice in the database.
+func (_c *InvoiceCreate) Save(ctx context.Context) (*Invoice, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *InvoiceCreate) SaveX(ctx context.Context) *Invoice {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *InvoiceCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *InvoiceCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *InvoiceCreate) defaults() {
+	if _, ok := _c.mutation.Currency(); !ok {
+		v := invoice.DefaultCurrency
+		_c.mutation.SetCurrency(v)
+	}
+	if _, ok := _c.mutation.DiscountAmount(); !ok {
+		v := invoice.DefaultDiscountAmount
+		_c.mutation.SetDiscountAmount(v)
+	}
+	if _, ok := _c.mutation.CgstAmount(); !ok {
+		v := invoice.DefaultCgstAmount
+		_c.mutation.SetCgstAmount(v)
+	}
+	if _, ok := _c.mutation.SgstAmount(); !ok {
+		v := invoice.DefaultSgstAmount
+		_c.mutation.SetSgstAmount(v)
+	}
+	if _, ok := _c.mutation.IgstAmount(); !ok {
+		v := invoice.DefaultIgstAmount
+		_c.mutation.SetIgstAmount(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := invoice.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *InvoiceCreate) check() error {
+	if _, ok := _c.mutation.InvoiceNumber(); !ok {
+		return &ValidationError{Name: "invoice_number", err: errors.New(`generated: missing required field "Invoice.invoice_number"`)}
+	}
+	if v, ok := _c.mutation.InvoiceNumber(); ok {
+		if err := invoice.InvoiceNumberValidator(v); err != nil {
+			return &ValidationError{Name: "invoice_number", err: fmt.Errorf(`generated: validator failed for field "Invoice.invoice_number": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.FinancialYear(); !ok {
+		return &ValidationError{Name: "financial_year", err: errors.New(`generated: missing required field "Invoice.financial_year"`)}
+	}
+	if v, ok := _c.mutation.FinancialYear(); ok {
+		if err := invoice.FinancialYearValidator(v); err != nil {
+			return &ValidationError{Name: "financial_year", err: fmt.Errorf(`generated: validator failed for field "Invoice.financial_year": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SequenceNumber(); !ok {
+		return &ValidationError{Name: "sequence_number", err: errors.New(`generated: missing required field "Invoice.sequence_number"`)}
+	}
+	if v, ok := _c.mutation.SequenceNumber(); ok {
+		if err := invoice.SequenceNumberValidator(v); err != nil {
+			return &ValidationError{Name: "sequence_number", err: fmt.Errorf(`generated: validator failed for field "Invoice.sequence_number": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.OrderID(); !ok {
+		return &ValidationError{Name: "order_id", err: errors.New(`generated: missing required field "Invoice.order_id"`)}
+	}
+	if v, ok := _c.mutation.OrderID(); ok {
+		if err := invoice.OrderIDValidator(v); err != nil {
+			return &ValidationError{Name: "order_id", err: fmt.Errorf(`generated: validator failed for field "Invoice.order_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`generated: missing required field "Invoice.user_id"`)}
+	}
+	if v, ok := _c.mutation.UserID(); ok {
+		if err := invoice.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`generated: validator failed for field "Invoice.user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SellerName(); !ok {
+		return &ValidationError{Name: "seller_name", err: errors.New(`generated: missing required field "Invoice.seller_name"`)}
+	}
+	if v, ok := _c.mutation.SellerName(); ok {
+		if err := invoice.SellerNameValidator(v); err != nil {
+			return &ValidationError{Name: "seller_name", err: fmt.Errorf(`generated: validator failed for field "Invoice.seller_name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SellerAddress(); !ok {
+		return &ValidationError{Name: "seller_address", err: errors.New(`generated: missing required field "Invoice.seller_address"`)}
+	}
+	if v, ok := _c.mutation.SellerAddress(); ok {
+		if err := invoice.SellerAddressValidator(v); err != nil {
+			return &ValidationError{Name: "seller_address", err: fmt.Errorf(`generated: validator failed for field "Invoice.seller_address": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SellerGstin(); !ok {
+		return &ValidationError{Name: "seller_gstin", err: errors.New(`generated: missing required field "Invoice.seller_gstin"`)}
+	}
+	if v, ok := _c.mutation.SellerGstin(); ok {
+		if err := invoice.SellerGstinValidator(v); err != nil {
+			return &ValidationError{Name: "seller_gstin", err: fmt.Errorf(`generated: validator failed for field "Invoice.seller_gstin": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.BuyerName(); ok {
+		if err := invoice.BuyerNameValidator(v); err != nil {
+			return &ValidationError{Name: "buyer_name", err: fmt.Errorf(`generated: validator failed for field "Invoice.buyer_name": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.BuyerEmail(); ok {
+		if err := invoice.BuyerEmailValidator(v); err != nil {
+			return &ValidationError{Name: "buyer_email", err: fmt.Errorf(`generated: validator failed for field "Invoice.buyer_email": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PlaceOfSupply(); !ok {
+		return &ValidationError{Name: "place_of_supply", err: errors.New(`generated: missing required field "Invoice.place_of_supply"`)}
+	}
+	if v, ok := _c.mutation.PlaceOfSupply(); ok {
+		if err := invoice.PlaceOfSupplyValidator(v); err != nil {
+			return &ValidationError{Name: "place_of_supply", err: fmt.Errorf(`generated: validator failed for field "Invoice.place_of_supply": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Description(); !ok {
+		return &ValidationError{Name: "description", err: errors.New(`generated: missing required field "Invoice.description"`)}
+	}
+	if v, ok := _c.mutation.Description(); ok {
+		if err := invoice.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`generated: validator failed for field "Invoice.description": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SacCode(); !ok {
+		return &ValidationError{Name: "sac_code", err: errors.New(`generated: missing required field "Invoice.sac_code"`)}
+	}
+	if v, ok := _c.mutation.SacCode(); ok {
+		if err := invoice.SacCodeValidator(v); err != nil {
+			return &ValidationError{Name: "sac_code", err: fmt.Errorf(`generated: validator failed for field "Invoice.sac_code": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`generated: missing required field "Invoice.currency"`)}
+	}
+	if v, ok := _c.mutation.Currency(); ok {
+		if err := invoice.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`generated: validator failed for field "Invoice.currency": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.DiscountAmount(); !ok {
+		return &ValidationError{Name: "discount_amount", err: errors.New(`generated: missing required field "Invoice.discount_amount"`)}
+	}
+	if v, ok := _c.mutation.DiscountAmount(); ok {
+		if err := invoice.DiscountAmountValidator(v); err != nil {
+			return &ValidationError{Name: "discount_amount", err: fmt.Errorf(`generated: validator failed for field "Invoice.discount_amount": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TaxableAmount(); !ok {
+		return &ValidationError{Name: "taxable_amount", err: errors.New(`generated: missing required field "Invoice.taxable_amount"`)}
+	}
+	if v, ok := _c.mutation.TaxableAmount(); ok {
+		if err := invoice.TaxableAmountValidator(v); err != nil {
+			return &ValidationError{Name: "taxable_amount", err: fmt.Errorf(`generated: validator failed for field "Invoice.taxable_amount": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.GstRate(); !ok {
+		return &ValidationError{Name: "gst_rate", err: errors.New(`generated: missing required field "Invoice.gst_rate"`)}
+	}
+	if _, ok := _c.mutation.CgstAmount(); !ok {
+		return &ValidationError{Name: "cgst_amount", err: errors.New(`generated: missing required field "Invoice.cgst_amount"`)}
+	}
+	if v, ok := _c.mutation.CgstAmount(); ok {
+		if err := invoice.CgstAmountValidator(v); err != nil {
+			return &ValidationError{Name: "cgst_amount", err: fmt.Errorf(`generated: validator failed for field "Invoice.cgst_amount": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SgstAmount(); !ok {
+		return &ValidationError{Name: "sgst_amount", err: errors.New(`generated: missing required field "Invoice.sgst_amount"`)}
+	}
+	if v, ok := _c.mutation.SgstAmount(); ok {
+		if err := invoice.SgstAmountValidator(v); err != nil {
+			return &ValidationError{Name: "sgst_amount", err: fmt.Errorf(`generated: validator failed for field "Invoice.sgst_amount": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.IgstAmount(); !ok {
+		return &ValidationError{Name: "igst_amount", err: errors.New(`generated: missing required field "Invoice.igst_amount"`)}
+	}
+	if v, ok := _c.mutation.IgstAmount(); ok {
+		if err := invoice.IgstAmountValidator(v); err != nil {
+			return &ValidationError{Name: "igst_amount", err: fmt.Errorf(`generated: validator failed for field "Invoice.igst_amount": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TotalAmount(); !ok {
+		return &ValidationError{Name: "total_amount", err: errors.New(`generated: missing required field "Invoice.total_amount"`)}
+	}
+	if v, ok := _c.mutation.TotalAmount(); ok {
+		if err := invoice.TotalAmountValidator(v); err != nil {
+			return &ValidationError{Name: "total_amount", err: fmt.Errorf(`generated: validator failed for field "Invoice.total_amount": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.PdfStorageKey(); ok {
+		if err := invoice.PdfStorageKeyValidator(v); err != nil {
+			return &ValidationError{Name: "pdf_storage_key", err: fmt.Errorf(`generated: validator failed for field "Invoice.pdf_storage_key": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.IssuedAt(); !ok {
+		return &ValidationError{Name: "issued_at", err: errors.New(`generated: missing required field "Invoice.issued_at"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "Invoice.created_at"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := invoice.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`generated: validator failed for field "Invoice.id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.OrderIDs()) == 0 {
+		return &ValidationError{Name: "order", err: errors.New(`generated: missing required edge "Invoice.order"`)}
+	}
+	return nil
+}
+
+func (_c *InvoiceCreate) sqlSave(ctx context.Context) (*Invoice, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Invoice.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *InvoiceCreate) createSpec() (*Invoice, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Invoice{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(invoice.Table, sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.InvoiceNumber(); ok {
+		_spec.SetField(invoice.FieldInvoiceNumber, field.TypeString, value)
+		_node.InvoiceNumber = value
+	}
+	if value, ok := _c.mutation.FinancialYear(); ok {
+		_spec.SetField(invoice.FieldFinancialYear, field.TypeString, value)
+		_node.FinancialYear = value
+	}
+	if value, ok := _c.mutation.SequenceNumber(); ok {
+		_spec.SetField(invoice.FieldSequenceNumber, field.TypeInt, value)
+		_node.SequenceNumber = value
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(invoice.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.SellerName(); ok {
+		_spec.SetField(invoice.FieldSellerName, field.TypeString, value)
+		_node.SellerName = value
+	}
+	if value, ok := _c.mutation.SellerAddress(); ok {
+		_spec.SetField(invoice.FieldSellerAddress, field.TypeString, value)
+		_node.SellerAddress = value
+	}
+	if value, ok := _c.mutation.SellerGstin(); ok {
+		_spec.SetField(invoice.FieldSellerGstin, field.TypeString, value)
+		_node.SellerGstin = value
+	}
+	if value, ok := _c.mutation.BuyerName(); ok {
+		_spec.SetField(invoice.FieldBuyerName, field.TypeString, value)
+		_node.BuyerName = &value
+	}
+	if value, ok := _c.mutation.BuyerEmail(); ok {
+		_spec.SetField(invoice.FieldBuyerEmail, field.TypeString, value)
+		_node.BuyerEmail = &value
+	}
+	if value, ok := _c.mutation.PlaceOfSupply(); ok {
+		_spec.SetField(invoice.FieldPlaceOfSupply, field.TypeString, value)
+		_node.PlaceOfSupply = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(invoice.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.SacCode(); ok {
+		_spec.SetField(invoice.FieldSacCode, field.TypeString, value)
+		_node.SacCode = value
+	}
+	if value, ok := _c.mutation.Currency(); ok {
+		_spec.SetField(invoice.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := _c.mutation.DiscountAmount(); ok {
+		_spec.SetField(invoice.FieldDiscountAmount, field.TypeInt, value)
+		_node.DiscountAmount = value
+	}
+	if value, ok := _c.mutation.TaxableAmount(); ok {
+		_spec.SetField(invoice.FieldTaxableAmount, field.TypeInt, value)
+		_node.TaxableAmount = value
+	}
+	if value, ok := _c.mutation.GstRate(); ok {
+		_spec.SetField(invoice.FieldGstRate, field.TypeFloat64, value)
+		_node.GstRate = value
+	}
+	if value, ok := _c.mutation.CgstAmount(); ok {
+		_spec.SetField(invoice.FieldCgstAmount, field.TypeInt, value)
+		_node.CgstAmount = value
+	}
+	if value, ok := _c.mutation.SgstAmount(); ok {
+		_spec.SetField(invoice.FieldSgstAmount, field.TypeInt, value)
+		_node.SgstAmount = value
+	}
+	if value, ok := _c.mutation.IgstAmount(); ok {
+		_spec.SetField(invoice.FieldIgstAmount, field.TypeInt, value)
+		_node.IgstAmount = value
+	}
+	if value, ok := _c.mutation.TotalAmount(); ok {
+		_spec.SetField(invoice.FieldTotalAmount, field.TypeInt, value)
+		_node.TotalAmount = value
+	}
+	if value, ok := _c.mutation.PdfStorageKey(); ok {
+		_spec.SetField(invoice.FieldPdfStorageKey, field.TypeString, value)
+		_node.PdfStorageKey = &value
+	}
+	if value, ok := _c.mutation.IssuedAt(); ok {
+		_spec.SetField(invoice.FieldIssuedAt, field.TypeTime, value)
+		_node.IssuedAt = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(invoice.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.OrderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   invoice.OrderTable,
+			Columns: []string{invoice.OrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentorder.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OrderID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Invoice.Create().
+//		SetInvoiceNumber(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.InvoiceUpsert) {
+//			SetInvoiceNumber(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *InvoiceCreate) OnConflict(opts ...sql.ConflictOption) *InvoiceUpsertOne {
+	_c.conflict = opts
+	return &InvoiceUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Invoice.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *InvoiceCreate) OnConflictColumns(columns ...string) *InvoiceUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &InvoiceUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// InvoiceUpsertOne is the builder for "upsert"-ing
+	//  one Invoice node.
+	InvoiceUpsertOne struct {
+		create *InvoiceCreate
+	}
+
+	// InvoiceUpsert is the "OnConflict" setter.
+	InvoiceUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetPdfStorageKey sets the "pdf_storage_key" field.
+func (u *InvoiceUpsert) SetPdfStorageKey(v string) *InvoiceUpsert {
+	u.Set(invoice.FieldPdfStorageKey, v)
+	return u
+}
+
+// UpdatePdfStorageKey sets the "pdf_storage_key" field to the value that was provided on create.
+func (u *InvoiceUpsert) UpdatePdfStorageKey() *InvoiceUpsert {
+	u.SetExcluded(invoice.FieldPdfStorageKey)
+	return u
+}
+
+// ClearPdfStorageKey clears the value of the "pdf_storage_key" field.
+func (u *InvoiceUpsert) ClearPdfStorageKey() *InvoiceUpsert {
+	u.SetNull(invoice.FieldPdfStorageKey)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Invoice.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(invoice.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *InvoiceUpsertOne) UpdateNewValues() *InvoiceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(invoice.FieldID)
+		}
+		if _, exists := u.create.mutation.InvoiceNumber(); exists {
+			s.SetIgnore(invoice.FieldInvoiceNumber)
+		}
+		if _, exists := u.create.mutation.FinancialYear(); exists {
+			s.SetIgnore(invoice.FieldFinancialYear)
+		}
+		if _, exists := u.create.mutation.SequenceNumber(); exists {
+			s.SetIgnore(invoice.FieldSequenceNumber)
+		}
+		if _, exists := u.create.mutation.OrderID(); exists {
+			s.SetIgnore(invoice.FieldOrderID)
+		}
+		if _, exists := u.create.mutation.UserID(); exists {
+			s.SetIgnore(invoice.FieldUserID)
+		}
+		if _, exists := u.create.mutation.SellerName(); exists {
+			s.SetIgnore(invoice.FieldSellerName)
+		}
+		if _, exists := u.create.mutation.SellerAddress(); exists {
+			s.SetIgnore(invoice.FieldSellerAddress)
+		}
+		if _, exists := u.create.mutation.SellerGstin(); exists {
+			s.SetIgnore(invoice.FieldSellerGstin)
+		}
+		if _, exists := u.create.mutation.BuyerName(); exists {
+			s.SetIgnore(invoice.FieldBuyerName)
+		}
+		if _, exists := u.create.mutation.BuyerEmail(); exists {
+			s.SetIgnore(invoice.FieldBuyerEmail)
+		}
+		if _, exists := u.create.mutation.PlaceOfSupply(); exists {
+			s.SetIgnore(invoice.FieldPlaceOfSupply)
+		}
+		if _, exists := u.create.mutation.Description(); exists {
+			s.SetIgnore(invoice.FieldDescription)
+		}
+		if _, exists := u.create.mutation.SacCode(); exists {
+			s.SetIgnore(invoice.FieldSacCode)
+		}
+		if _, exists := u.create.mutation.Currency(); exists {
+			s.SetIgnore(invoice.FieldCurrency)
+		}
+		if _, exists := u.create.mutation.DiscountAmount(); exists {
+			s.SetIgnore(invoice.FieldDiscountAmount)
+		}
+		if _, exists := u.create.mutation.TaxableAmount(); exists {
+			s.SetIgnore(invoice.FieldTaxableAmount)
+		}
+		if _, exists := u.create.mutation.GstRate(); exists {
+			s.SetIgnore(invoice.FieldGstRate)
+		}
+		if _, exists := u.create.mutation.CgstAmount(); exists {
+			s.SetIgnore(invoice.FieldCgstAmount)
+		}
+		if _, exists := u.create.mutation.SgstAmount(); exists {
+			s.SetIgnore(invoice.FieldSgstAmount)
+		}
+		if _, exists := u.create.mutation.IgstAmount(); exists {
+			s.SetIgnore(invoice.FieldIgstAmount)
+		}
+		if _, exists := u.create.mutation.TotalAmount(); exists {
+			s.SetIgnore(invoice.FieldTotalAmount)
+		}
+		if _, exists := u.create.mutation.IssuedAt(); exists {
+			s.SetIgnore(invoice.FieldIssuedAt)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(invoice.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Invoice.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *InvoiceUpsertOne) Ignore() *InvoiceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *InvoiceUpsertOne) DoNothing() *InvoiceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the InvoiceCreate.OnConflict
+// documentation for more info.
+func (u *InvoiceUpsertOne) Update(set func(*InvoiceUpsert)) *InvoiceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&InvoiceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPdfStorageKey sets the "pdf_storage_key" field.
+func (u *InvoiceUpsertOne) SetPdfStorageKey(v string) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetPdfStorageKey(v)
+	})
+}
+
+// UpdatePdfStorageKey sets the "pdf_storage_key" field to the value that was provided on create.
+func (u *InvoiceUpsertOne) UpdatePdfStorageKey() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdatePdfStorageKey()
+	})
+}
+
+// ClearPdfStorageKey clears the value of the "pdf_storage_key" field.
+func (u *InvoiceUpsertOne) ClearPdfStorageKey() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.ClearPdfStorageKey()
+	})
+}
+
+// Exec executes the query.
+func (u *InvoiceUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("generated: missing options for InvoiceCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *InvoiceUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *InvoiceUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("generated: InvoiceUpsertOne.ID is not supported by MySQL driver. Use InvoiceUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *InvoiceUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// InvoiceCreateBulk is the builder for creating many Invoice entities in bulk.
+type InvoiceCreateBulk struct {
+	config
+	err      error
+	builders []*InvoiceCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Invoice entities in the database.
+func (_c *InvoiceCreateBulk) Save(ctx context.Context) ([]*Invoice, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Invoice, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*InvoiceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *InvoiceCreateBulk) SaveX(ctx context.Context) []*Invoice {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *InvoiceCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *InvoiceCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Invoice.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.InvoiceUpsert) {
+//			SetInvoiceNumber(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *InvoiceCreateBulk) OnConflict(opts ...sql.ConflictOption) *InvoiceUpsertBulk {
+	_c.conflict = opts
+	return &InvoiceUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Invoice.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *InvoiceCreateBulk) OnConflictColumns(columns ...string) *InvoiceUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &InvoiceUpsertBulk{
+		create: _c,
+	}
+}
+
+// InvoiceUpsertBulk is the builder for "upsert"-ing
+// a bulk of Invoice nodes.
+type InvoiceUpsertBulk struct {
+	create *InvoiceCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Invoice.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(invoice.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *InvoiceUpsertBulk) UpdateNewValues() *InvoiceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(invoice.FieldID)
+			}
+			if _, exists := b.mutation.InvoiceNumber(); exists {
+				s.SetIgnore(invoice.FieldInvoiceNumber)
+			}
+			if _, exists := b.mutation.FinancialYear(); exists {
+				s.SetIgnore(invoice.FieldFinancialYear)
+			}
+			if _, exists := b.mutation.SequenceNumber(); exists {
+				s.SetIgnore(invoice.FieldSequenceNumber)
+			}
+			if _, exists := b.mutation.OrderID(); exists {
+				s.SetIgnore(invoice.FieldOrderID)
+			}
+			if _, exists := b.mutation.UserID(); exists {
+				s.SetIgnore(invoice.FieldUserID)
+			}
+			if _, exists := b.mutation.SellerName(); exists {
+				s.SetIgnore(invoice.FieldSellerName)
+			}
+			if _, exists := b.mutation.SellerAddress(); exists {
+				s.SetIgnore(invoice.FieldSellerAddress)
+			}
+			if _, exists := b.mutation.SellerGstin(); exists {
+				s.SetIgnore(invoice.FieldSellerGstin)
+			}
+			if _, exists := b.mutation.BuyerName(); exists {
+				s.SetIgnore(invoice.FieldBuyerName)
+			}
+			if _, exists := b.mutation.BuyerEmail(); exists {
+				s.SetIgnore(invoice.FieldBuyerEmail)
+			}
+			if _, exists := b.mutation.PlaceOfSupply(); exists {
+				s.SetIgnore(invoice.FieldPlaceOfSupply)
+			}
+			if _, exists := b.mutation.Description(); exists {
+				s.SetIgnore(invoice.FieldDescription)
+			}
+			if _, exists := b.mutation.SacCode(); exists {
+				s.SetIgnore(invoice.FieldSacCode)
+			}
+			if _, exists := b.mutation.Currency(); exists {
+				s.SetIgnore(invoice.FieldCurrency)
+			}
+			if _, exists := b.mutation.DiscountAmount(); exists {
+				s.SetIgnore(invoice.FieldDiscountAmount)
+			}
+			if _, exists := b.mutation.TaxableAmount(); exists {
+				s.SetIgnore(invoice.FieldTaxableAmount)
+			}
+			if _, exists := b.mutation.GstRate(); exists {
+				s.SetIgnore(invoice.FieldGstRate)
+			}
+			if _, exists := b.mutation.CgstAmount(); exists {
+				s.SetIgnore(invoice.FieldCgstAmount)
+			}
+			if _, exists := b.mutation.SgstAmount(); exists {
+				s.SetIgnore(invoice.FieldSgstAmount)
+			}
+			if _, exists := b.mutation.IgstAmount(); exists {
+				s.SetIgnore(invoice.FieldIgstAmount)
+			}
+			if _, exists := b.mutation.TotalAmount(); exists {
+				s.SetIgnore(invoice.FieldTotalAmount)
+			}
+			if _, exists := b.mutation.IssuedAt(); exists {
+				s.SetIgnore(invoice.FieldIssuedAt)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(invoice.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Invoice.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *InvoiceUpsertBulk) Ignore() *InvoiceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *InvoiceUpsertBulk) DoNothing() *InvoiceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the InvoiceCreateBulk.OnConflict
+// documentation for more info.
+func (u *InvoiceUpsertBulk) Update(set func(*InvoiceUpsert)) *InvoiceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&InvoiceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPdfStorageKey sets the "pdf_storage_key" field.
+func (u *InvoiceUpsertBulk) SetPdfStorageKey(v string) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetPdfStorageKey(v)
+	})
+}
+
+// UpdatePdfStorageKey sets the "pdf_storage_key" field to the value that was provided on create.
+func (u *InvoiceUpsertBulk) UpdatePdfStorageKey() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdatePdfStorageKey()
+	})
+}
+
+// ClearPdfStorageKey clears the value of the "pdf_storage_key" field.
+func (u *InvoiceUpsertBulk) ClearPdfStorageKey() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.ClearPdfStorageKey()
+	})
+}
+
+// Exec executes the query.
+func (u *InvoiceUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("generated: OnConflict was set for builder %d. Set it on the InvoiceCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("generated: missing options for InvoiceCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *InvoiceUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
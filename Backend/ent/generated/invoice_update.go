@@ -0,0 +1,271 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/UnoraApp/be/ent/generated/invoice"
+	"github.com/UnoraApp/be/ent/generated/predicate"
+)
+
+// InvoiceUpdate is the builder for updating Invoice entities.
+type InvoiceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *InvoiceMutation
+}
+
+// Where appends a list predicates to the InvoiceUpdate builder.
+func (_u *InvoiceUpdate) Where(ps ...predicate.Invoice) *InvoiceUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetPdfStorageKey sets the "pdf_storage_key" field.
+func (_u *InvoiceUpdate) SetPdfStorageKey(v string) *InvoiceUpdate {
+	_u.mutation.SetPdfStorageKey(v)
+	return _u
+}
+
+// SetNillablePdfStorageKey sets the "pdf_storage_key" field if the given value is not nil.
+func (_u *InvoiceUpdate) SetNillablePdfStorageKey(v *string) *InvoiceUpdate {
+	if v != nil {
+		_u.SetPdfStorageKey(*v)
+	}
+	return _u
+}
+
+// ClearPdfStorageKey clears the value of the "pdf_storage_key" field.
+func (_u *InvoiceUpdate) ClearPdfStorageKey() *InvoiceUpdate {
+	_u.mutation.ClearPdfStorageKey()
+	return _u
+}
+
+// Mutation returns the InvoiceMutation object of the builder.
+func (_u *InvoiceUpdate) Mutation() *InvoiceMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *InvoiceUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *InvoiceUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *InvoiceUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *InvoiceUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *InvoiceUpdate) check() error {
+	if v, ok := _u.mutation.PdfStorageKey(); ok {
+		if err := invoice.PdfStorageKeyValidator(v); err != nil {
+			return &ValidationError{Name: "pdf_storage_key", err: fmt.Errorf(`generated: validator failed for field "Invoice.pdf_storage_key": %w`, err)}
+		}
+	}
+	if _u.mutation.OrderCleared() && len(_u.mutation.OrderIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "Invoice.order"`)
+	}
+	return nil
+}
+
+func (_u *InvoiceUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(invoice.Table, invoice.Columns, sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.BuyerNameCleared() {
+		_spec.ClearField(invoice.FieldBuyerName, field.TypeString)
+	}
+	if _u.mutation.BuyerEmailCleared() {
+		_spec.ClearField(invoice.FieldBuyerEmail, field.TypeString)
+	}
+	if value, ok := _u.mutation.PdfStorageKey(); ok {
+		_spec.SetField(invoice.FieldPdfStorageKey, field.TypeString, value)
+	}
+	if _u.mutation.PdfStorageKeyCleared() {
+		_spec.ClearField(invoice.FieldPdfStorageKey, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invoice.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// InvoiceUpdateOne is the builder for updating a single Invoice entity.
+type InvoiceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *InvoiceMutation
+}
+
+// SetPdfStorageKey sets the "pdf_storage_key" field.
+func (_u *InvoiceUpdateOne) SetPdfStorageKey(v string) *InvoiceUpdateOne {
+	_u.mutation.SetPdfStorageKey(v)
+	return _u
+}
+
+// SetNillablePdfStorageKey sets the "pdf_storage_key" field if the given value is not nil.
+func (_u *InvoiceUpdateOne) SetNillablePdfStorageKey(v *string) *InvoiceUpdateOne {
+	if v != nil {
+		_u.SetPdfStorageKey(*v)
+	}
+	return _u
+}
+
+// ClearPdfStorageKey clears the value of the "pdf_storage_key" field.
+func (_u *InvoiceUpdateOne) ClearPdfStorageKey() *InvoiceUpdateOne {
+	_u.mutation.ClearPdfStorageKey()
+	return _u
+}
+
+// Mutation returns the InvoiceMutation object of the builder.
+func (_u *InvoiceUpdateOne) Mutation() *InvoiceMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the InvoiceUpdate builder.
+func (_u *InvoiceUpdateOne) Where(ps ...predicate.Invoice) *InvoiceUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *InvoiceUpdateOne) Select(field string, fields ...string) *InvoiceUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Invoice entity.
+func (_u *InvoiceUpdateOne) Save(ctx context.Context) (*Invoice, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *InvoiceUpdateOne) SaveX(ctx context.Context) *Invoice {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *InvoiceUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *InvoiceUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *InvoiceUpdateOne) check() error {
+	if v, ok := _u.mutation.PdfStorageKey(); ok {
+		if err := invoice.PdfStorageKeyValidator(v); err != nil {
+			return &ValidationError{Name: "pdf_storage_key", err: fmt.Errorf(`generated: validator failed for field "Invoice.pdf_storage_key": %w`, err)}
+		}
+	}
+	if _u.mutation.OrderCleared() && len(_u.mutation.OrderIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "Invoice.order"`)
+	}
+	return nil
+}
+
+func (_u *InvoiceUpdateOne) sqlSave(ctx context.Context) (_node *Invoice, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(invoice.Table, invoice.Columns, sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "Invoice.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, invoice.FieldID)
+		for _, f := range fields {
+			if !invoice.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != invoice.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.BuyerNameCleared() {
+		_spec.ClearField(invoice.FieldBuyerName, field.TypeString)
+	}
+	if _u.mutation.BuyerEmailCleared() {
+		_spec.ClearField(invoice.FieldBuyerEmail, field.TypeString)
+	}
+	if value, ok := _u.mutation.PdfStorageKey(); ok {
+		_spec.SetField(invoice.FieldPdfStorageKey, field.TypeString, value)
+	}
+	if _u.mutation.PdfStorageKeyCleared() {
+		_spec.ClearField(invoice.FieldPdfStorageKey, field.TypeString)
+	}
+	_node = &Invoice{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invoice.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
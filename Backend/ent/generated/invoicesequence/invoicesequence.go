@@ -0,0 +1,70 @@
+// Code generated by ent, DO NOT EDIT.
+
+package invoicesequence
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the invoicesequence type in the database.
+	Label = "invoice_sequence"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldLastNumber holds the string denoting the last_number field in the database.
+	FieldLastNumber = "last_number"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the invoicesequence in the database.
+	Table = "invoice_sequences"
+)
+
+// Columns holds all SQL columns for invoicesequence fields.
+var Columns = []string{
+	FieldID,
+	FieldLastNumber,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultLastNumber holds the default value on creation for the "last_number" field.
+	DefaultLastNumber int
+	// LastNumberValidator is a validator for the "last_number" field. It is called by the builders before save.
+	LastNumberValidator func(int) error
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the InvoiceSequence queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByLastNumber orders the results by the last_number field.
+func ByLastNumber(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastNumber, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
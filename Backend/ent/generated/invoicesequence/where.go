@@ -0,0 +1,170 @@
+// Code generated by ent, DO NOT EDIT.
+
+package invoicesequence
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/UnoraApp/be/ent/generated/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.InvoiceSequence {
+	return predicate.InvoiceSequence(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.InvoiceSequence {
+	return predicate.InvoiceSequence(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.InvoiceSequence {
+	return predicate.InvoiceSequence(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.InvoiceSequence {
+	return predicate.InvoiceSequence(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.InvoiceSequence {
+	return predicate.InvoiceSequence(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.InvoiceSequence {
+	return predicate.InvoiceSequence(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.InvoiceSequence {
+	return predicate.InvoiceSequence(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.InvoiceSequence {
+	return predicate.InvoiceSequence(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.InvoiceSequence {
+	return predicate.InvoiceSequence(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.InvoiceSequence {
+	return predicate.InvoiceSequence(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.InvoiceSequence {
+	return predicate.InvoiceSequence(sql.FieldContainsFold(FieldID, id))
+}
+
+// LastNumber applies equality check predicate on the "last_number" field. It's identical to LastNumberEQ.
+func LastNumber(v int) predicate.InvoiceSequence {
+	return predicate.InvoiceSequence(sql.FieldEQ(FieldLastNumber, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.InvoiceSequence {
+	return predicate.InvoiceSequence(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// LastNumberEQ applies the EQ predicate on the "last_number" field.
+func LastNumberEQ(v int) predicate.InvoiceSequence {
+	return predicate.InvoiceSequence(sql.FieldEQ(FieldLastNumber, v))
+}
+
+// LastNumberNEQ applies the NEQ predicate on the "last_number" field.
+func LastNumberNEQ(v int) predicate.InvoiceSequence {
+	return predicate.InvoiceSequence(sql.FieldNEQ(FieldLastNumber, v))
+}
+
+// LastNumberIn applies the In predicate on the "last_number" field.
+func LastNumberIn(vs ...int) predicate.InvoiceSequence {
+	return predicate.InvoiceSequence(sql.FieldIn(FieldLastNumber, vs...))
+}
+
+// LastNumberNotIn applies the NotIn predicate on the "last_number" field.
+func LastNumberNotIn(vs ...int) predicate.InvoiceSequence {
+	return predicate.InvoiceSequence(sql.FieldNotIn(FieldLastNumber, vs...))
+}
+
+// LastNumberGT applies the GT predicate on the "last_number" field.
+func LastNumberGT(v int) predicate.InvoiceSequence {
+	return predicate.InvoiceSequence(sql.FieldGT(FieldLastNumber, v))
+}
+
+// LastNumberGTE applies the GTE predicate on the "last_number" field.
+func LastNumberGTE(v int) predicate.InvoiceSequence {
+	return predicate.InvoiceSequence(sql.FieldGTE(FieldLastNumber, v))
+}
+
+// LastNumberLT applies the LT predicate on the "last_number" field.
+func LastNumberLT(v int) predicate.InvoiceSequence {
+	return predicate.InvoiceSequence(sql.FieldLT(FieldLastNumber, v))
+}
+
+// LastNumberLTE applies the LTE predicate on the "last_number" field.
+func LastNumberLTE(v int) predicate.InvoiceSequence {
+	return predicate.InvoiceSequence(sql.FieldLTE(FieldLastNumber, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.InvoiceSequence {
+	return predicate.InvoiceSequence(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.InvoiceSequence {
+	return predicate.InvoiceSequence(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.InvoiceSequence {
+	return predicate.InvoiceSequence(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.InvoiceSequence {
+	return predicate.InvoiceSequence(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.InvoiceSequence {
+	return predicate.InvoiceSequence(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.InvoiceSequence {
+	return predicate.InvoiceSequence(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.InvoiceSequence {
+	return predicate.InvoiceSequence(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.InvoiceSequence {
+	return predicate.InvoiceSequence(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.InvoiceSequence) predicate.InvoiceSequence {
+	return predicate.InvoiceSequence(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.InvoiceSequence) predicate.InvoiceSequence {
+	return predicate.InvoiceSequence(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.InvoiceSequence) predicate.InvoiceSequence {
+	return predicate.InvoiceSequence(sql.NotPredicates(p))
+}
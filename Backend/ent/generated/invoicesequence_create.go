@@ -0,0 +1,603 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/UnoraApp/be/ent/generated/invoicesequence"
+)
+
+// InvoiceSequenceCreate is the builder for creating a InvoiceSequence entity.
+type InvoiceSequenceCreate struct {
+	config
+	mutation *InvoiceSequenceMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetLastNumber sets the "last_number" field.
+func (_c *InvoiceSequenceCreate) SetLastNumber(v int) *InvoiceSequenceCreate {
+	_c.mutation.SetLastNumber(v)
+	return _c
+}
+
+// SetNillableLastNumber sets the "last_number" field if the given value is not nil.
+func (_c *InvoiceSequenceCreate) SetNillableLastNumber(v *int) *InvoiceSequenceCreate {
+	if v != nil {
+		_c.SetLastNumber(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *InvoiceSequenceCreate) SetUpdatedAt(v time.Time) *InvoiceSequenceCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *InvoiceSequenceCreate) SetNillableUpdatedAt(v *time.Time) *InvoiceSequenceCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *InvoiceSequenceCreate) SetID(v string) *InvoiceSequenceCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the InvoiceSequenceMutation object of the builder.
+func (_c *InvoiceSequenceCreate) Mutation() *InvoiceSequenceMutation {
+	return _c.mutation
+}
+
+// Save creates the InvoiceSequence in the database.
+func (_c *InvoiceSequenceCreate) Save(ctx context.Context) (*InvoiceSequence, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *InvoiceSequenceCreate) SaveX(ctx context.Context) *InvoiceSequence {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *InvoiceSequenceCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *InvoiceSequenceCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *InvoiceSequenceCreate) defaults() {
+	if _, ok := _c.mutation.LastNumber(); !ok {
+		v := invoicesequence.DefaultLastNumber
+		_c.mutation.SetLastNumber(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := invoicesequence.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *InvoiceSequenceCreate) check() error {
+	if _, ok := _c.mutation.LastNumber(); !ok {
+		return &ValidationError{Name: "last_number", err: errors.New(`generated: missing required field "InvoiceSequence.last_number"`)}
+	}
+	if v, ok := _c.mutation.LastNumber(); ok {
+		if err := invoicesequence.LastNumberValidator(v); err != nil {
+			return &ValidationError{Name: "last_number", err: fmt.Errorf(`generated: validator failed for field "InvoiceSequence.last_number": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`generated: missing required field "InvoiceSequence.updated_at"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := invoicesequence.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`generated: validator failed for field "InvoiceSequence.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *InvoiceSequenceCreate) sqlSave(ctx context.Context) (*InvoiceSequence, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected InvoiceSequence.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *InvoiceSequenceCreate) createSpec() (*InvoiceSequence, *sqlgraph.CreateSpec) {
+	var (
+		_node = &InvoiceSequence{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(invoicesequence.Table, sqlgraph.NewFieldSpec(invoicesequence.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.LastNumber(); ok {
+		_spec.SetField(invoicesequence.FieldLastNumber, field.TypeInt, value)
+		_node.LastNumber = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(invoicesequence.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.InvoiceSequence.Create().
+//		SetLastNumber(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.InvoiceSequenceUpsert) {
+//			SetLastNumber(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *InvoiceSequenceCreate) OnConflict(opts ...sql.ConflictOption) *InvoiceSequenceUpsertOne {
+	_c.conflict = opts
+	return &InvoiceSequenceUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.InvoiceSequence.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *InvoiceSequenceCreate) OnConflictColumns(columns ...string) *InvoiceSequenceUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &InvoiceSequenceUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// InvoiceSequenceUpsertOne is the builder for "upsert"-ing
+	//  one InvoiceSequence node.
+	InvoiceSequenceUpsertOne struct {
+		create *InvoiceSequenceCreate
+	}
+
+	// InvoiceSequenceUpsert is the "OnConflict" setter.
+	InvoiceSequenceUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetLastNumber sets the "last_number" field.
+func (u *InvoiceSequenceUpsert) SetLastNumber(v int) *InvoiceSequenceUpsert {
+	u.Set(invoicesequence.FieldLastNumber, v)
+	return u
+}
+
+// UpdateLastNumber sets the "last_number" field to the value that was provided on create.
+func (u *InvoiceSequenceUpsert) UpdateLastNumber() *InvoiceSequenceUpsert {
+	u.SetExcluded(invoicesequence.FieldLastNumber)
+	return u
+}
+
+// AddLastNumber adds v to the "last_number" field.
+func (u *InvoiceSequenceUpsert) AddLastNumber(v int) *InvoiceSequenceUpsert {
+	u.Add(invoicesequence.FieldLastNumber, v)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *InvoiceSequenceUpsert) SetUpdatedAt(v time.Time) *InvoiceSequenceUpsert {
+	u.Set(invoicesequence.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *InvoiceSequenceUpsert) UpdateUpdatedAt() *InvoiceSequenceUpsert {
+	u.SetExcluded(invoicesequence.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.InvoiceSequence.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(invoicesequence.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *InvoiceSequenceUpsertOne) UpdateNewValues() *InvoiceSequenceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(invoicesequence.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.InvoiceSequence.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *InvoiceSequenceUpsertOne) Ignore() *InvoiceSequenceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *InvoiceSequenceUpsertOne) DoNothing() *InvoiceSequenceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the InvoiceSequenceCreate.OnConflict
+// documentation for more info.
+func (u *InvoiceSequenceUpsertOne) Update(set func(*InvoiceSequenceUpsert)) *InvoiceSequenceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&InvoiceSequenceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetLastNumber sets the "last_number" field.
+func (u *InvoiceSequenceUpsertOne) SetLastNumber(v int) *InvoiceSequenceUpsertOne {
+	return u.Update(func(s *InvoiceSequenceUpsert) {
+		s.SetLastNumber(v)
+	})
+}
+
+// AddLastNumber adds v to the "last_number" field.
+func (u *InvoiceSequenceUpsertOne) AddLastNumber(v int) *InvoiceSequenceUpsertOne {
+	return u.Update(func(s *InvoiceSequenceUpsert) {
+		s.AddLastNumber(v)
+	})
+}
+
+// UpdateLastNumber sets the "last_number" field to the value that was provided on create.
+func (u *InvoiceSequenceUpsertOne) UpdateLastNumber() *InvoiceSequenceUpsertOne {
+	return u.Update(func(s *InvoiceSequenceUpsert) {
+		s.UpdateLastNumber()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *InvoiceSequenceUpsertOne) SetUpdatedAt(v time.Time) *InvoiceSequenceUpsertOne {
+	return u.Update(func(s *InvoiceSequenceUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *InvoiceSequenceUpsertOne) UpdateUpdatedAt() *InvoiceSequenceUpsertOne {
+	return u.Update(func(s *InvoiceSequenceUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *InvoiceSequenceUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("generated: missing options for InvoiceSequenceCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *InvoiceSequenceUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *InvoiceSequenceUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("generated: InvoiceSequenceUpsertOne.ID is not supported by MySQL driver. Use InvoiceSequenceUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *InvoiceSequenceUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// InvoiceSequenceCreateBulk is the builder for creating many InvoiceSequence entities in bulk.
+type InvoiceSequenceCreateBulk struct {
+	config
+	err      error
+	builders []*InvoiceSequenceCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the InvoiceSequence entities in the database.
+func (_c *InvoiceSequenceCreateBulk) Save(ctx context.Context) ([]*InvoiceSequence, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*InvoiceSequence, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*InvoiceSequenceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *InvoiceSequenceCreateBulk) SaveX(ctx context.Context) []*InvoiceSequence {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *InvoiceSequenceCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *InvoiceSequenceCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.InvoiceSequence.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.InvoiceSequenceUpsert) {
+//			SetLastNumber(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *InvoiceSequenceCreateBulk) OnConflict(opts ...sql.ConflictOption) *InvoiceSequenceUpsertBulk {
+	_c.conflict = opts
+	return &InvoiceSequenceUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.InvoiceSequence.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *InvoiceSequenceCreateBulk) OnConflictColumns(columns ...string) *InvoiceSequenceUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &InvoiceSequenceUpsertBulk{
+		create: _c,
+	}
+}
+
+// InvoiceSequenceUpsertBulk is the builder for "upsert"-ing
+// a bulk of InvoiceSequence nodes.
+type InvoiceSequenceUpsertBulk struct {
+	create *InvoiceSequenceCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.InvoiceSequence.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(invoicesequence.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *InvoiceSequenceUpsertBulk) UpdateNewValues() *InvoiceSequenceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(invoicesequence.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.InvoiceSequence.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *InvoiceSequenceUpsertBulk) Ignore() *InvoiceSequenceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *InvoiceSequenceUpsertBulk) DoNothing() *InvoiceSequenceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the InvoiceSequenceCreateBulk.OnConflict
+// documentation for more info.
+func (u *InvoiceSequenceUpsertBulk) Update(set func(*InvoiceSequenceUpsert)) *InvoiceSequenceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&InvoiceSequenceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetLastNumber sets the "last_number" field.
+func (u *InvoiceSequenceUpsertBulk) SetLastNumber(v int) *InvoiceSequenceUpsertBulk {
+	return u.Update(func(s *InvoiceSequenceUpsert) {
+		s.SetLastNumber(v)
+	})
+}
+
+// AddLastNumber adds v to the "last_number" field.
+func (u *InvoiceSequenceUpsertBulk) AddLastNumber(v int) *InvoiceSequenceUpsertBulk {
+	return u.Update(func(s *InvoiceSequenceUpsert) {
+		s.AddLastNumber(v)
+	})
+}
+
+// UpdateLastNumber sets the "last_number" field to the value that was provided on create.
+func (u *InvoiceSequenceUpsertBulk) UpdateLastNumber() *InvoiceSequenceUpsertBulk {
+	return u.Update(func(s *InvoiceSequenceUpsert) {
+		s.UpdateLastNumber()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *InvoiceSequenceUpsertBulk) SetUpdatedAt(v time.Time) *InvoiceSequenceUpsertBulk {
+	return u.Update(func(s *InvoiceSequenceUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *InvoiceSequenceUpsertBulk) UpdateUpdatedAt() *InvoiceSequenceUpsertBulk {
+	return u.Update(func(s *InvoiceSequenceUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *InvoiceSequenceUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("generated: OnConflict was set for builder %d. Set it on the InvoiceSequenceCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("generated: missing options for InvoiceSequenceCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *InvoiceSequenceUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,168 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/UnoraApp/be/ent/generated/credittransaction"
+	"github.com/UnoraApp/be/ent/generated/ledgerentry"
+)
+
+// LedgerEntry is the model entity for the LedgerEntry schema.
+type LedgerEntry struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// TransactionID holds the value of the "transaction_id" field.
+	TransactionID string `json:"transaction_id,omitempty"`
+	// Account holds the value of the "account" field.
+	Account string `json:"account,omitempty"`
+	// Positive credits the account, negative debits it
+	Amount int `json:"amount,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the LedgerEntryQuery when eager-loading is set.
+	Edges        LedgerEntryEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// LedgerEntryEdges holds the relations/edges for other nodes in the graph.
+type LedgerEntryEdges struct {
+	// Transaction holds the value of the transaction edge.
+	Transaction *CreditTransaction `json:"transaction,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// TransactionOrErr returns the Transaction value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LedgerEntryEdges) TransactionOrErr() (*CreditTransaction, error) {
+	if e.Transaction != nil {
+		return e.Transaction, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: credittransaction.Label}
+	}
+	return nil, &NotLoadedError{edge: "transaction"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LedgerEntry) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case ledgerentry.FieldAmount:
+			values[i] = new(sql.NullInt64)
+		case ledgerentry.FieldID, ledgerentry.FieldTransactionID, ledgerentry.FieldAccount:
+			values[i] = new(sql.NullString)
+		case ledgerentry.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LedgerEntry fields.
+func (_m *LedgerEntry) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case ledgerentry.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case ledgerentry.FieldTransactionID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field transaction_id", values[i])
+			} else if value.Valid {
+				_m.TransactionID = value.String
+			}
+		case ledgerentry.FieldAccount:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field account", values[i])
+			} else if value.Valid {
+				_m.Account = value.String
+			}
+		case ledgerentry.FieldAmount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value.Valid {
+				_m.Amount = int(value.Int64)
+			}
+		case ledgerentry.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LedgerEntry.
+// This includes values selected through modifiers, order, etc.
+func (_m *LedgerEntry) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryTransaction queries the "transaction" edge of the LedgerEntry entity.
+func (_m *LedgerEntry) QueryTransaction() *CreditTransactionQuery {
+	return NewLedgerEntryClient(_m.config).QueryTransaction(_m)
+}
+
+// Update returns a builder for updating this LedgerEntry.
+// Note that you need to call LedgerEntry.Unwrap() before calling this method if this LedgerEntry
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *LedgerEntry) Update() *LedgerEntryUpdateOne {
+	return NewLedgerEntryClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the LedgerEntry entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *LedgerEntry) Unwrap() *LedgerEntry {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("generated: LedgerEntry is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *LedgerEntry) String() string {
+	var builder strings.Builder
+	builder.WriteString("LedgerEntry(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("transaction_id=")
+	builder.WriteString(_m.TransactionID)
+	builder.WriteString(", ")
+	builder.WriteString("account=")
+	builder.WriteString(_m.Account)
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LedgerEntries is a parsable slice of LedgerEntry.
+type LedgerEntries []*LedgerEntry
@@ -0,0 +1,240 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/UnoraApp/be/ent/generated/conversation"
+	"github.com/UnoraApp/be/ent/generated/message"
+	"github.com/UnoraApp/be/ent/generated/user"
+)
+
+// Message is the model entity for the Message schema.
+type Message struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// ConversationID holds the value of the "conversation_id" field.
+	ConversationID string `json:"conversation_id,omitempty"`
+	// SenderUserID holds the value of the "sender_user_id" field.
+	SenderUserID string `json:"sender_user_id,omitempty"`
+	// Body holds the value of the "body" field.
+	Body string `json:"body,omitempty"`
+	// ClientMessageID holds the value of the "client_message_id" field.
+	ClientMessageID *string `json:"client_message_id,omitempty"`
+	// ModerationStatus holds the value of the "moderation_status" field.
+	ModerationStatus message.ModerationStatus `json:"moderation_status,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// ReadAt holds the value of the "read_at" field.
+	ReadAt *time.Time `json:"read_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the MessageQuery when eager-loading is set.
+	Edges        MessageEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// MessageEdges holds the relations/edges for other nodes in the graph.
+type MessageEdges struct {
+	// Conversation holds the value of the conversation edge.
+	Conversation *Conversation `json:"conversation,omitempty"`
+	// Sender holds the value of the sender edge.
+	Sender *User `json:"sender,omitempty"`
+	// ModerationActions holds the value of the moderation_actions edge.
+	ModerationActions []*ModerationAction `json:"moderation_actions,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// ConversationOrErr returns the Conversation value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MessageEdges) ConversationOrErr() (*Conversation, error) {
+	if e.Conversation != nil {
+		return e.Conversation, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: conversation.Label}
+	}
+	return nil, &NotLoadedError{edge: "conversation"}
+}
+
+// SenderOrErr returns the Sender value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MessageEdges) SenderOrErr() (*User, error) {
+	if e.Sender != nil {
+		return e.Sender, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "sender"}
+}
+
+// ModerationActionsOrErr returns the ModerationActions value or an error if the edge
+// was not loaded in eager-loading.
+func (e MessageEdges) ModerationActionsOrErr() ([]*ModerationAction, error) {
+	if e.loadedTypes[2] {
+		return e.ModerationActions, nil
+	}
+	return nil, &NotLoadedError{edge: "moderation_actions"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Message) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case message.FieldID, message.FieldConversationID, message.FieldSenderUserID, message.FieldBody, message.FieldClientMessageID, message.FieldModerationStatus:
+			values[i] = new(sql.NullString)
+		case message.FieldCreatedAt, message.FieldReadAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Message fields.
+func (_m *Message) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case message.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case message.FieldConversationID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field conversation_id", values[i])
+			} else if value.Valid {
+				_m.ConversationID = value.String
+			}
+		case message.FieldSenderUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sender_user_id", values[i])
+			} else if value.Valid {
+				_m.SenderUserID = value.String
+			}
+		case message.FieldBody:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field body", values[i])
+			} else if value.Valid {
+				_m.Body = value.String
+			}
+		case message.FieldClientMessageID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field client_message_id", values[i])
+			} else if value.Valid {
+				_m.ClientMessageID = new(string)
+				*_m.ClientMessageID = value.String
+			}
+		case message.FieldModerationStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field moderation_status", values[i])
+			} else if value.Valid {
+				_m.ModerationStatus = message.ModerationStatus(value.String)
+			}
+		case message.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case message.FieldReadAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field read_at", values[i])
+			} else if value.Valid {
+				_m.ReadAt = new(time.Time)
+				*_m.ReadAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Message.
+// This includes values selected through modifiers, order, etc.
+func (_m *Message) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryConversation queries the "conversation" edge of the Message entity.
+func (_m *Message) QueryConversation() *ConversationQuery {
+	return NewMessageClient(_m.config).QueryConversation(_m)
+}
+
+// QuerySender queries the "sender" edge of the Message entity.
+func (_m *Message) QuerySender() *UserQuery {
+	return NewMessageClient(_m.config).QuerySender(_m)
+}
+
+// QueryModerationActions queries the "moderation_actions" edge of the Message entity.
+func (_m *Message) QueryModerationActions() *ModerationActionQuery {
+	return NewMessageClient(_m.config).QueryModerationActions(_m)
+}
+
+// Update returns a builder for updating this Message.
+// Note that you need to call Message.Unwrap() before calling this method if this Message
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Message) Update() *MessageUpdateOne {
+	return NewMessageClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Message entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Message) Unwrap() *Message {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("generated: Message is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Message) String() string {
+	var builder strings.Builder
+	builder.WriteString("Message(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("conversation_id=")
+	builder.WriteString(_m.ConversationID)
+	builder.WriteString(", ")
+	builder.WriteString("sender_user_id=")
+	builder.WriteString(_m.SenderUserID)
+	builder.WriteString(", ")
+	builder.WriteString("body=")
+	builder.WriteString(_m.Body)
+	builder.WriteString(", ")
+	if v := _m.ClientMessageID; v != nil {
+		builder.WriteString("client_message_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("moderation_status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ModerationStatus))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.ReadAt; v != nil {
+		builder.WriteString("read_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Messages is a parsable slice of Message.
+type Messages []*Message
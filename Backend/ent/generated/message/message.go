@@ -0,0 +1,217 @@
+// Code generated by ent, DO NOT EDIT.
+
+package message
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the message type in the database.
+	Label = "message"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldConversationID holds the string denoting the conversation_id field in the database.
+	FieldConversationID = "conversation_id"
+	// FieldSenderUserID holds the string denoting the sender_user_id field in the database.
+	FieldSenderUserID = "sender_user_id"
+	// FieldBody holds the string denoting the body field in the database.
+	FieldBody = "body"
+	// FieldClientMessageID holds the string denoting the client_message_id field in the database.
+	FieldClientMessageID = "client_message_id"
+	// FieldModerationStatus holds the string denoting the moderation_status field in the database.
+	FieldModerationStatus = "moderation_status"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldReadAt holds the string denoting the read_at field in the database.
+	FieldReadAt = "read_at"
+	// EdgeConversation holds the string denoting the conversation edge name in mutations.
+	EdgeConversation = "conversation"
+	// EdgeSender holds the string denoting the sender edge name in mutations.
+	EdgeSender = "sender"
+	// EdgeModerationActions holds the string denoting the moderation_actions edge name in mutations.
+	EdgeModerationActions = "moderation_actions"
+	// Table holds the table name of the message in the database.
+	Table = "messages"
+	// ConversationTable is the table that holds the conversation relation/edge.
+	ConversationTable = "messages"
+	// ConversationInverseTable is the table name for the Conversation entity.
+	// It exists in this package in order to avoid circular dependency with the "conversation" package.
+	ConversationInverseTable = "conversations"
+	// ConversationColumn is the table column denoting the conversation relation/edge.
+	ConversationColumn = "conversation_id"
+	// SenderTable is the table that holds the sender relation/edge.
+	SenderTable = "messages"
+	// SenderInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	SenderInverseTable = "users"
+	// SenderColumn is the table column denoting the sender relation/edge.
+	SenderColumn = "sender_user_id"
+	// ModerationActionsTable is the table that holds the moderation_actions relation/edge.
+	ModerationActionsTable = "moderation_actions"
+	// ModerationActionsInverseTable is the table name for the ModerationAction entity.
+	// It exists in this package in order to avoid circular dependency with the "moderationaction" package.
+	ModerationActionsInverseTable = "moderation_actions"
+	// ModerationActionsColumn is the table column denoting the moderation_actions relation/edge.
+	ModerationActionsColumn = "message_id"
+)
+
+// Columns holds all SQL columns for message fields.
+var Columns = []string{
+	FieldID,
+	FieldConversationID,
+	FieldSenderUserID,
+	FieldBody,
+	FieldClientMessageID,
+	FieldModerationStatus,
+	FieldCreatedAt,
+	FieldReadAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// ConversationIDValidator is a validator for the "conversation_id" field. It is called by the builders before save.
+	ConversationIDValidator func(string) error
+	// SenderUserIDValidator is a validator for the "sender_user_id" field. It is called by the builders before save.
+	SenderUserIDValidator func(string) error
+	// BodyValidator is a validator for the "body" field. It is called by the builders before save.
+	BodyValidator func(string) error
+	// ClientMessageIDValidator is a validator for the "client_message_id" field. It is called by the builders before save.
+	ClientMessageIDValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
+
+// ModerationStatus defines the type for the "moderation_status" enum field.
+type ModerationStatus string
+
+// ModerationStatusVisible is the default value of the ModerationStatus enum.
+const DefaultModerationStatus = ModerationStatusVisible
+
+// ModerationStatus values.
+const (
+	ModerationStatusVisible  ModerationStatus = "visible"
+	ModerationStatusHeld     ModerationStatus = "held"
+	ModerationStatusRejected ModerationStatus = "rejected"
+)
+
+func (ms ModerationStatus) String() string {
+	return string(ms)
+}
+
+// ModerationStatusValidator is a validator for the "moderation_status" field enum values. It is called by the builders before save.
+func ModerationStatusValidator(ms ModerationStatus) error {
+	switch ms {
+	case ModerationStatusVisible, ModerationStatusHeld, ModerationStatusRejected:
+		return nil
+	default:
+		return fmt.Errorf("message: invalid enum value for moderation_status field: %q", ms)
+	}
+}
+
+// OrderOption defines the ordering options for the Message queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByConversationID orders the results by the conversation_id field.
+func ByConversationID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldConversationID, opts...).ToFunc()
+}
+
+// BySenderUserID orders the results by the sender_user_id field.
+func BySenderUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSenderUserID, opts...).ToFunc()
+}
+
+// ByBody orders the results by the body field.
+func ByBody(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBody, opts...).ToFunc()
+}
+
+// ByClientMessageID orders the results by the client_message_id field.
+func ByClientMessageID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClientMessageID, opts...).ToFunc()
+}
+
+// ByModerationStatus orders the results by the moderation_status field.
+func ByModerationStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldModerationStatus, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByReadAt orders the results by the read_at field.
+func ByReadAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReadAt, opts...).ToFunc()
+}
+
+// ByConversationField orders the results by conversation field.
+func ByConversationField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newConversationStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// BySenderField orders the results by sender field.
+func BySenderField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSenderStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByModerationActionsCount orders the results by moderation_actions count.
+func ByModerationActionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newModerationActionsStep(), opts...)
+	}
+}
+
+// ByModerationActions orders the results by moderation_actions terms.
+func ByModerationActions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newModerationActionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newConversationStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ConversationInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ConversationTable, ConversationColumn),
+	)
+}
+func newSenderStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SenderInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, SenderTable, SenderColumn),
+	)
+}
+func newModerationActionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ModerationActionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ModerationActionsTable, ModerationActionsColumn),
+	)
+}
@@ -0,0 +1,560 @@
+// Code generated by ent, DO NOT EDIT.
+
+package message
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/UnoraApp/be/ent/generated/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.Message {
+	return predicate.Message(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.Message {
+	return predicate.Message(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.Message {
+	return predicate.Message(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.Message {
+	return predicate.Message(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.Message {
+	return predicate.Message(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.Message {
+	return predicate.Message(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.Message {
+	return predicate.Message(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.Message {
+	return predicate.Message(sql.FieldContainsFold(FieldID, id))
+}
+
+// ConversationID applies equality check predicate on the "conversation_id" field. It's identical to ConversationIDEQ.
+func ConversationID(v string) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldConversationID, v))
+}
+
+// SenderUserID applies equality check predicate on the "sender_user_id" field. It's identical to SenderUserIDEQ.
+func SenderUserID(v string) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldSenderUserID, v))
+}
+
+// Body applies equality check predicate on the "body" field. It's identical to BodyEQ.
+func Body(v string) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldBody, v))
+}
+
+// ClientMessageID applies equality check predicate on the "client_message_id" field. It's identical to ClientMessageIDEQ.
+func ClientMessageID(v string) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldClientMessageID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ReadAt applies equality check predicate on the "read_at" field. It's identical to ReadAtEQ.
+func ReadAt(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldReadAt, v))
+}
+
+// ConversationIDEQ applies the EQ predicate on the "conversation_id" field.
+func ConversationIDEQ(v string) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldConversationID, v))
+}
+
+// ConversationIDNEQ applies the NEQ predicate on the "conversation_id" field.
+func ConversationIDNEQ(v string) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldConversationID, v))
+}
+
+// ConversationIDIn applies the In predicate on the "conversation_id" field.
+func ConversationIDIn(vs ...string) predicate.Message {
+	return predicate.Message(sql.FieldIn(FieldConversationID, vs...))
+}
+
+// ConversationIDNotIn applies the NotIn predicate on the "conversation_id" field.
+func ConversationIDNotIn(vs ...string) predicate.Message {
+	return predicate.Message(sql.FieldNotIn(FieldConversationID, vs...))
+}
+
+// ConversationIDGT applies the GT predicate on the "conversation_id" field.
+func ConversationIDGT(v string) predicate.Message {
+	return predicate.Message(sql.FieldGT(FieldConversationID, v))
+}
+
+// ConversationIDGTE applies the GTE predicate on the "conversation_id" field.
+func ConversationIDGTE(v string) predicate.Message {
+	return predicate.Message(sql.FieldGTE(FieldConversationID, v))
+}
+
+// ConversationIDLT applies the LT predicate on the "conversation_id" field.
+func ConversationIDLT(v string) predicate.Message {
+	return predicate.Message(sql.FieldLT(FieldConversationID, v))
+}
+
+// ConversationIDLTE applies the LTE predicate on the "conversation_id" field.
+func ConversationIDLTE(v string) predicate.Message {
+	return predicate.Message(sql.FieldLTE(FieldConversationID, v))
+}
+
+// ConversationIDContains applies the Contains predicate on the "conversation_id" field.
+func ConversationIDContains(v string) predicate.Message {
+	return predicate.Message(sql.FieldContains(FieldConversationID, v))
+}
+
+// ConversationIDHasPrefix applies the HasPrefix predicate on the "conversation_id" field.
+func ConversationIDHasPrefix(v string) predicate.Message {
+	return predicate.Message(sql.FieldHasPrefix(FieldConversationID, v))
+}
+
+// ConversationIDHasSuffix applies the HasSuffix predicate on the "conversation_id" field.
+func ConversationIDHasSuffix(v string) predicate.Message {
+	return predicate.Message(sql.FieldHasSuffix(FieldConversationID, v))
+}
+
+// ConversationIDEqualFold applies the EqualFold predicate on the "conversation_id" field.
+func ConversationIDEqualFold(v string) predicate.Message {
+	return predicate.Message(sql.FieldEqualFold(FieldConversationID, v))
+}
+
+// ConversationIDContainsFold applies the ContainsFold predicate on the "conversation_id" field.
+func ConversationIDContainsFold(v string) predicate.Message {
+	return predicate.Message(sql.FieldContainsFold(FieldConversationID, v))
+}
+
+// SenderUserIDEQ applies the EQ predicate on the "sender_user_id" field.
+func SenderUserIDEQ(v string) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldSenderUserID, v))
+}
+
+// SenderUserIDNEQ applies the NEQ predicate on the "sender_user_id" field.
+func SenderUserIDNEQ(v string) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldSenderUserID, v))
+}
+
+// SenderUserIDIn applies the In predicate on the "sender_user_id" field.
+func SenderUserIDIn(vs ...string) predicate.Message {
+	return predicate.Message(sql.FieldIn(FieldSenderUserID, vs...))
+}
+
+// SenderUserIDNotIn applies the NotIn predicate on the "sender_user_id" field.
+func SenderUserIDNotIn(vs ...string) predicate.Message {
+	return predicate.Message(sql.FieldNotIn(FieldSenderUserID, vs...))
+}
+
+// SenderUserIDGT applies the GT predicate on the "sender_user_id" field.
+func SenderUserIDGT(v string) predicate.Message {
+	return predicate.Message(sql.FieldGT(FieldSenderUserID, v))
+}
+
+// SenderUserIDGTE applies the GTE predicate on the "sender_user_id" field.
+func SenderUserIDGTE(v string) predicate.Message {
+	return predicate.Message(sql.FieldGTE(FieldSenderUserID, v))
+}
+
+// SenderUserIDLT applies the LT predicate on the "sender_user_id" field.
+func SenderUserIDLT(v string) predicate.Message {
+	return predicate.Message(sql.FieldLT(FieldSenderUserID, v))
+}
+
+// SenderUserIDLTE applies the LTE predicate on the "sender_user_id" field.
+func SenderUserIDLTE(v string) predicate.Message {
+	return predicate.Message(sql.FieldLTE(FieldSenderUserID, v))
+}
+
+// SenderUserIDContains applies the Contains predicate on the "sender_user_id" field.
+func SenderUserIDContains(v string) predicate.Message {
+	return predicate.Message(sql.FieldContains(FieldSenderUserID, v))
+}
+
+// SenderUserIDHasPrefix applies the HasPrefix predicate on the "sender_user_id" field.
+func SenderUserIDHasPrefix(v string) predicate.Message {
+	return predicate.Message(sql.FieldHasPrefix(FieldSenderUserID, v))
+}
+
+// SenderUserIDHasSuffix applies the HasSuffix predicate on the "sender_user_id" field.
+func SenderUserIDHasSuffix(v string) predicate.Message {
+	return predicate.Message(sql.FieldHasSuffix(FieldSenderUserID, v))
+}
+
+// SenderUserIDEqualFold applies the EqualFold predicate on the "sender_user_id" field.
+func SenderUserIDEqualFold(v string) predicate.Message {
+	return predicate.Message(sql.FieldEqualFold(FieldSenderUserID, v))
+}
+
+// SenderUserIDContainsFold applies the ContainsFold predicate on the "sender_user_id" field.
+func SenderUserIDContainsFold(v string) predicate.Message {
+	return predicate.Message(sql.FieldContainsFold(FieldSenderUserID, v))
+}
+
+// BodyEQ applies the EQ predicate on the "body" field.
+func BodyEQ(v string) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldBody, v))
+}
+
+// BodyNEQ applies the NEQ predicate on the "body" field.
+func BodyNEQ(v string) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldBody, v))
+}
+
+// BodyIn applies the In predicate on the "body" field.
+func BodyIn(vs ...string) predicate.Message {
+	return predicate.Message(sql.FieldIn(FieldBody, vs...))
+}
+
+// BodyNotIn applies the NotIn predicate on the "body" field.
+func BodyNotIn(vs ...string) predicate.Message {
+	return predicate.Message(sql.FieldNotIn(FieldBody, vs...))
+}
+
+// BodyGT applies the GT predicate on the "body" field.
+func BodyGT(v string) predicate.Message {
+	return predicate.Message(sql.FieldGT(FieldBody, v))
+}
+
+// BodyGTE applies the GTE predicate on the "body" field.
+func BodyGTE(v string) predicate.Message {
+	return predicate.Message(sql.FieldGTE(FieldBody, v))
+}
+
+// BodyLT applies the LT predicate on the "body" field.
+func BodyLT(v string) predicate.Message {
+	return predicate.Message(sql.FieldLT(FieldBody, v))
+}
+
+// BodyLTE applies the LTE predicate on the "body" field.
+func BodyLTE(v string) predicate.Message {
+	return predicate.Message(sql.FieldLTE(FieldBody, v))
+}
+
+// BodyContains applies the Contains predicate on the "body" field.
+func BodyContains(v string) predicate.Message {
+	return predicate.Message(sql.FieldContains(FieldBody, v))
+}
+
+// BodyHasPrefix applies the HasPrefix predicate on the "body" field.
+func BodyHasPrefix(v string) predicate.Message {
+	return predicate.Message(sql.FieldHasPrefix(FieldBody, v))
+}
+
+// BodyHasSuffix applies the HasSuffix predicate on the "body" field.
+func BodyHasSuffix(v string) predicate.Message {
+	return predicate.Message(sql.FieldHasSuffix(FieldBody, v))
+}
+
+// BodyEqualFold applies the EqualFold predicate on the "body" field.
+func BodyEqualFold(v string) predicate.Message {
+	return predicate.Message(sql.FieldEqualFold(FieldBody, v))
+}
+
+// BodyContainsFold applies the ContainsFold predicate on the "body" field.
+func BodyContainsFold(v string) predicate.Message {
+	return predicate.Message(sql.FieldContainsFold(FieldBody, v))
+}
+
+// ClientMessageIDEQ applies the EQ predicate on the "client_message_id" field.
+func ClientMessageIDEQ(v string) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldClientMessageID, v))
+}
+
+// ClientMessageIDNEQ applies the NEQ predicate on the "client_message_id" field.
+func ClientMessageIDNEQ(v string) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldClientMessageID, v))
+}
+
+// ClientMessageIDIn applies the In predicate on the "client_message_id" field.
+func ClientMessageIDIn(vs ...string) predicate.Message {
+	return predicate.Message(sql.FieldIn(FieldClientMessageID, vs...))
+}
+
+// ClientMessageIDNotIn applies the NotIn predicate on the "client_message_id" field.
+func ClientMessageIDNotIn(vs ...string) predicate.Message {
+	return predicate.Message(sql.FieldNotIn(FieldClientMessageID, vs...))
+}
+
+// ClientMessageIDGT applies the GT predicate on the "client_message_id" field.
+func ClientMessageIDGT(v string) predicate.Message {
+	return predicate.Message(sql.FieldGT(FieldClientMessageID, v))
+}
+
+// ClientMessageIDGTE applies the GTE predicate on the "client_message_id" field.
+func ClientMessageIDGTE(v string) predicate.Message {
+	return predicate.Message(sql.FieldGTE(FieldClientMessageID, v))
+}
+
+// ClientMessageIDLT applies the LT predicate on the "client_message_id" field.
+func ClientMessageIDLT(v string) predicate.Message {
+	return predicate.Message(sql.FieldLT(FieldClientMessageID, v))
+}
+
+// ClientMessageIDLTE applies the LTE predicate on the "client_message_id" field.
+func ClientMessageIDLTE(v string) predicate.Message {
+	return predicate.Message(sql.FieldLTE(FieldClientMessageID, v))
+}
+
+// ClientMessageIDContains applies the Contains predicate on the "client_message_id" field.
+func ClientMessageIDContains(v string) predicate.Message {
+	return predicate.Message(sql.FieldContains(FieldClientMessageID, v))
+}
+
+// ClientMessageIDHasPrefix applies the HasPrefix predicate on the "client_message_id" field.
+func ClientMessageIDHasPrefix(v string) predicate.Message {
+	return predicate.Message(sql.FieldHasPrefix(FieldClientMessageID, v))
+}
+
+// ClientMessageIDHasSuffix applies the HasSuffix predicate on the "client_message_id" field.
+func ClientMessageIDHasSuffix(v string) predicate.Message {
+	return predicate.Message(sql.FieldHasSuffix(FieldClientMessageID, v))
+}
+
+// ClientMessageIDIsNil applies the IsNil predicate on the "client_message_id" field.
+func ClientMessageIDIsNil() predicate.Message {
+	return predicate.Message(sql.FieldIsNull(FieldClientMessageID))
+}
+
+// ClientMessageIDNotNil applies the NotNil predicate on the "client_message_id" field.
+func ClientMessageIDNotNil() predicate.Message {
+	return predicate.Message(sql.FieldNotNull(FieldClientMessageID))
+}
+
+// ClientMessageIDEqualFold applies the EqualFold predicate on the "client_message_id" field.
+func ClientMessageIDEqualFold(v string) predicate.Message {
+	return predicate.Message(sql.FieldEqualFold(FieldClientMessageID, v))
+}
+
+// ClientMessageIDContainsFold applies the ContainsFold predicate on the "client_message_id" field.
+func ClientMessageIDContainsFold(v string) predicate.Message {
+	return predicate.Message(sql.FieldContainsFold(FieldClientMessageID, v))
+}
+
+// ModerationStatusEQ applies the EQ predicate on the "moderation_status" field.
+func ModerationStatusEQ(v ModerationStatus) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldModerationStatus, v))
+}
+
+// ModerationStatusNEQ applies the NEQ predicate on the "moderation_status" field.
+func ModerationStatusNEQ(v ModerationStatus) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldModerationStatus, v))
+}
+
+// ModerationStatusIn applies the In predicate on the "moderation_status" field.
+func ModerationStatusIn(vs ...ModerationStatus) predicate.Message {
+	return predicate.Message(sql.FieldIn(FieldModerationStatus, vs...))
+}
+
+// ModerationStatusNotIn applies the NotIn predicate on the "moderation_status" field.
+func ModerationStatusNotIn(vs ...ModerationStatus) predicate.Message {
+	return predicate.Message(sql.FieldNotIn(FieldModerationStatus, vs...))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Message {
+	return predicate.Message(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Message {
+	return predicate.Message(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// ReadAtEQ applies the EQ predicate on the "read_at" field.
+func ReadAtEQ(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldReadAt, v))
+}
+
+// ReadAtNEQ applies the NEQ predicate on the "read_at" field.
+func ReadAtNEQ(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldReadAt, v))
+}
+
+// ReadAtIn applies the In predicate on the "read_at" field.
+func ReadAtIn(vs ...time.Time) predicate.Message {
+	return predicate.Message(sql.FieldIn(FieldReadAt, vs...))
+}
+
+// ReadAtNotIn applies the NotIn predicate on the "read_at" field.
+func ReadAtNotIn(vs ...time.Time) predicate.Message {
+	return predicate.Message(sql.FieldNotIn(FieldReadAt, vs...))
+}
+
+// ReadAtGT applies the GT predicate on the "read_at" field.
+func ReadAtGT(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldGT(FieldReadAt, v))
+}
+
+// ReadAtGTE applies the GTE predicate on the "read_at" field.
+func ReadAtGTE(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldGTE(FieldReadAt, v))
+}
+
+// ReadAtLT applies the LT predicate on the "read_at" field.
+func ReadAtLT(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldLT(FieldReadAt, v))
+}
+
+// ReadAtLTE applies the LTE predicate on the "read_at" field.
+func ReadAtLTE(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldLTE(FieldReadAt, v))
+}
+
+// ReadAtIsNil applies the IsNil predicate on the "read_at" field.
+func ReadAtIsNil() predicate.Message {
+	return predicate.Message(sql.FieldIsNull(FieldReadAt))
+}
+
+// ReadAtNotNil applies the NotNil predicate on the "read_at" field.
+func ReadAtNotNil() predicate.Message {
+	return predicate.Message(sql.FieldNotNull(FieldReadAt))
+}
+
+// HasConversation applies the HasEdge predicate on the "conversation" edge.
+func HasConversation() predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ConversationTable, ConversationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasConversationWith applies the HasEdge predicate on the "conversation" edge with a given conditions (other predicates).
+func HasConversationWith(preds ...predicate.Conversation) predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := newConversationStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSender applies the HasEdge predicate on the "sender" edge.
+func HasSender() predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, SenderTable, SenderColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSenderWith applies the HasEdge predicate on the "sender" edge with a given conditions (other predicates).
+func HasSenderWith(preds ...predicate.User) predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := newSenderStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasModerationActions applies the HasEdge predicate on the "moderation_actions" edge.
+func HasModerationActions() predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ModerationActionsTable, ModerationActionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasModerationActionsWith applies the HasEdge predicate on the "moderation_actions" edge with a given conditions (other predicates).
+func HasModerationActionsWith(preds ...predicate.ModerationAction) predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := newModerationActionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Message) predicate.Message {
+	return predicate.Message(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Message) predicate.Message {
+	return predicate.Message(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Message) predicate.Message {
+	return predicate.Message(sql.NotPredicates(p))
+}
@@ -0,0 +1,898 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/UnoraApp/be/ent/generated/conversation"
+	"github.com/UnoraApp/be/ent/generated/message"
+	"github.com/UnoraApp/be/ent/generated/moderationaction"
+	"github.com/UnoraApp/be/ent/generated/user"
+)
+
+// MessageCreate is the builder for creating a Message entity.
+type MessageCreate struct {
+	config
+	mutation *MessageMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetConversationID sets the "conversation_id" field.
+func (_c *MessageCreate) SetConversationID(v string) *MessageCreate {
+	_c.mutation.SetConversationID(v)
+	return _c
+}
+
+// SetSenderUserID sets the "sender_user_id" field.
+func (_c *MessageCreate) SetSenderUserID(v string) *MessageCreate {
+	_c.mutation.SetSenderUserID(v)
+	return _c
+}
+
+// SetBody sets the "body" field.
+func (_c *MessageCreate) SetBody(v string) *MessageCreate {
+	_c.mutation.SetBody(v)
+	return _c
+}
+
+// SetClientMessageID sets the "client_message_id" field.
+func (_c *MessageCreate) SetClientMessageID(v string) *MessageCreate {
+	_c.mutation.SetClientMessageID(v)
+	return _c
+}
+
+// SetNillableClientMessageID sets the "client_message_id" field if the given value is not nil.
+func (_c *MessageCreate) SetNillableClientMessageID(v *string) *MessageCreate {
+	if v != nil {
+		_c.SetClientMessageID(*v)
+	}
+	return _c
+}
+
+// SetModerationStatus sets the "moderation_status" field.
+func (_c *MessageCreate) SetModerationStatus(v message.ModerationStatus) *MessageCreate {
+	_c.mutation.SetModerationStatus(v)
+	return _c
+}
+
+// SetNillableModerationStatus sets the "moderation_status" field if the given value is not nil.
+func (_c *MessageCreate) SetNillableModerationStatus(v *message.ModerationStatus) *MessageCreate {
+	if v != nil {
+		_c.SetModerationStatus(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *MessageCreate) SetCreatedAt(v time.Time) *MessageCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *MessageCreate) SetNillableCreatedAt(v *time.Time) *MessageCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetReadAt sets the "read_at" field.
+func (_c *MessageCreate) SetReadAt(v time.Time) *MessageCreate {
+	_c.mutation.SetReadAt(v)
+	return _c
+}
+
+// SetNillableReadAt sets the "read_at" field if the given value is not nil.
+func (_c *MessageCreate) SetNillableReadAt(v *time.Time) *MessageCreate {
+	if v != nil {
+		_c.SetReadAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *MessageCreate) SetID(v string) *MessageCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetConversation sets the "conversation" edge to the Conversation entity.
+func (_c *MessageCreate) SetConversation(v *Conversation) *MessageCreate {
+	return _c.SetConversationID(v.ID)
+}
+
+// SetSenderID sets the "sender" edge to the User entity by ID.
+func (_c *MessageCreate) SetSenderID(id string) *MessageCreate {
+	_c.mutation.SetSenderID(id)
+	return _c
+}
+
+// SetSender sets the "sender" edge to the User entity.
+func (_c *MessageCreate) SetSender(v *User) *MessageCreate {
+	return _c.SetSenderID(v.ID)
+}
+
+// AddModerationActionIDs adds the "moderation_actions" edge to the ModerationAction entity by IDs.
+func (_c *MessageCreate) AddModerationActionIDs(ids ...string) *MessageCreate {
+	_c.mutation.AddModerationActionIDs(ids...)
+	return _c
+}
+
+// AddModerationActions adds the "moderation_actions" edges to the ModerationAction entity.
+func (_c *MessageCreate) AddModerationActions(v ...*ModerationAction) *MessageCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddModerationActionIDs(ids...)
+}
+
+// Mutation returns the MessageMutation object of the builder.
+func (_c *MessageCreate) Mutation() *MessageMutation {
+	return _c.mutation
+}
+
+// Save creates the Message in the database.
+func (_c *MessageCreate) Save(ctx context.Context) (*Message, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *MessageCreate) SaveX(ctx context.Context) *Message {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *MessageCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *MessageCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *MessageCreate) defaults() {
+	if _, ok := _c.mutation.ModerationStatus(); !ok {
+		v := message.DefaultModerationStatus
+		_c.mutation.SetModerationStatus(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := message.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *MessageCreate) check() error {
+	if _, ok := _c.mutation.ConversationID(); !ok {
+		return &ValidationError{Name: "conversation_id", err: errors.New(`generated: missing required field "Message.conversation_id"`)}
+	}
+	if v, ok := _c.mutation.ConversationID(); ok {
+		if err := message.ConversationIDValidator(v); err != nil {
+			return &ValidationError{Name: "conversation_id", err: fmt.Errorf(`generated: validator failed for field "Message.conversation_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SenderUserID(); !ok {
+		return &ValidationError{Name: "sender_user_id", err: errors.New(`generated: missing required field "Message.sender_user_id"`)}
+	}
+	if v, ok := _c.mutation.SenderUserID(); ok {
+		if err := message.SenderUserIDValidator(v); err != nil {
+			return &ValidationError{Name: "sender_user_id", err: fmt.Errorf(`generated: validator failed for field "Message.sender_user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Body(); !ok {
+		return &ValidationError{Name: "body", err: errors.New(`generated: missing required field "Message.body"`)}
+	}
+	if v, ok := _c.mutation.Body(); ok {
+		if err := message.BodyValidator(v); err != nil {
+			return &ValidationError{Name: "body", err: fmt.Errorf(`generated: validator failed for field "Message.body": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ClientMessageID(); ok {
+		if err := message.ClientMessageIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_message_id", err: fmt.Errorf(`generated: validator failed for field "Message.client_message_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ModerationStatus(); !ok {
+		return &ValidationError{Name: "moderation_status", err: errors.New(`generated: missing required field "Message.moderation_status"`)}
+	}
+	if v, ok := _c.mutation.ModerationStatus(); ok {
+		if err := message.ModerationStatusValidator(v); err != nil {
+			return &ValidationError{Name: "moderation_status", err: fmt.Errorf(`generated: validator failed for field "Message.moderation_status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "Message.created_at"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := message.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`generated: validator failed for field "Message.id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.ConversationIDs()) == 0 {
+		return &ValidationError{Name: "conversation", err: errors.New(`generated: missing required edge "Message.conversation"`)}
+	}
+	if len(_c.mutation.SenderIDs()) == 0 {
+		return &ValidationError{Name: "sender", err: errors.New(`generated: missing required edge "Message.sender"`)}
+	}
+	return nil
+}
+
+func (_c *MessageCreate) sqlSave(ctx context.Context) (*Message, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Message.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *MessageCreate) createSpec() (*Message, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Message{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(message.Table, sqlgraph.NewFieldSpec(message.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.Body(); ok {
+		_spec.SetField(message.FieldBody, field.TypeString, value)
+		_node.Body = value
+	}
+	if value, ok := _c.mutation.ClientMessageID(); ok {
+		_spec.SetField(message.FieldClientMessageID, field.TypeString, value)
+		_node.ClientMessageID = &value
+	}
+	if value, ok := _c.mutation.ModerationStatus(); ok {
+		_spec.SetField(message.FieldModerationStatus, field.TypeEnum, value)
+		_node.ModerationStatus = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(message.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.ReadAt(); ok {
+		_spec.SetField(message.FieldReadAt, field.TypeTime, value)
+		_node.ReadAt = &value
+	}
+	if nodes := _c.mutation.ConversationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   message.ConversationTable,
+			Columns: []string{message.ConversationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(conversation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ConversationID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.SenderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   message.SenderTable,
+			Columns: []string{message.SenderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.SenderUserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ModerationActionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.ModerationActionsTable,
+			Columns: []string{message.ModerationActionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(moderationaction.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Message.Create().
+//		SetConversationID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MessageUpsert) {
+//			SetConversationID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *MessageCreate) OnConflict(opts ...sql.ConflictOption) *MessageUpsertOne {
+	_c.conflict = opts
+	return &MessageUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Message.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *MessageCreate) OnConflictColumns(columns ...string) *MessageUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &MessageUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// MessageUpsertOne is the builder for "upsert"-ing
+	//  one Message node.
+	MessageUpsertOne struct {
+		create *MessageCreate
+	}
+
+	// MessageUpsert is the "OnConflict" setter.
+	MessageUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetBody sets the "body" field.
+func (u *MessageUpsert) SetBody(v string) *MessageUpsert {
+	u.Set(message.FieldBody, v)
+	return u
+}
+
+// UpdateBody sets the "body" field to the value that was provided on create.
+func (u *MessageUpsert) UpdateBody() *MessageUpsert {
+	u.SetExcluded(message.FieldBody)
+	return u
+}
+
+// SetClientMessageID sets the "client_message_id" field.
+func (u *MessageUpsert) SetClientMessageID(v string) *MessageUpsert {
+	u.Set(message.FieldClientMessageID, v)
+	return u
+}
+
+// UpdateClientMessageID sets the "client_message_id" field to the value that was provided on create.
+func (u *MessageUpsert) UpdateClientMessageID() *MessageUpsert {
+	u.SetExcluded(message.FieldClientMessageID)
+	return u
+}
+
+// ClearClientMessageID clears the value of the "client_message_id" field.
+func (u *MessageUpsert) ClearClientMessageID() *MessageUpsert {
+	u.SetNull(message.FieldClientMessageID)
+	return u
+}
+
+// SetModerationStatus sets the "moderation_status" field.
+func (u *MessageUpsert) SetModerationStatus(v message.ModerationStatus) *MessageUpsert {
+	u.Set(message.FieldModerationStatus, v)
+	return u
+}
+
+// UpdateModerationStatus sets the "moderation_status" field to the value that was provided on create.
+func (u *MessageUpsert) UpdateModerationStatus() *MessageUpsert {
+	u.SetExcluded(message.FieldModerationStatus)
+	return u
+}
+
+// SetReadAt sets the "read_at" field.
+func (u *MessageUpsert) SetReadAt(v time.Time) *MessageUpsert {
+	u.Set(message.FieldReadAt, v)
+	return u
+}
+
+// UpdateReadAt sets the "read_at" field to the value that was provided on create.
+func (u *MessageUpsert) UpdateReadAt() *MessageUpsert {
+	u.SetExcluded(message.FieldReadAt)
+	return u
+}
+
+// ClearReadAt clears the value of the "read_at" field.
+func (u *MessageUpsert) ClearReadAt() *MessageUpsert {
+	u.SetNull(message.FieldReadAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Message.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(message.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *MessageUpsertOne) UpdateNewValues() *MessageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(message.FieldID)
+		}
+		if _, exists := u.create.mutation.ConversationID(); exists {
+			s.SetIgnore(message.FieldConversationID)
+		}
+		if _, exists := u.create.mutation.SenderUserID(); exists {
+			s.SetIgnore(message.FieldSenderUserID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(message.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Message.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *MessageUpsertOne) Ignore() *MessageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MessageUpsertOne) DoNothing() *MessageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MessageCreate.OnConflict
+// documentation for more info.
+func (u *MessageUpsertOne) Update(set func(*MessageUpsert)) *MessageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MessageUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetBody sets the "body" field.
+func (u *MessageUpsertOne) SetBody(v string) *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetBody(v)
+	})
+}
+
+// UpdateBody sets the "body" field to the value that was provided on create.
+func (u *MessageUpsertOne) UpdateBody() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateBody()
+	})
+}
+
+// SetClientMessageID sets the "client_message_id" field.
+func (u *MessageUpsertOne) SetClientMessageID(v string) *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetClientMessageID(v)
+	})
+}
+
+// UpdateClientMessageID sets the "client_message_id" field to the value that was provided on create.
+func (u *MessageUpsertOne) UpdateClientMessageID() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateClientMessageID()
+	})
+}
+
+// ClearClientMessageID clears the value of the "client_message_id" field.
+func (u *MessageUpsertOne) ClearClientMessageID() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.ClearClientMessageID()
+	})
+}
+
+// SetModerationStatus sets the "moderation_status" field.
+func (u *MessageUpsertOne) SetModerationStatus(v message.ModerationStatus) *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetModerationStatus(v)
+	})
+}
+
+// UpdateModerationStatus sets the "moderation_status" field to the value that was provided on create.
+func (u *MessageUpsertOne) UpdateModerationStatus() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateModerationStatus()
+	})
+}
+
+// SetReadAt sets the "read_at" field.
+func (u *MessageUpsertOne) SetReadAt(v time.Time) *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetReadAt(v)
+	})
+}
+
+// UpdateReadAt sets the "read_at" field to the value that was provided on create.
+func (u *MessageUpsertOne) UpdateReadAt() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateReadAt()
+	})
+}
+
+// ClearReadAt clears the value of the "read_at" field.
+func (u *MessageUpsertOne) ClearReadAt() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.ClearReadAt()
+	})
+}
+
+// Exec executes the query.
+func (u *MessageUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("generated: missing options for MessageCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MessageUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *MessageUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("generated: MessageUpsertOne.ID is not supported by MySQL driver. Use MessageUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *MessageUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// MessageCreateBulk is the builder for creating many Message entities in bulk.
+type MessageCreateBulk struct {
+	config
+	err      error
+	builders []*MessageCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Message entities in the database.
+func (_c *MessageCreateBulk) Save(ctx context.Context) ([]*Message, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Message, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MessageMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *MessageCreateBulk) SaveX(ctx context.Context) []*Message {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *MessageCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *MessageCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Message.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MessageUpsert) {
+//			SetConversationID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *MessageCreateBulk) OnConflict(opts ...sql.ConflictOption) *MessageUpsertBulk {
+	_c.conflict = opts
+	return &MessageUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Message.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *MessageCreateBulk) OnConflictColumns(columns ...string) *MessageUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &MessageUpsertBulk{
+		create: _c,
+	}
+}
+
+// MessageUpsertBulk is the builder for "upsert"-ing
+// a bulk of Message nodes.
+type MessageUpsertBulk struct {
+	create *MessageCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Message.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(message.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *MessageUpsertBulk) UpdateNewValues() *MessageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(message.FieldID)
+			}
+			if _, exists := b.mutation.ConversationID(); exists {
+				s.SetIgnore(message.FieldConversationID)
+			}
+			if _, exists := b.mutation.SenderUserID(); exists {
+				s.SetIgnore(message.FieldSenderUserID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(message.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Message.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *MessageUpsertBulk) Ignore() *MessageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MessageUpsertBulk) DoNothing() *MessageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MessageCreateBulk.OnConflict
+// documentation for more info.
+func (u *MessageUpsertBulk) Update(set func(*MessageUpsert)) *MessageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MessageUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetBody sets the "body" field.
+func (u *MessageUpsertBulk) SetBody(v string) *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetBody(v)
+	})
+}
+
+// UpdateBody sets the "body" field to the value that was provided on create.
+func (u *MessageUpsertBulk) UpdateBody() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateBody()
+	})
+}
+
+// SetClientMessageID sets the "client_message_id" field.
+func (u *MessageUpsertBulk) SetClientMessageID(v string) *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetClientMessageID(v)
+	})
+}
+
+// UpdateClientMessageID sets the "client_message_id" field to the value that was provided on create.
+func (u *MessageUpsertBulk) UpdateClientMessageID() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateClientMessageID()
+	})
+}
+
+// ClearClientMessageID clears the value of the "client_message_id" field.
+func (u *MessageUpsertBulk) ClearClientMessageID() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.ClearClientMessageID()
+	})
+}
+
+// SetModerationStatus sets the "moderation_status" field.
+func (u *MessageUpsertBulk) SetModerationStatus(v message.ModerationStatus) *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetModerationStatus(v)
+	})
+}
+
+// UpdateModerationStatus sets the "moderation_status" field to the value that was provided on create.
+func (u *MessageUpsertBulk) UpdateModerationStatus() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateModerationStatus()
+	})
+}
+
+// SetReadAt sets the "read_at" field.
+func (u *MessageUpsertBulk) SetReadAt(v time.Time) *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetReadAt(v)
+	})
+}
+
+// UpdateReadAt sets the "read_at" field to the value that was provided on create.
+func (u *MessageUpsertBulk) UpdateReadAt() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateReadAt()
+	})
+}
+
+// ClearReadAt clears the value of the "read_at" field.
+func (u *MessageUpsertBulk) ClearReadAt() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.ClearReadAt()
+	})
+}
+
+// Exec executes the query.
+func (u *MessageUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("generated: OnConflict was set for builder %d. Set it on the MessageCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("generated: missing options for MessageCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MessageUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
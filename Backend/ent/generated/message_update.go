@@ -0,0 +1,569 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/UnoraApp/be/ent/generated/message"
+	"github.com/UnoraApp/be/ent/generated/moderationaction"
+	"github.com/UnoraApp/be/ent/generated/predicate"
+)
+
+// MessageUpdate is the builder for updating Message entities.
+type MessageUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MessageMutation
+}
+
+// Where appends a list predicates to the MessageUpdate builder.
+func (_u *MessageUpdate) Where(ps ...predicate.Message) *MessageUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetBody sets the "body" field.
+func (_u *MessageUpdate) SetBody(v string) *MessageUpdate {
+	_u.mutation.SetBody(v)
+	return _u
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (_u *MessageUpdate) SetNillableBody(v *string) *MessageUpdate {
+	if v != nil {
+		_u.SetBody(*v)
+	}
+	return _u
+}
+
+// SetClientMessageID sets the "client_message_id" field.
+func (_u *MessageUpdate) SetClientMessageID(v string) *MessageUpdate {
+	_u.mutation.SetClientMessageID(v)
+	return _u
+}
+
+// SetNillableClientMessageID sets the "client_message_id" field if the given value is not nil.
+func (_u *MessageUpdate) SetNillableClientMessageID(v *string) *MessageUpdate {
+	if v != nil {
+		_u.SetClientMessageID(*v)
+	}
+	return _u
+}
+
+// ClearClientMessageID clears the value of the "client_message_id" field.
+func (_u *MessageUpdate) ClearClientMessageID() *MessageUpdate {
+	_u.mutation.ClearClientMessageID()
+	return _u
+}
+
+// SetModerationStatus sets the "moderation_status" field.
+func (_u *MessageUpdate) SetModerationStatus(v message.ModerationStatus) *MessageUpdate {
+	_u.mutation.SetModerationStatus(v)
+	return _u
+}
+
+// SetNillableModerationStatus sets the "moderation_status" field if the given value is not nil.
+func (_u *MessageUpdate) SetNillableModerationStatus(v *message.ModerationStatus) *MessageUpdate {
+	if v != nil {
+		_u.SetModerationStatus(*v)
+	}
+	return _u
+}
+
+// SetReadAt sets the "read_at" field.
+func (_u *MessageUpdate) SetReadAt(v time.Time) *MessageUpdate {
+	_u.mutation.SetReadAt(v)
+	return _u
+}
+
+// SetNillableReadAt sets the "read_at" field if the given value is not nil.
+func (_u *MessageUpdate) SetNillableReadAt(v *time.Time) *MessageUpdate {
+	if v != nil {
+		_u.SetReadAt(*v)
+	}
+	return _u
+}
+
+// ClearReadAt clears the value of the "read_at" field.
+func (_u *MessageUpdate) ClearReadAt() *MessageUpdate {
+	_u.mutation.ClearReadAt()
+	return _u
+}
+
+// AddModerationActionIDs adds the "moderation_actions" edge to the ModerationAction entity by IDs.
+func (_u *MessageUpdate) AddModerationActionIDs(ids ...string) *MessageUpdate {
+	_u.mutation.AddModerationActionIDs(ids...)
+	return _u
+}
+
+// AddModerationActions adds the "moderation_actions" edges to the ModerationAction entity.
+func (_u *MessageUpdate) AddModerationActions(v ...*ModerationAction) *MessageUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddModerationActionIDs(ids...)
+}
+
+// Mutation returns the MessageMutation object of the builder.
+func (_u *MessageUpdate) Mutation() *MessageMutation {
+	return _u.mutation
+}
+
+// ClearModerationActions clears all "moderation_actions" edges to the ModerationAction entity.
+func (_u *MessageUpdate) ClearModerationActions() *MessageUpdate {
+	_u.mutation.ClearModerationActions()
+	return _u
+}
+
+// RemoveModerationActionIDs removes the "moderation_actions" edge to ModerationAction entities by IDs.
+func (_u *MessageUpdate) RemoveModerationActionIDs(ids ...string) *MessageUpdate {
+	_u.mutation.RemoveModerationActionIDs(ids...)
+	return _u
+}
+
+// RemoveModerationActions removes "moderation_actions" edges to ModerationAction entities.
+func (_u *MessageUpdate) RemoveModerationActions(v ...*ModerationAction) *MessageUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveModerationActionIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *MessageUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MessageUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *MessageUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MessageUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MessageUpdate) check() error {
+	if v, ok := _u.mutation.Body(); ok {
+		if err := message.BodyValidator(v); err != nil {
+			return &ValidationError{Name: "body", err: fmt.Errorf(`generated: validator failed for field "Message.body": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ClientMessageID(); ok {
+		if err := message.ClientMessageIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_message_id", err: fmt.Errorf(`generated: validator failed for field "Message.client_message_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ModerationStatus(); ok {
+		if err := message.ModerationStatusValidator(v); err != nil {
+			return &ValidationError{Name: "moderation_status", err: fmt.Errorf(`generated: validator failed for field "Message.moderation_status": %w`, err)}
+		}
+	}
+	if _u.mutation.ConversationCleared() && len(_u.mutation.ConversationIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "Message.conversation"`)
+	}
+	if _u.mutation.SenderCleared() && len(_u.mutation.SenderIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "Message.sender"`)
+	}
+	return nil
+}
+
+func (_u *MessageUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(message.Table, message.Columns, sqlgraph.NewFieldSpec(message.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Body(); ok {
+		_spec.SetField(message.FieldBody, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ClientMessageID(); ok {
+		_spec.SetField(message.FieldClientMessageID, field.TypeString, value)
+	}
+	if _u.mutation.ClientMessageIDCleared() {
+		_spec.ClearField(message.FieldClientMessageID, field.TypeString)
+	}
+	if value, ok := _u.mutation.ModerationStatus(); ok {
+		_spec.SetField(message.FieldModerationStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ReadAt(); ok {
+		_spec.SetField(message.FieldReadAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReadAtCleared() {
+		_spec.ClearField(message.FieldReadAt, field.TypeTime)
+	}
+	if _u.mutation.ModerationActionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.ModerationActionsTable,
+			Columns: []string{message.ModerationActionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(moderationaction.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedModerationActionsIDs(); len(nodes) > 0 && !_u.mutation.ModerationActionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.ModerationActionsTable,
+			Columns: []string{message.ModerationActionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(moderationaction.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ModerationActionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.ModerationActionsTable,
+			Columns: []string{message.ModerationActionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(moderationaction.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{message.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// MessageUpdateOne is the builder for updating a single Message entity.
+type MessageUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MessageMutation
+}
+
+// SetBody sets the "body" field.
+func (_u *MessageUpdateOne) SetBody(v string) *MessageUpdateOne {
+	_u.mutation.SetBody(v)
+	return _u
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (_u *MessageUpdateOne) SetNillableBody(v *string) *MessageUpdateOne {
+	if v != nil {
+		_u.SetBody(*v)
+	}
+	return _u
+}
+
+// SetClientMessageID sets the "client_message_id" field.
+func (_u *MessageUpdateOne) SetClientMessageID(v string) *MessageUpdateOne {
+	_u.mutation.SetClientMessageID(v)
+	return _u
+}
+
+// SetNillableClientMessageID sets the "client_message_id" field if the given value is not nil.
+func (_u *MessageUpdateOne) SetNillableClientMessageID(v *string) *MessageUpdateOne {
+	if v != nil {
+		_u.SetClientMessageID(*v)
+	}
+	return _u
+}
+
+// ClearClientMessageID clears the value of the "client_message_id" field.
+func (_u *MessageUpdateOne) ClearClientMessageID() *MessageUpdateOne {
+	_u.mutation.ClearClientMessageID()
+	return _u
+}
+
+// SetModerationStatus sets the "moderation_status" field.
+func (_u *MessageUpdateOne) SetModerationStatus(v message.ModerationStatus) *MessageUpdateOne {
+	_u.mutation.SetModerationStatus(v)
+	return _u
+}
+
+// SetNillableModerationStatus sets the "moderation_status" field if the given value is not nil.
+func (_u *MessageUpdateOne) SetNillableModerationStatus(v *message.ModerationStatus) *MessageUpdateOne {
+	if v != nil {
+		_u.SetModerationStatus(*v)
+	}
+	return _u
+}
+
+// SetReadAt sets the "read_at" field.
+func (_u *MessageUpdateOne) SetReadAt(v time.Time) *MessageUpdateOne {
+	_u.mutation.SetReadAt(v)
+	return _u
+}
+
+// SetNillableReadAt sets the "read_at" field if the given value is not nil.
+func (_u *MessageUpdateOne) SetNillableReadAt(v *time.Time) *MessageUpdateOne {
+	if v != nil {
+		_u.SetReadAt(*v)
+	}
+	return _u
+}
+
+// ClearReadAt clears the value of the "read_at" field.
+func (_u *MessageUpdateOne) ClearReadAt() *MessageUpdateOne {
+	_u.mutation.ClearReadAt()
+	return _u
+}
+
+// AddModerationActionIDs adds the "moderation_actions" edge to the ModerationAction entity by IDs.
+func (_u *MessageUpdateOne) AddModerationActionIDs(ids ...string) *MessageUpdateOne {
+	_u.mutation.AddModerationActionIDs(ids...)
+	return _u
+}
+
+// AddModerationActions adds the "moderation_actions" edges to the ModerationAction entity.
+func (_u *MessageUpdateOne) AddModerationActions(v ...*ModerationAction) *MessageUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddModerationActionIDs(ids...)
+}
+
+// Mutation returns the MessageMutation object of the builder.
+func (_u *MessageUpdateOne) Mutation() *MessageMutation {
+	return _u.mutation
+}
+
+// ClearModerationActions clears all "moderation_actions" edges to the ModerationAction entity.
+func (_u *MessageUpdateOne) ClearModerationActions() *MessageUpdateOne {
+	_u.mutation.ClearModerationActions()
+	return _u
+}
+
+// RemoveModerationActionIDs removes the "moderation_actions" edge to ModerationAction entities by IDs.
+func (_u *MessageUpdateOne) RemoveModerationActionIDs(ids ...string) *MessageUpdateOne {
+	_u.mutation.RemoveModerationActionIDs(ids...)
+	return _u
+}
+
+// RemoveModerationActions removes "moderation_actions" edges to ModerationAction entities.
+func (_u *MessageUpdateOne) RemoveModerationActions(v ...*ModerationAction) *MessageUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveModerationActionIDs(ids...)
+}
+
+// Where appends a list predicates to the MessageUpdate builder.
+func (_u *MessageUpdateOne) Where(ps ...predicate.Message) *MessageUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *MessageUpdateOne) Select(field string, fields ...string) *MessageUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Message entity.
+func (_u *MessageUpdateOne) Save(ctx context.Context) (*Message, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MessageUpdateOne) SaveX(ctx context.Context) *Message {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *MessageUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MessageUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MessageUpdateOne) check() error {
+	if v, ok := _u.mutation.Body(); ok {
+		if err := message.BodyValidator(v); err != nil {
+			return &ValidationError{Name: "body", err: fmt.Errorf(`generated: validator failed for field "Message.body": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ClientMessageID(); ok {
+		if err := message.ClientMessageIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_message_id", err: fmt.Errorf(`generated: validator failed for field "Message.client_message_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ModerationStatus(); ok {
+		if err := message.ModerationStatusValidator(v); err != nil {
+			return &ValidationError{Name: "moderation_status", err: fmt.Errorf(`generated: validator failed for field "Message.moderation_status": %w`, err)}
+		}
+	}
+	if _u.mutation.ConversationCleared() && len(_u.mutation.ConversationIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "Message.conversation"`)
+	}
+	if _u.mutation.SenderCleared() && len(_u.mutation.SenderIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "Message.sender"`)
+	}
+	return nil
+}
+
+func (_u *MessageUpdateOne) sqlSave(ctx context.Context) (_node *Message, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(message.Table, message.Columns, sqlgraph.NewFieldSpec(message.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "Message.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, message.FieldID)
+		for _, f := range fields {
+			if !message.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != message.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Body(); ok {
+		_spec.SetField(message.FieldBody, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ClientMessageID(); ok {
+		_spec.SetField(message.FieldClientMessageID, field.TypeString, value)
+	}
+	if _u.mutation.ClientMessageIDCleared() {
+		_spec.ClearField(message.FieldClientMessageID, field.TypeString)
+	}
+	if value, ok := _u.mutation.ModerationStatus(); ok {
+		_spec.SetField(message.FieldModerationStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ReadAt(); ok {
+		_spec.SetField(message.FieldReadAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReadAtCleared() {
+		_spec.ClearField(message.FieldReadAt, field.TypeTime)
+	}
+	if _u.mutation.ModerationActionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.ModerationActionsTable,
+			Columns: []string{message.ModerationActionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(moderationaction.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedModerationActionsIDs(); len(nodes) > 0 && !_u.mutation.ModerationActionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.ModerationActionsTable,
+			Columns: []string{message.ModerationActionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(moderationaction.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ModerationActionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.ModerationActionsTable,
+			Columns: []string{message.ModerationActionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(moderationaction.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Message{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{message.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -8,6 +8,69 @@ import (
 )
 
 var (
+	// AccountDeletionsColumns holds the columns for the "account_deletions" table.
+	AccountDeletionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Size: 36},
+		{Name: "user_id", Type: field.TypeString, Size: 36},
+		{Name: "deletion_status", Type: field.TypeEnum, Enums: []string{"scheduled", "cancelled", "completed"}, Default: "scheduled"},
+		{Name: "requested_by", Type: field.TypeEnum, Enums: []string{"user", "admin"}, Default: "user"},
+		{Name: "reason", Type: field.TypeString, Nullable: true, Size: 500},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "scheduled_for", Type: field.TypeTime},
+		{Name: "cancelled_at", Type: field.TypeTime, Nullable: true},
+		{Name: "completed_at", Type: field.TypeTime, Nullable: true},
+	}
+	// AccountDeletionsTable holds the schema information for the "account_deletions" table.
+	AccountDeletionsTable = &schema.Table{
+		Name:       "account_deletions",
+		Columns:    AccountDeletionsColumns,
+		PrimaryKey: []*schema.Column{AccountDeletionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "accountdeletion_user_id_deletion_status",
+				Unique:  false,
+				Columns: []*schema.Column{AccountDeletionsColumns[1], AccountDeletionsColumns[2]},
+			},
+			{
+				Name:    "accountdeletion_deletion_status_scheduled_for",
+				Unique:  false,
+				Columns: []*schema.Column{AccountDeletionsColumns[2], AccountDeletionsColumns[6]},
+			},
+		},
+	}
+	// AdminUsersColumns holds the columns for the "admin_users" table.
+	AdminUsersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Size: 36},
+		{Name: "email", Type: field.TypeString, Size: 100},
+		{Name: "name", Type: field.TypeString, Size: 100},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"support", "moderator", "finance", "superadmin"}},
+		{Name: "admin_status", Type: field.TypeEnum, Enums: []string{"active", "disabled"}, Default: "active"},
+		{Name: "api_key_hash", Type: field.TypeString, Size: 64},
+		{Name: "api_key_prefix", Type: field.TypeString, Size: 16},
+		{Name: "api_key_rotated_at", Type: field.TypeTime},
+		{Name: "created_by", Type: field.TypeString, Nullable: true, Size: 36},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// AdminUsersTable holds the schema information for the "admin_users" table.
+	AdminUsersTable = &schema.Table{
+		Name:       "admin_users",
+		Columns:    AdminUsersColumns,
+		PrimaryKey: []*schema.Column{AdminUsersColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "adminuser_email",
+				Unique:  true,
+				Columns: []*schema.Column{AdminUsersColumns[1]},
+			},
+			{
+				Name:    "adminuser_api_key_hash",
+				Unique:  true,
+				Columns: []*schema.Column{AdminUsersColumns[5]},
+			},
+		},
+	}
 	// AuditLogsColumns holds the columns for the "audit_logs" table.
 	AuditLogsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true, Size: 36},
@@ -51,6 +114,43 @@ var (
 			},
 		},
 	}
+	// BalanceDriftsColumns holds the columns for the "balance_drifts" table.
+	BalanceDriftsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Size: 36},
+		{Name: "stored_balance", Type: field.TypeInt},
+		{Name: "ledger_balance", Type: field.TypeInt},
+		{Name: "difference", Type: field.TypeInt},
+		{Name: "detected_at", Type: field.TypeTime},
+		{Name: "last_checked_at", Type: field.TypeTime},
+		{Name: "resolved_at", Type: field.TypeTime, Nullable: true},
+		{Name: "user_id", Type: field.TypeString, Size: 36},
+	}
+	// BalanceDriftsTable holds the schema information for the "balance_drifts" table.
+	BalanceDriftsTable = &schema.Table{
+		Name:       "balance_drifts",
+		Columns:    BalanceDriftsColumns,
+		PrimaryKey: []*schema.Column{BalanceDriftsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "balance_drifts_users_balance_drifts",
+				Columns:    []*schema.Column{BalanceDriftsColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "balancedrift_user_id_resolved_at",
+				Unique:  false,
+				Columns: []*schema.Column{BalanceDriftsColumns[7], BalanceDriftsColumns[6]},
+			},
+			{
+				Name:    "balancedrift_resolved_at_detected_at",
+				Unique:  false,
+				Columns: []*schema.Column{BalanceDriftsColumns[6], BalanceDriftsColumns[4]},
+			},
+		},
+	}
 	// CheckInsColumns holds the columns for the "check_ins" table.
 	CheckInsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true, Size: 36},
@@ -146,6 +246,49 @@ var (
 			},
 		},
 	}
+	// ConversationsColumns holds the columns for the "conversations" table.
+	ConversationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Size: 36},
+		{Name: "user_a_id", Type: field.TypeString, Size: 36},
+		{Name: "user_b_id", Type: field.TypeString, Size: 36},
+		{Name: "conversation_status", Type: field.TypeEnum, Enums: []string{"open", "closed"}, Default: "open"},
+		{Name: "closed_reason", Type: field.TypeEnum, Nullable: true, Enums: []string{"blocked", "terminated"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "last_message_at", Type: field.TypeTime, Nullable: true},
+		{Name: "closed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "connection_id", Type: field.TypeString, Unique: true, Size: 36},
+	}
+	// ConversationsTable holds the schema information for the "conversations" table.
+	ConversationsTable = &schema.Table{
+		Name:       "conversations",
+		Columns:    ConversationsColumns,
+		PrimaryKey: []*schema.Column{ConversationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "conversations_connections_conversation",
+				Columns:    []*schema.Column{ConversationsColumns[8]},
+				RefColumns: []*schema.Column{ConnectionsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "conversation_connection_id",
+				Unique:  true,
+				Columns: []*schema.Column{ConversationsColumns[8]},
+			},
+			{
+				Name:    "conversation_user_a_id_conversation_status",
+				Unique:  false,
+				Columns: []*schema.Column{ConversationsColumns[1], ConversationsColumns[3]},
+			},
+			{
+				Name:    "conversation_user_b_id_conversation_status",
+				Unique:  false,
+				Columns: []*schema.Column{ConversationsColumns[2], ConversationsColumns[3]},
+			},
+		},
+	}
 	// CreditPackagesColumns holds the columns for the "credit_packages" table.
 	CreditPackagesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true, Size: 36},
@@ -177,12 +320,13 @@ var (
 	// CreditTransactionsColumns holds the columns for the "credit_transactions" table.
 	CreditTransactionsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true, Size: 36},
-		{Name: "transaction_type", Type: field.TypeEnum, Enums: []string{"purchase", "streak_recovery", "early_reveal", "referral_bonus", "welcome_bonus", "refund", "admin_adjustment"}},
+		{Name: "transaction_type", Type: field.TypeEnum, Enums: []string{"purchase", "streak_recovery", "early_reveal", "reveal_gift", "referral_bonus", "welcome_bonus", "promo_code", "refund", "admin_adjustment"}},
 		{Name: "credit_amount", Type: field.TypeInt},
 		{Name: "balance_after", Type: field.TypeInt},
 		{Name: "reference_type", Type: field.TypeString, Nullable: true, Size: 50},
 		{Name: "reference_id", Type: field.TypeString, Nullable: true, Size: 36},
 		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "idempotency_key", Type: field.TypeString, Nullable: true, Size: 64},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "user_id", Type: field.TypeString, Size: 36},
 	}
@@ -194,7 +338,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "credit_transactions_users_credit_transactions",
-				Columns:    []*schema.Column{CreditTransactionsColumns[8]},
+				Columns:    []*schema.Column{CreditTransactionsColumns[9]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -203,13 +347,85 @@ var (
 			{
 				Name:    "credittransaction_user_id_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{CreditTransactionsColumns[8], CreditTransactionsColumns[7]},
+				Columns: []*schema.Column{CreditTransactionsColumns[9], CreditTransactionsColumns[8]},
 			},
 			{
 				Name:    "credittransaction_transaction_type",
 				Unique:  false,
 				Columns: []*schema.Column{CreditTransactionsColumns[1]},
 			},
+			{
+				Name:    "credittransaction_user_id_idempotency_key",
+				Unique:  true,
+				Columns: []*schema.Column{CreditTransactionsColumns[9], CreditTransactionsColumns[7]},
+			},
+			{
+				Name:    "credittransaction_reference_type_reference_id",
+				Unique:  true,
+				Columns: []*schema.Column{CreditTransactionsColumns[4], CreditTransactionsColumns[5]},
+			},
+		},
+	}
+	// DataExportsColumns holds the columns for the "data_exports" table.
+	DataExportsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Size: 36},
+		{Name: "user_id", Type: field.TypeString, Size: 36},
+		{Name: "export_status", Type: field.TypeEnum, Enums: []string{"pending", "processing", "ready", "failed", "expired"}, Default: "pending"},
+		{Name: "storage_key", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "size_bytes", Type: field.TypeInt64, Nullable: true},
+		{Name: "error_message", Type: field.TypeString, Nullable: true, Size: 500},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "started_at", Type: field.TypeTime, Nullable: true},
+		{Name: "completed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+	}
+	// DataExportsTable holds the schema information for the "data_exports" table.
+	DataExportsTable = &schema.Table{
+		Name:       "data_exports",
+		Columns:    DataExportsColumns,
+		PrimaryKey: []*schema.Column{DataExportsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "dataexport_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{DataExportsColumns[1], DataExportsColumns[6]},
+			},
+			{
+				Name:    "dataexport_export_status_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{DataExportsColumns[2], DataExportsColumns[6]},
+			},
+		},
+	}
+	// DeviceRiskFlagsColumns holds the columns for the "device_risk_flags" table.
+	DeviceRiskFlagsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Size: 36},
+		{Name: "user_id", Type: field.TypeString, Size: 36},
+		{Name: "device_id", Type: field.TypeString, Size: 128},
+		{Name: "rule", Type: field.TypeEnum, Enums: []string{"multi_account_device", "device_churn", "suspended_account_device"}},
+		{Name: "details", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "flag_status", Type: field.TypeEnum, Enums: []string{"pending", "reviewed", "action_taken", "dismissed"}, Default: "pending"},
+		{Name: "reviewed_by", Type: field.TypeString, Nullable: true, Size: 36},
+		{Name: "admin_notes", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "reviewed_at", Type: field.TypeTime, Nullable: true},
+	}
+	// DeviceRiskFlagsTable holds the schema information for the "device_risk_flags" table.
+	DeviceRiskFlagsTable = &schema.Table{
+		Name:       "device_risk_flags",
+		Columns:    DeviceRiskFlagsColumns,
+		PrimaryKey: []*schema.Column{DeviceRiskFlagsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "deviceriskflag_flag_status_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{DeviceRiskFlagsColumns[5], DeviceRiskFlagsColumns[8]},
+			},
+			{
+				Name:    "deviceriskflag_user_id_rule_flag_status",
+				Unique:  false,
+				Columns: []*schema.Column{DeviceRiskFlagsColumns[1], DeviceRiskFlagsColumns[3], DeviceRiskFlagsColumns[5]},
+			},
 		},
 	}
 	// DiscoveryBatchesColumns holds the columns for the "discovery_batches" table.
@@ -466,6 +682,244 @@ var (
 			},
 		},
 	}
+	// InvoicesColumns holds the columns for the "invoices" table.
+	InvoicesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Size: 36},
+		{Name: "invoice_number", Type: field.TypeString, Unique: true, Size: 16},
+		{Name: "financial_year", Type: field.TypeString, Size: 7},
+		{Name: "sequence_number", Type: field.TypeInt},
+		{Name: "user_id", Type: field.TypeString, Size: 36},
+		{Name: "seller_name", Type: field.TypeString, Size: 255},
+		{Name: "seller_address", Type: field.TypeString, Size: 500},
+		{Name: "seller_gstin", Type: field.TypeString, Size: 15},
+		{Name: "buyer_name", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "buyer_email", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "place_of_supply", Type: field.TypeString, Size: 2},
+		{Name: "description", Type: field.TypeString, Size: 255},
+		{Name: "sac_code", Type: field.TypeString, Size: 8},
+		{Name: "currency", Type: field.TypeString, Size: 3, Default: "INR"},
+		{Name: "discount_amount", Type: field.TypeInt, Default: 0},
+		{Name: "taxable_amount", Type: field.TypeInt},
+		{Name: "gst_rate", Type: field.TypeFloat64},
+		{Name: "cgst_amount", Type: field.TypeInt, Default: 0},
+		{Name: "sgst_amount", Type: field.TypeInt, Default: 0},
+		{Name: "igst_amount", Type: field.TypeInt, Default: 0},
+		{Name: "total_amount", Type: field.TypeInt},
+		{Name: "pdf_storage_key", Type: field.TypeString, Nullable: true, Size: 500},
+		{Name: "issued_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "order_id", Type: field.TypeString, Unique: true, Size: 36},
+	}
+	// InvoicesTable holds the schema information for the "invoices" table.
+	InvoicesTable = &schema.Table{
+		Name:       "invoices",
+		Columns:    InvoicesColumns,
+		PrimaryKey: []*schema.Column{InvoicesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "invoices_payment_orders_invoice",
+				Columns:    []*schema.Column{InvoicesColumns[24]},
+				RefColumns: []*schema.Column{PaymentOrdersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "invoice_financial_year_sequence_number",
+				Unique:  true,
+				Columns: []*schema.Column{InvoicesColumns[2], InvoicesColumns[3]},
+			},
+			{
+				Name:    "invoice_issued_at",
+				Unique:  false,
+				Columns: []*schema.Column{InvoicesColumns[22]},
+			},
+			{
+				Name:    "invoice_user_id_issued_at",
+				Unique:  false,
+				Columns: []*schema.Column{InvoicesColumns[4], InvoicesColumns[22]},
+			},
+		},
+	}
+	// InvoiceSequencesColumns holds the columns for the "invoice_sequences" table.
+	InvoiceSequencesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Size: 7},
+		{Name: "last_number", Type: field.TypeInt, Default: 0},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// InvoiceSequencesTable holds the schema information for the "invoice_sequences" table.
+	InvoiceSequencesTable = &schema.Table{
+		Name:       "invoice_sequences",
+		Columns:    InvoiceSequencesColumns,
+		PrimaryKey: []*schema.Column{InvoiceSequencesColumns[0]},
+	}
+	// LedgerEntriesColumns holds the columns for the "ledger_entries" table.
+	LedgerEntriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Size: 36},
+		{Name: "account", Type: field.TypeString, Size: 64},
+		{Name: "amount", Type: field.TypeInt},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "transaction_id", Type: field.TypeString, Size: 36},
+	}
+	// LedgerEntriesTable holds the schema information for the "ledger_entries" table.
+	LedgerEntriesTable = &schema.Table{
+		Name:       "ledger_entries",
+		Columns:    LedgerEntriesColumns,
+		PrimaryKey: []*schema.Column{LedgerEntriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "ledger_entries_credit_transactions_entries",
+				Columns:    []*schema.Column{LedgerEntriesColumns[4]},
+				RefColumns: []*schema.Column{CreditTransactionsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "ledgerentry_transaction_id",
+				Unique:  false,
+				Columns: []*schema.Column{LedgerEntriesColumns[4]},
+			},
+			{
+				Name:    "ledgerentry_account_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{LedgerEntriesColumns[1], LedgerEntriesColumns[3]},
+			},
+		},
+	}
+	// MessagesColumns holds the columns for the "messages" table.
+	MessagesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Size: 36},
+		{Name: "body", Type: field.TypeString, Size: 2000},
+		{Name: "client_message_id", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "moderation_status", Type: field.TypeEnum, Enums: []string{"visible", "held", "rejected"}, Default: "visible"},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "read_at", Type: field.TypeTime, Nullable: true},
+		{Name: "conversation_id", Type: field.TypeString, Size: 36},
+		{Name: "sender_user_id", Type: field.TypeString, Size: 36},
+	}
+	// MessagesTable holds the schema information for the "messages" table.
+	MessagesTable = &schema.Table{
+		Name:       "messages",
+		Columns:    MessagesColumns,
+		PrimaryKey: []*schema.Column{MessagesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "messages_conversations_messages",
+				Columns:    []*schema.Column{MessagesColumns[6]},
+				RefColumns: []*schema.Column{ConversationsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "messages_users_chat_messages",
+				Columns:    []*schema.Column{MessagesColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "message_conversation_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{MessagesColumns[6], MessagesColumns[4]},
+			},
+			{
+				Name:    "message_conversation_id_sender_user_id_client_message_id",
+				Unique:  true,
+				Columns: []*schema.Column{MessagesColumns[6], MessagesColumns[7], MessagesColumns[2]},
+			},
+			{
+				Name:    "message_sender_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{MessagesColumns[7]},
+			},
+			{
+				Name:    "message_moderation_status_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{MessagesColumns[3], MessagesColumns[4]},
+			},
+		},
+	}
+	// ModerationActionsColumns holds the columns for the "moderation_actions" table.
+	ModerationActionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Size: 36},
+		{Name: "conversation_id", Type: field.TypeString, Nullable: true, Size: 36},
+		{Name: "action", Type: field.TypeEnum, Enums: []string{"flagged", "held", "rejected", "rate_limited", "approved"}},
+		{Name: "source", Type: field.TypeString, Size: 50},
+		{Name: "reason", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "message_id", Type: field.TypeString, Nullable: true, Size: 36},
+		{Name: "user_id", Type: field.TypeString, Size: 36},
+	}
+	// ModerationActionsTable holds the schema information for the "moderation_actions" table.
+	ModerationActionsTable = &schema.Table{
+		Name:       "moderation_actions",
+		Columns:    ModerationActionsColumns,
+		PrimaryKey: []*schema.Column{ModerationActionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "moderation_actions_messages_moderation_actions",
+				Columns:    []*schema.Column{ModerationActionsColumns[6]},
+				RefColumns: []*schema.Column{MessagesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "moderation_actions_users_moderation_actions",
+				Columns:    []*schema.Column{ModerationActionsColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "moderationaction_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{ModerationActionsColumns[7], ModerationActionsColumns[5]},
+			},
+			{
+				Name:    "moderationaction_message_id",
+				Unique:  false,
+				Columns: []*schema.Column{ModerationActionsColumns[6]},
+			},
+		},
+	}
+	// NotificationsColumns holds the columns for the "notifications" table.
+	NotificationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Size: 36},
+		{Name: "notification_type", Type: field.TypeString, Size: 50},
+		{Name: "title", Type: field.TypeString, Size: 200},
+		{Name: "body", Type: field.TypeString, Size: 2147483647},
+		{Name: "data", Type: field.TypeJSON, Nullable: true},
+		{Name: "read_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeString, Size: 36},
+	}
+	// NotificationsTable holds the schema information for the "notifications" table.
+	NotificationsTable = &schema.Table{
+		Name:       "notifications",
+		Columns:    NotificationsColumns,
+		PrimaryKey: []*schema.Column{NotificationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "notifications_users_notifications",
+				Columns:    []*schema.Column{NotificationsColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "notification_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{NotificationsColumns[7], NotificationsColumns[6]},
+			},
+			{
+				Name:    "notification_user_id_read_at",
+				Unique:  false,
+				Columns: []*schema.Column{NotificationsColumns[7], NotificationsColumns[5]},
+			},
+		},
+	}
 	// NudgesColumns holds the columns for the "nudges" table.
 	NudgesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true, Size: 36},
@@ -522,6 +976,45 @@ var (
 			},
 		},
 	}
+	// PaymentDiscrepanciesColumns holds the columns for the "payment_discrepancies" table.
+	PaymentDiscrepanciesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Size: 36},
+		{Name: "order_id", Type: field.TypeString, Nullable: true, Size: 36},
+		{Name: "razorpay_order_id", Type: field.TypeString, Size: 100},
+		{Name: "razorpay_payment_id", Type: field.TypeString, Nullable: true, Size: 100},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"captured_not_fulfilled", "paid_not_captured", "amount_mismatch", "refund_mismatch", "unknown_order"}},
+		{Name: "db_status", Type: field.TypeString, Nullable: true, Size: 20},
+		{Name: "gateway_status", Type: field.TypeString, Nullable: true, Size: 20},
+		{Name: "db_amount", Type: field.TypeInt, Default: 0},
+		{Name: "gateway_amount", Type: field.TypeInt, Default: 0},
+		{Name: "report_id", Type: field.TypeString, Size: 36},
+	}
+	// PaymentDiscrepanciesTable holds the schema information for the "payment_discrepancies" table.
+	PaymentDiscrepanciesTable = &schema.Table{
+		Name:       "payment_discrepancies",
+		Columns:    PaymentDiscrepanciesColumns,
+		PrimaryKey: []*schema.Column{PaymentDiscrepanciesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "payment_discrepancies_payment_reconciliation_reports_discrepancies",
+				Columns:    []*schema.Column{PaymentDiscrepanciesColumns[9]},
+				RefColumns: []*schema.Column{PaymentReconciliationReportsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "paymentdiscrepancy_report_id_kind",
+				Unique:  false,
+				Columns: []*schema.Column{PaymentDiscrepanciesColumns[9], PaymentDiscrepanciesColumns[4]},
+			},
+			{
+				Name:    "paymentdiscrepancy_order_id",
+				Unique:  false,
+				Columns: []*schema.Column{PaymentDiscrepanciesColumns[1]},
+			},
+		},
+	}
 	// PaymentOrdersColumns holds the columns for the "payment_orders" table.
 	PaymentOrdersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true, Size: 36},
@@ -530,9 +1023,11 @@ var (
 		{Name: "razorpay_payment_id", Type: field.TypeString, Nullable: true, Size: 100},
 		{Name: "razorpay_signature", Type: field.TypeString, Nullable: true, Size: 256},
 		{Name: "amount", Type: field.TypeInt},
+		{Name: "discount_amount", Type: field.TypeInt, Default: 0},
 		{Name: "currency", Type: field.TypeString, Size: 3, Default: "INR"},
 		{Name: "credits_to_add", Type: field.TypeInt},
 		{Name: "order_status", Type: field.TypeEnum, Enums: []string{"created", "paid", "failed", "refunded", "expired"}, Default: "created"},
+		{Name: "amount_refunded", Type: field.TypeInt, Default: 0},
 		{Name: "failure_reason", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "paid_at", Type: field.TypeTime, Nullable: true},
@@ -547,7 +1042,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "payment_orders_users_payment_orders",
-				Columns:    []*schema.Column{PaymentOrdersColumns[13]},
+				Columns:    []*schema.Column{PaymentOrdersColumns[15]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -556,7 +1051,7 @@ var (
 			{
 				Name:    "paymentorder_user_id_order_status",
 				Unique:  false,
-				Columns: []*schema.Column{PaymentOrdersColumns[13], PaymentOrdersColumns[8]},
+				Columns: []*schema.Column{PaymentOrdersColumns[15], PaymentOrdersColumns[9]},
 			},
 			{
 				Name:    "paymentorder_razorpay_order_id",
@@ -566,7 +1061,78 @@ var (
 			{
 				Name:    "paymentorder_order_status_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{PaymentOrdersColumns[8], PaymentOrdersColumns[10]},
+				Columns: []*schema.Column{PaymentOrdersColumns[9], PaymentOrdersColumns[12]},
+			},
+		},
+	}
+	// PaymentReconciliationReportsColumns holds the columns for the "payment_reconciliation_reports" table.
+	PaymentReconciliationReportsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Size: 36},
+		{Name: "report_date", Type: field.TypeString, Unique: true, Size: 10},
+		{Name: "window_start", Type: field.TypeTime},
+		{Name: "window_end", Type: field.TypeTime},
+		{Name: "orders_checked", Type: field.TypeInt, Default: 0},
+		{Name: "gateway_payments", Type: field.TypeInt, Default: 0},
+		{Name: "matched", Type: field.TypeInt, Default: 0},
+		{Name: "discrepancy_count", Type: field.TypeInt, Default: 0},
+		{Name: "db_paid_amount", Type: field.TypeInt, Default: 0},
+		{Name: "gateway_captured_amount", Type: field.TypeInt, Default: 0},
+		{Name: "generated_at", Type: field.TypeTime},
+	}
+	// PaymentReconciliationReportsTable holds the schema information for the "payment_reconciliation_reports" table.
+	PaymentReconciliationReportsTable = &schema.Table{
+		Name:       "payment_reconciliation_reports",
+		Columns:    PaymentReconciliationReportsColumns,
+		PrimaryKey: []*schema.Column{PaymentReconciliationReportsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "paymentreconciliationreport_generated_at",
+				Unique:  false,
+				Columns: []*schema.Column{PaymentReconciliationReportsColumns[10]},
+			},
+		},
+	}
+	// PaymentRefundsColumns holds the columns for the "payment_refunds" table.
+	PaymentRefundsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Size: 36},
+		{Name: "user_id", Type: field.TypeString, Size: 36},
+		{Name: "razorpay_refund_id", Type: field.TypeString, Unique: true, Nullable: true, Size: 100},
+		{Name: "amount", Type: field.TypeInt},
+		{Name: "currency", Type: field.TypeString, Size: 3, Default: "INR"},
+		{Name: "refund_status", Type: field.TypeEnum, Enums: []string{"pending", "processing", "processed", "failed"}, Default: "pending"},
+		{Name: "source", Type: field.TypeEnum, Enums: []string{"admin", "gateway"}},
+		{Name: "credits_reversed", Type: field.TypeInt, Default: 0},
+		{Name: "credits_written_off", Type: field.TypeInt, Default: 0},
+		{Name: "reason", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "failure_reason", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "processed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "order_id", Type: field.TypeString, Size: 36},
+	}
+	// PaymentRefundsTable holds the schema information for the "payment_refunds" table.
+	PaymentRefundsTable = &schema.Table{
+		Name:       "payment_refunds",
+		Columns:    PaymentRefundsColumns,
+		PrimaryKey: []*schema.Column{PaymentRefundsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "payment_refunds_payment_orders_refunds",
+				Columns:    []*schema.Column{PaymentRefundsColumns[14]},
+				RefColumns: []*schema.Column{PaymentOrdersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "paymentrefund_order_id_refund_status",
+				Unique:  false,
+				Columns: []*schema.Column{PaymentRefundsColumns[14], PaymentRefundsColumns[5]},
+			},
+			{
+				Name:    "paymentrefund_refund_status_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{PaymentRefundsColumns[5], PaymentRefundsColumns[11]},
 			},
 		},
 	}
@@ -663,6 +1229,140 @@ var (
 			},
 		},
 	}
+	// PromoCodesColumns holds the columns for the "promo_codes" table.
+	PromoCodesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Size: 36},
+		{Name: "code", Type: field.TypeString, Unique: true, Size: 32},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"credits", "package_discount"}},
+		{Name: "credit_amount", Type: field.TypeInt, Default: 0},
+		{Name: "discount_percent", Type: field.TypeInt, Default: 0},
+		{Name: "package_id", Type: field.TypeString, Nullable: true, Size: 36},
+		{Name: "max_redemptions", Type: field.TypeInt, Nullable: true},
+		{Name: "per_user_limit", Type: field.TypeInt, Default: 1},
+		{Name: "starts_at", Type: field.TypeTime, Nullable: true},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "is_active", Type: field.TypeBool, Default: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// PromoCodesTable holds the schema information for the "promo_codes" table.
+	PromoCodesTable = &schema.Table{
+		Name:       "promo_codes",
+		Columns:    PromoCodesColumns,
+		PrimaryKey: []*schema.Column{PromoCodesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "promocode_is_active_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{PromoCodesColumns[11], PromoCodesColumns[12]},
+			},
+		},
+	}
+	// PromoRedemptionsColumns holds the columns for the "promo_redemptions" table.
+	PromoRedemptionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Size: 36},
+		{Name: "user_id", Type: field.TypeString, Size: 36},
+		{Name: "order_id", Type: field.TypeString, Unique: true, Nullable: true, Size: 36},
+		{Name: "redemption_status", Type: field.TypeEnum, Enums: []string{"pending", "redeemed"}, Default: "pending"},
+		{Name: "credits_granted", Type: field.TypeInt, Default: 0},
+		{Name: "discount_amount", Type: field.TypeInt, Default: 0},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "redeemed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "promo_code_id", Type: field.TypeString, Size: 36},
+	}
+	// PromoRedemptionsTable holds the schema information for the "promo_redemptions" table.
+	PromoRedemptionsTable = &schema.Table{
+		Name:       "promo_redemptions",
+		Columns:    PromoRedemptionsColumns,
+		PrimaryKey: []*schema.Column{PromoRedemptionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "promo_redemptions_promo_codes_redemptions",
+				Columns:    []*schema.Column{PromoRedemptionsColumns[9]},
+				RefColumns: []*schema.Column{PromoCodesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "promoredemption_promo_code_id_redemption_status",
+				Unique:  false,
+				Columns: []*schema.Column{PromoRedemptionsColumns[9], PromoRedemptionsColumns[3]},
+			},
+			{
+				Name:    "promoredemption_promo_code_id_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{PromoRedemptionsColumns[9], PromoRedemptionsColumns[1]},
+			},
+			{
+				Name:    "promoredemption_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{PromoRedemptionsColumns[1], PromoRedemptionsColumns[7]},
+			},
+		},
+	}
+	// ReferralsColumns holds the columns for the "referrals" table.
+	ReferralsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Size: 36},
+		{Name: "referrer_id", Type: field.TypeString, Size: 36},
+		{Name: "referee_id", Type: field.TypeString, Unique: true, Size: 36},
+		{Name: "code", Type: field.TypeString, Size: 16},
+		{Name: "device_id", Type: field.TypeString, Nullable: true, Size: 128},
+		{Name: "referral_status", Type: field.TypeEnum, Enums: []string{"pending", "rewarded", "rejected"}, Default: "pending"},
+		{Name: "rejection_reason", Type: field.TypeString, Nullable: true, Size: 50},
+		{Name: "referrer_credits", Type: field.TypeInt, Default: 0},
+		{Name: "referee_credits", Type: field.TypeInt, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "resolved_at", Type: field.TypeTime, Nullable: true},
+	}
+	// ReferralsTable holds the schema information for the "referrals" table.
+	ReferralsTable = &schema.Table{
+		Name:       "referrals",
+		Columns:    ReferralsColumns,
+		PrimaryKey: []*schema.Column{ReferralsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "referral_referrer_id_referral_status",
+				Unique:  false,
+				Columns: []*schema.Column{ReferralsColumns[1], ReferralsColumns[5]},
+			},
+		},
+	}
+	// ReportEvidencesColumns holds the columns for the "report_evidences" table.
+	ReportEvidencesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Size: 36},
+		{Name: "message_id", Type: field.TypeString, Size: 36},
+		{Name: "sender_user_id", Type: field.TypeString, Size: 36},
+		{Name: "body", Type: field.TypeString, Size: 2147483647},
+		{Name: "moderation_status", Type: field.TypeString, Size: 20},
+		{Name: "sent_at", Type: field.TypeTime},
+		{Name: "is_reported_message", Type: field.TypeBool, Default: false},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "report_id", Type: field.TypeString, Size: 36},
+	}
+	// ReportEvidencesTable holds the schema information for the "report_evidences" table.
+	ReportEvidencesTable = &schema.Table{
+		Name:       "report_evidences",
+		Columns:    ReportEvidencesColumns,
+		PrimaryKey: []*schema.Column{ReportEvidencesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "report_evidences_user_reports_evidence",
+				Columns:    []*schema.Column{ReportEvidencesColumns[8]},
+				RefColumns: []*schema.Column{UserReportsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "reportevidence_report_id_sent_at",
+				Unique:  false,
+				Columns: []*schema.Column{ReportEvidencesColumns[8], ReportEvidencesColumns[5]},
+			},
+		},
+	}
 	// RevealsColumns holds the columns for the "reveals" table.
 	RevealsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true, Size: 36},
@@ -738,6 +1438,71 @@ var (
 			},
 		},
 	}
+	// RevealGiftsColumns holds the columns for the "reveal_gifts" table.
+	RevealGiftsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Size: 36},
+		{Name: "credit_cost", Type: field.TypeInt},
+		{Name: "gift_status", Type: field.TypeEnum, Enums: []string{"pending", "accepted", "declined", "cancelled", "expired"}, Default: "pending"},
+		{Name: "gifter_consented_at", Type: field.TypeTime},
+		{Name: "reveal_id", Type: field.TypeString, Nullable: true, Size: 36},
+		{Name: "credit_transaction_id", Type: field.TypeString, Nullable: true, Size: 36},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "responded_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "connection_id", Type: field.TypeString, Size: 36},
+		{Name: "milestone_id", Type: field.TypeString, Size: 36},
+		{Name: "gifter_user_id", Type: field.TypeString, Size: 36},
+		{Name: "recipient_user_id", Type: field.TypeString, Size: 36},
+	}
+	// RevealGiftsTable holds the schema information for the "reveal_gifts" table.
+	RevealGiftsTable = &schema.Table{
+		Name:       "reveal_gifts",
+		Columns:    RevealGiftsColumns,
+		PrimaryKey: []*schema.Column{RevealGiftsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "reveal_gifts_connections_reveal_gifts",
+				Columns:    []*schema.Column{RevealGiftsColumns[9]},
+				RefColumns: []*schema.Column{ConnectionsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "reveal_gifts_reveal_milestones_gifts",
+				Columns:    []*schema.Column{RevealGiftsColumns[10]},
+				RefColumns: []*schema.Column{RevealMilestonesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "reveal_gifts_users_reveal_gifts_sent",
+				Columns:    []*schema.Column{RevealGiftsColumns[11]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "reveal_gifts_users_reveal_gifts_received",
+				Columns:    []*schema.Column{RevealGiftsColumns[12]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "revealgift_connection_id_milestone_id_gift_status",
+				Unique:  false,
+				Columns: []*schema.Column{RevealGiftsColumns[9], RevealGiftsColumns[10], RevealGiftsColumns[2]},
+			},
+			{
+				Name:    "revealgift_recipient_user_id_gift_status",
+				Unique:  false,
+				Columns: []*schema.Column{RevealGiftsColumns[12], RevealGiftsColumns[2]},
+			},
+			{
+				Name:    "revealgift_gifter_user_id_gift_status",
+				Unique:  false,
+				Columns: []*schema.Column{RevealGiftsColumns[11], RevealGiftsColumns[2]},
+			},
+		},
+	}
 	// RevealMilestonesColumns holds the columns for the "reveal_milestones" table.
 	RevealMilestonesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true, Size: 36},
@@ -768,6 +1533,49 @@ var (
 			},
 		},
 	}
+	// RevealViewsColumns holds the columns for the "reveal_views" table.
+	RevealViewsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Size: 36},
+		{Name: "is_first_view", Type: field.TypeBool, Default: false},
+		{Name: "first_view_key", Type: field.TypeString, Unique: true, Nullable: true, Size: 73},
+		{Name: "duration_seconds", Type: field.TypeInt, Nullable: true},
+		{Name: "viewer_tier", Type: field.TypeEnum, Enums: []string{"free", "plus", "pro"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "reveal_id", Type: field.TypeString, Size: 36},
+		{Name: "viewer_user_id", Type: field.TypeString, Size: 36},
+	}
+	// RevealViewsTable holds the schema information for the "reveal_views" table.
+	RevealViewsTable = &schema.Table{
+		Name:       "reveal_views",
+		Columns:    RevealViewsColumns,
+		PrimaryKey: []*schema.Column{RevealViewsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "reveal_views_reveals_views",
+				Columns:    []*schema.Column{RevealViewsColumns[6]},
+				RefColumns: []*schema.Column{RevealsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "reveal_views_users_reveal_views",
+				Columns:    []*schema.Column{RevealViewsColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "revealview_reveal_id_viewer_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{RevealViewsColumns[6], RevealViewsColumns[7]},
+			},
+			{
+				Name:    "revealview_viewer_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{RevealViewsColumns[7], RevealViewsColumns[5]},
+			},
+		},
+	}
 	// ServersColumns holds the columns for the "servers" table.
 	ServersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true, Size: 36},
@@ -797,6 +1605,7 @@ var (
 		{Name: "reset_count", Type: field.TypeInt, Default: 0},
 		{Name: "recovery_deadline_at", Type: field.TypeTime, Nullable: true},
 		{Name: "recovery_payment_id", Type: field.TypeString, Nullable: true, Size: 36},
+		{Name: "free_recoveries_used", Type: field.TypeInt, Default: 0},
 		{Name: "streak_health_score", Type: field.TypeFloat64, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
@@ -813,13 +1622,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "streaks_connections_streak",
-				Columns:    []*schema.Column{StreaksColumns[11]},
+				Columns:    []*schema.Column{StreaksColumns[12]},
 				RefColumns: []*schema.Column{ConnectionsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "streaks_users_broken_streaks",
-				Columns:    []*schema.Column{StreaksColumns[12]},
+				Columns:    []*schema.Column{StreaksColumns[13]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -828,7 +1637,7 @@ var (
 			{
 				Name:    "streak_connection_id",
 				Unique:  false,
-				Columns: []*schema.Column{StreaksColumns[11]},
+				Columns: []*schema.Column{StreaksColumns[12]},
 			},
 			{
 				Name:    "streak_streak_state",
@@ -838,12 +1647,127 @@ var (
 			{
 				Name:    "streak_streak_state_updated_at",
 				Unique:  false,
-				Columns: []*schema.Column{StreaksColumns[1], StreaksColumns[8]},
+				Columns: []*schema.Column{StreaksColumns[1], StreaksColumns[9]},
 			},
 			{
 				Name:    "streak_deleted_at",
 				Unique:  false,
-				Columns: []*schema.Column{StreaksColumns[10]},
+				Columns: []*schema.Column{StreaksColumns[11]},
+			},
+		},
+	}
+	// SubscriptionsColumns holds the columns for the "subscriptions" table.
+	SubscriptionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Size: 36},
+		{Name: "razorpay_subscription_id", Type: field.TypeString, Unique: true, Size: 100},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"created", "authenticated", "active", "pending", "halted", "paused", "cancelled", "expired"}, Default: "created"},
+		{Name: "current_start", Type: field.TypeTime, Nullable: true},
+		{Name: "current_end", Type: field.TypeTime, Nullable: true},
+		{Name: "grace_until", Type: field.TypeTime, Nullable: true},
+		{Name: "cancel_at_period_end", Type: field.TypeBool, Default: false},
+		{Name: "paid_count", Type: field.TypeInt, Default: 0},
+		{Name: "short_url", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "activated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "paused_at", Type: field.TypeTime, Nullable: true},
+		{Name: "cancelled_at", Type: field.TypeTime, Nullable: true},
+		{Name: "ended_at", Type: field.TypeTime, Nullable: true},
+		{Name: "plan_id", Type: field.TypeString, Size: 36},
+		{Name: "user_id", Type: field.TypeString, Size: 36},
+	}
+	// SubscriptionsTable holds the schema information for the "subscriptions" table.
+	SubscriptionsTable = &schema.Table{
+		Name:       "subscriptions",
+		Columns:    SubscriptionsColumns,
+		PrimaryKey: []*schema.Column{SubscriptionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "subscriptions_subscription_plans_subscriptions",
+				Columns:    []*schema.Column{SubscriptionsColumns[15]},
+				RefColumns: []*schema.Column{SubscriptionPlansColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "subscriptions_users_subscriptions",
+				Columns:    []*schema.Column{SubscriptionsColumns[16]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "subscription_user_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{SubscriptionsColumns[16], SubscriptionsColumns[2]},
+			},
+			{
+				Name:    "subscription_status_grace_until",
+				Unique:  false,
+				Columns: []*schema.Column{SubscriptionsColumns[2], SubscriptionsColumns[5]},
+			},
+			{
+				Name:    "subscription_status_current_end",
+				Unique:  false,
+				Columns: []*schema.Column{SubscriptionsColumns[2], SubscriptionsColumns[4]},
+			},
+		},
+	}
+	// SubscriptionPlansColumns holds the columns for the "subscription_plans" table.
+	SubscriptionPlansColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Size: 36},
+		{Name: "name", Type: field.TypeString, Size: 100},
+		{Name: "tier", Type: field.TypeEnum, Enums: []string{"plus", "pro"}},
+		{Name: "billing_period", Type: field.TypeEnum, Enums: []string{"monthly", "yearly"}},
+		{Name: "price_amount", Type: field.TypeInt},
+		{Name: "currency", Type: field.TypeString, Size: 3, Default: "INR"},
+		{Name: "razorpay_plan_id", Type: field.TypeString, Nullable: true, Size: 100},
+		{Name: "is_active", Type: field.TypeBool, Default: true},
+		{Name: "sort_order", Type: field.TypeInt, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// SubscriptionPlansTable holds the schema information for the "subscription_plans" table.
+	SubscriptionPlansTable = &schema.Table{
+		Name:       "subscription_plans",
+		Columns:    SubscriptionPlansColumns,
+		PrimaryKey: []*schema.Column{SubscriptionPlansColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "subscriptionplan_tier_billing_period",
+				Unique:  true,
+				Columns: []*schema.Column{SubscriptionPlansColumns[2], SubscriptionPlansColumns[3]},
+			},
+			{
+				Name:    "subscriptionplan_is_active_sort_order",
+				Unique:  false,
+				Columns: []*schema.Column{SubscriptionPlansColumns[7], SubscriptionPlansColumns[8]},
+			},
+		},
+	}
+	// TierEntitlementsColumns holds the columns for the "tier_entitlements" table.
+	TierEntitlementsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Size: 36},
+		{Name: "tier", Type: field.TypeEnum, Enums: []string{"free", "plus", "pro"}},
+		{Name: "connection_slots", Type: field.TypeInt},
+		{Name: "refresh_cooldown_minutes", Type: field.TypeInt},
+		{Name: "nudges_per_at_risk", Type: field.TypeInt},
+		{Name: "nudge_interval_minutes", Type: field.TypeInt},
+		{Name: "free_recoveries_per_connection", Type: field.TypeInt},
+		{Name: "purchasable_reveals", Type: field.TypeInt},
+		{Name: "reveal_days", Type: field.TypeJSON},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// TierEntitlementsTable holds the schema information for the "tier_entitlements" table.
+	TierEntitlementsTable = &schema.Table{
+		Name:       "tier_entitlements",
+		Columns:    TierEntitlementsColumns,
+		PrimaryKey: []*schema.Column{TierEntitlementsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "tierentitlement_tier",
+				Unique:  true,
+				Columns: []*schema.Column{TierEntitlementsColumns[1]},
 			},
 		},
 	}
@@ -881,6 +1805,9 @@ var (
 		{Name: "last_active_at", Type: field.TypeTime, Nullable: true},
 		{Name: "suspended_at", Type: field.TypeTime, Nullable: true},
 		{Name: "suspension_reason", Type: field.TypeString, Nullable: true, Size: 500},
+		{Name: "referral_code", Type: field.TypeString, Unique: true, Nullable: true, Size: 16},
+		{Name: "signup_device_id", Type: field.TypeString, Nullable: true, Size: 128},
+		{Name: "phone_hash", Type: field.TypeString, Nullable: true, Size: 64},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
@@ -909,6 +1836,16 @@ var (
 			{
 				Name:    "user_deleted_at",
 				Unique:  false,
+				Columns: []*schema.Column{UsersColumns[37]},
+			},
+			{
+				Name:    "user_signup_device_id",
+				Unique:  false,
+				Columns: []*schema.Column{UsersColumns[33]},
+			},
+			{
+				Name:    "user_phone_hash",
+				Unique:  false,
 				Columns: []*schema.Column{UsersColumns[34]},
 			},
 		},
@@ -959,10 +1896,68 @@ var (
 			},
 		},
 	}
+	// UserDevicesColumns holds the columns for the "user_devices" table.
+	UserDevicesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Size: 36},
+		{Name: "user_id", Type: field.TypeString, Size: 36},
+		{Name: "device_id", Type: field.TypeString, Size: 128},
+		{Name: "platform", Type: field.TypeEnum, Enums: []string{"android", "ios", "web", "unknown"}, Default: "unknown"},
+		{Name: "attestation_status", Type: field.TypeEnum, Enums: []string{"missing", "unverified"}, Default: "missing"},
+		{Name: "login_count", Type: field.TypeInt, Default: 0},
+		{Name: "first_seen_at", Type: field.TypeTime},
+		{Name: "last_seen_at", Type: field.TypeTime},
+	}
+	// UserDevicesTable holds the schema information for the "user_devices" table.
+	UserDevicesTable = &schema.Table{
+		Name:       "user_devices",
+		Columns:    UserDevicesColumns,
+		PrimaryKey: []*schema.Column{UserDevicesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "userdevice_device_id_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{UserDevicesColumns[2], UserDevicesColumns[1]},
+			},
+			{
+				Name:    "userdevice_user_id_first_seen_at",
+				Unique:  false,
+				Columns: []*schema.Column{UserDevicesColumns[1], UserDevicesColumns[6]},
+			},
+		},
+	}
+	// UserIdentitiesColumns holds the columns for the "user_identities" table.
+	UserIdentitiesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Size: 36},
+		{Name: "user_id", Type: field.TypeString, Size: 36},
+		{Name: "provider", Type: field.TypeEnum, Enums: []string{"google", "apple"}},
+		{Name: "subject", Type: field.TypeString, Size: 255},
+		{Name: "email", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "is_private_email", Type: field.TypeBool, Default: false},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+	}
+	// UserIdentitiesTable holds the schema information for the "user_identities" table.
+	UserIdentitiesTable = &schema.Table{
+		Name:       "user_identities",
+		Columns:    UserIdentitiesColumns,
+		PrimaryKey: []*schema.Column{UserIdentitiesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "useridentity_provider_subject",
+				Unique:  true,
+				Columns: []*schema.Column{UserIdentitiesColumns[2], UserIdentitiesColumns[3]},
+			},
+			{
+				Name:    "useridentity_user_id_provider",
+				Unique:  true,
+				Columns: []*schema.Column{UserIdentitiesColumns[1], UserIdentitiesColumns[2]},
+			},
+		},
+	}
 	// UserReportsColumns holds the columns for the "user_reports" table.
 	UserReportsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true, Size: 36},
-		{Name: "report_reason", Type: field.TypeEnum, Enums: []string{"inappropriate_content", "harassment", "spam", "fake_profile", "underage", "offensive_behavior", "other"}},
+		{Name: "report_reason", Type: field.TypeEnum, Enums: []string{"inappropriate_content", "harassment", "spam", "fake_profile", "underage", "offensive_behavior", "other", "device_risk"}},
 		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "reference_type", Type: field.TypeString, Nullable: true, Size: 50},
 		{Name: "reference_id", Type: field.TypeString, Nullable: true, Size: 36},
@@ -971,7 +1966,7 @@ var (
 		{Name: "admin_notes", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "reviewed_at", Type: field.TypeTime, Nullable: true},
-		{Name: "reporter_user_id", Type: field.TypeString, Size: 36},
+		{Name: "reporter_user_id", Type: field.TypeString, Nullable: true, Size: 36},
 		{Name: "reported_user_id", Type: field.TypeString, Size: 36},
 	}
 	// UserReportsTable holds the schema information for the "user_reports" table.
@@ -984,7 +1979,7 @@ var (
 				Symbol:     "user_reports_users_reports_given",
 				Columns:    []*schema.Column{UserReportsColumns[10]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
-				OnDelete:   schema.NoAction,
+				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "user_reports_users_reports_received",
@@ -1011,39 +2006,142 @@ var (
 			},
 		},
 	}
+	// UserSessionsColumns holds the columns for the "user_sessions" table.
+	UserSessionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Size: 36},
+		{Name: "user_id", Type: field.TypeString, Size: 36},
+		{Name: "refresh_token_id", Type: field.TypeString, Size: 36},
+		{Name: "provider", Type: field.TypeString, Size: 20},
+		{Name: "device_id", Type: field.TypeString, Nullable: true, Size: 128},
+		{Name: "device_name", Type: field.TypeString, Nullable: true, Size: 100},
+		{Name: "platform", Type: field.TypeEnum, Enums: []string{"android", "ios", "web", "unknown"}, Default: "unknown"},
+		{Name: "ip_address", Type: field.TypeString, Nullable: true, Size: 45},
+		{Name: "user_agent", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "last_used_at", Type: field.TypeTime},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "revoke_reason", Type: field.TypeString, Nullable: true, Size: 30},
+	}
+	// UserSessionsTable holds the schema information for the "user_sessions" table.
+	UserSessionsTable = &schema.Table{
+		Name:       "user_sessions",
+		Columns:    UserSessionsColumns,
+		PrimaryKey: []*schema.Column{UserSessionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "usersession_user_id_revoked_at",
+				Unique:  false,
+				Columns: []*schema.Column{UserSessionsColumns[1], UserSessionsColumns[12]},
+			},
+			{
+				Name:    "usersession_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{UserSessionsColumns[11]},
+			},
+		},
+	}
+	// WebhookEventsColumns holds the columns for the "webhook_events" table.
+	WebhookEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Size: 36},
+		{Name: "provider", Type: field.TypeString, Size: 20, Default: "razorpay"},
+		{Name: "event_id", Type: field.TypeString, Nullable: true, Size: 100},
+		{Name: "event_type", Type: field.TypeString, Nullable: true, Size: 100},
+		{Name: "payload", Type: field.TypeString, Size: 2147483647},
+		{Name: "signature", Type: field.TypeString, Nullable: true, Size: 256},
+		{Name: "signature_valid", Type: field.TypeBool, Default: false},
+		{Name: "processing_status", Type: field.TypeEnum, Enums: []string{"received", "processed", "ignored", "failed", "rejected"}, Default: "received"},
+		{Name: "error_message", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "received_at", Type: field.TypeTime},
+		{Name: "processed_at", Type: field.TypeTime, Nullable: true},
+	}
+	// WebhookEventsTable holds the schema information for the "webhook_events" table.
+	WebhookEventsTable = &schema.Table{
+		Name:       "webhook_events",
+		Columns:    WebhookEventsColumns,
+		PrimaryKey: []*schema.Column{WebhookEventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhookevent_provider_event_id",
+				Unique:  true,
+				Columns: []*schema.Column{WebhookEventsColumns[1], WebhookEventsColumns[2]},
+			},
+			{
+				Name:    "webhookevent_processing_status_received_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookEventsColumns[7], WebhookEventsColumns[10]},
+			},
+			{
+				Name:    "webhookevent_event_type",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookEventsColumns[3]},
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AccountDeletionsTable,
+		AdminUsersTable,
 		AuditLogsTable,
+		BalanceDriftsTable,
 		CheckInsTable,
 		ConnectionsTable,
+		ConversationsTable,
 		CreditPackagesTable,
 		CreditTransactionsTable,
+		DataExportsTable,
+		DeviceRiskFlagsTable,
 		DiscoveryBatchesTable,
 		DiscoveryCardsTable,
 		FiltersTable,
 		HobbiesTable,
 		HobbyOptionsTable,
 		InterestsTable,
+		InvoicesTable,
+		InvoiceSequencesTable,
+		LedgerEntriesTable,
+		MessagesTable,
+		ModerationActionsTable,
+		NotificationsTable,
 		NudgesTable,
+		PaymentDiscrepanciesTable,
 		PaymentOrdersTable,
+		PaymentReconciliationReportsTable,
+		PaymentRefundsTable,
 		PhotosTable,
 		ProfilesTable,
+		PromoCodesTable,
+		PromoRedemptionsTable,
+		ReferralsTable,
+		ReportEvidencesTable,
 		RevealsTable,
 		RevealContentsTable,
+		RevealGiftsTable,
 		RevealMilestonesTable,
+		RevealViewsTable,
 		ServersTable,
 		StreaksTable,
+		SubscriptionsTable,
+		SubscriptionPlansTable,
+		TierEntitlementsTable,
 		UsersTable,
 		UserBlocksTable,
+		UserDevicesTable,
+		UserIdentitiesTable,
 		UserReportsTable,
+		UserSessionsTable,
+		WebhookEventsTable,
 	}
 )
 
 func init() {
+	BalanceDriftsTable.ForeignKeys[0].RefTable = UsersTable
 	CheckInsTable.ForeignKeys[0].RefTable = StreaksTable
 	CheckInsTable.ForeignKeys[1].RefTable = UsersTable
 	ConnectionsTable.ForeignKeys[0].RefTable = UsersTable
 	ConnectionsTable.ForeignKeys[1].RefTable = UsersTable
+	ConversationsTable.ForeignKeys[0].RefTable = ConnectionsTable
 	CreditTransactionsTable.ForeignKeys[0].RefTable = UsersTable
 	DiscoveryBatchesTable.ForeignKeys[0].RefTable = UsersTable
 	DiscoveryCardsTable.ForeignKeys[0].RefTable = DiscoveryBatchesTable
@@ -1054,17 +2152,36 @@ func init() {
 	InterestsTable.ForeignKeys[0].RefTable = DiscoveryCardsTable
 	InterestsTable.ForeignKeys[1].RefTable = UsersTable
 	InterestsTable.ForeignKeys[2].RefTable = UsersTable
+	InvoicesTable.ForeignKeys[0].RefTable = PaymentOrdersTable
+	LedgerEntriesTable.ForeignKeys[0].RefTable = CreditTransactionsTable
+	MessagesTable.ForeignKeys[0].RefTable = ConversationsTable
+	MessagesTable.ForeignKeys[1].RefTable = UsersTable
+	ModerationActionsTable.ForeignKeys[0].RefTable = MessagesTable
+	ModerationActionsTable.ForeignKeys[1].RefTable = UsersTable
+	NotificationsTable.ForeignKeys[0].RefTable = UsersTable
 	NudgesTable.ForeignKeys[0].RefTable = StreaksTable
 	NudgesTable.ForeignKeys[1].RefTable = UsersTable
 	NudgesTable.ForeignKeys[2].RefTable = UsersTable
+	PaymentDiscrepanciesTable.ForeignKeys[0].RefTable = PaymentReconciliationReportsTable
 	PaymentOrdersTable.ForeignKeys[0].RefTable = UsersTable
+	PaymentRefundsTable.ForeignKeys[0].RefTable = PaymentOrdersTable
 	PhotosTable.ForeignKeys[0].RefTable = UsersTable
 	ProfilesTable.ForeignKeys[0].RefTable = UsersTable
+	PromoRedemptionsTable.ForeignKeys[0].RefTable = PromoCodesTable
+	ReportEvidencesTable.ForeignKeys[0].RefTable = UserReportsTable
 	RevealsTable.ForeignKeys[0].RefTable = ConnectionsTable
 	RevealsTable.ForeignKeys[1].RefTable = RevealMilestonesTable
 	RevealContentsTable.ForeignKeys[0].RefTable = RevealsTable
+	RevealGiftsTable.ForeignKeys[0].RefTable = ConnectionsTable
+	RevealGiftsTable.ForeignKeys[1].RefTable = RevealMilestonesTable
+	RevealGiftsTable.ForeignKeys[2].RefTable = UsersTable
+	RevealGiftsTable.ForeignKeys[3].RefTable = UsersTable
+	RevealViewsTable.ForeignKeys[0].RefTable = RevealsTable
+	RevealViewsTable.ForeignKeys[1].RefTable = UsersTable
 	StreaksTable.ForeignKeys[0].RefTable = ConnectionsTable
 	StreaksTable.ForeignKeys[1].RefTable = UsersTable
+	SubscriptionsTable.ForeignKeys[0].RefTable = SubscriptionPlansTable
+	SubscriptionsTable.ForeignKeys[1].RefTable = UsersTable
 	UserBlocksTable.ForeignKeys[0].RefTable = UsersTable
 	UserBlocksTable.ForeignKeys[1].RefTable = UsersTable
 	UserReportsTable.ForeignKeys[0].RefTable = UsersTable
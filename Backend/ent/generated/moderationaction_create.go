@@ -0,0 +1,674 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/UnoraApp/be/ent/generated/message"
+	"github.com/UnoraApp/be/ent/generated/moderationaction"
+	"github.com/UnoraApp/be/ent/generated/user"
+)
+
+// ModerationActionCreate is the builder for creating a ModerationAction entity.
+type ModerationActionCreate struct {
+	config
+	mutation *ModerationActionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *ModerationActionCreate) SetUserID(v string) *ModerationActionCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetMessageID sets the "message_id" field.
+func (_c *ModerationActionCreate) SetMessageID(v string) *ModerationActionCreate {
+	_c.mutation.SetMessageID(v)
+	return _c
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (_c *ModerationActionCreate) SetNillableMessageID(v *string) *ModerationActionCreate {
+	if v != nil {
+		_c.SetMessageID(*v)
+	}
+	return _c
+}
+
+// SetConversationID sets the "conversation_id" field.
+func (_c *ModerationActionCreate) SetConversationID(v string) *ModerationActionCreate {
+	_c.mutation.SetConversationID(v)
+	return _c
+}
+
+// SetNillableConversationID sets the "conversation_id" field if the given value is not nil.
+func (_c *ModerationActionCreate) SetNillableConversationID(v *string) *ModerationActionCreate {
+	if v != nil {
+		_c.SetConversationID(*v)
+	}
+	return _c
+}
+
+// SetAction sets the "action" field.
+func (_c *ModerationActionCreate) SetAction(v moderationaction.Action) *ModerationActionCreate {
+	_c.mutation.SetAction(v)
+	return _c
+}
+
+// SetSource sets the "source" field.
+func (_c *ModerationActionCreate) SetSource(v string) *ModerationActionCreate {
+	_c.mutation.SetSource(v)
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *ModerationActionCreate) SetReason(v string) *ModerationActionCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_c *ModerationActionCreate) SetNillableReason(v *string) *ModerationActionCreate {
+	if v != nil {
+		_c.SetReason(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ModerationActionCreate) SetCreatedAt(v time.Time) *ModerationActionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ModerationActionCreate) SetNillableCreatedAt(v *time.Time) *ModerationActionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ModerationActionCreate) SetID(v string) *ModerationActionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *ModerationActionCreate) SetUser(v *User) *ModerationActionCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// SetMessage sets the "message" edge to the Message entity.
+func (_c *ModerationActionCreate) SetMessage(v *Message) *ModerationActionCreate {
+	return _c.SetMessageID(v.ID)
+}
+
+// Mutation returns the ModerationActionMutation object of the builder.
+func (_c *ModerationActionCreate) Mutation() *ModerationActionMutation {
+	return _c.mutation
+}
+
+// Save creates the ModerationAction in the database.
+func (_c *ModerationActionCreate) Save(ctx context.Context) (*ModerationAction, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ModerationActionCreate) SaveX(ctx context.Context) *ModerationAction {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ModerationActionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ModerationActionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ModerationActionCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := moderationaction.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ModerationActionCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`generated: missing required field "ModerationAction.user_id"`)}
+	}
+	if v, ok := _c.mutation.UserID(); ok {
+		if err := moderationaction.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`generated: validator failed for field "ModerationAction.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.MessageID(); ok {
+		if err := moderationaction.MessageIDValidator(v); err != nil {
+			return &ValidationError{Name: "message_id", err: fmt.Errorf(`generated: validator failed for field "ModerationAction.message_id": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ConversationID(); ok {
+		if err := moderationaction.ConversationIDValidator(v); err != nil {
+			return &ValidationError{Name: "conversation_id", err: fmt.Errorf(`generated: validator failed for field "ModerationAction.conversation_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`generated: missing required field "ModerationAction.action"`)}
+	}
+	if v, ok := _c.mutation.Action(); ok {
+		if err := moderationaction.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`generated: validator failed for field "ModerationAction.action": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New(`generated: missing required field "ModerationAction.source"`)}
+	}
+	if v, ok := _c.mutation.Source(); ok {
+		if err := moderationaction.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`generated: validator failed for field "ModerationAction.source": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "ModerationAction.created_at"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := moderationaction.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`generated: validator failed for field "ModerationAction.id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`generated: missing required edge "ModerationAction.user"`)}
+	}
+	return nil
+}
+
+func (_c *ModerationActionCreate) sqlSave(ctx context.Context) (*ModerationAction, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected ModerationAction.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ModerationActionCreate) createSpec() (*ModerationAction, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ModerationAction{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(moderationaction.Table, sqlgraph.NewFieldSpec(moderationaction.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.ConversationID(); ok {
+		_spec.SetField(moderationaction.FieldConversationID, field.TypeString, value)
+		_node.ConversationID = &value
+	}
+	if value, ok := _c.mutation.Action(); ok {
+		_spec.SetField(moderationaction.FieldAction, field.TypeEnum, value)
+		_node.Action = value
+	}
+	if value, ok := _c.mutation.Source(); ok {
+		_spec.SetField(moderationaction.FieldSource, field.TypeString, value)
+		_node.Source = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(moderationaction.FieldReason, field.TypeString, value)
+		_node.Reason = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(moderationaction.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   moderationaction.UserTable,
+			Columns: []string{moderationaction.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.MessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   moderationaction.MessageTable,
+			Columns: []string{moderationaction.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.MessageID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ModerationAction.Create().
+//		SetUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ModerationActionUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ModerationActionCreate) OnConflict(opts ...sql.ConflictOption) *ModerationActionUpsertOne {
+	_c.conflict = opts
+	return &ModerationActionUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ModerationAction.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ModerationActionCreate) OnConflictColumns(columns ...string) *ModerationActionUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ModerationActionUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ModerationActionUpsertOne is the builder for "upsert"-ing
+	//  one ModerationAction node.
+	ModerationActionUpsertOne struct {
+		create *ModerationActionCreate
+	}
+
+	// ModerationActionUpsert is the "OnConflict" setter.
+	ModerationActionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ModerationAction.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(moderationaction.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ModerationActionUpsertOne) UpdateNewValues() *ModerationActionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(moderationaction.FieldID)
+		}
+		if _, exists := u.create.mutation.UserID(); exists {
+			s.SetIgnore(moderationaction.FieldUserID)
+		}
+		if _, exists := u.create.mutation.MessageID(); exists {
+			s.SetIgnore(moderationaction.FieldMessageID)
+		}
+		if _, exists := u.create.mutation.ConversationID(); exists {
+			s.SetIgnore(moderationaction.FieldConversationID)
+		}
+		if _, exists := u.create.mutation.Action(); exists {
+			s.SetIgnore(moderationaction.FieldAction)
+		}
+		if _, exists := u.create.mutation.Source(); exists {
+			s.SetIgnore(moderationaction.FieldSource)
+		}
+		if _, exists := u.create.mutation.Reason(); exists {
+			s.SetIgnore(moderationaction.FieldReason)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(moderationaction.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ModerationAction.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ModerationActionUpsertOne) Ignore() *ModerationActionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ModerationActionUpsertOne) DoNothing() *ModerationActionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ModerationActionCreate.OnConflict
+// documentation for more info.
+func (u *ModerationActionUpsertOne) Update(set func(*ModerationActionUpsert)) *ModerationActionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ModerationActionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *ModerationActionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("generated: missing options for ModerationActionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ModerationActionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ModerationActionUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("generated: ModerationActionUpsertOne.ID is not supported by MySQL driver. Use ModerationActionUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ModerationActionUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ModerationActionCreateBulk is the builder for creating many ModerationAction entities in bulk.
+type ModerationActionCreateBulk struct {
+	config
+	err      error
+	builders []*ModerationActionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ModerationAction entities in the database.
+func (_c *ModerationActionCreateBulk) Save(ctx context.Context) ([]*ModerationAction, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ModerationAction, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ModerationActionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ModerationActionCreateBulk) SaveX(ctx context.Context) []*ModerationAction {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ModerationActionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ModerationActionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ModerationAction.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ModerationActionUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ModerationActionCreateBulk) OnConflict(opts ...sql.ConflictOption) *ModerationActionUpsertBulk {
+	_c.conflict = opts
+	return &ModerationActionUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ModerationAction.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ModerationActionCreateBulk) OnConflictColumns(columns ...string) *ModerationActionUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ModerationActionUpsertBulk{
+		create: _c,
+	}
+}
+
+// ModerationActionUpsertBulk is the builder for "upsert"-ing
+// a bulk of ModerationAction nodes.
+type ModerationActionUpsertBulk struct {
+	create *ModerationActionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ModerationAction.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(moderationaction.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ModerationActionUpsertBulk) UpdateNewValues() *ModerationActionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(moderationaction.FieldID)
+			}
+			if _, exists := b.mutation.UserID(); exists {
+				s.SetIgnore(moderationaction.FieldUserID)
+			}
+			if _, exists := b.mutation.MessageID(); exists {
+				s.SetIgnore(moderationaction.FieldMessageID)
+			}
+			if _, exists := b.mutation.ConversationID(); exists {
+				s.SetIgnore(moderationaction.FieldConversationID)
+			}
+			if _, exists := b.mutation.Action(); exists {
+				s.SetIgnore(moderationaction.FieldAction)
+			}
+			if _, exists := b.mutation.Source(); exists {
+				s.SetIgnore(moderationaction.FieldSource)
+			}
+			if _, exists := b.mutation.Reason(); exists {
+				s.SetIgnore(moderationaction.FieldReason)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(moderationaction.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ModerationAction.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ModerationActionUpsertBulk) Ignore() *ModerationActionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ModerationActionUpsertBulk) DoNothing() *ModerationActionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ModerationActionCreateBulk.OnConflict
+// documentation for more info.
+func (u *ModerationActionUpsertBulk) Update(set func(*ModerationActionUpsert)) *ModerationActionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ModerationActionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *ModerationActionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("generated: OnConflict was set for builder %d. Set it on the ModerationActionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("generated: missing options for ModerationActionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ModerationActionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,209 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/UnoraApp/be/ent/generated/moderationaction"
+	"github.com/UnoraApp/be/ent/generated/predicate"
+)
+
+// ModerationActionUpdate is the builder for updating ModerationAction entities.
+type ModerationActionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ModerationActionMutation
+}
+
+// Where appends a list predicates to the ModerationActionUpdate builder.
+func (_u *ModerationActionUpdate) Where(ps ...predicate.ModerationAction) *ModerationActionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Mutation returns the ModerationActionMutation object of the builder.
+func (_u *ModerationActionUpdate) Mutation() *ModerationActionMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ModerationActionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ModerationActionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ModerationActionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ModerationActionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ModerationActionUpdate) check() error {
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "ModerationAction.user"`)
+	}
+	return nil
+}
+
+func (_u *ModerationActionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(moderationaction.Table, moderationaction.Columns, sqlgraph.NewFieldSpec(moderationaction.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.ConversationIDCleared() {
+		_spec.ClearField(moderationaction.FieldConversationID, field.TypeString)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(moderationaction.FieldReason, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{moderationaction.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ModerationActionUpdateOne is the builder for updating a single ModerationAction entity.
+type ModerationActionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ModerationActionMutation
+}
+
+// Mutation returns the ModerationActionMutation object of the builder.
+func (_u *ModerationActionUpdateOne) Mutation() *ModerationActionMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ModerationActionUpdate builder.
+func (_u *ModerationActionUpdateOne) Where(ps ...predicate.ModerationAction) *ModerationActionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ModerationActionUpdateOne) Select(field string, fields ...string) *ModerationActionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ModerationAction entity.
+func (_u *ModerationActionUpdateOne) Save(ctx context.Context) (*ModerationAction, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ModerationActionUpdateOne) SaveX(ctx context.Context) *ModerationAction {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ModerationActionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ModerationActionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ModerationActionUpdateOne) check() error {
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "ModerationAction.user"`)
+	}
+	return nil
+}
+
+func (_u *ModerationActionUpdateOne) sqlSave(ctx context.Context) (_node *ModerationAction, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(moderationaction.Table, moderationaction.Columns, sqlgraph.NewFieldSpec(moderationaction.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "ModerationAction.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, moderationaction.FieldID)
+		for _, f := range fields {
+			if !moderationaction.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != moderationaction.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.ConversationIDCleared() {
+		_spec.ClearField(moderationaction.FieldConversationID, field.TypeString)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(moderationaction.FieldReason, field.TypeString)
+	}
+	_node = &ModerationAction{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{moderationaction.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,226 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/UnoraApp/be/ent/generated/paymentdiscrepancy"
+	"github.com/UnoraApp/be/ent/generated/paymentreconciliationreport"
+)
+
+// PaymentDiscrepancy is the model entity for the PaymentDiscrepancy schema.
+type PaymentDiscrepancy struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// ReportID holds the value of the "report_id" field.
+	ReportID string `json:"report_id,omitempty"`
+	// OrderID holds the value of the "order_id" field.
+	OrderID *string `json:"order_id,omitempty"`
+	// RazorpayOrderID holds the value of the "razorpay_order_id" field.
+	RazorpayOrderID string `json:"razorpay_order_id,omitempty"`
+	// RazorpayPaymentID holds the value of the "razorpay_payment_id" field.
+	RazorpayPaymentID *string `json:"razorpay_payment_id,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind paymentdiscrepancy.Kind `json:"kind,omitempty"`
+	// DbStatus holds the value of the "db_status" field.
+	DbStatus string `json:"db_status,omitempty"`
+	// GatewayStatus holds the value of the "gateway_status" field.
+	GatewayStatus string `json:"gateway_status,omitempty"`
+	// DbAmount holds the value of the "db_amount" field.
+	DbAmount int `json:"db_amount,omitempty"`
+	// GatewayAmount holds the value of the "gateway_amount" field.
+	GatewayAmount int `json:"gateway_amount,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PaymentDiscrepancyQuery when eager-loading is set.
+	Edges        PaymentDiscrepancyEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PaymentDiscrepancyEdges holds the relations/edges for other nodes in the graph.
+type PaymentDiscrepancyEdges struct {
+	// Report holds the value of the report edge.
+	Report *PaymentReconciliationReport `json:"report,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ReportOrErr returns the Report value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PaymentDiscrepancyEdges) ReportOrErr() (*PaymentReconciliationReport, error) {
+	if e.Report != nil {
+		return e.Report, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: paymentreconciliationreport.Label}
+	}
+	return nil, &NotLoadedError{edge: "report"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PaymentDiscrepancy) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case paymentdiscrepancy.FieldDbAmount, paymentdiscrepancy.FieldGatewayAmount:
+			values[i] = new(sql.NullInt64)
+		case paymentdiscrepancy.FieldID, paymentdiscrepancy.FieldReportID, paymentdiscrepancy.FieldOrderID, paymentdiscrepancy.FieldRazorpayOrderID, paymentdiscrepancy.FieldRazorpayPaymentID, paymentdiscrepancy.FieldKind, paymentdiscrepancy.FieldDbStatus, paymentdiscrepancy.FieldGatewayStatus:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PaymentDiscrepancy fields.
+func (_m *PaymentDiscrepancy) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case paymentdiscrepancy.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case paymentdiscrepancy.FieldReportID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field report_id", values[i])
+			} else if value.Valid {
+				_m.ReportID = value.String
+			}
+		case paymentdiscrepancy.FieldOrderID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field order_id", values[i])
+			} else if value.Valid {
+				_m.OrderID = new(string)
+				*_m.OrderID = value.String
+			}
+		case paymentdiscrepancy.FieldRazorpayOrderID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field razorpay_order_id", values[i])
+			} else if value.Valid {
+				_m.RazorpayOrderID = value.String
+			}
+		case paymentdiscrepancy.FieldRazorpayPaymentID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field razorpay_payment_id", values[i])
+			} else if value.Valid {
+				_m.RazorpayPaymentID = new(string)
+				*_m.RazorpayPaymentID = value.String
+			}
+		case paymentdiscrepancy.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				_m.Kind = paymentdiscrepancy.Kind(value.String)
+			}
+		case paymentdiscrepancy.FieldDbStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field db_status", values[i])
+			} else if value.Valid {
+				_m.DbStatus = value.String
+			}
+		case paymentdiscrepancy.FieldGatewayStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field gateway_status", values[i])
+			} else if value.Valid {
+				_m.GatewayStatus = value.String
+			}
+		case paymentdiscrepancy.FieldDbAmount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field db_amount", values[i])
+			} else if value.Valid {
+				_m.DbAmount = int(value.Int64)
+			}
+		case paymentdiscrepancy.FieldGatewayAmount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field gateway_amount", values[i])
+			} else if value.Valid {
+				_m.GatewayAmount = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PaymentDiscrepancy.
+// This includes values selected through modifiers, order, etc.
+func (_m *PaymentDiscrepancy) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryReport queries the "report" edge of the PaymentDiscrepancy entity.
+func (_m *PaymentDiscrepancy) QueryReport() *PaymentReconciliationReportQuery {
+	return NewPaymentDiscrepancyClient(_m.config).QueryReport(_m)
+}
+
+// Update returns a builder for updating this PaymentDiscrepancy.
+// Note that you need to call PaymentDiscrepancy.Unwrap() before calling this method if this PaymentDiscrepancy
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *PaymentDiscrepancy) Update() *PaymentDiscrepancyUpdateOne {
+	return NewPaymentDiscrepancyClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the PaymentDiscrepancy entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *PaymentDiscrepancy) Unwrap() *PaymentDiscrepancy {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("generated: PaymentDiscrepancy is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *PaymentDiscrepancy) String() string {
+	var builder strings.Builder
+	builder.WriteString("PaymentDiscrepancy(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("report_id=")
+	builder.WriteString(_m.ReportID)
+	builder.WriteString(", ")
+	if v := _m.OrderID; v != nil {
+		builder.WriteString("order_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("razorpay_order_id=")
+	builder.WriteString(_m.RazorpayOrderID)
+	builder.WriteString(", ")
+	if v := _m.RazorpayPaymentID; v != nil {
+		builder.WriteString("razorpay_payment_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Kind))
+	builder.WriteString(", ")
+	builder.WriteString("db_status=")
+	builder.WriteString(_m.DbStatus)
+	builder.WriteString(", ")
+	builder.WriteString("gateway_status=")
+	builder.WriteString(_m.GatewayStatus)
+	builder.WriteString(", ")
+	builder.WriteString("db_amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DbAmount))
+	builder.WriteString(", ")
+	builder.WriteString("gateway_amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.GatewayAmount))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PaymentDiscrepancies is a parsable slice of PaymentDiscrepancy.
+type PaymentDiscrepancies []*PaymentDiscrepancy
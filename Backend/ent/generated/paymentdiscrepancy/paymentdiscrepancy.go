@@ -0,0 +1,184 @@
+// Code generated by ent, DO NOT EDIT.
+
+package paymentdiscrepancy
+
+import (
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the paymentdiscrepancy type in the database.
+	Label = "payment_discrepancy"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldReportID holds the string denoting the report_id field in the database.
+	FieldReportID = "report_id"
+	// FieldOrderID holds the string denoting the order_id field in the database.
+	FieldOrderID = "order_id"
+	// FieldRazorpayOrderID holds the string denoting the razorpay_order_id field in the database.
+	FieldRazorpayOrderID = "razorpay_order_id"
+	// FieldRazorpayPaymentID holds the string denoting the razorpay_payment_id field in the database.
+	FieldRazorpayPaymentID = "razorpay_payment_id"
+	// FieldKind holds the string denoting the kind field in the database.
+	FieldKind = "kind"
+	// FieldDbStatus holds the string denoting the db_status field in the database.
+	FieldDbStatus = "db_status"
+	// FieldGatewayStatus holds the string denoting the gateway_status field in the database.
+	FieldGatewayStatus = "gateway_status"
+	// FieldDbAmount holds the string denoting the db_amount field in the database.
+	FieldDbAmount = "db_amount"
+	// FieldGatewayAmount holds the string denoting the gateway_amount field in the database.
+	FieldGatewayAmount = "gateway_amount"
+	// EdgeReport holds the string denoting the report edge name in mutations.
+	EdgeReport = "report"
+	// Table holds the table name of the paymentdiscrepancy in the database.
+	Table = "payment_discrepancies"
+	// ReportTable is the table that holds the report relation/edge.
+	ReportTable = "payment_discrepancies"
+	// ReportInverseTable is the table name for the PaymentReconciliationReport entity.
+	// It exists in this package in order to avoid circular dependency with the "paymentreconciliationreport" package.
+	ReportInverseTable = "payment_reconciliation_reports"
+	// ReportColumn is the table column denoting the report relation/edge.
+	ReportColumn = "report_id"
+)
+
+// Columns holds all SQL columns for paymentdiscrepancy fields.
+var Columns = []string{
+	FieldID,
+	FieldReportID,
+	FieldOrderID,
+	FieldRazorpayOrderID,
+	FieldRazorpayPaymentID,
+	FieldKind,
+	FieldDbStatus,
+	FieldGatewayStatus,
+	FieldDbAmount,
+	FieldGatewayAmount,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// ReportIDValidator is a validator for the "report_id" field. It is called by the builders before save.
+	ReportIDValidator func(string) error
+	// OrderIDValidator is a validator for the "order_id" field. It is called by the builders before save.
+	OrderIDValidator func(string) error
+	// RazorpayOrderIDValidator is a validator for the "razorpay_order_id" field. It is called by the builders before save.
+	RazorpayOrderIDValidator func(string) error
+	// RazorpayPaymentIDValidator is a validator for the "razorpay_payment_id" field. It is called by the builders before save.
+	RazorpayPaymentIDValidator func(string) error
+	// DbStatusValidator is a validator for the "db_status" field. It is called by the builders before save.
+	DbStatusValidator func(string) error
+	// GatewayStatusValidator is a validator for the "gateway_status" field. It is called by the builders before save.
+	GatewayStatusValidator func(string) error
+	// DefaultDbAmount holds the default value on creation for the "db_amount" field.
+	DefaultDbAmount int
+	// DefaultGatewayAmount holds the default value on creation for the "gateway_amount" field.
+	DefaultGatewayAmount int
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
+
+// Kind defines the type for the "kind" enum field.
+type Kind string
+
+// Kind values.
+const (
+	KindCapturedNotFulfilled Kind = "captured_not_fulfilled"
+	KindPaidNotCaptured      Kind = "paid_not_captured"
+	KindAmountMismatch       Kind = "amount_mismatch"
+	KindRefundMismatch       Kind = "refund_mismatch"
+	KindUnknownOrder         Kind = "unknown_order"
+)
+
+func (k Kind) String() string {
+	return string(k)
+}
+
+// KindValidator is a validator for the "kind" field enum values. It is called by the builders before save.
+func KindValidator(k Kind) error {
+	switch k {
+	case KindCapturedNotFulfilled, KindPaidNotCaptured, KindAmountMismatch, KindRefundMismatch, KindUnknownOrder:
+		return nil
+	default:
+		return fmt.Errorf("paymentdiscrepancy: invalid enum value for kind field: %q", k)
+	}
+}
+
+// OrderOption defines the ordering options for the PaymentDiscrepancy queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByReportID orders the results by the report_id field.
+func ByReportID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReportID, opts...).ToFunc()
+}
+
+// ByOrderID orders the results by the order_id field.
+func ByOrderID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrderID, opts...).ToFunc()
+}
+
+// ByRazorpayOrderID orders the results by the razorpay_order_id field.
+func ByRazorpayOrderID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRazorpayOrderID, opts...).ToFunc()
+}
+
+// ByRazorpayPaymentID orders the results by the razorpay_payment_id field.
+func ByRazorpayPaymentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRazorpayPaymentID, opts...).ToFunc()
+}
+
+// ByKind orders the results by the kind field.
+func ByKind(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKind, opts...).ToFunc()
+}
+
+// ByDbStatus orders the results by the db_status field.
+func ByDbStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDbStatus, opts...).ToFunc()
+}
+
+// ByGatewayStatus orders the results by the gateway_status field.
+func ByGatewayStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGatewayStatus, opts...).ToFunc()
+}
+
+// ByDbAmount orders the results by the db_amount field.
+func ByDbAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDbAmount, opts...).ToFunc()
+}
+
+// ByGatewayAmount orders the results by the gateway_amount field.
+func ByGatewayAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGatewayAmount, opts...).ToFunc()
+}
+
+// ByReportField orders the results by report field.
+func ByReportField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReportStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newReportStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ReportInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ReportTable, ReportColumn),
+	)
+}
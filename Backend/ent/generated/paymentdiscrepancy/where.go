@@ -0,0 +1,672 @@
+// Code generated by ent, DO NOT EDIT.
+
+package paymentdiscrepancy
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/UnoraApp/be/ent/generated/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldContainsFold(FieldID, id))
+}
+
+// ReportID applies equality check predicate on the "report_id" field. It's identical to ReportIDEQ.
+func ReportID(v string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldEQ(FieldReportID, v))
+}
+
+// OrderID applies equality check predicate on the "order_id" field. It's identical to OrderIDEQ.
+func OrderID(v string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldEQ(FieldOrderID, v))
+}
+
+// RazorpayOrderID applies equality check predicate on the "razorpay_order_id" field. It's identical to RazorpayOrderIDEQ.
+func RazorpayOrderID(v string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldEQ(FieldRazorpayOrderID, v))
+}
+
+// RazorpayPaymentID applies equality check predicate on the "razorpay_payment_id" field. It's identical to RazorpayPaymentIDEQ.
+func RazorpayPaymentID(v string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldEQ(FieldRazorpayPaymentID, v))
+}
+
+// DbStatus applies equality check predicate on the "db_status" field. It's identical to DbStatusEQ.
+func DbStatus(v string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldEQ(FieldDbStatus, v))
+}
+
+// GatewayStatus applies equality check predicate on the "gateway_status" field. It's identical to GatewayStatusEQ.
+func GatewayStatus(v string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldEQ(FieldGatewayStatus, v))
+}
+
+// DbAmount applies equality check predicate on the "db_amount" field. It's identical to DbAmountEQ.
+func DbAmount(v int) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldEQ(FieldDbAmount, v))
+}
+
+// GatewayAmount applies equality check predicate on the "gateway_amount" field. It's identical to GatewayAmountEQ.
+func GatewayAmount(v int) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldEQ(FieldGatewayAmount, v))
+}
+
+// ReportIDEQ applies the EQ predicate on the "report_id" field.
+func ReportIDEQ(v string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldEQ(FieldReportID, v))
+}
+
+// ReportIDNEQ applies the NEQ predicate on the "report_id" field.
+func ReportIDNEQ(v string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldNEQ(FieldReportID, v))
+}
+
+// ReportIDIn applies the In predicate on the "report_id" field.
+func ReportIDIn(vs ...string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldIn(FieldReportID, vs...))
+}
+
+// ReportIDNotIn applies the NotIn predicate on the "report_id" field.
+func ReportIDNotIn(vs ...string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldNotIn(FieldReportID, vs...))
+}
+
+// ReportIDGT applies the GT predicate on the "report_id" field.
+func ReportIDGT(v string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldGT(FieldReportID, v))
+}
+
+// ReportIDGTE applies the GTE predicate on the "report_id" field.
+func ReportIDGTE(v string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldGTE(FieldReportID, v))
+}
+
+// ReportIDLT applies the LT predicate on the "report_id" field.
+func ReportIDLT(v string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldLT(FieldReportID, v))
+}
+
+// ReportIDLTE applies the LTE predicate on the "report_id" field.
+func ReportIDLTE(v string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldLTE(FieldReportID, v))
+}
+
+// ReportIDContains applies the Contains predicate on the "report_id" field.
+func ReportIDContains(v string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldContains(FieldReportID, v))
+}
+
+// ReportIDHasPrefix applies the HasPrefix predicate on the "report_id" field.
+func ReportIDHasPrefix(v string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldHasPrefix(FieldReportID, v))
+}
+
+// ReportIDHasSuffix applies the HasSuffix predicate on the "report_id" field.
+func ReportIDHasSuffix(v string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldHasSuffix(FieldReportID, v))
+}
+
+// ReportIDEqualFold applies the EqualFold predicate on the "report_id" field.
+func ReportIDEqualFold(v string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldEqualFold(FieldReportID, v))
+}
+
+// ReportIDContainsFold applies the ContainsFold predicate on the "report_id" field.
+func ReportIDContainsFold(v string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldContainsFold(FieldReportID, v))
+}
+
+// OrderIDEQ applies the EQ predicate on the "order_id" field.
+func OrderIDEQ(v string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldEQ(FieldOrderID, v))
+}
+
+// OrderIDNEQ applies the NEQ predicate on the "order_id" field.
+func OrderIDNEQ(v string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldNEQ(FieldOrderID, v))
+}
+
+// OrderIDIn applies the In predicate on the "order_id" field.
+func OrderIDIn(vs ...string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldIn(FieldOrderID, vs...))
+}
+
+// OrderIDNotIn applies the NotIn predicate on the "order_id" field.
+func OrderIDNotIn(vs ...string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldNotIn(FieldOrderID, vs...))
+}
+
+// OrderIDGT applies the GT predicate on the "order_id" field.
+func OrderIDGT(v string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldGT(FieldOrderID, v))
+}
+
+// OrderIDGTE applies the GTE predicate on the "order_id" field.
+func OrderIDGTE(v string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldGTE(FieldOrderID, v))
+}
+
+// OrderIDLT applies the LT predicate on the "order_id" field.
+func OrderIDLT(v string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldLT(FieldOrderID, v))
+}
+
+// OrderIDLTE applies the LTE predicate on the "order_id" field.
+func OrderIDLTE(v string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldLTE(FieldOrderID, v))
+}
+
+// OrderIDContains applies the Contains predicate on the "order_id" field.
+func OrderIDContains(v string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldContains(FieldOrderID, v))
+}
+
+// OrderIDHasPrefix applies the HasPrefix predicate on the "order_id" field.
+func OrderIDHasPrefix(v string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldHasPrefix(FieldOrderID, v))
+}
+
+// OrderIDHasSuffix applies the HasSuffix predicate on the "order_id" field.
+func OrderIDHasSuffix(v string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldHasSuffix(FieldOrderID, v))
+}
+
+// OrderIDIsNil applies the IsNil predicate on the "order_id" field.
+func OrderIDIsNil() predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldIsNull(FieldOrderID))
+}
+
+// OrderIDNotNil applies the NotNil predicate on the "order_id" field.
+func OrderIDNotNil() predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldNotNull(FieldOrderID))
+}
+
+// OrderIDEqualFold applies the EqualFold predicate on the "order_id" field.
+func OrderIDEqualFold(v string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldEqualFold(FieldOrderID, v))
+}
+
+// OrderIDContainsFold applies the ContainsFold predicate on the "order_id" field.
+func OrderIDContainsFold(v string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldContainsFold(FieldOrderID, v))
+}
+
+// RazorpayOrderIDEQ applies the EQ predicate on the "razorpay_order_id" field.
+func RazorpayOrderIDEQ(v string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldEQ(FieldRazorpayOrderID, v))
+}
+
+// RazorpayOrderIDNEQ applies the NEQ predicate on the "razorpay_order_id" field.
+func RazorpayOrderIDNEQ(v string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldNEQ(FieldRazorpayOrderID, v))
+}
+
+// RazorpayOrderIDIn applies the In predicate on the "razorpay_order_id" field.
+func RazorpayOrderIDIn(vs ...string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldIn(FieldRazorpayOrderID, vs...))
+}
+
+// RazorpayOrderIDNotIn applies the NotIn predicate on the "razorpay_order_id" field.
+func RazorpayOrderIDNotIn(vs ...string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldNotIn(FieldRazorpayOrderID, vs...))
+}
+
+// RazorpayOrderIDGT applies the GT predicate on the "razorpay_order_id" field.
+func RazorpayOrderIDGT(v string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldGT(FieldRazorpayOrderID, v))
+}
+
+// RazorpayOrderIDGTE applies the GTE predicate on the "razorpay_order_id" field.
+func RazorpayOrderIDGTE(v string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldGTE(FieldRazorpayOrderID, v))
+}
+
+// RazorpayOrderIDLT applies the LT predicate on the "razorpay_order_id" field.
+func RazorpayOrderIDLT(v string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldLT(FieldRazorpayOrderID, v))
+}
+
+// RazorpayOrderIDLTE applies the LTE predicate on the "razorpay_order_id" field.
+func RazorpayOrderIDLTE(v string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldLTE(FieldRazorpayOrderID, v))
+}
+
+// RazorpayOrderIDContains applies the Contains predicate on the "razorpay_order_id" field.
+func RazorpayOrderIDContains(v string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldContains(FieldRazorpayOrderID, v))
+}
+
+// RazorpayOrderIDHasPrefix applies the HasPrefix predicate on the "razorpay_order_id" field.
+func RazorpayOrderIDHasPrefix(v string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldHasPrefix(FieldRazorpayOrderID, v))
+}
+
+// RazorpayOrderIDHasSuffix applies the HasSuffix predicate on the "razorpay_order_id" field.
+func RazorpayOrderIDHasSuffix(v string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldHasSuffix(FieldRazorpayOrderID, v))
+}
+
+// RazorpayOrderIDEqualFold applies the EqualFold predicate on the "razorpay_order_id" field.
+func RazorpayOrderIDEqualFold(v string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldEqualFold(FieldRazorpayOrderID, v))
+}
+
+// RazorpayOrderIDContainsFold applies the ContainsFold predicate on the "razorpay_order_id" field.
+func RazorpayOrderIDContainsFold(v string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldContainsFold(FieldRazorpayOrderID, v))
+}
+
+// RazorpayPaymentIDEQ applies the EQ predicate on the "razorpay_payment_id" field.
+func RazorpayPaymentIDEQ(v string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldEQ(FieldRazorpayPaymentID, v))
+}
+
+// RazorpayPaymentIDNEQ applies the NEQ predicate on the "razorpay_payment_id" field.
+func RazorpayPaymentIDNEQ(v string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldNEQ(FieldRazorpayPaymentID, v))
+}
+
+// RazorpayPaymentIDIn applies the In predicate on the "razorpay_payment_id" field.
+func RazorpayPaymentIDIn(vs ...string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldIn(FieldRazorpayPaymentID, vs...))
+}
+
+// RazorpayPaymentIDNotIn applies the NotIn predicate on the "razorpay_payment_id" field.
+func RazorpayPaymentIDNotIn(vs ...string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldNotIn(FieldRazorpayPaymentID, vs...))
+}
+
+// RazorpayPaymentIDGT applies the GT predicate on the "razorpay_payment_id" field.
+func RazorpayPaymentIDGT(v string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldGT(FieldRazorpayPaymentID, v))
+}
+
+// RazorpayPaymentIDGTE applies the GTE predicate on the "razorpay_payment_id" field.
+func RazorpayPaymentIDGTE(v string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldGTE(FieldRazorpayPaymentID, v))
+}
+
+// RazorpayPaymentIDLT applies the LT predicate on the "razorpay_payment_id" field.
+func RazorpayPaymentIDLT(v string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldLT(FieldRazorpayPaymentID, v))
+}
+
+// RazorpayPaymentIDLTE applies the LTE predicate on the "razorpay_payment_id" field.
+func RazorpayPaymentIDLTE(v string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldLTE(FieldRazorpayPaymentID, v))
+}
+
+// RazorpayPaymentIDContains applies the Contains predicate on the "razorpay_payment_id" field.
+func RazorpayPaymentIDContains(v string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldContains(FieldRazorpayPaymentID, v))
+}
+
+// RazorpayPaymentIDHasPrefix applies the HasPrefix predicate on the "razorpay_payment_id" field.
+func RazorpayPaymentIDHasPrefix(v string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldHasPrefix(FieldRazorpayPaymentID, v))
+}
+
+// RazorpayPaymentIDHasSuffix applies the HasSuffix predicate on the "razorpay_payment_id" field.
+func RazorpayPaymentIDHasSuffix(v string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldHasSuffix(FieldRazorpayPaymentID, v))
+}
+
+// RazorpayPaymentIDIsNil applies the IsNil predicate on the "razorpay_payment_id" field.
+func RazorpayPaymentIDIsNil() predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldIsNull(FieldRazorpayPaymentID))
+}
+
+// RazorpayPaymentIDNotNil applies the NotNil predicate on the "razorpay_payment_id" field.
+func RazorpayPaymentIDNotNil() predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldNotNull(FieldRazorpayPaymentID))
+}
+
+// RazorpayPaymentIDEqualFold applies the EqualFold predicate on the "razorpay_payment_id" field.
+func RazorpayPaymentIDEqualFold(v string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldEqualFold(FieldRazorpayPaymentID, v))
+}
+
+// RazorpayPaymentIDContainsFold applies the ContainsFold predicate on the "razorpay_payment_id" field.
+func RazorpayPaymentIDContainsFold(v string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldContainsFold(FieldRazorpayPaymentID, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v Kind) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v Kind) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...Kind) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...Kind) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// DbStatusEQ applies the EQ predicate on the "db_status" field.
+func DbStatusEQ(v string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldEQ(FieldDbStatus, v))
+}
+
+// DbStatusNEQ applies the NEQ predicate on the "db_status" field.
+func DbStatusNEQ(v string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldNEQ(FieldDbStatus, v))
+}
+
+// DbStatusIn applies the In predicate on the "db_status" field.
+func DbStatusIn(vs ...string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldIn(FieldDbStatus, vs...))
+}
+
+// DbStatusNotIn applies the NotIn predicate on the "db_status" field.
+func DbStatusNotIn(vs ...string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldNotIn(FieldDbStatus, vs...))
+}
+
+// DbStatusGT applies the GT predicate on the "db_status" field.
+func DbStatusGT(v string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldGT(FieldDbStatus, v))
+}
+
+// DbStatusGTE applies the GTE predicate on the "db_status" field.
+func DbStatusGTE(v string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldGTE(FieldDbStatus, v))
+}
+
+// DbStatusLT applies the LT predicate on the "db_status" field.
+func DbStatusLT(v string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldLT(FieldDbStatus, v))
+}
+
+// DbStatusLTE applies the LTE predicate on the "db_status" field.
+func DbStatusLTE(v string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldLTE(FieldDbStatus, v))
+}
+
+// DbStatusContains applies the Contains predicate on the "db_status" field.
+func DbStatusContains(v string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldContains(FieldDbStatus, v))
+}
+
+// DbStatusHasPrefix applies the HasPrefix predicate on the "db_status" field.
+func DbStatusHasPrefix(v string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldHasPrefix(FieldDbStatus, v))
+}
+
+// DbStatusHasSuffix applies the HasSuffix predicate on the "db_status" field.
+func DbStatusHasSuffix(v string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldHasSuffix(FieldDbStatus, v))
+}
+
+// DbStatusIsNil applies the IsNil predicate on the "db_status" field.
+func DbStatusIsNil() predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldIsNull(FieldDbStatus))
+}
+
+// DbStatusNotNil applies the NotNil predicate on the "db_status" field.
+func DbStatusNotNil() predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldNotNull(FieldDbStatus))
+}
+
+// DbStatusEqualFold applies the EqualFold predicate on the "db_status" field.
+func DbStatusEqualFold(v string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldEqualFold(FieldDbStatus, v))
+}
+
+// DbStatusContainsFold applies the ContainsFold predicate on the "db_status" field.
+func DbStatusContainsFold(v string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldContainsFold(FieldDbStatus, v))
+}
+
+// GatewayStatusEQ applies the EQ predicate on the "gateway_status" field.
+func GatewayStatusEQ(v string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldEQ(FieldGatewayStatus, v))
+}
+
+// GatewayStatusNEQ applies the NEQ predicate on the "gateway_status" field.
+func GatewayStatusNEQ(v string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldNEQ(FieldGatewayStatus, v))
+}
+
+// GatewayStatusIn applies the In predicate on the "gateway_status" field.
+func GatewayStatusIn(vs ...string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldIn(FieldGatewayStatus, vs...))
+}
+
+// GatewayStatusNotIn applies the NotIn predicate on the "gateway_status" field.
+func GatewayStatusNotIn(vs ...string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldNotIn(FieldGatewayStatus, vs...))
+}
+
+// GatewayStatusGT applies the GT predicate on the "gateway_status" field.
+func GatewayStatusGT(v string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldGT(FieldGatewayStatus, v))
+}
+
+// GatewayStatusGTE applies the GTE predicate on the "gateway_status" field.
+func GatewayStatusGTE(v string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldGTE(FieldGatewayStatus, v))
+}
+
+// GatewayStatusLT applies the LT predicate on the "gateway_status" field.
+func GatewayStatusLT(v string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldLT(FieldGatewayStatus, v))
+}
+
+// GatewayStatusLTE applies the LTE predicate on the "gateway_status" field.
+func GatewayStatusLTE(v string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldLTE(FieldGatewayStatus, v))
+}
+
+// GatewayStatusContains applies the Contains predicate on the "gateway_status" field.
+func GatewayStatusContains(v string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldContains(FieldGatewayStatus, v))
+}
+
+// GatewayStatusHasPrefix applies the HasPrefix predicate on the "gateway_status" field.
+func GatewayStatusHasPrefix(v string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldHasPrefix(FieldGatewayStatus, v))
+}
+
+// GatewayStatusHasSuffix applies the HasSuffix predicate on the "gateway_status" field.
+func GatewayStatusHasSuffix(v string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldHasSuffix(FieldGatewayStatus, v))
+}
+
+// GatewayStatusIsNil applies the IsNil predicate on the "gateway_status" field.
+func GatewayStatusIsNil() predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldIsNull(FieldGatewayStatus))
+}
+
+// GatewayStatusNotNil applies the NotNil predicate on the "gateway_status" field.
+func GatewayStatusNotNil() predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldNotNull(FieldGatewayStatus))
+}
+
+// GatewayStatusEqualFold applies the EqualFold predicate on the "gateway_status" field.
+func GatewayStatusEqualFold(v string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldEqualFold(FieldGatewayStatus, v))
+}
+
+// GatewayStatusContainsFold applies the ContainsFold predicate on the "gateway_status" field.
+func GatewayStatusContainsFold(v string) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldContainsFold(FieldGatewayStatus, v))
+}
+
+// DbAmountEQ applies the EQ predicate on the "db_amount" field.
+func DbAmountEQ(v int) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldEQ(FieldDbAmount, v))
+}
+
+// DbAmountNEQ applies the NEQ predicate on the "db_amount" field.
+func DbAmountNEQ(v int) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldNEQ(FieldDbAmount, v))
+}
+
+// DbAmountIn applies the In predicate on the "db_amount" field.
+func DbAmountIn(vs ...int) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldIn(FieldDbAmount, vs...))
+}
+
+// DbAmountNotIn applies the NotIn predicate on the "db_amount" field.
+func DbAmountNotIn(vs ...int) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldNotIn(FieldDbAmount, vs...))
+}
+
+// DbAmountGT applies the GT predicate on the "db_amount" field.
+func DbAmountGT(v int) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldGT(FieldDbAmount, v))
+}
+
+// DbAmountGTE applies the GTE predicate on the "db_amount" field.
+func DbAmountGTE(v int) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldGTE(FieldDbAmount, v))
+}
+
+// DbAmountLT applies the LT predicate on the "db_amount" field.
+func DbAmountLT(v int) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldLT(FieldDbAmount, v))
+}
+
+// DbAmountLTE applies the LTE predicate on the "db_amount" field.
+func DbAmountLTE(v int) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldLTE(FieldDbAmount, v))
+}
+
+// GatewayAmountEQ applies the EQ predicate on the "gateway_amount" field.
+func GatewayAmountEQ(v int) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldEQ(FieldGatewayAmount, v))
+}
+
+// GatewayAmountNEQ applies the NEQ predicate on the "gateway_amount" field.
+func GatewayAmountNEQ(v int) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldNEQ(FieldGatewayAmount, v))
+}
+
+// GatewayAmountIn applies the In predicate on the "gateway_amount" field.
+func GatewayAmountIn(vs ...int) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldIn(FieldGatewayAmount, vs...))
+}
+
+// GatewayAmountNotIn applies the NotIn predicate on the "gateway_amount" field.
+func GatewayAmountNotIn(vs ...int) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldNotIn(FieldGatewayAmount, vs...))
+}
+
+// GatewayAmountGT applies the GT predicate on the "gateway_amount" field.
+func GatewayAmountGT(v int) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldGT(FieldGatewayAmount, v))
+}
+
+// GatewayAmountGTE applies the GTE predicate on the "gateway_amount" field.
+func GatewayAmountGTE(v int) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldGTE(FieldGatewayAmount, v))
+}
+
+// GatewayAmountLT applies the LT predicate on the "gateway_amount" field.
+func GatewayAmountLT(v int) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldLT(FieldGatewayAmount, v))
+}
+
+// GatewayAmountLTE applies the LTE predicate on the "gateway_amount" field.
+func GatewayAmountLTE(v int) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.FieldLTE(FieldGatewayAmount, v))
+}
+
+// HasReport applies the HasEdge predicate on the "report" edge.
+func HasReport() predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ReportTable, ReportColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReportWith applies the HasEdge predicate on the "report" edge with a given conditions (other predicates).
+func HasReportWith(preds ...predicate.PaymentReconciliationReport) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(func(s *sql.Selector) {
+		step := newReportStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PaymentDiscrepancy) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PaymentDiscrepancy) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PaymentDiscrepancy) predicate.PaymentDiscrepancy {
+	return predicate.PaymentDiscrepancy(sql.NotPredicates(p))
+}
@@ -0,0 +1,1111 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/UnoraApp/be/ent/generated/paymentdiscrepancy"
+	"github.com/UnoraApp/be/ent/generated/paymentreconciliationreport"
+)
+
+// PaymentDiscrepancyCreate is the builder for creating a PaymentDiscrepancy entity.
+type PaymentDiscrepancyCreate struct {
+	config
+	mutation *PaymentDiscrepancyMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetReportID sets the "report_id" field.
+func (_c *PaymentDiscrepancyCreate) SetReportID(v string) *PaymentDiscrepancyCreate {
+	_c.mutation.SetReportID(v)
+	return _c
+}
+
+// SetOrderID sets the "order_id" field.
+func (_c *PaymentDiscrepancyCreate) SetOrderID(v string) *PaymentDiscrepancyCreate {
+	_c.mutation.SetOrderID(v)
+	return _c
+}
+
+// SetNillableOrderID sets the "order_id" field if the given value is not nil.
+func (_c *PaymentDiscrepancyCreate) SetNillableOrderID(v *string) *PaymentDiscrepancyCreate {
+	if v != nil {
+		_c.SetOrderID(*v)
+	}
+	return _c
+}
+
+// SetRazorpayOrderID sets the "razorpay_order_id" field.
+func (_c *PaymentDiscrepancyCreate) SetRazorpayOrderID(v string) *PaymentDiscrepancyCreate {
+	_c.mutation.SetRazorpayOrderID(v)
+	return _c
+}
+
+// SetRazorpayPaymentID sets the "razorpay_payment_id" field.
+func (_c *PaymentDiscrepancyCreate) SetRazorpayPaymentID(v string) *PaymentDiscrepancyCreate {
+	_c.mutation.SetRazorpayPaymentID(v)
+	return _c
+}
+
+// SetNillableRazorpayPaymentID sets the "razorpay_payment_id" field if the given value is not nil.
+func (_c *PaymentDiscrepancyCreate) SetNillableRazorpayPaymentID(v *string) *PaymentDiscrepancyCreate {
+	if v != nil {
+		_c.SetRazorpayPaymentID(*v)
+	}
+	return _c
+}
+
+// SetKind sets the "kind" field.
+func (_c *PaymentDiscrepancyCreate) SetKind(v paymentdiscrepancy.Kind) *PaymentDiscrepancyCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetDbStatus sets the "db_status" field.
+func (_c *PaymentDiscrepancyCreate) SetDbStatus(v string) *PaymentDiscrepancyCreate {
+	_c.mutation.SetDbStatus(v)
+	return _c
+}
+
+// SetNillableDbStatus sets the "db_status" field if the given value is not nil.
+func (_c *PaymentDiscrepancyCreate) SetNillableDbStatus(v *string) *PaymentDiscrepancyCreate {
+	if v != nil {
+		_c.SetDbStatus(*v)
+	}
+	return _c
+}
+
+// SetGatewayStatus sets the "gateway_status" field.
+func (_c *PaymentDiscrepancyCreate) SetGatewayStatus(v string) *PaymentDiscrepancyCreate {
+	_c.mutation.SetGatewayStatus(v)
+	return _c
+}
+
+// SetNillableGatewayStatus sets the "gateway_status" field if the given value is not nil.
+func (_c *PaymentDiscrepancyCreate) SetNillableGatewayStatus(v *string) *PaymentDiscrepancyCreate {
+	if v != nil {
+		_c.SetGatewayStatus(*v)
+	}
+	return _c
+}
+
+// SetDbAmount sets the "db_amount" field.
+func (_c *PaymentDiscrepancyCreate) SetDbAmount(v int) *PaymentDiscrepancyCreate {
+	_c.mutation.SetDbAmount(v)
+	return _c
+}
+
+// SetNillableDbAmount sets the "db_amount" field if the given value is not nil.
+func (_c *PaymentDiscrepancyCreate) SetNillableDbAmount(v *int) *PaymentDiscrepancyCreate {
+	if v != nil {
+		_c.SetDbAmount(*v)
+	}
+	return _c
+}
+
+// SetGatewayAmount sets the "gateway_amount" field.
+func (_c *PaymentDiscrepancyCreate) SetGatewayAmount(v int) *PaymentDiscrepancyCreate {
+	_c.mutation.SetGatewayAmount(v)
+	return _c
+}
+
+// SetNillableGatewayAmount sets the "gateway_amount" field if the given value is not nil.
+func (_c *PaymentDiscrepancyCreate) SetNillableGatewayAmount(v *int) *PaymentDiscrepancyCreate {
+	if v != nil {
+		_c.SetGatewayAmount(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PaymentDiscrepancyCreate) SetID(v string) *PaymentDiscrepancyCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetReport sets the "report" edge to the PaymentReconciliationReport entity.
+func (_c *PaymentDiscrepancyCreate) SetReport(v *PaymentReconciliationReport) *PaymentDiscrepancyCreate {
+	return _c.SetReportID(v.ID)
+}
+
+// Mutation returns the PaymentDiscrepancyMutation object of the builder.
+func (_c *PaymentDiscrepancyCreate) Mutation() *PaymentDiscrepancyMutation {
+	return _c.mutation
+}
+
+// Save creates the PaymentDiscrepancy in the database.
+func (_c *PaymentDiscrepancyCreate) Save(ctx context.Context) (*PaymentDiscrepancy, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PaymentDiscrepancyCreate) SaveX(ctx context.Context) *PaymentDiscrepancy {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PaymentDiscrepancyCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PaymentDiscrepancyCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PaymentDiscrepancyCreate) defaults() {
+	if _, ok := _c.mutation.DbAmount(); !ok {
+		v := paymentdiscrepancy.DefaultDbAmount
+		_c.mutation.SetDbAmount(v)
+	}
+	if _, ok := _c.mutation.GatewayAmount(); !ok {
+		v := paymentdiscrepancy.DefaultGatewayAmount
+		_c.mutation.SetGatewayAmount(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PaymentDiscrepancyCreate) check() error {
+	if _, ok := _c.mutation.ReportID(); !ok {
+		return &ValidationError{Name: "report_id", err: errors.New(`generated: missing required field "PaymentDiscrepancy.report_id"`)}
+	}
+	if v, ok := _c.mutation.ReportID(); ok {
+		if err := paymentdiscrepancy.ReportIDValidator(v); err != nil {
+			return &ValidationError{Name: "report_id", err: fmt.Errorf(`generated: validator failed for field "PaymentDiscrepancy.report_id": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.OrderID(); ok {
+		if err := paymentdiscrepancy.OrderIDValidator(v); err != nil {
+			return &ValidationError{Name: "order_id", err: fmt.Errorf(`generated: validator failed for field "PaymentDiscrepancy.order_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RazorpayOrderID(); !ok {
+		return &ValidationError{Name: "razorpay_order_id", err: errors.New(`generated: missing required field "PaymentDiscrepancy.razorpay_order_id"`)}
+	}
+	if v, ok := _c.mutation.RazorpayOrderID(); ok {
+		if err := paymentdiscrepancy.RazorpayOrderIDValidator(v); err != nil {
+			return &ValidationError{Name: "razorpay_order_id", err: fmt.Errorf(`generated: validator failed for field "PaymentDiscrepancy.razorpay_order_id": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.RazorpayPaymentID(); ok {
+		if err := paymentdiscrepancy.RazorpayPaymentIDValidator(v); err != nil {
+			return &ValidationError{Name: "razorpay_payment_id", err: fmt.Errorf(`generated: validator failed for field "PaymentDiscrepancy.razorpay_payment_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`generated: missing required field "PaymentDiscrepancy.kind"`)}
+	}
+	if v, ok := _c.mutation.Kind(); ok {
+		if err := paymentdiscrepancy.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`generated: validator failed for field "PaymentDiscrepancy.kind": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.DbStatus(); ok {
+		if err := paymentdiscrepancy.DbStatusValidator(v); err != nil {
+			return &ValidationError{Name: "db_status", err: fmt.Errorf(`generated: validator failed for field "PaymentDiscrepancy.db_status": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.GatewayStatus(); ok {
+		if err := paymentdiscrepancy.GatewayStatusValidator(v); err != nil {
+			return &ValidationError{Name: "gateway_status", err: fmt.Errorf(`generated: validator failed for field "PaymentDiscrepancy.gateway_status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.DbAmount(); !ok {
+		return &ValidationError{Name: "db_amount", err: errors.New(`generated: missing required field "PaymentDiscrepancy.db_amount"`)}
+	}
+	if _, ok := _c.mutation.GatewayAmount(); !ok {
+		return &ValidationError{Name: "gateway_amount", err: errors.New(`generated: missing required field "PaymentDiscrepancy.gateway_amount"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := paymentdiscrepancy.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`generated: validator failed for field "PaymentDiscrepancy.id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.ReportIDs()) == 0 {
+		return &ValidationError{Name: "report", err: errors.New(`generated: missing required edge "PaymentDiscrepancy.report"`)}
+	}
+	return nil
+}
+
+func (_c *PaymentDiscrepancyCreate) sqlSave(ctx context.Context) (*PaymentDiscrepancy, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected PaymentDiscrepancy.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PaymentDiscrepancyCreate) createSpec() (*PaymentDiscrepancy, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PaymentDiscrepancy{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(paymentdiscrepancy.Table, sqlgraph.NewFieldSpec(paymentdiscrepancy.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.OrderID(); ok {
+		_spec.SetField(paymentdiscrepancy.FieldOrderID, field.TypeString, value)
+		_node.OrderID = &value
+	}
+	if value, ok := _c.mutation.RazorpayOrderID(); ok {
+		_spec.SetField(paymentdiscrepancy.FieldRazorpayOrderID, field.TypeString, value)
+		_node.RazorpayOrderID = value
+	}
+	if value, ok := _c.mutation.RazorpayPaymentID(); ok {
+		_spec.SetField(paymentdiscrepancy.FieldRazorpayPaymentID, field.TypeString, value)
+		_node.RazorpayPaymentID = &value
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(paymentdiscrepancy.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.DbStatus(); ok {
+		_spec.SetField(paymentdiscrepancy.FieldDbStatus, field.TypeString, value)
+		_node.DbStatus = value
+	}
+	if value, ok := _c.mutation.GatewayStatus(); ok {
+		_spec.SetField(paymentdiscrepancy.FieldGatewayStatus, field.TypeString, value)
+		_node.GatewayStatus = value
+	}
+	if value, ok := _c.mutation.DbAmount(); ok {
+		_spec.SetField(paymentdiscrepancy.FieldDbAmount, field.TypeInt, value)
+		_node.DbAmount = value
+	}
+	if value, ok := _c.mutation.GatewayAmount(); ok {
+		_spec.SetField(paymentdiscrepancy.FieldGatewayAmount, field.TypeInt, value)
+		_node.GatewayAmount = value
+	}
+	if nodes := _c.mutation.ReportIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paymentdiscrepancy.ReportTable,
+			Columns: []string{paymentdiscrepancy.ReportColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentreconciliationreport.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ReportID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PaymentDiscrepancy.Create().
+//		SetReportID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PaymentDiscrepancyUpsert) {
+//			SetReportID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PaymentDiscrepancyCreate) OnConflict(opts ...sql.ConflictOption) *PaymentDiscrepancyUpsertOne {
+	_c.conflict = opts
+	return &PaymentDiscrepancyUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PaymentDiscrepancy.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PaymentDiscrepancyCreate) OnConflictColumns(columns ...string) *PaymentDiscrepancyUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PaymentDiscrepancyUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// PaymentDiscrepancyUpsertOne is the builder for "upsert"-ing
+	//  one PaymentDiscrepancy node.
+	PaymentDiscrepancyUpsertOne struct {
+		create *PaymentDiscrepancyCreate
+	}
+
+	// PaymentDiscrepancyUpsert is the "OnConflict" setter.
+	PaymentDiscrepancyUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetOrderID sets the "order_id" field.
+func (u *PaymentDiscrepancyUpsert) SetOrderID(v string) *PaymentDiscrepancyUpsert {
+	u.Set(paymentdiscrepancy.FieldOrderID, v)
+	return u
+}
+
+// UpdateOrderID sets the "order_id" field to the value that was provided on create.
+func (u *PaymentDiscrepancyUpsert) UpdateOrderID() *PaymentDiscrepancyUpsert {
+	u.SetExcluded(paymentdiscrepancy.FieldOrderID)
+	return u
+}
+
+// ClearOrderID clears the value of the "order_id" field.
+func (u *PaymentDiscrepancyUpsert) ClearOrderID() *PaymentDiscrepancyUpsert {
+	u.SetNull(paymentdiscrepancy.FieldOrderID)
+	return u
+}
+
+// SetRazorpayOrderID sets the "razorpay_order_id" field.
+func (u *PaymentDiscrepancyUpsert) SetRazorpayOrderID(v string) *PaymentDiscrepancyUpsert {
+	u.Set(paymentdiscrepancy.FieldRazorpayOrderID, v)
+	return u
+}
+
+// UpdateRazorpayOrderID sets the "razorpay_order_id" field to the value that was provided on create.
+func (u *PaymentDiscrepancyUpsert) UpdateRazorpayOrderID() *PaymentDiscrepancyUpsert {
+	u.SetExcluded(paymentdiscrepancy.FieldRazorpayOrderID)
+	return u
+}
+
+// SetRazorpayPaymentID sets the "razorpay_payment_id" field.
+func (u *PaymentDiscrepancyUpsert) SetRazorpayPaymentID(v string) *PaymentDiscrepancyUpsert {
+	u.Set(paymentdiscrepancy.FieldRazorpayPaymentID, v)
+	return u
+}
+
+// UpdateRazorpayPaymentID sets the "razorpay_payment_id" field to the value that was provided on create.
+func (u *PaymentDiscrepancyUpsert) UpdateRazorpayPaymentID() *PaymentDiscrepancyUpsert {
+	u.SetExcluded(paymentdiscrepancy.FieldRazorpayPaymentID)
+	return u
+}
+
+// ClearRazorpayPaymentID clears the value of the "razorpay_payment_id" field.
+func (u *PaymentDiscrepancyUpsert) ClearRazorpayPaymentID() *PaymentDiscrepancyUpsert {
+	u.SetNull(paymentdiscrepancy.FieldRazorpayPaymentID)
+	return u
+}
+
+// SetKind sets the "kind" field.
+func (u *PaymentDiscrepancyUpsert) SetKind(v paymentdiscrepancy.Kind) *PaymentDiscrepancyUpsert {
+	u.Set(paymentdiscrepancy.FieldKind, v)
+	return u
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *PaymentDiscrepancyUpsert) UpdateKind() *PaymentDiscrepancyUpsert {
+	u.SetExcluded(paymentdiscrepancy.FieldKind)
+	return u
+}
+
+// SetDbStatus sets the "db_status" field.
+func (u *PaymentDiscrepancyUpsert) SetDbStatus(v string) *PaymentDiscrepancyUpsert {
+	u.Set(paymentdiscrepancy.FieldDbStatus, v)
+	return u
+}
+
+// UpdateDbStatus sets the "db_status" field to the value that was provided on create.
+func (u *PaymentDiscrepancyUpsert) UpdateDbStatus() *PaymentDiscrepancyUpsert {
+	u.SetExcluded(paymentdiscrepancy.FieldDbStatus)
+	return u
+}
+
+// ClearDbStatus clears the value of the "db_status" field.
+func (u *PaymentDiscrepancyUpsert) ClearDbStatus() *PaymentDiscrepancyUpsert {
+	u.SetNull(paymentdiscrepancy.FieldDbStatus)
+	return u
+}
+
+// SetGatewayStatus sets the "gateway_status" field.
+func (u *PaymentDiscrepancyUpsert) SetGatewayStatus(v string) *PaymentDiscrepancyUpsert {
+	u.Set(paymentdiscrepancy.FieldGatewayStatus, v)
+	return u
+}
+
+// UpdateGatewayStatus sets the "gateway_status" field to the value that was provided on create.
+func (u *PaymentDiscrepancyUpsert) UpdateGatewayStatus() *PaymentDiscrepancyUpsert {
+	u.SetExcluded(paymentdiscrepancy.FieldGatewayStatus)
+	return u
+}
+
+// ClearGatewayStatus clears the value of the "gateway_status" field.
+func (u *PaymentDiscrepancyUpsert) ClearGatewayStatus() *PaymentDiscrepancyUpsert {
+	u.SetNull(paymentdiscrepancy.FieldGatewayStatus)
+	return u
+}
+
+// SetDbAmount sets the "db_amount" field.
+func (u *PaymentDiscrepancyUpsert) SetDbAmount(v int) *PaymentDiscrepancyUpsert {
+	u.Set(paymentdiscrepancy.FieldDbAmount, v)
+	return u
+}
+
+// UpdateDbAmount sets the "db_amount" field to the value that was provided on create.
+func (u *PaymentDiscrepancyUpsert) UpdateDbAmount() *PaymentDiscrepancyUpsert {
+	u.SetExcluded(paymentdiscrepancy.FieldDbAmount)
+	return u
+}
+
+// AddDbAmount adds v to the "db_amount" field.
+func (u *PaymentDiscrepancyUpsert) AddDbAmount(v int) *PaymentDiscrepancyUpsert {
+	u.Add(paymentdiscrepancy.FieldDbAmount, v)
+	return u
+}
+
+// SetGatewayAmount sets the "gateway_amount" field.
+func (u *PaymentDiscrepancyUpsert) SetGatewayAmount(v int) *PaymentDiscrepancyUpsert {
+	u.Set(paymentdiscrepancy.FieldGatewayAmount, v)
+	return u
+}
+
+// UpdateGatewayAmount sets the "gateway_amount" field to the value that was provided on create.
+func (u *PaymentDiscrepancyUpsert) UpdateGatewayAmount() *PaymentDiscrepancyUpsert {
+	u.SetExcluded(paymentdiscrepancy.FieldGatewayAmount)
+	return u
+}
+
+// AddGatewayAmount adds v to the "gateway_amount" field.
+func (u *PaymentDiscrepancyUpsert) AddGatewayAmount(v int) *PaymentDiscrepancyUpsert {
+	u.Add(paymentdiscrepancy.FieldGatewayAmount, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.PaymentDiscrepancy.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(paymentdiscrepancy.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PaymentDiscrepancyUpsertOne) UpdateNewValues() *PaymentDiscrepancyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(paymentdiscrepancy.FieldID)
+		}
+		if _, exists := u.create.mutation.ReportID(); exists {
+			s.SetIgnore(paymentdiscrepancy.FieldReportID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PaymentDiscrepancy.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PaymentDiscrepancyUpsertOne) Ignore() *PaymentDiscrepancyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PaymentDiscrepancyUpsertOne) DoNothing() *PaymentDiscrepancyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PaymentDiscrepancyCreate.OnConflict
+// documentation for more info.
+func (u *PaymentDiscrepancyUpsertOne) Update(set func(*PaymentDiscrepancyUpsert)) *PaymentDiscrepancyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PaymentDiscrepancyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetOrderID sets the "order_id" field.
+func (u *PaymentDiscrepancyUpsertOne) SetOrderID(v string) *PaymentDiscrepancyUpsertOne {
+	return u.Update(func(s *PaymentDiscrepancyUpsert) {
+		s.SetOrderID(v)
+	})
+}
+
+// UpdateOrderID sets the "order_id" field to the value that was provided on create.
+func (u *PaymentDiscrepancyUpsertOne) UpdateOrderID() *PaymentDiscrepancyUpsertOne {
+	return u.Update(func(s *PaymentDiscrepancyUpsert) {
+		s.UpdateOrderID()
+	})
+}
+
+// ClearOrderID clears the value of the "order_id" field.
+func (u *PaymentDiscrepancyUpsertOne) ClearOrderID() *PaymentDiscrepancyUpsertOne {
+	return u.Update(func(s *PaymentDiscrepancyUpsert) {
+		s.ClearOrderID()
+	})
+}
+
+// SetRazorpayOrderID sets the "razorpay_order_id" field.
+func (u *PaymentDiscrepancyUpsertOne) SetRazorpayOrderID(v string) *PaymentDiscrepancyUpsertOne {
+	return u.Update(func(s *PaymentDiscrepancyUpsert) {
+		s.SetRazorpayOrderID(v)
+	})
+}
+
+// UpdateRazorpayOrderID sets the "razorpay_order_id" field to the value that was provided on create.
+func (u *PaymentDiscrepancyUpsertOne) UpdateRazorpayOrderID() *PaymentDiscrepancyUpsertOne {
+	return u.Update(func(s *PaymentDiscrepancyUpsert) {
+		s.UpdateRazorpayOrderID()
+	})
+}
+
+// SetRazorpayPaymentID sets the "razorpay_payment_id" field.
+func (u *PaymentDiscrepancyUpsertOne) SetRazorpayPaymentID(v string) *PaymentDiscrepancyUpsertOne {
+	return u.Update(func(s *PaymentDiscrepancyUpsert) {
+		s.SetRazorpayPaymentID(v)
+	})
+}
+
+// UpdateRazorpayPaymentID sets the "razorpay_payment_id" field to the value that was provided on create.
+func (u *PaymentDiscrepancyUpsertOne) UpdateRazorpayPaymentID() *PaymentDiscrepancyUpsertOne {
+	return u.Update(func(s *PaymentDiscrepancyUpsert) {
+		s.UpdateRazorpayPaymentID()
+	})
+}
+
+// ClearRazorpayPaymentID clears the value of the "razorpay_payment_id" field.
+func (u *PaymentDiscrepancyUpsertOne) ClearRazorpayPaymentID() *PaymentDiscrepancyUpsertOne {
+	return u.Update(func(s *PaymentDiscrepancyUpsert) {
+		s.ClearRazorpayPaymentID()
+	})
+}
+
+// SetKind sets the "kind" field.
+func (u *PaymentDiscrepancyUpsertOne) SetKind(v paymentdiscrepancy.Kind) *PaymentDiscrepancyUpsertOne {
+	return u.Update(func(s *PaymentDiscrepancyUpsert) {
+		s.SetKind(v)
+	})
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *PaymentDiscrepancyUpsertOne) UpdateKind() *PaymentDiscrepancyUpsertOne {
+	return u.Update(func(s *PaymentDiscrepancyUpsert) {
+		s.UpdateKind()
+	})
+}
+
+// SetDbStatus sets the "db_status" field.
+func (u *PaymentDiscrepancyUpsertOne) SetDbStatus(v string) *PaymentDiscrepancyUpsertOne {
+	return u.Update(func(s *PaymentDiscrepancyUpsert) {
+		s.SetDbStatus(v)
+	})
+}
+
+// UpdateDbStatus sets the "db_status" field to the value that was provided on create.
+func (u *PaymentDiscrepancyUpsertOne) UpdateDbStatus() *PaymentDiscrepancyUpsertOne {
+	return u.Update(func(s *PaymentDiscrepancyUpsert) {
+		s.UpdateDbStatus()
+	})
+}
+
+// ClearDbStatus clears the value of the "db_status" field.
+func (u *PaymentDiscrepancyUpsertOne) ClearDbStatus() *PaymentDiscrepancyUpsertOne {
+	return u.Update(func(s *PaymentDiscrepancyUpsert) {
+		s.ClearDbStatus()
+	})
+}
+
+// SetGatewayStatus sets the "gateway_status" field.
+func (u *PaymentDiscrepancyUpsertOne) SetGatewayStatus(v string) *PaymentDiscrepancyUpsertOne {
+	return u.Update(func(s *PaymentDiscrepancyUpsert) {
+		s.SetGatewayStatus(v)
+	})
+}
+
+// UpdateGatewayStatus sets the "gateway_status" field to the value that was provided on create.
+func (u *PaymentDiscrepancyUpsertOne) UpdateGatewayStatus() *PaymentDiscrepancyUpsertOne {
+	return u.Update(func(s *PaymentDiscrepancyUpsert) {
+		s.UpdateGatewayStatus()
+	})
+}
+
+// ClearGatewayStatus clears the value of the "gateway_status" field.
+func (u *PaymentDiscrepancyUpsertOne) ClearGatewayStatus() *PaymentDiscrepancyUpsertOne {
+	return u.Update(func(s *PaymentDiscrepancyUpsert) {
+		s.ClearGatewayStatus()
+	})
+}
+
+// SetDbAmount sets the "db_amount" field.
+func (u *PaymentDiscrepancyUpsertOne) SetDbAmount(v int) *PaymentDiscrepancyUpsertOne {
+	return u.Update(func(s *PaymentDiscrepancyUpsert) {
+		s.SetDbAmount(v)
+	})
+}
+
+// AddDbAmount adds v to the "db_amount" field.
+func (u *PaymentDiscrepancyUpsertOne) AddDbAmount(v int) *PaymentDiscrepancyUpsertOne {
+	return u.Update(func(s *PaymentDiscrepancyUpsert) {
+		s.AddDbAmount(v)
+	})
+}
+
+// UpdateDbAmount sets the "db_amount" field to the value that was provided on create.
+func (u *PaymentDiscrepancyUpsertOne) UpdateDbAmount() *PaymentDiscrepancyUpsertOne {
+	return u.Update(func(s *PaymentDiscrepancyUpsert) {
+		s.UpdateDbAmount()
+	})
+}
+
+// SetGatewayAmount sets the "gateway_amount" field.
+func (u *PaymentDiscrepancyUpsertOne) SetGatewayAmount(v int) *PaymentDiscrepancyUpsertOne {
+	return u.Update(func(s *PaymentDiscrepancyUpsert) {
+		s.SetGatewayAmount(v)
+	})
+}
+
+// AddGatewayAmount adds v to the "gateway_amount" field.
+func (u *PaymentDiscrepancyUpsertOne) AddGatewayAmount(v int) *PaymentDiscrepancyUpsertOne {
+	return u.Update(func(s *PaymentDiscrepancyUpsert) {
+		s.AddGatewayAmount(v)
+	})
+}
+
+// UpdateGatewayAmount sets the "gateway_amount" field to the value that was provided on create.
+func (u *PaymentDiscrepancyUpsertOne) UpdateGatewayAmount() *PaymentDiscrepancyUpsertOne {
+	return u.Update(func(s *PaymentDiscrepancyUpsert) {
+		s.UpdateGatewayAmount()
+	})
+}
+
+// Exec executes the query.
+func (u *PaymentDiscrepancyUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("generated: missing options for PaymentDiscrepancyCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PaymentDiscrepancyUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PaymentDiscrepancyUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("generated: PaymentDiscrepancyUpsertOne.ID is not supported by MySQL driver. Use PaymentDiscrepancyUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PaymentDiscrepancyUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PaymentDiscrepancyCreateBulk is the builder for creating many PaymentDiscrepancy entities in bulk.
+type PaymentDiscrepancyCreateBulk struct {
+	config
+	err      error
+	builders []*PaymentDiscrepancyCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the PaymentDiscrepancy entities in the database.
+func (_c *PaymentDiscrepancyCreateBulk) Save(ctx context.Context) ([]*PaymentDiscrepancy, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PaymentDiscrepancy, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PaymentDiscrepancyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PaymentDiscrepancyCreateBulk) SaveX(ctx context.Context) []*PaymentDiscrepancy {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PaymentDiscrepancyCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PaymentDiscrepancyCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PaymentDiscrepancy.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PaymentDiscrepancyUpsert) {
+//			SetReportID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PaymentDiscrepancyCreateBulk) OnConflict(opts ...sql.ConflictOption) *PaymentDiscrepancyUpsertBulk {
+	_c.conflict = opts
+	return &PaymentDiscrepancyUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PaymentDiscrepancy.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PaymentDiscrepancyCreateBulk) OnConflictColumns(columns ...string) *PaymentDiscrepancyUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PaymentDiscrepancyUpsertBulk{
+		create: _c,
+	}
+}
+
+// PaymentDiscrepancyUpsertBulk is the builder for "upsert"-ing
+// a bulk of PaymentDiscrepancy nodes.
+type PaymentDiscrepancyUpsertBulk struct {
+	create *PaymentDiscrepancyCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PaymentDiscrepancy.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(paymentdiscrepancy.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PaymentDiscrepancyUpsertBulk) UpdateNewValues() *PaymentDiscrepancyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(paymentdiscrepancy.FieldID)
+			}
+			if _, exists := b.mutation.ReportID(); exists {
+				s.SetIgnore(paymentdiscrepancy.FieldReportID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PaymentDiscrepancy.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PaymentDiscrepancyUpsertBulk) Ignore() *PaymentDiscrepancyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PaymentDiscrepancyUpsertBulk) DoNothing() *PaymentDiscrepancyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PaymentDiscrepancyCreateBulk.OnConflict
+// documentation for more info.
+func (u *PaymentDiscrepancyUpsertBulk) Update(set func(*PaymentDiscrepancyUpsert)) *PaymentDiscrepancyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PaymentDiscrepancyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetOrderID sets the "order_id" field.
+func (u *PaymentDiscrepancyUpsertBulk) SetOrderID(v string) *PaymentDiscrepancyUpsertBulk {
+	return u.Update(func(s *PaymentDiscrepancyUpsert) {
+		s.SetOrderID(v)
+	})
+}
+
+// UpdateOrderID sets the "order_id" field to the value that was provided on create.
+func (u *PaymentDiscrepancyUpsertBulk) UpdateOrderID() *PaymentDiscrepancyUpsertBulk {
+	return u.Update(func(s *PaymentDiscrepancyUpsert) {
+		s.UpdateOrderID()
+	})
+}
+
+// ClearOrderID clears the value of the "order_id" field.
+func (u *PaymentDiscrepancyUpsertBulk) ClearOrderID() *PaymentDiscrepancyUpsertBulk {
+	return u.Update(func(s *PaymentDiscrepancyUpsert) {
+		s.ClearOrderID()
+	})
+}
+
+// SetRazorpayOrderID sets the "razorpay_order_id" field.
+func (u *PaymentDiscrepancyUpsertBulk) SetRazorpayOrderID(v string) *PaymentDiscrepancyUpsertBulk {
+	return u.Update(func(s *PaymentDiscrepancyUpsert) {
+		s.SetRazorpayOrderID(v)
+	})
+}
+
+// UpdateRazorpayOrderID sets the "razorpay_order_id" field to the value that was provided on create.
+func (u *PaymentDiscrepancyUpsertBulk) UpdateRazorpayOrderID() *PaymentDiscrepancyUpsertBulk {
+	return u.Update(func(s *PaymentDiscrepancyUpsert) {
+		s.UpdateRazorpayOrderID()
+	})
+}
+
+// SetRazorpayPaymentID sets the "razorpay_payment_id" field.
+func (u *PaymentDiscrepancyUpsertBulk) SetRazorpayPaymentID(v string) *PaymentDiscrepancyUpsertBulk {
+	return u.Update(func(s *PaymentDiscrepancyUpsert) {
+		s.SetRazorpayPaymentID(v)
+	})
+}
+
+// UpdateRazorpayPaymentID sets the "razorpay_payment_id" field to the value that was provided on create.
+func (u *PaymentDiscrepancyUpsertBulk) UpdateRazorpayPaymentID() *PaymentDiscrepancyUpsertBulk {
+	return u.Update(func(s *PaymentDiscrepancyUpsert) {
+		s.UpdateRazorpayPaymentID()
+	})
+}
+
+// ClearRazorpayPaymentID clears the value of the "razorpay_payment_id" field.
+func (u *PaymentDiscrepancyUpsertBulk) ClearRazorpayPaymentID() *PaymentDiscrepancyUpsertBulk {
+	return u.Update(func(s *PaymentDiscrepancyUpsert) {
+		s.ClearRazorpayPaymentID()
+	})
+}
+
+// SetKind sets the "kind" field.
+func (u *PaymentDiscrepancyUpsertBulk) SetKind(v paymentdiscrepancy.Kind) *PaymentDiscrepancyUpsertBulk {
+	return u.Update(func(s *PaymentDiscrepancyUpsert) {
+		s.SetKind(v)
+	})
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *PaymentDiscrepancyUpsertBulk) UpdateKind() *PaymentDiscrepancyUpsertBulk {
+	return u.Update(func(s *PaymentDiscrepancyUpsert) {
+		s.UpdateKind()
+	})
+}
+
+// SetDbStatus sets the "db_status" field.
+func (u *PaymentDiscrepancyUpsertBulk) SetDbStatus(v string) *PaymentDiscrepancyUpsertBulk {
+	return u.Update(func(s *PaymentDiscrepancyUpsert) {
+		s.SetDbStatus(v)
+	})
+}
+
+// UpdateDbStatus sets the "db_status" field to the value that was provided on create.
+func (u *PaymentDiscrepancyUpsertBulk) UpdateDbStatus() *PaymentDiscrepancyUpsertBulk {
+	return u.Update(func(s *PaymentDiscrepancyUpsert) {
+		s.UpdateDbStatus()
+	})
+}
+
+// ClearDbStatus clears the value of the "db_status" field.
+func (u *PaymentDiscrepancyUpsertBulk) ClearDbStatus() *PaymentDiscrepancyUpsertBulk {
+	return u.Update(func(s *PaymentDiscrepancyUpsert) {
+		s.ClearDbStatus()
+	})
+}
+
+// SetGatewayStatus sets the "gateway_status" field.
+func (u *PaymentDiscrepancyUpsertBulk) SetGatewayStatus(v string) *PaymentDiscrepancyUpsertBulk {
+	return u.Update(func(s *PaymentDiscrepancyUpsert) {
+		s.SetGatewayStatus(v)
+	})
+}
+
+// UpdateGatewayStatus sets the "gateway_status" field to the value that was provided on create.
+func (u *PaymentDiscrepancyUpsertBulk) UpdateGatewayStatus() *PaymentDiscrepancyUpsertBulk {
+	return u.Update(func(s *PaymentDiscrepancyUpsert) {
+		s.UpdateGatewayStatus()
+	})
+}
+
+// ClearGatewayStatus clears the value of the "gateway_status" field.
+func (u *PaymentDiscrepancyUpsertBulk) ClearGatewayStatus() *PaymentDiscrepancyUpsertBulk {
+	return u.Update(func(s *PaymentDiscrepancyUpsert) {
+		s.ClearGatewayStatus()
+	})
+}
+
+// SetDbAmount sets the "db_amount" field.
+func (u *PaymentDiscrepancyUpsertBulk) SetDbAmount(v int) *PaymentDiscrepancyUpsertBulk {
+	return u.Update(func(s *PaymentDiscrepancyUpsert) {
+		s.SetDbAmount(v)
+	})
+}
+
+// AddDbAmount adds v to the "db_amount" field.
+func (u *PaymentDiscrepancyUpsertBulk) AddDbAmount(v int) *PaymentDiscrepancyUpsertBulk {
+	return u.Update(func(s *PaymentDiscrepancyUpsert) {
+		s.AddDbAmount(v)
+	})
+}
+
+// UpdateDbAmount sets the "db_amount" field to the value that was provided on create.
+func (u *PaymentDiscrepancyUpsertBulk) UpdateDbAmount() *PaymentDiscrepancyUpsertBulk {
+	return u.Update(func(s *PaymentDiscrepancyUpsert) {
+		s.UpdateDbAmount()
+	})
+}
+
+// SetGatewayAmount sets the "gateway_amount" field.
+func (u *PaymentDiscrepancyUpsertBulk) SetGatewayAmount(v int) *PaymentDiscrepancyUpsertBulk {
+	return u.Update(func(s *PaymentDiscrepancyUpsert) {
+		s.SetGatewayAmount(v)
+	})
+}
+
+// AddGatewayAmount adds v to the "gateway_amount" field.
+func (u *PaymentDiscrepancyUpsertBulk) AddGatewayAmount(v int) *PaymentDiscrepancyUpsertBulk {
+	return u.Update(func(s *PaymentDiscrepancyUpsert) {
+		s.AddGatewayAmount(v)
+	})
+}
+
+// UpdateGatewayAmount sets the "gateway_amount" field to the value that was provided on create.
+func (u *PaymentDiscrepancyUpsertBulk) UpdateGatewayAmount() *PaymentDiscrepancyUpsertBulk {
+	return u.Update(func(s *PaymentDiscrepancyUpsert) {
+		s.UpdateGatewayAmount()
+	})
+}
+
+// Exec executes the query.
+func (u *PaymentDiscrepancyUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("generated: OnConflict was set for builder %d. Set it on the PaymentDiscrepancyCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("generated: missing options for PaymentDiscrepancyCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PaymentDiscrepancyUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
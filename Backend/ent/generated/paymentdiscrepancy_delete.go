@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/UnoraApp/be/ent/generated/paymentdiscrepancy"
+	"github.com/UnoraApp/be/ent/generated/predicate"
+)
+
+// PaymentDiscrepancyDelete is the builder for deleting a PaymentDiscrepancy entity.
+type PaymentDiscrepancyDelete struct {
+	config
+	hooks    []Hook
+	mutation *PaymentDiscrepancyMutation
+}
+
+// Where appends a list predicates to the PaymentDiscrepancyDelete builder.
+func (_d *PaymentDiscrepancyDelete) Where(ps ...predicate.PaymentDiscrepancy) *PaymentDiscrepancyDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *PaymentDiscrepancyDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *PaymentDiscrepancyDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *PaymentDiscrepancyDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(paymentdiscrepancy.Table, sqlgraph.NewFieldSpec(paymentdiscrepancy.FieldID, field.TypeString))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// PaymentDiscrepancyDeleteOne is the builder for deleting a single PaymentDiscrepancy entity.
+type PaymentDiscrepancyDeleteOne struct {
+	_d *PaymentDiscrepancyDelete
+}
+
+// Where appends a list predicates to the PaymentDiscrepancyDelete builder.
+func (_d *PaymentDiscrepancyDeleteOne) Where(ps ...predicate.PaymentDiscrepancy) *PaymentDiscrepancyDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *PaymentDiscrepancyDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{paymentdiscrepancy.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *PaymentDiscrepancyDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,641 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/UnoraApp/be/ent/generated/paymentdiscrepancy"
+	"github.com/UnoraApp/be/ent/generated/predicate"
+)
+
+// PaymentDiscrepancyUpdate is the builder for updating PaymentDiscrepancy entities.
+type PaymentDiscrepancyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PaymentDiscrepancyMutation
+}
+
+// Where appends a list predicates to the PaymentDiscrepancyUpdate builder.
+func (_u *PaymentDiscrepancyUpdate) Where(ps ...predicate.PaymentDiscrepancy) *PaymentDiscrepancyUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetOrderID sets the "order_id" field.
+func (_u *PaymentDiscrepancyUpdate) SetOrderID(v string) *PaymentDiscrepancyUpdate {
+	_u.mutation.SetOrderID(v)
+	return _u
+}
+
+// SetNillableOrderID sets the "order_id" field if the given value is not nil.
+func (_u *PaymentDiscrepancyUpdate) SetNillableOrderID(v *string) *PaymentDiscrepancyUpdate {
+	if v != nil {
+		_u.SetOrderID(*v)
+	}
+	return _u
+}
+
+// ClearOrderID clears the value of the "order_id" field.
+func (_u *PaymentDiscrepancyUpdate) ClearOrderID() *PaymentDiscrepancyUpdate {
+	_u.mutation.ClearOrderID()
+	return _u
+}
+
+// SetRazorpayOrderID sets the "razorpay_order_id" field.
+func (_u *PaymentDiscrepancyUpdate) SetRazorpayOrderID(v string) *PaymentDiscrepancyUpdate {
+	_u.mutation.SetRazorpayOrderID(v)
+	return _u
+}
+
+// SetNillableRazorpayOrderID sets the "razorpay_order_id" field if the given value is not nil.
+func (_u *PaymentDiscrepancyUpdate) SetNillableRazorpayOrderID(v *string) *PaymentDiscrepancyUpdate {
+	if v != nil {
+		_u.SetRazorpayOrderID(*v)
+	}
+	return _u
+}
+
+// SetRazorpayPaymentID sets the "razorpay_payment_id" field.
+func (_u *PaymentDiscrepancyUpdate) SetRazorpayPaymentID(v string) *PaymentDiscrepancyUpdate {
+	_u.mutation.SetRazorpayPaymentID(v)
+	return _u
+}
+
+// SetNillableRazorpayPaymentID sets the "razorpay_payment_id" field if the given value is not nil.
+func (_u *PaymentDiscrepancyUpdate) SetNillableRazorpayPaymentID(v *string) *PaymentDiscrepancyUpdate {
+	if v != nil {
+		_u.SetRazorpayPaymentID(*v)
+	}
+	return _u
+}
+
+// ClearRazorpayPaymentID clears the value of the "razorpay_payment_id" field.
+func (_u *PaymentDiscrepancyUpdate) ClearRazorpayPaymentID() *PaymentDiscrepancyUpdate {
+	_u.mutation.ClearRazorpayPaymentID()
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *PaymentDiscrepancyUpdate) SetKind(v paymentdiscrepancy.Kind) *PaymentDiscrepancyUpdate {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *PaymentDiscrepancyUpdate) SetNillableKind(v *paymentdiscrepancy.Kind) *PaymentDiscrepancyUpdate {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetDbStatus sets the "db_status" field.
+func (_u *PaymentDiscrepancyUpdate) SetDbStatus(v string) *PaymentDiscrepancyUpdate {
+	_u.mutation.SetDbStatus(v)
+	return _u
+}
+
+// SetNillableDbStatus sets the "db_status" field if the given value is not nil.
+func (_u *PaymentDiscrepancyUpdate) SetNillableDbStatus(v *string) *PaymentDiscrepancyUpdate {
+	if v != nil {
+		_u.SetDbStatus(*v)
+	}
+	return _u
+}
+
+// ClearDbStatus clears the value of the "db_status" field.
+func (_u *PaymentDiscrepancyUpdate) ClearDbStatus() *PaymentDiscrepancyUpdate {
+	_u.mutation.ClearDbStatus()
+	return _u
+}
+
+// SetGatewayStatus sets the "gateway_status" field.
+func (_u *PaymentDiscrepancyUpdate) SetGatewayStatus(v string) *PaymentDiscrepancyUpdate {
+	_u.mutation.SetGatewayStatus(v)
+	return _u
+}
+
+// SetNillableGatewayStatus sets the "gateway_status" field if the given value is not nil.
+func (_u *PaymentDiscrepancyUpdate) SetNillableGatewayStatus(v *string) *PaymentDiscrepancyUpdate {
+	if v != nil {
+		_u.SetGatewayStatus(*v)
+	}
+	return _u
+}
+
+// ClearGatewayStatus clears the value of the "gateway_status" field.
+func (_u *PaymentDiscrepancyUpdate) ClearGatewayStatus() *PaymentDiscrepancyUpdate {
+	_u.mutation.ClearGatewayStatus()
+	return _u
+}
+
+// SetDbAmount sets the "db_amount" field.
+func (_u *PaymentDiscrepancyUpdate) SetDbAmount(v int) *PaymentDiscrepancyUpdate {
+	_u.mutation.ResetDbAmount()
+	_u.mutation.SetDbAmount(v)
+	return _u
+}
+
+// SetNillableDbAmount sets the "db_amount" field if the given value is not nil.
+func (_u *PaymentDiscrepancyUpdate) SetNillableDbAmount(v *int) *PaymentDiscrepancyUpdate {
+	if v != nil {
+		_u.SetDbAmount(*v)
+	}
+	return _u
+}
+
+// AddDbAmount adds value to the "db_amount" field.
+func (_u *PaymentDiscrepancyUpdate) AddDbAmount(v int) *PaymentDiscrepancyUpdate {
+	_u.mutation.AddDbAmount(v)
+	return _u
+}
+
+// SetGatewayAmount sets the "gateway_amount" field.
+func (_u *PaymentDiscrepancyUpdate) SetGatewayAmount(v int) *PaymentDiscrepancyUpdate {
+	_u.mutation.ResetGatewayAmount()
+	_u.mutation.SetGatewayAmount(v)
+	return _u
+}
+
+// SetNillableGatewayAmount sets the "gateway_amount" field if the given value is not nil.
+func (_u *PaymentDiscrepancyUpdate) SetNillableGatewayAmount(v *int) *PaymentDiscrepancyUpdate {
+	if v != nil {
+		_u.SetGatewayAmount(*v)
+	}
+	return _u
+}
+
+// AddGatewayAmount adds value to the "gateway_amount" field.
+func (_u *PaymentDiscrepancyUpdate) AddGatewayAmount(v int) *PaymentDiscrepancyUpdate {
+	_u.mutation.AddGatewayAmount(v)
+	return _u
+}
+
+// Mutation returns the PaymentDiscrepancyMutation object of the builder.
+func (_u *PaymentDiscrepancyUpdate) Mutation() *PaymentDiscrepancyMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PaymentDiscrepancyUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PaymentDiscrepancyUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PaymentDiscrepancyUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PaymentDiscrepancyUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PaymentDiscrepancyUpdate) check() error {
+	if v, ok := _u.mutation.OrderID(); ok {
+		if err := paymentdiscrepancy.OrderIDValidator(v); err != nil {
+			return &ValidationError{Name: "order_id", err: fmt.Errorf(`generated: validator failed for field "PaymentDiscrepancy.order_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RazorpayOrderID(); ok {
+		if err := paymentdiscrepancy.RazorpayOrderIDValidator(v); err != nil {
+			return &ValidationError{Name: "razorpay_order_id", err: fmt.Errorf(`generated: validator failed for field "PaymentDiscrepancy.razorpay_order_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RazorpayPaymentID(); ok {
+		if err := paymentdiscrepancy.RazorpayPaymentIDValidator(v); err != nil {
+			return &ValidationError{Name: "razorpay_payment_id", err: fmt.Errorf(`generated: validator failed for field "PaymentDiscrepancy.razorpay_payment_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := paymentdiscrepancy.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`generated: validator failed for field "PaymentDiscrepancy.kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DbStatus(); ok {
+		if err := paymentdiscrepancy.DbStatusValidator(v); err != nil {
+			return &ValidationError{Name: "db_status", err: fmt.Errorf(`generated: validator failed for field "PaymentDiscrepancy.db_status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.GatewayStatus(); ok {
+		if err := paymentdiscrepancy.GatewayStatusValidator(v); err != nil {
+			return &ValidationError{Name: "gateway_status", err: fmt.Errorf(`generated: validator failed for field "PaymentDiscrepancy.gateway_status": %w`, err)}
+		}
+	}
+	if _u.mutation.ReportCleared() && len(_u.mutation.ReportIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "PaymentDiscrepancy.report"`)
+	}
+	return nil
+}
+
+func (_u *PaymentDiscrepancyUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(paymentdiscrepancy.Table, paymentdiscrepancy.Columns, sqlgraph.NewFieldSpec(paymentdiscrepancy.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.OrderID(); ok {
+		_spec.SetField(paymentdiscrepancy.FieldOrderID, field.TypeString, value)
+	}
+	if _u.mutation.OrderIDCleared() {
+		_spec.ClearField(paymentdiscrepancy.FieldOrderID, field.TypeString)
+	}
+	if value, ok := _u.mutation.RazorpayOrderID(); ok {
+		_spec.SetField(paymentdiscrepancy.FieldRazorpayOrderID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RazorpayPaymentID(); ok {
+		_spec.SetField(paymentdiscrepancy.FieldRazorpayPaymentID, field.TypeString, value)
+	}
+	if _u.mutation.RazorpayPaymentIDCleared() {
+		_spec.ClearField(paymentdiscrepancy.FieldRazorpayPaymentID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(paymentdiscrepancy.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.DbStatus(); ok {
+		_spec.SetField(paymentdiscrepancy.FieldDbStatus, field.TypeString, value)
+	}
+	if _u.mutation.DbStatusCleared() {
+		_spec.ClearField(paymentdiscrepancy.FieldDbStatus, field.TypeString)
+	}
+	if value, ok := _u.mutation.GatewayStatus(); ok {
+		_spec.SetField(paymentdiscrepancy.FieldGatewayStatus, field.TypeString, value)
+	}
+	if _u.mutation.GatewayStatusCleared() {
+		_spec.ClearField(paymentdiscrepancy.FieldGatewayStatus, field.TypeString)
+	}
+	if value, ok := _u.mutation.DbAmount(); ok {
+		_spec.SetField(paymentdiscrepancy.FieldDbAmount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDbAmount(); ok {
+		_spec.AddField(paymentdiscrepancy.FieldDbAmount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.GatewayAmount(); ok {
+		_spec.SetField(paymentdiscrepancy.FieldGatewayAmount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedGatewayAmount(); ok {
+		_spec.AddField(paymentdiscrepancy.FieldGatewayAmount, field.TypeInt, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{paymentdiscrepancy.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PaymentDiscrepancyUpdateOne is the builder for updating a single PaymentDiscrepancy entity.
+type PaymentDiscrepancyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PaymentDiscrepancyMutation
+}
+
+// SetOrderID sets the "order_id" field.
+func (_u *PaymentDiscrepancyUpdateOne) SetOrderID(v string) *PaymentDiscrepancyUpdateOne {
+	_u.mutation.SetOrderID(v)
+	return _u
+}
+
+// SetNillableOrderID sets the "order_id" field if the given value is not nil.
+func (_u *PaymentDiscrepancyUpdateOne) SetNillableOrderID(v *string) *PaymentDiscrepancyUpdateOne {
+	if v != nil {
+		_u.SetOrderID(*v)
+	}
+	return _u
+}
+
+// ClearOrderID clears the value of the "order_id" field.
+func (_u *PaymentDiscrepancyUpdateOne) ClearOrderID() *PaymentDiscrepancyUpdateOne {
+	_u.mutation.ClearOrderID()
+	return _u
+}
+
+// SetRazorpayOrderID sets the "razorpay_order_id" field.
+func (_u *PaymentDiscrepancyUpdateOne) SetRazorpayOrderID(v string) *PaymentDiscrepancyUpdateOne {
+	_u.mutation.SetRazorpayOrderID(v)
+	return _u
+}
+
+// SetNillableRazorpayOrderID sets the "razorpay_order_id" field if the given value is not nil.
+func (_u *PaymentDiscrepancyUpdateOne) SetNillableRazorpayOrderID(v *string) *PaymentDiscrepancyUpdateOne {
+	if v != nil {
+		_u.SetRazorpayOrderID(*v)
+	}
+	return _u
+}
+
+// SetRazorpayPaymentID sets the "razorpay_payment_id" field.
+func (_u *PaymentDiscrepancyUpdateOne) SetRazorpayPaymentID(v string) *PaymentDiscrepancyUpdateOne {
+	_u.mutation.SetRazorpayPaymentID(v)
+	return _u
+}
+
+// SetNillableRazorpayPaymentID sets the "razorpay_payment_id" field if the given value is not nil.
+func (_u *PaymentDiscrepancyUpdateOne) SetNillableRazorpayPaymentID(v *string) *PaymentDiscrepancyUpdateOne {
+	if v != nil {
+		_u.SetRazorpayPaymentID(*v)
+	}
+	return _u
+}
+
+// ClearRazorpayPaymentID clears the value of the "razorpay_payment_id" field.
+func (_u *PaymentDiscrepancyUpdateOne) ClearRazorpayPaymentID() *PaymentDiscrepancyUpdateOne {
+	_u.mutation.ClearRazorpayPaymentID()
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *PaymentDiscrepancyUpdateOne) SetKind(v paymentdiscrepancy.Kind) *PaymentDiscrepancyUpdateOne {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *PaymentDiscrepancyUpdateOne) SetNillableKind(v *paymentdiscrepancy.Kind) *PaymentDiscrepancyUpdateOne {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetDbStatus sets the "db_status" field.
+func (_u *PaymentDiscrepancyUpdateOne) SetDbStatus(v string) *PaymentDiscrepancyUpdateOne {
+	_u.mutation.SetDbStatus(v)
+	return _u
+}
+
+// SetNillableDbStatus sets the "db_status" field if the given value is not nil.
+func (_u *PaymentDiscrepancyUpdateOne) SetNillableDbStatus(v *string) *PaymentDiscrepancyUpdateOne {
+	if v != nil {
+		_u.SetDbStatus(*v)
+	}
+	return _u
+}
+
+// ClearDbStatus clears the value of the "db_status" field.
+func (_u *PaymentDiscrepancyUpdateOne) ClearDbStatus() *PaymentDiscrepancyUpdateOne {
+	_u.mutation.ClearDbStatus()
+	return _u
+}
+
+// SetGatewayStatus sets the "gateway_status" field.
+func (_u *PaymentDiscrepancyUpdateOne) SetGatewayStatus(v string) *PaymentDiscrepancyUpdateOne {
+	_u.mutation.SetGatewayStatus(v)
+	return _u
+}
+
+// SetNillableGatewayStatus sets the "gateway_status" field if the given value is not nil.
+func (_u *PaymentDiscrepancyUpdateOne) SetNillableGatewayStatus(v *string) *PaymentDiscrepancyUpdateOne {
+	if v != nil {
+		_u.SetGatewayStatus(*v)
+	}
+	return _u
+}
+
+// ClearGatewayStatus clears the value of the "gateway_status" field.
+func (_u *PaymentDiscrepancyUpdateOne) ClearGatewayStatus() *PaymentDiscrepancyUpdateOne {
+	_u.mutation.ClearGatewayStatus()
+	return _u
+}
+
+// SetDbAmount sets the "db_amount" field.
+func (_u *PaymentDiscrepancyUpdateOne) SetDbAmount(v int) *PaymentDiscrepancyUpdateOne {
+	_u.mutation.ResetDbAmount()
+	_u.mutation.SetDbAmount(v)
+	return _u
+}
+
+// SetNillableDbAmount sets the "db_amount" field if the given value is not nil.
+func (_u *PaymentDiscrepancyUpdateOne) SetNillableDbAmount(v *int) *PaymentDiscrepancyUpdateOne {
+	if v != nil {
+		_u.SetDbAmount(*v)
+	}
+	return _u
+}
+
+// AddDbAmount adds value to the "db_amount" field.
+func (_u *PaymentDiscrepancyUpdateOne) AddDbAmount(v int) *PaymentDiscrepancyUpdateOne {
+	_u.mutation.AddDbAmount(v)
+	return _u
+}
+
+// SetGatewayAmount sets the "gateway_amount" field.
+func (_u *PaymentDiscrepancyUpdateOne) SetGatewayAmount(v int) *PaymentDiscrepancyUpdateOne {
+	_u.mutation.ResetGatewayAmount()
+	_u.mutation.SetGatewayAmount(v)
+	return _u
+}
+
+// SetNillableGatewayAmount sets the "gateway_amount" field if the given value is not nil.
+func (_u *PaymentDiscrepancyUpdateOne) SetNillableGatewayAmount(v *int) *PaymentDiscrepancyUpdateOne {
+	if v != nil {
+		_u.SetGatewayAmount(*v)
+	}
+	return _u
+}
+
+// AddGatewayAmount adds value to the "gateway_amount" field.
+func (_u *PaymentDiscrepancyUpdateOne) AddGatewayAmount(v int) *PaymentDiscrepancyUpdateOne {
+	_u.mutation.AddGatewayAmount(v)
+	return _u
+}
+
+// Mutation returns the PaymentDiscrepancyMutation object of the builder.
+func (_u *PaymentDiscrepancyUpdateOne) Mutation() *PaymentDiscrepancyMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the PaymentDiscrepancyUpdate builder.
+func (_u *PaymentDiscrepancyUpdateOne) Where(ps ...predicate.PaymentDiscrepancy) *PaymentDiscrepancyUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PaymentDiscrepancyUpdateOne) Select(field string, fields ...string) *PaymentDiscrepancyUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PaymentDiscrepancy entity.
+func (_u *PaymentDiscrepancyUpdateOne) Save(ctx context.Context) (*PaymentDiscrepancy, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PaymentDiscrepancyUpdateOne) SaveX(ctx context.Context) *PaymentDiscrepancy {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PaymentDiscrepancyUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PaymentDiscrepancyUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PaymentDiscrepancyUpdateOne) check() error {
+	if v, ok := _u.mutation.OrderID(); ok {
+		if err := paymentdiscrepancy.OrderIDValidator(v); err != nil {
+			return &ValidationError{Name: "order_id", err: fmt.Errorf(`generated: validator failed for field "PaymentDiscrepancy.order_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RazorpayOrderID(); ok {
+		if err := paymentdiscrepancy.RazorpayOrderIDValidator(v); err != nil {
+			return &ValidationError{Name: "razorpay_order_id", err: fmt.Errorf(`generated: validator failed for field "PaymentDiscrepancy.razorpay_order_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RazorpayPaymentID(); ok {
+		if err := paymentdiscrepancy.RazorpayPaymentIDValidator(v); err != nil {
+			return &ValidationError{Name: "razorpay_payment_id", err: fmt.Errorf(`generated: validator failed for field "PaymentDiscrepancy.razorpay_payment_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := paymentdiscrepancy.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`generated: validator failed for field "PaymentDiscrepancy.kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DbStatus(); ok {
+		if err := paymentdiscrepancy.DbStatusValidator(v); err != nil {
+			return &ValidationError{Name: "db_status", err: fmt.Errorf(`generated: validator failed for field "PaymentDiscrepancy.db_status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.GatewayStatus(); ok {
+		if err := paymentdiscrepancy.GatewayStatusValidator(v); err != nil {
+			return &ValidationError{Name: "gateway_status", err: fmt.Errorf(`generated: validator failed for field "PaymentDiscrepancy.gateway_status": %w`, err)}
+		}
+	}
+	if _u.mutation.ReportCleared() && len(_u.mutation.ReportIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "PaymentDiscrepancy.report"`)
+	}
+	return nil
+}
+
+func (_u *PaymentDiscrepancyUpdateOne) sqlSave(ctx context.Context) (_node *PaymentDiscrepancy, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(paymentdiscrepancy.Table, paymentdiscrepancy.Columns, sqlgraph.NewFieldSpec(paymentdiscrepancy.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "PaymentDiscrepancy.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, paymentdiscrepancy.FieldID)
+		for _, f := range fields {
+			if !paymentdiscrepancy.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != paymentdiscrepancy.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.OrderID(); ok {
+		_spec.SetField(paymentdiscrepancy.FieldOrderID, field.TypeString, value)
+	}
+	if _u.mutation.OrderIDCleared() {
+		_spec.ClearField(paymentdiscrepancy.FieldOrderID, field.TypeString)
+	}
+	if value, ok := _u.mutation.RazorpayOrderID(); ok {
+		_spec.SetField(paymentdiscrepancy.FieldRazorpayOrderID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RazorpayPaymentID(); ok {
+		_spec.SetField(paymentdiscrepancy.FieldRazorpayPaymentID, field.TypeString, value)
+	}
+	if _u.mutation.RazorpayPaymentIDCleared() {
+		_spec.ClearField(paymentdiscrepancy.FieldRazorpayPaymentID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(paymentdiscrepancy.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.DbStatus(); ok {
+		_spec.SetField(paymentdiscrepancy.FieldDbStatus, field.TypeString, value)
+	}
+	if _u.mutation.DbStatusCleared() {
+		_spec.ClearField(paymentdiscrepancy.FieldDbStatus, field.TypeString)
+	}
+	if value, ok := _u.mutation.GatewayStatus(); ok {
+		_spec.SetField(paymentdiscrepancy.FieldGatewayStatus, field.TypeString, value)
+	}
+	if _u.mutation.GatewayStatusCleared() {
+		_spec.ClearField(paymentdiscrepancy.FieldGatewayStatus, field.TypeString)
+	}
+	if value, ok := _u.mutation.DbAmount(); ok {
+		_spec.SetField(paymentdiscrepancy.FieldDbAmount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDbAmount(); ok {
+		_spec.AddField(paymentdiscrepancy.FieldDbAmount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.GatewayAmount(); ok {
+		_spec.SetField(paymentdiscrepancy.FieldGatewayAmount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedGatewayAmount(); ok {
+		_spec.AddField(paymentdiscrepancy.FieldGatewayAmount, field.TypeInt, value)
+	}
+	_node = &PaymentDiscrepancy{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{paymentdiscrepancy.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
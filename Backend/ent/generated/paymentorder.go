@@ -9,6 +9,7 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/UnoraApp/be/ent/generated/invoice"
 	"github.com/UnoraApp/be/ent/generated/paymentorder"
 	"github.com/UnoraApp/be/ent/generated/user"
 )
@@ -30,12 +31,16 @@ type PaymentOrder struct {
 	RazorpaySignature *string `json:"razorpay_signature,omitempty"`
 	// Amount in smallest currency unit (paise)
 	Amount int `json:"amount,omitempty"`
+	// DiscountAmount holds the value of the "discount_amount" field.
+	DiscountAmount int `json:"discount_amount,omitempty"`
 	// Currency holds the value of the "currency" field.
 	Currency string `json:"currency,omitempty"`
 	// Credits to add on successful payment
 	CreditsToAdd int `json:"credits_to_add,omitempty"`
 	// OrderStatus holds the value of the "order_status" field.
 	OrderStatus paymentorder.OrderStatus `json:"order_status,omitempty"`
+	// AmountRefunded holds the value of the "amount_refunded" field.
+	AmountRefunded int `json:"amount_refunded,omitempty"`
 	// FailureReason holds the value of the "failure_reason" field.
 	FailureReason *string `json:"failure_reason,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
@@ -54,9 +59,13 @@ type PaymentOrder struct {
 type PaymentOrderEdges struct {
 	// User holds the value of the user edge.
 	User *User `json:"user,omitempty"`
+	// Refunds holds the value of the refunds edge.
+	Refunds []*PaymentRefund `json:"refunds,omitempty"`
+	// Invoice holds the value of the invoice edge.
+	Invoice *Invoice `json:"invoice,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [3]bool
 }
 
 // UserOrErr returns the User value or an error if the edge
@@ -70,12 +79,32 @@ func (e PaymentOrderEdges) UserOrErr() (*User, error) {
 	return nil, &NotLoadedError{edge: "user"}
 }
 
+// RefundsOrErr returns the Refunds value or an error if the edge
+// was not loaded in eager-loading.
+func (e PaymentOrderEdges) RefundsOrErr() ([]*PaymentRefund, error) {
+	if e.loadedTypes[1] {
+		return e.Refunds, nil
+	}
+	return nil, &NotLoadedError{edge: "refunds"}
+}
+
+// InvoiceOrErr returns the Invoice value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PaymentOrderEdges) InvoiceOrErr() (*Invoice, error) {
+	if e.Invoice != nil {
+		return e.Invoice, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: invoice.Label}
+	}
+	return nil, &NotLoadedError{edge: "invoice"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*PaymentOrder) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case paymentorder.FieldAmount, paymentorder.FieldCreditsToAdd:
+		case paymentorder.FieldAmount, paymentorder.FieldDiscountAmount, paymentorder.FieldCreditsToAdd, paymentorder.FieldAmountRefunded:
 			values[i] = new(sql.NullInt64)
 		case paymentorder.FieldID, paymentorder.FieldUserID, paymentorder.FieldPackageID, paymentorder.FieldRazorpayOrderID, paymentorder.FieldRazorpayPaymentID, paymentorder.FieldRazorpaySignature, paymentorder.FieldCurrency, paymentorder.FieldOrderStatus, paymentorder.FieldFailureReason:
 			values[i] = new(sql.NullString)
@@ -141,6 +170,12 @@ func (_m *PaymentOrder) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Amount = int(value.Int64)
 			}
+		case paymentorder.FieldDiscountAmount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field discount_amount", values[i])
+			} else if value.Valid {
+				_m.DiscountAmount = int(value.Int64)
+			}
 		case paymentorder.FieldCurrency:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field currency", values[i])
@@ -159,6 +194,12 @@ func (_m *PaymentOrder) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.OrderStatus = paymentorder.OrderStatus(value.String)
 			}
+		case paymentorder.FieldAmountRefunded:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field amount_refunded", values[i])
+			} else if value.Valid {
+				_m.AmountRefunded = int(value.Int64)
+			}
 		case paymentorder.FieldFailureReason:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field failure_reason", values[i])
@@ -204,6 +245,16 @@ func (_m *PaymentOrder) QueryUser() *UserQuery {
 	return NewPaymentOrderClient(_m.config).QueryUser(_m)
 }
 
+// QueryRefunds queries the "refunds" edge of the PaymentOrder entity.
+func (_m *PaymentOrder) QueryRefunds() *PaymentRefundQuery {
+	return NewPaymentOrderClient(_m.config).QueryRefunds(_m)
+}
+
+// QueryInvoice queries the "invoice" edge of the PaymentOrder entity.
+func (_m *PaymentOrder) QueryInvoice() *InvoiceQuery {
+	return NewPaymentOrderClient(_m.config).QueryInvoice(_m)
+}
+
 // Update returns a builder for updating this PaymentOrder.
 // Note that you need to call PaymentOrder.Unwrap() before calling this method if this PaymentOrder
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -251,6 +302,9 @@ func (_m *PaymentOrder) String() string {
 	builder.WriteString("amount=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Amount))
 	builder.WriteString(", ")
+	builder.WriteString("discount_amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DiscountAmount))
+	builder.WriteString(", ")
 	builder.WriteString("currency=")
 	builder.WriteString(_m.Currency)
 	builder.WriteString(", ")
@@ -260,6 +314,9 @@ func (_m *PaymentOrder) String() string {
 	builder.WriteString("order_status=")
 	builder.WriteString(fmt.Sprintf("%v", _m.OrderStatus))
 	builder.WriteString(", ")
+	builder.WriteString("amount_refunded=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AmountRefunded))
+	builder.WriteString(", ")
 	if v := _m.FailureReason; v != nil {
 		builder.WriteString("failure_reason=")
 		builder.WriteString(*v)
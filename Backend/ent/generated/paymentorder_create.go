@@ -12,7 +12,9 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/UnoraApp/be/ent/generated/invoice"
 	"github.com/UnoraApp/be/ent/generated/paymentorder"
+	"github.com/UnoraApp/be/ent/generated/paymentrefund"
 	"github.com/UnoraApp/be/ent/generated/user"
 )
 
@@ -84,6 +86,20 @@ func (_c *PaymentOrderCreate) SetAmount(v int) *PaymentOrderCreate {
 	return _c
 }
 
+// SetDiscountAmount sets the "discount_amount" field.
+func (_c *PaymentOrderCreate) SetDiscountAmount(v int) *PaymentOrderCreate {
+	_c.mutation.SetDiscountAmount(v)
+	return _c
+}
+
+// SetNillableDiscountAmount sets the "discount_amount" field if the given value is not nil.
+func (_c *PaymentOrderCreate) SetNillableDiscountAmount(v *int) *PaymentOrderCreate {
+	if v != nil {
+		_c.SetDiscountAmount(*v)
+	}
+	return _c
+}
+
 // SetCurrency sets the "currency" field.
 func (_c *PaymentOrderCreate) SetCurrency(v string) *PaymentOrderCreate {
 	_c.mutation.SetCurrency(v)
@@ -118,6 +134,20 @@ func (_c *PaymentOrderCreate) SetNillableOrderStatus(v *paymentorder.OrderStatus
 	return _c
 }
 
+// SetAmountRefunded sets the "amount_refunded" field.
+func (_c *PaymentOrderCreate) SetAmountRefunded(v int) *PaymentOrderCreate {
+	_c.mutation.SetAmountRefunded(v)
+	return _c
+}
+
+// SetNillableAmountRefunded sets the "amount_refunded" field if the given value is not nil.
+func (_c *PaymentOrderCreate) SetNillableAmountRefunded(v *int) *PaymentOrderCreate {
+	if v != nil {
+		_c.SetAmountRefunded(*v)
+	}
+	return _c
+}
+
 // SetFailureReason sets the "failure_reason" field.
 func (_c *PaymentOrderCreate) SetFailureReason(v string) *PaymentOrderCreate {
 	_c.mutation.SetFailureReason(v)
@@ -185,6 +215,40 @@ func (_c *PaymentOrderCreate) SetUser(v *User) *PaymentOrderCreate {
 	return _c.SetUserID(v.ID)
 }
 
+// AddRefundIDs adds the "refunds" edge to the PaymentRefund entity by IDs.
+func (_c *PaymentOrderCreate) AddRefundIDs(ids ...string) *PaymentOrderCreate {
+	_c.mutation.AddRefundIDs(ids...)
+	return _c
+}
+
+// AddRefunds adds the "refunds" edges to the PaymentRefund entity.
+func (_c *PaymentOrderCreate) AddRefunds(v ...*PaymentRefund) *PaymentOrderCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRefundIDs(ids...)
+}
+
+// SetInvoiceID sets the "invoice" edge to the Invoice entity by ID.
+func (_c *PaymentOrderCreate) SetInvoiceID(id string) *PaymentOrderCreate {
+	_c.mutation.SetInvoiceID(id)
+	return _c
+}
+
+// SetNillableInvoiceID sets the "invoice" edge to the Invoice entity by ID if the given value is not nil.
+func (_c *PaymentOrderCreate) SetNillableInvoiceID(id *string) *PaymentOrderCreate {
+	if id != nil {
+		_c = _c.SetInvoiceID(*id)
+	}
+	return _c
+}
+
+// SetInvoice sets the "invoice" edge to the Invoice entity.
+func (_c *PaymentOrderCreate) SetInvoice(v *Invoice) *PaymentOrderCreate {
+	return _c.SetInvoiceID(v.ID)
+}
+
 // Mutation returns the PaymentOrderMutation object of the builder.
 func (_c *PaymentOrderCreate) Mutation() *PaymentOrderMutation {
 	return _c.mutation
@@ -220,6 +284,10 @@ func (_c *PaymentOrderCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (_c *PaymentOrderCreate) defaults() {
+	if _, ok := _c.mutation.DiscountAmount(); !ok {
+		v := paymentorder.DefaultDiscountAmount
+		_c.mutation.SetDiscountAmount(v)
+	}
 	if _, ok := _c.mutation.Currency(); !ok {
 		v := paymentorder.DefaultCurrency
 		_c.mutation.SetCurrency(v)
@@ -228,6 +296,10 @@ func (_c *PaymentOrderCreate) defaults() {
 		v := paymentorder.DefaultOrderStatus
 		_c.mutation.SetOrderStatus(v)
 	}
+	if _, ok := _c.mutation.AmountRefunded(); !ok {
+		v := paymentorder.DefaultAmountRefunded
+		_c.mutation.SetAmountRefunded(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := paymentorder.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -270,6 +342,14 @@ func (_c *PaymentOrderCreate) check() error {
 	if _, ok := _c.mutation.Amount(); !ok {
 		return &ValidationError{Name: "amount", err: errors.New(`generated: missing required field "PaymentOrder.amount"`)}
 	}
+	if _, ok := _c.mutation.DiscountAmount(); !ok {
+		return &ValidationError{Name: "discount_amount", err: errors.New(`generated: missing required field "PaymentOrder.discount_amount"`)}
+	}
+	if v, ok := _c.mutation.DiscountAmount(); ok {
+		if err := paymentorder.DiscountAmountValidator(v); err != nil {
+			return &ValidationError{Name: "discount_amount", err: fmt.Errorf(`generated: validator failed for field "PaymentOrder.discount_amount": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.Currency(); !ok {
 		return &ValidationError{Name: "currency", err: errors.New(`generated: missing required field "PaymentOrder.currency"`)}
 	}
@@ -289,6 +369,14 @@ func (_c *PaymentOrderCreate) check() error {
 			return &ValidationError{Name: "order_status", err: fmt.Errorf(`generated: validator failed for field "PaymentOrder.order_status": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.AmountRefunded(); !ok {
+		return &ValidationError{Name: "amount_refunded", err: errors.New(`generated: missing required field "PaymentOrder.amount_refunded"`)}
+	}
+	if v, ok := _c.mutation.AmountRefunded(); ok {
+		if err := paymentorder.AmountRefundedValidator(v); err != nil {
+			return &ValidationError{Name: "amount_refunded", err: fmt.Errorf(`generated: validator failed for field "PaymentOrder.amount_refunded": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "PaymentOrder.created_at"`)}
 	}
@@ -356,6 +444,10 @@ func (_c *PaymentOrderCreate) createSpec() (*PaymentOrder, *sqlgraph.CreateSpec)
 		_spec.SetField(paymentorder.FieldAmount, field.TypeInt, value)
 		_node.Amount = value
 	}
+	if value, ok := _c.mutation.DiscountAmount(); ok {
+		_spec.SetField(paymentorder.FieldDiscountAmount, field.TypeInt, value)
+		_node.DiscountAmount = value
+	}
 	if value, ok := _c.mutation.Currency(); ok {
 		_spec.SetField(paymentorder.FieldCurrency, field.TypeString, value)
 		_node.Currency = value
@@ -368,6 +460,10 @@ func (_c *PaymentOrderCreate) createSpec() (*PaymentOrder, *sqlgraph.CreateSpec)
 		_spec.SetField(paymentorder.FieldOrderStatus, field.TypeEnum, value)
 		_node.OrderStatus = value
 	}
+	if value, ok := _c.mutation.AmountRefunded(); ok {
+		_spec.SetField(paymentorder.FieldAmountRefunded, field.TypeInt, value)
+		_node.AmountRefunded = value
+	}
 	if value, ok := _c.mutation.FailureReason(); ok {
 		_spec.SetField(paymentorder.FieldFailureReason, field.TypeString, value)
 		_node.FailureReason = &value
@@ -401,6 +497,38 @@ func (_c *PaymentOrderCreate) createSpec() (*PaymentOrder, *sqlgraph.CreateSpec)
 		_node.UserID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.RefundsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentorder.RefundsTable,
+			Columns: []string{paymentorder.RefundsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentrefund.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.InvoiceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   paymentorder.InvoiceTable,
+			Columns: []string{paymentorder.InvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -549,6 +677,24 @@ func (u *PaymentOrderUpsert) AddAmount(v int) *PaymentOrderUpsert {
 	return u
 }
 
+// SetDiscountAmount sets the "discount_amount" field.
+func (u *PaymentOrderUpsert) SetDiscountAmount(v int) *PaymentOrderUpsert {
+	u.Set(paymentorder.FieldDiscountAmount, v)
+	return u
+}
+
+// UpdateDiscountAmount sets the "discount_amount" field to the value that was provided on create.
+func (u *PaymentOrderUpsert) UpdateDiscountAmount() *PaymentOrderUpsert {
+	u.SetExcluded(paymentorder.FieldDiscountAmount)
+	return u
+}
+
+// AddDiscountAmount adds v to the "discount_amount" field.
+func (u *PaymentOrderUpsert) AddDiscountAmount(v int) *PaymentOrderUpsert {
+	u.Add(paymentorder.FieldDiscountAmount, v)
+	return u
+}
+
 // SetCurrency sets the "currency" field.
 func (u *PaymentOrderUpsert) SetCurrency(v string) *PaymentOrderUpsert {
 	u.Set(paymentorder.FieldCurrency, v)
@@ -591,6 +737,24 @@ func (u *PaymentOrderUpsert) UpdateOrderStatus() *PaymentOrderUpsert {
 	return u
 }
 
+// SetAmountRefunded sets the "amount_refunded" field.
+func (u *PaymentOrderUpsert) SetAmountRefunded(v int) *PaymentOrderUpsert {
+	u.Set(paymentorder.FieldAmountRefunded, v)
+	return u
+}
+
+// UpdateAmountRefunded sets the "amount_refunded" field to the value that was provided on create.
+func (u *PaymentOrderUpsert) UpdateAmountRefunded() *PaymentOrderUpsert {
+	u.SetExcluded(paymentorder.FieldAmountRefunded)
+	return u
+}
+
+// AddAmountRefunded adds v to the "amount_refunded" field.
+func (u *PaymentOrderUpsert) AddAmountRefunded(v int) *PaymentOrderUpsert {
+	u.Add(paymentorder.FieldAmountRefunded, v)
+	return u
+}
+
 // SetFailureReason sets the "failure_reason" field.
 func (u *PaymentOrderUpsert) SetFailureReason(v string) *PaymentOrderUpsert {
 	u.Set(paymentorder.FieldFailureReason, v)
@@ -808,6 +972,27 @@ func (u *PaymentOrderUpsertOne) UpdateAmount() *PaymentOrderUpsertOne {
 	})
 }
 
+// SetDiscountAmount sets the "discount_amount" field.
+func (u *PaymentOrderUpsertOne) SetDiscountAmount(v int) *PaymentOrderUpsertOne {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.SetDiscountAmount(v)
+	})
+}
+
+// AddDiscountAmount adds v to the "discount_amount" field.
+func (u *PaymentOrderUpsertOne) AddDiscountAmount(v int) *PaymentOrderUpsertOne {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.AddDiscountAmount(v)
+	})
+}
+
+// UpdateDiscountAmount sets the "discount_amount" field to the value that was provided on create.
+func (u *PaymentOrderUpsertOne) UpdateDiscountAmount() *PaymentOrderUpsertOne {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.UpdateDiscountAmount()
+	})
+}
+
 // SetCurrency sets the "currency" field.
 func (u *PaymentOrderUpsertOne) SetCurrency(v string) *PaymentOrderUpsertOne {
 	return u.Update(func(s *PaymentOrderUpsert) {
@@ -857,6 +1042,27 @@ func (u *PaymentOrderUpsertOne) UpdateOrderStatus() *PaymentOrderUpsertOne {
 	})
 }
 
+// SetAmountRefunded sets the "amount_refunded" field.
+func (u *PaymentOrderUpsertOne) SetAmountRefunded(v int) *PaymentOrderUpsertOne {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.SetAmountRefunded(v)
+	})
+}
+
+// AddAmountRefunded adds v to the "amount_refunded" field.
+func (u *PaymentOrderUpsertOne) AddAmountRefunded(v int) *PaymentOrderUpsertOne {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.AddAmountRefunded(v)
+	})
+}
+
+// UpdateAmountRefunded sets the "amount_refunded" field to the value that was provided on create.
+func (u *PaymentOrderUpsertOne) UpdateAmountRefunded() *PaymentOrderUpsertOne {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.UpdateAmountRefunded()
+	})
+}
+
 // SetFailureReason sets the "failure_reason" field.
 func (u *PaymentOrderUpsertOne) SetFailureReason(v string) *PaymentOrderUpsertOne {
 	return u.Update(func(s *PaymentOrderUpsert) {
@@ -1250,6 +1456,27 @@ func (u *PaymentOrderUpsertBulk) UpdateAmount() *PaymentOrderUpsertBulk {
 	})
 }
 
+// SetDiscountAmount sets the "discount_amount" field.
+func (u *PaymentOrderUpsertBulk) SetDiscountAmount(v int) *PaymentOrderUpsertBulk {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.SetDiscountAmount(v)
+	})
+}
+
+// AddDiscountAmount adds v to the "discount_amount" field.
+func (u *PaymentOrderUpsertBulk) AddDiscountAmount(v int) *PaymentOrderUpsertBulk {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.AddDiscountAmount(v)
+	})
+}
+
+// UpdateDiscountAmount sets the "discount_amount" field to the value that was provided on create.
+func (u *PaymentOrderUpsertBulk) UpdateDiscountAmount() *PaymentOrderUpsertBulk {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.UpdateDiscountAmount()
+	})
+}
+
 // SetCurrency sets the "currency" field.
 func (u *PaymentOrderUpsertBulk) SetCurrency(v string) *PaymentOrderUpsertBulk {
 	return u.Update(func(s *PaymentOrderUpsert) {
@@ -1299,6 +1526,27 @@ func (u *PaymentOrderUpsertBulk) UpdateOrderStatus() *PaymentOrderUpsertBulk {
 	})
 }
 
+// SetAmountRefunded sets the "amount_refunded" field.
+func (u *PaymentOrderUpsertBulk) SetAmountRefunded(v int) *PaymentOrderUpsertBulk {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.SetAmountRefunded(v)
+	})
+}
+
+// AddAmountRefunded adds v to the "amount_refunded" field.
+func (u *PaymentOrderUpsertBulk) AddAmountRefunded(v int) *PaymentOrderUpsertBulk {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.AddAmountRefunded(v)
+	})
+}
+
+// UpdateAmountRefunded sets the "amount_refunded" field to the value that was provided on create.
+func (u *PaymentOrderUpsertBulk) UpdateAmountRefunded() *PaymentOrderUpsertBulk {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.UpdateAmountRefunded()
+	})
+}
+
 // SetFailureReason sets the "failure_reason" field.
 func (u *PaymentOrderUpsertBulk) SetFailureReason(v string) *PaymentOrderUpsertBulk {
 	return u.Update(func(s *PaymentOrderUpsert) {
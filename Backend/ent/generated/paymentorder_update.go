@@ -11,7 +11,9 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/UnoraApp/be/ent/generated/invoice"
 	"github.com/UnoraApp/be/ent/generated/paymentorder"
+	"github.com/UnoraApp/be/ent/generated/paymentrefund"
 	"github.com/UnoraApp/be/ent/generated/predicate"
 	"github.com/UnoraApp/be/ent/generated/user"
 )
@@ -138,6 +140,27 @@ func (_u *PaymentOrderUpdate) AddAmount(v int) *PaymentOrderUpdate {
 	return _u
 }
 
+// SetDiscountAmount sets the "discount_amount" field.
+func (_u *PaymentOrderUpdate) SetDiscountAmount(v int) *PaymentOrderUpdate {
+	_u.mutation.ResetDiscountAmount()
+	_u.mutation.SetDiscountAmount(v)
+	return _u
+}
+
+// SetNillableDiscountAmount sets the "discount_amount" field if the given value is not nil.
+func (_u *PaymentOrderUpdate) SetNillableDiscountAmount(v *int) *PaymentOrderUpdate {
+	if v != nil {
+		_u.SetDiscountAmount(*v)
+	}
+	return _u
+}
+
+// AddDiscountAmount adds value to the "discount_amount" field.
+func (_u *PaymentOrderUpdate) AddDiscountAmount(v int) *PaymentOrderUpdate {
+	_u.mutation.AddDiscountAmount(v)
+	return _u
+}
+
 // SetCurrency sets the "currency" field.
 func (_u *PaymentOrderUpdate) SetCurrency(v string) *PaymentOrderUpdate {
 	_u.mutation.SetCurrency(v)
@@ -187,6 +210,27 @@ func (_u *PaymentOrderUpdate) SetNillableOrderStatus(v *paymentorder.OrderStatus
 	return _u
 }
 
+// SetAmountRefunded sets the "amount_refunded" field.
+func (_u *PaymentOrderUpdate) SetAmountRefunded(v int) *PaymentOrderUpdate {
+	_u.mutation.ResetAmountRefunded()
+	_u.mutation.SetAmountRefunded(v)
+	return _u
+}
+
+// SetNillableAmountRefunded sets the "amount_refunded" field if the given value is not nil.
+func (_u *PaymentOrderUpdate) SetNillableAmountRefunded(v *int) *PaymentOrderUpdate {
+	if v != nil {
+		_u.SetAmountRefunded(*v)
+	}
+	return _u
+}
+
+// AddAmountRefunded adds value to the "amount_refunded" field.
+func (_u *PaymentOrderUpdate) AddAmountRefunded(v int) *PaymentOrderUpdate {
+	_u.mutation.AddAmountRefunded(v)
+	return _u
+}
+
 // SetFailureReason sets the "failure_reason" field.
 func (_u *PaymentOrderUpdate) SetFailureReason(v string) *PaymentOrderUpdate {
 	_u.mutation.SetFailureReason(v)
@@ -252,6 +296,40 @@ func (_u *PaymentOrderUpdate) SetUser(v *User) *PaymentOrderUpdate {
 	return _u.SetUserID(v.ID)
 }
 
+// AddRefundIDs adds the "refunds" edge to the PaymentRefund entity by IDs.
+func (_u *PaymentOrderUpdate) AddRefundIDs(ids ...string) *PaymentOrderUpdate {
+	_u.mutation.AddRefundIDs(ids...)
+	return _u
+}
+
+// AddRefunds adds the "refunds" edges to the PaymentRefund entity.
+func (_u *PaymentOrderUpdate) AddRefunds(v ...*PaymentRefund) *PaymentOrderUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRefundIDs(ids...)
+}
+
+// SetInvoiceID sets the "invoice" edge to the Invoice entity by ID.
+func (_u *PaymentOrderUpdate) SetInvoiceID(id string) *PaymentOrderUpdate {
+	_u.mutation.SetInvoiceID(id)
+	return _u
+}
+
+// SetNillableInvoiceID sets the "invoice" edge to the Invoice entity by ID if the given value is not nil.
+func (_u *PaymentOrderUpdate) SetNillableInvoiceID(id *string) *PaymentOrderUpdate {
+	if id != nil {
+		_u = _u.SetInvoiceID(*id)
+	}
+	return _u
+}
+
+// SetInvoice sets the "invoice" edge to the Invoice entity.
+func (_u *PaymentOrderUpdate) SetInvoice(v *Invoice) *PaymentOrderUpdate {
+	return _u.SetInvoiceID(v.ID)
+}
+
 // Mutation returns the PaymentOrderMutation object of the builder.
 func (_u *PaymentOrderUpdate) Mutation() *PaymentOrderMutation {
 	return _u.mutation
@@ -263,6 +341,33 @@ func (_u *PaymentOrderUpdate) ClearUser() *PaymentOrderUpdate {
 	return _u
 }
 
+// ClearRefunds clears all "refunds" edges to the PaymentRefund entity.
+func (_u *PaymentOrderUpdate) ClearRefunds() *PaymentOrderUpdate {
+	_u.mutation.ClearRefunds()
+	return _u
+}
+
+// RemoveRefundIDs removes the "refunds" edge to PaymentRefund entities by IDs.
+func (_u *PaymentOrderUpdate) RemoveRefundIDs(ids ...string) *PaymentOrderUpdate {
+	_u.mutation.RemoveRefundIDs(ids...)
+	return _u
+}
+
+// RemoveRefunds removes "refunds" edges to PaymentRefund entities.
+func (_u *PaymentOrderUpdate) RemoveRefunds(v ...*PaymentRefund) *PaymentOrderUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRefundIDs(ids...)
+}
+
+// ClearInvoice clears the "invoice" edge to the Invoice entity.
+func (_u *PaymentOrderUpdate) ClearInvoice() *PaymentOrderUpdate {
+	_u.mutation.ClearInvoice()
+	return _u
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *PaymentOrderUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -317,6 +422,11 @@ func (_u *PaymentOrderUpdate) check() error {
 			return &ValidationError{Name: "razorpay_signature", err: fmt.Errorf(`generated: validator failed for field "PaymentOrder.razorpay_signature": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.DiscountAmount(); ok {
+		if err := paymentorder.DiscountAmountValidator(v); err != nil {
+			return &ValidationError{Name: "discount_amount", err: fmt.Errorf(`generated: validator failed for field "PaymentOrder.discount_amount": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.Currency(); ok {
 		if err := paymentorder.CurrencyValidator(v); err != nil {
 			return &ValidationError{Name: "currency", err: fmt.Errorf(`generated: validator failed for field "PaymentOrder.currency": %w`, err)}
@@ -327,6 +437,11 @@ func (_u *PaymentOrderUpdate) check() error {
 			return &ValidationError{Name: "order_status", err: fmt.Errorf(`generated: validator failed for field "PaymentOrder.order_status": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.AmountRefunded(); ok {
+		if err := paymentorder.AmountRefundedValidator(v); err != nil {
+			return &ValidationError{Name: "amount_refunded", err: fmt.Errorf(`generated: validator failed for field "PaymentOrder.amount_refunded": %w`, err)}
+		}
+	}
 	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
 		return errors.New(`generated: clearing a required unique edge "PaymentOrder.user"`)
 	}
@@ -372,6 +487,12 @@ func (_u *PaymentOrderUpdate) sqlSave(ctx context.Context) (_node int, err error
 	if value, ok := _u.mutation.AddedAmount(); ok {
 		_spec.AddField(paymentorder.FieldAmount, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.DiscountAmount(); ok {
+		_spec.SetField(paymentorder.FieldDiscountAmount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDiscountAmount(); ok {
+		_spec.AddField(paymentorder.FieldDiscountAmount, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.Currency(); ok {
 		_spec.SetField(paymentorder.FieldCurrency, field.TypeString, value)
 	}
@@ -384,6 +505,12 @@ func (_u *PaymentOrderUpdate) sqlSave(ctx context.Context) (_node int, err error
 	if value, ok := _u.mutation.OrderStatus(); ok {
 		_spec.SetField(paymentorder.FieldOrderStatus, field.TypeEnum, value)
 	}
+	if value, ok := _u.mutation.AmountRefunded(); ok {
+		_spec.SetField(paymentorder.FieldAmountRefunded, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAmountRefunded(); ok {
+		_spec.AddField(paymentorder.FieldAmountRefunded, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.FailureReason(); ok {
 		_spec.SetField(paymentorder.FieldFailureReason, field.TypeString, value)
 	}
@@ -431,6 +558,80 @@ func (_u *PaymentOrderUpdate) sqlSave(ctx context.Context) (_node int, err error
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.RefundsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentorder.RefundsTable,
+			Columns: []string{paymentorder.RefundsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentrefund.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRefundsIDs(); len(nodes) > 0 && !_u.mutation.RefundsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentorder.RefundsTable,
+			Columns: []string{paymentorder.RefundsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentrefund.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RefundsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentorder.RefundsTable,
+			Columns: []string{paymentorder.RefundsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentrefund.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.InvoiceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   paymentorder.InvoiceTable,
+			Columns: []string{paymentorder.InvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.InvoiceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   paymentorder.InvoiceTable,
+			Columns: []string{paymentorder.InvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{paymentorder.Label}
@@ -560,6 +761,27 @@ func (_u *PaymentOrderUpdateOne) AddAmount(v int) *PaymentOrderUpdateOne {
 	return _u
 }
 
+// SetDiscountAmount sets the "discount_amount" field.
+func (_u *PaymentOrderUpdateOne) SetDiscountAmount(v int) *PaymentOrderUpdateOne {
+	_u.mutation.ResetDiscountAmount()
+	_u.mutation.SetDiscountAmount(v)
+	return _u
+}
+
+// SetNillableDiscountAmount sets the "discount_amount" field if the given value is not nil.
+func (_u *PaymentOrderUpdateOne) SetNillableDiscountAmount(v *int) *PaymentOrderUpdateOne {
+	if v != nil {
+		_u.SetDiscountAmount(*v)
+	}
+	return _u
+}
+
+// AddDiscountAmount adds value to the "discount_amount" field.
+func (_u *PaymentOrderUpdateOne) AddDiscountAmount(v int) *PaymentOrderUpdateOne {
+	_u.mutation.AddDiscountAmount(v)
+	return _u
+}
+
 // SetCurrency sets the "currency" field.
 func (_u *PaymentOrderUpdateOne) SetCurrency(v string) *PaymentOrderUpdateOne {
 	_u.mutation.SetCurrency(v)
@@ -609,6 +831,27 @@ func (_u *PaymentOrderUpdateOne) SetNillableOrderStatus(v *paymentorder.OrderSta
 	return _u
 }
 
+// SetAmountRefunded sets the "amount_refunded" field.
+func (_u *PaymentOrderUpdateOne) SetAmountRefunded(v int) *PaymentOrderUpdateOne {
+	_u.mutation.ResetAmountRefunded()
+	_u.mutation.SetAmountRefunded(v)
+	return _u
+}
+
+// SetNillableAmountRefunded sets the "amount_refunded" field if the given value is not nil.
+func (_u *PaymentOrderUpdateOne) SetNillableAmountRefunded(v *int) *PaymentOrderUpdateOne {
+	if v != nil {
+		_u.SetAmountRefunded(*v)
+	}
+	return _u
+}
+
+// AddAmountRefunded adds value to the "amount_refunded" field.
+func (_u *PaymentOrderUpdateOne) AddAmountRefunded(v int) *PaymentOrderUpdateOne {
+	_u.mutation.AddAmountRefunded(v)
+	return _u
+}
+
 // SetFailureReason sets the "failure_reason" field.
 func (_u *PaymentOrderUpdateOne) SetFailureReason(v string) *PaymentOrderUpdateOne {
 	_u.mutation.SetFailureReason(v)
@@ -674,6 +917,40 @@ func (_u *PaymentOrderUpdateOne) SetUser(v *User) *PaymentOrderUpdateOne {
 	return _u.SetUserID(v.ID)
 }
 
+// AddRefundIDs adds the "refunds" edge to the PaymentRefund entity by IDs.
+func (_u *PaymentOrderUpdateOne) AddRefundIDs(ids ...string) *PaymentOrderUpdateOne {
+	_u.mutation.AddRefundIDs(ids...)
+	return _u
+}
+
+// AddRefunds adds the "refunds" edges to the PaymentRefund entity.
+func (_u *PaymentOrderUpdateOne) AddRefunds(v ...*PaymentRefund) *PaymentOrderUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRefundIDs(ids...)
+}
+
+// SetInvoiceID sets the "invoice" edge to the Invoice entity by ID.
+func (_u *PaymentOrderUpdateOne) SetInvoiceID(id string) *PaymentOrderUpdateOne {
+	_u.mutation.SetInvoiceID(id)
+	return _u
+}
+
+// SetNillableInvoiceID sets the "invoice" edge to the Invoice entity by ID if the given value is not nil.
+func (_u *PaymentOrderUpdateOne) SetNillableInvoiceID(id *string) *PaymentOrderUpdateOne {
+	if id != nil {
+		_u = _u.SetInvoiceID(*id)
+	}
+	return _u
+}
+
+// SetInvoice sets the "invoice" edge to the Invoice entity.
+func (_u *PaymentOrderUpdateOne) SetInvoice(v *Invoice) *PaymentOrderUpdateOne {
+	return _u.SetInvoiceID(v.ID)
+}
+
 // Mutation returns the PaymentOrderMutation object of the builder.
 func (_u *PaymentOrderUpdateOne) Mutation() *PaymentOrderMutation {
 	return _u.mutation
@@ -685,6 +962,33 @@ func (_u *PaymentOrderUpdateOne) ClearUser() *PaymentOrderUpdateOne {
 	return _u
 }
 
+// ClearRefunds clears all "refunds" edges to the PaymentRefund entity.
+func (_u *PaymentOrderUpdateOne) ClearRefunds() *PaymentOrderUpdateOne {
+	_u.mutation.ClearRefunds()
+	return _u
+}
+
+// RemoveRefundIDs removes the "refunds" edge to PaymentRefund entities by IDs.
+func (_u *PaymentOrderUpdateOne) RemoveRefundIDs(ids ...string) *PaymentOrderUpdateOne {
+	_u.mutation.RemoveRefundIDs(ids...)
+	return _u
+}
+
+// RemoveRefunds removes "refunds" edges to PaymentRefund entities.
+func (_u *PaymentOrderUpdateOne) RemoveRefunds(v ...*PaymentRefund) *PaymentOrderUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRefundIDs(ids...)
+}
+
+// ClearInvoice clears the "invoice" edge to the Invoice entity.
+func (_u *PaymentOrderUpdateOne) ClearInvoice() *PaymentOrderUpdateOne {
+	_u.mutation.ClearInvoice()
+	return _u
+}
+
 // Where appends a list predicates to the PaymentOrderUpdate builder.
 func (_u *PaymentOrderUpdateOne) Where(ps ...predicate.PaymentOrder) *PaymentOrderUpdateOne {
 	_u.mutation.Where(ps...)
@@ -752,6 +1056,11 @@ func (_u *PaymentOrderUpdateOne) check() error {
 			return &ValidationError{Name: "razorpay_signature", err: fmt.Errorf(`generated: validator failed for field "PaymentOrder.razorpay_signature": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.DiscountAmount(); ok {
+		if err := paymentorder.DiscountAmountValidator(v); err != nil {
+			return &ValidationError{Name: "discount_amount", err: fmt.Errorf(`generated: validator failed for field "PaymentOrder.discount_amount": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.Currency(); ok {
 		if err := paymentorder.CurrencyValidator(v); err != nil {
 			return &ValidationError{Name: "currency", err: fmt.Errorf(`generated: validator failed for field "PaymentOrder.currency": %w`, err)}
@@ -762,6 +1071,11 @@ func (_u *PaymentOrderUpdateOne) check() error {
 			return &ValidationError{Name: "order_status", err: fmt.Errorf(`generated: validator failed for field "PaymentOrder.order_status": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.AmountRefunded(); ok {
+		if err := paymentorder.AmountRefundedValidator(v); err != nil {
+			return &ValidationError{Name: "amount_refunded", err: fmt.Errorf(`generated: validator failed for field "PaymentOrder.amount_refunded": %w`, err)}
+		}
+	}
 	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
 		return errors.New(`generated: clearing a required unique edge "PaymentOrder.user"`)
 	}
@@ -824,6 +1138,12 @@ func (_u *PaymentOrderUpdateOne) sqlSave(ctx context.Context) (_node *PaymentOrd
 	if value, ok := _u.mutation.AddedAmount(); ok {
 		_spec.AddField(paymentorder.FieldAmount, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.DiscountAmount(); ok {
+		_spec.SetField(paymentorder.FieldDiscountAmount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDiscountAmount(); ok {
+		_spec.AddField(paymentorder.FieldDiscountAmount, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.Currency(); ok {
 		_spec.SetField(paymentorder.FieldCurrency, field.TypeString, value)
 	}
@@ -836,6 +1156,12 @@ func (_u *PaymentOrderUpdateOne) sqlSave(ctx context.Context) (_node *PaymentOrd
 	if value, ok := _u.mutation.OrderStatus(); ok {
 		_spec.SetField(paymentorder.FieldOrderStatus, field.TypeEnum, value)
 	}
+	if value, ok := _u.mutation.AmountRefunded(); ok {
+		_spec.SetField(paymentorder.FieldAmountRefunded, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAmountRefunded(); ok {
+		_spec.AddField(paymentorder.FieldAmountRefunded, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.FailureReason(); ok {
 		_spec.SetField(paymentorder.FieldFailureReason, field.TypeString, value)
 	}
@@ -883,6 +1209,80 @@ func (_u *PaymentOrderUpdateOne) sqlSave(ctx context.Context) (_node *PaymentOrd
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.RefundsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentorder.RefundsTable,
+			Columns: []string{paymentorder.RefundsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentrefund.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRefundsIDs(); len(nodes) > 0 && !_u.mutation.RefundsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentorder.RefundsTable,
+			Columns: []string{paymentorder.RefundsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentrefund.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RefundsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentorder.RefundsTable,
+			Columns: []string{paymentorder.RefundsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentrefund.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.InvoiceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   paymentorder.InvoiceTable,
+			Columns: []string{paymentorder.InvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.InvoiceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   paymentorder.InvoiceTable,
+			Columns: []string{paymentorder.InvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &PaymentOrder{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,231 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/UnoraApp/be/ent/generated/paymentreconciliationreport"
+)
+
+// PaymentReconciliationReport is the model entity for the PaymentReconciliationReport schema.
+type PaymentReconciliationReport struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// ReportDate holds the value of the "report_date" field.
+	ReportDate string `json:"report_date,omitempty"`
+	// WindowStart holds the value of the "window_start" field.
+	WindowStart time.Time `json:"window_start,omitempty"`
+	// WindowEnd holds the value of the "window_end" field.
+	WindowEnd time.Time `json:"window_end,omitempty"`
+	// OrdersChecked holds the value of the "orders_checked" field.
+	OrdersChecked int `json:"orders_checked,omitempty"`
+	// GatewayPayments holds the value of the "gateway_payments" field.
+	GatewayPayments int `json:"gateway_payments,omitempty"`
+	// Matched holds the value of the "matched" field.
+	Matched int `json:"matched,omitempty"`
+	// DiscrepancyCount holds the value of the "discrepancy_count" field.
+	DiscrepancyCount int `json:"discrepancy_count,omitempty"`
+	// DbPaidAmount holds the value of the "db_paid_amount" field.
+	DbPaidAmount int `json:"db_paid_amount,omitempty"`
+	// GatewayCapturedAmount holds the value of the "gateway_captured_amount" field.
+	GatewayCapturedAmount int `json:"gateway_captured_amount,omitempty"`
+	// GeneratedAt holds the value of the "generated_at" field.
+	GeneratedAt time.Time `json:"generated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PaymentReconciliationReportQuery when eager-loading is set.
+	Edges        PaymentReconciliationReportEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PaymentReconciliationReportEdges holds the relations/edges for other nodes in the graph.
+type PaymentReconciliationReportEdges struct {
+	// Discrepancies holds the value of the discrepancies edge.
+	Discrepancies []*PaymentDiscrepancy `json:"discrepancies,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// DiscrepanciesOrErr returns the Discrepancies value or an error if the edge
+// was not loaded in eager-loading.
+func (e PaymentReconciliationReportEdges) DiscrepanciesOrErr() ([]*PaymentDiscrepancy, error) {
+	if e.loadedTypes[0] {
+		return e.Discrepancies, nil
+	}
+	return nil, &NotLoadedError{edge: "discrepancies"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PaymentReconciliationReport) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case paymentreconciliationreport.FieldOrdersChecked, paymentreconciliationreport.FieldGatewayPayments, paymentreconciliationreport.FieldMatched, paymentreconciliationreport.FieldDiscrepancyCount, paymentreconciliationreport.FieldDbPaidAmount, paymentreconciliationreport.FieldGatewayCapturedAmount:
+			values[i] = new(sql.NullInt64)
+		case paymentreconciliationreport.FieldID, paymentreconciliationreport.FieldReportDate:
+			values[i] = new(sql.NullString)
+		case paymentreconciliationreport.FieldWindowStart, paymentreconciliationreport.FieldWindowEnd, paymentreconciliationreport.FieldGeneratedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PaymentReconciliationReport fields.
+func (_m *PaymentReconciliationReport) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case paymentreconciliationreport.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case paymentreconciliationreport.FieldReportDate:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field report_date", values[i])
+			} else if value.Valid {
+				_m.ReportDate = value.String
+			}
+		case paymentreconciliationreport.FieldWindowStart:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field window_start", values[i])
+			} else if value.Valid {
+				_m.WindowStart = value.Time
+			}
+		case paymentreconciliationreport.FieldWindowEnd:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field window_end", values[i])
+			} else if value.Valid {
+				_m.WindowEnd = value.Time
+			}
+		case paymentreconciliationreport.FieldOrdersChecked:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field orders_checked", values[i])
+			} else if value.Valid {
+				_m.OrdersChecked = int(value.Int64)
+			}
+		case paymentreconciliationreport.FieldGatewayPayments:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field gateway_payments", values[i])
+			} else if value.Valid {
+				_m.GatewayPayments = int(value.Int64)
+			}
+		case paymentreconciliationreport.FieldMatched:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field matched", values[i])
+			} else if value.Valid {
+				_m.Matched = int(value.Int64)
+			}
+		case paymentreconciliationreport.FieldDiscrepancyCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field discrepancy_count", values[i])
+			} else if value.Valid {
+				_m.DiscrepancyCount = int(value.Int64)
+			}
+		case paymentreconciliationreport.FieldDbPaidAmount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field db_paid_amount", values[i])
+			} else if value.Valid {
+				_m.DbPaidAmount = int(value.Int64)
+			}
+		case paymentreconciliationreport.FieldGatewayCapturedAmount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field gateway_captured_amount", values[i])
+			} else if value.Valid {
+				_m.GatewayCapturedAmount = int(value.Int64)
+			}
+		case paymentreconciliationreport.FieldGeneratedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field generated_at", values[i])
+			} else if value.Valid {
+				_m.GeneratedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PaymentReconciliationReport.
+// This includes values selected through modifiers, order, etc.
+func (_m *PaymentReconciliationReport) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryDiscrepancies queries the "discrepancies" edge of the PaymentReconciliationReport entity.
+func (_m *PaymentReconciliationReport) QueryDiscrepancies() *PaymentDiscrepancyQuery {
+	return NewPaymentReconciliationReportClient(_m.config).QueryDiscrepancies(_m)
+}
+
+// Update returns a builder for updating this PaymentReconciliationReport.
+// Note that you need to call PaymentReconciliationReport.Unwrap() before calling this method if this PaymentReconciliationReport
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *PaymentReconciliationReport) Update() *PaymentReconciliationReportUpdateOne {
+	return NewPaymentReconciliationReportClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the PaymentReconciliationReport entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *PaymentReconciliationReport) Unwrap() *PaymentReconciliationReport {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("generated: PaymentReconciliationReport is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *PaymentReconciliationReport) String() string {
+	var builder strings.Builder
+	builder.WriteString("PaymentReconciliationReport(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("report_date=")
+	builder.WriteString(_m.ReportDate)
+	builder.WriteString(", ")
+	builder.WriteString("window_start=")
+	builder.WriteString(_m.WindowStart.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("window_end=")
+	builder.WriteString(_m.WindowEnd.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("orders_checked=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OrdersChecked))
+	builder.WriteString(", ")
+	builder.WriteString("gateway_payments=")
+	builder.WriteString(fmt.Sprintf("%v", _m.GatewayPayments))
+	builder.WriteString(", ")
+	builder.WriteString("matched=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Matched))
+	builder.WriteString(", ")
+	builder.WriteString("discrepancy_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DiscrepancyCount))
+	builder.WriteString(", ")
+	builder.WriteString("db_paid_amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DbPaidAmount))
+	builder.WriteString(", ")
+	builder.WriteString("gateway_captured_amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.GatewayCapturedAmount))
+	builder.WriteString(", ")
+	builder.WriteString("generated_at=")
+	builder.WriteString(_m.GeneratedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PaymentReconciliationReports is a parsable slice of PaymentReconciliationReport.
+type PaymentReconciliationReports []*PaymentReconciliationReport
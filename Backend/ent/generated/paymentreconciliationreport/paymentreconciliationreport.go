@@ -0,0 +1,173 @@
+// Code generated by ent, DO NOT EDIT.
+
+package paymentreconciliationreport
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the paymentreconciliationreport type in the database.
+	Label = "payment_reconciliation_report"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldReportDate holds the string denoting the report_date field in the database.
+	FieldReportDate = "report_date"
+	// FieldWindowStart holds the string denoting the window_start field in the database.
+	FieldWindowStart = "window_start"
+	// FieldWindowEnd holds the string denoting the window_end field in the database.
+	FieldWindowEnd = "window_end"
+	// FieldOrdersChecked holds the string denoting the orders_checked field in the database.
+	FieldOrdersChecked = "orders_checked"
+	// FieldGatewayPayments holds the string denoting the gateway_payments field in the database.
+	FieldGatewayPayments = "gateway_payments"
+	// FieldMatched holds the string denoting the matched field in the database.
+	FieldMatched = "matched"
+	// FieldDiscrepancyCount holds the string denoting the discrepancy_count field in the database.
+	FieldDiscrepancyCount = "discrepancy_count"
+	// FieldDbPaidAmount holds the string denoting the db_paid_amount field in the database.
+	FieldDbPaidAmount = "db_paid_amount"
+	// FieldGatewayCapturedAmount holds the string denoting the gateway_captured_amount field in the database.
+	FieldGatewayCapturedAmount = "gateway_captured_amount"
+	// FieldGeneratedAt holds the string denoting the generated_at field in the database.
+	FieldGeneratedAt = "generated_at"
+	// EdgeDiscrepancies holds the string denoting the discrepancies edge name in mutations.
+	EdgeDiscrepancies = "discrepancies"
+	// Table holds the table name of the paymentreconciliationreport in the database.
+	Table = "payment_reconciliation_reports"
+	// DiscrepanciesTable is the table that holds the discrepancies relation/edge.
+	DiscrepanciesTable = "payment_discrepancies"
+	// DiscrepanciesInverseTable is the table name for the PaymentDiscrepancy entity.
+	// It exists in this package in order to avoid circular dependency with the "paymentdiscrepancy" package.
+	DiscrepanciesInverseTable = "payment_discrepancies"
+	// DiscrepanciesColumn is the table column denoting the discrepancies relation/edge.
+	DiscrepanciesColumn = "report_id"
+)
+
+// Columns holds all SQL columns for paymentreconciliationreport fields.
+var Columns = []string{
+	FieldID,
+	FieldReportDate,
+	FieldWindowStart,
+	FieldWindowEnd,
+	FieldOrdersChecked,
+	FieldGatewayPayments,
+	FieldMatched,
+	FieldDiscrepancyCount,
+	FieldDbPaidAmount,
+	FieldGatewayCapturedAmount,
+	FieldGeneratedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// ReportDateValidator is a validator for the "report_date" field. It is called by the builders before save.
+	ReportDateValidator func(string) error
+	// DefaultOrdersChecked holds the default value on creation for the "orders_checked" field.
+	DefaultOrdersChecked int
+	// DefaultGatewayPayments holds the default value on creation for the "gateway_payments" field.
+	DefaultGatewayPayments int
+	// DefaultMatched holds the default value on creation for the "matched" field.
+	DefaultMatched int
+	// DefaultDiscrepancyCount holds the default value on creation for the "discrepancy_count" field.
+	DefaultDiscrepancyCount int
+	// DefaultDbPaidAmount holds the default value on creation for the "db_paid_amount" field.
+	DefaultDbPaidAmount int
+	// DefaultGatewayCapturedAmount holds the default value on creation for the "gateway_captured_amount" field.
+	DefaultGatewayCapturedAmount int
+	// DefaultGeneratedAt holds the default value on creation for the "generated_at" field.
+	DefaultGeneratedAt func() time.Time
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the PaymentReconciliationReport queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByReportDate orders the results by the report_date field.
+func ByReportDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReportDate, opts...).ToFunc()
+}
+
+// ByWindowStart orders the results by the window_start field.
+func ByWindowStart(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWindowStart, opts...).ToFunc()
+}
+
+// ByWindowEnd orders the results by the window_end field.
+func ByWindowEnd(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWindowEnd, opts...).ToFunc()
+}
+
+// ByOrdersChecked orders the results by the orders_checked field.
+func ByOrdersChecked(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrdersChecked, opts...).ToFunc()
+}
+
+// ByGatewayPayments orders the results by the gateway_payments field.
+func ByGatewayPayments(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGatewayPayments, opts...).ToFunc()
+}
+
+// ByMatched orders the results by the matched field.
+func ByMatched(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMatched, opts...).ToFunc()
+}
+
+// ByDiscrepancyCount orders the results by the discrepancy_count field.
+func ByDiscrepancyCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDiscrepancyCount, opts...).ToFunc()
+}
+
+// ByDbPaidAmount orders the results by the db_paid_amount field.
+func ByDbPaidAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDbPaidAmount, opts...).ToFunc()
+}
+
+// ByGatewayCapturedAmount orders the results by the gateway_captured_amount field.
+func ByGatewayCapturedAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGatewayCapturedAmount, opts...).ToFunc()
+}
+
+// ByGeneratedAt orders the results by the generated_at field.
+func ByGeneratedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGeneratedAt, opts...).ToFunc()
+}
+
+// ByDiscrepanciesCount orders the results by discrepancies count.
+func ByDiscrepanciesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newDiscrepanciesStep(), opts...)
+	}
+}
+
+// ByDiscrepancies orders the results by discrepancies terms.
+func ByDiscrepancies(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDiscrepanciesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newDiscrepanciesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DiscrepanciesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, DiscrepanciesTable, DiscrepanciesColumn),
+	)
+}
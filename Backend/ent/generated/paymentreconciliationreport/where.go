@@ -0,0 +1,579 @@
+// Code generated by ent, DO NOT EDIT.
+
+package paymentreconciliationreport
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/UnoraApp/be/ent/generated/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldContainsFold(FieldID, id))
+}
+
+// ReportDate applies equality check predicate on the "report_date" field. It's identical to ReportDateEQ.
+func ReportDate(v string) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldEQ(FieldReportDate, v))
+}
+
+// WindowStart applies equality check predicate on the "window_start" field. It's identical to WindowStartEQ.
+func WindowStart(v time.Time) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldEQ(FieldWindowStart, v))
+}
+
+// WindowEnd applies equality check predicate on the "window_end" field. It's identical to WindowEndEQ.
+func WindowEnd(v time.Time) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldEQ(FieldWindowEnd, v))
+}
+
+// OrdersChecked applies equality check predicate on the "orders_checked" field. It's identical to OrdersCheckedEQ.
+func OrdersChecked(v int) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldEQ(FieldOrdersChecked, v))
+}
+
+// GatewayPayments applies equality check predicate on the "gateway_payments" field. It's identical to GatewayPaymentsEQ.
+func GatewayPayments(v int) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldEQ(FieldGatewayPayments, v))
+}
+
+// Matched applies equality check predicate on the "matched" field. It's identical to MatchedEQ.
+func Matched(v int) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldEQ(FieldMatched, v))
+}
+
+// DiscrepancyCount applies equality check predicate on the "discrepancy_count" field. It's identical to DiscrepancyCountEQ.
+func DiscrepancyCount(v int) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldEQ(FieldDiscrepancyCount, v))
+}
+
+// DbPaidAmount applies equality check predicate on the "db_paid_amount" field. It's identical to DbPaidAmountEQ.
+func DbPaidAmount(v int) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldEQ(FieldDbPaidAmount, v))
+}
+
+// GatewayCapturedAmount applies equality check predicate on the "gateway_captured_amount" field. It's identical to GatewayCapturedAmountEQ.
+func GatewayCapturedAmount(v int) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldEQ(FieldGatewayCapturedAmount, v))
+}
+
+// GeneratedAt applies equality check predicate on the "generated_at" field. It's identical to GeneratedAtEQ.
+func GeneratedAt(v time.Time) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldEQ(FieldGeneratedAt, v))
+}
+
+// ReportDateEQ applies the EQ predicate on the "report_date" field.
+func ReportDateEQ(v string) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldEQ(FieldReportDate, v))
+}
+
+// ReportDateNEQ applies the NEQ predicate on the "report_date" field.
+func ReportDateNEQ(v string) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldNEQ(FieldReportDate, v))
+}
+
+// ReportDateIn applies the In predicate on the "report_date" field.
+func ReportDateIn(vs ...string) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldIn(FieldReportDate, vs...))
+}
+
+// ReportDateNotIn applies the NotIn predicate on the "report_date" field.
+func ReportDateNotIn(vs ...string) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldNotIn(FieldReportDate, vs...))
+}
+
+// ReportDateGT applies the GT predicate on the "report_date" field.
+func ReportDateGT(v string) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldGT(FieldReportDate, v))
+}
+
+// ReportDateGTE applies the GTE predicate on the "report_date" field.
+func ReportDateGTE(v string) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldGTE(FieldReportDate, v))
+}
+
+// ReportDateLT applies the LT predicate on the "report_date" field.
+func ReportDateLT(v string) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldLT(FieldReportDate, v))
+}
+
+// ReportDateLTE applies the LTE predicate on the "report_date" field.
+func ReportDateLTE(v string) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldLTE(FieldReportDate, v))
+}
+
+// ReportDateContains applies the Contains predicate on the "report_date" field.
+func ReportDateContains(v string) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldContains(FieldReportDate, v))
+}
+
+// ReportDateHasPrefix applies the HasPrefix predicate on the "report_date" field.
+func ReportDateHasPrefix(v string) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldHasPrefix(FieldReportDate, v))
+}
+
+// ReportDateHasSuffix applies the HasSuffix predicate on the "report_date" field.
+func ReportDateHasSuffix(v string) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldHasSuffix(FieldReportDate, v))
+}
+
+// ReportDateEqualFold applies the EqualFold predicate on the "report_date" field.
+func ReportDateEqualFold(v string) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldEqualFold(FieldReportDate, v))
+}
+
+// ReportDateContainsFold applies the ContainsFold predicate on the "report_date" field.
+func ReportDateContainsFold(v string) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldContainsFold(FieldReportDate, v))
+}
+
+// WindowStartEQ applies the EQ predicate on the "window_start" field.
+func WindowStartEQ(v time.Time) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldEQ(FieldWindowStart, v))
+}
+
+// WindowStartNEQ applies the NEQ predicate on the "window_start" field.
+func WindowStartNEQ(v time.Time) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldNEQ(FieldWindowStart, v))
+}
+
+// WindowStartIn applies the In predicate on the "window_start" field.
+func WindowStartIn(vs ...time.Time) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldIn(FieldWindowStart, vs...))
+}
+
+// WindowStartNotIn applies the NotIn predicate on the "window_start" field.
+func WindowStartNotIn(vs ...time.Time) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldNotIn(FieldWindowStart, vs...))
+}
+
+// WindowStartGT applies the GT predicate on the "window_start" field.
+func WindowStartGT(v time.Time) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldGT(FieldWindowStart, v))
+}
+
+// WindowStartGTE applies the GTE predicate on the "window_start" field.
+func WindowStartGTE(v time.Time) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldGTE(FieldWindowStart, v))
+}
+
+// WindowStartLT applies the LT predicate on the "window_start" field.
+func WindowStartLT(v time.Time) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldLT(FieldWindowStart, v))
+}
+
+// WindowStartLTE applies the LTE predicate on the "window_start" field.
+func WindowStartLTE(v time.Time) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldLTE(FieldWindowStart, v))
+}
+
+// WindowEndEQ applies the EQ predicate on the "window_end" field.
+func WindowEndEQ(v time.Time) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldEQ(FieldWindowEnd, v))
+}
+
+// WindowEndNEQ applies the NEQ predicate on the "window_end" field.
+func WindowEndNEQ(v time.Time) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldNEQ(FieldWindowEnd, v))
+}
+
+// WindowEndIn applies the In predicate on the "window_end" field.
+func WindowEndIn(vs ...time.Time) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldIn(FieldWindowEnd, vs...))
+}
+
+// WindowEndNotIn applies the NotIn predicate on the "window_end" field.
+func WindowEndNotIn(vs ...time.Time) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldNotIn(FieldWindowEnd, vs...))
+}
+
+// WindowEndGT applies the GT predicate on the "window_end" field.
+func WindowEndGT(v time.Time) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldGT(FieldWindowEnd, v))
+}
+
+// WindowEndGTE applies the GTE predicate on the "window_end" field.
+func WindowEndGTE(v time.Time) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldGTE(FieldWindowEnd, v))
+}
+
+// WindowEndLT applies the LT predicate on the "window_end" field.
+func WindowEndLT(v time.Time) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldLT(FieldWindowEnd, v))
+}
+
+// WindowEndLTE applies the LTE predicate on the "window_end" field.
+func WindowEndLTE(v time.Time) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldLTE(FieldWindowEnd, v))
+}
+
+// OrdersCheckedEQ applies the EQ predicate on the "orders_checked" field.
+func OrdersCheckedEQ(v int) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldEQ(FieldOrdersChecked, v))
+}
+
+// OrdersCheckedNEQ applies the NEQ predicate on the "orders_checked" field.
+func OrdersCheckedNEQ(v int) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldNEQ(FieldOrdersChecked, v))
+}
+
+// OrdersCheckedIn applies the In predicate on the "orders_checked" field.
+func OrdersCheckedIn(vs ...int) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldIn(FieldOrdersChecked, vs...))
+}
+
+// OrdersCheckedNotIn applies the NotIn predicate on the "orders_checked" field.
+func OrdersCheckedNotIn(vs ...int) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldNotIn(FieldOrdersChecked, vs...))
+}
+
+// OrdersCheckedGT applies the GT predicate on the "orders_checked" field.
+func OrdersCheckedGT(v int) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldGT(FieldOrdersChecked, v))
+}
+
+// OrdersCheckedGTE applies the GTE predicate on the "orders_checked" field.
+func OrdersCheckedGTE(v int) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldGTE(FieldOrdersChecked, v))
+}
+
+// OrdersCheckedLT applies the LT predicate on the "orders_checked" field.
+func OrdersCheckedLT(v int) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldLT(FieldOrdersChecked, v))
+}
+
+// OrdersCheckedLTE applies the LTE predicate on the "orders_checked" field.
+func OrdersCheckedLTE(v int) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldLTE(FieldOrdersChecked, v))
+}
+
+// GatewayPaymentsEQ applies the EQ predicate on the "gateway_payments" field.
+func GatewayPaymentsEQ(v int) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldEQ(FieldGatewayPayments, v))
+}
+
+// GatewayPaymentsNEQ applies the NEQ predicate on the "gateway_payments" field.
+func GatewayPaymentsNEQ(v int) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldNEQ(FieldGatewayPayments, v))
+}
+
+// GatewayPaymentsIn applies the In predicate on the "gateway_payments" field.
+func GatewayPaymentsIn(vs ...int) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldIn(FieldGatewayPayments, vs...))
+}
+
+// GatewayPaymentsNotIn applies the NotIn predicate on the "gateway_payments" field.
+func GatewayPaymentsNotIn(vs ...int) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldNotIn(FieldGatewayPayments, vs...))
+}
+
+// GatewayPaymentsGT applies the GT predicate on the "gateway_payments" field.
+func GatewayPaymentsGT(v int) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldGT(FieldGatewayPayments, v))
+}
+
+// GatewayPaymentsGTE applies the GTE predicate on the "gateway_payments" field.
+func GatewayPaymentsGTE(v int) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldGTE(FieldGatewayPayments, v))
+}
+
+// GatewayPaymentsLT applies the LT predicate on the "gateway_payments" field.
+func GatewayPaymentsLT(v int) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldLT(FieldGatewayPayments, v))
+}
+
+// GatewayPaymentsLTE applies the LTE predicate on the "gateway_payments" field.
+func GatewayPaymentsLTE(v int) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldLTE(FieldGatewayPayments, v))
+}
+
+// MatchedEQ applies the EQ predicate on the "matched" field.
+func MatchedEQ(v int) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldEQ(FieldMatched, v))
+}
+
+// MatchedNEQ applies the NEQ predicate on the "matched" field.
+func MatchedNEQ(v int) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldNEQ(FieldMatched, v))
+}
+
+// MatchedIn applies the In predicate on the "matched" field.
+func MatchedIn(vs ...int) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldIn(FieldMatched, vs...))
+}
+
+// MatchedNotIn applies the NotIn predicate on the "matched" field.
+func MatchedNotIn(vs ...int) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldNotIn(FieldMatched, vs...))
+}
+
+// MatchedGT applies the GT predicate on the "matched" field.
+func MatchedGT(v int) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldGT(FieldMatched, v))
+}
+
+// MatchedGTE applies the GTE predicate on the "matched" field.
+func MatchedGTE(v int) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldGTE(FieldMatched, v))
+}
+
+// MatchedLT applies the LT predicate on the "matched" field.
+func MatchedLT(v int) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldLT(FieldMatched, v))
+}
+
+// MatchedLTE applies the LTE predicate on the "matched" field.
+func MatchedLTE(v int) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldLTE(FieldMatched, v))
+}
+
+// DiscrepancyCountEQ applies the EQ predicate on the "discrepancy_count" field.
+func DiscrepancyCountEQ(v int) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldEQ(FieldDiscrepancyCount, v))
+}
+
+// DiscrepancyCountNEQ applies the NEQ predicate on the "discrepancy_count" field.
+func DiscrepancyCountNEQ(v int) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldNEQ(FieldDiscrepancyCount, v))
+}
+
+// DiscrepancyCountIn applies the In predicate on the "discrepancy_count" field.
+func DiscrepancyCountIn(vs ...int) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldIn(FieldDiscrepancyCount, vs...))
+}
+
+// DiscrepancyCountNotIn applies the NotIn predicate on the "discrepancy_count" field.
+func DiscrepancyCountNotIn(vs ...int) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldNotIn(FieldDiscrepancyCount, vs...))
+}
+
+// DiscrepancyCountGT applies the GT predicate on the "discrepancy_count" field.
+func DiscrepancyCountGT(v int) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldGT(FieldDiscrepancyCount, v))
+}
+
+// DiscrepancyCountGTE applies the GTE predicate on the "discrepancy_count" field.
+func DiscrepancyCountGTE(v int) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldGTE(FieldDiscrepancyCount, v))
+}
+
+// DiscrepancyCountLT applies the LT predicate on the "discrepancy_count" field.
+func DiscrepancyCountLT(v int) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldLT(FieldDiscrepancyCount, v))
+}
+
+// DiscrepancyCountLTE applies the LTE predicate on the "discrepancy_count" field.
+func DiscrepancyCountLTE(v int) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldLTE(FieldDiscrepancyCount, v))
+}
+
+// DbPaidAmountEQ applies the EQ predicate on the "db_paid_amount" field.
+func DbPaidAmountEQ(v int) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldEQ(FieldDbPaidAmount, v))
+}
+
+// DbPaidAmountNEQ applies the NEQ predicate on the "db_paid_amount" field.
+func DbPaidAmountNEQ(v int) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldNEQ(FieldDbPaidAmount, v))
+}
+
+// DbPaidAmountIn applies the In predicate on the "db_paid_amount" field.
+func DbPaidAmountIn(vs ...int) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldIn(FieldDbPaidAmount, vs...))
+}
+
+// DbPaidAmountNotIn applies the NotIn predicate on the "db_paid_amount" field.
+func DbPaidAmountNotIn(vs ...int) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldNotIn(FieldDbPaidAmount, vs...))
+}
+
+// DbPaidAmountGT applies the GT predicate on the "db_paid_amount" field.
+func DbPaidAmountGT(v int) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldGT(FieldDbPaidAmount, v))
+}
+
+// DbPaidAmountGTE applies the GTE predicate on the "db_paid_amount" field.
+func DbPaidAmountGTE(v int) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldGTE(FieldDbPaidAmount, v))
+}
+
+// DbPaidAmountLT applies the LT predicate on the "db_paid_amount" field.
+func DbPaidAmountLT(v int) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldLT(FieldDbPaidAmount, v))
+}
+
+// DbPaidAmountLTE applies the LTE predicate on the "db_paid_amount" field.
+func DbPaidAmountLTE(v int) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldLTE(FieldDbPaidAmount, v))
+}
+
+// GatewayCapturedAmountEQ applies the EQ predicate on the "gateway_captured_amount" field.
+func GatewayCapturedAmountEQ(v int) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldEQ(FieldGatewayCapturedAmount, v))
+}
+
+// GatewayCapturedAmountNEQ applies the NEQ predicate on the "gateway_captured_amount" field.
+func GatewayCapturedAmountNEQ(v int) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldNEQ(FieldGatewayCapturedAmount, v))
+}
+
+// GatewayCapturedAmountIn applies the In predicate on the "gateway_captured_amount" field.
+func GatewayCapturedAmountIn(vs ...int) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldIn(FieldGatewayCapturedAmount, vs...))
+}
+
+// GatewayCapturedAmountNotIn applies the NotIn predicate on the "gateway_captured_amount" field.
+func GatewayCapturedAmountNotIn(vs ...int) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldNotIn(FieldGatewayCapturedAmount, vs...))
+}
+
+// GatewayCapturedAmountGT applies the GT predicate on the "gateway_captured_amount" field.
+func GatewayCapturedAmountGT(v int) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldGT(FieldGatewayCapturedAmount, v))
+}
+
+// GatewayCapturedAmountGTE applies the GTE predicate on the "gateway_captured_amount" field.
+func GatewayCapturedAmountGTE(v int) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldGTE(FieldGatewayCapturedAmount, v))
+}
+
+// GatewayCapturedAmountLT applies the LT predicate on the "gateway_captured_amount" field.
+func GatewayCapturedAmountLT(v int) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldLT(FieldGatewayCapturedAmount, v))
+}
+
+// GatewayCapturedAmountLTE applies the LTE predicate on the "gateway_captured_amount" field.
+func GatewayCapturedAmountLTE(v int) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldLTE(FieldGatewayCapturedAmount, v))
+}
+
+// GeneratedAtEQ applies the EQ predicate on the "generated_at" field.
+func GeneratedAtEQ(v time.Time) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldEQ(FieldGeneratedAt, v))
+}
+
+// GeneratedAtNEQ applies the NEQ predicate on the "generated_at" field.
+func GeneratedAtNEQ(v time.Time) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldNEQ(FieldGeneratedAt, v))
+}
+
+// GeneratedAtIn applies the In predicate on the "generated_at" field.
+func GeneratedAtIn(vs ...time.Time) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldIn(FieldGeneratedAt, vs...))
+}
+
+// GeneratedAtNotIn applies the NotIn predicate on the "generated_at" field.
+func GeneratedAtNotIn(vs ...time.Time) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldNotIn(FieldGeneratedAt, vs...))
+}
+
+// GeneratedAtGT applies the GT predicate on the "generated_at" field.
+func GeneratedAtGT(v time.Time) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldGT(FieldGeneratedAt, v))
+}
+
+// GeneratedAtGTE applies the GTE predicate on the "generated_at" field.
+func GeneratedAtGTE(v time.Time) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldGTE(FieldGeneratedAt, v))
+}
+
+// GeneratedAtLT applies the LT predicate on the "generated_at" field.
+func GeneratedAtLT(v time.Time) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldLT(FieldGeneratedAt, v))
+}
+
+// GeneratedAtLTE applies the LTE predicate on the "generated_at" field.
+func GeneratedAtLTE(v time.Time) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.FieldLTE(FieldGeneratedAt, v))
+}
+
+// HasDiscrepancies applies the HasEdge predicate on the "discrepancies" edge.
+func HasDiscrepancies() predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, DiscrepanciesTable, DiscrepanciesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDiscrepanciesWith applies the HasEdge predicate on the "discrepancies" edge with a given conditions (other predicates).
+func HasDiscrepanciesWith(preds ...predicate.PaymentDiscrepancy) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(func(s *sql.Selector) {
+		step := newDiscrepanciesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PaymentReconciliationReport) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PaymentReconciliationReport) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PaymentReconciliationReport) predicate.PaymentReconciliationReport {
+	return predicate.PaymentReconciliationReport(sql.NotPredicates(p))
+}
@@ -0,0 +1,1219 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/UnoraApp/be/ent/generated/paymentdiscrepancy"
+	"github.com/UnoraApp/be/ent/generated/paymentreconciliationreport"
+)
+
+// PaymentReconciliationReportCreate is the builder for creating a PaymentReconciliationReport entity.
+type PaymentReconciliationReportCreate struct {
+	config
+	mutation *PaymentReconciliationReportMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetReportDate sets the "report_date" field.
+func (_c *PaymentReconciliationReportCreate) SetReportDate(v string) *PaymentReconciliationReportCreate {
+	_c.mutation.SetReportDate(v)
+	return _c
+}
+
+// SetWindowStart sets the "window_start" field.
+func (_c *PaymentReconciliationReportCreate) SetWindowStart(v time.Time) *PaymentReconciliationReportCreate {
+	_c.mutation.SetWindowStart(v)
+	return _c
+}
+
+// SetWindowEnd sets the "window_end" field.
+func (_c *PaymentReconciliationReportCreate) SetWindowEnd(v time.Time) *PaymentReconciliationReportCreate {
+	_c.mutation.SetWindowEnd(v)
+	return _c
+}
+
+// SetOrdersChecked sets the "orders_checked" field.
+func (_c *PaymentReconciliationReportCreate) SetOrdersChecked(v int) *PaymentReconciliationReportCreate {
+	_c.mutation.SetOrdersChecked(v)
+	return _c
+}
+
+// SetNillableOrdersChecked sets the "orders_checked" field if the given value is not nil.
+func (_c *PaymentReconciliationReportCreate) SetNillableOrdersChecked(v *int) *PaymentReconciliationReportCreate {
+	if v != nil {
+		_c.SetOrdersChecked(*v)
+	}
+	return _c
+}
+
+// SetGatewayPayments sets the "gateway_payments" field.
+func (_c *PaymentReconciliationReportCreate) SetGatewayPayments(v int) *PaymentReconciliationReportCreate {
+	_c.mutation.SetGatewayPayments(v)
+	return _c
+}
+
+// SetNillableGatewayPayments sets the "gateway_payments" field if the given value is not nil.
+func (_c *PaymentReconciliationReportCreate) SetNillableGatewayPayments(v *int) *PaymentReconciliationReportCreate {
+	if v != nil {
+		_c.SetGatewayPayments(*v)
+	}
+	return _c
+}
+
+// SetMatched sets the "matched" field.
+func (_c *PaymentReconciliationReportCreate) SetMatched(v int) *PaymentReconciliationReportCreate {
+	_c.mutation.SetMatched(v)
+	return _c
+}
+
+// SetNillableMatched sets the "matched" field if the given value is not nil.
+func (_c *PaymentReconciliationReportCreate) SetNillableMatched(v *int) *PaymentReconciliationReportCreate {
+	if v != nil {
+		_c.SetMatched(*v)
+	}
+	return _c
+}
+
+// SetDiscrepancyCount sets the "discrepancy_count" field.
+func (_c *PaymentReconciliationReportCreate) SetDiscrepancyCount(v int) *PaymentReconciliationReportCreate {
+	_c.mutation.SetDiscrepancyCount(v)
+	return _c
+}
+
+// SetNillableDiscrepancyCount sets the "discrepancy_count" field if the given value is not nil.
+func (_c *PaymentReconciliationReportCreate) SetNillableDiscrepancyCount(v *int) *PaymentReconciliationReportCreate {
+	if v != nil {
+		_c.SetDiscrepancyCount(*v)
+	}
+	return _c
+}
+
+// SetDbPaidAmount sets the "db_paid_amount" field.
+func (_c *PaymentReconciliationReportCreate) SetDbPaidAmount(v int) *PaymentReconciliationReportCreate {
+	_c.mutation.SetDbPaidAmount(v)
+	return _c
+}
+
+// SetNillableDbPaidAmount sets the "db_paid_amount" field if the given value is not nil.
+func (_c *PaymentReconciliationReportCreate) SetNillableDbPaidAmount(v *int) *PaymentReconciliationReportCreate {
+	if v != nil {
+		_c.SetDbPaidAmount(*v)
+	}
+	return _c
+}
+
+// SetGatewayCapturedAmount sets the "gateway_captured_amount" field.
+func (_c *PaymentReconciliationReportCreate) SetGatewayCapturedAmount(v int) *PaymentReconciliationReportCreate {
+	_c.mutation.SetGatewayCapturedAmount(v)
+	return _c
+}
+
+// SetNillableGatewayCapturedAmount sets the "gateway_captured_amount" field if the given value is not nil.
+func (_c *PaymentReconciliationReportCreate) SetNillableGatewayCapturedAmount(v *int) *PaymentReconciliationReportCreate {
+	if v != nil {
+		_c.SetGatewayCapturedAmount(*v)
+	}
+	return _c
+}
+
+// SetGeneratedAt sets the "generated_at" field.
+func (_c *PaymentReconciliationReportCreate) SetGeneratedAt(v time.Time) *PaymentReconciliationReportCreate {
+	_c.mutation.SetGeneratedAt(v)
+	return _c
+}
+
+// SetNillableGeneratedAt sets the "generated_at" field if the given value is not nil.
+func (_c *PaymentReconciliationReportCreate) SetNillableGeneratedAt(v *time.Time) *PaymentReconciliationReportCreate {
+	if v != nil {
+		_c.SetGeneratedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PaymentReconciliationReportCreate) SetID(v string) *PaymentReconciliationReportCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// AddDiscrepancyIDs adds the "discrepancies" edge to the PaymentDiscrepancy entity by IDs.
+func (_c *PaymentReconciliationReportCreate) AddDiscrepancyIDs(ids ...string) *PaymentReconciliationReportCreate {
+	_c.mutation.AddDiscrepancyIDs(ids...)
+	return _c
+}
+
+// AddDiscrepancies adds the "discrepancies" edges to the PaymentDiscrepancy entity.
+func (_c *PaymentReconciliationReportCreate) AddDiscrepancies(v ...*PaymentDiscrepancy) *PaymentReconciliationReportCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddDiscrepancyIDs(ids...)
+}
+
+// Mutation returns the PaymentReconciliationReportMutation object of the builder.
+func (_c *PaymentReconciliationReportCreate) Mutation() *PaymentReconciliationReportMutation {
+	return _c.mutation
+}
+
+// Save creates the PaymentReconciliationReport in the database.
+func (_c *PaymentReconciliationReportCreate) Save(ctx context.Context) (*PaymentReconciliationReport, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PaymentReconciliationReportCreate) SaveX(ctx context.Context) *PaymentReconciliationReport {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PaymentReconciliationReportCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PaymentReconciliationReportCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PaymentReconciliationReportCreate) defaults() {
+	if _, ok := _c.mutation.OrdersChecked(); !ok {
+		v := paymentreconciliationreport.DefaultOrdersChecked
+		_c.mutation.SetOrdersChecked(v)
+	}
+	if _, ok := _c.mutation.GatewayPayments(); !ok {
+		v := paymentreconciliationreport.DefaultGatewayPayments
+		_c.mutation.SetGatewayPayments(v)
+	}
+	if _, ok := _c.mutation.Matched(); !ok {
+		v := paymentreconciliationreport.DefaultMatched
+		_c.mutation.SetMatched(v)
+	}
+	if _, ok := _c.mutation.DiscrepancyCount(); !ok {
+		v := paymentreconciliationreport.DefaultDiscrepancyCount
+		_c.mutation.SetDiscrepancyCount(v)
+	}
+	if _, ok := _c.mutation.DbPaidAmount(); !ok {
+		v := paymentreconciliationreport.DefaultDbPaidAmount
+		_c.mutation.SetDbPaidAmount(v)
+	}
+	if _, ok := _c.mutation.GatewayCapturedAmount(); !ok {
+		v := paymentreconciliationreport.DefaultGatewayCapturedAmount
+		_c.mutation.SetGatewayCapturedAmount(v)
+	}
+	if _, ok := _c.mutation.GeneratedAt(); !ok {
+		v := paymentreconciliationreport.DefaultGeneratedAt()
+		_c.mutation.SetGeneratedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PaymentReconciliationReportCreate) check() error {
+	if _, ok := _c.mutation.ReportDate(); !ok {
+		return &ValidationError{Name: "report_date", err: errors.New(`generated: missing required field "PaymentReconciliationReport.report_date"`)}
+	}
+	if v, ok := _c.mutation.ReportDate(); ok {
+		if err := paymentreconciliationreport.ReportDateValidator(v); err != nil {
+			return &ValidationError{Name: "report_date", err: fmt.Errorf(`generated: validator failed for field "PaymentReconciliationReport.report_date": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.WindowStart(); !ok {
+		return &ValidationError{Name: "window_start", err: errors.New(`generated: missing required field "PaymentReconciliationReport.window_start"`)}
+	}
+	if _, ok := _c.mutation.WindowEnd(); !ok {
+		return &ValidationError{Name: "window_end", err: errors.New(`generated: missing required field "PaymentReconciliationReport.window_end"`)}
+	}
+	if _, ok := _c.mutation.OrdersChecked(); !ok {
+		return &ValidationError{Name: "orders_checked", err: errors.New(`generated: missing required field "PaymentReconciliationReport.orders_checked"`)}
+	}
+	if _, ok := _c.mutation.GatewayPayments(); !ok {
+		return &ValidationError{Name: "gateway_payments", err: errors.New(`generated: missing required field "PaymentReconciliationReport.gateway_payments"`)}
+	}
+	if _, ok := _c.mutation.Matched(); !ok {
+		return &ValidationError{Name: "matched", err: errors.New(`generated: missing required field "PaymentReconciliationReport.matched"`)}
+	}
+	if _, ok := _c.mutation.DiscrepancyCount(); !ok {
+		return &ValidationError{Name: "discrepancy_count", err: errors.New(`generated: missing required field "PaymentReconciliationReport.discrepancy_count"`)}
+	}
+	if _, ok := _c.mutation.DbPaidAmount(); !ok {
+		return &ValidationError{Name: "db_paid_amount", err: errors.New(`generated: missing required field "PaymentReconciliationReport.db_paid_amount"`)}
+	}
+	if _, ok := _c.mutation.GatewayCapturedAmount(); !ok {
+		return &ValidationError{Name: "gateway_captured_amount", err: errors.New(`generated: missing required field "PaymentReconciliationReport.gateway_captured_amount"`)}
+	}
+	if _, ok := _c.mutation.GeneratedAt(); !ok {
+		return &ValidationError{Name: "generated_at", err: errors.New(`generated: missing required field "PaymentReconciliationReport.generated_at"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := paymentreconciliationreport.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`generated: validator failed for field "PaymentReconciliationReport.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *PaymentReconciliationReportCreate) sqlSave(ctx context.Context) (*PaymentReconciliationReport, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected PaymentReconciliationReport.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PaymentReconciliationReportCreate) createSpec() (*PaymentReconciliationReport, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PaymentReconciliationReport{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(paymentreconciliationreport.Table, sqlgraph.NewFieldSpec(paymentreconciliationreport.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.ReportDate(); ok {
+		_spec.SetField(paymentreconciliationreport.FieldReportDate, field.TypeString, value)
+		_node.ReportDate = value
+	}
+	if value, ok := _c.mutation.WindowStart(); ok {
+		_spec.SetField(paymentreconciliationreport.FieldWindowStart, field.TypeTime, value)
+		_node.WindowStart = value
+	}
+	if value, ok := _c.mutation.WindowEnd(); ok {
+		_spec.SetField(paymentreconciliationreport.FieldWindowEnd, field.TypeTime, value)
+		_node.WindowEnd = value
+	}
+	if value, ok := _c.mutation.OrdersChecked(); ok {
+		_spec.SetField(paymentreconciliationreport.FieldOrdersChecked, field.TypeInt, value)
+		_node.OrdersChecked = value
+	}
+	if value, ok := _c.mutation.GatewayPayments(); ok {
+		_spec.SetField(paymentreconciliationreport.FieldGatewayPayments, field.TypeInt, value)
+		_node.GatewayPayments = value
+	}
+	if value, ok := _c.mutation.Matched(); ok {
+		_spec.SetField(paymentreconciliationreport.FieldMatched, field.TypeInt, value)
+		_node.Matched = value
+	}
+	if value, ok := _c.mutation.DiscrepancyCount(); ok {
+		_spec.SetField(paymentreconciliationreport.FieldDiscrepancyCount, field.TypeInt, value)
+		_node.DiscrepancyCount = value
+	}
+	if value, ok := _c.mutation.DbPaidAmount(); ok {
+		_spec.SetField(paymentreconciliationreport.FieldDbPaidAmount, field.TypeInt, value)
+		_node.DbPaidAmount = value
+	}
+	if value, ok := _c.mutation.GatewayCapturedAmount(); ok {
+		_spec.SetField(paymentreconciliationreport.FieldGatewayCapturedAmount, field.TypeInt, value)
+		_node.GatewayCapturedAmount = value
+	}
+	if value, ok := _c.mutation.GeneratedAt(); ok {
+		_spec.SetField(paymentreconciliationreport.FieldGeneratedAt, field.TypeTime, value)
+		_node.GeneratedAt = value
+	}
+	if nodes := _c.mutation.DiscrepanciesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentreconciliationreport.DiscrepanciesTable,
+			Columns: []string{paymentreconciliationreport.DiscrepanciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentdiscrepancy.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PaymentReconciliationReport.Create().
+//		SetReportDate(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PaymentReconciliationReportUpsert) {
+//			SetReportDate(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PaymentReconciliationReportCreate) OnConflict(opts ...sql.ConflictOption) *PaymentReconciliationReportUpsertOne {
+	_c.conflict = opts
+	return &PaymentReconciliationReportUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PaymentReconciliationReport.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PaymentReconciliationReportCreate) OnConflictColumns(columns ...string) *PaymentReconciliationReportUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PaymentReconciliationReportUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// PaymentReconciliationReportUpsertOne is the builder for "upsert"-ing
+	//  one PaymentReconciliationReport node.
+	PaymentReconciliationReportUpsertOne struct {
+		create *PaymentReconciliationReportCreate
+	}
+
+	// PaymentReconciliationReportUpsert is the "OnConflict" setter.
+	PaymentReconciliationReportUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetReportDate sets the "report_date" field.
+func (u *PaymentReconciliationReportUpsert) SetReportDate(v string) *PaymentReconciliationReportUpsert {
+	u.Set(paymentreconciliationreport.FieldReportDate, v)
+	return u
+}
+
+// UpdateReportDate sets the "report_date" field to the value that was provided on create.
+func (u *PaymentReconciliationReportUpsert) UpdateReportDate() *PaymentReconciliationReportUpsert {
+	u.SetExcluded(paymentreconciliationreport.FieldReportDate)
+	return u
+}
+
+// SetWindowStart sets the "window_start" field.
+func (u *PaymentReconciliationReportUpsert) SetWindowStart(v time.Time) *PaymentReconciliationReportUpsert {
+	u.Set(paymentreconciliationreport.FieldWindowStart, v)
+	return u
+}
+
+// UpdateWindowStart sets the "window_start" field to the value that was provided on create.
+func (u *PaymentReconciliationReportUpsert) UpdateWindowStart() *PaymentReconciliationReportUpsert {
+	u.SetExcluded(paymentreconciliationreport.FieldWindowStart)
+	return u
+}
+
+// SetWindowEnd sets the "window_end" field.
+func (u *PaymentReconciliationReportUpsert) SetWindowEnd(v time.Time) *PaymentReconciliationReportUpsert {
+	u.Set(paymentreconciliationreport.FieldWindowEnd, v)
+	return u
+}
+
+// UpdateWindowEnd sets the "window_end" field to the value that was provided on create.
+func (u *PaymentReconciliationReportUpsert) UpdateWindowEnd() *PaymentReconciliationReportUpsert {
+	u.SetExcluded(paymentreconciliationreport.FieldWindowEnd)
+	return u
+}
+
+// SetOrdersChecked sets the "orders_checked" field.
+func (u *PaymentReconciliationReportUpsert) SetOrdersChecked(v int) *PaymentReconciliationReportUpsert {
+	u.Set(paymentreconciliationreport.FieldOrdersChecked, v)
+	return u
+}
+
+// UpdateOrdersChecked sets the "orders_checked" field to the value that was provided on create.
+func (u *PaymentReconciliationReportUpsert) UpdateOrdersChecked() *PaymentReconciliationReportUpsert {
+	u.SetExcluded(paymentreconciliationreport.FieldOrdersChecked)
+	return u
+}
+
+// AddOrdersChecked adds v to the "orders_checked" field.
+func (u *PaymentReconciliationReportUpsert) AddOrdersChecked(v int) *PaymentReconciliationReportUpsert {
+	u.Add(paymentreconciliationreport.FieldOrdersChecked, v)
+	return u
+}
+
+// SetGatewayPayments sets the "gateway_payments" field.
+func (u *PaymentReconciliationReportUpsert) SetGatewayPayments(v int) *PaymentReconciliationReportUpsert {
+	u.Set(paymentreconciliationreport.FieldGatewayPayments, v)
+	return u
+}
+
+// UpdateGatewayPayments sets the "gateway_payments" field to the value that was provided on create.
+func (u *PaymentReconciliationReportUpsert) UpdateGatewayPayments() *PaymentReconciliationReportUpsert {
+	u.SetExcluded(paymentreconciliationreport.FieldGatewayPayments)
+	return u
+}
+
+// AddGatewayPayments adds v to the "gateway_payments" field.
+func (u *PaymentReconciliationReportUpsert) AddGatewayPayments(v int) *PaymentReconciliationReportUpsert {
+	u.Add(paymentreconciliationreport.FieldGatewayPayments, v)
+	return u
+}
+
+// SetMatched sets the "matched" field.
+func (u *PaymentReconciliationReportUpsert) SetMatched(v int) *PaymentReconciliationReportUpsert {
+	u.Set(paymentreconciliationreport.FieldMatched, v)
+	return u
+}
+
+// UpdateMatched sets the "matched" field to the value that was provided on create.
+func (u *PaymentReconciliationReportUpsert) UpdateMatched() *PaymentReconciliationReportUpsert {
+	u.SetExcluded(paymentreconciliationreport.FieldMatched)
+	return u
+}
+
+// AddMatched adds v to the "matched" field.
+func (u *PaymentReconciliationReportUpsert) AddMatched(v int) *PaymentReconciliationReportUpsert {
+	u.Add(paymentreconciliationreport.FieldMatched, v)
+	return u
+}
+
+// SetDiscrepancyCount sets the "discrepancy_count" field.
+func (u *PaymentReconciliationReportUpsert) SetDiscrepancyCount(v int) *PaymentReconciliationReportUpsert {
+	u.Set(paymentreconciliationreport.FieldDiscrepancyCount, v)
+	return u
+}
+
+// UpdateDiscrepancyCount sets the "discrepancy_count" field to the value that was provided on create.
+func (u *PaymentReconciliationReportUpsert) UpdateDiscrepancyCount() *PaymentReconciliationReportUpsert {
+	u.SetExcluded(paymentreconciliationreport.FieldDiscrepancyCount)
+	return u
+}
+
+// AddDiscrepancyCount adds v to the "discrepancy_count" field.
+func (u *PaymentReconciliationReportUpsert) AddDiscrepancyCount(v int) *PaymentReconciliationReportUpsert {
+	u.Add(paymentreconciliationreport.FieldDiscrepancyCount, v)
+	return u
+}
+
+// SetDbPaidAmount sets the "db_paid_amount" field.
+func (u *PaymentReconciliationReportUpsert) SetDbPaidAmount(v int) *PaymentReconciliationReportUpsert {
+	u.Set(paymentreconciliationreport.FieldDbPaidAmount, v)
+	return u
+}
+
+// UpdateDbPaidAmount sets the "db_paid_amount" field to the value that was provided on create.
+func (u *PaymentReconciliationReportUpsert) UpdateDbPaidAmount() *PaymentReconciliationReportUpsert {
+	u.SetExcluded(paymentreconciliationreport.FieldDbPaidAmount)
+	return u
+}
+
+// AddDbPaidAmount adds v to the "db_paid_amount" field.
+func (u *PaymentReconciliationReportUpsert) AddDbPaidAmount(v int) *PaymentReconciliationReportUpsert {
+	u.Add(paymentreconciliationreport.FieldDbPaidAmount, v)
+	return u
+}
+
+// SetGatewayCapturedAmount sets the "gateway_captured_amount" field.
+func (u *PaymentReconciliationReportUpsert) SetGatewayCapturedAmount(v int) *PaymentReconciliationReportUpsert {
+	u.Set(paymentreconciliationreport.FieldGatewayCapturedAmount, v)
+	return u
+}
+
+// UpdateGatewayCapturedAmount sets the "gateway_captured_amount" field to the value that was provided on create.
+func (u *PaymentReconciliationReportUpsert) UpdateGatewayCapturedAmount() *PaymentReconciliationReportUpsert {
+	u.SetExcluded(paymentreconciliationreport.FieldGatewayCapturedAmount)
+	return u
+}
+
+// AddGatewayCapturedAmount adds v to the "gateway_captured_amount" field.
+func (u *PaymentReconciliationReportUpsert) AddGatewayCapturedAmount(v int) *PaymentReconciliationReportUpsert {
+	u.Add(paymentreconciliationreport.FieldGatewayCapturedAmount, v)
+	return u
+}
+
+// SetGeneratedAt sets the "generated_at" field.
+func (u *PaymentReconciliationReportUpsert) SetGeneratedAt(v time.Time) *PaymentReconciliationReportUpsert {
+	u.Set(paymentreconciliationreport.FieldGeneratedAt, v)
+	return u
+}
+
+// UpdateGeneratedAt sets the "generated_at" field to the value that was provided on create.
+func (u *PaymentReconciliationReportUpsert) UpdateGeneratedAt() *PaymentReconciliationReportUpsert {
+	u.SetExcluded(paymentreconciliationreport.FieldGeneratedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.PaymentReconciliationReport.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(paymentreconciliationreport.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PaymentReconciliationReportUpsertOne) UpdateNewValues() *PaymentReconciliationReportUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(paymentreconciliationreport.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PaymentReconciliationReport.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PaymentReconciliationReportUpsertOne) Ignore() *PaymentReconciliationReportUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PaymentReconciliationReportUpsertOne) DoNothing() *PaymentReconciliationReportUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PaymentReconciliationReportCreate.OnConflict
+// documentation for more info.
+func (u *PaymentReconciliationReportUpsertOne) Update(set func(*PaymentReconciliationReportUpsert)) *PaymentReconciliationReportUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PaymentReconciliationReportUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetReportDate sets the "report_date" field.
+func (u *PaymentReconciliationReportUpsertOne) SetReportDate(v string) *PaymentReconciliationReportUpsertOne {
+	return u.Update(func(s *PaymentReconciliationReportUpsert) {
+		s.SetReportDate(v)
+	})
+}
+
+// UpdateReportDate sets the "report_date" field to the value that was provided on create.
+func (u *PaymentReconciliationReportUpsertOne) UpdateReportDate() *PaymentReconciliationReportUpsertOne {
+	return u.Update(func(s *PaymentReconciliationReportUpsert) {
+		s.UpdateReportDate()
+	})
+}
+
+// SetWindowStart sets the "window_start" field.
+func (u *PaymentReconciliationReportUpsertOne) SetWindowStart(v time.Time) *PaymentReconciliationReportUpsertOne {
+	return u.Update(func(s *PaymentReconciliationReportUpsert) {
+		s.SetWindowStart(v)
+	})
+}
+
+// UpdateWindowStart sets the "window_start" field to the value that was provided on create.
+func (u *PaymentReconciliationReportUpsertOne) UpdateWindowStart() *PaymentReconciliationReportUpsertOne {
+	return u.Update(func(s *PaymentReconciliationReportUpsert) {
+		s.UpdateWindowStart()
+	})
+}
+
+// SetWindowEnd sets the "window_end" field.
+func (u *PaymentReconciliationReportUpsertOne) SetWindowEnd(v time.Time) *PaymentReconciliationReportUpsertOne {
+	return u.Update(func(s *PaymentReconciliationReportUpsert) {
+		s.SetWindowEnd(v)
+	})
+}
+
+// UpdateWindowEnd sets the "window_end" field to the value that was provided on create.
+func (u *PaymentReconciliationReportUpsertOne) UpdateWindowEnd() *PaymentReconciliationReportUpsertOne {
+	return u.Update(func(s *PaymentReconciliationReportUpsert) {
+		s.UpdateWindowEnd()
+	})
+}
+
+// SetOrdersChecked sets the "orders_checked" field.
+func (u *PaymentReconciliationReportUpsertOne) SetOrdersChecked(v int) *PaymentReconciliationReportUpsertOne {
+	return u.Update(func(s *PaymentReconciliationReportUpsert) {
+		s.SetOrdersChecked(v)
+	})
+}
+
+// AddOrdersChecked adds v to the "orders_checked" field.
+func (u *PaymentReconciliationReportUpsertOne) AddOrdersChecked(v int) *PaymentReconciliationReportUpsertOne {
+	return u.Update(func(s *PaymentReconciliationReportUpsert) {
+		s.AddOrdersChecked(v)
+	})
+}
+
+// UpdateOrdersChecked sets the "orders_checked" field to the value that was provided on create.
+func (u *PaymentReconciliationReportUpsertOne) UpdateOrdersChecked() *PaymentReconciliationReportUpsertOne {
+	return u.Update(func(s *PaymentReconciliationReportUpsert) {
+		s.UpdateOrdersChecked()
+	})
+}
+
+// SetGatewayPayments sets the "gateway_payments" field.
+func (u *PaymentReconciliationReportUpsertOne) SetGatewayPayments(v int) *PaymentReconciliationReportUpsertOne {
+	return u.Update(func(s *PaymentReconciliationReportUpsert) {
+		s.SetGatewayPayments(v)
+	})
+}
+
+// AddGatewayPayments adds v to the "gateway_payments" field.
+func (u *PaymentReconciliationReportUpsertOne) AddGatewayPayments(v int) *PaymentReconciliationReportUpsertOne {
+	return u.Update(func(s *PaymentReconciliationReportUpsert) {
+		s.AddGatewayPayments(v)
+	})
+}
+
+// UpdateGatewayPayments sets the "gateway_payments" field to the value that was provided on create.
+func (u *PaymentReconciliationReportUpsertOne) UpdateGatewayPayments() *PaymentReconciliationReportUpsertOne {
+	return u.Update(func(s *PaymentReconciliationReportUpsert) {
+		s.UpdateGatewayPayments()
+	})
+}
+
+// SetMatched sets the "matched" field.
+func (u *PaymentReconciliationReportUpsertOne) SetMatched(v int) *PaymentReconciliationReportUpsertOne {
+	return u.Update(func(s *PaymentReconciliationReportUpsert) {
+		s.SetMatched(v)
+	})
+}
+
+// AddMatched adds v to the "matched" field.
+func (u *PaymentReconciliationReportUpsertOne) AddMatched(v int) *PaymentReconciliationReportUpsertOne {
+	return u.Update(func(s *PaymentReconciliationReportUpsert) {
+		s.AddMatched(v)
+	})
+}
+
+// UpdateMatched sets the "matched" field to the value that was provided on create.
+func (u *PaymentReconciliationReportUpsertOne) UpdateMatched() *PaymentReconciliationReportUpsertOne {
+	return u.Update(func(s *PaymentReconciliationReportUpsert) {
+		s.UpdateMatched()
+	})
+}
+
+// SetDiscrepancyCount sets the "discrepancy_count" field.
+func (u *PaymentReconciliationReportUpsertOne) SetDiscrepancyCount(v int) *PaymentReconciliationReportUpsertOne {
+	return u.Update(func(s *PaymentReconciliationReportUpsert) {
+		s.SetDiscrepancyCount(v)
+	})
+}
+
+// AddDiscrepancyCount adds v to the "discrepancy_count" field.
+func (u *PaymentReconciliationReportUpsertOne) AddDiscrepancyCount(v int) *PaymentReconciliationReportUpsertOne {
+	return u.Update(func(s *PaymentReconciliationReportUpsert) {
+		s.AddDiscrepancyCount(v)
+	})
+}
+
+// UpdateDiscrepancyCount sets the "discrepancy_count" field to the value that was provided on create.
+func (u *PaymentReconciliationReportUpsertOne) UpdateDiscrepancyCount() *PaymentReconciliationReportUpsertOne {
+	return u.Update(func(s *PaymentReconciliationReportUpsert) {
+		s.UpdateDiscrepancyCount()
+	})
+}
+
+// SetDbPaidAmount sets the "db_paid_amount" field.
+func (u *PaymentReconciliationReportUpsertOne) SetDbPaidAmount(v int) *PaymentReconciliationReportUpsertOne {
+	return u.Update(func(s *PaymentReconciliationReportUpsert) {
+		s.SetDbPaidAmount(v)
+	})
+}
+
+// AddDbPaidAmount adds v to the "db_paid_amount" field.
+func (u *PaymentReconciliationReportUpsertOne) AddDbPaidAmount(v int) *PaymentReconciliationReportUpsertOne {
+	return u.Update(func(s *PaymentReconciliationReportUpsert) {
+		s.AddDbPaidAmount(v)
+	})
+}
+
+// UpdateDbPaidAmount sets the "db_paid_amount" field to the value that was provided on create.
+func (u *PaymentReconciliationReportUpsertOne) UpdateDbPaidAmount() *PaymentReconciliationReportUpsertOne {
+	return u.Update(func(s *PaymentReconciliationReportUpsert) {
+		s.UpdateDbPaidAmount()
+	})
+}
+
+// SetGatewayCapturedAmount sets the "gateway_captured_amount" field.
+func (u *PaymentReconciliationReportUpsertOne) SetGatewayCapturedAmount(v int) *PaymentReconciliationReportUpsertOne {
+	return u.Update(func(s *PaymentReconciliationReportUpsert) {
+		s.SetGatewayCapturedAmount(v)
+	})
+}
+
+// AddGatewayCapturedAmount adds v to the "gateway_captured_amount" field.
+func (u *PaymentReconciliationReportUpsertOne) AddGatewayCapturedAmount(v int) *PaymentReconciliationReportUpsertOne {
+	return u.Update(func(s *PaymentReconciliationReportUpsert) {
+		s.AddGatewayCapturedAmount(v)
+	})
+}
+
+// UpdateGatewayCapturedAmount sets the "gateway_captured_amount" field to the value that was provided on create.
+func (u *PaymentReconciliationReportUpsertOne) UpdateGatewayCapturedAmount() *PaymentReconciliationReportUpsertOne {
+	return u.Update(func(s *PaymentReconciliationReportUpsert) {
+		s.UpdateGatewayCapturedAmount()
+	})
+}
+
+// SetGeneratedAt sets the "generated_at" field.
+func (u *PaymentReconciliationReportUpsertOne) SetGeneratedAt(v time.Time) *PaymentReconciliationReportUpsertOne {
+	return u.Update(func(s *PaymentReconciliationReportUpsert) {
+		s.SetGeneratedAt(v)
+	})
+}
+
+// UpdateGeneratedAt sets the "generated_at" field to the value that was provided on create.
+func (u *PaymentReconciliationReportUpsertOne) UpdateGeneratedAt() *PaymentReconciliationReportUpsertOne {
+	return u.Update(func(s *PaymentReconciliationReportUpsert) {
+		s.UpdateGeneratedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PaymentReconciliationReportUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("generated: missing options for PaymentReconciliationReportCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PaymentReconciliationReportUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PaymentReconciliationReportUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("generated: PaymentReconciliationReportUpsertOne.ID is not supported by MySQL driver. Use PaymentReconciliationReportUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PaymentReconciliationReportUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PaymentReconciliationReportCreateBulk is the builder for creating many PaymentReconciliationReport entities in bulk.
+type PaymentReconciliationReportCreateBulk struct {
+	config
+	err      error
+	builders []*PaymentReconciliationReportCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the PaymentReconciliationReport entities in the database.
+func (_c *PaymentReconciliationReportCreateBulk) Save(ctx context.Context) ([]*PaymentReconciliationReport, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PaymentReconciliationReport, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PaymentReconciliationReportMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PaymentReconciliationReportCreateBulk) SaveX(ctx context.Context) []*PaymentReconciliationReport {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PaymentReconciliationReportCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PaymentReconciliationReportCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PaymentReconciliationReport.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PaymentReconciliationReportUpsert) {
+//			SetReportDate(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PaymentReconciliationReportCreateBulk) OnConflict(opts ...sql.ConflictOption) *PaymentReconciliationReportUpsertBulk {
+	_c.conflict = opts
+	return &PaymentReconciliationReportUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PaymentReconciliationReport.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PaymentReconciliationReportCreateBulk) OnConflictColumns(columns ...string) *PaymentReconciliationReportUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PaymentReconciliationReportUpsertBulk{
+		create: _c,
+	}
+}
+
+// PaymentReconciliationReportUpsertBulk is the builder for "upsert"-ing
+// a bulk of PaymentReconciliationReport nodes.
+type PaymentReconciliationReportUpsertBulk struct {
+	create *PaymentReconciliationReportCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PaymentReconciliationReport.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(paymentreconciliationreport.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PaymentReconciliationReportUpsertBulk) UpdateNewValues() *PaymentReconciliationReportUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(paymentreconciliationreport.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PaymentReconciliationReport.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PaymentReconciliationReportUpsertBulk) Ignore() *PaymentReconciliationReportUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PaymentReconciliationReportUpsertBulk) DoNothing() *PaymentReconciliationReportUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PaymentReconciliationReportCreateBulk.OnConflict
+// documentation for more info.
+func (u *PaymentReconciliationReportUpsertBulk) Update(set func(*PaymentReconciliationReportUpsert)) *PaymentReconciliationReportUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PaymentReconciliationReportUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetReportDate sets the "report_date" field.
+func (u *PaymentReconciliationReportUpsertBulk) SetReportDate(v string) *PaymentReconciliationReportUpsertBulk {
+	return u.Update(func(s *PaymentReconciliationReportUpsert) {
+		s.SetReportDate(v)
+	})
+}
+
+// UpdateReportDate sets the "report_date" field to the value that was provided on create.
+func (u *PaymentReconciliationReportUpsertBulk) UpdateReportDate() *PaymentReconciliationReportUpsertBulk {
+	return u.Update(func(s *PaymentReconciliationReportUpsert) {
+		s.UpdateReportDate()
+	})
+}
+
+// SetWindowStart sets the "window_start" field.
+func (u *PaymentReconciliationReportUpsertBulk) SetWindowStart(v time.Time) *PaymentReconciliationReportUpsertBulk {
+	return u.Update(func(s *PaymentReconciliationReportUpsert) {
+		s.SetWindowStart(v)
+	})
+}
+
+// UpdateWindowStart sets the "window_start" field to the value that was provided on create.
+func (u *PaymentReconciliationReportUpsertBulk) UpdateWindowStart() *PaymentReconciliationReportUpsertBulk {
+	return u.Update(func(s *PaymentReconciliationReportUpsert) {
+		s.UpdateWindowStart()
+	})
+}
+
+// SetWindowEnd sets the "window_end" field.
+func (u *PaymentReconciliationReportUpsertBulk) SetWindowEnd(v time.Time) *PaymentReconciliationReportUpsertBulk {
+	return u.Update(func(s *PaymentReconciliationReportUpsert) {
+		s.SetWindowEnd(v)
+	})
+}
+
+// UpdateWindowEnd sets the "window_end" field to the value that was provided on create.
+func (u *PaymentReconciliationReportUpsertBulk) UpdateWindowEnd() *PaymentReconciliationReportUpsertBulk {
+	return u.Update(func(s *PaymentReconciliationReportUpsert) {
+		s.UpdateWindowEnd()
+	})
+}
+
+// SetOrdersChecked sets the "orders_checked" field.
+func (u *PaymentReconciliationReportUpsertBulk) SetOrdersChecked(v int) *PaymentReconciliationReportUpsertBulk {
+	return u.Update(func(s *PaymentReconciliationReportUpsert) {
+		s.SetOrdersChecked(v)
+	})
+}
+
+// AddOrdersChecked adds v to the "orders_checked" field.
+func (u *PaymentReconciliationReportUpsertBulk) AddOrdersChecked(v int) *PaymentReconciliationReportUpsertBulk {
+	return u.Update(func(s *PaymentReconciliationReportUpsert) {
+		s.AddOrdersChecked(v)
+	})
+}
+
+// UpdateOrdersChecked sets the "orders_checked" field to the value that was provided on create.
+func (u *PaymentReconciliationReportUpsertBulk) UpdateOrdersChecked() *PaymentReconciliationReportUpsertBulk {
+	return u.Update(func(s *PaymentReconciliationReportUpsert) {
+		s.UpdateOrdersChecked()
+	})
+}
+
+// SetGatewayPayments sets the "gateway_payments" field.
+func (u *PaymentReconciliationReportUpsertBulk) SetGatewayPayments(v int) *PaymentReconciliationReportUpsertBulk {
+	return u.Update(func(s *PaymentReconciliationReportUpsert) {
+		s.SetGatewayPayments(v)
+	})
+}
+
+// AddGatewayPayments adds v to the "gateway_payments" field.
+func (u *PaymentReconciliationReportUpsertBulk) AddGatewayPayments(v int) *PaymentReconciliationReportUpsertBulk {
+	return u.Update(func(s *PaymentReconciliationReportUpsert) {
+		s.AddGatewayPayments(v)
+	})
+}
+
+// UpdateGatewayPayments sets the "gateway_payments" field to the value that was provided on create.
+func (u *PaymentReconciliationReportUpsertBulk) UpdateGatewayPayments() *PaymentReconciliationReportUpsertBulk {
+	return u.Update(func(s *PaymentReconciliationReportUpsert) {
+		s.UpdateGatewayPayments()
+	})
+}
+
+// SetMatched sets the "matched" field.
+func (u *PaymentReconciliationReportUpsertBulk) SetMatched(v int) *PaymentReconciliationReportUpsertBulk {
+	return u.Update(func(s *PaymentReconciliationReportUpsert) {
+		s.SetMatched(v)
+	})
+}
+
+// AddMatched adds v to the "matched" field.
+func (u *PaymentReconciliationReportUpsertBulk) AddMatched(v int) *PaymentReconciliationReportUpsertBulk {
+	return u.Update(func(s *PaymentReconciliationReportUpsert) {
+		s.AddMatched(v)
+	})
+}
+
+// UpdateMatched sets the "matched" field to the value that was provided on create.
+func (u *PaymentReconciliationReportUpsertBulk) UpdateMatched() *PaymentReconciliationReportUpsertBulk {
+	return u.Update(func(s *PaymentReconciliationReportUpsert) {
+		s.UpdateMatched()
+	})
+}
+
+// SetDiscrepancyCount sets the "discrepancy_count" field.
+func (u *PaymentReconciliationReportUpsertBulk) SetDiscrepancyCount(v int) *PaymentReconciliationReportUpsertBulk {
+	return u.Update(func(s *PaymentReconciliationReportUpsert) {
+		s.SetDiscrepancyCount(v)
+	})
+}
+
+// AddDiscrepancyCount adds v to the "discrepancy_count" field.
+func (u *PaymentReconciliationReportUpsertBulk) AddDiscrepancyCount(v int) *PaymentReconciliationReportUpsertBulk {
+	return u.Update(func(s *PaymentReconciliationReportUpsert) {
+		s.AddDiscrepancyCount(v)
+	})
+}
+
+// UpdateDiscrepancyCount sets the "discrepancy_count" field to the value that was provided on create.
+func (u *PaymentReconciliationReportUpsertBulk) UpdateDiscrepancyCount() *PaymentReconciliationReportUpsertBulk {
+	return u.Update(func(s *PaymentReconciliationReportUpsert) {
+		s.UpdateDiscrepancyCount()
+	})
+}
+
+// SetDbPaidAmount sets the "db_paid_amount" field.
+func (u *PaymentReconciliationReportUpsertBulk) SetDbPaidAmount(v int) *PaymentReconciliationReportUpsertBulk {
+	return u.Update(func(s *PaymentReconciliationReportUpsert) {
+		s.SetDbPaidAmount(v)
+	})
+}
+
+// AddDbPaidAmount adds v to the "db_paid_amount" field.
+func (u *PaymentReconciliationReportUpsertBulk) AddDbPaidAmount(v int) *PaymentReconciliationReportUpsertBulk {
+	return u.Update(func(s *PaymentReconciliationReportUpsert) {
+		s.AddDbPaidAmount(v)
+	})
+}
+
+// UpdateDbPaidAmount sets the "db_paid_amount" field to the value that was provided on create.
+func (u *PaymentReconciliationReportUpsertBulk) UpdateDbPaidAmount() *PaymentReconciliationReportUpsertBulk {
+	return u.Update(func(s *PaymentReconciliationReportUpsert) {
+		s.UpdateDbPaidAmount()
+	})
+}
+
+// SetGatewayCapturedAmount sets the "gateway_captured_amount" field.
+func (u *PaymentReconciliationReportUpsertBulk) SetGatewayCapturedAmount(v int) *PaymentReconciliationReportUpsertBulk {
+	return u.Update(func(s *PaymentReconciliationReportUpsert) {
+		s.SetGatewayCapturedAmount(v)
+	})
+}
+
+// AddGatewayCapturedAmount adds v to the "gateway_captured_amount" field.
+func (u *PaymentReconciliationReportUpsertBulk) AddGatewayCapturedAmount(v int) *PaymentReconciliationReportUpsertBulk {
+	return u.Update(func(s *PaymentReconciliationReportUpsert) {
+		s.AddGatewayCapturedAmount(v)
+	})
+}
+
+// UpdateGatewayCapturedAmount sets the "gateway_captured_amount" field to the value that was provided on create.
+func (u *PaymentReconciliationReportUpsertBulk) UpdateGatewayCapturedAmount() *PaymentReconciliationReportUpsertBulk {
+	return u.Update(func(s *PaymentReconciliationReportUpsert) {
+		s.UpdateGatewayCapturedAmount()
+	})
+}
+
+// SetGeneratedAt sets the "generated_at" field.
+func (u *PaymentReconciliationReportUpsertBulk) SetGeneratedAt(v time.Time) *PaymentReconciliationReportUpsertBulk {
+	return u.Update(func(s *PaymentReconciliationReportUpsert) {
+		s.SetGeneratedAt(v)
+	})
+}
+
+// UpdateGeneratedAt sets the "generated_at" field to the value that was provided on create.
+func (u *PaymentReconciliationReportUpsertBulk) UpdateGeneratedAt() *PaymentReconciliationReportUpsertBulk {
+	return u.Update(func(s *PaymentReconciliationReportUpsert) {
+		s.UpdateGeneratedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PaymentReconciliationReportUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("generated: OnConflict was set for builder %d. Set it on the PaymentReconciliationReportCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("generated: missing options for PaymentReconciliationReportCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PaymentReconciliationReportUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/UnoraApp/be/ent/generated/paymentreconciliationreport"
+	"github.com/UnoraApp/be/ent/generated/predicate"
+)
+
+// PaymentReconciliationReportDelete is the builder for deleting a PaymentReconciliationReport entity.
+type PaymentReconciliationReportDelete struct {
+	config
+	hooks    []Hook
+	mutation *PaymentReconciliationReportMutation
+}
+
+// Where appends a list predicates to the PaymentReconciliationReportDelete builder.
+func (_d *PaymentReconciliationReportDelete) Where(ps ...predicate.PaymentReconciliationReport) *PaymentReconciliationReportDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *PaymentReconciliationReportDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *PaymentReconciliationReportDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *PaymentReconciliationReportDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(paymentreconciliationreport.Table, sqlgraph.NewFieldSpec(paymentreconciliationreport.FieldID, field.TypeString))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// PaymentReconciliationReportDeleteOne is the builder for deleting a single PaymentReconciliationReport entity.
+type PaymentReconciliationReportDeleteOne struct {
+	_d *PaymentReconciliationReportDelete
+}
+
+// Where appends a list predicates to the PaymentReconciliationReportDelete builder.
+func (_d *PaymentReconciliationReportDeleteOne) Where(ps ...predicate.PaymentReconciliationReport) *PaymentReconciliationReportDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *PaymentReconciliationReportDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{paymentreconciliationreport.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *PaymentReconciliationReportDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,645 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/UnoraApp/be/ent/generated/paymentdiscrepancy"
+	"github.com/UnoraApp/be/ent/generated/paymentreconciliationreport"
+	"github.com/UnoraApp/be/ent/generated/predicate"
+)
+
+// PaymentReconciliationReportQuery is the builder for querying PaymentReconciliationReport entities.
+type PaymentReconciliationReportQuery struct {
+	config
+	ctx               *QueryContext
+	order             []paymentreconciliationreport.OrderOption
+	inters            []Interceptor
+	predicates        []predicate.PaymentReconciliationReport
+	withDiscrepancies *PaymentDiscrepancyQuery
+	modifiers         []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the PaymentReconciliationReportQuery builder.
+func (_q *PaymentReconciliationReportQuery) Where(ps ...predicate.PaymentReconciliationReport) *PaymentReconciliationReportQuery {
+	_q.predicates = append(_q.predicates, ps...)
+	return _q
+}
+
+// Limit the number of records to be returned by this query.
+func (_q *PaymentReconciliationReportQuery) Limit(limit int) *PaymentReconciliationReportQuery {
+	_q.ctx.Limit = &limit
+	return _q
+}
+
+// Offset to start from.
+func (_q *PaymentReconciliationReportQuery) Offset(offset int) *PaymentReconciliationReportQuery {
+	_q.ctx.Offset = &offset
+	return _q
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (_q *PaymentReconciliationReportQuery) Unique(unique bool) *PaymentReconciliationReportQuery {
+	_q.ctx.Unique = &unique
+	return _q
+}
+
+// Order specifies how the records should be ordered.
+func (_q *PaymentReconciliationReportQuery) Order(o ...paymentreconciliationreport.OrderOption) *PaymentReconciliationReportQuery {
+	_q.order = append(_q.order, o...)
+	return _q
+}
+
+// QueryDiscrepancies chains the current query on the "discrepancies" edge.
+func (_q *PaymentReconciliationReportQuery) QueryDiscrepancies() *PaymentDiscrepancyQuery {
+	query := (&PaymentDiscrepancyClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(paymentreconciliationreport.Table, paymentreconciliationreport.FieldID, selector),
+			sqlgraph.To(paymentdiscrepancy.Table, paymentdiscrepancy.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, paymentreconciliationreport.DiscrepanciesTable, paymentreconciliationreport.DiscrepanciesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first PaymentReconciliationReport entity from the query.
+// Returns a *NotFoundError when no PaymentReconciliationReport was found.
+func (_q *PaymentReconciliationReportQuery) First(ctx context.Context) (*PaymentReconciliationReport, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{paymentreconciliationreport.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (_q *PaymentReconciliationReportQuery) FirstX(ctx context.Context) *PaymentReconciliationReport {
+	node, err := _q.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first PaymentReconciliationReport ID from the query.
+// Returns a *NotFoundError when no PaymentReconciliationReport ID was found.
+func (_q *PaymentReconciliationReportQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = _q.Limit(1).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{paymentreconciliationreport.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (_q *PaymentReconciliationReportQuery) FirstIDX(ctx context.Context) string {
+	id, err := _q.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single PaymentReconciliationReport entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one PaymentReconciliationReport entity is found.
+// Returns a *NotFoundError when no PaymentReconciliationReport entities are found.
+func (_q *PaymentReconciliationReportQuery) Only(ctx context.Context) (*PaymentReconciliationReport, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{paymentreconciliationreport.Label}
+	default:
+		return nil, &NotSingularError{paymentreconciliationreport.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (_q *PaymentReconciliationReportQuery) OnlyX(ctx context.Context) *PaymentReconciliationReport {
+	node, err := _q.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only PaymentReconciliationReport ID in the query.
+// Returns a *NotSingularError when more than one PaymentReconciliationReport ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (_q *PaymentReconciliationReportQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = _q.Limit(2).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{paymentreconciliationreport.Label}
+	default:
+		err = &NotSingularError{paymentreconciliationreport.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (_q *PaymentReconciliationReportQuery) OnlyIDX(ctx context.Context) string {
+	id, err := _q.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of PaymentReconciliationReports.
+func (_q *PaymentReconciliationReportQuery) All(ctx context.Context) ([]*PaymentReconciliationReport, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*PaymentReconciliationReport, *PaymentReconciliationReportQuery]()
+	return withInterceptors[[]*PaymentReconciliationReport](ctx, _q, qr, _q.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (_q *PaymentReconciliationReportQuery) AllX(ctx context.Context) []*PaymentReconciliationReport {
+	nodes, err := _q.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of PaymentReconciliationReport IDs.
+func (_q *PaymentReconciliationReportQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if _q.ctx.Unique == nil && _q.path != nil {
+		_q.Unique(true)
+	}
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
+	if err = _q.Select(paymentreconciliationreport.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (_q *PaymentReconciliationReportQuery) IDsX(ctx context.Context) []string {
+	ids, err := _q.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (_q *PaymentReconciliationReportQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, _q, querierCount[*PaymentReconciliationReportQuery](), _q.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (_q *PaymentReconciliationReportQuery) CountX(ctx context.Context) int {
+	count, err := _q.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (_q *PaymentReconciliationReportQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
+	switch _, err := _q.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("generated: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (_q *PaymentReconciliationReportQuery) ExistX(ctx context.Context) bool {
+	exist, err := _q.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the PaymentReconciliationReportQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (_q *PaymentReconciliationReportQuery) Clone() *PaymentReconciliationReportQuery {
+	if _q == nil {
+		return nil
+	}
+	return &PaymentReconciliationReportQuery{
+		config:            _q.config,
+		ctx:               _q.ctx.Clone(),
+		order:             append([]paymentreconciliationreport.OrderOption{}, _q.order...),
+		inters:            append([]Interceptor{}, _q.inters...),
+		predicates:        append([]predicate.PaymentReconciliationReport{}, _q.predicates...),
+		withDiscrepancies: _q.withDiscrepancies.Clone(),
+		// clone intermediate query.
+		sql:  _q.sql.Clone(),
+		path: _q.path,
+	}
+}
+
+// WithDiscrepancies tells the query-builder to eager-load the nodes that are connected to
+// the "discrepancies" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *PaymentReconciliationReportQuery) WithDiscrepancies(opts ...func(*PaymentDiscrepancyQuery)) *PaymentReconciliationReportQuery {
+	query := (&PaymentDiscrepancyClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withDiscrepancies = query
+	return _q
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		ReportDate string `json:"report_date,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.PaymentReconciliationReport.Query().
+//		GroupBy(paymentreconciliationreport.FieldReportDate).
+//		Aggregate(generated.Count()).
+//		Scan(ctx, &v)
+func (_q *PaymentReconciliationReportQuery) GroupBy(field string, fields ...string) *PaymentReconciliationReportGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &PaymentReconciliationReportGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
+	grbuild.label = paymentreconciliationreport.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		ReportDate string `json:"report_date,omitempty"`
+//	}
+//
+//	client.PaymentReconciliationReport.Query().
+//		Select(paymentreconciliationreport.FieldReportDate).
+//		Scan(ctx, &v)
+func (_q *PaymentReconciliationReportQuery) Select(fields ...string) *PaymentReconciliationReportSelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &PaymentReconciliationReportSelect{PaymentReconciliationReportQuery: _q}
+	sbuild.label = paymentreconciliationreport.Label
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a PaymentReconciliationReportSelect configured with the given aggregations.
+func (_q *PaymentReconciliationReportQuery) Aggregate(fns ...AggregateFunc) *PaymentReconciliationReportSelect {
+	return _q.Select().Aggregate(fns...)
+}
+
+func (_q *PaymentReconciliationReportQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
+		if inter == nil {
+			return fmt.Errorf("generated: uninitialized interceptor (forgotten import generated/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, _q); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range _q.ctx.Fields {
+		if !paymentreconciliationreport.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+		}
+	}
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
+		if err != nil {
+			return err
+		}
+		_q.sql = prev
+	}
+	return nil
+}
+
+func (_q *PaymentReconciliationReportQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*PaymentReconciliationReport, error) {
+	var (
+		nodes       = []*PaymentReconciliationReport{}
+		_spec       = _q.querySpec()
+		loadedTypes = [1]bool{
+			_q.withDiscrepancies != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*PaymentReconciliationReport).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &PaymentReconciliationReport{config: _q.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := _q.withDiscrepancies; query != nil {
+		if err := _q.loadDiscrepancies(ctx, query, nodes,
+			func(n *PaymentReconciliationReport) { n.Edges.Discrepancies = []*PaymentDiscrepancy{} },
+			func(n *PaymentReconciliationReport, e *PaymentDiscrepancy) {
+				n.Edges.Discrepancies = append(n.Edges.Discrepancies, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (_q *PaymentReconciliationReportQuery) loadDiscrepancies(ctx context.Context, query *PaymentDiscrepancyQuery, nodes []*PaymentReconciliationReport, init func(*PaymentReconciliationReport), assign func(*PaymentReconciliationReport, *PaymentDiscrepancy)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*PaymentReconciliationReport)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(paymentdiscrepancy.FieldReportID)
+	}
+	query.Where(predicate.PaymentDiscrepancy(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(paymentreconciliationreport.DiscrepanciesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ReportID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "report_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (_q *PaymentReconciliationReportQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
+	_spec.Node.Columns = _q.ctx.Fields
+	if len(_q.ctx.Fields) > 0 {
+		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, _q.driver, _spec)
+}
+
+func (_q *PaymentReconciliationReportQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(paymentreconciliationreport.Table, paymentreconciliationreport.Columns, sqlgraph.NewFieldSpec(paymentreconciliationreport.FieldID, field.TypeString))
+	_spec.From = _q.sql
+	if unique := _q.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if _q.path != nil {
+		_spec.Unique = true
+	}
+	if fields := _q.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, paymentreconciliationreport.FieldID)
+		for i := range fields {
+			if fields[i] != paymentreconciliationreport.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := _q.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := _q.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (_q *PaymentReconciliationReportQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(_q.driver.Dialect())
+	t1 := builder.Table(paymentreconciliationreport.Table)
+	columns := _q.ctx.Fields
+	if len(columns) == 0 {
+		columns = paymentreconciliationreport.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if _q.sql != nil {
+		selector = _q.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if _q.ctx.Unique != nil && *_q.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
+	for _, p := range _q.predicates {
+		p(selector)
+	}
+	for _, p := range _q.order {
+		p(selector)
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (_q *PaymentReconciliationReportQuery) ForUpdate(opts ...sql.LockOption) *PaymentReconciliationReportQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return _q
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (_q *PaymentReconciliationReportQuery) ForShare(opts ...sql.LockOption) *PaymentReconciliationReportQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return _q
+}
+
+// PaymentReconciliationReportGroupBy is the group-by builder for PaymentReconciliationReport entities.
+type PaymentReconciliationReportGroupBy struct {
+	selector
+	build *PaymentReconciliationReportQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (_g *PaymentReconciliationReportGroupBy) Aggregate(fns ...AggregateFunc) *PaymentReconciliationReportGroupBy {
+	_g.fns = append(_g.fns, fns...)
+	return _g
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_g *PaymentReconciliationReportGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _g.build.ctx, ent.OpQueryGroupBy)
+	if err := _g.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PaymentReconciliationReportQuery, *PaymentReconciliationReportGroupBy](ctx, _g.build, _g, _g.build.inters, v)
+}
+
+func (_g *PaymentReconciliationReportGroupBy) sqlScan(ctx context.Context, root *PaymentReconciliationReportQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(_g.fns))
+	for _, fn := range _g.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*_g.flds)+len(_g.fns))
+		for _, f := range *_g.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// PaymentReconciliationReportSelect is the builder for selecting fields of PaymentReconciliationReport entities.
+type PaymentReconciliationReportSelect struct {
+	*PaymentReconciliationReportQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (_s *PaymentReconciliationReportSelect) Aggregate(fns ...AggregateFunc) *PaymentReconciliationReportSelect {
+	_s.fns = append(_s.fns, fns...)
+	return _s
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_s *PaymentReconciliationReportSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _s.ctx, ent.OpQuerySelect)
+	if err := _s.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PaymentReconciliationReportQuery, *PaymentReconciliationReportSelect](ctx, _s.PaymentReconciliationReportQuery, _s, _s.inters, v)
+}
+
+func (_s *PaymentReconciliationReportSelect) sqlScan(ctx context.Context, root *PaymentReconciliationReportQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(_s.fns))
+	for _, fn := range _s.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*_s.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,825 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/UnoraApp/be/ent/generated/paymentdiscrepancy"
+	"github.com/UnoraApp/be/ent/generated/paymentreconciliationreport"
+	"github.com/UnoraApp/be/ent/generated/predicate"
+)
+
+// PaymentReconciliationReportUpdate is the builder for updating PaymentReconciliationReport entities.
+type PaymentReconciliationReportUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PaymentReconciliationReportMutation
+}
+
+// Where appends a list predicates to the PaymentReconciliationReportUpdate builder.
+func (_u *PaymentReconciliationReportUpdate) Where(ps ...predicate.PaymentReconciliationReport) *PaymentReconciliationReportUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetReportDate sets the "report_date" field.
+func (_u *PaymentReconciliationReportUpdate) SetReportDate(v string) *PaymentReconciliationReportUpdate {
+	_u.mutation.SetReportDate(v)
+	return _u
+}
+
+// SetNillableReportDate sets the "report_date" field if the given value is not nil.
+func (_u *PaymentReconciliationReportUpdate) SetNillableReportDate(v *string) *PaymentReconciliationReportUpdate {
+	if v != nil {
+		_u.SetReportDate(*v)
+	}
+	return _u
+}
+
+// SetWindowStart sets the "window_start" field.
+func (_u *PaymentReconciliationReportUpdate) SetWindowStart(v time.Time) *PaymentReconciliationReportUpdate {
+	_u.mutation.SetWindowStart(v)
+	return _u
+}
+
+// SetNillableWindowStart sets the "window_start" field if the given value is not nil.
+func (_u *PaymentReconciliationReportUpdate) SetNillableWindowStart(v *time.Time) *PaymentReconciliationReportUpdate {
+	if v != nil {
+		_u.SetWindowStart(*v)
+	}
+	return _u
+}
+
+// SetWindowEnd sets the "window_end" field.
+func (_u *PaymentReconciliationReportUpdate) SetWindowEnd(v time.Time) *PaymentReconciliationReportUpdate {
+	_u.mutation.SetWindowEnd(v)
+	return _u
+}
+
+// SetNillableWindowEnd sets the "window_end" field if the given value is not nil.
+func (_u *PaymentReconciliationReportUpdate) SetNillableWindowEnd(v *time.Time) *PaymentReconciliationReportUpdate {
+	if v != nil {
+		_u.SetWindowEnd(*v)
+	}
+	return _u
+}
+
+// SetOrdersChecked sets the "orders_checked" field.
+func (_u *PaymentReconciliationReportUpdate) SetOrdersChecked(v int) *PaymentReconciliationReportUpdate {
+	_u.mutation.ResetOrdersChecked()
+	_u.mutation.SetOrdersChecked(v)
+	return _u
+}
+
+// SetNillableOrdersChecked sets the "orders_checked" field if the given value is not nil.
+func (_u *PaymentReconciliationReportUpdate) SetNillableOrdersChecked(v *int) *PaymentReconciliationReportUpdate {
+	if v != nil {
+		_u.SetOrdersChecked(*v)
+	}
+	return _u
+}
+
+// AddOrdersChecked adds value to the "orders_checked" field.
+func (_u *PaymentReconciliationReportUpdate) AddOrdersChecked(v int) *PaymentReconciliationReportUpdate {
+	_u.mutation.AddOrdersChecked(v)
+	return _u
+}
+
+// SetGatewayPayments sets the "gateway_payments" field.
+func (_u *PaymentReconciliationReportUpdate) SetGatewayPayments(v int) *PaymentReconciliationReportUpdate {
+	_u.mutation.ResetGatewayPayments()
+	_u.mutation.SetGatewayPayments(v)
+	return _u
+}
+
+// SetNillableGatewayPayments sets the "gateway_payments" field if the given value is not nil.
+func (_u *PaymentReconciliationReportUpdate) SetNillableGatewayPayments(v *int) *PaymentReconciliationReportUpdate {
+	if v != nil {
+		_u.SetGatewayPayments(*v)
+	}
+	return _u
+}
+
+// AddGatewayPayments adds value to the "gateway_payments" field.
+func (_u *PaymentReconciliationReportUpdate) AddGatewayPayments(v int) *PaymentReconciliationReportUpdate {
+	_u.mutation.AddGatewayPayments(v)
+	return _u
+}
+
+// SetMatched sets the "matched" field.
+func (_u *PaymentReconciliationReportUpdate) SetMatched(v int) *PaymentReconciliationReportUpdate {
+	_u.mutation.ResetMatched()
+	_u.mutation.SetMatched(v)
+	return _u
+}
+
+// SetNillableMatched sets the "matched" field if the given value is not nil.
+func (_u *PaymentReconciliationReportUpdate) SetNillableMatched(v *int) *PaymentReconciliationReportUpdate {
+	if v != nil {
+		_u.SetMatched(*v)
+	}
+	return _u
+}
+
+// AddMatched adds value to the "matched" field.
+func (_u *PaymentReconciliationReportUpdate) AddMatched(v int) *PaymentReconciliationReportUpdate {
+	_u.mutation.AddMatched(v)
+	return _u
+}
+
+// SetDiscrepancyCount sets the "discrepancy_count" field.
+func (_u *PaymentReconciliationReportUpdate) SetDiscrepancyCount(v int) *PaymentReconciliationReportUpdate {
+	_u.mutation.ResetDiscrepancyCount()
+	_u.mutation.SetDiscrepancyCount(v)
+	return _u
+}
+
+// SetNillableDiscrepancyCount sets the "discrepancy_count" field if the given value is not nil.
+func (_u *PaymentReconciliationReportUpdate) SetNillableDiscrepancyCount(v *int) *PaymentReconciliationReportUpdate {
+	if v != nil {
+		_u.SetDiscrepancyCount(*v)
+	}
+	return _u
+}
+
+// AddDiscrepancyCount adds value to the "discrepancy_count" field.
+func (_u *PaymentReconciliationReportUpdate) AddDiscrepancyCount(v int) *PaymentReconciliationReportUpdate {
+	_u.mutation.AddDiscrepancyCount(v)
+	return _u
+}
+
+// SetDbPaidAmount sets the "db_paid_amount" field.
+func (_u *PaymentReconciliationReportUpdate) SetDbPaidAmount(v int) *PaymentReconciliationReportUpdate {
+	_u.mutation.ResetDbPaidAmount()
+	_u.mutation.SetDbPaidAmount(v)
+	return _u
+}
+
+// SetNillableDbPaidAmount sets the "db_paid_amount" field if the given value is not nil.
+func (_u *PaymentReconciliationReportUpdate) SetNillableDbPaidAmount(v *int) *PaymentReconciliationReportUpdate {
+	if v != nil {
+		_u.SetDbPaidAmount(*v)
+	}
+	return _u
+}
+
+// AddDbPaidAmount adds value to the "db_paid_amount" field.
+func (_u *PaymentReconciliationReportUpdate) AddDbPaidAmount(v int) *PaymentReconciliationReportUpdate {
+	_u.mutation.AddDbPaidAmount(v)
+	return _u
+}
+
+// SetGatewayCapturedAmount sets the "gateway_captured_amount" field.
+func (_u *PaymentReconciliationReportUpdate) SetGatewayCapturedAmount(v int) *PaymentReconciliationReportUpdate {
+	_u.mutation.ResetGatewayCapturedAmount()
+	_u.mutation.SetGatewayCapturedAmount(v)
+	return _u
+}
+
+// SetNillableGatewayCapturedAmount sets the "gateway_captured_amount" field if the given value is not nil.
+func (_u *PaymentReconciliationReportUpdate) SetNillableGatewayCapturedAmount(v *int) *PaymentReconciliationReportUpdate {
+	if v != nil {
+		_u.SetGatewayCapturedAmount(*v)
+	}
+	return _u
+}
+
+// AddGatewayCapturedAmount adds value to the "gateway_captured_amount" field.
+func (_u *PaymentReconciliationReportUpdate) AddGatewayCapturedAmount(v int) *PaymentReconciliationReportUpdate {
+	_u.mutation.AddGatewayCapturedAmount(v)
+	return _u
+}
+
+// SetGeneratedAt sets the "generated_at" field.
+func (_u *PaymentReconciliationReportUpdate) SetGeneratedAt(v time.Time) *PaymentReconciliationReportUpdate {
+	_u.mutation.SetGeneratedAt(v)
+	return _u
+}
+
+// SetNillableGeneratedAt sets the "generated_at" field if the given value is not nil.
+func (_u *PaymentReconciliationReportUpdate) SetNillableGeneratedAt(v *time.Time) *PaymentReconciliationReportUpdate {
+	if v != nil {
+		_u.SetGeneratedAt(*v)
+	}
+	return _u
+}
+
+// AddDiscrepancyIDs adds the "discrepancies" edge to the PaymentDiscrepancy entity by IDs.
+func (_u *PaymentReconciliationReportUpdate) AddDiscrepancyIDs(ids ...string) *PaymentReconciliationReportUpdate {
+	_u.mutation.AddDiscrepancyIDs(ids...)
+	return _u
+}
+
+// AddDiscrepancies adds the "discrepancies" edges to the PaymentDiscrepancy entity.
+func (_u *PaymentReconciliationReportUpdate) AddDiscrepancies(v ...*PaymentDiscrepancy) *PaymentReconciliationReportUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDiscrepancyIDs(ids...)
+}
+
+// Mutation returns the PaymentReconciliationReportMutation object of the builder.
+func (_u *PaymentReconciliationReportUpdate) Mutation() *PaymentReconciliationReportMutation {
+	return _u.mutation
+}
+
+// ClearDiscrepancies clears all "discrepancies" edges to the PaymentDiscrepancy entity.
+func (_u *PaymentReconciliationReportUpdate) ClearDiscrepancies() *PaymentReconciliationReportUpdate {
+	_u.mutation.ClearDiscrepancies()
+	return _u
+}
+
+// RemoveDiscrepancyIDs removes the "discrepancies" edge to PaymentDiscrepancy entities by IDs.
+func (_u *PaymentReconciliationReportUpdate) RemoveDiscrepancyIDs(ids ...string) *PaymentReconciliationReportUpdate {
+	_u.mutation.RemoveDiscrepancyIDs(ids...)
+	return _u
+}
+
+// RemoveDiscrepancies removes "discrepancies" edges to PaymentDiscrepancy entities.
+func (_u *PaymentReconciliationReportUpdate) RemoveDiscrepancies(v ...*PaymentDiscrepancy) *PaymentReconciliationReportUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDiscrepancyIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PaymentReconciliationReportUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PaymentReconciliationReportUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PaymentReconciliationReportUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PaymentReconciliationReportUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PaymentReconciliationReportUpdate) check() error {
+	if v, ok := _u.mutation.ReportDate(); ok {
+		if err := paymentreconciliationreport.ReportDateValidator(v); err != nil {
+			return &ValidationError{Name: "report_date", err: fmt.Errorf(`generated: validator failed for field "PaymentReconciliationReport.report_date": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *PaymentReconciliationReportUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(paymentreconciliationreport.Table, paymentreconciliationreport.Columns, sqlgraph.NewFieldSpec(paymentreconciliationreport.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ReportDate(); ok {
+		_spec.SetField(paymentreconciliationreport.FieldReportDate, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.WindowStart(); ok {
+		_spec.SetField(paymentreconciliationreport.FieldWindowStart, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.WindowEnd(); ok {
+		_spec.SetField(paymentreconciliationreport.FieldWindowEnd, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.OrdersChecked(); ok {
+		_spec.SetField(paymentreconciliationreport.FieldOrdersChecked, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOrdersChecked(); ok {
+		_spec.AddField(paymentreconciliationreport.FieldOrdersChecked, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.GatewayPayments(); ok {
+		_spec.SetField(paymentreconciliationreport.FieldGatewayPayments, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedGatewayPayments(); ok {
+		_spec.AddField(paymentreconciliationreport.FieldGatewayPayments, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Matched(); ok {
+		_spec.SetField(paymentreconciliationreport.FieldMatched, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMatched(); ok {
+		_spec.AddField(paymentreconciliationreport.FieldMatched, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DiscrepancyCount(); ok {
+		_spec.SetField(paymentreconciliationreport.FieldDiscrepancyCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDiscrepancyCount(); ok {
+		_spec.AddField(paymentreconciliationreport.FieldDiscrepancyCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DbPaidAmount(); ok {
+		_spec.SetField(paymentreconciliationreport.FieldDbPaidAmount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDbPaidAmount(); ok {
+		_spec.AddField(paymentreconciliationreport.FieldDbPaidAmount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.GatewayCapturedAmount(); ok {
+		_spec.SetField(paymentreconciliationreport.FieldGatewayCapturedAmount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedGatewayCapturedAmount(); ok {
+		_spec.AddField(paymentreconciliationreport.FieldGatewayCapturedAmount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.GeneratedAt(); ok {
+		_spec.SetField(paymentreconciliationreport.FieldGeneratedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DiscrepanciesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentreconciliationreport.DiscrepanciesTable,
+			Columns: []string{paymentreconciliationreport.DiscrepanciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentdiscrepancy.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedDiscrepanciesIDs(); len(nodes) > 0 && !_u.mutation.DiscrepanciesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentreconciliationreport.DiscrepanciesTable,
+			Columns: []string{paymentreconciliationreport.DiscrepanciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentdiscrepancy.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DiscrepanciesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentreconciliationreport.DiscrepanciesTable,
+			Columns: []string{paymentreconciliationreport.DiscrepanciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentdiscrepancy.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{paymentreconciliationreport.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PaymentReconciliationReportUpdateOne is the builder for updating a single PaymentReconciliationReport entity.
+type PaymentReconciliationReportUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PaymentReconciliationReportMutation
+}
+
+// SetReportDate sets the "report_date" field.
+func (_u *PaymentReconciliationReportUpdateOne) SetReportDate(v string) *PaymentReconciliationReportUpdateOne {
+	_u.mutation.SetReportDate(v)
+	return _u
+}
+
+// SetNillableReportDate sets the "report_date" field if the given value is not nil.
+func (_u *PaymentReconciliationReportUpdateOne) SetNillableReportDate(v *string) *PaymentReconciliationReportUpdateOne {
+	if v != nil {
+		_u.SetReportDate(*v)
+	}
+	return _u
+}
+
+// SetWindowStart sets the "window_start" field.
+func (_u *PaymentReconciliationReportUpdateOne) SetWindowStart(v time.Time) *PaymentReconciliationReportUpdateOne {
+	_u.mutation.SetWindowStart(v)
+	return _u
+}
+
+// SetNillableWindowStart sets the "window_start" field if the given value is not nil.
+func (_u *PaymentReconciliationReportUpdateOne) SetNillableWindowStart(v *time.Time) *PaymentReconciliationReportUpdateOne {
+	if v != nil {
+		_u.SetWindowStart(*v)
+	}
+	return _u
+}
+
+// SetWindowEnd sets the "window_end" field.
+func (_u *PaymentReconciliationReportUpdateOne) SetWindowEnd(v time.Time) *PaymentReconciliationReportUpdateOne {
+	_u.mutation.SetWindowEnd(v)
+	return _u
+}
+
+// SetNillableWindowEnd sets the "window_end" field if the given value is not nil.
+func (_u *PaymentReconciliationReportUpdateOne) SetNillableWindowEnd(v *time.Time) *PaymentReconciliationReportUpdateOne {
+	if v != nil {
+		_u.SetWindowEnd(*v)
+	}
+	return _u
+}
+
+// SetOrdersChecked sets the "orders_checked" field.
+func (_u *PaymentReconciliationReportUpdateOne) SetOrdersChecked(v int) *PaymentReconciliationReportUpdateOne {
+	_u.mutation.ResetOrdersChecked()
+	_u.mutation.SetOrdersChecked(v)
+	return _u
+}
+
+// SetNillableOrdersChecked sets the "orders_checked" field if the given value is not nil.
+func (_u *PaymentReconciliationReportUpdateOne) SetNillableOrdersChecked(v *int) *PaymentReconciliationReportUpdateOne {
+	if v != nil {
+		_u.SetOrdersChecked(*v)
+	}
+	return _u
+}
+
+// AddOrdersChecked adds value to the "orders_checked" field.
+func (_u *PaymentReconciliationReportUpdateOne) AddOrdersChecked(v int) *PaymentReconciliationReportUpdateOne {
+	_u.mutation.AddOrdersChecked(v)
+	return _u
+}
+
+// SetGatewayPayments sets the "gateway_payments" field.
+func (_u *PaymentReconciliationReportUpdateOne) SetGatewayPayments(v int) *PaymentReconciliationReportUpdateOne {
+	_u.mutation.ResetGatewayPayments()
+	_u.mutation.SetGatewayPayments(v)
+	return _u
+}
+
+// SetNillableGatewayPayments sets the "gateway_payments" field if the given value is not nil.
+func (_u *PaymentReconciliationReportUpdateOne) SetNillableGatewayPayments(v *int) *PaymentReconciliationReportUpdateOne {
+	if v != nil {
+		_u.SetGatewayPayments(*v)
+	}
+	return _u
+}
+
+// AddGatewayPayments adds value to the "gateway_payments" field.
+func (_u *PaymentReconciliationReportUpdateOne) AddGatewayPayments(v int) *PaymentReconciliationReportUpdateOne {
+	_u.mutation.AddGatewayPayments(v)
+	return _u
+}
+
+// SetMatched sets the "matched" field.
+func (_u *PaymentReconciliationReportUpdateOne) SetMatched(v int) *PaymentReconciliationReportUpdateOne {
+	_u.mutation.ResetMatched()
+	_u.mutation.SetMatched(v)
+	return _u
+}
+
+// SetNillableMatched sets the "matched" field if the given value is not nil.
+func (_u *PaymentReconciliationReportUpdateOne) SetNillableMatched(v *int) *PaymentReconciliationReportUpdateOne {
+	if v != nil {
+		_u.SetMatched(*v)
+	}
+	return _u
+}
+
+// AddMatched adds value to the "matched" field.
+func (_u *PaymentReconciliationReportUpdateOne) AddMatched(v int) *PaymentReconciliationReportUpdateOne {
+	_u.mutation.AddMatched(v)
+	return _u
+}
+
+// SetDiscrepancyCount sets the "discrepancy_count" field.
+func (_u *PaymentReconciliationReportUpdateOne) SetDiscrepancyCount(v int) *PaymentReconciliationReportUpdateOne {
+	_u.mutation.ResetDiscrepancyCount()
+	_u.mutation.SetDiscrepancyCount(v)
+	return _u
+}
+
+// SetNillableDiscrepancyCount sets the "discrepancy_count" field if the given value is not nil.
+func (_u *PaymentReconciliationReportUpdateOne) SetNillableDiscrepancyCount(v *int) *PaymentReconciliationReportUpdateOne {
+	if v != nil {
+		_u.SetDiscrepancyCount(*v)
+	}
+	return _u
+}
+
+// AddDiscrepancyCount adds value to the "discrepancy_count" field.
+func (_u *PaymentReconciliationReportUpdateOne) AddDiscrepancyCount(v int) *PaymentReconciliationReportUpdateOne {
+	_u.mutation.AddDiscrepancyCount(v)
+	return _u
+}
+
+// SetDbPaidAmount sets the "db_paid_amount" field.
+func (_u *PaymentReconciliationReportUpdateOne) SetDbPaidAmount(v int) *PaymentReconciliationReportUpdateOne {
+	_u.mutation.ResetDbPaidAmount()
+	_u.mutation.SetDbPaidAmount(v)
+	return _u
+}
+
+// SetNillableDbPaidAmount sets the "db_paid_amount" field if the given value is not nil.
+func (_u *PaymentReconciliationReportUpdateOne) SetNillableDbPaidAmount(v *int) *PaymentReconciliationReportUpdateOne {
+	if v != nil {
+		_u.SetDbPaidAmount(*v)
+	}
+	return _u
+}
+
+// AddDbPaidAmount adds value to the "db_paid_amount" field.
+func (_u *PaymentReconciliationReportUpdateOne) AddDbPaidAmount(v int) *PaymentReconciliationReportUpdateOne {
+	_u.mutation.AddDbPaidAmount(v)
+	return _u
+}
+
+// SetGatewayCapturedAmount sets the "gateway_captured_amount" field.
+func (_u *PaymentReconciliationReportUpdateOne) SetGatewayCapturedAmount(v int) *PaymentReconciliationReportUpdateOne {
+	_u.mutation.ResetGatewayCapturedAmount()
+	_u.mutation.SetGatewayCapturedAmount(v)
+	return _u
+}
+
+// SetNillableGatewayCapturedAmount sets the "gateway_captured_amount" field if the given value is not nil.
+func (_u *PaymentReconciliationReportUpdateOne) SetNillableGatewayCapturedAmount(v *int) *PaymentReconciliationReportUpdateOne {
+	if v != nil {
+		_u.SetGatewayCapturedAmount(*v)
+	}
+	return _u
+}
+
+// AddGatewayCapturedAmount adds value to the "gateway_captured_amount" field.
+func (_u *PaymentReconciliationReportUpdateOne) AddGatewayCapturedAmount(v int) *PaymentReconciliationReportUpdateOne {
+	_u.mutation.AddGatewayCapturedAmount(v)
+	return _u
+}
+
+// SetGeneratedAt sets the "generated_at" field.
+func (_u *PaymentReconciliationReportUpdateOne) SetGeneratedAt(v time.Time) *PaymentReconciliationReportUpdateOne {
+	_u.mutation.SetGeneratedAt(v)
+	return _u
+}
+
+// SetNillableGeneratedAt sets the "generated_at" field if the given value is not nil.
+func (_u *PaymentReconciliationReportUpdateOne) SetNillableGeneratedAt(v *time.Time) *PaymentReconciliationReportUpdateOne {
+	if v != nil {
+		_u.SetGeneratedAt(*v)
+	}
+	return _u
+}
+
+// AddDiscrepancyIDs adds the "discrepancies" edge to the PaymentDiscrepancy entity by IDs.
+func (_u *PaymentReconciliationReportUpdateOne) AddDiscrepancyIDs(ids ...string) *PaymentReconciliationReportUpdateOne {
+	_u.mutation.AddDiscrepancyIDs(ids...)
+	return _u
+}
+
+// AddDiscrepancies adds the "discrepancies" edges to the PaymentDiscrepancy entity.
+func (_u *PaymentReconciliationReportUpdateOne) AddDiscrepancies(v ...*PaymentDiscrepancy) *PaymentReconciliationReportUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDiscrepancyIDs(ids...)
+}
+
+// Mutation returns the PaymentReconciliationReportMutation object of the builder.
+func (_u *PaymentReconciliationReportUpdateOne) Mutation() *PaymentReconciliationReportMutation {
+	return _u.mutation
+}
+
+// ClearDiscrepancies clears all "discrepancies" edges to the PaymentDiscrepancy entity.
+func (_u *PaymentReconciliationReportUpdateOne) ClearDiscrepancies() *PaymentReconciliationReportUpdateOne {
+	_u.mutation.ClearDiscrepancies()
+	return _u
+}
+
+// RemoveDiscrepancyIDs removes the "discrepancies" edge to PaymentDiscrepancy entities by IDs.
+func (_u *PaymentReconciliationReportUpdateOne) RemoveDiscrepancyIDs(ids ...string) *PaymentReconciliationReportUpdateOne {
+	_u.mutation.RemoveDiscrepancyIDs(ids...)
+	return _u
+}
+
+// RemoveDiscrepancies removes "discrepancies" edges to PaymentDiscrepancy entities.
+func (_u *PaymentReconciliationReportUpdateOne) RemoveDiscrepancies(v ...*PaymentDiscrepancy) *PaymentReconciliationReportUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDiscrepancyIDs(ids...)
+}
+
+// Where appends a list predicates to the PaymentReconciliationReportUpdate builder.
+func (_u *PaymentReconciliationReportUpdateOne) Where(ps ...predicate.PaymentReconciliationReport) *PaymentReconciliationReportUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PaymentReconciliationReportUpdateOne) Select(field string, fields ...string) *PaymentReconciliationReportUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PaymentReconciliationReport entity.
+func (_u *PaymentReconciliationReportUpdateOne) Save(ctx context.Context) (*PaymentReconciliationReport, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PaymentReconciliationReportUpdateOne) SaveX(ctx context.Context) *PaymentReconciliationReport {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PaymentReconciliationReportUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PaymentReconciliationReportUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PaymentReconciliationReportUpdateOne) check() error {
+	if v, ok := _u.mutation.ReportDate(); ok {
+		if err := paymentreconciliationreport.ReportDateValidator(v); err != nil {
+			return &ValidationError{Name: "report_date", err: fmt.Errorf(`generated: validator failed for field "PaymentReconciliationReport.report_date": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *PaymentReconciliationReportUpdateOne) sqlSave(ctx context.Context) (_node *PaymentReconciliationReport, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(paymentreconciliationreport.Table, paymentreconciliationreport.Columns, sqlgraph.NewFieldSpec(paymentreconciliationreport.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "PaymentReconciliationReport.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, paymentreconciliationreport.FieldID)
+		for _, f := range fields {
+			if !paymentreconciliationreport.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != paymentreconciliationreport.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ReportDate(); ok {
+		_spec.SetField(paymentreconciliationreport.FieldReportDate, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.WindowStart(); ok {
+		_spec.SetField(paymentreconciliationreport.FieldWindowStart, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.WindowEnd(); ok {
+		_spec.SetField(paymentreconciliationreport.FieldWindowEnd, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.OrdersChecked(); ok {
+		_spec.SetField(paymentreconciliationreport.FieldOrdersChecked, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOrdersChecked(); ok {
+		_spec.AddField(paymentreconciliationreport.FieldOrdersChecked, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.GatewayPayments(); ok {
+		_spec.SetField(paymentreconciliationreport.FieldGatewayPayments, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedGatewayPayments(); ok {
+		_spec.AddField(paymentreconciliationreport.FieldGatewayPayments, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Matched(); ok {
+		_spec.SetField(paymentreconciliationreport.FieldMatched, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMatched(); ok {
+		_spec.AddField(paymentreconciliationreport.FieldMatched, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DiscrepancyCount(); ok {
+		_spec.SetField(paymentreconciliationreport.FieldDiscrepancyCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDiscrepancyCount(); ok {
+		_spec.AddField(paymentreconciliationreport.FieldDiscrepancyCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DbPaidAmount(); ok {
+		_spec.SetField(paymentreconciliationreport.FieldDbPaidAmount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDbPaidAmount(); ok {
+		_spec.AddField(paymentreconciliationreport.FieldDbPaidAmount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.GatewayCapturedAmount(); ok {
+		_spec.SetField(paymentreconciliationreport.FieldGatewayCapturedAmount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedGatewayCapturedAmount(); ok {
+		_spec.AddField(paymentreconciliationreport.FieldGatewayCapturedAmount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.GeneratedAt(); ok {
+		_spec.SetField(paymentreconciliationreport.FieldGeneratedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DiscrepanciesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentreconciliationreport.DiscrepanciesTable,
+			Columns: []string{paymentreconciliationreport.DiscrepanciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentdiscrepancy.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedDiscrepanciesIDs(); len(nodes) > 0 && !_u.mutation.DiscrepanciesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentreconciliationreport.DiscrepanciesTable,
+			Columns: []string{paymentreconciliationreport.DiscrepanciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentdiscrepancy.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DiscrepanciesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentreconciliationreport.DiscrepanciesTable,
+			Columns: []string{paymentreconciliationreport.DiscrepanciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentdiscrepancy.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &PaymentReconciliationReport{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{paymentreconciliationreport.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
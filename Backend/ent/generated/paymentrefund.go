@@ -0,0 +1,290 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/UnoraApp/be/ent/generated/paymentorder"
+	"github.com/UnoraApp/be/ent/generated/paymentrefund"
+)
+
+// PaymentRefund is the model entity for the PaymentRefund schema.
+type PaymentRefund struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// OrderID holds the value of the "order_id" field.
+	OrderID string `json:"order_id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID string `json:"user_id,omitempty"`
+	// RazorpayRefundID holds the value of the "razorpay_refund_id" field.
+	RazorpayRefundID *string `json:"razorpay_refund_id,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount int `json:"amount,omitempty"`
+	// Currency holds the value of the "currency" field.
+	Currency string `json:"currency,omitempty"`
+	// RefundStatus holds the value of the "refund_status" field.
+	RefundStatus paymentrefund.RefundStatus `json:"refund_status,omitempty"`
+	// Source holds the value of the "source" field.
+	Source paymentrefund.Source `json:"source,omitempty"`
+	// CreditsReversed holds the value of the "credits_reversed" field.
+	CreditsReversed int `json:"credits_reversed,omitempty"`
+	// CreditsWrittenOff holds the value of the "credits_written_off" field.
+	CreditsWrittenOff int `json:"credits_written_off,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason *string `json:"reason,omitempty"`
+	// FailureReason holds the value of the "failure_reason" field.
+	FailureReason *string `json:"failure_reason,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// ProcessedAt holds the value of the "processed_at" field.
+	ProcessedAt *time.Time `json:"processed_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PaymentRefundQuery when eager-loading is set.
+	Edges        PaymentRefundEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PaymentRefundEdges holds the relations/edges for other nodes in the graph.
+type PaymentRefundEdges struct {
+	// Order holds the value of the order edge.
+	Order *PaymentOrder `json:"order,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// OrderOrErr returns the Order value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PaymentRefundEdges) OrderOrErr() (*PaymentOrder, error) {
+	if e.Order != nil {
+		return e.Order, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: paymentorder.Label}
+	}
+	return nil, &NotLoadedError{edge: "order"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PaymentRefund) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case paymentrefund.FieldAmount, paymentrefund.FieldCreditsReversed, paymentrefund.FieldCreditsWrittenOff:
+			values[i] = new(sql.NullInt64)
+		case paymentrefund.FieldID, paymentrefund.FieldOrderID, paymentrefund.FieldUserID, paymentrefund.FieldRazorpayRefundID, paymentrefund.FieldCurrency, paymentrefund.FieldRefundStatus, paymentrefund.FieldSource, paymentrefund.FieldReason, paymentrefund.FieldFailureReason:
+			values[i] = new(sql.NullString)
+		case paymentrefund.FieldCreatedAt, paymentrefund.FieldUpdatedAt, paymentrefund.FieldProcessedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PaymentRefund fields.
+func (_m *PaymentRefund) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case paymentrefund.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case paymentrefund.FieldOrderID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field order_id", values[i])
+			} else if value.Valid {
+				_m.OrderID = value.String
+			}
+		case paymentrefund.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = value.String
+			}
+		case paymentrefund.FieldRazorpayRefundID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field razorpay_refund_id", values[i])
+			} else if value.Valid {
+				_m.RazorpayRefundID = new(string)
+				*_m.RazorpayRefundID = value.String
+			}
+		case paymentrefund.FieldAmount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value.Valid {
+				_m.Amount = int(value.Int64)
+			}
+		case paymentrefund.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				_m.Currency = value.String
+			}
+		case paymentrefund.FieldRefundStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field refund_status", values[i])
+			} else if value.Valid {
+				_m.RefundStatus = paymentrefund.RefundStatus(value.String)
+			}
+		case paymentrefund.FieldSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source", values[i])
+			} else if value.Valid {
+				_m.Source = paymentrefund.Source(value.String)
+			}
+		case paymentrefund.FieldCreditsReversed:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field credits_reversed", values[i])
+			} else if value.Valid {
+				_m.CreditsReversed = int(value.Int64)
+			}
+		case paymentrefund.FieldCreditsWrittenOff:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field credits_written_off", values[i])
+			} else if value.Valid {
+				_m.CreditsWrittenOff = int(value.Int64)
+			}
+		case paymentrefund.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				_m.Reason = new(string)
+				*_m.Reason = value.String
+			}
+		case paymentrefund.FieldFailureReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field failure_reason", values[i])
+			} else if value.Valid {
+				_m.FailureReason = new(string)
+				*_m.FailureReason = value.String
+			}
+		case paymentrefund.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case paymentrefund.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case paymentrefund.FieldProcessedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field processed_at", values[i])
+			} else if value.Valid {
+				_m.ProcessedAt = new(time.Time)
+				*_m.ProcessedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PaymentRefund.
+// This includes values selected through modifiers, order, etc.
+func (_m *PaymentRefund) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryOrder queries the "order" edge of the PaymentRefund entity.
+func (_m *PaymentRefund) QueryOrder() *PaymentOrderQuery {
+	return NewPaymentRefundClient(_m.config).QueryOrder(_m)
+}
+
+// Update returns a builder for updating this PaymentRefund.
+// Note that you need to call PaymentRefund.Unwrap() before calling this method if this PaymentRefund
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *PaymentRefund) Update() *PaymentRefundUpdateOne {
+	return NewPaymentRefundClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the PaymentRefund entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *PaymentRefund) Unwrap() *PaymentRefund {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("generated: PaymentRefund is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *PaymentRefund) String() string {
+	var builder strings.Builder
+	builder.WriteString("PaymentRefund(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("order_id=")
+	builder.WriteString(_m.OrderID)
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(_m.UserID)
+	builder.WriteString(", ")
+	if v := _m.RazorpayRefundID; v != nil {
+		builder.WriteString("razorpay_refund_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(_m.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("refund_status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RefundStatus))
+	builder.WriteString(", ")
+	builder.WriteString("source=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Source))
+	builder.WriteString(", ")
+	builder.WriteString("credits_reversed=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CreditsReversed))
+	builder.WriteString(", ")
+	builder.WriteString("credits_written_off=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CreditsWrittenOff))
+	builder.WriteString(", ")
+	if v := _m.Reason; v != nil {
+		builder.WriteString("reason=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.FailureReason; v != nil {
+		builder.WriteString("failure_reason=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.ProcessedAt; v != nil {
+		builder.WriteString("processed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PaymentRefunds is a parsable slice of PaymentRefund.
+type PaymentRefunds []*PaymentRefund
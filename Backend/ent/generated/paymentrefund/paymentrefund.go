@@ -0,0 +1,260 @@
+// Code generated by ent, DO NOT EDIT.
+
+package paymentrefund
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the paymentrefund type in the database.
+	Label = "payment_refund"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldOrderID holds the string denoting the order_id field in the database.
+	FieldOrderID = "order_id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldRazorpayRefundID holds the string denoting the razorpay_refund_id field in the database.
+	FieldRazorpayRefundID = "razorpay_refund_id"
+	// FieldAmount holds the string denoting the amount field in the database.
+	FieldAmount = "amount"
+	// FieldCurrency holds the string denoting the currency field in the database.
+	FieldCurrency = "currency"
+	// FieldRefundStatus holds the string denoting the refund_status field in the database.
+	FieldRefundStatus = "refund_status"
+	// FieldSource holds the string denoting the source field in the database.
+	FieldSource = "source"
+	// FieldCreditsReversed holds the string denoting the credits_reversed field in the database.
+	FieldCreditsReversed = "credits_reversed"
+	// FieldCreditsWrittenOff holds the string denoting the credits_written_off field in the database.
+	FieldCreditsWrittenOff = "credits_written_off"
+	// FieldReason holds the string denoting the reason field in the database.
+	FieldReason = "reason"
+	// FieldFailureReason holds the string denoting the failure_reason field in the database.
+	FieldFailureReason = "failure_reason"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldProcessedAt holds the string denoting the processed_at field in the database.
+	FieldProcessedAt = "processed_at"
+	// EdgeOrder holds the string denoting the order edge name in mutations.
+	EdgeOrder = "order"
+	// Table holds the table name of the paymentrefund in the database.
+	Table = "payment_refunds"
+	// OrderTable is the table that holds the order relation/edge.
+	OrderTable = "payment_refunds"
+	// OrderInverseTable is the table name for the PaymentOrder entity.
+	// It exists in this package in order to avoid circular dependency with the "paymentorder" package.
+	OrderInverseTable = "payment_orders"
+	// OrderColumn is the table column denoting the order relation/edge.
+	OrderColumn = "order_id"
+)
+
+// Columns holds all SQL columns for paymentrefund fields.
+var Columns = []string{
+	FieldID,
+	FieldOrderID,
+	FieldUserID,
+	FieldRazorpayRefundID,
+	FieldAmount,
+	FieldCurrency,
+	FieldRefundStatus,
+	FieldSource,
+	FieldCreditsReversed,
+	FieldCreditsWrittenOff,
+	FieldReason,
+	FieldFailureReason,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldProcessedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// OrderIDValidator is a validator for the "order_id" field. It is called by the builders before save.
+	OrderIDValidator func(string) error
+	// UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	UserIDValidator func(string) error
+	// RazorpayRefundIDValidator is a validator for the "razorpay_refund_id" field. It is called by the builders before save.
+	RazorpayRefundIDValidator func(string) error
+	// AmountValidator is a validator for the "amount" field. It is called by the builders before save.
+	AmountValidator func(int) error
+	// DefaultCurrency holds the default value on creation for the "currency" field.
+	DefaultCurrency string
+	// CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	CurrencyValidator func(string) error
+	// DefaultCreditsReversed holds the default value on creation for the "credits_reversed" field.
+	DefaultCreditsReversed int
+	// CreditsReversedValidator is a validator for the "credits_reversed" field. It is called by the builders before save.
+	CreditsReversedValidator func(int) error
+	// DefaultCreditsWrittenOff holds the default value on creation for the "credits_written_off" field.
+	DefaultCreditsWrittenOff int
+	// CreditsWrittenOffValidator is a validator for the "credits_written_off" field. It is called by the builders before save.
+	CreditsWrittenOffValidator func(int) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
+
+// RefundStatus defines the type for the "refund_status" enum field.
+type RefundStatus string
+
+// RefundStatusPending is the default value of the RefundStatus enum.
+const DefaultRefundStatus = RefundStatusPending
+
+// RefundStatus values.
+const (
+	RefundStatusPending    RefundStatus = "pending"
+	RefundStatusProcessing RefundStatus = "processing"
+	RefundStatusProcessed  RefundStatus = "processed"
+	RefundStatusFailed     RefundStatus = "failed"
+)
+
+func (rs RefundStatus) String() string {
+	return string(rs)
+}
+
+// RefundStatusValidator is a validator for the "refund_status" field enum values. It is called by the builders before save.
+func RefundStatusValidator(rs RefundStatus) error {
+	switch rs {
+	case RefundStatusPending, RefundStatusProcessing, RefundStatusProcessed, RefundStatusFailed:
+		return nil
+	default:
+		return fmt.Errorf("paymentrefund: invalid enum value for refund_status field: %q", rs)
+	}
+}
+
+// Source defines the type for the "source" enum field.
+type Source string
+
+// Source values.
+const (
+	SourceAdmin   Source = "admin"
+	SourceGateway Source = "gateway"
+)
+
+func (s Source) String() string {
+	return string(s)
+}
+
+// SourceValidator is a validator for the "source" field enum values. It is called by the builders before save.
+func SourceValidator(s Source) error {
+	switch s {
+	case SourceAdmin, SourceGateway:
+		return nil
+	default:
+		return fmt.Errorf("paymentrefund: invalid enum value for source field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the PaymentRefund queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByOrderID orders the results by the order_id field.
+func ByOrderID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrderID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByRazorpayRefundID orders the results by the razorpay_refund_id field.
+func ByRazorpayRefundID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRazorpayRefundID, opts...).ToFunc()
+}
+
+// ByAmount orders the results by the amount field.
+func ByAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAmount, opts...).ToFunc()
+}
+
+// ByCurrency orders the results by the currency field.
+func ByCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrency, opts...).ToFunc()
+}
+
+// ByRefundStatus orders the results by the refund_status field.
+func ByRefundStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRefundStatus, opts...).ToFunc()
+}
+
+// BySource orders the results by the source field.
+func BySource(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSource, opts...).ToFunc()
+}
+
+// ByCreditsReversed orders the results by the credits_reversed field.
+func ByCreditsReversed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreditsReversed, opts...).ToFunc()
+}
+
+// ByCreditsWrittenOff orders the results by the credits_written_off field.
+func ByCreditsWrittenOff(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreditsWrittenOff, opts...).ToFunc()
+}
+
+// ByReason orders the results by the reason field.
+func ByReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReason, opts...).ToFunc()
+}
+
+// ByFailureReason orders the results by the failure_reason field.
+func ByFailureReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFailureReason, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByProcessedAt orders the results by the processed_at field.
+func ByProcessedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProcessedAt, opts...).ToFunc()
+}
+
+// ByOrderField orders the results by order field.
+func ByOrderField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOrderStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newOrderStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OrderInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, OrderTable, OrderColumn),
+	)
+}
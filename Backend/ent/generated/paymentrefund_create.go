@@ -0,0 +1,1299 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/UnoraApp/be/ent/generated/paymentorder"
+	"github.com/UnoraApp/be/ent/generated/paymentrefund"
+)
+
+// PaymentRefundCreate is the builder for creating a PaymentRefund entity.
+type PaymentRefundCreate struct {
+	config
+	mutation *PaymentRefundMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetOrderID sets the "order_id" field.
+func (_c *PaymentRefundCreate) SetOrderID(v string) *PaymentRefundCreate {
+	_c.mutation.SetOrderID(v)
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *PaymentRefundCreate) SetUserID(v string) *PaymentRefundCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetRazorpayRefundID sets the "razorpay_refund_id" field.
+func (_c *PaymentRefundCreate) SetRazorpayRefundID(v string) *PaymentRefundCreate {
+	_c.mutation.SetRazorpayRefundID(v)
+	return _c
+}
+
+// SetNillableRazorpayRefundID sets the "razorpay_refund_id" field if the given value is not nil.
+func (_c *PaymentRefundCreate) SetNillableRazorpayRefundID(v *string) *PaymentRefundCreate {
+	if v != nil {
+		_c.SetRazorpayRefundID(*v)
+	}
+	return _c
+}
+
+// SetAmount sets the "amount" field.
+func (_c *PaymentRefundCreate) SetAmount(v int) *PaymentRefundCreate {
+	_c.mutation.SetAmount(v)
+	return _c
+}
+
+// SetCurrency sets the "currency" field.
+func (_c *PaymentRefundCreate) SetCurrency(v string) *PaymentRefundCreate {
+	_c.mutation.SetCurrency(v)
+	return _c
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_c *PaymentRefundCreate) SetNillableCurrency(v *string) *PaymentRefundCreate {
+	if v != nil {
+		_c.SetCurrency(*v)
+	}
+	return _c
+}
+
+// SetRefundStatus sets the "refund_status" field.
+func (_c *PaymentRefundCreate) SetRefundStatus(v paymentrefund.RefundStatus) *PaymentRefundCreate {
+	_c.mutation.SetRefundStatus(v)
+	return _c
+}
+
+// SetNillableRefundStatus sets the "refund_status" field if the given value is not nil.
+func (_c *PaymentRefundCreate) SetNillableRefundStatus(v *paymentrefund.RefundStatus) *PaymentRefundCreate {
+	if v != nil {
+		_c.SetRefundStatus(*v)
+	}
+	return _c
+}
+
+// SetSource sets the "source" field.
+func (_c *PaymentRefundCreate) SetSource(v paymentrefund.Source) *PaymentRefundCreate {
+	_c.mutation.SetSource(v)
+	return _c
+}
+
+// SetCreditsReversed sets the "credits_reversed" field.
+func (_c *PaymentRefundCreate) SetCreditsReversed(v int) *PaymentRefundCreate {
+	_c.mutation.SetCreditsReversed(v)
+	return _c
+}
+
+// SetNillableCreditsReversed sets the "credits_reversed" field if the given value is not nil.
+func (_c *PaymentRefundCreate) SetNillableCreditsReversed(v *int) *PaymentRefundCreate {
+	if v != nil {
+		_c.SetCreditsReversed(*v)
+	}
+	return _c
+}
+
+// SetCreditsWrittenOff sets the "credits_written_off" field.
+func (_c *PaymentRefundCreate) SetCreditsWrittenOff(v int) *PaymentRefundCreate {
+	_c.mutation.SetCreditsWrittenOff(v)
+	return _c
+}
+
+// SetNillableCreditsWrittenOff sets the "credits_written_off" field if the given value is not nil.
+func (_c *PaymentRefundCreate) SetNillableCreditsWrittenOff(v *int) *PaymentRefundCreate {
+	if v != nil {
+		_c.SetCreditsWrittenOff(*v)
+	}
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *PaymentRefundCreate) SetReason(v string) *PaymentRefundCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_c *PaymentRefundCreate) SetNillableReason(v *string) *PaymentRefundCreate {
+	if v != nil {
+		_c.SetReason(*v)
+	}
+	return _c
+}
+
+// SetFailureReason sets the "failure_reason" field.
+func (_c *PaymentRefundCreate) SetFailureReason(v string) *PaymentRefundCreate {
+	_c.mutation.SetFailureReason(v)
+	return _c
+}
+
+// SetNillableFailureReason sets the "failure_reason" field if the given value is not nil.
+func (_c *PaymentRefundCreate) SetNillableFailureReason(v *string) *PaymentRefundCreate {
+	if v != nil {
+		_c.SetFailureReason(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *PaymentRefundCreate) SetCreatedAt(v time.Time) *PaymentRefundCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *PaymentRefundCreate) SetNillableCreatedAt(v *time.Time) *PaymentRefundCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *PaymentRefundCreate) SetUpdatedAt(v time.Time) *PaymentRefundCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *PaymentRefundCreate) SetNillableUpdatedAt(v *time.Time) *PaymentRefundCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetProcessedAt sets the "processed_at" field.
+func (_c *PaymentRefundCreate) SetProcessedAt(v time.Time) *PaymentRefundCreate {
+	_c.mutation.SetProcessedAt(v)
+	return _c
+}
+
+// SetNillableProcessedAt sets the "processed_at" field if the given value is not nil.
+func (_c *PaymentRefundCreate) SetNillableProcessedAt(v *time.Time) *PaymentRefundCreate {
+	if v != nil {
+		_c.SetProcessedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PaymentRefundCreate) SetID(v string) *PaymentRefundCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetOrder sets the "order" edge to the PaymentOrder entity.
+func (_c *PaymentRefundCreate) SetOrder(v *PaymentOrder) *PaymentRefundCreate {
+	return _c.SetOrderID(v.ID)
+}
+
+// Mutation returns the PaymentRefundMutation object of the builder.
+func (_c *PaymentRefundCreate) Mutation() *PaymentRefundMutation {
+	return _c.mutation
+}
+
+// Save creates the PaymentRefund in the database.
+func (_c *PaymentRefundCreate) Save(ctx context.Context) (*PaymentRefund, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PaymentRefundCreate) SaveX(ctx context.Context) *PaymentRefund {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PaymentRefundCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PaymentRefundCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PaymentRefundCreate) defaults() {
+	if _, ok := _c.mutation.Currency(); !ok {
+		v := paymentrefund.DefaultCurrency
+		_c.mutation.SetCurrency(v)
+	}
+	if _, ok := _c.mutation.RefundStatus(); !ok {
+		v := paymentrefund.DefaultRefundStatus
+		_c.mutation.SetRefundStatus(v)
+	}
+	if _, ok := _c.mutation.CreditsReversed(); !ok {
+		v := paymentrefund.DefaultCreditsReversed
+		_c.mutation.SetCreditsReversed(v)
+	}
+	if _, ok := _c.mutation.CreditsWrittenOff(); !ok {
+		v := paymentrefund.DefaultCreditsWrittenOff
+		_c.mutation.SetCreditsWrittenOff(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := paymentrefund.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := paymentrefund.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PaymentRefundCreate) check() error {
+	if _, ok := _c.mutation.OrderID(); !ok {
+		return &ValidationError{Name: "order_id", err: errors.New(`generated: missing required field "PaymentRefund.order_id"`)}
+	}
+	if v, ok := _c.mutation.OrderID(); ok {
+		if err := paymentrefund.OrderIDValidator(v); err != nil {
+			return &ValidationError{Name: "order_id", err: fmt.Errorf(`generated: validator failed for field "PaymentRefund.order_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`generated: missing required field "PaymentRefund.user_id"`)}
+	}
+	if v, ok := _c.mutation.UserID(); ok {
+		if err := paymentrefund.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`generated: validator failed for field "PaymentRefund.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.RazorpayRefundID(); ok {
+		if err := paymentrefund.RazorpayRefundIDValidator(v); err != nil {
+			return &ValidationError{Name: "razorpay_refund_id", err: fmt.Errorf(`generated: validator failed for field "PaymentRefund.razorpay_refund_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`generated: missing required field "PaymentRefund.amount"`)}
+	}
+	if v, ok := _c.mutation.Amount(); ok {
+		if err := paymentrefund.AmountValidator(v); err != nil {
+			return &ValidationError{Name: "amount", err: fmt.Errorf(`generated: validator failed for field "PaymentRefund.amount": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`generated: missing required field "PaymentRefund.currency"`)}
+	}
+	if v, ok := _c.mutation.Currency(); ok {
+		if err := paymentrefund.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`generated: validator failed for field "PaymentRefund.currency": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RefundStatus(); !ok {
+		return &ValidationError{Name: "refund_status", err: errors.New(`generated: missing required field "PaymentRefund.refund_status"`)}
+	}
+	if v, ok := _c.mutation.RefundStatus(); ok {
+		if err := paymentrefund.RefundStatusValidator(v); err != nil {
+			return &ValidationError{Name: "refund_status", err: fmt.Errorf(`generated: validator failed for field "PaymentRefund.refund_status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New(`generated: missing required field "PaymentRefund.source"`)}
+	}
+	if v, ok := _c.mutation.Source(); ok {
+		if err := paymentrefund.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`generated: validator failed for field "PaymentRefund.source": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreditsReversed(); !ok {
+		return &ValidationError{Name: "credits_reversed", err: errors.New(`generated: missing required field "PaymentRefund.credits_reversed"`)}
+	}
+	if v, ok := _c.mutation.CreditsReversed(); ok {
+		if err := paymentrefund.CreditsReversedValidator(v); err != nil {
+			return &ValidationError{Name: "credits_reversed", err: fmt.Errorf(`generated: validator failed for field "PaymentRefund.credits_reversed": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreditsWrittenOff(); !ok {
+		return &ValidationError{Name: "credits_written_off", err: errors.New(`generated: missing required field "PaymentRefund.credits_written_off"`)}
+	}
+	if v, ok := _c.mutation.CreditsWrittenOff(); ok {
+		if err := paymentrefund.CreditsWrittenOffValidator(v); err != nil {
+			return &ValidationError{Name: "credits_written_off", err: fmt.Errorf(`generated: validator failed for field "PaymentRefund.credits_written_off": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "PaymentRefund.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`generated: missing required field "PaymentRefund.updated_at"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := paymentrefund.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`generated: validator failed for field "PaymentRefund.id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.OrderIDs()) == 0 {
+		return &ValidationError{Name: "order", err: errors.New(`generated: missing required edge "PaymentRefund.order"`)}
+	}
+	return nil
+}
+
+func (_c *PaymentRefundCreate) sqlSave(ctx context.Context) (*PaymentRefund, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected PaymentRefund.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PaymentRefundCreate) createSpec() (*PaymentRefund, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PaymentRefund{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(paymentrefund.Table, sqlgraph.NewFieldSpec(paymentrefund.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(paymentrefund.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.RazorpayRefundID(); ok {
+		_spec.SetField(paymentrefund.FieldRazorpayRefundID, field.TypeString, value)
+		_node.RazorpayRefundID = &value
+	}
+	if value, ok := _c.mutation.Amount(); ok {
+		_spec.SetField(paymentrefund.FieldAmount, field.TypeInt, value)
+		_node.Amount = value
+	}
+	if value, ok := _c.mutation.Currency(); ok {
+		_spec.SetField(paymentrefund.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := _c.mutation.RefundStatus(); ok {
+		_spec.SetField(paymentrefund.FieldRefundStatus, field.TypeEnum, value)
+		_node.RefundStatus = value
+	}
+	if value, ok := _c.mutation.Source(); ok {
+		_spec.SetField(paymentrefund.FieldSource, field.TypeEnum, value)
+		_node.Source = value
+	}
+	if value, ok := _c.mutation.CreditsReversed(); ok {
+		_spec.SetField(paymentrefund.FieldCreditsReversed, field.TypeInt, value)
+		_node.CreditsReversed = value
+	}
+	if value, ok := _c.mutation.CreditsWrittenOff(); ok {
+		_spec.SetField(paymentrefund.FieldCreditsWrittenOff, field.TypeInt, value)
+		_node.CreditsWrittenOff = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(paymentrefund.FieldReason, field.TypeString, value)
+		_node.Reason = &value
+	}
+	if value, ok := _c.mutation.FailureReason(); ok {
+		_spec.SetField(paymentrefund.FieldFailureReason, field.TypeString, value)
+		_node.FailureReason = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(paymentrefund.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(paymentrefund.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.ProcessedAt(); ok {
+		_spec.SetField(paymentrefund.FieldProcessedAt, field.TypeTime, value)
+		_node.ProcessedAt = &value
+	}
+	if nodes := _c.mutation.OrderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paymentrefund.OrderTable,
+			Columns: []string{paymentrefund.OrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentorder.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OrderID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PaymentRefund.Create().
+//		SetOrderID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PaymentRefundUpsert) {
+//			SetOrderID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PaymentRefundCreate) OnConflict(opts ...sql.ConflictOption) *PaymentRefundUpsertOne {
+	_c.conflict = opts
+	return &PaymentRefundUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PaymentRefund.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PaymentRefundCreate) OnConflictColumns(columns ...string) *PaymentRefundUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PaymentRefundUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// PaymentRefundUpsertOne is the builder for "upsert"-ing
+	//  one PaymentRefund node.
+	PaymentRefundUpsertOne struct {
+		create *PaymentRefundCreate
+	}
+
+	// PaymentRefundUpsert is the "OnConflict" setter.
+	PaymentRefundUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetRazorpayRefundID sets the "razorpay_refund_id" field.
+func (u *PaymentRefundUpsert) SetRazorpayRefundID(v string) *PaymentRefundUpsert {
+	u.Set(paymentrefund.FieldRazorpayRefundID, v)
+	return u
+}
+
+// UpdateRazorpayRefundID sets the "razorpay_refund_id" field to the value that was provided on create.
+func (u *PaymentRefundUpsert) UpdateRazorpayRefundID() *PaymentRefundUpsert {
+	u.SetExcluded(paymentrefund.FieldRazorpayRefundID)
+	return u
+}
+
+// ClearRazorpayRefundID clears the value of the "razorpay_refund_id" field.
+func (u *PaymentRefundUpsert) ClearRazorpayRefundID() *PaymentRefundUpsert {
+	u.SetNull(paymentrefund.FieldRazorpayRefundID)
+	return u
+}
+
+// SetCurrency sets the "currency" field.
+func (u *PaymentRefundUpsert) SetCurrency(v string) *PaymentRefundUpsert {
+	u.Set(paymentrefund.FieldCurrency, v)
+	return u
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *PaymentRefundUpsert) UpdateCurrency() *PaymentRefundUpsert {
+	u.SetExcluded(paymentrefund.FieldCurrency)
+	return u
+}
+
+// SetRefundStatus sets the "refund_status" field.
+func (u *PaymentRefundUpsert) SetRefundStatus(v paymentrefund.RefundStatus) *PaymentRefundUpsert {
+	u.Set(paymentrefund.FieldRefundStatus, v)
+	return u
+}
+
+// UpdateRefundStatus sets the "refund_status" field to the value that was provided on create.
+func (u *PaymentRefundUpsert) UpdateRefundStatus() *PaymentRefundUpsert {
+	u.SetExcluded(paymentrefund.FieldRefundStatus)
+	return u
+}
+
+// SetCreditsReversed sets the "credits_reversed" field.
+func (u *PaymentRefundUpsert) SetCreditsReversed(v int) *PaymentRefundUpsert {
+	u.Set(paymentrefund.FieldCreditsReversed, v)
+	return u
+}
+
+// UpdateCreditsReversed sets the "credits_reversed" field to the value that was provided on create.
+func (u *PaymentRefundUpsert) UpdateCreditsReversed() *PaymentRefundUpsert {
+	u.SetExcluded(paymentrefund.FieldCreditsReversed)
+	return u
+}
+
+// AddCreditsReversed adds v to the "credits_reversed" field.
+func (u *PaymentRefundUpsert) AddCreditsReversed(v int) *PaymentRefundUpsert {
+	u.Add(paymentrefund.FieldCreditsReversed, v)
+	return u
+}
+
+// SetCreditsWrittenOff sets the "credits_written_off" field.
+func (u *PaymentRefundUpsert) SetCreditsWrittenOff(v int) *PaymentRefundUpsert {
+	u.Set(paymentrefund.FieldCreditsWrittenOff, v)
+	return u
+}
+
+// UpdateCreditsWrittenOff sets the "credits_written_off" field to the value that was provided on create.
+func (u *PaymentRefundUpsert) UpdateCreditsWrittenOff() *PaymentRefundUpsert {
+	u.SetExcluded(paymentrefund.FieldCreditsWrittenOff)
+	return u
+}
+
+// AddCreditsWrittenOff adds v to the "credits_written_off" field.
+func (u *PaymentRefundUpsert) AddCreditsWrittenOff(v int) *PaymentRefundUpsert {
+	u.Add(paymentrefund.FieldCreditsWrittenOff, v)
+	return u
+}
+
+// SetReason sets the "reason" field.
+func (u *PaymentRefundUpsert) SetReason(v string) *PaymentRefundUpsert {
+	u.Set(paymentrefund.FieldReason, v)
+	return u
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *PaymentRefundUpsert) UpdateReason() *PaymentRefundUpsert {
+	u.SetExcluded(paymentrefund.FieldReason)
+	return u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (u *PaymentRefundUpsert) ClearReason() *PaymentRefundUpsert {
+	u.SetNull(paymentrefund.FieldReason)
+	return u
+}
+
+// SetFailureReason sets the "failure_reason" field.
+func (u *PaymentRefundUpsert) SetFailureReason(v string) *PaymentRefundUpsert {
+	u.Set(paymentrefund.FieldFailureReason, v)
+	return u
+}
+
+// UpdateFailureReason sets the "failure_reason" field to the value that was provided on create.
+func (u *PaymentRefundUpsert) UpdateFailureReason() *PaymentRefundUpsert {
+	u.SetExcluded(paymentrefund.FieldFailureReason)
+	return u
+}
+
+// ClearFailureReason clears the value of the "failure_reason" field.
+func (u *PaymentRefundUpsert) ClearFailureReason() *PaymentRefundUpsert {
+	u.SetNull(paymentrefund.FieldFailureReason)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PaymentRefundUpsert) SetUpdatedAt(v time.Time) *PaymentRefundUpsert {
+	u.Set(paymentrefund.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PaymentRefundUpsert) UpdateUpdatedAt() *PaymentRefundUpsert {
+	u.SetExcluded(paymentrefund.FieldUpdatedAt)
+	return u
+}
+
+// SetProcessedAt sets the "processed_at" field.
+func (u *PaymentRefundUpsert) SetProcessedAt(v time.Time) *PaymentRefundUpsert {
+	u.Set(paymentrefund.FieldProcessedAt, v)
+	return u
+}
+
+// UpdateProcessedAt sets the "processed_at" field to the value that was provided on create.
+func (u *PaymentRefundUpsert) UpdateProcessedAt() *PaymentRefundUpsert {
+	u.SetExcluded(paymentrefund.FieldProcessedAt)
+	return u
+}
+
+// ClearProcessedAt clears the value of the "processed_at" field.
+func (u *PaymentRefundUpsert) ClearProcessedAt() *PaymentRefundUpsert {
+	u.SetNull(paymentrefund.FieldProcessedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.PaymentRefund.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(paymentrefund.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PaymentRefundUpsertOne) UpdateNewValues() *PaymentRefundUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(paymentrefund.FieldID)
+		}
+		if _, exists := u.create.mutation.OrderID(); exists {
+			s.SetIgnore(paymentrefund.FieldOrderID)
+		}
+		if _, exists := u.create.mutation.UserID(); exists {
+			s.SetIgnore(paymentrefund.FieldUserID)
+		}
+		if _, exists := u.create.mutation.Amount(); exists {
+			s.SetIgnore(paymentrefund.FieldAmount)
+		}
+		if _, exists := u.create.mutation.Source(); exists {
+			s.SetIgnore(paymentrefund.FieldSource)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(paymentrefund.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PaymentRefund.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PaymentRefundUpsertOne) Ignore() *PaymentRefundUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PaymentRefundUpsertOne) DoNothing() *PaymentRefundUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PaymentRefundCreate.OnConflict
+// documentation for more info.
+func (u *PaymentRefundUpsertOne) Update(set func(*PaymentRefundUpsert)) *PaymentRefundUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PaymentRefundUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetRazorpayRefundID sets the "razorpay_refund_id" field.
+func (u *PaymentRefundUpsertOne) SetRazorpayRefundID(v string) *PaymentRefundUpsertOne {
+	return u.Update(func(s *PaymentRefundUpsert) {
+		s.SetRazorpayRefundID(v)
+	})
+}
+
+// UpdateRazorpayRefundID sets the "razorpay_refund_id" field to the value that was provided on create.
+func (u *PaymentRefundUpsertOne) UpdateRazorpayRefundID() *PaymentRefundUpsertOne {
+	return u.Update(func(s *PaymentRefundUpsert) {
+		s.UpdateRazorpayRefundID()
+	})
+}
+
+// ClearRazorpayRefundID clears the value of the "razorpay_refund_id" field.
+func (u *PaymentRefundUpsertOne) ClearRazorpayRefundID() *PaymentRefundUpsertOne {
+	return u.Update(func(s *PaymentRefundUpsert) {
+		s.ClearRazorpayRefundID()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *PaymentRefundUpsertOne) SetCurrency(v string) *PaymentRefundUpsertOne {
+	return u.Update(func(s *PaymentRefundUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *PaymentRefundUpsertOne) UpdateCurrency() *PaymentRefundUpsertOne {
+	return u.Update(func(s *PaymentRefundUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetRefundStatus sets the "refund_status" field.
+func (u *PaymentRefundUpsertOne) SetRefundStatus(v paymentrefund.RefundStatus) *PaymentRefundUpsertOne {
+	return u.Update(func(s *PaymentRefundUpsert) {
+		s.SetRefundStatus(v)
+	})
+}
+
+// UpdateRefundStatus sets the "refund_status" field to the value that was provided on create.
+func (u *PaymentRefundUpsertOne) UpdateRefundStatus() *PaymentRefundUpsertOne {
+	return u.Update(func(s *PaymentRefundUpsert) {
+		s.UpdateRefundStatus()
+	})
+}
+
+// SetCreditsReversed sets the "credits_reversed" field.
+func (u *PaymentRefundUpsertOne) SetCreditsReversed(v int) *PaymentRefundUpsertOne {
+	return u.Update(func(s *PaymentRefundUpsert) {
+		s.SetCreditsReversed(v)
+	})
+}
+
+// AddCreditsReversed adds v to the "credits_reversed" field.
+func (u *PaymentRefundUpsertOne) AddCreditsReversed(v int) *PaymentRefundUpsertOne {
+	return u.Update(func(s *PaymentRefundUpsert) {
+		s.AddCreditsReversed(v)
+	})
+}
+
+// UpdateCreditsReversed sets the "credits_reversed" field to the value that was provided on create.
+func (u *PaymentRefundUpsertOne) UpdateCreditsReversed() *PaymentRefundUpsertOne {
+	return u.Update(func(s *PaymentRefundUpsert) {
+		s.UpdateCreditsReversed()
+	})
+}
+
+// SetCreditsWrittenOff sets the "credits_written_off" field.
+func (u *PaymentRefundUpsertOne) SetCreditsWrittenOff(v int) *PaymentRefundUpsertOne {
+	return u.Update(func(s *PaymentRefundUpsert) {
+		s.SetCreditsWrittenOff(v)
+	})
+}
+
+// AddCreditsWrittenOff adds v to the "credits_written_off" field.
+func (u *PaymentRefundUpsertOne) AddCreditsWrittenOff(v int) *PaymentRefundUpsertOne {
+	return u.Update(func(s *PaymentRefundUpsert) {
+		s.AddCreditsWrittenOff(v)
+	})
+}
+
+// UpdateCreditsWrittenOff sets the "credits_written_off" field to the value that was provided on create.
+func (u *PaymentRefundUpsertOne) UpdateCreditsWrittenOff() *PaymentRefundUpsertOne {
+	return u.Update(func(s *PaymentRefundUpsert) {
+		s.UpdateCreditsWrittenOff()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *PaymentRefundUpsertOne) SetReason(v string) *PaymentRefundUpsertOne {
+	return u.Update(func(s *PaymentRefundUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *PaymentRefundUpsertOne) UpdateReason() *PaymentRefundUpsertOne {
+	return u.Update(func(s *PaymentRefundUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// ClearReason clears the value of the "reason" field.
+func (u *PaymentRefundUpsertOne) ClearReason() *PaymentRefundUpsertOne {
+	return u.Update(func(s *PaymentRefundUpsert) {
+		s.ClearReason()
+	})
+}
+
+// SetFailureReason sets the "failure_reason" field.
+func (u *PaymentRefundUpsertOne) SetFailureReason(v string) *PaymentRefundUpsertOne {
+	return u.Update(func(s *PaymentRefundUpsert) {
+		s.SetFailureReason(v)
+	})
+}
+
+// UpdateFailureReason sets the "failure_reason" field to the value that was provided on create.
+func (u *PaymentRefundUpsertOne) UpdateFailureReason() *PaymentRefundUpsertOne {
+	return u.Update(func(s *PaymentRefundUpsert) {
+		s.UpdateFailureReason()
+	})
+}
+
+// ClearFailureReason clears the value of the "failure_reason" field.
+func (u *PaymentRefundUpsertOne) ClearFailureReason() *PaymentRefundUpsertOne {
+	return u.Update(func(s *PaymentRefundUpsert) {
+		s.ClearFailureReason()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PaymentRefundUpsertOne) SetUpdatedAt(v time.Time) *PaymentRefundUpsertOne {
+	return u.Update(func(s *PaymentRefundUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PaymentRefundUpsertOne) UpdateUpdatedAt() *PaymentRefundUpsertOne {
+	return u.Update(func(s *PaymentRefundUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetProcessedAt sets the "processed_at" field.
+func (u *PaymentRefundUpsertOne) SetProcessedAt(v time.Time) *PaymentRefundUpsertOne {
+	return u.Update(func(s *PaymentRefundUpsert) {
+		s.SetProcessedAt(v)
+	})
+}
+
+// UpdateProcessedAt sets the "processed_at" field to the value that was provided on create.
+func (u *PaymentRefundUpsertOne) UpdateProcessedAt() *PaymentRefundUpsertOne {
+	return u.Update(func(s *PaymentRefundUpsert) {
+		s.UpdateProcessedAt()
+	})
+}
+
+// ClearProcessedAt clears the value of the "processed_at" field.
+func (u *PaymentRefundUpsertOne) ClearProcessedAt() *PaymentRefundUpsertOne {
+	return u.Update(func(s *PaymentRefundUpsert) {
+		s.ClearProcessedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PaymentRefundUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("generated: missing options for PaymentRefundCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PaymentRefundUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PaymentRefundUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("generated: PaymentRefundUpsertOne.ID is not supported by MySQL driver. Use PaymentRefundUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PaymentRefundUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PaymentRefundCreateBulk is the builder for creating many PaymentRefund entities in bulk.
+type PaymentRefundCreateBulk struct {
+	config
+	err      error
+	builders []*PaymentRefundCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the PaymentRefund entities in the database.
+func (_c *PaymentRefundCreateBulk) Save(ctx context.Context) ([]*PaymentRefund, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PaymentRefund, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PaymentRefundMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PaymentRefundCreateBulk) SaveX(ctx context.Context) []*PaymentRefund {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PaymentRefundCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PaymentRefundCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PaymentRefund.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PaymentRefundUpsert) {
+//			SetOrderID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PaymentRefundCreateBulk) OnConflict(opts ...sql.ConflictOption) *PaymentRefundUpsertBulk {
+	_c.conflict = opts
+	return &PaymentRefundUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PaymentRefund.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PaymentRefundCreateBulk) OnConflictColumns(columns ...string) *PaymentRefundUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PaymentRefundUpsertBulk{
+		create: _c,
+	}
+}
+
+// PaymentRefundUpsertBulk is the builder for "upsert"-ing
+// a bulk of PaymentRefund nodes.
+type PaymentRefundUpsertBulk struct {
+	create *PaymentRefundCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PaymentRefund.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(paymentrefund.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PaymentRefundUpsertBulk) UpdateNewValues() *PaymentRefundUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(paymentrefund.FieldID)
+			}
+			if _, exists := b.mutation.OrderID(); exists {
+				s.SetIgnore(paymentrefund.FieldOrderID)
+			}
+			if _, exists := b.mutation.UserID(); exists {
+				s.SetIgnore(paymentrefund.FieldUserID)
+			}
+			if _, exists := b.mutation.Amount(); exists {
+				s.SetIgnore(paymentrefund.FieldAmount)
+			}
+			if _, exists := b.mutation.Source(); exists {
+				s.SetIgnore(paymentrefund.FieldSource)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(paymentrefund.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PaymentRefund.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PaymentRefundUpsertBulk) Ignore() *PaymentRefundUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PaymentRefundUpsertBulk) DoNothing() *PaymentRefundUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PaymentRefundCreateBulk.OnConflict
+// documentation for more info.
+func (u *PaymentRefundUpsertBulk) Update(set func(*PaymentRefundUpsert)) *PaymentRefundUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PaymentRefundUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetRazorpayRefundID sets the "razorpay_refund_id" field.
+func (u *PaymentRefundUpsertBulk) SetRazorpayRefundID(v string) *PaymentRefundUpsertBulk {
+	return u.Update(func(s *PaymentRefundUpsert) {
+		s.SetRazorpayRefundID(v)
+	})
+}
+
+// UpdateRazorpayRefundID sets the "razorpay_refund_id" field to the value that was provided on create.
+func (u *PaymentRefundUpsertBulk) UpdateRazorpayRefundID() *PaymentRefundUpsertBulk {
+	return u.Update(func(s *PaymentRefundUpsert) {
+		s.UpdateRazorpayRefundID()
+	})
+}
+
+// ClearRazorpayRefundID clears the value of the "razorpay_refund_id" field.
+func (u *PaymentRefundUpsertBulk) ClearRazorpayRefundID() *PaymentRefundUpsertBulk {
+	return u.Update(func(s *PaymentRefundUpsert) {
+		s.ClearRazorpayRefundID()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *PaymentRefundUpsertBulk) SetCurrency(v string) *PaymentRefundUpsertBulk {
+	return u.Update(func(s *PaymentRefundUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *PaymentRefundUpsertBulk) UpdateCurrency() *PaymentRefundUpsertBulk {
+	return u.Update(func(s *PaymentRefundUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetRefundStatus sets the "refund_status" field.
+func (u *PaymentRefundUpsertBulk) SetRefundStatus(v paymentrefund.RefundStatus) *PaymentRefundUpsertBulk {
+	return u.Update(func(s *PaymentRefundUpsert) {
+		s.SetRefundStatus(v)
+	})
+}
+
+// UpdateRefundStatus sets the "refund_status" field to the value that was provided on create.
+func (u *PaymentRefundUpsertBulk) UpdateRefundStatus() *PaymentRefundUpsertBulk {
+	return u.Update(func(s *PaymentRefundUpsert) {
+		s.UpdateRefundStatus()
+	})
+}
+
+// SetCreditsReversed sets the "credits_reversed" field.
+func (u *PaymentRefundUpsertBulk) SetCreditsReversed(v int) *PaymentRefundUpsertBulk {
+	return u.Update(func(s *PaymentRefundUpsert) {
+		s.SetCreditsReversed(v)
+	})
+}
+
+// AddCreditsReversed adds v to the "credits_reversed" field.
+func (u *PaymentRefundUpsertBulk) AddCreditsReversed(v int) *PaymentRefundUpsertBulk {
+	return u.Update(func(s *PaymentRefundUpsert) {
+		s.AddCreditsReversed(v)
+	})
+}
+
+// UpdateCreditsReversed sets the "credits_reversed" field to the value that was provided on create.
+func (u *PaymentRefundUpsertBulk) UpdateCreditsReversed() *PaymentRefundUpsertBulk {
+	return u.Update(func(s *PaymentRefundUpsert) {
+		s.UpdateCreditsReversed()
+	})
+}
+
+// SetCreditsWrittenOff sets the "credits_written_off" field.
+func (u *PaymentRefundUpsertBulk) SetCreditsWrittenOff(v int) *PaymentRefundUpsertBulk {
+	return u.Update(func(s *PaymentRefundUpsert) {
+		s.SetCreditsWrittenOff(v)
+	})
+}
+
+// AddCreditsWrittenOff adds v to the "credits_written_off" field.
+func (u *PaymentRefundUpsertBulk) AddCreditsWrittenOff(v int) *PaymentRefundUpsertBulk {
+	return u.Update(func(s *PaymentRefundUpsert) {
+		s.AddCreditsWrittenOff(v)
+	})
+}
+
+// UpdateCreditsWrittenOff sets the "credits_written_off" field to the value that was provided on create.
+func (u *PaymentRefundUpsertBulk) UpdateCreditsWrittenOff() *PaymentRefundUpsertBulk {
+	return u.Update(func(s *PaymentRefundUpsert) {
+		s.UpdateCreditsWrittenOff()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *PaymentRefundUpsertBulk) SetReason(v string) *PaymentRefundUpsertBulk {
+	return u.Update(func(s *PaymentRefundUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *PaymentRefundUpsertBulk) UpdateReason() *PaymentRefundUpsertBulk {
+	return u.Update(func(s *PaymentRefundUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// ClearReason clears the value of the "reason" field.
+func (u *PaymentRefundUpsertBulk) ClearReason() *PaymentRefundUpsertBulk {
+	return u.Update(func(s *PaymentRefundUpsert) {
+		s.ClearReason()
+	})
+}
+
+// SetFailureReason sets the "failure_reason" field.
+func (u *PaymentRefundUpsertBulk) SetFailureReason(v string) *PaymentRefundUpsertBulk {
+	return u.Update(func(s *PaymentRefundUpsert) {
+		s.SetFailureReason(v)
+	})
+}
+
+// UpdateFailureReason sets the "failure_reason" field to the value that was provided on create.
+func (u *PaymentRefundUpsertBulk) UpdateFailureReason() *PaymentRefundUpsertBulk {
+	return u.Update(func(s *PaymentRefundUpsert) {
+		s.UpdateFailureReason()
+	})
+}
+
+// ClearFailureReason clears the value of the "failure_reason" field.
+func (u *PaymentRefundUpsertBulk) ClearFailureReason() *PaymentRefundUpsertBulk {
+	return u.Update(func(s *PaymentRefundUpsert) {
+		s.ClearFailureReason()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PaymentRefundUpsertBulk) SetUpdatedAt(v time.Time) *PaymentRefundUpsertBulk {
+	return u.Update(func(s *PaymentRefundUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PaymentRefundUpsertBulk) UpdateUpdatedAt() *PaymentRefundUpsertBulk {
+	return u.Update(func(s *PaymentRefundUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetProcessedAt sets the "processed_at" field.
+func (u *PaymentRefundUpsertBulk) SetProcessedAt(v time.Time) *PaymentRefundUpsertBulk {
+	return u.Update(func(s *PaymentRefundUpsert) {
+		s.SetProcessedAt(v)
+	})
+}
+
+// UpdateProcessedAt sets the "processed_at" field to the value that was provided on create.
+func (u *PaymentRefundUpsertBulk) UpdateProcessedAt() *PaymentRefundUpsertBulk {
+	return u.Update(func(s *PaymentRefundUpsert) {
+		s.UpdateProcessedAt()
+	})
+}
+
+// ClearProcessedAt clears the value of the "processed_at" field.
+func (u *PaymentRefundUpsertBulk) ClearProcessedAt() *PaymentRefundUpsertBulk {
+	return u.Update(func(s *PaymentRefundUpsert) {
+		s.ClearProcessedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PaymentRefundUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("generated: OnConflict was set for builder %d. Set it on the PaymentRefundCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("generated: missing options for PaymentRefundCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PaymentRefundUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,668 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/UnoraApp/be/ent/generated/paymentrefund"
+	"github.com/UnoraApp/be/ent/generated/predicate"
+)
+
+// PaymentRefundUpdate is the builder for updating PaymentRefund entities.
+type PaymentRefundUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PaymentRefundMutation
+}
+
+// Where appends a list predicates to the PaymentRefundUpdate builder.
+func (_u *PaymentRefundUpdate) Where(ps ...predicate.PaymentRefund) *PaymentRefundUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetRazorpayRefundID sets the "razorpay_refund_id" field.
+func (_u *PaymentRefundUpdate) SetRazorpayRefundID(v string) *PaymentRefundUpdate {
+	_u.mutation.SetRazorpayRefundID(v)
+	return _u
+}
+
+// SetNillableRazorpayRefundID sets the "razorpay_refund_id" field if the given value is not nil.
+func (_u *PaymentRefundUpdate) SetNillableRazorpayRefundID(v *string) *PaymentRefundUpdate {
+	if v != nil {
+		_u.SetRazorpayRefundID(*v)
+	}
+	return _u
+}
+
+// ClearRazorpayRefundID clears the value of the "razorpay_refund_id" field.
+func (_u *PaymentRefundUpdate) ClearRazorpayRefundID() *PaymentRefundUpdate {
+	_u.mutation.ClearRazorpayRefundID()
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *PaymentRefundUpdate) SetCurrency(v string) *PaymentRefundUpdate {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *PaymentRefundUpdate) SetNillableCurrency(v *string) *PaymentRefundUpdate {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetRefundStatus sets the "refund_status" field.
+func (_u *PaymentRefundUpdate) SetRefundStatus(v paymentrefund.RefundStatus) *PaymentRefundUpdate {
+	_u.mutation.SetRefundStatus(v)
+	return _u
+}
+
+// SetNillableRefundStatus sets the "refund_status" field if the given value is not nil.
+func (_u *PaymentRefundUpdate) SetNillableRefundStatus(v *paymentrefund.RefundStatus) *PaymentRefundUpdate {
+	if v != nil {
+		_u.SetRefundStatus(*v)
+	}
+	return _u
+}
+
+// SetCreditsReversed sets the "credits_reversed" field.
+func (_u *PaymentRefundUpdate) SetCreditsReversed(v int) *PaymentRefundUpdate {
+	_u.mutation.ResetCreditsReversed()
+	_u.mutation.SetCreditsReversed(v)
+	return _u
+}
+
+// SetNillableCreditsReversed sets the "credits_reversed" field if the given value is not nil.
+func (_u *PaymentRefundUpdate) SetNillableCreditsReversed(v *int) *PaymentRefundUpdate {
+	if v != nil {
+		_u.SetCreditsReversed(*v)
+	}
+	return _u
+}
+
+// AddCreditsReversed adds value to the "credits_reversed" field.
+func (_u *PaymentRefundUpdate) AddCreditsReversed(v int) *PaymentRefundUpdate {
+	_u.mutation.AddCreditsReversed(v)
+	return _u
+}
+
+// SetCreditsWrittenOff sets the "credits_written_off" field.
+func (_u *PaymentRefundUpdate) SetCreditsWrittenOff(v int) *PaymentRefundUpdate {
+	_u.mutation.ResetCreditsWrittenOff()
+	_u.mutation.SetCreditsWrittenOff(v)
+	return _u
+}
+
+// SetNillableCreditsWrittenOff sets the "credits_written_off" field if the given value is not nil.
+func (_u *PaymentRefundUpdate) SetNillableCreditsWrittenOff(v *int) *PaymentRefundUpdate {
+	if v != nil {
+		_u.SetCreditsWrittenOff(*v)
+	}
+	return _u
+}
+
+// AddCreditsWrittenOff adds value to the "credits_written_off" field.
+func (_u *PaymentRefundUpdate) AddCreditsWrittenOff(v int) *PaymentRefundUpdate {
+	_u.mutation.AddCreditsWrittenOff(v)
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *PaymentRefundUpdate) SetReason(v string) *PaymentRefundUpdate {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *PaymentRefundUpdate) SetNillableReason(v *string) *PaymentRefundUpdate {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (_u *PaymentRefundUpdate) ClearReason() *PaymentRefundUpdate {
+	_u.mutation.ClearReason()
+	return _u
+}
+
+// SetFailureReason sets the "failure_reason" field.
+func (_u *PaymentRefundUpdate) SetFailureReason(v string) *PaymentRefundUpdate {
+	_u.mutation.SetFailureReason(v)
+	return _u
+}
+
+// SetNillableFailureReason sets the "failure_reason" field if the given value is not nil.
+func (_u *PaymentRefundUpdate) SetNillableFailureReason(v *string) *PaymentRefundUpdate {
+	if v != nil {
+		_u.SetFailureReason(*v)
+	}
+	return _u
+}
+
+// ClearFailureReason clears the value of the "failure_reason" field.
+func (_u *PaymentRefundUpdate) ClearFailureReason() *PaymentRefundUpdate {
+	_u.mutation.ClearFailureReason()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PaymentRefundUpdate) SetUpdatedAt(v time.Time) *PaymentRefundUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetProcessedAt sets the "processed_at" field.
+func (_u *PaymentRefundUpdate) SetProcessedAt(v time.Time) *PaymentRefundUpdate {
+	_u.mutation.SetProcessedAt(v)
+	return _u
+}
+
+// SetNillableProcessedAt sets the "processed_at" field if the given value is not nil.
+func (_u *PaymentRefundUpdate) SetNillableProcessedAt(v *time.Time) *PaymentRefundUpdate {
+	if v != nil {
+		_u.SetProcessedAt(*v)
+	}
+	return _u
+}
+
+// ClearProcessedAt clears the value of the "processed_at" field.
+func (_u *PaymentRefundUpdate) ClearProcessedAt() *PaymentRefundUpdate {
+	_u.mutation.ClearProcessedAt()
+	return _u
+}
+
+// Mutation returns the PaymentRefundMutation object of the builder.
+func (_u *PaymentRefundUpdate) Mutation() *PaymentRefundMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PaymentRefundUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PaymentRefundUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PaymentRefundUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PaymentRefundUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PaymentRefundUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := paymentrefund.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PaymentRefundUpdate) check() error {
+	if v, ok := _u.mutation.RazorpayRefundID(); ok {
+		if err := paymentrefund.RazorpayRefundIDValidator(v); err != nil {
+			return &ValidationError{Name: "razorpay_refund_id", err: fmt.Errorf(`generated: validator failed for field "PaymentRefund.razorpay_refund_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Currency(); ok {
+		if err := paymentrefund.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`generated: validator failed for field "PaymentRefund.currency": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RefundStatus(); ok {
+		if err := paymentrefund.RefundStatusValidator(v); err != nil {
+			return &ValidationError{Name: "refund_status", err: fmt.Errorf(`generated: validator failed for field "PaymentRefund.refund_status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CreditsReversed(); ok {
+		if err := paymentrefund.CreditsReversedValidator(v); err != nil {
+			return &ValidationError{Name: "credits_reversed", err: fmt.Errorf(`generated: validator failed for field "PaymentRefund.credits_reversed": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CreditsWrittenOff(); ok {
+		if err := paymentrefund.CreditsWrittenOffValidator(v); err != nil {
+			return &ValidationError{Name: "credits_written_off", err: fmt.Errorf(`generated: validator failed for field "PaymentRefund.credits_written_off": %w`, err)}
+		}
+	}
+	if _u.mutation.OrderCleared() && len(_u.mutation.OrderIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "PaymentRefund.order"`)
+	}
+	return nil
+}
+
+func (_u *PaymentRefundUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(paymentrefund.Table, paymentrefund.Columns, sqlgraph.NewFieldSpec(paymentrefund.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.RazorpayRefundID(); ok {
+		_spec.SetField(paymentrefund.FieldRazorpayRefundID, field.TypeString, value)
+	}
+	if _u.mutation.RazorpayRefundIDCleared() {
+		_spec.ClearField(paymentrefund.FieldRazorpayRefundID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(paymentrefund.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RefundStatus(); ok {
+		_spec.SetField(paymentrefund.FieldRefundStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.CreditsReversed(); ok {
+		_spec.SetField(paymentrefund.FieldCreditsReversed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCreditsReversed(); ok {
+		_spec.AddField(paymentrefund.FieldCreditsReversed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CreditsWrittenOff(); ok {
+		_spec.SetField(paymentrefund.FieldCreditsWrittenOff, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCreditsWrittenOff(); ok {
+		_spec.AddField(paymentrefund.FieldCreditsWrittenOff, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(paymentrefund.FieldReason, field.TypeString, value)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(paymentrefund.FieldReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.FailureReason(); ok {
+		_spec.SetField(paymentrefund.FieldFailureReason, field.TypeString, value)
+	}
+	if _u.mutation.FailureReasonCleared() {
+		_spec.ClearField(paymentrefund.FieldFailureReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(paymentrefund.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ProcessedAt(); ok {
+		_spec.SetField(paymentrefund.FieldProcessedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ProcessedAtCleared() {
+		_spec.ClearField(paymentrefund.FieldProcessedAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{paymentrefund.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PaymentRefundUpdateOne is the builder for updating a single PaymentRefund entity.
+type PaymentRefundUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PaymentRefundMutation
+}
+
+// SetRazorpayRefundID sets the "razorpay_refund_id" field.
+func (_u *PaymentRefundUpdateOne) SetRazorpayRefundID(v string) *PaymentRefundUpdateOne {
+	_u.mutation.SetRazorpayRefundID(v)
+	return _u
+}
+
+// SetNillableRazorpayRefundID sets the "razorpay_refund_id" field if the given value is not nil.
+func (_u *PaymentRefundUpdateOne) SetNillableRazorpayRefundID(v *string) *PaymentRefundUpdateOne {
+	if v != nil {
+		_u.SetRazorpayRefundID(*v)
+	}
+	return _u
+}
+
+// ClearRazorpayRefundID clears the value of the "razorpay_refund_id" field.
+func (_u *PaymentRefundUpdateOne) ClearRazorpayRefundID() *PaymentRefundUpdateOne {
+	_u.mutation.ClearRazorpayRefundID()
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *PaymentRefundUpdateOne) SetCurrency(v string) *PaymentRefundUpdateOne {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *PaymentRefundUpdateOne) SetNillableCurrency(v *string) *PaymentRefundUpdateOne {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetRefundStatus sets the "refund_status" field.
+func (_u *PaymentRefundUpdateOne) SetRefundStatus(v paymentrefund.RefundStatus) *PaymentRefundUpdateOne {
+	_u.mutation.SetRefundStatus(v)
+	return _u
+}
+
+// SetNillableRefundStatus sets the "refund_status" field if the given value is not nil.
+func (_u *PaymentRefundUpdateOne) SetNillableRefundStatus(v *paymentrefund.RefundStatus) *PaymentRefundUpdateOne {
+	if v != nil {
+		_u.SetRefundStatus(*v)
+	}
+	return _u
+}
+
+// SetCreditsReversed sets the "credits_reversed" field.
+func (_u *PaymentRefundUpdateOne) SetCreditsReversed(v int) *PaymentRefundUpdateOne {
+	_u.mutation.ResetCreditsReversed()
+	_u.mutation.SetCreditsReversed(v)
+	return _u
+}
+
+// SetNillableCreditsReversed sets the "credits_reversed" field if the given value is not nil.
+func (_u *PaymentRefundUpdateOne) SetNillableCreditsReversed(v *int) *PaymentRefundUpdateOne {
+	if v != nil {
+		_u.SetCreditsReversed(*v)
+	}
+	return _u
+}
+
+// AddCreditsReversed adds value to the "credits_reversed" field.
+func (_u *PaymentRefundUpdateOne) AddCreditsReversed(v int) *PaymentRefundUpdateOne {
+	_u.mutation.AddCreditsReversed(v)
+	return _u
+}
+
+// SetCreditsWrittenOff sets the "credits_written_off" field.
+func (_u *PaymentRefundUpdateOne) SetCreditsWrittenOff(v int) *PaymentRefundUpdateOne {
+	_u.mutation.ResetCreditsWrittenOff()
+	_u.mutation.SetCreditsWrittenOff(v)
+	return _u
+}
+
+// SetNillableCreditsWrittenOff sets the "credits_written_off" field if the given value is not nil.
+func (_u *PaymentRefundUpdateOne) SetNillableCreditsWrittenOff(v *int) *PaymentRefundUpdateOne {
+	if v != nil {
+		_u.SetCreditsWrittenOff(*v)
+	}
+	return _u
+}
+
+// AddCreditsWrittenOff adds value to the "credits_written_off" field.
+func (_u *PaymentRefundUpdateOne) AddCreditsWrittenOff(v int) *PaymentRefundUpdateOne {
+	_u.mutation.AddCreditsWrittenOff(v)
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *PaymentRefundUpdateOne) SetReason(v string) *PaymentRefundUpdateOne {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *PaymentRefundUpdateOne) SetNillableReason(v *string) *PaymentRefundUpdateOne {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (_u *PaymentRefundUpdateOne) ClearReason() *PaymentRefundUpdateOne {
+	_u.mutation.ClearReason()
+	return _u
+}
+
+// SetFailureReason sets the "failure_reason" field.
+func (_u *PaymentRefundUpdateOne) SetFailureReason(v string) *PaymentRefundUpdateOne {
+	_u.mutation.SetFailureReason(v)
+	return _u
+}
+
+// SetNillableFailureReason sets the "failure_reason" field if the given value is not nil.
+func (_u *PaymentRefundUpdateOne) SetNillableFailureReason(v *string) *PaymentRefundUpdateOne {
+	if v != nil {
+		_u.SetFailureReason(*v)
+	}
+	return _u
+}
+
+// ClearFailureReason clears the value of the "failure_reason" field.
+func (_u *PaymentRefundUpdateOne) ClearFailureReason() *PaymentRefundUpdateOne {
+	_u.mutation.ClearFailureReason()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PaymentRefundUpdateOne) SetUpdatedAt(v time.Time) *PaymentRefundUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetProcessedAt sets the "processed_at" field.
+func (_u *PaymentRefundUpdateOne) SetProcessedAt(v time.Time) *PaymentRefundUpdateOne {
+	_u.mutation.SetProcessedAt(v)
+	return _u
+}
+
+// SetNillableProcessedAt sets the "processed_at" field if the given value is not nil.
+func (_u *PaymentRefundUpdateOne) SetNillableProcessedAt(v *time.Time) *PaymentRefundUpdateOne {
+	if v != nil {
+		_u.SetProcessedAt(*v)
+	}
+	return _u
+}
+
+// ClearProcessedAt clears the value of the "processed_at" field.
+func (_u *PaymentRefundUpdateOne) ClearProcessedAt() *PaymentRefundUpdateOne {
+	_u.mutation.ClearProcessedAt()
+	return _u
+}
+
+// Mutation returns the PaymentRefundMutation object of the builder.
+func (_u *PaymentRefundUpdateOne) Mutation() *PaymentRefundMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the PaymentRefundUpdate builder.
+func (_u *PaymentRefundUpdateOne) Where(ps ...predicate.PaymentRefund) *PaymentRefundUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PaymentRefundUpdateOne) Select(field string, fields ...string) *PaymentRefundUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PaymentRefund entity.
+func (_u *PaymentRefundUpdateOne) Save(ctx context.Context) (*PaymentRefund, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PaymentRefundUpdateOne) SaveX(ctx context.Context) *PaymentRefund {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PaymentRefundUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PaymentRefundUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PaymentRefundUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := paymentrefund.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PaymentRefundUpdateOne) check() error {
+	if v, ok := _u.mutation.RazorpayRefundID(); ok {
+		if err := paymentrefund.RazorpayRefundIDValidator(v); err != nil {
+			return &ValidationError{Name: "razorpay_refund_id", err: fmt.Errorf(`generated: validator failed for field "PaymentRefund.razorpay_refund_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Currency(); ok {
+		if err := paymentrefund.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`generated: validator failed for field "PaymentRefund.currency": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RefundStatus(); ok {
+		if err := paymentrefund.RefundStatusValidator(v); err != nil {
+			return &ValidationError{Name: "refund_status", err: fmt.Errorf(`generated: validator failed for field "PaymentRefund.refund_status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CreditsReversed(); ok {
+		if err := paymentrefund.CreditsReversedValidator(v); err != nil {
+			return &ValidationError{Name: "credits_reversed", err: fmt.Errorf(`generated: validator failed for field "PaymentRefund.credits_reversed": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CreditsWrittenOff(); ok {
+		if err := paymentrefund.CreditsWrittenOffValidator(v); err != nil {
+			return &ValidationError{Name: "credits_written_off", err: fmt.Errorf(`generated: validator failed for field "PaymentRefund.credits_written_off": %w`, err)}
+		}
+	}
+	if _u.mutation.OrderCleared() && len(_u.mutation.OrderIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "PaymentRefund.order"`)
+	}
+	return nil
+}
+
+func (_u *PaymentRefundUpdateOne) sqlSave(ctx context.Context) (_node *PaymentRefund, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(paymentrefund.Table, paymentrefund.Columns, sqlgraph.NewFieldSpec(paymentrefund.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "PaymentRefund.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, paymentrefund.FieldID)
+		for _, f := range fields {
+			if !paymentrefund.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != paymentrefund.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.RazorpayRefundID(); ok {
+		_spec.SetField(paymentrefund.FieldRazorpayRefundID, field.TypeString, value)
+	}
+	if _u.mutation.RazorpayRefundIDCleared() {
+		_spec.ClearField(paymentrefund.FieldRazorpayRefundID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(paymentrefund.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RefundStatus(); ok {
+		_spec.SetField(paymentrefund.FieldRefundStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.CreditsReversed(); ok {
+		_spec.SetField(paymentrefund.FieldCreditsReversed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCreditsReversed(); ok {
+		_spec.AddField(paymentrefund.FieldCreditsReversed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CreditsWrittenOff(); ok {
+		_spec.SetField(paymentrefund.FieldCreditsWrittenOff, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCreditsWrittenOff(); ok {
+		_spec.AddField(paymentrefund.FieldCreditsWrittenOff, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(paymentrefund.FieldReason, field.TypeString, value)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(paymentrefund.FieldReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.FailureReason(); ok {
+		_spec.SetField(paymentrefund.FieldFailureReason, field.TypeString, value)
+	}
+	if _u.mutation.FailureReasonCleared() {
+		_spec.ClearField(paymentrefund.FieldFailureReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(paymentrefund.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ProcessedAt(); ok {
+		_spec.SetField(paymentrefund.FieldProcessedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ProcessedAtCleared() {
+		_spec.ClearField(paymentrefund.FieldProcessedAt, field.TypeTime)
+	}
+	_node = &PaymentRefund{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{paymentrefund.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
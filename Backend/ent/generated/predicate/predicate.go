@@ -6,21 +6,39 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// AccountDeletion is the predicate function for accountdeletion builders.
+type AccountDeletion func(*sql.Selector)
+
+// AdminUser is the predicate function for adminuser builders.
+type AdminUser func(*sql.Selector)
+
 // AuditLog is the predicate function for auditlog builders.
 type AuditLog func(*sql.Selector)
 
+// BalanceDrift is the predicate function for balancedrift builders.
+type BalanceDrift func(*sql.Selector)
+
 // CheckIn is the predicate function for checkin builders.
 type CheckIn func(*sql.Selector)
 
 // Connection is the predicate function for connection builders.
 type Connection func(*sql.Selector)
 
+// Conversation is the predicate function for conversation builders.
+type Conversation func(*sql.Selector)
+
 // CreditPackage is the predicate function for creditpackage builders.
 type CreditPackage func(*sql.Selector)
 
 // CreditTransaction is the predicate function for credittransaction builders.
 type CreditTransaction func(*sql.Selector)
 
+// DataExport is the predicate function for dataexport builders.
+type DataExport func(*sql.Selector)
+
+// DeviceRiskFlag is the predicate function for deviceriskflag builders.
+type DeviceRiskFlag func(*sql.Selector)
+
 // DiscoveryBatch is the predicate function for discoverybatch builders.
 type DiscoveryBatch func(*sql.Selector)
 
@@ -39,38 +57,104 @@ type HobbyOption func(*sql.Selector)
 // Interest is the predicate function for interest builders.
 type Interest func(*sql.Selector)
 
+// Invoice is the predicate function for invoice builders.
+type Invoice func(*sql.Selector)
+
+// InvoiceSequence is the predicate function for invoicesequence builders.
+type InvoiceSequence func(*sql.Selector)
+
+// LedgerEntry is the predicate function for ledgerentry builders.
+type LedgerEntry func(*sql.Selector)
+
+// Message is the predicate function for message builders.
+type Message func(*sql.Selector)
+
+// ModerationAction is the predicate function for moderationaction builders.
+type ModerationAction func(*sql.Selector)
+
+// Notification is the predicate function for notification builders.
+type Notification func(*sql.Selector)
+
 // Nudge is the predicate function for nudge builders.
 type Nudge func(*sql.Selector)
 
+// PaymentDiscrepancy is the predicate function for paymentdiscrepancy builders.
+type PaymentDiscrepancy func(*sql.Selector)
+
 // PaymentOrder is the predicate function for paymentorder builders.
 type PaymentOrder func(*sql.Selector)
 
+// PaymentReconciliationReport is the predicate function for paymentreconciliationreport builders.
+type PaymentReconciliationReport func(*sql.Selector)
+
+// PaymentRefund is the predicate function for paymentrefund builders.
+type PaymentRefund func(*sql.Selector)
+
 // Photo is the predicate function for photo builders.
 type Photo func(*sql.Selector)
 
 // Profile is the predicate function for profile builders.
 type Profile func(*sql.Selector)
 
+// PromoCode is the predicate function for promocode builders.
+type PromoCode func(*sql.Selector)
+
+// PromoRedemption is the predicate function for promoredemption builders.
+type PromoRedemption func(*sql.Selector)
+
+// Referral is the predicate function for referral builders.
+type Referral func(*sql.Selector)
+
+// ReportEvidence is the predicate function for reportevidence builders.
+type ReportEvidence func(*sql.Selector)
+
 // Reveal is the predicate function for reveal builders.
 type Reveal func(*sql.Selector)
 
 // RevealContent is the predicate function for revealcontent builders.
 type RevealContent func(*sql.Selector)
 
+// RevealGift is the predicate function for revealgift builders.
+type RevealGift func(*sql.Selector)
+
 // RevealMilestone is the predicate function for revealmilestone builders.
 type RevealMilestone func(*sql.Selector)
 
+// RevealView is the predicate function for revealview builders.
+type RevealView func(*sql.Selector)
+
 // Server is the predicate function for server builders.
 type Server func(*sql.Selector)
 
 // Streak is the predicate function for streak builders.
 type Streak func(*sql.Selector)
 
+// Subscription is the predicate function for subscription builders.
+type Subscription func(*sql.Selector)
+
+// SubscriptionPlan is the predicate function for subscriptionplan builders.
+type SubscriptionPlan func(*sql.Selector)
+
+// TierEntitlement is the predicate function for tierentitlement builders.
+type TierEntitlement func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
 
 // UserBlock is the predicate function for userblock builders.
 type UserBlock func(*sql.Selector)
 
+// UserDevice is the predicate function for userdevice builders.
+type UserDevice func(*sql.Selector)
+
+// UserIdentity is the predicate function for useridentity builders.
+type UserIdentity func(*sql.Selector)
+
 // UserReport is the predicate function for userreport builders.
 type UserReport func(*sql.Selector)
+
+// UserSession is the predicate function for usersession builders.
+type UserSession func(*sql.Selector)
+
+// WebhookEvent is the predicate function for webhookevent builders.
+type WebhookEvent func(*sql.Selector)
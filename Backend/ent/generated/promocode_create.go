@@ -0,0 +1,1384 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/UnoraApp/be/ent/generated/promocode"
+	"github.com/UnoraApp/be/ent/generated/promoredemption"
+)
+
+// PromoCodeCreate is the builder for creating a PromoCode entity.
+type PromoCodeCreate struct {
+	config
+	mutation *PromoCodeMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCode sets the "code" field.
+func (_c *PromoCodeCreate) SetCode(v string) *PromoCodeCreate {
+	_c.mutation.SetCode(v)
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *PromoCodeCreate) SetDescription(v string) *PromoCodeCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *PromoCodeCreate) SetNillableDescription(v *string) *PromoCodeCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetKind sets the "kind" field.
+func (_c *PromoCodeCreate) SetKind(v promocode.Kind) *PromoCodeCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetCreditAmount sets the "credit_amount" field.
+func (_c *PromoCodeCreate) SetCreditAmount(v int) *PromoCodeCreate {
+	_c.mutation.SetCreditAmount(v)
+	return _c
+}
+
+// SetNillableCreditAmount sets the "credit_amount" field if the given value is not nil.
+func (_c *PromoCodeCreate) SetNillableCreditAmount(v *int) *PromoCodeCreate {
+	if v != nil {
+		_c.SetCreditAmount(*v)
+	}
+	return _c
+}
+
+// SetDiscountPercent sets the "discount_percent" field.
+func (_c *PromoCodeCreate) SetDiscountPercent(v int) *PromoCodeCreate {
+	_c.mutation.SetDiscountPercent(v)
+	return _c
+}
+
+// SetNillableDiscountPercent sets the "discount_percent" field if the given value is not nil.
+func (_c *PromoCodeCreate) SetNillableDiscountPercent(v *int) *PromoCodeCreate {
+	if v != nil {
+		_c.SetDiscountPercent(*v)
+	}
+	return _c
+}
+
+// SetPackageID sets the "package_id" field.
+func (_c *PromoCodeCreate) SetPackageID(v string) *PromoCodeCreate {
+	_c.mutation.SetPackageID(v)
+	return _c
+}
+
+// SetNillablePackageID sets the "package_id" field if the given value is not nil.
+func (_c *PromoCodeCreate) SetNillablePackageID(v *string) *PromoCodeCreate {
+	if v != nil {
+		_c.SetPackageID(*v)
+	}
+	return _c
+}
+
+// SetMaxRedemptions sets the "max_redemptions" field.
+func (_c *PromoCodeCreate) SetMaxRedemptions(v int) *PromoCodeCreate {
+	_c.mutation.SetMaxRedemptions(v)
+	return _c
+}
+
+// SetNillableMaxRedemptions sets the "max_redemptions" field if the given value is not nil.
+func (_c *PromoCodeCreate) SetNillableMaxRedemptions(v *int) *PromoCodeCreate {
+	if v != nil {
+		_c.SetMaxRedemptions(*v)
+	}
+	return _c
+}
+
+// SetPerUserLimit sets the "per_user_limit" field.
+func (_c *PromoCodeCreate) SetPerUserLimit(v int) *PromoCodeCreate {
+	_c.mutation.SetPerUserLimit(v)
+	return _c
+}
+
+// SetNillablePerUserLimit sets the "per_user_limit" field if the given value is not nil.
+func (_c *PromoCodeCreate) SetNillablePerUserLimit(v *int) *PromoCodeCreate {
+	if v != nil {
+		_c.SetPerUserLimit(*v)
+	}
+	return _c
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (_c *PromoCodeCreate) SetStartsAt(v time.Time) *PromoCodeCreate {
+	_c.mutation.SetStartsAt(v)
+	return _c
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (_c *PromoCodeCreate) SetNillableStartsAt(v *time.Time) *PromoCodeCreate {
+	if v != nil {
+		_c.SetStartsAt(*v)
+	}
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *PromoCodeCreate) SetExpiresAt(v time.Time) *PromoCodeCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_c *PromoCodeCreate) SetNillableExpiresAt(v *time.Time) *PromoCodeCreate {
+	if v != nil {
+		_c.SetExpiresAt(*v)
+	}
+	return _c
+}
+
+// SetIsActive sets the "is_active" field.
+func (_c *PromoCodeCreate) SetIsActive(v bool) *PromoCodeCreate {
+	_c.mutation.SetIsActive(v)
+	return _c
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (_c *PromoCodeCreate) SetNillableIsActive(v *bool) *PromoCodeCreate {
+	if v != nil {
+		_c.SetIsActive(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *PromoCodeCreate) SetCreatedAt(v time.Time) *PromoCodeCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *PromoCodeCreate) SetNillableCreatedAt(v *time.Time) *PromoCodeCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *PromoCodeCreate) SetUpdatedAt(v time.Time) *PromoCodeCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *PromoCodeCreate) SetNillableUpdatedAt(v *time.Time) *PromoCodeCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PromoCodeCreate) SetID(v string) *PromoCodeCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// AddRedemptionIDs adds the "redemptions" edge to the PromoRedemption entity by IDs.
+func (_c *PromoCodeCreate) AddRedemptionIDs(ids ...string) *PromoCodeCreate {
+	_c.mutation.AddRedemptionIDs(ids...)
+	return _c
+}
+
+// AddRedemptions adds the "redemptions" edges to the PromoRedemption entity.
+func (_c *PromoCodeCreate) AddRedemptions(v ...*PromoRedemption) *PromoCodeCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRedemptionIDs(ids...)
+}
+
+// Mutation returns the PromoCodeMutation object of the builder.
+func (_c *PromoCodeCreate) Mutation() *PromoCodeMutation {
+	return _c.mutation
+}
+
+// Save creates the PromoCode in the database.
+func (_c *PromoCodeCreate) Save(ctx context.Context) (*PromoCode, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PromoCodeCreate) SaveX(ctx context.Context) *PromoCode {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PromoCodeCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PromoCodeCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PromoCodeCreate) defaults() {
+	if _, ok := _c.mutation.CreditAmount(); !ok {
+		v := promocode.DefaultCreditAmount
+		_c.mutation.SetCreditAmount(v)
+	}
+	if _, ok := _c.mutation.DiscountPercent(); !ok {
+		v := promocode.DefaultDiscountPercent
+		_c.mutation.SetDiscountPercent(v)
+	}
+	if _, ok := _c.mutation.PerUserLimit(); !ok {
+		v := promocode.DefaultPerUserLimit
+		_c.mutation.SetPerUserLimit(v)
+	}
+	if _, ok := _c.mutation.IsActive(); !ok {
+		v := promocode.DefaultIsActive
+		_c.mutation.SetIsActive(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := promocode.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := promocode.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PromoCodeCreate) check() error {
+	if _, ok := _c.mutation.Code(); !ok {
+		return &ValidationError{Name: "code", err: errors.New(`generated: missing required field "PromoCode.code"`)}
+	}
+	if v, ok := _c.mutation.Code(); ok {
+		if err := promocode.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`generated: validator failed for field "PromoCode.code": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Description(); ok {
+		if err := promocode.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`generated: validator failed for field "PromoCode.description": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`generated: missing required field "PromoCode.kind"`)}
+	}
+	if v, ok := _c.mutation.Kind(); ok {
+		if err := promocode.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`generated: validator failed for field "PromoCode.kind": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreditAmount(); !ok {
+		return &ValidationError{Name: "credit_amount", err: errors.New(`generated: missing required field "PromoCode.credit_amount"`)}
+	}
+	if v, ok := _c.mutation.CreditAmount(); ok {
+		if err := promocode.CreditAmountValidator(v); err != nil {
+			return &ValidationError{Name: "credit_amount", err: fmt.Errorf(`generated: validator failed for field "PromoCode.credit_amount": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.DiscountPercent(); !ok {
+		return &ValidationError{Name: "discount_percent", err: errors.New(`generated: missing required field "PromoCode.discount_percent"`)}
+	}
+	if v, ok := _c.mutation.DiscountPercent(); ok {
+		if err := promocode.DiscountPercentValidator(v); err != nil {
+			return &ValidationError{Name: "discount_percent", err: fmt.Errorf(`generated: validator failed for field "PromoCode.discount_percent": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.PackageID(); ok {
+		if err := promocode.PackageIDValidator(v); err != nil {
+			return &ValidationError{Name: "package_id", err: fmt.Errorf(`generated: validator failed for field "PromoCode.package_id": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.MaxRedemptions(); ok {
+		if err := promocode.MaxRedemptionsValidator(v); err != nil {
+			return &ValidationError{Name: "max_redemptions", err: fmt.Errorf(`generated: validator failed for field "PromoCode.max_redemptions": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PerUserLimit(); !ok {
+		return &ValidationError{Name: "per_user_limit", err: errors.New(`generated: missing required field "PromoCode.per_user_limit"`)}
+	}
+	if v, ok := _c.mutation.PerUserLimit(); ok {
+		if err := promocode.PerUserLimitValidator(v); err != nil {
+			return &ValidationError{Name: "per_user_limit", err: fmt.Errorf(`generated: validator failed for field "PromoCode.per_user_limit": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.IsActive(); !ok {
+		return &ValidationError{Name: "is_active", err: errors.New(`generated: missing required field "PromoCode.is_active"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "PromoCode.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`generated: missing required field "PromoCode.updated_at"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := promocode.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`generated: validator failed for field "PromoCode.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *PromoCodeCreate) sqlSave(ctx context.Context) (*PromoCode, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected PromoCode.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PromoCodeCreate) createSpec() (*PromoCode, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PromoCode{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(promocode.Table, sqlgraph.NewFieldSpec(promocode.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.Code(); ok {
+		_spec.SetField(promocode.FieldCode, field.TypeString, value)
+		_node.Code = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(promocode.FieldDescription, field.TypeString, value)
+		_node.Description = &value
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(promocode.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.CreditAmount(); ok {
+		_spec.SetField(promocode.FieldCreditAmount, field.TypeInt, value)
+		_node.CreditAmount = value
+	}
+	if value, ok := _c.mutation.DiscountPercent(); ok {
+		_spec.SetField(promocode.FieldDiscountPercent, field.TypeInt, value)
+		_node.DiscountPercent = value
+	}
+	if value, ok := _c.mutation.PackageID(); ok {
+		_spec.SetField(promocode.FieldPackageID, field.TypeString, value)
+		_node.PackageID = &value
+	}
+	if value, ok := _c.mutation.MaxRedemptions(); ok {
+		_spec.SetField(promocode.FieldMaxRedemptions, field.TypeInt, value)
+		_node.MaxRedemptions = &value
+	}
+	if value, ok := _c.mutation.PerUserLimit(); ok {
+		_spec.SetField(promocode.FieldPerUserLimit, field.TypeInt, value)
+		_node.PerUserLimit = value
+	}
+	if value, ok := _c.mutation.StartsAt(); ok {
+		_spec.SetField(promocode.FieldStartsAt, field.TypeTime, value)
+		_node.StartsAt = &value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(promocode.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if value, ok := _c.mutation.IsActive(); ok {
+		_spec.SetField(promocode.FieldIsActive, field.TypeBool, value)
+		_node.IsActive = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(promocode.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(promocode.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.RedemptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   promocode.RedemptionsTable,
+			Columns: []string{promocode.RedemptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(promoredemption.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PromoCode.Create().
+//		SetCode(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PromoCodeUpsert) {
+//			SetCode(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PromoCodeCreate) OnConflict(opts ...sql.ConflictOption) *PromoCodeUpsertOne {
+	_c.conflict = opts
+	return &PromoCodeUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PromoCode.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PromoCodeCreate) OnConflictColumns(columns ...string) *PromoCodeUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PromoCodeUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// PromoCodeUpsertOne is the builder for "upsert"-ing
+	//  one PromoCode node.
+	PromoCodeUpsertOne struct {
+		create *PromoCodeCreate
+	}
+
+	// PromoCodeUpsert is the "OnConflict" setter.
+	PromoCodeUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetDescription sets the "description" field.
+func (u *PromoCodeUpsert) SetDescription(v string) *PromoCodeUpsert {
+	u.Set(promocode.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *PromoCodeUpsert) UpdateDescription() *PromoCodeUpsert {
+	u.SetExcluded(promocode.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *PromoCodeUpsert) ClearDescription() *PromoCodeUpsert {
+	u.SetNull(promocode.FieldDescription)
+	return u
+}
+
+// SetCreditAmount sets the "credit_amount" field.
+func (u *PromoCodeUpsert) SetCreditAmount(v int) *PromoCodeUpsert {
+	u.Set(promocode.FieldCreditAmount, v)
+	return u
+}
+
+// UpdateCreditAmount sets the "credit_amount" field to the value that was provided on create.
+func (u *PromoCodeUpsert) UpdateCreditAmount() *PromoCodeUpsert {
+	u.SetExcluded(promocode.FieldCreditAmount)
+	return u
+}
+
+// AddCreditAmount adds v to the "credit_amount" field.
+func (u *PromoCodeUpsert) AddCreditAmount(v int) *PromoCodeUpsert {
+	u.Add(promocode.FieldCreditAmount, v)
+	return u
+}
+
+// SetDiscountPercent sets the "discount_percent" field.
+func (u *PromoCodeUpsert) SetDiscountPercent(v int) *PromoCodeUpsert {
+	u.Set(promocode.FieldDiscountPercent, v)
+	return u
+}
+
+// UpdateDiscountPercent sets the "discount_percent" field to the value that was provided on create.
+func (u *PromoCodeUpsert) UpdateDiscountPercent() *PromoCodeUpsert {
+	u.SetExcluded(promocode.FieldDiscountPercent)
+	return u
+}
+
+// AddDiscountPercent adds v to the "discount_percent" field.
+func (u *PromoCodeUpsert) AddDiscountPercent(v int) *PromoCodeUpsert {
+	u.Add(promocode.FieldDiscountPercent, v)
+	return u
+}
+
+// SetPackageID sets the "package_id" field.
+func (u *PromoCodeUpsert) SetPackageID(v string) *PromoCodeUpsert {
+	u.Set(promocode.FieldPackageID, v)
+	return u
+}
+
+// UpdatePackageID sets the "package_id" field to the value that was provided on create.
+func (u *PromoCodeUpsert) UpdatePackageID() *PromoCodeUpsert {
+	u.SetExcluded(promocode.FieldPackageID)
+	return u
+}
+
+// ClearPackageID clears the value of the "package_id" field.
+func (u *PromoCodeUpsert) ClearPackageID() *PromoCodeUpsert {
+	u.SetNull(promocode.FieldPackageID)
+	return u
+}
+
+// SetMaxRedemptions sets the "max_redemptions" field.
+func (u *PromoCodeUpsert) SetMaxRedemptions(v int) *PromoCodeUpsert {
+	u.Set(promocode.FieldMaxRedemptions, v)
+	return u
+}
+
+// UpdateMaxRedemptions sets the "max_redemptions" field to the value that was provided on create.
+func (u *PromoCodeUpsert) UpdateMaxRedemptions() *PromoCodeUpsert {
+	u.SetExcluded(promocode.FieldMaxRedemptions)
+	return u
+}
+
+// AddMaxRedemptions adds v to the "max_redemptions" field.
+func (u *PromoCodeUpsert) AddMaxRedemptions(v int) *PromoCodeUpsert {
+	u.Add(promocode.FieldMaxRedemptions, v)
+	return u
+}
+
+// ClearMaxRedemptions clears the value of the "max_redemptions" field.
+func (u *PromoCodeUpsert) ClearMaxRedemptions() *PromoCodeUpsert {
+	u.SetNull(promocode.FieldMaxRedemptions)
+	return u
+}
+
+// SetPerUserLimit sets the "per_user_limit" field.
+func (u *PromoCodeUpsert) SetPerUserLimit(v int) *PromoCodeUpsert {
+	u.Set(promocode.FieldPerUserLimit, v)
+	return u
+}
+
+// UpdatePerUserLimit sets the "per_user_limit" field to the value that was provided on create.
+func (u *PromoCodeUpsert) UpdatePerUserLimit() *PromoCodeUpsert {
+	u.SetExcluded(promocode.FieldPerUserLimit)
+	return u
+}
+
+// AddPerUserLimit adds v to the "per_user_limit" field.
+func (u *PromoCodeUpsert) AddPerUserLimit(v int) *PromoCodeUpsert {
+	u.Add(promocode.FieldPerUserLimit, v)
+	return u
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (u *PromoCodeUpsert) SetStartsAt(v time.Time) *PromoCodeUpsert {
+	u.Set(promocode.FieldStartsAt, v)
+	return u
+}
+
+// UpdateStartsAt sets the "starts_at" field to the value that was provided on create.
+func (u *PromoCodeUpsert) UpdateStartsAt() *PromoCodeUpsert {
+	u.SetExcluded(promocode.FieldStartsAt)
+	return u
+}
+
+// ClearStartsAt clears the value of the "starts_at" field.
+func (u *PromoCodeUpsert) ClearStartsAt() *PromoCodeUpsert {
+	u.SetNull(promocode.FieldStartsAt)
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *PromoCodeUpsert) SetExpiresAt(v time.Time) *PromoCodeUpsert {
+	u.Set(promocode.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *PromoCodeUpsert) UpdateExpiresAt() *PromoCodeUpsert {
+	u.SetExcluded(promocode.FieldExpiresAt)
+	return u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *PromoCodeUpsert) ClearExpiresAt() *PromoCodeUpsert {
+	u.SetNull(promocode.FieldExpiresAt)
+	return u
+}
+
+// SetIsActive sets the "is_active" field.
+func (u *PromoCodeUpsert) SetIsActive(v bool) *PromoCodeUpsert {
+	u.Set(promocode.FieldIsActive, v)
+	return u
+}
+
+// UpdateIsActive sets the "is_active" field to the value that was provided on create.
+func (u *PromoCodeUpsert) UpdateIsActive() *PromoCodeUpsert {
+	u.SetExcluded(promocode.FieldIsActive)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PromoCodeUpsert) SetUpdatedAt(v time.Time) *PromoCodeUpsert {
+	u.Set(promocode.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PromoCodeUpsert) UpdateUpdatedAt() *PromoCodeUpsert {
+	u.SetExcluded(promocode.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.PromoCode.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(promocode.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PromoCodeUpsertOne) UpdateNewValues() *PromoCodeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(promocode.FieldID)
+		}
+		if _, exists := u.create.mutation.Code(); exists {
+			s.SetIgnore(promocode.FieldCode)
+		}
+		if _, exists := u.create.mutation.Kind(); exists {
+			s.SetIgnore(promocode.FieldKind)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(promocode.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PromoCode.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PromoCodeUpsertOne) Ignore() *PromoCodeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PromoCodeUpsertOne) DoNothing() *PromoCodeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PromoCodeCreate.OnConflict
+// documentation for more info.
+func (u *PromoCodeUpsertOne) Update(set func(*PromoCodeUpsert)) *PromoCodeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PromoCodeUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *PromoCodeUpsertOne) SetDescription(v string) *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *PromoCodeUpsertOne) UpdateDescription() *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *PromoCodeUpsertOne) ClearDescription() *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetCreditAmount sets the "credit_amount" field.
+func (u *PromoCodeUpsertOne) SetCreditAmount(v int) *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.SetCreditAmount(v)
+	})
+}
+
+// AddCreditAmount adds v to the "credit_amount" field.
+func (u *PromoCodeUpsertOne) AddCreditAmount(v int) *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.AddCreditAmount(v)
+	})
+}
+
+// UpdateCreditAmount sets the "credit_amount" field to the value that was provided on create.
+func (u *PromoCodeUpsertOne) UpdateCreditAmount() *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.UpdateCreditAmount()
+	})
+}
+
+// SetDiscountPercent sets the "discount_percent" field.
+func (u *PromoCodeUpsertOne) SetDiscountPercent(v int) *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.SetDiscountPercent(v)
+	})
+}
+
+// AddDiscountPercent adds v to the "discount_percent" field.
+func (u *PromoCodeUpsertOne) AddDiscountPercent(v int) *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.AddDiscountPercent(v)
+	})
+}
+
+// UpdateDiscountPercent sets the "discount_percent" field to the value that was provided on create.
+func (u *PromoCodeUpsertOne) UpdateDiscountPercent() *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.UpdateDiscountPercent()
+	})
+}
+
+// SetPackageID sets the "package_id" field.
+func (u *PromoCodeUpsertOne) SetPackageID(v string) *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.SetPackageID(v)
+	})
+}
+
+// UpdatePackageID sets the "package_id" field to the value that was provided on create.
+func (u *PromoCodeUpsertOne) UpdatePackageID() *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.UpdatePackageID()
+	})
+}
+
+// ClearPackageID clears the value of the "package_id" field.
+func (u *PromoCodeUpsertOne) ClearPackageID() *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.ClearPackageID()
+	})
+}
+
+// SetMaxRedemptions sets the "max_redemptions" field.
+func (u *PromoCodeUpsertOne) SetMaxRedemptions(v int) *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.SetMaxRedemptions(v)
+	})
+}
+
+// AddMaxRedemptions adds v to the "max_redemptions" field.
+func (u *PromoCodeUpsertOne) AddMaxRedemptions(v int) *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.AddMaxRedemptions(v)
+	})
+}
+
+// UpdateMaxRedemptions sets the "max_redemptions" field to the value that was provided on create.
+func (u *PromoCodeUpsertOne) UpdateMaxRedemptions() *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.UpdateMaxRedemptions()
+	})
+}
+
+// ClearMaxRedemptions clears the value of the "max_redemptions" field.
+func (u *PromoCodeUpsertOne) ClearMaxRedemptions() *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.ClearMaxRedemptions()
+	})
+}
+
+// SetPerUserLimit sets the "per_user_limit" field.
+func (u *PromoCodeUpsertOne) SetPerUserLimit(v int) *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.SetPerUserLimit(v)
+	})
+}
+
+// AddPerUserLimit adds v to the "per_user_limit" field.
+func (u *PromoCodeUpsertOne) AddPerUserLimit(v int) *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.AddPerUserLimit(v)
+	})
+}
+
+// UpdatePerUserLimit sets the "per_user_limit" field to the value that was provided on create.
+func (u *PromoCodeUpsertOne) UpdatePerUserLimit() *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.UpdatePerUserLimit()
+	})
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (u *PromoCodeUpsertOne) SetStartsAt(v time.Time) *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.SetStartsAt(v)
+	})
+}
+
+// UpdateStartsAt sets the "starts_at" field to the value that was provided on create.
+func (u *PromoCodeUpsertOne) UpdateStartsAt() *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.UpdateStartsAt()
+	})
+}
+
+// ClearStartsAt clears the value of the "starts_at" field.
+func (u *PromoCodeUpsertOne) ClearStartsAt() *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.ClearStartsAt()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *PromoCodeUpsertOne) SetExpiresAt(v time.Time) *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *PromoCodeUpsertOne) UpdateExpiresAt() *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *PromoCodeUpsertOne) ClearExpiresAt() *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// SetIsActive sets the "is_active" field.
+func (u *PromoCodeUpsertOne) SetIsActive(v bool) *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.SetIsActive(v)
+	})
+}
+
+// UpdateIsActive sets the "is_active" field to the value that was provided on create.
+func (u *PromoCodeUpsertOne) UpdateIsActive() *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.UpdateIsActive()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PromoCodeUpsertOne) SetUpdatedAt(v time.Time) *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PromoCodeUpsertOne) UpdateUpdatedAt() *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PromoCodeUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("generated: missing options for PromoCodeCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PromoCodeUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PromoCodeUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("generated: PromoCodeUpsertOne.ID is not supported by MySQL driver. Use PromoCodeUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PromoCodeUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PromoCodeCreateBulk is the builder for creating many PromoCode entities in bulk.
+type PromoCodeCreateBulk struct {
+	config
+	err      error
+	builders []*PromoCodeCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the PromoCode entities in the database.
+func (_c *PromoCodeCreateBulk) Save(ctx context.Context) ([]*PromoCode, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PromoCode, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PromoCodeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PromoCodeCreateBulk) SaveX(ctx context.Context) []*PromoCode {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PromoCodeCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PromoCodeCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PromoCode.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PromoCodeUpsert) {
+//			SetCode(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PromoCodeCreateBulk) OnConflict(opts ...sql.ConflictOption) *PromoCodeUpsertBulk {
+	_c.conflict = opts
+	return &PromoCodeUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PromoCode.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PromoCodeCreateBulk) OnConflictColumns(columns ...string) *PromoCodeUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PromoCodeUpsertBulk{
+		create: _c,
+	}
+}
+
+// PromoCodeUpsertBulk is the builder for "upsert"-ing
+// a bulk of PromoCode nodes.
+type PromoCodeUpsertBulk struct {
+	create *PromoCodeCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PromoCode.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(promocode.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PromoCodeUpsertBulk) UpdateNewValues() *PromoCodeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(promocode.FieldID)
+			}
+			if _, exists := b.mutation.Code(); exists {
+				s.SetIgnore(promocode.FieldCode)
+			}
+			if _, exists := b.mutation.Kind(); exists {
+				s.SetIgnore(promocode.FieldKind)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(promocode.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PromoCode.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PromoCodeUpsertBulk) Ignore() *PromoCodeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PromoCodeUpsertBulk) DoNothing() *PromoCodeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PromoCodeCreateBulk.OnConflict
+// documentation for more info.
+func (u *PromoCodeUpsertBulk) Update(set func(*PromoCodeUpsert)) *PromoCodeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PromoCodeUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *PromoCodeUpsertBulk) SetDescription(v string) *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *PromoCodeUpsertBulk) UpdateDescription() *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *PromoCodeUpsertBulk) ClearDescription() *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetCreditAmount sets the "credit_amount" field.
+func (u *PromoCodeUpsertBulk) SetCreditAmount(v int) *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.SetCreditAmount(v)
+	})
+}
+
+// AddCreditAmount adds v to the "credit_amount" field.
+func (u *PromoCodeUpsertBulk) AddCreditAmount(v int) *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.AddCreditAmount(v)
+	})
+}
+
+// UpdateCreditAmount sets the "credit_amount" field to the value that was provided on create.
+func (u *PromoCodeUpsertBulk) UpdateCreditAmount() *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.UpdateCreditAmount()
+	})
+}
+
+// SetDiscountPercent sets the "discount_percent" field.
+func (u *PromoCodeUpsertBulk) SetDiscountPercent(v int) *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.SetDiscountPercent(v)
+	})
+}
+
+// AddDiscountPercent adds v to the "discount_percent" field.
+func (u *PromoCodeUpsertBulk) AddDiscountPercent(v int) *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.AddDiscountPercent(v)
+	})
+}
+
+// UpdateDiscountPercent sets the "discount_percent" field to the value that was provided on create.
+func (u *PromoCodeUpsertBulk) UpdateDiscountPercent() *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.UpdateDiscountPercent()
+	})
+}
+
+// SetPackageID sets the "package_id" field.
+func (u *PromoCodeUpsertBulk) SetPackageID(v string) *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.SetPackageID(v)
+	})
+}
+
+// UpdatePackageID sets the "package_id" field to the value that was provided on create.
+func (u *PromoCodeUpsertBulk) UpdatePackageID() *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.UpdatePackageID()
+	})
+}
+
+// ClearPackageID clears the value of the "package_id" field.
+func (u *PromoCodeUpsertBulk) ClearPackageID() *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.ClearPackageID()
+	})
+}
+
+// SetMaxRedemptions sets the "max_redemptions" field.
+func (u *PromoCodeUpsertBulk) SetMaxRedemptions(v int) *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.SetMaxRedemptions(v)
+	})
+}
+
+// AddMaxRedemptions adds v to the "max_redemptions" field.
+func (u *PromoCodeUpsertBulk) AddMaxRedemptions(v int) *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.AddMaxRedemptions(v)
+	})
+}
+
+// UpdateMaxRedemptions sets the "max_redemptions" field to the value that was provided on create.
+func (u *PromoCodeUpsertBulk) UpdateMaxRedemptions() *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.UpdateMaxRedemptions()
+	})
+}
+
+// ClearMaxRedemptions clears the value of the "max_redemptions" field.
+func (u *PromoCodeUpsertBulk) ClearMaxRedemptions() *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.ClearMaxRedemptions()
+	})
+}
+
+// SetPerUserLimit sets the "per_user_limit" field.
+func (u *PromoCodeUpsertBulk) SetPerUserLimit(v int) *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.SetPerUserLimit(v)
+	})
+}
+
+// AddPerUserLimit adds v to the "per_user_limit" field.
+func (u *PromoCodeUpsertBulk) AddPerUserLimit(v int) *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.AddPerUserLimit(v)
+	})
+}
+
+// UpdatePerUserLimit sets the "per_user_limit" field to the value that was provided on create.
+func (u *PromoCodeUpsertBulk) UpdatePerUserLimit() *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.UpdatePerUserLimit()
+	})
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (u *PromoCodeUpsertBulk) SetStartsAt(v time.Time) *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.SetStartsAt(v)
+	})
+}
+
+// UpdateStartsAt sets the "starts_at" field to the value that was provided on create.
+func (u *PromoCodeUpsertBulk) UpdateStartsAt() *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.UpdateStartsAt()
+	})
+}
+
+// ClearStartsAt clears the value of the "starts_at" field.
+func (u *PromoCodeUpsertBulk) ClearStartsAt() *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.ClearStartsAt()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *PromoCodeUpsertBulk) SetExpiresAt(v time.Time) *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *PromoCodeUpsertBulk) UpdateExpiresAt() *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *PromoCodeUpsertBulk) ClearExpiresAt() *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// SetIsActive sets the "is_active" field.
+func (u *PromoCodeUpsertBulk) SetIsActive(v bool) *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.SetIsActive(v)
+	})
+}
+
+// UpdateIsActive sets the "is_active" field to the value that was provided on create.
+func (u *PromoCodeUpsertBulk) UpdateIsActive() *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.UpdateIsActive()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PromoCodeUpsertBulk) SetUpdatedAt(v time.Time) *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PromoCodeUpsertBulk) UpdateUpdatedAt() *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PromoCodeUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("generated: OnConflict was set for builder %d. Set it on the PromoCodeCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("generated: missing options for PromoCodeCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PromoCodeUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,927 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/UnoraApp/be/ent/generated/predicate"
+	"github.com/UnoraApp/be/ent/generated/promocode"
+	"github.com/UnoraApp/be/ent/generated/promoredemption"
+)
+
+// PromoCodeUpdate is the builder for updating PromoCode entities.
+type PromoCodeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PromoCodeMutation
+}
+
+// Where appends a list predicates to the PromoCodeUpdate builder.
+func (_u *PromoCodeUpdate) Where(ps ...predicate.PromoCode) *PromoCodeUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *PromoCodeUpdate) SetDescription(v string) *PromoCodeUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *PromoCodeUpdate) SetNillableDescription(v *string) *PromoCodeUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *PromoCodeUpdate) ClearDescription() *PromoCodeUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetCreditAmount sets the "credit_amount" field.
+func (_u *PromoCodeUpdate) SetCreditAmount(v int) *PromoCodeUpdate {
+	_u.mutation.ResetCreditAmount()
+	_u.mutation.SetCreditAmount(v)
+	return _u
+}
+
+// SetNillableCreditAmount sets the "credit_amount" field if the given value is not nil.
+func (_u *PromoCodeUpdate) SetNillableCreditAmount(v *int) *PromoCodeUpdate {
+	if v != nil {
+		_u.SetCreditAmount(*v)
+	}
+	return _u
+}
+
+// AddCreditAmount adds value to the "credit_amount" field.
+func (_u *PromoCodeUpdate) AddCreditAmount(v int) *PromoCodeUpdate {
+	_u.mutation.AddCreditAmount(v)
+	return _u
+}
+
+// SetDiscountPercent sets the "discount_percent" field.
+func (_u *PromoCodeUpdate) SetDiscountPercent(v int) *PromoCodeUpdate {
+	_u.mutation.ResetDiscountPercent()
+	_u.mutation.SetDiscountPercent(v)
+	return _u
+}
+
+// SetNillableDiscountPercent sets the "discount_percent" field if the given value is not nil.
+func (_u *PromoCodeUpdate) SetNillableDiscountPercent(v *int) *PromoCodeUpdate {
+	if v != nil {
+		_u.SetDiscountPercent(*v)
+	}
+	return _u
+}
+
+// AddDiscountPercent adds value to the "discount_percent" field.
+func (_u *PromoCodeUpdate) AddDiscountPercent(v int) *PromoCodeUpdate {
+	_u.mutation.AddDiscountPercent(v)
+	return _u
+}
+
+// SetPackageID sets the "package_id" field.
+func (_u *PromoCodeUpdate) SetPackageID(v string) *PromoCodeUpdate {
+	_u.mutation.SetPackageID(v)
+	return _u
+}
+
+// SetNillablePackageID sets the "package_id" field if the given value is not nil.
+func (_u *PromoCodeUpdate) SetNillablePackageID(v *string) *PromoCodeUpdate {
+	if v != nil {
+		_u.SetPackageID(*v)
+	}
+	return _u
+}
+
+// ClearPackageID clears the value of the "package_id" field.
+func (_u *PromoCodeUpdate) ClearPackageID() *PromoCodeUpdate {
+	_u.mutation.ClearPackageID()
+	return _u
+}
+
+// SetMaxRedemptions sets the "max_redemptions" field.
+func (_u *PromoCodeUpdate) SetMaxRedemptions(v int) *PromoCodeUpdate {
+	_u.mutation.ResetMaxRedemptions()
+	_u.mutation.SetMaxRedemptions(v)
+	return _u
+}
+
+// SetNillableMaxRedemptions sets the "max_redemptions" field if the given value is not nil.
+func (_u *PromoCodeUpdate) SetNillableMaxRedemptions(v *int) *PromoCodeUpdate {
+	if v != nil {
+		_u.SetMaxRedemptions(*v)
+	}
+	return _u
+}
+
+// AddMaxRedemptions adds value to the "max_redemptions" field.
+func (_u *PromoCodeUpdate) AddMaxRedemptions(v int) *PromoCodeUpdate {
+	_u.mutation.AddMaxRedemptions(v)
+	return _u
+}
+
+// ClearMaxRedemptions clears the value of the "max_redemptions" field.
+func (_u *PromoCodeUpdate) ClearMaxRedemptions() *PromoCodeUpdate {
+	_u.mutation.ClearMaxRedemptions()
+	return _u
+}
+
+// SetPerUserLimit sets the "per_user_limit" field.
+func (_u *PromoCodeUpdate) SetPerUserLimit(v int) *PromoCodeUpdate {
+	_u.mutation.ResetPerUserLimit()
+	_u.mutation.SetPerUserLimit(v)
+	return _u
+}
+
+// SetNillablePerUserLimit sets the "per_user_limit" field if the given value is not nil.
+func (_u *PromoCodeUpdate) SetNillablePerUserLimit(v *int) *PromoCodeUpdate {
+	if v != nil {
+		_u.SetPerUserLimit(*v)
+	}
+	return _u
+}
+
+// AddPerUserLimit adds value to the "per_user_limit" field.
+func (_u *PromoCodeUpdate) AddPerUserLimit(v int) *PromoCodeUpdate {
+	_u.mutation.AddPerUserLimit(v)
+	return _u
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (_u *PromoCodeUpdate) SetStartsAt(v time.Time) *PromoCodeUpdate {
+	_u.mutation.SetStartsAt(v)
+	return _u
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (_u *PromoCodeUpdate) SetNillableStartsAt(v *time.Time) *PromoCodeUpdate {
+	if v != nil {
+		_u.SetStartsAt(*v)
+	}
+	return _u
+}
+
+// ClearStartsAt clears the value of the "starts_at" field.
+func (_u *PromoCodeUpdate) ClearStartsAt() *PromoCodeUpdate {
+	_u.mutation.ClearStartsAt()
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *PromoCodeUpdate) SetExpiresAt(v time.Time) *PromoCodeUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *PromoCodeUpdate) SetNillableExpiresAt(v *time.Time) *PromoCodeUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *PromoCodeUpdate) ClearExpiresAt() *PromoCodeUpdate {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// SetIsActive sets the "is_active" field.
+func (_u *PromoCodeUpdate) SetIsActive(v bool) *PromoCodeUpdate {
+	_u.mutation.SetIsActive(v)
+	return _u
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (_u *PromoCodeUpdate) SetNillableIsActive(v *bool) *PromoCodeUpdate {
+	if v != nil {
+		_u.SetIsActive(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PromoCodeUpdate) SetUpdatedAt(v time.Time) *PromoCodeUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddRedemptionIDs adds the "redemptions" edge to the PromoRedemption entity by IDs.
+func (_u *PromoCodeUpdate) AddRedemptionIDs(ids ...string) *PromoCodeUpdate {
+	_u.mutation.AddRedemptionIDs(ids...)
+	return _u
+}
+
+// AddRedemptions adds the "redemptions" edges to the PromoRedemption entity.
+func (_u *PromoCodeUpdate) AddRedemptions(v ...*PromoRedemption) *PromoCodeUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRedemptionIDs(ids...)
+}
+
+// Mutation returns the PromoCodeMutation object of the builder.
+func (_u *PromoCodeUpdate) Mutation() *PromoCodeMutation {
+	return _u.mutation
+}
+
+// ClearRedemptions clears all "redemptions" edges to the PromoRedemption entity.
+func (_u *PromoCodeUpdate) ClearRedemptions() *PromoCodeUpdate {
+	_u.mutation.ClearRedemptions()
+	return _u
+}
+
+// RemoveRedemptionIDs removes the "redemptions" edge to PromoRedemption entities by IDs.
+func (_u *PromoCodeUpdate) RemoveRedemptionIDs(ids ...string) *PromoCodeUpdate {
+	_u.mutation.RemoveRedemptionIDs(ids...)
+	return _u
+}
+
+// RemoveRedemptions removes "redemptions" edges to PromoRedemption entities.
+func (_u *PromoCodeUpdate) RemoveRedemptions(v ...*PromoRedemption) *PromoCodeUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRedemptionIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PromoCodeUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PromoCodeUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PromoCodeUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PromoCodeUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PromoCodeUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := promocode.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PromoCodeUpdate) check() error {
+	if v, ok := _u.mutation.Description(); ok {
+		if err := promocode.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`generated: validator failed for field "PromoCode.description": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CreditAmount(); ok {
+		if err := promocode.CreditAmountValidator(v); err != nil {
+			return &ValidationError{Name: "credit_amount", err: fmt.Errorf(`generated: validator failed for field "PromoCode.credit_amount": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DiscountPercent(); ok {
+		if err := promocode.DiscountPercentValidator(v); err != nil {
+			return &ValidationError{Name: "discount_percent", err: fmt.Errorf(`generated: validator failed for field "PromoCode.discount_percent": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PackageID(); ok {
+		if err := promocode.PackageIDValidator(v); err != nil {
+			return &ValidationError{Name: "package_id", err: fmt.Errorf(`generated: validator failed for field "PromoCode.package_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaxRedemptions(); ok {
+		if err := promocode.MaxRedemptionsValidator(v); err != nil {
+			return &ValidationError{Name: "max_redemptions", err: fmt.Errorf(`generated: validator failed for field "PromoCode.max_redemptions": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PerUserLimit(); ok {
+		if err := promocode.PerUserLimitValidator(v); err != nil {
+			return &ValidationError{Name: "per_user_limit", err: fmt.Errorf(`generated: validator failed for field "PromoCode.per_user_limit": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *PromoCodeUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(promocode.Table, promocode.Columns, sqlgraph.NewFieldSpec(promocode.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(promocode.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(promocode.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreditAmount(); ok {
+		_spec.SetField(promocode.FieldCreditAmount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCreditAmount(); ok {
+		_spec.AddField(promocode.FieldCreditAmount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DiscountPercent(); ok {
+		_spec.SetField(promocode.FieldDiscountPercent, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDiscountPercent(); ok {
+		_spec.AddField(promocode.FieldDiscountPercent, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.PackageID(); ok {
+		_spec.SetField(promocode.FieldPackageID, field.TypeString, value)
+	}
+	if _u.mutation.PackageIDCleared() {
+		_spec.ClearField(promocode.FieldPackageID, field.TypeString)
+	}
+	if value, ok := _u.mutation.MaxRedemptions(); ok {
+		_spec.SetField(promocode.FieldMaxRedemptions, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxRedemptions(); ok {
+		_spec.AddField(promocode.FieldMaxRedemptions, field.TypeInt, value)
+	}
+	if _u.mutation.MaxRedemptionsCleared() {
+		_spec.ClearField(promocode.FieldMaxRedemptions, field.TypeInt)
+	}
+	if value, ok := _u.mutation.PerUserLimit(); ok {
+		_spec.SetField(promocode.FieldPerUserLimit, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPerUserLimit(); ok {
+		_spec.AddField(promocode.FieldPerUserLimit, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.StartsAt(); ok {
+		_spec.SetField(promocode.FieldStartsAt, field.TypeTime, value)
+	}
+	if _u.mutation.StartsAtCleared() {
+		_spec.ClearField(promocode.FieldStartsAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(promocode.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(promocode.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.IsActive(); ok {
+		_spec.SetField(promocode.FieldIsActive, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(promocode.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RedemptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   promocode.RedemptionsTable,
+			Columns: []string{promocode.RedemptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(promoredemption.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRedemptionsIDs(); len(nodes) > 0 && !_u.mutation.RedemptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   promocode.RedemptionsTable,
+			Columns: []string{promocode.RedemptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(promoredemption.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RedemptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   promocode.RedemptionsTable,
+			Columns: []string{promocode.RedemptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(promoredemption.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{promocode.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PromoCodeUpdateOne is the builder for updating a single PromoCode entity.
+type PromoCodeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PromoCodeMutation
+}
+
+// SetDescription sets the "description" field.
+func (_u *PromoCodeUpdateOne) SetDescription(v string) *PromoCodeUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *PromoCodeUpdateOne) SetNillableDescription(v *string) *PromoCodeUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *PromoCodeUpdateOne) ClearDescription() *PromoCodeUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetCreditAmount sets the "credit_amount" field.
+func (_u *PromoCodeUpdateOne) SetCreditAmount(v int) *PromoCodeUpdateOne {
+	_u.mutation.ResetCreditAmount()
+	_u.mutation.SetCreditAmount(v)
+	return _u
+}
+
+// SetNillableCreditAmount sets the "credit_amount" field if the given value is not nil.
+func (_u *PromoCodeUpdateOne) SetNillableCreditAmount(v *int) *PromoCodeUpdateOne {
+	if v != nil {
+		_u.SetCreditAmount(*v)
+	}
+	return _u
+}
+
+// AddCreditAmount adds value to the "credit_amount" field.
+func (_u *PromoCodeUpdateOne) AddCreditAmount(v int) *PromoCodeUpdateOne {
+	_u.mutation.AddCreditAmount(v)
+	return _u
+}
+
+// SetDiscountPercent sets the "discount_percent" field.
+func (_u *PromoCodeUpdateOne) SetDiscountPercent(v int) *PromoCodeUpdateOne {
+	_u.mutation.ResetDiscountPercent()
+	_u.mutation.SetDiscountPercent(v)
+	return _u
+}
+
+// SetNillableDiscountPercent sets the "discount_percent" field if the given value is not nil.
+func (_u *PromoCodeUpdateOne) SetNillableDiscountPercent(v *int) *PromoCodeUpdateOne {
+	if v != nil {
+		_u.SetDiscountPercent(*v)
+	}
+	return _u
+}
+
+// AddDiscountPercent adds value to the "discount_percent" field.
+func (_u *PromoCodeUpdateOne) AddDiscountPercent(v int) *PromoCodeUpdateOne {
+	_u.mutation.AddDiscountPercent(v)
+	return _u
+}
+
+// SetPackageID sets the "package_id" field.
+func (_u *PromoCodeUpdateOne) SetPackageID(v string) *PromoCodeUpdateOne {
+	_u.mutation.SetPackageID(v)
+	return _u
+}
+
+// SetNillablePackageID sets the "package_id" field if the given value is not nil.
+func (_u *PromoCodeUpdateOne) SetNillablePackageID(v *string) *PromoCodeUpdateOne {
+	if v != nil {
+		_u.SetPackageID(*v)
+	}
+	return _u
+}
+
+// ClearPackageID clears the value of the "package_id" field.
+func (_u *PromoCodeUpdateOne) ClearPackageID() *PromoCodeUpdateOne {
+	_u.mutation.ClearPackageID()
+	return _u
+}
+
+// SetMaxRedemptions sets the "max_redemptions" field.
+func (_u *PromoCodeUpdateOne) SetMaxRedemptions(v int) *PromoCodeUpdateOne {
+	_u.mutation.ResetMaxRedemptions()
+	_u.mutation.SetMaxRedemptions(v)
+	return _u
+}
+
+// SetNillableMaxRedemptions sets the "max_redemptions" field if the given value is not nil.
+func (_u *PromoCodeUpdateOne) SetNillableMaxRedemptions(v *int) *PromoCodeUpdateOne {
+	if v != nil {
+		_u.SetMaxRedemptions(*v)
+	}
+	return _u
+}
+
+// AddMaxRedemptions adds value to the "max_redemptions" field.
+func (_u *PromoCodeUpdateOne) AddMaxRedemptions(v int) *PromoCodeUpdateOne {
+	_u.mutation.AddMaxRedemptions(v)
+	return _u
+}
+
+// ClearMaxRedemptions clears the value of the "max_redemptions" field.
+func (_u *PromoCodeUpdateOne) ClearMaxRedemptions() *PromoCodeUpdateOne {
+	_u.mutation.ClearMaxRedemptions()
+	return _u
+}
+
+// SetPerUserLimit sets the "per_user_limit" field.
+func (_u *PromoCodeUpdateOne) SetPerUserLimit(v int) *PromoCodeUpdateOne {
+	_u.mutation.ResetPerUserLimit()
+	_u.mutation.SetPerUserLimit(v)
+	return _u
+}
+
+// SetNillablePerUserLimit sets the "per_user_limit" field if the given value is not nil.
+func (_u *PromoCodeUpdateOne) SetNillablePerUserLimit(v *int) *PromoCodeUpdateOne {
+	if v != nil {
+		_u.SetPerUserLimit(*v)
+	}
+	return _u
+}
+
+// AddPerUserLimit adds value to the "per_user_limit" field.
+func (_u *PromoCodeUpdateOne) AddPerUserLimit(v int) *PromoCodeUpdateOne {
+	_u.mutation.AddPerUserLimit(v)
+	return _u
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (_u *PromoCodeUpdateOne) SetStartsAt(v time.Time) *PromoCodeUpdateOne {
+	_u.mutation.SetStartsAt(v)
+	return _u
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (_u *PromoCodeUpdateOne) SetNillableStartsAt(v *time.Time) *PromoCodeUpdateOne {
+	if v != nil {
+		_u.SetStartsAt(*v)
+	}
+	return _u
+}
+
+// ClearStartsAt clears the value of the "starts_at" field.
+func (_u *PromoCodeUpdateOne) ClearStartsAt() *PromoCodeUpdateOne {
+	_u.mutation.ClearStartsAt()
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *PromoCodeUpdateOne) SetExpiresAt(v time.Time) *PromoCodeUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *PromoCodeUpdateOne) SetNillableExpiresAt(v *time.Time) *PromoCodeUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *PromoCodeUpdateOne) ClearExpiresAt() *PromoCodeUpdateOne {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// SetIsActive sets the "is_active" field.
+func (_u *PromoCodeUpdateOne) SetIsActive(v bool) *PromoCodeUpdateOne {
+	_u.mutation.SetIsActive(v)
+	return _u
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (_u *PromoCodeUpdateOne) SetNillableIsActive(v *bool) *PromoCodeUpdateOne {
+	if v != nil {
+		_u.SetIsActive(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PromoCodeUpdateOne) SetUpdatedAt(v time.Time) *PromoCodeUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddRedemptionIDs adds the "redemptions" edge to the PromoRedemption entity by IDs.
+func (_u *PromoCodeUpdateOne) AddRedemptionIDs(ids ...string) *PromoCodeUpdateOne {
+	_u.mutation.AddRedemptionIDs(ids...)
+	return _u
+}
+
+// AddRedemptions adds the "redemptions" edges to the PromoRedemption entity.
+func (_u *PromoCodeUpdateOne) AddRedemptions(v ...*PromoRedemption) *PromoCodeUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRedemptionIDs(ids...)
+}
+
+// Mutation returns the PromoCodeMutation object of the builder.
+func (_u *PromoCodeUpdateOne) Mutation() *PromoCodeMutation {
+	return _u.mutation
+}
+
+// ClearRedemptions clears all "redemptions" edges to the PromoRedemption entity.
+func (_u *PromoCodeUpdateOne) ClearRedemptions() *PromoCodeUpdateOne {
+	_u.mutation.ClearRedemptions()
+	return _u
+}
+
+// RemoveRedemptionIDs removes the "redemptions" edge to PromoRedemption entities by IDs.
+func (_u *PromoCodeUpdateOne) RemoveRedemptionIDs(ids ...string) *PromoCodeUpdateOne {
+	_u.mutation.RemoveRedemptionIDs(ids...)
+	return _u
+}
+
+// RemoveRedemptions removes "redemptions" edges to PromoRedemption entities.
+func (_u *PromoCodeUpdateOne) RemoveRedemptions(v ...*PromoRedemption) *PromoCodeUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRedemptionIDs(ids...)
+}
+
+// Where appends a list predicates to the PromoCodeUpdate builder.
+func (_u *PromoCodeUpdateOne) Where(ps ...predicate.PromoCode) *PromoCodeUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PromoCodeUpdateOne) Select(field string, fields ...string) *PromoCodeUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PromoCode entity.
+func (_u *PromoCodeUpdateOne) Save(ctx context.Context) (*PromoCode, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PromoCodeUpdateOne) SaveX(ctx context.Context) *PromoCode {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PromoCodeUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PromoCodeUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PromoCodeUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := promocode.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PromoCodeUpdateOne) check() error {
+	if v, ok := _u.mutation.Description(); ok {
+		if err := promocode.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`generated: validator failed for field "PromoCode.description": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CreditAmount(); ok {
+		if err := promocode.CreditAmountValidator(v); err != nil {
+			return &ValidationError{Name: "credit_amount", err: fmt.Errorf(`generated: validator failed for field "PromoCode.credit_amount": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DiscountPercent(); ok {
+		if err := promocode.DiscountPercentValidator(v); err != nil {
+			return &ValidationError{Name: "discount_percent", err: fmt.Errorf(`generated: validator failed for field "PromoCode.discount_percent": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PackageID(); ok {
+		if err := promocode.PackageIDValidator(v); err != nil {
+			return &ValidationError{Name: "package_id", err: fmt.Errorf(`generated: validator failed for field "PromoCode.package_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaxRedemptions(); ok {
+		if err := promocode.MaxRedemptionsValidator(v); err != nil {
+			return &ValidationError{Name: "max_redemptions", err: fmt.Errorf(`generated: validator failed for field "PromoCode.max_redemptions": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PerUserLimit(); ok {
+		if err := promocode.PerUserLimitValidator(v); err != nil {
+			return &ValidationError{Name: "per_user_limit", err: fmt.Errorf(`generated: validator failed for field "PromoCode.per_user_limit": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *PromoCodeUpdateOne) sqlSave(ctx context.Context) (_node *PromoCode, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(promocode.Table, promocode.Columns, sqlgraph.NewFieldSpec(promocode.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "PromoCode.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, promocode.FieldID)
+		for _, f := range fields {
+			if !promocode.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != promocode.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(promocode.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(promocode.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreditAmount(); ok {
+		_spec.SetField(promocode.FieldCreditAmount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCreditAmount(); ok {
+		_spec.AddField(promocode.FieldCreditAmount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DiscountPercent(); ok {
+		_spec.SetField(promocode.FieldDiscountPercent, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDiscountPercent(); ok {
+		_spec.AddField(promocode.FieldDiscountPercent, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.PackageID(); ok {
+		_spec.SetField(promocode.FieldPackageID, field.TypeString, value)
+	}
+	if _u.mutation.PackageIDCleared() {
+		_spec.ClearField(promocode.FieldPackageID, field.TypeString)
+	}
+	if value, ok := _u.mutation.MaxRedemptions(); ok {
+		_spec.SetField(promocode.FieldMaxRedemptions, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxRedemptions(); ok {
+		_spec.AddField(promocode.FieldMaxRedemptions, field.TypeInt, value)
+	}
+	if _u.mutation.MaxRedemptionsCleared() {
+		_spec.ClearField(promocode.FieldMaxRedemptions, field.TypeInt)
+	}
+	if value, ok := _u.mutation.PerUserLimit(); ok {
+		_spec.SetField(promocode.FieldPerUserLimit, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPerUserLimit(); ok {
+		_spec.AddField(promocode.FieldPerUserLimit, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.StartsAt(); ok {
+		_spec.SetField(promocode.FieldStartsAt, field.TypeTime, value)
+	}
+	if _u.mutation.StartsAtCleared() {
+		_spec.ClearField(promocode.FieldStartsAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(promocode.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(promocode.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.IsActive(); ok {
+		_spec.SetField(promocode.FieldIsActive, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(promocode.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RedemptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   promocode.RedemptionsTable,
+			Columns: []string{promocode.RedemptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(promoredemption.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRedemptionsIDs(); len(nodes) > 0 && !_u.mutation.RedemptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   promocode.RedemptionsTable,
+			Columns: []string{promocode.RedemptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(promoredemption.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RedemptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   promocode.RedemptionsTable,
+			Columns: []string{promocode.RedemptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(promoredemption.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &PromoCode{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{promocode.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
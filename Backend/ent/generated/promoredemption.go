@@ -0,0 +1,232 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/UnoraApp/be/ent/generated/promocode"
+	"github.com/UnoraApp/be/ent/generated/promoredemption"
+)
+
+// PromoRedemption is the model entity for the PromoRedemption schema.
+type PromoRedemption struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// PromoCodeID holds the value of the "promo_code_id" field.
+	PromoCodeID string `json:"promo_code_id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID string `json:"user_id,omitempty"`
+	// OrderID holds the value of the "order_id" field.
+	OrderID *string `json:"order_id,omitempty"`
+	// RedemptionStatus holds the value of the "redemption_status" field.
+	RedemptionStatus promoredemption.RedemptionStatus `json:"redemption_status,omitempty"`
+	// CreditsGranted holds the value of the "credits_granted" field.
+	CreditsGranted int `json:"credits_granted,omitempty"`
+	// DiscountAmount holds the value of the "discount_amount" field.
+	DiscountAmount int `json:"discount_amount,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// RedeemedAt holds the value of the "redeemed_at" field.
+	RedeemedAt *time.Time `json:"redeemed_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PromoRedemptionQuery when eager-loading is set.
+	Edges        PromoRedemptionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PromoRedemptionEdges holds the relations/edges for other nodes in the graph.
+type PromoRedemptionEdges struct {
+	// PromoCode holds the value of the promo_code edge.
+	PromoCode *PromoCode `json:"promo_code,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// PromoCodeOrErr returns the PromoCode value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PromoRedemptionEdges) PromoCodeOrErr() (*PromoCode, error) {
+	if e.PromoCode != nil {
+		return e.PromoCode, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: promocode.Label}
+	}
+	return nil, &NotLoadedError{edge: "promo_code"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PromoRedemption) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case promoredemption.FieldCreditsGranted, promoredemption.FieldDiscountAmount:
+			values[i] = new(sql.NullInt64)
+		case promoredemption.FieldID, promoredemption.FieldPromoCodeID, promoredemption.FieldUserID, promoredemption.FieldOrderID, promoredemption.FieldRedemptionStatus:
+			values[i] = new(sql.NullString)
+		case promoredemption.FieldExpiresAt, promoredemption.FieldCreatedAt, promoredemption.FieldRedeemedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PromoRedemption fields.
+func (_m *PromoRedemption) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case promoredemption.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case promoredemption.FieldPromoCodeID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field promo_code_id", values[i])
+			} else if value.Valid {
+				_m.PromoCodeID = value.String
+			}
+		case promoredemption.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = value.String
+			}
+		case promoredemption.FieldOrderID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field order_id", values[i])
+			} else if value.Valid {
+				_m.OrderID = new(string)
+				*_m.OrderID = value.String
+			}
+		case promoredemption.FieldRedemptionStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field redemption_status", values[i])
+			} else if value.Valid {
+				_m.RedemptionStatus = promoredemption.RedemptionStatus(value.String)
+			}
+		case promoredemption.FieldCreditsGranted:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field credits_granted", values[i])
+			} else if value.Valid {
+				_m.CreditsGranted = int(value.Int64)
+			}
+		case promoredemption.FieldDiscountAmount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field discount_amount", values[i])
+			} else if value.Valid {
+				_m.DiscountAmount = int(value.Int64)
+			}
+		case promoredemption.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				_m.ExpiresAt = new(time.Time)
+				*_m.ExpiresAt = value.Time
+			}
+		case promoredemption.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case promoredemption.FieldRedeemedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field redeemed_at", values[i])
+			} else if value.Valid {
+				_m.RedeemedAt = new(time.Time)
+				*_m.RedeemedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PromoRedemption.
+// This includes values selected through modifiers, order, etc.
+func (_m *PromoRedemption) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryPromoCode queries the "promo_code" edge of the PromoRedemption entity.
+func (_m *PromoRedemption) QueryPromoCode() *PromoCodeQuery {
+	return NewPromoRedemptionClient(_m.config).QueryPromoCode(_m)
+}
+
+// Update returns a builder for updating this PromoRedemption.
+// Note that you need to call PromoRedemption.Unwrap() before calling this method if this PromoRedemption
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *PromoRedemption) Update() *PromoRedemptionUpdateOne {
+	return NewPromoRedemptionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the PromoRedemption entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *PromoRedemption) Unwrap() *PromoRedemption {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("generated: PromoRedemption is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *PromoRedemption) String() string {
+	var builder strings.Builder
+	builder.WriteString("PromoRedemption(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("promo_code_id=")
+	builder.WriteString(_m.PromoCodeID)
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(_m.UserID)
+	builder.WriteString(", ")
+	if v := _m.OrderID; v != nil {
+		builder.WriteString("order_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("redemption_status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RedemptionStatus))
+	builder.WriteString(", ")
+	builder.WriteString("credits_granted=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CreditsGranted))
+	builder.WriteString(", ")
+	builder.WriteString("discount_amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DiscountAmount))
+	builder.WriteString(", ")
+	if v := _m.ExpiresAt; v != nil {
+		builder.WriteString("expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.RedeemedAt; v != nil {
+		builder.WriteString("redeemed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PromoRedemptions is a parsable slice of PromoRedemption.
+type PromoRedemptions []*PromoRedemption
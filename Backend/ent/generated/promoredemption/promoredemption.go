@@ -0,0 +1,185 @@
+// Code generated by ent, DO NOT EDIT.
+
+package promoredemption
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the promoredemption type in the database.
+	Label = "promo_redemption"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldPromoCodeID holds the string denoting the promo_code_id field in the database.
+	FieldPromoCodeID = "promo_code_id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldOrderID holds the string denoting the order_id field in the database.
+	FieldOrderID = "order_id"
+	// FieldRedemptionStatus holds the string denoting the redemption_status field in the database.
+	FieldRedemptionStatus = "redemption_status"
+	// FieldCreditsGranted holds the string denoting the credits_granted field in the database.
+	FieldCreditsGranted = "credits_granted"
+	// FieldDiscountAmount holds the string denoting the discount_amount field in the database.
+	FieldDiscountAmount = "discount_amount"
+	// FieldExpiresAt holds the string denoting the expires_at field in the database.
+	FieldExpiresAt = "expires_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldRedeemedAt holds the string denoting the redeemed_at field in the database.
+	FieldRedeemedAt = "redeemed_at"
+	// EdgePromoCode holds the string denoting the promo_code edge name in mutations.
+	EdgePromoCode = "promo_code"
+	// Table holds the table name of the promoredemption in the database.
+	Table = "promo_redemptions"
+	// PromoCodeTable is the table that holds the promo_code relation/edge.
+	PromoCodeTable = "promo_redemptions"
+	// PromoCodeInverseTable is the table name for the PromoCode entity.
+	// It exists in this package in order to avoid circular dependency with the "promocode" package.
+	PromoCodeInverseTable = "promo_codes"
+	// PromoCodeColumn is the table column denoting the promo_code relation/edge.
+	PromoCodeColumn = "promo_code_id"
+)
+
+// Columns holds all SQL columns for promoredemption fields.
+var Columns = []string{
+	FieldID,
+	FieldPromoCodeID,
+	FieldUserID,
+	FieldOrderID,
+	FieldRedemptionStatus,
+	FieldCreditsGranted,
+	FieldDiscountAmount,
+	FieldExpiresAt,
+	FieldCreatedAt,
+	FieldRedeemedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// PromoCodeIDValidator is a validator for the "promo_code_id" field. It is called by the builders before save.
+	PromoCodeIDValidator func(string) error
+	// UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	UserIDValidator func(string) error
+	// OrderIDValidator is a validator for the "order_id" field. It is called by the builders before save.
+	OrderIDValidator func(string) error
+	// DefaultCreditsGranted holds the default value on creation for the "credits_granted" field.
+	DefaultCreditsGranted int
+	// CreditsGrantedValidator is a validator for the "credits_granted" field. It is called by the builders before save.
+	CreditsGrantedValidator func(int) error
+	// DefaultDiscountAmount holds the default value on creation for the "discount_amount" field.
+	DefaultDiscountAmount int
+	// DiscountAmountValidator is a validator for the "discount_amount" field. It is called by the builders before save.
+	DiscountAmountValidator func(int) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
+
+// RedemptionStatus defines the type for the "redemption_status" enum field.
+type RedemptionStatus string
+
+// RedemptionStatusPending is the default value of the RedemptionStatus enum.
+const DefaultRedemptionStatus = RedemptionStatusPending
+
+// RedemptionStatus values.
+const (
+	RedemptionStatusPending  RedemptionStatus = "pending"
+	RedemptionStatusRedeemed RedemptionStatus = "redeemed"
+)
+
+func (rs RedemptionStatus) String() string {
+	return string(rs)
+}
+
+// RedemptionStatusValidator is a validator for the "redemption_status" field enum values. It is called by the builders before save.
+func RedemptionStatusValidator(rs RedemptionStatus) error {
+	switch rs {
+	case RedemptionStatusPending, RedemptionStatusRedeemed:
+		return nil
+	default:
+		return fmt.Errorf("promoredemption: invalid enum value for redemption_status field: %q", rs)
+	}
+}
+
+// OrderOption defines the ordering options for the PromoRedemption queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByPromoCodeID orders the results by the promo_code_id field.
+func ByPromoCodeID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPromoCodeID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByOrderID orders the results by the order_id field.
+func ByOrderID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrderID, opts...).ToFunc()
+}
+
+// ByRedemptionStatus orders the results by the redemption_status field.
+func ByRedemptionStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRedemptionStatus, opts...).ToFunc()
+}
+
+// ByCreditsGranted orders the results by the credits_granted field.
+func ByCreditsGranted(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreditsGranted, opts...).ToFunc()
+}
+
+// ByDiscountAmount orders the results by the discount_amount field.
+func ByDiscountAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDiscountAmount, opts...).ToFunc()
+}
+
+// ByExpiresAt orders the results by the expires_at field.
+func ByExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpiresAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByRedeemedAt orders the results by the redeemed_at field.
+func ByRedeemedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRedeemedAt, opts...).ToFunc()
+}
+
+// ByPromoCodeField orders the results by promo_code field.
+func ByPromoCodeField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPromoCodeStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newPromoCodeStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PromoCodeInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, PromoCodeTable, PromoCodeColumn),
+	)
+}
@@ -0,0 +1,589 @@
+// Code generated by ent, DO NOT EDIT.
+
+package promoredemption
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/UnoraApp/be/ent/generated/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldContainsFold(FieldID, id))
+}
+
+// PromoCodeID applies equality check predicate on the "promo_code_id" field. It's identical to PromoCodeIDEQ.
+func PromoCodeID(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldEQ(FieldPromoCodeID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldEQ(FieldUserID, v))
+}
+
+// OrderID applies equality check predicate on the "order_id" field. It's identical to OrderIDEQ.
+func OrderID(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldEQ(FieldOrderID, v))
+}
+
+// CreditsGranted applies equality check predicate on the "credits_granted" field. It's identical to CreditsGrantedEQ.
+func CreditsGranted(v int) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldEQ(FieldCreditsGranted, v))
+}
+
+// DiscountAmount applies equality check predicate on the "discount_amount" field. It's identical to DiscountAmountEQ.
+func DiscountAmount(v int) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldEQ(FieldDiscountAmount, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// RedeemedAt applies equality check predicate on the "redeemed_at" field. It's identical to RedeemedAtEQ.
+func RedeemedAt(v time.Time) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldEQ(FieldRedeemedAt, v))
+}
+
+// PromoCodeIDEQ applies the EQ predicate on the "promo_code_id" field.
+func PromoCodeIDEQ(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldEQ(FieldPromoCodeID, v))
+}
+
+// PromoCodeIDNEQ applies the NEQ predicate on the "promo_code_id" field.
+func PromoCodeIDNEQ(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldNEQ(FieldPromoCodeID, v))
+}
+
+// PromoCodeIDIn applies the In predicate on the "promo_code_id" field.
+func PromoCodeIDIn(vs ...string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldIn(FieldPromoCodeID, vs...))
+}
+
+// PromoCodeIDNotIn applies the NotIn predicate on the "promo_code_id" field.
+func PromoCodeIDNotIn(vs ...string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldNotIn(FieldPromoCodeID, vs...))
+}
+
+// PromoCodeIDGT applies the GT predicate on the "promo_code_id" field.
+func PromoCodeIDGT(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldGT(FieldPromoCodeID, v))
+}
+
+// PromoCodeIDGTE applies the GTE predicate on the "promo_code_id" field.
+func PromoCodeIDGTE(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldGTE(FieldPromoCodeID, v))
+}
+
+// PromoCodeIDLT applies the LT predicate on the "promo_code_id" field.
+func PromoCodeIDLT(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldLT(FieldPromoCodeID, v))
+}
+
+// PromoCodeIDLTE applies the LTE predicate on the "promo_code_id" field.
+func PromoCodeIDLTE(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldLTE(FieldPromoCodeID, v))
+}
+
+// PromoCodeIDContains applies the Contains predicate on the "promo_code_id" field.
+func PromoCodeIDContains(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldContains(FieldPromoCodeID, v))
+}
+
+// PromoCodeIDHasPrefix applies the HasPrefix predicate on the "promo_code_id" field.
+func PromoCodeIDHasPrefix(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldHasPrefix(FieldPromoCodeID, v))
+}
+
+// PromoCodeIDHasSuffix applies the HasSuffix predicate on the "promo_code_id" field.
+func PromoCodeIDHasSuffix(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldHasSuffix(FieldPromoCodeID, v))
+}
+
+// PromoCodeIDEqualFold applies the EqualFold predicate on the "promo_code_id" field.
+func PromoCodeIDEqualFold(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldEqualFold(FieldPromoCodeID, v))
+}
+
+// PromoCodeIDContainsFold applies the ContainsFold predicate on the "promo_code_id" field.
+func PromoCodeIDContainsFold(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldContainsFold(FieldPromoCodeID, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// OrderIDEQ applies the EQ predicate on the "order_id" field.
+func OrderIDEQ(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldEQ(FieldOrderID, v))
+}
+
+// OrderIDNEQ applies the NEQ predicate on the "order_id" field.
+func OrderIDNEQ(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldNEQ(FieldOrderID, v))
+}
+
+// OrderIDIn applies the In predicate on the "order_id" field.
+func OrderIDIn(vs ...string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldIn(FieldOrderID, vs...))
+}
+
+// OrderIDNotIn applies the NotIn predicate on the "order_id" field.
+func OrderIDNotIn(vs ...string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldNotIn(FieldOrderID, vs...))
+}
+
+// OrderIDGT applies the GT predicate on the "order_id" field.
+func OrderIDGT(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldGT(FieldOrderID, v))
+}
+
+// OrderIDGTE applies the GTE predicate on the "order_id" field.
+func OrderIDGTE(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldGTE(FieldOrderID, v))
+}
+
+// OrderIDLT applies the LT predicate on the "order_id" field.
+func OrderIDLT(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldLT(FieldOrderID, v))
+}
+
+// OrderIDLTE applies the LTE predicate on the "order_id" field.
+func OrderIDLTE(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldLTE(FieldOrderID, v))
+}
+
+// OrderIDContains applies the Contains predicate on the "order_id" field.
+func OrderIDContains(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldContains(FieldOrderID, v))
+}
+
+// OrderIDHasPrefix applies the HasPrefix predicate on the "order_id" field.
+func OrderIDHasPrefix(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldHasPrefix(FieldOrderID, v))
+}
+
+// OrderIDHasSuffix applies the HasSuffix predicate on the "order_id" field.
+func OrderIDHasSuffix(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldHasSuffix(FieldOrderID, v))
+}
+
+// OrderIDIsNil applies the IsNil predicate on the "order_id" field.
+func OrderIDIsNil() predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldIsNull(FieldOrderID))
+}
+
+// OrderIDNotNil applies the NotNil predicate on the "order_id" field.
+func OrderIDNotNil() predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldNotNull(FieldOrderID))
+}
+
+// OrderIDEqualFold applies the EqualFold predicate on the "order_id" field.
+func OrderIDEqualFold(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldEqualFold(FieldOrderID, v))
+}
+
+// OrderIDContainsFold applies the ContainsFold predicate on the "order_id" field.
+func OrderIDContainsFold(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldContainsFold(FieldOrderID, v))
+}
+
+// RedemptionStatusEQ applies the EQ predicate on the "redemption_status" field.
+func RedemptionStatusEQ(v RedemptionStatus) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldEQ(FieldRedemptionStatus, v))
+}
+
+// RedemptionStatusNEQ applies the NEQ predicate on the "redemption_status" field.
+func RedemptionStatusNEQ(v RedemptionStatus) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldNEQ(FieldRedemptionStatus, v))
+}
+
+// RedemptionStatusIn applies the In predicate on the "redemption_status" field.
+func RedemptionStatusIn(vs ...RedemptionStatus) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldIn(FieldRedemptionStatus, vs...))
+}
+
+// RedemptionStatusNotIn applies the NotIn predicate on the "redemption_status" field.
+func RedemptionStatusNotIn(vs ...RedemptionStatus) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldNotIn(FieldRedemptionStatus, vs...))
+}
+
+// CreditsGrantedEQ applies the EQ predicate on the "credits_granted" field.
+func CreditsGrantedEQ(v int) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldEQ(FieldCreditsGranted, v))
+}
+
+// CreditsGrantedNEQ applies the NEQ predicate on the "credits_granted" field.
+func CreditsGrantedNEQ(v int) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldNEQ(FieldCreditsGranted, v))
+}
+
+// CreditsGrantedIn applies the In predicate on the "credits_granted" field.
+func CreditsGrantedIn(vs ...int) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldIn(FieldCreditsGranted, vs...))
+}
+
+// CreditsGrantedNotIn applies the NotIn predicate on the "credits_granted" field.
+func CreditsGrantedNotIn(vs ...int) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldNotIn(FieldCreditsGranted, vs...))
+}
+
+// CreditsGrantedGT applies the GT predicate on the "credits_granted" field.
+func CreditsGrantedGT(v int) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldGT(FieldCreditsGranted, v))
+}
+
+// CreditsGrantedGTE applies the GTE predicate on the "credits_granted" field.
+func CreditsGrantedGTE(v int) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldGTE(FieldCreditsGranted, v))
+}
+
+// CreditsGrantedLT applies the LT predicate on the "credits_granted" field.
+func CreditsGrantedLT(v int) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldLT(FieldCreditsGranted, v))
+}
+
+// CreditsGrantedLTE applies the LTE predicate on the "credits_granted" field.
+func CreditsGrantedLTE(v int) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldLTE(FieldCreditsGranted, v))
+}
+
+// DiscountAmountEQ applies the EQ predicate on the "discount_amount" field.
+func DiscountAmountEQ(v int) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldEQ(FieldDiscountAmount, v))
+}
+
+// DiscountAmountNEQ applies the NEQ predicate on the "discount_amount" field.
+func DiscountAmountNEQ(v int) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldNEQ(FieldDiscountAmount, v))
+}
+
+// DiscountAmountIn applies the In predicate on the "discount_amount" field.
+func DiscountAmountIn(vs ...int) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldIn(FieldDiscountAmount, vs...))
+}
+
+// DiscountAmountNotIn applies the NotIn predicate on the "discount_amount" field.
+func DiscountAmountNotIn(vs ...int) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldNotIn(FieldDiscountAmount, vs...))
+}
+
+// DiscountAmountGT applies the GT predicate on the "discount_amount" field.
+func DiscountAmountGT(v int) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldGT(FieldDiscountAmount, v))
+}
+
+// DiscountAmountGTE applies the GTE predicate on the "discount_amount" field.
+func DiscountAmountGTE(v int) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldGTE(FieldDiscountAmount, v))
+}
+
+// DiscountAmountLT applies the LT predicate on the "discount_amount" field.
+func DiscountAmountLT(v int) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldLT(FieldDiscountAmount, v))
+}
+
+// DiscountAmountLTE applies the LTE predicate on the "discount_amount" field.
+func DiscountAmountLTE(v int) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldLTE(FieldDiscountAmount, v))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtIsNil applies the IsNil predicate on the "expires_at" field.
+func ExpiresAtIsNil() predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldIsNull(FieldExpiresAt))
+}
+
+// ExpiresAtNotNil applies the NotNil predicate on the "expires_at" field.
+func ExpiresAtNotNil() predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldNotNull(FieldExpiresAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// RedeemedAtEQ applies the EQ predicate on the "redeemed_at" field.
+func RedeemedAtEQ(v time.Time) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldEQ(FieldRedeemedAt, v))
+}
+
+// RedeemedAtNEQ applies the NEQ predicate on the "redeemed_at" field.
+func RedeemedAtNEQ(v time.Time) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldNEQ(FieldRedeemedAt, v))
+}
+
+// RedeemedAtIn applies the In predicate on the "redeemed_at" field.
+func RedeemedAtIn(vs ...time.Time) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldIn(FieldRedeemedAt, vs...))
+}
+
+// RedeemedAtNotIn applies the NotIn predicate on the "redeemed_at" field.
+func RedeemedAtNotIn(vs ...time.Time) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldNotIn(FieldRedeemedAt, vs...))
+}
+
+// RedeemedAtGT applies the GT predicate on the "redeemed_at" field.
+func RedeemedAtGT(v time.Time) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldGT(FieldRedeemedAt, v))
+}
+
+// RedeemedAtGTE applies the GTE predicate on the "redeemed_at" field.
+func RedeemedAtGTE(v time.Time) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldGTE(FieldRedeemedAt, v))
+}
+
+// RedeemedAtLT applies the LT predicate on the "redeemed_at" field.
+func RedeemedAtLT(v time.Time) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldLT(FieldRedeemedAt, v))
+}
+
+// RedeemedAtLTE applies the LTE predicate on the "redeemed_at" field.
+func RedeemedAtLTE(v time.Time) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldLTE(FieldRedeemedAt, v))
+}
+
+// RedeemedAtIsNil applies the IsNil predicate on the "redeemed_at" field.
+func RedeemedAtIsNil() predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldIsNull(FieldRedeemedAt))
+}
+
+// RedeemedAtNotNil applies the NotNil predicate on the "redeemed_at" field.
+func RedeemedAtNotNil() predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldNotNull(FieldRedeemedAt))
+}
+
+// HasPromoCode applies the HasEdge predicate on the "promo_code" edge.
+func HasPromoCode() predicate.PromoRedemption {
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PromoCodeTable, PromoCodeColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPromoCodeWith applies the HasEdge predicate on the "promo_code" edge with a given conditions (other predicates).
+func HasPromoCodeWith(preds ...predicate.PromoCode) predicate.PromoRedemption {
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		step := newPromoCodeStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PromoRedemption) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PromoRedemption) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PromoRedemption) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.NotPredicates(p))
+}
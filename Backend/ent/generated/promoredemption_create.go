@@ -0,0 +1,984 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/UnoraApp/be/ent/generated/promocode"
+	"github.com/UnoraApp/be/ent/generated/promoredemption"
+)
+
+// PromoRedemptionCreate is the builder for creating a PromoRedemption entity.
+type PromoRedemptionCreate struct {
+	config
+	mutation *PromoRedemptionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetPromoCodeID sets the "promo_code_id" field.
+func (_c *PromoRedemptionCreate) SetPromoCodeID(v string) *PromoRedemptionCreate {
+	_c.mutation.SetPromoCodeID(v)
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *PromoRedemptionCreate) SetUserID(v string) *PromoRedemptionCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetOrderID sets the "order_id" field.
+func (_c *PromoRedemptionCreate) SetOrderID(v string) *PromoRedemptionCreate {
+	_c.mutation.SetOrderID(v)
+	return _c
+}
+
+// SetNillableOrderID sets the "order_id" field if the given value is not nil.
+func (_c *PromoRedemptionCreate) SetNillableOrderID(v *string) *PromoRedemptionCreate {
+	if v != nil {
+		_c.SetOrderID(*v)
+	}
+	return _c
+}
+
+// SetRedemptionStatus sets the "redemption_status" field.
+func (_c *PromoRedemptionCreate) SetRedemptionStatus(v promoredemption.RedemptionStatus) *PromoRedemptionCreate {
+	_c.mutation.SetRedemptionStatus(v)
+	return _c
+}
+
+// SetNillableRedemptionStatus sets the "redemption_status" field if the given value is not nil.
+func (_c *PromoRedemptionCreate) SetNillableRedemptionStatus(v *promoredemption.RedemptionStatus) *PromoRedemptionCreate {
+	if v != nil {
+		_c.SetRedemptionStatus(*v)
+	}
+	return _c
+}
+
+// SetCreditsGranted sets the "credits_granted" field.
+func (_c *PromoRedemptionCreate) SetCreditsGranted(v int) *PromoRedemptionCreate {
+	_c.mutation.SetCreditsGranted(v)
+	return _c
+}
+
+// SetNillableCreditsGranted sets the "credits_granted" field if the given value is not nil.
+func (_c *PromoRedemptionCreate) SetNillableCreditsGranted(v *int) *PromoRedemptionCreate {
+	if v != nil {
+		_c.SetCreditsGranted(*v)
+	}
+	return _c
+}
+
+// SetDiscountAmount sets the "discount_amount" field.
+func (_c *PromoRedemptionCreate) SetDiscountAmount(v int) *PromoRedemptionCreate {
+	_c.mutation.SetDiscountAmount(v)
+	return _c
+}
+
+// SetNillableDiscountAmount sets the "discount_amount" field if the given value is not nil.
+func (_c *PromoRedemptionCreate) SetNillableDiscountAmount(v *int) *PromoRedemptionCreate {
+	if v != nil {
+		_c.SetDiscountAmount(*v)
+	}
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *PromoRedemptionCreate) SetExpiresAt(v time.Time) *PromoRedemptionCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_c *PromoRedemptionCreate) SetNillableExpiresAt(v *time.Time) *PromoRedemptionCreate {
+	if v != nil {
+		_c.SetExpiresAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *PromoRedemptionCreate) SetCreatedAt(v time.Time) *PromoRedemptionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *PromoRedemptionCreate) SetNillableCreatedAt(v *time.Time) *PromoRedemptionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetRedeemedAt sets the "redeemed_at" field.
+func (_c *PromoRedemptionCreate) SetRedeemedAt(v time.Time) *PromoRedemptionCreate {
+	_c.mutation.SetRedeemedAt(v)
+	return _c
+}
+
+// SetNillableRedeemedAt sets the "redeemed_at" field if the given value is not nil.
+func (_c *PromoRedemptionCreate) SetNillableRedeemedAt(v *time.Time) *PromoRedemptionCreate {
+	if v != nil {
+		_c.SetRedeemedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PromoRedemptionCreate) SetID(v string) *PromoRedemptionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetPromoCode sets the "promo_code" edge to the PromoCode entity.
+func (_c *PromoRedemptionCreate) SetPromoCode(v *PromoCode) *PromoRedemptionCreate {
+	return _c.SetPromoCodeID(v.ID)
+}
+
+// Mutation returns the PromoRedemptionMutation object of the builder.
+func (_c *PromoRedemptionCreate) Mutation() *PromoRedemptionMutation {
+	return _c.mutation
+}
+
+// Save creates the PromoRedemption in the database.
+func (_c *PromoRedemptionCreate) Save(ctx context.Context) (*PromoRedemption, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PromoRedemptionCreate) SaveX(ctx context.Context) *PromoRedemption {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PromoRedemptionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PromoRedemptionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PromoRedemptionCreate) defaults() {
+	if _, ok := _c.mutation.RedemptionStatus(); !ok {
+		v := promoredemption.DefaultRedemptionStatus
+		_c.mutation.SetRedemptionStatus(v)
+	}
+	if _, ok := _c.mutation.CreditsGranted(); !ok {
+		v := promoredemption.DefaultCreditsGranted
+		_c.mutation.SetCreditsGranted(v)
+	}
+	if _, ok := _c.mutation.DiscountAmount(); !ok {
+		v := promoredemption.DefaultDiscountAmount
+		_c.mutation.SetDiscountAmount(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := promoredemption.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PromoRedemptionCreate) check() error {
+	if _, ok := _c.mutation.PromoCodeID(); !ok {
+		return &ValidationError{Name: "promo_code_id", err: errors.New(`generated: missing required field "PromoRedemption.promo_code_id"`)}
+	}
+	if v, ok := _c.mutation.PromoCodeID(); ok {
+		if err := promoredemption.PromoCodeIDValidator(v); err != nil {
+			return &ValidationError{Name: "promo_code_id", err: fmt.Errorf(`generated: validator failed for field "PromoRedemption.promo_code_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`generated: missing required field "PromoRedemption.user_id"`)}
+	}
+	if v, ok := _c.mutation.UserID(); ok {
+		if err := promoredemption.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`generated: validator failed for field "PromoRedemption.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.OrderID(); ok {
+		if err := promoredemption.OrderIDValidator(v); err != nil {
+			return &ValidationError{Name: "order_id", err: fmt.Errorf(`generated: validator failed for field "PromoRedemption.order_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RedemptionStatus(); !ok {
+		return &ValidationError{Name: "redemption_status", err: errors.New(`generated: missing required field "PromoRedemption.redemption_status"`)}
+	}
+	if v, ok := _c.mutation.RedemptionStatus(); ok {
+		if err := promoredemption.RedemptionStatusValidator(v); err != nil {
+			return &ValidationError{Name: "redemption_status", err: fmt.Errorf(`generated: validator failed for field "PromoRedemption.redemption_status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreditsGranted(); !ok {
+		return &ValidationError{Name: "credits_granted", err: errors.New(`generated: missing required field "PromoRedemption.credits_granted"`)}
+	}
+	if v, ok := _c.mutation.CreditsGranted(); ok {
+		if err := promoredemption.CreditsGrantedValidator(v); err != nil {
+			return &ValidationError{Name: "credits_granted", err: fmt.Errorf(`generated: validator failed for field "PromoRedemption.credits_granted": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.DiscountAmount(); !ok {
+		return &ValidationError{Name: "discount_amount", err: errors.New(`generated: missing required field "PromoRedemption.discount_amount"`)}
+	}
+	if v, ok := _c.mutation.DiscountAmount(); ok {
+		if err := promoredemption.DiscountAmountValidator(v); err != nil {
+			return &ValidationError{Name: "discount_amount", err: fmt.Errorf(`generated: validator failed for field "PromoRedemption.discount_amount": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "PromoRedemption.created_at"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := promoredemption.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`generated: validator failed for field "PromoRedemption.id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.PromoCodeIDs()) == 0 {
+		return &ValidationError{Name: "promo_code", err: errors.New(`generated: missing required edge "PromoRedemption.promo_code"`)}
+	}
+	return nil
+}
+
+func (_c *PromoRedemptionCreate) sqlSave(ctx context.Context) (*PromoRedemption, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected PromoRedemption.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PromoRedemptionCreate) createSpec() (*PromoRedemption, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PromoRedemption{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(promoredemption.Table, sqlgraph.NewFieldSpec(promoredemption.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(promoredemption.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.OrderID(); ok {
+		_spec.SetField(promoredemption.FieldOrderID, field.TypeString, value)
+		_node.OrderID = &value
+	}
+	if value, ok := _c.mutation.RedemptionStatus(); ok {
+		_spec.SetField(promoredemption.FieldRedemptionStatus, field.TypeEnum, value)
+		_node.RedemptionStatus = value
+	}
+	if value, ok := _c.mutation.CreditsGranted(); ok {
+		_spec.SetField(promoredemption.FieldCreditsGranted, field.TypeInt, value)
+		_node.CreditsGranted = value
+	}
+	if value, ok := _c.mutation.DiscountAmount(); ok {
+		_spec.SetField(promoredemption.FieldDiscountAmount, field.TypeInt, value)
+		_node.DiscountAmount = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(promoredemption.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(promoredemption.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.RedeemedAt(); ok {
+		_spec.SetField(promoredemption.FieldRedeemedAt, field.TypeTime, value)
+		_node.RedeemedAt = &value
+	}
+	if nodes := _c.mutation.PromoCodeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   promoredemption.PromoCodeTable,
+			Columns: []string{promoredemption.PromoCodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(promocode.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PromoCodeID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PromoRedemption.Create().
+//		SetPromoCodeID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PromoRedemptionUpsert) {
+//			SetPromoCodeID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PromoRedemptionCreate) OnConflict(opts ...sql.ConflictOption) *PromoRedemptionUpsertOne {
+	_c.conflict = opts
+	return &PromoRedemptionUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PromoRedemption.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PromoRedemptionCreate) OnConflictColumns(columns ...string) *PromoRedemptionUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PromoRedemptionUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// PromoRedemptionUpsertOne is the builder for "upsert"-ing
+	//  one PromoRedemption node.
+	PromoRedemptionUpsertOne struct {
+		create *PromoRedemptionCreate
+	}
+
+	// PromoRedemptionUpsert is the "OnConflict" setter.
+	PromoRedemptionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetRedemptionStatus sets the "redemption_status" field.
+func (u *PromoRedemptionUpsert) SetRedemptionStatus(v promoredemption.RedemptionStatus) *PromoRedemptionUpsert {
+	u.Set(promoredemption.FieldRedemptionStatus, v)
+	return u
+}
+
+// UpdateRedemptionStatus sets the "redemption_status" field to the value that was provided on create.
+func (u *PromoRedemptionUpsert) UpdateRedemptionStatus() *PromoRedemptionUpsert {
+	u.SetExcluded(promoredemption.FieldRedemptionStatus)
+	return u
+}
+
+// SetCreditsGranted sets the "credits_granted" field.
+func (u *PromoRedemptionUpsert) SetCreditsGranted(v int) *PromoRedemptionUpsert {
+	u.Set(promoredemption.FieldCreditsGranted, v)
+	return u
+}
+
+// UpdateCreditsGranted sets the "credits_granted" field to the value that was provided on create.
+func (u *PromoRedemptionUpsert) UpdateCreditsGranted() *PromoRedemptionUpsert {
+	u.SetExcluded(promoredemption.FieldCreditsGranted)
+	return u
+}
+
+// AddCreditsGranted adds v to the "credits_granted" field.
+func (u *PromoRedemptionUpsert) AddCreditsGranted(v int) *PromoRedemptionUpsert {
+	u.Add(promoredemption.FieldCreditsGranted, v)
+	return u
+}
+
+// SetDiscountAmount sets the "discount_amount" field.
+func (u *PromoRedemptionUpsert) SetDiscountAmount(v int) *PromoRedemptionUpsert {
+	u.Set(promoredemption.FieldDiscountAmount, v)
+	return u
+}
+
+// UpdateDiscountAmount sets the "discount_amount" field to the value that was provided on create.
+func (u *PromoRedemptionUpsert) UpdateDiscountAmount() *PromoRedemptionUpsert {
+	u.SetExcluded(promoredemption.FieldDiscountAmount)
+	return u
+}
+
+// AddDiscountAmount adds v to the "discount_amount" field.
+func (u *PromoRedemptionUpsert) AddDiscountAmount(v int) *PromoRedemptionUpsert {
+	u.Add(promoredemption.FieldDiscountAmount, v)
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *PromoRedemptionUpsert) SetExpiresAt(v time.Time) *PromoRedemptionUpsert {
+	u.Set(promoredemption.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *PromoRedemptionUpsert) UpdateExpiresAt() *PromoRedemptionUpsert {
+	u.SetExcluded(promoredemption.FieldExpiresAt)
+	return u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *PromoRedemptionUpsert) ClearExpiresAt() *PromoRedemptionUpsert {
+	u.SetNull(promoredemption.FieldExpiresAt)
+	return u
+}
+
+// SetRedeemedAt sets the "redeemed_at" field.
+func (u *PromoRedemptionUpsert) SetRedeemedAt(v time.Time) *PromoRedemptionUpsert {
+	u.Set(promoredemption.FieldRedeemedAt, v)
+	return u
+}
+
+// UpdateRedeemedAt sets the "redeemed_at" field to the value that was provided on create.
+func (u *PromoRedemptionUpsert) UpdateRedeemedAt() *PromoRedemptionUpsert {
+	u.SetExcluded(promoredemption.FieldRedeemedAt)
+	return u
+}
+
+// ClearRedeemedAt clears the value of the "redeemed_at" field.
+func (u *PromoRedemptionUpsert) ClearRedeemedAt() *PromoRedemptionUpsert {
+	u.SetNull(promoredemption.FieldRedeemedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.PromoRedemption.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(promoredemption.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PromoRedemptionUpsertOne) UpdateNewValues() *PromoRedemptionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(promoredemption.FieldID)
+		}
+		if _, exists := u.create.mutation.PromoCodeID(); exists {
+			s.SetIgnore(promoredemption.FieldPromoCodeID)
+		}
+		if _, exists := u.create.mutation.UserID(); exists {
+			s.SetIgnore(promoredemption.FieldUserID)
+		}
+		if _, exists := u.create.mutation.OrderID(); exists {
+			s.SetIgnore(promoredemption.FieldOrderID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(promoredemption.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PromoRedemption.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PromoRedemptionUpsertOne) Ignore() *PromoRedemptionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PromoRedemptionUpsertOne) DoNothing() *PromoRedemptionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PromoRedemptionCreate.OnConflict
+// documentation for more info.
+func (u *PromoRedemptionUpsertOne) Update(set func(*PromoRedemptionUpsert)) *PromoRedemptionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PromoRedemptionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetRedemptionStatus sets the "redemption_status" field.
+func (u *PromoRedemptionUpsertOne) SetRedemptionStatus(v promoredemption.RedemptionStatus) *PromoRedemptionUpsertOne {
+	return u.Update(func(s *PromoRedemptionUpsert) {
+		s.SetRedemptionStatus(v)
+	})
+}
+
+// UpdateRedemptionStatus sets the "redemption_status" field to the value that was provided on create.
+func (u *PromoRedemptionUpsertOne) UpdateRedemptionStatus() *PromoRedemptionUpsertOne {
+	return u.Update(func(s *PromoRedemptionUpsert) {
+		s.UpdateRedemptionStatus()
+	})
+}
+
+// SetCreditsGranted sets the "credits_granted" field.
+func (u *PromoRedemptionUpsertOne) SetCreditsGranted(v int) *PromoRedemptionUpsertOne {
+	return u.Update(func(s *PromoRedemptionUpsert) {
+		s.SetCreditsGranted(v)
+	})
+}
+
+// AddCreditsGranted adds v to the "credits_granted" field.
+func (u *PromoRedemptionUpsertOne) AddCreditsGranted(v int) *PromoRedemptionUpsertOne {
+	return u.Update(func(s *PromoRedemptionUpsert) {
+		s.AddCreditsGranted(v)
+	})
+}
+
+// UpdateCreditsGranted sets the "credits_granted" field to the value that was provided on create.
+func (u *PromoRedemptionUpsertOne) UpdateCreditsGranted() *PromoRedemptionUpsertOne {
+	return u.Update(func(s *PromoRedemptionUpsert) {
+		s.UpdateCreditsGranted()
+	})
+}
+
+// SetDiscountAmount sets the "discount_amount" field.
+func (u *PromoRedemptionUpsertOne) SetDiscountAmount(v int) *PromoRedemptionUpsertOne {
+	return u.Update(func(s *PromoRedemptionUpsert) {
+		s.SetDiscountAmount(v)
+	})
+}
+
+// AddDiscountAmount adds v to the "discount_amount" field.
+func (u *PromoRedemptionUpsertOne) AddDiscountAmount(v int) *PromoRedemptionUpsertOne {
+	return u.Update(func(s *PromoRedemptionUpsert) {
+		s.AddDiscountAmount(v)
+	})
+}
+
+// UpdateDiscountAmount sets the "discount_amount" field to the value that was provided on create.
+func (u *PromoRedemptionUpsertOne) UpdateDiscountAmount() *PromoRedemptionUpsertOne {
+	return u.Update(func(s *PromoRedemptionUpsert) {
+		s.UpdateDiscountAmount()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *PromoRedemptionUpsertOne) SetExpiresAt(v time.Time) *PromoRedemptionUpsertOne {
+	return u.Update(func(s *PromoRedemptionUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *PromoRedemptionUpsertOne) UpdateExpiresAt() *PromoRedemptionUpsertOne {
+	return u.Update(func(s *PromoRedemptionUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *PromoRedemptionUpsertOne) ClearExpiresAt() *PromoRedemptionUpsertOne {
+	return u.Update(func(s *PromoRedemptionUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// SetRedeemedAt sets the "redeemed_at" field.
+func (u *PromoRedemptionUpsertOne) SetRedeemedAt(v time.Time) *PromoRedemptionUpsertOne {
+	return u.Update(func(s *PromoRedemptionUpsert) {
+		s.SetRedeemedAt(v)
+	})
+}
+
+// UpdateRedeemedAt sets the "redeemed_at" field to the value that was provided on create.
+func (u *PromoRedemptionUpsertOne) UpdateRedeemedAt() *PromoRedemptionUpsertOne {
+	return u.Update(func(s *PromoRedemptionUpsert) {
+		s.UpdateRedeemedAt()
+	})
+}
+
+// ClearRedeemedAt clears the value of the "redeemed_at" field.
+func (u *PromoRedemptionUpsertOne) ClearRedeemedAt() *PromoRedemptionUpsertOne {
+	return u.Update(func(s *PromoRedemptionUpsert) {
+		s.ClearRedeemedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PromoRedemptionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("generated: missing options for PromoRedemptionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PromoRedemptionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PromoRedemptionUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("generated: PromoRedemptionUpsertOne.ID is not supported by MySQL driver. Use PromoRedemptionUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PromoRedemptionUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PromoRedemptionCreateBulk is the builder for creating many PromoRedemption entities in bulk.
+type PromoRedemptionCreateBulk struct {
+	config
+	err      error
+	builders []*PromoRedemptionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the PromoRedemption entities in the database.
+func (_c *PromoRedemptionCreateBulk) Save(ctx context.Context) ([]*PromoRedemption, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PromoRedemption, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PromoRedemptionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PromoRedemptionCreateBulk) SaveX(ctx context.Context) []*PromoRedemption {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PromoRedemptionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PromoRedemptionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PromoRedemption.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PromoRedemptionUpsert) {
+//			SetPromoCodeID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PromoRedemptionCreateBulk) OnConflict(opts ...sql.ConflictOption) *PromoRedemptionUpsertBulk {
+	_c.conflict = opts
+	return &PromoRedemptionUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PromoRedemption.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PromoRedemptionCreateBulk) OnConflictColumns(columns ...string) *PromoRedemptionUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PromoRedemptionUpsertBulk{
+		create: _c,
+	}
+}
+
+// PromoRedemptionUpsertBulk is the builder for "upsert"-ing
+// a bulk of PromoRedemption nodes.
+type PromoRedemptionUpsertBulk struct {
+	create *PromoRedemptionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PromoRedemption.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(promoredemption.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PromoRedemptionUpsertBulk) UpdateNewValues() *PromoRedemptionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(promoredemption.FieldID)
+			}
+			if _, exists := b.mutation.PromoCodeID(); exists {
+				s.SetIgnore(promoredemption.FieldPromoCodeID)
+			}
+			if _, exists := b.mutation.UserID(); exists {
+				s.SetIgnore(promoredemption.FieldUserID)
+			}
+			if _, exists := b.mutation.OrderID(); exists {
+				s.SetIgnore(promoredemption.FieldOrderID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(promoredemption.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PromoRedemption.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PromoRedemptionUpsertBulk) Ignore() *PromoRedemptionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PromoRedemptionUpsertBulk) DoNothing() *PromoRedemptionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PromoRedemptionCreateBulk.OnConflict
+// documentation for more info.
+func (u *PromoRedemptionUpsertBulk) Update(set func(*PromoRedemptionUpsert)) *PromoRedemptionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PromoRedemptionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetRedemptionStatus sets the "redemption_status" field.
+func (u *PromoRedemptionUpsertBulk) SetRedemptionStatus(v promoredemption.RedemptionStatus) *PromoRedemptionUpsertBulk {
+	return u.Update(func(s *PromoRedemptionUpsert) {
+		s.SetRedemptionStatus(v)
+	})
+}
+
+// UpdateRedemptionStatus sets the "redemption_status" field to the value that was provided on create.
+func (u *PromoRedemptionUpsertBulk) UpdateRedemptionStatus() *PromoRedemptionUpsertBulk {
+	return u.Update(func(s *PromoRedemptionUpsert) {
+		s.UpdateRedemptionStatus()
+	})
+}
+
+// SetCreditsGranted sets the "credits_granted" field.
+func (u *PromoRedemptionUpsertBulk) SetCreditsGranted(v int) *PromoRedemptionUpsertBulk {
+	return u.Update(func(s *PromoRedemptionUpsert) {
+		s.SetCreditsGranted(v)
+	})
+}
+
+// AddCreditsGranted adds v to the "credits_granted" field.
+func (u *PromoRedemptionUpsertBulk) AddCreditsGranted(v int) *PromoRedemptionUpsertBulk {
+	return u.Update(func(s *PromoRedemptionUpsert) {
+		s.AddCreditsGranted(v)
+	})
+}
+
+// UpdateCreditsGranted sets the "credits_granted" field to the value that was provided on create.
+func (u *PromoRedemptionUpsertBulk) UpdateCreditsGranted() *PromoRedemptionUpsertBulk {
+	return u.Update(func(s *PromoRedemptionUpsert) {
+		s.UpdateCreditsGranted()
+	})
+}
+
+// SetDiscountAmount sets the "discount_amount" field.
+func (u *PromoRedemptionUpsertBulk) SetDiscountAmount(v int) *PromoRedemptionUpsertBulk {
+	return u.Update(func(s *PromoRedemptionUpsert) {
+		s.SetDiscountAmount(v)
+	})
+}
+
+// AddDiscountAmount adds v to the "discount_amount" field.
+func (u *PromoRedemptionUpsertBulk) AddDiscountAmount(v int) *PromoRedemptionUpsertBulk {
+	return u.Update(func(s *PromoRedemptionUpsert) {
+		s.AddDiscountAmount(v)
+	})
+}
+
+// UpdateDiscountAmount sets the "discount_amount" field to the value that was provided on create.
+func (u *PromoRedemptionUpsertBulk) UpdateDiscountAmount() *PromoRedemptionUpsertBulk {
+	return u.Update(func(s *PromoRedemptionUpsert) {
+		s.UpdateDiscountAmount()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *PromoRedemptionUpsertBulk) SetExpiresAt(v time.Time) *PromoRedemptionUpsertBulk {
+	return u.Update(func(s *PromoRedemptionUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *PromoRedemptionUpsertBulk) UpdateExpiresAt() *PromoRedemptionUpsertBulk {
+	return u.Update(func(s *PromoRedemptionUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *PromoRedemptionUpsertBulk) ClearExpiresAt() *PromoRedemptionUpsertBulk {
+	return u.Update(func(s *PromoRedemptionUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// SetRedeemedAt sets the "redeemed_at" field.
+func (u *PromoRedemptionUpsertBulk) SetRedeemedAt(v time.Time) *PromoRedemptionUpsertBulk {
+	return u.Update(func(s *PromoRedemptionUpsert) {
+		s.SetRedeemedAt(v)
+	})
+}
+
+// UpdateRedeemedAt sets the "redeemed_at" field to the value that was provided on create.
+func (u *PromoRedemptionUpsertBulk) UpdateRedeemedAt() *PromoRedemptionUpsertBulk {
+	return u.Update(func(s *PromoRedemptionUpsert) {
+		s.UpdateRedeemedAt()
+	})
+}
+
+// ClearRedeemedAt clears the value of the "redeemed_at" field.
+func (u *PromoRedemptionUpsertBulk) ClearRedeemedAt() *PromoRedemptionUpsertBulk {
+	return u.Update(func(s *PromoRedemptionUpsert) {
+		s.ClearRedeemedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PromoRedemptionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("generated: OnConflict was set for builder %d. Set it on the PromoRedemptionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("generated: missing options for PromoRedemptionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PromoRedemptionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,480 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/UnoraApp/be/ent/generated/predicate"
+	"github.com/UnoraApp/be/ent/generated/promoredemption"
+)
+
+// PromoRedemptionUpdate is the builder for updating PromoRedemption entities.
+type PromoRedemptionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PromoRedemptionMutation
+}
+
+// Where appends a list predicates to the PromoRedemptionUpdate builder.
+func (_u *PromoRedemptionUpdate) Where(ps ...predicate.PromoRedemption) *PromoRedemptionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetRedemptionStatus sets the "redemption_status" field.
+func (_u *PromoRedemptionUpdate) SetRedemptionStatus(v promoredemption.RedemptionStatus) *PromoRedemptionUpdate {
+	_u.mutation.SetRedemptionStatus(v)
+	return _u
+}
+
+// SetNillableRedemptionStatus sets the "redemption_status" field if the given value is not nil.
+func (_u *PromoRedemptionUpdate) SetNillableRedemptionStatus(v *promoredemption.RedemptionStatus) *PromoRedemptionUpdate {
+	if v != nil {
+		_u.SetRedemptionStatus(*v)
+	}
+	return _u
+}
+
+// SetCreditsGranted sets the "credits_granted" field.
+func (_u *PromoRedemptionUpdate) SetCreditsGranted(v int) *PromoRedemptionUpdate {
+	_u.mutation.ResetCreditsGranted()
+	_u.mutation.SetCreditsGranted(v)
+	return _u
+}
+
+// SetNillableCreditsGranted sets the "credits_granted" field if the given value is not nil.
+func (_u *PromoRedemptionUpdate) SetNillableCreditsGranted(v *int) *PromoRedemptionUpdate {
+	if v != nil {
+		_u.SetCreditsGranted(*v)
+	}
+	return _u
+}
+
+// AddCreditsGranted adds value to the "credits_granted" field.
+func (_u *PromoRedemptionUpdate) AddCreditsGranted(v int) *PromoRedemptionUpdate {
+	_u.mutation.AddCreditsGranted(v)
+	return _u
+}
+
+// SetDiscountAmount sets the "discount_amount" field.
+func (_u *PromoRedemptionUpdate) SetDiscountAmount(v int) *PromoRedemptionUpdate {
+	_u.mutation.ResetDiscountAmount()
+	_u.mutation.SetDiscountAmount(v)
+	return _u
+}
+
+// SetNillableDiscountAmount sets the "discount_amount" field if the given value is not nil.
+func (_u *PromoRedemptionUpdate) SetNillableDiscountAmount(v *int) *PromoRedemptionUpdate {
+	if v != nil {
+		_u.SetDiscountAmount(*v)
+	}
+	return _u
+}
+
+// AddDiscountAmount adds value to the "discount_amount" field.
+func (_u *PromoRedemptionUpdate) AddDiscountAmount(v int) *PromoRedemptionUpdate {
+	_u.mutation.AddDiscountAmount(v)
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *PromoRedemptionUpdate) SetExpiresAt(v time.Time) *PromoRedemptionUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *PromoRedemptionUpdate) SetNillableExpiresAt(v *time.Time) *PromoRedemptionUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *PromoRedemptionUpdate) ClearExpiresAt() *PromoRedemptionUpdate {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// SetRedeemedAt sets the "redeemed_at" field.
+func (_u *PromoRedemptionUpdate) SetRedeemedAt(v time.Time) *PromoRedemptionUpdate {
+	_u.mutation.SetRedeemedAt(v)
+	return _u
+}
+
+// SetNillableRedeemedAt sets the "redeemed_at" field if the given value is not nil.
+func (_u *PromoRedemptionUpdate) SetNillableRedeemedAt(v *time.Time) *PromoRedemptionUpdate {
+	if v != nil {
+		_u.SetRedeemedAt(*v)
+	}
+	return _u
+}
+
+// ClearRedeemedAt clears the value of the "redeemed_at" field.
+func (_u *PromoRedemptionUpdate) ClearRedeemedAt() *PromoRedemptionUpdate {
+	_u.mutation.ClearRedeemedAt()
+	return _u
+}
+
+// Mutation returns the PromoRedemptionMutation object of the builder.
+func (_u *PromoRedemptionUpdate) Mutation() *PromoRedemptionMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PromoRedemptionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PromoRedemptionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PromoRedemptionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PromoRedemptionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PromoRedemptionUpdate) check() error {
+	if v, ok := _u.mutation.RedemptionStatus(); ok {
+		if err := promoredemption.RedemptionStatusValidator(v); err != nil {
+			return &ValidationError{Name: "redemption_status", err: fmt.Errorf(`generated: validator failed for field "PromoRedemption.redemption_status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CreditsGranted(); ok {
+		if err := promoredemption.CreditsGrantedValidator(v); err != nil {
+			return &ValidationError{Name: "credits_granted", err: fmt.Errorf(`generated: validator failed for field "PromoRedemption.credits_granted": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DiscountAmount(); ok {
+		if err := promoredemption.DiscountAmountValidator(v); err != nil {
+			return &ValidationError{Name: "discount_amount", err: fmt.Errorf(`generated: validator failed for field "PromoRedemption.discount_amount": %w`, err)}
+		}
+	}
+	if _u.mutation.PromoCodeCleared() && len(_u.mutation.PromoCodeIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "PromoRedemption.promo_code"`)
+	}
+	return nil
+}
+
+func (_u *PromoRedemptionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(promoredemption.Table, promoredemption.Columns, sqlgraph.NewFieldSpec(promoredemption.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.OrderIDCleared() {
+		_spec.ClearField(promoredemption.FieldOrderID, field.TypeString)
+	}
+	if value, ok := _u.mutation.RedemptionStatus(); ok {
+		_spec.SetField(promoredemption.FieldRedemptionStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.CreditsGranted(); ok {
+		_spec.SetField(promoredemption.FieldCreditsGranted, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCreditsGranted(); ok {
+		_spec.AddField(promoredemption.FieldCreditsGranted, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DiscountAmount(); ok {
+		_spec.SetField(promoredemption.FieldDiscountAmount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDiscountAmount(); ok {
+		_spec.AddField(promoredemption.FieldDiscountAmount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(promoredemption.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(promoredemption.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RedeemedAt(); ok {
+		_spec.SetField(promoredemption.FieldRedeemedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RedeemedAtCleared() {
+		_spec.ClearField(promoredemption.FieldRedeemedAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{promoredemption.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PromoRedemptionUpdateOne is the builder for updating a single PromoRedemption entity.
+type PromoRedemptionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PromoRedemptionMutation
+}
+
+// SetRedemptionStatus sets the "redemption_status" field.
+func (_u *PromoRedemptionUpdateOne) SetRedemptionStatus(v promoredemption.RedemptionStatus) *PromoRedemptionUpdateOne {
+	_u.mutation.SetRedemptionStatus(v)
+	return _u
+}
+
+// SetNillableRedemptionStatus sets the "redemption_status" field if the given value is not nil.
+func (_u *PromoRedemptionUpdateOne) SetNillableRedemptionStatus(v *promoredemption.RedemptionStatus) *PromoRedemptionUpdateOne {
+	if v != nil {
+		_u.SetRedemptionStatus(*v)
+	}
+	return _u
+}
+
+// SetCreditsGranted sets the "credits_granted" field.
+func (_u *PromoRedemptionUpdateOne) SetCreditsGranted(v int) *PromoRedemptionUpdateOne {
+	_u.mutation.ResetCreditsGranted()
+	_u.mutation.SetCreditsGranted(v)
+	return _u
+}
+
+// SetNillableCreditsGranted sets the "credits_granted" field if the given value is not nil.
+func (_u *PromoRedemptionUpdateOne) SetNillableCreditsGranted(v *int) *PromoRedemptionUpdateOne {
+	if v != nil {
+		_u.SetCreditsGranted(*v)
+	}
+	return _u
+}
+
+// AddCreditsGranted adds value to the "credits_granted" field.
+func (_u *PromoRedemptionUpdateOne) AddCreditsGranted(v int) *PromoRedemptionUpdateOne {
+	_u.mutation.AddCreditsGranted(v)
+	return _u
+}
+
+// SetDiscountAmount sets the "discount_amount" field.
+func (_u *PromoRedemptionUpdateOne) SetDiscountAmount(v int) *PromoRedemptionUpdateOne {
+	_u.mutation.ResetDiscountAmount()
+	_u.mutation.SetDiscountAmount(v)
+	return _u
+}
+
+// SetNillableDiscountAmount sets the "discount_amount" field if the given value is not nil.
+func (_u *PromoRedemptionUpdateOne) SetNillableDiscountAmount(v *int) *PromoRedemptionUpdateOne {
+	if v != nil {
+		_u.SetDiscountAmount(*v)
+	}
+	return _u
+}
+
+// AddDiscountAmount adds value to the "discount_amount" field.
+func (_u *PromoRedemptionUpdateOne) AddDiscountAmount(v int) *PromoRedemptionUpdateOne {
+	_u.mutation.AddDiscountAmount(v)
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *PromoRedemptionUpdateOne) SetExpiresAt(v time.Time) *PromoRedemptionUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *PromoRedemptionUpdateOne) SetNillableExpiresAt(v *time.Time) *PromoRedemptionUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *PromoRedemptionUpdateOne) ClearExpiresAt() *PromoRedemptionUpdateOne {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// SetRedeemedAt sets the "redeemed_at" field.
+func (_u *PromoRedemptionUpdateOne) SetRedeemedAt(v time.Time) *PromoRedemptionUpdateOne {
+	_u.mutation.SetRedeemedAt(v)
+	return _u
+}
+
+// SetNillableRedeemedAt sets the "redeemed_at" field if the given value is not nil.
+func (_u *PromoRedemptionUpdateOne) SetNillableRedeemedAt(v *time.Time) *PromoRedemptionUpdateOne {
+	if v != nil {
+		_u.SetRedeemedAt(*v)
+	}
+	return _u
+}
+
+// ClearRedeemedAt clears the value of the "redeemed_at" field.
+func (_u *PromoRedemptionUpdateOne) ClearRedeemedAt() *PromoRedemptionUpdateOne {
+	_u.mutation.ClearRedeemedAt()
+	return _u
+}
+
+// Mutation returns the PromoRedemptionMutation object of the builder.
+func (_u *PromoRedemptionUpdateOne) Mutation() *PromoRedemptionMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the PromoRedemptionUpdate builder.
+func (_u *PromoRedemptionUpdateOne) Where(ps ...predicate.PromoRedemption) *PromoRedemptionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PromoRedemptionUpdateOne) Select(field string, fields ...string) *PromoRedemptionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PromoRedemption entity.
+func (_u *PromoRedemptionUpdateOne) Save(ctx context.Context) (*PromoRedemption, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PromoRedemptionUpdateOne) SaveX(ctx context.Context) *PromoRedemption {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PromoRedemptionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PromoRedemptionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PromoRedemptionUpdateOne) check() error {
+	if v, ok := _u.mutation.RedemptionStatus(); ok {
+		if err := promoredemption.RedemptionStatusValidator(v); err != nil {
+			return &ValidationError{Name: "redemption_status", err: fmt.Errorf(`generated: validator failed for field "PromoRedemption.redemption_status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CreditsGranted(); ok {
+		if err := promoredemption.CreditsGrantedValidator(v); err != nil {
+			return &ValidationError{Name: "credits_granted", err: fmt.Errorf(`generated: validator failed for field "PromoRedemption.credits_granted": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DiscountAmount(); ok {
+		if err := promoredemption.DiscountAmountValidator(v); err != nil {
+			return &ValidationError{Name: "discount_amount", err: fmt.Errorf(`generated: validator failed for field "PromoRedemption.discount_amount": %w`, err)}
+		}
+	}
+	if _u.mutation.PromoCodeCleared() && len(_u.mutation.PromoCodeIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "PromoRedemption.promo_code"`)
+	}
+	return nil
+}
+
+func (_u *PromoRedemptionUpdateOne) sqlSave(ctx context.Context) (_node *PromoRedemption, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(promoredemption.Table, promoredemption.Columns, sqlgraph.NewFieldSpec(promoredemption.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "PromoRedemption.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, promoredemption.FieldID)
+		for _, f := range fields {
+			if !promoredemption.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != promoredemption.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.OrderIDCleared() {
+		_spec.ClearField(promoredemption.FieldOrderID, field.TypeString)
+	}
+	if value, ok := _u.mutation.RedemptionStatus(); ok {
+		_spec.SetField(promoredemption.FieldRedemptionStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.CreditsGranted(); ok {
+		_spec.SetField(promoredemption.FieldCreditsGranted, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCreditsGranted(); ok {
+		_spec.AddField(promoredemption.FieldCreditsGranted, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DiscountAmount(); ok {
+		_spec.SetField(promoredemption.FieldDiscountAmount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDiscountAmount(); ok {
+		_spec.AddField(promoredemption.FieldDiscountAmount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(promoredemption.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(promoredemption.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RedeemedAt(); ok {
+		_spec.SetField(promoredemption.FieldRedeemedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RedeemedAtCleared() {
+		_spec.ClearField(promoredemption.FieldRedeemedAt, field.TypeTime)
+	}
+	_node = &PromoRedemption{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{promoredemption.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,214 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/UnoraApp/be/ent/generated/referral"
+)
+
+// Referral is the model entity for the Referral schema.
+type Referral struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// ReferrerID holds the value of the "referrer_id" field.
+	ReferrerID string `json:"referrer_id,omitempty"`
+	// RefereeID holds the value of the "referee_id" field.
+	RefereeID string `json:"referee_id,omitempty"`
+	// Code holds the value of the "code" field.
+	Code string `json:"code,omitempty"`
+	// DeviceID holds the value of the "device_id" field.
+	DeviceID *string `json:"device_id,omitempty"`
+	// ReferralStatus holds the value of the "referral_status" field.
+	ReferralStatus referral.ReferralStatus `json:"referral_status,omitempty"`
+	// RejectionReason holds the value of the "rejection_reason" field.
+	RejectionReason *string `json:"rejection_reason,omitempty"`
+	// ReferrerCredits holds the value of the "referrer_credits" field.
+	ReferrerCredits int `json:"referrer_credits,omitempty"`
+	// RefereeCredits holds the value of the "referee_credits" field.
+	RefereeCredits int `json:"referee_credits,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// ResolvedAt holds the value of the "resolved_at" field.
+	ResolvedAt   *time.Time `json:"resolved_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Referral) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case referral.FieldReferrerCredits, referral.FieldRefereeCredits:
+			values[i] = new(sql.NullInt64)
+		case referral.FieldID, referral.FieldReferrerID, referral.FieldRefereeID, referral.FieldCode, referral.FieldDeviceID, referral.FieldReferralStatus, referral.FieldRejectionReason:
+			values[i] = new(sql.NullString)
+		case referral.FieldCreatedAt, referral.FieldResolvedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Referral fields.
+func (_m *Referral) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case referral.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case referral.FieldReferrerID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field referrer_id", values[i])
+			} else if value.Valid {
+				_m.ReferrerID = value.String
+			}
+		case referral.FieldRefereeID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field referee_id", values[i])
+			} else if value.Valid {
+				_m.RefereeID = value.String
+			}
+		case referral.FieldCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field code", values[i])
+			} else if value.Valid {
+				_m.Code = value.String
+			}
+		case referral.FieldDeviceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field device_id", values[i])
+			} else if value.Valid {
+				_m.DeviceID = new(string)
+				*_m.DeviceID = value.String
+			}
+		case referral.FieldReferralStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field referral_status", values[i])
+			} else if value.Valid {
+				_m.ReferralStatus = referral.ReferralStatus(value.String)
+			}
+		case referral.FieldRejectionReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field rejection_reason", values[i])
+			} else if value.Valid {
+				_m.RejectionReason = new(string)
+				*_m.RejectionReason = value.String
+			}
+		case referral.FieldReferrerCredits:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field referrer_credits", values[i])
+			} else if value.Valid {
+				_m.ReferrerCredits = int(value.Int64)
+			}
+		case referral.FieldRefereeCredits:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field referee_credits", values[i])
+			} else if value.Valid {
+				_m.RefereeCredits = int(value.Int64)
+			}
+		case referral.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case referral.FieldResolvedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field resolved_at", values[i])
+			} else if value.Valid {
+				_m.ResolvedAt = new(time.Time)
+				*_m.ResolvedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Referral.
+// This includes values selected through modifiers, order, etc.
+func (_m *Referral) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Referral.
+// Note that you need to call Referral.Unwrap() before calling this method if this Referral
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Referral) Update() *ReferralUpdateOne {
+	return NewReferralClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Referral entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Referral) Unwrap() *Referral {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("generated: Referral is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Referral) String() string {
+	var builder strings.Builder
+	builder.WriteString("Referral(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("referrer_id=")
+	builder.WriteString(_m.ReferrerID)
+	builder.WriteString(", ")
+	builder.WriteString("referee_id=")
+	builder.WriteString(_m.RefereeID)
+	builder.WriteString(", ")
+	builder.WriteString("code=")
+	builder.WriteString(_m.Code)
+	builder.WriteString(", ")
+	if v := _m.DeviceID; v != nil {
+		builder.WriteString("device_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("referral_status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ReferralStatus))
+	builder.WriteString(", ")
+	if v := _m.RejectionReason; v != nil {
+		builder.WriteString("rejection_reason=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("referrer_credits=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ReferrerCredits))
+	builder.WriteString(", ")
+	builder.WriteString("referee_credits=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RefereeCredits))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.ResolvedAt; v != nil {
+		builder.WriteString("resolved_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Referrals is a parsable slice of Referral.
+type Referrals []*Referral
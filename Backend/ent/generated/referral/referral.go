@@ -0,0 +1,174 @@
+// Code generated by ent, DO NOT EDIT.
+
+package referral
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the referral type in the database.
+	Label = "referral"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldReferrerID holds the string denoting the referrer_id field in the database.
+	FieldReferrerID = "referrer_id"
+	// FieldRefereeID holds the string denoting the referee_id field in the database.
+	FieldRefereeID = "referee_id"
+	// FieldCode holds the string denoting the code field in the database.
+	FieldCode = "code"
+	// FieldDeviceID holds the string denoting the device_id field in the database.
+	FieldDeviceID = "device_id"
+	// FieldReferralStatus holds the string denoting the referral_status field in the database.
+	FieldReferralStatus = "referral_status"
+	// FieldRejectionReason holds the string denoting the rejection_reason field in the database.
+	FieldRejectionReason = "rejection_reason"
+	// FieldReferrerCredits holds the string denoting the referrer_credits field in the database.
+	FieldReferrerCredits = "referrer_credits"
+	// FieldRefereeCredits holds the string denoting the referee_credits field in the database.
+	FieldRefereeCredits = "referee_credits"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldResolvedAt holds the string denoting the resolved_at field in the database.
+	FieldResolvedAt = "resolved_at"
+	// Table holds the table name of the referral in the database.
+	Table = "referrals"
+)
+
+// Columns holds all SQL columns for referral fields.
+var Columns = []string{
+	FieldID,
+	FieldReferrerID,
+	FieldRefereeID,
+	FieldCode,
+	FieldDeviceID,
+	FieldReferralStatus,
+	FieldRejectionReason,
+	FieldReferrerCredits,
+	FieldRefereeCredits,
+	FieldCreatedAt,
+	FieldResolvedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// ReferrerIDValidator is a validator for the "referrer_id" field. It is called by the builders before save.
+	ReferrerIDValidator func(string) error
+	// RefereeIDValidator is a validator for the "referee_id" field. It is called by the builders before save.
+	RefereeIDValidator func(string) error
+	// CodeValidator is a validator for the "code" field. It is called by the builders before save.
+	CodeValidator func(string) error
+	// DeviceIDValidator is a validator for the "device_id" field. It is called by the builders before save.
+	DeviceIDValidator func(string) error
+	// RejectionReasonValidator is a validator for the "rejection_reason" field. It is called by the builders before save.
+	RejectionReasonValidator func(string) error
+	// DefaultReferrerCredits holds the default value on creation for the "referrer_credits" field.
+	DefaultReferrerCredits int
+	// ReferrerCreditsValidator is a validator for the "referrer_credits" field. It is called by the builders before save.
+	ReferrerCreditsValidator func(int) error
+	// DefaultRefereeCredits holds the default value on creation for the "referee_credits" field.
+	DefaultRefereeCredits int
+	// RefereeCreditsValidator is a validator for the "referee_credits" field. It is called by the builders before save.
+	RefereeCreditsValidator func(int) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
+
+// ReferralStatus defines the type for the "referral_status" enum field.
+type ReferralStatus string
+
+// ReferralStatusPending is the default value of the ReferralStatus enum.
+const DefaultReferralStatus = ReferralStatusPending
+
+// ReferralStatus values.
+const (
+	ReferralStatusPending  ReferralStatus = "pending"
+	ReferralStatusRewarded ReferralStatus = "rewarded"
+	ReferralStatusRejected ReferralStatus = "rejected"
+)
+
+func (rs ReferralStatus) String() string {
+	return string(rs)
+}
+
+// ReferralStatusValidator is a validator for the "referral_status" field enum values. It is called by the builders before save.
+func ReferralStatusValidator(rs ReferralStatus) error {
+	switch rs {
+	case ReferralStatusPending, ReferralStatusRewarded, ReferralStatusRejected:
+		return nil
+	default:
+		return fmt.Errorf("referral: invalid enum value for referral_status field: %q", rs)
+	}
+}
+
+// OrderOption defines the ordering options for the Referral queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByReferrerID orders the results by the referrer_id field.
+func ByReferrerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReferrerID, opts...).ToFunc()
+}
+
+// ByRefereeID orders the results by the referee_id field.
+func ByRefereeID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRefereeID, opts...).ToFunc()
+}
+
+// ByCode orders the results by the code field.
+func ByCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCode, opts...).ToFunc()
+}
+
+// ByDeviceID orders the results by the device_id field.
+func ByDeviceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeviceID, opts...).ToFunc()
+}
+
+// ByReferralStatus orders the results by the referral_status field.
+func ByReferralStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReferralStatus, opts...).ToFunc()
+}
+
+// ByRejectionReason orders the results by the rejection_reason field.
+func ByRejectionReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRejectionReason, opts...).ToFunc()
+}
+
+// ByReferrerCredits orders the results by the referrer_credits field.
+func ByReferrerCredits(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReferrerCredits, opts...).ToFunc()
+}
+
+// ByRefereeCredits orders the results by the referee_credits field.
+func ByRefereeCredits(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRefereeCredits, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByResolvedAt orders the results by the resolved_at field.
+func ByResolvedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResolvedAt, opts...).ToFunc()
+}
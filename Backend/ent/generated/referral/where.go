@@ -0,0 +1,660 @@
+// Code generated by ent, DO NOT EDIT.
+
+package referral
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/UnoraApp/be/ent/generated/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.Referral {
+	return predicate.Referral(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.Referral {
+	return predicate.Referral(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.Referral {
+	return predicate.Referral(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.Referral {
+	return predicate.Referral(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.Referral {
+	return predicate.Referral(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.Referral {
+	return predicate.Referral(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.Referral {
+	return predicate.Referral(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.Referral {
+	return predicate.Referral(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.Referral {
+	return predicate.Referral(sql.FieldContainsFold(FieldID, id))
+}
+
+// ReferrerID applies equality check predicate on the "referrer_id" field. It's identical to ReferrerIDEQ.
+func ReferrerID(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldReferrerID, v))
+}
+
+// RefereeID applies equality check predicate on the "referee_id" field. It's identical to RefereeIDEQ.
+func RefereeID(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldRefereeID, v))
+}
+
+// Code applies equality check predicate on the "code" field. It's identical to CodeEQ.
+func Code(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldCode, v))
+}
+
+// DeviceID applies equality check predicate on the "device_id" field. It's identical to DeviceIDEQ.
+func DeviceID(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldDeviceID, v))
+}
+
+// RejectionReason applies equality check predicate on the "rejection_reason" field. It's identical to RejectionReasonEQ.
+func RejectionReason(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldRejectionReason, v))
+}
+
+// ReferrerCredits applies equality check predicate on the "referrer_credits" field. It's identical to ReferrerCreditsEQ.
+func ReferrerCredits(v int) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldReferrerCredits, v))
+}
+
+// RefereeCredits applies equality check predicate on the "referee_credits" field. It's identical to RefereeCreditsEQ.
+func RefereeCredits(v int) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldRefereeCredits, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ResolvedAt applies equality check predicate on the "resolved_at" field. It's identical to ResolvedAtEQ.
+func ResolvedAt(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldResolvedAt, v))
+}
+
+// ReferrerIDEQ applies the EQ predicate on the "referrer_id" field.
+func ReferrerIDEQ(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldReferrerID, v))
+}
+
+// ReferrerIDNEQ applies the NEQ predicate on the "referrer_id" field.
+func ReferrerIDNEQ(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldNEQ(FieldReferrerID, v))
+}
+
+// ReferrerIDIn applies the In predicate on the "referrer_id" field.
+func ReferrerIDIn(vs ...string) predicate.Referral {
+	return predicate.Referral(sql.FieldIn(FieldReferrerID, vs...))
+}
+
+// ReferrerIDNotIn applies the NotIn predicate on the "referrer_id" field.
+func ReferrerIDNotIn(vs ...string) predicate.Referral {
+	return predicate.Referral(sql.FieldNotIn(FieldReferrerID, vs...))
+}
+
+// ReferrerIDGT applies the GT predicate on the "referrer_id" field.
+func ReferrerIDGT(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldGT(FieldReferrerID, v))
+}
+
+// ReferrerIDGTE applies the GTE predicate on the "referrer_id" field.
+func ReferrerIDGTE(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldGTE(FieldReferrerID, v))
+}
+
+// ReferrerIDLT applies the LT predicate on the "referrer_id" field.
+func ReferrerIDLT(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldLT(FieldReferrerID, v))
+}
+
+// ReferrerIDLTE applies the LTE predicate on the "referrer_id" field.
+func ReferrerIDLTE(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldLTE(FieldReferrerID, v))
+}
+
+// ReferrerIDContains applies the Contains predicate on the "referrer_id" field.
+func ReferrerIDContains(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldContains(FieldReferrerID, v))
+}
+
+// ReferrerIDHasPrefix applies the HasPrefix predicate on the "referrer_id" field.
+func ReferrerIDHasPrefix(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldHasPrefix(FieldReferrerID, v))
+}
+
+// ReferrerIDHasSuffix applies the HasSuffix predicate on the "referrer_id" field.
+func ReferrerIDHasSuffix(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldHasSuffix(FieldReferrerID, v))
+}
+
+// ReferrerIDEqualFold applies the EqualFold predicate on the "referrer_id" field.
+func ReferrerIDEqualFold(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldEqualFold(FieldReferrerID, v))
+}
+
+// ReferrerIDContainsFold applies the ContainsFold predicate on the "referrer_id" field.
+func ReferrerIDContainsFold(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldContainsFold(FieldReferrerID, v))
+}
+
+// RefereeIDEQ applies the EQ predicate on the "referee_id" field.
+func RefereeIDEQ(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldRefereeID, v))
+}
+
+// RefereeIDNEQ applies the NEQ predicate on the "referee_id" field.
+func RefereeIDNEQ(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldNEQ(FieldRefereeID, v))
+}
+
+// RefereeIDIn applies the In predicate on the "referee_id" field.
+func RefereeIDIn(vs ...string) predicate.Referral {
+	return predicate.Referral(sql.FieldIn(FieldRefereeID, vs...))
+}
+
+// RefereeIDNotIn applies the NotIn predicate on the "referee_id" field.
+func RefereeIDNotIn(vs ...string) predicate.Referral {
+	return predicate.Referral(sql.FieldNotIn(FieldRefereeID, vs...))
+}
+
+// RefereeIDGT applies the GT predicate on the "referee_id" field.
+func RefereeIDGT(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldGT(FieldRefereeID, v))
+}
+
+// RefereeIDGTE applies the GTE predicate on the "referee_id" field.
+func RefereeIDGTE(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldGTE(FieldRefereeID, v))
+}
+
+// RefereeIDLT applies the LT predicate on the "referee_id" field.
+func RefereeIDLT(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldLT(FieldRefereeID, v))
+}
+
+// RefereeIDLTE applies the LTE predicate on the "referee_id" field.
+func RefereeIDLTE(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldLTE(FieldRefereeID, v))
+}
+
+// RefereeIDContains applies the Contains predicate on the "referee_id" field.
+func RefereeIDContains(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldContains(FieldRefereeID, v))
+}
+
+// RefereeIDHasPrefix applies the HasPrefix predicate on the "referee_id" field.
+func RefereeIDHasPrefix(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldHasPrefix(FieldRefereeID, v))
+}
+
+// RefereeIDHasSuffix applies the HasSuffix predicate on the "referee_id" field.
+func RefereeIDHasSuffix(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldHasSuffix(FieldRefereeID, v))
+}
+
+// RefereeIDEqualFold applies the EqualFold predicate on the "referee_id" field.
+func RefereeIDEqualFold(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldEqualFold(FieldRefereeID, v))
+}
+
+// RefereeIDContainsFold applies the ContainsFold predicate on the "referee_id" field.
+func RefereeIDContainsFold(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldContainsFold(FieldRefereeID, v))
+}
+
+// CodeEQ applies the EQ predicate on the "code" field.
+func CodeEQ(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldCode, v))
+}
+
+// CodeNEQ applies the NEQ predicate on the "code" field.
+func CodeNEQ(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldNEQ(FieldCode, v))
+}
+
+// CodeIn applies the In predicate on the "code" field.
+func CodeIn(vs ...string) predicate.Referral {
+	return predicate.Referral(sql.FieldIn(FieldCode, vs...))
+}
+
+// CodeNotIn applies the NotIn predicate on the "code" field.
+func CodeNotIn(vs ...string) predicate.Referral {
+	return predicate.Referral(sql.FieldNotIn(FieldCode, vs...))
+}
+
+// CodeGT applies the GT predicate on the "code" field.
+func CodeGT(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldGT(FieldCode, v))
+}
+
+// CodeGTE applies the GTE predicate on the "code" field.
+func CodeGTE(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldGTE(FieldCode, v))
+}
+
+// CodeLT applies the LT predicate on the "code" field.
+func CodeLT(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldLT(FieldCode, v))
+}
+
+// CodeLTE applies the LTE predicate on the "code" field.
+func CodeLTE(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldLTE(FieldCode, v))
+}
+
+// CodeContains applies the Contains predicate on the "code" field.
+func CodeContains(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldContains(FieldCode, v))
+}
+
+// CodeHasPrefix applies the HasPrefix predicate on the "code" field.
+func CodeHasPrefix(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldHasPrefix(FieldCode, v))
+}
+
+// CodeHasSuffix applies the HasSuffix predicate on the "code" field.
+func CodeHasSuffix(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldHasSuffix(FieldCode, v))
+}
+
+// CodeEqualFold applies the EqualFold predicate on the "code" field.
+func CodeEqualFold(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldEqualFold(FieldCode, v))
+}
+
+// CodeContainsFold applies the ContainsFold predicate on the "code" field.
+func CodeContainsFold(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldContainsFold(FieldCode, v))
+}
+
+// DeviceIDEQ applies the EQ predicate on the "device_id" field.
+func DeviceIDEQ(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldDeviceID, v))
+}
+
+// DeviceIDNEQ applies the NEQ predicate on the "device_id" field.
+func DeviceIDNEQ(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldNEQ(FieldDeviceID, v))
+}
+
+// DeviceIDIn applies the In predicate on the "device_id" field.
+func DeviceIDIn(vs ...string) predicate.Referral {
+	return predicate.Referral(sql.FieldIn(FieldDeviceID, vs...))
+}
+
+// DeviceIDNotIn applies the NotIn predicate on the "device_id" field.
+func DeviceIDNotIn(vs ...string) predicate.Referral {
+	return predicate.Referral(sql.FieldNotIn(FieldDeviceID, vs...))
+}
+
+// DeviceIDGT applies the GT predicate on the "device_id" field.
+func DeviceIDGT(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldGT(FieldDeviceID, v))
+}
+
+// DeviceIDGTE applies the GTE predicate on the "device_id" field.
+func DeviceIDGTE(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldGTE(FieldDeviceID, v))
+}
+
+// DeviceIDLT applies the LT predicate on the "device_id" field.
+func DeviceIDLT(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldLT(FieldDeviceID, v))
+}
+
+// DeviceIDLTE applies the LTE predicate on the "device_id" field.
+func DeviceIDLTE(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldLTE(FieldDeviceID, v))
+}
+
+// DeviceIDContains applies the Contains predicate on the "device_id" field.
+func DeviceIDContains(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldContains(FieldDeviceID, v))
+}
+
+// DeviceIDHasPrefix applies the HasPrefix predicate on the "device_id" field.
+func DeviceIDHasPrefix(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldHasPrefix(FieldDeviceID, v))
+}
+
+// DeviceIDHasSuffix applies the HasSuffix predicate on the "device_id" field.
+func DeviceIDHasSuffix(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldHasSuffix(FieldDeviceID, v))
+}
+
+// DeviceIDIsNil applies the IsNil predicate on the "device_id" field.
+func DeviceIDIsNil() predicate.Referral {
+	return predicate.Referral(sql.FieldIsNull(FieldDeviceID))
+}
+
+// DeviceIDNotNil applies the NotNil predicate on the "device_id" field.
+func DeviceIDNotNil() predicate.Referral {
+	return predicate.Referral(sql.FieldNotNull(FieldDeviceID))
+}
+
+// DeviceIDEqualFold applies the EqualFold predicate on the "device_id" field.
+func DeviceIDEqualFold(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldEqualFold(FieldDeviceID, v))
+}
+
+// DeviceIDContainsFold applies the ContainsFold predicate on the "device_id" field.
+func DeviceIDContainsFold(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldContainsFold(FieldDeviceID, v))
+}
+
+// ReferralStatusEQ applies the EQ predicate on the "referral_status" field.
+func ReferralStatusEQ(v ReferralStatus) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldReferralStatus, v))
+}
+
+// ReferralStatusNEQ applies the NEQ predicate on the "referral_status" field.
+func ReferralStatusNEQ(v ReferralStatus) predicate.Referral {
+	return predicate.Referral(sql.FieldNEQ(FieldReferralStatus, v))
+}
+
+// ReferralStatusIn applies the In predicate on the "referral_status" field.
+func ReferralStatusIn(vs ...ReferralStatus) predicate.Referral {
+	return predicate.Referral(sql.FieldIn(FieldReferralStatus, vs...))
+}
+
+// ReferralStatusNotIn applies the NotIn predicate on the "referral_status" field.
+func ReferralStatusNotIn(vs ...ReferralStatus) predicate.Referral {
+	return predicate.Referral(sql.FieldNotIn(FieldReferralStatus, vs...))
+}
+
+// RejectionReasonEQ applies the EQ predicate on the "rejection_reason" field.
+func RejectionReasonEQ(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldRejectionReason, v))
+}
+
+// RejectionReasonNEQ applies the NEQ predicate on the "rejection_reason" field.
+func RejectionReasonNEQ(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldNEQ(FieldRejectionReason, v))
+}
+
+// RejectionReasonIn applies the In predicate on the "rejection_reason" field.
+func RejectionReasonIn(vs ...string) predicate.Referral {
+	return predicate.Referral(sql.FieldIn(FieldRejectionReason, vs...))
+}
+
+// RejectionReasonNotIn applies the NotIn predicate on the "rejection_reason" field.
+func RejectionReasonNotIn(vs ...string) predicate.Referral {
+	return predicate.Referral(sql.FieldNotIn(FieldRejectionReason, vs...))
+}
+
+// RejectionReasonGT applies the GT predicate on the "rejection_reason" field.
+func RejectionReasonGT(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldGT(FieldRejectionReason, v))
+}
+
+// RejectionReasonGTE applies the GTE predicate on the "rejection_reason" field.
+func RejectionReasonGTE(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldGTE(FieldRejectionReason, v))
+}
+
+// RejectionReasonLT applies the LT predicate on the "rejection_reason" field.
+func RejectionReasonLT(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldLT(FieldRejectionReason, v))
+}
+
+// RejectionReasonLTE applies the LTE predicate on the "rejection_reason" field.
+func RejectionReasonLTE(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldLTE(FieldRejectionReason, v))
+}
+
+// RejectionReasonContains applies the Contains predicate on the "rejection_reason" field.
+func RejectionReasonContains(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldContains(FieldRejectionReason, v))
+}
+
+// RejectionReasonHasPrefix applies the HasPrefix predicate on the "rejection_reason" field.
+func RejectionReasonHasPrefix(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldHasPrefix(FieldRejectionReason, v))
+}
+
+// RejectionReasonHasSuffix applies the HasSuffix predicate on the "rejection_reason" field.
+func RejectionReasonHasSuffix(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldHasSuffix(FieldRejectionReason, v))
+}
+
+// RejectionReasonIsNil applies the IsNil predicate on the "rejection_reason" field.
+func RejectionReasonIsNil() predicate.Referral {
+	return predicate.Referral(sql.FieldIsNull(FieldRejectionReason))
+}
+
+// RejectionReasonNotNil applies the NotNil predicate on the "rejection_reason" field.
+func RejectionReasonNotNil() predicate.Referral {
+	return predicate.Referral(sql.FieldNotNull(FieldRejectionReason))
+}
+
+// RejectionReasonEqualFold applies the EqualFold predicate on the "rejection_reason" field.
+func RejectionReasonEqualFold(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldEqualFold(FieldRejectionReason, v))
+}
+
+// RejectionReasonContainsFold applies the ContainsFold predicate on the "rejection_reason" field.
+func RejectionReasonContainsFold(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldContainsFold(FieldRejectionReason, v))
+}
+
+// ReferrerCreditsEQ applies the EQ predicate on the "referrer_credits" field.
+func ReferrerCreditsEQ(v int) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldReferrerCredits, v))
+}
+
+// ReferrerCreditsNEQ applies the NEQ predicate on the "referrer_credits" field.
+func ReferrerCreditsNEQ(v int) predicate.Referral {
+	return predicate.Referral(sql.FieldNEQ(FieldReferrerCredits, v))
+}
+
+// ReferrerCreditsIn applies the In predicate on the "referrer_credits" field.
+func ReferrerCreditsIn(vs ...int) predicate.Referral {
+	return predicate.Referral(sql.FieldIn(FieldReferrerCredits, vs...))
+}
+
+// ReferrerCreditsNotIn applies the NotIn predicate on the "referrer_credits" field.
+func ReferrerCreditsNotIn(vs ...int) predicate.Referral {
+	return predicate.Referral(sql.FieldNotIn(FieldReferrerCredits, vs...))
+}
+
+// ReferrerCreditsGT applies the GT predicate on the "referrer_credits" field.
+func ReferrerCreditsGT(v int) predicate.Referral {
+	return predicate.Referral(sql.FieldGT(FieldReferrerCredits, v))
+}
+
+// ReferrerCreditsGTE applies the GTE predicate on the "referrer_credits" field.
+func ReferrerCreditsGTE(v int) predicate.Referral {
+	return predicate.Referral(sql.FieldGTE(FieldReferrerCredits, v))
+}
+
+// ReferrerCreditsLT applies the LT predicate on the "referrer_credits" field.
+func ReferrerCreditsLT(v int) predicate.Referral {
+	return predicate.Referral(sql.FieldLT(FieldReferrerCredits, v))
+}
+
+// ReferrerCreditsLTE applies the LTE predicate on the "referrer_credits" field.
+func ReferrerCreditsLTE(v int) predicate.Referral {
+	return predicate.Referral(sql.FieldLTE(FieldReferrerCredits, v))
+}
+
+// RefereeCreditsEQ applies the EQ predicate on the "referee_credits" field.
+func RefereeCreditsEQ(v int) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldRefereeCredits, v))
+}
+
+// RefereeCreditsNEQ applies the NEQ predicate on the "referee_credits" field.
+func RefereeCreditsNEQ(v int) predicate.Referral {
+	return predicate.Referral(sql.FieldNEQ(FieldRefereeCredits, v))
+}
+
+// RefereeCreditsIn applies the In predicate on the "referee_credits" field.
+func RefereeCreditsIn(vs ...int) predicate.Referral {
+	return predicate.Referral(sql.FieldIn(FieldRefereeCredits, vs...))
+}
+
+// RefereeCreditsNotIn applies the NotIn predicate on the "referee_credits" field.
+func RefereeCreditsNotIn(vs ...int) predicate.Referral {
+	return predicate.Referral(sql.FieldNotIn(FieldRefereeCredits, vs...))
+}
+
+// RefereeCreditsGT applies the GT predicate on the "referee_credits" field.
+func RefereeCreditsGT(v int) predicate.Referral {
+	return predicate.Referral(sql.FieldGT(FieldRefereeCredits, v))
+}
+
+// RefereeCreditsGTE applies the GTE predicate on the "referee_credits" field.
+func RefereeCreditsGTE(v int) predicate.Referral {
+	return predicate.Referral(sql.FieldGTE(FieldRefereeCredits, v))
+}
+
+// RefereeCreditsLT applies the LT predicate on the "referee_credits" field.
+func RefereeCreditsLT(v int) predicate.Referral {
+	return predicate.Referral(sql.FieldLT(FieldRefereeCredits, v))
+}
+
+// RefereeCreditsLTE applies the LTE predicate on the "referee_credits" field.
+func RefereeCreditsLTE(v int) predicate.Referral {
+	return predicate.Referral(sql.FieldLTE(FieldRefereeCredits, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// ResolvedAtEQ applies the EQ predicate on the "resolved_at" field.
+func ResolvedAtEQ(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldResolvedAt, v))
+}
+
+// ResolvedAtNEQ applies the NEQ predicate on the "resolved_at" field.
+func ResolvedAtNEQ(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldNEQ(FieldResolvedAt, v))
+}
+
+// ResolvedAtIn applies the In predicate on the "resolved_at" field.
+func ResolvedAtIn(vs ...time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldIn(FieldResolvedAt, vs...))
+}
+
+// ResolvedAtNotIn applies the NotIn predicate on the "resolved_at" field.
+func ResolvedAtNotIn(vs ...time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldNotIn(FieldResolvedAt, vs...))
+}
+
+// ResolvedAtGT applies the GT predicate on the "resolved_at" field.
+func ResolvedAtGT(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldGT(FieldResolvedAt, v))
+}
+
+// ResolvedAtGTE applies the GTE predicate on the "resolved_at" field.
+func ResolvedAtGTE(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldGTE(FieldResolvedAt, v))
+}
+
+// ResolvedAtLT applies the LT predicate on the "resolved_at" field.
+func ResolvedAtLT(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldLT(FieldResolvedAt, v))
+}
+
+// ResolvedAtLTE applies the LTE predicate on the "resolved_at" field.
+func ResolvedAtLTE(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldLTE(FieldResolvedAt, v))
+}
+
+// ResolvedAtIsNil applies the IsNil predicate on the "resolved_at" field.
+func ResolvedAtIsNil() predicate.Referral {
+	return predicate.Referral(sql.FieldIsNull(FieldResolvedAt))
+}
+
+// ResolvedAtNotNil applies the NotNil predicate on the "resolved_at" field.
+func ResolvedAtNotNil() predicate.Referral {
+	return predicate.Referral(sql.FieldNotNull(FieldResolvedAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Referral) predicate.Referral {
+	return predicate.Referral(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Referral) predicate.Referral {
+	return predicate.Referral(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Referral) predicate.Referral {
+	return predicate.Referral(sql.NotPredicates(p))
+}
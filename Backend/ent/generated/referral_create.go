@@ -0,0 +1,991 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/UnoraApp/be/ent/generated/referral"
+)
+
+// ReferralCreate is the builder for creating a Referral entity.
+type ReferralCreate struct {
+	config
+	mutation *ReferralMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetReferrerID sets the "referrer_id" field.
+func (_c *ReferralCreate) SetReferrerID(v string) *ReferralCreate {
+	_c.mutation.SetReferrerID(v)
+	return _c
+}
+
+// SetRefereeID sets the "referee_id" field.
+func (_c *ReferralCreate) SetRefereeID(v string) *ReferralCreate {
+	_c.mutation.SetRefereeID(v)
+	return _c
+}
+
+// SetCode sets the "code" field.
+func (_c *ReferralCreate) SetCode(v string) *ReferralCreate {
+	_c.mutation.SetCode(v)
+	return _c
+}
+
+// SetDeviceID sets the "device_id" field.
+func (_c *ReferralCreate) SetDeviceID(v string) *ReferralCreate {
+	_c.mutation.SetDeviceID(v)
+	return _c
+}
+
+// SetNillableDeviceID sets the "device_id" field if the given value is not nil.
+func (_c *ReferralCreate) SetNillableDeviceID(v *string) *ReferralCreate {
+	if v != nil {
+		_c.SetDeviceID(*v)
+	}
+	return _c
+}
+
+// SetReferralStatus sets the "referral_status" field.
+func (_c *ReferralCreate) SetReferralStatus(v referral.ReferralStatus) *ReferralCreate {
+	_c.mutation.SetReferralStatus(v)
+	return _c
+}
+
+// SetNillableReferralStatus sets the "referral_status" field if the given value is not nil.
+func (_c *ReferralCreate) SetNillableReferralStatus(v *referral.ReferralStatus) *ReferralCreate {
+	if v != nil {
+		_c.SetReferralStatus(*v)
+	}
+	return _c
+}
+
+// SetRejectionReason sets the "rejection_reason" field.
+func (_c *ReferralCreate) SetRejectionReason(v string) *ReferralCreate {
+	_c.mutation.SetRejectionReason(v)
+	return _c
+}
+
+// SetNillableRejectionReason sets the "rejection_reason" field if the given value is not nil.
+func (_c *ReferralCreate) SetNillableRejectionReason(v *string) *ReferralCreate {
+	if v != nil {
+		_c.SetRejectionReason(*v)
+	}
+	return _c
+}
+
+// SetReferrerCredits sets the "referrer_credits" field.
+func (_c *ReferralCreate) SetReferrerCredits(v int) *ReferralCreate {
+	_c.mutation.SetReferrerCredits(v)
+	return _c
+}
+
+// SetNillableReferrerCredits sets the "referrer_credits" field if the given value is not nil.
+func (_c *ReferralCreate) SetNillableReferrerCredits(v *int) *ReferralCreate {
+	if v != nil {
+		_c.SetReferrerCredits(*v)
+	}
+	return _c
+}
+
+// SetRefereeCredits sets the "referee_credits" field.
+func (_c *ReferralCreate) SetRefereeCredits(v int) *ReferralCreate {
+	_c.mutation.SetRefereeCredits(v)
+	return _c
+}
+
+// SetNillableRefereeCredits sets the "referee_credits" field if the given value is not nil.
+func (_c *ReferralCreate) SetNillableRefereeCredits(v *int) *ReferralCreate {
+	if v != nil {
+		_c.SetRefereeCredits(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ReferralCreate) SetCreatedAt(v time.Time) *ReferralCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ReferralCreate) SetNillableCreatedAt(v *time.Time) *ReferralCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (_c *ReferralCreate) SetResolvedAt(v time.Time) *ReferralCreate {
+	_c.mutation.SetResolvedAt(v)
+	return _c
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (_c *ReferralCreate) SetNillableResolvedAt(v *time.Time) *ReferralCreate {
+	if v != nil {
+		_c.SetResolvedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ReferralCreate) SetID(v string) *ReferralCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the ReferralMutation object of the builder.
+func (_c *ReferralCreate) Mutation() *ReferralMutation {
+	return _c.mutation
+}
+
+// Save creates the Referral in the database.
+func (_c *ReferralCreate) Save(ctx context.Context) (*Referral, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ReferralCreate) SaveX(ctx context.Context) *Referral {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ReferralCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ReferralCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ReferralCreate) defaults() {
+	if _, ok := _c.mutation.ReferralStatus(); !ok {
+		v := referral.DefaultReferralStatus
+		_c.mutation.SetReferralStatus(v)
+	}
+	if _, ok := _c.mutation.ReferrerCredits(); !ok {
+		v := referral.DefaultReferrerCredits
+		_c.mutation.SetReferrerCredits(v)
+	}
+	if _, ok := _c.mutation.RefereeCredits(); !ok {
+		v := referral.DefaultRefereeCredits
+		_c.mutation.SetRefereeCredits(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := referral.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ReferralCreate) check() error {
+	if _, ok := _c.mutation.ReferrerID(); !ok {
+		return &ValidationError{Name: "referrer_id", err: errors.New(`generated: missing required field "Referral.referrer_id"`)}
+	}
+	if v, ok := _c.mutation.ReferrerID(); ok {
+		if err := referral.ReferrerIDValidator(v); err != nil {
+			return &ValidationError{Name: "referrer_id", err: fmt.Errorf(`generated: validator failed for field "Referral.referrer_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RefereeID(); !ok {
+		return &ValidationError{Name: "referee_id", err: errors.New(`generated: missing required field "Referral.referee_id"`)}
+	}
+	if v, ok := _c.mutation.RefereeID(); ok {
+		if err := referral.RefereeIDValidator(v); err != nil {
+			return &ValidationError{Name: "referee_id", err: fmt.Errorf(`generated: validator failed for field "Referral.referee_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Code(); !ok {
+		return &ValidationError{Name: "code", err: errors.New(`generated: missing required field "Referral.code"`)}
+	}
+	if v, ok := _c.mutation.Code(); ok {
+		if err := referral.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`generated: validator failed for field "Referral.code": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.DeviceID(); ok {
+		if err := referral.DeviceIDValidator(v); err != nil {
+			return &ValidationError{Name: "device_id", err: fmt.Errorf(`generated: validator failed for field "Referral.device_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ReferralStatus(); !ok {
+		return &ValidationError{Name: "referral_status", err: errors.New(`generated: missing required field "Referral.referral_status"`)}
+	}
+	if v, ok := _c.mutation.ReferralStatus(); ok {
+		if err := referral.ReferralStatusValidator(v); err != nil {
+			return &ValidationError{Name: "referral_status", err: fmt.Errorf(`generated: validator failed for field "Referral.referral_status": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.RejectionReason(); ok {
+		if err := referral.RejectionReasonValidator(v); err != nil {
+			return &ValidationError{Name: "rejection_reason", err: fmt.Errorf(`generated: validator failed for field "Referral.rejection_reason": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ReferrerCredits(); !ok {
+		return &ValidationError{Name: "referrer_credits", err: errors.New(`generated: missing required field "Referral.referrer_credits"`)}
+	}
+	if v, ok := _c.mutation.ReferrerCredits(); ok {
+		if err := referral.ReferrerCreditsValidator(v); err != nil {
+			return &ValidationError{Name: "referrer_credits", err: fmt.Errorf(`generated: validator failed for field "Referral.referrer_credits": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RefereeCredits(); !ok {
+		return &ValidationError{Name: "referee_credits", err: errors.New(`generated: missing required field "Referral.referee_credits"`)}
+	}
+	if v, ok := _c.mutation.RefereeCredits(); ok {
+		if err := referral.RefereeCreditsValidator(v); err != nil {
+			return &ValidationError{Name: "referee_credits", err: fmt.Errorf(`generated: validator failed for field "Referral.referee_credits": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "Referral.created_at"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := referral.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`generated: validator failed for field "Referral.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *ReferralCreate) sqlSave(ctx context.Context) (*Referral, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Referral.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ReferralCreate) createSpec() (*Referral, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Referral{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(referral.Table, sqlgraph.NewFieldSpec(referral.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.ReferrerID(); ok {
+		_spec.SetField(referral.FieldReferrerID, field.TypeString, value)
+		_node.ReferrerID = value
+	}
+	if value, ok := _c.mutation.RefereeID(); ok {
+		_spec.SetField(referral.FieldRefereeID, field.TypeString, value)
+		_node.RefereeID = value
+	}
+	if value, ok := _c.mutation.Code(); ok {
+		_spec.SetField(referral.FieldCode, field.TypeString, value)
+		_node.Code = value
+	}
+	if value, ok := _c.mutation.DeviceID(); ok {
+		_spec.SetField(referral.FieldDeviceID, field.TypeString, value)
+		_node.DeviceID = &value
+	}
+	if value, ok := _c.mutation.ReferralStatus(); ok {
+		_spec.SetField(referral.FieldReferralStatus, field.TypeEnum, value)
+		_node.ReferralStatus = value
+	}
+	if value, ok := _c.mutation.RejectionReason(); ok {
+		_spec.SetField(referral.FieldRejectionReason, field.TypeString, value)
+		_node.RejectionReason = &value
+	}
+	if value, ok := _c.mutation.ReferrerCredits(); ok {
+		_spec.SetField(referral.FieldReferrerCredits, field.TypeInt, value)
+		_node.ReferrerCredits = value
+	}
+	if value, ok := _c.mutation.RefereeCredits(); ok {
+		_spec.SetField(referral.FieldRefereeCredits, field.TypeInt, value)
+		_node.RefereeCredits = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(referral.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.ResolvedAt(); ok {
+		_spec.SetField(referral.FieldResolvedAt, field.TypeTime, value)
+		_node.ResolvedAt = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Referral.Create().
+//		SetReferrerID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReferralUpsert) {
+//			SetReferrerID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ReferralCreate) OnConflict(opts ...sql.ConflictOption) *ReferralUpsertOne {
+	_c.conflict = opts
+	return &ReferralUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Referral.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ReferralCreate) OnConflictColumns(columns ...string) *ReferralUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ReferralUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ReferralUpsertOne is the builder for "upsert"-ing
+	//  one Referral node.
+	ReferralUpsertOne struct {
+		create *ReferralCreate
+	}
+
+	// ReferralUpsert is the "OnConflict" setter.
+	ReferralUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetReferralStatus sets the "referral_status" field.
+func (u *ReferralUpsert) SetReferralStatus(v referral.ReferralStatus) *ReferralUpsert {
+	u.Set(referral.FieldReferralStatus, v)
+	return u
+}
+
+// UpdateReferralStatus sets the "referral_status" field to the value that was provided on create.
+func (u *ReferralUpsert) UpdateReferralStatus() *ReferralUpsert {
+	u.SetExcluded(referral.FieldReferralStatus)
+	return u
+}
+
+// SetRejectionReason sets the "rejection_reason" field.
+func (u *ReferralUpsert) SetRejectionReason(v string) *ReferralUpsert {
+	u.Set(referral.FieldRejectionReason, v)
+	return u
+}
+
+// UpdateRejectionReason sets the "rejection_reason" field to the value that was provided on create.
+func (u *ReferralUpsert) UpdateRejectionReason() *ReferralUpsert {
+	u.SetExcluded(referral.FieldRejectionReason)
+	return u
+}
+
+// ClearRejectionReason clears the value of the "rejection_reason" field.
+func (u *ReferralUpsert) ClearRejectionReason() *ReferralUpsert {
+	u.SetNull(referral.FieldRejectionReason)
+	return u
+}
+
+// SetReferrerCredits sets the "referrer_credits" field.
+func (u *ReferralUpsert) SetReferrerCredits(v int) *ReferralUpsert {
+	u.Set(referral.FieldReferrerCredits, v)
+	return u
+}
+
+// UpdateReferrerCredits sets the "referrer_credits" field to the value that was provided on create.
+func (u *ReferralUpsert) UpdateReferrerCredits() *ReferralUpsert {
+	u.SetExcluded(referral.FieldReferrerCredits)
+	return u
+}
+
+// AddReferrerCredits adds v to the "referrer_credits" field.
+func (u *ReferralUpsert) AddReferrerCredits(v int) *ReferralUpsert {
+	u.Add(referral.FieldReferrerCredits, v)
+	return u
+}
+
+// SetRefereeCredits sets the "referee_credits" field.
+func (u *ReferralUpsert) SetRefereeCredits(v int) *ReferralUpsert {
+	u.Set(referral.FieldRefereeCredits, v)
+	return u
+}
+
+// UpdateRefereeCredits sets the "referee_credits" field to the value that was provided on create.
+func (u *ReferralUpsert) UpdateRefereeCredits() *ReferralUpsert {
+	u.SetExcluded(referral.FieldRefereeCredits)
+	return u
+}
+
+// AddRefereeCredits adds v to the "referee_credits" field.
+func (u *ReferralUpsert) AddRefereeCredits(v int) *ReferralUpsert {
+	u.Add(referral.FieldRefereeCredits, v)
+	return u
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (u *ReferralUpsert) SetResolvedAt(v time.Time) *ReferralUpsert {
+	u.Set(referral.FieldResolvedAt, v)
+	return u
+}
+
+// UpdateResolvedAt sets the "resolved_at" field to the value that was provided on create.
+func (u *ReferralUpsert) UpdateResolvedAt() *ReferralUpsert {
+	u.SetExcluded(referral.FieldResolvedAt)
+	return u
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (u *ReferralUpsert) ClearResolvedAt() *ReferralUpsert {
+	u.SetNull(referral.FieldResolvedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Referral.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(referral.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ReferralUpsertOne) UpdateNewValues() *ReferralUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(referral.FieldID)
+		}
+		if _, exists := u.create.mutation.ReferrerID(); exists {
+			s.SetIgnore(referral.FieldReferrerID)
+		}
+		if _, exists := u.create.mutation.RefereeID(); exists {
+			s.SetIgnore(referral.FieldRefereeID)
+		}
+		if _, exists := u.create.mutation.Code(); exists {
+			s.SetIgnore(referral.FieldCode)
+		}
+		if _, exists := u.create.mutation.DeviceID(); exists {
+			s.SetIgnore(referral.FieldDeviceID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(referral.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Referral.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ReferralUpsertOne) Ignore() *ReferralUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReferralUpsertOne) DoNothing() *ReferralUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReferralCreate.OnConflict
+// documentation for more info.
+func (u *ReferralUpsertOne) Update(set func(*ReferralUpsert)) *ReferralUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReferralUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetReferralStatus sets the "referral_status" field.
+func (u *ReferralUpsertOne) SetReferralStatus(v referral.ReferralStatus) *ReferralUpsertOne {
+	return u.Update(func(s *ReferralUpsert) {
+		s.SetReferralStatus(v)
+	})
+}
+
+// UpdateReferralStatus sets the "referral_status" field to the value that was provided on create.
+func (u *ReferralUpsertOne) UpdateReferralStatus() *ReferralUpsertOne {
+	return u.Update(func(s *ReferralUpsert) {
+		s.UpdateReferralStatus()
+	})
+}
+
+// SetRejectionReason sets the "rejection_reason" field.
+func (u *ReferralUpsertOne) SetRejectionReason(v string) *ReferralUpsertOne {
+	return u.Update(func(s *ReferralUpsert) {
+		s.SetRejectionReason(v)
+	})
+}
+
+// UpdateRejectionReason sets the "rejection_reason" field to the value that was provided on create.
+func (u *ReferralUpsertOne) UpdateRejectionReason() *ReferralUpsertOne {
+	return u.Update(func(s *ReferralUpsert) {
+		s.UpdateRejectionReason()
+	})
+}
+
+// ClearRejectionReason clears the value of the "rejection_reason" field.
+func (u *ReferralUpsertOne) ClearRejectionReason() *ReferralUpsertOne {
+	return u.Update(func(s *ReferralUpsert) {
+		s.ClearRejectionReason()
+	})
+}
+
+// SetReferrerCredits sets the "referrer_credits" field.
+func (u *ReferralUpsertOne) SetReferrerCredits(v int) *ReferralUpsertOne {
+	return u.Update(func(s *ReferralUpsert) {
+		s.SetReferrerCredits(v)
+	})
+}
+
+// AddReferrerCredits adds v to the "referrer_credits" field.
+func (u *ReferralUpsertOne) AddReferrerCredits(v int) *ReferralUpsertOne {
+	return u.Update(func(s *ReferralUpsert) {
+		s.AddReferrerCredits(v)
+	})
+}
+
+// UpdateReferrerCredits sets the "referrer_credits" field to the value that was provided on create.
+func (u *ReferralUpsertOne) UpdateReferrerCredits() *ReferralUpsertOne {
+	return u.Update(func(s *ReferralUpsert) {
+		s.UpdateReferrerCredits()
+	})
+}
+
+// SetRefereeCredits sets the "referee_credits" field.
+func (u *ReferralUpsertOne) SetRefereeCredits(v int) *ReferralUpsertOne {
+	return u.Update(func(s *ReferralUpsert) {
+		s.SetRefereeCredits(v)
+	})
+}
+
+// AddRefereeCredits adds v to the "referee_credits" field.
+func (u *ReferralUpsertOne) AddRefereeCredits(v int) *ReferralUpsertOne {
+	return u.Update(func(s *ReferralUpsert) {
+		s.AddRefereeCredits(v)
+	})
+}
+
+// UpdateRefereeCredits sets the "referee_credits" field to the value that was provided on create.
+func (u *ReferralUpsertOne) UpdateRefereeCredits() *ReferralUpsertOne {
+	return u.Update(func(s *ReferralUpsert) {
+		s.UpdateRefereeCredits()
+	})
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (u *ReferralUpsertOne) SetResolvedAt(v time.Time) *ReferralUpsertOne {
+	return u.Update(func(s *ReferralUpsert) {
+		s.SetResolvedAt(v)
+	})
+}
+
+// UpdateResolvedAt sets the "resolved_at" field to the value that was provided on create.
+func (u *ReferralUpsertOne) UpdateResolvedAt() *ReferralUpsertOne {
+	return u.Update(func(s *ReferralUpsert) {
+		s.UpdateResolvedAt()
+	})
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (u *ReferralUpsertOne) ClearResolvedAt() *ReferralUpsertOne {
+	return u.Update(func(s *ReferralUpsert) {
+		s.ClearResolvedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ReferralUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("generated: missing options for ReferralCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReferralUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ReferralUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("generated: ReferralUpsertOne.ID is not supported by MySQL driver. Use ReferralUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ReferralUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ReferralCreateBulk is the builder for creating many Referral entities in bulk.
+type ReferralCreateBulk struct {
+	config
+	err      error
+	builders []*ReferralCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Referral entities in the database.
+func (_c *ReferralCreateBulk) Save(ctx context.Context) ([]*Referral, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Referral, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ReferralMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ReferralCreateBulk) SaveX(ctx context.Context) []*Referral {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ReferralCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ReferralCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Referral.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReferralUpsert) {
+//			SetReferrerID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ReferralCreateBulk) OnConflict(opts ...sql.ConflictOption) *ReferralUpsertBulk {
+	_c.conflict = opts
+	return &ReferralUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Referral.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ReferralCreateBulk) OnConflictColumns(columns ...string) *ReferralUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ReferralUpsertBulk{
+		create: _c,
+	}
+}
+
+// ReferralUpsertBulk is the builder for "upsert"-ing
+// a bulk of Referral nodes.
+type ReferralUpsertBulk struct {
+	create *ReferralCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Referral.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(referral.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ReferralUpsertBulk) UpdateNewValues() *ReferralUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(referral.FieldID)
+			}
+			if _, exists := b.mutation.ReferrerID(); exists {
+				s.SetIgnore(referral.FieldReferrerID)
+			}
+			if _, exists := b.mutation.RefereeID(); exists {
+				s.SetIgnore(referral.FieldRefereeID)
+			}
+			if _, exists := b.mutation.Code(); exists {
+				s.SetIgnore(referral.FieldCode)
+			}
+			if _, exists := b.mutation.DeviceID(); exists {
+				s.SetIgnore(referral.FieldDeviceID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(referral.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Referral.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ReferralUpsertBulk) Ignore() *ReferralUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReferralUpsertBulk) DoNothing() *ReferralUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReferralCreateBulk.OnConflict
+// documentation for more info.
+func (u *ReferralUpsertBulk) Update(set func(*ReferralUpsert)) *ReferralUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReferralUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetReferralStatus sets the "referral_status" field.
+func (u *ReferralUpsertBulk) SetReferralStatus(v referral.ReferralStatus) *ReferralUpsertBulk {
+	return u.Update(func(s *ReferralUpsert) {
+		s.SetReferralStatus(v)
+	})
+}
+
+// UpdateReferralStatus sets the "referral_status" field to the value that was provided on create.
+func (u *ReferralUpsertBulk) UpdateReferralStatus() *ReferralUpsertBulk {
+	return u.Update(func(s *ReferralUpsert) {
+		s.UpdateReferralStatus()
+	})
+}
+
+// SetRejectionReason sets the "rejection_reason" field.
+func (u *ReferralUpsertBulk) SetRejectionReason(v string) *ReferralUpsertBulk {
+	return u.Update(func(s *ReferralUpsert) {
+		s.SetRejectionReason(v)
+	})
+}
+
+// UpdateRejectionReason sets the "rejection_reason" field to the value that was provided on create.
+func (u *ReferralUpsertBulk) UpdateRejectionReason() *ReferralUpsertBulk {
+	return u.Update(func(s *ReferralUpsert) {
+		s.UpdateRejectionReason()
+	})
+}
+
+// ClearRejectionReason clears the value of the "rejection_reason" field.
+func (u *ReferralUpsertBulk) ClearRejectionReason() *ReferralUpsertBulk {
+	return u.Update(func(s *ReferralUpsert) {
+		s.ClearRejectionReason()
+	})
+}
+
+// SetReferrerCredits sets the "referrer_credits" field.
+func (u *ReferralUpsertBulk) SetReferrerCredits(v int) *ReferralUpsertBulk {
+	return u.Update(func(s *ReferralUpsert) {
+		s.SetReferrerCredits(v)
+	})
+}
+
+// AddReferrerCredits adds v to the "referrer_credits" field.
+func (u *ReferralUpsertBulk) AddReferrerCredits(v int) *ReferralUpsertBulk {
+	return u.Update(func(s *ReferralUpsert) {
+		s.AddReferrerCredits(v)
+	})
+}
+
+// UpdateReferrerCredits sets the "referrer_credits" field to the value that was provided on create.
+func (u *ReferralUpsertBulk) UpdateReferrerCredits() *ReferralUpsertBulk {
+	return u.Update(func(s *ReferralUpsert) {
+		s.UpdateReferrerCredits()
+	})
+}
+
+// SetRefereeCredits sets the "referee_credits" field.
+func (u *ReferralUpsertBulk) SetRefereeCredits(v int) *ReferralUpsertBulk {
+	return u.Update(func(s *ReferralUpsert) {
+		s.SetRefereeCredits(v)
+	})
+}
+
+// AddRefereeCredits adds v to the "referee_credits" field.
+func (u *ReferralUpsertBulk) AddRefereeCredits(v int) *ReferralUpsertBulk {
+	return u.Update(func(s *ReferralUpsert) {
+		s.AddRefereeCredits(v)
+	})
+}
+
+// UpdateRefereeCredits sets the "referee_credits" field to the value that was provided on create.
+func (u *ReferralUpsertBulk) UpdateRefereeCredits() *ReferralUpsertBulk {
+	return u.Update(func(s *ReferralUpsert) {
+		s.UpdateRefereeCredits()
+	})
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (u *ReferralUpsertBulk) SetResolvedAt(v time.Time) *ReferralUpsertBulk {
+	return u.Update(func(s *ReferralUpsert) {
+		s.SetResolvedAt(v)
+	})
+}
+
+// UpdateResolvedAt sets the "resolved_at" field to the value that was provided on create.
+func (u *ReferralUpsertBulk) UpdateResolvedAt() *ReferralUpsertBulk {
+	return u.Update(func(s *ReferralUpsert) {
+		s.UpdateResolvedAt()
+	})
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (u *ReferralUpsertBulk) ClearResolvedAt() *ReferralUpsertBulk {
+	return u.Update(func(s *ReferralUpsert) {
+		s.ClearResolvedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ReferralUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("generated: OnConflict was set for builder %d. Set it on the ReferralCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("generated: missing options for ReferralCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReferralUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,484 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/UnoraApp/be/ent/generated/predicate"
+	"github.com/UnoraApp/be/ent/generated/referral"
+)
+
+// ReferralUpdate is the builder for updating Referral entities.
+type ReferralUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ReferralMutation
+}
+
+// Where appends a list predicates to the ReferralUpdate builder.
+func (_u *ReferralUpdate) Where(ps ...predicate.Referral) *ReferralUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetReferralStatus sets the "referral_status" field.
+func (_u *ReferralUpdate) SetReferralStatus(v referral.ReferralStatus) *ReferralUpdate {
+	_u.mutation.SetReferralStatus(v)
+	return _u
+}
+
+// SetNillableReferralStatus sets the "referral_status" field if the given value is not nil.
+func (_u *ReferralUpdate) SetNillableReferralStatus(v *referral.ReferralStatus) *ReferralUpdate {
+	if v != nil {
+		_u.SetReferralStatus(*v)
+	}
+	return _u
+}
+
+// SetRejectionReason sets the "rejection_reason" field.
+func (_u *ReferralUpdate) SetRejectionReason(v string) *ReferralUpdate {
+	_u.mutation.SetRejectionReason(v)
+	return _u
+}
+
+// SetNillableRejectionReason sets the "rejection_reason" field if the given value is not nil.
+func (_u *ReferralUpdate) SetNillableRejectionReason(v *string) *ReferralUpdate {
+	if v != nil {
+		_u.SetRejectionReason(*v)
+	}
+	return _u
+}
+
+// ClearRejectionReason clears the value of the "rejection_reason" field.
+func (_u *ReferralUpdate) ClearRejectionReason() *ReferralUpdate {
+	_u.mutation.ClearRejectionReason()
+	return _u
+}
+
+// SetReferrerCredits sets the "referrer_credits" field.
+func (_u *ReferralUpdate) SetReferrerCredits(v int) *ReferralUpdate {
+	_u.mutation.ResetReferrerCredits()
+	_u.mutation.SetReferrerCredits(v)
+	return _u
+}
+
+// SetNillableReferrerCredits sets the "referrer_credits" field if the given value is not nil.
+func (_u *ReferralUpdate) SetNillableReferrerCredits(v *int) *ReferralUpdate {
+	if v != nil {
+		_u.SetReferrerCredits(*v)
+	}
+	return _u
+}
+
+// AddReferrerCredits adds value to the "referrer_credits" field.
+func (_u *ReferralUpdate) AddReferrerCredits(v int) *ReferralUpdate {
+	_u.mutation.AddReferrerCredits(v)
+	return _u
+}
+
+// SetRefereeCredits sets the "referee_credits" field.
+func (_u *ReferralUpdate) SetRefereeCredits(v int) *ReferralUpdate {
+	_u.mutation.ResetRefereeCredits()
+	_u.mutation.SetRefereeCredits(v)
+	return _u
+}
+
+// SetNillableRefereeCredits sets the "referee_credits" field if the given value is not nil.
+func (_u *ReferralUpdate) SetNillableRefereeCredits(v *int) *ReferralUpdate {
+	if v != nil {
+		_u.SetRefereeCredits(*v)
+	}
+	return _u
+}
+
+// AddRefereeCredits adds value to the "referee_credits" field.
+func (_u *ReferralUpdate) AddRefereeCredits(v int) *ReferralUpdate {
+	_u.mutation.AddRefereeCredits(v)
+	return _u
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (_u *ReferralUpdate) SetResolvedAt(v time.Time) *ReferralUpdate {
+	_u.mutation.SetResolvedAt(v)
+	return _u
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (_u *ReferralUpdate) SetNillableResolvedAt(v *time.Time) *ReferralUpdate {
+	if v != nil {
+		_u.SetResolvedAt(*v)
+	}
+	return _u
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (_u *ReferralUpdate) ClearResolvedAt() *ReferralUpdate {
+	_u.mutation.ClearResolvedAt()
+	return _u
+}
+
+// Mutation returns the ReferralMutation object of the builder.
+func (_u *ReferralUpdate) Mutation() *ReferralMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ReferralUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ReferralUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ReferralUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ReferralUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ReferralUpdate) check() error {
+	if v, ok := _u.mutation.ReferralStatus(); ok {
+		if err := referral.ReferralStatusValidator(v); err != nil {
+			return &ValidationError{Name: "referral_status", err: fmt.Errorf(`generated: validator failed for field "Referral.referral_status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RejectionReason(); ok {
+		if err := referral.RejectionReasonValidator(v); err != nil {
+			return &ValidationError{Name: "rejection_reason", err: fmt.Errorf(`generated: validator failed for field "Referral.rejection_reason": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ReferrerCredits(); ok {
+		if err := referral.ReferrerCreditsValidator(v); err != nil {
+			return &ValidationError{Name: "referrer_credits", err: fmt.Errorf(`generated: validator failed for field "Referral.referrer_credits": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RefereeCredits(); ok {
+		if err := referral.RefereeCreditsValidator(v); err != nil {
+			return &ValidationError{Name: "referee_credits", err: fmt.Errorf(`generated: validator failed for field "Referral.referee_credits": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ReferralUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(referral.Table, referral.Columns, sqlgraph.NewFieldSpec(referral.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.DeviceIDCleared() {
+		_spec.ClearField(referral.FieldDeviceID, field.TypeString)
+	}
+	if value, ok := _u.mutation.ReferralStatus(); ok {
+		_spec.SetField(referral.FieldReferralStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.RejectionReason(); ok {
+		_spec.SetField(referral.FieldRejectionReason, field.TypeString, value)
+	}
+	if _u.mutation.RejectionReasonCleared() {
+		_spec.ClearField(referral.FieldRejectionReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.ReferrerCredits(); ok {
+		_spec.SetField(referral.FieldReferrerCredits, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedReferrerCredits(); ok {
+		_spec.AddField(referral.FieldReferrerCredits, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.RefereeCredits(); ok {
+		_spec.SetField(referral.FieldRefereeCredits, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRefereeCredits(); ok {
+		_spec.AddField(referral.FieldRefereeCredits, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ResolvedAt(); ok {
+		_spec.SetField(referral.FieldResolvedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ResolvedAtCleared() {
+		_spec.ClearField(referral.FieldResolvedAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{referral.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ReferralUpdateOne is the builder for updating a single Referral entity.
+type ReferralUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ReferralMutation
+}
+
+// SetReferralStatus sets the "referral_status" field.
+func (_u *ReferralUpdateOne) SetReferralStatus(v referral.ReferralStatus) *ReferralUpdateOne {
+	_u.mutation.SetReferralStatus(v)
+	return _u
+}
+
+// SetNillableReferralStatus sets the "referral_status" field if the given value is not nil.
+func (_u *ReferralUpdateOne) SetNillableReferralStatus(v *referral.ReferralStatus) *ReferralUpdateOne {
+	if v != nil {
+		_u.SetReferralStatus(*v)
+	}
+	return _u
+}
+
+// SetRejectionReason sets the "rejection_reason" field.
+func (_u *ReferralUpdateOne) SetRejectionReason(v string) *ReferralUpdateOne {
+	_u.mutation.SetRejectionReason(v)
+	return _u
+}
+
+// SetNillableRejectionReason sets the "rejection_reason" field if the given value is not nil.
+func (_u *ReferralUpdateOne) SetNillableRejectionReason(v *string) *ReferralUpdateOne {
+	if v != nil {
+		_u.SetRejectionReason(*v)
+	}
+	return _u
+}
+
+// ClearRejectionReason clears the value of the "rejection_reason" field.
+func (_u *ReferralUpdateOne) ClearRejectionReason() *ReferralUpdateOne {
+	_u.mutation.ClearRejectionReason()
+	return _u
+}
+
+// SetReferrerCredits sets the "referrer_credits" field.
+func (_u *ReferralUpdateOne) SetReferrerCredits(v int) *ReferralUpdateOne {
+	_u.mutation.ResetReferrerCredits()
+	_u.mutation.SetReferrerCredits(v)
+	return _u
+}
+
+// SetNillableReferrerCredits sets the "referrer_credits" field if the given value is not nil.
+func (_u *ReferralUpdateOne) SetNillableReferrerCredits(v *int) *ReferralUpdateOne {
+	if v != nil {
+		_u.SetReferrerCredits(*v)
+	}
+	return _u
+}
+
+// AddReferrerCredits adds value to the "referrer_credits" field.
+func (_u *ReferralUpdateOne) AddReferrerCredits(v int) *ReferralUpdateOne {
+	_u.mutation.AddReferrerCredits(v)
+	return _u
+}
+
+// SetRefereeCredits sets the "referee_credits" field.
+func (_u *ReferralUpdateOne) SetRefereeCredits(v int) *ReferralUpdateOne {
+	_u.mutation.ResetRefereeCredits()
+	_u.mutation.SetRefereeCredits(v)
+	return _u
+}
+
+// SetNillableRefereeCredits sets the "referee_credits" field if the given value is not nil.
+func (_u *ReferralUpdateOne) SetNillableRefereeCredits(v *int) *ReferralUpdateOne {
+	if v != nil {
+		_u.SetRefereeCredits(*v)
+	}
+	return _u
+}
+
+// AddRefereeCredits adds value to the "referee_credits" field.
+func (_u *ReferralUpdateOne) AddRefereeCredits(v int) *ReferralUpdateOne {
+	_u.mutation.AddRefereeCredits(v)
+	return _u
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (_u *ReferralUpdateOne) SetResolvedAt(v time.Time) *ReferralUpdateOne {
+	_u.mutation.SetResolvedAt(v)
+	return _u
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (_u *ReferralUpdateOne) SetNillableResolvedAt(v *time.Time) *ReferralUpdateOne {
+	if v != nil {
+		_u.SetResolvedAt(*v)
+	}
+	return _u
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (_u *ReferralUpdateOne) ClearResolvedAt() *ReferralUpdateOne {
+	_u.mutation.ClearResolvedAt()
+	return _u
+}
+
+// Mutation returns the ReferralMutation object of the builder.
+func (_u *ReferralUpdateOne) Mutation() *ReferralMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ReferralUpdate builder.
+func (_u *ReferralUpdateOne) Where(ps ...predicate.Referral) *ReferralUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ReferralUpdateOne) Select(field string, fields ...string) *ReferralUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Referral entity.
+func (_u *ReferralUpdateOne) Save(ctx context.Context) (*Referral, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ReferralUpdateOne) SaveX(ctx context.Context) *Referral {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ReferralUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ReferralUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ReferralUpdateOne) check() error {
+	if v, ok := _u.mutation.ReferralStatus(); ok {
+		if err := referral.ReferralStatusValidator(v); err != nil {
+			return &ValidationError{Name: "referral_status", err: fmt.Errorf(`generated: validator failed for field "Referral.referral_status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RejectionReason(); ok {
+		if err := referral.RejectionReasonValidator(v); err != nil {
+			return &ValidationError{Name: "rejection_reason", err: fmt.Errorf(`generated: validator failed for field "Referral.rejection_reason": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ReferrerCredits(); ok {
+		if err := referral.ReferrerCreditsValidator(v); err != nil {
+			return &ValidationError{Name: "referrer_credits", err: fmt.Errorf(`generated: validator failed for field "Referral.referrer_credits": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RefereeCredits(); ok {
+		if err := referral.RefereeCreditsValidator(v); err != nil {
+			return &ValidationError{Name: "referee_credits", err: fmt.Errorf(`generated: validator failed for field "Referral.referee_credits": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ReferralUpdateOne) sqlSave(ctx context.Context) (_node *Referral, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(referral.Table, referral.Columns, sqlgraph.NewFieldSpec(referral.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "Referral.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, referral.FieldID)
+		for _, f := range fields {
+			if !referral.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != referral.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.DeviceIDCleared() {
+		_spec.ClearField(referral.FieldDeviceID, field.TypeString)
+	}
+	if value, ok := _u.mutation.ReferralStatus(); ok {
+		_spec.SetField(referral.FieldReferralStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.RejectionReason(); ok {
+		_spec.SetField(referral.FieldRejectionReason, field.TypeString, value)
+	}
+	if _u.mutation.RejectionReasonCleared() {
+		_spec.ClearField(referral.FieldRejectionReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.ReferrerCredits(); ok {
+		_spec.SetField(referral.FieldReferrerCredits, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedReferrerCredits(); ok {
+		_spec.AddField(referral.FieldReferrerCredits, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.RefereeCredits(); ok {
+		_spec.SetField(referral.FieldRefereeCredits, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRefereeCredits(); ok {
+		_spec.AddField(referral.FieldRefereeCredits, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ResolvedAt(); ok {
+		_spec.SetField(referral.FieldResolvedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ResolvedAtCleared() {
+		_spec.ClearField(referral.FieldResolvedAt, field.TypeTime)
+	}
+	_node = &Referral{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{referral.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
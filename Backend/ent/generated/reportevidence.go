@@ -0,0 +1,212 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/UnoraApp/be/ent/generated/reportevidence"
+	"github.com/UnoraApp/be/ent/generated/userreport"
+)
+
+// ReportEvidence is the model entity for the ReportEvidence schema.
+type ReportEvidence struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// ReportID holds the value of the "report_id" field.
+	ReportID string `json:"report_id,omitempty"`
+	// MessageID holds the value of the "message_id" field.
+	MessageID string `json:"message_id,omitempty"`
+	// SenderUserID holds the value of the "sender_user_id" field.
+	SenderUserID string `json:"sender_user_id,omitempty"`
+	// Body holds the value of the "body" field.
+	Body string `json:"body,omitempty"`
+	// ModerationStatus holds the value of the "moderation_status" field.
+	ModerationStatus string `json:"moderation_status,omitempty"`
+	// SentAt holds the value of the "sent_at" field.
+	SentAt time.Time `json:"sent_at,omitempty"`
+	// IsReportedMessage holds the value of the "is_reported_message" field.
+	IsReportedMessage bool `json:"is_reported_message,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ReportEvidenceQuery when eager-loading is set.
+	Edges        ReportEvidenceEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ReportEvidenceEdges holds the relations/edges for other nodes in the graph.
+type ReportEvidenceEdges struct {
+	// Report holds the value of the report edge.
+	Report *UserReport `json:"report,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ReportOrErr returns the Report value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ReportEvidenceEdges) ReportOrErr() (*UserReport, error) {
+	if e.Report != nil {
+		return e.Report, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: userreport.Label}
+	}
+	return nil, &NotLoadedError{edge: "report"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ReportEvidence) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case reportevidence.FieldIsReportedMessage:
+			values[i] = new(sql.NullBool)
+		case reportevidence.FieldID, reportevidence.FieldReportID, reportevidence.FieldMessageID, reportevidence.FieldSenderUserID, reportevidence.FieldBody, reportevidence.FieldModerationStatus:
+			values[i] = new(sql.NullString)
+		case reportevidence.FieldSentAt, reportevidence.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ReportEvidence fields.
+func (_m *ReportEvidence) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case reportevidence.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case reportevidence.FieldReportID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field report_id", values[i])
+			} else if value.Valid {
+				_m.ReportID = value.String
+			}
+		case reportevidence.FieldMessageID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field message_id", values[i])
+			} else if value.Valid {
+				_m.MessageID = value.String
+			}
+		case reportevidence.FieldSenderUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sender_user_id", values[i])
+			} else if value.Valid {
+				_m.SenderUserID = value.String
+			}
+		case reportevidence.FieldBody:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field body", values[i])
+			} else if value.Valid {
+				_m.Body = value.String
+			}
+		case reportevidence.FieldModerationStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field moderation_status", values[i])
+			} else if value.Valid {
+				_m.ModerationStatus = value.String
+			}
+		case reportevidence.FieldSentAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field sent_at", values[i])
+			} else if value.Valid {
+				_m.SentAt = value.Time
+			}
+		case reportevidence.FieldIsReportedMessage:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_reported_message", values[i])
+			} else if value.Valid {
+				_m.IsReportedMessage = value.Bool
+			}
+		case reportevidence.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ReportEvidence.
+// This includes values selected through modifiers, order, etc.
+func (_m *ReportEvidence) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryReport queries the "report" edge of the ReportEvidence entity.
+func (_m *ReportEvidence) QueryReport() *UserReportQuery {
+	return NewReportEvidenceClient(_m.config).QueryReport(_m)
+}
+
+// Update returns a builder for updating this ReportEvidence.
+// Note that you need to call ReportEvidence.Unwrap() before calling this method if this ReportEvidence
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ReportEvidence) Update() *ReportEvidenceUpdateOne {
+	return NewReportEvidenceClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ReportEvidence entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ReportEvidence) Unwrap() *ReportEvidence {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("generated: ReportEvidence is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ReportEvidence) String() string {
+	var builder strings.Builder
+	builder.WriteString("ReportEvidence(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("report_id=")
+	builder.WriteString(_m.ReportID)
+	builder.WriteString(", ")
+	builder.WriteString("message_id=")
+	builder.WriteString(_m.MessageID)
+	builder.WriteString(", ")
+	builder.WriteString("sender_user_id=")
+	builder.WriteString(_m.SenderUserID)
+	builder.WriteString(", ")
+	builder.WriteString("body=")
+	builder.WriteString(_m.Body)
+	builder.WriteString(", ")
+	builder.WriteString("moderation_status=")
+	builder.WriteString(_m.ModerationStatus)
+	builder.WriteString(", ")
+	builder.WriteString("sent_at=")
+	builder.WriteString(_m.SentAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("is_reported_message=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IsReportedMessage))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ReportEvidences is a parsable slice of ReportEvidence.
+type ReportEvidences []*ReportEvidence
@@ -0,0 +1,146 @@
+// Code generated by ent, DO NOT EDIT.
+
+package reportevidence
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the reportevidence type in the database.
+	Label = "report_evidence"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldReportID holds the string denoting the report_id field in the database.
+	FieldReportID = "report_id"
+	// FieldMessageID holds the string denoting the message_id field in the database.
+	FieldMessageID = "message_id"
+	// FieldSenderUserID holds the string denoting the sender_user_id field in the database.
+	FieldSenderUserID = "sender_user_id"
+	// FieldBody holds the string denoting the body field in the database.
+	FieldBody = "body"
+	// FieldModerationStatus holds the string denoting the moderation_status field in the database.
+	FieldModerationStatus = "moderation_status"
+	// FieldSentAt holds the string denoting the sent_at field in the database.
+	FieldSentAt = "sent_at"
+	// FieldIsReportedMessage holds the string denoting the is_reported_message field in the database.
+	FieldIsReportedMessage = "is_reported_message"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeReport holds the string denoting the report edge name in mutations.
+	EdgeReport = "report"
+	// Table holds the table name of the reportevidence in the database.
+	Table = "report_evidences"
+	// ReportTable is the table that holds the report relation/edge.
+	ReportTable = "report_evidences"
+	// ReportInverseTable is the table name for the UserReport entity.
+	// It exists in this package in order to avoid circular dependency with the "userreport" package.
+	ReportInverseTable = "user_reports"
+	// ReportColumn is the table column denoting the report relation/edge.
+	ReportColumn = "report_id"
+)
+
+// Columns holds all SQL columns for reportevidence fields.
+var Columns = []string{
+	FieldID,
+	FieldReportID,
+	FieldMessageID,
+	FieldSenderUserID,
+	FieldBody,
+	FieldModerationStatus,
+	FieldSentAt,
+	FieldIsReportedMessage,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// ReportIDValidator is a validator for the "report_id" field. It is called by the builders before save.
+	ReportIDValidator func(string) error
+	// MessageIDValidator is a validator for the "message_id" field. It is called by the builders before save.
+	MessageIDValidator func(string) error
+	// SenderUserIDValidator is a validator for the "sender_user_id" field. It is called by the builders before save.
+	SenderUserIDValidator func(string) error
+	// ModerationStatusValidator is a validator for the "moderation_status" field. It is called by the builders before save.
+	ModerationStatusValidator func(string) error
+	// DefaultIsReportedMessage holds the default value on creation for the "is_reported_message" field.
+	DefaultIsReportedMessage bool
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the ReportEvidence queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByReportID orders the results by the report_id field.
+func ByReportID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReportID, opts...).ToFunc()
+}
+
+// ByMessageID orders the results by the message_id field.
+func ByMessageID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMessageID, opts...).ToFunc()
+}
+
+// BySenderUserID orders the results by the sender_user_id field.
+func BySenderUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSenderUserID, opts...).ToFunc()
+}
+
+// ByBody orders the results by the body field.
+func ByBody(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBody, opts...).ToFunc()
+}
+
+// ByModerationStatus orders the results by the moderation_status field.
+func ByModerationStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldModerationStatus, opts...).ToFunc()
+}
+
+// BySentAt orders the results by the sent_at field.
+func BySentAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSentAt, opts...).ToFunc()
+}
+
+// ByIsReportedMessage orders the results by the is_reported_message field.
+func ByIsReportedMessage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsReportedMessage, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByReportField orders the results by report field.
+func ByReportField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReportStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newReportStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ReportInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ReportTable, ReportColumn),
+	)
+}
@@ -0,0 +1,559 @@
+// Code generated by ent, DO NOT EDIT.
+
+package reportevidence
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/UnoraApp/be/ent/generated/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldContainsFold(FieldID, id))
+}
+
+// ReportID applies equality check predicate on the "report_id" field. It's identical to ReportIDEQ.
+func ReportID(v string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldEQ(FieldReportID, v))
+}
+
+// MessageID applies equality check predicate on the "message_id" field. It's identical to MessageIDEQ.
+func MessageID(v string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldEQ(FieldMessageID, v))
+}
+
+// SenderUserID applies equality check predicate on the "sender_user_id" field. It's identical to SenderUserIDEQ.
+func SenderUserID(v string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldEQ(FieldSenderUserID, v))
+}
+
+// Body applies equality check predicate on the "body" field. It's identical to BodyEQ.
+func Body(v string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldEQ(FieldBody, v))
+}
+
+// ModerationStatus applies equality check predicate on the "moderation_status" field. It's identical to ModerationStatusEQ.
+func ModerationStatus(v string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldEQ(FieldModerationStatus, v))
+}
+
+// SentAt applies equality check predicate on the "sent_at" field. It's identical to SentAtEQ.
+func SentAt(v time.Time) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldEQ(FieldSentAt, v))
+}
+
+// IsReportedMessage applies equality check predicate on the "is_reported_message" field. It's identical to IsReportedMessageEQ.
+func IsReportedMessage(v bool) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldEQ(FieldIsReportedMessage, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ReportIDEQ applies the EQ predicate on the "report_id" field.
+func ReportIDEQ(v string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldEQ(FieldReportID, v))
+}
+
+// ReportIDNEQ applies the NEQ predicate on the "report_id" field.
+func ReportIDNEQ(v string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldNEQ(FieldReportID, v))
+}
+
+// ReportIDIn applies the In predicate on the "report_id" field.
+func ReportIDIn(vs ...string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldIn(FieldReportID, vs...))
+}
+
+// ReportIDNotIn applies the NotIn predicate on the "report_id" field.
+func ReportIDNotIn(vs ...string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldNotIn(FieldReportID, vs...))
+}
+
+// ReportIDGT applies the GT predicate on the "report_id" field.
+func ReportIDGT(v string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldGT(FieldReportID, v))
+}
+
+// ReportIDGTE applies the GTE predicate on the "report_id" field.
+func ReportIDGTE(v string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldGTE(FieldReportID, v))
+}
+
+// ReportIDLT applies the LT predicate on the "report_id" field.
+func ReportIDLT(v string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldLT(FieldReportID, v))
+}
+
+// ReportIDLTE applies the LTE predicate on the "report_id" field.
+func ReportIDLTE(v string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldLTE(FieldReportID, v))
+}
+
+// ReportIDContains applies the Contains predicate on the "report_id" field.
+func ReportIDContains(v string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldContains(FieldReportID, v))
+}
+
+// ReportIDHasPrefix applies the HasPrefix predicate on the "report_id" field.
+func ReportIDHasPrefix(v string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldHasPrefix(FieldReportID, v))
+}
+
+// ReportIDHasSuffix applies the HasSuffix predicate on the "report_id" field.
+func ReportIDHasSuffix(v string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldHasSuffix(FieldReportID, v))
+}
+
+// ReportIDEqualFold applies the EqualFold predicate on the "report_id" field.
+func ReportIDEqualFold(v string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldEqualFold(FieldReportID, v))
+}
+
+// ReportIDContainsFold applies the ContainsFold predicate on the "report_id" field.
+func ReportIDContainsFold(v string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldContainsFold(FieldReportID, v))
+}
+
+// MessageIDEQ applies the EQ predicate on the "message_id" field.
+func MessageIDEQ(v string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldEQ(FieldMessageID, v))
+}
+
+// MessageIDNEQ applies the NEQ predicate on the "message_id" field.
+func MessageIDNEQ(v string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldNEQ(FieldMessageID, v))
+}
+
+// MessageIDIn applies the In predicate on the "message_id" field.
+func MessageIDIn(vs ...string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldIn(FieldMessageID, vs...))
+}
+
+// MessageIDNotIn applies the NotIn predicate on the "message_id" field.
+func MessageIDNotIn(vs ...string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldNotIn(FieldMessageID, vs...))
+}
+
+// MessageIDGT applies the GT predicate on the "message_id" field.
+func MessageIDGT(v string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldGT(FieldMessageID, v))
+}
+
+// MessageIDGTE applies the GTE predicate on the "message_id" field.
+func MessageIDGTE(v string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldGTE(FieldMessageID, v))
+}
+
+// MessageIDLT applies the LT predicate on the "message_id" field.
+func MessageIDLT(v string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldLT(FieldMessageID, v))
+}
+
+// MessageIDLTE applies the LTE predicate on the "message_id" field.
+func MessageIDLTE(v string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldLTE(FieldMessageID, v))
+}
+
+// MessageIDContains applies the Contains predicate on the "message_id" field.
+func MessageIDContains(v string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldContains(FieldMessageID, v))
+}
+
+// MessageIDHasPrefix applies the HasPrefix predicate on the "message_id" field.
+func MessageIDHasPrefix(v string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldHasPrefix(FieldMessageID, v))
+}
+
+// MessageIDHasSuffix applies the HasSuffix predicate on the "message_id" field.
+func MessageIDHasSuffix(v string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldHasSuffix(FieldMessageID, v))
+}
+
+// MessageIDEqualFold applies the EqualFold predicate on the "message_id" field.
+func MessageIDEqualFold(v string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldEqualFold(FieldMessageID, v))
+}
+
+// MessageIDContainsFold applies the ContainsFold predicate on the "message_id" field.
+func MessageIDContainsFold(v string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldContainsFold(FieldMessageID, v))
+}
+
+// SenderUserIDEQ applies the EQ predicate on the "sender_user_id" field.
+func SenderUserIDEQ(v string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldEQ(FieldSenderUserID, v))
+}
+
+// SenderUserIDNEQ applies the NEQ predicate on the "sender_user_id" field.
+func SenderUserIDNEQ(v string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldNEQ(FieldSenderUserID, v))
+}
+
+// SenderUserIDIn applies the In predicate on the "sender_user_id" field.
+func SenderUserIDIn(vs ...string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldIn(FieldSenderUserID, vs...))
+}
+
+// SenderUserIDNotIn applies the NotIn predicate on the "sender_user_id" field.
+func SenderUserIDNotIn(vs ...string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldNotIn(FieldSenderUserID, vs...))
+}
+
+// SenderUserIDGT applies the GT predicate on the "sender_user_id" field.
+func SenderUserIDGT(v string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldGT(FieldSenderUserID, v))
+}
+
+// SenderUserIDGTE applies the GTE predicate on the "sender_user_id" field.
+func SenderUserIDGTE(v string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldGTE(FieldSenderUserID, v))
+}
+
+// SenderUserIDLT applies the LT predicate on the "sender_user_id" field.
+func SenderUserIDLT(v string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldLT(FieldSenderUserID, v))
+}
+
+// SenderUserIDLTE applies the LTE predicate on the "sender_user_id" field.
+func SenderUserIDLTE(v string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldLTE(FieldSenderUserID, v))
+}
+
+// SenderUserIDContains applies the Contains predicate on the "sender_user_id" field.
+func SenderUserIDContains(v string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldContains(FieldSenderUserID, v))
+}
+
+// SenderUserIDHasPrefix applies the HasPrefix predicate on the "sender_user_id" field.
+func SenderUserIDHasPrefix(v string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldHasPrefix(FieldSenderUserID, v))
+}
+
+// SenderUserIDHasSuffix applies the HasSuffix predicate on the "sender_user_id" field.
+func SenderUserIDHasSuffix(v string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldHasSuffix(FieldSenderUserID, v))
+}
+
+// SenderUserIDEqualFold applies the EqualFold predicate on the "sender_user_id" field.
+func SenderUserIDEqualFold(v string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldEqualFold(FieldSenderUserID, v))
+}
+
+// SenderUserIDContainsFold applies the ContainsFold predicate on the "sender_user_id" field.
+func SenderUserIDContainsFold(v string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldContainsFold(FieldSenderUserID, v))
+}
+
+// BodyEQ applies the EQ predicate on the "body" field.
+func BodyEQ(v string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldEQ(FieldBody, v))
+}
+
+// BodyNEQ applies the NEQ predicate on the "body" field.
+func BodyNEQ(v string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldNEQ(FieldBody, v))
+}
+
+// BodyIn applies the In predicate on the "body" field.
+func BodyIn(vs ...string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldIn(FieldBody, vs...))
+}
+
+// BodyNotIn applies the NotIn predicate on the "body" field.
+func BodyNotIn(vs ...string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldNotIn(FieldBody, vs...))
+}
+
+// BodyGT applies the GT predicate on the "body" field.
+func BodyGT(v string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldGT(FieldBody, v))
+}
+
+// BodyGTE applies the GTE predicate on the "body" field.
+func BodyGTE(v string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldGTE(FieldBody, v))
+}
+
+// BodyLT applies the LT predicate on the "body" field.
+func BodyLT(v string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldLT(FieldBody, v))
+}
+
+// BodyLTE applies the LTE predicate on the "body" field.
+func BodyLTE(v string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldLTE(FieldBody, v))
+}
+
+// BodyContains applies the Contains predicate on the "body" field.
+func BodyContains(v string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldContains(FieldBody, v))
+}
+
+// BodyHasPrefix applies the HasPrefix predicate on the "body" field.
+func BodyHasPrefix(v string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldHasPrefix(FieldBody, v))
+}
+
+// BodyHasSuffix applies the HasSuffix predicate on the "body" field.
+func BodyHasSuffix(v string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldHasSuffix(FieldBody, v))
+}
+
+// BodyEqualFold applies the EqualFold predicate on the "body" field.
+func BodyEqualFold(v string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldEqualFold(FieldBody, v))
+}
+
+// BodyContainsFold applies the ContainsFold predicate on the "body" field.
+func BodyContainsFold(v string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldContainsFold(FieldBody, v))
+}
+
+// ModerationStatusEQ applies the EQ predicate on the "moderation_status" field.
+func ModerationStatusEQ(v string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldEQ(FieldModerationStatus, v))
+}
+
+// ModerationStatusNEQ applies the NEQ predicate on the "moderation_status" field.
+func ModerationStatusNEQ(v string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldNEQ(FieldModerationStatus, v))
+}
+
+// ModerationStatusIn applies the In predicate on the "moderation_status" field.
+func ModerationStatusIn(vs ...string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldIn(FieldModerationStatus, vs...))
+}
+
+// ModerationStatusNotIn applies the NotIn predicate on the "moderation_status" field.
+func ModerationStatusNotIn(vs ...string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldNotIn(FieldModerationStatus, vs...))
+}
+
+// ModerationStatusGT applies the GT predicate on the "moderation_status" field.
+func ModerationStatusGT(v string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldGT(FieldModerationStatus, v))
+}
+
+// ModerationStatusGTE applies the GTE predicate on the "moderation_status" field.
+func ModerationStatusGTE(v string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldGTE(FieldModerationStatus, v))
+}
+
+// ModerationStatusLT applies the LT predicate on the "moderation_status" field.
+func ModerationStatusLT(v string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldLT(FieldModerationStatus, v))
+}
+
+// ModerationStatusLTE applies the LTE predicate on the "moderation_status" field.
+func ModerationStatusLTE(v string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldLTE(FieldModerationStatus, v))
+}
+
+// ModerationStatusContains applies the Contains predicate on the "moderation_status" field.
+func ModerationStatusContains(v string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldContains(FieldModerationStatus, v))
+}
+
+// ModerationStatusHasPrefix applies the HasPrefix predicate on the "moderation_status" field.
+func ModerationStatusHasPrefix(v string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldHasPrefix(FieldModerationStatus, v))
+}
+
+// ModerationStatusHasSuffix applies the HasSuffix predicate on the "moderation_status" field.
+func ModerationStatusHasSuffix(v string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldHasSuffix(FieldModerationStatus, v))
+}
+
+// ModerationStatusEqualFold applies the EqualFold predicate on the "moderation_status" field.
+func ModerationStatusEqualFold(v string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldEqualFold(FieldModerationStatus, v))
+}
+
+// ModerationStatusContainsFold applies the ContainsFold predicate on the "moderation_status" field.
+func ModerationStatusContainsFold(v string) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldContainsFold(FieldModerationStatus, v))
+}
+
+// SentAtEQ applies the EQ predicate on the "sent_at" field.
+func SentAtEQ(v time.Time) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldEQ(FieldSentAt, v))
+}
+
+// SentAtNEQ applies the NEQ predicate on the "sent_at" field.
+func SentAtNEQ(v time.Time) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldNEQ(FieldSentAt, v))
+}
+
+// SentAtIn applies the In predicate on the "sent_at" field.
+func SentAtIn(vs ...time.Time) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldIn(FieldSentAt, vs...))
+}
+
+// SentAtNotIn applies the NotIn predicate on the "sent_at" field.
+func SentAtNotIn(vs ...time.Time) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldNotIn(FieldSentAt, vs...))
+}
+
+// SentAtGT applies the GT predicate on the "sent_at" field.
+func SentAtGT(v time.Time) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldGT(FieldSentAt, v))
+}
+
+// SentAtGTE applies the GTE predicate on the "sent_at" field.
+func SentAtGTE(v time.Time) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldGTE(FieldSentAt, v))
+}
+
+// SentAtLT applies the LT predicate on the "sent_at" field.
+func SentAtLT(v time.Time) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldLT(FieldSentAt, v))
+}
+
+// SentAtLTE applies the LTE predicate on the "sent_at" field.
+func SentAtLTE(v time.Time) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldLTE(FieldSentAt, v))
+}
+
+// IsReportedMessageEQ applies the EQ predicate on the "is_reported_message" field.
+func IsReportedMessageEQ(v bool) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldEQ(FieldIsReportedMessage, v))
+}
+
+// IsReportedMessageNEQ applies the NEQ predicate on the "is_reported_message" field.
+func IsReportedMessageNEQ(v bool) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldNEQ(FieldIsReportedMessage, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasReport applies the HasEdge predicate on the "report" edge.
+func HasReport() predicate.ReportEvidence {
+	return predicate.ReportEvidence(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ReportTable, ReportColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReportWith applies the HasEdge predicate on the "report" edge with a given conditions (other predicates).
+func HasReportWith(preds ...predicate.UserReport) predicate.ReportEvidence {
+	return predicate.ReportEvidence(func(s *sql.Selector) {
+		step := newReportStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ReportEvidence) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ReportEvidence) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ReportEvidence) predicate.ReportEvidence {
+	return predicate.ReportEvidence(sql.NotPredicates(p))
+}
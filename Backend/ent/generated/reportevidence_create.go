@@ -0,0 +1,666 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/UnoraApp/be/ent/generated/reportevidence"
+	"github.com/UnoraApp/be/ent/generated/userreport"
+)
+
+// ReportEvidenceCreate is the builder for creating a ReportEvidence entity.
+type ReportEvidenceCreate struct {
+	config
+	mutation *ReportEvidenceMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetReportID sets the "report_id" field.
+func (_c *ReportEvidenceCreate) SetReportID(v string) *ReportEvidenceCreate {
+	_c.mutation.SetReportID(v)
+	return _c
+}
+
+// SetMessageID sets the "message_id" field.
+func (_c *ReportEvidenceCreate) SetMessageID(v string) *ReportEvidenceCreate {
+	_c.mutation.SetMessageID(v)
+	return _c
+}
+
+// SetSenderUserID sets the "sender_user_id" field.
+func (_c *ReportEvidenceCreate) SetSenderUserID(v string) *ReportEvidenceCreate {
+	_c.mutation.SetSenderUserID(v)
+	return _c
+}
+
+// SetBody sets the "body" field.
+func (_c *ReportEvidenceCreate) SetBody(v string) *ReportEvidenceCreate {
+	_c.mutation.SetBody(v)
+	return _c
+}
+
+// SetModerationStatus sets the "moderation_status" field.
+func (_c *ReportEvidenceCreate) SetModerationStatus(v string) *ReportEvidenceCreate {
+	_c.mutation.SetModerationStatus(v)
+	return _c
+}
+
+// SetSentAt sets the "sent_at" field.
+func (_c *ReportEvidenceCreate) SetSentAt(v time.Time) *ReportEvidenceCreate {
+	_c.mutation.SetSentAt(v)
+	return _c
+}
+
+// SetIsReportedMessage sets the "is_reported_message" field.
+func (_c *ReportEvidenceCreate) SetIsReportedMessage(v bool) *ReportEvidenceCreate {
+	_c.mutation.SetIsReportedMessage(v)
+	return _c
+}
+
+// SetNillableIsReportedMessage sets the "is_reported_message" field if the given value is not nil.
+func (_c *ReportEvidenceCreate) SetNillableIsReportedMessage(v *bool) *ReportEvidenceCreate {
+	if v != nil {
+		_c.SetIsReportedMessage(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ReportEvidenceCreate) SetCreatedAt(v time.Time) *ReportEvidenceCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ReportEvidenceCreate) SetNillableCreatedAt(v *time.Time) *ReportEvidenceCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ReportEvidenceCreate) SetID(v string) *ReportEvidenceCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetReport sets the "report" edge to the UserReport entity.
+func (_c *ReportEvidenceCreate) SetReport(v *UserReport) *ReportEvidenceCreate {
+	return _c.SetReportID(v.ID)
+}
+
+// Mutation returns the ReportEvidenceMutation object of the builder.
+func (_c *ReportEvidenceCreate) Mutation() *ReportEvidenceMutation {
+	return _c.mutation
+}
+
+// Save creates the ReportEvidence in the database.
+func (_c *ReportEvidenceCreate) Save(ctx context.Context) (*ReportEvidence, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ReportEvidenceCreate) SaveX(ctx context.Context) *ReportEvidence {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ReportEvidenceCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ReportEvidenceCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ReportEvidenceCreate) defaults() {
+	if _, ok := _c.mutation.IsReportedMessage(); !ok {
+		v := reportevidence.DefaultIsReportedMessage
+		_c.mutation.SetIsReportedMessage(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := reportevidence.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ReportEvidenceCreate) check() error {
+	if _, ok := _c.mutation.ReportID(); !ok {
+		return &ValidationError{Name: "report_id", err: errors.New(`generated: missing required field "ReportEvidence.report_id"`)}
+	}
+	if v, ok := _c.mutation.ReportID(); ok {
+		if err := reportevidence.ReportIDValidator(v); err != nil {
+			return &ValidationError{Name: "report_id", err: fmt.Errorf(`generated: validator failed for field "ReportEvidence.report_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.MessageID(); !ok {
+		return &ValidationError{Name: "message_id", err: errors.New(`generated: missing required field "ReportEvidence.message_id"`)}
+	}
+	if v, ok := _c.mutation.MessageID(); ok {
+		if err := reportevidence.MessageIDValidator(v); err != nil {
+			return &ValidationError{Name: "message_id", err: fmt.Errorf(`generated: validator failed for field "ReportEvidence.message_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SenderUserID(); !ok {
+		return &ValidationError{Name: "sender_user_id", err: errors.New(`generated: missing required field "ReportEvidence.sender_user_id"`)}
+	}
+	if v, ok := _c.mutation.SenderUserID(); ok {
+		if err := reportevidence.SenderUserIDValidator(v); err != nil {
+			return &ValidationError{Name: "sender_user_id", err: fmt.Errorf(`generated: validator failed for field "ReportEvidence.sender_user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Body(); !ok {
+		return &ValidationError{Name: "body", err: errors.New(`generated: missing required field "ReportEvidence.body"`)}
+	}
+	if _, ok := _c.mutation.ModerationStatus(); !ok {
+		return &ValidationError{Name: "moderation_status", err: errors.New(`generated: missing required field "ReportEvidence.moderation_status"`)}
+	}
+	if v, ok := _c.mutation.ModerationStatus(); ok {
+		if err := reportevidence.ModerationStatusValidator(v); err != nil {
+			return &ValidationError{Name: "moderation_status", err: fmt.Errorf(`generated: validator failed for field "ReportEvidence.moderation_status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SentAt(); !ok {
+		return &ValidationError{Name: "sent_at", err: errors.New(`generated: missing required field "ReportEvidence.sent_at"`)}
+	}
+	if _, ok := _c.mutation.IsReportedMessage(); !ok {
+		return &ValidationError{Name: "is_reported_message", err: errors.New(`generated: missing required field "ReportEvidence.is_reported_message"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "ReportEvidence.created_at"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := reportevidence.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`generated: validator failed for field "ReportEvidence.id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.ReportIDs()) == 0 {
+		return &ValidationError{Name: "report", err: errors.New(`generated: missing required edge "ReportEvidence.report"`)}
+	}
+	return nil
+}
+
+func (_c *ReportEvidenceCreate) sqlSave(ctx context.Context) (*ReportEvidence, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected ReportEvidence.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ReportEvidenceCreate) createSpec() (*ReportEvidence, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ReportEvidence{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(reportevidence.Table, sqlgraph.NewFieldSpec(reportevidence.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.MessageID(); ok {
+		_spec.SetField(reportevidence.FieldMessageID, field.TypeString, value)
+		_node.MessageID = value
+	}
+	if value, ok := _c.mutation.SenderUserID(); ok {
+		_spec.SetField(reportevidence.FieldSenderUserID, field.TypeString, value)
+		_node.SenderUserID = value
+	}
+	if value, ok := _c.mutation.Body(); ok {
+		_spec.SetField(reportevidence.FieldBody, field.TypeString, value)
+		_node.Body = value
+	}
+	if value, ok := _c.mutation.ModerationStatus(); ok {
+		_spec.SetField(reportevidence.FieldModerationStatus, field.TypeString, value)
+		_node.ModerationStatus = value
+	}
+	if value, ok := _c.mutation.SentAt(); ok {
+		_spec.SetField(reportevidence.FieldSentAt, field.TypeTime, value)
+		_node.SentAt = value
+	}
+	if value, ok := _c.mutation.IsReportedMessage(); ok {
+		_spec.SetField(reportevidence.FieldIsReportedMessage, field.TypeBool, value)
+		_node.IsReportedMessage = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(reportevidence.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.ReportIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reportevidence.ReportTable,
+			Columns: []string{reportevidence.ReportColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(userreport.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ReportID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ReportEvidence.Create().
+//		SetReportID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReportEvidenceUpsert) {
+//			SetReportID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ReportEvidenceCreate) OnConflict(opts ...sql.ConflictOption) *ReportEvidenceUpsertOne {
+	_c.conflict = opts
+	return &ReportEvidenceUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ReportEvidence.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ReportEvidenceCreate) OnConflictColumns(columns ...string) *ReportEvidenceUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ReportEvidenceUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ReportEvidenceUpsertOne is the builder for "upsert"-ing
+	//  one ReportEvidence node.
+	ReportEvidenceUpsertOne struct {
+		create *ReportEvidenceCreate
+	}
+
+	// ReportEvidenceUpsert is the "OnConflict" setter.
+	ReportEvidenceUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ReportEvidence.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(reportevidence.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ReportEvidenceUpsertOne) UpdateNewValues() *ReportEvidenceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(reportevidence.FieldID)
+		}
+		if _, exists := u.create.mutation.ReportID(); exists {
+			s.SetIgnore(reportevidence.FieldReportID)
+		}
+		if _, exists := u.create.mutation.MessageID(); exists {
+			s.SetIgnore(reportevidence.FieldMessageID)
+		}
+		if _, exists := u.create.mutation.SenderUserID(); exists {
+			s.SetIgnore(reportevidence.FieldSenderUserID)
+		}
+		if _, exists := u.create.mutation.Body(); exists {
+			s.SetIgnore(reportevidence.FieldBody)
+		}
+		if _, exists := u.create.mutation.ModerationStatus(); exists {
+			s.SetIgnore(reportevidence.FieldModerationStatus)
+		}
+		if _, exists := u.create.mutation.SentAt(); exists {
+			s.SetIgnore(reportevidence.FieldSentAt)
+		}
+		if _, exists := u.create.mutation.IsReportedMessage(); exists {
+			s.SetIgnore(reportevidence.FieldIsReportedMessage)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(reportevidence.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ReportEvidence.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ReportEvidenceUpsertOne) Ignore() *ReportEvidenceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReportEvidenceUpsertOne) DoNothing() *ReportEvidenceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReportEvidenceCreate.OnConflict
+// documentation for more info.
+func (u *ReportEvidenceUpsertOne) Update(set func(*ReportEvidenceUpsert)) *ReportEvidenceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReportEvidenceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *ReportEvidenceUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("generated: missing options for ReportEvidenceCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReportEvidenceUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ReportEvidenceUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("generated: ReportEvidenceUpsertOne.ID is not supported by MySQL driver. Use ReportEvidenceUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ReportEvidenceUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ReportEvidenceCreateBulk is the builder for creating many ReportEvidence entities in bulk.
+type ReportEvidenceCreateBulk struct {
+	config
+	err      error
+	builders []*ReportEvidenceCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ReportEvidence entities in the database.
+func (_c *ReportEvidenceCreateBulk) Save(ctx context.Context) ([]*ReportEvidence, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ReportEvidence, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ReportEvidenceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ReportEvidenceCreateBulk) SaveX(ctx context.Context) []*ReportEvidence {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ReportEvidenceCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ReportEvidenceCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ReportEvidence.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReportEvidenceUpsert) {
+//			SetReportID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ReportEvidenceCreateBulk) OnConflict(opts ...sql.ConflictOption) *ReportEvidenceUpsertBulk {
+	_c.conflict = opts
+	return &ReportEvidenceUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ReportEvidence.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ReportEvidenceCreateBulk) OnConflictColumns(columns ...string) *ReportEvidenceUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ReportEvidenceUpsertBulk{
+		create: _c,
+	}
+}
+
+// ReportEvidenceUpsertBulk is the builder for "upsert"-ing
+// a bulk of ReportEvidence nodes.
+type ReportEvidenceUpsertBulk struct {
+	create *ReportEvidenceCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ReportEvidence.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(reportevidence.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ReportEvidenceUpsertBulk) UpdateNewValues() *ReportEvidenceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(reportevidence.FieldID)
+			}
+			if _, exists := b.mutation.ReportID(); exists {
+				s.SetIgnore(reportevidence.FieldReportID)
+			}
+			if _, exists := b.mutation.MessageID(); exists {
+				s.SetIgnore(reportevidence.FieldMessageID)
+			}
+			if _, exists := b.mutation.SenderUserID(); exists {
+				s.SetIgnore(reportevidence.FieldSenderUserID)
+			}
+			if _, exists := b.mutation.Body(); exists {
+				s.SetIgnore(reportevidence.FieldBody)
+			}
+			if _, exists := b.mutation.ModerationStatus(); exists {
+				s.SetIgnore(reportevidence.FieldModerationStatus)
+			}
+			if _, exists := b.mutation.SentAt(); exists {
+				s.SetIgnore(reportevidence.FieldSentAt)
+			}
+			if _, exists := b.mutation.IsReportedMessage(); exists {
+				s.SetIgnore(reportevidence.FieldIsReportedMessage)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(reportevidence.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ReportEvidence.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ReportEvidenceUpsertBulk) Ignore() *ReportEvidenceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReportEvidenceUpsertBulk) DoNothing() *ReportEvidenceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReportEvidenceCreateBulk.OnConflict
+// documentation for more info.
+func (u *ReportEvidenceUpsertBulk) Update(set func(*ReportEvidenceUpsert)) *ReportEvidenceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReportEvidenceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *ReportEvidenceUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("generated: OnConflict was set for builder %d. Set it on the ReportEvidenceCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("generated: missing options for ReportEvidenceCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReportEvidenceUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
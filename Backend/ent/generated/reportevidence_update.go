@@ -0,0 +1,197 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/UnoraApp/be/ent/generated/predicate"
+	"github.com/UnoraApp/be/ent/generated/reportevidence"
+)
+
+// ReportEvidenceUpdate is the builder for updating ReportEvidence entities.
+type ReportEvidenceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ReportEvidenceMutation
+}
+
+// Where appends a list predicates to the ReportEvidenceUpdate builder.
+func (_u *ReportEvidenceUpdate) Where(ps ...predicate.ReportEvidence) *ReportEvidenceUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Mutation returns the ReportEvidenceMutation object of the builder.
+func (_u *ReportEvidenceUpdate) Mutation() *ReportEvidenceMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ReportEvidenceUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ReportEvidenceUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ReportEvidenceUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ReportEvidenceUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ReportEvidenceUpdate) check() error {
+	if _u.mutation.ReportCleared() && len(_u.mutation.ReportIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "ReportEvidence.report"`)
+	}
+	return nil
+}
+
+func (_u *ReportEvidenceUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(reportevidence.Table, reportevidence.Columns, sqlgraph.NewFieldSpec(reportevidence.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{reportevidence.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ReportEvidenceUpdateOne is the builder for updating a single ReportEvidence entity.
+type ReportEvidenceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ReportEvidenceMutation
+}
+
+// Mutation returns the ReportEvidenceMutation object of the builder.
+func (_u *ReportEvidenceUpdateOne) Mutation() *ReportEvidenceMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ReportEvidenceUpdate builder.
+func (_u *ReportEvidenceUpdateOne) Where(ps ...predicate.ReportEvidence) *ReportEvidenceUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ReportEvidenceUpdateOne) Select(field string, fields ...string) *ReportEvidenceUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ReportEvidence entity.
+func (_u *ReportEvidenceUpdateOne) Save(ctx context.Context) (*ReportEvidence, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ReportEvidenceUpdateOne) SaveX(ctx context.Context) *ReportEvidence {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ReportEvidenceUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ReportEvidenceUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ReportEvidenceUpdateOne) check() error {
+	if _u.mutation.ReportCleared() && len(_u.mutation.ReportIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "ReportEvidence.report"`)
+	}
+	return nil
+}
+
+func (_u *ReportEvidenceUpdateOne) sqlSave(ctx context.Context) (_node *ReportEvidence, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(reportevidence.Table, reportevidence.Columns, sqlgraph.NewFieldSpec(reportevidence.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "ReportEvidence.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, reportevidence.FieldID)
+		for _, f := range fields {
+			if !reportevidence.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != reportevidence.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_node = &ReportEvidence{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{reportevidence.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
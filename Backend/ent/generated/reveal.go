@@ -48,9 +48,11 @@ type RevealEdges struct {
 	Milestone *RevealMilestone `json:"milestone,omitempty"`
 	// Content holds the value of the content edge.
 	Content *RevealContent `json:"content,omitempty"`
+	// Views holds the value of the views edge.
+	Views []*RevealView `json:"views,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [4]bool
 }
 
 // ConnectionOrErr returns the Connection value or an error if the edge
@@ -86,6 +88,15 @@ func (e RevealEdges) ContentOrErr() (*RevealContent, error) {
 	return nil, &NotLoadedError{edge: "content"}
 }
 
+// ViewsOrErr returns the Views value or an error if the edge
+// was not loaded in eager-loading.
+func (e RevealEdges) ViewsOrErr() ([]*RevealView, error) {
+	if e.loadedTypes[3] {
+		return e.Views, nil
+	}
+	return nil, &NotLoadedError{edge: "views"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Reveal) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -188,6 +199,11 @@ func (_m *Reveal) QueryContent() *RevealContentQuery {
 	return NewRevealClient(_m.config).QueryContent(_m)
 }
 
+// QueryViews queries the "views" edge of the Reveal entity.
+func (_m *Reveal) QueryViews() *RevealViewQuery {
+	return NewRevealClient(_m.config).QueryViews(_m)
+}
+
 // Update returns a builder for updating this Reveal.
 // Note that you need to call Reveal.Unwrap() before calling this method if this Reveal
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -35,6 +35,8 @@ const (
 	EdgeMilestone = "milestone"
 	// EdgeContent holds the string denoting the content edge name in mutations.
 	EdgeContent = "content"
+	// EdgeViews holds the string denoting the views edge name in mutations.
+	EdgeViews = "views"
 	// Table holds the table name of the reveal in the database.
 	Table = "reveals"
 	// ConnectionTable is the table that holds the connection relation/edge.
@@ -58,6 +60,13 @@ const (
 	ContentInverseTable = "reveal_contents"
 	// ContentColumn is the table column denoting the content relation/edge.
 	ContentColumn = "reveal_id"
+	// ViewsTable is the table that holds the views relation/edge.
+	ViewsTable = "reveal_views"
+	// ViewsInverseTable is the table name for the RevealView entity.
+	// It exists in this package in order to avoid circular dependency with the "revealview" package.
+	ViewsInverseTable = "reveal_views"
+	// ViewsColumn is the table column denoting the views relation/edge.
+	ViewsColumn = "reveal_id"
 )
 
 // Columns holds all SQL columns for reveal fields.
@@ -210,6 +219,20 @@ func ByContentField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newContentStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByViewsCount orders the results by views count.
+func ByViewsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newViewsStep(), opts...)
+	}
+}
+
+// ByViews orders the results by views terms.
+func ByViews(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newViewsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newConnectionStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -231,3 +254,10 @@ func newContentStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2O, false, ContentTable, ContentColumn),
 	)
 }
+func newViewsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ViewsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ViewsTable, ViewsColumn),
+	)
+}
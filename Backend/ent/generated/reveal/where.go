@@ -469,6 +469,29 @@ func HasContentWith(preds ...predicate.RevealContent) predicate.Reveal {
 	})
 }
 
+// HasViews applies the HasEdge predicate on the "views" edge.
+func HasViews() predicate.Reveal {
+	return predicate.Reveal(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ViewsTable, ViewsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasViewsWith applies the HasEdge predicate on the "views" edge with a given conditions (other predicates).
+func HasViewsWith(preds ...predicate.RevealView) predicate.Reveal {
+	return predicate.Reveal(func(s *sql.Selector) {
+		step := newViewsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Reveal) predicate.Reveal {
 	return predicate.Reveal(sql.AndPredicates(predicates...))
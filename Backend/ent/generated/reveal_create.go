@@ -16,6 +16,7 @@ import (
 	"github.com/UnoraApp/be/ent/generated/reveal"
 	"github.com/UnoraApp/be/ent/generated/revealcontent"
 	"github.com/UnoraApp/be/ent/generated/revealmilestone"
+	"github.com/UnoraApp/be/ent/generated/revealview"
 )
 
 // RevealCreate is the builder for creating a Reveal entity.
@@ -143,6 +144,21 @@ func (_c *RevealCreate) SetContent(v *RevealContent) *RevealCreate {
 	return _c.SetContentID(v.ID)
 }
 
+// AddViewIDs adds the "views" edge to the RevealView entity by IDs.
+func (_c *RevealCreate) AddViewIDs(ids ...string) *RevealCreate {
+	_c.mutation.AddViewIDs(ids...)
+	return _c
+}
+
+// AddViews adds the "views" edges to the RevealView entity.
+func (_c *RevealCreate) AddViews(v ...*RevealView) *RevealCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddViewIDs(ids...)
+}
+
 // Mutation returns the RevealMutation object of the builder.
 func (_c *RevealCreate) Mutation() *RevealMutation {
 	return _c.mutation
@@ -346,6 +362,22 @@ func (_c *RevealCreate) createSpec() (*Reveal, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.ViewsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   reveal.ViewsTable,
+			Columns: []string{reveal.ViewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(revealview.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -9,6 +9,7 @@ import (
 	"math"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -17,6 +18,7 @@ import (
 	"github.com/UnoraApp/be/ent/generated/reveal"
 	"github.com/UnoraApp/be/ent/generated/revealcontent"
 	"github.com/UnoraApp/be/ent/generated/revealmilestone"
+	"github.com/UnoraApp/be/ent/generated/revealview"
 )
 
 // RevealQuery is the builder for querying Reveal entities.
@@ -29,6 +31,8 @@ type RevealQuery struct {
 	withConnection *ConnectionQuery
 	withMilestone  *RevealMilestoneQuery
 	withContent    *RevealContentQuery
+	withViews      *RevealViewQuery
+	modifiers      []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -131,6 +135,28 @@ func (_q *RevealQuery) QueryContent() *RevealContentQuery {
 	return query
 }
 
+// QueryViews chains the current query on the "views" edge.
+func (_q *RevealQuery) QueryViews() *RevealViewQuery {
+	query := (&RevealViewClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(reveal.Table, reveal.FieldID, selector),
+			sqlgraph.To(revealview.Table, revealview.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, reveal.ViewsTable, reveal.ViewsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Reveal entity from the query.
 // Returns a *NotFoundError when no Reveal was found.
 func (_q *RevealQuery) First(ctx context.Context) (*Reveal, error) {
@@ -326,6 +352,7 @@ func (_q *RevealQuery) Clone() *RevealQuery {
 		withConnection: _q.withConnection.Clone(),
 		withMilestone:  _q.withMilestone.Clone(),
 		withContent:    _q.withContent.Clone(),
+		withViews:      _q.withViews.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -365,6 +392,17 @@ func (_q *RevealQuery) WithContent(opts ...func(*RevealContentQuery)) *RevealQue
 	return _q
 }
 
+// WithViews tells the query-builder to eager-load the nodes that are connected to
+// the "views" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *RevealQuery) WithViews(opts ...func(*RevealViewQuery)) *RevealQuery {
+	query := (&RevealViewClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withViews = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -443,10 +481,11 @@ func (_q *RevealQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Revea
 	var (
 		nodes       = []*Reveal{}
 		_spec       = _q.querySpec()
-		loadedTypes = [3]bool{
+		loadedTypes = [4]bool{
 			_q.withConnection != nil,
 			_q.withMilestone != nil,
 			_q.withContent != nil,
+			_q.withViews != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -458,6 +497,9 @@ func (_q *RevealQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Revea
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -485,6 +527,13 @@ func (_q *RevealQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Revea
 			return nil, err
 		}
 	}
+	if query := _q.withViews; query != nil {
+		if err := _q.loadViews(ctx, query, nodes,
+			func(n *Reveal) { n.Edges.Views = []*RevealView{} },
+			func(n *Reveal, e *RevealView) { n.Edges.Views = append(n.Edges.Views, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -573,9 +622,42 @@ func (_q *RevealQuery) loadContent(ctx context.Context, query *RevealContentQuer
 	}
 	return nil
 }
+func (_q *RevealQuery) loadViews(ctx context.Context, query *RevealViewQuery, nodes []*Reveal, init func(*Reveal), assign func(*Reveal, *RevealView)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Reveal)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(revealview.FieldRevealID)
+	}
+	query.Where(predicate.RevealView(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(reveal.ViewsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.RevealID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "reveal_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *RevealQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -644,6 +726,9 @@ func (_q *RevealQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -661,6 +746,32 @@ func (_q *RevealQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (_q *RevealQuery) ForUpdate(opts ...sql.LockOption) *RevealQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return _q
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (_q *RevealQuery) ForShare(opts ...sql.LockOption) *RevealQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return _q
+}
+
 // RevealGroupBy is the group-by builder for Reveal entities.
 type RevealGroupBy struct {
 	selector
@@ -16,6 +16,7 @@ import (
 	"github.com/UnoraApp/be/ent/generated/reveal"
 	"github.com/UnoraApp/be/ent/generated/revealcontent"
 	"github.com/UnoraApp/be/ent/generated/revealmilestone"
+	"github.com/UnoraApp/be/ent/generated/revealview"
 )
 
 // RevealUpdate is the builder for updating Reveal entities.
@@ -156,6 +157,21 @@ func (_u *RevealUpdate) SetContent(v *RevealContent) *RevealUpdate {
 	return _u.SetContentID(v.ID)
 }
 
+// AddViewIDs adds the "views" edge to the RevealView entity by IDs.
+func (_u *RevealUpdate) AddViewIDs(ids ...string) *RevealUpdate {
+	_u.mutation.AddViewIDs(ids...)
+	return _u
+}
+
+// AddViews adds the "views" edges to the RevealView entity.
+func (_u *RevealUpdate) AddViews(v ...*RevealView) *RevealUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddViewIDs(ids...)
+}
+
 // Mutation returns the RevealMutation object of the builder.
 func (_u *RevealUpdate) Mutation() *RevealMutation {
 	return _u.mutation
@@ -179,6 +195,27 @@ func (_u *RevealUpdate) ClearContent() *RevealUpdate {
 	return _u
 }
 
+// ClearViews clears all "views" edges to the RevealView entity.
+func (_u *RevealUpdate) ClearViews() *RevealUpdate {
+	_u.mutation.ClearViews()
+	return _u
+}
+
+// RemoveViewIDs removes the "views" edge to RevealView entities by IDs.
+func (_u *RevealUpdate) RemoveViewIDs(ids ...string) *RevealUpdate {
+	_u.mutation.RemoveViewIDs(ids...)
+	return _u
+}
+
+// RemoveViews removes "views" edges to RevealView entities.
+func (_u *RevealUpdate) RemoveViews(v ...*RevealView) *RevealUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveViewIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *RevealUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -354,6 +391,51 @@ func (_u *RevealUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ViewsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   reveal.ViewsTable,
+			Columns: []string{reveal.ViewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(revealview.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedViewsIDs(); len(nodes) > 0 && !_u.mutation.ViewsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   reveal.ViewsTable,
+			Columns: []string{reveal.ViewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(revealview.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ViewsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   reveal.ViewsTable,
+			Columns: []string{reveal.ViewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(revealview.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{reveal.Label}
@@ -499,6 +581,21 @@ func (_u *RevealUpdateOne) SetContent(v *RevealContent) *RevealUpdateOne {
 	return _u.SetContentID(v.ID)
 }
 
+// AddViewIDs adds the "views" edge to the RevealView entity by IDs.
+func (_u *RevealUpdateOne) AddViewIDs(ids ...string) *RevealUpdateOne {
+	_u.mutation.AddViewIDs(ids...)
+	return _u
+}
+
+// AddViews adds the "views" edges to the RevealView entity.
+func (_u *RevealUpdateOne) AddViews(v ...*RevealView) *RevealUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddViewIDs(ids...)
+}
+
 // Mutation returns the RevealMutation object of the builder.
 func (_u *RevealUpdateOne) Mutation() *RevealMutation {
 	return _u.mutation
@@ -522,6 +619,27 @@ func (_u *RevealUpdateOne) ClearContent() *RevealUpdateOne {
 	return _u
 }
 
+// ClearViews clears all "views" edges to the RevealView entity.
+func (_u *RevealUpdateOne) ClearViews() *RevealUpdateOne {
+	_u.mutation.ClearViews()
+	return _u
+}
+
+// RemoveViewIDs removes the "views" edge to RevealView entities by IDs.
+func (_u *RevealUpdateOne) RemoveViewIDs(ids ...string) *RevealUpdateOne {
+	_u.mutation.RemoveViewIDs(ids...)
+	return _u
+}
+
+// RemoveViews removes "views" edges to RevealView entities.
+func (_u *RevealUpdateOne) RemoveViews(v ...*RevealView) *RevealUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveViewIDs(ids...)
+}
+
 // Where appends a list predicates to the RevealUpdate builder.
 func (_u *RevealUpdateOne) Where(ps ...predicate.Reveal) *RevealUpdateOne {
 	_u.mutation.Where(ps...)
@@ -727,6 +845,51 @@ func (_u *RevealUpdateOne) sqlSave(ctx context.Context) (_node *Reveal, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ViewsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   reveal.ViewsTable,
+			Columns: []string{reveal.ViewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(revealview.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedViewsIDs(); len(nodes) > 0 && !_u.mutation.ViewsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   reveal.ViewsTable,
+			Columns: []string{reveal.ViewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(revealview.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ViewsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   reveal.ViewsTable,
+			Columns: []string{reveal.ViewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(revealview.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Reveal{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
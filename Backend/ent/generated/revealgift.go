@@ -0,0 +1,321 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/UnoraApp/be/ent/generated/connection"
+	"github.com/UnoraApp/be/ent/generated/revealgift"
+	"github.com/UnoraApp/be/ent/generated/revealmilestone"
+	"github.com/UnoraApp/be/ent/generated/user"
+)
+
+// RevealGift is the model entity for the RevealGift schema.
+type RevealGift struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// ConnectionID holds the value of the "connection_id" field.
+	ConnectionID string `json:"connection_id,omitempty"`
+	// MilestoneID holds the value of the "milestone_id" field.
+	MilestoneID string `json:"milestone_id,omitempty"`
+	// GifterUserID holds the value of the "gifter_user_id" field.
+	GifterUserID string `json:"gifter_user_id,omitempty"`
+	// RecipientUserID holds the value of the "recipient_user_id" field.
+	RecipientUserID string `json:"recipient_user_id,omitempty"`
+	// CreditCost holds the value of the "credit_cost" field.
+	CreditCost int `json:"credit_cost,omitempty"`
+	// GiftStatus holds the value of the "gift_status" field.
+	GiftStatus revealgift.GiftStatus `json:"gift_status,omitempty"`
+	// GifterConsentedAt holds the value of the "gifter_consented_at" field.
+	GifterConsentedAt time.Time `json:"gifter_consented_at,omitempty"`
+	// RevealID holds the value of the "reveal_id" field.
+	RevealID *string `json:"reveal_id,omitempty"`
+	// CreditTransactionID holds the value of the "credit_transaction_id" field.
+	CreditTransactionID *string `json:"credit_transaction_id,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// RespondedAt holds the value of the "responded_at" field.
+	RespondedAt *time.Time `json:"responded_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the RevealGiftQuery when eager-loading is set.
+	Edges        RevealGiftEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// RevealGiftEdges holds the relations/edges for other nodes in the graph.
+type RevealGiftEdges struct {
+	// Connection holds the value of the connection edge.
+	Connection *Connection `json:"connection,omitempty"`
+	// Milestone holds the value of the milestone edge.
+	Milestone *RevealMilestone `json:"milestone,omitempty"`
+	// Gifter holds the value of the gifter edge.
+	Gifter *User `json:"gifter,omitempty"`
+	// Recipient holds the value of the recipient edge.
+	Recipient *User `json:"recipient,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [4]bool
+}
+
+// ConnectionOrErr returns the Connection value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RevealGiftEdges) ConnectionOrErr() (*Connection, error) {
+	if e.Connection != nil {
+		return e.Connection, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: connection.Label}
+	}
+	return nil, &NotLoadedError{edge: "connection"}
+}
+
+// MilestoneOrErr returns the Milestone value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RevealGiftEdges) MilestoneOrErr() (*RevealMilestone, error) {
+	if e.Milestone != nil {
+		return e.Milestone, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: revealmilestone.Label}
+	}
+	return nil, &NotLoadedError{edge: "milestone"}
+}
+
+// GifterOrErr returns the Gifter value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RevealGiftEdges) GifterOrErr() (*User, error) {
+	if e.Gifter != nil {
+		return e.Gifter, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "gifter"}
+}
+
+// RecipientOrErr returns the Recipient value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RevealGiftEdges) RecipientOrErr() (*User, error) {
+	if e.Recipient != nil {
+		return e.Recipient, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "recipient"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RevealGift) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case revealgift.FieldCreditCost:
+			values[i] = new(sql.NullInt64)
+		case revealgift.FieldID, revealgift.FieldConnectionID, revealgift.FieldMilestoneID, revealgift.FieldGifterUserID, revealgift.FieldRecipientUserID, revealgift.FieldGiftStatus, revealgift.FieldRevealID, revealgift.FieldCreditTransactionID:
+			values[i] = new(sql.NullString)
+		case revealgift.FieldGifterConsentedAt, revealgift.FieldExpiresAt, revealgift.FieldRespondedAt, revealgift.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RevealGift fields.
+func (_m *RevealGift) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case revealgift.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case revealgift.FieldConnectionID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field connection_id", values[i])
+			} else if value.Valid {
+				_m.ConnectionID = value.String
+			}
+		case revealgift.FieldMilestoneID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field milestone_id", values[i])
+			} else if value.Valid {
+				_m.MilestoneID = value.String
+			}
+		case revealgift.FieldGifterUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field gifter_user_id", values[i])
+			} else if value.Valid {
+				_m.GifterUserID = value.String
+			}
+		case revealgift.FieldRecipientUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field recipient_user_id", values[i])
+			} else if value.Valid {
+				_m.RecipientUserID = value.String
+			}
+		case revealgift.FieldCreditCost:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field credit_cost", values[i])
+			} else if value.Valid {
+				_m.CreditCost = int(value.Int64)
+			}
+		case revealgift.FieldGiftStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field gift_status", values[i])
+			} else if value.Valid {
+				_m.GiftStatus = revealgift.GiftStatus(value.String)
+			}
+		case revealgift.FieldGifterConsentedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field gifter_consented_at", values[i])
+			} else if value.Valid {
+				_m.GifterConsentedAt = value.Time
+			}
+		case revealgift.FieldRevealID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reveal_id", values[i])
+			} else if value.Valid {
+				_m.RevealID = new(string)
+				*_m.RevealID = value.String
+			}
+		case revealgift.FieldCreditTransactionID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field credit_transaction_id", values[i])
+			} else if value.Valid {
+				_m.CreditTransactionID = new(string)
+				*_m.CreditTransactionID = value.String
+			}
+		case revealgift.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				_m.ExpiresAt = value.Time
+			}
+		case revealgift.FieldRespondedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field responded_at", values[i])
+			} else if value.Valid {
+				_m.RespondedAt = new(time.Time)
+				*_m.RespondedAt = value.Time
+			}
+		case revealgift.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RevealGift.
+// This includes values selected through modifiers, order, etc.
+func (_m *RevealGift) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryConnection queries the "connection" edge of the RevealGift entity.
+func (_m *RevealGift) QueryConnection() *ConnectionQuery {
+	return NewRevealGiftClient(_m.config).QueryConnection(_m)
+}
+
+// QueryMilestone queries the "milestone" edge of the RevealGift entity.
+func (_m *RevealGift) QueryMilestone() *RevealMilestoneQuery {
+	return NewRevealGiftClient(_m.config).QueryMilestone(_m)
+}
+
+// QueryGifter queries the "gifter" edge of the RevealGift entity.
+func (_m *RevealGift) QueryGifter() *UserQuery {
+	return NewRevealGiftClient(_m.config).QueryGifter(_m)
+}
+
+// QueryRecipient queries the "recipient" edge of the RevealGift entity.
+func (_m *RevealGift) QueryRecipient() *UserQuery {
+	return NewRevealGiftClient(_m.config).QueryRecipient(_m)
+}
+
+// Update returns a builder for updating this RevealGift.
+// Note that you need to call RevealGift.Unwrap() before calling this method if this RevealGift
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *RevealGift) Update() *RevealGiftUpdateOne {
+	return NewRevealGiftClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the RevealGift entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *RevealGift) Unwrap() *RevealGift {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("generated: RevealGift is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *RevealGift) String() string {
+	var builder strings.Builder
+	builder.WriteString("RevealGift(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("connection_id=")
+	builder.WriteString(_m.ConnectionID)
+	builder.WriteString(", ")
+	builder.WriteString("milestone_id=")
+	builder.WriteString(_m.MilestoneID)
+	builder.WriteString(", ")
+	builder.WriteString("gifter_user_id=")
+	builder.WriteString(_m.GifterUserID)
+	builder.WriteString(", ")
+	builder.WriteString("recipient_user_id=")
+	builder.WriteString(_m.RecipientUserID)
+	builder.WriteString(", ")
+	builder.WriteString("credit_cost=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CreditCost))
+	builder.WriteString(", ")
+	builder.WriteString("gift_status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.GiftStatus))
+	builder.WriteString(", ")
+	builder.WriteString("gifter_consented_at=")
+	builder.WriteString(_m.GifterConsentedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.RevealID; v != nil {
+		builder.WriteString("reveal_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.CreditTransactionID; v != nil {
+		builder.WriteString("credit_transaction_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(_m.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.RespondedAt; v != nil {
+		builder.WriteString("responded_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RevealGifts is a parsable slice of RevealGift.
+type RevealGifts []*RevealGift
@@ -0,0 +1,281 @@
+// Code generated by ent, DO NOT EDIT.
+
+package revealgift
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the revealgift type in the database.
+	Label = "reveal_gift"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldConnectionID holds the string denoting the connection_id field in the database.
+	FieldConnectionID = "connection_id"
+	// FieldMilestoneID holds the string denoting the milestone_id field in the database.
+	FieldMilestoneID = "milestone_id"
+	// FieldGifterUserID holds the string denoting the gifter_user_id field in the database.
+	FieldGifterUserID = "gifter_user_id"
+	// FieldRecipientUserID holds the string denoting the recipient_user_id field in the database.
+	FieldRecipientUserID = "recipient_user_id"
+	// FieldCreditCost holds the string denoting the credit_cost field in the database.
+	FieldCreditCost = "credit_cost"
+	// FieldGiftStatus holds the string denoting the gift_status field in the database.
+	FieldGiftStatus = "gift_status"
+	// FieldGifterConsentedAt holds the string denoting the gifter_consented_at field in the database.
+	FieldGifterConsentedAt = "gifter_consented_at"
+	// FieldRevealID holds the string denoting the reveal_id field in the database.
+	FieldRevealID = "reveal_id"
+	// FieldCreditTransactionID holds the string denoting the credit_transaction_id field in the database.
+	FieldCreditTransactionID = "credit_transaction_id"
+	// FieldExpiresAt holds the string denoting the expires_at field in the database.
+	FieldExpiresAt = "expires_at"
+	// FieldRespondedAt holds the string denoting the responded_at field in the database.
+	FieldRespondedAt = "responded_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeConnection holds the string denoting the connection edge name in mutations.
+	EdgeConnection = "connection"
+	// EdgeMilestone holds the string denoting the milestone edge name in mutations.
+	EdgeMilestone = "milestone"
+	// EdgeGifter holds the string denoting the gifter edge name in mutations.
+	EdgeGifter = "gifter"
+	// EdgeRecipient holds the string denoting the recipient edge name in mutations.
+	EdgeRecipient = "recipient"
+	// Table holds the table name of the revealgift in the database.
+	Table = "reveal_gifts"
+	// ConnectionTable is the table that holds the connection relation/edge.
+	ConnectionTable = "reveal_gifts"
+	// ConnectionInverseTable is the table name for the Connection entity.
+	// It exists in this package in order to avoid circular dependency with the "connection" package.
+	ConnectionInverseTable = "connections"
+	// ConnectionColumn is the table column denoting the connection relation/edge.
+	ConnectionColumn = "connection_id"
+	// MilestoneTable is the table that holds the milestone relation/edge.
+	MilestoneTable = "reveal_gifts"
+	// MilestoneInverseTable is the table name for the RevealMilestone entity.
+	// It exists in this package in order to avoid circular dependency with the "revealmilestone" package.
+	MilestoneInverseTable = "reveal_milestones"
+	// MilestoneColumn is the table column denoting the milestone relation/edge.
+	MilestoneColumn = "milestone_id"
+	// GifterTable is the table that holds the gifter relation/edge.
+	GifterTable = "reveal_gifts"
+	// GifterInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	GifterInverseTable = "users"
+	// GifterColumn is the table column denoting the gifter relation/edge.
+	GifterColumn = "gifter_user_id"
+	// RecipientTable is the table that holds the recipient relation/edge.
+	RecipientTable = "reveal_gifts"
+	// RecipientInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	RecipientInverseTable = "users"
+	// RecipientColumn is the table column denoting the recipient relation/edge.
+	RecipientColumn = "recipient_user_id"
+)
+
+// Columns holds all SQL columns for revealgift fields.
+var Columns = []string{
+	FieldID,
+	FieldConnectionID,
+	FieldMilestoneID,
+	FieldGifterUserID,
+	FieldRecipientUserID,
+	FieldCreditCost,
+	FieldGiftStatus,
+	FieldGifterConsentedAt,
+	FieldRevealID,
+	FieldCreditTransactionID,
+	FieldExpiresAt,
+	FieldRespondedAt,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// ConnectionIDValidator is a validator for the "connection_id" field. It is called by the builders before save.
+	ConnectionIDValidator func(string) error
+	// MilestoneIDValidator is a validator for the "milestone_id" field. It is called by the builders before save.
+	MilestoneIDValidator func(string) error
+	// GifterUserIDValidator is a validator for the "gifter_user_id" field. It is called by the builders before save.
+	GifterUserIDValidator func(string) error
+	// RecipientUserIDValidator is a validator for the "recipient_user_id" field. It is called by the builders before save.
+	RecipientUserIDValidator func(string) error
+	// CreditCostValidator is a validator for the "credit_cost" field. It is called by the builders before save.
+	CreditCostValidator func(int) error
+	// RevealIDValidator is a validator for the "reveal_id" field. It is called by the builders before save.
+	RevealIDValidator func(string) error
+	// CreditTransactionIDValidator is a validator for the "credit_transaction_id" field. It is called by the builders before save.
+	CreditTransactionIDValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
+
+// GiftStatus defines the type for the "gift_status" enum field.
+type GiftStatus string
+
+// GiftStatusPending is the default value of the GiftStatus enum.
+const DefaultGiftStatus = GiftStatusPending
+
+// GiftStatus values.
+const (
+	GiftStatusPending   GiftStatus = "pending"
+	GiftStatusAccepted  GiftStatus = "accepted"
+	GiftStatusDeclined  GiftStatus = "declined"
+	GiftStatusCancelled GiftStatus = "cancelled"
+	GiftStatusExpired   GiftStatus = "expired"
+)
+
+func (gs GiftStatus) String() string {
+	return string(gs)
+}
+
+// GiftStatusValidator is a validator for the "gift_status" field enum values. It is called by the builders before save.
+func GiftStatusValidator(gs GiftStatus) error {
+	switch gs {
+	case GiftStatusPending, GiftStatusAccepted, GiftStatusDeclined, GiftStatusCancelled, GiftStatusExpired:
+		return nil
+	default:
+		return fmt.Errorf("revealgift: invalid enum value for gift_status field: %q", gs)
+	}
+}
+
+// OrderOption defines the ordering options for the RevealGift queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByConnectionID orders the results by the connection_id field.
+func ByConnectionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldConnectionID, opts...).ToFunc()
+}
+
+// ByMilestoneID orders the results by the milestone_id field.
+func ByMilestoneID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMilestoneID, opts...).ToFunc()
+}
+
+// ByGifterUserID orders the results by the gifter_user_id field.
+func ByGifterUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGifterUserID, opts...).ToFunc()
+}
+
+// ByRecipientUserID orders the results by the recipient_user_id field.
+func ByRecipientUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRecipientUserID, opts...).ToFunc()
+}
+
+// ByCreditCost orders the results by the credit_cost field.
+func ByCreditCost(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreditCost, opts...).ToFunc()
+}
+
+// ByGiftStatus orders the results by the gift_status field.
+func ByGiftStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGiftStatus, opts...).ToFunc()
+}
+
+// ByGifterConsentedAt orders the results by the gifter_consented_at field.
+func ByGifterConsentedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGifterConsentedAt, opts...).ToFunc()
+}
+
+// ByRevealID orders the results by the reveal_id field.
+func ByRevealID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRevealID, opts...).ToFunc()
+}
+
+// ByCreditTransactionID orders the results by the credit_transaction_id field.
+func ByCreditTransactionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreditTransactionID, opts...).ToFunc()
+}
+
+// ByExpiresAt orders the results by the expires_at field.
+func ByExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpiresAt, opts...).ToFunc()
+}
+
+// ByRespondedAt orders the results by the responded_at field.
+func ByRespondedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRespondedAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByConnectionField orders the results by connection field.
+func ByConnectionField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newConnectionStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByMilestoneField orders the results by milestone field.
+func ByMilestoneField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMilestoneStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByGifterField orders the results by gifter field.
+func ByGifterField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newGifterStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByRecipientField orders the results by recipient field.
+func ByRecipientField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRecipientStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newConnectionStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ConnectionInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ConnectionTable, ConnectionColumn),
+	)
+}
+func newMilestoneStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MilestoneInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, MilestoneTable, MilestoneColumn),
+	)
+}
+func newGifterStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(GifterInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, GifterTable, GifterColumn),
+	)
+}
+func newRecipientStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RecipientInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, RecipientTable, RecipientColumn),
+	)
+}
@@ -0,0 +1,868 @@
+// Code generated by ent, DO NOT EDIT.
+
+package revealgift
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/UnoraApp/be/ent/generated/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldContainsFold(FieldID, id))
+}
+
+// ConnectionID applies equality check predicate on the "connection_id" field. It's identical to ConnectionIDEQ.
+func ConnectionID(v string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldEQ(FieldConnectionID, v))
+}
+
+// MilestoneID applies equality check predicate on the "milestone_id" field. It's identical to MilestoneIDEQ.
+func MilestoneID(v string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldEQ(FieldMilestoneID, v))
+}
+
+// GifterUserID applies equality check predicate on the "gifter_user_id" field. It's identical to GifterUserIDEQ.
+func GifterUserID(v string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldEQ(FieldGifterUserID, v))
+}
+
+// RecipientUserID applies equality check predicate on the "recipient_user_id" field. It's identical to RecipientUserIDEQ.
+func RecipientUserID(v string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldEQ(FieldRecipientUserID, v))
+}
+
+// CreditCost applies equality check predicate on the "credit_cost" field. It's identical to CreditCostEQ.
+func CreditCost(v int) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldEQ(FieldCreditCost, v))
+}
+
+// GifterConsentedAt applies equality check predicate on the "gifter_consented_at" field. It's identical to GifterConsentedAtEQ.
+func GifterConsentedAt(v time.Time) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldEQ(FieldGifterConsentedAt, v))
+}
+
+// RevealID applies equality check predicate on the "reveal_id" field. It's identical to RevealIDEQ.
+func RevealID(v string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldEQ(FieldRevealID, v))
+}
+
+// CreditTransactionID applies equality check predicate on the "credit_transaction_id" field. It's identical to CreditTransactionIDEQ.
+func CreditTransactionID(v string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldEQ(FieldCreditTransactionID, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// RespondedAt applies equality check predicate on the "responded_at" field. It's identical to RespondedAtEQ.
+func RespondedAt(v time.Time) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldEQ(FieldRespondedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ConnectionIDEQ applies the EQ predicate on the "connection_id" field.
+func ConnectionIDEQ(v string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldEQ(FieldConnectionID, v))
+}
+
+// ConnectionIDNEQ applies the NEQ predicate on the "connection_id" field.
+func ConnectionIDNEQ(v string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldNEQ(FieldConnectionID, v))
+}
+
+// ConnectionIDIn applies the In predicate on the "connection_id" field.
+func ConnectionIDIn(vs ...string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldIn(FieldConnectionID, vs...))
+}
+
+// ConnectionIDNotIn applies the NotIn predicate on the "connection_id" field.
+func ConnectionIDNotIn(vs ...string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldNotIn(FieldConnectionID, vs...))
+}
+
+// ConnectionIDGT applies the GT predicate on the "connection_id" field.
+func ConnectionIDGT(v string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldGT(FieldConnectionID, v))
+}
+
+// ConnectionIDGTE applies the GTE predicate on the "connection_id" field.
+func ConnectionIDGTE(v string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldGTE(FieldConnectionID, v))
+}
+
+// ConnectionIDLT applies the LT predicate on the "connection_id" field.
+func ConnectionIDLT(v string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldLT(FieldConnectionID, v))
+}
+
+// ConnectionIDLTE applies the LTE predicate on the "connection_id" field.
+func ConnectionIDLTE(v string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldLTE(FieldConnectionID, v))
+}
+
+// ConnectionIDContains applies the Contains predicate on the "connection_id" field.
+func ConnectionIDContains(v string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldContains(FieldConnectionID, v))
+}
+
+// ConnectionIDHasPrefix applies the HasPrefix predicate on the "connection_id" field.
+func ConnectionIDHasPrefix(v string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldHasPrefix(FieldConnectionID, v))
+}
+
+// ConnectionIDHasSuffix applies the HasSuffix predicate on the "connection_id" field.
+func ConnectionIDHasSuffix(v string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldHasSuffix(FieldConnectionID, v))
+}
+
+// ConnectionIDEqualFold applies the EqualFold predicate on the "connection_id" field.
+func ConnectionIDEqualFold(v string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldEqualFold(FieldConnectionID, v))
+}
+
+// ConnectionIDContainsFold applies the ContainsFold predicate on the "connection_id" field.
+func ConnectionIDContainsFold(v string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldContainsFold(FieldConnectionID, v))
+}
+
+// MilestoneIDEQ applies the EQ predicate on the "milestone_id" field.
+func MilestoneIDEQ(v string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldEQ(FieldMilestoneID, v))
+}
+
+// MilestoneIDNEQ applies the NEQ predicate on the "milestone_id" field.
+func MilestoneIDNEQ(v string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldNEQ(FieldMilestoneID, v))
+}
+
+// MilestoneIDIn applies the In predicate on the "milestone_id" field.
+func MilestoneIDIn(vs ...string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldIn(FieldMilestoneID, vs...))
+}
+
+// MilestoneIDNotIn applies the NotIn predicate on the "milestone_id" field.
+func MilestoneIDNotIn(vs ...string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldNotIn(FieldMilestoneID, vs...))
+}
+
+// MilestoneIDGT applies the GT predicate on the "milestone_id" field.
+func MilestoneIDGT(v string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldGT(FieldMilestoneID, v))
+}
+
+// MilestoneIDGTE applies the GTE predicate on the "milestone_id" field.
+func MilestoneIDGTE(v string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldGTE(FieldMilestoneID, v))
+}
+
+// MilestoneIDLT applies the LT predicate on the "milestone_id" field.
+func MilestoneIDLT(v string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldLT(FieldMilestoneID, v))
+}
+
+// MilestoneIDLTE applies the LTE predicate on the "milestone_id" field.
+func MilestoneIDLTE(v string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldLTE(FieldMilestoneID, v))
+}
+
+// MilestoneIDContains applies the Contains predicate on the "milestone_id" field.
+func MilestoneIDContains(v string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldContains(FieldMilestoneID, v))
+}
+
+// MilestoneIDHasPrefix applies the HasPrefix predicate on the "milestone_id" field.
+func MilestoneIDHasPrefix(v string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldHasPrefix(FieldMilestoneID, v))
+}
+
+// MilestoneIDHasSuffix applies the HasSuffix predicate on the "milestone_id" field.
+func MilestoneIDHasSuffix(v string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldHasSuffix(FieldMilestoneID, v))
+}
+
+// MilestoneIDEqualFold applies the EqualFold predicate on the "milestone_id" field.
+func MilestoneIDEqualFold(v string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldEqualFold(FieldMilestoneID, v))
+}
+
+// MilestoneIDContainsFold applies the ContainsFold predicate on the "milestone_id" field.
+func MilestoneIDContainsFold(v string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldContainsFold(FieldMilestoneID, v))
+}
+
+// GifterUserIDEQ applies the EQ predicate on the "gifter_user_id" field.
+func GifterUserIDEQ(v string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldEQ(FieldGifterUserID, v))
+}
+
+// GifterUserIDNEQ applies the NEQ predicate on the "gifter_user_id" field.
+func GifterUserIDNEQ(v string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldNEQ(FieldGifterUserID, v))
+}
+
+// GifterUserIDIn applies the In predicate on the "gifter_user_id" field.
+func GifterUserIDIn(vs ...string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldIn(FieldGifterUserID, vs...))
+}
+
+// GifterUserIDNotIn applies the NotIn predicate on the "gifter_user_id" field.
+func GifterUserIDNotIn(vs ...string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldNotIn(FieldGifterUserID, vs...))
+}
+
+// GifterUserIDGT applies the GT predicate on the "gifter_user_id" field.
+func GifterUserIDGT(v string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldGT(FieldGifterUserID, v))
+}
+
+// GifterUserIDGTE applies the GTE predicate on the "gifter_user_id" field.
+func GifterUserIDGTE(v string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldGTE(FieldGifterUserID, v))
+}
+
+// GifterUserIDLT applies the LT predicate on the "gifter_user_id" field.
+func GifterUserIDLT(v string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldLT(FieldGifterUserID, v))
+}
+
+// GifterUserIDLTE applies the LTE predicate on the "gifter_user_id" field.
+func GifterUserIDLTE(v string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldLTE(FieldGifterUserID, v))
+}
+
+// GifterUserIDContains applies the Contains predicate on the "gifter_user_id" field.
+func GifterUserIDContains(v string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldContains(FieldGifterUserID, v))
+}
+
+// GifterUserIDHasPrefix applies the HasPrefix predicate on the "gifter_user_id" field.
+func GifterUserIDHasPrefix(v string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldHasPrefix(FieldGifterUserID, v))
+}
+
+// GifterUserIDHasSuffix applies the HasSuffix predicate on the "gifter_user_id" field.
+func GifterUserIDHasSuffix(v string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldHasSuffix(FieldGifterUserID, v))
+}
+
+// GifterUserIDEqualFold applies the EqualFold predicate on the "gifter_user_id" field.
+func GifterUserIDEqualFold(v string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldEqualFold(FieldGifterUserID, v))
+}
+
+// GifterUserIDContainsFold applies the ContainsFold predicate on the "gifter_user_id" field.
+func GifterUserIDContainsFold(v string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldContainsFold(FieldGifterUserID, v))
+}
+
+// RecipientUserIDEQ applies the EQ predicate on the "recipient_user_id" field.
+func RecipientUserIDEQ(v string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldEQ(FieldRecipientUserID, v))
+}
+
+// RecipientUserIDNEQ applies the NEQ predicate on the "recipient_user_id" field.
+func RecipientUserIDNEQ(v string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldNEQ(FieldRecipientUserID, v))
+}
+
+// RecipientUserIDIn applies the In predicate on the "recipient_user_id" field.
+func RecipientUserIDIn(vs ...string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldIn(FieldRecipientUserID, vs...))
+}
+
+// RecipientUserIDNotIn applies the NotIn predicate on the "recipient_user_id" field.
+func RecipientUserIDNotIn(vs ...string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldNotIn(FieldRecipientUserID, vs...))
+}
+
+// RecipientUserIDGT applies the GT predicate on the "recipient_user_id" field.
+func RecipientUserIDGT(v string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldGT(FieldRecipientUserID, v))
+}
+
+// RecipientUserIDGTE applies the GTE predicate on the "recipient_user_id" field.
+func RecipientUserIDGTE(v string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldGTE(FieldRecipientUserID, v))
+}
+
+// RecipientUserIDLT applies the LT predicate on the "recipient_user_id" field.
+func RecipientUserIDLT(v string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldLT(FieldRecipientUserID, v))
+}
+
+// RecipientUserIDLTE applies the LTE predicate on the "recipient_user_id" field.
+func RecipientUserIDLTE(v string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldLTE(FieldRecipientUserID, v))
+}
+
+// RecipientUserIDContains applies the Contains predicate on the "recipient_user_id" field.
+func RecipientUserIDContains(v string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldContains(FieldRecipientUserID, v))
+}
+
+// RecipientUserIDHasPrefix applies the HasPrefix predicate on the "recipient_user_id" field.
+func RecipientUserIDHasPrefix(v string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldHasPrefix(FieldRecipientUserID, v))
+}
+
+// RecipientUserIDHasSuffix applies the HasSuffix predicate on the "recipient_user_id" field.
+func RecipientUserIDHasSuffix(v string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldHasSuffix(FieldRecipientUserID, v))
+}
+
+// RecipientUserIDEqualFold applies the EqualFold predicate on the "recipient_user_id" field.
+func RecipientUserIDEqualFold(v string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldEqualFold(FieldRecipientUserID, v))
+}
+
+// RecipientUserIDContainsFold applies the ContainsFold predicate on the "recipient_user_id" field.
+func RecipientUserIDContainsFold(v string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldContainsFold(FieldRecipientUserID, v))
+}
+
+// CreditCostEQ applies the EQ predicate on the "credit_cost" field.
+func CreditCostEQ(v int) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldEQ(FieldCreditCost, v))
+}
+
+// CreditCostNEQ applies the NEQ predicate on the "credit_cost" field.
+func CreditCostNEQ(v int) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldNEQ(FieldCreditCost, v))
+}
+
+// CreditCostIn applies the In predicate on the "credit_cost" field.
+func CreditCostIn(vs ...int) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldIn(FieldCreditCost, vs...))
+}
+
+// CreditCostNotIn applies the NotIn predicate on the "credit_cost" field.
+func CreditCostNotIn(vs ...int) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldNotIn(FieldCreditCost, vs...))
+}
+
+// CreditCostGT applies the GT predicate on the "credit_cost" field.
+func CreditCostGT(v int) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldGT(FieldCreditCost, v))
+}
+
+// CreditCostGTE applies the GTE predicate on the "credit_cost" field.
+func CreditCostGTE(v int) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldGTE(FieldCreditCost, v))
+}
+
+// CreditCostLT applies the LT predicate on the "credit_cost" field.
+func CreditCostLT(v int) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldLT(FieldCreditCost, v))
+}
+
+// CreditCostLTE applies the LTE predicate on the "credit_cost" field.
+func CreditCostLTE(v int) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldLTE(FieldCreditCost, v))
+}
+
+// GiftStatusEQ applies the EQ predicate on the "gift_status" field.
+func GiftStatusEQ(v GiftStatus) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldEQ(FieldGiftStatus, v))
+}
+
+// GiftStatusNEQ applies the NEQ predicate on the "gift_status" field.
+func GiftStatusNEQ(v GiftStatus) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldNEQ(FieldGiftStatus, v))
+}
+
+// GiftStatusIn applies the In predicate on the "gift_status" field.
+func GiftStatusIn(vs ...GiftStatus) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldIn(FieldGiftStatus, vs...))
+}
+
+// GiftStatusNotIn applies the NotIn predicate on the "gift_status" field.
+func GiftStatusNotIn(vs ...GiftStatus) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldNotIn(FieldGiftStatus, vs...))
+}
+
+// GifterConsentedAtEQ applies the EQ predicate on the "gifter_consented_at" field.
+func GifterConsentedAtEQ(v time.Time) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldEQ(FieldGifterConsentedAt, v))
+}
+
+// GifterConsentedAtNEQ applies the NEQ predicate on the "gifter_consented_at" field.
+func GifterConsentedAtNEQ(v time.Time) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldNEQ(FieldGifterConsentedAt, v))
+}
+
+// GifterConsentedAtIn applies the In predicate on the "gifter_consented_at" field.
+func GifterConsentedAtIn(vs ...time.Time) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldIn(FieldGifterConsentedAt, vs...))
+}
+
+// GifterConsentedAtNotIn applies the NotIn predicate on the "gifter_consented_at" field.
+func GifterConsentedAtNotIn(vs ...time.Time) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldNotIn(FieldGifterConsentedAt, vs...))
+}
+
+// GifterConsentedAtGT applies the GT predicate on the "gifter_consented_at" field.
+func GifterConsentedAtGT(v time.Time) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldGT(FieldGifterConsentedAt, v))
+}
+
+// GifterConsentedAtGTE applies the GTE predicate on the "gifter_consented_at" field.
+func GifterConsentedAtGTE(v time.Time) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldGTE(FieldGifterConsentedAt, v))
+}
+
+// GifterConsentedAtLT applies the LT predicate on the "gifter_consented_at" field.
+func GifterConsentedAtLT(v time.Time) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldLT(FieldGifterConsentedAt, v))
+}
+
+// GifterConsentedAtLTE applies the LTE predicate on the "gifter_consented_at" field.
+func GifterConsentedAtLTE(v time.Time) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldLTE(FieldGifterConsentedAt, v))
+}
+
+// RevealIDEQ applies the EQ predicate on the "reveal_id" field.
+func RevealIDEQ(v string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldEQ(FieldRevealID, v))
+}
+
+// RevealIDNEQ applies the NEQ predicate on the "reveal_id" field.
+func RevealIDNEQ(v string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldNEQ(FieldRevealID, v))
+}
+
+// RevealIDIn applies the In predicate on the "reveal_id" field.
+func RevealIDIn(vs ...string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldIn(FieldRevealID, vs...))
+}
+
+// RevealIDNotIn applies the NotIn predicate on the "reveal_id" field.
+func RevealIDNotIn(vs ...string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldNotIn(FieldRevealID, vs...))
+}
+
+// RevealIDGT applies the GT predicate on the "reveal_id" field.
+func RevealIDGT(v string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldGT(FieldRevealID, v))
+}
+
+// RevealIDGTE applies the GTE predicate on the "reveal_id" field.
+func RevealIDGTE(v string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldGTE(FieldRevealID, v))
+}
+
+// RevealIDLT applies the LT predicate on the "reveal_id" field.
+func RevealIDLT(v string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldLT(FieldRevealID, v))
+}
+
+// RevealIDLTE applies the LTE predicate on the "reveal_id" field.
+func RevealIDLTE(v string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldLTE(FieldRevealID, v))
+}
+
+// RevealIDContains applies the Contains predicate on the "reveal_id" field.
+func RevealIDContains(v string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldContains(FieldRevealID, v))
+}
+
+// RevealIDHasPrefix applies the HasPrefix predicate on the "reveal_id" field.
+func RevealIDHasPrefix(v string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldHasPrefix(FieldRevealID, v))
+}
+
+// RevealIDHasSuffix applies the HasSuffix predicate on the "reveal_id" field.
+func RevealIDHasSuffix(v string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldHasSuffix(FieldRevealID, v))
+}
+
+// RevealIDIsNil applies the IsNil predicate on the "reveal_id" field.
+func RevealIDIsNil() predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldIsNull(FieldRevealID))
+}
+
+// RevealIDNotNil applies the NotNil predicate on the "reveal_id" field.
+func RevealIDNotNil() predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldNotNull(FieldRevealID))
+}
+
+// RevealIDEqualFold applies the EqualFold predicate on the "reveal_id" field.
+func RevealIDEqualFold(v string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldEqualFold(FieldRevealID, v))
+}
+
+// RevealIDContainsFold applies the ContainsFold predicate on the "reveal_id" field.
+func RevealIDContainsFold(v string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldContainsFold(FieldRevealID, v))
+}
+
+// CreditTransactionIDEQ applies the EQ predicate on the "credit_transaction_id" field.
+func CreditTransactionIDEQ(v string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldEQ(FieldCreditTransactionID, v))
+}
+
+// CreditTransactionIDNEQ applies the NEQ predicate on the "credit_transaction_id" field.
+func CreditTransactionIDNEQ(v string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldNEQ(FieldCreditTransactionID, v))
+}
+
+// CreditTransactionIDIn applies the In predicate on the "credit_transaction_id" field.
+func CreditTransactionIDIn(vs ...string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldIn(FieldCreditTransactionID, vs...))
+}
+
+// CreditTransactionIDNotIn applies the NotIn predicate on the "credit_transaction_id" field.
+func CreditTransactionIDNotIn(vs ...string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldNotIn(FieldCreditTransactionID, vs...))
+}
+
+// CreditTransactionIDGT applies the GT predicate on the "credit_transaction_id" field.
+func CreditTransactionIDGT(v string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldGT(FieldCreditTransactionID, v))
+}
+
+// CreditTransactionIDGTE applies the GTE predicate on the "credit_transaction_id" field.
+func CreditTransactionIDGTE(v string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldGTE(FieldCreditTransactionID, v))
+}
+
+// CreditTransactionIDLT applies the LT predicate on the "credit_transaction_id" field.
+func CreditTransactionIDLT(v string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldLT(FieldCreditTransactionID, v))
+}
+
+// CreditTransactionIDLTE applies the LTE predicate on the "credit_transaction_id" field.
+func CreditTransactionIDLTE(v string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldLTE(FieldCreditTransactionID, v))
+}
+
+// CreditTransactionIDContains applies the Contains predicate on the "credit_transaction_id" field.
+func CreditTransactionIDContains(v string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldContains(FieldCreditTransactionID, v))
+}
+
+// CreditTransactionIDHasPrefix applies the HasPrefix predicate on the "credit_transaction_id" field.
+func CreditTransactionIDHasPrefix(v string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldHasPrefix(FieldCreditTransactionID, v))
+}
+
+// CreditTransactionIDHasSuffix applies the HasSuffix predicate on the "credit_transaction_id" field.
+func CreditTransactionIDHasSuffix(v string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldHasSuffix(FieldCreditTransactionID, v))
+}
+
+// CreditTransactionIDIsNil applies the IsNil predicate on the "credit_transaction_id" field.
+func CreditTransactionIDIsNil() predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldIsNull(FieldCreditTransactionID))
+}
+
+// CreditTransactionIDNotNil applies the NotNil predicate on the "credit_transaction_id" field.
+func CreditTransactionIDNotNil() predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldNotNull(FieldCreditTransactionID))
+}
+
+// CreditTransactionIDEqualFold applies the EqualFold predicate on the "credit_transaction_id" field.
+func CreditTransactionIDEqualFold(v string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldEqualFold(FieldCreditTransactionID, v))
+}
+
+// CreditTransactionIDContainsFold applies the ContainsFold predicate on the "credit_transaction_id" field.
+func CreditTransactionIDContainsFold(v string) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldContainsFold(FieldCreditTransactionID, v))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// RespondedAtEQ applies the EQ predicate on the "responded_at" field.
+func RespondedAtEQ(v time.Time) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldEQ(FieldRespondedAt, v))
+}
+
+// RespondedAtNEQ applies the NEQ predicate on the "responded_at" field.
+func RespondedAtNEQ(v time.Time) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldNEQ(FieldRespondedAt, v))
+}
+
+// RespondedAtIn applies the In predicate on the "responded_at" field.
+func RespondedAtIn(vs ...time.Time) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldIn(FieldRespondedAt, vs...))
+}
+
+// RespondedAtNotIn applies the NotIn predicate on the "responded_at" field.
+func RespondedAtNotIn(vs ...time.Time) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldNotIn(FieldRespondedAt, vs...))
+}
+
+// RespondedAtGT applies the GT predicate on the "responded_at" field.
+func RespondedAtGT(v time.Time) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldGT(FieldRespondedAt, v))
+}
+
+// RespondedAtGTE applies the GTE predicate on the "responded_at" field.
+func RespondedAtGTE(v time.Time) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldGTE(FieldRespondedAt, v))
+}
+
+// RespondedAtLT applies the LT predicate on the "responded_at" field.
+func RespondedAtLT(v time.Time) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldLT(FieldRespondedAt, v))
+}
+
+// RespondedAtLTE applies the LTE predicate on the "responded_at" field.
+func RespondedAtLTE(v time.Time) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldLTE(FieldRespondedAt, v))
+}
+
+// RespondedAtIsNil applies the IsNil predicate on the "responded_at" field.
+func RespondedAtIsNil() predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldIsNull(FieldRespondedAt))
+}
+
+// RespondedAtNotNil applies the NotNil predicate on the "responded_at" field.
+func RespondedAtNotNil() predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldNotNull(FieldRespondedAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.RevealGift {
+	return predicate.RevealGift(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasConnection applies the HasEdge predicate on the "connection" edge.
+func HasConnection() predicate.RevealGift {
+	return predicate.RevealGift(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ConnectionTable, ConnectionColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasConnectionWith applies the HasEdge predicate on the "connection" edge with a given conditions (other predicates).
+func HasConnectionWith(preds ...predicate.Connection) predicate.RevealGift {
+	return predicate.RevealGift(func(s *sql.Selector) {
+		step := newConnectionStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMilestone applies the HasEdge predicate on the "milestone" edge.
+func HasMilestone() predicate.RevealGift {
+	return predicate.RevealGift(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, MilestoneTable, MilestoneColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMilestoneWith applies the HasEdge predicate on the "milestone" edge with a given conditions (other predicates).
+func HasMilestoneWith(preds ...predicate.RevealMilestone) predicate.RevealGift {
+	return predicate.RevealGift(func(s *sql.Selector) {
+		step := newMilestoneStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasGifter applies the HasEdge predicate on the "gifter" edge.
+func HasGifter() predicate.RevealGift {
+	return predicate.RevealGift(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, GifterTable, GifterColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasGifterWith applies the HasEdge predicate on the "gifter" edge with a given conditions (other predicates).
+func HasGifterWith(preds ...predicate.User) predicate.RevealGift {
+	return predicate.RevealGift(func(s *sql.Selector) {
+		step := newGifterStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRecipient applies the HasEdge predicate on the "recipient" edge.
+func HasRecipient() predicate.RevealGift {
+	return predicate.RevealGift(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, RecipientTable, RecipientColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRecipientWith applies the HasEdge predicate on the "recipient" edge with a given conditions (other predicates).
+func HasRecipientWith(preds ...predicate.User) predicate.RevealGift {
+	return predicate.RevealGift(func(s *sql.Selector) {
+		step := newRecipientStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.RevealGift) predicate.RevealGift {
+	return predicate.RevealGift(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.RevealGift) predicate.RevealGift {
+	return predicate.RevealGift(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.RevealGift) predicate.RevealGift {
+	return predicate.RevealGift(sql.NotPredicates(p))
+}
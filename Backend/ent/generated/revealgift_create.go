@@ -0,0 +1,1084 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/UnoraApp/be/ent/generated/connection"
+	"github.com/UnoraApp/be/ent/generated/revealgift"
+	"github.com/UnoraApp/be/ent/generated/revealmilestone"
+	"github.com/UnoraApp/be/ent/generated/user"
+)
+
+// RevealGiftCreate is the builder for creating a RevealGift entity.
+type RevealGiftCreate struct {
+	config
+	mutation *RevealGiftMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetConnectionID sets the "connection_id" field.
+func (_c *RevealGiftCreate) SetConnectionID(v string) *RevealGiftCreate {
+	_c.mutation.SetConnectionID(v)
+	return _c
+}
+
+// SetMilestoneID sets the "milestone_id" field.
+func (_c *RevealGiftCreate) SetMilestoneID(v string) *RevealGiftCreate {
+	_c.mutation.SetMilestoneID(v)
+	return _c
+}
+
+// SetGifterUserID sets the "gifter_user_id" field.
+func (_c *RevealGiftCreate) SetGifterUserID(v string) *RevealGiftCreate {
+	_c.mutation.SetGifterUserID(v)
+	return _c
+}
+
+// SetRecipientUserID sets the "recipient_user_id" field.
+func (_c *RevealGiftCreate) SetRecipientUserID(v string) *RevealGiftCreate {
+	_c.mutation.SetRecipientUserID(v)
+	return _c
+}
+
+// SetCreditCost sets the "credit_cost" field.
+func (_c *RevealGiftCreate) SetCreditCost(v int) *RevealGiftCreate {
+	_c.mutation.SetCreditCost(v)
+	return _c
+}
+
+// SetGiftStatus sets the "gift_status" field.
+func (_c *RevealGiftCreate) SetGiftStatus(v revealgift.GiftStatus) *RevealGiftCreate {
+	_c.mutation.SetGiftStatus(v)
+	return _c
+}
+
+// SetNillableGiftStatus sets the "gift_status" field if the given value is not nil.
+func (_c *RevealGiftCreate) SetNillableGiftStatus(v *revealgift.GiftStatus) *RevealGiftCreate {
+	if v != nil {
+		_c.SetGiftStatus(*v)
+	}
+	return _c
+}
+
+// SetGifterConsentedAt sets the "gifter_consented_at" field.
+func (_c *RevealGiftCreate) SetGifterConsentedAt(v time.Time) *RevealGiftCreate {
+	_c.mutation.SetGifterConsentedAt(v)
+	return _c
+}
+
+// SetRevealID sets the "reveal_id" field.
+func (_c *RevealGiftCreate) SetRevealID(v string) *RevealGiftCreate {
+	_c.mutation.SetRevealID(v)
+	return _c
+}
+
+// SetNillableRevealID sets the "reveal_id" field if the given value is not nil.
+func (_c *RevealGiftCreate) SetNillableRevealID(v *string) *RevealGiftCreate {
+	if v != nil {
+		_c.SetRevealID(*v)
+	}
+	return _c
+}
+
+// SetCreditTransactionID sets the "credit_transaction_id" field.
+func (_c *RevealGiftCreate) SetCreditTransactionID(v string) *RevealGiftCreate {
+	_c.mutation.SetCreditTransactionID(v)
+	return _c
+}
+
+// SetNillableCreditTransactionID sets the "credit_transaction_id" field if the given value is not nil.
+func (_c *RevealGiftCreate) SetNillableCreditTransactionID(v *string) *RevealGiftCreate {
+	if v != nil {
+		_c.SetCreditTransactionID(*v)
+	}
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *RevealGiftCreate) SetExpiresAt(v time.Time) *RevealGiftCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetRespondedAt sets the "responded_at" field.
+func (_c *RevealGiftCreate) SetRespondedAt(v time.Time) *RevealGiftCreate {
+	_c.mutation.SetRespondedAt(v)
+	return _c
+}
+
+// SetNillableRespondedAt sets the "responded_at" field if the given value is not nil.
+func (_c *RevealGiftCreate) SetNillableRespondedAt(v *time.Time) *RevealGiftCreate {
+	if v != nil {
+		_c.SetRespondedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *RevealGiftCreate) SetCreatedAt(v time.Time) *RevealGiftCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *RevealGiftCreate) SetNillableCreatedAt(v *time.Time) *RevealGiftCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *RevealGiftCreate) SetID(v string) *RevealGiftCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetConnection sets the "connection" edge to the Connection entity.
+func (_c *RevealGiftCreate) SetConnection(v *Connection) *RevealGiftCreate {
+	return _c.SetConnectionID(v.ID)
+}
+
+// SetMilestone sets the "milestone" edge to the RevealMilestone entity.
+func (_c *RevealGiftCreate) SetMilestone(v *RevealMilestone) *RevealGiftCreate {
+	return _c.SetMilestoneID(v.ID)
+}
+
+// SetGifterID sets the "gifter" edge to the User entity by ID.
+func (_c *RevealGiftCreate) SetGifterID(id string) *RevealGiftCreate {
+	_c.mutation.SetGifterID(id)
+	return _c
+}
+
+// SetGifter sets the "gifter" edge to the User entity.
+func (_c *RevealGiftCreate) SetGifter(v *User) *RevealGiftCreate {
+	return _c.SetGifterID(v.ID)
+}
+
+// SetRecipientID sets the "recipient" edge to the User entity by ID.
+func (_c *RevealGiftCreate) SetRecipientID(id string) *RevealGiftCreate {
+	_c.mutation.SetRecipientID(id)
+	return _c
+}
+
+// SetRecipient sets the "recipient" edge to the User entity.
+func (_c *RevealGiftCreate) SetRecipient(v *User) *RevealGiftCreate {
+	return _c.SetRecipientID(v.ID)
+}
+
+// Mutation returns the RevealGiftMutation object of the builder.
+func (_c *RevealGiftCreate) Mutation() *RevealGiftMutation {
+	return _c.mutation
+}
+
+// Save creates the RevealGift in the database.
+func (_c *RevealGiftCreate) Save(ctx context.Context) (*RevealGift, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *RevealGiftCreate) SaveX(ctx context.Context) *RevealGift {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RevealGiftCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RevealGiftCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *RevealGiftCreate) defaults() {
+	if _, ok := _c.mutation.GiftStatus(); !ok {
+		v := revealgift.DefaultGiftStatus
+		_c.mutation.SetGiftStatus(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := revealgift.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *RevealGiftCreate) check() error {
+	if _, ok := _c.mutation.ConnectionID(); !ok {
+		return &ValidationError{Name: "connection_id", err: errors.New(`generated: missing required field "RevealGift.connection_id"`)}
+	}
+	if v, ok := _c.mutation.ConnectionID(); ok {
+		if err := revealgift.ConnectionIDValidator(v); err != nil {
+			return &ValidationError{Name: "connection_id", err: fmt.Errorf(`generated: validator failed for field "RevealGift.connection_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.MilestoneID(); !ok {
+		return &ValidationError{Name: "milestone_id", err: errors.New(`generated: missing required field "RevealGift.milestone_id"`)}
+	}
+	if v, ok := _c.mutation.MilestoneID(); ok {
+		if err := revealgift.MilestoneIDValidator(v); err != nil {
+			return &ValidationError{Name: "milestone_id", err: fmt.Errorf(`generated: validator failed for field "RevealGift.milestone_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.GifterUserID(); !ok {
+		return &ValidationError{Name: "gifter_user_id", err: errors.New(`generated: missing required field "RevealGift.gifter_user_id"`)}
+	}
+	if v, ok := _c.mutation.GifterUserID(); ok {
+		if err := revealgift.GifterUserIDValidator(v); err != nil {
+			return &ValidationError{Name: "gifter_user_id", err: fmt.Errorf(`generated: validator failed for field "RevealGift.gifter_user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RecipientUserID(); !ok {
+		return &ValidationError{Name: "recipient_user_id", err: errors.New(`generated: missing required field "RevealGift.recipient_user_id"`)}
+	}
+	if v, ok := _c.mutation.RecipientUserID(); ok {
+		if err := revealgift.RecipientUserIDValidator(v); err != nil {
+			return &ValidationError{Name: "recipient_user_id", err: fmt.Errorf(`generated: validator failed for field "RevealGift.recipient_user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreditCost(); !ok {
+		return &ValidationError{Name: "credit_cost", err: errors.New(`generated: missing required field "RevealGift.credit_cost"`)}
+	}
+	if v, ok := _c.mutation.CreditCost(); ok {
+		if err := revealgift.CreditCostValidator(v); err != nil {
+			return &ValidationError{Name: "credit_cost", err: fmt.Errorf(`generated: validator failed for field "RevealGift.credit_cost": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.GiftStatus(); !ok {
+		return &ValidationError{Name: "gift_status", err: errors.New(`generated: missing required field "RevealGift.gift_status"`)}
+	}
+	if v, ok := _c.mutation.GiftStatus(); ok {
+		if err := revealgift.GiftStatusValidator(v); err != nil {
+			return &ValidationError{Name: "gift_status", err: fmt.Errorf(`generated: validator failed for field "RevealGift.gift_status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.GifterConsentedAt(); !ok {
+		return &ValidationError{Name: "gifter_consented_at", err: errors.New(`generated: missing required field "RevealGift.gifter_consented_at"`)}
+	}
+	if v, ok := _c.mutation.RevealID(); ok {
+		if err := revealgift.RevealIDValidator(v); err != nil {
+			return &ValidationError{Name: "reveal_id", err: fmt.Errorf(`generated: validator failed for field "RevealGift.reveal_id": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.CreditTransactionID(); ok {
+		if err := revealgift.CreditTransactionIDValidator(v); err != nil {
+			return &ValidationError{Name: "credit_transaction_id", err: fmt.Errorf(`generated: validator failed for field "RevealGift.credit_transaction_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`generated: missing required field "RevealGift.expires_at"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "RevealGift.created_at"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := revealgift.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`generated: validator failed for field "RevealGift.id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.ConnectionIDs()) == 0 {
+		return &ValidationError{Name: "connection", err: errors.New(`generated: missing required edge "RevealGift.connection"`)}
+	}
+	if len(_c.mutation.MilestoneIDs()) == 0 {
+		return &ValidationError{Name: "milestone", err: errors.New(`generated: missing required edge "RevealGift.milestone"`)}
+	}
+	if len(_c.mutation.GifterIDs()) == 0 {
+		return &ValidationError{Name: "gifter", err: errors.New(`generated: missing required edge "RevealGift.gifter"`)}
+	}
+	if len(_c.mutation.RecipientIDs()) == 0 {
+		return &ValidationError{Name: "recipient", err: errors.New(`generated: missing required edge "RevealGift.recipient"`)}
+	}
+	return nil
+}
+
+func (_c *RevealGiftCreate) sqlSave(ctx context.Context) (*RevealGift, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected RevealGift.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *RevealGiftCreate) createSpec() (*RevealGift, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RevealGift{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(revealgift.Table, sqlgraph.NewFieldSpec(revealgift.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreditCost(); ok {
+		_spec.SetField(revealgift.FieldCreditCost, field.TypeInt, value)
+		_node.CreditCost = value
+	}
+	if value, ok := _c.mutation.GiftStatus(); ok {
+		_spec.SetField(revealgift.FieldGiftStatus, field.TypeEnum, value)
+		_node.GiftStatus = value
+	}
+	if value, ok := _c.mutation.GifterConsentedAt(); ok {
+		_spec.SetField(revealgift.FieldGifterConsentedAt, field.TypeTime, value)
+		_node.GifterConsentedAt = value
+	}
+	if value, ok := _c.mutation.RevealID(); ok {
+		_spec.SetField(revealgift.FieldRevealID, field.TypeString, value)
+		_node.RevealID = &value
+	}
+	if value, ok := _c.mutation.CreditTransactionID(); ok {
+		_spec.SetField(revealgift.FieldCreditTransactionID, field.TypeString, value)
+		_node.CreditTransactionID = &value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(revealgift.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := _c.mutation.RespondedAt(); ok {
+		_spec.SetField(revealgift.FieldRespondedAt, field.TypeTime, value)
+		_node.RespondedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(revealgift.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.ConnectionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   revealgift.ConnectionTable,
+			Columns: []string{revealgift.ConnectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(connection.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ConnectionID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.MilestoneIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   revealgift.MilestoneTable,
+			Columns: []string{revealgift.MilestoneColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(revealmilestone.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.MilestoneID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.GifterIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   revealgift.GifterTable,
+			Columns: []string{revealgift.GifterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.GifterUserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.RecipientIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   revealgift.RecipientTable,
+			Columns: []string{revealgift.RecipientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.RecipientUserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.RevealGift.Create().
+//		SetConnectionID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RevealGiftUpsert) {
+//			SetConnectionID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *RevealGiftCreate) OnConflict(opts ...sql.ConflictOption) *RevealGiftUpsertOne {
+	_c.conflict = opts
+	return &RevealGiftUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.RevealGift.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *RevealGiftCreate) OnConflictColumns(columns ...string) *RevealGiftUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &RevealGiftUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// RevealGiftUpsertOne is the builder for "upsert"-ing
+	//  one RevealGift node.
+	RevealGiftUpsertOne struct {
+		create *RevealGiftCreate
+	}
+
+	// RevealGiftUpsert is the "OnConflict" setter.
+	RevealGiftUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetGiftStatus sets the "gift_status" field.
+func (u *RevealGiftUpsert) SetGiftStatus(v revealgift.GiftStatus) *RevealGiftUpsert {
+	u.Set(revealgift.FieldGiftStatus, v)
+	return u
+}
+
+// UpdateGiftStatus sets the "gift_status" field to the value that was provided on create.
+func (u *RevealGiftUpsert) UpdateGiftStatus() *RevealGiftUpsert {
+	u.SetExcluded(revealgift.FieldGiftStatus)
+	return u
+}
+
+// SetRevealID sets the "reveal_id" field.
+func (u *RevealGiftUpsert) SetRevealID(v string) *RevealGiftUpsert {
+	u.Set(revealgift.FieldRevealID, v)
+	return u
+}
+
+// UpdateRevealID sets the "reveal_id" field to the value that was provided on create.
+func (u *RevealGiftUpsert) UpdateRevealID() *RevealGiftUpsert {
+	u.SetExcluded(revealgift.FieldRevealID)
+	return u
+}
+
+// ClearRevealID clears the value of the "reveal_id" field.
+func (u *RevealGiftUpsert) ClearRevealID() *RevealGiftUpsert {
+	u.SetNull(revealgift.FieldRevealID)
+	return u
+}
+
+// SetCreditTransactionID sets the "credit_transaction_id" field.
+func (u *RevealGiftUpsert) SetCreditTransactionID(v string) *RevealGiftUpsert {
+	u.Set(revealgift.FieldCreditTransactionID, v)
+	return u
+}
+
+// UpdateCreditTransactionID sets the "credit_transaction_id" field to the value that was provided on create.
+func (u *RevealGiftUpsert) UpdateCreditTransactionID() *RevealGiftUpsert {
+	u.SetExcluded(revealgift.FieldCreditTransactionID)
+	return u
+}
+
+// ClearCreditTransactionID clears the value of the "credit_transaction_id" field.
+func (u *RevealGiftUpsert) ClearCreditTransactionID() *RevealGiftUpsert {
+	u.SetNull(revealgift.FieldCreditTransactionID)
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *RevealGiftUpsert) SetExpiresAt(v time.Time) *RevealGiftUpsert {
+	u.Set(revealgift.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *RevealGiftUpsert) UpdateExpiresAt() *RevealGiftUpsert {
+	u.SetExcluded(revealgift.FieldExpiresAt)
+	return u
+}
+
+// SetRespondedAt sets the "responded_at" field.
+func (u *RevealGiftUpsert) SetRespondedAt(v time.Time) *RevealGiftUpsert {
+	u.Set(revealgift.FieldRespondedAt, v)
+	return u
+}
+
+// UpdateRespondedAt sets the "responded_at" field to the value that was provided on create.
+func (u *RevealGiftUpsert) UpdateRespondedAt() *RevealGiftUpsert {
+	u.SetExcluded(revealgift.FieldRespondedAt)
+	return u
+}
+
+// ClearRespondedAt clears the value of the "responded_at" field.
+func (u *RevealGiftUpsert) ClearRespondedAt() *RevealGiftUpsert {
+	u.SetNull(revealgift.FieldRespondedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.RevealGift.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(revealgift.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *RevealGiftUpsertOne) UpdateNewValues() *RevealGiftUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(revealgift.FieldID)
+		}
+		if _, exists := u.create.mutation.ConnectionID(); exists {
+			s.SetIgnore(revealgift.FieldConnectionID)
+		}
+		if _, exists := u.create.mutation.MilestoneID(); exists {
+			s.SetIgnore(revealgift.FieldMilestoneID)
+		}
+		if _, exists := u.create.mutation.GifterUserID(); exists {
+			s.SetIgnore(revealgift.FieldGifterUserID)
+		}
+		if _, exists := u.create.mutation.RecipientUserID(); exists {
+			s.SetIgnore(revealgift.FieldRecipientUserID)
+		}
+		if _, exists := u.create.mutation.CreditCost(); exists {
+			s.SetIgnore(revealgift.FieldCreditCost)
+		}
+		if _, exists := u.create.mutation.GifterConsentedAt(); exists {
+			s.SetIgnore(revealgift.FieldGifterConsentedAt)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(revealgift.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.RevealGift.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *RevealGiftUpsertOne) Ignore() *RevealGiftUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RevealGiftUpsertOne) DoNothing() *RevealGiftUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RevealGiftCreate.OnConflict
+// documentation for more info.
+func (u *RevealGiftUpsertOne) Update(set func(*RevealGiftUpsert)) *RevealGiftUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RevealGiftUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetGiftStatus sets the "gift_status" field.
+func (u *RevealGiftUpsertOne) SetGiftStatus(v revealgift.GiftStatus) *RevealGiftUpsertOne {
+	return u.Update(func(s *RevealGiftUpsert) {
+		s.SetGiftStatus(v)
+	})
+}
+
+// UpdateGiftStatus sets the "gift_status" field to the value that was provided on create.
+func (u *RevealGiftUpsertOne) UpdateGiftStatus() *RevealGiftUpsertOne {
+	return u.Update(func(s *RevealGiftUpsert) {
+		s.UpdateGiftStatus()
+	})
+}
+
+// SetRevealID sets the "reveal_id" field.
+func (u *RevealGiftUpsertOne) SetRevealID(v string) *RevealGiftUpsertOne {
+	return u.Update(func(s *RevealGiftUpsert) {
+		s.SetRevealID(v)
+	})
+}
+
+// UpdateRevealID sets the "reveal_id" field to the value that was provided on create.
+func (u *RevealGiftUpsertOne) UpdateRevealID() *RevealGiftUpsertOne {
+	return u.Update(func(s *RevealGiftUpsert) {
+		s.UpdateRevealID()
+	})
+}
+
+// ClearRevealID clears the value of the "reveal_id" field.
+func (u *RevealGiftUpsertOne) ClearRevealID() *RevealGiftUpsertOne {
+	return u.Update(func(s *RevealGiftUpsert) {
+		s.ClearRevealID()
+	})
+}
+
+// SetCreditTransactionID sets the "credit_transaction_id" field.
+func (u *RevealGiftUpsertOne) SetCreditTransactionID(v string) *RevealGiftUpsertOne {
+	return u.Update(func(s *RevealGiftUpsert) {
+		s.SetCreditTransactionID(v)
+	})
+}
+
+// UpdateCreditTransactionID sets the "credit_transaction_id" field to the value that was provided on create.
+func (u *RevealGiftUpsertOne) UpdateCreditTransactionID() *RevealGiftUpsertOne {
+	return u.Update(func(s *RevealGiftUpsert) {
+		s.UpdateCreditTransactionID()
+	})
+}
+
+// ClearCreditTransactionID clears the value of the "credit_transaction_id" field.
+func (u *RevealGiftUpsertOne) ClearCreditTransactionID() *RevealGiftUpsertOne {
+	return u.Update(func(s *RevealGiftUpsert) {
+		s.ClearCreditTransactionID()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *RevealGiftUpsertOne) SetExpiresAt(v time.Time) *RevealGiftUpsertOne {
+	return u.Update(func(s *RevealGiftUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *RevealGiftUpsertOne) UpdateExpiresAt() *RevealGiftUpsertOne {
+	return u.Update(func(s *RevealGiftUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// SetRespondedAt sets the "responded_at" field.
+func (u *RevealGiftUpsertOne) SetRespondedAt(v time.Time) *RevealGiftUpsertOne {
+	return u.Update(func(s *RevealGiftUpsert) {
+		s.SetRespondedAt(v)
+	})
+}
+
+// UpdateRespondedAt sets the "responded_at" field to the value that was provided on create.
+func (u *RevealGiftUpsertOne) UpdateRespondedAt() *RevealGiftUpsertOne {
+	return u.Update(func(s *RevealGiftUpsert) {
+		s.UpdateRespondedAt()
+	})
+}
+
+// ClearRespondedAt clears the value of the "responded_at" field.
+func (u *RevealGiftUpsertOne) ClearRespondedAt() *RevealGiftUpsertOne {
+	return u.Update(func(s *RevealGiftUpsert) {
+		s.ClearRespondedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *RevealGiftUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("generated: missing options for RevealGiftCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RevealGiftUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *RevealGiftUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("generated: RevealGiftUpsertOne.ID is not supported by MySQL driver. Use RevealGiftUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *RevealGiftUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// RevealGiftCreateBulk is the builder for creating many RevealGift entities in bulk.
+type RevealGiftCreateBulk struct {
+	config
+	err      error
+	builders []*RevealGiftCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the RevealGift entities in the database.
+func (_c *RevealGiftCreateBulk) Save(ctx context.Context) ([]*RevealGift, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*RevealGift, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RevealGiftMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *RevealGiftCreateBulk) SaveX(ctx context.Context) []*RevealGift {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RevealGiftCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RevealGiftCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.RevealGift.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RevealGiftUpsert) {
+//			SetConnectionID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *RevealGiftCreateBulk) OnConflict(opts ...sql.ConflictOption) *RevealGiftUpsertBulk {
+	_c.conflict = opts
+	return &RevealGiftUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.RevealGift.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *RevealGiftCreateBulk) OnConflictColumns(columns ...string) *RevealGiftUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &RevealGiftUpsertBulk{
+		create: _c,
+	}
+}
+
+// RevealGiftUpsertBulk is the builder for "upsert"-ing
+// a bulk of RevealGift nodes.
+type RevealGiftUpsertBulk struct {
+	create *RevealGiftCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.RevealGift.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(revealgift.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *RevealGiftUpsertBulk) UpdateNewValues() *RevealGiftUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(revealgift.FieldID)
+			}
+			if _, exists := b.mutation.ConnectionID(); exists {
+				s.SetIgnore(revealgift.FieldConnectionID)
+			}
+			if _, exists := b.mutation.MilestoneID(); exists {
+				s.SetIgnore(revealgift.FieldMilestoneID)
+			}
+			if _, exists := b.mutation.GifterUserID(); exists {
+				s.SetIgnore(revealgift.FieldGifterUserID)
+			}
+			if _, exists := b.mutation.RecipientUserID(); exists {
+				s.SetIgnore(revealgift.FieldRecipientUserID)
+			}
+			if _, exists := b.mutation.CreditCost(); exists {
+				s.SetIgnore(revealgift.FieldCreditCost)
+			}
+			if _, exists := b.mutation.GifterConsentedAt(); exists {
+				s.SetIgnore(revealgift.FieldGifterConsentedAt)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(revealgift.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.RevealGift.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *RevealGiftUpsertBulk) Ignore() *RevealGiftUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RevealGiftUpsertBulk) DoNothing() *RevealGiftUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RevealGiftCreateBulk.OnConflict
+// documentation for more info.
+func (u *RevealGiftUpsertBulk) Update(set func(*RevealGiftUpsert)) *RevealGiftUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RevealGiftUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetGiftStatus sets the "gift_status" field.
+func (u *RevealGiftUpsertBulk) SetGiftStatus(v revealgift.GiftStatus) *RevealGiftUpsertBulk {
+	return u.Update(func(s *RevealGiftUpsert) {
+		s.SetGiftStatus(v)
+	})
+}
+
+// UpdateGiftStatus sets the "gift_status" field to the value that was provided on create.
+func (u *RevealGiftUpsertBulk) UpdateGiftStatus() *RevealGiftUpsertBulk {
+	return u.Update(func(s *RevealGiftUpsert) {
+		s.UpdateGiftStatus()
+	})
+}
+
+// SetRevealID sets the "reveal_id" field.
+func (u *RevealGiftUpsertBulk) SetRevealID(v string) *RevealGiftUpsertBulk {
+	return u.Update(func(s *RevealGiftUpsert) {
+		s.SetRevealID(v)
+	})
+}
+
+// UpdateRevealID sets the "reveal_id" field to the value that was provided on create.
+func (u *RevealGiftUpsertBulk) UpdateRevealID() *RevealGiftUpsertBulk {
+	return u.Update(func(s *RevealGiftUpsert) {
+		s.UpdateRevealID()
+	})
+}
+
+// ClearRevealID clears the value of the "reveal_id" field.
+func (u *RevealGiftUpsertBulk) ClearRevealID() *RevealGiftUpsertBulk {
+	return u.Update(func(s *RevealGiftUpsert) {
+		s.ClearRevealID()
+	})
+}
+
+// SetCreditTransactionID sets the "credit_transaction_id" field.
+func (u *RevealGiftUpsertBulk) SetCreditTransactionID(v string) *RevealGiftUpsertBulk {
+	return u.Update(func(s *RevealGiftUpsert) {
+		s.SetCreditTransactionID(v)
+	})
+}
+
+// UpdateCreditTransactionID sets the "credit_transaction_id" field to the value that was provided on create.
+func (u *RevealGiftUpsertBulk) UpdateCreditTransactionID() *RevealGiftUpsertBulk {
+	return u.Update(func(s *RevealGiftUpsert) {
+		s.UpdateCreditTransactionID()
+	})
+}
+
+// ClearCreditTransactionID clears the value of the "credit_transaction_id" field.
+func (u *RevealGiftUpsertBulk) ClearCreditTransactionID() *RevealGiftUpsertBulk {
+	return u.Update(func(s *RevealGiftUpsert) {
+		s.ClearCreditTransactionID()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *RevealGiftUpsertBulk) SetExpiresAt(v time.Time) *RevealGiftUpsertBulk {
+	return u.Update(func(s *RevealGiftUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *RevealGiftUpsertBulk) UpdateExpiresAt() *RevealGiftUpsertBulk {
+	return u.Update(func(s *RevealGiftUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// SetRespondedAt sets the "responded_at" field.
+func (u *RevealGiftUpsertBulk) SetRespondedAt(v time.Time) *RevealGiftUpsertBulk {
+	return u.Update(func(s *RevealGiftUpsert) {
+		s.SetRespondedAt(v)
+	})
+}
+
+// UpdateRespondedAt sets the "responded_at" field to the value that was provided on create.
+func (u *RevealGiftUpsertBulk) UpdateRespondedAt() *RevealGiftUpsertBulk {
+	return u.Update(func(s *RevealGiftUpsert) {
+		s.UpdateRespondedAt()
+	})
+}
+
+// ClearRespondedAt clears the value of the "responded_at" field.
+func (u *RevealGiftUpsertBulk) ClearRespondedAt() *RevealGiftUpsertBulk {
+	return u.Update(func(s *RevealGiftUpsert) {
+		s.ClearRespondedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *RevealGiftUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("generated: OnConflict was set for builder %d. Set it on the RevealGiftCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("generated: missing options for RevealGiftCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RevealGiftUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
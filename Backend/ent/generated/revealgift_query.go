@@ -0,0 +1,867 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/UnoraApp/be/ent/generated/connection"
+	"github.com/UnoraApp/be/ent/generated/predicate"
+	"github.com/UnoraApp/be/ent/generated/revealgift"
+	"github.com/UnoraApp/be/ent/generated/revealmilestone"
+	"github.com/UnoraApp/be/ent/generated/user"
+)
+
+// RevealGiftQuery is the builder for querying RevealGift entities.
+type RevealGiftQuery struct {
+	config
+	ctx            *QueryContext
+	order          []revealgift.OrderOption
+	inters         []Interceptor
+	predicates     []predicate.RevealGift
+	withConnection *ConnectionQuery
+	withMilestone  *RevealMilestoneQuery
+	withGifter     *UserQuery
+	withRecipient  *UserQuery
+	modifiers      []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the RevealGiftQuery builder.
+func (_q *RevealGiftQuery) Where(ps ...predicate.RevealGift) *RevealGiftQuery {
+	_q.predicates = append(_q.predicates, ps...)
+	return _q
+}
+
+// Limit the number of records to be returned by this query.
+func (_q *RevealGiftQuery) Limit(limit int) *RevealGiftQuery {
+	_q.ctx.Limit = &limit
+	return _q
+}
+
+// Offset to start from.
+func (_q *RevealGiftQuery) Offset(offset int) *RevealGiftQuery {
+	_q.ctx.Offset = &offset
+	return _q
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (_q *RevealGiftQuery) Unique(unique bool) *RevealGiftQuery {
+	_q.ctx.Unique = &unique
+	return _q
+}
+
+// Order specifies how the records should be ordered.
+func (_q *RevealGiftQuery) Order(o ...revealgift.OrderOption) *RevealGiftQuery {
+	_q.order = append(_q.order, o...)
+	return _q
+}
+
+// QueryConnection chains the current query on the "connection" edge.
+func (_q *RevealGiftQuery) QueryConnection() *ConnectionQuery {
+	query := (&ConnectionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(revealgift.Table, revealgift.FieldID, selector),
+			sqlgraph.To(connection.Table, connection.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, revealgift.ConnectionTable, revealgift.ConnectionColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryMilestone chains the current query on the "milestone" edge.
+func (_q *RevealGiftQuery) QueryMilestone() *RevealMilestoneQuery {
+	query := (&RevealMilestoneClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(revealgift.Table, revealgift.FieldID, selector),
+			sqlgraph.To(revealmilestone.Table, revealmilestone.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, revealgift.MilestoneTable, revealgift.MilestoneColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryGifter chains the current query on the "gifter" edge.
+func (_q *RevealGiftQuery) QueryGifter() *UserQuery {
+	query := (&UserClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(revealgift.Table, revealgift.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, revealgift.GifterTable, revealgift.GifterColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRecipient chains the current query on the "recipient" edge.
+func (_q *RevealGiftQuery) QueryRecipient() *UserQuery {
+	query := (&UserClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(revealgift.Table, revealgift.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, revealgift.RecipientTable, revealgift.RecipientColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first RevealGift entity from the query.
+// Returns a *NotFoundError when no RevealGift was found.
+func (_q *RevealGiftQuery) First(ctx context.Context) (*RevealGift, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{revealgift.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (_q *RevealGiftQuery) FirstX(ctx context.Context) *RevealGift {
+	node, err := _q.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first RevealGift ID from the query.
+// Returns a *NotFoundError when no RevealGift ID was found.
+func (_q *RevealGiftQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = _q.Limit(1).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{revealgift.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (_q *RevealGiftQuery) FirstIDX(ctx context.Context) string {
+	id, err := _q.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single RevealGift entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one RevealGift entity is found.
+// Returns a *NotFoundError when no RevealGift entities are found.
+func (_q *RevealGiftQuery) Only(ctx context.Context) (*RevealGift, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{revealgift.Label}
+	default:
+		return nil, &NotSingularError{revealgift.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (_q *RevealGiftQuery) OnlyX(ctx context.Context) *RevealGift {
+	node, err := _q.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only RevealGift ID in the query.
+// Returns a *NotSingularError when more than one RevealGift ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (_q *RevealGiftQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = _q.Limit(2).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{revealgift.Label}
+	default:
+		err = &NotSingularError{revealgift.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (_q *RevealGiftQuery) OnlyIDX(ctx context.Context) string {
+	id, err := _q.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of RevealGifts.
+func (_q *RevealGiftQuery) All(ctx context.Context) ([]*RevealGift, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*RevealGift, *RevealGiftQuery]()
+	return withInterceptors[[]*RevealGift](ctx, _q, qr, _q.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (_q *RevealGiftQuery) AllX(ctx context.Context) []*RevealGift {
+	nodes, err := _q.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of RevealGift IDs.
+func (_q *RevealGiftQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if _q.ctx.Unique == nil && _q.path != nil {
+		_q.Unique(true)
+	}
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
+	if err = _q.Select(revealgift.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (_q *RevealGiftQuery) IDsX(ctx context.Context) []string {
+	ids, err := _q.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (_q *RevealGiftQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, _q, querierCount[*RevealGiftQuery](), _q.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (_q *RevealGiftQuery) CountX(ctx context.Context) int {
+	count, err := _q.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (_q *RevealGiftQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
+	switch _, err := _q.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("generated: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (_q *RevealGiftQuery) ExistX(ctx context.Context) bool {
+	exist, err := _q.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the RevealGiftQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (_q *RevealGiftQuery) Clone() *RevealGiftQuery {
+	if _q == nil {
+		return nil
+	}
+	return &RevealGiftQuery{
+		config:         _q.config,
+		ctx:            _q.ctx.Clone(),
+		order:          append([]revealgift.OrderOption{}, _q.order...),
+		inters:         append([]Interceptor{}, _q.inters...),
+		predicates:     append([]predicate.RevealGift{}, _q.predicates...),
+		withConnection: _q.withConnection.Clone(),
+		withMilestone:  _q.withMilestone.Clone(),
+		withGifter:     _q.withGifter.Clone(),
+		withRecipient:  _q.withRecipient.Clone(),
+		// clone intermediate query.
+		sql:  _q.sql.Clone(),
+		path: _q.path,
+	}
+}
+
+// WithConnection tells the query-builder to eager-load the nodes that are connected to
+// the "connection" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *RevealGiftQuery) WithConnection(opts ...func(*ConnectionQuery)) *RevealGiftQuery {
+	query := (&ConnectionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withConnection = query
+	return _q
+}
+
+// WithMilestone tells the query-builder to eager-load the nodes that are connected to
+// the "milestone" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *RevealGiftQuery) WithMilestone(opts ...func(*RevealMilestoneQuery)) *RevealGiftQuery {
+	query := (&RevealMilestoneClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withMilestone = query
+	return _q
+}
+
+// WithGifter tells the query-builder to eager-load the nodes that are connected to
+// the "gifter" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *RevealGiftQuery) WithGifter(opts ...func(*UserQuery)) *RevealGiftQuery {
+	query := (&UserClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withGifter = query
+	return _q
+}
+
+// WithRecipient tells the query-builder to eager-load the nodes that are connected to
+// the "recipient" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *RevealGiftQuery) WithRecipient(opts ...func(*UserQuery)) *RevealGiftQuery {
+	query := (&UserClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withRecipient = query
+	return _q
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		ConnectionID string `json:"connection_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.RevealGift.Query().
+//		GroupBy(revealgift.FieldConnectionID).
+//		Aggregate(generated.Count()).
+//		Scan(ctx, &v)
+func (_q *RevealGiftQuery) GroupBy(field string, fields ...string) *RevealGiftGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &RevealGiftGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
+	grbuild.label = revealgift.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		ConnectionID string `json:"connection_id,omitempty"`
+//	}
+//
+//	client.RevealGift.Query().
+//		Select(revealgift.FieldConnectionID).
+//		Scan(ctx, &v)
+func (_q *RevealGiftQuery) Select(fields ...string) *RevealGiftSelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &RevealGiftSelect{RevealGiftQuery: _q}
+	sbuild.label = revealgift.Label
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a RevealGiftSelect configured with the given aggregations.
+func (_q *RevealGiftQuery) Aggregate(fns ...AggregateFunc) *RevealGiftSelect {
+	return _q.Select().Aggregate(fns...)
+}
+
+func (_q *RevealGiftQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
+		if inter == nil {
+			return fmt.Errorf("generated: uninitialized interceptor (forgotten import generated/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, _q); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range _q.ctx.Fields {
+		if !revealgift.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+		}
+	}
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
+		if err != nil {
+			return err
+		}
+		_q.sql = prev
+	}
+	return nil
+}
+
+func (_q *RevealGiftQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*RevealGift, error) {
+	var (
+		nodes       = []*RevealGift{}
+		_spec       = _q.querySpec()
+		loadedTypes = [4]bool{
+			_q.withConnection != nil,
+			_q.withMilestone != nil,
+			_q.withGifter != nil,
+			_q.withRecipient != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*RevealGift).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &RevealGift{config: _q.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := _q.withConnection; query != nil {
+		if err := _q.loadConnection(ctx, query, nodes, nil,
+			func(n *RevealGift, e *Connection) { n.Edges.Connection = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withMilestone; query != nil {
+		if err := _q.loadMilestone(ctx, query, nodes, nil,
+			func(n *RevealGift, e *RevealMilestone) { n.Edges.Milestone = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withGifter; query != nil {
+		if err := _q.loadGifter(ctx, query, nodes, nil,
+			func(n *RevealGift, e *User) { n.Edges.Gifter = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withRecipient; query != nil {
+		if err := _q.loadRecipient(ctx, query, nodes, nil,
+			func(n *RevealGift, e *User) { n.Edges.Recipient = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (_q *RevealGiftQuery) loadConnection(ctx context.Context, query *ConnectionQuery, nodes []*RevealGift, init func(*RevealGift), assign func(*RevealGift, *Connection)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*RevealGift)
+	for i := range nodes {
+		fk := nodes[i].ConnectionID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(connection.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "connection_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *RevealGiftQuery) loadMilestone(ctx context.Context, query *RevealMilestoneQuery, nodes []*RevealGift, init func(*RevealGift), assign func(*RevealGift, *RevealMilestone)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*RevealGift)
+	for i := range nodes {
+		fk := nodes[i].MilestoneID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(revealmilestone.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "milestone_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *RevealGiftQuery) loadGifter(ctx context.Context, query *UserQuery, nodes []*RevealGift, init func(*RevealGift), assign func(*RevealGift, *User)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*RevealGift)
+	for i := range nodes {
+		fk := nodes[i].GifterUserID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "gifter_user_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *RevealGiftQuery) loadRecipient(ctx context.Context, query *UserQuery, nodes []*RevealGift, init func(*RevealGift), assign func(*RevealGift, *User)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*RevealGift)
+	for i := range nodes {
+		fk := nodes[i].RecipientUserID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "recipient_user_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (_q *RevealGiftQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
+	_spec.Node.Columns = _q.ctx.Fields
+	if len(_q.ctx.Fields) > 0 {
+		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, _q.driver, _spec)
+}
+
+func (_q *RevealGiftQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(revealgift.Table, revealgift.Columns, sqlgraph.NewFieldSpec(revealgift.FieldID, field.TypeString))
+	_spec.From = _q.sql
+	if unique := _q.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if _q.path != nil {
+		_spec.Unique = true
+	}
+	if fields := _q.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, revealgift.FieldID)
+		for i := range fields {
+			if fields[i] != revealgift.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if _q.withConnection != nil {
+			_spec.Node.AddColumnOnce(revealgift.FieldConnectionID)
+		}
+		if _q.withMilestone != nil {
+			_spec.Node.AddColumnOnce(revealgift.FieldMilestoneID)
+		}
+		if _q.withGifter != nil {
+			_spec.Node.AddColumnOnce(revealgift.FieldGifterUserID)
+		}
+		if _q.withRecipient != nil {
+			_spec.Node.AddColumnOnce(revealgift.FieldRecipientUserID)
+		}
+	}
+	if ps := _q.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := _q.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (_q *RevealGiftQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(_q.driver.Dialect())
+	t1 := builder.Table(revealgift.Table)
+	columns := _q.ctx.Fields
+	if len(columns) == 0 {
+		columns = revealgift.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if _q.sql != nil {
+		selector = _q.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if _q.ctx.Unique != nil && *_q.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
+	for _, p := range _q.predicates {
+		p(selector)
+	}
+	for _, p := range _q.order {
+		p(selector)
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (_q *RevealGiftQuery) ForUpdate(opts ...sql.LockOption) *RevealGiftQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return _q
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (_q *RevealGiftQuery) ForShare(opts ...sql.LockOption) *RevealGiftQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return _q
+}
+
+// RevealGiftGroupBy is the group-by builder for RevealGift entities.
+type RevealGiftGroupBy struct {
+	selector
+	build *RevealGiftQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (_g *RevealGiftGroupBy) Aggregate(fns ...AggregateFunc) *RevealGiftGroupBy {
+	_g.fns = append(_g.fns, fns...)
+	return _g
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_g *RevealGiftGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _g.build.ctx, ent.OpQueryGroupBy)
+	if err := _g.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*RevealGiftQuery, *RevealGiftGroupBy](ctx, _g.build, _g, _g.build.inters, v)
+}
+
+func (_g *RevealGiftGroupBy) sqlScan(ctx context.Context, root *RevealGiftQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(_g.fns))
+	for _, fn := range _g.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*_g.flds)+len(_g.fns))
+		for _, f := range *_g.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// RevealGiftSelect is the builder for selecting fields of RevealGift entities.
+type RevealGiftSelect struct {
+	*RevealGiftQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (_s *RevealGiftSelect) Aggregate(fns ...AggregateFunc) *RevealGiftSelect {
+	_s.fns = append(_s.fns, fns...)
+	return _s
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_s *RevealGiftSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _s.ctx, ent.OpQuerySelect)
+	if err := _s.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*RevealGiftQuery, *RevealGiftSelect](ctx, _s.RevealGiftQuery, _s, _s.inters, v)
+}
+
+func (_s *RevealGiftSelect) sqlScan(ctx context.Context, root *RevealGiftQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(_s.fns))
+	for _, fn := range _s.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*_s.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
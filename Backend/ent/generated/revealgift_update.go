@@ -0,0 +1,470 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/UnoraApp/be/ent/generated/predicate"
+	"github.com/UnoraApp/be/ent/generated/revealgift"
+)
+
+// RevealGiftUpdate is the builder for updating RevealGift entities.
+type RevealGiftUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RevealGiftMutation
+}
+
+// Where appends a list predicates to the RevealGiftUpdate builder.
+func (_u *RevealGiftUpdate) Where(ps ...predicate.RevealGift) *RevealGiftUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetGiftStatus sets the "gift_status" field.
+func (_u *RevealGiftUpdate) SetGiftStatus(v revealgift.GiftStatus) *RevealGiftUpdate {
+	_u.mutation.SetGiftStatus(v)
+	return _u
+}
+
+// SetNillableGiftStatus sets the "gift_status" field if the given value is not nil.
+func (_u *RevealGiftUpdate) SetNillableGiftStatus(v *revealgift.GiftStatus) *RevealGiftUpdate {
+	if v != nil {
+		_u.SetGiftStatus(*v)
+	}
+	return _u
+}
+
+// SetRevealID sets the "reveal_id" field.
+func (_u *RevealGiftUpdate) SetRevealID(v string) *RevealGiftUpdate {
+	_u.mutation.SetRevealID(v)
+	return _u
+}
+
+// SetNillableRevealID sets the "reveal_id" field if the given value is not nil.
+func (_u *RevealGiftUpdate) SetNillableRevealID(v *string) *RevealGiftUpdate {
+	if v != nil {
+		_u.SetRevealID(*v)
+	}
+	return _u
+}
+
+// ClearRevealID clears the value of the "reveal_id" field.
+func (_u *RevealGiftUpdate) ClearRevealID() *RevealGiftUpdate {
+	_u.mutation.ClearRevealID()
+	return _u
+}
+
+// SetCreditTransactionID sets the "credit_transaction_id" field.
+func (_u *RevealGiftUpdate) SetCreditTransactionID(v string) *RevealGiftUpdate {
+	_u.mutation.SetCreditTransactionID(v)
+	return _u
+}
+
+// SetNillableCreditTransactionID sets the "credit_transaction_id" field if the given value is not nil.
+func (_u *RevealGiftUpdate) SetNillableCreditTransactionID(v *string) *RevealGiftUpdate {
+	if v != nil {
+		_u.SetCreditTransactionID(*v)
+	}
+	return _u
+}
+
+// ClearCreditTransactionID clears the value of the "credit_transaction_id" field.
+func (_u *RevealGiftUpdate) ClearCreditTransactionID() *RevealGiftUpdate {
+	_u.mutation.ClearCreditTransactionID()
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *RevealGiftUpdate) SetExpiresAt(v time.Time) *RevealGiftUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *RevealGiftUpdate) SetNillableExpiresAt(v *time.Time) *RevealGiftUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// SetRespondedAt sets the "responded_at" field.
+func (_u *RevealGiftUpdate) SetRespondedAt(v time.Time) *RevealGiftUpdate {
+	_u.mutation.SetRespondedAt(v)
+	return _u
+}
+
+// SetNillableRespondedAt sets the "responded_at" field if the given value is not nil.
+func (_u *RevealGiftUpdate) SetNillableRespondedAt(v *time.Time) *RevealGiftUpdate {
+	if v != nil {
+		_u.SetRespondedAt(*v)
+	}
+	return _u
+}
+
+// ClearRespondedAt clears the value of the "responded_at" field.
+func (_u *RevealGiftUpdate) ClearRespondedAt() *RevealGiftUpdate {
+	_u.mutation.ClearRespondedAt()
+	return _u
+}
+
+// Mutation returns the RevealGiftMutation object of the builder.
+func (_u *RevealGiftUpdate) Mutation() *RevealGiftMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *RevealGiftUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RevealGiftUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *RevealGiftUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RevealGiftUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RevealGiftUpdate) check() error {
+	if v, ok := _u.mutation.GiftStatus(); ok {
+		if err := revealgift.GiftStatusValidator(v); err != nil {
+			return &ValidationError{Name: "gift_status", err: fmt.Errorf(`generated: validator failed for field "RevealGift.gift_status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RevealID(); ok {
+		if err := revealgift.RevealIDValidator(v); err != nil {
+			return &ValidationError{Name: "reveal_id", err: fmt.Errorf(`generated: validator failed for field "RevealGift.reveal_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CreditTransactionID(); ok {
+		if err := revealgift.CreditTransactionIDValidator(v); err != nil {
+			return &ValidationError{Name: "credit_transaction_id", err: fmt.Errorf(`generated: validator failed for field "RevealGift.credit_transaction_id": %w`, err)}
+		}
+	}
+	if _u.mutation.ConnectionCleared() && len(_u.mutation.ConnectionIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "RevealGift.connection"`)
+	}
+	if _u.mutation.MilestoneCleared() && len(_u.mutation.MilestoneIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "RevealGift.milestone"`)
+	}
+	if _u.mutation.GifterCleared() && len(_u.mutation.GifterIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "RevealGift.gifter"`)
+	}
+	if _u.mutation.RecipientCleared() && len(_u.mutation.RecipientIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "RevealGift.recipient"`)
+	}
+	return nil
+}
+
+func (_u *RevealGiftUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(revealgift.Table, revealgift.Columns, sqlgraph.NewFieldSpec(revealgift.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.GiftStatus(); ok {
+		_spec.SetField(revealgift.FieldGiftStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.RevealID(); ok {
+		_spec.SetField(revealgift.FieldRevealID, field.TypeString, value)
+	}
+	if _u.mutation.RevealIDCleared() {
+		_spec.ClearField(revealgift.FieldRevealID, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreditTransactionID(); ok {
+		_spec.SetField(revealgift.FieldCreditTransactionID, field.TypeString, value)
+	}
+	if _u.mutation.CreditTransactionIDCleared() {
+		_spec.ClearField(revealgift.FieldCreditTransactionID, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(revealgift.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.RespondedAt(); ok {
+		_spec.SetField(revealgift.FieldRespondedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RespondedAtCleared() {
+		_spec.ClearField(revealgift.FieldRespondedAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{revealgift.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// RevealGiftUpdateOne is the builder for updating a single RevealGift entity.
+type RevealGiftUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RevealGiftMutation
+}
+
+// SetGiftStatus sets the "gift_status" field.
+func (_u *RevealGiftUpdateOne) SetGiftStatus(v revealgift.GiftStatus) *RevealGiftUpdateOne {
+	_u.mutation.SetGiftStatus(v)
+	return _u
+}
+
+// SetNillableGiftStatus sets the "gift_status" field if the given value is not nil.
+func (_u *RevealGiftUpdateOne) SetNillableGiftStatus(v *revealgift.GiftStatus) *RevealGiftUpdateOne {
+	if v != nil {
+		_u.SetGiftStatus(*v)
+	}
+	return _u
+}
+
+// SetRevealID sets the "reveal_id" field.
+func (_u *RevealGiftUpdateOne) SetRevealID(v string) *RevealGiftUpdateOne {
+	_u.mutation.SetRevealID(v)
+	return _u
+}
+
+// SetNillableRevealID sets the "reveal_id" field if the given value is not nil.
+func (_u *RevealGiftUpdateOne) SetNillableRevealID(v *string) *RevealGiftUpdateOne {
+	if v != nil {
+		_u.SetRevealID(*v)
+	}
+	return _u
+}
+
+// ClearRevealID clears the value of the "reveal_id" field.
+func (_u *RevealGiftUpdateOne) ClearRevealID() *RevealGiftUpdateOne {
+	_u.mutation.ClearRevealID()
+	return _u
+}
+
+// SetCreditTransactionID sets the "credit_transaction_id" field.
+func (_u *RevealGiftUpdateOne) SetCreditTransactionID(v string) *RevealGiftUpdateOne {
+	_u.mutation.SetCreditTransactionID(v)
+	return _u
+}
+
+// SetNillableCreditTransactionID sets the "credit_transaction_id" field if the given value is not nil.
+func (_u *RevealGiftUpdateOne) SetNillableCreditTransactionID(v *string) *RevealGiftUpdateOne {
+	if v != nil {
+		_u.SetCreditTransactionID(*v)
+	}
+	return _u
+}
+
+// ClearCreditTransactionID clears the value of the "credit_transaction_id" field.
+func (_u *RevealGiftUpdateOne) ClearCreditTransactionID() *RevealGiftUpdateOne {
+	_u.mutation.ClearCreditTransactionID()
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *RevealGiftUpdateOne) SetExpiresAt(v time.Time) *RevealGiftUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *RevealGiftUpdateOne) SetNillableExpiresAt(v *time.Time) *RevealGiftUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// SetRespondedAt sets the "responded_at" field.
+func (_u *RevealGiftUpdateOne) SetRespondedAt(v time.Time) *RevealGiftUpdateOne {
+	_u.mutation.SetRespondedAt(v)
+	return _u
+}
+
+// SetNillableRespondedAt sets the "responded_at" field if the given value is not nil.
+func (_u *RevealGiftUpdateOne) SetNillableRespondedAt(v *time.Time) *RevealGiftUpdateOne {
+	if v != nil {
+		_u.SetRespondedAt(*v)
+	}
+	return _u
+}
+
+// ClearRespondedAt clears the value of the "responded_at" field.
+func (_u *RevealGiftUpdateOne) ClearRespondedAt() *RevealGiftUpdateOne {
+	_u.mutation.ClearRespondedAt()
+	return _u
+}
+
+// Mutation returns the RevealGiftMutation object of the builder.
+func (_u *RevealGiftUpdateOne) Mutation() *RevealGiftMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the RevealGiftUpdate builder.
+func (_u *RevealGiftUpdateOne) Where(ps ...predicate.RevealGift) *RevealGiftUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *RevealGiftUpdateOne) Select(field string, fields ...string) *RevealGiftUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated RevealGift entity.
+func (_u *RevealGiftUpdateOne) Save(ctx context.Context) (*RevealGift, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RevealGiftUpdateOne) SaveX(ctx context.Context) *RevealGift {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *RevealGiftUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RevealGiftUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RevealGiftUpdateOne) check() error {
+	if v, ok := _u.mutation.GiftStatus(); ok {
+		if err := revealgift.GiftStatusValidator(v); err != nil {
+			return &ValidationError{Name: "gift_status", err: fmt.Errorf(`generated: validator failed for field "RevealGift.gift_status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RevealID(); ok {
+		if err := revealgift.RevealIDValidator(v); err != nil {
+			return &ValidationError{Name: "reveal_id", err: fmt.Errorf(`generated: validator failed for field "RevealGift.reveal_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CreditTransactionID(); ok {
+		if err := revealgift.CreditTransactionIDValidator(v); err != nil {
+			return &ValidationError{Name: "credit_transaction_id", err: fmt.Errorf(`generated: validator failed for field "RevealGift.credit_transaction_id": %w`, err)}
+		}
+	}
+	if _u.mutation.ConnectionCleared() && len(_u.mutation.ConnectionIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "RevealGift.connection"`)
+	}
+	if _u.mutation.MilestoneCleared() && len(_u.mutation.MilestoneIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "RevealGift.milestone"`)
+	}
+	if _u.mutation.GifterCleared() && len(_u.mutation.GifterIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "RevealGift.gifter"`)
+	}
+	if _u.mutation.RecipientCleared() && len(_u.mutation.RecipientIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "RevealGift.recipient"`)
+	}
+	return nil
+}
+
+func (_u *RevealGiftUpdateOne) sqlSave(ctx context.Context) (_node *RevealGift, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(revealgift.Table, revealgift.Columns, sqlgraph.NewFieldSpec(revealgift.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "RevealGift.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, revealgift.FieldID)
+		for _, f := range fields {
+			if !revealgift.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != revealgift.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.GiftStatus(); ok {
+		_spec.SetField(revealgift.FieldGiftStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.RevealID(); ok {
+		_spec.SetField(revealgift.FieldRevealID, field.TypeString, value)
+	}
+	if _u.mutation.RevealIDCleared() {
+		_spec.ClearField(revealgift.FieldRevealID, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreditTransactionID(); ok {
+		_spec.SetField(revealgift.FieldCreditTransactionID, field.TypeString, value)
+	}
+	if _u.mutation.CreditTransactionIDCleared() {
+		_spec.ClearField(revealgift.FieldCreditTransactionID, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(revealgift.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.RespondedAt(); ok {
+		_spec.SetField(revealgift.FieldRespondedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RespondedAtCleared() {
+		_spec.ClearField(revealgift.FieldRespondedAt, field.TypeTime)
+	}
+	_node = &RevealGift{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{revealgift.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -42,9 +42,11 @@ type RevealMilestone struct {
 type RevealMilestoneEdges struct {
 	// Reveals holds the value of the reveals edge.
 	Reveals []*Reveal `json:"reveals,omitempty"`
+	// Gifts holds the value of the gifts edge.
+	Gifts []*RevealGift `json:"gifts,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [2]bool
 }
 
 // RevealsOrErr returns the Reveals value or an error if the edge
@@ -56,6 +58,15 @@ func (e RevealMilestoneEdges) RevealsOrErr() ([]*Reveal, error) {
 	return nil, &NotLoadedError{edge: "reveals"}
 }
 
+// GiftsOrErr returns the Gifts value or an error if the edge
+// was not loaded in eager-loading.
+func (e RevealMilestoneEdges) GiftsOrErr() ([]*RevealGift, error) {
+	if e.loadedTypes[1] {
+		return e.Gifts, nil
+	}
+	return nil, &NotLoadedError{edge: "gifts"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*RevealMilestone) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -156,6 +167,11 @@ func (_m *RevealMilestone) QueryReveals() *RevealQuery {
 	return NewRevealMilestoneClient(_m.config).QueryReveals(_m)
 }
 
+// QueryGifts queries the "gifts" edge of the RevealMilestone entity.
+func (_m *RevealMilestone) QueryGifts() *RevealGiftQuery {
+	return NewRevealMilestoneClient(_m.config).QueryGifts(_m)
+}
+
 // Update returns a builder for updating this RevealMilestone.
 // Note that you need to call RevealMilestone.Unwrap() before calling this method if this RevealMilestone
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -32,6 +32,8 @@ const (
 	FieldIsActive = "is_active"
 	// EdgeReveals holds the string denoting the reveals edge name in mutations.
 	EdgeReveals = "reveals"
+	// EdgeGifts holds the string denoting the gifts edge name in mutations.
+	EdgeGifts = "gifts"
 	// Table holds the table name of the revealmilestone in the database.
 	Table = "reveal_milestones"
 	// RevealsTable is the table that holds the reveals relation/edge.
@@ -41,6 +43,13 @@ const (
 	RevealsInverseTable = "reveals"
 	// RevealsColumn is the table column denoting the reveals relation/edge.
 	RevealsColumn = "milestone_id"
+	// GiftsTable is the table that holds the gifts relation/edge.
+	GiftsTable = "reveal_gifts"
+	// GiftsInverseTable is the table name for the RevealGift entity.
+	// It exists in this package in order to avoid circular dependency with the "revealgift" package.
+	GiftsInverseTable = "reveal_gifts"
+	// GiftsColumn is the table column denoting the gifts relation/edge.
+	GiftsColumn = "milestone_id"
 )
 
 // Columns holds all SQL columns for revealmilestone fields.
@@ -168,6 +177,20 @@ func ByReveals(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newRevealsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByGiftsCount orders the results by gifts count.
+func ByGiftsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newGiftsStep(), opts...)
+	}
+}
+
+// ByGifts orders the results by gifts terms.
+func ByGifts(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newGiftsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newRevealsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -175,3 +198,10 @@ func newRevealsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, RevealsTable, RevealsColumn),
 	)
 }
+func newGiftsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(GiftsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, GiftsTable, GiftsColumn),
+	)
+}
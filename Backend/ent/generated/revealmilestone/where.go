@@ -486,6 +486,29 @@ func HasRevealsWith(preds ...predicate.Reveal) predicate.RevealMilestone {
 	})
 }
 
+// HasGifts applies the HasEdge predicate on the "gifts" edge.
+func HasGifts() predicate.RevealMilestone {
+	return predicate.RevealMilestone(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, GiftsTable, GiftsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasGiftsWith applies the HasEdge predicate on the "gifts" edge with a given conditions (other predicates).
+func HasGiftsWith(preds ...predicate.RevealGift) predicate.RevealMilestone {
+	return predicate.RevealMilestone(func(s *sql.Selector) {
+		step := newGiftsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.RevealMilestone) predicate.RevealMilestone {
 	return predicate.RevealMilestone(sql.AndPredicates(predicates...))
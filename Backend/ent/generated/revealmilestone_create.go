@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/UnoraApp/be/ent/generated/reveal"
+	"github.com/UnoraApp/be/ent/generated/revealgift"
 	"github.com/UnoraApp/be/ent/generated/revealmilestone"
 )
 
@@ -124,6 +125,21 @@ func (_c *RevealMilestoneCreate) AddReveals(v ...*Reveal) *RevealMilestoneCreate
 	return _c.AddRevealIDs(ids...)
 }
 
+// AddGiftIDs adds the "gifts" edge to the RevealGift entity by IDs.
+func (_c *RevealMilestoneCreate) AddGiftIDs(ids ...string) *RevealMilestoneCreate {
+	_c.mutation.AddGiftIDs(ids...)
+	return _c
+}
+
+// AddGifts adds the "gifts" edges to the RevealGift entity.
+func (_c *RevealMilestoneCreate) AddGifts(v ...*RevealGift) *RevealMilestoneCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddGiftIDs(ids...)
+}
+
 // Mutation returns the RevealMilestoneMutation object of the builder.
 func (_c *RevealMilestoneCreate) Mutation() *RevealMilestoneMutation {
 	return _c.mutation
@@ -303,6 +319,22 @@ func (_c *RevealMilestoneCreate) createSpec() (*RevealMilestone, *sqlgraph.Creat
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.GiftsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   revealmilestone.GiftsTable,
+			Columns: []string{revealmilestone.GiftsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(revealgift.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -9,11 +9,13 @@ import (
 	"math"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/UnoraApp/be/ent/generated/predicate"
 	"github.com/UnoraApp/be/ent/generated/reveal"
+	"github.com/UnoraApp/be/ent/generated/revealgift"
 	"github.com/UnoraApp/be/ent/generated/revealmilestone"
 )
 
@@ -25,6 +27,8 @@ type RevealMilestoneQuery struct {
 	inters      []Interceptor
 	predicates  []predicate.RevealMilestone
 	withReveals *RevealQuery
+	withGifts   *RevealGiftQuery
+	modifiers   []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -83,6 +87,28 @@ func (_q *RevealMilestoneQuery) QueryReveals() *RevealQuery {
 	return query
 }
 
+// QueryGifts chains the current query on the "gifts" edge.
+func (_q *RevealMilestoneQuery) QueryGifts() *RevealGiftQuery {
+	query := (&RevealGiftClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(revealmilestone.Table, revealmilestone.FieldID, selector),
+			sqlgraph.To(revealgift.Table, revealgift.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, revealmilestone.GiftsTable, revealmilestone.GiftsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first RevealMilestone entity from the query.
 // Returns a *NotFoundError when no RevealMilestone was found.
 func (_q *RevealMilestoneQuery) First(ctx context.Context) (*RevealMilestone, error) {
@@ -276,6 +302,7 @@ func (_q *RevealMilestoneQuery) Clone() *RevealMilestoneQuery {
 		inters:      append([]Interceptor{}, _q.inters...),
 		predicates:  append([]predicate.RevealMilestone{}, _q.predicates...),
 		withReveals: _q.withReveals.Clone(),
+		withGifts:   _q.withGifts.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -293,6 +320,17 @@ func (_q *RevealMilestoneQuery) WithReveals(opts ...func(*RevealQuery)) *RevealM
 	return _q
 }
 
+// WithGifts tells the query-builder to eager-load the nodes that are connected to
+// the "gifts" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *RevealMilestoneQuery) WithGifts(opts ...func(*RevealGiftQuery)) *RevealMilestoneQuery {
+	query := (&RevealGiftClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withGifts = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -371,8 +409,9 @@ func (_q *RevealMilestoneQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 	var (
 		nodes       = []*RevealMilestone{}
 		_spec       = _q.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [2]bool{
 			_q.withReveals != nil,
+			_q.withGifts != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -384,6 +423,9 @@ func (_q *RevealMilestoneQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -400,6 +442,13 @@ func (_q *RevealMilestoneQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 			return nil, err
 		}
 	}
+	if query := _q.withGifts; query != nil {
+		if err := _q.loadGifts(ctx, query, nodes,
+			func(n *RevealMilestone) { n.Edges.Gifts = []*RevealGift{} },
+			func(n *RevealMilestone, e *RevealGift) { n.Edges.Gifts = append(n.Edges.Gifts, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -433,9 +482,42 @@ func (_q *RevealMilestoneQuery) loadReveals(ctx context.Context, query *RevealQu
 	}
 	return nil
 }
+func (_q *RevealMilestoneQuery) loadGifts(ctx context.Context, query *RevealGiftQuery, nodes []*RevealMilestone, init func(*RevealMilestone), assign func(*RevealMilestone, *RevealGift)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*RevealMilestone)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(revealgift.FieldMilestoneID)
+	}
+	query.Where(predicate.RevealGift(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(revealmilestone.GiftsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.MilestoneID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "milestone_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *RevealMilestoneQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -498,6 +580,9 @@ func (_q *RevealMilestoneQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -515,6 +600,32 @@ func (_q *RevealMilestoneQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (_q *RevealMilestoneQuery) ForUpdate(opts ...sql.LockOption) *RevealMilestoneQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return _q
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (_q *RevealMilestoneQuery) ForShare(opts ...sql.LockOption) *RevealMilestoneQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return _q
+}
+
 // RevealMilestoneGroupBy is the group-by builder for RevealMilestone entities.
 type RevealMilestoneGroupBy struct {
 	selector
@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/UnoraApp/be/ent/generated/predicate"
 	"github.com/UnoraApp/be/ent/generated/reveal"
+	"github.com/UnoraApp/be/ent/generated/revealgift"
 	"github.com/UnoraApp/be/ent/generated/revealmilestone"
 )
 
@@ -188,6 +189,21 @@ func (_u *RevealMilestoneUpdate) AddReveals(v ...*Reveal) *RevealMilestoneUpdate
 	return _u.AddRevealIDs(ids...)
 }
 
+// AddGiftIDs adds the "gifts" edge to the RevealGift entity by IDs.
+func (_u *RevealMilestoneUpdate) AddGiftIDs(ids ...string) *RevealMilestoneUpdate {
+	_u.mutation.AddGiftIDs(ids...)
+	return _u
+}
+
+// AddGifts adds the "gifts" edges to the RevealGift entity.
+func (_u *RevealMilestoneUpdate) AddGifts(v ...*RevealGift) *RevealMilestoneUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddGiftIDs(ids...)
+}
+
 // Mutation returns the RevealMilestoneMutation object of the builder.
 func (_u *RevealMilestoneUpdate) Mutation() *RevealMilestoneMutation {
 	return _u.mutation
@@ -214,6 +230,27 @@ func (_u *RevealMilestoneUpdate) RemoveReveals(v ...*Reveal) *RevealMilestoneUpd
 	return _u.RemoveRevealIDs(ids...)
 }
 
+// ClearGifts clears all "gifts" edges to the RevealGift entity.
+func (_u *RevealMilestoneUpdate) ClearGifts() *RevealMilestoneUpdate {
+	_u.mutation.ClearGifts()
+	return _u
+}
+
+// RemoveGiftIDs removes the "gifts" edge to RevealGift entities by IDs.
+func (_u *RevealMilestoneUpdate) RemoveGiftIDs(ids ...string) *RevealMilestoneUpdate {
+	_u.mutation.RemoveGiftIDs(ids...)
+	return _u
+}
+
+// RemoveGifts removes "gifts" edges to RevealGift entities.
+func (_u *RevealMilestoneUpdate) RemoveGifts(v ...*RevealGift) *RevealMilestoneUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveGiftIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *RevealMilestoneUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -367,6 +404,51 @@ func (_u *RevealMilestoneUpdate) sqlSave(ctx context.Context) (_node int, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.GiftsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   revealmilestone.GiftsTable,
+			Columns: []string{revealmilestone.GiftsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(revealgift.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedGiftsIDs(); len(nodes) > 0 && !_u.mutation.GiftsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   revealmilestone.GiftsTable,
+			Columns: []string{revealmilestone.GiftsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(revealgift.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.GiftsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   revealmilestone.GiftsTable,
+			Columns: []string{revealmilestone.GiftsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(revealgift.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{revealmilestone.Label}
@@ -547,6 +629,21 @@ func (_u *RevealMilestoneUpdateOne) AddReveals(v ...*Reveal) *RevealMilestoneUpd
 	return _u.AddRevealIDs(ids...)
 }
 
+// AddGiftIDs adds the "gifts" edge to the RevealGift entity by IDs.
+func (_u *RevealMilestoneUpdateOne) AddGiftIDs(ids ...string) *RevealMilestoneUpdateOne {
+	_u.mutation.AddGiftIDs(ids...)
+	return _u
+}
+
+// AddGifts adds the "gifts" edges to the RevealGift entity.
+func (_u *RevealMilestoneUpdateOne) AddGifts(v ...*RevealGift) *RevealMilestoneUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddGiftIDs(ids...)
+}
+
 // Mutation returns the RevealMilestoneMutation object of the builder.
 func (_u *RevealMilestoneUpdateOne) Mutation() *RevealMilestoneMutation {
 	return _u.mutation
@@ -573,6 +670,27 @@ func (_u *RevealMilestoneUpdateOne) RemoveReveals(v ...*Reveal) *RevealMilestone
 	return _u.RemoveRevealIDs(ids...)
 }
 
+// ClearGifts clears all "gifts" edges to the RevealGift entity.
+func (_u *RevealMilestoneUpdateOne) ClearGifts() *RevealMilestoneUpdateOne {
+	_u.mutation.ClearGifts()
+	return _u
+}
+
+// RemoveGiftIDs removes the "gifts" edge to RevealGift entities by IDs.
+func (_u *RevealMilestoneUpdateOne) RemoveGiftIDs(ids ...string) *RevealMilestoneUpdateOne {
+	_u.mutation.RemoveGiftIDs(ids...)
+	return _u
+}
+
+// RemoveGifts removes "gifts" edges to RevealGift entities.
+func (_u *RevealMilestoneUpdateOne) RemoveGifts(v ...*RevealGift) *RevealMilestoneUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveGiftIDs(ids...)
+}
+
 // Where appends a list predicates to the RevealMilestoneUpdate builder.
 func (_u *RevealMilestoneUpdateOne) Where(ps ...predicate.RevealMilestone) *RevealMilestoneUpdateOne {
 	_u.mutation.Where(ps...)
@@ -756,6 +874,51 @@ func (_u *RevealMilestoneUpdateOne) sqlSave(ctx context.Context) (_node *RevealM
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.GiftsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   revealmilestone.GiftsTable,
+			Columns: []string{revealmilestone.GiftsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(revealgift.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedGiftsIDs(); len(nodes) > 0 && !_u.mutation.GiftsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   revealmilestone.GiftsTable,
+			Columns: []string{revealmilestone.GiftsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(revealgift.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.GiftsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   revealmilestone.GiftsTable,
+			Columns: []string{revealmilestone.GiftsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(revealgift.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &RevealMilestone{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,228 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/UnoraApp/be/ent/generated/reveal"
+	"github.com/UnoraApp/be/ent/generated/revealview"
+	"github.com/UnoraApp/be/ent/generated/user"
+)
+
+// RevealView is the model entity for the RevealView schema.
+type RevealView struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// RevealID holds the value of the "reveal_id" field.
+	RevealID string `json:"reveal_id,omitempty"`
+	// ViewerUserID holds the value of the "viewer_user_id" field.
+	ViewerUserID string `json:"viewer_user_id,omitempty"`
+	// IsFirstView holds the value of the "is_first_view" field.
+	IsFirstView bool `json:"is_first_view,omitempty"`
+	// FirstViewKey holds the value of the "first_view_key" field.
+	FirstViewKey *string `json:"first_view_key,omitempty"`
+	// DurationSeconds holds the value of the "duration_seconds" field.
+	DurationSeconds *int `json:"duration_seconds,omitempty"`
+	// ViewerTier holds the value of the "viewer_tier" field.
+	ViewerTier revealview.ViewerTier `json:"viewer_tier,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the RevealViewQuery when eager-loading is set.
+	Edges        RevealViewEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// RevealViewEdges holds the relations/edges for other nodes in the graph.
+type RevealViewEdges struct {
+	// Reveal holds the value of the reveal edge.
+	Reveal *Reveal `json:"reveal,omitempty"`
+	// Viewer holds the value of the viewer edge.
+	Viewer *User `json:"viewer,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// RevealOrErr returns the Reveal value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RevealViewEdges) RevealOrErr() (*Reveal, error) {
+	if e.Reveal != nil {
+		return e.Reveal, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: reveal.Label}
+	}
+	return nil, &NotLoadedError{edge: "reveal"}
+}
+
+// ViewerOrErr returns the Viewer value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RevealViewEdges) ViewerOrErr() (*User, error) {
+	if e.Viewer != nil {
+		return e.Viewer, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "viewer"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RevealView) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case revealview.FieldIsFirstView:
+			values[i] = new(sql.NullBool)
+		case revealview.FieldDurationSeconds:
+			values[i] = new(sql.NullInt64)
+		case revealview.FieldID, revealview.FieldRevealID, revealview.FieldViewerUserID, revealview.FieldFirstViewKey, revealview.FieldViewerTier:
+			values[i] = new(sql.NullString)
+		case revealview.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RevealView fields.
+func (_m *RevealView) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case revealview.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case revealview.FieldRevealID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reveal_id", values[i])
+			} else if value.Valid {
+				_m.RevealID = value.String
+			}
+		case revealview.FieldViewerUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field viewer_user_id", values[i])
+			} else if value.Valid {
+				_m.ViewerUserID = value.String
+			}
+		case revealview.FieldIsFirstView:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_first_view", values[i])
+			} else if value.Valid {
+				_m.IsFirstView = value.Bool
+			}
+		case revealview.FieldFirstViewKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field first_view_key", values[i])
+			} else if value.Valid {
+				_m.FirstViewKey = new(string)
+				*_m.FirstViewKey = value.String
+			}
+		case revealview.FieldDurationSeconds:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field duration_seconds", values[i])
+			} else if value.Valid {
+				_m.DurationSeconds = new(int)
+				*_m.DurationSeconds = int(value.Int64)
+			}
+		case revealview.FieldViewerTier:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field viewer_tier", values[i])
+			} else if value.Valid {
+				_m.ViewerTier = revealview.ViewerTier(value.String)
+			}
+		case revealview.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RevealView.
+// This includes values selected through modifiers, order, etc.
+func (_m *RevealView) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryReveal queries the "reveal" edge of the RevealView entity.
+func (_m *RevealView) QueryReveal() *RevealQuery {
+	return NewRevealViewClient(_m.config).QueryReveal(_m)
+}
+
+// QueryViewer queries the "viewer" edge of the RevealView entity.
+func (_m *RevealView) QueryViewer() *UserQuery {
+	return NewRevealViewClient(_m.config).QueryViewer(_m)
+}
+
+// Update returns a builder for updating this RevealView.
+// Note that you need to call RevealView.Unwrap() before calling this method if this RevealView
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *RevealView) Update() *RevealViewUpdateOne {
+	return NewRevealViewClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the RevealView entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *RevealView) Unwrap() *RevealView {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("generated: RevealView is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *RevealView) String() string {
+	var builder strings.Builder
+	builder.WriteString("RevealView(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("reveal_id=")
+	builder.WriteString(_m.RevealID)
+	builder.WriteString(", ")
+	builder.WriteString("viewer_user_id=")
+	builder.WriteString(_m.ViewerUserID)
+	builder.WriteString(", ")
+	builder.WriteString("is_first_view=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IsFirstView))
+	builder.WriteString(", ")
+	if v := _m.FirstViewKey; v != nil {
+		builder.WriteString("first_view_key=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.DurationSeconds; v != nil {
+		builder.WriteString("duration_seconds=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("viewer_tier=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ViewerTier))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RevealViews is a parsable slice of RevealView.
+type RevealViews []*RevealView
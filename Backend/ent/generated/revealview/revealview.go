@@ -0,0 +1,186 @@
+// Code generated by ent, DO NOT EDIT.
+
+package revealview
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the revealview type in the database.
+	Label = "reveal_view"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldRevealID holds the string denoting the reveal_id field in the database.
+	FieldRevealID = "reveal_id"
+	// FieldViewerUserID holds the string denoting the viewer_user_id field in the database.
+	FieldViewerUserID = "viewer_user_id"
+	// FieldIsFirstView holds the string denoting the is_first_view field in the database.
+	FieldIsFirstView = "is_first_view"
+	// FieldFirstViewKey holds the string denoting the first_view_key field in the database.
+	FieldFirstViewKey = "first_view_key"
+	// FieldDurationSeconds holds the string denoting the duration_seconds field in the database.
+	FieldDurationSeconds = "duration_seconds"
+	// FieldViewerTier holds the string denoting the viewer_tier field in the database.
+	FieldViewerTier = "viewer_tier"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeReveal holds the string denoting the reveal edge name in mutations.
+	EdgeReveal = "reveal"
+	// EdgeViewer holds the string denoting the viewer edge name in mutations.
+	EdgeViewer = "viewer"
+	// Table holds the table name of the revealview in the database.
+	Table = "reveal_views"
+	// RevealTable is the table that holds the reveal relation/edge.
+	RevealTable = "reveal_views"
+	// RevealInverseTable is the table name for the Reveal entity.
+	// It exists in this package in order to avoid circular dependency with the "reveal" package.
+	RevealInverseTable = "reveals"
+	// RevealColumn is the table column denoting the reveal relation/edge.
+	RevealColumn = "reveal_id"
+	// ViewerTable is the table that holds the viewer relation/edge.
+	ViewerTable = "reveal_views"
+	// ViewerInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	ViewerInverseTable = "users"
+	// ViewerColumn is the table column denoting the viewer relation/edge.
+	ViewerColumn = "viewer_user_id"
+)
+
+// Columns holds all SQL columns for revealview fields.
+var Columns = []string{
+	FieldID,
+	FieldRevealID,
+	FieldViewerUserID,
+	FieldIsFirstView,
+	FieldFirstViewKey,
+	FieldDurationSeconds,
+	FieldViewerTier,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// RevealIDValidator is a validator for the "reveal_id" field. It is called by the builders before save.
+	RevealIDValidator func(string) error
+	// ViewerUserIDValidator is a validator for the "viewer_user_id" field. It is called by the builders before save.
+	ViewerUserIDValidator func(string) error
+	// DefaultIsFirstView holds the default value on creation for the "is_first_view" field.
+	DefaultIsFirstView bool
+	// FirstViewKeyValidator is a validator for the "first_view_key" field. It is called by the builders before save.
+	FirstViewKeyValidator func(string) error
+	// DurationSecondsValidator is a validator for the "duration_seconds" field. It is called by the builders before save.
+	DurationSecondsValidator func(int) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
+
+// ViewerTier defines the type for the "viewer_tier" enum field.
+type ViewerTier string
+
+// ViewerTier values.
+const (
+	ViewerTierFree ViewerTier = "free"
+	ViewerTierPlus ViewerTier = "plus"
+	ViewerTierPro  ViewerTier = "pro"
+)
+
+func (vt ViewerTier) String() string {
+	return string(vt)
+}
+
+// ViewerTierValidator is a validator for the "viewer_tier" field enum values. It is called by the builders before save.
+func ViewerTierValidator(vt ViewerTier) error {
+	switch vt {
+	case ViewerTierFree, ViewerTierPlus, ViewerTierPro:
+		return nil
+	default:
+		return fmt.Errorf("revealview: invalid enum value for viewer_tier field: %q", vt)
+	}
+}
+
+// OrderOption defines the ordering options for the RevealView queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByRevealID orders the results by the reveal_id field.
+func ByRevealID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRevealID, opts...).ToFunc()
+}
+
+// ByViewerUserID orders the results by the viewer_user_id field.
+func ByViewerUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldViewerUserID, opts...).ToFunc()
+}
+
+// ByIsFirstView orders the results by the is_first_view field.
+func ByIsFirstView(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsFirstView, opts...).ToFunc()
+}
+
+// ByFirstViewKey orders the results by the first_view_key field.
+func ByFirstViewKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFirstViewKey, opts...).ToFunc()
+}
+
+// ByDurationSeconds orders the results by the duration_seconds field.
+func ByDurationSeconds(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDurationSeconds, opts...).ToFunc()
+}
+
+// ByViewerTier orders the results by the viewer_tier field.
+func ByViewerTier(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldViewerTier, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByRevealField orders the results by reveal field.
+func ByRevealField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRevealStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByViewerField orders the results by viewer field.
+func ByViewerField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newViewerStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newRevealStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RevealInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, RevealTable, RevealColumn),
+	)
+}
+func newViewerStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ViewerInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ViewerTable, ViewerColumn),
+	)
+}
@@ -0,0 +1,482 @@
+// Code generated by ent, DO NOT EDIT.
+
+package revealview
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/UnoraApp/be/ent/generated/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.RevealView {
+	return predicate.RevealView(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.RevealView {
+	return predicate.RevealView(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.RevealView {
+	return predicate.RevealView(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.RevealView {
+	return predicate.RevealView(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.RevealView {
+	return predicate.RevealView(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.RevealView {
+	return predicate.RevealView(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.RevealView {
+	return predicate.RevealView(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.RevealView {
+	return predicate.RevealView(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.RevealView {
+	return predicate.RevealView(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.RevealView {
+	return predicate.RevealView(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.RevealView {
+	return predicate.RevealView(sql.FieldContainsFold(FieldID, id))
+}
+
+// RevealID applies equality check predicate on the "reveal_id" field. It's identical to RevealIDEQ.
+func RevealID(v string) predicate.RevealView {
+	return predicate.RevealView(sql.FieldEQ(FieldRevealID, v))
+}
+
+// ViewerUserID applies equality check predicate on the "viewer_user_id" field. It's identical to ViewerUserIDEQ.
+func ViewerUserID(v string) predicate.RevealView {
+	return predicate.RevealView(sql.FieldEQ(FieldViewerUserID, v))
+}
+
+// IsFirstView applies equality check predicate on the "is_first_view" field. It's identical to IsFirstViewEQ.
+func IsFirstView(v bool) predicate.RevealView {
+	return predicate.RevealView(sql.FieldEQ(FieldIsFirstView, v))
+}
+
+// FirstViewKey applies equality check predicate on the "first_view_key" field. It's identical to FirstViewKeyEQ.
+func FirstViewKey(v string) predicate.RevealView {
+	return predicate.RevealView(sql.FieldEQ(FieldFirstViewKey, v))
+}
+
+// DurationSeconds applies equality check predicate on the "duration_seconds" field. It's identical to DurationSecondsEQ.
+func DurationSeconds(v int) predicate.RevealView {
+	return predicate.RevealView(sql.FieldEQ(FieldDurationSeconds, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.RevealView {
+	return predicate.RevealView(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// RevealIDEQ applies the EQ predicate on the "reveal_id" field.
+func RevealIDEQ(v string) predicate.RevealView {
+	return predicate.RevealView(sql.FieldEQ(FieldRevealID, v))
+}
+
+// RevealIDNEQ applies the NEQ predicate on the "reveal_id" field.
+func RevealIDNEQ(v string) predicate.RevealView {
+	return predicate.RevealView(sql.FieldNEQ(FieldRevealID, v))
+}
+
+// RevealIDIn applies the In predicate on the "reveal_id" field.
+func RevealIDIn(vs ...string) predicate.RevealView {
+	return predicate.RevealView(sql.FieldIn(FieldRevealID, vs...))
+}
+
+// RevealIDNotIn applies the NotIn predicate on the "reveal_id" field.
+func RevealIDNotIn(vs ...string) predicate.RevealView {
+	return predicate.RevealView(sql.FieldNotIn(FieldRevealID, vs...))
+}
+
+// RevealIDGT applies the GT predicate on the "reveal_id" field.
+func RevealIDGT(v string) predicate.RevealView {
+	return predicate.RevealView(sql.FieldGT(FieldRevealID, v))
+}
+
+// RevealIDGTE applies the GTE predicate on the "reveal_id" field.
+func RevealIDGTE(v string) predicate.RevealView {
+	return predicate.RevealView(sql.FieldGTE(FieldRevealID, v))
+}
+
+// RevealIDLT applies the LT predicate on the "reveal_id" field.
+func RevealIDLT(v string) predicate.RevealView {
+	return predicate.RevealView(sql.FieldLT(FieldRevealID, v))
+}
+
+// RevealIDLTE applies the LTE predicate on the "reveal_id" field.
+func RevealIDLTE(v string) predicate.RevealView {
+	return predicate.RevealView(sql.FieldLTE(FieldRevealID, v))
+}
+
+// RevealIDContains applies the Contains predicate on the "reveal_id" field.
+func RevealIDContains(v string) predicate.RevealView {
+	return predicate.RevealView(sql.FieldContains(FieldRevealID, v))
+}
+
+// RevealIDHasPrefix applies the HasPrefix predicate on the "reveal_id" field.
+func RevealIDHasPrefix(v string) predicate.RevealView {
+	return predicate.RevealView(sql.FieldHasPrefix(FieldRevealID, v))
+}
+
+// RevealIDHasSuffix applies the HasSuffix predicate on the "reveal_id" field.
+func RevealIDHasSuffix(v string) predicate.RevealView {
+	return predicate.RevealView(sql.FieldHasSuffix(FieldRevealID, v))
+}
+
+// RevealIDEqualFold applies the EqualFold predicate on the "reveal_id" field.
+func RevealIDEqualFold(v string) predicate.RevealView {
+	return predicate.RevealView(sql.FieldEqualFold(FieldRevealID, v))
+}
+
+// RevealIDContainsFold applies the ContainsFold predicate on the "reveal_id" field.
+func RevealIDContainsFold(v string) predicate.RevealView {
+	return predicate.RevealView(sql.FieldContainsFold(FieldRevealID, v))
+}
+
+// ViewerUserIDEQ applies the EQ predicate on the "viewer_user_id" field.
+func ViewerUserIDEQ(v string) predicate.RevealView {
+	return predicate.RevealView(sql.FieldEQ(FieldViewerUserID, v))
+}
+
+// ViewerUserIDNEQ applies the NEQ predicate on the "viewer_user_id" field.
+func ViewerUserIDNEQ(v string) predicate.RevealView {
+	return predicate.RevealView(sql.FieldNEQ(FieldViewerUserID, v))
+}
+
+// ViewerUserIDIn applies the In predicate on the "viewer_user_id" field.
+func ViewerUserIDIn(vs ...string) predicate.RevealView {
+	return predicate.RevealView(sql.FieldIn(FieldViewerUserID, vs...))
+}
+
+// ViewerUserIDNotIn applies the NotIn predicate on the "viewer_user_id" field.
+func ViewerUserIDNotIn(vs ...string) predicate.RevealView {
+	return predicate.RevealView(sql.FieldNotIn(FieldViewerUserID, vs...))
+}
+
+// ViewerUserIDGT applies the GT predicate on the "viewer_user_id" field.
+func ViewerUserIDGT(v string) predicate.RevealView {
+	return predicate.RevealView(sql.FieldGT(FieldViewerUserID, v))
+}
+
+// ViewerUserIDGTE applies the GTE predicate on the "viewer_user_id" field.
+func ViewerUserIDGTE(v string) predicate.RevealView {
+	return predicate.RevealView(sql.FieldGTE(FieldViewerUserID, v))
+}
+
+// ViewerUserIDLT applies the LT predicate on the "viewer_user_id" field.
+func ViewerUserIDLT(v string) predicate.RevealView {
+	return predicate.RevealView(sql.FieldLT(FieldViewerUserID, v))
+}
+
+// ViewerUserIDLTE applies the LTE predicate on the "viewer_user_id" field.
+func ViewerUserIDLTE(v string) predicate.RevealView {
+	return predicate.RevealView(sql.FieldLTE(FieldViewerUserID, v))
+}
+
+// ViewerUserIDContains applies the Contains predicate on the "viewer_user_id" field.
+func ViewerUserIDContains(v string) predicate.RevealView {
+	return predicate.RevealView(sql.FieldContains(FieldViewerUserID, v))
+}
+
+// ViewerUserIDHasPrefix applies the HasPrefix predicate on the "viewer_user_id" field.
+func ViewerUserIDHasPrefix(v string) predicate.RevealView {
+	return predicate.RevealView(sql.FieldHasPrefix(FieldViewerUserID, v))
+}
+
+// ViewerUserIDHasSuffix applies the HasSuffix predicate on the "viewer_user_id" field.
+func ViewerUserIDHasSuffix(v string) predicate.RevealView {
+	return predicate.RevealView(sql.FieldHasSuffix(FieldViewerUserID, v))
+}
+
+// ViewerUserIDEqualFold applies the EqualFold predicate on the "viewer_user_id" field.
+func ViewerUserIDEqualFold(v string) predicate.RevealView {
+	return predicate.RevealView(sql.FieldEqualFold(FieldViewerUserID, v))
+}
+
+// ViewerUserIDContainsFold applies the ContainsFold predicate on the "viewer_user_id" field.
+func ViewerUserIDContainsFold(v string) predicate.RevealView {
+	return predicate.RevealView(sql.FieldContainsFold(FieldViewerUserID, v))
+}
+
+// IsFirstViewEQ applies the EQ predicate on the "is_first_view" field.
+func IsFirstViewEQ(v bool) predicate.RevealView {
+	return predicate.RevealView(sql.FieldEQ(FieldIsFirstView, v))
+}
+
+// IsFirstViewNEQ applies the NEQ predicate on the "is_first_view" field.
+func IsFirstViewNEQ(v bool) predicate.RevealView {
+	return predicate.RevealView(sql.FieldNEQ(FieldIsFirstView, v))
+}
+
+// FirstViewKeyEQ applies the EQ predicate on the "first_view_key" field.
+func FirstViewKeyEQ(v string) predicate.RevealView {
+	return predicate.RevealView(sql.FieldEQ(FieldFirstViewKey, v))
+}
+
+// FirstViewKeyNEQ applies the NEQ predicate on the "first_view_key" field.
+func FirstViewKeyNEQ(v string) predicate.RevealView {
+	return predicate.RevealView(sql.FieldNEQ(FieldFirstViewKey, v))
+}
+
+// FirstViewKeyIn applies the In predicate on the "first_view_key" field.
+func FirstViewKeyIn(vs ...string) predicate.RevealView {
+	return predicate.RevealView(sql.FieldIn(FieldFirstViewKey, vs...))
+}
+
+// FirstViewKeyNotIn applies the NotIn predicate on the "first_view_key" field.
+func FirstViewKeyNotIn(vs ...string) predicate.RevealView {
+	return predicate.RevealView(sql.FieldNotIn(FieldFirstViewKey, vs...))
+}
+
+// FirstViewKeyGT applies the GT predicate on the "first_view_key" field.
+func FirstViewKeyGT(v string) predicate.RevealView {
+	return predicate.RevealView(sql.FieldGT(FieldFirstViewKey, v))
+}
+
+// FirstViewKeyGTE applies the GTE predicate on the "first_view_key" field.
+func FirstViewKeyGTE(v string) predicate.RevealView {
+	return predicate.RevealView(sql.FieldGTE(FieldFirstViewKey, v))
+}
+
+// FirstViewKeyLT applies the LT predicate on the "first_view_key" field.
+func FirstViewKeyLT(v string) predicate.RevealView {
+	return predicate.RevealView(sql.FieldLT(FieldFirstViewKey, v))
+}
+
+// FirstViewKeyLTE applies the LTE predicate on the "first_view_key" field.
+func FirstViewKeyLTE(v string) predicate.RevealView {
+	return predicate.RevealView(sql.FieldLTE(FieldFirstViewKey, v))
+}
+
+// FirstViewKeyContains applies the Contains predicate on the "first_view_key" field.
+func FirstViewKeyContains(v string) predicate.RevealView {
+	return predicate.RevealView(sql.FieldContains(FieldFirstViewKey, v))
+}
+
+// FirstViewKeyHasPrefix applies the HasPrefix predicate on the "first_view_key" field.
+func FirstViewKeyHasPrefix(v string) predicate.RevealView {
+	return predicate.RevealView(sql.FieldHasPrefix(FieldFirstViewKey, v))
+}
+
+// FirstViewKeyHasSuffix applies the HasSuffix predicate on the "first_view_key" field.
+func FirstViewKeyHasSuffix(v string) predicate.RevealView {
+	return predicate.RevealView(sql.FieldHasSuffix(FieldFirstViewKey, v))
+}
+
+// FirstViewKeyIsNil applies the IsNil predicate on the "first_view_key" field.
+func FirstViewKeyIsNil() predicate.RevealView {
+	return predicate.RevealView(sql.FieldIsNull(FieldFirstViewKey))
+}
+
+// FirstViewKeyNotNil applies the NotNil predicate on the "first_view_key" field.
+func FirstViewKeyNotNil() predicate.RevealView {
+	return predicate.RevealView(sql.FieldNotNull(FieldFirstViewKey))
+}
+
+// FirstViewKeyEqualFold applies the EqualFold predicate on the "first_view_key" field.
+func FirstViewKeyEqualFold(v string) predicate.RevealView {
+	return predicate.RevealView(sql.FieldEqualFold(FieldFirstViewKey, v))
+}
+
+// FirstViewKeyContainsFold applies the ContainsFold predicate on the "first_view_key" field.
+func FirstViewKeyContainsFold(v string) predicate.RevealView {
+	return predicate.RevealView(sql.FieldContainsFold(FieldFirstViewKey, v))
+}
+
+// DurationSecondsEQ applies the EQ predicate on the "duration_seconds" field.
+func DurationSecondsEQ(v int) predicate.RevealView {
+	return predicate.RevealView(sql.FieldEQ(FieldDurationSeconds, v))
+}
+
+// DurationSecondsNEQ applies the NEQ predicate on the "duration_seconds" field.
+func DurationSecondsNEQ(v int) predicate.RevealView {
+	return predicate.RevealView(sql.FieldNEQ(FieldDurationSeconds, v))
+}
+
+// DurationSecondsIn applies the In predicate on the "duration_seconds" field.
+func DurationSecondsIn(vs ...int) predicate.RevealView {
+	return predicate.RevealView(sql.FieldIn(FieldDurationSeconds, vs...))
+}
+
+// DurationSecondsNotIn applies the NotIn predicate on the "duration_seconds" field.
+func DurationSecondsNotIn(vs ...int) predicate.RevealView {
+	return predicate.RevealView(sql.FieldNotIn(FieldDurationSeconds, vs...))
+}
+
+// DurationSecondsGT applies the GT predicate on the "duration_seconds" field.
+func DurationSecondsGT(v int) predicate.RevealView {
+	return predicate.RevealView(sql.FieldGT(FieldDurationSeconds, v))
+}
+
+// DurationSecondsGTE applies the GTE predicate on the "duration_seconds" field.
+func DurationSecondsGTE(v int) predicate.RevealView {
+	return predicate.RevealView(sql.FieldGTE(FieldDurationSeconds, v))
+}
+
+// DurationSecondsLT applies the LT predicate on the "duration_seconds" field.
+func DurationSecondsLT(v int) predicate.RevealView {
+	return predicate.RevealView(sql.FieldLT(FieldDurationSeconds, v))
+}
+
+// DurationSecondsLTE applies the LTE predicate on the "duration_seconds" field.
+func DurationSecondsLTE(v int) predicate.RevealView {
+	return predicate.RevealView(sql.FieldLTE(FieldDurationSeconds, v))
+}
+
+// DurationSecondsIsNil applies the IsNil predicate on the "duration_seconds" field.
+func DurationSecondsIsNil() predicate.RevealView {
+	return predicate.RevealView(sql.FieldIsNull(FieldDurationSeconds))
+}
+
+// DurationSecondsNotNil applies the NotNil predicate on the "duration_seconds" field.
+func DurationSecondsNotNil() predicate.RevealView {
+	return predicate.RevealView(sql.FieldNotNull(FieldDurationSeconds))
+}
+
+// ViewerTierEQ applies the EQ predicate on the "viewer_tier" field.
+func ViewerTierEQ(v ViewerTier) predicate.RevealView {
+	return predicate.RevealView(sql.FieldEQ(FieldViewerTier, v))
+}
+
+// ViewerTierNEQ applies the NEQ predicate on the "viewer_tier" field.
+func ViewerTierNEQ(v ViewerTier) predicate.RevealView {
+	return predicate.RevealView(sql.FieldNEQ(FieldViewerTier, v))
+}
+
+// ViewerTierIn applies the In predicate on the "viewer_tier" field.
+func ViewerTierIn(vs ...ViewerTier) predicate.RevealView {
+	return predicate.RevealView(sql.FieldIn(FieldViewerTier, vs...))
+}
+
+// ViewerTierNotIn applies the NotIn predicate on the "viewer_tier" field.
+func ViewerTierNotIn(vs ...ViewerTier) predicate.RevealView {
+	return predicate.RevealView(sql.FieldNotIn(FieldViewerTier, vs...))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.RevealView {
+	return predicate.RevealView(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.RevealView {
+	return predicate.RevealView(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.RevealView {
+	return predicate.RevealView(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.RevealView {
+	return predicate.RevealView(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.RevealView {
+	return predicate.RevealView(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.RevealView {
+	return predicate.RevealView(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.RevealView {
+	return predicate.RevealView(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.RevealView {
+	return predicate.RevealView(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasReveal applies the HasEdge predicate on the "reveal" edge.
+func HasReveal() predicate.RevealView {
+	return predicate.RevealView(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, RevealTable, RevealColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRevealWith applies the HasEdge predicate on the "reveal" edge with a given conditions (other predicates).
+func HasRevealWith(preds ...predicate.Reveal) predicate.RevealView {
+	return predicate.RevealView(func(s *sql.Selector) {
+		step := newRevealStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasViewer applies the HasEdge predicate on the "viewer" edge.
+func HasViewer() predicate.RevealView {
+	return predicate.RevealView(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ViewerTable, ViewerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasViewerWith applies the HasEdge predicate on the "viewer" edge with a given conditions (other predicates).
+func HasViewerWith(preds ...predicate.User) predicate.RevealView {
+	return predicate.RevealView(func(s *sql.Selector) {
+		step := newViewerStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.RevealView) predicate.RevealView {
+	return predicate.RevealView(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.RevealView) predicate.RevealView {
+	return predicate.RevealView(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.RevealView) predicate.RevealView {
+	return predicate.RevealView(sql.NotPredicates(p))
+}
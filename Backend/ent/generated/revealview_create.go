@@ -0,0 +1,690 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/UnoraApp/be/ent/generated/reveal"
+	"github.com/UnoraApp/be/ent/generated/revealview"
+	"github.com/UnoraApp/be/ent/generated/user"
+)
+
+// RevealViewCreate is the builder for creating a RevealView entity.
+type RevealViewCreate struct {
+	config
+	mutation *RevealViewMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetRevealID sets the "reveal_id" field.
+func (_c *RevealViewCreate) SetRevealID(v string) *RevealViewCreate {
+	_c.mutation.SetRevealID(v)
+	return _c
+}
+
+// SetViewerUserID sets the "viewer_user_id" field.
+func (_c *RevealViewCreate) SetViewerUserID(v string) *RevealViewCreate {
+	_c.mutation.SetViewerUserID(v)
+	return _c
+}
+
+// SetIsFirstView sets the "is_first_view" field.
+func (_c *RevealViewCreate) SetIsFirstView(v bool) *RevealViewCreate {
+	_c.mutation.SetIsFirstView(v)
+	return _c
+}
+
+// SetNillableIsFirstView sets the "is_first_view" field if the given value is not nil.
+func (_c *RevealViewCreate) SetNillableIsFirstView(v *bool) *RevealViewCreate {
+	if v != nil {
+		_c.SetIsFirstView(*v)
+	}
+	return _c
+}
+
+// SetFirstViewKey sets the "first_view_key" field.
+func (_c *RevealViewCreate) SetFirstViewKey(v string) *RevealViewCreate {
+	_c.mutation.SetFirstViewKey(v)
+	return _c
+}
+
+// SetNillableFirstViewKey sets the "first_view_key" field if the given value is not nil.
+func (_c *RevealViewCreate) SetNillableFirstViewKey(v *string) *RevealViewCreate {
+	if v != nil {
+		_c.SetFirstViewKey(*v)
+	}
+	return _c
+}
+
+// SetDurationSeconds sets the "duration_seconds" field.
+func (_c *RevealViewCreate) SetDurationSeconds(v int) *RevealViewCreate {
+	_c.mutation.SetDurationSeconds(v)
+	return _c
+}
+
+// SetNillableDurationSeconds sets the "duration_seconds" field if the given value is not nil.
+func (_c *RevealViewCreate) SetNillableDurationSeconds(v *int) *RevealViewCreate {
+	if v != nil {
+		_c.SetDurationSeconds(*v)
+	}
+	return _c
+}
+
+// SetViewerTier sets the "viewer_tier" field.
+func (_c *RevealViewCreate) SetViewerTier(v revealview.ViewerTier) *RevealViewCreate {
+	_c.mutation.SetViewerTier(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *RevealViewCreate) SetCreatedAt(v time.Time) *RevealViewCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *RevealViewCreate) SetNillableCreatedAt(v *time.Time) *RevealViewCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *RevealViewCreate) SetID(v string) *RevealViewCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetReveal sets the "reveal" edge to the Reveal entity.
+func (_c *RevealViewCreate) SetReveal(v *Reveal) *RevealViewCreate {
+	return _c.SetRevealID(v.ID)
+}
+
+// SetViewerID sets the "viewer" edge to the User entity by ID.
+func (_c *RevealViewCreate) SetViewerID(id string) *RevealViewCreate {
+	_c.mutation.SetViewerID(id)
+	return _c
+}
+
+// SetViewer sets the "viewer" edge to the User entity.
+func (_c *RevealViewCreate) SetViewer(v *User) *RevealViewCreate {
+	return _c.SetViewerID(v.ID)
+}
+
+// Mutation returns the RevealViewMutation object of the builder.
+func (_c *RevealViewCreate) Mutation() *RevealViewMutation {
+	return _c.mutation
+}
+
+// Save creates the RevealView in the database.
+func (_c *RevealViewCreate) Save(ctx context.Context) (*RevealView, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *RevealViewCreate) SaveX(ctx context.Context) *RevealView {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RevealViewCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RevealViewCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *RevealViewCreate) defaults() {
+	if _, ok := _c.mutation.IsFirstView(); !ok {
+		v := revealview.DefaultIsFirstView
+		_c.mutation.SetIsFirstView(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := revealview.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *RevealViewCreate) check() error {
+	if _, ok := _c.mutation.RevealID(); !ok {
+		return &ValidationError{Name: "reveal_id", err: errors.New(`generated: missing required field "RevealView.reveal_id"`)}
+	}
+	if v, ok := _c.mutation.RevealID(); ok {
+		if err := revealview.RevealIDValidator(v); err != nil {
+			return &ValidationError{Name: "reveal_id", err: fmt.Errorf(`generated: validator failed for field "RevealView.reveal_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ViewerUserID(); !ok {
+		return &ValidationError{Name: "viewer_user_id", err: errors.New(`generated: missing required field "RevealView.viewer_user_id"`)}
+	}
+	if v, ok := _c.mutation.ViewerUserID(); ok {
+		if err := revealview.ViewerUserIDValidator(v); err != nil {
+			return &ValidationError{Name: "viewer_user_id", err: fmt.Errorf(`generated: validator failed for field "RevealView.viewer_user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.IsFirstView(); !ok {
+		return &ValidationError{Name: "is_first_view", err: errors.New(`generated: missing required field "RevealView.is_first_view"`)}
+	}
+	if v, ok := _c.mutation.FirstViewKey(); ok {
+		if err := revealview.FirstViewKeyValidator(v); err != nil {
+			return &ValidationError{Name: "first_view_key", err: fmt.Errorf(`generated: validator failed for field "RevealView.first_view_key": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.DurationSeconds(); ok {
+		if err := revealview.DurationSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "duration_seconds", err: fmt.Errorf(`generated: validator failed for field "RevealView.duration_seconds": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ViewerTier(); !ok {
+		return &ValidationError{Name: "viewer_tier", err: errors.New(`generated: missing required field "RevealView.viewer_tier"`)}
+	}
+	if v, ok := _c.mutation.ViewerTier(); ok {
+		if err := revealview.ViewerTierValidator(v); err != nil {
+			return &ValidationError{Name: "viewer_tier", err: fmt.Errorf(`generated: validator failed for field "RevealView.viewer_tier": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "RevealView.created_at"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := revealview.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`generated: validator failed for field "RevealView.id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.RevealIDs()) == 0 {
+		return &ValidationError{Name: "reveal", err: errors.New(`generated: missing required edge "RevealView.reveal"`)}
+	}
+	if len(_c.mutation.ViewerIDs()) == 0 {
+		return &ValidationError{Name: "viewer", err: errors.New(`generated: missing required edge "RevealView.viewer"`)}
+	}
+	return nil
+}
+
+func (_c *RevealViewCreate) sqlSave(ctx context.Context) (*RevealView, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected RevealView.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *RevealViewCreate) createSpec() (*RevealView, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RevealView{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(revealview.Table, sqlgraph.NewFieldSpec(revealview.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.IsFirstView(); ok {
+		_spec.SetField(revealview.FieldIsFirstView, field.TypeBool, value)
+		_node.IsFirstView = value
+	}
+	if value, ok := _c.mutation.FirstViewKey(); ok {
+		_spec.SetField(revealview.FieldFirstViewKey, field.TypeString, value)
+		_node.FirstViewKey = &value
+	}
+	if value, ok := _c.mutation.DurationSeconds(); ok {
+		_spec.SetField(revealview.FieldDurationSeconds, field.TypeInt, value)
+		_node.DurationSeconds = &value
+	}
+	if value, ok := _c.mutation.ViewerTier(); ok {
+		_spec.SetField(revealview.FieldViewerTier, field.TypeEnum, value)
+		_node.ViewerTier = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(revealview.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.RevealIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   revealview.RevealTable,
+			Columns: []string{revealview.RevealColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reveal.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.RevealID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ViewerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   revealview.ViewerTable,
+			Columns: []string{revealview.ViewerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ViewerUserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.RevealView.Create().
+//		SetRevealID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RevealViewUpsert) {
+//			SetRevealID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *RevealViewCreate) OnConflict(opts ...sql.ConflictOption) *RevealViewUpsertOne {
+	_c.conflict = opts
+	return &RevealViewUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.RevealView.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *RevealViewCreate) OnConflictColumns(columns ...string) *RevealViewUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &RevealViewUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// RevealViewUpsertOne is the builder for "upsert"-ing
+	//  one RevealView node.
+	RevealViewUpsertOne struct {
+		create *RevealViewCreate
+	}
+
+	// RevealViewUpsert is the "OnConflict" setter.
+	RevealViewUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.RevealView.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(revealview.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *RevealViewUpsertOne) UpdateNewValues() *RevealViewUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(revealview.FieldID)
+		}
+		if _, exists := u.create.mutation.RevealID(); exists {
+			s.SetIgnore(revealview.FieldRevealID)
+		}
+		if _, exists := u.create.mutation.ViewerUserID(); exists {
+			s.SetIgnore(revealview.FieldViewerUserID)
+		}
+		if _, exists := u.create.mutation.IsFirstView(); exists {
+			s.SetIgnore(revealview.FieldIsFirstView)
+		}
+		if _, exists := u.create.mutation.FirstViewKey(); exists {
+			s.SetIgnore(revealview.FieldFirstViewKey)
+		}
+		if _, exists := u.create.mutation.DurationSeconds(); exists {
+			s.SetIgnore(revealview.FieldDurationSeconds)
+		}
+		if _, exists := u.create.mutation.ViewerTier(); exists {
+			s.SetIgnore(revealview.FieldViewerTier)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(revealview.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.RevealView.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *RevealViewUpsertOne) Ignore() *RevealViewUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RevealViewUpsertOne) DoNothing() *RevealViewUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RevealViewCreate.OnConflict
+// documentation for more info.
+func (u *RevealViewUpsertOne) Update(set func(*RevealViewUpsert)) *RevealViewUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RevealViewUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *RevealViewUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("generated: missing options for RevealViewCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RevealViewUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *RevealViewUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("generated: RevealViewUpsertOne.ID is not supported by MySQL driver. Use RevealViewUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *RevealViewUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// RevealViewCreateBulk is the builder for creating many RevealView entities in bulk.
+type RevealViewCreateBulk struct {
+	config
+	err      error
+	builders []*RevealViewCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the RevealView entities in the database.
+func (_c *RevealViewCreateBulk) Save(ctx context.Context) ([]*RevealView, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*RevealView, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RevealViewMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *RevealViewCreateBulk) SaveX(ctx context.Context) []*RevealView {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RevealViewCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RevealViewCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.RevealView.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RevealViewUpsert) {
+//			SetRevealID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *RevealViewCreateBulk) OnConflict(opts ...sql.ConflictOption) *RevealViewUpsertBulk {
+	_c.conflict = opts
+	return &RevealViewUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.RevealView.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *RevealViewCreateBulk) OnConflictColumns(columns ...string) *RevealViewUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &RevealViewUpsertBulk{
+		create: _c,
+	}
+}
+
+// RevealViewUpsertBulk is the builder for "upsert"-ing
+// a bulk of RevealView nodes.
+type RevealViewUpsertBulk struct {
+	create *RevealViewCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.RevealView.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(revealview.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *RevealViewUpsertBulk) UpdateNewValues() *RevealViewUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(revealview.FieldID)
+			}
+			if _, exists := b.mutation.RevealID(); exists {
+				s.SetIgnore(revealview.FieldRevealID)
+			}
+			if _, exists := b.mutation.ViewerUserID(); exists {
+				s.SetIgnore(revealview.FieldViewerUserID)
+			}
+			if _, exists := b.mutation.IsFirstView(); exists {
+				s.SetIgnore(revealview.FieldIsFirstView)
+			}
+			if _, exists := b.mutation.FirstViewKey(); exists {
+				s.SetIgnore(revealview.FieldFirstViewKey)
+			}
+			if _, exists := b.mutation.DurationSeconds(); exists {
+				s.SetIgnore(revealview.FieldDurationSeconds)
+			}
+			if _, exists := b.mutation.ViewerTier(); exists {
+				s.SetIgnore(revealview.FieldViewerTier)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(revealview.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.RevealView.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *RevealViewUpsertBulk) Ignore() *RevealViewUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RevealViewUpsertBulk) DoNothing() *RevealViewUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RevealViewCreateBulk.OnConflict
+// documentation for more info.
+func (u *RevealViewUpsertBulk) Update(set func(*RevealViewUpsert)) *RevealViewUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RevealViewUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *RevealViewUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("generated: OnConflict was set for builder %d. Set it on the RevealViewCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("generated: missing options for RevealViewCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RevealViewUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
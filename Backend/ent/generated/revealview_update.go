@@ -0,0 +1,215 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/UnoraApp/be/ent/generated/predicate"
+	"github.com/UnoraApp/be/ent/generated/revealview"
+)
+
+// RevealViewUpdate is the builder for updating RevealView entities.
+type RevealViewUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RevealViewMutation
+}
+
+// Where appends a list predicates to the RevealViewUpdate builder.
+func (_u *RevealViewUpdate) Where(ps ...predicate.RevealView) *RevealViewUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Mutation returns the RevealViewMutation object of the builder.
+func (_u *RevealViewUpdate) Mutation() *RevealViewMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *RevealViewUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RevealViewUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *RevealViewUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RevealViewUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RevealViewUpdate) check() error {
+	if _u.mutation.RevealCleared() && len(_u.mutation.RevealIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "RevealView.reveal"`)
+	}
+	if _u.mutation.ViewerCleared() && len(_u.mutation.ViewerIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "RevealView.viewer"`)
+	}
+	return nil
+}
+
+func (_u *RevealViewUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(revealview.Table, revealview.Columns, sqlgraph.NewFieldSpec(revealview.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.FirstViewKeyCleared() {
+		_spec.ClearField(revealview.FieldFirstViewKey, field.TypeString)
+	}
+	if _u.mutation.DurationSecondsCleared() {
+		_spec.ClearField(revealview.FieldDurationSeconds, field.TypeInt)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{revealview.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// RevealViewUpdateOne is the builder for updating a single RevealView entity.
+type RevealViewUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RevealViewMutation
+}
+
+// Mutation returns the RevealViewMutation object of the builder.
+func (_u *RevealViewUpdateOne) Mutation() *RevealViewMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the RevealViewUpdate builder.
+func (_u *RevealViewUpdateOne) Where(ps ...predicate.RevealView) *RevealViewUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *RevealViewUpdateOne) Select(field string, fields ...string) *RevealViewUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated RevealView entity.
+func (_u *RevealViewUpdateOne) Save(ctx context.Context) (*RevealView, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RevealViewUpdateOne) SaveX(ctx context.Context) *RevealView {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *RevealViewUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RevealViewUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RevealViewUpdateOne) check() error {
+	if _u.mutation.RevealCleared() && len(_u.mutation.RevealIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "RevealView.reveal"`)
+	}
+	if _u.mutation.ViewerCleared() && len(_u.mutation.ViewerIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "RevealView.viewer"`)
+	}
+	return nil
+}
+
+func (_u *RevealViewUpdateOne) sqlSave(ctx context.Context) (_node *RevealView, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(revealview.Table, revealview.Columns, sqlgraph.NewFieldSpec(revealview.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "RevealView.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, revealview.FieldID)
+		for _, f := range fields {
+			if !revealview.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != revealview.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.FirstViewKeyCleared() {
+		_spec.ClearField(revealview.FieldFirstViewKey, field.TypeString)
+	}
+	if _u.mutation.DurationSecondsCleared() {
+		_spec.ClearField(revealview.FieldDurationSeconds, field.TypeInt)
+	}
+	_node = &RevealView{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{revealview.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -5,29 +5,57 @@ package generated
 import (
 	"time"
 
+	"github.com/UnoraApp/be/ent/generated/accountdeletion"
+	"github.com/UnoraApp/be/ent/generated/adminuser"
 	"github.com/UnoraApp/be/ent/generated/auditlog"
+	"github.com/UnoraApp/be/ent/generated/balancedrift"
 	"github.com/UnoraApp/be/ent/generated/checkin"
 	"github.com/UnoraApp/be/ent/generated/connection"
+	"github.com/UnoraApp/be/ent/generated/conversation"
 	"github.com/UnoraApp/be/ent/generated/creditpackage"
 	"github.com/UnoraApp/be/ent/generated/credittransaction"
+	"github.com/UnoraApp/be/ent/generated/dataexport"
+	"github.com/UnoraApp/be/ent/generated/deviceriskflag"
 	"github.com/UnoraApp/be/ent/generated/discoverybatch"
 	"github.com/UnoraApp/be/ent/generated/discoverycard"
 	"github.com/UnoraApp/be/ent/generated/filter"
 	"github.com/UnoraApp/be/ent/generated/hobby"
 	"github.com/UnoraApp/be/ent/generated/hobbyoption"
 	"github.com/UnoraApp/be/ent/generated/interest"
+	"github.com/UnoraApp/be/ent/generated/invoice"
+	"github.com/UnoraApp/be/ent/generated/invoicesequence"
+	"github.com/UnoraApp/be/ent/generated/ledgerentry"
+	"github.com/UnoraApp/be/ent/generated/message"
+	"github.com/UnoraApp/be/ent/generated/moderationaction"
+	"github.com/UnoraApp/be/ent/generated/notification"
 	"github.com/UnoraApp/be/ent/generated/nudge"
+	"github.com/UnoraApp/be/ent/generated/paymentdiscrepancy"
 	"github.com/UnoraApp/be/ent/generated/paymentorder"
+	"github.com/UnoraApp/be/ent/generated/paymentreconciliationreport"
+	"github.com/UnoraApp/be/ent/generated/paymentrefund"
 	"github.com/UnoraApp/be/ent/generated/photo"
 	"github.com/UnoraApp/be/ent/generated/profile"
+	"github.com/UnoraApp/be/ent/generated/promocode"
+	"github.com/UnoraApp/be/ent/generated/promoredemption"
+	"github.com/UnoraApp/be/ent/generated/referral"
+	"github.com/UnoraApp/be/ent/generated/reportevidence"
 	"github.com/UnoraApp/be/ent/generated/reveal"
 	"github.com/UnoraApp/be/ent/generated/revealcontent"
+	"github.com/UnoraApp/be/ent/generated/revealgift"
 	"github.com/UnoraApp/be/ent/generated/revealmilestone"
+	"github.com/UnoraApp/be/ent/generated/revealview"
 	"github.com/UnoraApp/be/ent/generated/server"
 	"github.com/UnoraApp/be/ent/generated/streak"
+	"github.com/UnoraApp/be/ent/generated/subscription"
+	"github.com/UnoraApp/be/ent/generated/subscriptionplan"
+	"github.com/UnoraApp/be/ent/generated/tierentitlement"
 	"github.com/UnoraApp/be/ent/generated/user"
 	"github.com/UnoraApp/be/ent/generated/userblock"
+	"github.com/UnoraApp/be/ent/generated/userdevice"
+	"github.com/UnoraApp/be/ent/generated/useridentity"
 	"github.com/UnoraApp/be/ent/generated/userreport"
+	"github.com/UnoraApp/be/ent/generated/usersession"
+	"github.com/UnoraApp/be/ent/generated/webhookevent"
 	"github.com/UnoraApp/be/ent/schema"
 )
 
@@ -35,6 +63,162 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	accountdeletionFields := schema.AccountDeletion{}.Fields()
+	_ = accountdeletionFields
+	// accountdeletionDescUserID is the schema descriptor for user_id field.
+	accountdeletionDescUserID := accountdeletionFields[1].Descriptor()
+	// accountdeletion.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	accountdeletion.UserIDValidator = func() func(string) error {
+		validators := accountdeletionDescUserID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(user_id string) error {
+			for _, fn := range fns {
+				if err := fn(user_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// accountdeletionDescReason is the schema descriptor for reason field.
+	accountdeletionDescReason := accountdeletionFields[4].Descriptor()
+	// accountdeletion.ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
+	accountdeletion.ReasonValidator = accountdeletionDescReason.Validators[0].(func(string) error)
+	// accountdeletionDescCreatedAt is the schema descriptor for created_at field.
+	accountdeletionDescCreatedAt := accountdeletionFields[5].Descriptor()
+	// accountdeletion.DefaultCreatedAt holds the default value on creation for the created_at field.
+	accountdeletion.DefaultCreatedAt = accountdeletionDescCreatedAt.Default.(func() time.Time)
+	// accountdeletionDescID is the schema descriptor for id field.
+	accountdeletionDescID := accountdeletionFields[0].Descriptor()
+	// accountdeletion.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	accountdeletion.IDValidator = func() func(string) error {
+		validators := accountdeletionDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	adminuserFields := schema.AdminUser{}.Fields()
+	_ = adminuserFields
+	// adminuserDescEmail is the schema descriptor for email field.
+	adminuserDescEmail := adminuserFields[1].Descriptor()
+	// adminuser.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	adminuser.EmailValidator = func() func(string) error {
+		validators := adminuserDescEmail.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(email string) error {
+			for _, fn := range fns {
+				if err := fn(email); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// adminuserDescName is the schema descriptor for name field.
+	adminuserDescName := adminuserFields[2].Descriptor()
+	// adminuser.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	adminuser.NameValidator = func() func(string) error {
+		validators := adminuserDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// adminuserDescAPIKeyHash is the schema descriptor for api_key_hash field.
+	adminuserDescAPIKeyHash := adminuserFields[5].Descriptor()
+	// adminuser.APIKeyHashValidator is a validator for the "api_key_hash" field. It is called by the builders before save.
+	adminuser.APIKeyHashValidator = func() func(string) error {
+		validators := adminuserDescAPIKeyHash.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(api_key_hash string) error {
+			for _, fn := range fns {
+				if err := fn(api_key_hash); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// adminuserDescAPIKeyPrefix is the schema descriptor for api_key_prefix field.
+	adminuserDescAPIKeyPrefix := adminuserFields[6].Descriptor()
+	// adminuser.APIKeyPrefixValidator is a validator for the "api_key_prefix" field. It is called by the builders before save.
+	adminuser.APIKeyPrefixValidator = func() func(string) error {
+		validators := adminuserDescAPIKeyPrefix.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(api_key_prefix string) error {
+			for _, fn := range fns {
+				if err := fn(api_key_prefix); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// adminuserDescAPIKeyRotatedAt is the schema descriptor for api_key_rotated_at field.
+	adminuserDescAPIKeyRotatedAt := adminuserFields[7].Descriptor()
+	// adminuser.DefaultAPIKeyRotatedAt holds the default value on creation for the api_key_rotated_at field.
+	adminuser.DefaultAPIKeyRotatedAt = adminuserDescAPIKeyRotatedAt.Default.(func() time.Time)
+	// adminuserDescCreatedBy is the schema descriptor for created_by field.
+	adminuserDescCreatedBy := adminuserFields[8].Descriptor()
+	// adminuser.CreatedByValidator is a validator for the "created_by" field. It is called by the builders before save.
+	adminuser.CreatedByValidator = adminuserDescCreatedBy.Validators[0].(func(string) error)
+	// adminuserDescCreatedAt is the schema descriptor for created_at field.
+	adminuserDescCreatedAt := adminuserFields[10].Descriptor()
+	// adminuser.DefaultCreatedAt holds the default value on creation for the created_at field.
+	adminuser.DefaultCreatedAt = adminuserDescCreatedAt.Default.(func() time.Time)
+	// adminuserDescUpdatedAt is the schema descriptor for updated_at field.
+	adminuserDescUpdatedAt := adminuserFields[11].Descriptor()
+	// adminuser.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	adminuser.DefaultUpdatedAt = adminuserDescUpdatedAt.Default.(func() time.Time)
+	// adminuser.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	adminuser.UpdateDefaultUpdatedAt = adminuserDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// adminuserDescID is the schema descriptor for id field.
+	adminuserDescID := adminuserFields[0].Descriptor()
+	// adminuser.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	adminuser.IDValidator = func() func(string) error {
+		validators := adminuserDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
 	auditlogFields := schema.AuditLog{}.Fields()
 	_ = auditlogFields
 	// auditlogDescAdminIdentifier is the schema descriptor for admin_identifier field.
@@ -129,6 +313,52 @@ func init() {
 			return nil
 		}
 	}()
+	balancedriftFields := schema.BalanceDrift{}.Fields()
+	_ = balancedriftFields
+	// balancedriftDescUserID is the schema descriptor for user_id field.
+	balancedriftDescUserID := balancedriftFields[1].Descriptor()
+	// balancedrift.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	balancedrift.UserIDValidator = func() func(string) error {
+		validators := balancedriftDescUserID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(user string) error {
+			for _, fn := range fns {
+				if err := fn(user); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// balancedriftDescDetectedAt is the schema descriptor for detected_at field.
+	balancedriftDescDetectedAt := balancedriftFields[5].Descriptor()
+	// balancedrift.DefaultDetectedAt holds the default value on creation for the detected_at field.
+	balancedrift.DefaultDetectedAt = balancedriftDescDetectedAt.Default.(func() time.Time)
+	// balancedriftDescLastCheckedAt is the schema descriptor for last_checked_at field.
+	balancedriftDescLastCheckedAt := balancedriftFields[6].Descriptor()
+	// balancedrift.DefaultLastCheckedAt holds the default value on creation for the last_checked_at field.
+	balancedrift.DefaultLastCheckedAt = balancedriftDescLastCheckedAt.Default.(func() time.Time)
+	// balancedriftDescID is the schema descriptor for id field.
+	balancedriftDescID := balancedriftFields[0].Descriptor()
+	// balancedrift.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	balancedrift.IDValidator = func() func(string) error {
+		validators := balancedriftDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
 	checkinFields := schema.CheckIn{}.Fields()
 	_ = checkinFields
 	// checkinDescStreakID is the schema descriptor for streak_id field.
@@ -267,6 +497,84 @@ func init() {
 			return nil
 		}
 	}()
+	conversationFields := schema.Conversation{}.Fields()
+	_ = conversationFields
+	// conversationDescConnectionID is the schema descriptor for connection_id field.
+	conversationDescConnectionID := conversationFields[1].Descriptor()
+	// conversation.ConnectionIDValidator is a validator for the "connection_id" field. It is called by the builders before save.
+	conversation.ConnectionIDValidator = func() func(string) error {
+		validators := conversationDescConnectionID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(connection string) error {
+			for _, fn := range fns {
+				if err := fn(connection); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// conversationDescUserAID is the schema descriptor for user_a_id field.
+	conversationDescUserAID := conversationFields[2].Descriptor()
+	// conversation.UserAIDValidator is a validator for the "user_a_id" field. It is called by the builders before save.
+	conversation.UserAIDValidator = func() func(string) error {
+		validators := conversationDescUserAID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(user_a_id string) error {
+			for _, fn := range fns {
+				if err := fn(user_a_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// conversationDescUserBID is the schema descriptor for user_b_id field.
+	conversationDescUserBID := conversationFields[3].Descriptor()
+	// conversation.UserBIDValidator is a validator for the "user_b_id" field. It is called by the builders before save.
+	conversation.UserBIDValidator = func() func(string) error {
+		validators := conversationDescUserBID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(user_b_id string) error {
+			for _, fn := range fns {
+				if err := fn(user_b_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// conversationDescCreatedAt is the schema descriptor for created_at field.
+	conversationDescCreatedAt := conversationFields[6].Descriptor()
+	// conversation.DefaultCreatedAt holds the default value on creation for the created_at field.
+	conversation.DefaultCreatedAt = conversationDescCreatedAt.Default.(func() time.Time)
+	// conversationDescID is the schema descriptor for id field.
+	conversationDescID := conversationFields[0].Descriptor()
+	// conversation.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	conversation.IDValidator = func() func(string) error {
+		validators := conversationDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
 	creditpackageFields := schema.CreditPackage{}.Fields()
 	_ = creditpackageFields
 	// creditpackageDescName is the schema descriptor for name field.
@@ -371,8 +679,12 @@ func init() {
 	credittransactionDescReferenceID := credittransactionFields[6].Descriptor()
 	// credittransaction.ReferenceIDValidator is a validator for the "reference_id" field. It is called by the builders before save.
 	credittransaction.ReferenceIDValidator = credittransactionDescReferenceID.Validators[0].(func(string) error)
+	// credittransactionDescIdempotencyKey is the schema descriptor for idempotency_key field.
+	credittransactionDescIdempotencyKey := credittransactionFields[8].Descriptor()
+	// credittransaction.IdempotencyKeyValidator is a validator for the "idempotency_key" field. It is called by the builders before save.
+	credittransaction.IdempotencyKeyValidator = credittransactionDescIdempotencyKey.Validators[0].(func(string) error)
 	// credittransactionDescCreatedAt is the schema descriptor for created_at field.
-	credittransactionDescCreatedAt := credittransactionFields[8].Descriptor()
+	credittransactionDescCreatedAt := credittransactionFields[9].Descriptor()
 	// credittransaction.DefaultCreatedAt holds the default value on creation for the created_at field.
 	credittransaction.DefaultCreatedAt = credittransactionDescCreatedAt.Default.(func() time.Time)
 	// credittransactionDescID is the schema descriptor for id field.
@@ -393,6 +705,120 @@ func init() {
 			return nil
 		}
 	}()
+	dataexportFields := schema.DataExport{}.Fields()
+	_ = dataexportFields
+	// dataexportDescUserID is the schema descriptor for user_id field.
+	dataexportDescUserID := dataexportFields[1].Descriptor()
+	// dataexport.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	dataexport.UserIDValidator = func() func(string) error {
+		validators := dataexportDescUserID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(user_id string) error {
+			for _, fn := range fns {
+				if err := fn(user_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// dataexportDescStorageKey is the schema descriptor for storage_key field.
+	dataexportDescStorageKey := dataexportFields[3].Descriptor()
+	// dataexport.StorageKeyValidator is a validator for the "storage_key" field. It is called by the builders before save.
+	dataexport.StorageKeyValidator = dataexportDescStorageKey.Validators[0].(func(string) error)
+	// dataexportDescErrorMessage is the schema descriptor for error_message field.
+	dataexportDescErrorMessage := dataexportFields[5].Descriptor()
+	// dataexport.ErrorMessageValidator is a validator for the "error_message" field. It is called by the builders before save.
+	dataexport.ErrorMessageValidator = dataexportDescErrorMessage.Validators[0].(func(string) error)
+	// dataexportDescCreatedAt is the schema descriptor for created_at field.
+	dataexportDescCreatedAt := dataexportFields[6].Descriptor()
+	// dataexport.DefaultCreatedAt holds the default value on creation for the created_at field.
+	dataexport.DefaultCreatedAt = dataexportDescCreatedAt.Default.(func() time.Time)
+	// dataexportDescID is the schema descriptor for id field.
+	dataexportDescID := dataexportFields[0].Descriptor()
+	// dataexport.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	dataexport.IDValidator = func() func(string) error {
+		validators := dataexportDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	deviceriskflagFields := schema.DeviceRiskFlag{}.Fields()
+	_ = deviceriskflagFields
+	// deviceriskflagDescUserID is the schema descriptor for user_id field.
+	deviceriskflagDescUserID := deviceriskflagFields[1].Descriptor()
+	// deviceriskflag.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	deviceriskflag.UserIDValidator = func() func(string) error {
+		validators := deviceriskflagDescUserID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(user_id string) error {
+			for _, fn := range fns {
+				if err := fn(user_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// deviceriskflagDescDeviceID is the schema descriptor for device_id field.
+	deviceriskflagDescDeviceID := deviceriskflagFields[2].Descriptor()
+	// deviceriskflag.DeviceIDValidator is a validator for the "device_id" field. It is called by the builders before save.
+	deviceriskflag.DeviceIDValidator = func() func(string) error {
+		validators := deviceriskflagDescDeviceID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(device_id string) error {
+			for _, fn := range fns {
+				if err := fn(device_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// deviceriskflagDescReviewedBy is the schema descriptor for reviewed_by field.
+	deviceriskflagDescReviewedBy := deviceriskflagFields[6].Descriptor()
+	// deviceriskflag.ReviewedByValidator is a validator for the "reviewed_by" field. It is called by the builders before save.
+	deviceriskflag.ReviewedByValidator = deviceriskflagDescReviewedBy.Validators[0].(func(string) error)
+	// deviceriskflagDescCreatedAt is the schema descriptor for created_at field.
+	deviceriskflagDescCreatedAt := deviceriskflagFields[8].Descriptor()
+	// deviceriskflag.DefaultCreatedAt holds the default value on creation for the created_at field.
+	deviceriskflag.DefaultCreatedAt = deviceriskflagDescCreatedAt.Default.(func() time.Time)
+	// deviceriskflagDescID is the schema descriptor for id field.
+	deviceriskflagDescID := deviceriskflagFields[0].Descriptor()
+	// deviceriskflag.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	deviceriskflag.IDValidator = func() func(string) error {
+		validators := deviceriskflagDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
 	discoverybatchFields := schema.DiscoveryBatch{}.Fields()
 	_ = discoverybatchFields
 	// discoverybatchDescUserID is the schema descriptor for user_id field.
@@ -765,72 +1191,566 @@ func init() {
 			return nil
 		}
 	}()
-	nudgeFields := schema.Nudge{}.Fields()
-	_ = nudgeFields
-	// nudgeDescStreakID is the schema descriptor for streak_id field.
-	nudgeDescStreakID := nudgeFields[1].Descriptor()
-	// nudge.StreakIDValidator is a validator for the "streak_id" field. It is called by the builders before save.
-	nudge.StreakIDValidator = func() func(string) error {
-		validators := nudgeDescStreakID.Validators
+	invoiceFields := schema.Invoice{}.Fields()
+	_ = invoiceFields
+	// invoiceDescInvoiceNumber is the schema descriptor for invoice_number field.
+	invoiceDescInvoiceNumber := invoiceFields[1].Descriptor()
+	// invoice.InvoiceNumberValidator is a validator for the "invoice_number" field. It is called by the builders before save.
+	invoice.InvoiceNumberValidator = func() func(string) error {
+		validators := invoiceDescInvoiceNumber.Validators
 		fns := [...]func(string) error{
 			validators[0].(func(string) error),
 			validators[1].(func(string) error),
 		}
-		return func(streak string) error {
+		return func(invoice_number string) error {
 			for _, fn := range fns {
-				if err := fn(streak); err != nil {
+				if err := fn(invoice_number); err != nil {
 					return err
 				}
 			}
 			return nil
 		}
 	}()
-	// nudgeDescSenderUserID is the schema descriptor for sender_user_id field.
-	nudgeDescSenderUserID := nudgeFields[2].Descriptor()
-	// nudge.SenderUserIDValidator is a validator for the "sender_user_id" field. It is called by the builders before save.
-	nudge.SenderUserIDValidator = func() func(string) error {
-		validators := nudgeDescSenderUserID.Validators
+	// invoiceDescFinancialYear is the schema descriptor for financial_year field.
+	invoiceDescFinancialYear := invoiceFields[2].Descriptor()
+	// invoice.FinancialYearValidator is a validator for the "financial_year" field. It is called by the builders before save.
+	invoice.FinancialYearValidator = func() func(string) error {
+		validators := invoiceDescFinancialYear.Validators
 		fns := [...]func(string) error{
 			validators[0].(func(string) error),
 			validators[1].(func(string) error),
 		}
-		return func(sender string) error {
+		return func(financial_year string) error {
 			for _, fn := range fns {
-				if err := fn(sender); err != nil {
+				if err := fn(financial_year); err != nil {
 					return err
 				}
 			}
 			return nil
 		}
 	}()
-	// nudgeDescReceiverUserID is the schema descriptor for receiver_user_id field.
-	nudgeDescReceiverUserID := nudgeFields[3].Descriptor()
-	// nudge.ReceiverUserIDValidator is a validator for the "receiver_user_id" field. It is called by the builders before save.
-	nudge.ReceiverUserIDValidator = func() func(string) error {
-		validators := nudgeDescReceiverUserID.Validators
+	// invoiceDescSequenceNumber is the schema descriptor for sequence_number field.
+	invoiceDescSequenceNumber := invoiceFields[3].Descriptor()
+	// invoice.SequenceNumberValidator is a validator for the "sequence_number" field. It is called by the builders before save.
+	invoice.SequenceNumberValidator = invoiceDescSequenceNumber.Validators[0].(func(int) error)
+	// invoiceDescOrderID is the schema descriptor for order_id field.
+	invoiceDescOrderID := invoiceFields[4].Descriptor()
+	// invoice.OrderIDValidator is a validator for the "order_id" field. It is called by the builders before save.
+	invoice.OrderIDValidator = func() func(string) error {
+		validators := invoiceDescOrderID.Validators
 		fns := [...]func(string) error{
 			validators[0].(func(string) error),
 			validators[1].(func(string) error),
 		}
-		return func(receiver string) error {
+		return func(_order string) error {
 			for _, fn := range fns {
-				if err := fn(receiver); err != nil {
+				if err := fn(_order); err != nil {
 					return err
 				}
 			}
 			return nil
 		}
 	}()
-	// nudgeDescDayNumber is the schema descriptor for day_number field.
-	nudgeDescDayNumber := nudgeFields[4].Descriptor()
-	// nudge.DayNumberValidator is a validator for the "day_number" field. It is called by the builders before save.
-	nudge.DayNumberValidator = func() func(int) error {
-		validators := nudgeDescDayNumber.Validators
-		fns := [...]func(int) error{
-			validators[0].(func(int) error),
-			validators[1].(func(int) error),
+	// invoiceDescUserID is the schema descriptor for user_id field.
+	invoiceDescUserID := invoiceFields[5].Descriptor()
+	// invoice.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	invoice.UserIDValidator = func() func(string) error {
+		validators := invoiceDescUserID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
 		}
-		return func(day_number int) error {
+		return func(user_id string) error {
+			for _, fn := range fns {
+				if err := fn(user_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// invoiceDescSellerName is the schema descriptor for seller_name field.
+	invoiceDescSellerName := invoiceFields[6].Descriptor()
+	// invoice.SellerNameValidator is a validator for the "seller_name" field. It is called by the builders before save.
+	invoice.SellerNameValidator = invoiceDescSellerName.Validators[0].(func(string) error)
+	// invoiceDescSellerAddress is the schema descriptor for seller_address field.
+	invoiceDescSellerAddress := invoiceFields[7].Descriptor()
+	// invoice.SellerAddressValidator is a validator for the "seller_address" field. It is called by the builders before save.
+	invoice.SellerAddressValidator = invoiceDescSellerAddress.Validators[0].(func(string) error)
+	// invoiceDescSellerGstin is the schema descriptor for seller_gstin field.
+	invoiceDescSellerGstin := invoiceFields[8].Descriptor()
+	// invoice.SellerGstinValidator is a validator for the "seller_gstin" field. It is called by the builders before save.
+	invoice.SellerGstinValidator = invoiceDescSellerGstin.Validators[0].(func(string) error)
+	// invoiceDescBuyerName is the schema descriptor for buyer_name field.
+	invoiceDescBuyerName := invoiceFields[9].Descriptor()
+	// invoice.BuyerNameValidator is a validator for the "buyer_name" field. It is called by the builders before save.
+	invoice.BuyerNameValidator = invoiceDescBuyerName.Validators[0].(func(string) error)
+	// invoiceDescBuyerEmail is the schema descriptor for buyer_email field.
+	invoiceDescBuyerEmail := invoiceFields[10].Descriptor()
+	// invoice.BuyerEmailValidator is a validator for the "buyer_email" field. It is called by the builders before save.
+	invoice.BuyerEmailValidator = invoiceDescBuyerEmail.Validators[0].(func(string) error)
+	// invoiceDescPlaceOfSupply is the schema descriptor for place_of_supply field.
+	invoiceDescPlaceOfSupply := invoiceFields[11].Descriptor()
+	// invoice.PlaceOfSupplyValidator is a validator for the "place_of_supply" field. It is called by the builders before save.
+	invoice.PlaceOfSupplyValidator = invoiceDescPlaceOfSupply.Validators[0].(func(string) error)
+	// invoiceDescDescription is the schema descriptor for description field.
+	invoiceDescDescription := invoiceFields[12].Descriptor()
+	// invoice.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	invoice.DescriptionValidator = invoiceDescDescription.Validators[0].(func(string) error)
+	// invoiceDescSacCode is the schema descriptor for sac_code field.
+	invoiceDescSacCode := invoiceFields[13].Descriptor()
+	// invoice.SacCodeValidator is a validator for the "sac_code" field. It is called by the builders before save.
+	invoice.SacCodeValidator = invoiceDescSacCode.Validators[0].(func(string) error)
+	// invoiceDescCurrency is the schema descriptor for currency field.
+	invoiceDescCurrency := invoiceFields[14].Descriptor()
+	// invoice.DefaultCurrency holds the default value on creation for the currency field.
+	invoice.DefaultCurrency = invoiceDescCurrency.Default.(string)
+	// invoice.CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	invoice.CurrencyValidator = invoiceDescCurrency.Validators[0].(func(string) error)
+	// invoiceDescDiscountAmount is the schema descriptor for discount_amount field.
+	invoiceDescDiscountAmount := invoiceFields[15].Descriptor()
+	// invoice.DefaultDiscountAmount holds the default value on creation for the discount_amount field.
+	invoice.DefaultDiscountAmount = invoiceDescDiscountAmount.Default.(int)
+	// invoice.DiscountAmountValidator is a validator for the "discount_amount" field. It is called by the builders before save.
+	invoice.DiscountAmountValidator = invoiceDescDiscountAmount.Validators[0].(func(int) error)
+	// invoiceDescTaxableAmount is the schema descriptor for taxable_amount field.
+	invoiceDescTaxableAmount := invoiceFields[16].Descriptor()
+	// invoice.TaxableAmountValidator is a validator for the "taxable_amount" field. It is called by the builders before save.
+	invoice.TaxableAmountValidator = invoiceDescTaxableAmount.Validators[0].(func(int) error)
+	// invoiceDescCgstAmount is the schema descriptor for cgst_amount field.
+	invoiceDescCgstAmount := invoiceFields[18].Descriptor()
+	// invoice.DefaultCgstAmount holds the default value on creation for the cgst_amount field.
+	invoice.DefaultCgstAmount = invoiceDescCgstAmount.Default.(int)
+	// invoice.CgstAmountValidator is a validator for the "cgst_amount" field. It is called by the builders before save.
+	invoice.CgstAmountValidator = invoiceDescCgstAmount.Validators[0].(func(int) error)
+	// invoiceDescSgstAmount is the schema descriptor for sgst_amount field.
+	invoiceDescSgstAmount := invoiceFields[19].Descriptor()
+	// invoice.DefaultSgstAmount holds the default value on creation for the sgst_amount field.
+	invoice.DefaultSgstAmount = invoiceDescSgstAmount.Default.(int)
+	// invoice.SgstAmountValidator is a validator for the "sgst_amount" field. It is called by the builders before save.
+	invoice.SgstAmountValidator = invoiceDescSgstAmount.Validators[0].(func(int) error)
+	// invoiceDescIgstAmount is the schema descriptor for igst_amount field.
+	invoiceDescIgstAmount := invoiceFields[20].Descriptor()
+	// invoice.DefaultIgstAmount holds the default value on creation for the igst_amount field.
+	invoice.DefaultIgstAmount = invoiceDescIgstAmount.Default.(int)
+	// invoice.IgstAmountValidator is a validator for the "igst_amount" field. It is called by the builders before save.
+	invoice.IgstAmountValidator = invoiceDescIgstAmount.Validators[0].(func(int) error)
+	// invoiceDescTotalAmount is the schema descriptor for total_amount field.
+	invoiceDescTotalAmount := invoiceFields[21].Descriptor()
+	// invoice.TotalAmountValidator is a validator for the "total_amount" field. It is called by the builders before save.
+	invoice.TotalAmountValidator = invoiceDescTotalAmount.Validators[0].(func(int) error)
+	// invoiceDescPdfStorageKey is the schema descriptor for pdf_storage_key field.
+	invoiceDescPdfStorageKey := invoiceFields[22].Descriptor()
+	// invoice.PdfStorageKeyValidator is a validator for the "pdf_storage_key" field. It is called by the builders before save.
+	invoice.PdfStorageKeyValidator = invoiceDescPdfStorageKey.Validators[0].(func(string) error)
+	// invoiceDescCreatedAt is the schema descriptor for created_at field.
+	invoiceDescCreatedAt := invoiceFields[24].Descriptor()
+	// invoice.DefaultCreatedAt holds the default value on creation for the created_at field.
+	invoice.DefaultCreatedAt = invoiceDescCreatedAt.Default.(func() time.Time)
+	// invoiceDescID is the schema descriptor for id field.
+	invoiceDescID := invoiceFields[0].Descriptor()
+	// invoice.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	invoice.IDValidator = func() func(string) error {
+		validators := invoiceDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	invoicesequenceFields := schema.InvoiceSequence{}.Fields()
+	_ = invoicesequenceFields
+	// invoicesequenceDescLastNumber is the schema descriptor for last_number field.
+	invoicesequenceDescLastNumber := invoicesequenceFields[1].Descriptor()
+	// invoicesequence.DefaultLastNumber holds the default value on creation for the last_number field.
+	invoicesequence.DefaultLastNumber = invoicesequenceDescLastNumber.Default.(int)
+	// invoicesequence.LastNumberValidator is a validator for the "last_number" field. It is called by the builders before save.
+	invoicesequence.LastNumberValidator = invoicesequenceDescLastNumber.Validators[0].(func(int) error)
+	// invoicesequenceDescUpdatedAt is the schema descriptor for updated_at field.
+	invoicesequenceDescUpdatedAt := invoicesequenceFields[2].Descriptor()
+	// invoicesequence.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	invoicesequence.DefaultUpdatedAt = invoicesequenceDescUpdatedAt.Default.(func() time.Time)
+	// invoicesequence.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	invoicesequence.UpdateDefaultUpdatedAt = invoicesequenceDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// invoicesequenceDescID is the schema descriptor for id field.
+	invoicesequenceDescID := invoicesequenceFields[0].Descriptor()
+	// invoicesequence.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	invoicesequence.IDValidator = func() func(string) error {
+		validators := invoicesequenceDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	ledgerentryFields := schema.LedgerEntry{}.Fields()
+	_ = ledgerentryFields
+	// ledgerentryDescTransactionID is the schema descriptor for transaction_id field.
+	ledgerentryDescTransactionID := ledgerentryFields[1].Descriptor()
+	// ledgerentry.TransactionIDValidator is a validator for the "transaction_id" field. It is called by the builders before save.
+	ledgerentry.TransactionIDValidator = func() func(string) error {
+		validators := ledgerentryDescTransactionID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(transaction string) error {
+			for _, fn := range fns {
+				if err := fn(transaction); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// ledgerentryDescAccount is the schema descriptor for account field.
+	ledgerentryDescAccount := ledgerentryFields[2].Descriptor()
+	// ledgerentry.AccountValidator is a validator for the "account" field. It is called by the builders before save.
+	ledgerentry.AccountValidator = func() func(string) error {
+		validators := ledgerentryDescAccount.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(account string) error {
+			for _, fn := range fns {
+				if err := fn(account); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// ledgerentryDescCreatedAt is the schema descriptor for created_at field.
+	ledgerentryDescCreatedAt := ledgerentryFields[4].Descriptor()
+	// ledgerentry.DefaultCreatedAt holds the default value on creation for the created_at field.
+	ledgerentry.DefaultCreatedAt = ledgerentryDescCreatedAt.Default.(func() time.Time)
+	// ledgerentryDescID is the schema descriptor for id field.
+	ledgerentryDescID := ledgerentryFields[0].Descriptor()
+	// ledgerentry.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	ledgerentry.IDValidator = func() func(string) error {
+		validators := ledgerentryDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	messageFields := schema.Message{}.Fields()
+	_ = messageFields
+	// messageDescConversationID is the schema descriptor for conversation_id field.
+	messageDescConversationID := messageFields[1].Descriptor()
+	// message.ConversationIDValidator is a validator for the "conversation_id" field. It is called by the builders before save.
+	message.ConversationIDValidator = func() func(string) error {
+		validators := messageDescConversationID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(conversation string) error {
+			for _, fn := range fns {
+				if err := fn(conversation); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// messageDescSenderUserID is the schema descriptor for sender_user_id field.
+	messageDescSenderUserID := messageFields[2].Descriptor()
+	// message.SenderUserIDValidator is a validator for the "sender_user_id" field. It is called by the builders before save.
+	message.SenderUserIDValidator = func() func(string) error {
+		validators := messageDescSenderUserID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(sender string) error {
+			for _, fn := range fns {
+				if err := fn(sender); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// messageDescBody is the schema descriptor for body field.
+	messageDescBody := messageFields[3].Descriptor()
+	// message.BodyValidator is a validator for the "body" field. It is called by the builders before save.
+	message.BodyValidator = func() func(string) error {
+		validators := messageDescBody.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(body string) error {
+			for _, fn := range fns {
+				if err := fn(body); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// messageDescClientMessageID is the schema descriptor for client_message_id field.
+	messageDescClientMessageID := messageFields[4].Descriptor()
+	// message.ClientMessageIDValidator is a validator for the "client_message_id" field. It is called by the builders before save.
+	message.ClientMessageIDValidator = messageDescClientMessageID.Validators[0].(func(string) error)
+	// messageDescCreatedAt is the schema descriptor for created_at field.
+	messageDescCreatedAt := messageFields[6].Descriptor()
+	// message.DefaultCreatedAt holds the default value on creation for the created_at field.
+	message.DefaultCreatedAt = messageDescCreatedAt.Default.(func() time.Time)
+	// messageDescID is the schema descriptor for id field.
+	messageDescID := messageFields[0].Descriptor()
+	// message.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	message.IDValidator = func() func(string) error {
+		validators := messageDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	moderationactionFields := schema.ModerationAction{}.Fields()
+	_ = moderationactionFields
+	// moderationactionDescUserID is the schema descriptor for user_id field.
+	moderationactionDescUserID := moderationactionFields[1].Descriptor()
+	// moderationaction.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	moderationaction.UserIDValidator = func() func(string) error {
+		validators := moderationactionDescUserID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(user string) error {
+			for _, fn := range fns {
+				if err := fn(user); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// moderationactionDescMessageID is the schema descriptor for message_id field.
+	moderationactionDescMessageID := moderationactionFields[2].Descriptor()
+	// moderationaction.MessageIDValidator is a validator for the "message_id" field. It is called by the builders before save.
+	moderationaction.MessageIDValidator = moderationactionDescMessageID.Validators[0].(func(string) error)
+	// moderationactionDescConversationID is the schema descriptor for conversation_id field.
+	moderationactionDescConversationID := moderationactionFields[3].Descriptor()
+	// moderationaction.ConversationIDValidator is a validator for the "conversation_id" field. It is called by the builders before save.
+	moderationaction.ConversationIDValidator = moderationactionDescConversationID.Validators[0].(func(string) error)
+	// moderationactionDescSource is the schema descriptor for source field.
+	moderationactionDescSource := moderationactionFields[5].Descriptor()
+	// moderationaction.SourceValidator is a validator for the "source" field. It is called by the builders before save.
+	moderationaction.SourceValidator = func() func(string) error {
+		validators := moderationactionDescSource.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(source string) error {
+			for _, fn := range fns {
+				if err := fn(source); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// moderationactionDescCreatedAt is the schema descriptor for created_at field.
+	moderationactionDescCreatedAt := moderationactionFields[7].Descriptor()
+	// moderationaction.DefaultCreatedAt holds the default value on creation for the created_at field.
+	moderationaction.DefaultCreatedAt = moderationactionDescCreatedAt.Default.(func() time.Time)
+	// moderationactionDescID is the schema descriptor for id field.
+	moderationactionDescID := moderationactionFields[0].Descriptor()
+	// moderationaction.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	moderationaction.IDValidator = func() func(string) error {
+		validators := moderationactionDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	notificationFields := schema.Notification{}.Fields()
+	_ = notificationFields
+	// notificationDescUserID is the schema descriptor for user_id field.
+	notificationDescUserID := notificationFields[1].Descriptor()
+	// notification.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	notification.UserIDValidator = func() func(string) error {
+		validators := notificationDescUserID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(user string) error {
+			for _, fn := range fns {
+				if err := fn(user); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// notificationDescNotificationType is the schema descriptor for notification_type field.
+	notificationDescNotificationType := notificationFields[2].Descriptor()
+	// notification.NotificationTypeValidator is a validator for the "notification_type" field. It is called by the builders before save.
+	notification.NotificationTypeValidator = func() func(string) error {
+		validators := notificationDescNotificationType.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(notification_type string) error {
+			for _, fn := range fns {
+				if err := fn(notification_type); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// notificationDescTitle is the schema descriptor for title field.
+	notificationDescTitle := notificationFields[3].Descriptor()
+	// notification.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	notification.TitleValidator = func() func(string) error {
+		validators := notificationDescTitle.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(title string) error {
+			for _, fn := range fns {
+				if err := fn(title); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// notificationDescCreatedAt is the schema descriptor for created_at field.
+	notificationDescCreatedAt := notificationFields[7].Descriptor()
+	// notification.DefaultCreatedAt holds the default value on creation for the created_at field.
+	notification.DefaultCreatedAt = notificationDescCreatedAt.Default.(func() time.Time)
+	// notificationDescID is the schema descriptor for id field.
+	notificationDescID := notificationFields[0].Descriptor()
+	// notification.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	notification.IDValidator = func() func(string) error {
+		validators := notificationDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	nudgeFields := schema.Nudge{}.Fields()
+	_ = nudgeFields
+	// nudgeDescStreakID is the schema descriptor for streak_id field.
+	nudgeDescStreakID := nudgeFields[1].Descriptor()
+	// nudge.StreakIDValidator is a validator for the "streak_id" field. It is called by the builders before save.
+	nudge.StreakIDValidator = func() func(string) error {
+		validators := nudgeDescStreakID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(streak string) error {
+			for _, fn := range fns {
+				if err := fn(streak); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// nudgeDescSenderUserID is the schema descriptor for sender_user_id field.
+	nudgeDescSenderUserID := nudgeFields[2].Descriptor()
+	// nudge.SenderUserIDValidator is a validator for the "sender_user_id" field. It is called by the builders before save.
+	nudge.SenderUserIDValidator = func() func(string) error {
+		validators := nudgeDescSenderUserID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(sender string) error {
+			for _, fn := range fns {
+				if err := fn(sender); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// nudgeDescReceiverUserID is the schema descriptor for receiver_user_id field.
+	nudgeDescReceiverUserID := nudgeFields[3].Descriptor()
+	// nudge.ReceiverUserIDValidator is a validator for the "receiver_user_id" field. It is called by the builders before save.
+	nudge.ReceiverUserIDValidator = func() func(string) error {
+		validators := nudgeDescReceiverUserID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(receiver string) error {
+			for _, fn := range fns {
+				if err := fn(receiver); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// nudgeDescDayNumber is the schema descriptor for day_number field.
+	nudgeDescDayNumber := nudgeFields[4].Descriptor()
+	// nudge.DayNumberValidator is a validator for the "day_number" field. It is called by the builders before save.
+	nudge.DayNumberValidator = func() func(int) error {
+		validators := nudgeDescDayNumber.Validators
+		fns := [...]func(int) error{
+			validators[0].(func(int) error),
+			validators[1].(func(int) error),
+		}
+		return func(day_number int) error {
 			for _, fn := range fns {
 				if err := fn(day_number); err != nil {
 					return err
@@ -865,6 +1785,72 @@ func init() {
 			return nil
 		}
 	}()
+	paymentdiscrepancyFields := schema.PaymentDiscrepancy{}.Fields()
+	_ = paymentdiscrepancyFields
+	// paymentdiscrepancyDescReportID is the schema descriptor for report_id field.
+	paymentdiscrepancyDescReportID := paymentdiscrepancyFields[1].Descriptor()
+	// paymentdiscrepancy.ReportIDValidator is a validator for the "report_id" field. It is called by the builders before save.
+	paymentdiscrepancy.ReportIDValidator = func() func(string) error {
+		validators := paymentdiscrepancyDescReportID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(report string) error {
+			for _, fn := range fns {
+				if err := fn(report); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// paymentdiscrepancyDescOrderID is the schema descriptor for order_id field.
+	paymentdiscrepancyDescOrderID := paymentdiscrepancyFields[2].Descriptor()
+	// paymentdiscrepancy.OrderIDValidator is a validator for the "order_id" field. It is called by the builders before save.
+	paymentdiscrepancy.OrderIDValidator = paymentdiscrepancyDescOrderID.Validators[0].(func(string) error)
+	// paymentdiscrepancyDescRazorpayOrderID is the schema descriptor for razorpay_order_id field.
+	paymentdiscrepancyDescRazorpayOrderID := paymentdiscrepancyFields[3].Descriptor()
+	// paymentdiscrepancy.RazorpayOrderIDValidator is a validator for the "razorpay_order_id" field. It is called by the builders before save.
+	paymentdiscrepancy.RazorpayOrderIDValidator = paymentdiscrepancyDescRazorpayOrderID.Validators[0].(func(string) error)
+	// paymentdiscrepancyDescRazorpayPaymentID is the schema descriptor for razorpay_payment_id field.
+	paymentdiscrepancyDescRazorpayPaymentID := paymentdiscrepancyFields[4].Descriptor()
+	// paymentdiscrepancy.RazorpayPaymentIDValidator is a validator for the "razorpay_payment_id" field. It is called by the builders before save.
+	paymentdiscrepancy.RazorpayPaymentIDValidator = paymentdiscrepancyDescRazorpayPaymentID.Validators[0].(func(string) error)
+	// paymentdiscrepancyDescDbStatus is the schema descriptor for db_status field.
+	paymentdiscrepancyDescDbStatus := paymentdiscrepancyFields[6].Descriptor()
+	// paymentdiscrepancy.DbStatusValidator is a validator for the "db_status" field. It is called by the builders before save.
+	paymentdiscrepancy.DbStatusValidator = paymentdiscrepancyDescDbStatus.Validators[0].(func(string) error)
+	// paymentdiscrepancyDescGatewayStatus is the schema descriptor for gateway_status field.
+	paymentdiscrepancyDescGatewayStatus := paymentdiscrepancyFields[7].Descriptor()
+	// paymentdiscrepancy.GatewayStatusValidator is a validator for the "gateway_status" field. It is called by the builders before save.
+	paymentdiscrepancy.GatewayStatusValidator = paymentdiscrepancyDescGatewayStatus.Validators[0].(func(string) error)
+	// paymentdiscrepancyDescDbAmount is the schema descriptor for db_amount field.
+	paymentdiscrepancyDescDbAmount := paymentdiscrepancyFields[8].Descriptor()
+	// paymentdiscrepancy.DefaultDbAmount holds the default value on creation for the db_amount field.
+	paymentdiscrepancy.DefaultDbAmount = paymentdiscrepancyDescDbAmount.Default.(int)
+	// paymentdiscrepancyDescGatewayAmount is the schema descriptor for gateway_amount field.
+	paymentdiscrepancyDescGatewayAmount := paymentdiscrepancyFields[9].Descriptor()
+	// paymentdiscrepancy.DefaultGatewayAmount holds the default value on creation for the gateway_amount field.
+	paymentdiscrepancy.DefaultGatewayAmount = paymentdiscrepancyDescGatewayAmount.Default.(int)
+	// paymentdiscrepancyDescID is the schema descriptor for id field.
+	paymentdiscrepancyDescID := paymentdiscrepancyFields[0].Descriptor()
+	// paymentdiscrepancy.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	paymentdiscrepancy.IDValidator = func() func(string) error {
+		validators := paymentdiscrepancyDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
 	paymentorderFields := schema.PaymentOrder{}.Fields()
 	_ = paymentorderFields
 	// paymentorderDescUserID is the schema descriptor for user_id field.
@@ -901,14 +1887,26 @@ func init() {
 	paymentorderDescRazorpaySignature := paymentorderFields[5].Descriptor()
 	// paymentorder.RazorpaySignatureValidator is a validator for the "razorpay_signature" field. It is called by the builders before save.
 	paymentorder.RazorpaySignatureValidator = paymentorderDescRazorpaySignature.Validators[0].(func(string) error)
+	// paymentorderDescDiscountAmount is the schema descriptor for discount_amount field.
+	paymentorderDescDiscountAmount := paymentorderFields[7].Descriptor()
+	// paymentorder.DefaultDiscountAmount holds the default value on creation for the discount_amount field.
+	paymentorder.DefaultDiscountAmount = paymentorderDescDiscountAmount.Default.(int)
+	// paymentorder.DiscountAmountValidator is a validator for the "discount_amount" field. It is called by the builders before save.
+	paymentorder.DiscountAmountValidator = paymentorderDescDiscountAmount.Validators[0].(func(int) error)
 	// paymentorderDescCurrency is the schema descriptor for currency field.
-	paymentorderDescCurrency := paymentorderFields[7].Descriptor()
+	paymentorderDescCurrency := paymentorderFields[8].Descriptor()
 	// paymentorder.DefaultCurrency holds the default value on creation for the currency field.
 	paymentorder.DefaultCurrency = paymentorderDescCurrency.Default.(string)
 	// paymentorder.CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
 	paymentorder.CurrencyValidator = paymentorderDescCurrency.Validators[0].(func(string) error)
+	// paymentorderDescAmountRefunded is the schema descriptor for amount_refunded field.
+	paymentorderDescAmountRefunded := paymentorderFields[11].Descriptor()
+	// paymentorder.DefaultAmountRefunded holds the default value on creation for the amount_refunded field.
+	paymentorder.DefaultAmountRefunded = paymentorderDescAmountRefunded.Default.(int)
+	// paymentorder.AmountRefundedValidator is a validator for the "amount_refunded" field. It is called by the builders before save.
+	paymentorder.AmountRefundedValidator = paymentorderDescAmountRefunded.Validators[0].(func(int) error)
 	// paymentorderDescCreatedAt is the schema descriptor for created_at field.
-	paymentorderDescCreatedAt := paymentorderFields[11].Descriptor()
+	paymentorderDescCreatedAt := paymentorderFields[13].Descriptor()
 	// paymentorder.DefaultCreatedAt holds the default value on creation for the created_at field.
 	paymentorder.DefaultCreatedAt = paymentorderDescCreatedAt.Default.(func() time.Time)
 	// paymentorderDescID is the schema descriptor for id field.
@@ -929,6 +1927,164 @@ func init() {
 			return nil
 		}
 	}()
+	paymentreconciliationreportFields := schema.PaymentReconciliationReport{}.Fields()
+	_ = paymentreconciliationreportFields
+	// paymentreconciliationreportDescReportDate is the schema descriptor for report_date field.
+	paymentreconciliationreportDescReportDate := paymentreconciliationreportFields[1].Descriptor()
+	// paymentreconciliationreport.ReportDateValidator is a validator for the "report_date" field. It is called by the builders before save.
+	paymentreconciliationreport.ReportDateValidator = func() func(string) error {
+		validators := paymentreconciliationreportDescReportDate.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(report_date string) error {
+			for _, fn := range fns {
+				if err := fn(report_date); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// paymentreconciliationreportDescOrdersChecked is the schema descriptor for orders_checked field.
+	paymentreconciliationreportDescOrdersChecked := paymentreconciliationreportFields[4].Descriptor()
+	// paymentreconciliationreport.DefaultOrdersChecked holds the default value on creation for the orders_checked field.
+	paymentreconciliationreport.DefaultOrdersChecked = paymentreconciliationreportDescOrdersChecked.Default.(int)
+	// paymentreconciliationreportDescGatewayPayments is the schema descriptor for gateway_payments field.
+	paymentreconciliationreportDescGatewayPayments := paymentreconciliationreportFields[5].Descriptor()
+	// paymentreconciliationreport.DefaultGatewayPayments holds the default value on creation for the gateway_payments field.
+	paymentreconciliationreport.DefaultGatewayPayments = paymentreconciliationreportDescGatewayPayments.Default.(int)
+	// paymentreconciliationreportDescMatched is the schema descriptor for matched field.
+	paymentreconciliationreportDescMatched := paymentreconciliationreportFields[6].Descriptor()
+	// paymentreconciliationreport.DefaultMatched holds the default value on creation for the matched field.
+	paymentreconciliationreport.DefaultMatched = paymentreconciliationreportDescMatched.Default.(int)
+	// paymentreconciliationreportDescDiscrepancyCount is the schema descriptor for discrepancy_count field.
+	paymentreconciliationreportDescDiscrepancyCount := paymentreconciliationreportFields[7].Descriptor()
+	// paymentreconciliationreport.DefaultDiscrepancyCount holds the default value on creation for the discrepancy_count field.
+	paymentreconciliationreport.DefaultDiscrepancyCount = paymentreconciliationreportDescDiscrepancyCount.Default.(int)
+	// paymentreconciliationreportDescDbPaidAmount is the schema descriptor for db_paid_amount field.
+	paymentreconciliationreportDescDbPaidAmount := paymentreconciliationreportFields[8].Descriptor()
+	// paymentreconciliationreport.DefaultDbPaidAmount holds the default value on creation for the db_paid_amount field.
+	paymentreconciliationreport.DefaultDbPaidAmount = paymentreconciliationreportDescDbPaidAmount.Default.(int)
+	// paymentreconciliationreportDescGatewayCapturedAmount is the schema descriptor for gateway_captured_amount field.
+	paymentreconciliationreportDescGatewayCapturedAmount := paymentreconciliationreportFields[9].Descriptor()
+	// paymentreconciliationreport.DefaultGatewayCapturedAmount holds the default value on creation for the gateway_captured_amount field.
+	paymentreconciliationreport.DefaultGatewayCapturedAmount = paymentreconciliationreportDescGatewayCapturedAmount.Default.(int)
+	// paymentreconciliationreportDescGeneratedAt is the schema descriptor for generated_at field.
+	paymentreconciliationreportDescGeneratedAt := paymentreconciliationreportFields[10].Descriptor()
+	// paymentreconciliationreport.DefaultGeneratedAt holds the default value on creation for the generated_at field.
+	paymentreconciliationreport.DefaultGeneratedAt = paymentreconciliationreportDescGeneratedAt.Default.(func() time.Time)
+	// paymentreconciliationreportDescID is the schema descriptor for id field.
+	paymentreconciliationreportDescID := paymentreconciliationreportFields[0].Descriptor()
+	// paymentreconciliationreport.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	paymentreconciliationreport.IDValidator = func() func(string) error {
+		validators := paymentreconciliationreportDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	paymentrefundFields := schema.PaymentRefund{}.Fields()
+	_ = paymentrefundFields
+	// paymentrefundDescOrderID is the schema descriptor for order_id field.
+	paymentrefundDescOrderID := paymentrefundFields[1].Descriptor()
+	// paymentrefund.OrderIDValidator is a validator for the "order_id" field. It is called by the builders before save.
+	paymentrefund.OrderIDValidator = func() func(string) error {
+		validators := paymentrefundDescOrderID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(_order string) error {
+			for _, fn := range fns {
+				if err := fn(_order); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// paymentrefundDescUserID is the schema descriptor for user_id field.
+	paymentrefundDescUserID := paymentrefundFields[2].Descriptor()
+	// paymentrefund.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	paymentrefund.UserIDValidator = func() func(string) error {
+		validators := paymentrefundDescUserID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(user_id string) error {
+			for _, fn := range fns {
+				if err := fn(user_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// paymentrefundDescRazorpayRefundID is the schema descriptor for razorpay_refund_id field.
+	paymentrefundDescRazorpayRefundID := paymentrefundFields[3].Descriptor()
+	// paymentrefund.RazorpayRefundIDValidator is a validator for the "razorpay_refund_id" field. It is called by the builders before save.
+	paymentrefund.RazorpayRefundIDValidator = paymentrefundDescRazorpayRefundID.Validators[0].(func(string) error)
+	// paymentrefundDescAmount is the schema descriptor for amount field.
+	paymentrefundDescAmount := paymentrefundFields[4].Descriptor()
+	// paymentrefund.AmountValidator is a validator for the "amount" field. It is called by the builders before save.
+	paymentrefund.AmountValidator = paymentrefundDescAmount.Validators[0].(func(int) error)
+	// paymentrefundDescCurrency is the schema descriptor for currency field.
+	paymentrefundDescCurrency := paymentrefundFields[5].Descriptor()
+	// paymentrefund.DefaultCurrency holds the default value on creation for the currency field.
+	paymentrefund.DefaultCurrency = paymentrefundDescCurrency.Default.(string)
+	// paymentrefund.CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	paymentrefund.CurrencyValidator = paymentrefundDescCurrency.Validators[0].(func(string) error)
+	// paymentrefundDescCreditsReversed is the schema descriptor for credits_reversed field.
+	paymentrefundDescCreditsReversed := paymentrefundFields[8].Descriptor()
+	// paymentrefund.DefaultCreditsReversed holds the default value on creation for the credits_reversed field.
+	paymentrefund.DefaultCreditsReversed = paymentrefundDescCreditsReversed.Default.(int)
+	// paymentrefund.CreditsReversedValidator is a validator for the "credits_reversed" field. It is called by the builders before save.
+	paymentrefund.CreditsReversedValidator = paymentrefundDescCreditsReversed.Validators[0].(func(int) error)
+	// paymentrefundDescCreditsWrittenOff is the schema descriptor for credits_written_off field.
+	paymentrefundDescCreditsWrittenOff := paymentrefundFields[9].Descriptor()
+	// paymentrefund.DefaultCreditsWrittenOff holds the default value on creation for the credits_written_off field.
+	paymentrefund.DefaultCreditsWrittenOff = paymentrefundDescCreditsWrittenOff.Default.(int)
+	// paymentrefund.CreditsWrittenOffValidator is a validator for the "credits_written_off" field. It is called by the builders before save.
+	paymentrefund.CreditsWrittenOffValidator = paymentrefundDescCreditsWrittenOff.Validators[0].(func(int) error)
+	// paymentrefundDescCreatedAt is the schema descriptor for created_at field.
+	paymentrefundDescCreatedAt := paymentrefundFields[12].Descriptor()
+	// paymentrefund.DefaultCreatedAt holds the default value on creation for the created_at field.
+	paymentrefund.DefaultCreatedAt = paymentrefundDescCreatedAt.Default.(func() time.Time)
+	// paymentrefundDescUpdatedAt is the schema descriptor for updated_at field.
+	paymentrefundDescUpdatedAt := paymentrefundFields[13].Descriptor()
+	// paymentrefund.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	paymentrefund.DefaultUpdatedAt = paymentrefundDescUpdatedAt.Default.(func() time.Time)
+	// paymentrefund.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	paymentrefund.UpdateDefaultUpdatedAt = paymentrefundDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// paymentrefundDescID is the schema descriptor for id field.
+	paymentrefundDescID := paymentrefundFields[0].Descriptor()
+	// paymentrefund.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	paymentrefund.IDValidator = func() func(string) error {
+		validators := paymentrefundDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
 	photoFields := schema.Photo{}.Fields()
 	_ = photoFields
 	// photoDescUserID is the schema descriptor for user_id field.
@@ -1087,6 +2243,362 @@ func init() {
 			return nil
 		}
 	}()
+	promocodeFields := schema.PromoCode{}.Fields()
+	_ = promocodeFields
+	// promocodeDescCode is the schema descriptor for code field.
+	promocodeDescCode := promocodeFields[1].Descriptor()
+	// promocode.CodeValidator is a validator for the "code" field. It is called by the builders before save.
+	promocode.CodeValidator = func() func(string) error {
+		validators := promocodeDescCode.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(code string) error {
+			for _, fn := range fns {
+				if err := fn(code); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// promocodeDescDescription is the schema descriptor for description field.
+	promocodeDescDescription := promocodeFields[2].Descriptor()
+	// promocode.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	promocode.DescriptionValidator = promocodeDescDescription.Validators[0].(func(string) error)
+	// promocodeDescCreditAmount is the schema descriptor for credit_amount field.
+	promocodeDescCreditAmount := promocodeFields[4].Descriptor()
+	// promocode.DefaultCreditAmount holds the default value on creation for the credit_amount field.
+	promocode.DefaultCreditAmount = promocodeDescCreditAmount.Default.(int)
+	// promocode.CreditAmountValidator is a validator for the "credit_amount" field. It is called by the builders before save.
+	promocode.CreditAmountValidator = promocodeDescCreditAmount.Validators[0].(func(int) error)
+	// promocodeDescDiscountPercent is the schema descriptor for discount_percent field.
+	promocodeDescDiscountPercent := promocodeFields[5].Descriptor()
+	// promocode.DefaultDiscountPercent holds the default value on creation for the discount_percent field.
+	promocode.DefaultDiscountPercent = promocodeDescDiscountPercent.Default.(int)
+	// promocode.DiscountPercentValidator is a validator for the "discount_percent" field. It is called by the builders before save.
+	promocode.DiscountPercentValidator = func() func(int) error {
+		validators := promocodeDescDiscountPercent.Validators
+		fns := [...]func(int) error{
+			validators[0].(func(int) error),
+			validators[1].(func(int) error),
+		}
+		return func(discount_percent int) error {
+			for _, fn := range fns {
+				if err := fn(discount_percent); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// promocodeDescPackageID is the schema descriptor for package_id field.
+	promocodeDescPackageID := promocodeFields[6].Descriptor()
+	// promocode.PackageIDValidator is a validator for the "package_id" field. It is called by the builders before save.
+	promocode.PackageIDValidator = promocodeDescPackageID.Validators[0].(func(string) error)
+	// promocodeDescMaxRedemptions is the schema descriptor for max_redemptions field.
+	promocodeDescMaxRedemptions := promocodeFields[7].Descriptor()
+	// promocode.MaxRedemptionsValidator is a validator for the "max_redemptions" field. It is called by the builders before save.
+	promocode.MaxRedemptionsValidator = promocodeDescMaxRedemptions.Validators[0].(func(int) error)
+	// promocodeDescPerUserLimit is the schema descriptor for per_user_limit field.
+	promocodeDescPerUserLimit := promocodeFields[8].Descriptor()
+	// promocode.DefaultPerUserLimit holds the default value on creation for the per_user_limit field.
+	promocode.DefaultPerUserLimit = promocodeDescPerUserLimit.Default.(int)
+	// promocode.PerUserLimitValidator is a validator for the "per_user_limit" field. It is called by the builders before save.
+	promocode.PerUserLimitValidator = promocodeDescPerUserLimit.Validators[0].(func(int) error)
+	// promocodeDescIsActive is the schema descriptor for is_active field.
+	promocodeDescIsActive := promocodeFields[11].Descriptor()
+	// promocode.DefaultIsActive holds the default value on creation for the is_active field.
+	promocode.DefaultIsActive = promocodeDescIsActive.Default.(bool)
+	// promocodeDescCreatedAt is the schema descriptor for created_at field.
+	promocodeDescCreatedAt := promocodeFields[12].Descriptor()
+	// promocode.DefaultCreatedAt holds the default value on creation for the created_at field.
+	promocode.DefaultCreatedAt = promocodeDescCreatedAt.Default.(func() time.Time)
+	// promocodeDescUpdatedAt is the schema descriptor for updated_at field.
+	promocodeDescUpdatedAt := promocodeFields[13].Descriptor()
+	// promocode.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	promocode.DefaultUpdatedAt = promocodeDescUpdatedAt.Default.(func() time.Time)
+	// promocode.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	promocode.UpdateDefaultUpdatedAt = promocodeDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// promocodeDescID is the schema descriptor for id field.
+	promocodeDescID := promocodeFields[0].Descriptor()
+	// promocode.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	promocode.IDValidator = func() func(string) error {
+		validators := promocodeDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	promoredemptionFields := schema.PromoRedemption{}.Fields()
+	_ = promoredemptionFields
+	// promoredemptionDescPromoCodeID is the schema descriptor for promo_code_id field.
+	promoredemptionDescPromoCodeID := promoredemptionFields[1].Descriptor()
+	// promoredemption.PromoCodeIDValidator is a validator for the "promo_code_id" field. It is called by the builders before save.
+	promoredemption.PromoCodeIDValidator = func() func(string) error {
+		validators := promoredemptionDescPromoCodeID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(promo_code string) error {
+			for _, fn := range fns {
+				if err := fn(promo_code); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// promoredemptionDescUserID is the schema descriptor for user_id field.
+	promoredemptionDescUserID := promoredemptionFields[2].Descriptor()
+	// promoredemption.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	promoredemption.UserIDValidator = func() func(string) error {
+		validators := promoredemptionDescUserID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(user_id string) error {
+			for _, fn := range fns {
+				if err := fn(user_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// promoredemptionDescOrderID is the schema descriptor for order_id field.
+	promoredemptionDescOrderID := promoredemptionFields[3].Descriptor()
+	// promoredemption.OrderIDValidator is a validator for the "order_id" field. It is called by the builders before save.
+	promoredemption.OrderIDValidator = promoredemptionDescOrderID.Validators[0].(func(string) error)
+	// promoredemptionDescCreditsGranted is the schema descriptor for credits_granted field.
+	promoredemptionDescCreditsGranted := promoredemptionFields[5].Descriptor()
+	// promoredemption.DefaultCreditsGranted holds the default value on creation for the credits_granted field.
+	promoredemption.DefaultCreditsGranted = promoredemptionDescCreditsGranted.Default.(int)
+	// promoredemption.CreditsGrantedValidator is a validator for the "credits_granted" field. It is called by the builders before save.
+	promoredemption.CreditsGrantedValidator = promoredemptionDescCreditsGranted.Validators[0].(func(int) error)
+	// promoredemptionDescDiscountAmount is the schema descriptor for discount_amount field.
+	promoredemptionDescDiscountAmount := promoredemptionFields[6].Descriptor()
+	// promoredemption.DefaultDiscountAmount holds the default value on creation for the discount_amount field.
+	promoredemption.DefaultDiscountAmount = promoredemptionDescDiscountAmount.Default.(int)
+	// promoredemption.DiscountAmountValidator is a validator for the "discount_amount" field. It is called by the builders before save.
+	promoredemption.DiscountAmountValidator = promoredemptionDescDiscountAmount.Validators[0].(func(int) error)
+	// promoredemptionDescCreatedAt is the schema descriptor for created_at field.
+	promoredemptionDescCreatedAt := promoredemptionFields[8].Descriptor()
+	// promoredemption.DefaultCreatedAt holds the default value on creation for the created_at field.
+	promoredemption.DefaultCreatedAt = promoredemptionDescCreatedAt.Default.(func() time.Time)
+	// promoredemptionDescID is the schema descriptor for id field.
+	promoredemptionDescID := promoredemptionFields[0].Descriptor()
+	// promoredemption.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	promoredemption.IDValidator = func() func(string) error {
+		validators := promoredemptionDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	referralFields := schema.Referral{}.Fields()
+	_ = referralFields
+	// referralDescReferrerID is the schema descriptor for referrer_id field.
+	referralDescReferrerID := referralFields[1].Descriptor()
+	// referral.ReferrerIDValidator is a validator for the "referrer_id" field. It is called by the builders before save.
+	referral.ReferrerIDValidator = func() func(string) error {
+		validators := referralDescReferrerID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(referrer_id string) error {
+			for _, fn := range fns {
+				if err := fn(referrer_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// referralDescRefereeID is the schema descriptor for referee_id field.
+	referralDescRefereeID := referralFields[2].Descriptor()
+	// referral.RefereeIDValidator is a validator for the "referee_id" field. It is called by the builders before save.
+	referral.RefereeIDValidator = func() func(string) error {
+		validators := referralDescRefereeID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(referee_id string) error {
+			for _, fn := range fns {
+				if err := fn(referee_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// referralDescCode is the schema descriptor for code field.
+	referralDescCode := referralFields[3].Descriptor()
+	// referral.CodeValidator is a validator for the "code" field. It is called by the builders before save.
+	referral.CodeValidator = func() func(string) error {
+		validators := referralDescCode.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(code string) error {
+			for _, fn := range fns {
+				if err := fn(code); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// referralDescDeviceID is the schema descriptor for device_id field.
+	referralDescDeviceID := referralFields[4].Descriptor()
+	// referral.DeviceIDValidator is a validator for the "device_id" field. It is called by the builders before save.
+	referral.DeviceIDValidator = referralDescDeviceID.Validators[0].(func(string) error)
+	// referralDescRejectionReason is the schema descriptor for rejection_reason field.
+	referralDescRejectionReason := referralFields[6].Descriptor()
+	// referral.RejectionReasonValidator is a validator for the "rejection_reason" field. It is called by the builders before save.
+	referral.RejectionReasonValidator = referralDescRejectionReason.Validators[0].(func(string) error)
+	// referralDescReferrerCredits is the schema descriptor for referrer_credits field.
+	referralDescReferrerCredits := referralFields[7].Descriptor()
+	// referral.DefaultReferrerCredits holds the default value on creation for the referrer_credits field.
+	referral.DefaultReferrerCredits = referralDescReferrerCredits.Default.(int)
+	// referral.ReferrerCreditsValidator is a validator for the "referrer_credits" field. It is called by the builders before save.
+	referral.ReferrerCreditsValidator = referralDescReferrerCredits.Validators[0].(func(int) error)
+	// referralDescRefereeCredits is the schema descriptor for referee_credits field.
+	referralDescRefereeCredits := referralFields[8].Descriptor()
+	// referral.DefaultRefereeCredits holds the default value on creation for the referee_credits field.
+	referral.DefaultRefereeCredits = referralDescRefereeCredits.Default.(int)
+	// referral.RefereeCreditsValidator is a validator for the "referee_credits" field. It is called by the builders before save.
+	referral.RefereeCreditsValidator = referralDescRefereeCredits.Validators[0].(func(int) error)
+	// referralDescCreatedAt is the schema descriptor for created_at field.
+	referralDescCreatedAt := referralFields[9].Descriptor()
+	// referral.DefaultCreatedAt holds the default value on creation for the created_at field.
+	referral.DefaultCreatedAt = referralDescCreatedAt.Default.(func() time.Time)
+	// referralDescID is the schema descriptor for id field.
+	referralDescID := referralFields[0].Descriptor()
+	// referral.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	referral.IDValidator = func() func(string) error {
+		validators := referralDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	reportevidenceFields := schema.ReportEvidence{}.Fields()
+	_ = reportevidenceFields
+	// reportevidenceDescReportID is the schema descriptor for report_id field.
+	reportevidenceDescReportID := reportevidenceFields[1].Descriptor()
+	// reportevidence.ReportIDValidator is a validator for the "report_id" field. It is called by the builders before save.
+	reportevidence.ReportIDValidator = func() func(string) error {
+		validators := reportevidenceDescReportID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(report string) error {
+			for _, fn := range fns {
+				if err := fn(report); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// reportevidenceDescMessageID is the schema descriptor for message_id field.
+	reportevidenceDescMessageID := reportevidenceFields[2].Descriptor()
+	// reportevidence.MessageIDValidator is a validator for the "message_id" field. It is called by the builders before save.
+	reportevidence.MessageIDValidator = func() func(string) error {
+		validators := reportevidenceDescMessageID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(message_id string) error {
+			for _, fn := range fns {
+				if err := fn(message_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// reportevidenceDescSenderUserID is the schema descriptor for sender_user_id field.
+	reportevidenceDescSenderUserID := reportevidenceFields[3].Descriptor()
+	// reportevidence.SenderUserIDValidator is a validator for the "sender_user_id" field. It is called by the builders before save.
+	reportevidence.SenderUserIDValidator = func() func(string) error {
+		validators := reportevidenceDescSenderUserID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(sender_user_id string) error {
+			for _, fn := range fns {
+				if err := fn(sender_user_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// reportevidenceDescModerationStatus is the schema descriptor for moderation_status field.
+	reportevidenceDescModerationStatus := reportevidenceFields[5].Descriptor()
+	// reportevidence.ModerationStatusValidator is a validator for the "moderation_status" field. It is called by the builders before save.
+	reportevidence.ModerationStatusValidator = reportevidenceDescModerationStatus.Validators[0].(func(string) error)
+	// reportevidenceDescIsReportedMessage is the schema descriptor for is_reported_message field.
+	reportevidenceDescIsReportedMessage := reportevidenceFields[7].Descriptor()
+	// reportevidence.DefaultIsReportedMessage holds the default value on creation for the is_reported_message field.
+	reportevidence.DefaultIsReportedMessage = reportevidenceDescIsReportedMessage.Default.(bool)
+	// reportevidenceDescCreatedAt is the schema descriptor for created_at field.
+	reportevidenceDescCreatedAt := reportevidenceFields[8].Descriptor()
+	// reportevidence.DefaultCreatedAt holds the default value on creation for the created_at field.
+	reportevidence.DefaultCreatedAt = reportevidenceDescCreatedAt.Default.(func() time.Time)
+	// reportevidenceDescID is the schema descriptor for id field.
+	reportevidenceDescID := reportevidenceFields[0].Descriptor()
+	// reportevidence.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	reportevidence.IDValidator = func() func(string) error {
+		validators := reportevidenceDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
 	revealFields := schema.Reveal{}.Fields()
 	_ = revealFields
 	// revealDescConnectionID is the schema descriptor for connection_id field.
@@ -1125,63 +2637,171 @@ func init() {
 			return nil
 		}
 	}()
-	// revealDescCreatedAt is the schema descriptor for created_at field.
-	revealDescCreatedAt := revealFields[5].Descriptor()
-	// reveal.DefaultCreatedAt holds the default value on creation for the created_at field.
-	reveal.DefaultCreatedAt = revealDescCreatedAt.Default.(func() time.Time)
-	// revealDescID is the schema descriptor for id field.
-	revealDescID := revealFields[0].Descriptor()
-	// reveal.IDValidator is a validator for the "id" field. It is called by the builders before save.
-	reveal.IDValidator = func() func(string) error {
-		validators := revealDescID.Validators
+	// revealDescCreatedAt is the schema descriptor for created_at field.
+	revealDescCreatedAt := revealFields[5].Descriptor()
+	// reveal.DefaultCreatedAt holds the default value on creation for the created_at field.
+	reveal.DefaultCreatedAt = revealDescCreatedAt.Default.(func() time.Time)
+	// revealDescID is the schema descriptor for id field.
+	revealDescID := revealFields[0].Descriptor()
+	// reveal.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	reveal.IDValidator = func() func(string) error {
+		validators := revealDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	revealcontentFields := schema.RevealContent{}.Fields()
+	_ = revealcontentFields
+	// revealcontentDescRevealID is the schema descriptor for reveal_id field.
+	revealcontentDescRevealID := revealcontentFields[1].Descriptor()
+	// revealcontent.RevealIDValidator is a validator for the "reveal_id" field. It is called by the builders before save.
+	revealcontent.RevealIDValidator = func() func(string) error {
+		validators := revealcontentDescRevealID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(reveal string) error {
+			for _, fn := range fns {
+				if err := fn(reveal); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// revealcontentDescCreatedAt is the schema descriptor for created_at field.
+	revealcontentDescCreatedAt := revealcontentFields[6].Descriptor()
+	// revealcontent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	revealcontent.DefaultCreatedAt = revealcontentDescCreatedAt.Default.(func() time.Time)
+	// revealcontentDescUpdatedAt is the schema descriptor for updated_at field.
+	revealcontentDescUpdatedAt := revealcontentFields[7].Descriptor()
+	// revealcontent.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	revealcontent.DefaultUpdatedAt = revealcontentDescUpdatedAt.Default.(func() time.Time)
+	// revealcontent.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	revealcontent.UpdateDefaultUpdatedAt = revealcontentDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// revealcontentDescID is the schema descriptor for id field.
+	revealcontentDescID := revealcontentFields[0].Descriptor()
+	// revealcontent.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	revealcontent.IDValidator = func() func(string) error {
+		validators := revealcontentDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	revealgiftFields := schema.RevealGift{}.Fields()
+	_ = revealgiftFields
+	// revealgiftDescConnectionID is the schema descriptor for connection_id field.
+	revealgiftDescConnectionID := revealgiftFields[1].Descriptor()
+	// revealgift.ConnectionIDValidator is a validator for the "connection_id" field. It is called by the builders before save.
+	revealgift.ConnectionIDValidator = func() func(string) error {
+		validators := revealgiftDescConnectionID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(connection string) error {
+			for _, fn := range fns {
+				if err := fn(connection); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// revealgiftDescMilestoneID is the schema descriptor for milestone_id field.
+	revealgiftDescMilestoneID := revealgiftFields[2].Descriptor()
+	// revealgift.MilestoneIDValidator is a validator for the "milestone_id" field. It is called by the builders before save.
+	revealgift.MilestoneIDValidator = func() func(string) error {
+		validators := revealgiftDescMilestoneID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(milestone string) error {
+			for _, fn := range fns {
+				if err := fn(milestone); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// revealgiftDescGifterUserID is the schema descriptor for gifter_user_id field.
+	revealgiftDescGifterUserID := revealgiftFields[3].Descriptor()
+	// revealgift.GifterUserIDValidator is a validator for the "gifter_user_id" field. It is called by the builders before save.
+	revealgift.GifterUserIDValidator = func() func(string) error {
+		validators := revealgiftDescGifterUserID.Validators
 		fns := [...]func(string) error{
 			validators[0].(func(string) error),
 			validators[1].(func(string) error),
 		}
-		return func(id string) error {
+		return func(gifter string) error {
 			for _, fn := range fns {
-				if err := fn(id); err != nil {
+				if err := fn(gifter); err != nil {
 					return err
 				}
 			}
 			return nil
 		}
 	}()
-	revealcontentFields := schema.RevealContent{}.Fields()
-	_ = revealcontentFields
-	// revealcontentDescRevealID is the schema descriptor for reveal_id field.
-	revealcontentDescRevealID := revealcontentFields[1].Descriptor()
-	// revealcontent.RevealIDValidator is a validator for the "reveal_id" field. It is called by the builders before save.
-	revealcontent.RevealIDValidator = func() func(string) error {
-		validators := revealcontentDescRevealID.Validators
+	// revealgiftDescRecipientUserID is the schema descriptor for recipient_user_id field.
+	revealgiftDescRecipientUserID := revealgiftFields[4].Descriptor()
+	// revealgift.RecipientUserIDValidator is a validator for the "recipient_user_id" field. It is called by the builders before save.
+	revealgift.RecipientUserIDValidator = func() func(string) error {
+		validators := revealgiftDescRecipientUserID.Validators
 		fns := [...]func(string) error{
 			validators[0].(func(string) error),
 			validators[1].(func(string) error),
 		}
-		return func(reveal string) error {
+		return func(recipient string) error {
 			for _, fn := range fns {
-				if err := fn(reveal); err != nil {
+				if err := fn(recipient); err != nil {
 					return err
 				}
 			}
 			return nil
 		}
 	}()
-	// revealcontentDescCreatedAt is the schema descriptor for created_at field.
-	revealcontentDescCreatedAt := revealcontentFields[6].Descriptor()
-	// revealcontent.DefaultCreatedAt holds the default value on creation for the created_at field.
-	revealcontent.DefaultCreatedAt = revealcontentDescCreatedAt.Default.(func() time.Time)
-	// revealcontentDescUpdatedAt is the schema descriptor for updated_at field.
-	revealcontentDescUpdatedAt := revealcontentFields[7].Descriptor()
-	// revealcontent.DefaultUpdatedAt holds the default value on creation for the updated_at field.
-	revealcontent.DefaultUpdatedAt = revealcontentDescUpdatedAt.Default.(func() time.Time)
-	// revealcontent.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
-	revealcontent.UpdateDefaultUpdatedAt = revealcontentDescUpdatedAt.UpdateDefault.(func() time.Time)
-	// revealcontentDescID is the schema descriptor for id field.
-	revealcontentDescID := revealcontentFields[0].Descriptor()
-	// revealcontent.IDValidator is a validator for the "id" field. It is called by the builders before save.
-	revealcontent.IDValidator = func() func(string) error {
-		validators := revealcontentDescID.Validators
+	// revealgiftDescCreditCost is the schema descriptor for credit_cost field.
+	revealgiftDescCreditCost := revealgiftFields[5].Descriptor()
+	// revealgift.CreditCostValidator is a validator for the "credit_cost" field. It is called by the builders before save.
+	revealgift.CreditCostValidator = revealgiftDescCreditCost.Validators[0].(func(int) error)
+	// revealgiftDescRevealID is the schema descriptor for reveal_id field.
+	revealgiftDescRevealID := revealgiftFields[8].Descriptor()
+	// revealgift.RevealIDValidator is a validator for the "reveal_id" field. It is called by the builders before save.
+	revealgift.RevealIDValidator = revealgiftDescRevealID.Validators[0].(func(string) error)
+	// revealgiftDescCreditTransactionID is the schema descriptor for credit_transaction_id field.
+	revealgiftDescCreditTransactionID := revealgiftFields[9].Descriptor()
+	// revealgift.CreditTransactionIDValidator is a validator for the "credit_transaction_id" field. It is called by the builders before save.
+	revealgift.CreditTransactionIDValidator = revealgiftDescCreditTransactionID.Validators[0].(func(string) error)
+	// revealgiftDescCreatedAt is the schema descriptor for created_at field.
+	revealgiftDescCreatedAt := revealgiftFields[12].Descriptor()
+	// revealgift.DefaultCreatedAt holds the default value on creation for the created_at field.
+	revealgift.DefaultCreatedAt = revealgiftDescCreatedAt.Default.(func() time.Time)
+	// revealgiftDescID is the schema descriptor for id field.
+	revealgiftDescID := revealgiftFields[0].Descriptor()
+	// revealgift.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	revealgift.IDValidator = func() func(string) error {
+		validators := revealgiftDescID.Validators
 		fns := [...]func(string) error{
 			validators[0].(func(string) error),
 			validators[1].(func(string) error),
@@ -1281,6 +2901,78 @@ func init() {
 			return nil
 		}
 	}()
+	revealviewFields := schema.RevealView{}.Fields()
+	_ = revealviewFields
+	// revealviewDescRevealID is the schema descriptor for reveal_id field.
+	revealviewDescRevealID := revealviewFields[1].Descriptor()
+	// revealview.RevealIDValidator is a validator for the "reveal_id" field. It is called by the builders before save.
+	revealview.RevealIDValidator = func() func(string) error {
+		validators := revealviewDescRevealID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(reveal string) error {
+			for _, fn := range fns {
+				if err := fn(reveal); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// revealviewDescViewerUserID is the schema descriptor for viewer_user_id field.
+	revealviewDescViewerUserID := revealviewFields[2].Descriptor()
+	// revealview.ViewerUserIDValidator is a validator for the "viewer_user_id" field. It is called by the builders before save.
+	revealview.ViewerUserIDValidator = func() func(string) error {
+		validators := revealviewDescViewerUserID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(viewer string) error {
+			for _, fn := range fns {
+				if err := fn(viewer); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// revealviewDescIsFirstView is the schema descriptor for is_first_view field.
+	revealviewDescIsFirstView := revealviewFields[3].Descriptor()
+	// revealview.DefaultIsFirstView holds the default value on creation for the is_first_view field.
+	revealview.DefaultIsFirstView = revealviewDescIsFirstView.Default.(bool)
+	// revealviewDescFirstViewKey is the schema descriptor for first_view_key field.
+	revealviewDescFirstViewKey := revealviewFields[4].Descriptor()
+	// revealview.FirstViewKeyValidator is a validator for the "first_view_key" field. It is called by the builders before save.
+	revealview.FirstViewKeyValidator = revealviewDescFirstViewKey.Validators[0].(func(string) error)
+	// revealviewDescDurationSeconds is the schema descriptor for duration_seconds field.
+	revealviewDescDurationSeconds := revealviewFields[5].Descriptor()
+	// revealview.DurationSecondsValidator is a validator for the "duration_seconds" field. It is called by the builders before save.
+	revealview.DurationSecondsValidator = revealviewDescDurationSeconds.Validators[0].(func(int) error)
+	// revealviewDescCreatedAt is the schema descriptor for created_at field.
+	revealviewDescCreatedAt := revealviewFields[7].Descriptor()
+	// revealview.DefaultCreatedAt holds the default value on creation for the created_at field.
+	revealview.DefaultCreatedAt = revealviewDescCreatedAt.Default.(func() time.Time)
+	// revealviewDescID is the schema descriptor for id field.
+	revealviewDescID := revealviewFields[0].Descriptor()
+	// revealview.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	revealview.IDValidator = func() func(string) error {
+		validators := revealviewDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
 	serverFields := schema.Server{}.Fields()
 	_ = serverFields
 	// serverDescDisplayName is the schema descriptor for display_name field.
@@ -1393,12 +3085,18 @@ func init() {
 	streakDescRecoveryPaymentID := streakFields[7].Descriptor()
 	// streak.RecoveryPaymentIDValidator is a validator for the "recovery_payment_id" field. It is called by the builders before save.
 	streak.RecoveryPaymentIDValidator = streakDescRecoveryPaymentID.Validators[0].(func(string) error)
+	// streakDescFreeRecoveriesUsed is the schema descriptor for free_recoveries_used field.
+	streakDescFreeRecoveriesUsed := streakFields[8].Descriptor()
+	// streak.DefaultFreeRecoveriesUsed holds the default value on creation for the free_recoveries_used field.
+	streak.DefaultFreeRecoveriesUsed = streakDescFreeRecoveriesUsed.Default.(int)
+	// streak.FreeRecoveriesUsedValidator is a validator for the "free_recoveries_used" field. It is called by the builders before save.
+	streak.FreeRecoveriesUsedValidator = streakDescFreeRecoveriesUsed.Validators[0].(func(int) error)
 	// streakDescCreatedAt is the schema descriptor for created_at field.
-	streakDescCreatedAt := streakFields[9].Descriptor()
+	streakDescCreatedAt := streakFields[10].Descriptor()
 	// streak.DefaultCreatedAt holds the default value on creation for the created_at field.
 	streak.DefaultCreatedAt = streakDescCreatedAt.Default.(func() time.Time)
 	// streakDescUpdatedAt is the schema descriptor for updated_at field.
-	streakDescUpdatedAt := streakFields[10].Descriptor()
+	streakDescUpdatedAt := streakFields[11].Descriptor()
 	// streak.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	streak.DefaultUpdatedAt = streakDescUpdatedAt.Default.(func() time.Time)
 	// streak.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -1421,6 +3119,208 @@ func init() {
 			return nil
 		}
 	}()
+	subscriptionFields := schema.Subscription{}.Fields()
+	_ = subscriptionFields
+	// subscriptionDescUserID is the schema descriptor for user_id field.
+	subscriptionDescUserID := subscriptionFields[1].Descriptor()
+	// subscription.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	subscription.UserIDValidator = func() func(string) error {
+		validators := subscriptionDescUserID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(user string) error {
+			for _, fn := range fns {
+				if err := fn(user); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// subscriptionDescPlanID is the schema descriptor for plan_id field.
+	subscriptionDescPlanID := subscriptionFields[2].Descriptor()
+	// subscription.PlanIDValidator is a validator for the "plan_id" field. It is called by the builders before save.
+	subscription.PlanIDValidator = func() func(string) error {
+		validators := subscriptionDescPlanID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(plan string) error {
+			for _, fn := range fns {
+				if err := fn(plan); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// subscriptionDescRazorpaySubscriptionID is the schema descriptor for razorpay_subscription_id field.
+	subscriptionDescRazorpaySubscriptionID := subscriptionFields[3].Descriptor()
+	// subscription.RazorpaySubscriptionIDValidator is a validator for the "razorpay_subscription_id" field. It is called by the builders before save.
+	subscription.RazorpaySubscriptionIDValidator = subscriptionDescRazorpaySubscriptionID.Validators[0].(func(string) error)
+	// subscriptionDescCancelAtPeriodEnd is the schema descriptor for cancel_at_period_end field.
+	subscriptionDescCancelAtPeriodEnd := subscriptionFields[8].Descriptor()
+	// subscription.DefaultCancelAtPeriodEnd holds the default value on creation for the cancel_at_period_end field.
+	subscription.DefaultCancelAtPeriodEnd = subscriptionDescCancelAtPeriodEnd.Default.(bool)
+	// subscriptionDescPaidCount is the schema descriptor for paid_count field.
+	subscriptionDescPaidCount := subscriptionFields[9].Descriptor()
+	// subscription.DefaultPaidCount holds the default value on creation for the paid_count field.
+	subscription.DefaultPaidCount = subscriptionDescPaidCount.Default.(int)
+	// subscriptionDescShortURL is the schema descriptor for short_url field.
+	subscriptionDescShortURL := subscriptionFields[10].Descriptor()
+	// subscription.ShortURLValidator is a validator for the "short_url" field. It is called by the builders before save.
+	subscription.ShortURLValidator = subscriptionDescShortURL.Validators[0].(func(string) error)
+	// subscriptionDescCreatedAt is the schema descriptor for created_at field.
+	subscriptionDescCreatedAt := subscriptionFields[11].Descriptor()
+	// subscription.DefaultCreatedAt holds the default value on creation for the created_at field.
+	subscription.DefaultCreatedAt = subscriptionDescCreatedAt.Default.(func() time.Time)
+	// subscriptionDescUpdatedAt is the schema descriptor for updated_at field.
+	subscriptionDescUpdatedAt := subscriptionFields[12].Descriptor()
+	// subscription.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	subscription.DefaultUpdatedAt = subscriptionDescUpdatedAt.Default.(func() time.Time)
+	// subscription.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	subscription.UpdateDefaultUpdatedAt = subscriptionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// subscriptionDescID is the schema descriptor for id field.
+	subscriptionDescID := subscriptionFields[0].Descriptor()
+	// subscription.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	subscription.IDValidator = func() func(string) error {
+		validators := subscriptionDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	subscriptionplanFields := schema.SubscriptionPlan{}.Fields()
+	_ = subscriptionplanFields
+	// subscriptionplanDescName is the schema descriptor for name field.
+	subscriptionplanDescName := subscriptionplanFields[1].Descriptor()
+	// subscriptionplan.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	subscriptionplan.NameValidator = func() func(string) error {
+		validators := subscriptionplanDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// subscriptionplanDescPriceAmount is the schema descriptor for price_amount field.
+	subscriptionplanDescPriceAmount := subscriptionplanFields[4].Descriptor()
+	// subscriptionplan.PriceAmountValidator is a validator for the "price_amount" field. It is called by the builders before save.
+	subscriptionplan.PriceAmountValidator = subscriptionplanDescPriceAmount.Validators[0].(func(int) error)
+	// subscriptionplanDescCurrency is the schema descriptor for currency field.
+	subscriptionplanDescCurrency := subscriptionplanFields[5].Descriptor()
+	// subscriptionplan.DefaultCurrency holds the default value on creation for the currency field.
+	subscriptionplan.DefaultCurrency = subscriptionplanDescCurrency.Default.(string)
+	// subscriptionplan.CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	subscriptionplan.CurrencyValidator = subscriptionplanDescCurrency.Validators[0].(func(string) error)
+	// subscriptionplanDescRazorpayPlanID is the schema descriptor for razorpay_plan_id field.
+	subscriptionplanDescRazorpayPlanID := subscriptionplanFields[6].Descriptor()
+	// subscriptionplan.RazorpayPlanIDValidator is a validator for the "razorpay_plan_id" field. It is called by the builders before save.
+	subscriptionplan.RazorpayPlanIDValidator = subscriptionplanDescRazorpayPlanID.Validators[0].(func(string) error)
+	// subscriptionplanDescIsActive is the schema descriptor for is_active field.
+	subscriptionplanDescIsActive := subscriptionplanFields[7].Descriptor()
+	// subscriptionplan.DefaultIsActive holds the default value on creation for the is_active field.
+	subscriptionplan.DefaultIsActive = subscriptionplanDescIsActive.Default.(bool)
+	// subscriptionplanDescSortOrder is the schema descriptor for sort_order field.
+	subscriptionplanDescSortOrder := subscriptionplanFields[8].Descriptor()
+	// subscriptionplan.DefaultSortOrder holds the default value on creation for the sort_order field.
+	subscriptionplan.DefaultSortOrder = subscriptionplanDescSortOrder.Default.(int)
+	// subscriptionplanDescCreatedAt is the schema descriptor for created_at field.
+	subscriptionplanDescCreatedAt := subscriptionplanFields[9].Descriptor()
+	// subscriptionplan.DefaultCreatedAt holds the default value on creation for the created_at field.
+	subscriptionplan.DefaultCreatedAt = subscriptionplanDescCreatedAt.Default.(func() time.Time)
+	// subscriptionplanDescUpdatedAt is the schema descriptor for updated_at field.
+	subscriptionplanDescUpdatedAt := subscriptionplanFields[10].Descriptor()
+	// subscriptionplan.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	subscriptionplan.DefaultUpdatedAt = subscriptionplanDescUpdatedAt.Default.(func() time.Time)
+	// subscriptionplan.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	subscriptionplan.UpdateDefaultUpdatedAt = subscriptionplanDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// subscriptionplanDescID is the schema descriptor for id field.
+	subscriptionplanDescID := subscriptionplanFields[0].Descriptor()
+	// subscriptionplan.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	subscriptionplan.IDValidator = func() func(string) error {
+		validators := subscriptionplanDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	tierentitlementFields := schema.TierEntitlement{}.Fields()
+	_ = tierentitlementFields
+	// tierentitlementDescConnectionSlots is the schema descriptor for connection_slots field.
+	tierentitlementDescConnectionSlots := tierentitlementFields[2].Descriptor()
+	// tierentitlement.ConnectionSlotsValidator is a validator for the "connection_slots" field. It is called by the builders before save.
+	tierentitlement.ConnectionSlotsValidator = tierentitlementDescConnectionSlots.Validators[0].(func(int) error)
+	// tierentitlementDescRefreshCooldownMinutes is the schema descriptor for refresh_cooldown_minutes field.
+	tierentitlementDescRefreshCooldownMinutes := tierentitlementFields[3].Descriptor()
+	// tierentitlement.RefreshCooldownMinutesValidator is a validator for the "refresh_cooldown_minutes" field. It is called by the builders before save.
+	tierentitlement.RefreshCooldownMinutesValidator = tierentitlementDescRefreshCooldownMinutes.Validators[0].(func(int) error)
+	// tierentitlementDescNudgesPerAtRisk is the schema descriptor for nudges_per_at_risk field.
+	tierentitlementDescNudgesPerAtRisk := tierentitlementFields[4].Descriptor()
+	// tierentitlement.NudgesPerAtRiskValidator is a validator for the "nudges_per_at_risk" field. It is called by the builders before save.
+	tierentitlement.NudgesPerAtRiskValidator = tierentitlementDescNudgesPerAtRisk.Validators[0].(func(int) error)
+	// tierentitlementDescNudgeIntervalMinutes is the schema descriptor for nudge_interval_minutes field.
+	tierentitlementDescNudgeIntervalMinutes := tierentitlementFields[5].Descriptor()
+	// tierentitlement.NudgeIntervalMinutesValidator is a validator for the "nudge_interval_minutes" field. It is called by the builders before save.
+	tierentitlement.NudgeIntervalMinutesValidator = tierentitlementDescNudgeIntervalMinutes.Validators[0].(func(int) error)
+	// tierentitlementDescFreeRecoveriesPerConnection is the schema descriptor for free_recoveries_per_connection field.
+	tierentitlementDescFreeRecoveriesPerConnection := tierentitlementFields[6].Descriptor()
+	// tierentitlement.FreeRecoveriesPerConnectionValidator is a validator for the "free_recoveries_per_connection" field. It is called by the builders before save.
+	tierentitlement.FreeRecoveriesPerConnectionValidator = tierentitlementDescFreeRecoveriesPerConnection.Validators[0].(func(int) error)
+	// tierentitlementDescPurchasableReveals is the schema descriptor for purchasable_reveals field.
+	tierentitlementDescPurchasableReveals := tierentitlementFields[7].Descriptor()
+	// tierentitlement.PurchasableRevealsValidator is a validator for the "purchasable_reveals" field. It is called by the builders before save.
+	tierentitlement.PurchasableRevealsValidator = tierentitlementDescPurchasableReveals.Validators[0].(func(int) error)
+	// tierentitlementDescUpdatedAt is the schema descriptor for updated_at field.
+	tierentitlementDescUpdatedAt := tierentitlementFields[9].Descriptor()
+	// tierentitlement.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	tierentitlement.DefaultUpdatedAt = tierentitlementDescUpdatedAt.Default.(func() time.Time)
+	// tierentitlement.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	tierentitlement.UpdateDefaultUpdatedAt = tierentitlementDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// tierentitlementDescID is the schema descriptor for id field.
+	tierentitlementDescID := tierentitlementFields[0].Descriptor()
+	// tierentitlement.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	tierentitlement.IDValidator = func() func(string) error {
+		validators := tierentitlementDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
 	userFields := schema.User{}.Fields()
 	_ = userFields
 	// userDescEmail is the schema descriptor for email field.
@@ -1503,12 +3403,24 @@ func init() {
 	userDescSuspensionReason := userFields[31].Descriptor()
 	// user.SuspensionReasonValidator is a validator for the "suspension_reason" field. It is called by the builders before save.
 	user.SuspensionReasonValidator = userDescSuspensionReason.Validators[0].(func(string) error)
+	// userDescReferralCode is the schema descriptor for referral_code field.
+	userDescReferralCode := userFields[32].Descriptor()
+	// user.ReferralCodeValidator is a validator for the "referral_code" field. It is called by the builders before save.
+	user.ReferralCodeValidator = userDescReferralCode.Validators[0].(func(string) error)
+	// userDescSignupDeviceID is the schema descriptor for signup_device_id field.
+	userDescSignupDeviceID := userFields[33].Descriptor()
+	// user.SignupDeviceIDValidator is a validator for the "signup_device_id" field. It is called by the builders before save.
+	user.SignupDeviceIDValidator = userDescSignupDeviceID.Validators[0].(func(string) error)
+	// userDescPhoneHash is the schema descriptor for phone_hash field.
+	userDescPhoneHash := userFields[34].Descriptor()
+	// user.PhoneHashValidator is a validator for the "phone_hash" field. It is called by the builders before save.
+	user.PhoneHashValidator = userDescPhoneHash.Validators[0].(func(string) error)
 	// userDescCreatedAt is the schema descriptor for created_at field.
-	userDescCreatedAt := userFields[32].Descriptor()
+	userDescCreatedAt := userFields[35].Descriptor()
 	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
 	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
 	// userDescUpdatedAt is the schema descriptor for updated_at field.
-	userDescUpdatedAt := userFields[33].Descriptor()
+	userDescUpdatedAt := userFields[36].Descriptor()
 	// user.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	user.DefaultUpdatedAt = userDescUpdatedAt.Default.(func() time.Time)
 	// user.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -1591,6 +3503,142 @@ func init() {
 			return nil
 		}
 	}()
+	userdeviceFields := schema.UserDevice{}.Fields()
+	_ = userdeviceFields
+	// userdeviceDescUserID is the schema descriptor for user_id field.
+	userdeviceDescUserID := userdeviceFields[1].Descriptor()
+	// userdevice.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	userdevice.UserIDValidator = func() func(string) error {
+		validators := userdeviceDescUserID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(user_id string) error {
+			for _, fn := range fns {
+				if err := fn(user_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// userdeviceDescDeviceID is the schema descriptor for device_id field.
+	userdeviceDescDeviceID := userdeviceFields[2].Descriptor()
+	// userdevice.DeviceIDValidator is a validator for the "device_id" field. It is called by the builders before save.
+	userdevice.DeviceIDValidator = func() func(string) error {
+		validators := userdeviceDescDeviceID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(device_id string) error {
+			for _, fn := range fns {
+				if err := fn(device_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// userdeviceDescLoginCount is the schema descriptor for login_count field.
+	userdeviceDescLoginCount := userdeviceFields[5].Descriptor()
+	// userdevice.DefaultLoginCount holds the default value on creation for the login_count field.
+	userdevice.DefaultLoginCount = userdeviceDescLoginCount.Default.(int)
+	// userdeviceDescFirstSeenAt is the schema descriptor for first_seen_at field.
+	userdeviceDescFirstSeenAt := userdeviceFields[6].Descriptor()
+	// userdevice.DefaultFirstSeenAt holds the default value on creation for the first_seen_at field.
+	userdevice.DefaultFirstSeenAt = userdeviceDescFirstSeenAt.Default.(func() time.Time)
+	// userdeviceDescLastSeenAt is the schema descriptor for last_seen_at field.
+	userdeviceDescLastSeenAt := userdeviceFields[7].Descriptor()
+	// userdevice.DefaultLastSeenAt holds the default value on creation for the last_seen_at field.
+	userdevice.DefaultLastSeenAt = userdeviceDescLastSeenAt.Default.(func() time.Time)
+	// userdeviceDescID is the schema descriptor for id field.
+	userdeviceDescID := userdeviceFields[0].Descriptor()
+	// userdevice.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	userdevice.IDValidator = func() func(string) error {
+		validators := userdeviceDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	useridentityFields := schema.UserIdentity{}.Fields()
+	_ = useridentityFields
+	// useridentityDescUserID is the schema descriptor for user_id field.
+	useridentityDescUserID := useridentityFields[1].Descriptor()
+	// useridentity.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	useridentity.UserIDValidator = func() func(string) error {
+		validators := useridentityDescUserID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(user_id string) error {
+			for _, fn := range fns {
+				if err := fn(user_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// useridentityDescSubject is the schema descriptor for subject field.
+	useridentityDescSubject := useridentityFields[3].Descriptor()
+	// useridentity.SubjectValidator is a validator for the "subject" field. It is called by the builders before save.
+	useridentity.SubjectValidator = func() func(string) error {
+		validators := useridentityDescSubject.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(subject string) error {
+			for _, fn := range fns {
+				if err := fn(subject); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// useridentityDescEmail is the schema descriptor for email field.
+	useridentityDescEmail := useridentityFields[4].Descriptor()
+	// useridentity.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	useridentity.EmailValidator = useridentityDescEmail.Validators[0].(func(string) error)
+	// useridentityDescIsPrivateEmail is the schema descriptor for is_private_email field.
+	useridentityDescIsPrivateEmail := useridentityFields[5].Descriptor()
+	// useridentity.DefaultIsPrivateEmail holds the default value on creation for the is_private_email field.
+	useridentity.DefaultIsPrivateEmail = useridentityDescIsPrivateEmail.Default.(bool)
+	// useridentityDescCreatedAt is the schema descriptor for created_at field.
+	useridentityDescCreatedAt := useridentityFields[6].Descriptor()
+	// useridentity.DefaultCreatedAt holds the default value on creation for the created_at field.
+	useridentity.DefaultCreatedAt = useridentityDescCreatedAt.Default.(func() time.Time)
+	// useridentityDescID is the schema descriptor for id field.
+	useridentityDescID := useridentityFields[0].Descriptor()
+	// useridentity.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	useridentity.IDValidator = func() func(string) error {
+		validators := useridentityDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
 	userreportFields := schema.UserReport{}.Fields()
 	_ = userreportFields
 	// userreportDescReporterUserID is the schema descriptor for reporter_user_id field.
@@ -1663,4 +3711,142 @@ func init() {
 			return nil
 		}
 	}()
+	usersessionFields := schema.UserSession{}.Fields()
+	_ = usersessionFields
+	// usersessionDescUserID is the schema descriptor for user_id field.
+	usersessionDescUserID := usersessionFields[1].Descriptor()
+	// usersession.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	usersession.UserIDValidator = func() func(string) error {
+		validators := usersessionDescUserID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(user_id string) error {
+			for _, fn := range fns {
+				if err := fn(user_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// usersessionDescRefreshTokenID is the schema descriptor for refresh_token_id field.
+	usersessionDescRefreshTokenID := usersessionFields[2].Descriptor()
+	// usersession.RefreshTokenIDValidator is a validator for the "refresh_token_id" field. It is called by the builders before save.
+	usersession.RefreshTokenIDValidator = func() func(string) error {
+		validators := usersessionDescRefreshTokenID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(refresh_token_id string) error {
+			for _, fn := range fns {
+				if err := fn(refresh_token_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// usersessionDescProvider is the schema descriptor for provider field.
+	usersessionDescProvider := usersessionFields[3].Descriptor()
+	// usersession.ProviderValidator is a validator for the "provider" field. It is called by the builders before save.
+	usersession.ProviderValidator = usersessionDescProvider.Validators[0].(func(string) error)
+	// usersessionDescDeviceID is the schema descriptor for device_id field.
+	usersessionDescDeviceID := usersessionFields[4].Descriptor()
+	// usersession.DeviceIDValidator is a validator for the "device_id" field. It is called by the builders before save.
+	usersession.DeviceIDValidator = usersessionDescDeviceID.Validators[0].(func(string) error)
+	// usersessionDescDeviceName is the schema descriptor for device_name field.
+	usersessionDescDeviceName := usersessionFields[5].Descriptor()
+	// usersession.DeviceNameValidator is a validator for the "device_name" field. It is called by the builders before save.
+	usersession.DeviceNameValidator = usersessionDescDeviceName.Validators[0].(func(string) error)
+	// usersessionDescIPAddress is the schema descriptor for ip_address field.
+	usersessionDescIPAddress := usersessionFields[7].Descriptor()
+	// usersession.IPAddressValidator is a validator for the "ip_address" field. It is called by the builders before save.
+	usersession.IPAddressValidator = usersessionDescIPAddress.Validators[0].(func(string) error)
+	// usersessionDescUserAgent is the schema descriptor for user_agent field.
+	usersessionDescUserAgent := usersessionFields[8].Descriptor()
+	// usersession.UserAgentValidator is a validator for the "user_agent" field. It is called by the builders before save.
+	usersession.UserAgentValidator = usersessionDescUserAgent.Validators[0].(func(string) error)
+	// usersessionDescCreatedAt is the schema descriptor for created_at field.
+	usersessionDescCreatedAt := usersessionFields[9].Descriptor()
+	// usersession.DefaultCreatedAt holds the default value on creation for the created_at field.
+	usersession.DefaultCreatedAt = usersessionDescCreatedAt.Default.(func() time.Time)
+	// usersessionDescLastUsedAt is the schema descriptor for last_used_at field.
+	usersessionDescLastUsedAt := usersessionFields[10].Descriptor()
+	// usersession.DefaultLastUsedAt holds the default value on creation for the last_used_at field.
+	usersession.DefaultLastUsedAt = usersessionDescLastUsedAt.Default.(func() time.Time)
+	// usersessionDescRevokeReason is the schema descriptor for revoke_reason field.
+	usersessionDescRevokeReason := usersessionFields[13].Descriptor()
+	// usersession.RevokeReasonValidator is a validator for the "revoke_reason" field. It is called by the builders before save.
+	usersession.RevokeReasonValidator = usersessionDescRevokeReason.Validators[0].(func(string) error)
+	// usersessionDescID is the schema descriptor for id field.
+	usersessionDescID := usersessionFields[0].Descriptor()
+	// usersession.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	usersession.IDValidator = func() func(string) error {
+		validators := usersessionDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	webhookeventFields := schema.WebhookEvent{}.Fields()
+	_ = webhookeventFields
+	// webhookeventDescProvider is the schema descriptor for provider field.
+	webhookeventDescProvider := webhookeventFields[1].Descriptor()
+	// webhookevent.DefaultProvider holds the default value on creation for the provider field.
+	webhookevent.DefaultProvider = webhookeventDescProvider.Default.(string)
+	// webhookevent.ProviderValidator is a validator for the "provider" field. It is called by the builders before save.
+	webhookevent.ProviderValidator = webhookeventDescProvider.Validators[0].(func(string) error)
+	// webhookeventDescEventID is the schema descriptor for event_id field.
+	webhookeventDescEventID := webhookeventFields[2].Descriptor()
+	// webhookevent.EventIDValidator is a validator for the "event_id" field. It is called by the builders before save.
+	webhookevent.EventIDValidator = webhookeventDescEventID.Validators[0].(func(string) error)
+	// webhookeventDescEventType is the schema descriptor for event_type field.
+	webhookeventDescEventType := webhookeventFields[3].Descriptor()
+	// webhookevent.EventTypeValidator is a validator for the "event_type" field. It is called by the builders before save.
+	webhookevent.EventTypeValidator = webhookeventDescEventType.Validators[0].(func(string) error)
+	// webhookeventDescSignature is the schema descriptor for signature field.
+	webhookeventDescSignature := webhookeventFields[5].Descriptor()
+	// webhookevent.SignatureValidator is a validator for the "signature" field. It is called by the builders before save.
+	webhookevent.SignatureValidator = webhookeventDescSignature.Validators[0].(func(string) error)
+	// webhookeventDescSignatureValid is the schema descriptor for signature_valid field.
+	webhookeventDescSignatureValid := webhookeventFields[6].Descriptor()
+	// webhookevent.DefaultSignatureValid holds the default value on creation for the signature_valid field.
+	webhookevent.DefaultSignatureValid = webhookeventDescSignatureValid.Default.(bool)
+	// webhookeventDescAttempts is the schema descriptor for attempts field.
+	webhookeventDescAttempts := webhookeventFields[9].Descriptor()
+	// webhookevent.DefaultAttempts holds the default value on creation for the attempts field.
+	webhookevent.DefaultAttempts = webhookeventDescAttempts.Default.(int)
+	// webhookeventDescReceivedAt is the schema descriptor for received_at field.
+	webhookeventDescReceivedAt := webhookeventFields[10].Descriptor()
+	// webhookevent.DefaultReceivedAt holds the default value on creation for the received_at field.
+	webhookevent.DefaultReceivedAt = webhookeventDescReceivedAt.Default.(func() time.Time)
+	// webhookeventDescID is the schema descriptor for id field.
+	webhookeventDescID := webhookeventFields[0].Descriptor()
+	// webhookevent.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	webhookevent.IDValidator = func() func(string) error {
+		validators := webhookeventDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
 }
@@ -0,0 +1,108 @@
+// Code generated by ent, DO NOT EDIT.
+
+package server
+
+import (
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the server type in the database.
+	Label = "server"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldServerType holds the string denoting the server_type field in the database.
+	FieldServerType = "server_type"
+	// FieldDisplayName holds the string denoting the display_name field in the database.
+	FieldDisplayName = "display_name"
+	// FieldIconName holds the string denoting the icon_name field in the database.
+	FieldIconName = "icon_name"
+	// FieldSortOrder holds the string denoting the sort_order field in the database.
+	FieldSortOrder = "sort_order"
+	// Table holds the table name of the server in the database.
+	Table = "servers"
+)
+
+// Columns holds all SQL columns for server fields.
+var Columns = []string{
+	FieldID,
+	FieldServerType,
+	FieldDisplayName,
+	FieldIconName,
+	FieldSortOrder,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DisplayNameValidator is a validator for the "display_name" field. It is called by the builders before save.
+	DisplayNameValidator func(string) error
+	// IconNameValidator is a validator for the "icon_name" field. It is called by the builders before save.
+	IconNameValidator func(string) error
+	// DefaultSortOrder holds the default value on creation for the "sort_order" field.
+	DefaultSortOrder int
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
+
+// ServerType defines the type for the "server_type" enum field.
+type ServerType string
+
+// ServerType values.
+const (
+	ServerTypePartner ServerType = "partner"
+	ServerTypeFriend  ServerType = "friend"
+	ServerTypeGrowth  ServerType = "growth"
+)
+
+func (st ServerType) String() string {
+	return string(st)
+}
+
+// ServerTypeValidator is a validator for the "server_type" field enum values. It is called by the builders before save.
+func ServerTypeValidator(st ServerType) error {
+	switch st {
+	case ServerTypePartner, ServerTypeFriend, ServerTypeGrowth:
+		return nil
+	default:
+		return fmt.Errorf("server: invalid enum value for server_type field: %q", st)
+	}
+}
+
+// OrderOption defines the ordering options for the Server queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByServerType orders the results by the server_type field.
+func ByServerType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldServerType, opts...).ToFunc()
+}
+
+// ByDisplayName orders the results by the display_name field.
+func ByDisplayName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDisplayName, opts...).ToFunc()
+}
+
+// ByIconName orders the results by the icon_name field.
+func ByIconName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIconName, opts...).ToFunc()
+}
+
+// BySortOrder orders the results by the sort_order field.
+func BySortOrder(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSortOrder, opts...).ToFunc()
+}
@@ -0,0 +1,283 @@
+// Code generated by ent, DO NOT EDIT.
+
+package server
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"github.com/UnoraApp/be/ent/generated/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.Server {
+	return predicate.Server(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.Server {
+	return predicate.Server(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.Server {
+	return predicate.Server(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.Server {
+	return predicate.Server(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.Server {
+	return predicate.Server(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.Server {
+	return predicate.Server(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.Server {
+	return predicate.Server(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.Server {
+	return predicate.Server(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.Server {
+	return predicate.Server(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.Server {
+	return predicate.Server(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.Server {
+	return predicate.Server(sql.FieldContainsFold(FieldID, id))
+}
+
+// DisplayName applies equality check predicate on the "display_name" field. It's identical to DisplayNameEQ.
+func DisplayName(v string) predicate.Server {
+	return predicate.Server(sql.FieldEQ(FieldDisplayName, v))
+}
+
+// IconName applies equality check predicate on the "icon_name" field. It's identical to IconNameEQ.
+func IconName(v string) predicate.Server {
+	return predicate.Server(sql.FieldEQ(FieldIconName, v))
+}
+
+// SortOrder applies equality check predicate on the "sort_order" field. It's identical to SortOrderEQ.
+func SortOrder(v int) predicate.Server {
+	return predicate.Server(sql.FieldEQ(FieldSortOrder, v))
+}
+
+// ServerTypeEQ applies the EQ predicate on the "server_type" field.
+func ServerTypeEQ(v ServerType) predicate.Server {
+	return predicate.Server(sql.FieldEQ(FieldServerType, v))
+}
+
+// ServerTypeNEQ applies the NEQ predicate on the "server_type" field.
+func ServerTypeNEQ(v ServerType) predicate.Server {
+	return predicate.Server(sql.FieldNEQ(FieldServerType, v))
+}
+
+// ServerTypeIn applies the In predicate on the "server_type" field.
+func ServerTypeIn(vs ...ServerType) predicate.Server {
+	return predicate.Server(sql.FieldIn(FieldServerType, vs...))
+}
+
+// ServerTypeNotIn applies the NotIn predicate on the "server_type" field.
+func ServerTypeNotIn(vs ...ServerType) predicate.Server {
+	return predicate.Server(sql.FieldNotIn(FieldServerType, vs...))
+}
+
+// DisplayNameEQ applies the EQ predicate on the "display_name" field.
+func DisplayNameEQ(v string) predicate.Server {
+	return predicate.Server(sql.FieldEQ(FieldDisplayName, v))
+}
+
+// DisplayNameNEQ applies the NEQ predicate on the "display_name" field.
+func DisplayNameNEQ(v string) predicate.Server {
+	return predicate.Server(sql.FieldNEQ(FieldDisplayName, v))
+}
+
+// DisplayNameIn applies the In predicate on the "display_name" field.
+func DisplayNameIn(vs ...string) predicate.Server {
+	return predicate.Server(sql.FieldIn(FieldDisplayName, vs...))
+}
+
+// DisplayNameNotIn applies the NotIn predicate on the "display_name" field.
+func DisplayNameNotIn(vs ...string) predicate.Server {
+	return predicate.Server(sql.FieldNotIn(FieldDisplayName, vs...))
+}
+
+// DisplayNameGT applies the GT predicate on the "display_name" field.
+func DisplayNameGT(v string) predicate.Server {
+	return predicate.Server(sql.FieldGT(FieldDisplayName, v))
+}
+
+// DisplayNameGTE applies the GTE predicate on the "display_name" field.
+func DisplayNameGTE(v string) predicate.Server {
+	return predicate.Server(sql.FieldGTE(FieldDisplayName, v))
+}
+
+// DisplayNameLT applies the LT predicate on the "display_name" field.
+func DisplayNameLT(v string) predicate.Server {
+	return predicate.Server(sql.FieldLT(FieldDisplayName, v))
+}
+
+// DisplayNameLTE applies the LTE predicate on the "display_name" field.
+func DisplayNameLTE(v string) predicate.Server {
+	return predicate.Server(sql.FieldLTE(FieldDisplayName, v))
+}
+
+// DisplayNameContains applies the Contains predicate on the "display_name" field.
+func DisplayNameContains(v string) predicate.Server {
+	return predicate.Server(sql.FieldContains(FieldDisplayName, v))
+}
+
+// DisplayNameHasPrefix applies the HasPrefix predicate on the "display_name" field.
+func DisplayNameHasPrefix(v string) predicate.Server {
+	return predicate.Server(sql.FieldHasPrefix(FieldDisplayName, v))
+}
+
+// DisplayNameHasSuffix applies the HasSuffix predicate on the "display_name" field.
+func DisplayNameHasSuffix(v string) predicate.Server {
+	return predicate.Server(sql.FieldHasSuffix(FieldDisplayName, v))
+}
+
+// DisplayNameEqualFold applies the EqualFold predicate on the "display_name" field.
+func DisplayNameEqualFold(v string) predicate.Server {
+	return predicate.Server(sql.FieldEqualFold(FieldDisplayName, v))
+}
+
+// DisplayNameContainsFold applies the ContainsFold predicate on the "display_name" field.
+func DisplayNameContainsFold(v string) predicate.Server {
+	return predicate.Server(sql.FieldContainsFold(FieldDisplayName, v))
+}
+
+// IconNameEQ applies the EQ predicate on the "icon_name" field.
+func IconNameEQ(v string) predicate.Server {
+	return predicate.Server(sql.FieldEQ(FieldIconName, v))
+}
+
+// IconNameNEQ applies the NEQ predicate on the "icon_name" field.
+func IconNameNEQ(v string) predicate.Server {
+	return predicate.Server(sql.FieldNEQ(FieldIconName, v))
+}
+
+// IconNameIn applies the In predicate on the "icon_name" field.
+func IconNameIn(vs ...string) predicate.Server {
+	return predicate.Server(sql.FieldIn(FieldIconName, vs...))
+}
+
+// IconNameNotIn applies the NotIn predicate on the "icon_name" field.
+func IconNameNotIn(vs ...string) predicate.Server {
+	return predicate.Server(sql.FieldNotIn(FieldIconName, vs...))
+}
+
+// IconNameGT applies the GT predicate on the "icon_name" field.
+func IconNameGT(v string) predicate.Server {
+	return predicate.Server(sql.FieldGT(FieldIconName, v))
+}
+
+// IconNameGTE applies the GTE predicate on the "icon_name" field.
+func IconNameGTE(v string) predicate.Server {
+	return predicate.Server(sql.FieldGTE(FieldIconName, v))
+}
+
+// IconNameLT applies the LT predicate on the "icon_name" field.
+func IconNameLT(v string) predicate.Server {
+	return predicate.Server(sql.FieldLT(FieldIconName, v))
+}
+
+// IconNameLTE applies the LTE predicate on the "icon_name" field.
+func IconNameLTE(v string) predicate.Server {
+	return predicate.Server(sql.FieldLTE(FieldIconName, v))
+}
+
+// IconNameContains applies the Contains predicate on the "icon_name" field.
+func IconNameContains(v string) predicate.Server {
+	return predicate.Server(sql.FieldContains(FieldIconName, v))
+}
+
+// IconNameHasPrefix applies the HasPrefix predicate on the "icon_name" field.
+func IconNameHasPrefix(v string) predicate.Server {
+	return predicate.Server(sql.FieldHasPrefix(FieldIconName, v))
+}
+
+// IconNameHasSuffix applies the HasSuffix predicate on the "icon_name" field.
+func IconNameHasSuffix(v string) predicate.Server {
+	return predicate.Server(sql.FieldHasSuffix(FieldIconName, v))
+}
+
+// IconNameEqualFold applies the EqualFold predicate on the "icon_name" field.
+func IconNameEqualFold(v string) predicate.Server {
+	return predicate.Server(sql.FieldEqualFold(FieldIconName, v))
+}
+
+// IconNameContainsFold applies the ContainsFold predicate on the "icon_name" field.
+func IconNameContainsFold(v string) predicate.Server {
+	return predicate.Server(sql.FieldContainsFold(FieldIconName, v))
+}
+
+// SortOrderEQ applies the EQ predicate on the "sort_order" field.
+func SortOrderEQ(v int) predicate.Server {
+	return predicate.Server(sql.FieldEQ(FieldSortOrder, v))
+}
+
+// SortOrderNEQ applies the NEQ predicate on the "sort_order" field.
+func SortOrderNEQ(v int) predicate.Server {
+	return predicate.Server(sql.FieldNEQ(FieldSortOrder, v))
+}
+
+// SortOrderIn applies the In predicate on the "sort_order" field.
+func SortOrderIn(vs ...int) predicate.Server {
+	return predicate.Server(sql.FieldIn(FieldSortOrder, vs...))
+}
+
+// SortOrderNotIn applies the NotIn predicate on the "sort_order" field.
+func SortOrderNotIn(vs ...int) predicate.Server {
+	return predicate.Server(sql.FieldNotIn(FieldSortOrder, vs...))
+}
+
+// SortOrderGT applies the GT predicate on the "sort_order" field.
+func SortOrderGT(v int) predicate.Server {
+	return predicate.Server(sql.FieldGT(FieldSortOrder, v))
+}
+
+// SortOrderGTE applies the GTE predicate on the "sort_order" field.
+func SortOrderGTE(v int) predicate.Server {
+	return predicate.Server(sql.FieldGTE(FieldSortOrder, v))
+}
+
+// SortOrderLT applies the LT predicate on the "sort_order" field.
+func SortOrderLT(v int) predicate.Server {
+	return predicate.Server(sql.FieldLT(FieldSortOrder, v))
+}
+
+// SortOrderLTE applies the LTE predicate on the "sort_order" field.
+func SortOrderLTE(v int) predicate.Server {
+	return predicate.Server(sql.FieldLTE(FieldSortOrder, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Server) predicate.Server {
+	return predicate.Server(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Server) predicate.Server {
+	return predicate.Server(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Server) predicate.Server {
+	return predicate.Server(sql.NotPredicates(p))
+}
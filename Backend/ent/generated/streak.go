@@ -33,6 +33,8 @@ type Streak struct {
 	RecoveryDeadlineAt *time.Time `json:"recovery_deadline_at,omitempty"`
 	// RecoveryPaymentID holds the value of the "recovery_payment_id" field.
 	RecoveryPaymentID *string `json:"recovery_payment_id,omitempty"`
+	// FreeRecoveriesUsed holds the value of the "free_recoveries_used" field.
+	FreeRecoveriesUsed int `json:"free_recoveries_used,omitempty"`
 	// StreakHealthScore holds the value of the "streak_health_score" field.
 	StreakHealthScore *float64 `json:"streak_health_score,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
@@ -111,7 +113,7 @@ func (*Streak) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case streak.FieldStreakHealthScore:
 			values[i] = new(sql.NullFloat64)
-		case streak.FieldCurrentDay, streak.FieldResetCount:
+		case streak.FieldCurrentDay, streak.FieldResetCount, streak.FieldFreeRecoveriesUsed:
 			values[i] = new(sql.NullInt64)
 		case streak.FieldID, streak.FieldConnectionID, streak.FieldStreakState, streak.FieldBreakerUserID, streak.FieldRecoveryPaymentID:
 			values[i] = new(sql.NullString)
@@ -183,6 +185,12 @@ func (_m *Streak) assignValues(columns []string, values []any) error {
 				_m.RecoveryPaymentID = new(string)
 				*_m.RecoveryPaymentID = value.String
 			}
+		case streak.FieldFreeRecoveriesUsed:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field free_recoveries_used", values[i])
+			} else if value.Valid {
+				_m.FreeRecoveriesUsed = int(value.Int64)
+			}
 		case streak.FieldStreakHealthScore:
 			if value, ok := values[i].(*sql.NullFloat64); !ok {
 				return fmt.Errorf("unexpected type %T for field streak_health_score", values[i])
@@ -299,6 +307,9 @@ func (_m *Streak) String() string {
 		builder.WriteString(*v)
 	}
 	builder.WriteString(", ")
+	builder.WriteString("free_recoveries_used=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FreeRecoveriesUsed))
+	builder.WriteString(", ")
 	if v := _m.StreakHealthScore; v != nil {
 		builder.WriteString("streak_health_score=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
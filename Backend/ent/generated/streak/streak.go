@@ -29,6 +29,8 @@ const (
 	FieldRecoveryDeadlineAt = "recovery_deadline_at"
 	// FieldRecoveryPaymentID holds the string denoting the recovery_payment_id field in the database.
 	FieldRecoveryPaymentID = "recovery_payment_id"
+	// FieldFreeRecoveriesUsed holds the string denoting the free_recoveries_used field in the database.
+	FieldFreeRecoveriesUsed = "free_recoveries_used"
 	// FieldStreakHealthScore holds the string denoting the streak_health_score field in the database.
 	FieldStreakHealthScore = "streak_health_score"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
@@ -89,6 +91,7 @@ var Columns = []string{
 	FieldBreakerUserID,
 	FieldRecoveryDeadlineAt,
 	FieldRecoveryPaymentID,
+	FieldFreeRecoveriesUsed,
 	FieldStreakHealthScore,
 	FieldCreatedAt,
 	FieldUpdatedAt,
@@ -119,6 +122,10 @@ var (
 	BreakerUserIDValidator func(string) error
 	// RecoveryPaymentIDValidator is a validator for the "recovery_payment_id" field. It is called by the builders before save.
 	RecoveryPaymentIDValidator func(string) error
+	// DefaultFreeRecoveriesUsed holds the default value on creation for the "free_recoveries_used" field.
+	DefaultFreeRecoveriesUsed int
+	// FreeRecoveriesUsedValidator is a validator for the "free_recoveries_used" field. It is called by the builders before save.
+	FreeRecoveriesUsedValidator func(int) error
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -202,6 +209,11 @@ func ByRecoveryPaymentID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldRecoveryPaymentID, opts...).ToFunc()
 }
 
+// ByFreeRecoveriesUsed orders the results by the free_recoveries_used field.
+func ByFreeRecoveriesUsed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFreeRecoveriesUsed, opts...).ToFunc()
+}
+
 // ByStreakHealthScore orders the results by the streak_health_score field.
 func ByStreakHealthScore(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStreakHealthScore, opts...).ToFunc()
@@ -95,6 +95,11 @@ func RecoveryPaymentID(v string) predicate.Streak {
 	return predicate.Streak(sql.FieldEQ(FieldRecoveryPaymentID, v))
 }
 
+// FreeRecoveriesUsed applies equality check predicate on the "free_recoveries_used" field. It's identical to FreeRecoveriesUsedEQ.
+func FreeRecoveriesUsed(v int) predicate.Streak {
+	return predicate.Streak(sql.FieldEQ(FieldFreeRecoveriesUsed, v))
+}
+
 // StreakHealthScore applies equality check predicate on the "streak_health_score" field. It's identical to StreakHealthScoreEQ.
 func StreakHealthScore(v float64) predicate.Streak {
 	return predicate.Streak(sql.FieldEQ(FieldStreakHealthScore, v))
@@ -485,6 +490,46 @@ func RecoveryPaymentIDContainsFold(v string) predicate.Streak {
 	return predicate.Streak(sql.FieldContainsFold(FieldRecoveryPaymentID, v))
 }
 
+// FreeRecoveriesUsedEQ applies the EQ predicate on the "free_recoveries_used" field.
+func FreeRecoveriesUsedEQ(v int) predicate.Streak {
+	return predicate.Streak(sql.FieldEQ(FieldFreeRecoveriesUsed, v))
+}
+
+// FreeRecoveriesUsedNEQ applies the NEQ predicate on the "free_recoveries_used" field.
+func FreeRecoveriesUsedNEQ(v int) predicate.Streak {
+	return predicate.Streak(sql.FieldNEQ(FieldFreeRecoveriesUsed, v))
+}
+
+// FreeRecoveriesUsedIn applies the In predicate on the "free_recoveries_used" field.
+func FreeRecoveriesUsedIn(vs ...int) predicate.Streak {
+	return predicate.Streak(sql.FieldIn(FieldFreeRecoveriesUsed, vs...))
+}
+
+// FreeRecoveriesUsedNotIn applies the NotIn predicate on the "free_recoveries_used" field.
+func FreeRecoveriesUsedNotIn(vs ...int) predicate.Streak {
+	return predicate.Streak(sql.FieldNotIn(FieldFreeRecoveriesUsed, vs...))
+}
+
+// FreeRecoveriesUsedGT applies the GT predicate on the "free_recoveries_used" field.
+func FreeRecoveriesUsedGT(v int) predicate.Streak {
+	return predicate.Streak(sql.FieldGT(FieldFreeRecoveriesUsed, v))
+}
+
+// FreeRecoveriesUsedGTE applies the GTE predicate on the "free_recoveries_used" field.
+func FreeRecoveriesUsedGTE(v int) predicate.Streak {
+	return predicate.Streak(sql.FieldGTE(FieldFreeRecoveriesUsed, v))
+}
+
+// FreeRecoveriesUsedLT applies the LT predicate on the "free_recoveries_used" field.
+func FreeRecoveriesUsedLT(v int) predicate.Streak {
+	return predicate.Streak(sql.FieldLT(FieldFreeRecoveriesUsed, v))
+}
+
+// FreeRecoveriesUsedLTE applies the LTE predicate on the "free_recoveries_used" field.
+func FreeRecoveriesUsedLTE(v int) predicate.Streak {
+	return predicate.Streak(sql.FieldLTE(FieldFreeRecoveriesUsed, v))
+}
+
 // StreakHealthScoreEQ applies the EQ predicate on the "streak_health_score" field.
 func StreakHealthScoreEQ(v float64) predicate.Streak {
 	return predicate.Streak(sql.FieldEQ(FieldStreakHealthScore, v))
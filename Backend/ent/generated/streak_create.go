@@ -117,6 +117,20 @@ func (_c *StreakCreate) SetNillableRecoveryPaymentID(v *string) *StreakCreate {
 	return _c
 }
 
+// SetFreeRecoveriesUsed sets the "free_recoveries_used" field.
+func (_c *StreakCreate) SetFreeRecoveriesUsed(v int) *StreakCreate {
+	_c.mutation.SetFreeRecoveriesUsed(v)
+	return _c
+}
+
+// SetNillableFreeRecoveriesUsed sets the "free_recoveries_used" field if the given value is not nil.
+func (_c *StreakCreate) SetNillableFreeRecoveriesUsed(v *int) *StreakCreate {
+	if v != nil {
+		_c.SetFreeRecoveriesUsed(*v)
+	}
+	return _c
+}
+
 // SetStreakHealthScore sets the "streak_health_score" field.
 func (_c *StreakCreate) SetStreakHealthScore(v float64) *StreakCreate {
 	_c.mutation.SetStreakHealthScore(v)
@@ -294,6 +308,10 @@ func (_c *StreakCreate) defaults() {
 		v := streak.DefaultResetCount
 		_c.mutation.SetResetCount(v)
 	}
+	if _, ok := _c.mutation.FreeRecoveriesUsed(); !ok {
+		v := streak.DefaultFreeRecoveriesUsed
+		_c.mutation.SetFreeRecoveriesUsed(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := streak.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -343,6 +361,14 @@ func (_c *StreakCreate) check() error {
 			return &ValidationError{Name: "recovery_payment_id", err: fmt.Errorf(`generated: validator failed for field "Streak.recovery_payment_id": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.FreeRecoveriesUsed(); !ok {
+		return &ValidationError{Name: "free_recoveries_used", err: errors.New(`generated: missing required field "Streak.free_recoveries_used"`)}
+	}
+	if v, ok := _c.mutation.FreeRecoveriesUsed(); ok {
+		if err := streak.FreeRecoveriesUsedValidator(v); err != nil {
+			return &ValidationError{Name: "free_recoveries_used", err: fmt.Errorf(`generated: validator failed for field "Streak.free_recoveries_used": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "Streak.created_at"`)}
 	}
@@ -413,6 +439,10 @@ func (_c *StreakCreate) createSpec() (*Streak, *sqlgraph.CreateSpec) {
 		_spec.SetField(streak.FieldRecoveryPaymentID, field.TypeString, value)
 		_node.RecoveryPaymentID = &value
 	}
+	if value, ok := _c.mutation.FreeRecoveriesUsed(); ok {
+		_spec.SetField(streak.FieldFreeRecoveriesUsed, field.TypeInt, value)
+		_node.FreeRecoveriesUsed = value
+	}
 	if value, ok := _c.mutation.StreakHealthScore(); ok {
 		_spec.SetField(streak.FieldStreakHealthScore, field.TypeFloat64, value)
 		_node.StreakHealthScore = &value
@@ -665,6 +695,24 @@ func (u *StreakUpsert) ClearRecoveryPaymentID() *StreakUpsert {
 	return u
 }
 
+// SetFreeRecoveriesUsed sets the "free_recoveries_used" field.
+func (u *StreakUpsert) SetFreeRecoveriesUsed(v int) *StreakUpsert {
+	u.Set(streak.FieldFreeRecoveriesUsed, v)
+	return u
+}
+
+// UpdateFreeRecoveriesUsed sets the "free_recoveries_used" field to the value that was provided on create.
+func (u *StreakUpsert) UpdateFreeRecoveriesUsed() *StreakUpsert {
+	u.SetExcluded(streak.FieldFreeRecoveriesUsed)
+	return u
+}
+
+// AddFreeRecoveriesUsed adds v to the "free_recoveries_used" field.
+func (u *StreakUpsert) AddFreeRecoveriesUsed(v int) *StreakUpsert {
+	u.Add(streak.FieldFreeRecoveriesUsed, v)
+	return u
+}
+
 // SetStreakHealthScore sets the "streak_health_score" field.
 func (u *StreakUpsert) SetStreakHealthScore(v float64) *StreakUpsert {
 	u.Set(streak.FieldStreakHealthScore, v)
@@ -921,6 +969,27 @@ func (u *StreakUpsertOne) ClearRecoveryPaymentID() *StreakUpsertOne {
 	})
 }
 
+// SetFreeRecoveriesUsed sets the "free_recoveries_used" field.
+func (u *StreakUpsertOne) SetFreeRecoveriesUsed(v int) *StreakUpsertOne {
+	return u.Update(func(s *StreakUpsert) {
+		s.SetFreeRecoveriesUsed(v)
+	})
+}
+
+// AddFreeRecoveriesUsed adds v to the "free_recoveries_used" field.
+func (u *StreakUpsertOne) AddFreeRecoveriesUsed(v int) *StreakUpsertOne {
+	return u.Update(func(s *StreakUpsert) {
+		s.AddFreeRecoveriesUsed(v)
+	})
+}
+
+// UpdateFreeRecoveriesUsed sets the "free_recoveries_used" field to the value that was provided on create.
+func (u *StreakUpsertOne) UpdateFreeRecoveriesUsed() *StreakUpsertOne {
+	return u.Update(func(s *StreakUpsert) {
+		s.UpdateFreeRecoveriesUsed()
+	})
+}
+
 // SetStreakHealthScore sets the "streak_health_score" field.
 func (u *StreakUpsertOne) SetStreakHealthScore(v float64) *StreakUpsertOne {
 	return u.Update(func(s *StreakUpsert) {
@@ -1356,6 +1425,27 @@ func (u *StreakUpsertBulk) ClearRecoveryPaymentID() *StreakUpsertBulk {
 	})
 }
 
+// SetFreeRecoveriesUsed sets the "free_recoveries_used" field.
+func (u *StreakUpsertBulk) SetFreeRecoveriesUsed(v int) *StreakUpsertBulk {
+	return u.Update(func(s *StreakUpsert) {
+		s.SetFreeRecoveriesUsed(v)
+	})
+}
+
+// AddFreeRecoveriesUsed adds v to the "free_recoveries_used" field.
+func (u *StreakUpsertBulk) AddFreeRecoveriesUsed(v int) *StreakUpsertBulk {
+	return u.Update(func(s *StreakUpsert) {
+		s.AddFreeRecoveriesUsed(v)
+	})
+}
+
+// UpdateFreeRecoveriesUsed sets the "free_recoveries_used" field to the value that was provided on create.
+func (u *StreakUpsertBulk) UpdateFreeRecoveriesUsed() *StreakUpsertBulk {
+	return u.Update(func(s *StreakUpsert) {
+		s.UpdateFreeRecoveriesUsed()
+	})
+}
+
 // SetStreakHealthScore sets the "streak_health_score" field.
 func (u *StreakUpsertBulk) SetStreakHealthScore(v float64) *StreakUpsertBulk {
 	return u.Update(func(s *StreakUpsert) {
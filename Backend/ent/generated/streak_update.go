@@ -162,6 +162,27 @@ func (_u *StreakUpdate) ClearRecoveryPaymentID() *StreakUpdate {
 	return _u
 }
 
+// SetFreeRecoveriesUsed sets the "free_recoveries_used" field.
+func (_u *StreakUpdate) SetFreeRecoveriesUsed(v int) *StreakUpdate {
+	_u.mutation.ResetFreeRecoveriesUsed()
+	_u.mutation.SetFreeRecoveriesUsed(v)
+	return _u
+}
+
+// SetNillableFreeRecoveriesUsed sets the "free_recoveries_used" field if the given value is not nil.
+func (_u *StreakUpdate) SetNillableFreeRecoveriesUsed(v *int) *StreakUpdate {
+	if v != nil {
+		_u.SetFreeRecoveriesUsed(*v)
+	}
+	return _u
+}
+
+// AddFreeRecoveriesUsed adds value to the "free_recoveries_used" field.
+func (_u *StreakUpdate) AddFreeRecoveriesUsed(v int) *StreakUpdate {
+	_u.mutation.AddFreeRecoveriesUsed(v)
+	return _u
+}
+
 // SetStreakHealthScore sets the "streak_health_score" field.
 func (_u *StreakUpdate) SetStreakHealthScore(v float64) *StreakUpdate {
 	_u.mutation.ResetStreakHealthScore()
@@ -411,6 +432,11 @@ func (_u *StreakUpdate) check() error {
 			return &ValidationError{Name: "recovery_payment_id", err: fmt.Errorf(`generated: validator failed for field "Streak.recovery_payment_id": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.FreeRecoveriesUsed(); ok {
+		if err := streak.FreeRecoveriesUsedValidator(v); err != nil {
+			return &ValidationError{Name: "free_recoveries_used", err: fmt.Errorf(`generated: validator failed for field "Streak.free_recoveries_used": %w`, err)}
+		}
+	}
 	if _u.mutation.ConnectionCleared() && len(_u.mutation.ConnectionIDs()) > 0 {
 		return errors.New(`generated: clearing a required unique edge "Streak.connection"`)
 	}
@@ -456,6 +482,12 @@ func (_u *StreakUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.RecoveryPaymentIDCleared() {
 		_spec.ClearField(streak.FieldRecoveryPaymentID, field.TypeString)
 	}
+	if value, ok := _u.mutation.FreeRecoveriesUsed(); ok {
+		_spec.SetField(streak.FieldFreeRecoveriesUsed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFreeRecoveriesUsed(); ok {
+		_spec.AddField(streak.FieldFreeRecoveriesUsed, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.StreakHealthScore(); ok {
 		_spec.SetField(streak.FieldStreakHealthScore, field.TypeFloat64, value)
 	}
@@ -778,6 +810,27 @@ func (_u *StreakUpdateOne) ClearRecoveryPaymentID() *StreakUpdateOne {
 	return _u
 }
 
+// SetFreeRecoveriesUsed sets the "free_recoveries_used" field.
+func (_u *StreakUpdateOne) SetFreeRecoveriesUsed(v int) *StreakUpdateOne {
+	_u.mutation.ResetFreeRecoveriesUsed()
+	_u.mutation.SetFreeRecoveriesUsed(v)
+	return _u
+}
+
+// SetNillableFreeRecoveriesUsed sets the "free_recoveries_used" field if the given value is not nil.
+func (_u *StreakUpdateOne) SetNillableFreeRecoveriesUsed(v *int) *StreakUpdateOne {
+	if v != nil {
+		_u.SetFreeRecoveriesUsed(*v)
+	}
+	return _u
+}
+
+// AddFreeRecoveriesUsed adds value to the "free_recoveries_used" field.
+func (_u *StreakUpdateOne) AddFreeRecoveriesUsed(v int) *StreakUpdateOne {
+	_u.mutation.AddFreeRecoveriesUsed(v)
+	return _u
+}
+
 // SetStreakHealthScore sets the "streak_health_score" field.
 func (_u *StreakUpdateOne) SetStreakHealthScore(v float64) *StreakUpdateOne {
 	_u.mutation.ResetStreakHealthScore()
@@ -1040,6 +1093,11 @@ func (_u *StreakUpdateOne) check() error {
 			return &ValidationError{Name: "recovery_payment_id", err: fmt.Errorf(`generated: validator failed for field "Streak.recovery_payment_id": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.FreeRecoveriesUsed(); ok {
+		if err := streak.FreeRecoveriesUsedValidator(v); err != nil {
+			return &ValidationError{Name: "free_recoveries_used", err: fmt.Errorf(`generated: validator failed for field "Streak.free_recoveries_used": %w`, err)}
+		}
+	}
 	if _u.mutation.ConnectionCleared() && len(_u.mutation.ConnectionIDs()) > 0 {
 		return errors.New(`generated: clearing a required unique edge "Streak.connection"`)
 	}
@@ -1102,6 +1160,12 @@ func (_u *StreakUpdateOne) sqlSave(ctx context.Context) (_node *Streak, err erro
 	if _u.mutation.RecoveryPaymentIDCleared() {
 		_spec.ClearField(streak.FieldRecoveryPaymentID, field.TypeString)
 	}
+	if value, ok := _u.mutation.FreeRecoveriesUsed(); ok {
+		_spec.SetField(streak.FieldFreeRecoveriesUsed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFreeRecoveriesUsed(); ok {
+		_spec.AddField(streak.FieldFreeRecoveriesUsed, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.StreakHealthScore(); ok {
 		_spec.SetField(streak.FieldStreakHealthScore, field.TypeFloat64, value)
 	}
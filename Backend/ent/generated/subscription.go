@@ -0,0 +1,345 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/UnoraApp/be/ent/generated/subscription"
+	"github.com/UnoraApp/be/ent/generated/subscriptionplan"
+	"github.com/UnoraApp/be/ent/generated/user"
+)
+
+// Subscription is the model entity for the Subscription schema.
+type Subscription struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID string `json:"user_id,omitempty"`
+	// PlanID holds the value of the "plan_id" field.
+	PlanID string `json:"plan_id,omitempty"`
+	// RazorpaySubscriptionID holds the value of the "razorpay_subscription_id" field.
+	RazorpaySubscriptionID string `json:"razorpay_subscription_id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status subscription.Status `json:"status,omitempty"`
+	// CurrentStart holds the value of the "current_start" field.
+	CurrentStart *time.Time `json:"current_start,omitempty"`
+	// CurrentEnd holds the value of the "current_end" field.
+	CurrentEnd *time.Time `json:"current_end,omitempty"`
+	// GraceUntil holds the value of the "grace_until" field.
+	GraceUntil *time.Time `json:"grace_until,omitempty"`
+	// CancelAtPeriodEnd holds the value of the "cancel_at_period_end" field.
+	CancelAtPeriodEnd bool `json:"cancel_at_period_end,omitempty"`
+	// PaidCount holds the value of the "paid_count" field.
+	PaidCount int `json:"paid_count,omitempty"`
+	// ShortURL holds the value of the "short_url" field.
+	ShortURL *string `json:"short_url,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// ActivatedAt holds the value of the "activated_at" field.
+	ActivatedAt *time.Time `json:"activated_at,omitempty"`
+	// PausedAt holds the value of the "paused_at" field.
+	PausedAt *time.Time `json:"paused_at,omitempty"`
+	// CancelledAt holds the value of the "cancelled_at" field.
+	CancelledAt *time.Time `json:"cancelled_at,omitempty"`
+	// EndedAt holds the value of the "ended_at" field.
+	EndedAt *time.Time `json:"ended_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SubscriptionQuery when eager-loading is set.
+	Edges        SubscriptionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// SubscriptionEdges holds the relations/edges for other nodes in the graph.
+type SubscriptionEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// Plan holds the value of the plan edge.
+	Plan *SubscriptionPlan `json:"plan,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SubscriptionEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// PlanOrErr returns the Plan value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SubscriptionEdges) PlanOrErr() (*SubscriptionPlan, error) {
+	if e.Plan != nil {
+		return e.Plan, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: subscriptionplan.Label}
+	}
+	return nil, &NotLoadedError{edge: "plan"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Subscription) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case subscription.FieldCancelAtPeriodEnd:
+			values[i] = new(sql.NullBool)
+		case subscription.FieldPaidCount:
+			values[i] = new(sql.NullInt64)
+		case subscription.FieldID, subscription.FieldUserID, subscription.FieldPlanID, subscription.FieldRazorpaySubscriptionID, subscription.FieldStatus, subscription.FieldShortURL:
+			values[i] = new(sql.NullString)
+		case subscription.FieldCurrentStart, subscription.FieldCurrentEnd, subscription.FieldGraceUntil, subscription.FieldCreatedAt, subscription.FieldUpdatedAt, subscription.FieldActivatedAt, subscription.FieldPausedAt, subscription.FieldCancelledAt, subscription.FieldEndedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Subscription fields.
+func (_m *Subscription) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case subscription.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case subscription.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = value.String
+			}
+		case subscription.FieldPlanID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field plan_id", values[i])
+			} else if value.Valid {
+				_m.PlanID = value.String
+			}
+		case subscription.FieldRazorpaySubscriptionID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field razorpay_subscription_id", values[i])
+			} else if value.Valid {
+				_m.RazorpaySubscriptionID = value.String
+			}
+		case subscription.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = subscription.Status(value.String)
+			}
+		case subscription.FieldCurrentStart:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field current_start", values[i])
+			} else if value.Valid {
+				_m.CurrentStart = new(time.Time)
+				*_m.CurrentStart = value.Time
+			}
+		case subscription.FieldCurrentEnd:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field current_end", values[i])
+			} else if value.Valid {
+				_m.CurrentEnd = new(time.Time)
+				*_m.CurrentEnd = value.Time
+			}
+		case subscription.FieldGraceUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field grace_until", values[i])
+			} else if value.Valid {
+				_m.GraceUntil = new(time.Time)
+				*_m.GraceUntil = value.Time
+			}
+		case subscription.FieldCancelAtPeriodEnd:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field cancel_at_period_end", values[i])
+			} else if value.Valid {
+				_m.CancelAtPeriodEnd = value.Bool
+			}
+		case subscription.FieldPaidCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field paid_count", values[i])
+			} else if value.Valid {
+				_m.PaidCount = int(value.Int64)
+			}
+		case subscription.FieldShortURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field short_url", values[i])
+			} else if value.Valid {
+				_m.ShortURL = new(string)
+				*_m.ShortURL = value.String
+			}
+		case subscription.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case subscription.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case subscription.FieldActivatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field activated_at", values[i])
+			} else if value.Valid {
+				_m.ActivatedAt = new(time.Time)
+				*_m.ActivatedAt = value.Time
+			}
+		case subscription.FieldPausedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field paused_at", values[i])
+			} else if value.Valid {
+				_m.PausedAt = new(time.Time)
+				*_m.PausedAt = value.Time
+			}
+		case subscription.FieldCancelledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field cancelled_at", values[i])
+			} else if value.Valid {
+				_m.CancelledAt = new(time.Time)
+				*_m.CancelledAt = value.Time
+			}
+		case subscription.FieldEndedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field ended_at", values[i])
+			} else if value.Valid {
+				_m.EndedAt = new(time.Time)
+				*_m.EndedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Subscription.
+// This includes values selected through modifiers, order, etc.
+func (_m *Subscription) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the Subscription entity.
+func (_m *Subscription) QueryUser() *UserQuery {
+	return NewSubscriptionClient(_m.config).QueryUser(_m)
+}
+
+// QueryPlan queries the "plan" edge of the Subscription entity.
+func (_m *Subscription) QueryPlan() *SubscriptionPlanQuery {
+	return NewSubscriptionClient(_m.config).QueryPlan(_m)
+}
+
+// Update returns a builder for updating this Subscription.
+// Note that you need to call Subscription.Unwrap() before calling this method if this Subscription
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Subscription) Update() *SubscriptionUpdateOne {
+	return NewSubscriptionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Subscription entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Subscription) Unwrap() *Subscription {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("generated: Subscription is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Subscription) String() string {
+	var builder strings.Builder
+	builder.WriteString("Subscription(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(_m.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("plan_id=")
+	builder.WriteString(_m.PlanID)
+	builder.WriteString(", ")
+	builder.WriteString("razorpay_subscription_id=")
+	builder.WriteString(_m.RazorpaySubscriptionID)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	if v := _m.CurrentStart; v != nil {
+		builder.WriteString("current_start=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.CurrentEnd; v != nil {
+		builder.WriteString("current_end=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.GraceUntil; v != nil {
+		builder.WriteString("grace_until=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("cancel_at_period_end=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CancelAtPeriodEnd))
+	builder.WriteString(", ")
+	builder.WriteString("paid_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PaidCount))
+	builder.WriteString(", ")
+	if v := _m.ShortURL; v != nil {
+		builder.WriteString("short_url=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.ActivatedAt; v != nil {
+		builder.WriteString("activated_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.PausedAt; v != nil {
+		builder.WriteString("paused_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.CancelledAt; v != nil {
+		builder.WriteString("cancelled_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.EndedAt; v != nil {
+		builder.WriteString("ended_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Subscriptions is a parsable slice of Subscription.
+type Subscriptions []*Subscription
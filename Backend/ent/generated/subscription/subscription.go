@@ -0,0 +1,272 @@
+// Code generated by ent, DO NOT EDIT.
+
+package subscription
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the subscription type in the database.
+	Label = "subscription"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldPlanID holds the string denoting the plan_id field in the database.
+	FieldPlanID = "plan_id"
+	// FieldRazorpaySubscriptionID holds the string denoting the razorpay_subscription_id field in the database.
+	FieldRazorpaySubscriptionID = "razorpay_subscription_id"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldCurrentStart holds the string denoting the current_start field in the database.
+	FieldCurrentStart = "current_start"
+	// FieldCurrentEnd holds the string denoting the current_end field in the database.
+	FieldCurrentEnd = "current_end"
+	// FieldGraceUntil holds the string denoting the grace_until field in the database.
+	FieldGraceUntil = "grace_until"
+	// FieldCancelAtPeriodEnd holds the string denoting the cancel_at_period_end field in the database.
+	FieldCancelAtPeriodEnd = "cancel_at_period_end"
+	// FieldPaidCount holds the string denoting the paid_count field in the database.
+	FieldPaidCount = "paid_count"
+	// FieldShortURL holds the string denoting the short_url field in the database.
+	FieldShortURL = "short_url"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldActivatedAt holds the string denoting the activated_at field in the database.
+	FieldActivatedAt = "activated_at"
+	// FieldPausedAt holds the string denoting the paused_at field in the database.
+	FieldPausedAt = "paused_at"
+	// FieldCancelledAt holds the string denoting the cancelled_at field in the database.
+	FieldCancelledAt = "cancelled_at"
+	// FieldEndedAt holds the string denoting the ended_at field in the database.
+	FieldEndedAt = "ended_at"
+	// EdgeUser holds the string denoting the user edge name in mutations.
+	EdgeUser = "user"
+	// EdgePlan holds the string denoting the plan edge name in mutations.
+	EdgePlan = "plan"
+	// Table holds the table name of the subscription in the database.
+	Table = "subscriptions"
+	// UserTable is the table that holds the user relation/edge.
+	UserTable = "subscriptions"
+	// UserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	UserInverseTable = "users"
+	// UserColumn is the table column denoting the user relation/edge.
+	UserColumn = "user_id"
+	// PlanTable is the table that holds the plan relation/edge.
+	PlanTable = "subscriptions"
+	// PlanInverseTable is the table name for the SubscriptionPlan entity.
+	// It exists in this package in order to avoid circular dependency with the "subscriptionplan" package.
+	PlanInverseTable = "subscription_plans"
+	// PlanColumn is the table column denoting the plan relation/edge.
+	PlanColumn = "plan_id"
+)
+
+// Columns holds all SQL columns for subscription fields.
+var Columns = []string{
+	FieldID,
+	FieldUserID,
+	FieldPlanID,
+	FieldRazorpaySubscriptionID,
+	FieldStatus,
+	FieldCurrentStart,
+	FieldCurrentEnd,
+	FieldGraceUntil,
+	FieldCancelAtPeriodEnd,
+	FieldPaidCount,
+	FieldShortURL,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldActivatedAt,
+	FieldPausedAt,
+	FieldCancelledAt,
+	FieldEndedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	UserIDValidator func(string) error
+	// PlanIDValidator is a validator for the "plan_id" field. It is called by the builders before save.
+	PlanIDValidator func(string) error
+	// RazorpaySubscriptionIDValidator is a validator for the "razorpay_subscription_id" field. It is called by the builders before save.
+	RazorpaySubscriptionIDValidator func(string) error
+	// DefaultCancelAtPeriodEnd holds the default value on creation for the "cancel_at_period_end" field.
+	DefaultCancelAtPeriodEnd bool
+	// DefaultPaidCount holds the default value on creation for the "paid_count" field.
+	DefaultPaidCount int
+	// ShortURLValidator is a validator for the "short_url" field. It is called by the builders before save.
+	ShortURLValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusCreated is the default value of the Status enum.
+const DefaultStatus = StatusCreated
+
+// Status values.
+const (
+	StatusCreated       Status = "created"
+	StatusAuthenticated Status = "authenticated"
+	StatusActive        Status = "active"
+	StatusPending       Status = "pending"
+	StatusHalted        Status = "halted"
+	StatusPaused        Status = "paused"
+	StatusCancelled     Status = "cancelled"
+	StatusExpired       Status = "expired"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusCreated, StatusAuthenticated, StatusActive, StatusPending, StatusHalted, StatusPaused, StatusCancelled, StatusExpired:
+		return nil
+	default:
+		return fmt.Errorf("subscription: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the Subscription queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByPlanID orders the results by the plan_id field.
+func ByPlanID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPlanID, opts...).ToFunc()
+}
+
+// ByRazorpaySubscriptionID orders the results by the razorpay_subscription_id field.
+func ByRazorpaySubscriptionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRazorpaySubscriptionID, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByCurrentStart orders the results by the current_start field.
+func ByCurrentStart(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrentStart, opts...).ToFunc()
+}
+
+// ByCurrentEnd orders the results by the current_end field.
+func ByCurrentEnd(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrentEnd, opts...).ToFunc()
+}
+
+// ByGraceUntil orders the results by the grace_until field.
+func ByGraceUntil(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGraceUntil, opts...).ToFunc()
+}
+
+// ByCancelAtPeriodEnd orders the results by the cancel_at_period_end field.
+func ByCancelAtPeriodEnd(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCancelAtPeriodEnd, opts...).ToFunc()
+}
+
+// ByPaidCount orders the results by the paid_count field.
+func ByPaidCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPaidCount, opts...).ToFunc()
+}
+
+// ByShortURL orders the results by the short_url field.
+func ByShortURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldShortURL, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByActivatedAt orders the results by the activated_at field.
+func ByActivatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldActivatedAt, opts...).ToFunc()
+}
+
+// ByPausedAt orders the results by the paused_at field.
+func ByPausedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPausedAt, opts...).ToFunc()
+}
+
+// ByCancelledAt orders the results by the cancelled_at field.
+func ByCancelledAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCancelledAt, opts...).ToFunc()
+}
+
+// ByEndedAt orders the results by the ended_at field.
+func ByEndedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndedAt, opts...).ToFunc()
+}
+
+// ByUserField orders the results by user field.
+func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByPlanField orders the results by plan field.
+func ByPlanField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPlanStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newUserStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UserInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+	)
+}
+func newPlanStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PlanInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, PlanTable, PlanColumn),
+	)
+}
@@ -0,0 +1,972 @@
+// Code generated by ent, DO NOT EDIT.
+
+package subscription
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/UnoraApp/be/ent/generated/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldContainsFold(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldUserID, v))
+}
+
+// PlanID applies equality check predicate on the "plan_id" field. It's identical to PlanIDEQ.
+func PlanID(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldPlanID, v))
+}
+
+// RazorpaySubscriptionID applies equality check predicate on the "razorpay_subscription_id" field. It's identical to RazorpaySubscriptionIDEQ.
+func RazorpaySubscriptionID(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldRazorpaySubscriptionID, v))
+}
+
+// CurrentStart applies equality check predicate on the "current_start" field. It's identical to CurrentStartEQ.
+func CurrentStart(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldCurrentStart, v))
+}
+
+// CurrentEnd applies equality check predicate on the "current_end" field. It's identical to CurrentEndEQ.
+func CurrentEnd(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldCurrentEnd, v))
+}
+
+// GraceUntil applies equality check predicate on the "grace_until" field. It's identical to GraceUntilEQ.
+func GraceUntil(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldGraceUntil, v))
+}
+
+// CancelAtPeriodEnd applies equality check predicate on the "cancel_at_period_end" field. It's identical to CancelAtPeriodEndEQ.
+func CancelAtPeriodEnd(v bool) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldCancelAtPeriodEnd, v))
+}
+
+// PaidCount applies equality check predicate on the "paid_count" field. It's identical to PaidCountEQ.
+func PaidCount(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldPaidCount, v))
+}
+
+// ShortURL applies equality check predicate on the "short_url" field. It's identical to ShortURLEQ.
+func ShortURL(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldShortURL, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// ActivatedAt applies equality check predicate on the "activated_at" field. It's identical to ActivatedAtEQ.
+func ActivatedAt(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldActivatedAt, v))
+}
+
+// PausedAt applies equality check predicate on the "paused_at" field. It's identical to PausedAtEQ.
+func PausedAt(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldPausedAt, v))
+}
+
+// CancelledAt applies equality check predicate on the "cancelled_at" field. It's identical to CancelledAtEQ.
+func CancelledAt(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldCancelledAt, v))
+}
+
+// EndedAt applies equality check predicate on the "ended_at" field. It's identical to EndedAtEQ.
+func EndedAt(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldEndedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// PlanIDEQ applies the EQ predicate on the "plan_id" field.
+func PlanIDEQ(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldPlanID, v))
+}
+
+// PlanIDNEQ applies the NEQ predicate on the "plan_id" field.
+func PlanIDNEQ(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldPlanID, v))
+}
+
+// PlanIDIn applies the In predicate on the "plan_id" field.
+func PlanIDIn(vs ...string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldPlanID, vs...))
+}
+
+// PlanIDNotIn applies the NotIn predicate on the "plan_id" field.
+func PlanIDNotIn(vs ...string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldPlanID, vs...))
+}
+
+// PlanIDGT applies the GT predicate on the "plan_id" field.
+func PlanIDGT(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldPlanID, v))
+}
+
+// PlanIDGTE applies the GTE predicate on the "plan_id" field.
+func PlanIDGTE(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldPlanID, v))
+}
+
+// PlanIDLT applies the LT predicate on the "plan_id" field.
+func PlanIDLT(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldPlanID, v))
+}
+
+// PlanIDLTE applies the LTE predicate on the "plan_id" field.
+func PlanIDLTE(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldPlanID, v))
+}
+
+// PlanIDContains applies the Contains predicate on the "plan_id" field.
+func PlanIDContains(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldContains(FieldPlanID, v))
+}
+
+// PlanIDHasPrefix applies the HasPrefix predicate on the "plan_id" field.
+func PlanIDHasPrefix(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldHasPrefix(FieldPlanID, v))
+}
+
+// PlanIDHasSuffix applies the HasSuffix predicate on the "plan_id" field.
+func PlanIDHasSuffix(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldHasSuffix(FieldPlanID, v))
+}
+
+// PlanIDEqualFold applies the EqualFold predicate on the "plan_id" field.
+func PlanIDEqualFold(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEqualFold(FieldPlanID, v))
+}
+
+// PlanIDContainsFold applies the ContainsFold predicate on the "plan_id" field.
+func PlanIDContainsFold(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldContainsFold(FieldPlanID, v))
+}
+
+// RazorpaySubscriptionIDEQ applies the EQ predicate on the "razorpay_subscription_id" field.
+func RazorpaySubscriptionIDEQ(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldRazorpaySubscriptionID, v))
+}
+
+// RazorpaySubscriptionIDNEQ applies the NEQ predicate on the "razorpay_subscription_id" field.
+func RazorpaySubscriptionIDNEQ(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldRazorpaySubscriptionID, v))
+}
+
+// RazorpaySubscriptionIDIn applies the In predicate on the "razorpay_subscription_id" field.
+func RazorpaySubscriptionIDIn(vs ...string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldRazorpaySubscriptionID, vs...))
+}
+
+// RazorpaySubscriptionIDNotIn applies the NotIn predicate on the "razorpay_subscription_id" field.
+func RazorpaySubscriptionIDNotIn(vs ...string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldRazorpaySubscriptionID, vs...))
+}
+
+// RazorpaySubscriptionIDGT applies the GT predicate on the "razorpay_subscription_id" field.
+func RazorpaySubscriptionIDGT(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldRazorpaySubscriptionID, v))
+}
+
+// RazorpaySubscriptionIDGTE applies the GTE predicate on the "razorpay_subscription_id" field.
+func RazorpaySubscriptionIDGTE(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldRazorpaySubscriptionID, v))
+}
+
+// RazorpaySubscriptionIDLT applies the LT predicate on the "razorpay_subscription_id" field.
+func RazorpaySubscriptionIDLT(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldRazorpaySubscriptionID, v))
+}
+
+// RazorpaySubscriptionIDLTE applies the LTE predicate on the "razorpay_subscription_id" field.
+func RazorpaySubscriptionIDLTE(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldRazorpaySubscriptionID, v))
+}
+
+// RazorpaySubscriptionIDContains applies the Contains predicate on the "razorpay_subscription_id" field.
+func RazorpaySubscriptionIDContains(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldContains(FieldRazorpaySubscriptionID, v))
+}
+
+// RazorpaySubscriptionIDHasPrefix applies the HasPrefix predicate on the "razorpay_subscription_id" field.
+func RazorpaySubscriptionIDHasPrefix(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldHasPrefix(FieldRazorpaySubscriptionID, v))
+}
+
+// RazorpaySubscriptionIDHasSuffix applies the HasSuffix predicate on the "razorpay_subscription_id" field.
+func RazorpaySubscriptionIDHasSuffix(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldHasSuffix(FieldRazorpaySubscriptionID, v))
+}
+
+// RazorpaySubscriptionIDEqualFold applies the EqualFold predicate on the "razorpay_subscription_id" field.
+func RazorpaySubscriptionIDEqualFold(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEqualFold(FieldRazorpaySubscriptionID, v))
+}
+
+// RazorpaySubscriptionIDContainsFold applies the ContainsFold predicate on the "razorpay_subscription_id" field.
+func RazorpaySubscriptionIDContainsFold(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldContainsFold(FieldRazorpaySubscriptionID, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// CurrentStartEQ applies the EQ predicate on the "current_start" field.
+func CurrentStartEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldCurrentStart, v))
+}
+
+// CurrentStartNEQ applies the NEQ predicate on the "current_start" field.
+func CurrentStartNEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldCurrentStart, v))
+}
+
+// CurrentStartIn applies the In predicate on the "current_start" field.
+func CurrentStartIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldCurrentStart, vs...))
+}
+
+// CurrentStartNotIn applies the NotIn predicate on the "current_start" field.
+func CurrentStartNotIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldCurrentStart, vs...))
+}
+
+// CurrentStartGT applies the GT predicate on the "current_start" field.
+func CurrentStartGT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldCurrentStart, v))
+}
+
+// CurrentStartGTE applies the GTE predicate on the "current_start" field.
+func CurrentStartGTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldCurrentStart, v))
+}
+
+// CurrentStartLT applies the LT predicate on the "current_start" field.
+func CurrentStartLT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldCurrentStart, v))
+}
+
+// CurrentStartLTE applies the LTE predicate on the "current_start" field.
+func CurrentStartLTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldCurrentStart, v))
+}
+
+// CurrentStartIsNil applies the IsNil predicate on the "current_start" field.
+func CurrentStartIsNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldIsNull(FieldCurrentStart))
+}
+
+// CurrentStartNotNil applies the NotNil predicate on the "current_start" field.
+func CurrentStartNotNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotNull(FieldCurrentStart))
+}
+
+// CurrentEndEQ applies the EQ predicate on the "current_end" field.
+func CurrentEndEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldCurrentEnd, v))
+}
+
+// CurrentEndNEQ applies the NEQ predicate on the "current_end" field.
+func CurrentEndNEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldCurrentEnd, v))
+}
+
+// CurrentEndIn applies the In predicate on the "current_end" field.
+func CurrentEndIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldCurrentEnd, vs...))
+}
+
+// CurrentEndNotIn applies the NotIn predicate on the "current_end" field.
+func CurrentEndNotIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldCurrentEnd, vs...))
+}
+
+// CurrentEndGT applies the GT predicate on the "current_end" field.
+func CurrentEndGT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldCurrentEnd, v))
+}
+
+// CurrentEndGTE applies the GTE predicate on the "current_end" field.
+func CurrentEndGTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldCurrentEnd, v))
+}
+
+// CurrentEndLT applies the LT predicate on the "current_end" field.
+func CurrentEndLT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldCurrentEnd, v))
+}
+
+// CurrentEndLTE applies the LTE predicate on the "current_end" field.
+func CurrentEndLTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldCurrentEnd, v))
+}
+
+// CurrentEndIsNil applies the IsNil predicate on the "current_end" field.
+func CurrentEndIsNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldIsNull(FieldCurrentEnd))
+}
+
+// CurrentEndNotNil applies the NotNil predicate on the "current_end" field.
+func CurrentEndNotNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotNull(FieldCurrentEnd))
+}
+
+// GraceUntilEQ applies the EQ predicate on the "grace_until" field.
+func GraceUntilEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldGraceUntil, v))
+}
+
+// GraceUntilNEQ applies the NEQ predicate on the "grace_until" field.
+func GraceUntilNEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldGraceUntil, v))
+}
+
+// GraceUntilIn applies the In predicate on the "grace_until" field.
+func GraceUntilIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldGraceUntil, vs...))
+}
+
+// GraceUntilNotIn applies the NotIn predicate on the "grace_until" field.
+func GraceUntilNotIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldGraceUntil, vs...))
+}
+
+// GraceUntilGT applies the GT predicate on the "grace_until" field.
+func GraceUntilGT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldGraceUntil, v))
+}
+
+// GraceUntilGTE applies the GTE predicate on the "grace_until" field.
+func GraceUntilGTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldGraceUntil, v))
+}
+
+// GraceUntilLT applies the LT predicate on the "grace_until" field.
+func GraceUntilLT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldGraceUntil, v))
+}
+
+// GraceUntilLTE applies the LTE predicate on the "grace_until" field.
+func GraceUntilLTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldGraceUntil, v))
+}
+
+// GraceUntilIsNil applies the IsNil predicate on the "grace_until" field.
+func GraceUntilIsNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldIsNull(FieldGraceUntil))
+}
+
+// GraceUntilNotNil applies the NotNil predicate on the "grace_until" field.
+func GraceUntilNotNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotNull(FieldGraceUntil))
+}
+
+// CancelAtPeriodEndEQ applies the EQ predicate on the "cancel_at_period_end" field.
+func CancelAtPeriodEndEQ(v bool) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldCancelAtPeriodEnd, v))
+}
+
+// CancelAtPeriodEndNEQ applies the NEQ predicate on the "cancel_at_period_end" field.
+func CancelAtPeriodEndNEQ(v bool) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldCancelAtPeriodEnd, v))
+}
+
+// PaidCountEQ applies the EQ predicate on the "paid_count" field.
+func PaidCountEQ(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldPaidCount, v))
+}
+
+// PaidCountNEQ applies the NEQ predicate on the "paid_count" field.
+func PaidCountNEQ(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldPaidCount, v))
+}
+
+// PaidCountIn applies the In predicate on the "paid_count" field.
+func PaidCountIn(vs ...int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldPaidCount, vs...))
+}
+
+// PaidCountNotIn applies the NotIn predicate on the "paid_count" field.
+func PaidCountNotIn(vs ...int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldPaidCount, vs...))
+}
+
+// PaidCountGT applies the GT predicate on the "paid_count" field.
+func PaidCountGT(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldPaidCount, v))
+}
+
+// PaidCountGTE applies the GTE predicate on the "paid_count" field.
+func PaidCountGTE(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldPaidCount, v))
+}
+
+// PaidCountLT applies the LT predicate on the "paid_count" field.
+func PaidCountLT(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldPaidCount, v))
+}
+
+// PaidCountLTE applies the LTE predicate on the "paid_count" field.
+func PaidCountLTE(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldPaidCount, v))
+}
+
+// ShortURLEQ applies the EQ predicate on the "short_url" field.
+func ShortURLEQ(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldShortURL, v))
+}
+
+// ShortURLNEQ applies the NEQ predicate on the "short_url" field.
+func ShortURLNEQ(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldShortURL, v))
+}
+
+// ShortURLIn applies the In predicate on the "short_url" field.
+func ShortURLIn(vs ...string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldShortURL, vs...))
+}
+
+// ShortURLNotIn applies the NotIn predicate on the "short_url" field.
+func ShortURLNotIn(vs ...string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldShortURL, vs...))
+}
+
+// ShortURLGT applies the GT predicate on the "short_url" field.
+func ShortURLGT(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldShortURL, v))
+}
+
+// ShortURLGTE applies the GTE predicate on the "short_url" field.
+func ShortURLGTE(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldShortURL, v))
+}
+
+// ShortURLLT applies the LT predicate on the "short_url" field.
+func ShortURLLT(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldShortURL, v))
+}
+
+// ShortURLLTE applies the LTE predicate on the "short_url" field.
+func ShortURLLTE(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldShortURL, v))
+}
+
+// ShortURLContains applies the Contains predicate on the "short_url" field.
+func ShortURLContains(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldContains(FieldShortURL, v))
+}
+
+// ShortURLHasPrefix applies the HasPrefix predicate on the "short_url" field.
+func ShortURLHasPrefix(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldHasPrefix(FieldShortURL, v))
+}
+
+// ShortURLHasSuffix applies the HasSuffix predicate on the "short_url" field.
+func ShortURLHasSuffix(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldHasSuffix(FieldShortURL, v))
+}
+
+// ShortURLIsNil applies the IsNil predicate on the "short_url" field.
+func ShortURLIsNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldIsNull(FieldShortURL))
+}
+
+// ShortURLNotNil applies the NotNil predicate on the "short_url" field.
+func ShortURLNotNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotNull(FieldShortURL))
+}
+
+// ShortURLEqualFold applies the EqualFold predicate on the "short_url" field.
+func ShortURLEqualFold(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEqualFold(FieldShortURL, v))
+}
+
+// ShortURLContainsFold applies the ContainsFold predicate on the "short_url" field.
+func ShortURLContainsFold(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldContainsFold(FieldShortURL, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// ActivatedAtEQ applies the EQ predicate on the "activated_at" field.
+func ActivatedAtEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldActivatedAt, v))
+}
+
+// ActivatedAtNEQ applies the NEQ predicate on the "activated_at" field.
+func ActivatedAtNEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldActivatedAt, v))
+}
+
+// ActivatedAtIn applies the In predicate on the "activated_at" field.
+func ActivatedAtIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldActivatedAt, vs...))
+}
+
+// ActivatedAtNotIn applies the NotIn predicate on the "activated_at" field.
+func ActivatedAtNotIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldActivatedAt, vs...))
+}
+
+// ActivatedAtGT applies the GT predicate on the "activated_at" field.
+func ActivatedAtGT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldActivatedAt, v))
+}
+
+// ActivatedAtGTE applies the GTE predicate on the "activated_at" field.
+func ActivatedAtGTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldActivatedAt, v))
+}
+
+// ActivatedAtLT applies the LT predicate on the "activated_at" field.
+func ActivatedAtLT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldActivatedAt, v))
+}
+
+// ActivatedAtLTE applies the LTE predicate on the "activated_at" field.
+func ActivatedAtLTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldActivatedAt, v))
+}
+
+// ActivatedAtIsNil applies the IsNil predicate on the "activated_at" field.
+func ActivatedAtIsNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldIsNull(FieldActivatedAt))
+}
+
+// ActivatedAtNotNil applies the NotNil predicate on the "activated_at" field.
+func ActivatedAtNotNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotNull(FieldActivatedAt))
+}
+
+// PausedAtEQ applies the EQ predicate on the "paused_at" field.
+func PausedAtEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldPausedAt, v))
+}
+
+// PausedAtNEQ applies the NEQ predicate on the "paused_at" field.
+func PausedAtNEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldPausedAt, v))
+}
+
+// PausedAtIn applies the In predicate on the "paused_at" field.
+func PausedAtIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldPausedAt, vs...))
+}
+
+// PausedAtNotIn applies the NotIn predicate on the "paused_at" field.
+func PausedAtNotIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldPausedAt, vs...))
+}
+
+// PausedAtGT applies the GT predicate on the "paused_at" field.
+func PausedAtGT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldPausedAt, v))
+}
+
+// PausedAtGTE applies the GTE predicate on the "paused_at" field.
+func PausedAtGTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldPausedAt, v))
+}
+
+// PausedAtLT applies the LT predicate on the "paused_at" field.
+func PausedAtLT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldPausedAt, v))
+}
+
+// PausedAtLTE applies the LTE predicate on the "paused_at" field.
+func PausedAtLTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldPausedAt, v))
+}
+
+// PausedAtIsNil applies the IsNil predicate on the "paused_at" field.
+func PausedAtIsNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldIsNull(FieldPausedAt))
+}
+
+// PausedAtNotNil applies the NotNil predicate on the "paused_at" field.
+func PausedAtNotNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotNull(FieldPausedAt))
+}
+
+// CancelledAtEQ applies the EQ predicate on the "cancelled_at" field.
+func CancelledAtEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldCancelledAt, v))
+}
+
+// CancelledAtNEQ applies the NEQ predicate on the "cancelled_at" field.
+func CancelledAtNEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldCancelledAt, v))
+}
+
+// CancelledAtIn applies the In predicate on the "cancelled_at" field.
+func CancelledAtIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldCancelledAt, vs...))
+}
+
+// CancelledAtNotIn applies the NotIn predicate on the "cancelled_at" field.
+func CancelledAtNotIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldCancelledAt, vs...))
+}
+
+// CancelledAtGT applies the GT predicate on the "cancelled_at" field.
+func CancelledAtGT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldCancelledAt, v))
+}
+
+// CancelledAtGTE applies the GTE predicate on the "cancelled_at" field.
+func CancelledAtGTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldCancelledAt, v))
+}
+
+// CancelledAtLT applies the LT predicate on the "cancelled_at" field.
+func CancelledAtLT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldCancelledAt, v))
+}
+
+// CancelledAtLTE applies the LTE predicate on the "cancelled_at" field.
+func CancelledAtLTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldCancelledAt, v))
+}
+
+// CancelledAtIsNil applies the IsNil predicate on the "cancelled_at" field.
+func CancelledAtIsNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldIsNull(FieldCancelledAt))
+}
+
+// CancelledAtNotNil applies the NotNil predicate on the "cancelled_at" field.
+func CancelledAtNotNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotNull(FieldCancelledAt))
+}
+
+// EndedAtEQ applies the EQ predicate on the "ended_at" field.
+func EndedAtEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldEndedAt, v))
+}
+
+// EndedAtNEQ applies the NEQ predicate on the "ended_at" field.
+func EndedAtNEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldEndedAt, v))
+}
+
+// EndedAtIn applies the In predicate on the "ended_at" field.
+func EndedAtIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldEndedAt, vs...))
+}
+
+// EndedAtNotIn applies the NotIn predicate on the "ended_at" field.
+func EndedAtNotIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldEndedAt, vs...))
+}
+
+// EndedAtGT applies the GT predicate on the "ended_at" field.
+func EndedAtGT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldEndedAt, v))
+}
+
+// EndedAtGTE applies the GTE predicate on the "ended_at" field.
+func EndedAtGTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldEndedAt, v))
+}
+
+// EndedAtLT applies the LT predicate on the "ended_at" field.
+func EndedAtLT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldEndedAt, v))
+}
+
+// EndedAtLTE applies the LTE predicate on the "ended_at" field.
+func EndedAtLTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldEndedAt, v))
+}
+
+// EndedAtIsNil applies the IsNil predicate on the "ended_at" field.
+func EndedAtIsNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldIsNull(FieldEndedAt))
+}
+
+// EndedAtNotNil applies the NotNil predicate on the "ended_at" field.
+func EndedAtNotNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotNull(FieldEndedAt))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.Subscription {
+	return predicate.Subscription(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.Subscription {
+	return predicate.Subscription(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPlan applies the HasEdge predicate on the "plan" edge.
+func HasPlan() predicate.Subscription {
+	return predicate.Subscription(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PlanTable, PlanColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPlanWith applies the HasEdge predicate on the "plan" edge with a given conditions (other predicates).
+func HasPlanWith(preds ...predicate.SubscriptionPlan) predicate.Subscription {
+	return predicate.Subscription(func(s *sql.Selector) {
+		step := newPlanStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Subscription) predicate.Subscription {
+	return predicate.Subscription(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Subscription) predicate.Subscription {
+	return predicate.Subscription(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Subscription) predicate.Subscription {
+	return predicate.Subscription(sql.NotPredicates(p))
+}
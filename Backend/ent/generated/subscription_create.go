@@ -0,0 +1,1509 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/UnoraApp/be/ent/generated/subscription"
+	"github.com/UnoraApp/be/ent/generated/subscriptionplan"
+	"github.com/UnoraApp/be/ent/generated/user"
+)
+
+// SubscriptionCreate is the builder for creating a Subscription entity.
+type SubscriptionCreate struct {
+	config
+	mutation *SubscriptionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *SubscriptionCreate) SetUserID(v string) *SubscriptionCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetPlanID sets the "plan_id" field.
+func (_c *SubscriptionCreate) SetPlanID(v string) *SubscriptionCreate {
+	_c.mutation.SetPlanID(v)
+	return _c
+}
+
+// SetRazorpaySubscriptionID sets the "razorpay_subscription_id" field.
+func (_c *SubscriptionCreate) SetRazorpaySubscriptionID(v string) *SubscriptionCreate {
+	_c.mutation.SetRazorpaySubscriptionID(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *SubscriptionCreate) SetStatus(v subscription.Status) *SubscriptionCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *SubscriptionCreate) SetNillableStatus(v *subscription.Status) *SubscriptionCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetCurrentStart sets the "current_start" field.
+func (_c *SubscriptionCreate) SetCurrentStart(v time.Time) *SubscriptionCreate {
+	_c.mutation.SetCurrentStart(v)
+	return _c
+}
+
+// SetNillableCurrentStart sets the "current_start" field if the given value is not nil.
+func (_c *SubscriptionCreate) SetNillableCurrentStart(v *time.Time) *SubscriptionCreate {
+	if v != nil {
+		_c.SetCurrentStart(*v)
+	}
+	return _c
+}
+
+// SetCurrentEnd sets the "current_end" field.
+func (_c *SubscriptionCreate) SetCurrentEnd(v time.Time) *SubscriptionCreate {
+	_c.mutation.SetCurrentEnd(v)
+	return _c
+}
+
+// SetNillableCurrentEnd sets the "current_end" field if the given value is not nil.
+func (_c *SubscriptionCreate) SetNillableCurrentEnd(v *time.Time) *SubscriptionCreate {
+	if v != nil {
+		_c.SetCurrentEnd(*v)
+	}
+	return _c
+}
+
+// SetGraceUntil sets the "grace_until" field.
+func (_c *SubscriptionCreate) SetGraceUntil(v time.Time) *SubscriptionCreate {
+	_c.mutation.SetGraceUntil(v)
+	return _c
+}
+
+// SetNillableGraceUntil sets the "grace_until" field if the given value is not nil.
+func (_c *SubscriptionCreate) SetNillableGraceUntil(v *time.Time) *SubscriptionCreate {
+	if v != nil {
+		_c.SetGraceUntil(*v)
+	}
+	return _c
+}
+
+// SetCancelAtPeriodEnd sets the "cancel_at_period_end" field.
+func (_c *SubscriptionCreate) SetCancelAtPeriodEnd(v bool) *SubscriptionCreate {
+	_c.mutation.SetCancelAtPeriodEnd(v)
+	return _c
+}
+
+// SetNillableCancelAtPeriodEnd sets the "cancel_at_period_end" field if the given value is not nil.
+func (_c *SubscriptionCreate) SetNillableCancelAtPeriodEnd(v *bool) *SubscriptionCreate {
+	if v != nil {
+		_c.SetCancelAtPeriodEnd(*v)
+	}
+	return _c
+}
+
+// SetPaidCount sets the "paid_count" field.
+func (_c *SubscriptionCreate) SetPaidCount(v int) *SubscriptionCreate {
+	_c.mutation.SetPaidCount(v)
+	return _c
+}
+
+// SetNillablePaidCount sets the "paid_count" field if the given value is not nil.
+func (_c *SubscriptionCreate) SetNillablePaidCount(v *int) *SubscriptionCreate {
+	if v != nil {
+		_c.SetPaidCount(*v)
+	}
+	return _c
+}
+
+// SetShortURL sets the "short_url" field.
+func (_c *SubscriptionCreate) SetShortURL(v string) *SubscriptionCreate {
+	_c.mutation.SetShortURL(v)
+	return _c
+}
+
+// SetNillableShortURL sets the "short_url" field if the given value is not nil.
+func (_c *SubscriptionCreate) SetNillableShortURL(v *string) *SubscriptionCreate {
+	if v != nil {
+		_c.SetShortURL(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *SubscriptionCreate) SetCreatedAt(v time.Time) *SubscriptionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *SubscriptionCreate) SetNillableCreatedAt(v *time.Time) *SubscriptionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *SubscriptionCreate) SetUpdatedAt(v time.Time) *SubscriptionCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *SubscriptionCreate) SetNillableUpdatedAt(v *time.Time) *SubscriptionCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetActivatedAt sets the "activated_at" field.
+func (_c *SubscriptionCreate) SetActivatedAt(v time.Time) *SubscriptionCreate {
+	_c.mutation.SetActivatedAt(v)
+	return _c
+}
+
+// SetNillableActivatedAt sets the "activated_at" field if the given value is not nil.
+func (_c *SubscriptionCreate) SetNillableActivatedAt(v *time.Time) *SubscriptionCreate {
+	if v != nil {
+		_c.SetActivatedAt(*v)
+	}
+	return _c
+}
+
+// SetPausedAt sets the "paused_at" field.
+func (_c *SubscriptionCreate) SetPausedAt(v time.Time) *SubscriptionCreate {
+	_c.mutation.SetPausedAt(v)
+	return _c
+}
+
+// SetNillablePausedAt sets the "paused_at" field if the given value is not nil.
+func (_c *SubscriptionCreate) SetNillablePausedAt(v *time.Time) *SubscriptionCreate {
+	if v != nil {
+		_c.SetPausedAt(*v)
+	}
+	return _c
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (_c *SubscriptionCreate) SetCancelledAt(v time.Time) *SubscriptionCreate {
+	_c.mutation.SetCancelledAt(v)
+	return _c
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (_c *SubscriptionCreate) SetNillableCancelledAt(v *time.Time) *SubscriptionCreate {
+	if v != nil {
+		_c.SetCancelledAt(*v)
+	}
+	return _c
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (_c *SubscriptionCreate) SetEndedAt(v time.Time) *SubscriptionCreate {
+	_c.mutation.SetEndedAt(v)
+	return _c
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (_c *SubscriptionCreate) SetNillableEndedAt(v *time.Time) *SubscriptionCreate {
+	if v != nil {
+		_c.SetEndedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *SubscriptionCreate) SetID(v string) *SubscriptionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *SubscriptionCreate) SetUser(v *User) *SubscriptionCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// SetPlan sets the "plan" edge to the SubscriptionPlan entity.
+func (_c *SubscriptionCreate) SetPlan(v *SubscriptionPlan) *SubscriptionCreate {
+	return _c.SetPlanID(v.ID)
+}
+
+// Mutation returns the SubscriptionMutation object of the builder.
+func (_c *SubscriptionCreate) Mutation() *SubscriptionMutation {
+	return _c.mutation
+}
+
+// Save creates the Subscription in the database.
+func (_c *SubscriptionCreate) Save(ctx context.Context) (*Subscription, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *SubscriptionCreate) SaveX(ctx context.Context) *Subscription {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SubscriptionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SubscriptionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *SubscriptionCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := subscription.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.CancelAtPeriodEnd(); !ok {
+		v := subscription.DefaultCancelAtPeriodEnd
+		_c.mutation.SetCancelAtPeriodEnd(v)
+	}
+	if _, ok := _c.mutation.PaidCount(); !ok {
+		v := subscription.DefaultPaidCount
+		_c.mutation.SetPaidCount(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := subscription.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := subscription.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *SubscriptionCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`generated: missing required field "Subscription.user_id"`)}
+	}
+	if v, ok := _c.mutation.UserID(); ok {
+		if err := subscription.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`generated: validator failed for field "Subscription.user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PlanID(); !ok {
+		return &ValidationError{Name: "plan_id", err: errors.New(`generated: missing required field "Subscription.plan_id"`)}
+	}
+	if v, ok := _c.mutation.PlanID(); ok {
+		if err := subscription.PlanIDValidator(v); err != nil {
+			return &ValidationError{Name: "plan_id", err: fmt.Errorf(`generated: validator failed for field "Subscription.plan_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RazorpaySubscriptionID(); !ok {
+		return &ValidationError{Name: "razorpay_subscription_id", err: errors.New(`generated: missing required field "Subscription.razorpay_subscription_id"`)}
+	}
+	if v, ok := _c.mutation.RazorpaySubscriptionID(); ok {
+		if err := subscription.RazorpaySubscriptionIDValidator(v); err != nil {
+			return &ValidationError{Name: "razorpay_subscription_id", err: fmt.Errorf(`generated: validator failed for field "Subscription.razorpay_subscription_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`generated: missing required field "Subscription.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := subscription.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`generated: validator failed for field "Subscription.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CancelAtPeriodEnd(); !ok {
+		return &ValidationError{Name: "cancel_at_period_end", err: errors.New(`generated: missing required field "Subscription.cancel_at_period_end"`)}
+	}
+	if _, ok := _c.mutation.PaidCount(); !ok {
+		return &ValidationError{Name: "paid_count", err: errors.New(`generated: missing required field "Subscription.paid_count"`)}
+	}
+	if v, ok := _c.mutation.ShortURL(); ok {
+		if err := subscription.ShortURLValidator(v); err != nil {
+			return &ValidationError{Name: "short_url", err: fmt.Errorf(`generated: validator failed for field "Subscription.short_url": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "Subscription.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`generated: missing required field "Subscription.updated_at"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := subscription.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`generated: validator failed for field "Subscription.id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`generated: missing required edge "Subscription.user"`)}
+	}
+	if len(_c.mutation.PlanIDs()) == 0 {
+		return &ValidationError{Name: "plan", err: errors.New(`generated: missing required edge "Subscription.plan"`)}
+	}
+	return nil
+}
+
+func (_c *SubscriptionCreate) sqlSave(ctx context.Context) (*Subscription, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Subscription.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *SubscriptionCreate) createSpec() (*Subscription, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Subscription{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(subscription.Table, sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.RazorpaySubscriptionID(); ok {
+		_spec.SetField(subscription.FieldRazorpaySubscriptionID, field.TypeString, value)
+		_node.RazorpaySubscriptionID = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(subscription.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.CurrentStart(); ok {
+		_spec.SetField(subscription.FieldCurrentStart, field.TypeTime, value)
+		_node.CurrentStart = &value
+	}
+	if value, ok := _c.mutation.CurrentEnd(); ok {
+		_spec.SetField(subscription.FieldCurrentEnd, field.TypeTime, value)
+		_node.CurrentEnd = &value
+	}
+	if value, ok := _c.mutation.GraceUntil(); ok {
+		_spec.SetField(subscription.FieldGraceUntil, field.TypeTime, value)
+		_node.GraceUntil = &value
+	}
+	if value, ok := _c.mutation.CancelAtPeriodEnd(); ok {
+		_spec.SetField(subscription.FieldCancelAtPeriodEnd, field.TypeBool, value)
+		_node.CancelAtPeriodEnd = value
+	}
+	if value, ok := _c.mutation.PaidCount(); ok {
+		_spec.SetField(subscription.FieldPaidCount, field.TypeInt, value)
+		_node.PaidCount = value
+	}
+	if value, ok := _c.mutation.ShortURL(); ok {
+		_spec.SetField(subscription.FieldShortURL, field.TypeString, value)
+		_node.ShortURL = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(subscription.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(subscription.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.ActivatedAt(); ok {
+		_spec.SetField(subscription.FieldActivatedAt, field.TypeTime, value)
+		_node.ActivatedAt = &value
+	}
+	if value, ok := _c.mutation.PausedAt(); ok {
+		_spec.SetField(subscription.FieldPausedAt, field.TypeTime, value)
+		_node.PausedAt = &value
+	}
+	if value, ok := _c.mutation.CancelledAt(); ok {
+		_spec.SetField(subscription.FieldCancelledAt, field.TypeTime, value)
+		_node.CancelledAt = &value
+	}
+	if value, ok := _c.mutation.EndedAt(); ok {
+		_spec.SetField(subscription.FieldEndedAt, field.TypeTime, value)
+		_node.EndedAt = &value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   subscription.UserTable,
+			Columns: []string{subscription.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PlanIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   subscription.PlanTable,
+			Columns: []string{subscription.PlanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscriptionplan.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PlanID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Subscription.Create().
+//		SetUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SubscriptionUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *SubscriptionCreate) OnConflict(opts ...sql.ConflictOption) *SubscriptionUpsertOne {
+	_c.conflict = opts
+	return &SubscriptionUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Subscription.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *SubscriptionCreate) OnConflictColumns(columns ...string) *SubscriptionUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &SubscriptionUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// SubscriptionUpsertOne is the builder for "upsert"-ing
+	//  one Subscription node.
+	SubscriptionUpsertOne struct {
+		create *SubscriptionCreate
+	}
+
+	// SubscriptionUpsert is the "OnConflict" setter.
+	SubscriptionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetStatus sets the "status" field.
+func (u *SubscriptionUpsert) SetStatus(v subscription.Status) *SubscriptionUpsert {
+	u.Set(subscription.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *SubscriptionUpsert) UpdateStatus() *SubscriptionUpsert {
+	u.SetExcluded(subscription.FieldStatus)
+	return u
+}
+
+// SetCurrentStart sets the "current_start" field.
+func (u *SubscriptionUpsert) SetCurrentStart(v time.Time) *SubscriptionUpsert {
+	u.Set(subscription.FieldCurrentStart, v)
+	return u
+}
+
+// UpdateCurrentStart sets the "current_start" field to the value that was provided on create.
+func (u *SubscriptionUpsert) UpdateCurrentStart() *SubscriptionUpsert {
+	u.SetExcluded(subscription.FieldCurrentStart)
+	return u
+}
+
+// ClearCurrentStart clears the value of the "current_start" field.
+func (u *SubscriptionUpsert) ClearCurrentStart() *SubscriptionUpsert {
+	u.SetNull(subscription.FieldCurrentStart)
+	return u
+}
+
+// SetCurrentEnd sets the "current_end" field.
+func (u *SubscriptionUpsert) SetCurrentEnd(v time.Time) *SubscriptionUpsert {
+	u.Set(subscription.FieldCurrentEnd, v)
+	return u
+}
+
+// UpdateCurrentEnd sets the "current_end" field to the value that was provided on create.
+func (u *SubscriptionUpsert) UpdateCurrentEnd() *SubscriptionUpsert {
+	u.SetExcluded(subscription.FieldCurrentEnd)
+	return u
+}
+
+// ClearCurrentEnd clears the value of the "current_end" field.
+func (u *SubscriptionUpsert) ClearCurrentEnd() *SubscriptionUpsert {
+	u.SetNull(subscription.FieldCurrentEnd)
+	return u
+}
+
+// SetGraceUntil sets the "grace_until" field.
+func (u *SubscriptionUpsert) SetGraceUntil(v time.Time) *SubscriptionUpsert {
+	u.Set(subscription.FieldGraceUntil, v)
+	return u
+}
+
+// UpdateGraceUntil sets the "grace_until" field to the value that was provided on create.
+func (u *SubscriptionUpsert) UpdateGraceUntil() *SubscriptionUpsert {
+	u.SetExcluded(subscription.FieldGraceUntil)
+	return u
+}
+
+// ClearGraceUntil clears the value of the "grace_until" field.
+func (u *SubscriptionUpsert) ClearGraceUntil() *SubscriptionUpsert {
+	u.SetNull(subscription.FieldGraceUntil)
+	return u
+}
+
+// SetCancelAtPeriodEnd sets the "cancel_at_period_end" field.
+func (u *SubscriptionUpsert) SetCancelAtPeriodEnd(v bool) *SubscriptionUpsert {
+	u.Set(subscription.FieldCancelAtPeriodEnd, v)
+	return u
+}
+
+// UpdateCancelAtPeriodEnd sets the "cancel_at_period_end" field to the value that was provided on create.
+func (u *SubscriptionUpsert) UpdateCancelAtPeriodEnd() *SubscriptionUpsert {
+	u.SetExcluded(subscription.FieldCancelAtPeriodEnd)
+	return u
+}
+
+// SetPaidCount sets the "paid_count" field.
+func (u *SubscriptionUpsert) SetPaidCount(v int) *SubscriptionUpsert {
+	u.Set(subscription.FieldPaidCount, v)
+	return u
+}
+
+// UpdatePaidCount sets the "paid_count" field to the value that was provided on create.
+func (u *SubscriptionUpsert) UpdatePaidCount() *SubscriptionUpsert {
+	u.SetExcluded(subscription.FieldPaidCount)
+	return u
+}
+
+// AddPaidCount adds v to the "paid_count" field.
+func (u *SubscriptionUpsert) AddPaidCount(v int) *SubscriptionUpsert {
+	u.Add(subscription.FieldPaidCount, v)
+	return u
+}
+
+// SetShortURL sets the "short_url" field.
+func (u *SubscriptionUpsert) SetShortURL(v string) *SubscriptionUpsert {
+	u.Set(subscription.FieldShortURL, v)
+	return u
+}
+
+// UpdateShortURL sets the "short_url" field to the value that was provided on create.
+func (u *SubscriptionUpsert) UpdateShortURL() *SubscriptionUpsert {
+	u.SetExcluded(subscription.FieldShortURL)
+	return u
+}
+
+// ClearShortURL clears the value of the "short_url" field.
+func (u *SubscriptionUpsert) ClearShortURL() *SubscriptionUpsert {
+	u.SetNull(subscription.FieldShortURL)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SubscriptionUpsert) SetUpdatedAt(v time.Time) *SubscriptionUpsert {
+	u.Set(subscription.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SubscriptionUpsert) UpdateUpdatedAt() *SubscriptionUpsert {
+	u.SetExcluded(subscription.FieldUpdatedAt)
+	return u
+}
+
+// SetActivatedAt sets the "activated_at" field.
+func (u *SubscriptionUpsert) SetActivatedAt(v time.Time) *SubscriptionUpsert {
+	u.Set(subscription.FieldActivatedAt, v)
+	return u
+}
+
+// UpdateActivatedAt sets the "activated_at" field to the value that was provided on create.
+func (u *SubscriptionUpsert) UpdateActivatedAt() *SubscriptionUpsert {
+	u.SetExcluded(subscription.FieldActivatedAt)
+	return u
+}
+
+// ClearActivatedAt clears the value of the "activated_at" field.
+func (u *SubscriptionUpsert) ClearActivatedAt() *SubscriptionUpsert {
+	u.SetNull(subscription.FieldActivatedAt)
+	return u
+}
+
+// SetPausedAt sets the "paused_at" field.
+func (u *SubscriptionUpsert) SetPausedAt(v time.Time) *SubscriptionUpsert {
+	u.Set(subscription.FieldPausedAt, v)
+	return u
+}
+
+// UpdatePausedAt sets the "paused_at" field to the value that was provided on create.
+func (u *SubscriptionUpsert) UpdatePausedAt() *SubscriptionUpsert {
+	u.SetExcluded(subscription.FieldPausedAt)
+	return u
+}
+
+// ClearPausedAt clears the value of the "paused_at" field.
+func (u *SubscriptionUpsert) ClearPausedAt() *SubscriptionUpsert {
+	u.SetNull(subscription.FieldPausedAt)
+	return u
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (u *SubscriptionUpsert) SetCancelledAt(v time.Time) *SubscriptionUpsert {
+	u.Set(subscription.FieldCancelledAt, v)
+	return u
+}
+
+// UpdateCancelledAt sets the "cancelled_at" field to the value that was provided on create.
+func (u *SubscriptionUpsert) UpdateCancelledAt() *SubscriptionUpsert {
+	u.SetExcluded(subscription.FieldCancelledAt)
+	return u
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (u *SubscriptionUpsert) ClearCancelledAt() *SubscriptionUpsert {
+	u.SetNull(subscription.FieldCancelledAt)
+	return u
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (u *SubscriptionUpsert) SetEndedAt(v time.Time) *SubscriptionUpsert {
+	u.Set(subscription.FieldEndedAt, v)
+	return u
+}
+
+// UpdateEndedAt sets the "ended_at" field to the value that was provided on create.
+func (u *SubscriptionUpsert) UpdateEndedAt() *SubscriptionUpsert {
+	u.SetExcluded(subscription.FieldEndedAt)
+	return u
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (u *SubscriptionUpsert) ClearEndedAt() *SubscriptionUpsert {
+	u.SetNull(subscription.FieldEndedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Subscription.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(subscription.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *SubscriptionUpsertOne) UpdateNewValues() *SubscriptionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(subscription.FieldID)
+		}
+		if _, exists := u.create.mutation.UserID(); exists {
+			s.SetIgnore(subscription.FieldUserID)
+		}
+		if _, exists := u.create.mutation.PlanID(); exists {
+			s.SetIgnore(subscription.FieldPlanID)
+		}
+		if _, exists := u.create.mutation.RazorpaySubscriptionID(); exists {
+			s.SetIgnore(subscription.FieldRazorpaySubscriptionID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(subscription.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Subscription.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *SubscriptionUpsertOne) Ignore() *SubscriptionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SubscriptionUpsertOne) DoNothing() *SubscriptionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SubscriptionCreate.OnConflict
+// documentation for more info.
+func (u *SubscriptionUpsertOne) Update(set func(*SubscriptionUpsert)) *SubscriptionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SubscriptionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *SubscriptionUpsertOne) SetStatus(v subscription.Status) *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *SubscriptionUpsertOne) UpdateStatus() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetCurrentStart sets the "current_start" field.
+func (u *SubscriptionUpsertOne) SetCurrentStart(v time.Time) *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetCurrentStart(v)
+	})
+}
+
+// UpdateCurrentStart sets the "current_start" field to the value that was provided on create.
+func (u *SubscriptionUpsertOne) UpdateCurrentStart() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateCurrentStart()
+	})
+}
+
+// ClearCurrentStart clears the value of the "current_start" field.
+func (u *SubscriptionUpsertOne) ClearCurrentStart() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.ClearCurrentStart()
+	})
+}
+
+// SetCurrentEnd sets the "current_end" field.
+func (u *SubscriptionUpsertOne) SetCurrentEnd(v time.Time) *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetCurrentEnd(v)
+	})
+}
+
+// UpdateCurrentEnd sets the "current_end" field to the value that was provided on create.
+func (u *SubscriptionUpsertOne) UpdateCurrentEnd() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateCurrentEnd()
+	})
+}
+
+// ClearCurrentEnd clears the value of the "current_end" field.
+func (u *SubscriptionUpsertOne) ClearCurrentEnd() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.ClearCurrentEnd()
+	})
+}
+
+// SetGraceUntil sets the "grace_until" field.
+func (u *SubscriptionUpsertOne) SetGraceUntil(v time.Time) *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetGraceUntil(v)
+	})
+}
+
+// UpdateGraceUntil sets the "grace_until" field to the value that was provided on create.
+func (u *SubscriptionUpsertOne) UpdateGraceUntil() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateGraceUntil()
+	})
+}
+
+// ClearGraceUntil clears the value of the "grace_until" field.
+func (u *SubscriptionUpsertOne) ClearGraceUntil() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.ClearGraceUntil()
+	})
+}
+
+// SetCancelAtPeriodEnd sets the "cancel_at_period_end" field.
+func (u *SubscriptionUpsertOne) SetCancelAtPeriodEnd(v bool) *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetCancelAtPeriodEnd(v)
+	})
+}
+
+// UpdateCancelAtPeriodEnd sets the "cancel_at_period_end" field to the value that was provided on create.
+func (u *SubscriptionUpsertOne) UpdateCancelAtPeriodEnd() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateCancelAtPeriodEnd()
+	})
+}
+
+// SetPaidCount sets the "paid_count" field.
+func (u *SubscriptionUpsertOne) SetPaidCount(v int) *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetPaidCount(v)
+	})
+}
+
+// AddPaidCount adds v to the "paid_count" field.
+func (u *SubscriptionUpsertOne) AddPaidCount(v int) *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.AddPaidCount(v)
+	})
+}
+
+// UpdatePaidCount sets the "paid_count" field to the value that was provided on create.
+func (u *SubscriptionUpsertOne) UpdatePaidCount() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdatePaidCount()
+	})
+}
+
+// SetShortURL sets the "short_url" field.
+func (u *SubscriptionUpsertOne) SetShortURL(v string) *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetShortURL(v)
+	})
+}
+
+// UpdateShortURL sets the "short_url" field to the value that was provided on create.
+func (u *SubscriptionUpsertOne) UpdateShortURL() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateShortURL()
+	})
+}
+
+// ClearShortURL clears the value of the "short_url" field.
+func (u *SubscriptionUpsertOne) ClearShortURL() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.ClearShortURL()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SubscriptionUpsertOne) SetUpdatedAt(v time.Time) *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SubscriptionUpsertOne) UpdateUpdatedAt() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetActivatedAt sets the "activated_at" field.
+func (u *SubscriptionUpsertOne) SetActivatedAt(v time.Time) *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetActivatedAt(v)
+	})
+}
+
+// UpdateActivatedAt sets the "activated_at" field to the value that was provided on create.
+func (u *SubscriptionUpsertOne) UpdateActivatedAt() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateActivatedAt()
+	})
+}
+
+// ClearActivatedAt clears the value of the "activated_at" field.
+func (u *SubscriptionUpsertOne) ClearActivatedAt() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.ClearActivatedAt()
+	})
+}
+
+// SetPausedAt sets the "paused_at" field.
+func (u *SubscriptionUpsertOne) SetPausedAt(v time.Time) *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetPausedAt(v)
+	})
+}
+
+// UpdatePausedAt sets the "paused_at" field to the value that was provided on create.
+func (u *SubscriptionUpsertOne) UpdatePausedAt() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdatePausedAt()
+	})
+}
+
+// ClearPausedAt clears the value of the "paused_at" field.
+func (u *SubscriptionUpsertOne) ClearPausedAt() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.ClearPausedAt()
+	})
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (u *SubscriptionUpsertOne) SetCancelledAt(v time.Time) *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetCancelledAt(v)
+	})
+}
+
+// UpdateCancelledAt sets the "cancelled_at" field to the value that was provided on create.
+func (u *SubscriptionUpsertOne) UpdateCancelledAt() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateCancelledAt()
+	})
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (u *SubscriptionUpsertOne) ClearCancelledAt() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.ClearCancelledAt()
+	})
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (u *SubscriptionUpsertOne) SetEndedAt(v time.Time) *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetEndedAt(v)
+	})
+}
+
+// UpdateEndedAt sets the "ended_at" field to the value that was provided on create.
+func (u *SubscriptionUpsertOne) UpdateEndedAt() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateEndedAt()
+	})
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (u *SubscriptionUpsertOne) ClearEndedAt() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.ClearEndedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *SubscriptionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("generated: missing options for SubscriptionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SubscriptionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *SubscriptionUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("generated: SubscriptionUpsertOne.ID is not supported by MySQL driver. Use SubscriptionUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *SubscriptionUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// SubscriptionCreateBulk is the builder for creating many Subscription entities in bulk.
+type SubscriptionCreateBulk struct {
+	config
+	err      error
+	builders []*SubscriptionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Subscription entities in the database.
+func (_c *SubscriptionCreateBulk) Save(ctx context.Context) ([]*Subscription, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Subscription, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SubscriptionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *SubscriptionCreateBulk) SaveX(ctx context.Context) []*Subscription {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SubscriptionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SubscriptionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Subscription.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SubscriptionUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *SubscriptionCreateBulk) OnConflict(opts ...sql.ConflictOption) *SubscriptionUpsertBulk {
+	_c.conflict = opts
+	return &SubscriptionUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Subscription.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *SubscriptionCreateBulk) OnConflictColumns(columns ...string) *SubscriptionUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &SubscriptionUpsertBulk{
+		create: _c,
+	}
+}
+
+// SubscriptionUpsertBulk is the builder for "upsert"-ing
+// a bulk of Subscription nodes.
+type SubscriptionUpsertBulk struct {
+	create *SubscriptionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Subscription.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(subscription.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *SubscriptionUpsertBulk) UpdateNewValues() *SubscriptionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(subscription.FieldID)
+			}
+			if _, exists := b.mutation.UserID(); exists {
+				s.SetIgnore(subscription.FieldUserID)
+			}
+			if _, exists := b.mutation.PlanID(); exists {
+				s.SetIgnore(subscription.FieldPlanID)
+			}
+			if _, exists := b.mutation.RazorpaySubscriptionID(); exists {
+				s.SetIgnore(subscription.FieldRazorpaySubscriptionID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(subscription.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Subscription.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *SubscriptionUpsertBulk) Ignore() *SubscriptionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SubscriptionUpsertBulk) DoNothing() *SubscriptionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SubscriptionCreateBulk.OnConflict
+// documentation for more info.
+func (u *SubscriptionUpsertBulk) Update(set func(*SubscriptionUpsert)) *SubscriptionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SubscriptionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *SubscriptionUpsertBulk) SetStatus(v subscription.Status) *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *SubscriptionUpsertBulk) UpdateStatus() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetCurrentStart sets the "current_start" field.
+func (u *SubscriptionUpsertBulk) SetCurrentStart(v time.Time) *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetCurrentStart(v)
+	})
+}
+
+// UpdateCurrentStart sets the "current_start" field to the value that was provided on create.
+func (u *SubscriptionUpsertBulk) UpdateCurrentStart() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateCurrentStart()
+	})
+}
+
+// ClearCurrentStart clears the value of the "current_start" field.
+func (u *SubscriptionUpsertBulk) ClearCurrentStart() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.ClearCurrentStart()
+	})
+}
+
+// SetCurrentEnd sets the "current_end" field.
+func (u *SubscriptionUpsertBulk) SetCurrentEnd(v time.Time) *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetCurrentEnd(v)
+	})
+}
+
+// UpdateCurrentEnd sets the "current_end" field to the value that was provided on create.
+func (u *SubscriptionUpsertBulk) UpdateCurrentEnd() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateCurrentEnd()
+	})
+}
+
+// ClearCurrentEnd clears the value of the "current_end" field.
+func (u *SubscriptionUpsertBulk) ClearCurrentEnd() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.ClearCurrentEnd()
+	})
+}
+
+// SetGraceUntil sets the "grace_until" field.
+func (u *SubscriptionUpsertBulk) SetGraceUntil(v time.Time) *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetGraceUntil(v)
+	})
+}
+
+// UpdateGraceUntil sets the "grace_until" field to the value that was provided on create.
+func (u *SubscriptionUpsertBulk) UpdateGraceUntil() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateGraceUntil()
+	})
+}
+
+// ClearGraceUntil clears the value of the "grace_until" field.
+func (u *SubscriptionUpsertBulk) ClearGraceUntil() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.ClearGraceUntil()
+	})
+}
+
+// SetCancelAtPeriodEnd sets the "cancel_at_period_end" field.
+func (u *SubscriptionUpsertBulk) SetCancelAtPeriodEnd(v bool) *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetCancelAtPeriodEnd(v)
+	})
+}
+
+// UpdateCancelAtPeriodEnd sets the "cancel_at_period_end" field to the value that was provided on create.
+func (u *SubscriptionUpsertBulk) UpdateCancelAtPeriodEnd() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateCancelAtPeriodEnd()
+	})
+}
+
+// SetPaidCount sets the "paid_count" field.
+func (u *SubscriptionUpsertBulk) SetPaidCount(v int) *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetPaidCount(v)
+	})
+}
+
+// AddPaidCount adds v to the "paid_count" field.
+func (u *SubscriptionUpsertBulk) AddPaidCount(v int) *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.AddPaidCount(v)
+	})
+}
+
+// UpdatePaidCount sets the "paid_count" field to the value that was provided on create.
+func (u *SubscriptionUpsertBulk) UpdatePaidCount() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdatePaidCount()
+	})
+}
+
+// SetShortURL sets the "short_url" field.
+func (u *SubscriptionUpsertBulk) SetShortURL(v string) *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetShortURL(v)
+	})
+}
+
+// UpdateShortURL sets the "short_url" field to the value that was provided on create.
+func (u *SubscriptionUpsertBulk) UpdateShortURL() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateShortURL()
+	})
+}
+
+// ClearShortURL clears the value of the "short_url" field.
+func (u *SubscriptionUpsertBulk) ClearShortURL() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.ClearShortURL()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SubscriptionUpsertBulk) SetUpdatedAt(v time.Time) *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SubscriptionUpsertBulk) UpdateUpdatedAt() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetActivatedAt sets the "activated_at" field.
+func (u *SubscriptionUpsertBulk) SetActivatedAt(v time.Time) *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetActivatedAt(v)
+	})
+}
+
+// UpdateActivatedAt sets the "activated_at" field to the value that was provided on create.
+func (u *SubscriptionUpsertBulk) UpdateActivatedAt() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateActivatedAt()
+	})
+}
+
+// ClearActivatedAt clears the value of the "activated_at" field.
+func (u *SubscriptionUpsertBulk) ClearActivatedAt() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.ClearActivatedAt()
+	})
+}
+
+// SetPausedAt sets the "paused_at" field.
+func (u *SubscriptionUpsertBulk) SetPausedAt(v time.Time) *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetPausedAt(v)
+	})
+}
+
+// UpdatePausedAt sets the "paused_at" field to the value that was provided on create.
+func (u *SubscriptionUpsertBulk) UpdatePausedAt() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdatePausedAt()
+	})
+}
+
+// ClearPausedAt clears the value of the "paused_at" field.
+func (u *SubscriptionUpsertBulk) ClearPausedAt() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.ClearPausedAt()
+	})
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (u *SubscriptionUpsertBulk) SetCancelledAt(v time.Time) *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetCancelledAt(v)
+	})
+}
+
+// UpdateCancelledAt sets the "cancelled_at" field to the value that was provided on create.
+func (u *SubscriptionUpsertBulk) UpdateCancelledAt() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateCancelledAt()
+	})
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (u *SubscriptionUpsertBulk) ClearCancelledAt() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.ClearCancelledAt()
+	})
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (u *SubscriptionUpsertBulk) SetEndedAt(v time.Time) *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetEndedAt(v)
+	})
+}
+
+// UpdateEndedAt sets the "ended_at" field to the value that was provided on create.
+func (u *SubscriptionUpsertBulk) UpdateEndedAt() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateEndedAt()
+	})
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (u *SubscriptionUpsertBulk) ClearEndedAt() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.ClearEndedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *SubscriptionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("generated: OnConflict was set for builder %d. Set it on the SubscriptionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("generated: missing options for SubscriptionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SubscriptionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
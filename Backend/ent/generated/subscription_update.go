@@ -0,0 +1,798 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/UnoraApp/be/ent/generated/predicate"
+	"github.com/UnoraApp/be/ent/generated/subscription"
+)
+
+// SubscriptionUpdate is the builder for updating Subscription entities.
+type SubscriptionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SubscriptionMutation
+}
+
+// Where appends a list predicates to the SubscriptionUpdate builder.
+func (_u *SubscriptionUpdate) Where(ps ...predicate.Subscription) *SubscriptionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *SubscriptionUpdate) SetStatus(v subscription.Status) *SubscriptionUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *SubscriptionUpdate) SetNillableStatus(v *subscription.Status) *SubscriptionUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetCurrentStart sets the "current_start" field.
+func (_u *SubscriptionUpdate) SetCurrentStart(v time.Time) *SubscriptionUpdate {
+	_u.mutation.SetCurrentStart(v)
+	return _u
+}
+
+// SetNillableCurrentStart sets the "current_start" field if the given value is not nil.
+func (_u *SubscriptionUpdate) SetNillableCurrentStart(v *time.Time) *SubscriptionUpdate {
+	if v != nil {
+		_u.SetCurrentStart(*v)
+	}
+	return _u
+}
+
+// ClearCurrentStart clears the value of the "current_start" field.
+func (_u *SubscriptionUpdate) ClearCurrentStart() *SubscriptionUpdate {
+	_u.mutation.ClearCurrentStart()
+	return _u
+}
+
+// SetCurrentEnd sets the "current_end" field.
+func (_u *SubscriptionUpdate) SetCurrentEnd(v time.Time) *SubscriptionUpdate {
+	_u.mutation.SetCurrentEnd(v)
+	return _u
+}
+
+// SetNillableCurrentEnd sets the "current_end" field if the given value is not nil.
+func (_u *SubscriptionUpdate) SetNillableCurrentEnd(v *time.Time) *SubscriptionUpdate {
+	if v != nil {
+		_u.SetCurrentEnd(*v)
+	}
+	return _u
+}
+
+// ClearCurrentEnd clears the value of the "current_end" field.
+func (_u *SubscriptionUpdate) ClearCurrentEnd() *SubscriptionUpdate {
+	_u.mutation.ClearCurrentEnd()
+	return _u
+}
+
+// SetGraceUntil sets the "grace_until" field.
+func (_u *SubscriptionUpdate) SetGraceUntil(v time.Time) *SubscriptionUpdate {
+	_u.mutation.SetGraceUntil(v)
+	return _u
+}
+
+// SetNillableGraceUntil sets the "grace_until" field if the given value is not nil.
+func (_u *SubscriptionUpdate) SetNillableGraceUntil(v *time.Time) *SubscriptionUpdate {
+	if v != nil {
+		_u.SetGraceUntil(*v)
+	}
+	return _u
+}
+
+// ClearGraceUntil clears the value of the "grace_until" field.
+func (_u *SubscriptionUpdate) ClearGraceUntil() *SubscriptionUpdate {
+	_u.mutation.ClearGraceUntil()
+	return _u
+}
+
+// SetCancelAtPeriodEnd sets the "cancel_at_period_end" field.
+func (_u *SubscriptionUpdate) SetCancelAtPeriodEnd(v bool) *SubscriptionUpdate {
+	_u.mutation.SetCancelAtPeriodEnd(v)
+	return _u
+}
+
+// SetNillableCancelAtPeriodEnd sets the "cancel_at_period_end" field if the given value is not nil.
+func (_u *SubscriptionUpdate) SetNillableCancelAtPeriodEnd(v *bool) *SubscriptionUpdate {
+	if v != nil {
+		_u.SetCancelAtPeriodEnd(*v)
+	}
+	return _u
+}
+
+// SetPaidCount sets the "paid_count" field.
+func (_u *SubscriptionUpdate) SetPaidCount(v int) *SubscriptionUpdate {
+	_u.mutation.ResetPaidCount()
+	_u.mutation.SetPaidCount(v)
+	return _u
+}
+
+// SetNillablePaidCount sets the "paid_count" field if the given value is not nil.
+func (_u *SubscriptionUpdate) SetNillablePaidCount(v *int) *SubscriptionUpdate {
+	if v != nil {
+		_u.SetPaidCount(*v)
+	}
+	return _u
+}
+
+// AddPaidCount adds value to the "paid_count" field.
+func (_u *SubscriptionUpdate) AddPaidCount(v int) *SubscriptionUpdate {
+	_u.mutation.AddPaidCount(v)
+	return _u
+}
+
+// SetShortURL sets the "short_url" field.
+func (_u *SubscriptionUpdate) SetShortURL(v string) *SubscriptionUpdate {
+	_u.mutation.SetShortURL(v)
+	return _u
+}
+
+// SetNillableShortURL sets the "short_url" field if the given value is not nil.
+func (_u *SubscriptionUpdate) SetNillableShortURL(v *string) *SubscriptionUpdate {
+	if v != nil {
+		_u.SetShortURL(*v)
+	}
+	return _u
+}
+
+// ClearShortURL clears the value of the "short_url" field.
+func (_u *SubscriptionUpdate) ClearShortURL() *SubscriptionUpdate {
+	_u.mutation.ClearShortURL()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *SubscriptionUpdate) SetUpdatedAt(v time.Time) *SubscriptionUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetActivatedAt sets the "activated_at" field.
+func (_u *SubscriptionUpdate) SetActivatedAt(v time.Time) *SubscriptionUpdate {
+	_u.mutation.SetActivatedAt(v)
+	return _u
+}
+
+// SetNillableActivatedAt sets the "activated_at" field if the given value is not nil.
+func (_u *SubscriptionUpdate) SetNillableActivatedAt(v *time.Time) *SubscriptionUpdate {
+	if v != nil {
+		_u.SetActivatedAt(*v)
+	}
+	return _u
+}
+
+// ClearActivatedAt clears the value of the "activated_at" field.
+func (_u *SubscriptionUpdate) ClearActivatedAt() *SubscriptionUpdate {
+	_u.mutation.ClearActivatedAt()
+	return _u
+}
+
+// SetPausedAt sets the "paused_at" field.
+func (_u *SubscriptionUpdate) SetPausedAt(v time.Time) *SubscriptionUpdate {
+	_u.mutation.SetPausedAt(v)
+	return _u
+}
+
+// SetNillablePausedAt sets the "paused_at" field if the given value is not nil.
+func (_u *SubscriptionUpdate) SetNillablePausedAt(v *time.Time) *SubscriptionUpdate {
+	if v != nil {
+		_u.SetPausedAt(*v)
+	}
+	return _u
+}
+
+// ClearPausedAt clears the value of the "paused_at" field.
+func (_u *SubscriptionUpdate) ClearPausedAt() *SubscriptionUpdate {
+	_u.mutation.ClearPausedAt()
+	return _u
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (_u *SubscriptionUpdate) SetCancelledAt(v time.Time) *SubscriptionUpdate {
+	_u.mutation.SetCancelledAt(v)
+	return _u
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (_u *SubscriptionUpdate) SetNillableCancelledAt(v *time.Time) *SubscriptionUpdate {
+	if v != nil {
+		_u.SetCancelledAt(*v)
+	}
+	return _u
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (_u *SubscriptionUpdate) ClearCancelledAt() *SubscriptionUpdate {
+	_u.mutation.ClearCancelledAt()
+	return _u
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (_u *SubscriptionUpdate) SetEndedAt(v time.Time) *SubscriptionUpdate {
+	_u.mutation.SetEndedAt(v)
+	return _u
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (_u *SubscriptionUpdate) SetNillableEndedAt(v *time.Time) *SubscriptionUpdate {
+	if v != nil {
+		_u.SetEndedAt(*v)
+	}
+	return _u
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (_u *SubscriptionUpdate) ClearEndedAt() *SubscriptionUpdate {
+	_u.mutation.ClearEndedAt()
+	return _u
+}
+
+// Mutation returns the SubscriptionMutation object of the builder.
+func (_u *SubscriptionUpdate) Mutation() *SubscriptionMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *SubscriptionUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SubscriptionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *SubscriptionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SubscriptionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SubscriptionUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := subscription.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SubscriptionUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := subscription.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`generated: validator failed for field "Subscription.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ShortURL(); ok {
+		if err := subscription.ShortURLValidator(v); err != nil {
+			return &ValidationError{Name: "short_url", err: fmt.Errorf(`generated: validator failed for field "Subscription.short_url": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "Subscription.user"`)
+	}
+	if _u.mutation.PlanCleared() && len(_u.mutation.PlanIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "Subscription.plan"`)
+	}
+	return nil
+}
+
+func (_u *SubscriptionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(subscription.Table, subscription.Columns, sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(subscription.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.CurrentStart(); ok {
+		_spec.SetField(subscription.FieldCurrentStart, field.TypeTime, value)
+	}
+	if _u.mutation.CurrentStartCleared() {
+		_spec.ClearField(subscription.FieldCurrentStart, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CurrentEnd(); ok {
+		_spec.SetField(subscription.FieldCurrentEnd, field.TypeTime, value)
+	}
+	if _u.mutation.CurrentEndCleared() {
+		_spec.ClearField(subscription.FieldCurrentEnd, field.TypeTime)
+	}
+	if value, ok := _u.mutation.GraceUntil(); ok {
+		_spec.SetField(subscription.FieldGraceUntil, field.TypeTime, value)
+	}
+	if _u.mutation.GraceUntilCleared() {
+		_spec.ClearField(subscription.FieldGraceUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CancelAtPeriodEnd(); ok {
+		_spec.SetField(subscription.FieldCancelAtPeriodEnd, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.PaidCount(); ok {
+		_spec.SetField(subscription.FieldPaidCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPaidCount(); ok {
+		_spec.AddField(subscription.FieldPaidCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ShortURL(); ok {
+		_spec.SetField(subscription.FieldShortURL, field.TypeString, value)
+	}
+	if _u.mutation.ShortURLCleared() {
+		_spec.ClearField(subscription.FieldShortURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(subscription.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ActivatedAt(); ok {
+		_spec.SetField(subscription.FieldActivatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ActivatedAtCleared() {
+		_spec.ClearField(subscription.FieldActivatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.PausedAt(); ok {
+		_spec.SetField(subscription.FieldPausedAt, field.TypeTime, value)
+	}
+	if _u.mutation.PausedAtCleared() {
+		_spec.ClearField(subscription.FieldPausedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CancelledAt(); ok {
+		_spec.SetField(subscription.FieldCancelledAt, field.TypeTime, value)
+	}
+	if _u.mutation.CancelledAtCleared() {
+		_spec.ClearField(subscription.FieldCancelledAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.EndedAt(); ok {
+		_spec.SetField(subscription.FieldEndedAt, field.TypeTime, value)
+	}
+	if _u.mutation.EndedAtCleared() {
+		_spec.ClearField(subscription.FieldEndedAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{subscription.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SubscriptionUpdateOne is the builder for updating a single Subscription entity.
+type SubscriptionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SubscriptionMutation
+}
+
+// SetStatus sets the "status" field.
+func (_u *SubscriptionUpdateOne) SetStatus(v subscription.Status) *SubscriptionUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *SubscriptionUpdateOne) SetNillableStatus(v *subscription.Status) *SubscriptionUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetCurrentStart sets the "current_start" field.
+func (_u *SubscriptionUpdateOne) SetCurrentStart(v time.Time) *SubscriptionUpdateOne {
+	_u.mutation.SetCurrentStart(v)
+	return _u
+}
+
+// SetNillableCurrentStart sets the "current_start" field if the given value is not nil.
+func (_u *SubscriptionUpdateOne) SetNillableCurrentStart(v *time.Time) *SubscriptionUpdateOne {
+	if v != nil {
+		_u.SetCurrentStart(*v)
+	}
+	return _u
+}
+
+// ClearCurrentStart clears the value of the "current_start" field.
+func (_u *SubscriptionUpdateOne) ClearCurrentStart() *SubscriptionUpdateOne {
+	_u.mutation.ClearCurrentStart()
+	return _u
+}
+
+// SetCurrentEnd sets the "current_end" field.
+func (_u *SubscriptionUpdateOne) SetCurrentEnd(v time.Time) *SubscriptionUpdateOne {
+	_u.mutation.SetCurrentEnd(v)
+	return _u
+}
+
+// SetNillableCurrentEnd sets the "current_end" field if the given value is not nil.
+func (_u *SubscriptionUpdateOne) SetNillableCurrentEnd(v *time.Time) *SubscriptionUpdateOne {
+	if v != nil {
+		_u.SetCurrentEnd(*v)
+	}
+	return _u
+}
+
+// ClearCurrentEnd clears the value of the "current_end" field.
+func (_u *SubscriptionUpdateOne) ClearCurrentEnd() *SubscriptionUpdateOne {
+	_u.mutation.ClearCurrentEnd()
+	return _u
+}
+
+// SetGraceUntil sets the "grace_until" field.
+func (_u *SubscriptionUpdateOne) SetGraceUntil(v time.Time) *SubscriptionUpdateOne {
+	_u.mutation.SetGraceUntil(v)
+	return _u
+}
+
+// SetNillableGraceUntil sets the "grace_until" field if the given value is not nil.
+func (_u *SubscriptionUpdateOne) SetNillableGraceUntil(v *time.Time) *SubscriptionUpdateOne {
+	if v != nil {
+		_u.SetGraceUntil(*v)
+	}
+	return _u
+}
+
+// ClearGraceUntil clears the value of the "grace_until" field.
+func (_u *SubscriptionUpdateOne) ClearGraceUntil() *SubscriptionUpdateOne {
+	_u.mutation.ClearGraceUntil()
+	return _u
+}
+
+// SetCancelAtPeriodEnd sets the "cancel_at_period_end" field.
+func (_u *SubscriptionUpdateOne) SetCancelAtPeriodEnd(v bool) *SubscriptionUpdateOne {
+	_u.mutation.SetCancelAtPeriodEnd(v)
+	return _u
+}
+
+// SetNillableCancelAtPeriodEnd sets the "cancel_at_period_end" field if the given value is not nil.
+func (_u *SubscriptionUpdateOne) SetNillableCancelAtPeriodEnd(v *bool) *SubscriptionUpdateOne {
+	if v != nil {
+		_u.SetCancelAtPeriodEnd(*v)
+	}
+	return _u
+}
+
+// SetPaidCount sets the "paid_count" field.
+func (_u *SubscriptionUpdateOne) SetPaidCount(v int) *SubscriptionUpdateOne {
+	_u.mutation.ResetPaidCount()
+	_u.mutation.SetPaidCount(v)
+	return _u
+}
+
+// SetNillablePaidCount sets the "paid_count" field if the given value is not nil.
+func (_u *SubscriptionUpdateOne) SetNillablePaidCount(v *int) *SubscriptionUpdateOne {
+	if v != nil {
+		_u.SetPaidCount(*v)
+	}
+	return _u
+}
+
+// AddPaidCount adds value to the "paid_count" field.
+func (_u *SubscriptionUpdateOne) AddPaidCount(v int) *SubscriptionUpdateOne {
+	_u.mutation.AddPaidCount(v)
+	return _u
+}
+
+// SetShortURL sets the "short_url" field.
+func (_u *SubscriptionUpdateOne) SetShortURL(v string) *SubscriptionUpdateOne {
+	_u.mutation.SetShortURL(v)
+	return _u
+}
+
+// SetNillableShortURL sets the "short_url" field if the given value is not nil.
+func (_u *SubscriptionUpdateOne) SetNillableShortURL(v *string) *SubscriptionUpdateOne {
+	if v != nil {
+		_u.SetShortURL(*v)
+	}
+	return _u
+}
+
+// ClearShortURL clears the value of the "short_url" field.
+func (_u *SubscriptionUpdateOne) ClearShortURL() *SubscriptionUpdateOne {
+	_u.mutation.ClearShortURL()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *SubscriptionUpdateOne) SetUpdatedAt(v time.Time) *SubscriptionUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetActivatedAt sets the "activated_at" field.
+func (_u *SubscriptionUpdateOne) SetActivatedAt(v time.Time) *SubscriptionUpdateOne {
+	_u.mutation.SetActivatedAt(v)
+	return _u
+}
+
+// SetNillableActivatedAt sets the "activated_at" field if the given value is not nil.
+func (_u *SubscriptionUpdateOne) SetNillableActivatedAt(v *time.Time) *SubscriptionUpdateOne {
+	if v != nil {
+		_u.SetActivatedAt(*v)
+	}
+	return _u
+}
+
+// ClearActivatedAt clears the value of the "activated_at" field.
+func (_u *SubscriptionUpdateOne) ClearActivatedAt() *SubscriptionUpdateOne {
+	_u.mutation.ClearActivatedAt()
+	return _u
+}
+
+// SetPausedAt sets the "paused_at" field.
+func (_u *SubscriptionUpdateOne) SetPausedAt(v time.Time) *SubscriptionUpdateOne {
+	_u.mutation.SetPausedAt(v)
+	return _u
+}
+
+// SetNillablePausedAt sets the "paused_at" field if the given value is not nil.
+func (_u *SubscriptionUpdateOne) SetNillablePausedAt(v *time.Time) *SubscriptionUpdateOne {
+	if v != nil {
+		_u.SetPausedAt(*v)
+	}
+	return _u
+}
+
+// ClearPausedAt clears the value of the "paused_at" field.
+func (_u *SubscriptionUpdateOne) ClearPausedAt() *SubscriptionUpdateOne {
+	_u.mutation.ClearPausedAt()
+	return _u
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (_u *SubscriptionUpdateOne) SetCancelledAt(v time.Time) *SubscriptionUpdateOne {
+	_u.mutation.SetCancelledAt(v)
+	return _u
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (_u *SubscriptionUpdateOne) SetNillableCancelledAt(v *time.Time) *SubscriptionUpdateOne {
+	if v != nil {
+		_u.SetCancelledAt(*v)
+	}
+	return _u
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (_u *SubscriptionUpdateOne) ClearCancelledAt() *SubscriptionUpdateOne {
+	_u.mutation.ClearCancelledAt()
+	return _u
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (_u *SubscriptionUpdateOne) SetEndedAt(v time.Time) *SubscriptionUpdateOne {
+	_u.mutation.SetEndedAt(v)
+	return _u
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (_u *SubscriptionUpdateOne) SetNillableEndedAt(v *time.Time) *SubscriptionUpdateOne {
+	if v != nil {
+		_u.SetEndedAt(*v)
+	}
+	return _u
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (_u *SubscriptionUpdateOne) ClearEndedAt() *SubscriptionUpdateOne {
+	_u.mutation.ClearEndedAt()
+	return _u
+}
+
+// Mutation returns the SubscriptionMutation object of the builder.
+func (_u *SubscriptionUpdateOne) Mutation() *SubscriptionMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the SubscriptionUpdate builder.
+func (_u *SubscriptionUpdateOne) Where(ps ...predicate.Subscription) *SubscriptionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *SubscriptionUpdateOne) Select(field string, fields ...string) *SubscriptionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Subscription entity.
+func (_u *SubscriptionUpdateOne) Save(ctx context.Context) (*Subscription, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SubscriptionUpdateOne) SaveX(ctx context.Context) *Subscription {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *SubscriptionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SubscriptionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SubscriptionUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := subscription.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SubscriptionUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := subscription.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`generated: validator failed for field "Subscription.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ShortURL(); ok {
+		if err := subscription.ShortURLValidator(v); err != nil {
+			return &ValidationError{Name: "short_url", err: fmt.Errorf(`generated: validator failed for field "Subscription.short_url": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "Subscription.user"`)
+	}
+	if _u.mutation.PlanCleared() && len(_u.mutation.PlanIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "Subscription.plan"`)
+	}
+	return nil
+}
+
+func (_u *SubscriptionUpdateOne) sqlSave(ctx context.Context) (_node *Subscription, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(subscription.Table, subscription.Columns, sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "Subscription.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, subscription.FieldID)
+		for _, f := range fields {
+			if !subscription.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != subscription.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(subscription.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.CurrentStart(); ok {
+		_spec.SetField(subscription.FieldCurrentStart, field.TypeTime, value)
+	}
+	if _u.mutation.CurrentStartCleared() {
+		_spec.ClearField(subscription.FieldCurrentStart, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CurrentEnd(); ok {
+		_spec.SetField(subscription.FieldCurrentEnd, field.TypeTime, value)
+	}
+	if _u.mutation.CurrentEndCleared() {
+		_spec.ClearField(subscription.FieldCurrentEnd, field.TypeTime)
+	}
+	if value, ok := _u.mutation.GraceUntil(); ok {
+		_spec.SetField(subscription.FieldGraceUntil, field.TypeTime, value)
+	}
+	if _u.mutation.GraceUntilCleared() {
+		_spec.ClearField(subscription.FieldGraceUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CancelAtPeriodEnd(); ok {
+		_spec.SetField(subscription.FieldCancelAtPeriodEnd, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.PaidCount(); ok {
+		_spec.SetField(subscription.FieldPaidCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPaidCount(); ok {
+		_spec.AddField(subscription.FieldPaidCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ShortURL(); ok {
+		_spec.SetField(subscription.FieldShortURL, field.TypeString, value)
+	}
+	if _u.mutation.ShortURLCleared() {
+		_spec.ClearField(subscription.FieldShortURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(subscription.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ActivatedAt(); ok {
+		_spec.SetField(subscription.FieldActivatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ActivatedAtCleared() {
+		_spec.ClearField(subscription.FieldActivatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.PausedAt(); ok {
+		_spec.SetField(subscription.FieldPausedAt, field.TypeTime, value)
+	}
+	if _u.mutation.PausedAtCleared() {
+		_spec.ClearField(subscription.FieldPausedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CancelledAt(); ok {
+		_spec.SetField(subscription.FieldCancelledAt, field.TypeTime, value)
+	}
+	if _u.mutation.CancelledAtCleared() {
+		_spec.ClearField(subscription.FieldCancelledAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.EndedAt(); ok {
+		_spec.SetField(subscription.FieldEndedAt, field.TypeTime, value)
+	}
+	if _u.mutation.EndedAtCleared() {
+		_spec.ClearField(subscription.FieldEndedAt, field.TypeTime)
+	}
+	_node = &Subscription{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{subscription.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
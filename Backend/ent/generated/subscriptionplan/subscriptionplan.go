@@ -0,0 +1,224 @@
+// Code generated by ent, DO NOT EDIT.
+
+package subscriptionplan
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the subscriptionplan type in the database.
+	Label = "subscription_plan"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldTier holds the string denoting the tier field in the database.
+	FieldTier = "tier"
+	// FieldBillingPeriod holds the string denoting the billing_period field in the database.
+	FieldBillingPeriod = "billing_period"
+	// FieldPriceAmount holds the string denoting the price_amount field in the database.
+	FieldPriceAmount = "price_amount"
+	// FieldCurrency holds the string denoting the currency field in the database.
+	FieldCurrency = "currency"
+	// FieldRazorpayPlanID holds the string denoting the razorpay_plan_id field in the database.
+	FieldRazorpayPlanID = "razorpay_plan_id"
+	// FieldIsActive holds the string denoting the is_active field in the database.
+	FieldIsActive = "is_active"
+	// FieldSortOrder holds the string denoting the sort_order field in the database.
+	FieldSortOrder = "sort_order"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeSubscriptions holds the string denoting the subscriptions edge name in mutations.
+	EdgeSubscriptions = "subscriptions"
+	// Table holds the table name of the subscriptionplan in the database.
+	Table = "subscription_plans"
+	// SubscriptionsTable is the table that holds the subscriptions relation/edge.
+	SubscriptionsTable = "subscriptions"
+	// SubscriptionsInverseTable is the table name for the Subscription entity.
+	// It exists in this package in order to avoid circular dependency with the "subscription" package.
+	SubscriptionsInverseTable = "subscriptions"
+	// SubscriptionsColumn is the table column denoting the subscriptions relation/edge.
+	SubscriptionsColumn = "plan_id"
+)
+
+// Columns holds all SQL columns for subscriptionplan fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldTier,
+	FieldBillingPeriod,
+	FieldPriceAmount,
+	FieldCurrency,
+	FieldRazorpayPlanID,
+	FieldIsActive,
+	FieldSortOrder,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// PriceAmountValidator is a validator for the "price_amount" field. It is called by the builders before save.
+	PriceAmountValidator func(int) error
+	// DefaultCurrency holds the default value on creation for the "currency" field.
+	DefaultCurrency string
+	// CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	CurrencyValidator func(string) error
+	// RazorpayPlanIDValidator is a validator for the "razorpay_plan_id" field. It is called by the builders before save.
+	RazorpayPlanIDValidator func(string) error
+	// DefaultIsActive holds the default value on creation for the "is_active" field.
+	DefaultIsActive bool
+	// DefaultSortOrder holds the default value on creation for the "sort_order" field.
+	DefaultSortOrder int
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
+
+// Tier defines the type for the "tier" enum field.
+type Tier string
+
+// Tier values.
+const (
+	TierPlus Tier = "plus"
+	TierPro  Tier = "pro"
+)
+
+func (t Tier) String() string {
+	return string(t)
+}
+
+// TierValidator is a validator for the "tier" field enum values. It is called by the builders before save.
+func TierValidator(t Tier) error {
+	switch t {
+	case TierPlus, TierPro:
+		return nil
+	default:
+		return fmt.Errorf("subscriptionplan: invalid enum value for tier field: %q", t)
+	}
+}
+
+// BillingPeriod defines the type for the "billing_period" enum field.
+type BillingPeriod string
+
+// BillingPeriod values.
+const (
+	BillingPeriodMonthly BillingPeriod = "monthly"
+	BillingPeriodYearly  BillingPeriod = "yearly"
+)
+
+func (bp BillingPeriod) String() string {
+	return string(bp)
+}
+
+// BillingPeriodValidator is a validator for the "billing_period" field enum values. It is called by the builders before save.
+func BillingPeriodValidator(bp BillingPeriod) error {
+	switch bp {
+	case BillingPeriodMonthly, BillingPeriodYearly:
+		return nil
+	default:
+		return fmt.Errorf("subscriptionplan: invalid enum value for billing_period field: %q", bp)
+	}
+}
+
+// OrderOption defines the ordering options for the SubscriptionPlan queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByTier orders the results by the tier field.
+func ByTier(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTier, opts...).ToFunc()
+}
+
+// ByBillingPeriod orders the results by the billing_period field.
+func ByBillingPeriod(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBillingPeriod, opts...).ToFunc()
+}
+
+// ByPriceAmount orders the results by the price_amount field.
+func ByPriceAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPriceAmount, opts...).ToFunc()
+}
+
+// ByCurrency orders the results by the currency field.
+func ByCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrency, opts...).ToFunc()
+}
+
+// ByRazorpayPlanID orders the results by the razorpay_plan_id field.
+func ByRazorpayPlanID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRazorpayPlanID, opts...).ToFunc()
+}
+
+// ByIsActive orders the results by the is_active field.
+func ByIsActive(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsActive, opts...).ToFunc()
+}
+
+// BySortOrder orders the results by the sort_order field.
+func BySortOrder(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSortOrder, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// BySubscriptionsCount orders the results by subscriptions count.
+func BySubscriptionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSubscriptionsStep(), opts...)
+	}
+}
+
+// BySubscriptions orders the results by subscriptions terms.
+func BySubscriptions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSubscriptionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newSubscriptionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SubscriptionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SubscriptionsTable, SubscriptionsColumn),
+	)
+}
@@ -0,0 +1,1131 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/UnoraApp/be/ent/generated/subscription"
+	"github.com/UnoraApp/be/ent/generated/subscriptionplan"
+)
+
+// SubscriptionPlanCreate is the builder for creating a SubscriptionPlan entity.
+type SubscriptionPlanCreate struct {
+	config
+	mutation *SubscriptionPlanMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetName sets the "name" field.
+func (_c *SubscriptionPlanCreate) SetName(v string) *SubscriptionPlanCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetTier sets the "tier" field.
+func (_c *SubscriptionPlanCreate) SetTier(v subscriptionplan.Tier) *SubscriptionPlanCreate {
+	_c.mutation.SetTier(v)
+	return _c
+}
+
+// SetBillingPeriod sets the "billing_period" field.
+func (_c *SubscriptionPlanCreate) SetBillingPeriod(v subscriptionplan.BillingPeriod) *SubscriptionPlanCreate {
+	_c.mutation.SetBillingPeriod(v)
+	return _c
+}
+
+// SetPriceAmount sets the "price_amount" field.
+func (_c *SubscriptionPlanCreate) SetPriceAmount(v int) *SubscriptionPlanCreate {
+	_c.mutation.SetPriceAmount(v)
+	return _c
+}
+
+// SetCurrency sets the "currency" field.
+func (_c *SubscriptionPlanCreate) SetCurrency(v string) *SubscriptionPlanCreate {
+	_c.mutation.SetCurrency(v)
+	return _c
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_c *SubscriptionPlanCreate) SetNillableCurrency(v *string) *SubscriptionPlanCreate {
+	if v != nil {
+		_c.SetCurrency(*v)
+	}
+	return _c
+}
+
+// SetRazorpayPlanID sets the "razorpay_plan_id" field.
+func (_c *SubscriptionPlanCreate) SetRazorpayPlanID(v string) *SubscriptionPlanCreate {
+	_c.mutation.SetRazorpayPlanID(v)
+	return _c
+}
+
+// SetNillableRazorpayPlanID sets the "razorpay_plan_id" field if the given value is not nil.
+func (_c *SubscriptionPlanCreate) SetNillableRazorpayPlanID(v *string) *SubscriptionPlanCreate {
+	if v != nil {
+		_c.SetRazorpayPlanID(*v)
+	}
+	return _c
+}
+
+// SetIsActive sets the "is_active" field.
+func (_c *SubscriptionPlanCreate) SetIsActive(v bool) *SubscriptionPlanCreate {
+	_c.mutation.SetIsActive(v)
+	return _c
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (_c *SubscriptionPlanCreate) SetNillableIsActive(v *bool) *SubscriptionPlanCreate {
+	if v != nil {
+		_c.SetIsActive(*v)
+	}
+	return _c
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (_c *SubscriptionPlanCreate) SetSortOrder(v int) *SubscriptionPlanCreate {
+	_c.mutation.SetSortOrder(v)
+	return _c
+}
+
+// SetNillableSortOrder sets the "sort_order" field if the given value is not nil.
+func (_c *SubscriptionPlanCreate) SetNillableSortOrder(v *int) *SubscriptionPlanCreate {
+	if v != nil {
+		_c.SetSortOrder(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *SubscriptionPlanCreate) SetCreatedAt(v time.Time) *SubscriptionPlanCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *SubscriptionPlanCreate) SetNillableCreatedAt(v *time.Time) *SubscriptionPlanCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *SubscriptionPlanCreate) SetUpdatedAt(v time.Time) *SubscriptionPlanCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *SubscriptionPlanCreate) SetNillableUpdatedAt(v *time.Time) *SubscriptionPlanCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *SubscriptionPlanCreate) SetID(v string) *SubscriptionPlanCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// AddSubscriptionIDs adds the "subscriptions" edge to the Subscription entity by IDs.
+func (_c *SubscriptionPlanCreate) AddSubscriptionIDs(ids ...string) *SubscriptionPlanCreate {
+	_c.mutation.AddSubscriptionIDs(ids...)
+	return _c
+}
+
+// AddSubscriptions adds the "subscriptions" edges to the Subscription entity.
+func (_c *SubscriptionPlanCreate) AddSubscriptions(v ...*Subscription) *SubscriptionPlanCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddSubscriptionIDs(ids...)
+}
+
+// Mutation returns the SubscriptionPlanMutation object of the builder.
+func (_c *SubscriptionPlanCreate) Mutation() *SubscriptionPlanMutation {
+	return _c.mutation
+}
+
+// Save creates the SubscriptionPlan in the database.
+func (_c *SubscriptionPlanCreate) Save(ctx context.Context) (*SubscriptionPlan, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *SubscriptionPlanCreate) SaveX(ctx context.Context) *SubscriptionPlan {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SubscriptionPlanCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SubscriptionPlanCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *SubscriptionPlanCreate) defaults() {
+	if _, ok := _c.mutation.Currency(); !ok {
+		v := subscriptionplan.DefaultCurrency
+		_c.mutation.SetCurrency(v)
+	}
+	if _, ok := _c.mutation.IsActive(); !ok {
+		v := subscriptionplan.DefaultIsActive
+		_c.mutation.SetIsActive(v)
+	}
+	if _, ok := _c.mutation.SortOrder(); !ok {
+		v := subscriptionplan.DefaultSortOrder
+		_c.mutation.SetSortOrder(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := subscriptionplan.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := subscriptionplan.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *SubscriptionPlanCreate) check() error {
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`generated: missing required field "SubscriptionPlan.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := subscriptionplan.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`generated: validator failed for field "SubscriptionPlan.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Tier(); !ok {
+		return &ValidationError{Name: "tier", err: errors.New(`generated: missing required field "SubscriptionPlan.tier"`)}
+	}
+	if v, ok := _c.mutation.Tier(); ok {
+		if err := subscriptionplan.TierValidator(v); err != nil {
+			return &ValidationError{Name: "tier", err: fmt.Errorf(`generated: validator failed for field "SubscriptionPlan.tier": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.BillingPeriod(); !ok {
+		return &ValidationError{Name: "billing_period", err: errors.New(`generated: missing required field "SubscriptionPlan.billing_period"`)}
+	}
+	if v, ok := _c.mutation.BillingPeriod(); ok {
+		if err := subscriptionplan.BillingPeriodValidator(v); err != nil {
+			return &ValidationError{Name: "billing_period", err: fmt.Errorf(`generated: validator failed for field "SubscriptionPlan.billing_period": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PriceAmount(); !ok {
+		return &ValidationError{Name: "price_amount", err: errors.New(`generated: missing required field "SubscriptionPlan.price_amount"`)}
+	}
+	if v, ok := _c.mutation.PriceAmount(); ok {
+		if err := subscriptionplan.PriceAmountValidator(v); err != nil {
+			return &ValidationError{Name: "price_amount", err: fmt.Errorf(`generated: validator failed for field "SubscriptionPlan.price_amount": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`generated: missing required field "SubscriptionPlan.currency"`)}
+	}
+	if v, ok := _c.mutation.Currency(); ok {
+		if err := subscriptionplan.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`generated: validator failed for field "SubscriptionPlan.currency": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.RazorpayPlanID(); ok {
+		if err := subscriptionplan.RazorpayPlanIDValidator(v); err != nil {
+			return &ValidationError{Name: "razorpay_plan_id", err: fmt.Errorf(`generated: validator failed for field "SubscriptionPlan.razorpay_plan_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.IsActive(); !ok {
+		return &ValidationError{Name: "is_active", err: errors.New(`generated: missing required field "SubscriptionPlan.is_active"`)}
+	}
+	if _, ok := _c.mutation.SortOrder(); !ok {
+		return &ValidationError{Name: "sort_order", err: errors.New(`generated: missing required field "SubscriptionPlan.sort_order"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "SubscriptionPlan.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`generated: missing required field "SubscriptionPlan.updated_at"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := subscriptionplan.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`generated: validator failed for field "SubscriptionPlan.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *SubscriptionPlanCreate) sqlSave(ctx context.Context) (*SubscriptionPlan, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected SubscriptionPlan.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *SubscriptionPlanCreate) createSpec() (*SubscriptionPlan, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SubscriptionPlan{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(subscriptionplan.Table, sqlgraph.NewFieldSpec(subscriptionplan.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(subscriptionplan.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Tier(); ok {
+		_spec.SetField(subscriptionplan.FieldTier, field.TypeEnum, value)
+		_node.Tier = value
+	}
+	if value, ok := _c.mutation.BillingPeriod(); ok {
+		_spec.SetField(subscriptionplan.FieldBillingPeriod, field.TypeEnum, value)
+		_node.BillingPeriod = value
+	}
+	if value, ok := _c.mutation.PriceAmount(); ok {
+		_spec.SetField(subscriptionplan.FieldPriceAmount, field.TypeInt, value)
+		_node.PriceAmount = value
+	}
+	if value, ok := _c.mutation.Currency(); ok {
+		_spec.SetField(subscriptionplan.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := _c.mutation.RazorpayPlanID(); ok {
+		_spec.SetField(subscriptionplan.FieldRazorpayPlanID, field.TypeString, value)
+		_node.RazorpayPlanID = &value
+	}
+	if value, ok := _c.mutation.IsActive(); ok {
+		_spec.SetField(subscriptionplan.FieldIsActive, field.TypeBool, value)
+		_node.IsActive = value
+	}
+	if value, ok := _c.mutation.SortOrder(); ok {
+		_spec.SetField(subscriptionplan.FieldSortOrder, field.TypeInt, value)
+		_node.SortOrder = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(subscriptionplan.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(subscriptionplan.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.SubscriptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   subscriptionplan.SubscriptionsTable,
+			Columns: []string{subscriptionplan.SubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SubscriptionPlan.Create().
+//		SetName(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SubscriptionPlanUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *SubscriptionPlanCreate) OnConflict(opts ...sql.ConflictOption) *SubscriptionPlanUpsertOne {
+	_c.conflict = opts
+	return &SubscriptionPlanUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SubscriptionPlan.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *SubscriptionPlanCreate) OnConflictColumns(columns ...string) *SubscriptionPlanUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &SubscriptionPlanUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// SubscriptionPlanUpsertOne is the builder for "upsert"-ing
+	//  one SubscriptionPlan node.
+	SubscriptionPlanUpsertOne struct {
+		create *SubscriptionPlanCreate
+	}
+
+	// SubscriptionPlanUpsert is the "OnConflict" setter.
+	SubscriptionPlanUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetName sets the "name" field.
+func (u *SubscriptionPlanUpsert) SetName(v string) *SubscriptionPlanUpsert {
+	u.Set(subscriptionplan.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *SubscriptionPlanUpsert) UpdateName() *SubscriptionPlanUpsert {
+	u.SetExcluded(subscriptionplan.FieldName)
+	return u
+}
+
+// SetTier sets the "tier" field.
+func (u *SubscriptionPlanUpsert) SetTier(v subscriptionplan.Tier) *SubscriptionPlanUpsert {
+	u.Set(subscriptionplan.FieldTier, v)
+	return u
+}
+
+// UpdateTier sets the "tier" field to the value that was provided on create.
+func (u *SubscriptionPlanUpsert) UpdateTier() *SubscriptionPlanUpsert {
+	u.SetExcluded(subscriptionplan.FieldTier)
+	return u
+}
+
+// SetBillingPeriod sets the "billing_period" field.
+func (u *SubscriptionPlanUpsert) SetBillingPeriod(v subscriptionplan.BillingPeriod) *SubscriptionPlanUpsert {
+	u.Set(subscriptionplan.FieldBillingPeriod, v)
+	return u
+}
+
+// UpdateBillingPeriod sets the "billing_period" field to the value that was provided on create.
+func (u *SubscriptionPlanUpsert) UpdateBillingPeriod() *SubscriptionPlanUpsert {
+	u.SetExcluded(subscriptionplan.FieldBillingPeriod)
+	return u
+}
+
+// SetPriceAmount sets the "price_amount" field.
+func (u *SubscriptionPlanUpsert) SetPriceAmount(v int) *SubscriptionPlanUpsert {
+	u.Set(subscriptionplan.FieldPriceAmount, v)
+	return u
+}
+
+// UpdatePriceAmount sets the "price_amount" field to the value that was provided on create.
+func (u *SubscriptionPlanUpsert) UpdatePriceAmount() *SubscriptionPlanUpsert {
+	u.SetExcluded(subscriptionplan.FieldPriceAmount)
+	return u
+}
+
+// AddPriceAmount adds v to the "price_amount" field.
+func (u *SubscriptionPlanUpsert) AddPriceAmount(v int) *SubscriptionPlanUpsert {
+	u.Add(subscriptionplan.FieldPriceAmount, v)
+	return u
+}
+
+// SetCurrency sets the "currency" field.
+func (u *SubscriptionPlanUpsert) SetCurrency(v string) *SubscriptionPlanUpsert {
+	u.Set(subscriptionplan.FieldCurrency, v)
+	return u
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *SubscriptionPlanUpsert) UpdateCurrency() *SubscriptionPlanUpsert {
+	u.SetExcluded(subscriptionplan.FieldCurrency)
+	return u
+}
+
+// SetRazorpayPlanID sets the "razorpay_plan_id" field.
+func (u *SubscriptionPlanUpsert) SetRazorpayPlanID(v string) *SubscriptionPlanUpsert {
+	u.Set(subscriptionplan.FieldRazorpayPlanID, v)
+	return u
+}
+
+// UpdateRazorpayPlanID sets the "razorpay_plan_id" field to the value that was provided on create.
+func (u *SubscriptionPlanUpsert) UpdateRazorpayPlanID() *SubscriptionPlanUpsert {
+	u.SetExcluded(subscriptionplan.FieldRazorpayPlanID)
+	return u
+}
+
+// ClearRazorpayPlanID clears the value of the "razorpay_plan_id" field.
+func (u *SubscriptionPlanUpsert) ClearRazorpayPlanID() *SubscriptionPlanUpsert {
+	u.SetNull(subscriptionplan.FieldRazorpayPlanID)
+	return u
+}
+
+// SetIsActive sets the "is_active" field.
+func (u *SubscriptionPlanUpsert) SetIsActive(v bool) *SubscriptionPlanUpsert {
+	u.Set(subscriptionplan.FieldIsActive, v)
+	return u
+}
+
+// UpdateIsActive sets the "is_active" field to the value that was provided on create.
+func (u *SubscriptionPlanUpsert) UpdateIsActive() *SubscriptionPlanUpsert {
+	u.SetExcluded(subscriptionplan.FieldIsActive)
+	return u
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (u *SubscriptionPlanUpsert) SetSortOrder(v int) *SubscriptionPlanUpsert {
+	u.Set(subscriptionplan.FieldSortOrder, v)
+	return u
+}
+
+// UpdateSortOrder sets the "sort_order" field to the value that was provided on create.
+func (u *SubscriptionPlanUpsert) UpdateSortOrder() *SubscriptionPlanUpsert {
+	u.SetExcluded(subscriptionplan.FieldSortOrder)
+	return u
+}
+
+// AddSortOrder adds v to the "sort_order" field.
+func (u *SubscriptionPlanUpsert) AddSortOrder(v int) *SubscriptionPlanUpsert {
+	u.Add(subscriptionplan.FieldSortOrder, v)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SubscriptionPlanUpsert) SetUpdatedAt(v time.Time) *SubscriptionPlanUpsert {
+	u.Set(subscriptionplan.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SubscriptionPlanUpsert) UpdateUpdatedAt() *SubscriptionPlanUpsert {
+	u.SetExcluded(subscriptionplan.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.SubscriptionPlan.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(subscriptionplan.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *SubscriptionPlanUpsertOne) UpdateNewValues() *SubscriptionPlanUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(subscriptionplan.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(subscriptionplan.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SubscriptionPlan.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *SubscriptionPlanUpsertOne) Ignore() *SubscriptionPlanUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SubscriptionPlanUpsertOne) DoNothing() *SubscriptionPlanUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SubscriptionPlanCreate.OnConflict
+// documentation for more info.
+func (u *SubscriptionPlanUpsertOne) Update(set func(*SubscriptionPlanUpsert)) *SubscriptionPlanUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SubscriptionPlanUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *SubscriptionPlanUpsertOne) SetName(v string) *SubscriptionPlanUpsertOne {
+	return u.Update(func(s *SubscriptionPlanUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *SubscriptionPlanUpsertOne) UpdateName() *SubscriptionPlanUpsertOne {
+	return u.Update(func(s *SubscriptionPlanUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetTier sets the "tier" field.
+func (u *SubscriptionPlanUpsertOne) SetTier(v subscriptionplan.Tier) *SubscriptionPlanUpsertOne {
+	return u.Update(func(s *SubscriptionPlanUpsert) {
+		s.SetTier(v)
+	})
+}
+
+// UpdateTier sets the "tier" field to the value that was provided on create.
+func (u *SubscriptionPlanUpsertOne) UpdateTier() *SubscriptionPlanUpsertOne {
+	return u.Update(func(s *SubscriptionPlanUpsert) {
+		s.UpdateTier()
+	})
+}
+
+// SetBillingPeriod sets the "billing_period" field.
+func (u *SubscriptionPlanUpsertOne) SetBillingPeriod(v subscriptionplan.BillingPeriod) *SubscriptionPlanUpsertOne {
+	return u.Update(func(s *SubscriptionPlanUpsert) {
+		s.SetBillingPeriod(v)
+	})
+}
+
+// UpdateBillingPeriod sets the "billing_period" field to the value that was provided on create.
+func (u *SubscriptionPlanUpsertOne) UpdateBillingPeriod() *SubscriptionPlanUpsertOne {
+	return u.Update(func(s *SubscriptionPlanUpsert) {
+		s.UpdateBillingPeriod()
+	})
+}
+
+// SetPriceAmount sets the "price_amount" field.
+func (u *SubscriptionPlanUpsertOne) SetPriceAmount(v int) *SubscriptionPlanUpsertOne {
+	return u.Update(func(s *SubscriptionPlanUpsert) {
+		s.SetPriceAmount(v)
+	})
+}
+
+// AddPriceAmount adds v to the "price_amount" field.
+func (u *SubscriptionPlanUpsertOne) AddPriceAmount(v int) *SubscriptionPlanUpsertOne {
+	return u.Update(func(s *SubscriptionPlanUpsert) {
+		s.AddPriceAmount(v)
+	})
+}
+
+// UpdatePriceAmount sets the "price_amount" field to the value that was provided on create.
+func (u *SubscriptionPlanUpsertOne) UpdatePriceAmount() *SubscriptionPlanUpsertOne {
+	return u.Update(func(s *SubscriptionPlanUpsert) {
+		s.UpdatePriceAmount()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *SubscriptionPlanUpsertOne) SetCurrency(v string) *SubscriptionPlanUpsertOne {
+	return u.Update(func(s *SubscriptionPlanUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *SubscriptionPlanUpsertOne) UpdateCurrency() *SubscriptionPlanUpsertOne {
+	return u.Update(func(s *SubscriptionPlanUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetRazorpayPlanID sets the "razorpay_plan_id" field.
+func (u *SubscriptionPlanUpsertOne) SetRazorpayPlanID(v string) *SubscriptionPlanUpsertOne {
+	return u.Update(func(s *SubscriptionPlanUpsert) {
+		s.SetRazorpayPlanID(v)
+	})
+}
+
+// UpdateRazorpayPlanID sets the "razorpay_plan_id" field to the value that was provided on create.
+func (u *SubscriptionPlanUpsertOne) UpdateRazorpayPlanID() *SubscriptionPlanUpsertOne {
+	return u.Update(func(s *SubscriptionPlanUpsert) {
+		s.UpdateRazorpayPlanID()
+	})
+}
+
+// ClearRazorpayPlanID clears the value of the "razorpay_plan_id" field.
+func (u *SubscriptionPlanUpsertOne) ClearRazorpayPlanID() *SubscriptionPlanUpsertOne {
+	return u.Update(func(s *SubscriptionPlanUpsert) {
+		s.ClearRazorpayPlanID()
+	})
+}
+
+// SetIsActive sets the "is_active" field.
+func (u *SubscriptionPlanUpsertOne) SetIsActive(v bool) *SubscriptionPlanUpsertOne {
+	return u.Update(func(s *SubscriptionPlanUpsert) {
+		s.SetIsActive(v)
+	})
+}
+
+// UpdateIsActive sets the "is_active" field to the value that was provided on create.
+func (u *SubscriptionPlanUpsertOne) UpdateIsActive() *SubscriptionPlanUpsertOne {
+	return u.Update(func(s *SubscriptionPlanUpsert) {
+		s.UpdateIsActive()
+	})
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (u *SubscriptionPlanUpsertOne) SetSortOrder(v int) *SubscriptionPlanUpsertOne {
+	return u.Update(func(s *SubscriptionPlanUpsert) {
+		s.SetSortOrder(v)
+	})
+}
+
+// AddSortOrder adds v to the "sort_order" field.
+func (u *SubscriptionPlanUpsertOne) AddSortOrder(v int) *SubscriptionPlanUpsertOne {
+	return u.Update(func(s *SubscriptionPlanUpsert) {
+		s.AddSortOrder(v)
+	})
+}
+
+// UpdateSortOrder sets the "sort_order" field to the value that was provided on create.
+func (u *SubscriptionPlanUpsertOne) UpdateSortOrder() *SubscriptionPlanUpsertOne {
+	return u.Update(func(s *SubscriptionPlanUpsert) {
+		s.UpdateSortOrder()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SubscriptionPlanUpsertOne) SetUpdatedAt(v time.Time) *SubscriptionPlanUpsertOne {
+	return u.Update(func(s *SubscriptionPlanUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SubscriptionPlanUpsertOne) UpdateUpdatedAt() *SubscriptionPlanUpsertOne {
+	return u.Update(func(s *SubscriptionPlanUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *SubscriptionPlanUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("generated: missing options for SubscriptionPlanCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SubscriptionPlanUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *SubscriptionPlanUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("generated: SubscriptionPlanUpsertOne.ID is not supported by MySQL driver. Use SubscriptionPlanUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *SubscriptionPlanUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// SubscriptionPlanCreateBulk is the builder for creating many SubscriptionPlan entities in bulk.
+type SubscriptionPlanCreateBulk struct {
+	config
+	err      error
+	builders []*SubscriptionPlanCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the SubscriptionPlan entities in the database.
+func (_c *SubscriptionPlanCreateBulk) Save(ctx context.Context) ([]*SubscriptionPlan, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*SubscriptionPlan, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SubscriptionPlanMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *SubscriptionPlanCreateBulk) SaveX(ctx context.Context) []*SubscriptionPlan {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SubscriptionPlanCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SubscriptionPlanCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SubscriptionPlan.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SubscriptionPlanUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *SubscriptionPlanCreateBulk) OnConflict(opts ...sql.ConflictOption) *SubscriptionPlanUpsertBulk {
+	_c.conflict = opts
+	return &SubscriptionPlanUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SubscriptionPlan.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *SubscriptionPlanCreateBulk) OnConflictColumns(columns ...string) *SubscriptionPlanUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &SubscriptionPlanUpsertBulk{
+		create: _c,
+	}
+}
+
+// SubscriptionPlanUpsertBulk is the builder for "upsert"-ing
+// a bulk of SubscriptionPlan nodes.
+type SubscriptionPlanUpsertBulk struct {
+	create *SubscriptionPlanCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.SubscriptionPlan.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(subscriptionplan.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *SubscriptionPlanUpsertBulk) UpdateNewValues() *SubscriptionPlanUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(subscriptionplan.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(subscriptionplan.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SubscriptionPlan.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *SubscriptionPlanUpsertBulk) Ignore() *SubscriptionPlanUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SubscriptionPlanUpsertBulk) DoNothing() *SubscriptionPlanUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SubscriptionPlanCreateBulk.OnConflict
+// documentation for more info.
+func (u *SubscriptionPlanUpsertBulk) Update(set func(*SubscriptionPlanUpsert)) *SubscriptionPlanUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SubscriptionPlanUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *SubscriptionPlanUpsertBulk) SetName(v string) *SubscriptionPlanUpsertBulk {
+	return u.Update(func(s *SubscriptionPlanUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *SubscriptionPlanUpsertBulk) UpdateName() *SubscriptionPlanUpsertBulk {
+	return u.Update(func(s *SubscriptionPlanUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetTier sets the "tier" field.
+func (u *SubscriptionPlanUpsertBulk) SetTier(v subscriptionplan.Tier) *SubscriptionPlanUpsertBulk {
+	return u.Update(func(s *SubscriptionPlanUpsert) {
+		s.SetTier(v)
+	})
+}
+
+// UpdateTier sets the "tier" field to the value that was provided on create.
+func (u *SubscriptionPlanUpsertBulk) UpdateTier() *SubscriptionPlanUpsertBulk {
+	return u.Update(func(s *SubscriptionPlanUpsert) {
+		s.UpdateTier()
+	})
+}
+
+// SetBillingPeriod sets the "billing_period" field.
+func (u *SubscriptionPlanUpsertBulk) SetBillingPeriod(v subscriptionplan.BillingPeriod) *SubscriptionPlanUpsertBulk {
+	return u.Update(func(s *SubscriptionPlanUpsert) {
+		s.SetBillingPeriod(v)
+	})
+}
+
+// UpdateBillingPeriod sets the "billing_period" field to the value that was provided on create.
+func (u *SubscriptionPlanUpsertBulk) UpdateBillingPeriod() *SubscriptionPlanUpsertBulk {
+	return u.Update(func(s *SubscriptionPlanUpsert) {
+		s.UpdateBillingPeriod()
+	})
+}
+
+// SetPriceAmount sets the "price_amount" field.
+func (u *SubscriptionPlanUpsertBulk) SetPriceAmount(v int) *SubscriptionPlanUpsertBulk {
+	return u.Update(func(s *SubscriptionPlanUpsert) {
+		s.SetPriceAmount(v)
+	})
+}
+
+// AddPriceAmount adds v to the "price_amount" field.
+func (u *SubscriptionPlanUpsertBulk) AddPriceAmount(v int) *SubscriptionPlanUpsertBulk {
+	return u.Update(func(s *SubscriptionPlanUpsert) {
+		s.AddPriceAmount(v)
+	})
+}
+
+// UpdatePriceAmount sets the "price_amount" field to the value that was provided on create.
+func (u *SubscriptionPlanUpsertBulk) UpdatePriceAmount() *SubscriptionPlanUpsertBulk {
+	return u.Update(func(s *SubscriptionPlanUpsert) {
+		s.UpdatePriceAmount()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *SubscriptionPlanUpsertBulk) SetCurrency(v string) *SubscriptionPlanUpsertBulk {
+	return u.Update(func(s *SubscriptionPlanUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *SubscriptionPlanUpsertBulk) UpdateCurrency() *SubscriptionPlanUpsertBulk {
+	return u.Update(func(s *SubscriptionPlanUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetRazorpayPlanID sets the "razorpay_plan_id" field.
+func (u *SubscriptionPlanUpsertBulk) SetRazorpayPlanID(v string) *SubscriptionPlanUpsertBulk {
+	return u.Update(func(s *SubscriptionPlanUpsert) {
+		s.SetRazorpayPlanID(v)
+	})
+}
+
+// UpdateRazorpayPlanID sets the "razorpay_plan_id" field to the value that was provided on create.
+func (u *SubscriptionPlanUpsertBulk) UpdateRazorpayPlanID() *SubscriptionPlanUpsertBulk {
+	return u.Update(func(s *SubscriptionPlanUpsert) {
+		s.UpdateRazorpayPlanID()
+	})
+}
+
+// ClearRazorpayPlanID clears the value of the "razorpay_plan_id" field.
+func (u *SubscriptionPlanUpsertBulk) ClearRazorpayPlanID() *SubscriptionPlanUpsertBulk {
+	return u.Update(func(s *SubscriptionPlanUpsert) {
+		s.ClearRazorpayPlanID()
+	})
+}
+
+// SetIsActive sets the "is_active" field.
+func (u *SubscriptionPlanUpsertBulk) SetIsActive(v bool) *SubscriptionPlanUpsertBulk {
+	return u.Update(func(s *SubscriptionPlanUpsert) {
+		s.SetIsActive(v)
+	})
+}
+
+// UpdateIsActive sets the "is_active" field to the value that was provided on create.
+func (u *SubscriptionPlanUpsertBulk) UpdateIsActive() *SubscriptionPlanUpsertBulk {
+	return u.Update(func(s *SubscriptionPlanUpsert) {
+		s.UpdateIsActive()
+	})
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (u *SubscriptionPlanUpsertBulk) SetSortOrder(v int) *SubscriptionPlanUpsertBulk {
+	return u.Update(func(s *SubscriptionPlanUpsert) {
+		s.SetSortOrder(v)
+	})
+}
+
+// AddSortOrder adds v to the "sort_order" field.
+func (u *SubscriptionPlanUpsertBulk) AddSortOrder(v int) *SubscriptionPlanUpsertBulk {
+	return u.Update(func(s *SubscriptionPlanUpsert) {
+		s.AddSortOrder(v)
+	})
+}
+
+// UpdateSortOrder sets the "sort_order" field to the value that was provided on create.
+func (u *SubscriptionPlanUpsertBulk) UpdateSortOrder() *SubscriptionPlanUpsertBulk {
+	return u.Update(func(s *SubscriptionPlanUpsert) {
+		s.UpdateSortOrder()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SubscriptionPlanUpsertBulk) SetUpdatedAt(v time.Time) *SubscriptionPlanUpsertBulk {
+	return u.Update(func(s *SubscriptionPlanUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SubscriptionPlanUpsertBulk) UpdateUpdatedAt() *SubscriptionPlanUpsertBulk {
+	return u.Update(func(s *SubscriptionPlanUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *SubscriptionPlanUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("generated: OnConflict was set for builder %d. Set it on the SubscriptionPlanCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("generated: missing options for SubscriptionPlanCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SubscriptionPlanUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,781 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/UnoraApp/be/ent/generated/predicate"
+	"github.com/UnoraApp/be/ent/generated/subscription"
+	"github.com/UnoraApp/be/ent/generated/subscriptionplan"
+)
+
+// SubscriptionPlanUpdate is the builder for updating SubscriptionPlan entities.
+type SubscriptionPlanUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SubscriptionPlanMutation
+}
+
+// Where appends a list predicates to the SubscriptionPlanUpdate builder.
+func (_u *SubscriptionPlanUpdate) Where(ps ...predicate.SubscriptionPlan) *SubscriptionPlanUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *SubscriptionPlanUpdate) SetName(v string) *SubscriptionPlanUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *SubscriptionPlanUpdate) SetNillableName(v *string) *SubscriptionPlanUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetTier sets the "tier" field.
+func (_u *SubscriptionPlanUpdate) SetTier(v subscriptionplan.Tier) *SubscriptionPlanUpdate {
+	_u.mutation.SetTier(v)
+	return _u
+}
+
+// SetNillableTier sets the "tier" field if the given value is not nil.
+func (_u *SubscriptionPlanUpdate) SetNillableTier(v *subscriptionplan.Tier) *SubscriptionPlanUpdate {
+	if v != nil {
+		_u.SetTier(*v)
+	}
+	return _u
+}
+
+// SetBillingPeriod sets the "billing_period" field.
+func (_u *SubscriptionPlanUpdate) SetBillingPeriod(v subscriptionplan.BillingPeriod) *SubscriptionPlanUpdate {
+	_u.mutation.SetBillingPeriod(v)
+	return _u
+}
+
+// SetNillableBillingPeriod sets the "billing_period" field if the given value is not nil.
+func (_u *SubscriptionPlanUpdate) SetNillableBillingPeriod(v *subscriptionplan.BillingPeriod) *SubscriptionPlanUpdate {
+	if v != nil {
+		_u.SetBillingPeriod(*v)
+	}
+	return _u
+}
+
+// SetPriceAmount sets the "price_amount" field.
+func (_u *SubscriptionPlanUpdate) SetPriceAmount(v int) *SubscriptionPlanUpdate {
+	_u.mutation.ResetPriceAmount()
+	_u.mutation.SetPriceAmount(v)
+	return _u
+}
+
+// SetNillablePriceAmount sets the "price_amount" field if the given value is not nil.
+func (_u *SubscriptionPlanUpdate) SetNillablePriceAmount(v *int) *SubscriptionPlanUpdate {
+	if v != nil {
+		_u.SetPriceAmount(*v)
+	}
+	return _u
+}
+
+// AddPriceAmount adds value to the "price_amount" field.
+func (_u *SubscriptionPlanUpdate) AddPriceAmount(v int) *SubscriptionPlanUpdate {
+	_u.mutation.AddPriceAmount(v)
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *SubscriptionPlanUpdate) SetCurrency(v string) *SubscriptionPlanUpdate {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *SubscriptionPlanUpdate) SetNillableCurrency(v *string) *SubscriptionPlanUpdate {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetRazorpayPlanID sets the "razorpay_plan_id" field.
+func (_u *SubscriptionPlanUpdate) SetRazorpayPlanID(v string) *SubscriptionPlanUpdate {
+	_u.mutation.SetRazorpayPlanID(v)
+	return _u
+}
+
+// SetNillableRazorpayPlanID sets the "razorpay_plan_id" field if the given value is not nil.
+func (_u *SubscriptionPlanUpdate) SetNillableRazorpayPlanID(v *string) *SubscriptionPlanUpdate {
+	if v != nil {
+		_u.SetRazorpayPlanID(*v)
+	}
+	return _u
+}
+
+// ClearRazorpayPlanID clears the value of the "razorpay_plan_id" field.
+func (_u *SubscriptionPlanUpdate) ClearRazorpayPlanID() *SubscriptionPlanUpdate {
+	_u.mutation.ClearRazorpayPlanID()
+	return _u
+}
+
+// SetIsActive sets the "is_active" field.
+func (_u *SubscriptionPlanUpdate) SetIsActive(v bool) *SubscriptionPlanUpdate {
+	_u.mutation.SetIsActive(v)
+	return _u
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (_u *SubscriptionPlanUpdate) SetNillableIsActive(v *bool) *SubscriptionPlanUpdate {
+	if v != nil {
+		_u.SetIsActive(*v)
+	}
+	return _u
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (_u *SubscriptionPlanUpdate) SetSortOrder(v int) *SubscriptionPlanUpdate {
+	_u.mutation.ResetSortOrder()
+	_u.mutation.SetSortOrder(v)
+	return _u
+}
+
+// SetNillableSortOrder sets the "sort_order" field if the given value is not nil.
+func (_u *SubscriptionPlanUpdate) SetNillableSortOrder(v *int) *SubscriptionPlanUpdate {
+	if v != nil {
+		_u.SetSortOrder(*v)
+	}
+	return _u
+}
+
+// AddSortOrder adds value to the "sort_order" field.
+func (_u *SubscriptionPlanUpdate) AddSortOrder(v int) *SubscriptionPlanUpdate {
+	_u.mutation.AddSortOrder(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *SubscriptionPlanUpdate) SetUpdatedAt(v time.Time) *SubscriptionPlanUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddSubscriptionIDs adds the "subscriptions" edge to the Subscription entity by IDs.
+func (_u *SubscriptionPlanUpdate) AddSubscriptionIDs(ids ...string) *SubscriptionPlanUpdate {
+	_u.mutation.AddSubscriptionIDs(ids...)
+	return _u
+}
+
+// AddSubscriptions adds the "subscriptions" edges to the Subscription entity.
+func (_u *SubscriptionPlanUpdate) AddSubscriptions(v ...*Subscription) *SubscriptionPlanUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSubscriptionIDs(ids...)
+}
+
+// Mutation returns the SubscriptionPlanMutation object of the builder.
+func (_u *SubscriptionPlanUpdate) Mutation() *SubscriptionPlanMutation {
+	return _u.mutation
+}
+
+// ClearSubscriptions clears all "subscriptions" edges to the Subscription entity.
+func (_u *SubscriptionPlanUpdate) ClearSubscriptions() *SubscriptionPlanUpdate {
+	_u.mutation.ClearSubscriptions()
+	return _u
+}
+
+// RemoveSubscriptionIDs removes the "subscriptions" edge to Subscription entities by IDs.
+func (_u *SubscriptionPlanUpdate) RemoveSubscriptionIDs(ids ...string) *SubscriptionPlanUpdate {
+	_u.mutation.RemoveSubscriptionIDs(ids...)
+	return _u
+}
+
+// RemoveSubscriptions removes "subscriptions" edges to Subscription entities.
+func (_u *SubscriptionPlanUpdate) RemoveSubscriptions(v ...*Subscription) *SubscriptionPlanUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSubscriptionIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *SubscriptionPlanUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SubscriptionPlanUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *SubscriptionPlanUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SubscriptionPlanUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SubscriptionPlanUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := subscriptionplan.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SubscriptionPlanUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := subscriptionplan.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`generated: validator failed for field "SubscriptionPlan.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Tier(); ok {
+		if err := subscriptionplan.TierValidator(v); err != nil {
+			return &ValidationError{Name: "tier", err: fmt.Errorf(`generated: validator failed for field "SubscriptionPlan.tier": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BillingPeriod(); ok {
+		if err := subscriptionplan.BillingPeriodValidator(v); err != nil {
+			return &ValidationError{Name: "billing_period", err: fmt.Errorf(`generated: validator failed for field "SubscriptionPlan.billing_period": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PriceAmount(); ok {
+		if err := subscriptionplan.PriceAmountValidator(v); err != nil {
+			return &ValidationError{Name: "price_amount", err: fmt.Errorf(`generated: validator failed for field "SubscriptionPlan.price_amount": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Currency(); ok {
+		if err := subscriptionplan.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`generated: validator failed for field "SubscriptionPlan.currency": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RazorpayPlanID(); ok {
+		if err := subscriptionplan.RazorpayPlanIDValidator(v); err != nil {
+			return &ValidationError{Name: "razorpay_plan_id", err: fmt.Errorf(`generated: validator failed for field "SubscriptionPlan.razorpay_plan_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *SubscriptionPlanUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(subscriptionplan.Table, subscriptionplan.Columns, sqlgraph.NewFieldSpec(subscriptionplan.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(subscriptionplan.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Tier(); ok {
+		_spec.SetField(subscriptionplan.FieldTier, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.BillingPeriod(); ok {
+		_spec.SetField(subscriptionplan.FieldBillingPeriod, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.PriceAmount(); ok {
+		_spec.SetField(subscriptionplan.FieldPriceAmount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPriceAmount(); ok {
+		_spec.AddField(subscriptionplan.FieldPriceAmount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(subscriptionplan.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RazorpayPlanID(); ok {
+		_spec.SetField(subscriptionplan.FieldRazorpayPlanID, field.TypeString, value)
+	}
+	if _u.mutation.RazorpayPlanIDCleared() {
+		_spec.ClearField(subscriptionplan.FieldRazorpayPlanID, field.TypeString)
+	}
+	if value, ok := _u.mutation.IsActive(); ok {
+		_spec.SetField(subscriptionplan.FieldIsActive, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.SortOrder(); ok {
+		_spec.SetField(subscriptionplan.FieldSortOrder, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSortOrder(); ok {
+		_spec.AddField(subscriptionplan.FieldSortOrder, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(subscriptionplan.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.SubscriptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   subscriptionplan.SubscriptionsTable,
+			Columns: []string{subscriptionplan.SubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSubscriptionsIDs(); len(nodes) > 0 && !_u.mutation.SubscriptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   subscriptionplan.SubscriptionsTable,
+			Columns: []string{subscriptionplan.SubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SubscriptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   subscriptionplan.SubscriptionsTable,
+			Columns: []string{subscriptionplan.SubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{subscriptionplan.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SubscriptionPlanUpdateOne is the builder for updating a single SubscriptionPlan entity.
+type SubscriptionPlanUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SubscriptionPlanMutation
+}
+
+// SetName sets the "name" field.
+func (_u *SubscriptionPlanUpdateOne) SetName(v string) *SubscriptionPlanUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *SubscriptionPlanUpdateOne) SetNillableName(v *string) *SubscriptionPlanUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetTier sets the "tier" field.
+func (_u *SubscriptionPlanUpdateOne) SetTier(v subscriptionplan.Tier) *SubscriptionPlanUpdateOne {
+	_u.mutation.SetTier(v)
+	return _u
+}
+
+// SetNillableTier sets the "tier" field if the given value is not nil.
+func (_u *SubscriptionPlanUpdateOne) SetNillableTier(v *subscriptionplan.Tier) *SubscriptionPlanUpdateOne {
+	if v != nil {
+		_u.SetTier(*v)
+	}
+	return _u
+}
+
+// SetBillingPeriod sets the "billing_period" field.
+func (_u *SubscriptionPlanUpdateOne) SetBillingPeriod(v subscriptionplan.BillingPeriod) *SubscriptionPlanUpdateOne {
+	_u.mutation.SetBillingPeriod(v)
+	return _u
+}
+
+// SetNillableBillingPeriod sets the "billing_period" field if the given value is not nil.
+func (_u *SubscriptionPlanUpdateOne) SetNillableBillingPeriod(v *subscriptionplan.BillingPeriod) *SubscriptionPlanUpdateOne {
+	if v != nil {
+		_u.SetBillingPeriod(*v)
+	}
+	return _u
+}
+
+// SetPriceAmount sets the "price_amount" field.
+func (_u *SubscriptionPlanUpdateOne) SetPriceAmount(v int) *SubscriptionPlanUpdateOne {
+	_u.mutation.ResetPriceAmount()
+	_u.mutation.SetPriceAmount(v)
+	return _u
+}
+
+// SetNillablePriceAmount sets the "price_amount" field if the given value is not nil.
+func (_u *SubscriptionPlanUpdateOne) SetNillablePriceAmount(v *int) *SubscriptionPlanUpdateOne {
+	if v != nil {
+		_u.SetPriceAmount(*v)
+	}
+	return _u
+}
+
+// AddPriceAmount adds value to the "price_amount" field.
+func (_u *SubscriptionPlanUpdateOne) AddPriceAmount(v int) *SubscriptionPlanUpdateOne {
+	_u.mutation.AddPriceAmount(v)
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *SubscriptionPlanUpdateOne) SetCurrency(v string) *SubscriptionPlanUpdateOne {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *SubscriptionPlanUpdateOne) SetNillableCurrency(v *string) *SubscriptionPlanUpdateOne {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetRazorpayPlanID sets the "razorpay_plan_id" field.
+func (_u *SubscriptionPlanUpdateOne) SetRazorpayPlanID(v string) *SubscriptionPlanUpdateOne {
+	_u.mutation.SetRazorpayPlanID(v)
+	return _u
+}
+
+// SetNillableRazorpayPlanID sets the "razorpay_plan_id" field if the given value is not nil.
+func (_u *SubscriptionPlanUpdateOne) SetNillableRazorpayPlanID(v *string) *SubscriptionPlanUpdateOne {
+	if v != nil {
+		_u.SetRazorpayPlanID(*v)
+	}
+	return _u
+}
+
+// ClearRazorpayPlanID clears the value of the "razorpay_plan_id" field.
+func (_u *SubscriptionPlanUpdateOne) ClearRazorpayPlanID() *SubscriptionPlanUpdateOne {
+	_u.mutation.ClearRazorpayPlanID()
+	return _u
+}
+
+// SetIsActive sets the "is_active" field.
+func (_u *SubscriptionPlanUpdateOne) SetIsActive(v bool) *SubscriptionPlanUpdateOne {
+	_u.mutation.SetIsActive(v)
+	return _u
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (_u *SubscriptionPlanUpdateOne) SetNillableIsActive(v *bool) *SubscriptionPlanUpdateOne {
+	if v != nil {
+		_u.SetIsActive(*v)
+	}
+	return _u
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (_u *SubscriptionPlanUpdateOne) SetSortOrder(v int) *SubscriptionPlanUpdateOne {
+	_u.mutation.ResetSortOrder()
+	_u.mutation.SetSortOrder(v)
+	return _u
+}
+
+// SetNillableSortOrder sets the "sort_order" field if the given value is not nil.
+func (_u *SubscriptionPlanUpdateOne) SetNillableSortOrder(v *int) *SubscriptionPlanUpdateOne {
+	if v != nil {
+		_u.SetSortOrder(*v)
+	}
+	return _u
+}
+
+// AddSortOrder adds value to the "sort_order" field.
+func (_u *SubscriptionPlanUpdateOne) AddSortOrder(v int) *SubscriptionPlanUpdateOne {
+	_u.mutation.AddSortOrder(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *SubscriptionPlanUpdateOne) SetUpdatedAt(v time.Time) *SubscriptionPlanUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddSubscriptionIDs adds the "subscriptions" edge to the Subscription entity by IDs.
+func (_u *SubscriptionPlanUpdateOne) AddSubscriptionIDs(ids ...string) *SubscriptionPlanUpdateOne {
+	_u.mutation.AddSubscriptionIDs(ids...)
+	return _u
+}
+
+// AddSubscriptions adds the "subscriptions" edges to the Subscription entity.
+func (_u *SubscriptionPlanUpdateOne) AddSubscriptions(v ...*Subscription) *SubscriptionPlanUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSubscriptionIDs(ids...)
+}
+
+// Mutation returns the SubscriptionPlanMutation object of the builder.
+func (_u *SubscriptionPlanUpdateOne) Mutation() *SubscriptionPlanMutation {
+	return _u.mutation
+}
+
+// ClearSubscriptions clears all "subscriptions" edges to the Subscription entity.
+func (_u *SubscriptionPlanUpdateOne) ClearSubscriptions() *SubscriptionPlanUpdateOne {
+	_u.mutation.ClearSubscriptions()
+	return _u
+}
+
+// RemoveSubscriptionIDs removes the "subscriptions" edge to Subscription entities by IDs.
+func (_u *SubscriptionPlanUpdateOne) RemoveSubscriptionIDs(ids ...string) *SubscriptionPlanUpdateOne {
+	_u.mutation.RemoveSubscriptionIDs(ids...)
+	return _u
+}
+
+// RemoveSubscriptions removes "subscriptions" edges to Subscription entities.
+func (_u *SubscriptionPlanUpdateOne) RemoveSubscriptions(v ...*Subscription) *SubscriptionPlanUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSubscriptionIDs(ids...)
+}
+
+// Where appends a list predicates to the SubscriptionPlanUpdate builder.
+func (_u *SubscriptionPlanUpdateOne) Where(ps ...predicate.SubscriptionPlan) *SubscriptionPlanUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *SubscriptionPlanUpdateOne) Select(field string, fields ...string) *SubscriptionPlanUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated SubscriptionPlan entity.
+func (_u *SubscriptionPlanUpdateOne) Save(ctx context.Context) (*SubscriptionPlan, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SubscriptionPlanUpdateOne) SaveX(ctx context.Context) *SubscriptionPlan {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *SubscriptionPlanUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SubscriptionPlanUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SubscriptionPlanUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := subscriptionplan.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SubscriptionPlanUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := subscriptionplan.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`generated: validator failed for field "SubscriptionPlan.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Tier(); ok {
+		if err := subscriptionplan.TierValidator(v); err != nil {
+			return &ValidationError{Name: "tier", err: fmt.Errorf(`generated: validator failed for field "SubscriptionPlan.tier": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BillingPeriod(); ok {
+		if err := subscriptionplan.BillingPeriodValidator(v); err != nil {
+			return &ValidationError{Name: "billing_period", err: fmt.Errorf(`generated: validator failed for field "SubscriptionPlan.billing_period": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PriceAmount(); ok {
+		if err := subscriptionplan.PriceAmountValidator(v); err != nil {
+			return &ValidationError{Name: "price_amount", err: fmt.Errorf(`generated: validator failed for field "SubscriptionPlan.price_amount": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Currency(); ok {
+		if err := subscriptionplan.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`generated: validator failed for field "SubscriptionPlan.currency": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RazorpayPlanID(); ok {
+		if err := subscriptionplan.RazorpayPlanIDValidator(v); err != nil {
+			return &ValidationError{Name: "razorpay_plan_id", err: fmt.Errorf(`generated: validator failed for field "SubscriptionPlan.razorpay_plan_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *SubscriptionPlanUpdateOne) sqlSave(ctx context.Context) (_node *SubscriptionPlan, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(subscriptionplan.Table, subscriptionplan.Columns, sqlgraph.NewFieldSpec(subscriptionplan.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "SubscriptionPlan.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, subscriptionplan.FieldID)
+		for _, f := range fields {
+			if !subscriptionplan.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != subscriptionplan.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(subscriptionplan.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Tier(); ok {
+		_spec.SetField(subscriptionplan.FieldTier, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.BillingPeriod(); ok {
+		_spec.SetField(subscriptionplan.FieldBillingPeriod, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.PriceAmount(); ok {
+		_spec.SetField(subscriptionplan.FieldPriceAmount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPriceAmount(); ok {
+		_spec.AddField(subscriptionplan.FieldPriceAmount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(subscriptionplan.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RazorpayPlanID(); ok {
+		_spec.SetField(subscriptionplan.FieldRazorpayPlanID, field.TypeString, value)
+	}
+	if _u.mutation.RazorpayPlanIDCleared() {
+		_spec.ClearField(subscriptionplan.FieldRazorpayPlanID, field.TypeString)
+	}
+	if value, ok := _u.mutation.IsActive(); ok {
+		_spec.SetField(subscriptionplan.FieldIsActive, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.SortOrder(); ok {
+		_spec.SetField(subscriptionplan.FieldSortOrder, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSortOrder(); ok {
+		_spec.AddField(subscriptionplan.FieldSortOrder, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(subscriptionplan.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.SubscriptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   subscriptionplan.SubscriptionsTable,
+			Columns: []string{subscriptionplan.SubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSubscriptionsIDs(); len(nodes) > 0 && !_u.mutation.SubscriptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   subscriptionplan.SubscriptionsTable,
+			Columns: []string{subscriptionplan.SubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SubscriptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   subscriptionplan.SubscriptionsTable,
+			Columns: []string{subscriptionplan.SubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &SubscriptionPlan{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{subscriptionplan.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
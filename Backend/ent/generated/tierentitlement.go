@@ -0,0 +1,199 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/UnoraApp/be/ent/generated/tierentitlement"
+)
+
+// TierEntitlement is the model entity for the TierEntitlement schema.
+type TierEntitlement struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Tier holds the value of the "tier" field.
+	Tier tierentitlement.Tier `json:"tier,omitempty"`
+	// ConnectionSlots holds the value of the "connection_slots" field.
+	ConnectionSlots int `json:"connection_slots,omitempty"`
+	// RefreshCooldownMinutes holds the value of the "refresh_cooldown_minutes" field.
+	RefreshCooldownMinutes int `json:"refresh_cooldown_minutes,omitempty"`
+	// NudgesPerAtRisk holds the value of the "nudges_per_at_risk" field.
+	NudgesPerAtRisk int `json:"nudges_per_at_risk,omitempty"`
+	// NudgeIntervalMinutes holds the value of the "nudge_interval_minutes" field.
+	NudgeIntervalMinutes int `json:"nudge_interval_minutes,omitempty"`
+	// FreeRecoveriesPerConnection holds the value of the "free_recoveries_per_connection" field.
+	FreeRecoveriesPerConnection int `json:"free_recoveries_per_connection,omitempty"`
+	// PurchasableReveals holds the value of the "purchasable_reveals" field.
+	PurchasableReveals int `json:"purchasable_reveals,omitempty"`
+	// RevealDays holds the value of the "reveal_days" field.
+	RevealDays []int `json:"reveal_days,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TierEntitlement) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case tierentitlement.FieldRevealDays:
+			values[i] = new([]byte)
+		case tierentitlement.FieldConnectionSlots, tierentitlement.FieldRefreshCooldownMinutes, tierentitlement.FieldNudgesPerAtRisk, tierentitlement.FieldNudgeIntervalMinutes, tierentitlement.FieldFreeRecoveriesPerConnection, tierentitlement.FieldPurchasableReveals:
+			values[i] = new(sql.NullInt64)
+		case tierentitlement.FieldID, tierentitlement.FieldTier:
+			values[i] = new(sql.NullString)
+		case tierentitlement.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TierEntitlement fields.
+func (_m *TierEntitlement) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case tierentitlement.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case tierentitlement.FieldTier:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tier", values[i])
+			} else if value.Valid {
+				_m.Tier = tierentitlement.Tier(value.String)
+			}
+		case tierentitlement.FieldConnectionSlots:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field connection_slots", values[i])
+			} else if value.Valid {
+				_m.ConnectionSlots = int(value.Int64)
+			}
+		case tierentitlement.FieldRefreshCooldownMinutes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field refresh_cooldown_minutes", values[i])
+			} else if value.Valid {
+				_m.RefreshCooldownMinutes = int(value.Int64)
+			}
+		case tierentitlement.FieldNudgesPerAtRisk:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field nudges_per_at_risk", values[i])
+			} else if value.Valid {
+				_m.NudgesPerAtRisk = int(value.Int64)
+			}
+		case tierentitlement.FieldNudgeIntervalMinutes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field nudge_interval_minutes", values[i])
+			} else if value.Valid {
+				_m.NudgeIntervalMinutes = int(value.Int64)
+			}
+		case tierentitlement.FieldFreeRecoveriesPerConnection:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field free_recoveries_per_connection", values[i])
+			} else if value.Valid {
+				_m.FreeRecoveriesPerConnection = int(value.Int64)
+			}
+		case tierentitlement.FieldPurchasableReveals:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field purchasable_reveals", values[i])
+			} else if value.Valid {
+				_m.PurchasableReveals = int(value.Int64)
+			}
+		case tierentitlement.FieldRevealDays:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field reveal_days", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.RevealDays); err != nil {
+					return fmt.Errorf("unmarshal field reveal_days: %w", err)
+				}
+			}
+		case tierentitlement.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TierEntitlement.
+// This includes values selected through modifiers, order, etc.
+func (_m *TierEntitlement) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this TierEntitlement.
+// Note that you need to call TierEntitlement.Unwrap() before calling this method if this TierEntitlement
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *TierEntitlement) Update() *TierEntitlementUpdateOne {
+	return NewTierEntitlementClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the TierEntitlement entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *TierEntitlement) Unwrap() *TierEntitlement {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("generated: TierEntitlement is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *TierEntitlement) String() string {
+	var builder strings.Builder
+	builder.WriteString("TierEntitlement(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tier=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Tier))
+	builder.WriteString(", ")
+	builder.WriteString("connection_slots=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ConnectionSlots))
+	builder.WriteString(", ")
+	builder.WriteString("refresh_cooldown_minutes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RefreshCooldownMinutes))
+	builder.WriteString(", ")
+	builder.WriteString("nudges_per_at_risk=")
+	builder.WriteString(fmt.Sprintf("%v", _m.NudgesPerAtRisk))
+	builder.WriteString(", ")
+	builder.WriteString("nudge_interval_minutes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.NudgeIntervalMinutes))
+	builder.WriteString(", ")
+	builder.WriteString("free_recoveries_per_connection=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FreeRecoveriesPerConnection))
+	builder.WriteString(", ")
+	builder.WriteString("purchasable_reveals=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PurchasableReveals))
+	builder.WriteString(", ")
+	builder.WriteString("reveal_days=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RevealDays))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TierEntitlements is a parsable slice of TierEntitlement.
+type TierEntitlements []*TierEntitlement
@@ -0,0 +1,154 @@
+// Code generated by ent, DO NOT EDIT.
+
+package tierentitlement
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the tierentitlement type in the database.
+	Label = "tier_entitlement"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTier holds the string denoting the tier field in the database.
+	FieldTier = "tier"
+	// FieldConnectionSlots holds the string denoting the connection_slots field in the database.
+	FieldConnectionSlots = "connection_slots"
+	// FieldRefreshCooldownMinutes holds the string denoting the refresh_cooldown_minutes field in the database.
+	FieldRefreshCooldownMinutes = "refresh_cooldown_minutes"
+	// FieldNudgesPerAtRisk holds the string denoting the nudges_per_at_risk field in the database.
+	FieldNudgesPerAtRisk = "nudges_per_at_risk"
+	// FieldNudgeIntervalMinutes holds the string denoting the nudge_interval_minutes field in the database.
+	FieldNudgeIntervalMinutes = "nudge_interval_minutes"
+	// FieldFreeRecoveriesPerConnection holds the string denoting the free_recoveries_per_connection field in the database.
+	FieldFreeRecoveriesPerConnection = "free_recoveries_per_connection"
+	// FieldPurchasableReveals holds the string denoting the purchasable_reveals field in the database.
+	FieldPurchasableReveals = "purchasable_reveals"
+	// FieldRevealDays holds the string denoting the reveal_days field in the database.
+	FieldRevealDays = "reveal_days"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the tierentitlement in the database.
+	Table = "tier_entitlements"
+)
+
+// Columns holds all SQL columns for tierentitlement fields.
+var Columns = []string{
+	FieldID,
+	FieldTier,
+	FieldConnectionSlots,
+	FieldRefreshCooldownMinutes,
+	FieldNudgesPerAtRisk,
+	FieldNudgeIntervalMinutes,
+	FieldFreeRecoveriesPerConnection,
+	FieldPurchasableReveals,
+	FieldRevealDays,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// ConnectionSlotsValidator is a validator for the "connection_slots" field. It is called by the builders before save.
+	ConnectionSlotsValidator func(int) error
+	// RefreshCooldownMinutesValidator is a validator for the "refresh_cooldown_minutes" field. It is called by the builders before save.
+	RefreshCooldownMinutesValidator func(int) error
+	// NudgesPerAtRiskValidator is a validator for the "nudges_per_at_risk" field. It is called by the builders before save.
+	NudgesPerAtRiskValidator func(int) error
+	// NudgeIntervalMinutesValidator is a validator for the "nudge_interval_minutes" field. It is called by the builders before save.
+	NudgeIntervalMinutesValidator func(int) error
+	// FreeRecoveriesPerConnectionValidator is a validator for the "free_recoveries_per_connection" field. It is called by the builders before save.
+	FreeRecoveriesPerConnectionValidator func(int) error
+	// PurchasableRevealsValidator is a validator for the "purchasable_reveals" field. It is called by the builders before save.
+	PurchasableRevealsValidator func(int) error
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
+
+// Tier defines the type for the "tier" enum field.
+type Tier string
+
+// Tier values.
+const (
+	TierFree Tier = "free"
+	TierPlus Tier = "plus"
+	TierPro  Tier = "pro"
+)
+
+func (t Tier) String() string {
+	return string(t)
+}
+
+// TierValidator is a validator for the "tier" field enum values. It is called by the builders before save.
+func TierValidator(t Tier) error {
+	switch t {
+	case TierFree, TierPlus, TierPro:
+		return nil
+	default:
+		return fmt.Errorf("tierentitlement: invalid enum value for tier field: %q", t)
+	}
+}
+
+// OrderOption defines the ordering options for the TierEntitlement queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTier orders the results by the tier field.
+func ByTier(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTier, opts...).ToFunc()
+}
+
+// ByConnectionSlots orders the results by the connection_slots field.
+func ByConnectionSlots(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldConnectionSlots, opts...).ToFunc()
+}
+
+// ByRefreshCooldownMinutes orders the results by the refresh_cooldown_minutes field.
+func ByRefreshCooldownMinutes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRefreshCooldownMinutes, opts...).ToFunc()
+}
+
+// ByNudgesPerAtRisk orders the results by the nudges_per_at_risk field.
+func ByNudgesPerAtRisk(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNudgesPerAtRisk, opts...).ToFunc()
+}
+
+// ByNudgeIntervalMinutes orders the results by the nudge_interval_minutes field.
+func ByNudgeIntervalMinutes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNudgeIntervalMinutes, opts...).ToFunc()
+}
+
+// ByFreeRecoveriesPerConnection orders the results by the free_recoveries_per_connection field.
+func ByFreeRecoveriesPerConnection(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFreeRecoveriesPerConnection, opts...).ToFunc()
+}
+
+// ByPurchasableReveals orders the results by the purchasable_reveals field.
+func ByPurchasableReveals(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPurchasableReveals, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,415 @@
+// Code generated by ent, DO NOT EDIT.
+
+package tierentitlement
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/UnoraApp/be/ent/generated/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.FieldContainsFold(FieldID, id))
+}
+
+// ConnectionSlots applies equality check predicate on the "connection_slots" field. It's identical to ConnectionSlotsEQ.
+func ConnectionSlots(v int) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.FieldEQ(FieldConnectionSlots, v))
+}
+
+// RefreshCooldownMinutes applies equality check predicate on the "refresh_cooldown_minutes" field. It's identical to RefreshCooldownMinutesEQ.
+func RefreshCooldownMinutes(v int) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.FieldEQ(FieldRefreshCooldownMinutes, v))
+}
+
+// NudgesPerAtRisk applies equality check predicate on the "nudges_per_at_risk" field. It's identical to NudgesPerAtRiskEQ.
+func NudgesPerAtRisk(v int) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.FieldEQ(FieldNudgesPerAtRisk, v))
+}
+
+// NudgeIntervalMinutes applies equality check predicate on the "nudge_interval_minutes" field. It's identical to NudgeIntervalMinutesEQ.
+func NudgeIntervalMinutes(v int) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.FieldEQ(FieldNudgeIntervalMinutes, v))
+}
+
+// FreeRecoveriesPerConnection applies equality check predicate on the "free_recoveries_per_connection" field. It's identical to FreeRecoveriesPerConnectionEQ.
+func FreeRecoveriesPerConnection(v int) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.FieldEQ(FieldFreeRecoveriesPerConnection, v))
+}
+
+// PurchasableReveals applies equality check predicate on the "purchasable_reveals" field. It's identical to PurchasableRevealsEQ.
+func PurchasableReveals(v int) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.FieldEQ(FieldPurchasableReveals, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// TierEQ applies the EQ predicate on the "tier" field.
+func TierEQ(v Tier) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.FieldEQ(FieldTier, v))
+}
+
+// TierNEQ applies the NEQ predicate on the "tier" field.
+func TierNEQ(v Tier) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.FieldNEQ(FieldTier, v))
+}
+
+// TierIn applies the In predicate on the "tier" field.
+func TierIn(vs ...Tier) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.FieldIn(FieldTier, vs...))
+}
+
+// TierNotIn applies the NotIn predicate on the "tier" field.
+func TierNotIn(vs ...Tier) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.FieldNotIn(FieldTier, vs...))
+}
+
+// ConnectionSlotsEQ applies the EQ predicate on the "connection_slots" field.
+func ConnectionSlotsEQ(v int) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.FieldEQ(FieldConnectionSlots, v))
+}
+
+// ConnectionSlotsNEQ applies the NEQ predicate on the "connection_slots" field.
+func ConnectionSlotsNEQ(v int) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.FieldNEQ(FieldConnectionSlots, v))
+}
+
+// ConnectionSlotsIn applies the In predicate on the "connection_slots" field.
+func ConnectionSlotsIn(vs ...int) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.FieldIn(FieldConnectionSlots, vs...))
+}
+
+// ConnectionSlotsNotIn applies the NotIn predicate on the "connection_slots" field.
+func ConnectionSlotsNotIn(vs ...int) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.FieldNotIn(FieldConnectionSlots, vs...))
+}
+
+// ConnectionSlotsGT applies the GT predicate on the "connection_slots" field.
+func ConnectionSlotsGT(v int) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.FieldGT(FieldConnectionSlots, v))
+}
+
+// ConnectionSlotsGTE applies the GTE predicate on the "connection_slots" field.
+func ConnectionSlotsGTE(v int) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.FieldGTE(FieldConnectionSlots, v))
+}
+
+// ConnectionSlotsLT applies the LT predicate on the "connection_slots" field.
+func ConnectionSlotsLT(v int) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.FieldLT(FieldConnectionSlots, v))
+}
+
+// ConnectionSlotsLTE applies the LTE predicate on the "connection_slots" field.
+func ConnectionSlotsLTE(v int) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.FieldLTE(FieldConnectionSlots, v))
+}
+
+// RefreshCooldownMinutesEQ applies the EQ predicate on the "refresh_cooldown_minutes" field.
+func RefreshCooldownMinutesEQ(v int) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.FieldEQ(FieldRefreshCooldownMinutes, v))
+}
+
+// RefreshCooldownMinutesNEQ applies the NEQ predicate on the "refresh_cooldown_minutes" field.
+func RefreshCooldownMinutesNEQ(v int) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.FieldNEQ(FieldRefreshCooldownMinutes, v))
+}
+
+// RefreshCooldownMinutesIn applies the In predicate on the "refresh_cooldown_minutes" field.
+func RefreshCooldownMinutesIn(vs ...int) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.FieldIn(FieldRefreshCooldownMinutes, vs...))
+}
+
+// RefreshCooldownMinutesNotIn applies the NotIn predicate on the "refresh_cooldown_minutes" field.
+func RefreshCooldownMinutesNotIn(vs ...int) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.FieldNotIn(FieldRefreshCooldownMinutes, vs...))
+}
+
+// RefreshCooldownMinutesGT applies the GT predicate on the "refresh_cooldown_minutes" field.
+func RefreshCooldownMinutesGT(v int) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.FieldGT(FieldRefreshCooldownMinutes, v))
+}
+
+// RefreshCooldownMinutesGTE applies the GTE predicate on the "refresh_cooldown_minutes" field.
+func RefreshCooldownMinutesGTE(v int) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.FieldGTE(FieldRefreshCooldownMinutes, v))
+}
+
+// RefreshCooldownMinutesLT applies the LT predicate on the "refresh_cooldown_minutes" field.
+func RefreshCooldownMinutesLT(v int) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.FieldLT(FieldRefreshCooldownMinutes, v))
+}
+
+// RefreshCooldownMinutesLTE applies the LTE predicate on the "refresh_cooldown_minutes" field.
+func RefreshCooldownMinutesLTE(v int) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.FieldLTE(FieldRefreshCooldownMinutes, v))
+}
+
+// NudgesPerAtRiskEQ applies the EQ predicate on the "nudges_per_at_risk" field.
+func NudgesPerAtRiskEQ(v int) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.FieldEQ(FieldNudgesPerAtRisk, v))
+}
+
+// NudgesPerAtRiskNEQ applies the NEQ predicate on the "nudges_per_at_risk" field.
+func NudgesPerAtRiskNEQ(v int) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.FieldNEQ(FieldNudgesPerAtRisk, v))
+}
+
+// NudgesPerAtRiskIn applies the In predicate on the "nudges_per_at_risk" field.
+func NudgesPerAtRiskIn(vs ...int) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.FieldIn(FieldNudgesPerAtRisk, vs...))
+}
+
+// NudgesPerAtRiskNotIn applies the NotIn predicate on the "nudges_per_at_risk" field.
+func NudgesPerAtRiskNotIn(vs ...int) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.FieldNotIn(FieldNudgesPerAtRisk, vs...))
+}
+
+// NudgesPerAtRiskGT applies the GT predicate on the "nudges_per_at_risk" field.
+func NudgesPerAtRiskGT(v int) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.FieldGT(FieldNudgesPerAtRisk, v))
+}
+
+// NudgesPerAtRiskGTE applies the GTE predicate on the "nudges_per_at_risk" field.
+func NudgesPerAtRiskGTE(v int) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.FieldGTE(FieldNudgesPerAtRisk, v))
+}
+
+// NudgesPerAtRiskLT applies the LT predicate on the "nudges_per_at_risk" field.
+func NudgesPerAtRiskLT(v int) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.FieldLT(FieldNudgesPerAtRisk, v))
+}
+
+// NudgesPerAtRiskLTE applies the LTE predicate on the "nudges_per_at_risk" field.
+func NudgesPerAtRiskLTE(v int) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.FieldLTE(FieldNudgesPerAtRisk, v))
+}
+
+// NudgeIntervalMinutesEQ applies the EQ predicate on the "nudge_interval_minutes" field.
+func NudgeIntervalMinutesEQ(v int) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.FieldEQ(FieldNudgeIntervalMinutes, v))
+}
+
+// NudgeIntervalMinutesNEQ applies the NEQ predicate on the "nudge_interval_minutes" field.
+func NudgeIntervalMinutesNEQ(v int) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.FieldNEQ(FieldNudgeIntervalMinutes, v))
+}
+
+// NudgeIntervalMinutesIn applies the In predicate on the "nudge_interval_minutes" field.
+func NudgeIntervalMinutesIn(vs ...int) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.FieldIn(FieldNudgeIntervalMinutes, vs...))
+}
+
+// NudgeIntervalMinutesNotIn applies the NotIn predicate on the "nudge_interval_minutes" field.
+func NudgeIntervalMinutesNotIn(vs ...int) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.FieldNotIn(FieldNudgeIntervalMinutes, vs...))
+}
+
+// NudgeIntervalMinutesGT applies the GT predicate on the "nudge_interval_minutes" field.
+func NudgeIntervalMinutesGT(v int) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.FieldGT(FieldNudgeIntervalMinutes, v))
+}
+
+// NudgeIntervalMinutesGTE applies the GTE predicate on the "nudge_interval_minutes" field.
+func NudgeIntervalMinutesGTE(v int) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.FieldGTE(FieldNudgeIntervalMinutes, v))
+}
+
+// NudgeIntervalMinutesLT applies the LT predicate on the "nudge_interval_minutes" field.
+func NudgeIntervalMinutesLT(v int) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.FieldLT(FieldNudgeIntervalMinutes, v))
+}
+
+// NudgeIntervalMinutesLTE applies the LTE predicate on the "nudge_interval_minutes" field.
+func NudgeIntervalMinutesLTE(v int) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.FieldLTE(FieldNudgeIntervalMinutes, v))
+}
+
+// FreeRecoveriesPerConnectionEQ applies the EQ predicate on the "free_recoveries_per_connection" field.
+func FreeRecoveriesPerConnectionEQ(v int) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.FieldEQ(FieldFreeRecoveriesPerConnection, v))
+}
+
+// FreeRecoveriesPerConnectionNEQ applies the NEQ predicate on the "free_recoveries_per_connection" field.
+func FreeRecoveriesPerConnectionNEQ(v int) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.FieldNEQ(FieldFreeRecoveriesPerConnection, v))
+}
+
+// FreeRecoveriesPerConnectionIn applies the In predicate on the "free_recoveries_per_connection" field.
+func FreeRecoveriesPerConnectionIn(vs ...int) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.FieldIn(FieldFreeRecoveriesPerConnection, vs...))
+}
+
+// FreeRecoveriesPerConnectionNotIn applies the NotIn predicate on the "free_recoveries_per_connection" field.
+func FreeRecoveriesPerConnectionNotIn(vs ...int) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.FieldNotIn(FieldFreeRecoveriesPerConnection, vs...))
+}
+
+// FreeRecoveriesPerConnectionGT applies the GT predicate on the "free_recoveries_per_connection" field.
+func FreeRecoveriesPerConnectionGT(v int) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.FieldGT(FieldFreeRecoveriesPerConnection, v))
+}
+
+// FreeRecoveriesPerConnectionGTE applies the GTE predicate on the "free_recoveries_per_connection" field.
+func FreeRecoveriesPerConnectionGTE(v int) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.FieldGTE(FieldFreeRecoveriesPerConnection, v))
+}
+
+// FreeRecoveriesPerConnectionLT applies the LT predicate on the "free_recoveries_per_connection" field.
+func FreeRecoveriesPerConnectionLT(v int) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.FieldLT(FieldFreeRecoveriesPerConnection, v))
+}
+
+// FreeRecoveriesPerConnectionLTE applies the LTE predicate on the "free_recoveries_per_connection" field.
+func FreeRecoveriesPerConnectionLTE(v int) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.FieldLTE(FieldFreeRecoveriesPerConnection, v))
+}
+
+// PurchasableRevealsEQ applies the EQ predicate on the "purchasable_reveals" field.
+func PurchasableRevealsEQ(v int) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.FieldEQ(FieldPurchasableReveals, v))
+}
+
+// PurchasableRevealsNEQ applies the NEQ predicate on the "purchasable_reveals" field.
+func PurchasableRevealsNEQ(v int) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.FieldNEQ(FieldPurchasableReveals, v))
+}
+
+// PurchasableRevealsIn applies the In predicate on the "purchasable_reveals" field.
+func PurchasableRevealsIn(vs ...int) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.FieldIn(FieldPurchasableReveals, vs...))
+}
+
+// PurchasableRevealsNotIn applies the NotIn predicate on the "purchasable_reveals" field.
+func PurchasableRevealsNotIn(vs ...int) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.FieldNotIn(FieldPurchasableReveals, vs...))
+}
+
+// PurchasableRevealsGT applies the GT predicate on the "purchasable_reveals" field.
+func PurchasableRevealsGT(v int) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.FieldGT(FieldPurchasableReveals, v))
+}
+
+// PurchasableRevealsGTE applies the GTE predicate on the "purchasable_reveals" field.
+func PurchasableRevealsGTE(v int) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.FieldGTE(FieldPurchasableReveals, v))
+}
+
+// PurchasableRevealsLT applies the LT predicate on the "purchasable_reveals" field.
+func PurchasableRevealsLT(v int) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.FieldLT(FieldPurchasableReveals, v))
+}
+
+// PurchasableRevealsLTE applies the LTE predicate on the "purchasable_reveals" field.
+func PurchasableRevealsLTE(v int) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.FieldLTE(FieldPurchasableReveals, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TierEntitlement) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TierEntitlement) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TierEntitlement) predicate.TierEntitlement {
+	return predicate.TierEntitlement(sql.NotPredicates(p))
+}
@@ -0,0 +1,1058 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/UnoraApp/be/ent/generated/tierentitlement"
+)
+
+// TierEntitlementCreate is the builder for creating a TierEntitlement entity.
+type TierEntitlementCreate struct {
+	config
+	mutation *TierEntitlementMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTier sets the "tier" field.
+func (_c *TierEntitlementCreate) SetTier(v tierentitlement.Tier) *TierEntitlementCreate {
+	_c.mutation.SetTier(v)
+	return _c
+}
+
+// SetConnectionSlots sets the "connection_slots" field.
+func (_c *TierEntitlementCreate) SetConnectionSlots(v int) *TierEntitlementCreate {
+	_c.mutation.SetConnectionSlots(v)
+	return _c
+}
+
+// SetRefreshCooldownMinutes sets the "refresh_cooldown_minutes" field.
+func (_c *TierEntitlementCreate) SetRefreshCooldownMinutes(v int) *TierEntitlementCreate {
+	_c.mutation.SetRefreshCooldownMinutes(v)
+	return _c
+}
+
+// SetNudgesPerAtRisk sets the "nudges_per_at_risk" field.
+func (_c *TierEntitlementCreate) SetNudgesPerAtRisk(v int) *TierEntitlementCreate {
+	_c.mutation.SetNudgesPerAtRisk(v)
+	return _c
+}
+
+// SetNudgeIntervalMinutes sets the "nudge_interval_minutes" field.
+func (_c *TierEntitlementCreate) SetNudgeIntervalMinutes(v int) *TierEntitlementCreate {
+	_c.mutation.SetNudgeIntervalMinutes(v)
+	return _c
+}
+
+// SetFreeRecoveriesPerConnection sets the "free_recoveries_per_connection" field.
+func (_c *TierEntitlementCreate) SetFreeRecoveriesPerConnection(v int) *TierEntitlementCreate {
+	_c.mutation.SetFreeRecoveriesPerConnection(v)
+	return _c
+}
+
+// SetPurchasableReveals sets the "purchasable_reveals" field.
+func (_c *TierEntitlementCreate) SetPurchasableReveals(v int) *TierEntitlementCreate {
+	_c.mutation.SetPurchasableReveals(v)
+	return _c
+}
+
+// SetRevealDays sets the "reveal_days" field.
+func (_c *TierEntitlementCreate) SetRevealDays(v []int) *TierEntitlementCreate {
+	_c.mutation.SetRevealDays(v)
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *TierEntitlementCreate) SetUpdatedAt(v time.Time) *TierEntitlementCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *TierEntitlementCreate) SetNillableUpdatedAt(v *time.Time) *TierEntitlementCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *TierEntitlementCreate) SetID(v string) *TierEntitlementCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the TierEntitlementMutation object of the builder.
+func (_c *TierEntitlementCreate) Mutation() *TierEntitlementMutation {
+	return _c.mutation
+}
+
+// Save creates the TierEntitlement in the database.
+func (_c *TierEntitlementCreate) Save(ctx context.Context) (*TierEntitlement, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TierEntitlementCreate) SaveX(ctx context.Context) *TierEntitlement {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TierEntitlementCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TierEntitlementCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TierEntitlementCreate) defaults() {
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := tierentitlement.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TierEntitlementCreate) check() error {
+	if _, ok := _c.mutation.Tier(); !ok {
+		return &ValidationError{Name: "tier", err: errors.New(`generated: missing required field "TierEntitlement.tier"`)}
+	}
+	if v, ok := _c.mutation.Tier(); ok {
+		if err := tierentitlement.TierValidator(v); err != nil {
+			return &ValidationError{Name: "tier", err: fmt.Errorf(`generated: validator failed for field "TierEntitlement.tier": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ConnectionSlots(); !ok {
+		return &ValidationError{Name: "connection_slots", err: errors.New(`generated: missing required field "TierEntitlement.connection_slots"`)}
+	}
+	if v, ok := _c.mutation.ConnectionSlots(); ok {
+		if err := tierentitlement.ConnectionSlotsValidator(v); err != nil {
+			return &ValidationError{Name: "connection_slots", err: fmt.Errorf(`generated: validator failed for field "TierEntitlement.connection_slots": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RefreshCooldownMinutes(); !ok {
+		return &ValidationError{Name: "refresh_cooldown_minutes", err: errors.New(`generated: missing required field "TierEntitlement.refresh_cooldown_minutes"`)}
+	}
+	if v, ok := _c.mutation.RefreshCooldownMinutes(); ok {
+		if err := tierentitlement.RefreshCooldownMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "refresh_cooldown_minutes", err: fmt.Errorf(`generated: validator failed for field "TierEntitlement.refresh_cooldown_minutes": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.NudgesPerAtRisk(); !ok {
+		return &ValidationError{Name: "nudges_per_at_risk", err: errors.New(`generated: missing required field "TierEntitlement.nudges_per_at_risk"`)}
+	}
+	if v, ok := _c.mutation.NudgesPerAtRisk(); ok {
+		if err := tierentitlement.NudgesPerAtRiskValidator(v); err != nil {
+			return &ValidationError{Name: "nudges_per_at_risk", err: fmt.Errorf(`generated: validator failed for field "TierEntitlement.nudges_per_at_risk": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.NudgeIntervalMinutes(); !ok {
+		return &ValidationError{Name: "nudge_interval_minutes", err: errors.New(`generated: missing required field "TierEntitlement.nudge_interval_minutes"`)}
+	}
+	if v, ok := _c.mutation.NudgeIntervalMinutes(); ok {
+		if err := tierentitlement.NudgeIntervalMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "nudge_interval_minutes", err: fmt.Errorf(`generated: validator failed for field "TierEntitlement.nudge_interval_minutes": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.FreeRecoveriesPerConnection(); !ok {
+		return &ValidationError{Name: "free_recoveries_per_connection", err: errors.New(`generated: missing required field "TierEntitlement.free_recoveries_per_connection"`)}
+	}
+	if v, ok := _c.mutation.FreeRecoveriesPerConnection(); ok {
+		if err := tierentitlement.FreeRecoveriesPerConnectionValidator(v); err != nil {
+			return &ValidationError{Name: "free_recoveries_per_connection", err: fmt.Errorf(`generated: validator failed for field "TierEntitlement.free_recoveries_per_connection": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PurchasableReveals(); !ok {
+		return &ValidationError{Name: "purchasable_reveals", err: errors.New(`generated: missing required field "TierEntitlement.purchasable_reveals"`)}
+	}
+	if v, ok := _c.mutation.PurchasableReveals(); ok {
+		if err := tierentitlement.PurchasableRevealsValidator(v); err != nil {
+			return &ValidationError{Name: "purchasable_reveals", err: fmt.Errorf(`generated: validator failed for field "TierEntitlement.purchasable_reveals": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RevealDays(); !ok {
+		return &ValidationError{Name: "reveal_days", err: errors.New(`generated: missing required field "TierEntitlement.reveal_days"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`generated: missing required field "TierEntitlement.updated_at"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := tierentitlement.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`generated: validator failed for field "TierEntitlement.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *TierEntitlementCreate) sqlSave(ctx context.Context) (*TierEntitlement, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected TierEntitlement.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TierEntitlementCreate) createSpec() (*TierEntitlement, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TierEntitlement{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(tierentitlement.Table, sqlgraph.NewFieldSpec(tierentitlement.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.Tier(); ok {
+		_spec.SetField(tierentitlement.FieldTier, field.TypeEnum, value)
+		_node.Tier = value
+	}
+	if value, ok := _c.mutation.ConnectionSlots(); ok {
+		_spec.SetField(tierentitlement.FieldConnectionSlots, field.TypeInt, value)
+		_node.ConnectionSlots = value
+	}
+	if value, ok := _c.mutation.RefreshCooldownMinutes(); ok {
+		_spec.SetField(tierentitlement.FieldRefreshCooldownMinutes, field.TypeInt, value)
+		_node.RefreshCooldownMinutes = value
+	}
+	if value, ok := _c.mutation.NudgesPerAtRisk(); ok {
+		_spec.SetField(tierentitlement.FieldNudgesPerAtRisk, field.TypeInt, value)
+		_node.NudgesPerAtRisk = value
+	}
+	if value, ok := _c.mutation.NudgeIntervalMinutes(); ok {
+		_spec.SetField(tierentitlement.FieldNudgeIntervalMinutes, field.TypeInt, value)
+		_node.NudgeIntervalMinutes = value
+	}
+	if value, ok := _c.mutation.FreeRecoveriesPerConnection(); ok {
+		_spec.SetField(tierentitlement.FieldFreeRecoveriesPerConnection, field.TypeInt, value)
+		_node.FreeRecoveriesPerConnection = value
+	}
+	if value, ok := _c.mutation.PurchasableReveals(); ok {
+		_spec.SetField(tierentitlement.FieldPurchasableReveals, field.TypeInt, value)
+		_node.PurchasableReveals = value
+	}
+	if value, ok := _c.mutation.RevealDays(); ok {
+		_spec.SetField(tierentitlement.FieldRevealDays, field.TypeJSON, value)
+		_node.RevealDays = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(tierentitlement.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TierEntitlement.Create().
+//		SetTier(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TierEntitlementUpsert) {
+//			SetTier(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *TierEntitlementCreate) OnConflict(opts ...sql.ConflictOption) *TierEntitlementUpsertOne {
+	_c.conflict = opts
+	return &TierEntitlementUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TierEntitlement.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *TierEntitlementCreate) OnConflictColumns(columns ...string) *TierEntitlementUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &TierEntitlementUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// TierEntitlementUpsertOne is the builder for "upsert"-ing
+	//  one TierEntitlement node.
+	TierEntitlementUpsertOne struct {
+		create *TierEntitlementCreate
+	}
+
+	// TierEntitlementUpsert is the "OnConflict" setter.
+	TierEntitlementUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetConnectionSlots sets the "connection_slots" field.
+func (u *TierEntitlementUpsert) SetConnectionSlots(v int) *TierEntitlementUpsert {
+	u.Set(tierentitlement.FieldConnectionSlots, v)
+	return u
+}
+
+// UpdateConnectionSlots sets the "connection_slots" field to the value that was provided on create.
+func (u *TierEntitlementUpsert) UpdateConnectionSlots() *TierEntitlementUpsert {
+	u.SetExcluded(tierentitlement.FieldConnectionSlots)
+	return u
+}
+
+// AddConnectionSlots adds v to the "connection_slots" field.
+func (u *TierEntitlementUpsert) AddConnectionSlots(v int) *TierEntitlementUpsert {
+	u.Add(tierentitlement.FieldConnectionSlots, v)
+	return u
+}
+
+// SetRefreshCooldownMinutes sets the "refresh_cooldown_minutes" field.
+func (u *TierEntitlementUpsert) SetRefreshCooldownMinutes(v int) *TierEntitlementUpsert {
+	u.Set(tierentitlement.FieldRefreshCooldownMinutes, v)
+	return u
+}
+
+// UpdateRefreshCooldownMinutes sets the "refresh_cooldown_minutes" field to the value that was provided on create.
+func (u *TierEntitlementUpsert) UpdateRefreshCooldownMinutes() *TierEntitlementUpsert {
+	u.SetExcluded(tierentitlement.FieldRefreshCooldownMinutes)
+	return u
+}
+
+// AddRefreshCooldownMinutes adds v to the "refresh_cooldown_minutes" field.
+func (u *TierEntitlementUpsert) AddRefreshCooldownMinutes(v int) *TierEntitlementUpsert {
+	u.Add(tierentitlement.FieldRefreshCooldownMinutes, v)
+	return u
+}
+
+// SetNudgesPerAtRisk sets the "nudges_per_at_risk" field.
+func (u *TierEntitlementUpsert) SetNudgesPerAtRisk(v int) *TierEntitlementUpsert {
+	u.Set(tierentitlement.FieldNudgesPerAtRisk, v)
+	return u
+}
+
+// UpdateNudgesPerAtRisk sets the "nudges_per_at_risk" field to the value that was provided on create.
+func (u *TierEntitlementUpsert) UpdateNudgesPerAtRisk() *TierEntitlementUpsert {
+	u.SetExcluded(tierentitlement.FieldNudgesPerAtRisk)
+	return u
+}
+
+// AddNudgesPerAtRisk adds v to the "nudges_per_at_risk" field.
+func (u *TierEntitlementUpsert) AddNudgesPerAtRisk(v int) *TierEntitlementUpsert {
+	u.Add(tierentitlement.FieldNudgesPerAtRisk, v)
+	return u
+}
+
+// SetNudgeIntervalMinutes sets the "nudge_interval_minutes" field.
+func (u *TierEntitlementUpsert) SetNudgeIntervalMinutes(v int) *TierEntitlementUpsert {
+	u.Set(tierentitlement.FieldNudgeIntervalMinutes, v)
+	return u
+}
+
+// UpdateNudgeIntervalMinutes sets the "nudge_interval_minutes" field to the value that was provided on create.
+func (u *TierEntitlementUpsert) UpdateNudgeIntervalMinutes() *TierEntitlementUpsert {
+	u.SetExcluded(tierentitlement.FieldNudgeIntervalMinutes)
+	return u
+}
+
+// AddNudgeIntervalMinutes adds v to the "nudge_interval_minutes" field.
+func (u *TierEntitlementUpsert) AddNudgeIntervalMinutes(v int) *TierEntitlementUpsert {
+	u.Add(tierentitlement.FieldNudgeIntervalMinutes, v)
+	return u
+}
+
+// SetFreeRecoveriesPerConnection sets the "free_recoveries_per_connection" field.
+func (u *TierEntitlementUpsert) SetFreeRecoveriesPerConnection(v int) *TierEntitlementUpsert {
+	u.Set(tierentitlement.FieldFreeRecoveriesPerConnection, v)
+	return u
+}
+
+// UpdateFreeRecoveriesPerConnection sets the "free_recoveries_per_connection" field to the value that was provided on create.
+func (u *TierEntitlementUpsert) UpdateFreeRecoveriesPerConnection() *TierEntitlementUpsert {
+	u.SetExcluded(tierentitlement.FieldFreeRecoveriesPerConnection)
+	return u
+}
+
+// AddFreeRecoveriesPerConnection adds v to the "free_recoveries_per_connection" field.
+func (u *TierEntitlementUpsert) AddFreeRecoveriesPerConnection(v int) *TierEntitlementUpsert {
+	u.Add(tierentitlement.FieldFreeRecoveriesPerConnection, v)
+	return u
+}
+
+// SetPurchasableReveals sets the "purchasable_reveals" field.
+func (u *TierEntitlementUpsert) SetPurchasableReveals(v int) *TierEntitlementUpsert {
+	u.Set(tierentitlement.FieldPurchasableReveals, v)
+	return u
+}
+
+// UpdatePurchasableReveals sets the "purchasable_reveals" field to the value that was provided on create.
+func (u *TierEntitlementUpsert) UpdatePurchasableReveals() *TierEntitlementUpsert {
+	u.SetExcluded(tierentitlement.FieldPurchasableReveals)
+	return u
+}
+
+// AddPurchasableReveals adds v to the "purchasable_reveals" field.
+func (u *TierEntitlementUpsert) AddPurchasableReveals(v int) *TierEntitlementUpsert {
+	u.Add(tierentitlement.FieldPurchasableReveals, v)
+	return u
+}
+
+// SetRevealDays sets the "reveal_days" field.
+func (u *TierEntitlementUpsert) SetRevealDays(v []int) *TierEntitlementUpsert {
+	u.Set(tierentitlement.FieldRevealDays, v)
+	return u
+}
+
+// UpdateRevealDays sets the "reveal_days" field to the value that was provided on create.
+func (u *TierEntitlementUpsert) UpdateRevealDays() *TierEntitlementUpsert {
+	u.SetExcluded(tierentitlement.FieldRevealDays)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TierEntitlementUpsert) SetUpdatedAt(v time.Time) *TierEntitlementUpsert {
+	u.Set(tierentitlement.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TierEntitlementUpsert) UpdateUpdatedAt() *TierEntitlementUpsert {
+	u.SetExcluded(tierentitlement.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.TierEntitlement.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(tierentitlement.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TierEntitlementUpsertOne) UpdateNewValues() *TierEntitlementUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(tierentitlement.FieldID)
+		}
+		if _, exists := u.create.mutation.Tier(); exists {
+			s.SetIgnore(tierentitlement.FieldTier)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TierEntitlement.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TierEntitlementUpsertOne) Ignore() *TierEntitlementUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TierEntitlementUpsertOne) DoNothing() *TierEntitlementUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TierEntitlementCreate.OnConflict
+// documentation for more info.
+func (u *TierEntitlementUpsertOne) Update(set func(*TierEntitlementUpsert)) *TierEntitlementUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TierEntitlementUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetConnectionSlots sets the "connection_slots" field.
+func (u *TierEntitlementUpsertOne) SetConnectionSlots(v int) *TierEntitlementUpsertOne {
+	return u.Update(func(s *TierEntitlementUpsert) {
+		s.SetConnectionSlots(v)
+	})
+}
+
+// AddConnectionSlots adds v to the "connection_slots" field.
+func (u *TierEntitlementUpsertOne) AddConnectionSlots(v int) *TierEntitlementUpsertOne {
+	return u.Update(func(s *TierEntitlementUpsert) {
+		s.AddConnectionSlots(v)
+	})
+}
+
+// UpdateConnectionSlots sets the "connection_slots" field to the value that was provided on create.
+func (u *TierEntitlementUpsertOne) UpdateConnectionSlots() *TierEntitlementUpsertOne {
+	return u.Update(func(s *TierEntitlementUpsert) {
+		s.UpdateConnectionSlots()
+	})
+}
+
+// SetRefreshCooldownMinutes sets the "refresh_cooldown_minutes" field.
+func (u *TierEntitlementUpsertOne) SetRefreshCooldownMinutes(v int) *TierEntitlementUpsertOne {
+	return u.Update(func(s *TierEntitlementUpsert) {
+		s.SetRefreshCooldownMinutes(v)
+	})
+}
+
+// AddRefreshCooldownMinutes adds v to the "refresh_cooldown_minutes" field.
+func (u *TierEntitlementUpsertOne) AddRefreshCooldownMinutes(v int) *TierEntitlementUpsertOne {
+	return u.Update(func(s *TierEntitlementUpsert) {
+		s.AddRefreshCooldownMinutes(v)
+	})
+}
+
+// UpdateRefreshCooldownMinutes sets the "refresh_cooldown_minutes" field to the value that was provided on create.
+func (u *TierEntitlementUpsertOne) UpdateRefreshCooldownMinutes() *TierEntitlementUpsertOne {
+	return u.Update(func(s *TierEntitlementUpsert) {
+		s.UpdateRefreshCooldownMinutes()
+	})
+}
+
+// SetNudgesPerAtRisk sets the "nudges_per_at_risk" field.
+func (u *TierEntitlementUpsertOne) SetNudgesPerAtRisk(v int) *TierEntitlementUpsertOne {
+	return u.Update(func(s *TierEntitlementUpsert) {
+		s.SetNudgesPerAtRisk(v)
+	})
+}
+
+// AddNudgesPerAtRisk adds v to the "nudges_per_at_risk" field.
+func (u *TierEntitlementUpsertOne) AddNudgesPerAtRisk(v int) *TierEntitlementUpsertOne {
+	return u.Update(func(s *TierEntitlementUpsert) {
+		s.AddNudgesPerAtRisk(v)
+	})
+}
+
+// UpdateNudgesPerAtRisk sets the "nudges_per_at_risk" field to the value that was provided on create.
+func (u *TierEntitlementUpsertOne) UpdateNudgesPerAtRisk() *TierEntitlementUpsertOne {
+	return u.Update(func(s *TierEntitlementUpsert) {
+		s.UpdateNudgesPerAtRisk()
+	})
+}
+
+// SetNudgeIntervalMinutes sets the "nudge_interval_minutes" field.
+func (u *TierEntitlementUpsertOne) SetNudgeIntervalMinutes(v int) *TierEntitlementUpsertOne {
+	return u.Update(func(s *TierEntitlementUpsert) {
+		s.SetNudgeIntervalMinutes(v)
+	})
+}
+
+// AddNudgeIntervalMinutes adds v to the "nudge_interval_minutes" field.
+func (u *TierEntitlementUpsertOne) AddNudgeIntervalMinutes(v int) *TierEntitlementUpsertOne {
+	return u.Update(func(s *TierEntitlementUpsert) {
+		s.AddNudgeIntervalMinutes(v)
+	})
+}
+
+// UpdateNudgeIntervalMinutes sets the "nudge_interval_minutes" field to the value that was provided on create.
+func (u *TierEntitlementUpsertOne) UpdateNudgeIntervalMinutes() *TierEntitlementUpsertOne {
+	return u.Update(func(s *TierEntitlementUpsert) {
+		s.UpdateNudgeIntervalMinutes()
+	})
+}
+
+// SetFreeRecoveriesPerConnection sets the "free_recoveries_per_connection" field.
+func (u *TierEntitlementUpsertOne) SetFreeRecoveriesPerConnection(v int) *TierEntitlementUpsertOne {
+	return u.Update(func(s *TierEntitlementUpsert) {
+		s.SetFreeRecoveriesPerConnection(v)
+	})
+}
+
+// AddFreeRecoveriesPerConnection adds v to the "free_recoveries_per_connection" field.
+func (u *TierEntitlementUpsertOne) AddFreeRecoveriesPerConnection(v int) *TierEntitlementUpsertOne {
+	return u.Update(func(s *TierEntitlementUpsert) {
+		s.AddFreeRecoveriesPerConnection(v)
+	})
+}
+
+// UpdateFreeRecoveriesPerConnection sets the "free_recoveries_per_connection" field to the value that was provided on create.
+func (u *TierEntitlementUpsertOne) UpdateFreeRecoveriesPerConnection() *TierEntitlementUpsertOne {
+	return u.Update(func(s *TierEntitlementUpsert) {
+		s.UpdateFreeRecoveriesPerConnection()
+	})
+}
+
+// SetPurchasableReveals sets the "purchasable_reveals" field.
+func (u *TierEntitlementUpsertOne) SetPurchasableReveals(v int) *TierEntitlementUpsertOne {
+	return u.Update(func(s *TierEntitlementUpsert) {
+		s.SetPurchasableReveals(v)
+	})
+}
+
+// AddPurchasableReveals adds v to the "purchasable_reveals" field.
+func (u *TierEntitlementUpsertOne) AddPurchasableReveals(v int) *TierEntitlementUpsertOne {
+	return u.Update(func(s *TierEntitlementUpsert) {
+		s.AddPurchasableReveals(v)
+	})
+}
+
+// UpdatePurchasableReveals sets the "purchasable_reveals" field to the value that was provided on create.
+func (u *TierEntitlementUpsertOne) UpdatePurchasableReveals() *TierEntitlementUpsertOne {
+	return u.Update(func(s *TierEntitlementUpsert) {
+		s.UpdatePurchasableReveals()
+	})
+}
+
+// SetRevealDays sets the "reveal_days" field.
+func (u *TierEntitlementUpsertOne) SetRevealDays(v []int) *TierEntitlementUpsertOne {
+	return u.Update(func(s *TierEntitlementUpsert) {
+		s.SetRevealDays(v)
+	})
+}
+
+// UpdateRevealDays sets the "reveal_days" field to the value that was provided on create.
+func (u *TierEntitlementUpsertOne) UpdateRevealDays() *TierEntitlementUpsertOne {
+	return u.Update(func(s *TierEntitlementUpsert) {
+		s.UpdateRevealDays()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TierEntitlementUpsertOne) SetUpdatedAt(v time.Time) *TierEntitlementUpsertOne {
+	return u.Update(func(s *TierEntitlementUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TierEntitlementUpsertOne) UpdateUpdatedAt() *TierEntitlementUpsertOne {
+	return u.Update(func(s *TierEntitlementUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *TierEntitlementUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("generated: missing options for TierEntitlementCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TierEntitlementUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TierEntitlementUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("generated: TierEntitlementUpsertOne.ID is not supported by MySQL driver. Use TierEntitlementUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TierEntitlementUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TierEntitlementCreateBulk is the builder for creating many TierEntitlement entities in bulk.
+type TierEntitlementCreateBulk struct {
+	config
+	err      error
+	builders []*TierEntitlementCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the TierEntitlement entities in the database.
+func (_c *TierEntitlementCreateBulk) Save(ctx context.Context) ([]*TierEntitlement, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TierEntitlement, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TierEntitlementMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TierEntitlementCreateBulk) SaveX(ctx context.Context) []*TierEntitlement {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TierEntitlementCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TierEntitlementCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TierEntitlement.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TierEntitlementUpsert) {
+//			SetTier(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *TierEntitlementCreateBulk) OnConflict(opts ...sql.ConflictOption) *TierEntitlementUpsertBulk {
+	_c.conflict = opts
+	return &TierEntitlementUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TierEntitlement.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *TierEntitlementCreateBulk) OnConflictColumns(columns ...string) *TierEntitlementUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &TierEntitlementUpsertBulk{
+		create: _c,
+	}
+}
+
+// TierEntitlementUpsertBulk is the builder for "upsert"-ing
+// a bulk of TierEntitlement nodes.
+type TierEntitlementUpsertBulk struct {
+	create *TierEntitlementCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TierEntitlement.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(tierentitlement.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TierEntitlementUpsertBulk) UpdateNewValues() *TierEntitlementUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(tierentitlement.FieldID)
+			}
+			if _, exists := b.mutation.Tier(); exists {
+				s.SetIgnore(tierentitlement.FieldTier)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TierEntitlement.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TierEntitlementUpsertBulk) Ignore() *TierEntitlementUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TierEntitlementUpsertBulk) DoNothing() *TierEntitlementUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TierEntitlementCreateBulk.OnConflict
+// documentation for more info.
+func (u *TierEntitlementUpsertBulk) Update(set func(*TierEntitlementUpsert)) *TierEntitlementUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TierEntitlementUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetConnectionSlots sets the "connection_slots" field.
+func (u *TierEntitlementUpsertBulk) SetConnectionSlots(v int) *TierEntitlementUpsertBulk {
+	return u.Update(func(s *TierEntitlementUpsert) {
+		s.SetConnectionSlots(v)
+	})
+}
+
+// AddConnectionSlots adds v to the "connection_slots" field.
+func (u *TierEntitlementUpsertBulk) AddConnectionSlots(v int) *TierEntitlementUpsertBulk {
+	return u.Update(func(s *TierEntitlementUpsert) {
+		s.AddConnectionSlots(v)
+	})
+}
+
+// UpdateConnectionSlots sets the "connection_slots" field to the value that was provided on create.
+func (u *TierEntitlementUpsertBulk) UpdateConnectionSlots() *TierEntitlementUpsertBulk {
+	return u.Update(func(s *TierEntitlementUpsert) {
+		s.UpdateConnectionSlots()
+	})
+}
+
+// SetRefreshCooldownMinutes sets the "refresh_cooldown_minutes" field.
+func (u *TierEntitlementUpsertBulk) SetRefreshCooldownMinutes(v int) *TierEntitlementUpsertBulk {
+	return u.Update(func(s *TierEntitlementUpsert) {
+		s.SetRefreshCooldownMinutes(v)
+	})
+}
+
+// AddRefreshCooldownMinutes adds v to the "refresh_cooldown_minutes" field.
+func (u *TierEntitlementUpsertBulk) AddRefreshCooldownMinutes(v int) *TierEntitlementUpsertBulk {
+	return u.Update(func(s *TierEntitlementUpsert) {
+		s.AddRefreshCooldownMinutes(v)
+	})
+}
+
+// UpdateRefreshCooldownMinutes sets the "refresh_cooldown_minutes" field to the value that was provided on create.
+func (u *TierEntitlementUpsertBulk) UpdateRefreshCooldownMinutes() *TierEntitlementUpsertBulk {
+	return u.Update(func(s *TierEntitlementUpsert) {
+		s.UpdateRefreshCooldownMinutes()
+	})
+}
+
+// SetNudgesPerAtRisk sets the "nudges_per_at_risk" field.
+func (u *TierEntitlementUpsertBulk) SetNudgesPerAtRisk(v int) *TierEntitlementUpsertBulk {
+	return u.Update(func(s *TierEntitlementUpsert) {
+		s.SetNudgesPerAtRisk(v)
+	})
+}
+
+// AddNudgesPerAtRisk adds v to the "nudges_per_at_risk" field.
+func (u *TierEntitlementUpsertBulk) AddNudgesPerAtRisk(v int) *TierEntitlementUpsertBulk {
+	return u.Update(func(s *TierEntitlementUpsert) {
+		s.AddNudgesPerAtRisk(v)
+	})
+}
+
+// UpdateNudgesPerAtRisk sets the "nudges_per_at_risk" field to the value that was provided on create.
+func (u *TierEntitlementUpsertBulk) UpdateNudgesPerAtRisk() *TierEntitlementUpsertBulk {
+	return u.Update(func(s *TierEntitlementUpsert) {
+		s.UpdateNudgesPerAtRisk()
+	})
+}
+
+// SetNudgeIntervalMinutes sets the "nudge_interval_minutes" field.
+func (u *TierEntitlementUpsertBulk) SetNudgeIntervalMinutes(v int) *TierEntitlementUpsertBulk {
+	return u.Update(func(s *TierEntitlementUpsert) {
+		s.SetNudgeIntervalMinutes(v)
+	})
+}
+
+// AddNudgeIntervalMinutes adds v to the "nudge_interval_minutes" field.
+func (u *TierEntitlementUpsertBulk) AddNudgeIntervalMinutes(v int) *TierEntitlementUpsertBulk {
+	return u.Update(func(s *TierEntitlementUpsert) {
+		s.AddNudgeIntervalMinutes(v)
+	})
+}
+
+// UpdateNudgeIntervalMinutes sets the "nudge_interval_minutes" field to the value that was provided on create.
+func (u *TierEntitlementUpsertBulk) UpdateNudgeIntervalMinutes() *TierEntitlementUpsertBulk {
+	return u.Update(func(s *TierEntitlementUpsert) {
+		s.UpdateNudgeIntervalMinutes()
+	})
+}
+
+// SetFreeRecoveriesPerConnection sets the "free_recoveries_per_connection" field.
+func (u *TierEntitlementUpsertBulk) SetFreeRecoveriesPerConnection(v int) *TierEntitlementUpsertBulk {
+	return u.Update(func(s *TierEntitlementUpsert) {
+		s.SetFreeRecoveriesPerConnection(v)
+	})
+}
+
+// AddFreeRecoveriesPerConnection adds v to the "free_recoveries_per_connection" field.
+func (u *TierEntitlementUpsertBulk) AddFreeRecoveriesPerConnection(v int) *TierEntitlementUpsertBulk {
+	return u.Update(func(s *TierEntitlementUpsert) {
+		s.AddFreeRecoveriesPerConnection(v)
+	})
+}
+
+// UpdateFreeRecoveriesPerConnection sets the "free_recoveries_per_connection" field to the value that was provided on create.
+func (u *TierEntitlementUpsertBulk) UpdateFreeRecoveriesPerConnection() *TierEntitlementUpsertBulk {
+	return u.Update(func(s *TierEntitlementUpsert) {
+		s.UpdateFreeRecoveriesPerConnection()
+	})
+}
+
+// SetPurchasableReveals sets the "purchasable_reveals" field.
+func (u *TierEntitlementUpsertBulk) SetPurchasableReveals(v int) *TierEntitlementUpsertBulk {
+	return u.Update(func(s *TierEntitlementUpsert) {
+		s.SetPurchasableReveals(v)
+	})
+}
+
+// AddPurchasableReveals adds v to the "purchasable_reveals" field.
+func (u *TierEntitlementUpsertBulk) AddPurchasableReveals(v int) *TierEntitlementUpsertBulk {
+	return u.Update(func(s *TierEntitlementUpsert) {
+		s.AddPurchasableReveals(v)
+	})
+}
+
+// UpdatePurchasableReveals sets the "purchasable_reveals" field to the value that was provided on create.
+func (u *TierEntitlementUpsertBulk) UpdatePurchasableReveals() *TierEntitlementUpsertBulk {
+	return u.Update(func(s *TierEntitlementUpsert) {
+		s.UpdatePurchasableReveals()
+	})
+}
+
+// SetRevealDays sets the "reveal_days" field.
+func (u *TierEntitlementUpsertBulk) SetRevealDays(v []int) *TierEntitlementUpsertBulk {
+	return u.Update(func(s *TierEntitlementUpsert) {
+		s.SetRevealDays(v)
+	})
+}
+
+// UpdateRevealDays sets the "reveal_days" field to the value that was provided on create.
+func (u *TierEntitlementUpsertBulk) UpdateRevealDays() *TierEntitlementUpsertBulk {
+	return u.Update(func(s *TierEntitlementUpsert) {
+		s.UpdateRevealDays()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TierEntitlementUpsertBulk) SetUpdatedAt(v time.Time) *TierEntitlementUpsertBulk {
+	return u.Update(func(s *TierEntitlementUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TierEntitlementUpsertBulk) UpdateUpdatedAt() *TierEntitlementUpsertBulk {
+	return u.Update(func(s *TierEntitlementUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *TierEntitlementUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("generated: OnConflict was set for builder %d. Set it on the TierEntitlementCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("generated: missing options for TierEntitlementCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TierEntitlementUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
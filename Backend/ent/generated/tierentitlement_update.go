@@ -0,0 +1,653 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/UnoraApp/be/ent/generated/predicate"
+	"github.com/UnoraApp/be/ent/generated/tierentitlement"
+)
+
+// TierEntitlementUpdate is the builder for updating TierEntitlement entities.
+type TierEntitlementUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TierEntitlementMutation
+}
+
+// Where appends a list predicates to the TierEntitlementUpdate builder.
+func (_u *TierEntitlementUpdate) Where(ps ...predicate.TierEntitlement) *TierEntitlementUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetConnectionSlots sets the "connection_slots" field.
+func (_u *TierEntitlementUpdate) SetConnectionSlots(v int) *TierEntitlementUpdate {
+	_u.mutation.ResetConnectionSlots()
+	_u.mutation.SetConnectionSlots(v)
+	return _u
+}
+
+// SetNillableConnectionSlots sets the "connection_slots" field if the given value is not nil.
+func (_u *TierEntitlementUpdate) SetNillableConnectionSlots(v *int) *TierEntitlementUpdate {
+	if v != nil {
+		_u.SetConnectionSlots(*v)
+	}
+	return _u
+}
+
+// AddConnectionSlots adds value to the "connection_slots" field.
+func (_u *TierEntitlementUpdate) AddConnectionSlots(v int) *TierEntitlementUpdate {
+	_u.mutation.AddConnectionSlots(v)
+	return _u
+}
+
+// SetRefreshCooldownMinutes sets the "refresh_cooldown_minutes" field.
+func (_u *TierEntitlementUpdate) SetRefreshCooldownMinutes(v int) *TierEntitlementUpdate {
+	_u.mutation.ResetRefreshCooldownMinutes()
+	_u.mutation.SetRefreshCooldownMinutes(v)
+	return _u
+}
+
+// SetNillableRefreshCooldownMinutes sets the "refresh_cooldown_minutes" field if the given value is not nil.
+func (_u *TierEntitlementUpdate) SetNillableRefreshCooldownMinutes(v *int) *TierEntitlementUpdate {
+	if v != nil {
+		_u.SetRefreshCooldownMinutes(*v)
+	}
+	return _u
+}
+
+// AddRefreshCooldownMinutes adds value to the "refresh_cooldown_minutes" field.
+func (_u *TierEntitlementUpdate) AddRefreshCooldownMinutes(v int) *TierEntitlementUpdate {
+	_u.mutation.AddRefreshCooldownMinutes(v)
+	return _u
+}
+
+// SetNudgesPerAtRisk sets the "nudges_per_at_risk" field.
+func (_u *TierEntitlementUpdate) SetNudgesPerAtRisk(v int) *TierEntitlementUpdate {
+	_u.mutation.ResetNudgesPerAtRisk()
+	_u.mutation.SetNudgesPerAtRisk(v)
+	return _u
+}
+
+// SetNillableNudgesPerAtRisk sets the "nudges_per_at_risk" field if the given value is not nil.
+func (_u *TierEntitlementUpdate) SetNillableNudgesPerAtRisk(v *int) *TierEntitlementUpdate {
+	if v != nil {
+		_u.SetNudgesPerAtRisk(*v)
+	}
+	return _u
+}
+
+// AddNudgesPerAtRisk adds value to the "nudges_per_at_risk" field.
+func (_u *TierEntitlementUpdate) AddNudgesPerAtRisk(v int) *TierEntitlementUpdate {
+	_u.mutation.AddNudgesPerAtRisk(v)
+	return _u
+}
+
+// SetNudgeIntervalMinutes sets the "nudge_interval_minutes" field.
+func (_u *TierEntitlementUpdate) SetNudgeIntervalMinutes(v int) *TierEntitlementUpdate {
+	_u.mutation.ResetNudgeIntervalMinutes()
+	_u.mutation.SetNudgeIntervalMinutes(v)
+	return _u
+}
+
+// SetNillableNudgeIntervalMinutes sets the "nudge_interval_minutes" field if the given value is not nil.
+func (_u *TierEntitlementUpdate) SetNillableNudgeIntervalMinutes(v *int) *TierEntitlementUpdate {
+	if v != nil {
+		_u.SetNudgeIntervalMinutes(*v)
+	}
+	return _u
+}
+
+// AddNudgeIntervalMinutes adds value to the "nudge_interval_minutes" field.
+func (_u *TierEntitlementUpdate) AddNudgeIntervalMinutes(v int) *TierEntitlementUpdate {
+	_u.mutation.AddNudgeIntervalMinutes(v)
+	return _u
+}
+
+// SetFreeRecoveriesPerConnection sets the "free_recoveries_per_connection" field.
+func (_u *TierEntitlementUpdate) SetFreeRecoveriesPerConnection(v int) *TierEntitlementUpdate {
+	_u.mutation.ResetFreeRecoveriesPerConnection()
+	_u.mutation.SetFreeRecoveriesPerConnection(v)
+	return _u
+}
+
+// SetNillableFreeRecoveriesPerConnection sets the "free_recoveries_per_connection" field if the given value is not nil.
+func (_u *TierEntitlementUpdate) SetNillableFreeRecoveriesPerConnection(v *int) *TierEntitlementUpdate {
+	if v != nil {
+		_u.SetFreeRecoveriesPerConnection(*v)
+	}
+	return _u
+}
+
+// AddFreeRecoveriesPerConnection adds value to the "free_recoveries_per_connection" field.
+func (_u *TierEntitlementUpdate) AddFreeRecoveriesPerConnection(v int) *TierEntitlementUpdate {
+	_u.mutation.AddFreeRecoveriesPerConnection(v)
+	return _u
+}
+
+// SetPurchasableReveals sets the "purchasable_reveals" field.
+func (_u *TierEntitlementUpdate) SetPurchasableReveals(v int) *TierEntitlementUpdate {
+	_u.mutation.ResetPurchasableReveals()
+	_u.mutation.SetPurchasableReveals(v)
+	return _u
+}
+
+// SetNillablePurchasableReveals sets the "purchasable_reveals" field if the given value is not nil.
+func (_u *TierEntitlementUpdate) SetNillablePurchasableReveals(v *int) *TierEntitlementUpdate {
+	if v != nil {
+		_u.SetPurchasableReveals(*v)
+	}
+	return _u
+}
+
+// AddPurchasableReveals adds value to the "purchasable_reveals" field.
+func (_u *TierEntitlementUpdate) AddPurchasableReveals(v int) *TierEntitlementUpdate {
+	_u.mutation.AddPurchasableReveals(v)
+	return _u
+}
+
+// SetRevealDays sets the "reveal_days" field.
+func (_u *TierEntitlementUpdate) SetRevealDays(v []int) *TierEntitlementUpdate {
+	_u.mutation.SetRevealDays(v)
+	return _u
+}
+
+// AppendRevealDays appends value to the "reveal_days" field.
+func (_u *TierEntitlementUpdate) AppendRevealDays(v []int) *TierEntitlementUpdate {
+	_u.mutation.AppendRevealDays(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *TierEntitlementUpdate) SetUpdatedAt(v time.Time) *TierEntitlementUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the TierEntitlementMutation object of the builder.
+func (_u *TierEntitlementUpdate) Mutation() *TierEntitlementMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TierEntitlementUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TierEntitlementUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TierEntitlementUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TierEntitlementUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *TierEntitlementUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := tierentitlement.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TierEntitlementUpdate) check() error {
+	if v, ok := _u.mutation.ConnectionSlots(); ok {
+		if err := tierentitlement.ConnectionSlotsValidator(v); err != nil {
+			return &ValidationError{Name: "connection_slots", err: fmt.Errorf(`generated: validator failed for field "TierEntitlement.connection_slots": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RefreshCooldownMinutes(); ok {
+		if err := tierentitlement.RefreshCooldownMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "refresh_cooldown_minutes", err: fmt.Errorf(`generated: validator failed for field "TierEntitlement.refresh_cooldown_minutes": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.NudgesPerAtRisk(); ok {
+		if err := tierentitlement.NudgesPerAtRiskValidator(v); err != nil {
+			return &ValidationError{Name: "nudges_per_at_risk", err: fmt.Errorf(`generated: validator failed for field "TierEntitlement.nudges_per_at_risk": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.NudgeIntervalMinutes(); ok {
+		if err := tierentitlement.NudgeIntervalMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "nudge_interval_minutes", err: fmt.Errorf(`generated: validator failed for field "TierEntitlement.nudge_interval_minutes": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.FreeRecoveriesPerConnection(); ok {
+		if err := tierentitlement.FreeRecoveriesPerConnectionValidator(v); err != nil {
+			return &ValidationError{Name: "free_recoveries_per_connection", err: fmt.Errorf(`generated: validator failed for field "TierEntitlement.free_recoveries_per_connection": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PurchasableReveals(); ok {
+		if err := tierentitlement.PurchasableRevealsValidator(v); err != nil {
+			return &ValidationError{Name: "purchasable_reveals", err: fmt.Errorf(`generated: validator failed for field "TierEntitlement.purchasable_reveals": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *TierEntitlementUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tierentitlement.Table, tierentitlement.Columns, sqlgraph.NewFieldSpec(tierentitlement.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ConnectionSlots(); ok {
+		_spec.SetField(tierentitlement.FieldConnectionSlots, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedConnectionSlots(); ok {
+		_spec.AddField(tierentitlement.FieldConnectionSlots, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.RefreshCooldownMinutes(); ok {
+		_spec.SetField(tierentitlement.FieldRefreshCooldownMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRefreshCooldownMinutes(); ok {
+		_spec.AddField(tierentitlement.FieldRefreshCooldownMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.NudgesPerAtRisk(); ok {
+		_spec.SetField(tierentitlement.FieldNudgesPerAtRisk, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedNudgesPerAtRisk(); ok {
+		_spec.AddField(tierentitlement.FieldNudgesPerAtRisk, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.NudgeIntervalMinutes(); ok {
+		_spec.SetField(tierentitlement.FieldNudgeIntervalMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedNudgeIntervalMinutes(); ok {
+		_spec.AddField(tierentitlement.FieldNudgeIntervalMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.FreeRecoveriesPerConnection(); ok {
+		_spec.SetField(tierentitlement.FieldFreeRecoveriesPerConnection, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFreeRecoveriesPerConnection(); ok {
+		_spec.AddField(tierentitlement.FieldFreeRecoveriesPerConnection, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.PurchasableReveals(); ok {
+		_spec.SetField(tierentitlement.FieldPurchasableReveals, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPurchasableReveals(); ok {
+		_spec.AddField(tierentitlement.FieldPurchasableReveals, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.RevealDays(); ok {
+		_spec.SetField(tierentitlement.FieldRevealDays, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRevealDays(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, tierentitlement.FieldRevealDays, value)
+		})
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(tierentitlement.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tierentitlement.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TierEntitlementUpdateOne is the builder for updating a single TierEntitlement entity.
+type TierEntitlementUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TierEntitlementMutation
+}
+
+// SetConnectionSlots sets the "connection_slots" field.
+func (_u *TierEntitlementUpdateOne) SetConnectionSlots(v int) *TierEntitlementUpdateOne {
+	_u.mutation.ResetConnectionSlots()
+	_u.mutation.SetConnectionSlots(v)
+	return _u
+}
+
+// SetNillableConnectionSlots sets the "connection_slots" field if the given value is not nil.
+func (_u *TierEntitlementUpdateOne) SetNillableConnectionSlots(v *int) *TierEntitlementUpdateOne {
+	if v != nil {
+		_u.SetConnectionSlots(*v)
+	}
+	return _u
+}
+
+// AddConnectionSlots adds value to the "connection_slots" field.
+func (_u *TierEntitlementUpdateOne) AddConnectionSlots(v int) *TierEntitlementUpdateOne {
+	_u.mutation.AddConnectionSlots(v)
+	return _u
+}
+
+// SetRefreshCooldownMinutes sets the "refresh_cooldown_minutes" field.
+func (_u *TierEntitlementUpdateOne) SetRefreshCooldownMinutes(v int) *TierEntitlementUpdateOne {
+	_u.mutation.ResetRefreshCooldownMinutes()
+	_u.mutation.SetRefreshCooldownMinutes(v)
+	return _u
+}
+
+// SetNillableRefreshCooldownMinutes sets the "refresh_cooldown_minutes" field if the given value is not nil.
+func (_u *TierEntitlementUpdateOne) SetNillableRefreshCooldownMinutes(v *int) *TierEntitlementUpdateOne {
+	if v != nil {
+		_u.SetRefreshCooldownMinutes(*v)
+	}
+	return _u
+}
+
+// AddRefreshCooldownMinutes adds value to the "refresh_cooldown_minutes" field.
+func (_u *TierEntitlementUpdateOne) AddRefreshCooldownMinutes(v int) *TierEntitlementUpdateOne {
+	_u.mutation.AddRefreshCooldownMinutes(v)
+	return _u
+}
+
+// SetNudgesPerAtRisk sets the "nudges_per_at_risk" field.
+func (_u *TierEntitlementUpdateOne) SetNudgesPerAtRisk(v int) *TierEntitlementUpdateOne {
+	_u.mutation.ResetNudgesPerAtRisk()
+	_u.mutation.SetNudgesPerAtRisk(v)
+	return _u
+}
+
+// SetNillableNudgesPerAtRisk sets the "nudges_per_at_risk" field if the given value is not nil.
+func (_u *TierEntitlementUpdateOne) SetNillableNudgesPerAtRisk(v *int) *TierEntitlementUpdateOne {
+	if v != nil {
+		_u.SetNudgesPerAtRisk(*v)
+	}
+	return _u
+}
+
+// AddNudgesPerAtRisk adds value to the "nudges_per_at_risk" field.
+func (_u *TierEntitlementUpdateOne) AddNudgesPerAtRisk(v int) *TierEntitlementUpdateOne {
+	_u.mutation.AddNudgesPerAtRisk(v)
+	return _u
+}
+
+// SetNudgeIntervalMinutes sets the "nudge_interval_minutes" field.
+func (_u *TierEntitlementUpdateOne) SetNudgeIntervalMinutes(v int) *TierEntitlementUpdateOne {
+	_u.mutation.ResetNudgeIntervalMinutes()
+	_u.mutation.SetNudgeIntervalMinutes(v)
+	return _u
+}
+
+// SetNillableNudgeIntervalMinutes sets the "nudge_interval_minutes" field if the given value is not nil.
+func (_u *TierEntitlementUpdateOne) SetNillableNudgeIntervalMinutes(v *int) *TierEntitlementUpdateOne {
+	if v != nil {
+		_u.SetNudgeIntervalMinutes(*v)
+	}
+	return _u
+}
+
+// AddNudgeIntervalMinutes adds value to the "nudge_interval_minutes" field.
+func (_u *TierEntitlementUpdateOne) AddNudgeIntervalMinutes(v int) *TierEntitlementUpdateOne {
+	_u.mutation.AddNudgeIntervalMinutes(v)
+	return _u
+}
+
+// SetFreeRecoveriesPerConnection sets the "free_recoveries_per_connection" field.
+func (_u *TierEntitlementUpdateOne) SetFreeRecoveriesPerConnection(v int) *TierEntitlementUpdateOne {
+	_u.mutation.ResetFreeRecoveriesPerConnection()
+	_u.mutation.SetFreeRecoveriesPerConnection(v)
+	return _u
+}
+
+// SetNillableFreeRecoveriesPerConnection sets the "free_recoveries_per_connection" field if the given value is not nil.
+func (_u *TierEntitlementUpdateOne) SetNillableFreeRecoveriesPerConnection(v *int) *TierEntitlementUpdateOne {
+	if v != nil {
+		_u.SetFreeRecoveriesPerConnection(*v)
+	}
+	return _u
+}
+
+// AddFreeRecoveriesPerConnection adds value to the "free_recoveries_per_connection" field.
+func (_u *TierEntitlementUpdateOne) AddFreeRecoveriesPerConnection(v int) *TierEntitlementUpdateOne {
+	_u.mutation.AddFreeRecoveriesPerConnection(v)
+	return _u
+}
+
+// SetPurchasableReveals sets the "purchasable_reveals" field.
+func (_u *TierEntitlementUpdateOne) SetPurchasableReveals(v int) *TierEntitlementUpdateOne {
+	_u.mutation.ResetPurchasableReveals()
+	_u.mutation.SetPurchasableReveals(v)
+	return _u
+}
+
+// SetNillablePurchasableReveals sets the "purchasable_reveals" field if the given value is not nil.
+func (_u *TierEntitlementUpdateOne) SetNillablePurchasableReveals(v *int) *TierEntitlementUpdateOne {
+	if v != nil {
+		_u.SetPurchasableReveals(*v)
+	}
+	return _u
+}
+
+// AddPurchasableReveals adds value to the "purchasable_reveals" field.
+func (_u *TierEntitlementUpdateOne) AddPurchasableReveals(v int) *TierEntitlementUpdateOne {
+	_u.mutation.AddPurchasableReveals(v)
+	return _u
+}
+
+// SetRevealDays sets the "reveal_days" field.
+func (_u *TierEntitlementUpdateOne) SetRevealDays(v []int) *TierEntitlementUpdateOne {
+	_u.mutation.SetRevealDays(v)
+	return _u
+}
+
+// AppendRevealDays appends value to the "reveal_days" field.
+func (_u *TierEntitlementUpdateOne) AppendRevealDays(v []int) *TierEntitlementUpdateOne {
+	_u.mutation.AppendRevealDays(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *TierEntitlementUpdateOne) SetUpdatedAt(v time.Time) *TierEntitlementUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the TierEntitlementMutation object of the builder.
+func (_u *TierEntitlementUpdateOne) Mutation() *TierEntitlementMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the TierEntitlementUpdate builder.
+func (_u *TierEntitlementUpdateOne) Where(ps ...predicate.TierEntitlement) *TierEntitlementUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TierEntitlementUpdateOne) Select(field string, fields ...string) *TierEntitlementUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TierEntitlement entity.
+func (_u *TierEntitlementUpdateOne) Save(ctx context.Context) (*TierEntitlement, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TierEntitlementUpdateOne) SaveX(ctx context.Context) *TierEntitlement {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TierEntitlementUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TierEntitlementUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *TierEntitlementUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := tierentitlement.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TierEntitlementUpdateOne) check() error {
+	if v, ok := _u.mutation.ConnectionSlots(); ok {
+		if err := tierentitlement.ConnectionSlotsValidator(v); err != nil {
+			return &ValidationError{Name: "connection_slots", err: fmt.Errorf(`generated: validator failed for field "TierEntitlement.connection_slots": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RefreshCooldownMinutes(); ok {
+		if err := tierentitlement.RefreshCooldownMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "refresh_cooldown_minutes", err: fmt.Errorf(`generated: validator failed for field "TierEntitlement.refresh_cooldown_minutes": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.NudgesPerAtRisk(); ok {
+		if err := tierentitlement.NudgesPerAtRiskValidator(v); err != nil {
+			return &ValidationError{Name: "nudges_per_at_risk", err: fmt.Errorf(`generated: validator failed for field "TierEntitlement.nudges_per_at_risk": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.NudgeIntervalMinutes(); ok {
+		if err := tierentitlement.NudgeIntervalMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "nudge_interval_minutes", err: fmt.Errorf(`generated: validator failed for field "TierEntitlement.nudge_interval_minutes": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.FreeRecoveriesPerConnection(); ok {
+		if err := tierentitlement.FreeRecoveriesPerConnectionValidator(v); err != nil {
+			return &ValidationError{Name: "free_recoveries_per_connection", err: fmt.Errorf(`generated: validator failed for field "TierEntitlement.free_recoveries_per_connection": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PurchasableReveals(); ok {
+		if err := tierentitlement.PurchasableRevealsValidator(v); err != nil {
+			return &ValidationError{Name: "purchasable_reveals", err: fmt.Errorf(`generated: validator failed for field "TierEntitlement.purchasable_reveals": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *TierEntitlementUpdateOne) sqlSave(ctx context.Context) (_node *TierEntitlement, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tierentitlement.Table, tierentitlement.Columns, sqlgraph.NewFieldSpec(tierentitlement.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "TierEntitlement.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, tierentitlement.FieldID)
+		for _, f := range fields {
+			if !tierentitlement.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != tierentitlement.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ConnectionSlots(); ok {
+		_spec.SetField(tierentitlement.FieldConnectionSlots, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedConnectionSlots(); ok {
+		_spec.AddField(tierentitlement.FieldConnectionSlots, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.RefreshCooldownMinutes(); ok {
+		_spec.SetField(tierentitlement.FieldRefreshCooldownMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRefreshCooldownMinutes(); ok {
+		_spec.AddField(tierentitlement.FieldRefreshCooldownMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.NudgesPerAtRisk(); ok {
+		_spec.SetField(tierentitlement.FieldNudgesPerAtRisk, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedNudgesPerAtRisk(); ok {
+		_spec.AddField(tierentitlement.FieldNudgesPerAtRisk, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.NudgeIntervalMinutes(); ok {
+		_spec.SetField(tierentitlement.FieldNudgeIntervalMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedNudgeIntervalMinutes(); ok {
+		_spec.AddField(tierentitlement.FieldNudgeIntervalMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.FreeRecoveriesPerConnection(); ok {
+		_spec.SetField(tierentitlement.FieldFreeRecoveriesPerConnection, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFreeRecoveriesPerConnection(); ok {
+		_spec.AddField(tierentitlement.FieldFreeRecoveriesPerConnection, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.PurchasableReveals(); ok {
+		_spec.SetField(tierentitlement.FieldPurchasableReveals, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPurchasableReveals(); ok {
+		_spec.AddField(tierentitlement.FieldPurchasableReveals, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.RevealDays(); ok {
+		_spec.SetField(tierentitlement.FieldRevealDays, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRevealDays(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, tierentitlement.FieldRevealDays, value)
+		})
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(tierentitlement.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &TierEntitlement{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tierentitlement.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
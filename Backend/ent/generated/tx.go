@@ -12,16 +12,28 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// AccountDeletion is the client for interacting with the AccountDeletion builders.
+	AccountDeletion *AccountDeletionClient
+	// AdminUser is the client for interacting with the AdminUser builders.
+	AdminUser *AdminUserClient
 	// AuditLog is the client for interacting with the AuditLog builders.
 	AuditLog *AuditLogClient
+	// BalanceDrift is the client for interacting with the BalanceDrift builders.
+	BalanceDrift *BalanceDriftClient
 	// CheckIn is the client for interacting with the CheckIn builders.
 	CheckIn *CheckInClient
 	// Connection is the client for interacting with the Connection builders.
 	Connection *ConnectionClient
+	// Conversation is the client for interacting with the Conversation builders.
+	Conversation *ConversationClient
 	// CreditPackage is the client for interacting with the CreditPackage builders.
 	CreditPackage *CreditPackageClient
 	// CreditTransaction is the client for interacting with the CreditTransaction builders.
 	CreditTransaction *CreditTransactionClient
+	// DataExport is the client for interacting with the DataExport builders.
+	DataExport *DataExportClient
+	// DeviceRiskFlag is the client for interacting with the DeviceRiskFlag builders.
+	DeviceRiskFlag *DeviceRiskFlagClient
 	// DiscoveryBatch is the client for interacting with the DiscoveryBatch builders.
 	DiscoveryBatch *DiscoveryBatchClient
 	// DiscoveryCard is the client for interacting with the DiscoveryCard builders.
@@ -34,30 +46,74 @@ type Tx struct {
 	HobbyOption *HobbyOptionClient
 	// Interest is the client for interacting with the Interest builders.
 	Interest *InterestClient
+	// Invoice is the client for interacting with the Invoice builders.
+	Invoice *InvoiceClient
+	// InvoiceSequence is the client for interacting with the InvoiceSequence builders.
+	InvoiceSequence *InvoiceSequenceClient
+	// LedgerEntry is the client for interacting with the LedgerEntry builders.
+	LedgerEntry *LedgerEntryClient
+	// Message is the client for interacting with the Message builders.
+	Message *MessageClient
+	// ModerationAction is the client for interacting with the ModerationAction builders.
+	ModerationAction *ModerationActionClient
+	// Notification is the client for interacting with the Notification builders.
+	Notification *NotificationClient
 	// Nudge is the client for interacting with the Nudge builders.
 	Nudge *NudgeClient
+	// PaymentDiscrepancy is the client for interacting with the PaymentDiscrepancy builders.
+	PaymentDiscrepancy *PaymentDiscrepancyClient
 	// PaymentOrder is the client for interacting with the PaymentOrder builders.
 	PaymentOrder *PaymentOrderClient
+	// PaymentReconciliationReport is the client for interacting with the PaymentReconciliationReport builders.
+	PaymentReconciliationReport *PaymentReconciliationReportClient
+	// PaymentRefund is the client for interacting with the PaymentRefund builders.
+	PaymentRefund *PaymentRefundClient
 	// Photo is the client for interacting with the Photo builders.
 	Photo *PhotoClient
 	// Profile is the client for interacting with the Profile builders.
 	Profile *ProfileClient
+	// PromoCode is the client for interacting with the PromoCode builders.
+	PromoCode *PromoCodeClient
+	// PromoRedemption is the client for interacting with the PromoRedemption builders.
+	PromoRedemption *PromoRedemptionClient
+	// Referral is the client for interacting with the Referral builders.
+	Referral *ReferralClient
+	// ReportEvidence is the client for interacting with the ReportEvidence builders.
+	ReportEvidence *ReportEvidenceClient
 	// Reveal is the client for interacting with the Reveal builders.
 	Reveal *RevealClient
 	// RevealContent is the client for interacting with the RevealContent builders.
 	RevealContent *RevealContentClient
+	// RevealGift is the client for interacting with the RevealGift builders.
+	RevealGift *RevealGiftClient
 	// RevealMilestone is the client for interacting with the RevealMilestone builders.
 	RevealMilestone *RevealMilestoneClient
+	// RevealView is the client for interacting with the RevealView builders.
+	RevealView *RevealViewClient
 	// Server is the client for interacting with the Server builders.
 	Server *ServerClient
 	// Streak is the client for interacting with the Streak builders.
 	Streak *StreakClient
+	// Subscription is the client for interacting with the Subscription builders.
+	Subscription *SubscriptionClient
+	// SubscriptionPlan is the client for interacting with the SubscriptionPlan builders.
+	SubscriptionPlan *SubscriptionPlanClient
+	// TierEntitlement is the client for interacting with the TierEntitlement builders.
+	TierEntitlement *TierEntitlementClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 	// UserBlock is the client for interacting with the UserBlock builders.
 	UserBlock *UserBlockClient
+	// UserDevice is the client for interacting with the UserDevice builders.
+	UserDevice *UserDeviceClient
+	// UserIdentity is the client for interacting with the UserIdentity builders.
+	UserIdentity *UserIdentityClient
 	// UserReport is the client for interacting with the UserReport builders.
 	UserReport *UserReportClient
+	// UserSession is the client for interacting with the UserSession builders.
+	UserSession *UserSessionClient
+	// WebhookEvent is the client for interacting with the WebhookEvent builders.
+	WebhookEvent *WebhookEventClient
 
 	// lazily loaded.
 	client     *Client
@@ -189,29 +245,57 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.AccountDeletion = NewAccountDeletionClient(tx.config)
+	tx.AdminUser = NewAdminUserClient(tx.config)
 	tx.AuditLog = NewAuditLogClient(tx.config)
+	tx.BalanceDrift = NewBalanceDriftClient(tx.config)
 	tx.CheckIn = NewCheckInClient(tx.config)
 	tx.Connection = NewConnectionClient(tx.config)
+	tx.Conversation = NewConversationClient(tx.config)
 	tx.CreditPackage = NewCreditPackageClient(tx.config)
 	tx.CreditTransaction = NewCreditTransactionClient(tx.config)
+	tx.DataExport = NewDataExportClient(tx.config)
+	tx.DeviceRiskFlag = NewDeviceRiskFlagClient(tx.config)
 	tx.DiscoveryBatch = NewDiscoveryBatchClient(tx.config)
 	tx.DiscoveryCard = NewDiscoveryCardClient(tx.config)
 	tx.Filter = NewFilterClient(tx.config)
 	tx.Hobby = NewHobbyClient(tx.config)
 	tx.HobbyOption = NewHobbyOptionClient(tx.config)
 	tx.Interest = NewInterestClient(tx.config)
+	tx.Invoice = NewInvoiceClient(tx.config)
+	tx.InvoiceSequence = NewInvoiceSequenceClient(tx.config)
+	tx.LedgerEntry = NewLedgerEntryClient(tx.config)
+	tx.Message = NewMessageClient(tx.config)
+	tx.ModerationAction = NewModerationActionClient(tx.config)
+	tx.Notification = NewNotificationClient(tx.config)
 	tx.Nudge = NewNudgeClient(tx.config)
+	tx.PaymentDiscrepancy = NewPaymentDiscrepancyClient(tx.config)
 	tx.PaymentOrder = NewPaymentOrderClient(tx.config)
+	tx.PaymentReconciliationReport = NewPaymentReconciliationReportClient(tx.config)
+	tx.PaymentRefund = NewPaymentRefundClient(tx.config)
 	tx.Photo = NewPhotoClient(tx.config)
 	tx.Profile = NewProfileClient(tx.config)
+	tx.PromoCode = NewPromoCodeClient(tx.config)
+	tx.PromoRedemption = NewPromoRedemptionClient(tx.config)
+	tx.Referral = NewReferralClient(tx.config)
+	tx.ReportEvidence = NewReportEvidenceClient(tx.config)
 	tx.Reveal = NewRevealClient(tx.config)
 	tx.RevealContent = NewRevealContentClient(tx.config)
+	tx.RevealGift = NewRevealGiftClient(tx.config)
 	tx.RevealMilestone = NewRevealMilestoneClient(tx.config)
+	tx.RevealView = NewRevealViewClient(tx.config)
 	tx.Server = NewServerClient(tx.config)
 	tx.Streak = NewStreakClient(tx.config)
+	tx.Subscription = NewSubscriptionClient(tx.config)
+	tx.SubscriptionPlan = NewSubscriptionPlanClient(tx.config)
+	tx.TierEntitlement = NewTierEntitlementClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 	tx.UserBlock = NewUserBlockClient(tx.config)
+	tx.UserDevice = NewUserDeviceClient(tx.config)
+	tx.UserIdentity = NewUserIdentityClient(tx.config)
 	tx.UserReport = NewUserReportClient(tx.config)
+	tx.UserSession = NewUserSessionClient(tx.config)
+	tx.WebhookEvent = NewWebhookEventClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -221,7 +305,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: AuditLog.QueryXXX(), the query will be executed
+// applies a query, for example: AccountDeletion.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -80,6 +80,12 @@ type User struct {
 	SuspendedAt *time.Time `json:"suspended_at,omitempty"`
 	// SuspensionReason holds the value of the "suspension_reason" field.
 	SuspensionReason *string `json:"suspension_reason,omitempty"`
+	// ReferralCode holds the value of the "referral_code" field.
+	ReferralCode *string `json:"referral_code,omitempty"`
+	// SignupDeviceID holds the value of the "signup_device_id" field.
+	SignupDeviceID *string `json:"signup_device_id,omitempty"`
+	// PhoneHash holds the value of the "phone_hash" field.
+	PhoneHash *string `json:"phone_hash,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -126,6 +132,20 @@ type UserEdges struct {
 	CreditTransactions []*CreditTransaction `json:"credit_transactions,omitempty"`
 	// PaymentOrders holds the value of the payment_orders edge.
 	PaymentOrders []*PaymentOrder `json:"payment_orders,omitempty"`
+	// BalanceDrifts holds the value of the balance_drifts edge.
+	BalanceDrifts []*BalanceDrift `json:"balance_drifts,omitempty"`
+	// Subscriptions holds the value of the subscriptions edge.
+	Subscriptions []*Subscription `json:"subscriptions,omitempty"`
+	// RevealViews holds the value of the reveal_views edge.
+	RevealViews []*RevealView `json:"reveal_views,omitempty"`
+	// RevealGiftsSent holds the value of the reveal_gifts_sent edge.
+	RevealGiftsSent []*RevealGift `json:"reveal_gifts_sent,omitempty"`
+	// RevealGiftsReceived holds the value of the reveal_gifts_received edge.
+	RevealGiftsReceived []*RevealGift `json:"reveal_gifts_received,omitempty"`
+	// ChatMessages holds the value of the chat_messages edge.
+	ChatMessages []*Message `json:"chat_messages,omitempty"`
+	// ModerationActions holds the value of the moderation_actions edge.
+	ModerationActions []*ModerationAction `json:"moderation_actions,omitempty"`
 	// BlocksGiven holds the value of the blocks_given edge.
 	BlocksGiven []*UserBlock `json:"blocks_given,omitempty"`
 	// BlocksReceived holds the value of the blocks_received edge.
@@ -134,9 +154,11 @@ type UserEdges struct {
 	ReportsGiven []*UserReport `json:"reports_given,omitempty"`
 	// ReportsReceived holds the value of the reports_received edge.
 	ReportsReceived []*UserReport `json:"reports_received,omitempty"`
+	// Notifications holds the value of the notifications edge.
+	Notifications []*Notification `json:"notifications,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [20]bool
+	loadedTypes [28]bool
 }
 
 // ProfileOrErr returns the Profile value or an error if the edge
@@ -285,10 +307,73 @@ func (e UserEdges) PaymentOrdersOrErr() ([]*PaymentOrder, error) {
 	return nil, &NotLoadedError{edge: "payment_orders"}
 }
 
+// BalanceDriftsOrErr returns the BalanceDrifts value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) BalanceDriftsOrErr() ([]*BalanceDrift, error) {
+	if e.loadedTypes[16] {
+		return e.BalanceDrifts, nil
+	}
+	return nil, &NotLoadedError{edge: "balance_drifts"}
+}
+
+// SubscriptionsOrErr returns the Subscriptions value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) SubscriptionsOrErr() ([]*Subscription, error) {
+	if e.loadedTypes[17] {
+		return e.Subscriptions, nil
+	}
+	return nil, &NotLoadedError{edge: "subscriptions"}
+}
+
+// RevealViewsOrErr returns the RevealViews value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) RevealViewsOrErr() ([]*RevealView, error) {
+	if e.loadedTypes[18] {
+		return e.RevealViews, nil
+	}
+	return nil, &NotLoadedError{edge: "reveal_views"}
+}
+
+// RevealGiftsSentOrErr returns the RevealGiftsSent value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) RevealGiftsSentOrErr() ([]*RevealGift, error) {
+	if e.loadedTypes[19] {
+		return e.RevealGiftsSent, nil
+	}
+	return nil, &NotLoadedError{edge: "reveal_gifts_sent"}
+}
+
+// RevealGiftsReceivedOrErr returns the RevealGiftsReceived value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) RevealGiftsReceivedOrErr() ([]*RevealGift, error) {
+	if e.loadedTypes[20] {
+		return e.RevealGiftsReceived, nil
+	}
+	return nil, &NotLoadedError{edge: "reveal_gifts_received"}
+}
+
+// ChatMessagesOrErr returns the ChatMessages value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ChatMessagesOrErr() ([]*Message, error) {
+	if e.loadedTypes[21] {
+		return e.ChatMessages, nil
+	}
+	return nil, &NotLoadedError{edge: "chat_messages"}
+}
+
+// ModerationActionsOrErr returns the ModerationActions value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ModerationActionsOrErr() ([]*ModerationAction, error) {
+	if e.loadedTypes[22] {
+		return e.ModerationActions, nil
+	}
+	return nil, &NotLoadedError{edge: "moderation_actions"}
+}
+
 // BlocksGivenOrErr returns the BlocksGiven value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) BlocksGivenOrErr() ([]*UserBlock, error) {
-	if e.loadedTypes[16] {
+	if e.loadedTypes[23] {
 		return e.BlocksGiven, nil
 	}
 	return nil, &NotLoadedError{edge: "blocks_given"}
@@ -297,7 +382,7 @@ func (e UserEdges) BlocksGivenOrErr() ([]*UserBlock, error) {
 // BlocksReceivedOrErr returns the BlocksReceived value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) BlocksReceivedOrErr() ([]*UserBlock, error) {
-	if e.loadedTypes[17] {
+	if e.loadedTypes[24] {
 		return e.BlocksReceived, nil
 	}
 	return nil, &NotLoadedError{edge: "blocks_received"}
@@ -306,7 +391,7 @@ func (e UserEdges) BlocksReceivedOrErr() ([]*UserBlock, error) {
 // ReportsGivenOrErr returns the ReportsGiven value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) ReportsGivenOrErr() ([]*UserReport, error) {
-	if e.loadedTypes[18] {
+	if e.loadedTypes[25] {
 		return e.ReportsGiven, nil
 	}
 	return nil, &NotLoadedError{edge: "reports_given"}
@@ -315,12 +400,21 @@ func (e UserEdges) ReportsGivenOrErr() ([]*UserReport, error) {
 // ReportsReceivedOrErr returns the ReportsReceived value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) ReportsReceivedOrErr() ([]*UserReport, error) {
-	if e.loadedTypes[19] {
+	if e.loadedTypes[26] {
 		return e.ReportsReceived, nil
 	}
 	return nil, &NotLoadedError{edge: "reports_received"}
 }
 
+// NotificationsOrErr returns the Notifications value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) NotificationsOrErr() ([]*Notification, error) {
+	if e.loadedTypes[27] {
+		return e.Notifications, nil
+	}
+	return nil, &NotLoadedError{edge: "notifications"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -330,7 +424,7 @@ func (*User) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullFloat64)
 		case user.FieldFreeRecoveriesUsed, user.FieldNudgesSentToday, user.FieldActiveConnectionCount, user.FieldCreditBalance:
 			values[i] = new(sql.NullInt64)
-		case user.FieldID, user.FieldEmail, user.FieldPhoneNumber, user.FieldPhoneCountryCode, user.FieldProvider, user.FieldProviderUserID, user.FieldName, user.FieldFirstName, user.FieldLastName, user.FieldPicture, user.FieldGender, user.FieldCity, user.FieldEducation, user.FieldProfession, user.FieldReligion, user.FieldBio, user.FieldVerificationStatus, user.FieldSubscriptionTier, user.FieldAccountStatus, user.FieldOnboardingStatus, user.FieldSuspensionReason:
+		case user.FieldID, user.FieldEmail, user.FieldPhoneNumber, user.FieldPhoneCountryCode, user.FieldProvider, user.FieldProviderUserID, user.FieldName, user.FieldFirstName, user.FieldLastName, user.FieldPicture, user.FieldGender, user.FieldCity, user.FieldEducation, user.FieldProfession, user.FieldReligion, user.FieldBio, user.FieldVerificationStatus, user.FieldSubscriptionTier, user.FieldAccountStatus, user.FieldOnboardingStatus, user.FieldSuspensionReason, user.FieldReferralCode, user.FieldSignupDeviceID, user.FieldPhoneHash:
 			values[i] = new(sql.NullString)
 		case user.FieldDateOfBirth, user.FieldNudgesResetAt, user.FieldLastGlobalRefreshAt, user.FieldRefreshAvailableAt, user.FieldLastActiveAt, user.FieldSuspendedAt, user.FieldCreatedAt, user.FieldUpdatedAt, user.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
@@ -563,6 +657,27 @@ func (_m *User) assignValues(columns []string, values []any) error {
 				_m.SuspensionReason = new(string)
 				*_m.SuspensionReason = value.String
 			}
+		case user.FieldReferralCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field referral_code", values[i])
+			} else if value.Valid {
+				_m.ReferralCode = new(string)
+				*_m.ReferralCode = value.String
+			}
+		case user.FieldSignupDeviceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field signup_device_id", values[i])
+			} else if value.Valid {
+				_m.SignupDeviceID = new(string)
+				*_m.SignupDeviceID = value.String
+			}
+		case user.FieldPhoneHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field phone_hash", values[i])
+			} else if value.Valid {
+				_m.PhoneHash = new(string)
+				*_m.PhoneHash = value.String
+			}
 		case user.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -675,6 +790,41 @@ func (_m *User) QueryPaymentOrders() *PaymentOrderQuery {
 	return NewUserClient(_m.config).QueryPaymentOrders(_m)
 }
 
+// QueryBalanceDrifts queries the "balance_drifts" edge of the User entity.
+func (_m *User) QueryBalanceDrifts() *BalanceDriftQuery {
+	return NewUserClient(_m.config).QueryBalanceDrifts(_m)
+}
+
+// QuerySubscriptions queries the "subscriptions" edge of the User entity.
+func (_m *User) QuerySubscriptions() *SubscriptionQuery {
+	return NewUserClient(_m.config).QuerySubscriptions(_m)
+}
+
+// QueryRevealViews queries the "reveal_views" edge of the User entity.
+func (_m *User) QueryRevealViews() *RevealViewQuery {
+	return NewUserClient(_m.config).QueryRevealViews(_m)
+}
+
+// QueryRevealGiftsSent queries the "reveal_gifts_sent" edge of the User entity.
+func (_m *User) QueryRevealGiftsSent() *RevealGiftQuery {
+	return NewUserClient(_m.config).QueryRevealGiftsSent(_m)
+}
+
+// QueryRevealGiftsReceived queries the "reveal_gifts_received" edge of the User entity.
+func (_m *User) QueryRevealGiftsReceived() *RevealGiftQuery {
+	return NewUserClient(_m.config).QueryRevealGiftsReceived(_m)
+}
+
+// QueryChatMessages queries the "chat_messages" edge of the User entity.
+func (_m *User) QueryChatMessages() *MessageQuery {
+	return NewUserClient(_m.config).QueryChatMessages(_m)
+}
+
+// QueryModerationActions queries the "moderation_actions" edge of the User entity.
+func (_m *User) QueryModerationActions() *ModerationActionQuery {
+	return NewUserClient(_m.config).QueryModerationActions(_m)
+}
+
 // QueryBlocksGiven queries the "blocks_given" edge of the User entity.
 func (_m *User) QueryBlocksGiven() *UserBlockQuery {
 	return NewUserClient(_m.config).QueryBlocksGiven(_m)
@@ -695,6 +845,11 @@ func (_m *User) QueryReportsReceived() *UserReportQuery {
 	return NewUserClient(_m.config).QueryReportsReceived(_m)
 }
 
+// QueryNotifications queries the "notifications" edge of the User entity.
+func (_m *User) QueryNotifications() *NotificationQuery {
+	return NewUserClient(_m.config).QueryNotifications(_m)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -855,6 +1010,21 @@ func (_m *User) String() string {
 		builder.WriteString(*v)
 	}
 	builder.WriteString(", ")
+	if v := _m.ReferralCode; v != nil {
+		builder.WriteString("referral_code=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.SignupDeviceID; v != nil {
+		builder.WriteString("signup_device_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.PhoneHash; v != nil {
+		builder.WriteString("phone_hash=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -77,6 +77,12 @@ const (
 	FieldSuspendedAt = "suspended_at"
 	// FieldSuspensionReason holds the string denoting the suspension_reason field in the database.
 	FieldSuspensionReason = "suspension_reason"
+	// FieldReferralCode holds the string denoting the referral_code field in the database.
+	FieldReferralCode = "referral_code"
+	// FieldSignupDeviceID holds the string denoting the signup_device_id field in the database.
+	FieldSignupDeviceID = "signup_device_id"
+	// FieldPhoneHash holds the string denoting the phone_hash field in the database.
+	FieldPhoneHash = "phone_hash"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -115,6 +121,20 @@ const (
 	EdgeCreditTransactions = "credit_transactions"
 	// EdgePaymentOrders holds the string denoting the payment_orders edge name in mutations.
 	EdgePaymentOrders = "payment_orders"
+	// EdgeBalanceDrifts holds the string denoting the balance_drifts edge name in mutations.
+	EdgeBalanceDrifts = "balance_drifts"
+	// EdgeSubscriptions holds the string denoting the subscriptions edge name in mutations.
+	EdgeSubscriptions = "subscriptions"
+	// EdgeRevealViews holds the string denoting the reveal_views edge name in mutations.
+	EdgeRevealViews = "reveal_views"
+	// EdgeRevealGiftsSent holds the string denoting the reveal_gifts_sent edge name in mutations.
+	EdgeRevealGiftsSent = "reveal_gifts_sent"
+	// EdgeRevealGiftsReceived holds the string denoting the reveal_gifts_received edge name in mutations.
+	EdgeRevealGiftsReceived = "reveal_gifts_received"
+	// EdgeChatMessages holds the string denoting the chat_messages edge name in mutations.
+	EdgeChatMessages = "chat_messages"
+	// EdgeModerationActions holds the string denoting the moderation_actions edge name in mutations.
+	EdgeModerationActions = "moderation_actions"
 	// EdgeBlocksGiven holds the string denoting the blocks_given edge name in mutations.
 	EdgeBlocksGiven = "blocks_given"
 	// EdgeBlocksReceived holds the string denoting the blocks_received edge name in mutations.
@@ -123,6 +143,8 @@ const (
 	EdgeReportsGiven = "reports_given"
 	// EdgeReportsReceived holds the string denoting the reports_received edge name in mutations.
 	EdgeReportsReceived = "reports_received"
+	// EdgeNotifications holds the string denoting the notifications edge name in mutations.
+	EdgeNotifications = "notifications"
 	// Table holds the table name of the user in the database.
 	Table = "users"
 	// ProfileTable is the table that holds the profile relation/edge.
@@ -237,6 +259,55 @@ const (
 	PaymentOrdersInverseTable = "payment_orders"
 	// PaymentOrdersColumn is the table column denoting the payment_orders relation/edge.
 	PaymentOrdersColumn = "user_id"
+	// BalanceDriftsTable is the table that holds the balance_drifts relation/edge.
+	BalanceDriftsTable = "balance_drifts"
+	// BalanceDriftsInverseTable is the table name for the BalanceDrift entity.
+	// It exists in this package in order to avoid circular dependency with the "balancedrift" package.
+	BalanceDriftsInverseTable = "balance_drifts"
+	// BalanceDriftsColumn is the table column denoting the balance_drifts relation/edge.
+	BalanceDriftsColumn = "user_id"
+	// SubscriptionsTable is the table that holds the subscriptions relation/edge.
+	SubscriptionsTable = "subscriptions"
+	// SubscriptionsInverseTable is the table name for the Subscription entity.
+	// It exists in this package in order to avoid circular dependency with the "subscription" package.
+	SubscriptionsInverseTable = "subscriptions"
+	// SubscriptionsColumn is the table column denoting the subscriptions relation/edge.
+	SubscriptionsColumn = "user_id"
+	// RevealViewsTable is the table that holds the reveal_views relation/edge.
+	RevealViewsTable = "reveal_views"
+	// RevealViewsInverseTable is the table name for the RevealView entity.
+	// It exists in this package in order to avoid circular dependency with the "revealview" package.
+	RevealViewsInverseTable = "reveal_views"
+	// RevealViewsColumn is the table column denoting the reveal_views relation/edge.
+	RevealViewsColumn = "viewer_user_id"
+	// RevealGiftsSentTable is the table that holds the reveal_gifts_sent relation/edge.
+	RevealGiftsSentTable = "reveal_gifts"
+	// RevealGiftsSentInverseTable is the table name for the RevealGift entity.
+	// It exists in this package in order to avoid circular dependency with the "revealgift" package.
+	RevealGiftsSentInverseTable = "reveal_gifts"
+	// RevealGiftsSentColumn is the table column denoting the reveal_gifts_sent relation/edge.
+	RevealGiftsSentColumn = "gifter_user_id"
+	// RevealGiftsReceivedTable is the table that holds the reveal_gifts_received relation/edge.
+	RevealGiftsReceivedTable = "reveal_gifts"
+	// RevealGiftsReceivedInverseTable is the table name for the RevealGift entity.
+	// It exists in this package in order to avoid circular dependency with the "revealgift" package.
+	RevealGiftsReceivedInverseTable = "reveal_gifts"
+	// RevealGiftsReceivedColumn is the table column denoting the reveal_gifts_received relation/edge.
+	RevealGiftsReceivedColumn = "recipient_user_id"
+	// ChatMessagesTable is the table that holds the chat_messages relation/edge.
+	ChatMessagesTable = "messages"
+	// ChatMessagesInverseTable is the table name for the Message entity.
+	// It exists in this package in order to avoid circular dependency with the "message" package.
+	ChatMessagesInverseTable = "messages"
+	// ChatMessagesColumn is the table column denoting the chat_messages relation/edge.
+	ChatMessagesColumn = "sender_user_id"
+	// ModerationActionsTable is the table that holds the moderation_actions relation/edge.
+	ModerationActionsTable = "moderation_actions"
+	// ModerationActionsInverseTable is the table name for the ModerationAction entity.
+	// It exists in this package in order to avoid circular dependency with the "moderationaction" package.
+	ModerationActionsInverseTable = "moderation_actions"
+	// ModerationActionsColumn is the table column denoting the moderation_actions relation/edge.
+	ModerationActionsColumn = "user_id"
 	// BlocksGivenTable is the table that holds the blocks_given relation/edge.
 	BlocksGivenTable = "user_blocks"
 	// BlocksGivenInverseTable is the table name for the UserBlock entity.
@@ -265,6 +336,13 @@ const (
 	ReportsReceivedInverseTable = "user_reports"
 	// ReportsReceivedColumn is the table column denoting the reports_received relation/edge.
 	ReportsReceivedColumn = "reported_user_id"
+	// NotificationsTable is the table that holds the notifications relation/edge.
+	NotificationsTable = "notifications"
+	// NotificationsInverseTable is the table name for the Notification entity.
+	// It exists in this package in order to avoid circular dependency with the "notification" package.
+	NotificationsInverseTable = "notifications"
+	// NotificationsColumn is the table column denoting the notifications relation/edge.
+	NotificationsColumn = "user_id"
 )
 
 // Columns holds all SQL columns for user fields.
@@ -301,6 +379,9 @@ var Columns = []string{
 	FieldLastActiveAt,
 	FieldSuspendedAt,
 	FieldSuspensionReason,
+	FieldReferralCode,
+	FieldSignupDeviceID,
+	FieldPhoneHash,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 	FieldDeletedAt,
@@ -359,6 +440,12 @@ var (
 	DefaultCreditBalance int
 	// SuspensionReasonValidator is a validator for the "suspension_reason" field. It is called by the builders before save.
 	SuspensionReasonValidator func(string) error
+	// ReferralCodeValidator is a validator for the "referral_code" field. It is called by the builders before save.
+	ReferralCodeValidator func(string) error
+	// SignupDeviceIDValidator is a validator for the "signup_device_id" field. It is called by the builders before save.
+	SignupDeviceIDValidator func(string) error
+	// PhoneHashValidator is a validator for the "phone_hash" field. It is called by the builders before save.
+	PhoneHashValidator func(string) error
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -670,6 +757,21 @@ func BySuspensionReason(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSuspensionReason, opts...).ToFunc()
 }
 
+// ByReferralCode orders the results by the referral_code field.
+func ByReferralCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReferralCode, opts...).ToFunc()
+}
+
+// BySignupDeviceID orders the results by the signup_device_id field.
+func BySignupDeviceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSignupDeviceID, opts...).ToFunc()
+}
+
+// ByPhoneHash orders the results by the phone_hash field.
+func ByPhoneHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPhoneHash, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -902,6 +1004,104 @@ func ByPaymentOrders(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	}
 }
 
+// ByBalanceDriftsCount orders the results by balance_drifts count.
+func ByBalanceDriftsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newBalanceDriftsStep(), opts...)
+	}
+}
+
+// ByBalanceDrifts orders the results by balance_drifts terms.
+func ByBalanceDrifts(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBalanceDriftsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySubscriptionsCount orders the results by subscriptions count.
+func BySubscriptionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSubscriptionsStep(), opts...)
+	}
+}
+
+// BySubscriptions orders the results by subscriptions terms.
+func BySubscriptions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSubscriptionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByRevealViewsCount orders the results by reveal_views count.
+func ByRevealViewsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRevealViewsStep(), opts...)
+	}
+}
+
+// ByRevealViews orders the results by reveal_views terms.
+func ByRevealViews(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRevealViewsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByRevealGiftsSentCount orders the results by reveal_gifts_sent count.
+func ByRevealGiftsSentCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRevealGiftsSentStep(), opts...)
+	}
+}
+
+// ByRevealGiftsSent orders the results by reveal_gifts_sent terms.
+func ByRevealGiftsSent(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRevealGiftsSentStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByRevealGiftsReceivedCount orders the results by reveal_gifts_received count.
+func ByRevealGiftsReceivedCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRevealGiftsReceivedStep(), opts...)
+	}
+}
+
+// ByRevealGiftsReceived orders the results by reveal_gifts_received terms.
+func ByRevealGiftsReceived(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRevealGiftsReceivedStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByChatMessagesCount orders the results by chat_messages count.
+func ByChatMessagesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newChatMessagesStep(), opts...)
+	}
+}
+
+// ByChatMessages orders the results by chat_messages terms.
+func ByChatMessages(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newChatMessagesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByModerationActionsCount orders the results by moderation_actions count.
+func ByModerationActionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newModerationActionsStep(), opts...)
+	}
+}
+
+// ByModerationActions orders the results by moderation_actions terms.
+func ByModerationActions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newModerationActionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByBlocksGivenCount orders the results by blocks_given count.
 func ByBlocksGivenCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -957,6 +1157,20 @@ func ByReportsReceived(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newReportsReceivedStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByNotificationsCount orders the results by notifications count.
+func ByNotificationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newNotificationsStep(), opts...)
+	}
+}
+
+// ByNotifications orders the results by notifications terms.
+func ByNotifications(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newNotificationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newProfileStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -1069,6 +1283,55 @@ func newPaymentOrdersStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, PaymentOrdersTable, PaymentOrdersColumn),
 	)
 }
+func newBalanceDriftsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BalanceDriftsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, BalanceDriftsTable, BalanceDriftsColumn),
+	)
+}
+func newSubscriptionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SubscriptionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SubscriptionsTable, SubscriptionsColumn),
+	)
+}
+func newRevealViewsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RevealViewsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RevealViewsTable, RevealViewsColumn),
+	)
+}
+func newRevealGiftsSentStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RevealGiftsSentInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RevealGiftsSentTable, RevealGiftsSentColumn),
+	)
+}
+func newRevealGiftsReceivedStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RevealGiftsReceivedInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RevealGiftsReceivedTable, RevealGiftsReceivedColumn),
+	)
+}
+func newChatMessagesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ChatMessagesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ChatMessagesTable, ChatMessagesColumn),
+	)
+}
+func newModerationActionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ModerationActionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ModerationActionsTable, ModerationActionsColumn),
+	)
+}
 func newBlocksGivenStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -1097,3 +1360,10 @@ func newReportsReceivedStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, ReportsReceivedTable, ReportsReceivedColumn),
 	)
 }
+func newNotificationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(NotificationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, NotificationsTable, NotificationsColumn),
+	)
+}
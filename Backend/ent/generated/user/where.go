@@ -195,6 +195,21 @@ func SuspensionReason(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldSuspensionReason, v))
 }
 
+// ReferralCode applies equality check predicate on the "referral_code" field. It's identical to ReferralCodeEQ.
+func ReferralCode(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldReferralCode, v))
+}
+
+// SignupDeviceID applies equality check predicate on the "signup_device_id" field. It's identical to SignupDeviceIDEQ.
+func SignupDeviceID(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldSignupDeviceID, v))
+}
+
+// PhoneHash applies equality check predicate on the "phone_hash" field. It's identical to PhoneHashEQ.
+func PhoneHash(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldPhoneHash, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldCreatedAt, v))
@@ -1955,6 +1970,231 @@ func SuspensionReasonContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldSuspensionReason, v))
 }
 
+// ReferralCodeEQ applies the EQ predicate on the "referral_code" field.
+func ReferralCodeEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldReferralCode, v))
+}
+
+// ReferralCodeNEQ applies the NEQ predicate on the "referral_code" field.
+func ReferralCodeNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldReferralCode, v))
+}
+
+// ReferralCodeIn applies the In predicate on the "referral_code" field.
+func ReferralCodeIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldReferralCode, vs...))
+}
+
+// ReferralCodeNotIn applies the NotIn predicate on the "referral_code" field.
+func ReferralCodeNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldReferralCode, vs...))
+}
+
+// ReferralCodeGT applies the GT predicate on the "referral_code" field.
+func ReferralCodeGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldReferralCode, v))
+}
+
+// ReferralCodeGTE applies the GTE predicate on the "referral_code" field.
+func ReferralCodeGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldReferralCode, v))
+}
+
+// ReferralCodeLT applies the LT predicate on the "referral_code" field.
+func ReferralCodeLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldReferralCode, v))
+}
+
+// ReferralCodeLTE applies the LTE predicate on the "referral_code" field.
+func ReferralCodeLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldReferralCode, v))
+}
+
+// ReferralCodeContains applies the Contains predicate on the "referral_code" field.
+func ReferralCodeContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldReferralCode, v))
+}
+
+// ReferralCodeHasPrefix applies the HasPrefix predicate on the "referral_code" field.
+func ReferralCodeHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldReferralCode, v))
+}
+
+// ReferralCodeHasSuffix applies the HasSuffix predicate on the "referral_code" field.
+func ReferralCodeHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldReferralCode, v))
+}
+
+// ReferralCodeIsNil applies the IsNil predicate on the "referral_code" field.
+func ReferralCodeIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldReferralCode))
+}
+
+// ReferralCodeNotNil applies the NotNil predicate on the "referral_code" field.
+func ReferralCodeNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldReferralCode))
+}
+
+// ReferralCodeEqualFold applies the EqualFold predicate on the "referral_code" field.
+func ReferralCodeEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldReferralCode, v))
+}
+
+// ReferralCodeContainsFold applies the ContainsFold predicate on the "referral_code" field.
+func ReferralCodeContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldReferralCode, v))
+}
+
+// SignupDeviceIDEQ applies the EQ predicate on the "signup_device_id" field.
+func SignupDeviceIDEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldSignupDeviceID, v))
+}
+
+// SignupDeviceIDNEQ applies the NEQ predicate on the "signup_device_id" field.
+func SignupDeviceIDNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldSignupDeviceID, v))
+}
+
+// SignupDeviceIDIn applies the In predicate on the "signup_device_id" field.
+func SignupDeviceIDIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldSignupDeviceID, vs...))
+}
+
+// SignupDeviceIDNotIn applies the NotIn predicate on the "signup_device_id" field.
+func SignupDeviceIDNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldSignupDeviceID, vs...))
+}
+
+// SignupDeviceIDGT applies the GT predicate on the "signup_device_id" field.
+func SignupDeviceIDGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldSignupDeviceID, v))
+}
+
+// SignupDeviceIDGTE applies the GTE predicate on the "signup_device_id" field.
+func SignupDeviceIDGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldSignupDeviceID, v))
+}
+
+// SignupDeviceIDLT applies the LT predicate on the "signup_device_id" field.
+func SignupDeviceIDLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldSignupDeviceID, v))
+}
+
+// SignupDeviceIDLTE applies the LTE predicate on the "signup_device_id" field.
+func SignupDeviceIDLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldSignupDeviceID, v))
+}
+
+// SignupDeviceIDContains applies the Contains predicate on the "signup_device_id" field.
+func SignupDeviceIDContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldSignupDeviceID, v))
+}
+
+// SignupDeviceIDHasPrefix applies the HasPrefix predicate on the "signup_device_id" field.
+func SignupDeviceIDHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldSignupDeviceID, v))
+}
+
+// SignupDeviceIDHasSuffix applies the HasSuffix predicate on the "signup_device_id" field.
+func SignupDeviceIDHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldSignupDeviceID, v))
+}
+
+// SignupDeviceIDIsNil applies the IsNil predicate on the "signup_device_id" field.
+func SignupDeviceIDIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldSignupDeviceID))
+}
+
+// SignupDeviceIDNotNil applies the NotNil predicate on the "signup_device_id" field.
+func SignupDeviceIDNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldSignupDeviceID))
+}
+
+// SignupDeviceIDEqualFold applies the EqualFold predicate on the "signup_device_id" field.
+func SignupDeviceIDEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldSignupDeviceID, v))
+}
+
+// SignupDeviceIDContainsFold applies the ContainsFold predicate on the "signup_device_id" field.
+func SignupDeviceIDContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldSignupDeviceID, v))
+}
+
+// PhoneHashEQ applies the EQ predicate on the "phone_hash" field.
+func PhoneHashEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldPhoneHash, v))
+}
+
+// PhoneHashNEQ applies the NEQ predicate on the "phone_hash" field.
+func PhoneHashNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldPhoneHash, v))
+}
+
+// PhoneHashIn applies the In predicate on the "phone_hash" field.
+func PhoneHashIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldPhoneHash, vs...))
+}
+
+// PhoneHashNotIn applies the NotIn predicate on the "phone_hash" field.
+func PhoneHashNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldPhoneHash, vs...))
+}
+
+// PhoneHashGT applies the GT predicate on the "phone_hash" field.
+func PhoneHashGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldPhoneHash, v))
+}
+
+// PhoneHashGTE applies the GTE predicate on the "phone_hash" field.
+func PhoneHashGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldPhoneHash, v))
+}
+
+// PhoneHashLT applies the LT predicate on the "phone_hash" field.
+func PhoneHashLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldPhoneHash, v))
+}
+
+// PhoneHashLTE applies the LTE predicate on the "phone_hash" field.
+func PhoneHashLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldPhoneHash, v))
+}
+
+// PhoneHashContains applies the Contains predicate on the "phone_hash" field.
+func PhoneHashContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldPhoneHash, v))
+}
+
+// PhoneHashHasPrefix applies the HasPrefix predicate on the "phone_hash" field.
+func PhoneHashHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldPhoneHash, v))
+}
+
+// PhoneHashHasSuffix applies the HasSuffix predicate on the "phone_hash" field.
+func PhoneHashHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldPhoneHash, v))
+}
+
+// PhoneHashIsNil applies the IsNil predicate on the "phone_hash" field.
+func PhoneHashIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldPhoneHash))
+}
+
+// PhoneHashNotNil applies the NotNil predicate on the "phone_hash" field.
+func PhoneHashNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldPhoneHash))
+}
+
+// PhoneHashEqualFold applies the EqualFold predicate on the "phone_hash" field.
+func PhoneHashEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldPhoneHash, v))
+}
+
+// PhoneHashContainsFold applies the ContainsFold predicate on the "phone_hash" field.
+func PhoneHashContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldPhoneHash, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldCreatedAt, v))
@@ -2453,6 +2693,167 @@ func HasPaymentOrdersWith(preds ...predicate.PaymentOrder) predicate.User {
 	})
 }
 
+// HasBalanceDrifts applies the HasEdge predicate on the "balance_drifts" edge.
+func HasBalanceDrifts() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, BalanceDriftsTable, BalanceDriftsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBalanceDriftsWith applies the HasEdge predicate on the "balance_drifts" edge with a given conditions (other predicates).
+func HasBalanceDriftsWith(preds ...predicate.BalanceDrift) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newBalanceDriftsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSubscriptions applies the HasEdge predicate on the "subscriptions" edge.
+func HasSubscriptions() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SubscriptionsTable, SubscriptionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSubscriptionsWith applies the HasEdge predicate on the "subscriptions" edge with a given conditions (other predicates).
+func HasSubscriptionsWith(preds ...predicate.Subscription) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newSubscriptionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRevealViews applies the HasEdge predicate on the "reveal_views" edge.
+func HasRevealViews() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RevealViewsTable, RevealViewsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRevealViewsWith applies the HasEdge predicate on the "reveal_views" edge with a given conditions (other predicates).
+func HasRevealViewsWith(preds ...predicate.RevealView) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newRevealViewsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRevealGiftsSent applies the HasEdge predicate on the "reveal_gifts_sent" edge.
+func HasRevealGiftsSent() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RevealGiftsSentTable, RevealGiftsSentColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRevealGiftsSentWith applies the HasEdge predicate on the "reveal_gifts_sent" edge with a given conditions (other predicates).
+func HasRevealGiftsSentWith(preds ...predicate.RevealGift) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newRevealGiftsSentStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRevealGiftsReceived applies the HasEdge predicate on the "reveal_gifts_received" edge.
+func HasRevealGiftsReceived() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RevealGiftsReceivedTable, RevealGiftsReceivedColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRevealGiftsReceivedWith applies the HasEdge predicate on the "reveal_gifts_received" edge with a given conditions (other predicates).
+func HasRevealGiftsReceivedWith(preds ...predicate.RevealGift) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newRevealGiftsReceivedStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasChatMessages applies the HasEdge predicate on the "chat_messages" edge.
+func HasChatMessages() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ChatMessagesTable, ChatMessagesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasChatMessagesWith applies the HasEdge predicate on the "chat_messages" edge with a given conditions (other predicates).
+func HasChatMessagesWith(preds ...predicate.Message) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newChatMessagesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasModerationActions applies the HasEdge predicate on the "moderation_actions" edge.
+func HasModerationActions() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ModerationActionsTable, ModerationActionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasModerationActionsWith applies the HasEdge predicate on the "moderation_actions" edge with a given conditions (other predicates).
+func HasModerationActionsWith(preds ...predicate.ModerationAction) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newModerationActionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasBlocksGiven applies the HasEdge predicate on the "blocks_given" edge.
 func HasBlocksGiven() predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -2545,6 +2946,29 @@ func HasReportsReceivedWith(preds ...predicate.UserReport) predicate.User {
 	})
 }
 
+// HasNotifications applies the HasEdge predicate on the "notifications" edge.
+func HasNotifications() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, NotificationsTable, NotificationsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasNotificationsWith applies the HasEdge predicate on the "notifications" edge with a given conditions (other predicates).
+func HasNotificationsWith(preds ...predicate.Notification) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newNotificationsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.User) predicate.User {
 	return predicate.User(sql.AndPredicates(predicates...))
@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/UnoraApp/be/ent/generated/balancedrift"
 	"github.com/UnoraApp/be/ent/generated/checkin"
 	"github.com/UnoraApp/be/ent/generated/connection"
 	"github.com/UnoraApp/be/ent/generated/credittransaction"
@@ -20,11 +21,17 @@ import (
 	"github.com/UnoraApp/be/ent/generated/filter"
 	"github.com/UnoraApp/be/ent/generated/hobby"
 	"github.com/UnoraApp/be/ent/generated/interest"
+	"github.com/UnoraApp/be/ent/generated/message"
+	"github.com/UnoraApp/be/ent/generated/moderationaction"
+	"github.com/UnoraApp/be/ent/generated/notification"
 	"github.com/UnoraApp/be/ent/generated/nudge"
 	"github.com/UnoraApp/be/ent/generated/paymentorder"
 	"github.com/UnoraApp/be/ent/generated/photo"
 	"github.com/UnoraApp/be/ent/generated/profile"
+	"github.com/UnoraApp/be/ent/generated/revealgift"
+	"github.com/UnoraApp/be/ent/generated/revealview"
 	"github.com/UnoraApp/be/ent/generated/streak"
+	"github.com/UnoraApp/be/ent/generated/subscription"
 	"github.com/UnoraApp/be/ent/generated/user"
 	"github.com/UnoraApp/be/ent/generated/userblock"
 	"github.com/UnoraApp/be/ent/generated/userreport"
@@ -472,6 +479,48 @@ func (_c *UserCreate) SetNillableSuspensionReason(v *string) *UserCreate {
 	return _c
 }
 
+// SetReferralCode sets the "referral_code" field.
+func (_c *UserCreate) SetReferralCode(v string) *UserCreate {
+	_c.mutation.SetReferralCode(v)
+	return _c
+}
+
+// SetNillableReferralCode sets the "referral_code" field if the given value is not nil.
+func (_c *UserCreate) SetNillableReferralCode(v *string) *UserCreate {
+	if v != nil {
+		_c.SetReferralCode(*v)
+	}
+	return _c
+}
+
+// SetSignupDeviceID sets the "signup_device_id" field.
+func (_c *UserCreate) SetSignupDeviceID(v string) *UserCreate {
+	_c.mutation.SetSignupDeviceID(v)
+	return _c
+}
+
+// SetNillableSignupDeviceID sets the "signup_device_id" field if the given value is not nil.
+func (_c *UserCreate) SetNillableSignupDeviceID(v *string) *UserCreate {
+	if v != nil {
+		_c.SetSignupDeviceID(*v)
+	}
+	return _c
+}
+
+// SetPhoneHash sets the "phone_hash" field.
+func (_c *UserCreate) SetPhoneHash(v string) *UserCreate {
+	_c.mutation.SetPhoneHash(v)
+	return _c
+}
+
+// SetNillablePhoneHash sets the "phone_hash" field if the given value is not nil.
+func (_c *UserCreate) SetNillablePhoneHash(v *string) *UserCreate {
+	if v != nil {
+		_c.SetPhoneHash(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *UserCreate) SetCreatedAt(v time.Time) *UserCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -764,6 +813,111 @@ func (_c *UserCreate) AddPaymentOrders(v ...*PaymentOrder) *UserCreate {
 	return _c.AddPaymentOrderIDs(ids...)
 }
 
+// AddBalanceDriftIDs adds the "balance_drifts" edge to the BalanceDrift entity by IDs.
+func (_c *UserCreate) AddBalanceDriftIDs(ids ...string) *UserCreate {
+	_c.mutation.AddBalanceDriftIDs(ids...)
+	return _c
+}
+
+// AddBalanceDrifts adds the "balance_drifts" edges to the BalanceDrift entity.
+func (_c *UserCreate) AddBalanceDrifts(v ...*BalanceDrift) *UserCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddBalanceDriftIDs(ids...)
+}
+
+// AddSubscriptionIDs adds the "subscriptions" edge to the Subscription entity by IDs.
+func (_c *UserCreate) AddSubscriptionIDs(ids ...string) *UserCreate {
+	_c.mutation.AddSubscriptionIDs(ids...)
+	return _c
+}
+
+// AddSubscriptions adds the "subscriptions" edges to the Subscription entity.
+func (_c *UserCreate) AddSubscriptions(v ...*Subscription) *UserCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddSubscriptionIDs(ids...)
+}
+
+// AddRevealViewIDs adds the "reveal_views" edge to the RevealView entity by IDs.
+func (_c *UserCreate) AddRevealViewIDs(ids ...string) *UserCreate {
+	_c.mutation.AddRevealViewIDs(ids...)
+	return _c
+}
+
+// AddRevealViews adds the "reveal_views" edges to the RevealView entity.
+func (_c *UserCreate) AddRevealViews(v ...*RevealView) *UserCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRevealViewIDs(ids...)
+}
+
+// AddRevealGiftsSentIDs adds the "reveal_gifts_sent" edge to the RevealGift entity by IDs.
+func (_c *UserCreate) AddRevealGiftsSentIDs(ids ...string) *UserCreate {
+	_c.mutation.AddRevealGiftsSentIDs(ids...)
+	return _c
+}
+
+// AddRevealGiftsSent adds the "reveal_gifts_sent" edges to the RevealGift entity.
+func (_c *UserCreate) AddRevealGiftsSent(v ...*RevealGift) *UserCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRevealGiftsSentIDs(ids...)
+}
+
+// AddRevealGiftsReceivedIDs adds the "reveal_gifts_received" edge to the RevealGift entity by IDs.
+func (_c *UserCreate) AddRevealGiftsReceivedIDs(ids ...string) *UserCreate {
+	_c.mutation.AddRevealGiftsReceivedIDs(ids...)
+	return _c
+}
+
+// AddRevealGiftsReceived adds the "reveal_gifts_received" edges to the RevealGift entity.
+func (_c *UserCreate) AddRevealGiftsReceived(v ...*RevealGift) *UserCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRevealGiftsReceivedIDs(ids...)
+}
+
+// AddChatMessageIDs adds the "chat_messages" edge to the Message entity by IDs.
+func (_c *UserCreate) AddChatMessageIDs(ids ...string) *UserCreate {
+	_c.mutation.AddChatMessageIDs(ids...)
+	return _c
+}
+
+// AddChatMessages adds the "chat_messages" edges to the Message entity.
+func (_c *UserCreate) AddChatMessages(v ...*Message) *UserCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddChatMessageIDs(ids...)
+}
+
+// AddModerationActionIDs adds the "moderation_actions" edge to the ModerationAction entity by IDs.
+func (_c *UserCreate) AddModerationActionIDs(ids ...string) *UserCreate {
+	_c.mutation.AddModerationActionIDs(ids...)
+	return _c
+}
+
+// AddModerationActions adds the "moderation_actions" edges to the ModerationAction entity.
+func (_c *UserCreate) AddModerationActions(v ...*ModerationAction) *UserCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddModerationActionIDs(ids...)
+}
+
 // AddBlocksGivenIDs adds the "blocks_given" edge to the UserBlock entity by IDs.
 func (_c *UserCreate) AddBlocksGivenIDs(ids ...string) *UserCreate {
 	_c.mutation.AddBlocksGivenIDs(ids...)
@@ -824,6 +978,21 @@ func (_c *UserCreate) AddReportsReceived(v ...*UserReport) *UserCreate {
 	return _c.AddReportsReceivedIDs(ids...)
 }
 
+// AddNotificationIDs adds the "notifications" edge to the Notification entity by IDs.
+func (_c *UserCreate) AddNotificationIDs(ids ...string) *UserCreate {
+	_c.mutation.AddNotificationIDs(ids...)
+	return _c
+}
+
+// AddNotifications adds the "notifications" edges to the Notification entity.
+func (_c *UserCreate) AddNotifications(v ...*Notification) *UserCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddNotificationIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_c *UserCreate) Mutation() *UserMutation {
 	return _c.mutation
@@ -1036,6 +1205,21 @@ func (_c *UserCreate) check() error {
 			return &ValidationError{Name: "suspension_reason", err: fmt.Errorf(`generated: validator failed for field "User.suspension_reason": %w`, err)}
 		}
 	}
+	if v, ok := _c.mutation.ReferralCode(); ok {
+		if err := user.ReferralCodeValidator(v); err != nil {
+			return &ValidationError{Name: "referral_code", err: fmt.Errorf(`generated: validator failed for field "User.referral_code": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.SignupDeviceID(); ok {
+		if err := user.SignupDeviceIDValidator(v); err != nil {
+			return &ValidationError{Name: "signup_device_id", err: fmt.Errorf(`generated: validator failed for field "User.signup_device_id": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.PhoneHash(); ok {
+		if err := user.PhoneHashValidator(v); err != nil {
+			return &ValidationError{Name: "phone_hash", err: fmt.Errorf(`generated: validator failed for field "User.phone_hash": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "User.created_at"`)}
 	}
@@ -1207,6 +1391,18 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldSuspensionReason, field.TypeString, value)
 		_node.SuspensionReason = &value
 	}
+	if value, ok := _c.mutation.ReferralCode(); ok {
+		_spec.SetField(user.FieldReferralCode, field.TypeString, value)
+		_node.ReferralCode = &value
+	}
+	if value, ok := _c.mutation.SignupDeviceID(); ok {
+		_spec.SetField(user.FieldSignupDeviceID, field.TypeString, value)
+		_node.SignupDeviceID = &value
+	}
+	if value, ok := _c.mutation.PhoneHash(); ok {
+		_spec.SetField(user.FieldPhoneHash, field.TypeString, value)
+		_node.PhoneHash = &value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(user.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -1475,6 +1671,118 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.BalanceDriftsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BalanceDriftsTable,
+			Columns: []string{user.BalanceDriftsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(balancedrift.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.SubscriptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SubscriptionsTable,
+			Columns: []string{user.SubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.RevealViewsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RevealViewsTable,
+			Columns: []string{user.RevealViewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(revealview.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.RevealGiftsSentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RevealGiftsSentTable,
+			Columns: []string{user.RevealGiftsSentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(revealgift.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.RevealGiftsReceivedIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RevealGiftsReceivedTable,
+			Columns: []string{user.RevealGiftsReceivedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(revealgift.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ChatMessagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ChatMessagesTable,
+			Columns: []string{user.ChatMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ModerationActionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ModerationActionsTable,
+			Columns: []string{user.ModerationActionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(moderationaction.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := _c.mutation.BlocksGivenIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -1539,6 +1847,22 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.NotificationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NotificationsTable,
+			Columns: []string{user.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -2131,6 +2455,60 @@ func (u *UserUpsert) ClearSuspensionReason() *UserUpsert {
 	return u
 }
 
+// SetReferralCode sets the "referral_code" field.
+func (u *UserUpsert) SetReferralCode(v string) *UserUpsert {
+	u.Set(user.FieldReferralCode, v)
+	return u
+}
+
+// UpdateReferralCode sets the "referral_code" field to the value that was provided on create.
+func (u *UserUpsert) UpdateReferralCode() *UserUpsert {
+	u.SetExcluded(user.FieldReferralCode)
+	return u
+}
+
+// ClearReferralCode clears the value of the "referral_code" field.
+func (u *UserUpsert) ClearReferralCode() *UserUpsert {
+	u.SetNull(user.FieldReferralCode)
+	return u
+}
+
+// SetSignupDeviceID sets the "signup_device_id" field.
+func (u *UserUpsert) SetSignupDeviceID(v string) *UserUpsert {
+	u.Set(user.FieldSignupDeviceID, v)
+	return u
+}
+
+// UpdateSignupDeviceID sets the "signup_device_id" field to the value that was provided on create.
+func (u *UserUpsert) UpdateSignupDeviceID() *UserUpsert {
+	u.SetExcluded(user.FieldSignupDeviceID)
+	return u
+}
+
+// ClearSignupDeviceID clears the value of the "signup_device_id" field.
+func (u *UserUpsert) ClearSignupDeviceID() *UserUpsert {
+	u.SetNull(user.FieldSignupDeviceID)
+	return u
+}
+
+// SetPhoneHash sets the "phone_hash" field.
+func (u *UserUpsert) SetPhoneHash(v string) *UserUpsert {
+	u.Set(user.FieldPhoneHash, v)
+	return u
+}
+
+// UpdatePhoneHash sets the "phone_hash" field to the value that was provided on create.
+func (u *UserUpsert) UpdatePhoneHash() *UserUpsert {
+	u.SetExcluded(user.FieldPhoneHash)
+	return u
+}
+
+// ClearPhoneHash clears the value of the "phone_hash" field.
+func (u *UserUpsert) ClearPhoneHash() *UserUpsert {
+	u.SetNull(user.FieldPhoneHash)
+	return u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *UserUpsert) SetUpdatedAt(v time.Time) *UserUpsert {
 	u.Set(user.FieldUpdatedAt, v)
@@ -2842,6 +3220,69 @@ func (u *UserUpsertOne) ClearSuspensionReason() *UserUpsertOne {
 	})
 }
 
+// SetReferralCode sets the "referral_code" field.
+func (u *UserUpsertOne) SetReferralCode(v string) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetReferralCode(v)
+	})
+}
+
+// UpdateReferralCode sets the "referral_code" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateReferralCode() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateReferralCode()
+	})
+}
+
+// ClearReferralCode clears the value of the "referral_code" field.
+func (u *UserUpsertOne) ClearReferralCode() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearReferralCode()
+	})
+}
+
+// SetSignupDeviceID sets the "signup_device_id" field.
+func (u *UserUpsertOne) SetSignupDeviceID(v string) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetSignupDeviceID(v)
+	})
+}
+
+// UpdateSignupDeviceID sets the "signup_device_id" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateSignupDeviceID() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateSignupDeviceID()
+	})
+}
+
+// ClearSignupDeviceID clears the value of the "signup_device_id" field.
+func (u *UserUpsertOne) ClearSignupDeviceID() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearSignupDeviceID()
+	})
+}
+
+// SetPhoneHash sets the "phone_hash" field.
+func (u *UserUpsertOne) SetPhoneHash(v string) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetPhoneHash(v)
+	})
+}
+
+// UpdatePhoneHash sets the "phone_hash" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdatePhoneHash() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdatePhoneHash()
+	})
+}
+
+// ClearPhoneHash clears the value of the "phone_hash" field.
+func (u *UserUpsertOne) ClearPhoneHash() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearPhoneHash()
+	})
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *UserUpsertOne) SetUpdatedAt(v time.Time) *UserUpsertOne {
 	return u.Update(func(s *UserUpsert) {
@@ -3725,6 +4166,69 @@ func (u *UserUpsertBulk) ClearSuspensionReason() *UserUpsertBulk {
 	})
 }
 
+// SetReferralCode sets the "referral_code" field.
+func (u *UserUpsertBulk) SetReferralCode(v string) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetReferralCode(v)
+	})
+}
+
+// UpdateReferralCode sets the "referral_code" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateReferralCode() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateReferralCode()
+	})
+}
+
+// ClearReferralCode clears the value of the "referral_code" field.
+func (u *UserUpsertBulk) ClearReferralCode() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearReferralCode()
+	})
+}
+
+// SetSignupDeviceID sets the "signup_device_id" field.
+func (u *UserUpsertBulk) SetSignupDeviceID(v string) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetSignupDeviceID(v)
+	})
+}
+
+// UpdateSignupDeviceID sets the "signup_device_id" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateSignupDeviceID() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateSignupDeviceID()
+	})
+}
+
+// ClearSignupDeviceID clears the value of the "signup_device_id" field.
+func (u *UserUpsertBulk) ClearSignupDeviceID() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearSignupDeviceID()
+	})
+}
+
+// SetPhoneHash sets the "phone_hash" field.
+func (u *UserUpsertBulk) SetPhoneHash(v string) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetPhoneHash(v)
+	})
+}
+
+// UpdatePhoneHash sets the "phone_hash" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdatePhoneHash() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdatePhoneHash()
+	})
+}
+
+// ClearPhoneHash clears the value of the "phone_hash" field.
+func (u *UserUpsertBulk) ClearPhoneHash() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearPhoneHash()
+	})
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *UserUpsertBulk) SetUpdatedAt(v time.Time) *UserUpsertBulk {
 	return u.Update(func(s *UserUpsert) {
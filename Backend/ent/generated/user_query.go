@@ -9,9 +9,11 @@ import (
 	"math"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/UnoraApp/be/ent/generated/balancedrift"
 	"github.com/UnoraApp/be/ent/generated/checkin"
 	"github.com/UnoraApp/be/ent/generated/connection"
 	"github.com/UnoraApp/be/ent/generated/credittransaction"
@@ -20,12 +22,18 @@ import (
 	"github.com/UnoraApp/be/ent/generated/filter"
 	"github.com/UnoraApp/be/ent/generated/hobby"
 	"github.com/UnoraApp/be/ent/generated/interest"
+	"github.com/UnoraApp/be/ent/generated/message"
+	"github.com/UnoraApp/be/ent/generated/moderationaction"
+	"github.com/UnoraApp/be/ent/generated/notification"
 	"github.com/UnoraApp/be/ent/generated/nudge"
 	"github.com/UnoraApp/be/ent/generated/paymentorder"
 	"github.com/UnoraApp/be/ent/generated/photo"
 	"github.com/UnoraApp/be/ent/generated/predicate"
 	"github.com/UnoraApp/be/ent/generated/profile"
+	"github.com/UnoraApp/be/ent/generated/revealgift"
+	"github.com/UnoraApp/be/ent/generated/revealview"
 	"github.com/UnoraApp/be/ent/generated/streak"
+	"github.com/UnoraApp/be/ent/generated/subscription"
 	"github.com/UnoraApp/be/ent/generated/user"
 	"github.com/UnoraApp/be/ent/generated/userblock"
 	"github.com/UnoraApp/be/ent/generated/userreport"
@@ -54,10 +62,19 @@ type UserQuery struct {
 	withReceivedNudges       *NudgeQuery
 	withCreditTransactions   *CreditTransactionQuery
 	withPaymentOrders        *PaymentOrderQuery
+	withBalanceDrifts        *BalanceDriftQuery
+	withSubscriptions        *SubscriptionQuery
+	withRevealViews          *RevealViewQuery
+	withRevealGiftsSent      *RevealGiftQuery
+	withRevealGiftsReceived  *RevealGiftQuery
+	withChatMessages         *MessageQuery
+	withModerationActions    *ModerationActionQuery
 	withBlocksGiven          *UserBlockQuery
 	withBlocksReceived       *UserBlockQuery
 	withReportsGiven         *UserReportQuery
 	withReportsReceived      *UserReportQuery
+	withNotifications        *NotificationQuery
+	modifiers                []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -446,6 +463,160 @@ func (_q *UserQuery) QueryPaymentOrders() *PaymentOrderQuery {
 	return query
 }
 
+// QueryBalanceDrifts chains the current query on the "balance_drifts" edge.
+func (_q *UserQuery) QueryBalanceDrifts() *BalanceDriftQuery {
+	query := (&BalanceDriftClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(balancedrift.Table, balancedrift.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.BalanceDriftsTable, user.BalanceDriftsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySubscriptions chains the current query on the "subscriptions" edge.
+func (_q *UserQuery) QuerySubscriptions() *SubscriptionQuery {
+	query := (&SubscriptionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(subscription.Table, subscription.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.SubscriptionsTable, user.SubscriptionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRevealViews chains the current query on the "reveal_views" edge.
+func (_q *UserQuery) QueryRevealViews() *RevealViewQuery {
+	query := (&RevealViewClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(revealview.Table, revealview.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.RevealViewsTable, user.RevealViewsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRevealGiftsSent chains the current query on the "reveal_gifts_sent" edge.
+func (_q *UserQuery) QueryRevealGiftsSent() *RevealGiftQuery {
+	query := (&RevealGiftClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(revealgift.Table, revealgift.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.RevealGiftsSentTable, user.RevealGiftsSentColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRevealGiftsReceived chains the current query on the "reveal_gifts_received" edge.
+func (_q *UserQuery) QueryRevealGiftsReceived() *RevealGiftQuery {
+	query := (&RevealGiftClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(revealgift.Table, revealgift.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.RevealGiftsReceivedTable, user.RevealGiftsReceivedColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryChatMessages chains the current query on the "chat_messages" edge.
+func (_q *UserQuery) QueryChatMessages() *MessageQuery {
+	query := (&MessageClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(message.Table, message.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ChatMessagesTable, user.ChatMessagesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryModerationActions chains the current query on the "moderation_actions" edge.
+func (_q *UserQuery) QueryModerationActions() *ModerationActionQuery {
+	query := (&ModerationActionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(moderationaction.Table, moderationaction.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ModerationActionsTable, user.ModerationActionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryBlocksGiven chains the current query on the "blocks_given" edge.
 func (_q *UserQuery) QueryBlocksGiven() *UserBlockQuery {
 	query := (&UserBlockClient{config: _q.config}).Query()
@@ -534,6 +705,28 @@ func (_q *UserQuery) QueryReportsReceived() *UserReportQuery {
 	return query
 }
 
+// QueryNotifications chains the current query on the "notifications" edge.
+func (_q *UserQuery) QueryNotifications() *NotificationQuery {
+	query := (&NotificationClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(notification.Table, notification.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.NotificationsTable, user.NotificationsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first User entity from the query.
 // Returns a *NotFoundError when no User was found.
 func (_q *UserQuery) First(ctx context.Context) (*User, error) {
@@ -742,10 +935,18 @@ func (_q *UserQuery) Clone() *UserQuery {
 		withReceivedNudges:       _q.withReceivedNudges.Clone(),
 		withCreditTransactions:   _q.withCreditTransactions.Clone(),
 		withPaymentOrders:        _q.withPaymentOrders.Clone(),
+		withBalanceDrifts:        _q.withBalanceDrifts.Clone(),
+		withSubscriptions:        _q.withSubscriptions.Clone(),
+		withRevealViews:          _q.withRevealViews.Clone(),
+		withRevealGiftsSent:      _q.withRevealGiftsSent.Clone(),
+		withRevealGiftsReceived:  _q.withRevealGiftsReceived.Clone(),
+		withChatMessages:         _q.withChatMessages.Clone(),
+		withModerationActions:    _q.withModerationActions.Clone(),
 		withBlocksGiven:          _q.withBlocksGiven.Clone(),
 		withBlocksReceived:       _q.withBlocksReceived.Clone(),
 		withReportsGiven:         _q.withReportsGiven.Clone(),
 		withReportsReceived:      _q.withReportsReceived.Clone(),
+		withNotifications:        _q.withNotifications.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -928,6 +1129,83 @@ func (_q *UserQuery) WithPaymentOrders(opts ...func(*PaymentOrderQuery)) *UserQu
 	return _q
 }
 
+// WithBalanceDrifts tells the query-builder to eager-load the nodes that are connected to
+// the "balance_drifts" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithBalanceDrifts(opts ...func(*BalanceDriftQuery)) *UserQuery {
+	query := (&BalanceDriftClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withBalanceDrifts = query
+	return _q
+}
+
+// WithSubscriptions tells the query-builder to eager-load the nodes that are connected to
+// the "subscriptions" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithSubscriptions(opts ...func(*SubscriptionQuery)) *UserQuery {
+	query := (&SubscriptionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withSubscriptions = query
+	return _q
+}
+
+// WithRevealViews tells the query-builder to eager-load the nodes that are connected to
+// the "reveal_views" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithRevealViews(opts ...func(*RevealViewQuery)) *UserQuery {
+	query := (&RevealViewClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withRevealViews = query
+	return _q
+}
+
+// WithRevealGiftsSent tells the query-builder to eager-load the nodes that are connected to
+// the "reveal_gifts_sent" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithRevealGiftsSent(opts ...func(*RevealGiftQuery)) *UserQuery {
+	query := (&RevealGiftClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withRevealGiftsSent = query
+	return _q
+}
+
+// WithRevealGiftsReceived tells the query-builder to eager-load the nodes that are connected to
+// the "reveal_gifts_received" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithRevealGiftsReceived(opts ...func(*RevealGiftQuery)) *UserQuery {
+	query := (&RevealGiftClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withRevealGiftsReceived = query
+	return _q
+}
+
+// WithChatMessages tells the query-builder to eager-load the nodes that are connected to
+// the "chat_messages" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithChatMessages(opts ...func(*MessageQuery)) *UserQuery {
+	query := (&MessageClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withChatMessages = query
+	return _q
+}
+
+// WithModerationActions tells the query-builder to eager-load the nodes that are connected to
+// the "moderation_actions" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithModerationActions(opts ...func(*ModerationActionQuery)) *UserQuery {
+	query := (&ModerationActionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withModerationActions = query
+	return _q
+}
+
 // WithBlocksGiven tells the query-builder to eager-load the nodes that are connected to
 // the "blocks_given" edge. The optional arguments are used to configure the query builder of the edge.
 func (_q *UserQuery) WithBlocksGiven(opts ...func(*UserBlockQuery)) *UserQuery {
@@ -972,6 +1250,17 @@ func (_q *UserQuery) WithReportsReceived(opts ...func(*UserReportQuery)) *UserQu
 	return _q
 }
 
+// WithNotifications tells the query-builder to eager-load the nodes that are connected to
+// the "notifications" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithNotifications(opts ...func(*NotificationQuery)) *UserQuery {
+	query := (&NotificationClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withNotifications = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -1050,7 +1339,7 @@ func (_q *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 	var (
 		nodes       = []*User{}
 		_spec       = _q.querySpec()
-		loadedTypes = [20]bool{
+		loadedTypes = [28]bool{
 			_q.withProfile != nil,
 			_q.withPhotos != nil,
 			_q.withHobbies != nil,
@@ -1067,10 +1356,18 @@ func (_q *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 			_q.withReceivedNudges != nil,
 			_q.withCreditTransactions != nil,
 			_q.withPaymentOrders != nil,
+			_q.withBalanceDrifts != nil,
+			_q.withSubscriptions != nil,
+			_q.withRevealViews != nil,
+			_q.withRevealGiftsSent != nil,
+			_q.withRevealGiftsReceived != nil,
+			_q.withChatMessages != nil,
+			_q.withModerationActions != nil,
 			_q.withBlocksGiven != nil,
 			_q.withBlocksReceived != nil,
 			_q.withReportsGiven != nil,
 			_q.withReportsReceived != nil,
+			_q.withNotifications != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -1082,6 +1379,9 @@ func (_q *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -1206,6 +1506,55 @@ func (_q *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 			return nil, err
 		}
 	}
+	if query := _q.withBalanceDrifts; query != nil {
+		if err := _q.loadBalanceDrifts(ctx, query, nodes,
+			func(n *User) { n.Edges.BalanceDrifts = []*BalanceDrift{} },
+			func(n *User, e *BalanceDrift) { n.Edges.BalanceDrifts = append(n.Edges.BalanceDrifts, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withSubscriptions; query != nil {
+		if err := _q.loadSubscriptions(ctx, query, nodes,
+			func(n *User) { n.Edges.Subscriptions = []*Subscription{} },
+			func(n *User, e *Subscription) { n.Edges.Subscriptions = append(n.Edges.Subscriptions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withRevealViews; query != nil {
+		if err := _q.loadRevealViews(ctx, query, nodes,
+			func(n *User) { n.Edges.RevealViews = []*RevealView{} },
+			func(n *User, e *RevealView) { n.Edges.RevealViews = append(n.Edges.RevealViews, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withRevealGiftsSent; query != nil {
+		if err := _q.loadRevealGiftsSent(ctx, query, nodes,
+			func(n *User) { n.Edges.RevealGiftsSent = []*RevealGift{} },
+			func(n *User, e *RevealGift) { n.Edges.RevealGiftsSent = append(n.Edges.RevealGiftsSent, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withRevealGiftsReceived; query != nil {
+		if err := _q.loadRevealGiftsReceived(ctx, query, nodes,
+			func(n *User) { n.Edges.RevealGiftsReceived = []*RevealGift{} },
+			func(n *User, e *RevealGift) { n.Edges.RevealGiftsReceived = append(n.Edges.RevealGiftsReceived, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withChatMessages; query != nil {
+		if err := _q.loadChatMessages(ctx, query, nodes,
+			func(n *User) { n.Edges.ChatMessages = []*Message{} },
+			func(n *User, e *Message) { n.Edges.ChatMessages = append(n.Edges.ChatMessages, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withModerationActions; query != nil {
+		if err := _q.loadModerationActions(ctx, query, nodes,
+			func(n *User) { n.Edges.ModerationActions = []*ModerationAction{} },
+			func(n *User, e *ModerationAction) { n.Edges.ModerationActions = append(n.Edges.ModerationActions, e) }); err != nil {
+			return nil, err
+		}
+	}
 	if query := _q.withBlocksGiven; query != nil {
 		if err := _q.loadBlocksGiven(ctx, query, nodes,
 			func(n *User) { n.Edges.BlocksGiven = []*UserBlock{} },
@@ -1234,6 +1583,13 @@ func (_q *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 			return nil, err
 		}
 	}
+	if query := _q.withNotifications; query != nil {
+		if err := _q.loadNotifications(ctx, query, nodes,
+			func(n *User) { n.Edges.Notifications = []*Notification{} },
+			func(n *User, e *Notification) { n.Edges.Notifications = append(n.Edges.Notifications, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -1717,6 +2073,216 @@ func (_q *UserQuery) loadPaymentOrders(ctx context.Context, query *PaymentOrderQ
 	}
 	return nil
 }
+func (_q *UserQuery) loadBalanceDrifts(ctx context.Context, query *BalanceDriftQuery, nodes []*User, init func(*User), assign func(*User, *BalanceDrift)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(balancedrift.FieldUserID)
+	}
+	query.Where(predicate.BalanceDrift(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.BalanceDriftsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadSubscriptions(ctx context.Context, query *SubscriptionQuery, nodes []*User, init func(*User), assign func(*User, *Subscription)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(subscription.FieldUserID)
+	}
+	query.Where(predicate.Subscription(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.SubscriptionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadRevealViews(ctx context.Context, query *RevealViewQuery, nodes []*User, init func(*User), assign func(*User, *RevealView)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(revealview.FieldViewerUserID)
+	}
+	query.Where(predicate.RevealView(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.RevealViewsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ViewerUserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "viewer_user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadRevealGiftsSent(ctx context.Context, query *RevealGiftQuery, nodes []*User, init func(*User), assign func(*User, *RevealGift)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(revealgift.FieldGifterUserID)
+	}
+	query.Where(predicate.RevealGift(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.RevealGiftsSentColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.GifterUserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "gifter_user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadRevealGiftsReceived(ctx context.Context, query *RevealGiftQuery, nodes []*User, init func(*User), assign func(*User, *RevealGift)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(revealgift.FieldRecipientUserID)
+	}
+	query.Where(predicate.RevealGift(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.RevealGiftsReceivedColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.RecipientUserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "recipient_user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadChatMessages(ctx context.Context, query *MessageQuery, nodes []*User, init func(*User), assign func(*User, *Message)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(message.FieldSenderUserID)
+	}
+	query.Where(predicate.Message(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.ChatMessagesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.SenderUserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "sender_user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadModerationActions(ctx context.Context, query *ModerationActionQuery, nodes []*User, init func(*User), assign func(*User, *ModerationAction)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(moderationaction.FieldUserID)
+	}
+	query.Where(predicate.ModerationAction(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.ModerationActionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 func (_q *UserQuery) loadBlocksGiven(ctx context.Context, query *UserBlockQuery, nodes []*User, init func(*User), assign func(*User, *UserBlock)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[string]*User)
@@ -1799,9 +2365,12 @@ func (_q *UserQuery) loadReportsGiven(ctx context.Context, query *UserReportQuer
 	}
 	for _, n := range neighbors {
 		fk := n.ReporterUserID
-		node, ok := nodeids[fk]
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "reporter_user_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "reporter_user_id" returned %v for node %v`, fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "reporter_user_id" returned %v for node %v`, *fk, n.ID)
 		}
 		assign(node, n)
 	}
@@ -1837,9 +2406,42 @@ func (_q *UserQuery) loadReportsReceived(ctx context.Context, query *UserReportQ
 	}
 	return nil
 }
+func (_q *UserQuery) loadNotifications(ctx context.Context, query *NotificationQuery, nodes []*User, init func(*User), assign func(*User, *Notification)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(notification.FieldUserID)
+	}
+	query.Where(predicate.Notification(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.NotificationsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *UserQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -1902,6 +2504,9 @@ func (_q *UserQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -1919,6 +2524,32 @@ func (_q *UserQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (_q *UserQuery) ForUpdate(opts ...sql.LockOption) *UserQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return _q
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (_q *UserQuery) ForShare(opts ...sql.LockOption) *UserQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return _q
+}
+
 // UserGroupBy is the group-by builder for User entities.
 type UserGroupBy struct {
 	selector
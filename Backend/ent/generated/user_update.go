@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/UnoraApp/be/ent/generated/balancedrift"
 	"github.com/UnoraApp/be/ent/generated/checkin"
 	"github.com/UnoraApp/be/ent/generated/connection"
 	"github.com/UnoraApp/be/ent/generated/credittransaction"
@@ -19,12 +20,18 @@ import (
 	"github.com/UnoraApp/be/ent/generated/filter"
 	"github.com/UnoraApp/be/ent/generated/hobby"
 	"github.com/UnoraApp/be/ent/generated/interest"
+	"github.com/UnoraApp/be/ent/generated/message"
+	"github.com/UnoraApp/be/ent/generated/moderationaction"
+	"github.com/UnoraApp/be/ent/generated/notification"
 	"github.com/UnoraApp/be/ent/generated/nudge"
 	"github.com/UnoraApp/be/ent/generated/paymentorder"
 	"github.com/UnoraApp/be/ent/generated/photo"
 	"github.com/UnoraApp/be/ent/generated/predicate"
 	"github.com/UnoraApp/be/ent/generated/profile"
+	"github.com/UnoraApp/be/ent/generated/revealgift"
+	"github.com/UnoraApp/be/ent/generated/revealview"
 	"github.com/UnoraApp/be/ent/generated/streak"
+	"github.com/UnoraApp/be/ent/generated/subscription"
 	"github.com/UnoraApp/be/ent/generated/user"
 	"github.com/UnoraApp/be/ent/generated/userblock"
 	"github.com/UnoraApp/be/ent/generated/userreport"
@@ -650,6 +657,66 @@ func (_u *UserUpdate) ClearSuspensionReason() *UserUpdate {
 	return _u
 }
 
+// SetReferralCode sets the "referral_code" field.
+func (_u *UserUpdate) SetReferralCode(v string) *UserUpdate {
+	_u.mutation.SetReferralCode(v)
+	return _u
+}
+
+// SetNillableReferralCode sets the "referral_code" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableReferralCode(v *string) *UserUpdate {
+	if v != nil {
+		_u.SetReferralCode(*v)
+	}
+	return _u
+}
+
+// ClearReferralCode clears the value of the "referral_code" field.
+func (_u *UserUpdate) ClearReferralCode() *UserUpdate {
+	_u.mutation.ClearReferralCode()
+	return _u
+}
+
+// SetSignupDeviceID sets the "signup_device_id" field.
+func (_u *UserUpdate) SetSignupDeviceID(v string) *UserUpdate {
+	_u.mutation.SetSignupDeviceID(v)
+	return _u
+}
+
+// SetNillableSignupDeviceID sets the "signup_device_id" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableSignupDeviceID(v *string) *UserUpdate {
+	if v != nil {
+		_u.SetSignupDeviceID(*v)
+	}
+	return _u
+}
+
+// ClearSignupDeviceID clears the value of the "signup_device_id" field.
+func (_u *UserUpdate) ClearSignupDeviceID() *UserUpdate {
+	_u.mutation.ClearSignupDeviceID()
+	return _u
+}
+
+// SetPhoneHash sets the "phone_hash" field.
+func (_u *UserUpdate) SetPhoneHash(v string) *UserUpdate {
+	_u.mutation.SetPhoneHash(v)
+	return _u
+}
+
+// SetNillablePhoneHash sets the "phone_hash" field if the given value is not nil.
+func (_u *UserUpdate) SetNillablePhoneHash(v *string) *UserUpdate {
+	if v != nil {
+		_u.SetPhoneHash(*v)
+	}
+	return _u
+}
+
+// ClearPhoneHash clears the value of the "phone_hash" field.
+func (_u *UserUpdate) ClearPhoneHash() *UserUpdate {
+	_u.mutation.ClearPhoneHash()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *UserUpdate) SetUpdatedAt(v time.Time) *UserUpdate {
 	_u.mutation.SetUpdatedAt(v)
@@ -920,6 +987,111 @@ func (_u *UserUpdate) AddPaymentOrders(v ...*PaymentOrder) *UserUpdate {
 	return _u.AddPaymentOrderIDs(ids...)
 }
 
+// AddBalanceDriftIDs adds the "balance_drifts" edge to the BalanceDrift entity by IDs.
+func (_u *UserUpdate) AddBalanceDriftIDs(ids ...string) *UserUpdate {
+	_u.mutation.AddBalanceDriftIDs(ids...)
+	return _u
+}
+
+// AddBalanceDrifts adds the "balance_drifts" edges to the BalanceDrift entity.
+func (_u *UserUpdate) AddBalanceDrifts(v ...*BalanceDrift) *UserUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBalanceDriftIDs(ids...)
+}
+
+// AddSubscriptionIDs adds the "subscriptions" edge to the Subscription entity by IDs.
+func (_u *UserUpdate) AddSubscriptionIDs(ids ...string) *UserUpdate {
+	_u.mutation.AddSubscriptionIDs(ids...)
+	return _u
+}
+
+// AddSubscriptions adds the "subscriptions" edges to the Subscription entity.
+func (_u *UserUpdate) AddSubscriptions(v ...*Subscription) *UserUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSubscriptionIDs(ids...)
+}
+
+// AddRevealViewIDs adds the "reveal_views" edge to the RevealView entity by IDs.
+func (_u *UserUpdate) AddRevealViewIDs(ids ...string) *UserUpdate {
+	_u.mutation.AddRevealViewIDs(ids...)
+	return _u
+}
+
+// AddRevealViews adds the "reveal_views" edges to the RevealView entity.
+func (_u *UserUpdate) AddRevealViews(v ...*RevealView) *UserUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRevealViewIDs(ids...)
+}
+
+// AddRevealGiftsSentIDs adds the "reveal_gifts_sent" edge to the RevealGift entity by IDs.
+func (_u *UserUpdate) AddRevealGiftsSentIDs(ids ...string) *UserUpdate {
+	_u.mutation.AddRevealGiftsSentIDs(ids...)
+	return _u
+}
+
+// AddRevealGiftsSent adds the "reveal_gifts_sent" edges to the RevealGift entity.
+func (_u *UserUpdate) AddRevealGiftsSent(v ...*RevealGift) *UserUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRevealGiftsSentIDs(ids...)
+}
+
+// AddRevealGiftsReceivedIDs adds the "reveal_gifts_received" edge to the RevealGift entity by IDs.
+func (_u *UserUpdate) AddRevealGiftsReceivedIDs(ids ...string) *UserUpdate {
+	_u.mutation.AddRevealGiftsReceivedIDs(ids...)
+	return _u
+}
+
+// AddRevealGiftsReceived adds the "reveal_gifts_received" edges to the RevealGift entity.
+func (_u *UserUpdate) AddRevealGiftsReceived(v ...*RevealGift) *UserUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRevealGiftsReceivedIDs(ids...)
+}
+
+// AddChatMessageIDs adds the "chat_messages" edge to the Message entity by IDs.
+func (_u *UserUpdate) AddChatMessageIDs(ids ...string) *UserUpdate {
+	_u.mutation.AddChatMessageIDs(ids...)
+	return _u
+}
+
+// AddChatMessages adds the "chat_messages" edges to the Message entity.
+func (_u *UserUpdate) AddChatMessages(v ...*Message) *UserUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddChatMessageIDs(ids...)
+}
+
+// AddModerationActionIDs adds the "moderation_actions" edge to the ModerationAction entity by IDs.
+func (_u *UserUpdate) AddModerationActionIDs(ids ...string) *UserUpdate {
+	_u.mutation.AddModerationActionIDs(ids...)
+	return _u
+}
+
+// AddModerationActions adds the "moderation_actions" edges to the ModerationAction entity.
+func (_u *UserUpdate) AddModerationActions(v ...*ModerationAction) *UserUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddModerationActionIDs(ids...)
+}
+
 // AddBlocksGivenIDs adds the "blocks_given" edge to the UserBlock entity by IDs.
 func (_u *UserUpdate) AddBlocksGivenIDs(ids ...string) *UserUpdate {
 	_u.mutation.AddBlocksGivenIDs(ids...)
@@ -980,6 +1152,21 @@ func (_u *UserUpdate) AddReportsReceived(v ...*UserReport) *UserUpdate {
 	return _u.AddReportsReceivedIDs(ids...)
 }
 
+// AddNotificationIDs adds the "notifications" edge to the Notification entity by IDs.
+func (_u *UserUpdate) AddNotificationIDs(ids ...string) *UserUpdate {
+	_u.mutation.AddNotificationIDs(ids...)
+	return _u
+}
+
+// AddNotifications adds the "notifications" edges to the Notification entity.
+func (_u *UserUpdate) AddNotifications(v ...*Notification) *UserUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddNotificationIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_u *UserUpdate) Mutation() *UserMutation {
 	return _u.mutation
@@ -1306,6 +1493,153 @@ func (_u *UserUpdate) RemovePaymentOrders(v ...*PaymentOrder) *UserUpdate {
 	return _u.RemovePaymentOrderIDs(ids...)
 }
 
+// ClearBalanceDrifts clears all "balance_drifts" edges to the BalanceDrift entity.
+func (_u *UserUpdate) ClearBalanceDrifts() *UserUpdate {
+	_u.mutation.ClearBalanceDrifts()
+	return _u
+}
+
+// RemoveBalanceDriftIDs removes the "balance_drifts" edge to BalanceDrift entities by IDs.
+func (_u *UserUpdate) RemoveBalanceDriftIDs(ids ...string) *UserUpdate {
+	_u.mutation.RemoveBalanceDriftIDs(ids...)
+	return _u
+}
+
+// RemoveBalanceDrifts removes "balance_drifts" edges to BalanceDrift entities.
+func (_u *UserUpdate) RemoveBalanceDrifts(v ...*BalanceDrift) *UserUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBalanceDriftIDs(ids...)
+}
+
+// ClearSubscriptions clears all "subscriptions" edges to the Subscription entity.
+func (_u *UserUpdate) ClearSubscriptions() *UserUpdate {
+	_u.mutation.ClearSubscriptions()
+	return _u
+}
+
+// RemoveSubscriptionIDs removes the "subscriptions" edge to Subscription entities by IDs.
+func (_u *UserUpdate) RemoveSubscriptionIDs(ids ...string) *UserUpdate {
+	_u.mutation.RemoveSubscriptionIDs(ids...)
+	return _u
+}
+
+// RemoveSubscriptions removes "subscriptions" edges to Subscription entities.
+func (_u *UserUpdate) RemoveSubscriptions(v ...*Subscription) *UserUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSubscriptionIDs(ids...)
+}
+
+// ClearRevealViews clears all "reveal_views" edges to the RevealView entity.
+func (_u *UserUpdate) ClearRevealViews() *UserUpdate {
+	_u.mutation.ClearRevealViews()
+	return _u
+}
+
+// RemoveRevealViewIDs removes the "reveal_views" edge to RevealView entities by IDs.
+func (_u *UserUpdate) RemoveRevealViewIDs(ids ...string) *UserUpdate {
+	_u.mutation.RemoveRevealViewIDs(ids...)
+	return _u
+}
+
+// RemoveRevealViews removes "reveal_views" edges to RevealView entities.
+func (_u *UserUpdate) RemoveRevealViews(v ...*RevealView) *UserUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRevealViewIDs(ids...)
+}
+
+// ClearRevealGiftsSent clears all "reveal_gifts_sent" edges to the RevealGift entity.
+func (_u *UserUpdate) ClearRevealGiftsSent() *UserUpdate {
+	_u.mutation.ClearRevealGiftsSent()
+	return _u
+}
+
+// RemoveRevealGiftsSentIDs removes the "reveal_gifts_sent" edge to RevealGift entities by IDs.
+func (_u *UserUpdate) RemoveRevealGiftsSentIDs(ids ...string) *UserUpdate {
+	_u.mutation.RemoveRevealGiftsSentIDs(ids...)
+	return _u
+}
+
+// RemoveRevealGiftsSent removes "reveal_gifts_sent" edges to RevealGift entities.
+func (_u *UserUpdate) RemoveRevealGiftsSent(v ...*RevealGift) *UserUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRevealGiftsSentIDs(ids...)
+}
+
+// ClearRevealGiftsReceived clears all "reveal_gifts_received" edges to the RevealGift entity.
+func (_u *UserUpdate) ClearRevealGiftsReceived() *UserUpdate {
+	_u.mutation.ClearRevealGiftsReceived()
+	return _u
+}
+
+// RemoveRevealGiftsReceivedIDs removes the "reveal_gifts_received" edge to RevealGift entities by IDs.
+func (_u *UserUpdate) RemoveRevealGiftsReceivedIDs(ids ...string) *UserUpdate {
+	_u.mutation.RemoveRevealGiftsReceivedIDs(ids...)
+	return _u
+}
+
+// RemoveRevealGiftsReceived removes "reveal_gifts_received" edges to RevealGift entities.
+func (_u *UserUpdate) RemoveRevealGiftsReceived(v ...*RevealGift) *UserUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRevealGiftsReceivedIDs(ids...)
+}
+
+// ClearChatMessages clears all "chat_messages" edges to the Message entity.
+func (_u *UserUpdate) ClearChatMessages() *UserUpdate {
+	_u.mutation.ClearChatMessages()
+	return _u
+}
+
+// RemoveChatMessageIDs removes the "chat_messages" edge to Message entities by IDs.
+func (_u *UserUpdate) RemoveChatMessageIDs(ids ...string) *UserUpdate {
+	_u.mutation.RemoveChatMessageIDs(ids...)
+	return _u
+}
+
+// RemoveChatMessages removes "chat_messages" edges to Message entities.
+func (_u *UserUpdate) RemoveChatMessages(v ...*Message) *UserUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveChatMessageIDs(ids...)
+}
+
+// ClearModerationActions clears all "moderation_actions" edges to the ModerationAction entity.
+func (_u *UserUpdate) ClearModerationActions() *UserUpdate {
+	_u.mutation.ClearModerationActions()
+	return _u
+}
+
+// RemoveModerationActionIDs removes the "moderation_actions" edge to ModerationAction entities by IDs.
+func (_u *UserUpdate) RemoveModerationActionIDs(ids ...string) *UserUpdate {
+	_u.mutation.RemoveModerationActionIDs(ids...)
+	return _u
+}
+
+// RemoveModerationActions removes "moderation_actions" edges to ModerationAction entities.
+func (_u *UserUpdate) RemoveModerationActions(v ...*ModerationAction) *UserUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveModerationActionIDs(ids...)
+}
+
 // ClearBlocksGiven clears all "blocks_given" edges to the UserBlock entity.
 func (_u *UserUpdate) ClearBlocksGiven() *UserUpdate {
 	_u.mutation.ClearBlocksGiven()
@@ -1390,6 +1724,27 @@ func (_u *UserUpdate) RemoveReportsReceived(v ...*UserReport) *UserUpdate {
 	return _u.RemoveReportsReceivedIDs(ids...)
 }
 
+// ClearNotifications clears all "notifications" edges to the Notification entity.
+func (_u *UserUpdate) ClearNotifications() *UserUpdate {
+	_u.mutation.ClearNotifications()
+	return _u
+}
+
+// RemoveNotificationIDs removes the "notifications" edge to Notification entities by IDs.
+func (_u *UserUpdate) RemoveNotificationIDs(ids ...string) *UserUpdate {
+	_u.mutation.RemoveNotificationIDs(ids...)
+	return _u
+}
+
+// RemoveNotifications removes "notifications" edges to Notification entities.
+func (_u *UserUpdate) RemoveNotifications(v ...*Notification) *UserUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveNotificationIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *UserUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -1533,6 +1888,21 @@ func (_u *UserUpdate) check() error {
 			return &ValidationError{Name: "suspension_reason", err: fmt.Errorf(`generated: validator failed for field "User.suspension_reason": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.ReferralCode(); ok {
+		if err := user.ReferralCodeValidator(v); err != nil {
+			return &ValidationError{Name: "referral_code", err: fmt.Errorf(`generated: validator failed for field "User.referral_code": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SignupDeviceID(); ok {
+		if err := user.SignupDeviceIDValidator(v); err != nil {
+			return &ValidationError{Name: "signup_device_id", err: fmt.Errorf(`generated: validator failed for field "User.signup_device_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PhoneHash(); ok {
+		if err := user.PhoneHashValidator(v); err != nil {
+			return &ValidationError{Name: "phone_hash", err: fmt.Errorf(`generated: validator failed for field "User.phone_hash": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -1725,6 +2095,24 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.SuspensionReasonCleared() {
 		_spec.ClearField(user.FieldSuspensionReason, field.TypeString)
 	}
+	if value, ok := _u.mutation.ReferralCode(); ok {
+		_spec.SetField(user.FieldReferralCode, field.TypeString, value)
+	}
+	if _u.mutation.ReferralCodeCleared() {
+		_spec.ClearField(user.FieldReferralCode, field.TypeString)
+	}
+	if value, ok := _u.mutation.SignupDeviceID(); ok {
+		_spec.SetField(user.FieldSignupDeviceID, field.TypeString, value)
+	}
+	if _u.mutation.SignupDeviceIDCleared() {
+		_spec.ClearField(user.FieldSignupDeviceID, field.TypeString)
+	}
+	if value, ok := _u.mutation.PhoneHash(); ok {
+		_spec.SetField(user.FieldPhoneHash, field.TypeString, value)
+	}
+	if _u.mutation.PhoneHashCleared() {
+		_spec.ClearField(user.FieldPhoneHash, field.TypeString)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(user.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -2438,28 +2826,28 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.BlocksGivenCleared() {
+	if _u.mutation.BalanceDriftsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.BlocksGivenTable,
-			Columns: []string{user.BlocksGivenColumn},
+			Table:   user.BalanceDriftsTable,
+			Columns: []string{user.BalanceDriftsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(userblock.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(balancedrift.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedBlocksGivenIDs(); len(nodes) > 0 && !_u.mutation.BlocksGivenCleared() {
+	if nodes := _u.mutation.RemovedBalanceDriftsIDs(); len(nodes) > 0 && !_u.mutation.BalanceDriftsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.BlocksGivenTable,
-			Columns: []string{user.BlocksGivenColumn},
+			Table:   user.BalanceDriftsTable,
+			Columns: []string{user.BalanceDriftsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(userblock.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(balancedrift.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -2467,15 +2855,15 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.BlocksGivenIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.BalanceDriftsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.BlocksGivenTable,
-			Columns: []string{user.BlocksGivenColumn},
+			Table:   user.BalanceDriftsTable,
+			Columns: []string{user.BalanceDriftsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(userblock.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(balancedrift.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -2483,28 +2871,28 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.BlocksReceivedCleared() {
+	if _u.mutation.SubscriptionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.BlocksReceivedTable,
-			Columns: []string{user.BlocksReceivedColumn},
+			Table:   user.SubscriptionsTable,
+			Columns: []string{user.SubscriptionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(userblock.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedBlocksReceivedIDs(); len(nodes) > 0 && !_u.mutation.BlocksReceivedCleared() {
+	if nodes := _u.mutation.RemovedSubscriptionsIDs(); len(nodes) > 0 && !_u.mutation.SubscriptionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.BlocksReceivedTable,
-			Columns: []string{user.BlocksReceivedColumn},
+			Table:   user.SubscriptionsTable,
+			Columns: []string{user.SubscriptionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(userblock.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -2512,15 +2900,15 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.BlocksReceivedIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.SubscriptionsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.BlocksReceivedTable,
-			Columns: []string{user.BlocksReceivedColumn},
+			Table:   user.SubscriptionsTable,
+			Columns: []string{user.SubscriptionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(userblock.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -2528,28 +2916,28 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.ReportsGivenCleared() {
+	if _u.mutation.RevealViewsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.ReportsGivenTable,
-			Columns: []string{user.ReportsGivenColumn},
+			Table:   user.RevealViewsTable,
+			Columns: []string{user.RevealViewsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(userreport.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(revealview.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedReportsGivenIDs(); len(nodes) > 0 && !_u.mutation.ReportsGivenCleared() {
+	if nodes := _u.mutation.RemovedRevealViewsIDs(); len(nodes) > 0 && !_u.mutation.RevealViewsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.ReportsGivenTable,
-			Columns: []string{user.ReportsGivenColumn},
+			Table:   user.RevealViewsTable,
+			Columns: []string{user.RevealViewsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(userreport.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(revealview.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -2557,15 +2945,15 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.ReportsGivenIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.RevealViewsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.ReportsGivenTable,
-			Columns: []string{user.ReportsGivenColumn},
+			Table:   user.RevealViewsTable,
+			Columns: []string{user.RevealViewsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(userreport.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(revealview.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -2573,44 +2961,404 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.ReportsReceivedCleared() {
+	if _u.mutation.RevealGiftsSentCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.ReportsReceivedTable,
-			Columns: []string{user.ReportsReceivedColumn},
+			Table:   user.RevealGiftsSentTable,
+			Columns: []string{user.RevealGiftsSentColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(userreport.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(revealgift.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedReportsReceivedIDs(); len(nodes) > 0 && !_u.mutation.ReportsReceivedCleared() {
+	if nodes := _u.mutation.RemovedRevealGiftsSentIDs(); len(nodes) > 0 && !_u.mutation.RevealGiftsSentCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.ReportsReceivedTable,
-			Columns: []string{user.ReportsReceivedColumn},
+			Table:   user.RevealGiftsSentTable,
+			Columns: []string{user.RevealGiftsSentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(revealgift.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RevealGiftsSentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RevealGiftsSentTable,
+			Columns: []string{user.RevealGiftsSentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(revealgift.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RevealGiftsReceivedCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RevealGiftsReceivedTable,
+			Columns: []string{user.RevealGiftsReceivedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(revealgift.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRevealGiftsReceivedIDs(); len(nodes) > 0 && !_u.mutation.RevealGiftsReceivedCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RevealGiftsReceivedTable,
+			Columns: []string{user.RevealGiftsReceivedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(revealgift.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RevealGiftsReceivedIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RevealGiftsReceivedTable,
+			Columns: []string{user.RevealGiftsReceivedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(revealgift.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ChatMessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ChatMessagesTable,
+			Columns: []string{user.ChatMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedChatMessagesIDs(); len(nodes) > 0 && !_u.mutation.ChatMessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ChatMessagesTable,
+			Columns: []string{user.ChatMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ChatMessagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ChatMessagesTable,
+			Columns: []string{user.ChatMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ModerationActionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ModerationActionsTable,
+			Columns: []string{user.ModerationActionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(moderationaction.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedModerationActionsIDs(); len(nodes) > 0 && !_u.mutation.ModerationActionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ModerationActionsTable,
+			Columns: []string{user.ModerationActionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(moderationaction.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ModerationActionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ModerationActionsTable,
+			Columns: []string{user.ModerationActionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(moderationaction.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BlocksGivenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BlocksGivenTable,
+			Columns: []string{user.BlocksGivenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(userblock.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedBlocksGivenIDs(); len(nodes) > 0 && !_u.mutation.BlocksGivenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BlocksGivenTable,
+			Columns: []string{user.BlocksGivenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(userblock.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BlocksGivenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BlocksGivenTable,
+			Columns: []string{user.BlocksGivenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(userblock.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BlocksReceivedCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BlocksReceivedTable,
+			Columns: []string{user.BlocksReceivedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(userblock.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedBlocksReceivedIDs(); len(nodes) > 0 && !_u.mutation.BlocksReceivedCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BlocksReceivedTable,
+			Columns: []string{user.BlocksReceivedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(userblock.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BlocksReceivedIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BlocksReceivedTable,
+			Columns: []string{user.BlocksReceivedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(userblock.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReportsGivenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReportsGivenTable,
+			Columns: []string{user.ReportsGivenColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(userreport.FieldID, field.TypeString),
 			},
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedReportsGivenIDs(); len(nodes) > 0 && !_u.mutation.ReportsGivenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReportsGivenTable,
+			Columns: []string{user.ReportsGivenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(userreport.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReportsGivenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReportsGivenTable,
+			Columns: []string{user.ReportsGivenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(userreport.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReportsReceivedCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReportsReceivedTable,
+			Columns: []string{user.ReportsReceivedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(userreport.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedReportsReceivedIDs(); len(nodes) > 0 && !_u.mutation.ReportsReceivedCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReportsReceivedTable,
+			Columns: []string{user.ReportsReceivedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(userreport.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReportsReceivedIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReportsReceivedTable,
+			Columns: []string{user.ReportsReceivedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(userreport.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.NotificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NotificationsTable,
+			Columns: []string{user.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedNotificationsIDs(); len(nodes) > 0 && !_u.mutation.NotificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NotificationsTable,
+			Columns: []string{user.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
+			},
+		}
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.ReportsReceivedIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.NotificationsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.ReportsReceivedTable,
-			Columns: []string{user.ReportsReceivedColumn},
+			Table:   user.NotificationsTable,
+			Columns: []string{user.NotificationsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(userreport.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -3245,6 +3993,66 @@ func (_u *UserUpdateOne) ClearSuspensionReason() *UserUpdateOne {
 	return _u
 }
 
+// SetReferralCode sets the "referral_code" field.
+func (_u *UserUpdateOne) SetReferralCode(v string) *UserUpdateOne {
+	_u.mutation.SetReferralCode(v)
+	return _u
+}
+
+// SetNillableReferralCode sets the "referral_code" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableReferralCode(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetReferralCode(*v)
+	}
+	return _u
+}
+
+// ClearReferralCode clears the value of the "referral_code" field.
+func (_u *UserUpdateOne) ClearReferralCode() *UserUpdateOne {
+	_u.mutation.ClearReferralCode()
+	return _u
+}
+
+// SetSignupDeviceID sets the "signup_device_id" field.
+func (_u *UserUpdateOne) SetSignupDeviceID(v string) *UserUpdateOne {
+	_u.mutation.SetSignupDeviceID(v)
+	return _u
+}
+
+// SetNillableSignupDeviceID sets the "signup_device_id" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableSignupDeviceID(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetSignupDeviceID(*v)
+	}
+	return _u
+}
+
+// ClearSignupDeviceID clears the value of the "signup_device_id" field.
+func (_u *UserUpdateOne) ClearSignupDeviceID() *UserUpdateOne {
+	_u.mutation.ClearSignupDeviceID()
+	return _u
+}
+
+// SetPhoneHash sets the "phone_hash" field.
+func (_u *UserUpdateOne) SetPhoneHash(v string) *UserUpdateOne {
+	_u.mutation.SetPhoneHash(v)
+	return _u
+}
+
+// SetNillablePhoneHash sets the "phone_hash" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillablePhoneHash(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetPhoneHash(*v)
+	}
+	return _u
+}
+
+// ClearPhoneHash clears the value of the "phone_hash" field.
+func (_u *UserUpdateOne) ClearPhoneHash() *UserUpdateOne {
+	_u.mutation.ClearPhoneHash()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *UserUpdateOne) SetUpdatedAt(v time.Time) *UserUpdateOne {
 	_u.mutation.SetUpdatedAt(v)
@@ -3515,6 +4323,111 @@ func (_u *UserUpdateOne) AddPaymentOrders(v ...*PaymentOrder) *UserUpdateOne {
 	return _u.AddPaymentOrderIDs(ids...)
 }
 
+// AddBalanceDriftIDs adds the "balance_drifts" edge to the BalanceDrift entity by IDs.
+func (_u *UserUpdateOne) AddBalanceDriftIDs(ids ...string) *UserUpdateOne {
+	_u.mutation.AddBalanceDriftIDs(ids...)
+	return _u
+}
+
+// AddBalanceDrifts adds the "balance_drifts" edges to the BalanceDrift entity.
+func (_u *UserUpdateOne) AddBalanceDrifts(v ...*BalanceDrift) *UserUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBalanceDriftIDs(ids...)
+}
+
+// AddSubscriptionIDs adds the "subscriptions" edge to the Subscription entity by IDs.
+func (_u *UserUpdateOne) AddSubscriptionIDs(ids ...string) *UserUpdateOne {
+	_u.mutation.AddSubscriptionIDs(ids...)
+	return _u
+}
+
+// AddSubscriptions adds the "subscriptions" edges to the Subscription entity.
+func (_u *UserUpdateOne) AddSubscriptions(v ...*Subscription) *UserUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSubscriptionIDs(ids...)
+}
+
+// AddRevealViewIDs adds the "reveal_views" edge to the RevealView entity by IDs.
+func (_u *UserUpdateOne) AddRevealViewIDs(ids ...string) *UserUpdateOne {
+	_u.mutation.AddRevealViewIDs(ids...)
+	return _u
+}
+
+// AddRevealViews adds the "reveal_views" edges to the RevealView entity.
+func (_u *UserUpdateOne) AddRevealViews(v ...*RevealView) *UserUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRevealViewIDs(ids...)
+}
+
+// AddRevealGiftsSentIDs adds the "reveal_gifts_sent" edge to the RevealGift entity by IDs.
+func (_u *UserUpdateOne) AddRevealGiftsSentIDs(ids ...string) *UserUpdateOne {
+	_u.mutation.AddRevealGiftsSentIDs(ids...)
+	return _u
+}
+
+// AddRevealGiftsSent adds the "reveal_gifts_sent" edges to the RevealGift entity.
+func (_u *UserUpdateOne) AddRevealGiftsSent(v ...*RevealGift) *UserUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRevealGiftsSentIDs(ids...)
+}
+
+// AddRevealGiftsReceivedIDs adds the "reveal_gifts_received" edge to the RevealGift entity by IDs.
+func (_u *UserUpdateOne) AddRevealGiftsReceivedIDs(ids ...string) *UserUpdateOne {
+	_u.mutation.AddRevealGiftsReceivedIDs(ids...)
+	return _u
+}
+
+// AddRevealGiftsReceived adds the "reveal_gifts_received" edges to the RevealGift entity.
+func (_u *UserUpdateOne) AddRevealGiftsReceived(v ...*RevealGift) *UserUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRevealGiftsReceivedIDs(ids...)
+}
+
+// AddChatMessageIDs adds the "chat_messages" edge to the Message entity by IDs.
+func (_u *UserUpdateOne) AddChatMessageIDs(ids ...string) *UserUpdateOne {
+	_u.mutation.AddChatMessageIDs(ids...)
+	return _u
+}
+
+// AddChatMessages adds the "chat_messages" edges to the Message entity.
+func (_u *UserUpdateOne) AddChatMessages(v ...*Message) *UserUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddChatMessageIDs(ids...)
+}
+
+// AddModerationActionIDs adds the "moderation_actions" edge to the ModerationAction entity by IDs.
+func (_u *UserUpdateOne) AddModerationActionIDs(ids ...string) *UserUpdateOne {
+	_u.mutation.AddModerationActionIDs(ids...)
+	return _u
+}
+
+// AddModerationActions adds the "moderation_actions" edges to the ModerationAction entity.
+func (_u *UserUpdateOne) AddModerationActions(v ...*ModerationAction) *UserUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddModerationActionIDs(ids...)
+}
+
 // AddBlocksGivenIDs adds the "blocks_given" edge to the UserBlock entity by IDs.
 func (_u *UserUpdateOne) AddBlocksGivenIDs(ids ...string) *UserUpdateOne {
 	_u.mutation.AddBlocksGivenIDs(ids...)
@@ -3575,6 +4488,21 @@ func (_u *UserUpdateOne) AddReportsReceived(v ...*UserReport) *UserUpdateOne {
 	return _u.AddReportsReceivedIDs(ids...)
 }
 
+// AddNotificationIDs adds the "notifications" edge to the Notification entity by IDs.
+func (_u *UserUpdateOne) AddNotificationIDs(ids ...string) *UserUpdateOne {
+	_u.mutation.AddNotificationIDs(ids...)
+	return _u
+}
+
+// AddNotifications adds the "notifications" edges to the Notification entity.
+func (_u *UserUpdateOne) AddNotifications(v ...*Notification) *UserUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddNotificationIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_u *UserUpdateOne) Mutation() *UserMutation {
 	return _u.mutation
@@ -3772,133 +4700,280 @@ func (_u *UserUpdateOne) RemoveConnectionsAsB(v ...*Connection) *UserUpdateOne {
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveConnectionsAsBIDs(ids...)
+	return _u.RemoveConnectionsAsBIDs(ids...)
+}
+
+// ClearBrokenStreaks clears all "broken_streaks" edges to the Streak entity.
+func (_u *UserUpdateOne) ClearBrokenStreaks() *UserUpdateOne {
+	_u.mutation.ClearBrokenStreaks()
+	return _u
+}
+
+// RemoveBrokenStreakIDs removes the "broken_streaks" edge to Streak entities by IDs.
+func (_u *UserUpdateOne) RemoveBrokenStreakIDs(ids ...string) *UserUpdateOne {
+	_u.mutation.RemoveBrokenStreakIDs(ids...)
+	return _u
+}
+
+// RemoveBrokenStreaks removes "broken_streaks" edges to Streak entities.
+func (_u *UserUpdateOne) RemoveBrokenStreaks(v ...*Streak) *UserUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBrokenStreakIDs(ids...)
+}
+
+// ClearCheckIns clears all "check_ins" edges to the CheckIn entity.
+func (_u *UserUpdateOne) ClearCheckIns() *UserUpdateOne {
+	_u.mutation.ClearCheckIns()
+	return _u
+}
+
+// RemoveCheckInIDs removes the "check_ins" edge to CheckIn entities by IDs.
+func (_u *UserUpdateOne) RemoveCheckInIDs(ids ...string) *UserUpdateOne {
+	_u.mutation.RemoveCheckInIDs(ids...)
+	return _u
+}
+
+// RemoveCheckIns removes "check_ins" edges to CheckIn entities.
+func (_u *UserUpdateOne) RemoveCheckIns(v ...*CheckIn) *UserUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCheckInIDs(ids...)
+}
+
+// ClearSentNudges clears all "sent_nudges" edges to the Nudge entity.
+func (_u *UserUpdateOne) ClearSentNudges() *UserUpdateOne {
+	_u.mutation.ClearSentNudges()
+	return _u
+}
+
+// RemoveSentNudgeIDs removes the "sent_nudges" edge to Nudge entities by IDs.
+func (_u *UserUpdateOne) RemoveSentNudgeIDs(ids ...string) *UserUpdateOne {
+	_u.mutation.RemoveSentNudgeIDs(ids...)
+	return _u
+}
+
+// RemoveSentNudges removes "sent_nudges" edges to Nudge entities.
+func (_u *UserUpdateOne) RemoveSentNudges(v ...*Nudge) *UserUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSentNudgeIDs(ids...)
+}
+
+// ClearReceivedNudges clears all "received_nudges" edges to the Nudge entity.
+func (_u *UserUpdateOne) ClearReceivedNudges() *UserUpdateOne {
+	_u.mutation.ClearReceivedNudges()
+	return _u
+}
+
+// RemoveReceivedNudgeIDs removes the "received_nudges" edge to Nudge entities by IDs.
+func (_u *UserUpdateOne) RemoveReceivedNudgeIDs(ids ...string) *UserUpdateOne {
+	_u.mutation.RemoveReceivedNudgeIDs(ids...)
+	return _u
+}
+
+// RemoveReceivedNudges removes "received_nudges" edges to Nudge entities.
+func (_u *UserUpdateOne) RemoveReceivedNudges(v ...*Nudge) *UserUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReceivedNudgeIDs(ids...)
+}
+
+// ClearCreditTransactions clears all "credit_transactions" edges to the CreditTransaction entity.
+func (_u *UserUpdateOne) ClearCreditTransactions() *UserUpdateOne {
+	_u.mutation.ClearCreditTransactions()
+	return _u
+}
+
+// RemoveCreditTransactionIDs removes the "credit_transactions" edge to CreditTransaction entities by IDs.
+func (_u *UserUpdateOne) RemoveCreditTransactionIDs(ids ...string) *UserUpdateOne {
+	_u.mutation.RemoveCreditTransactionIDs(ids...)
+	return _u
+}
+
+// RemoveCreditTransactions removes "credit_transactions" edges to CreditTransaction entities.
+func (_u *UserUpdateOne) RemoveCreditTransactions(v ...*CreditTransaction) *UserUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCreditTransactionIDs(ids...)
+}
+
+// ClearPaymentOrders clears all "payment_orders" edges to the PaymentOrder entity.
+func (_u *UserUpdateOne) ClearPaymentOrders() *UserUpdateOne {
+	_u.mutation.ClearPaymentOrders()
+	return _u
+}
+
+// RemovePaymentOrderIDs removes the "payment_orders" edge to PaymentOrder entities by IDs.
+func (_u *UserUpdateOne) RemovePaymentOrderIDs(ids ...string) *UserUpdateOne {
+	_u.mutation.RemovePaymentOrderIDs(ids...)
+	return _u
+}
+
+// RemovePaymentOrders removes "payment_orders" edges to PaymentOrder entities.
+func (_u *UserUpdateOne) RemovePaymentOrders(v ...*PaymentOrder) *UserUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePaymentOrderIDs(ids...)
+}
+
+// ClearBalanceDrifts clears all "balance_drifts" edges to the BalanceDrift entity.
+func (_u *UserUpdateOne) ClearBalanceDrifts() *UserUpdateOne {
+	_u.mutation.ClearBalanceDrifts()
+	return _u
+}
+
+// RemoveBalanceDriftIDs removes the "balance_drifts" edge to BalanceDrift entities by IDs.
+func (_u *UserUpdateOne) RemoveBalanceDriftIDs(ids ...string) *UserUpdateOne {
+	_u.mutation.RemoveBalanceDriftIDs(ids...)
+	return _u
+}
+
+// RemoveBalanceDrifts removes "balance_drifts" edges to BalanceDrift entities.
+func (_u *UserUpdateOne) RemoveBalanceDrifts(v ...*BalanceDrift) *UserUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBalanceDriftIDs(ids...)
 }
 
-// ClearBrokenStreaks clears all "broken_streaks" edges to the Streak entity.
-func (_u *UserUpdateOne) ClearBrokenStreaks() *UserUpdateOne {
-	_u.mutation.ClearBrokenStreaks()
+// ClearSubscriptions clears all "subscriptions" edges to the Subscription entity.
+func (_u *UserUpdateOne) ClearSubscriptions() *UserUpdateOne {
+	_u.mutation.ClearSubscriptions()
 	return _u
 }
 
-// RemoveBrokenStreakIDs removes the "broken_streaks" edge to Streak entities by IDs.
-func (_u *UserUpdateOne) RemoveBrokenStreakIDs(ids ...string) *UserUpdateOne {
-	_u.mutation.RemoveBrokenStreakIDs(ids...)
+// RemoveSubscriptionIDs removes the "subscriptions" edge to Subscription entities by IDs.
+func (_u *UserUpdateOne) RemoveSubscriptionIDs(ids ...string) *UserUpdateOne {
+	_u.mutation.RemoveSubscriptionIDs(ids...)
 	return _u
 }
 
-// RemoveBrokenStreaks removes "broken_streaks" edges to Streak entities.
-func (_u *UserUpdateOne) RemoveBrokenStreaks(v ...*Streak) *UserUpdateOne {
+// RemoveSubscriptions removes "subscriptions" edges to Subscription entities.
+func (_u *UserUpdateOne) RemoveSubscriptions(v ...*Subscription) *UserUpdateOne {
 	ids := make([]string, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveBrokenStreakIDs(ids...)
+	return _u.RemoveSubscriptionIDs(ids...)
 }
 
-// ClearCheckIns clears all "check_ins" edges to the CheckIn entity.
-func (_u *UserUpdateOne) ClearCheckIns() *UserUpdateOne {
-	_u.mutation.ClearCheckIns()
+// ClearRevealViews clears all "reveal_views" edges to the RevealView entity.
+func (_u *UserUpdateOne) ClearRevealViews() *UserUpdateOne {
+	_u.mutation.ClearRevealViews()
 	return _u
 }
 
-// RemoveCheckInIDs removes the "check_ins" edge to CheckIn entities by IDs.
-func (_u *UserUpdateOne) RemoveCheckInIDs(ids ...string) *UserUpdateOne {
-	_u.mutation.RemoveCheckInIDs(ids...)
+// RemoveRevealViewIDs removes the "reveal_views" edge to RevealView entities by IDs.
+func (_u *UserUpdateOne) RemoveRevealViewIDs(ids ...string) *UserUpdateOne {
+	_u.mutation.RemoveRevealViewIDs(ids...)
 	return _u
 }
 
-// RemoveCheckIns removes "check_ins" edges to CheckIn entities.
-func (_u *UserUpdateOne) RemoveCheckIns(v ...*CheckIn) *UserUpdateOne {
+// RemoveRevealViews removes "reveal_views" edges to RevealView entities.
+func (_u *UserUpdateOne) RemoveRevealViews(v ...*RevealView) *UserUpdateOne {
 	ids := make([]string, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveCheckInIDs(ids...)
+	return _u.RemoveRevealViewIDs(ids...)
 }
 
-// ClearSentNudges clears all "sent_nudges" edges to the Nudge entity.
-func (_u *UserUpdateOne) ClearSentNudges() *UserUpdateOne {
-	_u.mutation.ClearSentNudges()
+// ClearRevealGiftsSent clears all "reveal_gifts_sent" edges to the RevealGift entity.
+func (_u *UserUpdateOne) ClearRevealGiftsSent() *UserUpdateOne {
+	_u.mutation.ClearRevealGiftsSent()
 	return _u
 }
 
-// RemoveSentNudgeIDs removes the "sent_nudges" edge to Nudge entities by IDs.
-func (_u *UserUpdateOne) RemoveSentNudgeIDs(ids ...string) *UserUpdateOne {
-	_u.mutation.RemoveSentNudgeIDs(ids...)
+// RemoveRevealGiftsSentIDs removes the "reveal_gifts_sent" edge to RevealGift entities by IDs.
+func (_u *UserUpdateOne) RemoveRevealGiftsSentIDs(ids ...string) *UserUpdateOne {
+	_u.mutation.RemoveRevealGiftsSentIDs(ids...)
 	return _u
 }
 
-// RemoveSentNudges removes "sent_nudges" edges to Nudge entities.
-func (_u *UserUpdateOne) RemoveSentNudges(v ...*Nudge) *UserUpdateOne {
+// RemoveRevealGiftsSent removes "reveal_gifts_sent" edges to RevealGift entities.
+func (_u *UserUpdateOne) RemoveRevealGiftsSent(v ...*RevealGift) *UserUpdateOne {
 	ids := make([]string, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveSentNudgeIDs(ids...)
+	return _u.RemoveRevealGiftsSentIDs(ids...)
 }
 
-// ClearReceivedNudges clears all "received_nudges" edges to the Nudge entity.
-func (_u *UserUpdateOne) ClearReceivedNudges() *UserUpdateOne {
-	_u.mutation.ClearReceivedNudges()
+// ClearRevealGiftsReceived clears all "reveal_gifts_received" edges to the RevealGift entity.
+func (_u *UserUpdateOne) ClearRevealGiftsReceived() *UserUpdateOne {
+	_u.mutation.ClearRevealGiftsReceived()
 	return _u
 }
 
-// RemoveReceivedNudgeIDs removes the "received_nudges" edge to Nudge entities by IDs.
-func (_u *UserUpdateOne) RemoveReceivedNudgeIDs(ids ...string) *UserUpdateOne {
-	_u.mutation.RemoveReceivedNudgeIDs(ids...)
+// RemoveRevealGiftsReceivedIDs removes the "reveal_gifts_received" edge to RevealGift entities by IDs.
+func (_u *UserUpdateOne) RemoveRevealGiftsReceivedIDs(ids ...string) *UserUpdateOne {
+	_u.mutation.RemoveRevealGiftsReceivedIDs(ids...)
 	return _u
 }
 
-// RemoveReceivedNudges removes "received_nudges" edges to Nudge entities.
-func (_u *UserUpdateOne) RemoveReceivedNudges(v ...*Nudge) *UserUpdateOne {
+// RemoveRevealGiftsReceived removes "reveal_gifts_received" edges to RevealGift entities.
+func (_u *UserUpdateOne) RemoveRevealGiftsReceived(v ...*RevealGift) *UserUpdateOne {
 	ids := make([]string, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveReceivedNudgeIDs(ids...)
+	return _u.RemoveRevealGiftsReceivedIDs(ids...)
 }
 
-// ClearCreditTransactions clears all "credit_transactions" edges to the CreditTransaction entity.
-func (_u *UserUpdateOne) ClearCreditTransactions() *UserUpdateOne {
-	_u.mutation.ClearCreditTransactions()
+// ClearChatMessages clears all "chat_messages" edges to the Message entity.
+func (_u *UserUpdateOne) ClearChatMessages() *UserUpdateOne {
+	_u.mutation.ClearChatMessages()
 	return _u
 }
 
-// RemoveCreditTransactionIDs removes the "credit_transactions" edge to CreditTransaction entities by IDs.
-func (_u *UserUpdateOne) RemoveCreditTransactionIDs(ids ...string) *UserUpdateOne {
-	_u.mutation.RemoveCreditTransactionIDs(ids...)
+// RemoveChatMessageIDs removes the "chat_messages" edge to Message entities by IDs.
+func (_u *UserUpdateOne) RemoveChatMessageIDs(ids ...string) *UserUpdateOne {
+	_u.mutation.RemoveChatMessageIDs(ids...)
 	return _u
 }
 
-// RemoveCreditTransactions removes "credit_transactions" edges to CreditTransaction entities.
-func (_u *UserUpdateOne) RemoveCreditTransactions(v ...*CreditTransaction) *UserUpdateOne {
+// RemoveChatMessages removes "chat_messages" edges to Message entities.
+func (_u *UserUpdateOne) RemoveChatMessages(v ...*Message) *UserUpdateOne {
 	ids := make([]string, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveCreditTransactionIDs(ids...)
+	return _u.RemoveChatMessageIDs(ids...)
 }
 
-// ClearPaymentOrders clears all "payment_orders" edges to the PaymentOrder entity.
-func (_u *UserUpdateOne) ClearPaymentOrders() *UserUpdateOne {
-	_u.mutation.ClearPaymentOrders()
+// ClearModerationActions clears all "moderation_actions" edges to the ModerationAction entity.
+func (_u *UserUpdateOne) ClearModerationActions() *UserUpdateOne {
+	_u.mutation.ClearModerationActions()
 	return _u
 }
 
-// RemovePaymentOrderIDs removes the "payment_orders" edge to PaymentOrder entities by IDs.
-func (_u *UserUpdateOne) RemovePaymentOrderIDs(ids ...string) *UserUpdateOne {
-	_u.mutation.RemovePaymentOrderIDs(ids...)
+// RemoveModerationActionIDs removes the "moderation_actions" edge to ModerationAction entities by IDs.
+func (_u *UserUpdateOne) RemoveModerationActionIDs(ids ...string) *UserUpdateOne {
+	_u.mutation.RemoveModerationActionIDs(ids...)
 	return _u
 }
 
-// RemovePaymentOrders removes "payment_orders" edges to PaymentOrder entities.
-func (_u *UserUpdateOne) RemovePaymentOrders(v ...*PaymentOrder) *UserUpdateOne {
+// RemoveModerationActions removes "moderation_actions" edges to ModerationAction entities.
+func (_u *UserUpdateOne) RemoveModerationActions(v ...*ModerationAction) *UserUpdateOne {
 	ids := make([]string, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemovePaymentOrderIDs(ids...)
+	return _u.RemoveModerationActionIDs(ids...)
 }
 
 // ClearBlocksGiven clears all "blocks_given" edges to the UserBlock entity.
@@ -3985,6 +5060,27 @@ func (_u *UserUpdateOne) RemoveReportsReceived(v ...*UserReport) *UserUpdateOne
 	return _u.RemoveReportsReceivedIDs(ids...)
 }
 
+// ClearNotifications clears all "notifications" edges to the Notification entity.
+func (_u *UserUpdateOne) ClearNotifications() *UserUpdateOne {
+	_u.mutation.ClearNotifications()
+	return _u
+}
+
+// RemoveNotificationIDs removes the "notifications" edge to Notification entities by IDs.
+func (_u *UserUpdateOne) RemoveNotificationIDs(ids ...string) *UserUpdateOne {
+	_u.mutation.RemoveNotificationIDs(ids...)
+	return _u
+}
+
+// RemoveNotifications removes "notifications" edges to Notification entities.
+func (_u *UserUpdateOne) RemoveNotifications(v ...*Notification) *UserUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveNotificationIDs(ids...)
+}
+
 // Where appends a list predicates to the UserUpdate builder.
 func (_u *UserUpdateOne) Where(ps ...predicate.User) *UserUpdateOne {
 	_u.mutation.Where(ps...)
@@ -4141,6 +5237,21 @@ func (_u *UserUpdateOne) check() error {
 			return &ValidationError{Name: "suspension_reason", err: fmt.Errorf(`generated: validator failed for field "User.suspension_reason": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.ReferralCode(); ok {
+		if err := user.ReferralCodeValidator(v); err != nil {
+			return &ValidationError{Name: "referral_code", err: fmt.Errorf(`generated: validator failed for field "User.referral_code": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SignupDeviceID(); ok {
+		if err := user.SignupDeviceIDValidator(v); err != nil {
+			return &ValidationError{Name: "signup_device_id", err: fmt.Errorf(`generated: validator failed for field "User.signup_device_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PhoneHash(); ok {
+		if err := user.PhoneHashValidator(v); err != nil {
+			return &ValidationError{Name: "phone_hash", err: fmt.Errorf(`generated: validator failed for field "User.phone_hash": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -4326,61 +5437,394 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 	if _u.mutation.BehavioralTrustScoreCleared() {
 		_spec.ClearField(user.FieldBehavioralTrustScore, field.TypeFloat64)
 	}
-	if value, ok := _u.mutation.AccountStatus(); ok {
-		_spec.SetField(user.FieldAccountStatus, field.TypeEnum, value)
+	if value, ok := _u.mutation.AccountStatus(); ok {
+		_spec.SetField(user.FieldAccountStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.OnboardingStatus(); ok {
+		_spec.SetField(user.FieldOnboardingStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.LastActiveAt(); ok {
+		_spec.SetField(user.FieldLastActiveAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastActiveAtCleared() {
+		_spec.ClearField(user.FieldLastActiveAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.SuspendedAt(); ok {
+		_spec.SetField(user.FieldSuspendedAt, field.TypeTime, value)
+	}
+	if _u.mutation.SuspendedAtCleared() {
+		_spec.ClearField(user.FieldSuspendedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.SuspensionReason(); ok {
+		_spec.SetField(user.FieldSuspensionReason, field.TypeString, value)
+	}
+	if _u.mutation.SuspensionReasonCleared() {
+		_spec.ClearField(user.FieldSuspensionReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.ReferralCode(); ok {
+		_spec.SetField(user.FieldReferralCode, field.TypeString, value)
+	}
+	if _u.mutation.ReferralCodeCleared() {
+		_spec.ClearField(user.FieldReferralCode, field.TypeString)
+	}
+	if value, ok := _u.mutation.SignupDeviceID(); ok {
+		_spec.SetField(user.FieldSignupDeviceID, field.TypeString, value)
+	}
+	if _u.mutation.SignupDeviceIDCleared() {
+		_spec.ClearField(user.FieldSignupDeviceID, field.TypeString)
+	}
+	if value, ok := _u.mutation.PhoneHash(); ok {
+		_spec.SetField(user.FieldPhoneHash, field.TypeString, value)
+	}
+	if _u.mutation.PhoneHashCleared() {
+		_spec.ClearField(user.FieldPhoneHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(user.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(user.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(user.FieldDeletedAt, field.TypeTime)
+	}
+	if _u.mutation.ProfileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   user.ProfileTable,
+			Columns: []string{user.ProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   user.ProfileTable,
+			Columns: []string{user.ProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PhotosCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PhotosTable,
+			Columns: []string{user.PhotosColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(photo.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPhotosIDs(); len(nodes) > 0 && !_u.mutation.PhotosCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PhotosTable,
+			Columns: []string{user.PhotosColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(photo.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PhotosIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PhotosTable,
+			Columns: []string{user.PhotosColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(photo.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.HobbiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.HobbiesTable,
+			Columns: []string{user.HobbiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hobby.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedHobbiesIDs(); len(nodes) > 0 && !_u.mutation.HobbiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.HobbiesTable,
+			Columns: []string{user.HobbiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hobby.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.HobbiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.HobbiesTable,
+			Columns: []string{user.HobbiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hobby.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.FiltersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.FiltersTable,
+			Columns: []string{user.FiltersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(filter.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedFiltersIDs(); len(nodes) > 0 && !_u.mutation.FiltersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.FiltersTable,
+			Columns: []string{user.FiltersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(filter.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FiltersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.FiltersTable,
+			Columns: []string{user.FiltersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(filter.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := _u.mutation.OnboardingStatus(); ok {
-		_spec.SetField(user.FieldOnboardingStatus, field.TypeEnum, value)
+	if _u.mutation.DiscoveryBatchesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DiscoveryBatchesTable,
+			Columns: []string{user.DiscoveryBatchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discoverybatch.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := _u.mutation.LastActiveAt(); ok {
-		_spec.SetField(user.FieldLastActiveAt, field.TypeTime, value)
+	if nodes := _u.mutation.RemovedDiscoveryBatchesIDs(); len(nodes) > 0 && !_u.mutation.DiscoveryBatchesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DiscoveryBatchesTable,
+			Columns: []string{user.DiscoveryBatchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discoverybatch.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if _u.mutation.LastActiveAtCleared() {
-		_spec.ClearField(user.FieldLastActiveAt, field.TypeTime)
+	if nodes := _u.mutation.DiscoveryBatchesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DiscoveryBatchesTable,
+			Columns: []string{user.DiscoveryBatchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discoverybatch.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := _u.mutation.SuspendedAt(); ok {
-		_spec.SetField(user.FieldSuspendedAt, field.TypeTime, value)
+	if _u.mutation.DiscoveryAppearancesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DiscoveryAppearancesTable,
+			Columns: []string{user.DiscoveryAppearancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discoverycard.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if _u.mutation.SuspendedAtCleared() {
-		_spec.ClearField(user.FieldSuspendedAt, field.TypeTime)
+	if nodes := _u.mutation.RemovedDiscoveryAppearancesIDs(); len(nodes) > 0 && !_u.mutation.DiscoveryAppearancesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DiscoveryAppearancesTable,
+			Columns: []string{user.DiscoveryAppearancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discoverycard.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := _u.mutation.SuspensionReason(); ok {
-		_spec.SetField(user.FieldSuspensionReason, field.TypeString, value)
+	if nodes := _u.mutation.DiscoveryAppearancesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DiscoveryAppearancesTable,
+			Columns: []string{user.DiscoveryAppearancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discoverycard.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.SuspensionReasonCleared() {
-		_spec.ClearField(user.FieldSuspensionReason, field.TypeString)
+	if _u.mutation.SentInterestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SentInterestsTable,
+			Columns: []string{user.SentInterestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(interest.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := _u.mutation.UpdatedAt(); ok {
-		_spec.SetField(user.FieldUpdatedAt, field.TypeTime, value)
+	if nodes := _u.mutation.RemovedSentInterestsIDs(); len(nodes) > 0 && !_u.mutation.SentInterestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SentInterestsTable,
+			Columns: []string{user.SentInterestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(interest.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := _u.mutation.DeletedAt(); ok {
-		_spec.SetField(user.FieldDeletedAt, field.TypeTime, value)
+	if nodes := _u.mutation.SentInterestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SentInterestsTable,
+			Columns: []string{user.SentInterestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(interest.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.DeletedAtCleared() {
-		_spec.ClearField(user.FieldDeletedAt, field.TypeTime)
+	if _u.mutation.ReceivedInterestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReceivedInterestsTable,
+			Columns: []string{user.ReceivedInterestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(interest.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if _u.mutation.ProfileCleared() {
+	if nodes := _u.mutation.RemovedReceivedInterestsIDs(); len(nodes) > 0 && !_u.mutation.ReceivedInterestsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2O,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.ProfileTable,
-			Columns: []string{user.ProfileColumn},
+			Table:   user.ReceivedInterestsTable,
+			Columns: []string{user.ReceivedInterestsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(interest.FieldID, field.TypeString),
 			},
 		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.ProfileIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.ReceivedInterestsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2O,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.ProfileTable,
-			Columns: []string{user.ProfileColumn},
+			Table:   user.ReceivedInterestsTable,
+			Columns: []string{user.ReceivedInterestsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(interest.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -4388,28 +5832,28 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.PhotosCleared() {
+	if _u.mutation.ConnectionsAsACleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.PhotosTable,
-			Columns: []string{user.PhotosColumn},
+			Table:   user.ConnectionsAsATable,
+			Columns: []string{user.ConnectionsAsAColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(photo.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(connection.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedPhotosIDs(); len(nodes) > 0 && !_u.mutation.PhotosCleared() {
+	if nodes := _u.mutation.RemovedConnectionsAsAIDs(); len(nodes) > 0 && !_u.mutation.ConnectionsAsACleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.PhotosTable,
-			Columns: []string{user.PhotosColumn},
+			Table:   user.ConnectionsAsATable,
+			Columns: []string{user.ConnectionsAsAColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(photo.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(connection.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -4417,15 +5861,15 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.PhotosIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.ConnectionsAsAIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.PhotosTable,
-			Columns: []string{user.PhotosColumn},
+			Table:   user.ConnectionsAsATable,
+			Columns: []string{user.ConnectionsAsAColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(photo.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(connection.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -4433,28 +5877,28 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.HobbiesCleared() {
+	if _u.mutation.ConnectionsAsBCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.HobbiesTable,
-			Columns: []string{user.HobbiesColumn},
+			Table:   user.ConnectionsAsBTable,
+			Columns: []string{user.ConnectionsAsBColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(hobby.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(connection.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedHobbiesIDs(); len(nodes) > 0 && !_u.mutation.HobbiesCleared() {
+	if nodes := _u.mutation.RemovedConnectionsAsBIDs(); len(nodes) > 0 && !_u.mutation.ConnectionsAsBCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.HobbiesTable,
-			Columns: []string{user.HobbiesColumn},
+			Table:   user.ConnectionsAsBTable,
+			Columns: []string{user.ConnectionsAsBColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(hobby.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(connection.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -4462,15 +5906,15 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.HobbiesIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.ConnectionsAsBIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.HobbiesTable,
-			Columns: []string{user.HobbiesColumn},
+			Table:   user.ConnectionsAsBTable,
+			Columns: []string{user.ConnectionsAsBColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(hobby.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(connection.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -4478,28 +5922,28 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.FiltersCleared() {
+	if _u.mutation.BrokenStreaksCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.FiltersTable,
-			Columns: []string{user.FiltersColumn},
+			Table:   user.BrokenStreaksTable,
+			Columns: []string{user.BrokenStreaksColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(filter.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(streak.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedFiltersIDs(); len(nodes) > 0 && !_u.mutation.FiltersCleared() {
+	if nodes := _u.mutation.RemovedBrokenStreaksIDs(); len(nodes) > 0 && !_u.mutation.BrokenStreaksCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.FiltersTable,
-			Columns: []string{user.FiltersColumn},
+			Table:   user.BrokenStreaksTable,
+			Columns: []string{user.BrokenStreaksColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(filter.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(streak.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -4507,15 +5951,15 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.FiltersIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.BrokenStreaksIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.FiltersTable,
-			Columns: []string{user.FiltersColumn},
+			Table:   user.BrokenStreaksTable,
+			Columns: []string{user.BrokenStreaksColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(filter.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(streak.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -4523,28 +5967,28 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.DiscoveryBatchesCleared() {
+	if _u.mutation.CheckInsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.DiscoveryBatchesTable,
-			Columns: []string{user.DiscoveryBatchesColumn},
+			Table:   user.CheckInsTable,
+			Columns: []string{user.CheckInsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(discoverybatch.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(checkin.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedDiscoveryBatchesIDs(); len(nodes) > 0 && !_u.mutation.DiscoveryBatchesCleared() {
+	if nodes := _u.mutation.RemovedCheckInsIDs(); len(nodes) > 0 && !_u.mutation.CheckInsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.DiscoveryBatchesTable,
-			Columns: []string{user.DiscoveryBatchesColumn},
+			Table:   user.CheckInsTable,
+			Columns: []string{user.CheckInsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(discoverybatch.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(checkin.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -4552,15 +5996,15 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.DiscoveryBatchesIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.CheckInsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.DiscoveryBatchesTable,
-			Columns: []string{user.DiscoveryBatchesColumn},
+			Table:   user.CheckInsTable,
+			Columns: []string{user.CheckInsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(discoverybatch.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(checkin.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -4568,28 +6012,28 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.DiscoveryAppearancesCleared() {
+	if _u.mutation.SentNudgesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.DiscoveryAppearancesTable,
-			Columns: []string{user.DiscoveryAppearancesColumn},
+			Table:   user.SentNudgesTable,
+			Columns: []string{user.SentNudgesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(discoverycard.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(nudge.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedDiscoveryAppearancesIDs(); len(nodes) > 0 && !_u.mutation.DiscoveryAppearancesCleared() {
+	if nodes := _u.mutation.RemovedSentNudgesIDs(); len(nodes) > 0 && !_u.mutation.SentNudgesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.DiscoveryAppearancesTable,
-			Columns: []string{user.DiscoveryAppearancesColumn},
+			Table:   user.SentNudgesTable,
+			Columns: []string{user.SentNudgesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(discoverycard.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(nudge.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -4597,15 +6041,15 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.DiscoveryAppearancesIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.SentNudgesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.DiscoveryAppearancesTable,
-			Columns: []string{user.DiscoveryAppearancesColumn},
+			Table:   user.SentNudgesTable,
+			Columns: []string{user.SentNudgesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(discoverycard.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(nudge.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -4613,28 +6057,28 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.SentInterestsCleared() {
+	if _u.mutation.ReceivedNudgesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.SentInterestsTable,
-			Columns: []string{user.SentInterestsColumn},
+			Table:   user.ReceivedNudgesTable,
+			Columns: []string{user.ReceivedNudgesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(interest.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(nudge.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedSentInterestsIDs(); len(nodes) > 0 && !_u.mutation.SentInterestsCleared() {
+	if nodes := _u.mutation.RemovedReceivedNudgesIDs(); len(nodes) > 0 && !_u.mutation.ReceivedNudgesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.SentInterestsTable,
-			Columns: []string{user.SentInterestsColumn},
+			Table:   user.ReceivedNudgesTable,
+			Columns: []string{user.ReceivedNudgesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(interest.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(nudge.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -4642,15 +6086,15 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.SentInterestsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.ReceivedNudgesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.SentInterestsTable,
-			Columns: []string{user.SentInterestsColumn},
+			Table:   user.ReceivedNudgesTable,
+			Columns: []string{user.ReceivedNudgesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(interest.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(nudge.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -4658,28 +6102,28 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.ReceivedInterestsCleared() {
+	if _u.mutation.CreditTransactionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.ReceivedInterestsTable,
-			Columns: []string{user.ReceivedInterestsColumn},
+			Table:   user.CreditTransactionsTable,
+			Columns: []string{user.CreditTransactionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(interest.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(credittransaction.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedReceivedInterestsIDs(); len(nodes) > 0 && !_u.mutation.ReceivedInterestsCleared() {
+	if nodes := _u.mutation.RemovedCreditTransactionsIDs(); len(nodes) > 0 && !_u.mutation.CreditTransactionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.ReceivedInterestsTable,
-			Columns: []string{user.ReceivedInterestsColumn},
+			Table:   user.CreditTransactionsTable,
+			Columns: []string{user.CreditTransactionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(interest.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(credittransaction.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -4687,15 +6131,15 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.ReceivedInterestsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.CreditTransactionsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.ReceivedInterestsTable,
-			Columns: []string{user.ReceivedInterestsColumn},
+			Table:   user.CreditTransactionsTable,
+			Columns: []string{user.CreditTransactionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(interest.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(credittransaction.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -4703,28 +6147,28 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.ConnectionsAsACleared() {
+	if _u.mutation.PaymentOrdersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.ConnectionsAsATable,
-			Columns: []string{user.ConnectionsAsAColumn},
+			Table:   user.PaymentOrdersTable,
+			Columns: []string{user.PaymentOrdersColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(connection.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(paymentorder.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedConnectionsAsAIDs(); len(nodes) > 0 && !_u.mutation.ConnectionsAsACleared() {
+	if nodes := _u.mutation.RemovedPaymentOrdersIDs(); len(nodes) > 0 && !_u.mutation.PaymentOrdersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.ConnectionsAsATable,
-			Columns: []string{user.ConnectionsAsAColumn},
+			Table:   user.PaymentOrdersTable,
+			Columns: []string{user.PaymentOrdersColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(connection.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(paymentorder.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -4732,15 +6176,15 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.ConnectionsAsAIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.PaymentOrdersIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.ConnectionsAsATable,
-			Columns: []string{user.ConnectionsAsAColumn},
+			Table:   user.PaymentOrdersTable,
+			Columns: []string{user.PaymentOrdersColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(connection.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(paymentorder.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -4748,28 +6192,28 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.ConnectionsAsBCleared() {
+	if _u.mutation.BalanceDriftsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.ConnectionsAsBTable,
-			Columns: []string{user.ConnectionsAsBColumn},
+			Table:   user.BalanceDriftsTable,
+			Columns: []string{user.BalanceDriftsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(connection.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(balancedrift.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedConnectionsAsBIDs(); len(nodes) > 0 && !_u.mutation.ConnectionsAsBCleared() {
+	if nodes := _u.mutation.RemovedBalanceDriftsIDs(); len(nodes) > 0 && !_u.mutation.BalanceDriftsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.ConnectionsAsBTable,
-			Columns: []string{user.ConnectionsAsBColumn},
+			Table:   user.BalanceDriftsTable,
+			Columns: []string{user.BalanceDriftsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(connection.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(balancedrift.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -4777,15 +6221,15 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.ConnectionsAsBIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.BalanceDriftsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.ConnectionsAsBTable,
-			Columns: []string{user.ConnectionsAsBColumn},
+			Table:   user.BalanceDriftsTable,
+			Columns: []string{user.BalanceDriftsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(connection.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(balancedrift.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -4793,28 +6237,28 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.BrokenStreaksCleared() {
+	if _u.mutation.SubscriptionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.BrokenStreaksTable,
-			Columns: []string{user.BrokenStreaksColumn},
+			Table:   user.SubscriptionsTable,
+			Columns: []string{user.SubscriptionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(streak.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedBrokenStreaksIDs(); len(nodes) > 0 && !_u.mutation.BrokenStreaksCleared() {
+	if nodes := _u.mutation.RemovedSubscriptionsIDs(); len(nodes) > 0 && !_u.mutation.SubscriptionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.BrokenStreaksTable,
-			Columns: []string{user.BrokenStreaksColumn},
+			Table:   user.SubscriptionsTable,
+			Columns: []string{user.SubscriptionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(streak.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -4822,15 +6266,15 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.BrokenStreaksIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.SubscriptionsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.BrokenStreaksTable,
-			Columns: []string{user.BrokenStreaksColumn},
+			Table:   user.SubscriptionsTable,
+			Columns: []string{user.SubscriptionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(streak.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -4838,28 +6282,28 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.CheckInsCleared() {
+	if _u.mutation.RevealViewsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.CheckInsTable,
-			Columns: []string{user.CheckInsColumn},
+			Table:   user.RevealViewsTable,
+			Columns: []string{user.RevealViewsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(checkin.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(revealview.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedCheckInsIDs(); len(nodes) > 0 && !_u.mutation.CheckInsCleared() {
+	if nodes := _u.mutation.RemovedRevealViewsIDs(); len(nodes) > 0 && !_u.mutation.RevealViewsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.CheckInsTable,
-			Columns: []string{user.CheckInsColumn},
+			Table:   user.RevealViewsTable,
+			Columns: []string{user.RevealViewsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(checkin.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(revealview.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -4867,15 +6311,15 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.CheckInsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.RevealViewsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.CheckInsTable,
-			Columns: []string{user.CheckInsColumn},
+			Table:   user.RevealViewsTable,
+			Columns: []string{user.RevealViewsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(checkin.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(revealview.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -4883,28 +6327,28 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.SentNudgesCleared() {
+	if _u.mutation.RevealGiftsSentCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.SentNudgesTable,
-			Columns: []string{user.SentNudgesColumn},
+			Table:   user.RevealGiftsSentTable,
+			Columns: []string{user.RevealGiftsSentColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(nudge.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(revealgift.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedSentNudgesIDs(); len(nodes) > 0 && !_u.mutation.SentNudgesCleared() {
+	if nodes := _u.mutation.RemovedRevealGiftsSentIDs(); len(nodes) > 0 && !_u.mutation.RevealGiftsSentCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.SentNudgesTable,
-			Columns: []string{user.SentNudgesColumn},
+			Table:   user.RevealGiftsSentTable,
+			Columns: []string{user.RevealGiftsSentColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(nudge.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(revealgift.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -4912,15 +6356,15 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.SentNudgesIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.RevealGiftsSentIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.SentNudgesTable,
-			Columns: []string{user.SentNudgesColumn},
+			Table:   user.RevealGiftsSentTable,
+			Columns: []string{user.RevealGiftsSentColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(nudge.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(revealgift.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -4928,28 +6372,28 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.ReceivedNudgesCleared() {
+	if _u.mutation.RevealGiftsReceivedCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.ReceivedNudgesTable,
-			Columns: []string{user.ReceivedNudgesColumn},
+			Table:   user.RevealGiftsReceivedTable,
+			Columns: []string{user.RevealGiftsReceivedColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(nudge.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(revealgift.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedReceivedNudgesIDs(); len(nodes) > 0 && !_u.mutation.ReceivedNudgesCleared() {
+	if nodes := _u.mutation.RemovedRevealGiftsReceivedIDs(); len(nodes) > 0 && !_u.mutation.RevealGiftsReceivedCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.ReceivedNudgesTable,
-			Columns: []string{user.ReceivedNudgesColumn},
+			Table:   user.RevealGiftsReceivedTable,
+			Columns: []string{user.RevealGiftsReceivedColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(nudge.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(revealgift.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -4957,15 +6401,15 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.ReceivedNudgesIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.RevealGiftsReceivedIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.ReceivedNudgesTable,
-			Columns: []string{user.ReceivedNudgesColumn},
+			Table:   user.RevealGiftsReceivedTable,
+			Columns: []string{user.RevealGiftsReceivedColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(nudge.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(revealgift.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -4973,28 +6417,28 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.CreditTransactionsCleared() {
+	if _u.mutation.ChatMessagesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.CreditTransactionsTable,
-			Columns: []string{user.CreditTransactionsColumn},
+			Table:   user.ChatMessagesTable,
+			Columns: []string{user.ChatMessagesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(credittransaction.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedCreditTransactionsIDs(); len(nodes) > 0 && !_u.mutation.CreditTransactionsCleared() {
+	if nodes := _u.mutation.RemovedChatMessagesIDs(); len(nodes) > 0 && !_u.mutation.ChatMessagesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.CreditTransactionsTable,
-			Columns: []string{user.CreditTransactionsColumn},
+			Table:   user.ChatMessagesTable,
+			Columns: []string{user.ChatMessagesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(credittransaction.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -5002,15 +6446,15 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.CreditTransactionsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.ChatMessagesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.CreditTransactionsTable,
-			Columns: []string{user.CreditTransactionsColumn},
+			Table:   user.ChatMessagesTable,
+			Columns: []string{user.ChatMessagesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(credittransaction.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -5018,28 +6462,28 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.PaymentOrdersCleared() {
+	if _u.mutation.ModerationActionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.PaymentOrdersTable,
-			Columns: []string{user.PaymentOrdersColumn},
+			Table:   user.ModerationActionsTable,
+			Columns: []string{user.ModerationActionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(paymentorder.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(moderationaction.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedPaymentOrdersIDs(); len(nodes) > 0 && !_u.mutation.PaymentOrdersCleared() {
+	if nodes := _u.mutation.RemovedModerationActionsIDs(); len(nodes) > 0 && !_u.mutation.ModerationActionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.PaymentOrdersTable,
-			Columns: []string{user.PaymentOrdersColumn},
+			Table:   user.ModerationActionsTable,
+			Columns: []string{user.ModerationActionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(paymentorder.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(moderationaction.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -5047,15 +6491,15 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.PaymentOrdersIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.ModerationActionsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.PaymentOrdersTable,
-			Columns: []string{user.PaymentOrdersColumn},
+			Table:   user.ModerationActionsTable,
+			Columns: []string{user.ModerationActionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(paymentorder.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(moderationaction.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -5243,6 +6687,51 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.NotificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NotificationsTable,
+			Columns: []string{user.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedNotificationsIDs(); len(nodes) > 0 && !_u.mutation.NotificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NotificationsTable,
+			Columns: []string{user.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.NotificationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NotificationsTable,
+			Columns: []string{user.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &User{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
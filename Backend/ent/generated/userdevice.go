@@ -0,0 +1,172 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/UnoraApp/be/ent/generated/userdevice"
+)
+
+// UserDevice is the model entity for the UserDevice schema.
+type UserDevice struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID string `json:"user_id,omitempty"`
+	// DeviceID holds the value of the "device_id" field.
+	DeviceID string `json:"device_id,omitempty"`
+	// Platform holds the value of the "platform" field.
+	Platform userdevice.Platform `json:"platform,omitempty"`
+	// AttestationStatus holds the value of the "attestation_status" field.
+	AttestationStatus userdevice.AttestationStatus `json:"attestation_status,omitempty"`
+	// LoginCount holds the value of the "login_count" field.
+	LoginCount int `json:"login_count,omitempty"`
+	// FirstSeenAt holds the value of the "first_seen_at" field.
+	FirstSeenAt time.Time `json:"first_seen_at,omitempty"`
+	// LastSeenAt holds the value of the "last_seen_at" field.
+	LastSeenAt   time.Time `json:"last_seen_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*UserDevice) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case userdevice.FieldLoginCount:
+			values[i] = new(sql.NullInt64)
+		case userdevice.FieldID, userdevice.FieldUserID, userdevice.FieldDeviceID, userdevice.FieldPlatform, userdevice.FieldAttestationStatus:
+			values[i] = new(sql.NullString)
+		case userdevice.FieldFirstSeenAt, userdevice.FieldLastSeenAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the UserDevice fields.
+func (_m *UserDevice) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case userdevice.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case userdevice.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = value.String
+			}
+		case userdevice.FieldDeviceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field device_id", values[i])
+			} else if value.Valid {
+				_m.DeviceID = value.String
+			}
+		case userdevice.FieldPlatform:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field platform", values[i])
+			} else if value.Valid {
+				_m.Platform = userdevice.Platform(value.String)
+			}
+		case userdevice.FieldAttestationStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field attestation_status", values[i])
+			} else if value.Valid {
+				_m.AttestationStatus = userdevice.AttestationStatus(value.String)
+			}
+		case userdevice.FieldLoginCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field login_count", values[i])
+			} else if value.Valid {
+				_m.LoginCount = int(value.Int64)
+			}
+		case userdevice.FieldFirstSeenAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field first_seen_at", values[i])
+			} else if value.Valid {
+				_m.FirstSeenAt = value.Time
+			}
+		case userdevice.FieldLastSeenAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_seen_at", values[i])
+			} else if value.Valid {
+				_m.LastSeenAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the UserDevice.
+// This includes values selected through modifiers, order, etc.
+func (_m *UserDevice) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this UserDevice.
+// Note that you need to call UserDevice.Unwrap() before calling this method if this UserDevice
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *UserDevice) Update() *UserDeviceUpdateOne {
+	return NewUserDeviceClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the UserDevice entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *UserDevice) Unwrap() *UserDevice {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("generated: UserDevice is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *UserDevice) String() string {
+	var builder strings.Builder
+	builder.WriteString("UserDevice(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(_m.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("device_id=")
+	builder.WriteString(_m.DeviceID)
+	builder.WriteString(", ")
+	builder.WriteString("platform=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Platform))
+	builder.WriteString(", ")
+	builder.WriteString("attestation_status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AttestationStatus))
+	builder.WriteString(", ")
+	builder.WriteString("login_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LoginCount))
+	builder.WriteString(", ")
+	builder.WriteString("first_seen_at=")
+	builder.WriteString(_m.FirstSeenAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("last_seen_at=")
+	builder.WriteString(_m.LastSeenAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// UserDevices is a parsable slice of UserDevice.
+type UserDevices []*UserDevice
@@ -0,0 +1,167 @@
+// Code generated by ent, DO NOT EDIT.
+
+package userdevice
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the userdevice type in the database.
+	Label = "user_device"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldDeviceID holds the string denoting the device_id field in the database.
+	FieldDeviceID = "device_id"
+	// FieldPlatform holds the string denoting the platform field in the database.
+	FieldPlatform = "platform"
+	// FieldAttestationStatus holds the string denoting the attestation_status field in the database.
+	FieldAttestationStatus = "attestation_status"
+	// FieldLoginCount holds the string denoting the login_count field in the database.
+	FieldLoginCount = "login_count"
+	// FieldFirstSeenAt holds the string denoting the first_seen_at field in the database.
+	FieldFirstSeenAt = "first_seen_at"
+	// FieldLastSeenAt holds the string denoting the last_seen_at field in the database.
+	FieldLastSeenAt = "last_seen_at"
+	// Table holds the table name of the userdevice in the database.
+	Table = "user_devices"
+)
+
+// Columns holds all SQL columns for userdevice fields.
+var Columns = []string{
+	FieldID,
+	FieldUserID,
+	FieldDeviceID,
+	FieldPlatform,
+	FieldAttestationStatus,
+	FieldLoginCount,
+	FieldFirstSeenAt,
+	FieldLastSeenAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	UserIDValidator func(string) error
+	// DeviceIDValidator is a validator for the "device_id" field. It is called by the builders before save.
+	DeviceIDValidator func(string) error
+	// DefaultLoginCount holds the default value on creation for the "login_count" field.
+	DefaultLoginCount int
+	// DefaultFirstSeenAt holds the default value on creation for the "first_seen_at" field.
+	DefaultFirstSeenAt func() time.Time
+	// DefaultLastSeenAt holds the default value on creation for the "last_seen_at" field.
+	DefaultLastSeenAt func() time.Time
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
+
+// Platform defines the type for the "platform" enum field.
+type Platform string
+
+// PlatformUnknown is the default value of the Platform enum.
+const DefaultPlatform = PlatformUnknown
+
+// Platform values.
+const (
+	PlatformAndroid Platform = "android"
+	PlatformIos     Platform = "ios"
+	PlatformWeb     Platform = "web"
+	PlatformUnknown Platform = "unknown"
+)
+
+func (pl Platform) String() string {
+	return string(pl)
+}
+
+// PlatformValidator is a validator for the "platform" field enum values. It is called by the builders before save.
+func PlatformValidator(pl Platform) error {
+	switch pl {
+	case PlatformAndroid, PlatformIos, PlatformWeb, PlatformUnknown:
+		return nil
+	default:
+		return fmt.Errorf("userdevice: invalid enum value for platform field: %q", pl)
+	}
+}
+
+// AttestationStatus defines the type for the "attestation_status" enum field.
+type AttestationStatus string
+
+// AttestationStatusMissing is the default value of the AttestationStatus enum.
+const DefaultAttestationStatus = AttestationStatusMissing
+
+// AttestationStatus values.
+const (
+	AttestationStatusMissing    AttestationStatus = "missing"
+	AttestationStatusUnverified AttestationStatus = "unverified"
+)
+
+func (as AttestationStatus) String() string {
+	return string(as)
+}
+
+// AttestationStatusValidator is a validator for the "attestation_status" field enum values. It is called by the builders before save.
+func AttestationStatusValidator(as AttestationStatus) error {
+	switch as {
+	case AttestationStatusMissing, AttestationStatusUnverified:
+		return nil
+	default:
+		return fmt.Errorf("userdevice: invalid enum value for attestation_status field: %q", as)
+	}
+}
+
+// OrderOption defines the ordering options for the UserDevice queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByDeviceID orders the results by the device_id field.
+func ByDeviceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeviceID, opts...).ToFunc()
+}
+
+// ByPlatform orders the results by the platform field.
+func ByPlatform(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPlatform, opts...).ToFunc()
+}
+
+// ByAttestationStatus orders the results by the attestation_status field.
+func ByAttestationStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttestationStatus, opts...).ToFunc()
+}
+
+// ByLoginCount orders the results by the login_count field.
+func ByLoginCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLoginCount, opts...).ToFunc()
+}
+
+// ByFirstSeenAt orders the results by the first_seen_at field.
+func ByFirstSeenAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFirstSeenAt, opts...).ToFunc()
+}
+
+// ByLastSeenAt orders the results by the last_seen_at field.
+func ByLastSeenAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastSeenAt, opts...).ToFunc()
+}
@@ -0,0 +1,395 @@
+// Code generated by ent, DO NOT EDIT.
+
+package userdevice
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/UnoraApp/be/ent/generated/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.UserDevice {
+	return predicate.UserDevice(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.UserDevice {
+	return predicate.UserDevice(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.UserDevice {
+	return predicate.UserDevice(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.UserDevice {
+	return predicate.UserDevice(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.UserDevice {
+	return predicate.UserDevice(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.UserDevice {
+	return predicate.UserDevice(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.UserDevice {
+	return predicate.UserDevice(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.UserDevice {
+	return predicate.UserDevice(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.UserDevice {
+	return predicate.UserDevice(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.UserDevice {
+	return predicate.UserDevice(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.UserDevice {
+	return predicate.UserDevice(sql.FieldContainsFold(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.UserDevice {
+	return predicate.UserDevice(sql.FieldEQ(FieldUserID, v))
+}
+
+// DeviceID applies equality check predicate on the "device_id" field. It's identical to DeviceIDEQ.
+func DeviceID(v string) predicate.UserDevice {
+	return predicate.UserDevice(sql.FieldEQ(FieldDeviceID, v))
+}
+
+// LoginCount applies equality check predicate on the "login_count" field. It's identical to LoginCountEQ.
+func LoginCount(v int) predicate.UserDevice {
+	return predicate.UserDevice(sql.FieldEQ(FieldLoginCount, v))
+}
+
+// FirstSeenAt applies equality check predicate on the "first_seen_at" field. It's identical to FirstSeenAtEQ.
+func FirstSeenAt(v time.Time) predicate.UserDevice {
+	return predicate.UserDevice(sql.FieldEQ(FieldFirstSeenAt, v))
+}
+
+// LastSeenAt applies equality check predicate on the "last_seen_at" field. It's identical to LastSeenAtEQ.
+func LastSeenAt(v time.Time) predicate.UserDevice {
+	return predicate.UserDevice(sql.FieldEQ(FieldLastSeenAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.UserDevice {
+	return predicate.UserDevice(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.UserDevice {
+	return predicate.UserDevice(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.UserDevice {
+	return predicate.UserDevice(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.UserDevice {
+	return predicate.UserDevice(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.UserDevice {
+	return predicate.UserDevice(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.UserDevice {
+	return predicate.UserDevice(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.UserDevice {
+	return predicate.UserDevice(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.UserDevice {
+	return predicate.UserDevice(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.UserDevice {
+	return predicate.UserDevice(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.UserDevice {
+	return predicate.UserDevice(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.UserDevice {
+	return predicate.UserDevice(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.UserDevice {
+	return predicate.UserDevice(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.UserDevice {
+	return predicate.UserDevice(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// DeviceIDEQ applies the EQ predicate on the "device_id" field.
+func DeviceIDEQ(v string) predicate.UserDevice {
+	return predicate.UserDevice(sql.FieldEQ(FieldDeviceID, v))
+}
+
+// DeviceIDNEQ applies the NEQ predicate on the "device_id" field.
+func DeviceIDNEQ(v string) predicate.UserDevice {
+	return predicate.UserDevice(sql.FieldNEQ(FieldDeviceID, v))
+}
+
+// DeviceIDIn applies the In predicate on the "device_id" field.
+func DeviceIDIn(vs ...string) predicate.UserDevice {
+	return predicate.UserDevice(sql.FieldIn(FieldDeviceID, vs...))
+}
+
+// DeviceIDNotIn applies the NotIn predicate on the "device_id" field.
+func DeviceIDNotIn(vs ...string) predicate.UserDevice {
+	return predicate.UserDevice(sql.FieldNotIn(FieldDeviceID, vs...))
+}
+
+// DeviceIDGT applies the GT predicate on the "device_id" field.
+func DeviceIDGT(v string) predicate.UserDevice {
+	return predicate.UserDevice(sql.FieldGT(FieldDeviceID, v))
+}
+
+// DeviceIDGTE applies the GTE predicate on the "device_id" field.
+func DeviceIDGTE(v string) predicate.UserDevice {
+	return predicate.UserDevice(sql.FieldGTE(FieldDeviceID, v))
+}
+
+// DeviceIDLT applies the LT predicate on the "device_id" field.
+func DeviceIDLT(v string) predicate.UserDevice {
+	return predicate.UserDevice(sql.FieldLT(FieldDeviceID, v))
+}
+
+// DeviceIDLTE applies the LTE predicate on the "device_id" field.
+func DeviceIDLTE(v string) predicate.UserDevice {
+	return predicate.UserDevice(sql.FieldLTE(FieldDeviceID, v))
+}
+
+// DeviceIDContains applies the Contains predicate on the "device_id" field.
+func DeviceIDContains(v string) predicate.UserDevice {
+	return predicate.UserDevice(sql.FieldContains(FieldDeviceID, v))
+}
+
+// DeviceIDHasPrefix applies the HasPrefix predicate on the "device_id" field.
+func DeviceIDHasPrefix(v string) predicate.UserDevice {
+	return predicate.UserDevice(sql.FieldHasPrefix(FieldDeviceID, v))
+}
+
+// DeviceIDHasSuffix applies the HasSuffix predicate on the "device_id" field.
+func DeviceIDHasSuffix(v string) predicate.UserDevice {
+	return predicate.UserDevice(sql.FieldHasSuffix(FieldDeviceID, v))
+}
+
+// DeviceIDEqualFold applies the EqualFold predicate on the "device_id" field.
+func DeviceIDEqualFold(v string) predicate.UserDevice {
+	return predicate.UserDevice(sql.FieldEqualFold(FieldDeviceID, v))
+}
+
+// DeviceIDContainsFold applies the ContainsFold predicate on the "device_id" field.
+func DeviceIDContainsFold(v string) predicate.UserDevice {
+	return predicate.UserDevice(sql.FieldContainsFold(FieldDeviceID, v))
+}
+
+// PlatformEQ applies the EQ predicate on the "platform" field.
+func PlatformEQ(v Platform) predicate.UserDevice {
+	return predicate.UserDevice(sql.FieldEQ(FieldPlatform, v))
+}
+
+// PlatformNEQ applies the NEQ predicate on the "platform" field.
+func PlatformNEQ(v Platform) predicate.UserDevice {
+	return predicate.UserDevice(sql.FieldNEQ(FieldPlatform, v))
+}
+
+// PlatformIn applies the In predicate on the "platform" field.
+func PlatformIn(vs ...Platform) predicate.UserDevice {
+	return predicate.UserDevice(sql.FieldIn(FieldPlatform, vs...))
+}
+
+// PlatformNotIn applies the NotIn predicate on the "platform" field.
+func PlatformNotIn(vs ...Platform) predicate.UserDevice {
+	return predicate.UserDevice(sql.FieldNotIn(FieldPlatform, vs...))
+}
+
+// AttestationStatusEQ applies the EQ predicate on the "attestation_status" field.
+func AttestationStatusEQ(v AttestationStatus) predicate.UserDevice {
+	return predicate.UserDevice(sql.FieldEQ(FieldAttestationStatus, v))
+}
+
+// AttestationStatusNEQ applies the NEQ predicate on the "attestation_status" field.
+func AttestationStatusNEQ(v AttestationStatus) predicate.UserDevice {
+	return predicate.UserDevice(sql.FieldNEQ(FieldAttestationStatus, v))
+}
+
+// AttestationStatusIn applies the In predicate on the "attestation_status" field.
+func AttestationStatusIn(vs ...AttestationStatus) predicate.UserDevice {
+	return predicate.UserDevice(sql.FieldIn(FieldAttestationStatus, vs...))
+}
+
+// AttestationStatusNotIn applies the NotIn predicate on the "attestation_status" field.
+func AttestationStatusNotIn(vs ...AttestationStatus) predicate.UserDevice {
+	return predicate.UserDevice(sql.FieldNotIn(FieldAttestationStatus, vs...))
+}
+
+// LoginCountEQ applies the EQ predicate on the "login_count" field.
+func LoginCountEQ(v int) predicate.UserDevice {
+	return predicate.UserDevice(sql.FieldEQ(FieldLoginCount, v))
+}
+
+// LoginCountNEQ applies the NEQ predicate on the "login_count" field.
+func LoginCountNEQ(v int) predicate.UserDevice {
+	return predicate.UserDevice(sql.FieldNEQ(FieldLoginCount, v))
+}
+
+// LoginCountIn applies the In predicate on the "login_count" field.
+func LoginCountIn(vs ...int) predicate.UserDevice {
+	return predicate.UserDevice(sql.FieldIn(FieldLoginCount, vs...))
+}
+
+// LoginCountNotIn applies the NotIn predicate on the "login_count" field.
+func LoginCountNotIn(vs ...int) predicate.UserDevice {
+	return predicate.UserDevice(sql.FieldNotIn(FieldLoginCount, vs...))
+}
+
+// LoginCountGT applies the GT predicate on the "login_count" field.
+func LoginCountGT(v int) predicate.UserDevice {
+	return predicate.UserDevice(sql.FieldGT(FieldLoginCount, v))
+}
+
+// LoginCountGTE applies the GTE predicate on the "login_count" field.
+func LoginCountGTE(v int) predicate.UserDevice {
+	return predicate.UserDevice(sql.FieldGTE(FieldLoginCount, v))
+}
+
+// LoginCountLT applies the LT predicate on the "login_count" field.
+func LoginCountLT(v int) predicate.UserDevice {
+	return predicate.UserDevice(sql.FieldLT(FieldLoginCount, v))
+}
+
+// LoginCountLTE applies the LTE predicate on the "login_count" field.
+func LoginCountLTE(v int) predicate.UserDevice {
+	return predicate.UserDevice(sql.FieldLTE(FieldLoginCount, v))
+}
+
+// FirstSeenAtEQ applies the EQ predicate on the "first_seen_at" field.
+func FirstSeenAtEQ(v time.Time) predicate.UserDevice {
+	return predicate.UserDevice(sql.FieldEQ(FieldFirstSeenAt, v))
+}
+
+// FirstSeenAtNEQ applies the NEQ predicate on the "first_seen_at" field.
+func FirstSeenAtNEQ(v time.Time) predicate.UserDevice {
+	return predicate.UserDevice(sql.FieldNEQ(FieldFirstSeenAt, v))
+}
+
+// FirstSeenAtIn applies the In predicate on the "first_seen_at" field.
+func FirstSeenAtIn(vs ...time.Time) predicate.UserDevice {
+	return predicate.UserDevice(sql.FieldIn(FieldFirstSeenAt, vs...))
+}
+
+// FirstSeenAtNotIn applies the NotIn predicate on the "first_seen_at" field.
+func FirstSeenAtNotIn(vs ...time.Time) predicate.UserDevice {
+	return predicate.UserDevice(sql.FieldNotIn(FieldFirstSeenAt, vs...))
+}
+
+// FirstSeenAtGT applies the GT predicate on the "first_seen_at" field.
+func FirstSeenAtGT(v time.Time) predicate.UserDevice {
+	return predicate.UserDevice(sql.FieldGT(FieldFirstSeenAt, v))
+}
+
+// FirstSeenAtGTE applies the GTE predicate on the "first_seen_at" field.
+func FirstSeenAtGTE(v time.Time) predicate.UserDevice {
+	return predicate.UserDevice(sql.FieldGTE(FieldFirstSeenAt, v))
+}
+
+// FirstSeenAtLT applies the LT predicate on the "first_seen_at" field.
+func FirstSeenAtLT(v time.Time) predicate.UserDevice {
+	return predicate.UserDevice(sql.FieldLT(FieldFirstSeenAt, v))
+}
+
+// FirstSeenAtLTE applies the LTE predicate on the "first_seen_at" field.
+func FirstSeenAtLTE(v time.Time) predicate.UserDevice {
+	return predicate.UserDevice(sql.FieldLTE(FieldFirstSeenAt, v))
+}
+
+// LastSeenAtEQ applies the EQ predicate on the "last_seen_at" field.
+func LastSeenAtEQ(v time.Time) predicate.UserDevice {
+	return predicate.UserDevice(sql.FieldEQ(FieldLastSeenAt, v))
+}
+
+// LastSeenAtNEQ applies the NEQ predicate on the "last_seen_at" field.
+func LastSeenAtNEQ(v time.Time) predicate.UserDevice {
+	return predicate.UserDevice(sql.FieldNEQ(FieldLastSeenAt, v))
+}
+
+// LastSeenAtIn applies the In predicate on the "last_seen_at" field.
+func LastSeenAtIn(vs ...time.Time) predicate.UserDevice {
+	return predicate.UserDevice(sql.FieldIn(FieldLastSeenAt, vs...))
+}
+
+// LastSeenAtNotIn applies the NotIn predicate on the "last_seen_at" field.
+func LastSeenAtNotIn(vs ...time.Time) predicate.UserDevice {
+	return predicate.UserDevice(sql.FieldNotIn(FieldLastSeenAt, vs...))
+}
+
+// LastSeenAtGT applies the GT predicate on the "last_seen_at" field.
+func LastSeenAtGT(v time.Time) predicate.UserDevice {
+	return predicate.UserDevice(sql.FieldGT(FieldLastSeenAt, v))
+}
+
+// LastSeenAtGTE applies the GTE predicate on the "last_seen_at" field.
+func LastSeenAtGTE(v time.Time) predicate.UserDevice {
+	return predicate.UserDevice(sql.FieldGTE(FieldLastSeenAt, v))
+}
+
+// LastSeenAtLT applies the LT predicate on the "last_seen_at" field.
+func LastSeenAtLT(v time.Time) predicate.UserDevice {
+	return predicate.UserDevice(sql.FieldLT(FieldLastSeenAt, v))
+}
+
+// LastSeenAtLTE applies the LTE predicate on the "last_seen_at" field.
+func LastSeenAtLTE(v time.Time) predicate.UserDevice {
+	return predicate.UserDevice(sql.FieldLTE(FieldLastSeenAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.UserDevice) predicate.UserDevice {
+	return predicate.UserDevice(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.UserDevice) predicate.UserDevice {
+	return predicate.UserDevice(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.UserDevice) predicate.UserDevice {
+	return predicate.UserDevice(sql.NotPredicates(p))
+}
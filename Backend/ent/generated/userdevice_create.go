@@ -0,0 +1,817 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/UnoraApp/be/ent/generated/userdevice"
+)
+
+// UserDeviceCreate is the builder for creating a UserDevice entity.
+type UserDeviceCreate struct {
+	config
+	mutation *UserDeviceMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *UserDeviceCreate) SetUserID(v string) *UserDeviceCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetDeviceID sets the "device_id" field.
+func (_c *UserDeviceCreate) SetDeviceID(v string) *UserDeviceCreate {
+	_c.mutation.SetDeviceID(v)
+	return _c
+}
+
+// SetPlatform sets the "platform" field.
+func (_c *UserDeviceCreate) SetPlatform(v userdevice.Platform) *UserDeviceCreate {
+	_c.mutation.SetPlatform(v)
+	return _c
+}
+
+// SetNillablePlatform sets the "platform" field if the given value is not nil.
+func (_c *UserDeviceCreate) SetNillablePlatform(v *userdevice.Platform) *UserDeviceCreate {
+	if v != nil {
+		_c.SetPlatform(*v)
+	}
+	return _c
+}
+
+// SetAttestationStatus sets the "attestation_status" field.
+func (_c *UserDeviceCreate) SetAttestationStatus(v userdevice.AttestationStatus) *UserDeviceCreate {
+	_c.mutation.SetAttestationStatus(v)
+	return _c
+}
+
+// SetNillableAttestationStatus sets the "attestation_status" field if the given value is not nil.
+func (_c *UserDeviceCreate) SetNillableAttestationStatus(v *userdevice.AttestationStatus) *UserDeviceCreate {
+	if v != nil {
+		_c.SetAttestationStatus(*v)
+	}
+	return _c
+}
+
+// SetLoginCount sets the "login_count" field.
+func (_c *UserDeviceCreate) SetLoginCount(v int) *UserDeviceCreate {
+	_c.mutation.SetLoginCount(v)
+	return _c
+}
+
+// SetNillableLoginCount sets the "login_count" field if the given value is not nil.
+func (_c *UserDeviceCreate) SetNillableLoginCount(v *int) *UserDeviceCreate {
+	if v != nil {
+		_c.SetLoginCount(*v)
+	}
+	return _c
+}
+
+// SetFirstSeenAt sets the "first_seen_at" field.
+func (_c *UserDeviceCreate) SetFirstSeenAt(v time.Time) *UserDeviceCreate {
+	_c.mutation.SetFirstSeenAt(v)
+	return _c
+}
+
+// SetNillableFirstSeenAt sets the "first_seen_at" field if the given value is not nil.
+func (_c *UserDeviceCreate) SetNillableFirstSeenAt(v *time.Time) *UserDeviceCreate {
+	if v != nil {
+		_c.SetFirstSeenAt(*v)
+	}
+	return _c
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (_c *UserDeviceCreate) SetLastSeenAt(v time.Time) *UserDeviceCreate {
+	_c.mutation.SetLastSeenAt(v)
+	return _c
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (_c *UserDeviceCreate) SetNillableLastSeenAt(v *time.Time) *UserDeviceCreate {
+	if v != nil {
+		_c.SetLastSeenAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *UserDeviceCreate) SetID(v string) *UserDeviceCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the UserDeviceMutation object of the builder.
+func (_c *UserDeviceCreate) Mutation() *UserDeviceMutation {
+	return _c.mutation
+}
+
+// Save creates the UserDevice in the database.
+func (_c *UserDeviceCreate) Save(ctx context.Context) (*UserDevice, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *UserDeviceCreate) SaveX(ctx context.Context) *UserDevice {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *UserDeviceCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *UserDeviceCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *UserDeviceCreate) defaults() {
+	if _, ok := _c.mutation.Platform(); !ok {
+		v := userdevice.DefaultPlatform
+		_c.mutation.SetPlatform(v)
+	}
+	if _, ok := _c.mutation.AttestationStatus(); !ok {
+		v := userdevice.DefaultAttestationStatus
+		_c.mutation.SetAttestationStatus(v)
+	}
+	if _, ok := _c.mutation.LoginCount(); !ok {
+		v := userdevice.DefaultLoginCount
+		_c.mutation.SetLoginCount(v)
+	}
+	if _, ok := _c.mutation.FirstSeenAt(); !ok {
+		v := userdevice.DefaultFirstSeenAt()
+		_c.mutation.SetFirstSeenAt(v)
+	}
+	if _, ok := _c.mutation.LastSeenAt(); !ok {
+		v := userdevice.DefaultLastSeenAt()
+		_c.mutation.SetLastSeenAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *UserDeviceCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`generated: missing required field "UserDevice.user_id"`)}
+	}
+	if v, ok := _c.mutation.UserID(); ok {
+		if err := userdevice.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`generated: validator failed for field "UserDevice.user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.DeviceID(); !ok {
+		return &ValidationError{Name: "device_id", err: errors.New(`generated: missing required field "UserDevice.device_id"`)}
+	}
+	if v, ok := _c.mutation.DeviceID(); ok {
+		if err := userdevice.DeviceIDValidator(v); err != nil {
+			return &ValidationError{Name: "device_id", err: fmt.Errorf(`generated: validator failed for field "UserDevice.device_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Platform(); !ok {
+		return &ValidationError{Name: "platform", err: errors.New(`generated: missing required field "UserDevice.platform"`)}
+	}
+	if v, ok := _c.mutation.Platform(); ok {
+		if err := userdevice.PlatformValidator(v); err != nil {
+			return &ValidationError{Name: "platform", err: fmt.Errorf(`generated: validator failed for field "UserDevice.platform": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AttestationStatus(); !ok {
+		return &ValidationError{Name: "attestation_status", err: errors.New(`generated: missing required field "UserDevice.attestation_status"`)}
+	}
+	if v, ok := _c.mutation.AttestationStatus(); ok {
+		if err := userdevice.AttestationStatusValidator(v); err != nil {
+			return &ValidationError{Name: "attestation_status", err: fmt.Errorf(`generated: validator failed for field "UserDevice.attestation_status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.LoginCount(); !ok {
+		return &ValidationError{Name: "login_count", err: errors.New(`generated: missing required field "UserDevice.login_count"`)}
+	}
+	if _, ok := _c.mutation.FirstSeenAt(); !ok {
+		return &ValidationError{Name: "first_seen_at", err: errors.New(`generated: missing required field "UserDevice.first_seen_at"`)}
+	}
+	if _, ok := _c.mutation.LastSeenAt(); !ok {
+		return &ValidationError{Name: "last_seen_at", err: errors.New(`generated: missing required field "UserDevice.last_seen_at"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := userdevice.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`generated: validator failed for field "UserDevice.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *UserDeviceCreate) sqlSave(ctx context.Context) (*UserDevice, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected UserDevice.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *UserDeviceCreate) createSpec() (*UserDevice, *sqlgraph.CreateSpec) {
+	var (
+		_node = &UserDevice{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(userdevice.Table, sqlgraph.NewFieldSpec(userdevice.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(userdevice.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.DeviceID(); ok {
+		_spec.SetField(userdevice.FieldDeviceID, field.TypeString, value)
+		_node.DeviceID = value
+	}
+	if value, ok := _c.mutation.Platform(); ok {
+		_spec.SetField(userdevice.FieldPlatform, field.TypeEnum, value)
+		_node.Platform = value
+	}
+	if value, ok := _c.mutation.AttestationStatus(); ok {
+		_spec.SetField(userdevice.FieldAttestationStatus, field.TypeEnum, value)
+		_node.AttestationStatus = value
+	}
+	if value, ok := _c.mutation.LoginCount(); ok {
+		_spec.SetField(userdevice.FieldLoginCount, field.TypeInt, value)
+		_node.LoginCount = value
+	}
+	if value, ok := _c.mutation.FirstSeenAt(); ok {
+		_spec.SetField(userdevice.FieldFirstSeenAt, field.TypeTime, value)
+		_node.FirstSeenAt = value
+	}
+	if value, ok := _c.mutation.LastSeenAt(); ok {
+		_spec.SetField(userdevice.FieldLastSeenAt, field.TypeTime, value)
+		_node.LastSeenAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.UserDevice.Create().
+//		SetUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UserDeviceUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *UserDeviceCreate) OnConflict(opts ...sql.ConflictOption) *UserDeviceUpsertOne {
+	_c.conflict = opts
+	return &UserDeviceUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.UserDevice.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *UserDeviceCreate) OnConflictColumns(columns ...string) *UserDeviceUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &UserDeviceUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// UserDeviceUpsertOne is the builder for "upsert"-ing
+	//  one UserDevice node.
+	UserDeviceUpsertOne struct {
+		create *UserDeviceCreate
+	}
+
+	// UserDeviceUpsert is the "OnConflict" setter.
+	UserDeviceUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetPlatform sets the "platform" field.
+func (u *UserDeviceUpsert) SetPlatform(v userdevice.Platform) *UserDeviceUpsert {
+	u.Set(userdevice.FieldPlatform, v)
+	return u
+}
+
+// UpdatePlatform sets the "platform" field to the value that was provided on create.
+func (u *UserDeviceUpsert) UpdatePlatform() *UserDeviceUpsert {
+	u.SetExcluded(userdevice.FieldPlatform)
+	return u
+}
+
+// SetAttestationStatus sets the "attestation_status" field.
+func (u *UserDeviceUpsert) SetAttestationStatus(v userdevice.AttestationStatus) *UserDeviceUpsert {
+	u.Set(userdevice.FieldAttestationStatus, v)
+	return u
+}
+
+// UpdateAttestationStatus sets the "attestation_status" field to the value that was provided on create.
+func (u *UserDeviceUpsert) UpdateAttestationStatus() *UserDeviceUpsert {
+	u.SetExcluded(userdevice.FieldAttestationStatus)
+	return u
+}
+
+// SetLoginCount sets the "login_count" field.
+func (u *UserDeviceUpsert) SetLoginCount(v int) *UserDeviceUpsert {
+	u.Set(userdevice.FieldLoginCount, v)
+	return u
+}
+
+// UpdateLoginCount sets the "login_count" field to the value that was provided on create.
+func (u *UserDeviceUpsert) UpdateLoginCount() *UserDeviceUpsert {
+	u.SetExcluded(userdevice.FieldLoginCount)
+	return u
+}
+
+// AddLoginCount adds v to the "login_count" field.
+func (u *UserDeviceUpsert) AddLoginCount(v int) *UserDeviceUpsert {
+	u.Add(userdevice.FieldLoginCount, v)
+	return u
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (u *UserDeviceUpsert) SetLastSeenAt(v time.Time) *UserDeviceUpsert {
+	u.Set(userdevice.FieldLastSeenAt, v)
+	return u
+}
+
+// UpdateLastSeenAt sets the "last_seen_at" field to the value that was provided on create.
+func (u *UserDeviceUpsert) UpdateLastSeenAt() *UserDeviceUpsert {
+	u.SetExcluded(userdevice.FieldLastSeenAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.UserDevice.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(userdevice.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *UserDeviceUpsertOne) UpdateNewValues() *UserDeviceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(userdevice.FieldID)
+		}
+		if _, exists := u.create.mutation.UserID(); exists {
+			s.SetIgnore(userdevice.FieldUserID)
+		}
+		if _, exists := u.create.mutation.DeviceID(); exists {
+			s.SetIgnore(userdevice.FieldDeviceID)
+		}
+		if _, exists := u.create.mutation.FirstSeenAt(); exists {
+			s.SetIgnore(userdevice.FieldFirstSeenAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.UserDevice.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *UserDeviceUpsertOne) Ignore() *UserDeviceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UserDeviceUpsertOne) DoNothing() *UserDeviceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UserDeviceCreate.OnConflict
+// documentation for more info.
+func (u *UserDeviceUpsertOne) Update(set func(*UserDeviceUpsert)) *UserDeviceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UserDeviceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPlatform sets the "platform" field.
+func (u *UserDeviceUpsertOne) SetPlatform(v userdevice.Platform) *UserDeviceUpsertOne {
+	return u.Update(func(s *UserDeviceUpsert) {
+		s.SetPlatform(v)
+	})
+}
+
+// UpdatePlatform sets the "platform" field to the value that was provided on create.
+func (u *UserDeviceUpsertOne) UpdatePlatform() *UserDeviceUpsertOne {
+	return u.Update(func(s *UserDeviceUpsert) {
+		s.UpdatePlatform()
+	})
+}
+
+// SetAttestationStatus sets the "attestation_status" field.
+func (u *UserDeviceUpsertOne) SetAttestationStatus(v userdevice.AttestationStatus) *UserDeviceUpsertOne {
+	return u.Update(func(s *UserDeviceUpsert) {
+		s.SetAttestationStatus(v)
+	})
+}
+
+// UpdateAttestationStatus sets the "attestation_status" field to the value that was provided on create.
+func (u *UserDeviceUpsertOne) UpdateAttestationStatus() *UserDeviceUpsertOne {
+	return u.Update(func(s *UserDeviceUpsert) {
+		s.UpdateAttestationStatus()
+	})
+}
+
+// SetLoginCount sets the "login_count" field.
+func (u *UserDeviceUpsertOne) SetLoginCount(v int) *UserDeviceUpsertOne {
+	return u.Update(func(s *UserDeviceUpsert) {
+		s.SetLoginCount(v)
+	})
+}
+
+// AddLoginCount adds v to the "login_count" field.
+func (u *UserDeviceUpsertOne) AddLoginCount(v int) *UserDeviceUpsertOne {
+	return u.Update(func(s *UserDeviceUpsert) {
+		s.AddLoginCount(v)
+	})
+}
+
+// UpdateLoginCount sets the "login_count" field to the value that was provided on create.
+func (u *UserDeviceUpsertOne) UpdateLoginCount() *UserDeviceUpsertOne {
+	return u.Update(func(s *UserDeviceUpsert) {
+		s.UpdateLoginCount()
+	})
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (u *UserDeviceUpsertOne) SetLastSeenAt(v time.Time) *UserDeviceUpsertOne {
+	return u.Update(func(s *UserDeviceUpsert) {
+		s.SetLastSeenAt(v)
+	})
+}
+
+// UpdateLastSeenAt sets the "last_seen_at" field to the value that was provided on create.
+func (u *UserDeviceUpsertOne) UpdateLastSeenAt() *UserDeviceUpsertOne {
+	return u.Update(func(s *UserDeviceUpsert) {
+		s.UpdateLastSeenAt()
+	})
+}
+
+// Exec executes the query.
+func (u *UserDeviceUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("generated: missing options for UserDeviceCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UserDeviceUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *UserDeviceUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("generated: UserDeviceUpsertOne.ID is not supported by MySQL driver. Use UserDeviceUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *UserDeviceUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// UserDeviceCreateBulk is the builder for creating many UserDevice entities in bulk.
+type UserDeviceCreateBulk struct {
+	config
+	err      error
+	builders []*UserDeviceCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the UserDevice entities in the database.
+func (_c *UserDeviceCreateBulk) Save(ctx context.Context) ([]*UserDevice, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*UserDevice, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UserDeviceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *UserDeviceCreateBulk) SaveX(ctx context.Context) []*UserDevice {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *UserDeviceCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *UserDeviceCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.UserDevice.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UserDeviceUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *UserDeviceCreateBulk) OnConflict(opts ...sql.ConflictOption) *UserDeviceUpsertBulk {
+	_c.conflict = opts
+	return &UserDeviceUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.UserDevice.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *UserDeviceCreateBulk) OnConflictColumns(columns ...string) *UserDeviceUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &UserDeviceUpsertBulk{
+		create: _c,
+	}
+}
+
+// UserDeviceUpsertBulk is the builder for "upsert"-ing
+// a bulk of UserDevice nodes.
+type UserDeviceUpsertBulk struct {
+	create *UserDeviceCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.UserDevice.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(userdevice.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *UserDeviceUpsertBulk) UpdateNewValues() *UserDeviceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(userdevice.FieldID)
+			}
+			if _, exists := b.mutation.UserID(); exists {
+				s.SetIgnore(userdevice.FieldUserID)
+			}
+			if _, exists := b.mutation.DeviceID(); exists {
+				s.SetIgnore(userdevice.FieldDeviceID)
+			}
+			if _, exists := b.mutation.FirstSeenAt(); exists {
+				s.SetIgnore(userdevice.FieldFirstSeenAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.UserDevice.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *UserDeviceUpsertBulk) Ignore() *UserDeviceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UserDeviceUpsertBulk) DoNothing() *UserDeviceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UserDeviceCreateBulk.OnConflict
+// documentation for more info.
+func (u *UserDeviceUpsertBulk) Update(set func(*UserDeviceUpsert)) *UserDeviceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UserDeviceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPlatform sets the "platform" field.
+func (u *UserDeviceUpsertBulk) SetPlatform(v userdevice.Platform) *UserDeviceUpsertBulk {
+	return u.Update(func(s *UserDeviceUpsert) {
+		s.SetPlatform(v)
+	})
+}
+
+// UpdatePlatform sets the "platform" field to the value that was provided on create.
+func (u *UserDeviceUpsertBulk) UpdatePlatform() *UserDeviceUpsertBulk {
+	return u.Update(func(s *UserDeviceUpsert) {
+		s.UpdatePlatform()
+	})
+}
+
+// SetAttestationStatus sets the "attestation_status" field.
+func (u *UserDeviceUpsertBulk) SetAttestationStatus(v userdevice.AttestationStatus) *UserDeviceUpsertBulk {
+	return u.Update(func(s *UserDeviceUpsert) {
+		s.SetAttestationStatus(v)
+	})
+}
+
+// UpdateAttestationStatus sets the "attestation_status" field to the value that was provided on create.
+func (u *UserDeviceUpsertBulk) UpdateAttestationStatus() *UserDeviceUpsertBulk {
+	return u.Update(func(s *UserDeviceUpsert) {
+		s.UpdateAttestationStatus()
+	})
+}
+
+// SetLoginCount sets the "login_count" field.
+func (u *UserDeviceUpsertBulk) SetLoginCount(v int) *UserDeviceUpsertBulk {
+	return u.Update(func(s *UserDeviceUpsert) {
+		s.SetLoginCount(v)
+	})
+}
+
+// AddLoginCount adds v to the "login_count" field.
+func (u *UserDeviceUpsertBulk) AddLoginCount(v int) *UserDeviceUpsertBulk {
+	return u.Update(func(s *UserDeviceUpsert) {
+		s.AddLoginCount(v)
+	})
+}
+
+// UpdateLoginCount sets the "login_count" field to the value that was provided on create.
+func (u *UserDeviceUpsertBulk) UpdateLoginCount() *UserDeviceUpsertBulk {
+	return u.Update(func(s *UserDeviceUpsert) {
+		s.UpdateLoginCount()
+	})
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (u *UserDeviceUpsertBulk) SetLastSeenAt(v time.Time) *UserDeviceUpsertBulk {
+	return u.Update(func(s *UserDeviceUpsert) {
+		s.SetLastSeenAt(v)
+	})
+}
+
+// UpdateLastSeenAt sets the "last_seen_at" field to the value that was provided on create.
+func (u *UserDeviceUpsertBulk) UpdateLastSeenAt() *UserDeviceUpsertBulk {
+	return u.Update(func(s *UserDeviceUpsert) {
+		s.UpdateLastSeenAt()
+	})
+}
+
+// Exec executes the query.
+func (u *UserDeviceUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("generated: OnConflict was set for builder %d. Set it on the UserDeviceCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("generated: missing options for UserDeviceCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UserDeviceUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
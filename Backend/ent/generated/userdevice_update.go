@@ -0,0 +1,368 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/UnoraApp/be/ent/generated/predicate"
+	"github.com/UnoraApp/be/ent/generated/userdevice"
+)
+
+// UserDeviceUpdate is the builder for updating UserDevice entities.
+type UserDeviceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *UserDeviceMutation
+}
+
+// Where appends a list predicates to the UserDeviceUpdate builder.
+func (_u *UserDeviceUpdate) Where(ps ...predicate.UserDevice) *UserDeviceUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetPlatform sets the "platform" field.
+func (_u *UserDeviceUpdate) SetPlatform(v userdevice.Platform) *UserDeviceUpdate {
+	_u.mutation.SetPlatform(v)
+	return _u
+}
+
+// SetNillablePlatform sets the "platform" field if the given value is not nil.
+func (_u *UserDeviceUpdate) SetNillablePlatform(v *userdevice.Platform) *UserDeviceUpdate {
+	if v != nil {
+		_u.SetPlatform(*v)
+	}
+	return _u
+}
+
+// SetAttestationStatus sets the "attestation_status" field.
+func (_u *UserDeviceUpdate) SetAttestationStatus(v userdevice.AttestationStatus) *UserDeviceUpdate {
+	_u.mutation.SetAttestationStatus(v)
+	return _u
+}
+
+// SetNillableAttestationStatus sets the "attestation_status" field if the given value is not nil.
+func (_u *UserDeviceUpdate) SetNillableAttestationStatus(v *userdevice.AttestationStatus) *UserDeviceUpdate {
+	if v != nil {
+		_u.SetAttestationStatus(*v)
+	}
+	return _u
+}
+
+// SetLoginCount sets the "login_count" field.
+func (_u *UserDeviceUpdate) SetLoginCount(v int) *UserDeviceUpdate {
+	_u.mutation.ResetLoginCount()
+	_u.mutation.SetLoginCount(v)
+	return _u
+}
+
+// SetNillableLoginCount sets the "login_count" field if the given value is not nil.
+func (_u *UserDeviceUpdate) SetNillableLoginCount(v *int) *UserDeviceUpdate {
+	if v != nil {
+		_u.SetLoginCount(*v)
+	}
+	return _u
+}
+
+// AddLoginCount adds value to the "login_count" field.
+func (_u *UserDeviceUpdate) AddLoginCount(v int) *UserDeviceUpdate {
+	_u.mutation.AddLoginCount(v)
+	return _u
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (_u *UserDeviceUpdate) SetLastSeenAt(v time.Time) *UserDeviceUpdate {
+	_u.mutation.SetLastSeenAt(v)
+	return _u
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (_u *UserDeviceUpdate) SetNillableLastSeenAt(v *time.Time) *UserDeviceUpdate {
+	if v != nil {
+		_u.SetLastSeenAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the UserDeviceMutation object of the builder.
+func (_u *UserDeviceUpdate) Mutation() *UserDeviceMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *UserDeviceUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UserDeviceUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *UserDeviceUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UserDeviceUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *UserDeviceUpdate) check() error {
+	if v, ok := _u.mutation.Platform(); ok {
+		if err := userdevice.PlatformValidator(v); err != nil {
+			return &ValidationError{Name: "platform", err: fmt.Errorf(`generated: validator failed for field "UserDevice.platform": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AttestationStatus(); ok {
+		if err := userdevice.AttestationStatusValidator(v); err != nil {
+			return &ValidationError{Name: "attestation_status", err: fmt.Errorf(`generated: validator failed for field "UserDevice.attestation_status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *UserDeviceUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(userdevice.Table, userdevice.Columns, sqlgraph.NewFieldSpec(userdevice.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Platform(); ok {
+		_spec.SetField(userdevice.FieldPlatform, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.AttestationStatus(); ok {
+		_spec.SetField(userdevice.FieldAttestationStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.LoginCount(); ok {
+		_spec.SetField(userdevice.FieldLoginCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLoginCount(); ok {
+		_spec.AddField(userdevice.FieldLoginCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastSeenAt(); ok {
+		_spec.SetField(userdevice.FieldLastSeenAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{userdevice.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// UserDeviceUpdateOne is the builder for updating a single UserDevice entity.
+type UserDeviceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UserDeviceMutation
+}
+
+// SetPlatform sets the "platform" field.
+func (_u *UserDeviceUpdateOne) SetPlatform(v userdevice.Platform) *UserDeviceUpdateOne {
+	_u.mutation.SetPlatform(v)
+	return _u
+}
+
+// SetNillablePlatform sets the "platform" field if the given value is not nil.
+func (_u *UserDeviceUpdateOne) SetNillablePlatform(v *userdevice.Platform) *UserDeviceUpdateOne {
+	if v != nil {
+		_u.SetPlatform(*v)
+	}
+	return _u
+}
+
+// SetAttestationStatus sets the "attestation_status" field.
+func (_u *UserDeviceUpdateOne) SetAttestationStatus(v userdevice.AttestationStatus) *UserDeviceUpdateOne {
+	_u.mutation.SetAttestationStatus(v)
+	return _u
+}
+
+// SetNillableAttestationStatus sets the "attestation_status" field if the given value is not nil.
+func (_u *UserDeviceUpdateOne) SetNillableAttestationStatus(v *userdevice.AttestationStatus) *UserDeviceUpdateOne {
+	if v != nil {
+		_u.SetAttestationStatus(*v)
+	}
+	return _u
+}
+
+// SetLoginCount sets the "login_count" field.
+func (_u *UserDeviceUpdateOne) SetLoginCount(v int) *UserDeviceUpdateOne {
+	_u.mutation.ResetLoginCount()
+	_u.mutation.SetLoginCount(v)
+	return _u
+}
+
+// SetNillableLoginCount sets the "login_count" field if the given value is not nil.
+func (_u *UserDeviceUpdateOne) SetNillableLoginCount(v *int) *UserDeviceUpdateOne {
+	if v != nil {
+		_u.SetLoginCount(*v)
+	}
+	return _u
+}
+
+// AddLoginCount adds value to the "login_count" field.
+func (_u *UserDeviceUpdateOne) AddLoginCount(v int) *UserDeviceUpdateOne {
+	_u.mutation.AddLoginCount(v)
+	return _u
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (_u *UserDeviceUpdateOne) SetLastSeenAt(v time.Time) *UserDeviceUpdateOne {
+	_u.mutation.SetLastSeenAt(v)
+	return _u
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (_u *UserDeviceUpdateOne) SetNillableLastSeenAt(v *time.Time) *UserDeviceUpdateOne {
+	if v != nil {
+		_u.SetLastSeenAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the UserDeviceMutation object of the builder.
+func (_u *UserDeviceUpdateOne) Mutation() *UserDeviceMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the UserDeviceUpdate builder.
+func (_u *UserDeviceUpdateOne) Where(ps ...predicate.UserDevice) *UserDeviceUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *UserDeviceUpdateOne) Select(field string, fields ...string) *UserDeviceUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated UserDevice entity.
+func (_u *UserDeviceUpdateOne) Save(ctx context.Context) (*UserDevice, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UserDeviceUpdateOne) SaveX(ctx context.Context) *UserDevice {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *UserDeviceUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UserDeviceUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *UserDeviceUpdateOne) check() error {
+	if v, ok := _u.mutation.Platform(); ok {
+		if err := userdevice.PlatformValidator(v); err != nil {
+			return &ValidationError{Name: "platform", err: fmt.Errorf(`generated: validator failed for field "UserDevice.platform": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AttestationStatus(); ok {
+		if err := userdevice.AttestationStatusValidator(v); err != nil {
+			return &ValidationError{Name: "attestation_status", err: fmt.Errorf(`generated: validator failed for field "UserDevice.attestation_status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *UserDeviceUpdateOne) sqlSave(ctx context.Context) (_node *UserDevice, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(userdevice.Table, userdevice.Columns, sqlgraph.NewFieldSpec(userdevice.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "UserDevice.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, userdevice.FieldID)
+		for _, f := range fields {
+			if !userdevice.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != userdevice.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Platform(); ok {
+		_spec.SetField(userdevice.FieldPlatform, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.AttestationStatus(); ok {
+		_spec.SetField(userdevice.FieldAttestationStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.LoginCount(); ok {
+		_spec.SetField(userdevice.FieldLoginCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLoginCount(); ok {
+		_spec.AddField(userdevice.FieldLoginCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastSeenAt(); ok {
+		_spec.SetField(userdevice.FieldLastSeenAt, field.TypeTime, value)
+	}
+	_node = &UserDevice{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{userdevice.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -19,7 +19,7 @@ type UserReport struct {
 	// ID of the ent.
 	ID string `json:"id,omitempty"`
 	// ReporterUserID holds the value of the "reporter_user_id" field.
-	ReporterUserID string `json:"reporter_user_id,omitempty"`
+	ReporterUserID *string `json:"reporter_user_id,omitempty"`
 	// ReportedUserID holds the value of the "reported_user_id" field.
 	ReportedUserID string `json:"reported_user_id,omitempty"`
 	// ReportReason holds the value of the "report_reason" field.
@@ -52,9 +52,11 @@ type UserReportEdges struct {
 	Reporter *User `json:"reporter,omitempty"`
 	// Reported holds the value of the reported edge.
 	Reported *User `json:"reported,omitempty"`
+	// Evidence holds the value of the evidence edge.
+	Evidence []*ReportEvidence `json:"evidence,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [3]bool
 }
 
 // ReporterOrErr returns the Reporter value or an error if the edge
@@ -79,6 +81,15 @@ func (e UserReportEdges) ReportedOrErr() (*User, error) {
 	return nil, &NotLoadedError{edge: "reported"}
 }
 
+// EvidenceOrErr returns the Evidence value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserReportEdges) EvidenceOrErr() ([]*ReportEvidence, error) {
+	if e.loadedTypes[2] {
+		return e.Evidence, nil
+	}
+	return nil, &NotLoadedError{edge: "evidence"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*UserReport) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -113,7 +124,8 @@ func (_m *UserReport) assignValues(columns []string, values []any) error {
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field reporter_user_id", values[i])
 			} else if value.Valid {
-				_m.ReporterUserID = value.String
+				_m.ReporterUserID = new(string)
+				*_m.ReporterUserID = value.String
 			}
 		case userreport.FieldReportedUserID:
 			if value, ok := values[i].(*sql.NullString); !ok {
@@ -204,6 +216,11 @@ func (_m *UserReport) QueryReported() *UserQuery {
 	return NewUserReportClient(_m.config).QueryReported(_m)
 }
 
+// QueryEvidence queries the "evidence" edge of the UserReport entity.
+func (_m *UserReport) QueryEvidence() *ReportEvidenceQuery {
+	return NewUserReportClient(_m.config).QueryEvidence(_m)
+}
+
 // Update returns a builder for updating this UserReport.
 // Note that you need to call UserReport.Unwrap() before calling this method if this UserReport
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -227,8 +244,10 @@ func (_m *UserReport) String() string {
 	var builder strings.Builder
 	builder.WriteString("UserReport(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
-	builder.WriteString("reporter_user_id=")
-	builder.WriteString(_m.ReporterUserID)
+	if v := _m.ReporterUserID; v != nil {
+		builder.WriteString("reporter_user_id=")
+		builder.WriteString(*v)
+	}
 	builder.WriteString(", ")
 	builder.WriteString("reported_user_id=")
 	builder.WriteString(_m.ReportedUserID)
@@ -41,6 +41,8 @@ const (
 	EdgeReporter = "reporter"
 	// EdgeReported holds the string denoting the reported edge name in mutations.
 	EdgeReported = "reported"
+	// EdgeEvidence holds the string denoting the evidence edge name in mutations.
+	EdgeEvidence = "evidence"
 	// Table holds the table name of the userreport in the database.
 	Table = "user_reports"
 	// ReporterTable is the table that holds the reporter relation/edge.
@@ -57,6 +59,13 @@ const (
 	ReportedInverseTable = "users"
 	// ReportedColumn is the table column denoting the reported relation/edge.
 	ReportedColumn = "reported_user_id"
+	// EvidenceTable is the table that holds the evidence relation/edge.
+	EvidenceTable = "report_evidences"
+	// EvidenceInverseTable is the table name for the ReportEvidence entity.
+	// It exists in this package in order to avoid circular dependency with the "reportevidence" package.
+	EvidenceInverseTable = "report_evidences"
+	// EvidenceColumn is the table column denoting the evidence relation/edge.
+	EvidenceColumn = "report_id"
 )
 
 // Columns holds all SQL columns for userreport fields.
@@ -114,6 +123,7 @@ const (
 	ReportReasonUnderage             ReportReason = "underage"
 	ReportReasonOffensiveBehavior    ReportReason = "offensive_behavior"
 	ReportReasonOther                ReportReason = "other"
+	ReportReasonDeviceRisk           ReportReason = "device_risk"
 )
 
 func (rr ReportReason) String() string {
@@ -123,7 +133,7 @@ func (rr ReportReason) String() string {
 // ReportReasonValidator is a validator for the "report_reason" field enum values. It is called by the builders before save.
 func ReportReasonValidator(rr ReportReason) error {
 	switch rr {
-	case ReportReasonInappropriateContent, ReportReasonHarassment, ReportReasonSpam, ReportReasonFakeProfile, ReportReasonUnderage, ReportReasonOffensiveBehavior, ReportReasonOther:
+	case ReportReasonInappropriateContent, ReportReasonHarassment, ReportReasonSpam, ReportReasonFakeProfile, ReportReasonUnderage, ReportReasonOffensiveBehavior, ReportReasonOther, ReportReasonDeviceRisk:
 		return nil
 	default:
 		return fmt.Errorf("userreport: invalid enum value for report_reason field: %q", rr)
@@ -234,6 +244,20 @@ func ByReportedField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newReportedStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByEvidenceCount orders the results by evidence count.
+func ByEvidenceCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newEvidenceStep(), opts...)
+	}
+}
+
+// ByEvidence orders the results by evidence terms.
+func ByEvidence(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newEvidenceStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newReporterStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -248,3 +272,10 @@ func newReportedStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, ReportedTable, ReportedColumn),
 	)
 }
+func newEvidenceStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(EvidenceInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, EvidenceTable, EvidenceColumn),
+	)
+}
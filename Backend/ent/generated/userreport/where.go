@@ -165,6 +165,16 @@ func ReporterUserIDHasSuffix(v string) predicate.UserReport {
 	return predicate.UserReport(sql.FieldHasSuffix(FieldReporterUserID, v))
 }
 
+// ReporterUserIDIsNil applies the IsNil predicate on the "reporter_user_id" field.
+func ReporterUserIDIsNil() predicate.UserReport {
+	return predicate.UserReport(sql.FieldIsNull(FieldReporterUserID))
+}
+
+// ReporterUserIDNotNil applies the NotNil predicate on the "reporter_user_id" field.
+func ReporterUserIDNotNil() predicate.UserReport {
+	return predicate.UserReport(sql.FieldNotNull(FieldReporterUserID))
+}
+
 // ReporterUserIDEqualFold applies the EqualFold predicate on the "reporter_user_id" field.
 func ReporterUserIDEqualFold(v string) predicate.UserReport {
 	return predicate.UserReport(sql.FieldEqualFold(FieldReporterUserID, v))
@@ -791,6 +801,29 @@ func HasReportedWith(preds ...predicate.User) predicate.UserReport {
 	})
 }
 
+// HasEvidence applies the HasEdge predicate on the "evidence" edge.
+func HasEvidence() predicate.UserReport {
+	return predicate.UserReport(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, EvidenceTable, EvidenceColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEvidenceWith applies the HasEdge predicate on the "evidence" edge with a given conditions (other predicates).
+func HasEvidenceWith(preds ...predicate.ReportEvidence) predicate.UserReport {
+	return predicate.UserReport(func(s *sql.Selector) {
+		step := newEvidenceStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.UserReport) predicate.UserReport {
 	return predicate.UserReport(sql.AndPredicates(predicates...))
@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/UnoraApp/be/ent/generated/reportevidence"
 	"github.com/UnoraApp/be/ent/generated/user"
 	"github.com/UnoraApp/be/ent/generated/userreport"
 )
@@ -30,6 +31,14 @@ func (_c *UserReportCreate) SetReporterUserID(v string) *UserReportCreate {
 	return _c
 }
 
+// SetNillableReporterUserID sets the "reporter_user_id" field if the given value is not nil.
+func (_c *UserReportCreate) SetNillableReporterUserID(v *string) *UserReportCreate {
+	if v != nil {
+		_c.SetReporterUserID(*v)
+	}
+	return _c
+}
+
 // SetReportedUserID sets the "reported_user_id" field.
 func (_c *UserReportCreate) SetReportedUserID(v string) *UserReportCreate {
 	_c.mutation.SetReportedUserID(v)
@@ -166,6 +175,14 @@ func (_c *UserReportCreate) SetReporterID(id string) *UserReportCreate {
 	return _c
 }
 
+// SetNillableReporterID sets the "reporter" edge to the User entity by ID if the given value is not nil.
+func (_c *UserReportCreate) SetNillableReporterID(id *string) *UserReportCreate {
+	if id != nil {
+		_c = _c.SetReporterID(*id)
+	}
+	return _c
+}
+
 // SetReporter sets the "reporter" edge to the User entity.
 func (_c *UserReportCreate) SetReporter(v *User) *UserReportCreate {
 	return _c.SetReporterID(v.ID)
@@ -182,6 +199,21 @@ func (_c *UserReportCreate) SetReported(v *User) *UserReportCreate {
 	return _c.SetReportedID(v.ID)
 }
 
+// AddEvidenceIDs adds the "evidence" edge to the ReportEvidence entity by IDs.
+func (_c *UserReportCreate) AddEvidenceIDs(ids ...string) *UserReportCreate {
+	_c.mutation.AddEvidenceIDs(ids...)
+	return _c
+}
+
+// AddEvidence adds the "evidence" edges to the ReportEvidence entity.
+func (_c *UserReportCreate) AddEvidence(v ...*ReportEvidence) *UserReportCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddEvidenceIDs(ids...)
+}
+
 // Mutation returns the UserReportMutation object of the builder.
 func (_c *UserReportCreate) Mutation() *UserReportMutation {
 	return _c.mutation
@@ -229,9 +261,6 @@ func (_c *UserReportCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (_c *UserReportCreate) check() error {
-	if _, ok := _c.mutation.ReporterUserID(); !ok {
-		return &ValidationError{Name: "reporter_user_id", err: errors.New(`generated: missing required field "UserReport.reporter_user_id"`)}
-	}
 	if v, ok := _c.mutation.ReporterUserID(); ok {
 		if err := userreport.ReporterUserIDValidator(v); err != nil {
 			return &ValidationError{Name: "reporter_user_id", err: fmt.Errorf(`generated: validator failed for field "UserReport.reporter_user_id": %w`, err)}
@@ -284,9 +313,6 @@ func (_c *UserReportCreate) check() error {
 			return &ValidationError{Name: "id", err: fmt.Errorf(`generated: validator failed for field "UserReport.id": %w`, err)}
 		}
 	}
-	if len(_c.mutation.ReporterIDs()) == 0 {
-		return &ValidationError{Name: "reporter", err: errors.New(`generated: missing required edge "UserReport.reporter"`)}
-	}
 	if len(_c.mutation.ReportedIDs()) == 0 {
 		return &ValidationError{Name: "reported", err: errors.New(`generated: missing required edge "UserReport.reported"`)}
 	}
@@ -376,7 +402,7 @@ func (_c *UserReportCreate) createSpec() (*UserReport, *sqlgraph.CreateSpec) {
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
-		_node.ReporterUserID = nodes[0]
+		_node.ReporterUserID = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
 	if nodes := _c.mutation.ReportedIDs(); len(nodes) > 0 {
@@ -396,6 +422,22 @@ func (_c *UserReportCreate) createSpec() (*UserReport, *sqlgraph.CreateSpec) {
 		_node.ReportedUserID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.EvidenceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   userreport.EvidenceTable,
+			Columns: []string{userreport.EvidenceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reportevidence.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -460,6 +502,12 @@ func (u *UserReportUpsert) UpdateReporterUserID() *UserReportUpsert {
 	return u
 }
 
+// ClearReporterUserID clears the value of the "reporter_user_id" field.
+func (u *UserReportUpsert) ClearReporterUserID() *UserReportUpsert {
+	u.SetNull(userreport.FieldReporterUserID)
+	return u
+}
+
 // SetReportedUserID sets the "reported_user_id" field.
 func (u *UserReportUpsert) SetReportedUserID(v string) *UserReportUpsert {
 	u.Set(userreport.FieldReportedUserID, v)
@@ -669,6 +717,13 @@ func (u *UserReportUpsertOne) UpdateReporterUserID() *UserReportUpsertOne {
 	})
 }
 
+// ClearReporterUserID clears the value of the "reporter_user_id" field.
+func (u *UserReportUpsertOne) ClearReporterUserID() *UserReportUpsertOne {
+	return u.Update(func(s *UserReportUpsert) {
+		s.ClearReporterUserID()
+	})
+}
+
 // SetReportedUserID sets the "reported_user_id" field.
 func (u *UserReportUpsertOne) SetReportedUserID(v string) *UserReportUpsertOne {
 	return u.Update(func(s *UserReportUpsert) {
@@ -1069,6 +1124,13 @@ func (u *UserReportUpsertBulk) UpdateReporterUserID() *UserReportUpsertBulk {
 	})
 }
 
+// ClearReporterUserID clears the value of the "reporter_user_id" field.
+func (u *UserReportUpsertBulk) ClearReporterUserID() *UserReportUpsertBulk {
+	return u.Update(func(s *UserReportUpsert) {
+		s.ClearReporterUserID()
+	})
+}
+
 // SetReportedUserID sets the "reported_user_id" field.
 func (u *UserReportUpsertBulk) SetReportedUserID(v string) *UserReportUpsertBulk {
 	return u.Update(func(s *UserReportUpsert) {
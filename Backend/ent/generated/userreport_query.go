@@ -4,14 +4,17 @@ package generated
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/UnoraApp/be/ent/generated/predicate"
+	"github.com/UnoraApp/be/ent/generated/reportevidence"
 	"github.com/UnoraApp/be/ent/generated/user"
 	"github.com/UnoraApp/be/ent/generated/userreport"
 )
@@ -25,6 +28,8 @@ type UserReportQuery struct {
 	predicates   []predicate.UserReport
 	withReporter *UserQuery
 	withReported *UserQuery
+	withEvidence *ReportEvidenceQuery
+	modifiers    []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -105,6 +110,28 @@ func (_q *UserReportQuery) QueryReported() *UserQuery {
 	return query
 }
 
+// QueryEvidence chains the current query on the "evidence" edge.
+func (_q *UserReportQuery) QueryEvidence() *ReportEvidenceQuery {
+	query := (&ReportEvidenceClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(userreport.Table, userreport.FieldID, selector),
+			sqlgraph.To(reportevidence.Table, reportevidence.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, userreport.EvidenceTable, userreport.EvidenceColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first UserReport entity from the query.
 // Returns a *NotFoundError when no UserReport was found.
 func (_q *UserReportQuery) First(ctx context.Context) (*UserReport, error) {
@@ -299,6 +326,7 @@ func (_q *UserReportQuery) Clone() *UserReportQuery {
 		predicates:   append([]predicate.UserReport{}, _q.predicates...),
 		withReporter: _q.withReporter.Clone(),
 		withReported: _q.withReported.Clone(),
+		withEvidence: _q.withEvidence.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -327,6 +355,17 @@ func (_q *UserReportQuery) WithReported(opts ...func(*UserQuery)) *UserReportQue
 	return _q
 }
 
+// WithEvidence tells the query-builder to eager-load the nodes that are connected to
+// the "evidence" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserReportQuery) WithEvidence(opts ...func(*ReportEvidenceQuery)) *UserReportQuery {
+	query := (&ReportEvidenceClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withEvidence = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -405,9 +444,10 @@ func (_q *UserReportQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*U
 	var (
 		nodes       = []*UserReport{}
 		_spec       = _q.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [3]bool{
 			_q.withReporter != nil,
 			_q.withReported != nil,
+			_q.withEvidence != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -419,6 +459,9 @@ func (_q *UserReportQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*U
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -440,6 +483,13 @@ func (_q *UserReportQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*U
 			return nil, err
 		}
 	}
+	if query := _q.withEvidence; query != nil {
+		if err := _q.loadEvidence(ctx, query, nodes,
+			func(n *UserReport) { n.Edges.Evidence = []*ReportEvidence{} },
+			func(n *UserReport, e *ReportEvidence) { n.Edges.Evidence = append(n.Edges.Evidence, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -447,7 +497,10 @@ func (_q *UserReportQuery) loadReporter(ctx context.Context, query *UserQuery, n
 	ids := make([]string, 0, len(nodes))
 	nodeids := make(map[string][]*UserReport)
 	for i := range nodes {
-		fk := nodes[i].ReporterUserID
+		if nodes[i].ReporterUserID == nil {
+			continue
+		}
+		fk := *nodes[i].ReporterUserID
 		if _, ok := nodeids[fk]; !ok {
 			ids = append(ids, fk)
 		}
@@ -501,9 +554,42 @@ func (_q *UserReportQuery) loadReported(ctx context.Context, query *UserQuery, n
 	}
 	return nil
 }
+func (_q *UserReportQuery) loadEvidence(ctx context.Context, query *ReportEvidenceQuery, nodes []*UserReport, init func(*UserReport), assign func(*UserReport, *ReportEvidence)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*UserReport)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(reportevidence.FieldReportID)
+	}
+	query.Where(predicate.ReportEvidence(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(userreport.EvidenceColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ReportID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "report_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *UserReportQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -572,6 +658,9 @@ func (_q *UserReportQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -589,6 +678,32 @@ func (_q *UserReportQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (_q *UserReportQuery) ForUpdate(opts ...sql.LockOption) *UserReportQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return _q
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (_q *UserReportQuery) ForShare(opts ...sql.LockOption) *UserReportQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return _q
+}
+
 // UserReportGroupBy is the group-by builder for UserReport entities.
 type UserReportGroupBy struct {
 	selector
@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/UnoraApp/be/ent/generated/predicate"
+	"github.com/UnoraApp/be/ent/generated/reportevidence"
 	"github.com/UnoraApp/be/ent/generated/user"
 	"github.com/UnoraApp/be/ent/generated/userreport"
 )
@@ -43,6 +44,12 @@ func (_u *UserReportUpdate) SetNillableReporterUserID(v *string) *UserReportUpda
 	return _u
 }
 
+// ClearReporterUserID clears the value of the "reporter_user_id" field.
+func (_u *UserReportUpdate) ClearReporterUserID() *UserReportUpdate {
+	_u.mutation.ClearReporterUserID()
+	return _u
+}
+
 // SetReportedUserID sets the "reported_user_id" field.
 func (_u *UserReportUpdate) SetReportedUserID(v string) *UserReportUpdate {
 	_u.mutation.SetReportedUserID(v)
@@ -211,6 +218,14 @@ func (_u *UserReportUpdate) SetReporterID(id string) *UserReportUpdate {
 	return _u
 }
 
+// SetNillableReporterID sets the "reporter" edge to the User entity by ID if the given value is not nil.
+func (_u *UserReportUpdate) SetNillableReporterID(id *string) *UserReportUpdate {
+	if id != nil {
+		_u = _u.SetReporterID(*id)
+	}
+	return _u
+}
+
 // SetReporter sets the "reporter" edge to the User entity.
 func (_u *UserReportUpdate) SetReporter(v *User) *UserReportUpdate {
 	return _u.SetReporterID(v.ID)
@@ -227,6 +242,21 @@ func (_u *UserReportUpdate) SetReported(v *User) *UserReportUpdate {
 	return _u.SetReportedID(v.ID)
 }
 
+// AddEvidenceIDs adds the "evidence" edge to the ReportEvidence entity by IDs.
+func (_u *UserReportUpdate) AddEvidenceIDs(ids ...string) *UserReportUpdate {
+	_u.mutation.AddEvidenceIDs(ids...)
+	return _u
+}
+
+// AddEvidence adds the "evidence" edges to the ReportEvidence entity.
+func (_u *UserReportUpdate) AddEvidence(v ...*ReportEvidence) *UserReportUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddEvidenceIDs(ids...)
+}
+
 // Mutation returns the UserReportMutation object of the builder.
 func (_u *UserReportUpdate) Mutation() *UserReportMutation {
 	return _u.mutation
@@ -244,6 +274,27 @@ func (_u *UserReportUpdate) ClearReported() *UserReportUpdate {
 	return _u
 }
 
+// ClearEvidence clears all "evidence" edges to the ReportEvidence entity.
+func (_u *UserReportUpdate) ClearEvidence() *UserReportUpdate {
+	_u.mutation.ClearEvidence()
+	return _u
+}
+
+// RemoveEvidenceIDs removes the "evidence" edge to ReportEvidence entities by IDs.
+func (_u *UserReportUpdate) RemoveEvidenceIDs(ids ...string) *UserReportUpdate {
+	_u.mutation.RemoveEvidenceIDs(ids...)
+	return _u
+}
+
+// RemoveEvidence removes "evidence" edges to ReportEvidence entities.
+func (_u *UserReportUpdate) RemoveEvidence(v ...*ReportEvidence) *UserReportUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveEvidenceIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *UserReportUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -308,9 +359,6 @@ func (_u *UserReportUpdate) check() error {
 			return &ValidationError{Name: "reviewed_by", err: fmt.Errorf(`generated: validator failed for field "UserReport.reviewed_by": %w`, err)}
 		}
 	}
-	if _u.mutation.ReporterCleared() && len(_u.mutation.ReporterIDs()) > 0 {
-		return errors.New(`generated: clearing a required unique edge "UserReport.reporter"`)
-	}
 	if _u.mutation.ReportedCleared() && len(_u.mutation.ReportedIDs()) > 0 {
 		return errors.New(`generated: clearing a required unique edge "UserReport.reported"`)
 	}
@@ -429,6 +477,51 @@ func (_u *UserReportUpdate) sqlSave(ctx context.Context) (_node int, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.EvidenceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   userreport.EvidenceTable,
+			Columns: []string{userreport.EvidenceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reportevidence.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedEvidenceIDs(); len(nodes) > 0 && !_u.mutation.EvidenceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   userreport.EvidenceTable,
+			Columns: []string{userreport.EvidenceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reportevidence.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EvidenceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   userreport.EvidenceTable,
+			Columns: []string{userreport.EvidenceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reportevidence.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{userreport.Label}
@@ -463,6 +556,12 @@ func (_u *UserReportUpdateOne) SetNillableReporterUserID(v *string) *UserReportU
 	return _u
 }
 
+// ClearReporterUserID clears the value of the "reporter_user_id" field.
+func (_u *UserReportUpdateOne) ClearReporterUserID() *UserReportUpdateOne {
+	_u.mutation.ClearReporterUserID()
+	return _u
+}
+
 // SetReportedUserID sets the "reported_user_id" field.
 func (_u *UserReportUpdateOne) SetReportedUserID(v string) *UserReportUpdateOne {
 	_u.mutation.SetReportedUserID(v)
@@ -631,6 +730,14 @@ func (_u *UserReportUpdateOne) SetReporterID(id string) *UserReportUpdateOne {
 	return _u
 }
 
+// SetNillableReporterID sets the "reporter" edge to the User entity by ID if the given value is not nil.
+func (_u *UserReportUpdateOne) SetNillableReporterID(id *string) *UserReportUpdateOne {
+	if id != nil {
+		_u = _u.SetReporterID(*id)
+	}
+	return _u
+}
+
 // SetReporter sets the "reporter" edge to the User entity.
 func (_u *UserReportUpdateOne) SetReporter(v *User) *UserReportUpdateOne {
 	return _u.SetReporterID(v.ID)
@@ -647,6 +754,21 @@ func (_u *UserReportUpdateOne) SetReported(v *User) *UserReportUpdateOne {
 	return _u.SetReportedID(v.ID)
 }
 
+// AddEvidenceIDs adds the "evidence" edge to the ReportEvidence entity by IDs.
+func (_u *UserReportUpdateOne) AddEvidenceIDs(ids ...string) *UserReportUpdateOne {
+	_u.mutation.AddEvidenceIDs(ids...)
+	return _u
+}
+
+// AddEvidence adds the "evidence" edges to the ReportEvidence entity.
+func (_u *UserReportUpdateOne) AddEvidence(v ...*ReportEvidence) *UserReportUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddEvidenceIDs(ids...)
+}
+
 // Mutation returns the UserReportMutation object of the builder.
 func (_u *UserReportUpdateOne) Mutation() *UserReportMutation {
 	return _u.mutation
@@ -664,6 +786,27 @@ func (_u *UserReportUpdateOne) ClearReported() *UserReportUpdateOne {
 	return _u
 }
 
+// ClearEvidence clears all "evidence" edges to the ReportEvidence entity.
+func (_u *UserReportUpdateOne) ClearEvidence() *UserReportUpdateOne {
+	_u.mutation.ClearEvidence()
+	return _u
+}
+
+// RemoveEvidenceIDs removes the "evidence" edge to ReportEvidence entities by IDs.
+func (_u *UserReportUpdateOne) RemoveEvidenceIDs(ids ...string) *UserReportUpdateOne {
+	_u.mutation.RemoveEvidenceIDs(ids...)
+	return _u
+}
+
+// RemoveEvidence removes "evidence" edges to ReportEvidence entities.
+func (_u *UserReportUpdateOne) RemoveEvidence(v ...*ReportEvidence) *UserReportUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveEvidenceIDs(ids...)
+}
+
 // Where appends a list predicates to the UserReportUpdate builder.
 func (_u *UserReportUpdateOne) Where(ps ...predicate.UserReport) *UserReportUpdateOne {
 	_u.mutation.Where(ps...)
@@ -741,9 +884,6 @@ func (_u *UserReportUpdateOne) check() error {
 			return &ValidationError{Name: "reviewed_by", err: fmt.Errorf(`generated: validator failed for field "UserReport.reviewed_by": %w`, err)}
 		}
 	}
-	if _u.mutation.ReporterCleared() && len(_u.mutation.ReporterIDs()) > 0 {
-		return errors.New(`generated: clearing a required unique edge "UserReport.reporter"`)
-	}
 	if _u.mutation.ReportedCleared() && len(_u.mutation.ReportedIDs()) > 0 {
 		return errors.New(`generated: clearing a required unique edge "UserReport.reported"`)
 	}
@@ -879,6 +1019,51 @@ func (_u *UserReportUpdateOne) sqlSave(ctx context.Context) (_node *UserReport,
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.EvidenceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   userreport.EvidenceTable,
+			Columns: []string{userreport.EvidenceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reportevidence.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedEvidenceIDs(); len(nodes) > 0 && !_u.mutation.EvidenceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   userreport.EvidenceTable,
+			Columns: []string{userreport.EvidenceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reportevidence.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EvidenceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   userreport.EvidenceTable,
+			Columns: []string{userreport.EvidenceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reportevidence.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &UserReport{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,180 @@
+// Code generated by ent, DO NOT EDIT.
+
+package webhookevent
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the webhookevent type in the database.
+	Label = "webhook_event"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldProvider holds the string denoting the provider field in the database.
+	FieldProvider = "provider"
+	// FieldEventID holds the string denoting the event_id field in the database.
+	FieldEventID = "event_id"
+	// FieldEventType holds the string denoting the event_type field in the database.
+	FieldEventType = "event_type"
+	// FieldPayload holds the string denoting the payload field in the database.
+	FieldPayload = "payload"
+	// FieldSignature holds the string denoting the signature field in the database.
+	FieldSignature = "signature"
+	// FieldSignatureValid holds the string denoting the signature_valid field in the database.
+	FieldSignatureValid = "signature_valid"
+	// FieldProcessingStatus holds the string denoting the processing_status field in the database.
+	FieldProcessingStatus = "processing_status"
+	// FieldErrorMessage holds the string denoting the error_message field in the database.
+	FieldErrorMessage = "error_message"
+	// FieldAttempts holds the string denoting the attempts field in the database.
+	FieldAttempts = "attempts"
+	// FieldReceivedAt holds the string denoting the received_at field in the database.
+	FieldReceivedAt = "received_at"
+	// FieldProcessedAt holds the string denoting the processed_at field in the database.
+	FieldProcessedAt = "processed_at"
+	// Table holds the table name of the webhookevent in the database.
+	Table = "webhook_events"
+)
+
+// Columns holds all SQL columns for webhookevent fields.
+var Columns = []string{
+	FieldID,
+	FieldProvider,
+	FieldEventID,
+	FieldEventType,
+	FieldPayload,
+	FieldSignature,
+	FieldSignatureValid,
+	FieldProcessingStatus,
+	FieldErrorMessage,
+	FieldAttempts,
+	FieldReceivedAt,
+	FieldProcessedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultProvider holds the default value on creation for the "provider" field.
+	DefaultProvider string
+	// ProviderValidator is a validator for the "provider" field. It is called by the builders before save.
+	ProviderValidator func(string) error
+	// EventIDValidator is a validator for the "event_id" field. It is called by the builders before save.
+	EventIDValidator func(string) error
+	// EventTypeValidator is a validator for the "event_type" field. It is called by the builders before save.
+	EventTypeValidator func(string) error
+	// SignatureValidator is a validator for the "signature" field. It is called by the builders before save.
+	SignatureValidator func(string) error
+	// DefaultSignatureValid holds the default value on creation for the "signature_valid" field.
+	DefaultSignatureValid bool
+	// DefaultAttempts holds the default value on creation for the "attempts" field.
+	DefaultAttempts int
+	// DefaultReceivedAt holds the default value on creation for the "received_at" field.
+	DefaultReceivedAt func() time.Time
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
+
+// ProcessingStatus defines the type for the "processing_status" enum field.
+type ProcessingStatus string
+
+// ProcessingStatusReceived is the default value of the ProcessingStatus enum.
+const DefaultProcessingStatus = ProcessingStatusReceived
+
+// ProcessingStatus values.
+const (
+	ProcessingStatusReceived  ProcessingStatus = "received"
+	ProcessingStatusProcessed ProcessingStatus = "processed"
+	ProcessingStatusIgnored   ProcessingStatus = "ignored"
+	ProcessingStatusFailed    ProcessingStatus = "failed"
+	ProcessingStatusRejected  ProcessingStatus = "rejected"
+)
+
+func (ps ProcessingStatus) String() string {
+	return string(ps)
+}
+
+// ProcessingStatusValidator is a validator for the "processing_status" field enum values. It is called by the builders before save.
+func ProcessingStatusValidator(ps ProcessingStatus) error {
+	switch ps {
+	case ProcessingStatusReceived, ProcessingStatusProcessed, ProcessingStatusIgnored, ProcessingStatusFailed, ProcessingStatusRejected:
+		return nil
+	default:
+		return fmt.Errorf("webhookevent: invalid enum value for processing_status field: %q", ps)
+	}
+}
+
+// OrderOption defines the ordering options for the WebhookEvent queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByProvider orders the results by the provider field.
+func ByProvider(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProvider, opts...).ToFunc()
+}
+
+// ByEventID orders the results by the event_id field.
+func ByEventID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEventID, opts...).ToFunc()
+}
+
+// ByEventType orders the results by the event_type field.
+func ByEventType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEventType, opts...).ToFunc()
+}
+
+// ByPayload orders the results by the payload field.
+func ByPayload(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPayload, opts...).ToFunc()
+}
+
+// BySignature orders the results by the signature field.
+func BySignature(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSignature, opts...).ToFunc()
+}
+
+// BySignatureValid orders the results by the signature_valid field.
+func BySignatureValid(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSignatureValid, opts...).ToFunc()
+}
+
+// ByProcessingStatus orders the results by the processing_status field.
+func ByProcessingStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProcessingStatus, opts...).ToFunc()
+}
+
+// ByErrorMessage orders the results by the error_message field.
+func ByErrorMessage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldErrorMessage, opts...).ToFunc()
+}
+
+// ByAttempts orders the results by the attempts field.
+func ByAttempts(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttempts, opts...).ToFunc()
+}
+
+// ByReceivedAt orders the results by the received_at field.
+func ByReceivedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReceivedAt, opts...).ToFunc()
+}
+
+// ByProcessedAt orders the results by the processed_at field.
+func ByProcessedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProcessedAt, opts...).ToFunc()
+}
@@ -0,0 +1,963 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/UnoraApp/be/ent/generated/webhookevent"
+)
+
+// WebhookEventCreate is the builder for creating a WebhookEvent entity.
+type WebhookEventCreate struct {
+	config
+	mutation *WebhookEventMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetProvider sets the "provider" field.
+func (_c *WebhookEventCreate) SetProvider(v string) *WebhookEventCreate {
+	_c.mutation.SetProvider(v)
+	return _c
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (_c *WebhookEventCreate) SetNillableProvider(v *string) *WebhookEventCreate {
+	if v != nil {
+		_c.SetProvider(*v)
+	}
+	return _c
+}
+
+// SetEventID sets the "event_id" field.
+func (_c *WebhookEventCreate) SetEventID(v string) *WebhookEventCreate {
+	_c.mutation.SetEventID(v)
+	return _c
+}
+
+// SetNillableEventID sets the "event_id" field if the given value is not nil.
+func (_c *WebhookEventCreate) SetNillableEventID(v *string) *WebhookEventCreate {
+	if v != nil {
+		_c.SetEventID(*v)
+	}
+	return _c
+}
+
+// SetEventType sets the "event_type" field.
+func (_c *WebhookEventCreate) SetEventType(v string) *WebhookEventCreate {
+	_c.mutation.SetEventType(v)
+	return _c
+}
+
+// SetNillableEventType sets the "event_type" field if the given value is not nil.
+func (_c *WebhookEventCreate) SetNillableEventType(v *string) *WebhookEventCreate {
+	if v != nil {
+		_c.SetEventType(*v)
+	}
+	return _c
+}
+
+// SetPayload sets the "payload" field.
+func (_c *WebhookEventCreate) SetPayload(v string) *WebhookEventCreate {
+	_c.mutation.SetPayload(v)
+	return _c
+}
+
+// SetSignature sets the "signature" field.
+func (_c *WebhookEventCreate) SetSignature(v string) *WebhookEventCreate {
+	_c.mutation.SetSignature(v)
+	return _c
+}
+
+// SetNillableSignature sets the "signature" field if the given value is not nil.
+func (_c *WebhookEventCreate) SetNillableSignature(v *string) *WebhookEventCreate {
+	if v != nil {
+		_c.SetSignature(*v)
+	}
+	return _c
+}
+
+// SetSignatureValid sets the "signature_valid" field.
+func (_c *WebhookEventCreate) SetSignatureValid(v bool) *WebhookEventCreate {
+	_c.mutation.SetSignatureValid(v)
+	return _c
+}
+
+// SetNillableSignatureValid sets the "signature_valid" field if the given value is not nil.
+func (_c *WebhookEventCreate) SetNillableSignatureValid(v *bool) *WebhookEventCreate {
+	if v != nil {
+		_c.SetSignatureValid(*v)
+	}
+	return _c
+}
+
+// SetProcessingStatus sets the "processing_status" field.
+func (_c *WebhookEventCreate) SetProcessingStatus(v webhookevent.ProcessingStatus) *WebhookEventCreate {
+	_c.mutation.SetProcessingStatus(v)
+	return _c
+}
+
+// SetNillableProcessingStatus sets the "processing_status" field if the given value is not nil.
+func (_c *WebhookEventCreate) SetNillableProcessingStatus(v *webhookevent.ProcessingStatus) *WebhookEventCreate {
+	if v != nil {
+		_c.SetProcessingStatus(*v)
+	}
+	return _c
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (_c *WebhookEventCreate) SetErrorMessage(v string) *WebhookEventCreate {
+	_c.mutation.SetErrorMessage(v)
+	return _c
+}
+
+// SetNillableErrorMessage sets the "error_message" field if the given value is not nil.
+func (_c *WebhookEventCreate) SetNillableErrorMessage(v *string) *WebhookEventCreate {
+	if v != nil {
+		_c.SetErrorMessage(*v)
+	}
+	return _c
+}
+
+// SetAttempts sets the "attempts" field.
+func (_c *WebhookEventCreate) SetAttempts(v int) *WebhookEventCreate {
+	_c.mutation.SetAttempts(v)
+	return _c
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_c *WebhookEventCreate) SetNillableAttempts(v *int) *WebhookEventCreate {
+	if v != nil {
+		_c.SetAttempts(*v)
+	}
+	return _c
+}
+
+// SetReceivedAt sets the "received_at" field.
+func (_c *WebhookEventCreate) SetReceivedAt(v time.Time) *WebhookEventCreate {
+	_c.mutation.SetReceivedAt(v)
+	return _c
+}
+
+// SetNillableReceivedAt sets the "received_at" field if the given value is not nil.
+func (_c *WebhookEventCreate) SetNillableReceivedAt(v *time.Time) *WebhookEventCreate {
+	if v != nil {
+		_c.SetReceivedAt(*v)
+	}
+	return _c
+}
+
+// SetProcessedAt sets the "processed_at" field.
+func (_c *WebhookEventCreate) SetProcessedAt(v time.Time) *WebhookEventCreate {
+	_c.mutation.SetProcessedAt(v)
+	return _c
+}
+
+// SetNillableProcessedAt sets the "processed_at" field if the given value is not nil.
+func (_c *WebhookEventCreate) SetNillableProcessedAt(v *time.Time) *WebhookEventCreate {
+	if v != nil {
+		_c.SetProcessedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *WebhookEventCreate) SetID(v string) *WebhookEventCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the WebhookEventMutation object of the builder.
+func (_c *WebhookEventCreate) Mutation() *WebhookEventMutation {
+	return _c.mutation
+}
+
+// Save creates the WebhookEvent in the database.
+func (_c *WebhookEventCreate) Save(ctx context.Context) (*WebhookEvent, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *WebhookEventCreate) SaveX(ctx context.Context) *WebhookEvent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WebhookEventCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WebhookEventCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *WebhookEventCreate) defaults() {
+	if _, ok := _c.mutation.Provider(); !ok {
+		v := webhookevent.DefaultProvider
+		_c.mutation.SetProvider(v)
+	}
+	if _, ok := _c.mutation.SignatureValid(); !ok {
+		v := webhookevent.DefaultSignatureValid
+		_c.mutation.SetSignatureValid(v)
+	}
+	if _, ok := _c.mutation.ProcessingStatus(); !ok {
+		v := webhookevent.DefaultProcessingStatus
+		_c.mutation.SetProcessingStatus(v)
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		v := webhookevent.DefaultAttempts
+		_c.mutation.SetAttempts(v)
+	}
+	if _, ok := _c.mutation.ReceivedAt(); !ok {
+		v := webhookevent.DefaultReceivedAt()
+		_c.mutation.SetReceivedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *WebhookEventCreate) check() error {
+	if _, ok := _c.mutation.Provider(); !ok {
+		return &ValidationError{Name: "provider", err: errors.New(`generated: missing required field "WebhookEvent.provider"`)}
+	}
+	if v, ok := _c.mutation.Provider(); ok {
+		if err := webhookevent.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`generated: validator failed for field "WebhookEvent.provider": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.EventID(); ok {
+		if err := webhookevent.EventIDValidator(v); err != nil {
+			return &ValidationError{Name: "event_id", err: fmt.Errorf(`generated: validator failed for field "WebhookEvent.event_id": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.EventType(); ok {
+		if err := webhookevent.EventTypeValidator(v); err != nil {
+			return &ValidationError{Name: "event_type", err: fmt.Errorf(`generated: validator failed for field "WebhookEvent.event_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Payload(); !ok {
+		return &ValidationError{Name: "payload", err: errors.New(`generated: missing required field "WebhookEvent.payload"`)}
+	}
+	if v, ok := _c.mutation.Signature(); ok {
+		if err := webhookevent.SignatureValidator(v); err != nil {
+			return &ValidationError{Name: "signature", err: fmt.Errorf(`generated: validator failed for field "WebhookEvent.signature": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SignatureValid(); !ok {
+		return &ValidationError{Name: "signature_valid", err: errors.New(`generated: missing required field "WebhookEvent.signature_valid"`)}
+	}
+	if _, ok := _c.mutation.ProcessingStatus(); !ok {
+		return &ValidationError{Name: "processing_status", err: errors.New(`generated: missing required field "WebhookEvent.processing_status"`)}
+	}
+	if v, ok := _c.mutation.ProcessingStatus(); ok {
+		if err := webhookevent.ProcessingStatusValidator(v); err != nil {
+			return &ValidationError{Name: "processing_status", err: fmt.Errorf(`generated: validator failed for field "WebhookEvent.processing_status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`generated: missing required field "WebhookEvent.attempts"`)}
+	}
+	if _, ok := _c.mutation.ReceivedAt(); !ok {
+		return &ValidationError{Name: "received_at", err: errors.New(`generated: missing required field "WebhookEvent.received_at"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := webhookevent.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`generated: validator failed for field "WebhookEvent.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *WebhookEventCreate) sqlSave(ctx context.Context) (*WebhookEvent, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected WebhookEvent.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *WebhookEventCreate) createSpec() (*WebhookEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WebhookEvent{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(webhookevent.Table, sqlgraph.NewFieldSpec(webhookevent.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.Provider(); ok {
+		_spec.SetField(webhookevent.FieldProvider, field.TypeString, value)
+		_node.Provider = value
+	}
+	if value, ok := _c.mutation.EventID(); ok {
+		_spec.SetField(webhookevent.FieldEventID, field.TypeString, value)
+		_node.EventID = &value
+	}
+	if value, ok := _c.mutation.EventType(); ok {
+		_spec.SetField(webhookevent.FieldEventType, field.TypeString, value)
+		_node.EventType = value
+	}
+	if value, ok := _c.mutation.Payload(); ok {
+		_spec.SetField(webhookevent.FieldPayload, field.TypeString, value)
+		_node.Payload = value
+	}
+	if value, ok := _c.mutation.Signature(); ok {
+		_spec.SetField(webhookevent.FieldSignature, field.TypeString, value)
+		_node.Signature = &value
+	}
+	if value, ok := _c.mutation.SignatureValid(); ok {
+		_spec.SetField(webhookevent.FieldSignatureValid, field.TypeBool, value)
+		_node.SignatureValid = value
+	}
+	if value, ok := _c.mutation.ProcessingStatus(); ok {
+		_spec.SetField(webhookevent.FieldProcessingStatus, field.TypeEnum, value)
+		_node.ProcessingStatus = value
+	}
+	if value, ok := _c.mutation.ErrorMessage(); ok {
+		_spec.SetField(webhookevent.FieldErrorMessage, field.TypeString, value)
+		_node.ErrorMessage = &value
+	}
+	if value, ok := _c.mutation.Attempts(); ok {
+		_spec.SetField(webhookevent.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := _c.mutation.ReceivedAt(); ok {
+		_spec.SetField(webhookevent.FieldReceivedAt, field.TypeTime, value)
+		_node.ReceivedAt = value
+	}
+	if value, ok := _c.mutation.ProcessedAt(); ok {
+		_spec.SetField(webhookevent.FieldProcessedAt, field.TypeTime, value)
+		_node.ProcessedAt = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.WebhookEvent.Create().
+//		SetProvider(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WebhookEventUpsert) {
+//			SetProvider(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *WebhookEventCreate) OnConflict(opts ...sql.ConflictOption) *WebhookEventUpsertOne {
+	_c.conflict = opts
+	return &WebhookEventUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.WebhookEvent.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *WebhookEventCreate) OnConflictColumns(columns ...string) *WebhookEventUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &WebhookEventUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// WebhookEventUpsertOne is the builder for "upsert"-ing
+	//  one WebhookEvent node.
+	WebhookEventUpsertOne struct {
+		create *WebhookEventCreate
+	}
+
+	// WebhookEventUpsert is the "OnConflict" setter.
+	WebhookEventUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetProcessingStatus sets the "processing_status" field.
+func (u *WebhookEventUpsert) SetProcessingStatus(v webhookevent.ProcessingStatus) *WebhookEventUpsert {
+	u.Set(webhookevent.FieldProcessingStatus, v)
+	return u
+}
+
+// UpdateProcessingStatus sets the "processing_status" field to the value that was provided on create.
+func (u *WebhookEventUpsert) UpdateProcessingStatus() *WebhookEventUpsert {
+	u.SetExcluded(webhookevent.FieldProcessingStatus)
+	return u
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (u *WebhookEventUpsert) SetErrorMessage(v string) *WebhookEventUpsert {
+	u.Set(webhookevent.FieldErrorMessage, v)
+	return u
+}
+
+// UpdateErrorMessage sets the "error_message" field to the value that was provided on create.
+func (u *WebhookEventUpsert) UpdateErrorMessage() *WebhookEventUpsert {
+	u.SetExcluded(webhookevent.FieldErrorMessage)
+	return u
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (u *WebhookEventUpsert) ClearErrorMessage() *WebhookEventUpsert {
+	u.SetNull(webhookevent.FieldErrorMessage)
+	return u
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *WebhookEventUpsert) SetAttempts(v int) *WebhookEventUpsert {
+	u.Set(webhookevent.FieldAttempts, v)
+	return u
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *WebhookEventUpsert) UpdateAttempts() *WebhookEventUpsert {
+	u.SetExcluded(webhookevent.FieldAttempts)
+	return u
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *WebhookEventUpsert) AddAttempts(v int) *WebhookEventUpsert {
+	u.Add(webhookevent.FieldAttempts, v)
+	return u
+}
+
+// SetProcessedAt sets the "processed_at" field.
+func (u *WebhookEventUpsert) SetProcessedAt(v time.Time) *WebhookEventUpsert {
+	u.Set(webhookevent.FieldProcessedAt, v)
+	return u
+}
+
+// UpdateProcessedAt sets the "processed_at" field to the value that was provided on create.
+func (u *WebhookEventUpsert) UpdateProcessedAt() *WebhookEventUpsert {
+	u.SetExcluded(webhookevent.FieldProcessedAt)
+	return u
+}
+
+// ClearProcessedAt clears the value of the "processed_at" field.
+func (u *WebhookEventUpsert) ClearProcessedAt() *WebhookEventUpsert {
+	u.SetNull(webhookevent.FieldProcessedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.WebhookEvent.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(webhookevent.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *WebhookEventUpsertOne) UpdateNewValues() *WebhookEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(webhookevent.FieldID)
+		}
+		if _, exists := u.create.mutation.Provider(); exists {
+			s.SetIgnore(webhookevent.FieldProvider)
+		}
+		if _, exists := u.create.mutation.EventID(); exists {
+			s.SetIgnore(webhookevent.FieldEventID)
+		}
+		if _, exists := u.create.mutation.EventType(); exists {
+			s.SetIgnore(webhookevent.FieldEventType)
+		}
+		if _, exists := u.create.mutation.Payload(); exists {
+			s.SetIgnore(webhookevent.FieldPayload)
+		}
+		if _, exists := u.create.mutation.Signature(); exists {
+			s.SetIgnore(webhookevent.FieldSignature)
+		}
+		if _, exists := u.create.mutation.SignatureValid(); exists {
+			s.SetIgnore(webhookevent.FieldSignatureValid)
+		}
+		if _, exists := u.create.mutation.ReceivedAt(); exists {
+			s.SetIgnore(webhookevent.FieldReceivedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.WebhookEvent.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *WebhookEventUpsertOne) Ignore() *WebhookEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WebhookEventUpsertOne) DoNothing() *WebhookEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WebhookEventCreate.OnConflict
+// documentation for more info.
+func (u *WebhookEventUpsertOne) Update(set func(*WebhookEventUpsert)) *WebhookEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WebhookEventUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetProcessingStatus sets the "processing_status" field.
+func (u *WebhookEventUpsertOne) SetProcessingStatus(v webhookevent.ProcessingStatus) *WebhookEventUpsertOne {
+	return u.Update(func(s *WebhookEventUpsert) {
+		s.SetProcessingStatus(v)
+	})
+}
+
+// UpdateProcessingStatus sets the "processing_status" field to the value that was provided on create.
+func (u *WebhookEventUpsertOne) UpdateProcessingStatus() *WebhookEventUpsertOne {
+	return u.Update(func(s *WebhookEventUpsert) {
+		s.UpdateProcessingStatus()
+	})
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (u *WebhookEventUpsertOne) SetErrorMessage(v string) *WebhookEventUpsertOne {
+	return u.Update(func(s *WebhookEventUpsert) {
+		s.SetErrorMessage(v)
+	})
+}
+
+// UpdateErrorMessage sets the "error_message" field to the value that was provided on create.
+func (u *WebhookEventUpsertOne) UpdateErrorMessage() *WebhookEventUpsertOne {
+	return u.Update(func(s *WebhookEventUpsert) {
+		s.UpdateErrorMessage()
+	})
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (u *WebhookEventUpsertOne) ClearErrorMessage() *WebhookEventUpsertOne {
+	return u.Update(func(s *WebhookEventUpsert) {
+		s.ClearErrorMessage()
+	})
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *WebhookEventUpsertOne) SetAttempts(v int) *WebhookEventUpsertOne {
+	return u.Update(func(s *WebhookEventUpsert) {
+		s.SetAttempts(v)
+	})
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *WebhookEventUpsertOne) AddAttempts(v int) *WebhookEventUpsertOne {
+	return u.Update(func(s *WebhookEventUpsert) {
+		s.AddAttempts(v)
+	})
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *WebhookEventUpsertOne) UpdateAttempts() *WebhookEventUpsertOne {
+	return u.Update(func(s *WebhookEventUpsert) {
+		s.UpdateAttempts()
+	})
+}
+
+// SetProcessedAt sets the "processed_at" field.
+func (u *WebhookEventUpsertOne) SetProcessedAt(v time.Time) *WebhookEventUpsertOne {
+	return u.Update(func(s *WebhookEventUpsert) {
+		s.SetProcessedAt(v)
+	})
+}
+
+// UpdateProcessedAt sets the "processed_at" field to the value that was provided on create.
+func (u *WebhookEventUpsertOne) UpdateProcessedAt() *WebhookEventUpsertOne {
+	return u.Update(func(s *WebhookEventUpsert) {
+		s.UpdateProcessedAt()
+	})
+}
+
+// ClearProcessedAt clears the value of the "processed_at" field.
+func (u *WebhookEventUpsertOne) ClearProcessedAt() *WebhookEventUpsertOne {
+	return u.Update(func(s *WebhookEventUpsert) {
+		s.ClearProcessedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *WebhookEventUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("generated: missing options for WebhookEventCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WebhookEventUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *WebhookEventUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("generated: WebhookEventUpsertOne.ID is not supported by MySQL driver. Use WebhookEventUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *WebhookEventUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// WebhookEventCreateBulk is the builder for creating many WebhookEvent entities in bulk.
+type WebhookEventCreateBulk struct {
+	config
+	err      error
+	builders []*WebhookEventCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the WebhookEvent entities in the database.
+func (_c *WebhookEventCreateBulk) Save(ctx context.Context) ([]*WebhookEvent, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*WebhookEvent, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WebhookEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *WebhookEventCreateBulk) SaveX(ctx context.Context) []*WebhookEvent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WebhookEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WebhookEventCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.WebhookEvent.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WebhookEventUpsert) {
+//			SetProvider(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *WebhookEventCreateBulk) OnConflict(opts ...sql.ConflictOption) *WebhookEventUpsertBulk {
+	_c.conflict = opts
+	return &WebhookEventUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.WebhookEvent.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *WebhookEventCreateBulk) OnConflictColumns(columns ...string) *WebhookEventUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &WebhookEventUpsertBulk{
+		create: _c,
+	}
+}
+
+// WebhookEventUpsertBulk is the builder for "upsert"-ing
+// a bulk of WebhookEvent nodes.
+type WebhookEventUpsertBulk struct {
+	create *WebhookEventCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.WebhookEvent.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(webhookevent.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *WebhookEventUpsertBulk) UpdateNewValues() *WebhookEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(webhookevent.FieldID)
+			}
+			if _, exists := b.mutation.Provider(); exists {
+				s.SetIgnore(webhookevent.FieldProvider)
+			}
+			if _, exists := b.mutation.EventID(); exists {
+				s.SetIgnore(webhookevent.FieldEventID)
+			}
+			if _, exists := b.mutation.EventType(); exists {
+				s.SetIgnore(webhookevent.FieldEventType)
+			}
+			if _, exists := b.mutation.Payload(); exists {
+				s.SetIgnore(webhookevent.FieldPayload)
+			}
+			if _, exists := b.mutation.Signature(); exists {
+				s.SetIgnore(webhookevent.FieldSignature)
+			}
+			if _, exists := b.mutation.SignatureValid(); exists {
+				s.SetIgnore(webhookevent.FieldSignatureValid)
+			}
+			if _, exists := b.mutation.ReceivedAt(); exists {
+				s.SetIgnore(webhookevent.FieldReceivedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.WebhookEvent.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *WebhookEventUpsertBulk) Ignore() *WebhookEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WebhookEventUpsertBulk) DoNothing() *WebhookEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WebhookEventCreateBulk.OnConflict
+// documentation for more info.
+func (u *WebhookEventUpsertBulk) Update(set func(*WebhookEventUpsert)) *WebhookEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WebhookEventUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetProcessingStatus sets the "processing_status" field.
+func (u *WebhookEventUpsertBulk) SetProcessingStatus(v webhookevent.ProcessingStatus) *WebhookEventUpsertBulk {
+	return u.Update(func(s *WebhookEventUpsert) {
+		s.SetProcessingStatus(v)
+	})
+}
+
+// UpdateProcessingStatus sets the "processing_status" field to the value that was provided on create.
+func (u *WebhookEventUpsertBulk) UpdateProcessingStatus() *WebhookEventUpsertBulk {
+	return u.Update(func(s *WebhookEventUpsert) {
+		s.UpdateProcessingStatus()
+	})
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (u *WebhookEventUpsertBulk) SetErrorMessage(v string) *WebhookEventUpsertBulk {
+	return u.Update(func(s *WebhookEventUpsert) {
+		s.SetErrorMessage(v)
+	})
+}
+
+// UpdateErrorMessage sets the "error_message" field to the value that was provided on create.
+func (u *WebhookEventUpsertBulk) UpdateErrorMessage() *WebhookEventUpsertBulk {
+	return u.Update(func(s *WebhookEventUpsert) {
+		s.UpdateErrorMessage()
+	})
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (u *WebhookEventUpsertBulk) ClearErrorMessage() *WebhookEventUpsertBulk {
+	return u.Update(func(s *WebhookEventUpsert) {
+		s.ClearErrorMessage()
+	})
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *WebhookEventUpsertBulk) SetAttempts(v int) *WebhookEventUpsertBulk {
+	return u.Update(func(s *WebhookEventUpsert) {
+		s.SetAttempts(v)
+	})
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *WebhookEventUpsertBulk) AddAttempts(v int) *WebhookEventUpsertBulk {
+	return u.Update(func(s *WebhookEventUpsert) {
+		s.AddAttempts(v)
+	})
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *WebhookEventUpsertBulk) UpdateAttempts() *WebhookEventUpsertBulk {
+	return u.Update(func(s *WebhookEventUpsert) {
+		s.UpdateAttempts()
+	})
+}
+
+// SetProcessedAt sets the "processed_at" field.
+func (u *WebhookEventUpsertBulk) SetProcessedAt(v time.Time) *WebhookEventUpsertBulk {
+	return u.Update(func(s *WebhookEventUpsert) {
+		s.SetProcessedAt(v)
+	})
+}
+
+// UpdateProcessedAt sets the "processed_at" field to the value that was provided on create.
+func (u *WebhookEventUpsertBulk) UpdateProcessedAt() *WebhookEventUpsertBulk {
+	return u.Update(func(s *WebhookEventUpsert) {
+		s.UpdateProcessedAt()
+	})
+}
+
+// ClearProcessedAt clears the value of the "processed_at" field.
+func (u *WebhookEventUpsertBulk) ClearProcessedAt() *WebhookEventUpsertBulk {
+	return u.Update(func(s *WebhookEventUpsert) {
+		s.ClearProcessedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *WebhookEventUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("generated: OnConflict was set for builder %d. Set it on the WebhookEventCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("generated: missing options for WebhookEventCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WebhookEventUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
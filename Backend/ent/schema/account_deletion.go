@@ -0,0 +1,69 @@
+// Package schema contains the Ent schema definitions
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AccountDeletion holds the schema definition for the AccountDeletion entity
+// (a request to delete an account, carried out once its cooling-off period
+// ends unless the user cancels it first).
+type AccountDeletion struct {
+	ent.Schema
+}
+
+// Fields of the AccountDeletion.
+func (AccountDeletion) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			MaxLen(36).
+			NotEmpty().
+			Unique().
+			Immutable(),
+
+		field.String("user_id").
+			MaxLen(36).
+			NotEmpty().
+			Immutable(),
+
+		field.Enum("deletion_status").
+			Values("scheduled", "cancelled", "completed").
+			Default("scheduled"),
+
+		// user for self-serve requests, admin for moderation deletions
+		field.Enum("requested_by").
+			Values("user", "admin").
+			Default("user").
+			Immutable(),
+		field.String("reason").
+			MaxLen(500).
+			Optional().
+			Nillable().
+			Immutable(),
+
+		// Timestamps
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		// End of the cooling-off period
+		field.Time("scheduled_for"),
+		field.Time("cancelled_at").
+			Optional().
+			Nillable(),
+		field.Time("completed_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Indexes of the AccountDeletion.
+func (AccountDeletion) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "deletion_status"),
+		index.Fields("deletion_status", "scheduled_for"),
+	}
+}
@@ -0,0 +1,83 @@
+// Package schema contains the Ent schema definitions
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AdminUser holds the schema definition for the AdminUser entity (a person
+// with access to the admin API, identified by their own API key).
+type AdminUser struct {
+	ent.Schema
+}
+
+// Fields of the AdminUser.
+func (AdminUser) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			MaxLen(36).
+			NotEmpty().
+			Unique().
+			Immutable(),
+
+		// Identifies the admin in audit logs
+		field.String("email").
+			MaxLen(100).
+			NotEmpty(),
+		field.String("name").
+			MaxLen(100).
+			NotEmpty(),
+
+		// Role, mapped to route permissions in RegisterAdminRoutes
+		field.Enum("role").
+			Values("support", "moderator", "finance", "superadmin"),
+		field.Enum("admin_status").
+			Values("active", "disabled").
+			Default("active"),
+
+		// API key: only its SHA-256 is stored, and its first characters so
+		// admins can tell keys apart
+		field.String("api_key_hash").
+			MaxLen(64).
+			NotEmpty().
+			Sensitive(),
+		field.String("api_key_prefix").
+			MaxLen(16).
+			NotEmpty(),
+		field.Time("api_key_rotated_at").
+			Default(time.Now),
+
+		// Admin who created the account; empty for one created from the
+		// command line
+		field.String("created_by").
+			MaxLen(36).
+			Optional().
+			Nillable().
+			Immutable(),
+
+		// Timestamps
+		field.Time("last_used_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the AdminUser.
+func (AdminUser) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("email").
+			Unique(),
+		index.Fields("api_key_hash").
+			Unique(),
+	}
+}
@@ -0,0 +1,74 @@
+// Package schema contains the Ent schema definitions
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// BalanceDrift holds the schema definition for the BalanceDrift entity (a
+// mismatch between a user's stored credit balance and their ledger account,
+// found by reconciliation).
+type BalanceDrift struct {
+	ent.Schema
+}
+
+// Fields of the BalanceDrift.
+func (BalanceDrift) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			MaxLen(36).
+			NotEmpty().
+			Unique().
+			Immutable(),
+
+		field.String("user_id").
+			MaxLen(36).
+			NotEmpty().
+			Immutable(),
+
+		// users.credit_balance when last checked
+		field.Int("stored_balance"),
+
+		// Sum of the user's ledger entries when last checked
+		field.Int("ledger_balance"),
+
+		field.Int("difference").
+			Comment("stored_balance - ledger_balance"),
+
+		// Timestamps
+		field.Time("detected_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("last_checked_at").
+			Default(time.Now),
+		// Set once a later reconciliation finds the balance and ledger agree
+		field.Time("resolved_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Indexes of the BalanceDrift.
+func (BalanceDrift) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "resolved_at"),
+		index.Fields("resolved_at", "detected_at"),
+	}
+}
+
+// Edges of the BalanceDrift.
+func (BalanceDrift) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("balance_drifts").
+			Field("user_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
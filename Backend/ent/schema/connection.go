@@ -79,5 +79,8 @@ func (Connection) Edges() []ent.Edge {
 		edge.To("streak", Streak.Type).
 			Unique(),
 		edge.To("reveals", Reveal.Type),
+		edge.To("reveal_gifts", RevealGift.Type),
+		edge.To("conversation", Conversation.Type).
+			Unique(),
 	}
 }
@@ -0,0 +1,84 @@
+// Package schema contains the Ent schema definitions
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Conversation holds the schema definition for the Conversation entity (post-Day-15 chat).
+type Conversation struct {
+	ent.Schema
+}
+
+// Fields of the Conversation.
+func (Conversation) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			MaxLen(36).
+			NotEmpty().
+			Unique().
+			Immutable(),
+
+		// One conversation per connection
+		field.String("connection_id").
+			MaxLen(36).
+			NotEmpty().
+			Immutable(),
+
+		// Participants, copied from the connection for fast membership checks
+		field.String("user_a_id").
+			MaxLen(36).
+			NotEmpty().
+			Immutable(),
+		field.String("user_b_id").
+			MaxLen(36).
+			NotEmpty().
+			Immutable(),
+
+		field.Enum("conversation_status").
+			Values("open", "closed").
+			Default("open"),
+		field.Enum("closed_reason").
+			Values("blocked", "terminated").
+			Optional().
+			Nillable(),
+
+		// Timestamps
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("last_message_at").
+			Optional().
+			Nillable(),
+		field.Time("closed_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Indexes of the Conversation.
+func (Conversation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("connection_id").Unique(),
+		index.Fields("user_a_id", "conversation_status"),
+		index.Fields("user_b_id", "conversation_status"),
+	}
+}
+
+// Edges of the Conversation.
+func (Conversation) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("connection", Connection.Type).
+			Ref("conversation").
+			Field("connection_id").
+			Unique().
+			Required().
+			Immutable(),
+		edge.To("messages", Message.Type),
+	}
+}
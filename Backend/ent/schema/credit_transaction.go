@@ -29,7 +29,7 @@ func (CreditTransaction) Fields() []ent.Field {
 			NotEmpty(),
 
 		field.Enum("transaction_type").
-			Values("purchase", "streak_recovery", "early_reveal", "referral_bonus", "welcome_bonus", "refund", "admin_adjustment"),
+			Values("purchase", "streak_recovery", "early_reveal", "reveal_gift", "referral_bonus", "welcome_bonus", "promo_code", "refund", "admin_adjustment"),
 
 		field.Int("credit_amount").
 			Comment("Positive for credits added, negative for credits spent"),
@@ -51,6 +51,12 @@ func (CreditTransaction) Fields() []ent.Field {
 			Optional().
 			Nillable(),
 
+		// Client-supplied key that makes a spend safe to retry
+		field.String("idempotency_key").
+			MaxLen(64).
+			Optional().
+			Nillable(),
+
 		// Timestamps
 		field.Time("created_at").
 			Default(time.Now).
@@ -63,6 +69,9 @@ func (CreditTransaction) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("user_id", "created_at"),
 		index.Fields("transaction_type"),
+		index.Fields("user_id", "idempotency_key").Unique(),
+		// One ledger entry per thing it pays for or credits, e.g. a payment order
+		index.Fields("reference_type", "reference_id").Unique(),
 	}
 }
 
@@ -74,5 +83,6 @@ func (CreditTransaction) Edges() []ent.Edge {
 			Field("user_id").
 			Unique().
 			Required(),
+		edge.To("entries", LedgerEntry.Type),
 	}
 }
@@ -0,0 +1,73 @@
+// Package schema contains the Ent schema definitions
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// DataExport holds the schema definition for the DataExport entity
+// (an archive of a user's data, built in the background and downloadable
+// until it expires).
+type DataExport struct {
+	ent.Schema
+}
+
+// Fields of the DataExport.
+func (DataExport) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			MaxLen(36).
+			NotEmpty().
+			Unique().
+			Immutable(),
+
+		field.String("user_id").
+			MaxLen(36).
+			NotEmpty().
+			Immutable(),
+
+		field.Enum("export_status").
+			Values("pending", "processing", "ready", "failed", "expired").
+			Default("pending"),
+
+		// Archive in object storage, once ready
+		field.String("storage_key").
+			MaxLen(255).
+			Optional().
+			Nillable(),
+		field.Int64("size_bytes").
+			Optional().
+			Nillable(),
+		field.String("error_message").
+			MaxLen(500).
+			Optional().
+			Nillable(),
+
+		// Timestamps
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("started_at").
+			Optional().
+			Nillable(),
+		field.Time("completed_at").
+			Optional().
+			Nillable(),
+		// When the archive is deleted from storage
+		field.Time("expires_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Indexes of the DataExport.
+func (DataExport) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "created_at"),
+		index.Fields("export_status", "created_at"),
+	}
+}
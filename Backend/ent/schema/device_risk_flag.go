@@ -0,0 +1,75 @@
+// Package schema contains the Ent schema definitions
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// DeviceRiskFlag holds the schema definition for the DeviceRiskFlag entity
+// (a suspicious device pattern found at login, queued for safety review).
+type DeviceRiskFlag struct {
+	ent.Schema
+}
+
+// Fields of the DeviceRiskFlag.
+func (DeviceRiskFlag) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			MaxLen(36).
+			NotEmpty().
+			Unique().
+			Immutable(),
+
+		// User who logged in when the pattern was found
+		field.String("user_id").
+			MaxLen(36).
+			NotEmpty().
+			Immutable(),
+		field.String("device_id").
+			MaxLen(128).
+			NotEmpty().
+			Immutable(),
+
+		field.Enum("rule").
+			Values("multi_account_device", "device_churn", "suspended_account_device").
+			Immutable(),
+
+		// What the rule saw, e.g. the other accounts on the device
+		field.Text("details").
+			Optional().
+			Nillable().
+			Immutable(),
+
+		// Admin review
+		field.Enum("flag_status").
+			Values("pending", "reviewed", "action_taken", "dismissed").
+			Default("pending"),
+		field.String("reviewed_by").
+			MaxLen(36).
+			Optional().
+			Nillable(),
+		field.Text("admin_notes").
+			Optional().
+			Nillable(),
+
+		// Timestamps
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("reviewed_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Indexes of the DeviceRiskFlag.
+func (DeviceRiskFlag) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("flag_status", "created_at"),
+		index.Fields("user_id", "rule", "flag_status"),
+	}
+}
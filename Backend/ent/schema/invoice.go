@@ -0,0 +1,160 @@
+// Package schema contains the Ent schema definitions
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Invoice holds the schema definition for the Invoice entity
+// (the GST tax invoice issued for a paid payment order).
+type Invoice struct {
+	ent.Schema
+}
+
+// Fields of the Invoice.
+func (Invoice) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			MaxLen(36).
+			NotEmpty().
+			Unique().
+			Immutable(),
+
+		// e.g. UNR/26-27/000042: consecutive within a financial year, at
+		// most 16 characters as GST requires
+		field.String("invoice_number").
+			MaxLen(16).
+			NotEmpty().
+			Unique().
+			Immutable(),
+
+		// Indian financial year (April to March), e.g. 2026-27
+		field.String("financial_year").
+			MaxLen(7).
+			NotEmpty().
+			Immutable(),
+
+		field.Int("sequence_number").
+			Positive().
+			Immutable(),
+
+		field.String("order_id").
+			MaxLen(36).
+			NotEmpty().
+			Unique().
+			Immutable(),
+
+		field.String("user_id").
+			MaxLen(36).
+			NotEmpty().
+			Immutable(),
+
+		// Supplier details as printed on the invoice
+		field.String("seller_name").
+			MaxLen(255).
+			Immutable(),
+		field.String("seller_address").
+			MaxLen(500).
+			Immutable(),
+		field.String("seller_gstin").
+			MaxLen(15).
+			Immutable(),
+
+		// Recipient details; consumers have no GSTIN
+		field.String("buyer_name").
+			MaxLen(255).
+			Optional().
+			Nillable().
+			Immutable(),
+		field.String("buyer_email").
+			MaxLen(255).
+			Optional().
+			Nillable().
+			Immutable(),
+
+		// Two-digit GST state code
+		field.String("place_of_supply").
+			MaxLen(2).
+			Immutable(),
+
+		// Line item
+		field.String("description").
+			MaxLen(255).
+			Immutable(),
+		field.String("sac_code").
+			MaxLen(8).
+			Immutable(),
+
+		// Amounts in smallest currency unit (paise). The order amount is
+		// tax-inclusive: total = taxable + cgst + sgst + igst.
+		field.String("currency").
+			MaxLen(3).
+			Default("INR").
+			Immutable(),
+		field.Int("discount_amount").
+			Default(0).
+			NonNegative().
+			Immutable(),
+		field.Int("taxable_amount").
+			NonNegative().
+			Immutable(),
+		field.Float("gst_rate").
+			Comment("Total GST rate in percent").
+			Immutable(),
+		field.Int("cgst_amount").
+			Default(0).
+			NonNegative().
+			Immutable(),
+		field.Int("sgst_amount").
+			Default(0).
+			NonNegative().
+			Immutable(),
+		field.Int("igst_amount").
+			Default(0).
+			NonNegative().
+			Immutable(),
+		field.Int("total_amount").
+			NonNegative().
+			Immutable(),
+
+		// Object key of the PDF in storage; empty until it has been stored
+		field.String("pdf_storage_key").
+			MaxLen(500).
+			Optional().
+			Nillable(),
+
+		// Timestamps
+		field.Time("issued_at").
+			Immutable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the Invoice.
+func (Invoice) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("financial_year", "sequence_number").
+			Unique(),
+		index.Fields("issued_at"),
+		index.Fields("user_id", "issued_at"),
+	}
+}
+
+// Edges of the Invoice.
+func (Invoice) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("order", PaymentOrder.Type).
+			Ref("invoice").
+			Field("order_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
@@ -0,0 +1,35 @@
+// Package schema contains the Ent schema definitions
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// InvoiceSequence holds the schema definition for the InvoiceSequence entity
+// (the last invoice number issued in a financial year).
+type InvoiceSequence struct {
+	ent.Schema
+}
+
+// Fields of the InvoiceSequence.
+func (InvoiceSequence) Fields() []ent.Field {
+	return []ent.Field{
+		// Financial year, e.g. 2026-27
+		field.String("id").
+			MaxLen(7).
+			NotEmpty().
+			Unique().
+			Immutable(),
+
+		field.Int("last_number").
+			Default(0).
+			NonNegative(),
+
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
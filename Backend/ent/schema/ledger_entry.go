@@ -0,0 +1,69 @@
+// Package schema contains the Ent schema definitions
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// LedgerEntry holds the schema definition for the LedgerEntry entity (one side
+// of a double-entry credit posting). Every CreditTransaction has exactly two
+// entries, one on the user's account and one on a system account, summing to zero.
+type LedgerEntry struct {
+	ent.Schema
+}
+
+// Fields of the LedgerEntry.
+func (LedgerEntry) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			MaxLen(36).
+			NotEmpty().
+			Unique().
+			Immutable(),
+
+		field.String("transaction_id").
+			MaxLen(36).
+			NotEmpty().
+			Immutable(),
+
+		// "user:<user id>" or a system account such as "system:purchases"
+		field.String("account").
+			MaxLen(64).
+			NotEmpty().
+			Immutable(),
+
+		field.Int("amount").
+			Immutable().
+			Comment("Positive credits the account, negative debits it"),
+
+		// Timestamps
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the LedgerEntry.
+func (LedgerEntry) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("transaction_id"),
+		index.Fields("account", "created_at"),
+	}
+}
+
+// Edges of the LedgerEntry.
+func (LedgerEntry) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("transaction", CreditTransaction.Type).
+			Ref("entries").
+			Field("transaction_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
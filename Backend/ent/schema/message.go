@@ -0,0 +1,89 @@
+// Package schema contains the Ent schema definitions
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Message holds the schema definition for the Message entity (chat message in a conversation).
+type Message struct {
+	ent.Schema
+}
+
+// Fields of the Message.
+func (Message) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			MaxLen(36).
+			NotEmpty().
+			Unique().
+			Immutable(),
+
+		field.String("conversation_id").
+			MaxLen(36).
+			NotEmpty().
+			Immutable(),
+
+		field.String("sender_user_id").
+			MaxLen(36).
+			NotEmpty().
+			Immutable(),
+
+		field.String("body").
+			MaxLen(2000).
+			NotEmpty(),
+
+		// Client-generated ID so a resent message is stored once
+		field.String("client_message_id").
+			MaxLen(64).
+			Optional().
+			Nillable(),
+
+		// Moderation: held and rejected messages are only visible to the sender
+		field.Enum("moderation_status").
+			Values("visible", "held", "rejected").
+			Default("visible"),
+
+		// Timestamps
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("read_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Indexes of the Message.
+func (Message) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("conversation_id", "created_at"),
+		index.Fields("conversation_id", "sender_user_id", "client_message_id").Unique(),
+		index.Fields("sender_user_id"),
+		index.Fields("moderation_status", "created_at"),
+	}
+}
+
+// Edges of the Message.
+func (Message) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("conversation", Conversation.Type).
+			Ref("messages").
+			Field("conversation_id").
+			Unique().
+			Required().
+			Immutable(),
+		edge.From("sender", User.Type).
+			Ref("chat_messages").
+			Field("sender_user_id").
+			Unique().
+			Required().
+			Immutable(),
+		edge.To("moderation_actions", ModerationAction.Type),
+	}
+}
@@ -0,0 +1,89 @@
+// Package schema contains the Ent schema definitions
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ModerationAction holds the schema definition for the ModerationAction entity
+// (a decision taken by the chat moderation pipeline or an admin).
+type ModerationAction struct {
+	ent.Schema
+}
+
+// Fields of the ModerationAction.
+func (ModerationAction) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			MaxLen(36).
+			NotEmpty().
+			Unique().
+			Immutable(),
+
+		// User whose content or behaviour was moderated
+		field.String("user_id").
+			MaxLen(36).
+			NotEmpty().
+			Immutable(),
+
+		field.String("message_id").
+			MaxLen(36).
+			Optional().
+			Nillable().
+			Immutable(),
+		field.String("conversation_id").
+			MaxLen(36).
+			Optional().
+			Nillable().
+			Immutable(),
+
+		field.Enum("action").
+			Values("flagged", "held", "rejected", "rate_limited", "approved").
+			Immutable(),
+
+		// Moderator that decided: keyword, link, phone_number, ai, rate_limit or admin
+		field.String("source").
+			MaxLen(50).
+			NotEmpty().
+			Immutable(),
+		field.Text("reason").
+			Optional().
+			Nillable().
+			Immutable(),
+
+		// Timestamps
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the ModerationAction.
+func (ModerationAction) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "created_at"),
+		index.Fields("message_id"),
+	}
+}
+
+// Edges of the ModerationAction.
+func (ModerationAction) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("moderation_actions").
+			Field("user_id").
+			Unique().
+			Required().
+			Immutable(),
+		edge.From("message", Message.Type).
+			Ref("moderation_actions").
+			Field("message_id").
+			Unique().
+			Immutable(),
+	}
+}
@@ -0,0 +1,79 @@
+// Package schema contains the Ent schema definitions
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Notification holds the schema definition for the Notification entity (an
+// in-app notification shown in a user's inbox).
+type Notification struct {
+	ent.Schema
+}
+
+// Fields of the Notification.
+func (Notification) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			MaxLen(36).
+			NotEmpty().
+			Unique().
+			Immutable(),
+
+		field.String("user_id").
+			MaxLen(36).
+			NotEmpty().
+			Immutable(),
+
+		// Machine-readable kind, e.g. "reveal_gift_offered"
+		field.String("notification_type").
+			MaxLen(50).
+			NotEmpty().
+			Immutable(),
+
+		field.String("title").
+			MaxLen(200).
+			NotEmpty().
+			Immutable(),
+		field.Text("body").
+			Immutable(),
+
+		// Identifiers the client needs to deep-link, e.g. connectionId
+		field.JSON("data", map[string]string{}).
+			Optional().
+			Immutable(),
+
+		// Timestamps
+		field.Time("read_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the Notification.
+func (Notification) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "created_at"),
+		index.Fields("user_id", "read_at"),
+	}
+}
+
+// Edges of the Notification.
+func (Notification) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("notifications").
+			Field("user_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
@@ -0,0 +1,86 @@
+// Package schema contains the Ent schema definitions
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// PaymentDiscrepancy holds the schema definition for the PaymentDiscrepancy
+// entity (a payment order whose state disagrees with Razorpay, found by the
+// daily payment reconciliation).
+type PaymentDiscrepancy struct {
+	ent.Schema
+}
+
+// Fields of the PaymentDiscrepancy.
+func (PaymentDiscrepancy) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			MaxLen(36).
+			NotEmpty().
+			Unique().
+			Immutable(),
+
+		field.String("report_id").
+			MaxLen(36).
+			NotEmpty().
+			Immutable(),
+
+		// Empty when Razorpay has a payment for an order we do not know
+		field.String("order_id").
+			MaxLen(36).
+			Optional().
+			Nillable(),
+
+		field.String("razorpay_order_id").
+			MaxLen(100),
+		field.String("razorpay_payment_id").
+			MaxLen(100).
+			Optional().
+			Nillable(),
+
+		field.Enum("kind").
+			Values(
+				"captured_not_fulfilled", // Razorpay captured it, the order is not paid
+				"paid_not_captured",      // The order is paid, Razorpay has no capture
+				"amount_mismatch",        // Captured amount differs from the order amount
+				"refund_mismatch",        // Refunded amounts differ
+				"unknown_order",          // Captured payment for an order not in the DB
+			),
+
+		// Status and amount (paise) on each side, for the report reader
+		field.String("db_status").
+			MaxLen(20).
+			Optional(),
+		field.String("gateway_status").
+			MaxLen(20).
+			Optional(),
+		field.Int("db_amount").
+			Default(0),
+		field.Int("gateway_amount").
+			Default(0),
+	}
+}
+
+// Indexes of the PaymentDiscrepancy.
+func (PaymentDiscrepancy) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("report_id", "kind"),
+		index.Fields("order_id"),
+	}
+}
+
+// Edges of the PaymentDiscrepancy.
+func (PaymentDiscrepancy) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("report", PaymentReconciliationReport.Type).
+			Ref("discrepancies").
+			Field("report_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
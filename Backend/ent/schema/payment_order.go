@@ -52,6 +52,11 @@ func (PaymentOrder) Fields() []ent.Field {
 		field.Int("amount").
 			Comment("Amount in smallest currency unit (paise)"),
 
+		// Promo code discount already taken off amount, in paise
+		field.Int("discount_amount").
+			Default(0).
+			NonNegative(),
+
 		field.String("currency").
 			MaxLen(3).
 			Default("INR"),
@@ -63,6 +68,11 @@ func (PaymentOrder) Fields() []ent.Field {
 			Values("created", "paid", "failed", "refunded", "expired").
 			Default("created"),
 
+		// Running total of refunds processed by Razorpay, in paise
+		field.Int("amount_refunded").
+			Default(0).
+			NonNegative(),
+
 		field.Text("failure_reason").
 			Optional().
 			Nillable(),
@@ -97,5 +107,8 @@ func (PaymentOrder) Edges() []ent.Edge {
 			Field("user_id").
 			Unique().
 			Required(),
+		edge.To("refunds", PaymentRefund.Type),
+		edge.To("invoice", Invoice.Type).
+			Unique(),
 	}
 }
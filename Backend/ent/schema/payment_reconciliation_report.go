@@ -0,0 +1,71 @@
+// Package schema contains the Ent schema definitions
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// PaymentReconciliationReport holds the schema definition for the
+// PaymentReconciliationReport entity (one day's payment orders compared with
+// the payments Razorpay recorded).
+type PaymentReconciliationReport struct {
+	ent.Schema
+}
+
+// Fields of the PaymentReconciliationReport.
+func (PaymentReconciliationReport) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			MaxLen(36).
+			NotEmpty().
+			Unique().
+			Immutable(),
+
+		// Day covered, YYYY-MM-DD in server time; re-running a day replaces it
+		field.String("report_date").
+			MaxLen(10).
+			NotEmpty().
+			Unique(),
+
+		field.Time("window_start"),
+		field.Time("window_end"),
+
+		field.Int("orders_checked").
+			Default(0),
+		field.Int("gateway_payments").
+			Default(0),
+		field.Int("matched").
+			Default(0),
+		field.Int("discrepancy_count").
+			Default(0),
+
+		// Totals in paise for the window, paid orders vs captured payments
+		field.Int("db_paid_amount").
+			Default(0),
+		field.Int("gateway_captured_amount").
+			Default(0),
+
+		// Timestamps
+		field.Time("generated_at").
+			Default(time.Now),
+	}
+}
+
+// Indexes of the PaymentReconciliationReport.
+func (PaymentReconciliationReport) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("generated_at"),
+	}
+}
+
+// Edges of the PaymentReconciliationReport.
+func (PaymentReconciliationReport) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("discrepancies", PaymentDiscrepancy.Type),
+	}
+}
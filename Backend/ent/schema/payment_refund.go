@@ -0,0 +1,115 @@
+// Package schema contains the Ent schema definitions
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// PaymentRefund holds the schema definition for the PaymentRefund entity
+// (one Razorpay refund of a payment order, full or partial).
+type PaymentRefund struct {
+	ent.Schema
+}
+
+// Fields of the PaymentRefund.
+func (PaymentRefund) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			MaxLen(36).
+			NotEmpty().
+			Unique().
+			Immutable(),
+
+		field.String("order_id").
+			MaxLen(36).
+			NotEmpty().
+			Immutable(),
+
+		field.String("user_id").
+			MaxLen(36).
+			NotEmpty().
+			Immutable(),
+
+		// Set once Razorpay accepts the refund
+		field.String("razorpay_refund_id").
+			MaxLen(100).
+			Optional().
+			Nillable().
+			Unique(),
+
+		// Amount in smallest currency unit (paise)
+		field.Int("amount").
+			Positive().
+			Immutable(),
+
+		field.String("currency").
+			MaxLen(3).
+			Default("INR"),
+
+		// pending: recorded, not yet accepted by Razorpay
+		// processing: accepted, waiting for refund.processed
+		field.Enum("refund_status").
+			Values("pending", "processing", "processed", "failed").
+			Default("pending"),
+
+		// admin: issued from the admin panel; gateway: issued outside the app,
+		// e.g. from the Razorpay dashboard, and learned of by webhook
+		field.Enum("source").
+			Values("admin", "gateway").
+			Immutable(),
+
+		// Credits taken back from the user for this refund
+		field.Int("credits_reversed").
+			Default(0).
+			NonNegative(),
+
+		// Credits the user had already spent, refunded without being taken back
+		field.Int("credits_written_off").
+			Default(0).
+			NonNegative(),
+
+		field.Text("reason").
+			Optional().
+			Nillable(),
+
+		field.Text("failure_reason").
+			Optional().
+			Nillable(),
+
+		// Timestamps
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+		field.Time("processed_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Indexes of the PaymentRefund.
+func (PaymentRefund) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("order_id", "refund_status"),
+		index.Fields("refund_status", "created_at"),
+	}
+}
+
+// Edges of the PaymentRefund.
+func (PaymentRefund) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("order", PaymentOrder.Type).
+			Ref("refunds").
+			Field("order_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
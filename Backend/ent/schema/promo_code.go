@@ -0,0 +1,103 @@
+// Package schema contains the Ent schema definitions
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// PromoCode holds the schema definition for the PromoCode entity
+// (an admin-created code granting credits or a discount on a credit package).
+type PromoCode struct {
+	ent.Schema
+}
+
+// Fields of the PromoCode.
+func (PromoCode) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			MaxLen(36).
+			NotEmpty().
+			Unique().
+			Immutable(),
+
+		// Stored upper-case; matched case-insensitively on redemption
+		field.String("code").
+			MaxLen(32).
+			NotEmpty().
+			Unique().
+			Immutable(),
+
+		field.String("description").
+			MaxLen(255).
+			Optional().
+			Nillable(),
+
+		// credits: redeemed directly for credit_amount credits
+		// package_discount: applied when creating a payment order
+		field.Enum("kind").
+			Values("credits", "package_discount").
+			Immutable(),
+
+		field.Int("credit_amount").
+			Default(0).
+			NonNegative(),
+
+		field.Int("discount_percent").
+			Default(0).
+			Min(0).
+			Max(100),
+
+		// Restricts a discount to one package; any package when empty
+		field.String("package_id").
+			MaxLen(36).
+			Optional().
+			Nillable(),
+
+		// Total redemptions allowed; unlimited when empty
+		field.Int("max_redemptions").
+			Optional().
+			Nillable().
+			Positive(),
+
+		field.Int("per_user_limit").
+			Default(1).
+			Positive(),
+
+		field.Time("starts_at").
+			Optional().
+			Nillable(),
+		field.Time("expires_at").
+			Optional().
+			Nillable(),
+
+		field.Bool("is_active").
+			Default(true),
+
+		// Timestamps
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the PromoCode.
+func (PromoCode) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("is_active", "created_at"),
+	}
+}
+
+// Edges of the PromoCode.
+func (PromoCode) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("redemptions", PromoRedemption.Type),
+	}
+}
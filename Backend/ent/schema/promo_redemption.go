@@ -0,0 +1,93 @@
+// Package schema contains the Ent schema definitions
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// PromoRedemption holds the schema definition for the PromoRedemption entity
+// (one use of a promo code by a user).
+type PromoRedemption struct {
+	ent.Schema
+}
+
+// Fields of the PromoRedemption.
+func (PromoRedemption) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			MaxLen(36).
+			NotEmpty().
+			Unique().
+			Immutable(),
+
+		field.String("promo_code_id").
+			MaxLen(36).
+			NotEmpty().
+			Immutable(),
+
+		field.String("user_id").
+			MaxLen(36).
+			NotEmpty().
+			Immutable(),
+
+		// Payment order a discount was applied to
+		field.String("order_id").
+			MaxLen(36).
+			Optional().
+			Nillable().
+			Unique().
+			Immutable(),
+
+		// pending: reserved by an unpaid order until the order expires
+		// redeemed: credits granted or the discounted order paid
+		field.Enum("redemption_status").
+			Values("pending", "redeemed").
+			Default("pending"),
+
+		field.Int("credits_granted").
+			Default(0).
+			NonNegative(),
+		field.Int("discount_amount").
+			Default(0).
+			NonNegative(),
+
+		// A pending redemption stops counting against limits after this
+		field.Time("expires_at").
+			Optional().
+			Nillable(),
+
+		// Timestamps
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("redeemed_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Indexes of the PromoRedemption.
+func (PromoRedemption) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("promo_code_id", "redemption_status"),
+		index.Fields("promo_code_id", "user_id"),
+		index.Fields("user_id", "created_at"),
+	}
+}
+
+// Edges of the PromoRedemption.
+func (PromoRedemption) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("promo_code", PromoCode.Type).
+			Ref("redemptions").
+			Field("promo_code_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
@@ -0,0 +1,89 @@
+// Package schema contains the Ent schema definitions
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Referral holds the schema definition for the Referral entity
+// (a signup attributed to another user's referral code).
+type Referral struct {
+	ent.Schema
+}
+
+// Fields of the Referral.
+func (Referral) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			MaxLen(36).
+			NotEmpty().
+			Unique().
+			Immutable(),
+
+		field.String("referrer_id").
+			MaxLen(36).
+			NotEmpty().
+			Immutable(),
+
+		// A user can be referred only once
+		field.String("referee_id").
+			MaxLen(36).
+			NotEmpty().
+			Unique().
+			Immutable(),
+
+		// Code used at signup
+		field.String("code").
+			MaxLen(16).
+			NotEmpty().
+			Immutable(),
+
+		// Device the referee signed up on, as sent by the app; the fraud
+		// checks use the device registry instead
+		field.String("device_id").
+			MaxLen(128).
+			Optional().
+			Nillable().
+			Immutable(),
+
+		// pending: waiting for the referee's first streak milestone and a
+		// verified phone number
+		// rewarded: bonuses granted to both sides
+		// rejected: failed a fraud check; no bonus is granted
+		field.Enum("referral_status").
+			Values("pending", "rewarded", "rejected").
+			Default("pending"),
+
+		// no_device, device_reused, phone_reused, referrer_deleted
+		field.String("rejection_reason").
+			MaxLen(50).
+			Optional().
+			Nillable(),
+
+		field.Int("referrer_credits").
+			Default(0).
+			NonNegative(),
+		field.Int("referee_credits").
+			Default(0).
+			NonNegative(),
+
+		// Timestamps
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("resolved_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Indexes of the Referral.
+func (Referral) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("referrer_id", "referral_status"),
+	}
+}
@@ -0,0 +1,80 @@
+// Package schema contains the Ent schema definitions
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ReportEvidence holds the schema definition for the ReportEvidence entity
+// (an immutable snapshot of a chat message attached to a report).
+type ReportEvidence struct {
+	ent.Schema
+}
+
+// Fields of the ReportEvidence. Every field is immutable: evidence is
+// captured once when the report is filed and never edited.
+func (ReportEvidence) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			MaxLen(36).
+			NotEmpty().
+			Unique().
+			Immutable(),
+
+		field.String("report_id").
+			MaxLen(36).
+			NotEmpty().
+			Immutable(),
+
+		// Snapshot of the message as it was when reported
+		field.String("message_id").
+			MaxLen(36).
+			NotEmpty().
+			Immutable(),
+		field.String("sender_user_id").
+			MaxLen(36).
+			NotEmpty().
+			Immutable(),
+		field.Text("body").
+			Immutable(),
+		field.String("moderation_status").
+			MaxLen(20).
+			Immutable(),
+		field.Time("sent_at").
+			Immutable(),
+
+		// True for the reported message, false for surrounding context
+		field.Bool("is_reported_message").
+			Default(false).
+			Immutable(),
+
+		// Timestamps
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the ReportEvidence.
+func (ReportEvidence) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("report_id", "sent_at"),
+	}
+}
+
+// Edges of the ReportEvidence.
+func (ReportEvidence) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("report", UserReport.Type).
+			Ref("evidence").
+			Field("report_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
@@ -76,5 +76,6 @@ func (Reveal) Edges() []ent.Edge {
 			Required(),
 		edge.To("content", RevealContent.Type).
 			Unique(),
+		edge.To("views", RevealView.Type),
 	}
 }
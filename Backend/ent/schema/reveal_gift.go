@@ -0,0 +1,121 @@
+// Package schema contains the Ent schema definitions
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// RevealGift holds the schema definition for the RevealGift entity (a user
+// paying credits to let their partner see an upcoming reveal early).
+type RevealGift struct {
+	ent.Schema
+}
+
+// Fields of the RevealGift.
+func (RevealGift) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			MaxLen(36).
+			NotEmpty().
+			Unique().
+			Immutable(),
+
+		field.String("connection_id").
+			MaxLen(36).
+			NotEmpty().
+			Immutable(),
+
+		field.String("milestone_id").
+			MaxLen(36).
+			NotEmpty().
+			Immutable(),
+
+		// User whose reveal is unlocked early and who pays for it
+		field.String("gifter_user_id").
+			MaxLen(36).
+			NotEmpty().
+			Immutable(),
+
+		// Partner who gets to see the reveal early
+		field.String("recipient_user_id").
+			MaxLen(36).
+			NotEmpty().
+			Immutable(),
+
+		// Credits quoted when the gift was offered
+		field.Int("credit_cost").
+			NonNegative().
+			Immutable(),
+
+		field.Enum("gift_status").
+			Values("pending", "accepted", "declined", "cancelled", "expired").
+			Default("pending"),
+
+		// Gifter's explicit consent to reveal their information early
+		field.Time("gifter_consented_at").
+			Immutable(),
+
+		// Set once the gift is accepted
+		field.String("reveal_id").
+			MaxLen(36).
+			Optional().
+			Nillable(),
+		field.String("credit_transaction_id").
+			MaxLen(36).
+			Optional().
+			Nillable(),
+
+		// Timestamps
+		field.Time("expires_at"),
+		field.Time("responded_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the RevealGift.
+func (RevealGift) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("connection_id", "milestone_id", "gift_status"),
+		index.Fields("recipient_user_id", "gift_status"),
+		index.Fields("gifter_user_id", "gift_status"),
+	}
+}
+
+// Edges of the RevealGift.
+func (RevealGift) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("connection", Connection.Type).
+			Ref("reveal_gifts").
+			Field("connection_id").
+			Unique().
+			Required().
+			Immutable(),
+		edge.From("milestone", RevealMilestone.Type).
+			Ref("gifts").
+			Field("milestone_id").
+			Unique().
+			Required().
+			Immutable(),
+		edge.From("gifter", User.Type).
+			Ref("reveal_gifts_sent").
+			Field("gifter_user_id").
+			Unique().
+			Required().
+			Immutable(),
+		edge.From("recipient", User.Type).
+			Ref("reveal_gifts_received").
+			Field("recipient_user_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
@@ -66,5 +66,6 @@ func (RevealMilestone) Indexes() []ent.Index {
 func (RevealMilestone) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("reveals", Reveal.Type),
+		edge.To("gifts", RevealGift.Type),
 	}
 }
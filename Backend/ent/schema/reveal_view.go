@@ -0,0 +1,94 @@
+// Package schema contains the Ent schema definitions
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// RevealView holds the schema definition for the RevealView entity (one
+// viewing of a reveal by one participant).
+type RevealView struct {
+	ent.Schema
+}
+
+// Fields of the RevealView.
+func (RevealView) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			MaxLen(36).
+			NotEmpty().
+			Unique().
+			Immutable(),
+
+		field.String("reveal_id").
+			MaxLen(36).
+			NotEmpty().
+			Immutable(),
+
+		field.String("viewer_user_id").
+			MaxLen(36).
+			NotEmpty().
+			Immutable(),
+
+		// First time this viewer opened the reveal; later views are re-views
+		field.Bool("is_first_view").
+			Default(false).
+			Immutable(),
+		// "<reveal_id>:<viewer_user_id>" on the first view only, NULL on
+		// re-views; unique, so concurrent views cannot both be the first
+		field.String("first_view_key").
+			MaxLen(73).
+			Optional().
+			Nillable().
+			Unique().
+			Immutable(),
+
+		// Client-reported time spent on the reveal
+		field.Int("duration_seconds").
+			Optional().
+			Nillable().
+			NonNegative().
+			Immutable(),
+
+		// Viewer's tier when viewing, so funnels survive upgrades
+		field.Enum("viewer_tier").
+			Values("free", "plus", "pro").
+			Immutable(),
+
+		// Timestamps
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the RevealView.
+func (RevealView) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("reveal_id", "viewer_user_id"),
+		index.Fields("viewer_user_id", "created_at"),
+	}
+}
+
+// Edges of the RevealView.
+func (RevealView) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("reveal", Reveal.Type).
+			Ref("views").
+			Field("reveal_id").
+			Unique().
+			Required().
+			Immutable(),
+		edge.From("viewer", User.Type).
+			Ref("reveal_views").
+			Field("viewer_user_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
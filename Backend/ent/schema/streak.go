@@ -54,6 +54,10 @@ func (Streak) Fields() []ent.Field {
 			MaxLen(36).
 			Optional().
 			Nillable(),
+		// Tier allowance recoveries spent on this connection
+		field.Int("free_recoveries_used").
+			Default(0).
+			Min(0),
 
 		// AI scoring
 		field.Float("streak_health_score").
@@ -0,0 +1,121 @@
+// Package schema contains the Ent schema definitions
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Subscription holds the schema definition for the Subscription entity (a
+// user's Razorpay recurring subscription to a Plus/Pro plan).
+type Subscription struct {
+	ent.Schema
+}
+
+// Fields of the Subscription.
+func (Subscription) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			MaxLen(36).
+			NotEmpty().
+			Unique().
+			Immutable(),
+
+		field.String("user_id").
+			MaxLen(36).
+			NotEmpty().
+			Immutable(),
+
+		field.String("plan_id").
+			MaxLen(36).
+			NotEmpty().
+			Immutable(),
+
+		field.String("razorpay_subscription_id").
+			MaxLen(100).
+			Unique().
+			Immutable(),
+
+		// created: awaiting first payment; authenticated: mandate set up, first
+		// charge pending; pending: a renewal charge failed and is being retried;
+		// halted: all retries failed
+		field.Enum("status").
+			Values("created", "authenticated", "active", "pending", "halted", "paused", "cancelled", "expired").
+			Default("created"),
+
+		// Current billing cycle, as reported by Razorpay
+		field.Time("current_start").
+			Optional().
+			Nillable(),
+		field.Time("current_end").
+			Optional().
+			Nillable(),
+
+		// The tier is kept until this time while a renewal is failing
+		field.Time("grace_until").
+			Optional().
+			Nillable(),
+
+		field.Bool("cancel_at_period_end").
+			Default(false),
+
+		field.Int("paid_count").
+			Default(0),
+
+		field.String("short_url").
+			MaxLen(255).
+			Optional().
+			Nillable(),
+
+		// Timestamps
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+		field.Time("activated_at").
+			Optional().
+			Nillable(),
+		field.Time("paused_at").
+			Optional().
+			Nillable(),
+		field.Time("cancelled_at").
+			Optional().
+			Nillable(),
+		field.Time("ended_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Indexes of the Subscription.
+func (Subscription) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "status"),
+		index.Fields("status", "grace_until"),
+		index.Fields("status", "current_end"),
+	}
+}
+
+// Edges of the Subscription.
+func (Subscription) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("subscriptions").
+			Field("user_id").
+			Unique().
+			Required().
+			Immutable(),
+		edge.From("plan", SubscriptionPlan.Type).
+			Ref("subscriptions").
+			Field("plan_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
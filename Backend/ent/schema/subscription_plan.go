@@ -0,0 +1,83 @@
+// Package schema contains the Ent schema definitions
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// SubscriptionPlan holds the schema definition for the SubscriptionPlan entity
+// (the Plus/Pro plan catalog, each backed by a Razorpay plan).
+type SubscriptionPlan struct {
+	ent.Schema
+}
+
+// Fields of the SubscriptionPlan.
+func (SubscriptionPlan) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			MaxLen(36).
+			NotEmpty().
+			Unique().
+			Immutable(),
+
+		field.String("name").
+			MaxLen(100).
+			NotEmpty(),
+
+		field.Enum("tier").
+			Values("plus", "pro"),
+
+		field.Enum("billing_period").
+			Values("monthly", "yearly"),
+
+		// Price per billing period in smallest currency unit (paise for INR)
+		field.Int("price_amount").
+			Min(100),
+
+		field.String("currency").
+			MaxLen(3).
+			Default("INR"),
+
+		// Plan created in the Razorpay dashboard; subscriptions cannot be
+		// started until it is set
+		field.String("razorpay_plan_id").
+			MaxLen(100).
+			Optional().
+			Nillable(),
+
+		field.Bool("is_active").
+			Default(true),
+
+		field.Int("sort_order").
+			Default(0),
+
+		// Timestamps
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the SubscriptionPlan.
+func (SubscriptionPlan) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tier", "billing_period").
+			Unique(),
+		index.Fields("is_active", "sort_order"),
+	}
+}
+
+// Edges of the SubscriptionPlan.
+func (SubscriptionPlan) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("subscriptions", Subscription.Type),
+	}
+}
@@ -0,0 +1,75 @@
+// Package schema contains the Ent schema definitions
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// TierEntitlement holds the schema definition for the TierEntitlement entity
+// (the limits of one subscription tier, editable by admins without a deploy).
+type TierEntitlement struct {
+	ent.Schema
+}
+
+// Fields of the TierEntitlement.
+func (TierEntitlement) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			MaxLen(36).
+			NotEmpty().
+			Unique().
+			Immutable(),
+
+		field.Enum("tier").
+			Values("free", "plus", "pro").
+			Immutable(),
+
+		// Concurrent active connections
+		field.Int("connection_slots").
+			Min(0),
+
+		// Minimum time between discovery refreshes
+		field.Int("refresh_cooldown_minutes").
+			Min(0),
+
+		// Nudges a user may send a partner while a streak is at risk
+		field.Int("nudges_per_at_risk").
+			Min(0),
+
+		// Minimum time between two nudges to the same partner
+		field.Int("nudge_interval_minutes").
+			Min(0),
+
+		// Streak recoveries that cost no credits, per connection
+		field.Int("free_recoveries_per_connection").
+			Min(0),
+
+		// Early reveals that can be bought per connection
+		field.Int("purchasable_reveals").
+			Min(0),
+
+		// Streak days on which earned reveals unlock
+		field.JSON("reveal_days", []int{}),
+
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the TierEntitlement.
+func (TierEntitlement) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tier").
+			Unique(),
+	}
+}
+
+// Edges of the TierEntitlement.
+func (TierEntitlement) Edges() []ent.Edge {
+	return nil
+}
@@ -155,6 +155,26 @@ func (User) Fields() []ent.Field {
 			Optional().
 			Nillable(),
 
+		// Referrals: the code this user shares, and the device they signed up
+		// on (used to spot one person farming referrals with new accounts)
+		field.String("referral_code").
+			MaxLen(16).
+			Optional().
+			Nillable().
+			Unique(),
+		field.String("signup_device_id").
+			MaxLen(128).
+			Optional().
+			Nillable(),
+
+		// Keyed hash of the E.164 phone number. Kept when the account is
+		// deleted and the number erased, so that a deleted account's number
+		// still counts in the referral fraud checks.
+		field.String("phone_hash").
+			MaxLen(64).
+			Optional().
+			Nillable(),
+
 		// Timestamps
 		field.Time("created_at").
 			Default(time.Now).
@@ -176,6 +196,8 @@ func (User) Indexes() []ent.Index {
 		index.Fields("phone_number"),
 		index.Fields("provider", "provider_user_id"),
 		index.Fields("deleted_at"),
+		index.Fields("signup_device_id"),
+		index.Fields("phone_hash"),
 	}
 }
 
@@ -208,12 +230,26 @@ func (User) Edges() []ent.Edge {
 		// Monetization module
 		edge.To("credit_transactions", CreditTransaction.Type),
 		edge.To("payment_orders", PaymentOrder.Type),
+		edge.To("balance_drifts", BalanceDrift.Type),
+		edge.To("subscriptions", Subscription.Type),
+
+		// Reveal module
+		edge.To("reveal_views", RevealView.Type),
+		edge.To("reveal_gifts_sent", RevealGift.Type),
+		edge.To("reveal_gifts_received", RevealGift.Type),
+
+		// Chat module
+		edge.To("chat_messages", Message.Type),
+		edge.To("moderation_actions", ModerationAction.Type),
 
 		// Safety module
 		edge.To("blocks_given", UserBlock.Type),
 		edge.To("blocks_received", UserBlock.Type),
 		edge.To("reports_given", UserReport.Type),
 		edge.To("reports_received", UserReport.Type),
+
+		// Notification module
+		edge.To("notifications", Notification.Type),
 	}
 }
 
@@ -0,0 +1,66 @@
+// Package schema contains the Ent schema definitions
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// UserDevice holds the schema definition for the UserDevice entity
+// (the device registry: one row per device a user has logged in on).
+type UserDevice struct {
+	ent.Schema
+}
+
+// Fields of the UserDevice.
+func (UserDevice) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			MaxLen(36).
+			NotEmpty().
+			Unique().
+			Immutable(),
+
+		field.String("user_id").
+			MaxLen(36).
+			NotEmpty().
+			Immutable(),
+
+		// Identifier the app sends in X-Device-ID
+		field.String("device_id").
+			MaxLen(128).
+			NotEmpty().
+			Immutable(),
+
+		field.Enum("platform").
+			Values("android", "ios", "web", "unknown").
+			Default("unknown"),
+
+		// Whether the last login sent a Play Integrity / App Attest token in
+		// X-Device-Attestation; tokens are not verified yet
+		field.Enum("attestation_status").
+			Values("missing", "unverified").
+			Default("missing"),
+
+		field.Int("login_count").
+			Default(0),
+
+		// Timestamps
+		field.Time("first_seen_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("last_seen_at").
+			Default(time.Now),
+	}
+}
+
+// Indexes of the UserDevice.
+func (UserDevice) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("device_id", "user_id").Unique(),
+		index.Fields("user_id", "first_seen_at"),
+	}
+}
@@ -0,0 +1,71 @@
+// Package schema contains the Ent schema definitions
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// UserIdentity holds the schema definition for the UserIdentity entity
+// (a Google or Apple account a user signs in with; a user may link one of
+// each).
+type UserIdentity struct {
+	ent.Schema
+}
+
+// Fields of the UserIdentity.
+func (UserIdentity) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			MaxLen(36).
+			NotEmpty().
+			Unique().
+			Immutable(),
+
+		field.String("user_id").
+			MaxLen(36).
+			NotEmpty().
+			Immutable(),
+
+		// Identity provider and its stable ID for the account (the ID
+		// token's sub claim)
+		field.Enum("provider").
+			Values("google", "apple").
+			Immutable(),
+		field.String("subject").
+			MaxLen(255).
+			NotEmpty().
+			Immutable(),
+
+		// Verified email the provider reported, if any. Apple may give a
+		// private relay address.
+		field.String("email").
+			MaxLen(255).
+			Optional().
+			Nillable(),
+		field.Bool("is_private_email").
+			Default(false),
+
+		// Timestamps
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("last_used_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Indexes of the UserIdentity.
+func (UserIdentity) Indexes() []ent.Index {
+	return []ent.Index{
+		// An identity belongs to one user, and a user links one per provider
+		index.Fields("provider", "subject").
+			Unique(),
+		index.Fields("user_id", "provider").
+			Unique(),
+	}
+}
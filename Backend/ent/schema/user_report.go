@@ -24,9 +24,12 @@ func (UserReport) Fields() []ent.Field {
 			Unique().
 			Immutable(),
 
+		// Unset on reports raised by the system, e.g. for device risk flags
 		field.String("reporter_user_id").
 			MaxLen(36).
-			NotEmpty(),
+			NotEmpty().
+			Optional().
+			Nillable(),
 
 		field.String("reported_user_id").
 			MaxLen(36).
@@ -41,6 +44,7 @@ func (UserReport) Fields() []ent.Field {
 				"underage",
 				"offensive_behavior",
 				"other",
+				"device_risk",
 			),
 
 		field.Text("description").
@@ -95,12 +99,12 @@ func (UserReport) Edges() []ent.Edge {
 		edge.From("reporter", User.Type).
 			Ref("reports_given").
 			Field("reporter_user_id").
-			Unique().
-			Required(),
+			Unique(),
 		edge.From("reported", User.Type).
 			Ref("reports_received").
 			Field("reported_user_id").
 			Unique().
 			Required(),
+		edge.To("evidence", ReportEvidence.Type),
 	}
 }
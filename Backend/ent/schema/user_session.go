@@ -0,0 +1,96 @@
+// Package schema contains the Ent schema definitions
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// UserSession holds the schema definition for the UserSession entity
+// (one signed-in device; its refresh tokens are rotated on every use).
+type UserSession struct {
+	ent.Schema
+}
+
+// Fields of the UserSession.
+func (UserSession) Fields() []ent.Field {
+	return []ent.Field{
+		// Session ID, carried in the session's access and refresh tokens
+		field.String("id").
+			MaxLen(36).
+			NotEmpty().
+			Unique().
+			Immutable(),
+
+		field.String("user_id").
+			MaxLen(36).
+			NotEmpty().
+			Immutable(),
+
+		// ID of the only refresh token of the session that is still valid;
+		// presenting an older one revokes the session
+		field.String("refresh_token_id").
+			MaxLen(36).
+			NotEmpty(),
+
+		// Login provider: google, phone
+		field.String("provider").
+			MaxLen(20).
+			Immutable(),
+
+		// Device as reported by the app
+		field.String("device_id").
+			MaxLen(128).
+			Optional().
+			Nillable().
+			Immutable(),
+		field.String("device_name").
+			MaxLen(100).
+			Optional().
+			Nillable().
+			Immutable(),
+		field.Enum("platform").
+			Values("android", "ios", "web", "unknown").
+			Default("unknown").
+			Immutable(),
+
+		// Client address and user agent of the last use
+		field.String("ip_address").
+			MaxLen(45).
+			Optional().
+			Nillable(),
+		field.String("user_agent").
+			MaxLen(255).
+			Optional().
+			Nillable(),
+
+		// Timestamps
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("last_used_at").
+			Default(time.Now),
+		// Expiry of the current refresh token
+		field.Time("expires_at"),
+		field.Time("revoked_at").
+			Optional().
+			Nillable(),
+
+		// logout, user_revoked, token_reuse
+		field.String("revoke_reason").
+			MaxLen(30).
+			Optional().
+			Nillable(),
+	}
+}
+
+// Indexes of the UserSession.
+func (UserSession) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "revoked_at"),
+		index.Fields("expires_at"),
+	}
+}
@@ -0,0 +1,83 @@
+// Package schema contains the Ent schema definitions
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// WebhookEvent holds the schema definition for the WebhookEvent entity (one
+// stored payment-provider webhook delivery, kept for dedup, replay and debugging).
+type WebhookEvent struct {
+	ent.Schema
+}
+
+// Fields of the WebhookEvent.
+func (WebhookEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			MaxLen(36).
+			NotEmpty().
+			Unique().
+			Immutable(),
+
+		field.String("provider").
+			MaxLen(20).
+			Default("razorpay").
+			Immutable(),
+
+		// Provider's event ID; unset for deliveries that failed verification
+		field.String("event_id").
+			MaxLen(100).
+			Optional().
+			Nillable().
+			Immutable(),
+
+		field.String("event_type").
+			MaxLen(100).
+			Optional().
+			Immutable(),
+
+		// Raw request body exactly as received, so replays verify and parse
+		// identically; empty for deliveries failing signature verification
+		field.Text("payload").
+			Immutable(),
+		field.String("signature").
+			MaxLen(256).
+			Optional().
+			Nillable().
+			Immutable(),
+		field.Bool("signature_valid").
+			Default(false).
+			Immutable(),
+
+		field.Enum("processing_status").
+			Values("received", "processed", "ignored", "failed", "rejected").
+			Default("received"),
+		field.Text("error_message").
+			Optional().
+			Nillable(),
+		field.Int("attempts").
+			Default(0),
+
+		// Timestamps
+		field.Time("received_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("processed_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Indexes of the WebhookEvent.
+func (WebhookEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("provider", "event_id").Unique(),
+		index.Fields("processing_status", "received_at"),
+		index.Fields("event_type"),
+	}
+}
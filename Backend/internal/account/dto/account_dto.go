@@ -0,0 +1,32 @@
+// internal/account/dto/account_dto.go
+package dto
+
+import "time"
+
+// RequestDeletionRequest schedules deletion of the user's account
+// @Description Account deletion request
+type RequestDeletionRequest struct {
+	Reason string `json:"reason" binding:"max=500" example:"Found someone"`
+}
+
+// DeletionResponse describes a scheduled account deletion
+// @Description Account deletion, cancellable until scheduledFor
+type DeletionResponse struct {
+	ID           string     `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Status       string     `json:"status" example:"scheduled"`
+	RequestedAt  time.Time  `json:"requestedAt" example:"2024-01-15T10:30:00Z"`
+	ScheduledFor time.Time  `json:"scheduledFor" example:"2024-01-29T10:30:00Z"`
+	CancelledAt  *time.Time `json:"cancelledAt,omitempty"`
+}
+
+// DataExportResponse describes an archive of the user's data
+// @Description Data export; downloadUrl is set once the archive is ready
+type DataExportResponse struct {
+	ID          string     `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Status      string     `json:"status" example:"ready"`
+	RequestedAt time.Time  `json:"requestedAt" example:"2024-01-15T10:30:00Z"`
+	CompletedAt *time.Time `json:"completedAt,omitempty"`
+	ExpiresAt   *time.Time `json:"expiresAt,omitempty"`
+	SizeBytes   *int64     `json:"sizeBytes,omitempty" example:"1048576"`
+	DownloadURL string     `json:"downloadUrl,omitempty"`
+}
@@ -0,0 +1,143 @@
+// internal/account/handlers/account_handler.go
+package handlers
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/UnoraApp/be/internal/account/dto"
+	"github.com/UnoraApp/be/internal/account/services"
+	"github.com/UnoraApp/be/pkg/apperror"
+	"github.com/UnoraApp/be/pkg/response"
+)
+
+// AccountHandler handles account deletion and data export HTTP requests
+type AccountHandler struct {
+	deletionService *services.DeletionService
+	exportService   *services.ExportService
+}
+
+// NewAccountHandler creates a new account handler
+func NewAccountHandler(deletionService *services.DeletionService, exportService *services.ExportService) *AccountHandler {
+	return &AccountHandler{
+		deletionService: deletionService,
+		exportService:   exportService,
+	}
+}
+
+// RequestDeletion godoc
+// @Summary      Delete account
+// @Description  Schedule deletion of the account. The account keeps working until scheduledFor and the deletion can be cancelled until then. Deletion ends all connections, logs out every device, deletes photos and erases personal data; payment records and invoices are kept as the law requires.
+// @Tags         account
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request body dto.RequestDeletionRequest false "Optional reason"
+// @Success      201 {object} response.APIResponse{data=dto.DeletionResponse} "Deletion scheduled"
+// @Failure      400 {object} response.APIResponse "Invalid request body"
+// @Failure      401 {object} response.APIResponse "Not authenticated"
+// @Failure      409 {object} response.APIResponse "Deletion already scheduled"
+// @Router       /me/deletion [post]
+func (h *AccountHandler) RequestDeletion(c *gin.Context) {
+	userID := c.GetString("userID")
+
+	// The body is optional
+	var req dto.RequestDeletionRequest
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		apperror.HandleError(c, apperror.BadRequest("Invalid request body: "+err.Error()))
+		return
+	}
+
+	deletion, err := h.deletionService.RequestDeletion(c.Request.Context(), userID, &req)
+	if err != nil {
+		if errors.Is(err, services.ErrDeletionAlreadyScheduled) {
+			apperror.HandleError(c, apperror.Conflict(err.Error()))
+			return
+		}
+		apperror.HandleError(c, apperror.InternalError(err))
+		return
+	}
+
+	response.JSON(c, http.StatusCreated, deletion)
+}
+
+// GetDeletion godoc
+// @Summary      Get scheduled deletion
+// @Description  Returns the account's scheduled deletion, if any
+// @Tags         account
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200 {object} response.APIResponse{data=dto.DeletionResponse} "Scheduled deletion"
+// @Failure      401 {object} response.APIResponse "Not authenticated"
+// @Failure      404 {object} response.APIResponse "No deletion scheduled"
+// @Router       /me/deletion [get]
+func (h *AccountHandler) GetDeletion(c *gin.Context) {
+	userID := c.GetString("userID")
+
+	deletion, err := h.deletionService.GetDeletion(c.Request.Context(), userID)
+	if err != nil {
+		handleDeletionError(c, err)
+		return
+	}
+
+	response.JSON(c, http.StatusOK, deletion)
+}
+
+// CancelDeletion godoc
+// @Summary      Cancel account deletion
+// @Description  Cancel the account's scheduled deletion during the cooling-off period
+// @Tags         account
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200 {object} response.APIResponse{data=dto.DeletionResponse} "Deletion cancelled"
+// @Failure      401 {object} response.APIResponse "Not authenticated"
+// @Failure      404 {object} response.APIResponse "No deletion scheduled"
+// @Router       /me/deletion [delete]
+func (h *AccountHandler) CancelDeletion(c *gin.Context) {
+	userID := c.GetString("userID")
+
+	deletion, err := h.deletionService.CancelDeletion(c.Request.Context(), userID)
+	if err != nil {
+		handleDeletionError(c, err)
+		return
+	}
+
+	response.JSON(c, http.StatusOK, deletion)
+}
+
+func handleDeletionError(c *gin.Context, err error) {
+	if errors.Is(err, services.ErrNoDeletionScheduled) {
+		apperror.HandleError(c, apperror.NotFound("Scheduled deletion"))
+		return
+	}
+	apperror.HandleError(c, apperror.InternalError(err))
+}
+
+// GetExport godoc
+// @Summary      Export my data
+// @Description  Returns the account's data export, starting one if none is in progress or ready. The export is built in the background: poll until status is ready, then download the zip archive from downloadUrl. It holds the profile, photos and activity (connections, interests, check-ins, messages, credits, invoices, notifications, devices, linked sign-in accounts) and is deleted at expiresAt.
+// @Tags         account
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200 {object} response.APIResponse{data=dto.DataExportResponse} "Export ready"
+// @Success      202 {object} response.APIResponse{data=dto.DataExportResponse} "Export in progress"
+// @Failure      401 {object} response.APIResponse "Not authenticated"
+// @Router       /me/export [get]
+func (h *AccountHandler) GetExport(c *gin.Context) {
+	userID := c.GetString("userID")
+
+	export, err := h.exportService.RequestExport(c.Request.Context(), userID)
+	if err != nil {
+		apperror.HandleError(c, apperror.InternalError(err))
+		return
+	}
+
+	status := http.StatusAccepted
+	if export.Status == "ready" {
+		status = http.StatusOK
+	}
+	response.JSON(c, status, export)
+}
@@ -0,0 +1,9 @@
+// internal/account/handlers/providers.go
+package handlers
+
+import "github.com/google/wire"
+
+// ProviderSet is the wire provider set for account handlers
+var ProviderSet = wire.NewSet(
+	NewAccountHandler,
+)
@@ -0,0 +1,30 @@
+// internal/account/routes/account_routes.go
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/UnoraApp/be/internal/account/handlers"
+	"github.com/UnoraApp/be/internal/account/services"
+)
+
+// RegisterAccountRoutes registers account deletion and data export routes
+func RegisterAccountRoutes(
+	router *gin.RouterGroup,
+	deletionService *services.DeletionService,
+	exportService *services.ExportService,
+	authMiddleware gin.HandlerFunc,
+) {
+	// Create handler
+	handler := handlers.NewAccountHandler(deletionService, exportService)
+
+	// All account routes require authentication
+	protected := router.Group("/me")
+	protected.Use(authMiddleware)
+	{
+		protected.POST("/deletion", handler.RequestDeletion)
+		protected.GET("/deletion", handler.GetDeletion)
+		protected.DELETE("/deletion", handler.CancelDeletion)
+		protected.GET("/export", handler.GetExport)
+	}
+}
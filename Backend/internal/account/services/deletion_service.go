@@ -0,0 +1,492 @@
+// internal/account/services/deletion_service.go
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	ent "github.com/UnoraApp/be/ent/generated"
+	"github.com/UnoraApp/be/ent/generated/accountdeletion"
+	"github.com/UnoraApp/be/ent/generated/connection"
+	"github.com/UnoraApp/be/ent/generated/conversation"
+	"github.com/UnoraApp/be/ent/generated/hobby"
+	"github.com/UnoraApp/be/ent/generated/interest"
+	"github.com/UnoraApp/be/ent/generated/message"
+	"github.com/UnoraApp/be/ent/generated/notification"
+	"github.com/UnoraApp/be/ent/generated/photo"
+	"github.com/UnoraApp/be/ent/generated/profile"
+	"github.com/UnoraApp/be/ent/generated/streak"
+	"github.com/UnoraApp/be/ent/generated/user"
+	"github.com/UnoraApp/be/ent/generated/useridentity"
+	"github.com/UnoraApp/be/ent/generated/usersession"
+	"github.com/UnoraApp/be/internal/account/dto"
+	authservices "github.com/UnoraApp/be/internal/auth/services"
+	chatservices "github.com/UnoraApp/be/internal/chat/services"
+	"github.com/UnoraApp/be/internal/config"
+	notificationservices "github.com/UnoraApp/be/internal/notification/services"
+	"github.com/UnoraApp/be/pkg/logger"
+	"github.com/UnoraApp/be/pkg/storage"
+)
+
+// Account deletion errors
+var (
+	ErrDeletionAlreadyScheduled = errors.New("account deletion already scheduled")
+	ErrNoDeletionScheduled      = errors.New("no account deletion scheduled")
+)
+
+// redactedMessageBody replaces the chat messages of a deleted account.
+// Reported messages keep their text in the report evidence snapshot.
+const redactedMessageBody = "[deleted]"
+
+// DeletionService deletes accounts. Users schedule deletion themselves and
+// can cancel it during the cooling-off period; admins delete at once.
+//
+// Deleting an account ends its connections, revokes its sessions, purges
+// its photos and export archives from storage, and erases its personal
+// data. Credit transactions, the credit ledger, payment orders and invoices
+// are kept, as the law requires; they stay attached to the anonymized user
+// ID. So are safety reports and the device registry, which catch a banned
+// user signing up again.
+type DeletionService struct {
+	entClient           *ent.Client
+	storageClient       storage.Client
+	authService         *authservices.AuthService
+	chatService         *chatservices.ChatService
+	notificationService *notificationservices.NotificationService
+	exportService       *ExportService
+	cfg                 *config.AccountConfig
+}
+
+// NewDeletionService creates a new deletion service
+func NewDeletionService(
+	entClient *ent.Client,
+	storageClient storage.Client,
+	authService *authservices.AuthService,
+	chatService *chatservices.ChatService,
+	notificationService *notificationservices.NotificationService,
+	exportService *ExportService,
+	cfg *config.Config,
+) *DeletionService {
+	return &DeletionService{
+		entClient:           entClient,
+		storageClient:       storageClient,
+		authService:         authService,
+		chatService:         chatService,
+		notificationService: notificationService,
+		exportService:       exportService,
+		cfg:                 &cfg.Account,
+	}
+}
+
+// RequestDeletion schedules the user's account for deletion at the end of
+// the cooling-off period
+func (s *DeletionService) RequestDeletion(ctx context.Context, userID string, req *dto.RequestDeletionRequest) (*dto.DeletionResponse, error) {
+	scheduled, err := s.scheduled(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if scheduled != nil {
+		return nil, ErrDeletionAlreadyScheduled
+	}
+
+	coolingOff := time.Duration(s.cfg.DeletionCoolingOffDays) * 24 * time.Hour
+	d, err := s.entClient.AccountDeletion.
+		Create().
+		SetID(uuid.New().String()).
+		SetUserID(userID).
+		SetRequestedBy(accountdeletion.RequestedByUser).
+		SetNillableReason(strPtr(req.Reason)).
+		SetScheduledFor(time.Now().Add(coolingOff)).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to schedule account deletion: %w", err)
+	}
+
+	log := logger.GetLogger("account")
+	log.Info().Str("user_id", userID).Time("scheduled_for", d.ScheduledFor).Msg("Account deletion scheduled")
+
+	return deletionToResponse(d), nil
+}
+
+// GetDeletion returns the user's scheduled deletion
+func (s *DeletionService) GetDeletion(ctx context.Context, userID string) (*dto.DeletionResponse, error) {
+	d, err := s.scheduled(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if d == nil {
+		return nil, ErrNoDeletionScheduled
+	}
+	return deletionToResponse(d), nil
+}
+
+// CancelDeletion cancels the user's scheduled deletion. Once the cooling-off
+// period has ended the deletion may already be under way and cannot be
+// cancelled.
+func (s *DeletionService) CancelDeletion(ctx context.Context, userID string) (*dto.DeletionResponse, error) {
+	d, err := s.scheduled(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if d == nil || !d.ScheduledFor.After(time.Now()) {
+		return nil, ErrNoDeletionScheduled
+	}
+
+	affected, err := s.entClient.AccountDeletion.
+		Update().
+		Where(accountdeletion.IDEQ(d.ID)).
+		Where(accountdeletion.DeletionStatusEQ(accountdeletion.DeletionStatusScheduled)).
+		Where(accountdeletion.ScheduledForGT(time.Now())).
+		SetDeletionStatus(accountdeletion.DeletionStatusCancelled).
+		SetCancelledAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to cancel account deletion: %w", err)
+	}
+	if affected == 0 {
+		return nil, ErrNoDeletionScheduled
+	}
+
+	d, err = s.entClient.AccountDeletion.Get(ctx, d.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account deletion: %w", err)
+	}
+	return deletionToResponse(d), nil
+}
+
+// DeleteNow deletes an account without a cooling-off period, for admin
+// moderation
+func (s *DeletionService) DeleteNow(ctx context.Context, userID, reason string) error {
+	d, err := s.entClient.AccountDeletion.
+		Create().
+		SetID(uuid.New().String()).
+		SetUserID(userID).
+		SetRequestedBy(accountdeletion.RequestedByAdmin).
+		SetNillableReason(strPtr(reason)).
+		SetScheduledFor(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create account deletion: %w", err)
+	}
+	return s.complete(ctx, d)
+}
+
+// CompleteDue carries out deletions whose cooling-off period has ended. A
+// deletion that fails part way is retried on the next run; every step is
+// safe to repeat.
+func (s *DeletionService) CompleteDue(ctx context.Context) (int, error) {
+	due, err := s.entClient.AccountDeletion.
+		Query().
+		Where(accountdeletion.DeletionStatusEQ(accountdeletion.DeletionStatusScheduled)).
+		Where(accountdeletion.ScheduledForLTE(time.Now())).
+		Order(ent.Asc(accountdeletion.FieldScheduledFor)).
+		All(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get due account deletions: %w", err)
+	}
+
+	log := logger.GetLogger("account")
+	completed := 0
+	for _, d := range due {
+		if err := s.complete(ctx, d); err != nil {
+			log.Error().Err(err).Str("user_id", d.UserID).Str("deletion_id", d.ID).Msg("Account deletion failed")
+			continue
+		}
+		completed++
+	}
+	return completed, nil
+}
+
+// complete deletes the account of a deletion request
+func (s *DeletionService) complete(ctx context.Context, d *ent.AccountDeletion) error {
+	userID := d.UserID
+	now := time.Now()
+
+	// Lock the account out first so nothing changes while it is deleted
+	_, err := s.entClient.User.
+		Update().
+		Where(user.IDEQ(userID)).
+		SetAccountStatus(user.AccountStatusDeleted).
+		SetDeletedAt(now).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete user: %w", err)
+	}
+	if err := s.authService.RevokeAccountAccess(ctx, userID, authservices.SessionRevokedDeleted); err != nil {
+		return fmt.Errorf("failed to revoke sessions: %w", err)
+	}
+	if err := s.chatService.Hub().DisconnectUser(ctx, userID); err != nil {
+		return fmt.Errorf("failed to disconnect chat: %w", err)
+	}
+
+	if err := s.endConnections(ctx, userID); err != nil {
+		return err
+	}
+	if err := s.purgePhotos(ctx, userID); err != nil {
+		return err
+	}
+	if err := s.exportService.PurgeUser(ctx, userID); err != nil {
+		return err
+	}
+	if err := s.anonymize(ctx, userID); err != nil {
+		return err
+	}
+
+	// Also closes any other request of the user, e.g. a self-serve request
+	// overtaken by an admin deletion
+	_, err = s.entClient.AccountDeletion.
+		Update().
+		Where(accountdeletion.UserIDEQ(userID)).
+		Where(accountdeletion.DeletionStatusEQ(accountdeletion.DeletionStatusScheduled)).
+		SetDeletionStatus(accountdeletion.DeletionStatusCompleted).
+		SetCompletedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to complete account deletion: %w", err)
+	}
+
+	log := logger.GetLogger("account")
+	log.Info().Str("user_id", userID).Str("requested_by", string(d.RequestedBy)).Msg("Account deleted")
+	return nil
+}
+
+// endConnections terminates the user's active connections and their
+// streaks, closes the chats and tells each partner the connection ended.
+// Partners are not told why.
+func (s *DeletionService) endConnections(ctx context.Context, userID string) error {
+	conns, err := s.entClient.Connection.
+		Query().
+		Where(
+			connection.Or(
+				connection.UserAIDEQ(userID),
+				connection.UserBIDEQ(userID),
+			),
+		).
+		Where(connection.ConnectionStatusEQ(connection.ConnectionStatusActive)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get connections: %w", err)
+	}
+
+	log := logger.GetLogger("account")
+	for _, conn := range conns {
+		affected, err := s.entClient.Connection.
+			Update().
+			Where(connection.IDEQ(conn.ID)).
+			Where(connection.ConnectionStatusEQ(connection.ConnectionStatusActive)).
+			SetConnectionStatus(connection.ConnectionStatusTerminated).
+			SetTerminatedAt(time.Now()).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to terminate connection: %w", err)
+		}
+		if affected == 0 {
+			continue
+		}
+
+		_, err = s.entClient.Streak.
+			Update().
+			Where(streak.ConnectionIDEQ(conn.ID)).
+			Where(streak.StreakStateNotIn(streak.StreakStateCompleted, streak.StreakStateTerminated)).
+			SetStreakState(streak.StreakStateTerminated).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to terminate streak: %w", err)
+		}
+
+		if err := s.chatService.CloseConversation(ctx, conn.ID, conversation.ClosedReasonTerminated); err != nil {
+			return err
+		}
+
+		partnerID := conn.UserAID
+		if partnerID == userID {
+			partnerID = conn.UserBID
+		}
+
+		// Frees the partner's slot for a new connection
+		_, err = s.entClient.User.
+			Update().
+			Where(user.IDEQ(partnerID)).
+			Where(user.ActiveConnectionCountGT(0)).
+			AddActiveConnectionCount(-1).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to update partner connection count: %w", err)
+		}
+
+		_, err = s.notificationService.Notify(ctx, partnerID, notificationservices.TypeConnectionEnded,
+			"A connection has ended",
+			"This connection is no longer available. Your slot is free for someone new.",
+			map[string]string{"connectionId": conn.ID},
+		)
+		if err != nil {
+			log.Error().Err(err).Str("user_id", partnerID).Str("connection_id", conn.ID).Msg("Failed to notify partner of ended connection")
+		}
+	}
+
+	// Pending interests from or to the user can no longer match
+	_, err = s.entClient.Interest.
+		Update().
+		Where(
+			interest.Or(
+				interest.SenderUserIDEQ(userID),
+				interest.ReceiverUserIDEQ(userID),
+			),
+		).
+		Where(interest.InterestStatusEQ(interest.InterestStatusPending)).
+		SetInterestStatus(interest.InterestStatusExpired).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to expire interests: %w", err)
+	}
+
+	return nil
+}
+
+// purgePhotos deletes the user's photos, including soft-deleted ones, from
+// storage and the database. Photos whose file could not be deleted are kept
+// for the next attempt.
+func (s *DeletionService) purgePhotos(ctx context.Context, userID string) error {
+	photos, err := s.entClient.Photo.
+		Query().
+		Where(photo.UserIDEQ(userID)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get photos: %w", err)
+	}
+
+	for _, p := range photos {
+		if err := s.storageClient.Delete(ctx, p.StorageKey); err != nil {
+			return fmt.Errorf("failed to delete photo %s from storage: %w", p.ID, err)
+		}
+		if err := s.entClient.Photo.DeleteOneID(p.ID).Exec(ctx); err != nil && !ent.IsNotFound(err) {
+			return fmt.Errorf("failed to delete photo: %w", err)
+		}
+	}
+	return nil
+}
+
+// anonymize erases the personal data of the user. Only the keyed hash of
+// the phone number is kept, for the referral fraud checks.
+func (s *DeletionService) anonymize(ctx context.Context, userID string) error {
+	u, err := s.entClient.User.Get(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	update := s.entClient.User.
+		Update().
+		Where(user.IDEQ(userID))
+	if u.PhoneHash == nil && u.PhoneNumber != nil && *u.PhoneNumber != "" {
+		update.SetPhoneHash(s.authService.PhoneHash(authservices.PhoneNumber{
+			CountryCode: u.PhoneCountryCode,
+			National:    *u.PhoneNumber,
+		}))
+	}
+	_, err = update.
+		ClearEmail().
+		ClearPhoneNumber().
+		ClearProviderUserID().
+		ClearName().
+		ClearFirstName().
+		ClearLastName().
+		ClearPicture().
+		ClearDateOfBirth().
+		ClearGender().
+		ClearCity().
+		ClearEducation().
+		ClearProfession().
+		ClearReligion().
+		ClearBio().
+		ClearReferralCode().
+		ClearLastActiveAt().
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to anonymize user: %w", err)
+	}
+
+	_, err = s.entClient.Profile.
+		Update().
+		Where(profile.UserIDEQ(userID)).
+		ClearFirstName().
+		ClearLastName().
+		ClearDateOfBirth().
+		ClearGender().
+		ClearCity().
+		ClearBio().
+		ClearIntentStatement().
+		ClearOptionalFields().
+		SetDeletedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to anonymize profile: %w", err)
+	}
+
+	if _, err := s.entClient.Hobby.Delete().Where(hobby.UserIDEQ(userID)).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to delete hobbies: %w", err)
+	}
+
+	_, err = s.entClient.Message.
+		Update().
+		Where(message.SenderUserIDEQ(userID)).
+		Where(message.BodyNEQ(redactedMessageBody)).
+		SetBody(redactedMessageBody).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to redact messages: %w", err)
+	}
+
+	if _, err := s.entClient.Notification.Delete().Where(notification.UserIDEQ(userID)).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to delete notifications: %w", err)
+	}
+
+	// Sessions hold device names and IP addresses; all are revoked by now
+	if _, err := s.entClient.UserSession.Delete().Where(usersession.UserIDEQ(userID)).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to delete sessions: %w", err)
+	}
+
+	// Frees the Google and Apple accounts to sign up again
+	if _, err := s.entClient.UserIdentity.Delete().Where(useridentity.UserIDEQ(userID)).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to delete identities: %w", err)
+	}
+
+	return nil
+}
+
+// scheduled returns the user's scheduled deletion, if any
+func (s *DeletionService) scheduled(ctx context.Context, userID string) (*ent.AccountDeletion, error) {
+	d, err := s.entClient.AccountDeletion.
+		Query().
+		Where(accountdeletion.UserIDEQ(userID)).
+		Where(accountdeletion.DeletionStatusEQ(accountdeletion.DeletionStatusScheduled)).
+		Order(ent.Desc(accountdeletion.FieldCreatedAt)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account deletion: %w", err)
+	}
+	return d, nil
+}
+
+func deletionToResponse(d *ent.AccountDeletion) *dto.DeletionResponse {
+	return &dto.DeletionResponse{
+		ID:           d.ID,
+		Status:       string(d.DeletionStatus),
+		RequestedAt:  d.CreatedAt,
+		ScheduledFor: d.ScheduledFor,
+		CancelledAt:  d.CancelledAt,
+	}
+}
+
+func strPtr(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
@@ -0,0 +1,462 @@
+// internal/account/services/export_data.go
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	ent "github.com/UnoraApp/be/ent/generated"
+	"github.com/UnoraApp/be/ent/generated/checkin"
+	"github.com/UnoraApp/be/ent/generated/connection"
+	"github.com/UnoraApp/be/ent/generated/credittransaction"
+	"github.com/UnoraApp/be/ent/generated/hobby"
+	"github.com/UnoraApp/be/ent/generated/interest"
+	"github.com/UnoraApp/be/ent/generated/invoice"
+	"github.com/UnoraApp/be/ent/generated/message"
+	"github.com/UnoraApp/be/ent/generated/notification"
+	"github.com/UnoraApp/be/ent/generated/photo"
+	"github.com/UnoraApp/be/ent/generated/profile"
+	"github.com/UnoraApp/be/ent/generated/useridentity"
+	"github.com/UnoraApp/be/ent/generated/usersession"
+)
+
+// exportData is everything a data export holds about a user. Other users
+// appear only as connection IDs, never by name or user ID.
+type exportData struct {
+	account            exportAccount
+	profile            *exportProfile
+	connections        []exportConnection
+	interests          []exportInterest
+	checkIns           []exportCheckIn
+	messages           []exportMessage
+	creditTransactions []exportCreditTransaction
+	invoices           []exportInvoice
+	notifications      []exportNotification
+	sessions           []exportSession
+	identities         []exportIdentity
+	photos             []*ent.Photo
+}
+
+type exportFile struct {
+	name string
+	data interface{}
+}
+
+// files lists the JSON files of the archive; photos go under photos/
+func (d *exportData) files() []exportFile {
+	return []exportFile{
+		{"account.json", d.account},
+		{"profile.json", d.profile},
+		{"connections.json", d.connections},
+		{"interests.json", d.interests},
+		{"check_ins.json", d.checkIns},
+		{"messages.json", d.messages},
+		{"credit_transactions.json", d.creditTransactions},
+		{"invoices.json", d.invoices},
+		{"notifications.json", d.notifications},
+		{"sessions.json", d.sessions},
+		{"identities.json", d.identities},
+	}
+}
+
+type exportAccount struct {
+	ID                 string     `json:"id"`
+	Email              *string    `json:"email,omitempty"`
+	PhoneCountryCode   string     `json:"phoneCountryCode,omitempty"`
+	PhoneNumber        *string    `json:"phoneNumber,omitempty"`
+	Provider           *string    `json:"provider,omitempty"`
+	Name               *string    `json:"name,omitempty"`
+	FirstName          *string    `json:"firstName,omitempty"`
+	LastName           *string    `json:"lastName,omitempty"`
+	DateOfBirth        *time.Time `json:"dateOfBirth,omitempty"`
+	Gender             *string    `json:"gender,omitempty"`
+	City               *string    `json:"city,omitempty"`
+	Education          *string    `json:"education,omitempty"`
+	Profession         *string    `json:"profession,omitempty"`
+	Religion           *string    `json:"religion,omitempty"`
+	Bio                *string    `json:"bio,omitempty"`
+	VerificationStatus string     `json:"verificationStatus"`
+	SubscriptionTier   string     `json:"subscriptionTier"`
+	CreditBalance      int        `json:"creditBalance"`
+	ReferralCode       *string    `json:"referralCode,omitempty"`
+	CreatedAt          time.Time  `json:"createdAt"`
+	LastActiveAt       *time.Time `json:"lastActiveAt,omitempty"`
+}
+
+type exportProfile struct {
+	FirstName       *string                `json:"firstName,omitempty"`
+	LastName        *string                `json:"lastName,omitempty"`
+	DateOfBirth     *time.Time             `json:"dateOfBirth,omitempty"`
+	Gender          *string                `json:"gender,omitempty"`
+	City            *string                `json:"city,omitempty"`
+	Bio             *string                `json:"bio,omitempty"`
+	IntentStatement *string                `json:"intentStatement,omitempty"`
+	OptionalFields  map[string]interface{} `json:"optionalFields,omitempty"`
+	Hobbies         []exportHobby          `json:"hobbies"`
+	CreatedAt       time.Time              `json:"createdAt"`
+	UpdatedAt       time.Time              `json:"updatedAt"`
+}
+
+type exportHobby struct {
+	Name             string  `json:"name"`
+	Category         string  `json:"category"`
+	MicroDescription *string `json:"microDescription,omitempty"`
+}
+
+type exportConnection struct {
+	ID           string     `json:"id"`
+	ServerType   string     `json:"serverType"`
+	Status       string     `json:"status"`
+	CreatedAt    time.Time  `json:"createdAt"`
+	TerminatedAt *time.Time `json:"terminatedAt,omitempty"`
+}
+
+type exportInterest struct {
+	Direction  string     `json:"direction"` // sent, received
+	ServerType string     `json:"serverType"`
+	Status     string     `json:"status"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	MatchedAt  *time.Time `json:"matchedAt,omitempty"`
+}
+
+type exportCheckIn struct {
+	StreakID  string                 `json:"streakId"`
+	DayNumber int                    `json:"dayNumber"`
+	Type      string                 `json:"type"`
+	EventData map[string]interface{} `json:"eventData,omitempty"`
+	CreatedAt time.Time              `json:"createdAt"`
+}
+
+type exportMessage struct {
+	ConversationID string    `json:"conversationId"`
+	Body           string    `json:"body"`
+	SentAt         time.Time `json:"sentAt"`
+}
+
+type exportCreditTransaction struct {
+	Type         string    `json:"type"`
+	Amount       int       `json:"amount"`
+	BalanceAfter int       `json:"balanceAfter"`
+	Description  *string   `json:"description,omitempty"`
+	CreatedAt    time.Time `json:"createdAt"`
+}
+
+type exportInvoice struct {
+	InvoiceNumber string    `json:"invoiceNumber"`
+	Description   string    `json:"description"`
+	Currency      string    `json:"currency"`
+	TotalAmount   int       `json:"totalAmount"`
+	IssuedAt      time.Time `json:"issuedAt"`
+}
+
+type exportNotification struct {
+	Type      string    `json:"type"`
+	Title     string    `json:"title"`
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type exportSession struct {
+	DeviceName *string    `json:"deviceName,omitempty"`
+	Platform   string     `json:"platform"`
+	IPAddress  *string    `json:"ipAddress,omitempty"`
+	UserAgent  *string    `json:"userAgent,omitempty"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	LastUsedAt time.Time  `json:"lastUsedAt"`
+	RevokedAt  *time.Time `json:"revokedAt,omitempty"`
+}
+
+type exportIdentity struct {
+	Provider       string     `json:"provider"`
+	Email          *string    `json:"email,omitempty"`
+	IsPrivateEmail bool       `json:"isPrivateEmail"`
+	LinkedAt       time.Time  `json:"linkedAt"`
+	LastUsedAt     *time.Time `json:"lastUsedAt,omitempty"`
+}
+
+// collectExportData reads everything held about the user
+func collectExportData(ctx context.Context, client *ent.Client, userID string) (*exportData, error) {
+	u, err := client.User.Get(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	var gender *string
+	if u.Gender != nil {
+		g := string(*u.Gender)
+		gender = &g
+	}
+	data := &exportData{
+		account: exportAccount{
+			ID:                 u.ID,
+			Email:              u.Email,
+			PhoneCountryCode:   u.PhoneCountryCode,
+			PhoneNumber:        u.PhoneNumber,
+			Provider:           u.Provider,
+			Name:               u.Name,
+			FirstName:          u.FirstName,
+			LastName:           u.LastName,
+			DateOfBirth:        u.DateOfBirth,
+			Gender:             gender,
+			City:               u.City,
+			Education:          u.Education,
+			Profession:         u.Profession,
+			Religion:           u.Religion,
+			Bio:                u.Bio,
+			VerificationStatus: string(u.VerificationStatus),
+			SubscriptionTier:   string(u.SubscriptionTier),
+			CreditBalance:      u.CreditBalance,
+			ReferralCode:       u.ReferralCode,
+			CreatedAt:          u.CreatedAt,
+			LastActiveAt:       u.LastActiveAt,
+		},
+	}
+
+	// Profile and hobbies
+	p, err := client.Profile.
+		Query().
+		Where(profile.UserIDEQ(userID)).
+		Where(profile.DeletedAtIsNil()).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to get profile: %w", err)
+	}
+	if p != nil {
+		hobbies, err := client.Hobby.
+			Query().
+			Where(hobby.UserIDEQ(userID)).
+			Where(hobby.DeletedAtIsNil()).
+			WithHobbyOption().
+			Order(ent.Asc(hobby.FieldDisplayOrder)).
+			All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get hobbies: %w", err)
+		}
+		data.profile = &exportProfile{
+			FirstName:       p.FirstName,
+			LastName:        p.LastName,
+			DateOfBirth:     p.DateOfBirth,
+			Gender:          p.Gender,
+			City:            p.City,
+			Bio:             p.Bio,
+			IntentStatement: p.IntentStatement,
+			OptionalFields:  p.OptionalFields,
+			Hobbies:         make([]exportHobby, 0, len(hobbies)),
+			CreatedAt:       p.CreatedAt,
+			UpdatedAt:       p.UpdatedAt,
+		}
+		for _, h := range hobbies {
+			eh := exportHobby{MicroDescription: h.MicroDescription}
+			if h.Edges.HobbyOption != nil {
+				eh.Name = h.Edges.HobbyOption.Name
+				eh.Category = h.Edges.HobbyOption.Category
+			}
+			data.profile.Hobbies = append(data.profile.Hobbies, eh)
+		}
+	}
+
+	// Photos, including ones the user removed but are still stored
+	data.photos, err = client.Photo.
+		Query().
+		Where(photo.UserIDEQ(userID)).
+		Order(ent.Asc(photo.FieldDisplayOrder)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get photos: %w", err)
+	}
+
+	// Connections
+	conns, err := client.Connection.
+		Query().
+		Where(
+			connection.Or(
+				connection.UserAIDEQ(userID),
+				connection.UserBIDEQ(userID),
+			),
+		).
+		Order(ent.Asc(connection.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get connections: %w", err)
+	}
+	data.connections = make([]exportConnection, len(conns))
+	for i, c := range conns {
+		data.connections[i] = exportConnection{
+			ID:           c.ID,
+			ServerType:   string(c.ServerType),
+			Status:       string(c.ConnectionStatus),
+			CreatedAt:    c.CreatedAt,
+			TerminatedAt: c.TerminatedAt,
+		}
+	}
+
+	// Interests sent and received
+	interests, err := client.Interest.
+		Query().
+		Where(
+			interest.Or(
+				interest.SenderUserIDEQ(userID),
+				interest.ReceiverUserIDEQ(userID),
+			),
+		).
+		Order(ent.Asc(interest.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get interests: %w", err)
+	}
+	data.interests = make([]exportInterest, len(interests))
+	for i, in := range interests {
+		direction := "received"
+		if in.SenderUserID == userID {
+			direction = "sent"
+		}
+		data.interests[i] = exportInterest{
+			Direction:  direction,
+			ServerType: string(in.ServerType),
+			Status:     string(in.InterestStatus),
+			CreatedAt:  in.CreatedAt,
+			MatchedAt:  in.MatchedAt,
+		}
+	}
+
+	// Streak check-ins
+	checkIns, err := client.CheckIn.
+		Query().
+		Where(checkin.UserIDEQ(userID)).
+		Order(ent.Asc(checkin.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get check-ins: %w", err)
+	}
+	data.checkIns = make([]exportCheckIn, len(checkIns))
+	for i, c := range checkIns {
+		data.checkIns[i] = exportCheckIn{
+			StreakID:  c.StreakID,
+			DayNumber: c.DayNumber,
+			Type:      string(c.CheckInType),
+			EventData: c.EventData,
+			CreatedAt: c.CreatedAt,
+		}
+	}
+
+	// Chat messages the user sent
+	messages, err := client.Message.
+		Query().
+		Where(message.SenderUserIDEQ(userID)).
+		Order(ent.Asc(message.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get messages: %w", err)
+	}
+	data.messages = make([]exportMessage, len(messages))
+	for i, m := range messages {
+		data.messages[i] = exportMessage{
+			ConversationID: m.ConversationID,
+			Body:           m.Body,
+			SentAt:         m.CreatedAt,
+		}
+	}
+
+	// Credits and invoices
+	txns, err := client.CreditTransaction.
+		Query().
+		Where(credittransaction.UserIDEQ(userID)).
+		Order(ent.Asc(credittransaction.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get credit transactions: %w", err)
+	}
+	data.creditTransactions = make([]exportCreditTransaction, len(txns))
+	for i, t := range txns {
+		data.creditTransactions[i] = exportCreditTransaction{
+			Type:         string(t.TransactionType),
+			Amount:       t.CreditAmount,
+			BalanceAfter: t.BalanceAfter,
+			Description:  t.Description,
+			CreatedAt:    t.CreatedAt,
+		}
+	}
+
+	invoices, err := client.Invoice.
+		Query().
+		Where(invoice.UserIDEQ(userID)).
+		Order(ent.Asc(invoice.FieldIssuedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get invoices: %w", err)
+	}
+	data.invoices = make([]exportInvoice, len(invoices))
+	for i, inv := range invoices {
+		data.invoices[i] = exportInvoice{
+			InvoiceNumber: inv.InvoiceNumber,
+			Description:   inv.Description,
+			Currency:      inv.Currency,
+			TotalAmount:   inv.TotalAmount,
+			IssuedAt:      inv.IssuedAt,
+		}
+	}
+
+	// Notifications
+	notifications, err := client.Notification.
+		Query().
+		Where(notification.UserIDEQ(userID)).
+		Order(ent.Asc(notification.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get notifications: %w", err)
+	}
+	data.notifications = make([]exportNotification, len(notifications))
+	for i, n := range notifications {
+		data.notifications[i] = exportNotification{
+			Type:      n.NotificationType,
+			Title:     n.Title,
+			Body:      n.Body,
+			CreatedAt: n.CreatedAt,
+		}
+	}
+
+	// Signed-in devices
+	sessions, err := client.UserSession.
+		Query().
+		Where(usersession.UserIDEQ(userID)).
+		Order(ent.Asc(usersession.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sessions: %w", err)
+	}
+	data.sessions = make([]exportSession, len(sessions))
+	for i, sess := range sessions {
+		data.sessions[i] = exportSession{
+			DeviceName: sess.DeviceName,
+			Platform:   string(sess.Platform),
+			IPAddress:  sess.IPAddress,
+			UserAgent:  sess.UserAgent,
+			CreatedAt:  sess.CreatedAt,
+			LastUsedAt: sess.LastUsedAt,
+			RevokedAt:  sess.RevokedAt,
+		}
+	}
+
+	// Linked Google and Apple accounts
+	identities, err := client.UserIdentity.
+		Query().
+		Where(useridentity.UserIDEQ(userID)).
+		Order(ent.Asc(useridentity.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get identities: %w", err)
+	}
+	data.identities = make([]exportIdentity, len(identities))
+	for i, identity := range identities {
+		data.identities[i] = exportIdentity{
+			Provider:       string(identity.Provider),
+			Email:          identity.Email,
+			IsPrivateEmail: identity.IsPrivateEmail,
+			LinkedAt:       identity.CreatedAt,
+			LastUsedAt:     identity.LastUsedAt,
+		}
+	}
+
+	return data, nil
+}
@@ -0,0 +1,308 @@
+// internal/account/services/export_service.go
+package services
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"time"
+
+	"github.com/google/uuid"
+
+	ent "github.com/UnoraApp/be/ent/generated"
+	"github.com/UnoraApp/be/ent/generated/dataexport"
+	"github.com/UnoraApp/be/internal/account/dto"
+	"github.com/UnoraApp/be/internal/config"
+	"github.com/UnoraApp/be/pkg/logger"
+	"github.com/UnoraApp/be/pkg/storage"
+)
+
+const (
+	// exportBatchSize bounds the archives built per job run
+	exportBatchSize = 5
+	// exportStaleAfter is how long an export may stay processing before it
+	// is taken to have died with its instance and is built again
+	exportStaleAfter = 30 * time.Minute
+)
+
+// ExportService builds archives of a user's data. Exports are requested
+// through the API and built in the background by a scheduled job; the
+// archive can be downloaded until it expires.
+type ExportService struct {
+	entClient     *ent.Client
+	storageClient storage.Client
+	cfg           *config.AccountConfig
+}
+
+// NewExportService creates a new export service
+func NewExportService(entClient *ent.Client, storageClient storage.Client, cfg *config.Config) *ExportService {
+	return &ExportService{
+		entClient:     entClient,
+		storageClient: storageClient,
+		cfg:           &cfg.Account,
+	}
+}
+
+// RequestExport returns the user's current export, queueing a new one if
+// there is none in progress or ready to download
+func (s *ExportService) RequestExport(ctx context.Context, userID string) (*dto.DataExportResponse, error) {
+	latest, err := s.entClient.DataExport.
+		Query().
+		Where(dataexport.UserIDEQ(userID)).
+		Order(ent.Desc(dataexport.FieldCreatedAt)).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to get data export: %w", err)
+	}
+
+	if latest != nil {
+		switch latest.ExportStatus {
+		case dataexport.ExportStatusPending, dataexport.ExportStatusProcessing, dataexport.ExportStatusReady:
+			return s.exportToResponse(ctx, latest), nil
+		}
+	}
+
+	e, err := s.entClient.DataExport.
+		Create().
+		SetID(uuid.New().String()).
+		SetUserID(userID).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create data export: %w", err)
+	}
+	return s.exportToResponse(ctx, e), nil
+}
+
+// ProcessPending builds queued exports, and ones whose build died part way
+func (s *ExportService) ProcessPending(ctx context.Context) (int, error) {
+	staleBefore := time.Now().Add(-exportStaleAfter)
+	exports, err := s.entClient.DataExport.
+		Query().
+		Where(
+			dataexport.Or(
+				dataexport.ExportStatusEQ(dataexport.ExportStatusPending),
+				dataexport.And(
+					dataexport.ExportStatusEQ(dataexport.ExportStatusProcessing),
+					dataexport.StartedAtLT(staleBefore),
+				),
+			),
+		).
+		Order(ent.Asc(dataexport.FieldCreatedAt)).
+		Limit(exportBatchSize).
+		All(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get pending data exports: %w", err)
+	}
+
+	log := logger.GetLogger("account")
+	built := 0
+	for _, e := range exports {
+		// Claim the export so other instances skip it
+		claim := s.entClient.DataExport.
+			Update().
+			Where(dataexport.IDEQ(e.ID)).
+			Where(dataexport.ExportStatusEQ(e.ExportStatus))
+		if e.StartedAt != nil {
+			claim = claim.Where(dataexport.StartedAtEQ(*e.StartedAt))
+		}
+		affected, err := claim.
+			SetExportStatus(dataexport.ExportStatusProcessing).
+			SetStartedAt(time.Now()).
+			Save(ctx)
+		if err != nil {
+			return built, fmt.Errorf("failed to claim data export: %w", err)
+		}
+		if affected == 0 {
+			continue
+		}
+
+		if err := s.build(ctx, e); err != nil {
+			log.Error().Err(err).Str("user_id", e.UserID).Str("export_id", e.ID).Msg("Data export failed")
+			_, err = s.entClient.DataExport.
+				UpdateOneID(e.ID).
+				SetExportStatus(dataexport.ExportStatusFailed).
+				SetErrorMessage(truncate(err.Error(), 500)).
+				SetCompletedAt(time.Now()).
+				Save(ctx)
+			if err != nil {
+				log.Error().Err(err).Str("export_id", e.ID).Msg("Failed to mark data export failed")
+			}
+			continue
+		}
+		built++
+	}
+	return built, nil
+}
+
+// ExpireOld deletes archives past their download window from storage
+func (s *ExportService) ExpireOld(ctx context.Context) (int, error) {
+	exports, err := s.entClient.DataExport.
+		Query().
+		Where(dataexport.ExportStatusEQ(dataexport.ExportStatusReady)).
+		Where(dataexport.ExpiresAtLT(time.Now())).
+		All(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get expired data exports: %w", err)
+	}
+
+	expired := 0
+	for _, e := range exports {
+		if err := s.expire(ctx, e); err != nil {
+			return expired, err
+		}
+		expired++
+	}
+	return expired, nil
+}
+
+// PurgeUser deletes every archive of the user from storage, for account
+// deletion
+func (s *ExportService) PurgeUser(ctx context.Context, userID string) error {
+	exports, err := s.entClient.DataExport.
+		Query().
+		Where(dataexport.UserIDEQ(userID)).
+		Where(dataexport.ExportStatusNEQ(dataexport.ExportStatusExpired)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get data exports: %w", err)
+	}
+
+	for _, e := range exports {
+		if err := s.expire(ctx, e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// expire deletes the archive of an export
+func (s *ExportService) expire(ctx context.Context, e *ent.DataExport) error {
+	if e.StorageKey != nil {
+		if err := s.storageClient.Delete(ctx, *e.StorageKey); err != nil {
+			return fmt.Errorf("failed to delete data export %s from storage: %w", e.ID, err)
+		}
+	}
+	_, err := s.entClient.DataExport.
+		UpdateOneID(e.ID).
+		SetExportStatus(dataexport.ExportStatusExpired).
+		ClearStorageKey().
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to expire data export: %w", err)
+	}
+	return nil
+}
+
+// build writes the user's data to a zip archive and uploads it
+func (s *ExportService) build(ctx context.Context, e *ent.DataExport) error {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+
+	data, err := collectExportData(ctx, s.entClient, e.UserID)
+	if err != nil {
+		return err
+	}
+	for _, f := range data.files() {
+		if err := writeJSON(zw, f.name, f.data); err != nil {
+			return err
+		}
+	}
+
+	// A photo missing from storage is left out rather than failing the
+	// export every time it is retried
+	log := logger.GetLogger("account")
+	for _, p := range data.photos {
+		if err := s.writePhoto(ctx, zw, p); err != nil {
+			log.Warn().Err(err).Str("user_id", e.UserID).Str("photo_id", p.ID).Msg("Photo left out of data export")
+		}
+	}
+
+	if err := zw.Close(); err != nil {
+		return fmt.Errorf("failed to write archive: %w", err)
+	}
+
+	key := fmt.Sprintf("exports/%s/%s.zip", e.UserID, e.ID)
+	size := int64(buf.Len())
+	if _, err := s.storageClient.Upload(ctx, key, &buf, size, "application/zip"); err != nil {
+		return fmt.Errorf("failed to upload archive: %w", err)
+	}
+
+	now := time.Now()
+	retention := time.Duration(s.cfg.ExportRetentionDays) * 24 * time.Hour
+	_, err = s.entClient.DataExport.
+		UpdateOneID(e.ID).
+		SetExportStatus(dataexport.ExportStatusReady).
+		SetStorageKey(key).
+		SetSizeBytes(size).
+		SetCompletedAt(now).
+		SetExpiresAt(now.Add(retention)).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update data export: %w", err)
+	}
+	return nil
+}
+
+// writePhoto copies a photo from storage into the archive
+func (s *ExportService) writePhoto(ctx context.Context, zw *zip.Writer, p *ent.Photo) error {
+	r, err := s.storageClient.Download(ctx, p.StorageKey)
+	if err != nil {
+		return fmt.Errorf("failed to download photo %s: %w", p.ID, err)
+	}
+	defer r.Close()
+
+	// Read in full first so a failed download leaves no partial file behind
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return fmt.Errorf("failed to download photo %s: %w", p.ID, err)
+	}
+
+	name := fmt.Sprintf("photos/%d-%s%s", p.DisplayOrder, p.ID, path.Ext(p.StorageKey))
+	w, err := zw.Create(name)
+	if err != nil {
+		return fmt.Errorf("failed to write %s: %w", name, err)
+	}
+	if _, err := w.Write(content); err != nil {
+		return fmt.Errorf("failed to write %s: %w", name, err)
+	}
+	return nil
+}
+
+func (s *ExportService) exportToResponse(ctx context.Context, e *ent.DataExport) *dto.DataExportResponse {
+	resp := &dto.DataExportResponse{
+		ID:          e.ID,
+		Status:      string(e.ExportStatus),
+		RequestedAt: e.CreatedAt,
+		CompletedAt: e.CompletedAt,
+		ExpiresAt:   e.ExpiresAt,
+		SizeBytes:   e.SizeBytes,
+	}
+	if e.ExportStatus == dataexport.ExportStatusReady && e.StorageKey != nil {
+		resp.DownloadURL, _ = s.storageClient.GetPresignedDownloadURL(ctx, *e.StorageKey)
+	}
+	return resp
+}
+
+func writeJSON(zw *zip.Writer, name string, v interface{}) error {
+	w, err := zw.Create(name)
+	if err != nil {
+		return fmt.Errorf("failed to write %s: %w", name, err)
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(v); err != nil {
+		return fmt.Errorf("failed to write %s: %w", name, err)
+	}
+	return nil
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n]
+}
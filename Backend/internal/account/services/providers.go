@@ -0,0 +1,10 @@
+// internal/account/services/providers.go
+package services
+
+import "github.com/google/wire"
+
+// ProviderSet is the wire provider set for account services
+var ProviderSet = wire.NewSet(
+	NewExportService,
+	NewDeletionService,
+)
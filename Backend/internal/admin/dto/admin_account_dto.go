@@ -0,0 +1,44 @@
+// internal/admin/dto/admin_account_dto.go
+package dto
+
+import "time"
+
+// ===== ADMIN ACCOUNTS =====
+
+// AdminAccountResponse represents an admin account
+// @Description Admin account
+type AdminAccountResponse struct {
+	ID           string     `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Email        string     `json:"email" example:"priya@unora.app"`
+	Name         string     `json:"name" example:"Priya"`
+	Role         string     `json:"role" example:"moderator"`
+	Status       string     `json:"status" example:"active"`
+	APIKeyPrefix string     `json:"apiKeyPrefix" example:"uadm_3kQ9x"`
+	KeyRotatedAt time.Time  `json:"keyRotatedAt"`
+	LastUsedAt   *time.Time `json:"lastUsedAt,omitempty"`
+	CreatedBy    string     `json:"createdBy,omitempty"`
+	CreatedAt    time.Time  `json:"createdAt"`
+}
+
+// CreateAdminRequest request to create an admin account
+// @Description Create an admin account
+type CreateAdminRequest struct {
+	Email string `json:"email" validate:"required,email,max=100" example:"priya@unora.app"`
+	Name  string `json:"name" validate:"required,max=100" example:"Priya"`
+	Role  string `json:"role" validate:"required,oneof=support moderator finance superadmin" example:"moderator"`
+}
+
+// UpdateAdminRequest request to change an admin's role or status
+// @Description Change an admin's role or status
+type UpdateAdminRequest struct {
+	Role   *string `json:"role,omitempty" validate:"omitempty,oneof=support moderator finance superadmin" example:"finance"`
+	Status *string `json:"status,omitempty" validate:"omitempty,oneof=active disabled" example:"disabled"`
+}
+
+// AdminAPIKeyResponse is an admin account with its new API key, which is
+// shown only this once
+// @Description Admin account with its new API key
+type AdminAPIKeyResponse struct {
+	Admin  AdminAccountResponse `json:"admin"`
+	APIKey string               `json:"apiKey" example:"uadm_3kQ9xV..."`
+}
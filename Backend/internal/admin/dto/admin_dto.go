@@ -43,6 +43,19 @@ type SuspendUserRequest struct {
 	Duration string `json:"duration" validate:"required" example:"7d"` // "permanent", "7d", "30d", etc.
 }
 
+// ThrottleUserRequest request to silently throttle a user
+// @Description Silently throttle a user flagged by safety
+type ThrottleUserRequest struct {
+	Reason   string `json:"reason" validate:"required,max=500" example:"Mass interest spam"`
+	Duration string `json:"duration" validate:"required" example:"7d"` // "12h", "7d", etc., at most 90 days
+}
+
+// ThrottleUserResponse response for a throttled user
+// @Description Throttle end time
+type ThrottleUserResponse struct {
+	ThrottledUntil time.Time `json:"throttledUntil" example:"2024-01-08T00:00:00Z"`
+}
+
 // AdjustCreditsRequest request to adjust user credits
 // @Description Adjust user credit balance
 type AdjustCreditsRequest struct {
@@ -56,19 +69,45 @@ type AdjustCreditsRequest struct {
 // AdminReportResponse represents a report in admin view
 // @Description Report details for admin review
 type AdminReportResponse struct {
-	ID             string     `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
-	ReporterID     string     `json:"reporterId" example:"550e8400-e29b-41d4-a716-446655440000"`
-	ReporterName   string     `json:"reporterName" example:"John"`
-	ReportedID     string     `json:"reportedId" example:"550e8400-e29b-41d4-a716-446655440000"`
-	ReportedName   string     `json:"reportedName" example:"Jane"`
-	Reason         string     `json:"reason" example:"harassment"`
-	Description    string     `json:"description,omitempty" example:"User sent threatening messages"`
-	ReferenceType  string     `json:"referenceType,omitempty" example:"message"`
-	ReferenceID    string     `json:"referenceId,omitempty" example:"msg_123"`
-	Status         string     `json:"status" example:"pending"`
-	AdminNotes     string     `json:"adminNotes,omitempty" example:"Reviewed, evidence found"`
-	ReviewedAt     *time.Time `json:"reviewedAt,omitempty"`
-	CreatedAt      time.Time  `json:"createdAt" example:"2024-01-01T00:00:00Z"`
+	ID                string                  `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	ReporterID        string                  `json:"reporterId" example:"550e8400-e29b-41d4-a716-446655440000"`
+	ReporterName      string                  `json:"reporterName" example:"John"`
+	ReportedID        string                  `json:"reportedId" example:"550e8400-e29b-41d4-a716-446655440000"`
+	ReportedName      string                  `json:"reportedName" example:"Jane"`
+	Reason            string                  `json:"reason" example:"harassment"`
+	Description       string                  `json:"description,omitempty" example:"User sent threatening messages"`
+	ReferenceType     string                  `json:"referenceType,omitempty" example:"message"`
+	ReferenceID       string                  `json:"referenceId,omitempty" example:"msg_123"`
+	Status            string                  `json:"status" example:"pending"`
+	AdminNotes        string                  `json:"adminNotes,omitempty" example:"Reviewed, evidence found"`
+	ReviewedAt        *time.Time              `json:"reviewedAt,omitempty"`
+	CreatedAt         time.Time               `json:"createdAt" example:"2024-01-01T00:00:00Z"`
+	Evidence          []AdminReportEvidence   `json:"evidence,omitempty"`
+	ModerationActions []AdminModerationAction `json:"moderationActions,omitempty"`
+}
+
+// AdminReportEvidence is a message snapshot attached to a report
+// @Description Immutable chat message evidence captured when the report was filed
+type AdminReportEvidence struct {
+	MessageID         string    `json:"messageId" example:"550e8400-e29b-41d4-a716-446655440000"`
+	SenderID          string    `json:"senderId" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Body              string    `json:"body" example:"Can you send me money for the ticket?"`
+	ModerationStatus  string    `json:"moderationStatus" example:"visible"`
+	IsReportedMessage bool      `json:"isReportedMessage" example:"true"`
+	SentAt            time.Time `json:"sentAt" example:"2024-01-15T10:30:00Z"`
+}
+
+// AdminModerationAction is a chat moderation decision about a user
+// @Description Moderation pipeline or admin decision on a user's chat activity
+type AdminModerationAction struct {
+	ID             string    `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	UserID         string    `json:"userId" example:"550e8400-e29b-41d4-a716-446655440000"`
+	MessageID      string    `json:"messageId,omitempty" example:"550e8400-e29b-41d4-a716-446655440000"`
+	ConversationID string    `json:"conversationId,omitempty" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Action         string    `json:"action" example:"held"`
+	Source         string    `json:"source" example:"phone_number"`
+	Reason         string    `json:"reason,omitempty" example:"message contains a phone number"`
+	CreatedAt      time.Time `json:"createdAt" example:"2024-01-15T10:30:00Z"`
 }
 
 // AdminReportListResponse paginated report list
@@ -115,6 +154,87 @@ type UpdateCreditPackageRequest struct {
 	SortOrder       *int     `json:"sortOrder,omitempty" example:"1"`
 }
 
+// UpdateSubscriptionPlanRequest updates a subscription plan
+// @Description Update subscription plan details; an empty razorpayPlanId unlinks the plan
+type UpdateSubscriptionPlanRequest struct {
+	Name           string  `json:"name,omitempty" validate:"max=100" example:"Plus Monthly"`
+	PriceAmount    *int    `json:"priceAmount,omitempty" example:"19900"`
+	RazorpayPlanID *string `json:"razorpayPlanId,omitempty" example:"plan_00000000000001"`
+	IsActive       *bool   `json:"isActive,omitempty" example:"true"`
+	SortOrder      *int    `json:"sortOrder,omitempty" example:"1"`
+}
+
+// TierEntitlementResponse is the limits of one subscription tier
+// @Description Tier limits, including the internal reveal schedule
+type TierEntitlementResponse struct {
+	Tier                        string     `json:"tier" example:"plus"`
+	ConnectionSlots             int        `json:"connectionSlots" example:"2"`
+	RefreshCooldownMinutes      int        `json:"refreshCooldownMinutes" example:"720"`
+	NudgesPerAtRisk             int        `json:"nudgesPerAtRisk" example:"3"`
+	NudgeIntervalMinutes        int        `json:"nudgeIntervalMinutes" example:"420"`
+	FreeRecoveriesPerConnection int        `json:"freeRecoveriesPerConnection" example:"1"`
+	PurchasableReveals          int        `json:"purchasableReveals" example:"2"`
+	RevealDays                  []int      `json:"revealDays" example:"4,8,12"`
+	UpdatedAt                   *time.Time `json:"updatedAt,omitempty"`
+}
+
+// UpdateTierEntitlementRequest updates a tier's limits
+// @Description Update tier limits; omitted fields are left unchanged. Changes reach every server within a minute.
+type UpdateTierEntitlementRequest struct {
+	ConnectionSlots             *int  `json:"connectionSlots,omitempty" validate:"omitempty,min=0" example:"2"`
+	RefreshCooldownMinutes      *int  `json:"refreshCooldownMinutes,omitempty" validate:"omitempty,min=0" example:"720"`
+	NudgesPerAtRisk             *int  `json:"nudgesPerAtRisk,omitempty" validate:"omitempty,min=0" example:"3"`
+	NudgeIntervalMinutes        *int  `json:"nudgeIntervalMinutes,omitempty" validate:"omitempty,min=0" example:"420"`
+	FreeRecoveriesPerConnection *int  `json:"freeRecoveriesPerConnection,omitempty" validate:"omitempty,min=0" example:"1"`
+	PurchasableReveals          *int  `json:"purchasableReveals,omitempty" validate:"omitempty,min=0" example:"2"`
+	RevealDays                  []int `json:"revealDays,omitempty" example:"4,8,12"`
+}
+
+// CreatePromoCodeRequest creates a promo code
+// @Description Create a credits code (creditAmount) or a package discount code (discountPercent, optionally for one packageId)
+type CreatePromoCodeRequest struct {
+	Code            string     `json:"code" validate:"required" example:"DIWALI20"`
+	Description     string     `json:"description,omitempty" validate:"max=255" example:"Diwali sale"`
+	Kind            string     `json:"kind" validate:"required,oneof=credits package_discount" example:"package_discount"`
+	CreditAmount    int        `json:"creditAmount,omitempty" example:"50"`
+	DiscountPercent int        `json:"discountPercent,omitempty" validate:"omitempty,min=1,max=100" example:"20"`
+	PackageID       string     `json:"packageId,omitempty" example:"550e8400-e29b-41d4-a716-446655440000"`
+	MaxRedemptions  *int       `json:"maxRedemptions,omitempty" example:"1000"`
+	PerUserLimit    int        `json:"perUserLimit,omitempty" example:"1"`
+	StartsAt        *time.Time `json:"startsAt,omitempty" example:"2026-10-20T00:00:00Z"`
+	ExpiresAt       *time.Time `json:"expiresAt,omitempty" example:"2026-11-05T00:00:00Z"`
+}
+
+// UpdatePromoCodeRequest updates a promo code
+// @Description Update a promo code's limits, window or status; maxRedemptions 0 removes the cap
+type UpdatePromoCodeRequest struct {
+	Description    *string    `json:"description,omitempty" example:"Diwali sale"`
+	MaxRedemptions *int       `json:"maxRedemptions,omitempty" example:"2000"`
+	PerUserLimit   *int       `json:"perUserLimit,omitempty" example:"1"`
+	StartsAt       *time.Time `json:"startsAt,omitempty" example:"2026-10-20T00:00:00Z"`
+	ExpiresAt      *time.Time `json:"expiresAt,omitempty" example:"2026-11-05T00:00:00Z"`
+	IsActive       *bool      `json:"isActive,omitempty" example:"false"`
+}
+
+// PromoCodeResponse is a promo code with its redemption count
+// @Description Promo code details
+type PromoCodeResponse struct {
+	ID              string     `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Code            string     `json:"code" example:"DIWALI20"`
+	Description     string     `json:"description,omitempty" example:"Diwali sale"`
+	Kind            string     `json:"kind" example:"package_discount"`
+	CreditAmount    int        `json:"creditAmount" example:"0"`
+	DiscountPercent int        `json:"discountPercent" example:"20"`
+	PackageID       string     `json:"packageId,omitempty"`
+	MaxRedemptions  *int       `json:"maxRedemptions,omitempty" example:"1000"`
+	PerUserLimit    int        `json:"perUserLimit" example:"1"`
+	Redemptions     int        `json:"redemptions" example:"312"`
+	StartsAt        *time.Time `json:"startsAt,omitempty"`
+	ExpiresAt       *time.Time `json:"expiresAt,omitempty"`
+	IsActive        bool       `json:"isActive" example:"true"`
+	CreatedAt       time.Time  `json:"createdAt"`
+}
+
 // ===== ANALYTICS =====
 
 // DashboardStatsResponse aggregated dashboard stats
@@ -169,3 +289,30 @@ type ReportStatsResponse struct {
 	ActionsTaken       int `json:"actionsTaken" example:"50"`
 	DismissedReports   int `json:"dismissedReports" example:"30"`
 }
+
+// RevealFunnelResponse reveal funnel analytics
+// @Description Reveal funnels per milestone and tier for reveals unlocked in the period
+type RevealFunnelResponse struct {
+	From   time.Time           `json:"from" example:"2024-01-01T00:00:00Z"`
+	To     time.Time           `json:"to" example:"2024-03-31T00:00:00Z"`
+	Stages []RevealFunnelStage `json:"stages"`
+}
+
+// RevealFunnelStage funnel for one milestone and tier
+// @Description Unlocked vs viewed, time to view and early-unlock purchase conversion. Counts are per participant: each unlocked reveal counts once for each side of the connection.
+type RevealFunnelStage struct {
+	MilestoneID             string  `json:"milestoneId" example:"550e8400-e29b-41d4-a716-446655440000"`
+	RevealNumber            int     `json:"revealNumber" example:"2"`
+	Title                   string  `json:"title" example:"Values Reveal"`
+	Tier                    string  `json:"tier" example:"plus"`
+	ScheduledDay            *int    `json:"scheduledDay,omitempty" example:"8"`
+	Unlocked                int     `json:"unlocked" example:"120"`
+	Viewed                  int     `json:"viewed" example:"96"`
+	ViewRate                float64 `json:"viewRate" example:"0.8"`
+	AvgTimeToViewSeconds    float64 `json:"avgTimeToViewSeconds" example:"5400"`
+	MedianTimeToViewSeconds float64 `json:"medianTimeToViewSeconds" example:"1800"`
+	ReViews                 int     `json:"reViews" example:"40"`
+	AvgViewDurationSeconds  float64 `json:"avgViewDurationSeconds" example:"38.5"`
+	PurchasedUnlocks        int     `json:"purchasedUnlocks" example:"12"`
+	PurchaseConversionRate  float64 `json:"purchaseConversionRate" example:"0.1"`
+}
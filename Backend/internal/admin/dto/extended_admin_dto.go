@@ -135,6 +135,7 @@ type AdminPaymentOrderResponse struct {
 	Currency          string     `json:"currency" example:"INR"`
 	CreditsToAdd      int        `json:"creditsToAdd" example:"55"`
 	OrderStatus       string     `json:"orderStatus" example:"paid"`
+	AmountRefunded    int        `json:"amountRefunded" example:"0"`
 	FailureReason     string     `json:"failureReason,omitempty"`
 	CreatedAt         time.Time  `json:"createdAt"`
 	PaidAt            *time.Time `json:"paidAt,omitempty"`
@@ -151,9 +152,119 @@ type AdminPaymentListResponse struct {
 }
 
 // RefundPaymentRequest refunds a payment
-// @Description Refund a payment
+// @Description Refund a payment through Razorpay. Omit amount to refund everything still refundable. spentCredits decides what happens when the user has spent credits from the order: reject (default) refuses the refund, write_off refunds anyway and takes back only the credits the user still holds.
 type RefundPaymentRequest struct {
-	Reason string `json:"reason" validate:"required,max=500" example:"Customer request"`
+	Reason       string `json:"reason" validate:"required,max=500" example:"Customer request"`
+	Amount       *int   `json:"amount,omitempty" validate:"omitempty,min=1" example:"4900"`
+	SpentCredits string `json:"spentCredits,omitempty" validate:"omitempty,oneof=reject write_off" example:"reject"`
+}
+
+// AdminRefundResponse payment refund
+// @Description Razorpay refund of a payment order and the credits it reversed
+type AdminRefundResponse struct {
+	ID                string     `json:"id"`
+	OrderID           string     `json:"orderId"`
+	UserID            string     `json:"userId"`
+	RazorpayRefundID  string     `json:"razorpayRefundId,omitempty"`
+	Amount            int        `json:"amount" example:"4900"`
+	Currency          string     `json:"currency" example:"INR"`
+	Status            string     `json:"status" example:"processing"`
+	Source            string     `json:"source" example:"admin"`
+	CreditsReversed   int        `json:"creditsReversed" example:"50"`
+	CreditsWrittenOff int        `json:"creditsWrittenOff" example:"0"`
+	Reason            string     `json:"reason,omitempty"`
+	FailureReason     string     `json:"failureReason,omitempty"`
+	CreatedAt         time.Time  `json:"createdAt"`
+	ProcessedAt       *time.Time `json:"processedAt,omitempty"`
+}
+
+// AdminWebhookEventResponse stored payment webhook delivery
+// @Description Payment webhook delivery; payload is only included when fetching a single event
+type AdminWebhookEventResponse struct {
+	ID               string     `json:"id"`
+	Provider         string     `json:"provider" example:"razorpay"`
+	EventID          string     `json:"eventId,omitempty" example:"evt_NpXrZlrS1WyZ5d"`
+	EventType        string     `json:"eventType" example:"payment.captured"`
+	SignatureValid   bool       `json:"signatureValid" example:"true"`
+	ProcessingStatus string     `json:"processingStatus" example:"processed"`
+	ErrorMessage     string     `json:"errorMessage,omitempty"`
+	Attempts         int        `json:"attempts" example:"1"`
+	Payload          string     `json:"payload,omitempty"`
+	ReceivedAt       time.Time  `json:"receivedAt"`
+	ProcessedAt      *time.Time `json:"processedAt,omitempty"`
+}
+
+// AdminWebhookEventListResponse paginated webhook delivery list
+// @Description Paginated list of payment webhook deliveries
+type AdminWebhookEventListResponse struct {
+	Events     []AdminWebhookEventResponse `json:"events"`
+	Total      int                         `json:"total"`
+	Page       int                         `json:"page"`
+	PageSize   int                         `json:"pageSize"`
+	TotalPages int                         `json:"totalPages"`
+}
+
+// AdminBalanceDriftResponse credit balance that disagrees with the ledger
+// @Description A user whose stored credit balance differs from the sum of their ledger account
+type AdminBalanceDriftResponse struct {
+	ID            string     `json:"id"`
+	UserID        string     `json:"userId"`
+	StoredBalance int        `json:"storedBalance" example:"120"`
+	LedgerBalance int        `json:"ledgerBalance" example:"100"`
+	Difference    int        `json:"difference" example:"20"`
+	DetectedAt    time.Time  `json:"detectedAt"`
+	LastCheckedAt time.Time  `json:"lastCheckedAt"`
+	ResolvedAt    *time.Time `json:"resolvedAt,omitempty"`
+}
+
+// AdminLedgerDriftReportResponse credit ledger drift report
+// @Description Recorded balance drifts, with the outcome of the reconciliation run when one was just performed
+type AdminLedgerDriftReportResponse struct {
+	Drifts          []AdminBalanceDriftResponse `json:"drifts"`
+	Total           int                         `json:"total" example:"1"`
+	UsersChecked    int                         `json:"usersChecked,omitempty" example:"5000"`
+	Detected        int                         `json:"detected,omitempty" example:"1"`
+	Resolved        int                         `json:"resolved,omitempty" example:"0"`
+	LedgerImbalance int                         `json:"ledgerImbalance" example:"0"`
+	CheckedAt       *time.Time                  `json:"checkedAt,omitempty"`
+}
+
+// AdminPaymentDiscrepancyResponse payment order that disagrees with Razorpay
+// @Description A payment order whose state differs from what Razorpay recorded. Amounts are in paise.
+type AdminPaymentDiscrepancyResponse struct {
+	ID                string `json:"id"`
+	OrderID           string `json:"orderId,omitempty"`
+	RazorpayOrderID   string `json:"razorpayOrderId"`
+	RazorpayPaymentID string `json:"razorpayPaymentId,omitempty"`
+	Kind              string `json:"kind" example:"captured_not_fulfilled"`
+	DBStatus          string `json:"dbStatus,omitempty" example:"expired"`
+	GatewayStatus     string `json:"gatewayStatus,omitempty" example:"captured"`
+	DBAmount          int    `json:"dbAmount" example:"4900"`
+	GatewayAmount     int    `json:"gatewayAmount" example:"4900"`
+}
+
+// AdminPaymentReconciliationResponse daily payment reconciliation report
+// @Description One day's payment orders compared with the payments Razorpay recorded. Amounts are in paise.
+type AdminPaymentReconciliationResponse struct {
+	ReportDate            string                            `json:"reportDate" example:"2026-10-18"`
+	WindowStart           time.Time                         `json:"windowStart"`
+	WindowEnd             time.Time                         `json:"windowEnd"`
+	OrdersChecked         int                               `json:"ordersChecked" example:"120"`
+	GatewayPayments       int                               `json:"gatewayPayments" example:"131"`
+	Matched               int                               `json:"matched" example:"119"`
+	DBPaidAmount          int                               `json:"dbPaidAmount" example:"588000"`
+	GatewayCapturedAmount int                               `json:"gatewayCapturedAmount" example:"592900"`
+	Discrepancies         []AdminPaymentDiscrepancyResponse `json:"discrepancies"`
+	GeneratedAt           time.Time                         `json:"generatedAt"`
+}
+
+// AdminOrderSweepResponse pending order sweep outcome
+// @Description Outcome of polling Razorpay for orders still awaiting payment
+type AdminOrderSweepResponse struct {
+	Checked   int `json:"checked" example:"12"`
+	Fulfilled int `json:"fulfilled" example:"1"`
+	Expired   int `json:"expired" example:"4"`
+	Errors    int `json:"errors" example:"0"`
 }
 
 // ===== PHOTO MODERATION =====
@@ -188,6 +299,46 @@ type RejectPhotoRequest struct {
 	Reason string `json:"reason" validate:"required,max=500" example:"Inappropriate content"`
 }
 
+// ===== CHAT MODERATION =====
+
+// AdminHeldMessageResponse chat message held by moderation
+// @Description Chat message awaiting moderation review
+type AdminHeldMessageResponse struct {
+	ID                string                  `json:"id"`
+	ConversationID    string                  `json:"conversationId"`
+	SenderID          string                  `json:"senderId"`
+	SenderName        string                  `json:"senderName"`
+	Body              string                  `json:"body" example:"Call me on 98765 43210"`
+	CreatedAt         time.Time               `json:"createdAt"`
+	ModerationActions []AdminModerationAction `json:"moderationActions"`
+}
+
+// AdminHeldMessageListResponse paginated held message list
+// @Description Paginated list of held chat messages
+type AdminHeldMessageListResponse struct {
+	Messages   []AdminHeldMessageResponse `json:"messages"`
+	Total      int                        `json:"total"`
+	Page       int                        `json:"page"`
+	PageSize   int                        `json:"pageSize"`
+	TotalPages int                        `json:"totalPages"`
+}
+
+// ModerateMessageRequest approves or rejects a held message
+// @Description Decision on a held chat message
+type ModerateMessageRequest struct {
+	Reason string `json:"reason,omitempty" validate:"max=500" example:"Number shared with consent"`
+}
+
+// AdminModerationActionListResponse paginated moderation log
+// @Description Paginated list of chat moderation actions
+type AdminModerationActionListResponse struct {
+	Actions    []AdminModerationAction `json:"actions"`
+	Total      int                     `json:"total"`
+	Page       int                     `json:"page"`
+	PageSize   int                     `json:"pageSize"`
+	TotalPages int                     `json:"totalPages"`
+}
+
 // ===== AUDIT LOGS =====
 
 // AuditLogResponse audit log entry
@@ -213,3 +364,49 @@ type AuditLogListResponse struct {
 	PageSize   int                `json:"pageSize"`
 	TotalPages int                `json:"totalPages"`
 }
+
+// ===== DEVICE RISK =====
+
+// AdminDeviceRiskFlagResponse suspicious device pattern awaiting review
+// @Description Device risk rule match found at login
+type AdminDeviceRiskFlagResponse struct {
+	ID         string     `json:"id"`
+	UserID     string     `json:"userId"`
+	UserName   string     `json:"userName"`
+	DeviceID   string     `json:"deviceId"`
+	Rule       string     `json:"rule" example:"multi_account_device"`
+	Details    string     `json:"details,omitempty" example:"4 accounts on this device: ..."`
+	Status     string     `json:"status" example:"pending"`
+	AdminNotes string     `json:"adminNotes,omitempty"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	ReviewedAt *time.Time `json:"reviewedAt,omitempty"`
+}
+
+// AdminDeviceRiskFlagListResponse paginated device risk flag list
+// @Description Paginated list of device risk flags
+type AdminDeviceRiskFlagListResponse struct {
+	Flags      []AdminDeviceRiskFlagResponse `json:"flags"`
+	Total      int                           `json:"total"`
+	Page       int                           `json:"page"`
+	PageSize   int                           `json:"pageSize"`
+	TotalPages int                           `json:"totalPages"`
+}
+
+// ReviewDeviceRiskFlagRequest closes a device risk flag
+// @Description Review decision on a device risk flag
+type ReviewDeviceRiskFlagRequest struct {
+	Status     string `json:"status" validate:"required,oneof=reviewed action_taken dismissed" example:"dismissed"`
+	AdminNotes string `json:"adminNotes,omitempty" validate:"max=1000" example:"Family sharing one tablet"`
+}
+
+// AdminUserDeviceResponse device a user has logged in on
+// @Description Device registry entry
+type AdminUserDeviceResponse struct {
+	DeviceID          string    `json:"deviceId"`
+	Platform          string    `json:"platform" example:"android"`
+	AttestationStatus string    `json:"attestationStatus" example:"unverified"`
+	LoginCount        int       `json:"loginCount"`
+	OtherAccounts     int       `json:"otherAccounts"` // other users seen on the device
+	FirstSeenAt       time.Time `json:"firstSeenAt"`
+	LastSeenAt        time.Time `json:"lastSeenAt"`
+}
@@ -0,0 +1,161 @@
+// internal/admin/handlers/admin_account_handler.go
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/UnoraApp/be/internal/admin/dto"
+	"github.com/UnoraApp/be/internal/admin/services"
+	"github.com/UnoraApp/be/pkg/response"
+)
+
+// AdminAccountHandler handles admin account HTTP requests
+type AdminAccountHandler struct {
+	accountService *services.AdminAccountService
+}
+
+// NewAdminAccountHandler creates a new admin account handler
+func NewAdminAccountHandler(accountService *services.AdminAccountService) *AdminAccountHandler {
+	return &AdminAccountHandler{
+		accountService: accountService,
+	}
+}
+
+// GetMe godoc
+// @Summary      Get my admin account
+// @Description  Get the account of the admin making the request, with their role
+// @Tags         admin
+// @Produce      json
+// @Security     AdminAPIKey
+// @Success      200 {object} response.APIResponse{data=dto.AdminAccountResponse} "Admin account"
+// @Router       /admin/me [get]
+func (h *AdminAccountHandler) GetMe(c *gin.Context) {
+	admin, err := h.accountService.GetAdmin(c.Request.Context(), c.GetString("adminID"))
+	if err != nil {
+		handleAdminAccountError(c, err)
+		return
+	}
+	response.JSON(c, http.StatusOK, admin)
+}
+
+// RotateMyKey godoc
+// @Summary      Rotate my API key
+// @Description  Replace the API key of the admin making the request. The new key is shown only in this response, and the old one stops working at once.
+// @Tags         admin
+// @Produce      json
+// @Security     AdminAPIKey
+// @Success      200 {object} response.APIResponse{data=dto.AdminAPIKeyResponse} "New API key"
+// @Router       /admin/me/rotate-key [post]
+func (h *AdminAccountHandler) RotateMyKey(c *gin.Context) {
+	result, err := h.accountService.RotateKey(c.Request.Context(), c.GetString("adminID"))
+	if err != nil {
+		handleAdminAccountError(c, err)
+		return
+	}
+	response.JSON(c, http.StatusOK, result)
+}
+
+// ListAdmins godoc
+// @Summary      List admins
+// @Description  Get every admin account
+// @Tags         admin
+// @Produce      json
+// @Security     AdminAPIKey
+// @Success      200 {object} response.APIResponse{data=[]dto.AdminAccountResponse} "Admin accounts"
+// @Router       /admin/admins [get]
+func (h *AdminAccountHandler) ListAdmins(c *gin.Context) {
+	admins, err := h.accountService.ListAdmins(c.Request.Context())
+	if err != nil {
+		response.Error(c, http.StatusInternalServerError, "LIST_FAILED", err.Error())
+		return
+	}
+	response.JSON(c, http.StatusOK, admins)
+}
+
+// CreateAdmin godoc
+// @Summary      Create admin
+// @Description  Create an admin account with a role: support, moderator, finance or superadmin. The API key is shown only in this response; hand it to the admin securely.
+// @Tags         admin
+// @Accept       json
+// @Produce      json
+// @Security     AdminAPIKey
+// @Param        request body dto.CreateAdminRequest true "Admin details"
+// @Success      201 {object} response.APIResponse{data=dto.AdminAPIKeyResponse} "Admin created"
+// @Router       /admin/admins [post]
+func (h *AdminAccountHandler) CreateAdmin(c *gin.Context) {
+	var req dto.CreateAdminRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "INVALID_REQUEST", err.Error())
+		return
+	}
+
+	result, err := h.accountService.CreateAdmin(c.Request.Context(), c.GetString("adminID"), &req)
+	if err != nil {
+		handleAdminAccountError(c, err)
+		return
+	}
+	response.JSON(c, http.StatusCreated, result)
+}
+
+// UpdateAdmin godoc
+// @Summary      Update admin
+// @Description  Change an admin's role, or disable or re-enable their account. Admins cannot change their own.
+// @Tags         admin
+// @Accept       json
+// @Produce      json
+// @Security     AdminAPIKey
+// @Param        adminId path string true "Admin ID"
+// @Param        request body dto.UpdateAdminRequest true "New role or status"
+// @Success      200 {object} response.APIResponse{data=dto.AdminAccountResponse} "Admin updated"
+// @Router       /admin/admins/{adminId} [patch]
+func (h *AdminAccountHandler) UpdateAdmin(c *gin.Context) {
+	var req dto.UpdateAdminRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "INVALID_REQUEST", err.Error())
+		return
+	}
+
+	admin, err := h.accountService.UpdateAdmin(c.Request.Context(), c.GetString("adminID"), c.Param("adminId"), &req)
+	if err != nil {
+		handleAdminAccountError(c, err)
+		return
+	}
+	response.JSON(c, http.StatusOK, admin)
+}
+
+// RotateAdminKey godoc
+// @Summary      Rotate admin API key
+// @Description  Replace an admin's API key, e.g. when it may have leaked. The new key is shown only in this response, and the old one stops working at once.
+// @Tags         admin
+// @Produce      json
+// @Security     AdminAPIKey
+// @Param        adminId path string true "Admin ID"
+// @Success      200 {object} response.APIResponse{data=dto.AdminAPIKeyResponse} "New API key"
+// @Router       /admin/admins/{adminId}/rotate-key [post]
+func (h *AdminAccountHandler) RotateAdminKey(c *gin.Context) {
+	result, err := h.accountService.RotateKey(c.Request.Context(), c.Param("adminId"))
+	if err != nil {
+		handleAdminAccountError(c, err)
+		return
+	}
+	response.JSON(c, http.StatusOK, result)
+}
+
+func handleAdminAccountError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, services.ErrAdminNotFound):
+		response.Error(c, http.StatusNotFound, "NOT_FOUND", err.Error())
+	case errors.Is(err, services.ErrAdminEmailInUse):
+		response.Error(c, http.StatusConflict, "CONFLICT", err.Error())
+	case errors.Is(err, services.ErrCannotChangeOwnRole):
+		response.Error(c, http.StatusForbidden, "FORBIDDEN", err.Error())
+	case errors.Is(err, services.ErrInvalidAdminRole),
+		errors.Is(err, services.ErrInvalidAdminStatus):
+		response.Error(c, http.StatusBadRequest, "INVALID_REQUEST", err.Error())
+	default:
+		response.Error(c, http.StatusBadRequest, "ADMIN_UPDATE_FAILED", err.Error())
+	}
+}
@@ -2,13 +2,18 @@
 package handlers
 
 import (
+	"errors"
+	"fmt"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
 
 	"github.com/UnoraApp/be/internal/admin/dto"
 	"github.com/UnoraApp/be/internal/admin/services"
+	entitlementservices "github.com/UnoraApp/be/internal/entitlement/services"
+	monetizationservices "github.com/UnoraApp/be/internal/monetization/services"
 	"github.com/UnoraApp/be/pkg/response"
 )
 
@@ -55,6 +60,51 @@ func (h *AdminHandler) GetDashboardStats(c *gin.Context) {
 	response.JSON(c, http.StatusOK, stats)
 }
 
+// GetRevealFunnel godoc
+// @Summary      Get reveal funnel analytics
+// @Description  Reveal funnels per milestone and tier for reveals unlocked in the period: unlocked vs viewed, time from unlock to first view, re-views, time spent and early-unlock purchase conversion
+// @Tags         admin
+// @Accept       json
+// @Produce      json
+// @Security     AdminAPIKey
+// @Param        from query string false "Start date (YYYY-MM-DD), defaults to 90 days before to"
+// @Param        to query string false "End date, exclusive (YYYY-MM-DD), defaults to now"
+// @Success      200 {object} response.APIResponse{data=dto.RevealFunnelResponse} "Reveal funnel"
+// @Failure      400 {object} response.APIResponse "Invalid period"
+// @Router       /admin/analytics/reveals [get]
+func (h *AdminHandler) GetRevealFunnel(c *gin.Context) {
+	from, err := parseDateQuery(c, "from")
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "INVALID_REQUEST", err.Error())
+		return
+	}
+	to, err := parseDateQuery(c, "to")
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "INVALID_REQUEST", err.Error())
+		return
+	}
+
+	funnel, err := h.analyticsService.GetRevealFunnel(c.Request.Context(), from, to)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "GET_STATS_FAILED", err.Error())
+		return
+	}
+	response.JSON(c, http.StatusOK, funnel)
+}
+
+// parseDateQuery reads an optional YYYY-MM-DD query parameter
+func parseDateQuery(c *gin.Context, key string) (*time.Time, error) {
+	value := c.Query(key)
+	if value == "" {
+		return nil, nil
+	}
+	t, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return nil, fmt.Errorf("%s must be a date in YYYY-MM-DD format", key)
+	}
+	return &t, nil
+}
+
 // ========== USER MANAGEMENT ==========
 
 // ListUsers godoc
@@ -107,7 +157,7 @@ func (h *AdminHandler) GetUser(c *gin.Context) {
 
 // SuspendUser godoc
 // @Summary      Suspend user
-// @Description  Suspend a user account
+// @Description  Suspend a user account. All their sessions end and further requests get ACCOUNT_SUSPENDED.
 // @Tags         admin
 // @Accept       json
 // @Produce      json
@@ -152,9 +202,56 @@ func (h *AdminHandler) UnsuspendUser(c *gin.Context) {
 	response.JSON(c, http.StatusOK, gin.H{"message": "User unsuspended"})
 }
 
+// ThrottleUser godoc
+// @Summary      Throttle user
+// @Description  Silently throttle a user flagged by safety. Their requests to rate-limited routes (interests, reports, blocks, uploads) get a fraction of the usual limits and are slowed down; they are not told.
+// @Tags         admin
+// @Accept       json
+// @Produce      json
+// @Security     AdminAPIKey
+// @Param        userId path string true "User ID"
+// @Param        request body dto.ThrottleUserRequest true "Throttle details"
+// @Success      200 {object} response.APIResponse{data=dto.ThrottleUserResponse} "User throttled"
+// @Router       /admin/users/{userId}/throttle [post]
+func (h *AdminHandler) ThrottleUser(c *gin.Context) {
+	userID := c.Param("userId")
+
+	var req dto.ThrottleUserRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "INVALID_REQUEST", err.Error())
+		return
+	}
+
+	result, err := h.userMgmtService.ThrottleUser(c.Request.Context(), userID, &req)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "THROTTLE_FAILED", err.Error())
+		return
+	}
+	response.JSON(c, http.StatusOK, result)
+}
+
+// UnthrottleUser godoc
+// @Summary      Unthrottle user
+// @Description  Lift a user's throttle
+// @Tags         admin
+// @Produce      json
+// @Security     AdminAPIKey
+// @Param        userId path string true "User ID"
+// @Success      200 {object} response.APIResponse "User unthrottled"
+// @Router       /admin/users/{userId}/throttle [delete]
+func (h *AdminHandler) UnthrottleUser(c *gin.Context) {
+	userID := c.Param("userId")
+
+	if err := h.userMgmtService.UnthrottleUser(c.Request.Context(), userID); err != nil {
+		response.Error(c, http.StatusInternalServerError, "UNTHROTTLE_FAILED", err.Error())
+		return
+	}
+	response.JSON(c, http.StatusOK, gin.H{"message": "User unthrottled"})
+}
+
 // DeleteUser godoc
 // @Summary      Delete user
-// @Description  Soft delete a user account
+// @Description  Delete a user account at once: their connections end, their sessions are revoked, their photos are purged and their personal data is erased. Payment records and invoices are kept.
 // @Tags         admin
 // @Accept       json
 // @Produce      json
@@ -389,3 +486,195 @@ func (h *AdminHandler) UpdateCreditPackage(c *gin.Context) {
 	}
 	response.JSON(c, http.StatusOK, gin.H{"message": "Package updated"})
 }
+
+// ListSubscriptionPlans godoc
+// @Summary      List subscription plans
+// @Description  Get all Plus/Pro subscription plans
+// @Tags         admin
+// @Accept       json
+// @Produce      json
+// @Security     AdminAPIKey
+// @Param        includeInactive query bool false "Include inactive" default(false)
+// @Success      200 {object} response.APIResponse "Subscription plans"
+// @Router       /admin/subscription-plans [get]
+func (h *AdminHandler) ListSubscriptionPlans(c *gin.Context) {
+	includeInactive := c.Query("includeInactive") == "true"
+
+	plans, err := h.contentMgmtService.ListSubscriptionPlans(c.Request.Context(), includeInactive)
+	if err != nil {
+		response.Error(c, http.StatusInternalServerError, "LIST_FAILED", err.Error())
+		return
+	}
+	response.JSON(c, http.StatusOK, plans)
+}
+
+// UpdateSubscriptionPlan godoc
+// @Summary      Update subscription plan
+// @Description  Update a subscription plan, including the Razorpay plan it bills through
+// @Tags         admin
+// @Accept       json
+// @Produce      json
+// @Security     AdminAPIKey
+// @Param        planId path string true "Plan ID"
+// @Param        request body dto.UpdateSubscriptionPlanRequest true "Plan updates"
+// @Success      200 {object} response.APIResponse "Plan updated"
+// @Router       /admin/subscription-plans/{planId} [patch]
+func (h *AdminHandler) UpdateSubscriptionPlan(c *gin.Context) {
+	planID := c.Param("planId")
+
+	var req dto.UpdateSubscriptionPlanRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "INVALID_REQUEST", err.Error())
+		return
+	}
+
+	if err := h.contentMgmtService.UpdateSubscriptionPlan(c.Request.Context(), planID, &req); err != nil {
+		response.Error(c, http.StatusBadRequest, "UPDATE_FAILED", err.Error())
+		return
+	}
+	response.JSON(c, http.StatusOK, gin.H{"message": "Plan updated"})
+}
+
+// ListTierEntitlements godoc
+// @Summary      List tier entitlements
+// @Description  Get the limits of every subscription tier
+// @Tags         admin
+// @Accept       json
+// @Produce      json
+// @Security     AdminAPIKey
+// @Success      200 {object} response.APIResponse{data=[]dto.TierEntitlementResponse} "Tier entitlements"
+// @Router       /admin/tier-entitlements [get]
+func (h *AdminHandler) ListTierEntitlements(c *gin.Context) {
+	entitlements, err := h.contentMgmtService.ListTierEntitlements(c.Request.Context())
+	if err != nil {
+		response.Error(c, http.StatusInternalServerError, "LIST_FAILED", err.Error())
+		return
+	}
+	response.JSON(c, http.StatusOK, entitlements)
+}
+
+// UpdateTierEntitlement godoc
+// @Summary      Update tier entitlement
+// @Description  Change a tier's limits; every server picks the change up within a minute
+// @Tags         admin
+// @Accept       json
+// @Produce      json
+// @Security     AdminAPIKey
+// @Param        tier path string true "Tier" Enums(free, plus, pro)
+// @Param        request body dto.UpdateTierEntitlementRequest true "Limit updates"
+// @Success      200 {object} response.APIResponse{data=dto.TierEntitlementResponse} "Updated tier entitlement"
+// @Failure      400 {object} response.APIResponse "Invalid limits"
+// @Failure      404 {object} response.APIResponse "Unknown tier"
+// @Router       /admin/tier-entitlements/{tier} [patch]
+func (h *AdminHandler) UpdateTierEntitlement(c *gin.Context) {
+	tier := c.Param("tier")
+
+	var req dto.UpdateTierEntitlementRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "INVALID_REQUEST", err.Error())
+		return
+	}
+
+	entitlement, err := h.contentMgmtService.UpdateTierEntitlement(c.Request.Context(), tier, &req)
+	if err != nil {
+		if errors.Is(err, entitlementservices.ErrUnknownTier) {
+			response.Error(c, http.StatusNotFound, "NOT_FOUND", err.Error())
+			return
+		}
+		response.Error(c, http.StatusBadRequest, "UPDATE_FAILED", err.Error())
+		return
+	}
+	response.JSON(c, http.StatusOK, entitlement)
+}
+
+// ListPromoCodes godoc
+// @Summary      List promo codes
+// @Description  Get promo codes with how often each has been redeemed
+// @Tags         admin
+// @Accept       json
+// @Produce      json
+// @Security     AdminAPIKey
+// @Param        includeInactive query bool false "Include inactive" default(false)
+// @Success      200 {object} response.APIResponse{data=[]dto.PromoCodeResponse} "Promo codes"
+// @Router       /admin/promo-codes [get]
+func (h *AdminHandler) ListPromoCodes(c *gin.Context) {
+	includeInactive := c.Query("includeInactive") == "true"
+
+	promos, err := h.contentMgmtService.ListPromoCodes(c.Request.Context(), includeInactive)
+	if err != nil {
+		response.Error(c, http.StatusInternalServerError, "LIST_FAILED", err.Error())
+		return
+	}
+	response.JSON(c, http.StatusOK, promos)
+}
+
+// CreatePromoCode godoc
+// @Summary      Create promo code
+// @Description  Create a code that grants credits or discounts a credit package, with optional usage caps and validity window
+// @Tags         admin
+// @Accept       json
+// @Produce      json
+// @Security     AdminAPIKey
+// @Param        request body dto.CreatePromoCodeRequest true "Promo code details"
+// @Success      201 {object} response.APIResponse{data=dto.PromoCodeResponse} "Promo code created"
+// @Failure      400 {object} response.APIResponse "Invalid promo code"
+// @Failure      409 {object} response.APIResponse "Code already exists"
+// @Router       /admin/promo-codes [post]
+func (h *AdminHandler) CreatePromoCode(c *gin.Context) {
+	var req dto.CreatePromoCodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "INVALID_REQUEST", err.Error())
+		return
+	}
+
+	promo, err := h.contentMgmtService.CreatePromoCode(c.Request.Context(), &req)
+	if err != nil {
+		switch {
+		case errors.Is(err, monetizationservices.ErrPromoCodeExists):
+			response.Error(c, http.StatusConflict, "CONFLICT", err.Error())
+		case errors.Is(err, monetizationservices.ErrInvalidPromoCode):
+			response.Error(c, http.StatusBadRequest, "INVALID_REQUEST", err.Error())
+		default:
+			response.Error(c, http.StatusInternalServerError, "CREATE_FAILED", err.Error())
+		}
+		return
+	}
+	response.JSON(c, http.StatusCreated, promo)
+}
+
+// UpdatePromoCode godoc
+// @Summary      Update promo code
+// @Description  Change a promo code's limits or validity window, or deactivate it
+// @Tags         admin
+// @Accept       json
+// @Produce      json
+// @Security     AdminAPIKey
+// @Param        promoCodeId path string true "Promo code ID"
+// @Param        request body dto.UpdatePromoCodeRequest true "Promo code updates"
+// @Success      200 {object} response.APIResponse{data=dto.PromoCodeResponse} "Updated promo code"
+// @Failure      400 {object} response.APIResponse "Invalid update"
+// @Failure      404 {object} response.APIResponse "Promo code not found"
+// @Router       /admin/promo-codes/{promoCodeId} [patch]
+func (h *AdminHandler) UpdatePromoCode(c *gin.Context) {
+	promoCodeID := c.Param("promoCodeId")
+
+	var req dto.UpdatePromoCodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "INVALID_REQUEST", err.Error())
+		return
+	}
+
+	promo, err := h.contentMgmtService.UpdatePromoCode(c.Request.Context(), promoCodeID, &req)
+	if err != nil {
+		switch {
+		case errors.Is(err, monetizationservices.ErrPromoCodeNotFound):
+			response.Error(c, http.StatusNotFound, "NOT_FOUND", err.Error())
+		case errors.Is(err, monetizationservices.ErrInvalidPromoCode):
+			response.Error(c, http.StatusBadRequest, "INVALID_REQUEST", err.Error())
+		default:
+			response.Error(c, http.StatusInternalServerError, "UPDATE_FAILED", err.Error())
+		}
+		return
+	}
+	response.JSON(c, http.StatusOK, promo)
+}
@@ -2,13 +2,18 @@
 package handlers
 
 import (
+	"bytes"
+	"errors"
+	"fmt"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
 
 	"github.com/UnoraApp/be/internal/admin/dto"
 	"github.com/UnoraApp/be/internal/admin/services"
+	monetizationservices "github.com/UnoraApp/be/internal/monetization/services"
 	"github.com/UnoraApp/be/pkg/response"
 )
 
@@ -21,6 +26,8 @@ type ExtendedAdminHandler struct {
 	photoService          *services.PhotoModerationService
 	auditService          *services.AuditLogService
 	revealMilestoneService *services.RevealMilestoneService
+	chatModerationService *services.ChatModerationService
+	deviceRiskService     *services.DeviceRiskService
 }
 
 // NewExtendedAdminHandler creates a new extended admin handler
@@ -32,6 +39,8 @@ func NewExtendedAdminHandler(
 	photoService *services.PhotoModerationService,
 	auditService *services.AuditLogService,
 	revealMilestoneService *services.RevealMilestoneService,
+	chatModerationService *services.ChatModerationService,
+	deviceRiskService *services.DeviceRiskService,
 ) *ExtendedAdminHandler {
 	return &ExtendedAdminHandler{
 		serverService:         serverService,
@@ -41,6 +50,8 @@ func NewExtendedAdminHandler(
 		photoService:          photoService,
 		auditService:          auditService,
 		revealMilestoneService: revealMilestoneService,
+		chatModerationService: chatModerationService,
+		deviceRiskService:     deviceRiskService,
 	}
 }
 
@@ -303,12 +314,15 @@ func (h *ExtendedAdminHandler) ListPaymentOrders(c *gin.Context) {
 
 // RefundPayment godoc
 // @Summary      Refund payment
-// @Description  Mark payment as refunded and deduct credits
+// @Description  Refund a paid order, fully or partially, through Razorpay and reverse the purchased credits. The refund stays processing until Razorpay confirms it.
 // @Tags         admin
 // @Security     AdminAPIKey
 // @Param        orderId path string true "Order ID"
-// @Param        request body dto.RefundPaymentRequest true "Refund reason"
-// @Success      200 {object} response.APIResponse "Payment refunded"
+// @Param        request body dto.RefundPaymentRequest true "Refund details"
+// @Success      200 {object} response.APIResponse{data=dto.AdminRefundResponse} "Refund issued"
+// @Failure      404 {object} response.APIResponse "Order not found"
+// @Failure      409 {object} response.APIResponse "User has spent credits from the order"
+// @Failure      502 {object} response.APIResponse "Razorpay rejected the refund"
 // @Router       /admin/payments/{orderId}/refund [post]
 func (h *ExtendedAdminHandler) RefundPayment(c *gin.Context) {
 	orderID := c.Param("orderId")
@@ -317,11 +331,229 @@ func (h *ExtendedAdminHandler) RefundPayment(c *gin.Context) {
 		response.Error(c, http.StatusBadRequest, "INVALID_REQUEST", err.Error())
 		return
 	}
-	if err := h.paymentService.RefundPayment(c.Request.Context(), orderID, &req); err != nil {
-		response.Error(c, http.StatusBadRequest, "REFUND_FAILED", err.Error())
+	refund, err := h.paymentService.RefundPayment(c.Request.Context(), orderID, &req)
+	if err != nil {
+		switch {
+		case errors.Is(err, monetizationservices.ErrOrderNotFound):
+			response.Error(c, http.StatusNotFound, "NOT_FOUND", err.Error())
+		case errors.Is(err, monetizationservices.ErrCreditsAlreadySpent):
+			response.Error(c, http.StatusConflict, "CREDITS_SPENT", err.Error())
+		case errors.Is(err, monetizationservices.ErrRefundRejected):
+			response.Error(c, http.StatusBadGateway, "REFUND_REJECTED", err.Error())
+		default:
+			response.Error(c, http.StatusBadRequest, "REFUND_FAILED", err.Error())
+		}
+		return
+	}
+	response.JSON(c, http.StatusOK, refund)
+}
+
+// ListOrderRefunds godoc
+// @Summary      List order refunds
+// @Description  Get the refunds issued against a payment order, newest first
+// @Tags         admin
+// @Security     AdminAPIKey
+// @Param        orderId path string true "Order ID"
+// @Success      200 {object} response.APIResponse{data=[]dto.AdminRefundResponse} "Refunds"
+// @Failure      404 {object} response.APIResponse "Order not found"
+// @Router       /admin/payments/{orderId}/refunds [get]
+func (h *ExtendedAdminHandler) ListOrderRefunds(c *gin.Context) {
+	refunds, err := h.paymentService.ListOrderRefunds(c.Request.Context(), c.Param("orderId"))
+	if err != nil {
+		if errors.Is(err, monetizationservices.ErrOrderNotFound) {
+			response.Error(c, http.StatusNotFound, "NOT_FOUND", err.Error())
+			return
+		}
+		response.Error(c, http.StatusInternalServerError, "LIST_FAILED", err.Error())
+		return
+	}
+	response.JSON(c, http.StatusOK, refunds)
+}
+
+// ListWebhookEvents godoc
+// @Summary      List payment webhook events
+// @Description  Get paginated list of stored Razorpay webhook deliveries
+// @Tags         admin
+// @Security     AdminAPIKey
+// @Param        page query int false "Page number" default(1)
+// @Param        pageSize query int false "Page size" default(20)
+// @Param        status query string false "Processing status filter (received, processed, ignored, failed, rejected)"
+// @Param        eventType query string false "Event type filter, e.g. payment.captured"
+// @Success      200 {object} response.APIResponse{data=dto.AdminWebhookEventListResponse} "Webhook events"
+// @Router       /admin/webhook-events [get]
+func (h *ExtendedAdminHandler) ListWebhookEvents(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("pageSize", "20"))
+	events, err := h.paymentService.ListWebhookEvents(c.Request.Context(), page, pageSize, c.Query("status"), c.Query("eventType"))
+	if err != nil {
+		response.Error(c, http.StatusInternalServerError, "LIST_FAILED", err.Error())
+		return
+	}
+	response.JSON(c, http.StatusOK, events)
+}
+
+// GetWebhookEvent godoc
+// @Summary      Get payment webhook event
+// @Description  Get a stored Razorpay webhook delivery including its raw payload
+// @Tags         admin
+// @Security     AdminAPIKey
+// @Param        eventId path string true "Webhook event ID"
+// @Success      200 {object} response.APIResponse{data=dto.AdminWebhookEventResponse} "Webhook event"
+// @Router       /admin/webhook-events/{eventId} [get]
+func (h *ExtendedAdminHandler) GetWebhookEvent(c *gin.Context) {
+	event, err := h.paymentService.GetWebhookEvent(c.Request.Context(), c.Param("eventId"))
+	if err != nil {
+		response.Error(c, http.StatusNotFound, "NOT_FOUND", err.Error())
+		return
+	}
+	response.JSON(c, http.StatusOK, event)
+}
+
+// ReplayWebhookEvent godoc
+// @Summary      Replay payment webhook event
+// @Description  Process a stored, signature-verified Razorpay webhook delivery again. Handlers are idempotent, so replaying a processed event changes nothing.
+// @Tags         admin
+// @Security     AdminAPIKey
+// @Param        eventId path string true "Webhook event ID"
+// @Success      200 {object} response.APIResponse{data=dto.AdminWebhookEventResponse} "Replay outcome"
+// @Router       /admin/webhook-events/{eventId}/replay [post]
+func (h *ExtendedAdminHandler) ReplayWebhookEvent(c *gin.Context) {
+	event, err := h.paymentService.ReplayWebhookEvent(c.Request.Context(), c.Param("eventId"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "REPLAY_FAILED", err.Error())
+		return
+	}
+	response.JSON(c, http.StatusOK, event)
+}
+
+// GetLedgerDrift godoc
+// @Summary      Get credit ledger drift report
+// @Description  List users whose stored credit balance disagrees with their ledger account, as recorded by reconciliation
+// @Tags         admin
+// @Security     AdminAPIKey
+// @Param        includeResolved query bool false "Include drifts that have since been resolved" default(false)
+// @Success      200 {object} response.APIResponse{data=dto.AdminLedgerDriftReportResponse} "Drift report"
+// @Router       /admin/ledger/drift [get]
+func (h *ExtendedAdminHandler) GetLedgerDrift(c *gin.Context) {
+	includeResolved := c.Query("includeResolved") == "true"
+	report, err := h.paymentService.GetLedgerDrift(c.Request.Context(), includeResolved)
+	if err != nil {
+		response.Error(c, http.StatusInternalServerError, "LIST_FAILED", err.Error())
 		return
 	}
-	response.JSON(c, http.StatusOK, gin.H{"message": "Payment refunded"})
+	response.JSON(c, http.StatusOK, report)
+}
+
+// ReconcileLedger godoc
+// @Summary      Reconcile credit ledger
+// @Description  Recompute every credit balance from the ledger now, flag mismatches and resolve drifts that no longer reproduce
+// @Tags         admin
+// @Security     AdminAPIKey
+// @Success      200 {object} response.APIResponse{data=dto.AdminLedgerDriftReportResponse} "Reconciliation report"
+// @Router       /admin/ledger/reconcile [post]
+func (h *ExtendedAdminHandler) ReconcileLedger(c *gin.Context) {
+	report, err := h.paymentService.ReconcileLedger(c.Request.Context())
+	if err != nil {
+		response.Error(c, http.StatusInternalServerError, "RECONCILE_FAILED", err.Error())
+		return
+	}
+	response.JSON(c, http.StatusOK, report)
+}
+
+// GetPaymentReconciliation godoc
+// @Summary      Get payment reconciliation report
+// @Description  Get the daily comparison of payment orders with Razorpay for a date, or the latest report
+// @Tags         admin
+// @Security     AdminAPIKey
+// @Param        date query string false "Report date (YYYY-MM-DD); latest when omitted"
+// @Success      200 {object} response.APIResponse{data=dto.AdminPaymentReconciliationResponse} "Reconciliation report"
+// @Failure      404 {object} response.APIResponse "No report for the date"
+// @Router       /admin/payment-reconciliation/report [get]
+func (h *ExtendedAdminHandler) GetPaymentReconciliation(c *gin.Context) {
+	report, err := h.paymentService.GetPaymentReconciliation(c.Request.Context(), c.Query("date"))
+	if err != nil {
+		if errors.Is(err, monetizationservices.ErrReportNotFound) {
+			response.Error(c, http.StatusNotFound, "NOT_FOUND", err.Error())
+			return
+		}
+		response.Error(c, http.StatusInternalServerError, "GET_FAILED", err.Error())
+		return
+	}
+	response.JSON(c, http.StatusOK, report)
+}
+
+// ReconcilePayments godoc
+// @Summary      Reconcile payments
+// @Description  Compare a day's payment orders with the payments Razorpay recorded now, replacing any earlier report for that day
+// @Tags         admin
+// @Security     AdminAPIKey
+// @Param        date query string false "Day to reconcile (YYYY-MM-DD)" default(yesterday)
+// @Success      200 {object} response.APIResponse{data=dto.AdminPaymentReconciliationResponse} "Reconciliation report"
+// @Router       /admin/payment-reconciliation/reconcile [post]
+func (h *ExtendedAdminHandler) ReconcilePayments(c *gin.Context) {
+	day := time.Now().AddDate(0, 0, -1)
+	if date := c.Query("date"); date != "" {
+		parsed, err := time.ParseInLocation("2006-01-02", date, time.Local)
+		if err != nil {
+			response.Error(c, http.StatusBadRequest, "INVALID_REQUEST", "date must be YYYY-MM-DD")
+			return
+		}
+		day = parsed
+	}
+	report, err := h.paymentService.ReconcilePayments(c.Request.Context(), day)
+	if err != nil {
+		if errors.Is(err, monetizationservices.ErrReportDayNotOver) {
+			response.Error(c, http.StatusBadRequest, "DAY_NOT_OVER", err.Error())
+			return
+		}
+		response.Error(c, http.StatusInternalServerError, "RECONCILE_FAILED", err.Error())
+		return
+	}
+	response.JSON(c, http.StatusOK, report)
+}
+
+// SweepPendingOrders godoc
+// @Summary      Sweep pending payment orders
+// @Description  Poll Razorpay for orders awaiting payment now: fulfil those Razorpay captured and expire those past their expiry
+// @Tags         admin
+// @Security     AdminAPIKey
+// @Success      200 {object} response.APIResponse{data=dto.AdminOrderSweepResponse} "Sweep outcome"
+// @Router       /admin/payment-reconciliation/sweep [post]
+func (h *ExtendedAdminHandler) SweepPendingOrders(c *gin.Context) {
+	result, err := h.paymentService.SweepPendingOrders(c.Request.Context())
+	if err != nil {
+		response.Error(c, http.StatusInternalServerError, "SWEEP_FAILED", err.Error())
+		return
+	}
+	response.JSON(c, http.StatusOK, result)
+}
+
+// ExportInvoices godoc
+// @Summary      Export invoices
+// @Description  Download the GST invoices dated within a range (IST, both dates inclusive) as CSV for accounting. Amounts are in rupees.
+// @Tags         admin
+// @Produce      text/csv
+// @Security     AdminAPIKey
+// @Param        from query string true "First invoice date (YYYY-MM-DD)"
+// @Param        to query string true "Last invoice date (YYYY-MM-DD)"
+// @Success      200 {file} file "Invoices CSV"
+// @Failure      400 {object} response.APIResponse "Invalid date range"
+// @Router       /admin/invoices/export [get]
+func (h *ExtendedAdminHandler) ExportInvoices(c *gin.Context) {
+	from, to := c.Query("from"), c.Query("to")
+
+	var buf bytes.Buffer
+	if err := h.paymentService.ExportInvoices(c.Request.Context(), from, to, &buf); err != nil {
+		if errors.Is(err, monetizationservices.ErrInvalidInvoiceDateRange) {
+			response.Error(c, http.StatusBadRequest, "INVALID_REQUEST", err.Error())
+			return
+		}
+		response.Error(c, http.StatusInternalServerError, "EXPORT_FAILED", err.Error())
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="invoices_%s_%s.csv"`, from, to))
+	c.Data(http.StatusOK, "text/csv; charset=utf-8", buf.Bytes())
 }
 
 // ========== PHOTO MODERATION ==========
@@ -488,6 +720,158 @@ func (h *ExtendedAdminHandler) DeleteRevealMilestone(c *gin.Context) {
 	response.JSON(c, http.StatusOK, gin.H{"message": "Milestone deleted"})
 }
 
+// ========== CHAT MODERATION ==========
+
+// ListHeldMessages godoc
+// @Summary      List held messages
+// @Description  Get chat messages held by moderation, oldest first
+// @Tags         admin
+// @Security     AdminAPIKey
+// @Param        page query int false "Page number" default(1)
+// @Param        pageSize query int false "Page size" default(20)
+// @Success      200 {object} response.APIResponse{data=dto.AdminHeldMessageListResponse} "Held messages"
+// @Router       /admin/messages/held [get]
+func (h *ExtendedAdminHandler) ListHeldMessages(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("pageSize", "20"))
+	messages, err := h.chatModerationService.ListHeldMessages(c.Request.Context(), page, pageSize)
+	if err != nil {
+		response.Error(c, http.StatusInternalServerError, "LIST_FAILED", err.Error())
+		return
+	}
+	response.JSON(c, http.StatusOK, messages)
+}
+
+// ApproveMessage godoc
+// @Summary      Approve held message
+// @Description  Deliver a message held by moderation
+// @Tags         admin
+// @Security     AdminAPIKey
+// @Param        messageId path string true "Message ID"
+// @Param        request body dto.ModerateMessageRequest false "Decision note"
+// @Success      200 {object} response.APIResponse "Message approved"
+// @Router       /admin/messages/{messageId}/approve [post]
+func (h *ExtendedAdminHandler) ApproveMessage(c *gin.Context) {
+	messageID := c.Param("messageId")
+	var req dto.ModerateMessageRequest
+	_ = c.ShouldBindJSON(&req)
+	if err := h.chatModerationService.ApproveMessage(c.Request.Context(), messageID, &req); err != nil {
+		response.Error(c, http.StatusBadRequest, "APPROVE_FAILED", err.Error())
+		return
+	}
+	response.JSON(c, http.StatusOK, gin.H{"message": "Message approved"})
+}
+
+// RejectMessage godoc
+// @Summary      Reject held message
+// @Description  Keep a held message from ever being delivered
+// @Tags         admin
+// @Security     AdminAPIKey
+// @Param        messageId path string true "Message ID"
+// @Param        request body dto.ModerateMessageRequest false "Decision note"
+// @Success      200 {object} response.APIResponse "Message rejected"
+// @Router       /admin/messages/{messageId}/reject [post]
+func (h *ExtendedAdminHandler) RejectMessage(c *gin.Context) {
+	messageID := c.Param("messageId")
+	var req dto.ModerateMessageRequest
+	_ = c.ShouldBindJSON(&req)
+	if err := h.chatModerationService.RejectMessage(c.Request.Context(), messageID, &req); err != nil {
+		response.Error(c, http.StatusBadRequest, "REJECT_FAILED", err.Error())
+		return
+	}
+	response.JSON(c, http.StatusOK, gin.H{"message": "Message rejected"})
+}
+
+// ListModerationActions godoc
+// @Summary      List moderation actions
+// @Description  Get the chat moderation log
+// @Tags         admin
+// @Security     AdminAPIKey
+// @Param        page query int false "Page number" default(1)
+// @Param        pageSize query int false "Page size" default(20)
+// @Param        userId query string false "User filter"
+// @Param        action query string false "Action filter (flagged, held, rejected, rate_limited, approved)"
+// @Success      200 {object} response.APIResponse{data=dto.AdminModerationActionListResponse} "Moderation actions"
+// @Router       /admin/moderation-actions [get]
+func (h *ExtendedAdminHandler) ListModerationActions(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("pageSize", "20"))
+	userID := c.Query("userId")
+	action := c.Query("action")
+	actions, err := h.chatModerationService.ListModerationActions(c.Request.Context(), page, pageSize, userID, action)
+	if err != nil {
+		response.Error(c, http.StatusInternalServerError, "LIST_FAILED", err.Error())
+		return
+	}
+	response.JSON(c, http.StatusOK, actions)
+}
+
+// ========== DEVICE RISK ==========
+
+// ListDeviceRiskFlags godoc
+// @Summary      List device risk flags
+// @Description  Get suspicious device patterns flagged at login (many accounts on one device, rapid device churn, devices shared with suspended accounts), oldest first. Each flag is also a device_risk report in /admin/reports. Device IDs are client-reported and not attested.
+// @Tags         admin
+// @Security     AdminAPIKey
+// @Param        page query int false "Page number" default(1)
+// @Param        pageSize query int false "Page size" default(20)
+// @Param        status query string false "Status filter (pending, reviewed, action_taken, dismissed)"
+// @Param        rule query string false "Rule filter (multi_account_device, device_churn, suspended_account_device)"
+// @Success      200 {object} response.APIResponse{data=dto.AdminDeviceRiskFlagListResponse} "Device risk flags"
+// @Router       /admin/device-risk-flags [get]
+func (h *ExtendedAdminHandler) ListDeviceRiskFlags(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("pageSize", "20"))
+	status := c.Query("status")
+	rule := c.Query("rule")
+	flags, err := h.deviceRiskService.ListRiskFlags(c.Request.Context(), page, pageSize, status, rule)
+	if err != nil {
+		response.Error(c, http.StatusInternalServerError, "LIST_FAILED", err.Error())
+		return
+	}
+	response.JSON(c, http.StatusOK, flags)
+}
+
+// ReviewDeviceRiskFlag godoc
+// @Summary      Review device risk flag
+// @Description  Close a device risk flag and its report. Suspend or delete the account through the user endpoints.
+// @Tags         admin
+// @Security     AdminAPIKey
+// @Param        flagId path string true "Flag ID"
+// @Param        request body dto.ReviewDeviceRiskFlagRequest true "Review decision"
+// @Success      200 {object} response.APIResponse "Flag reviewed"
+// @Router       /admin/device-risk-flags/{flagId}/review [post]
+func (h *ExtendedAdminHandler) ReviewDeviceRiskFlag(c *gin.Context) {
+	flagID := c.Param("flagId")
+	var req dto.ReviewDeviceRiskFlagRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "INVALID_REQUEST", err.Error())
+		return
+	}
+	if err := h.deviceRiskService.ReviewRiskFlag(c.Request.Context(), flagID, &req); err != nil {
+		response.Error(c, http.StatusBadRequest, "REVIEW_FAILED", err.Error())
+		return
+	}
+	response.JSON(c, http.StatusOK, gin.H{"message": "Flag reviewed"})
+}
+
+// ListUserDevices godoc
+// @Summary      List user devices
+// @Description  Get the devices a user has logged in on and how many other accounts used each
+// @Tags         admin
+// @Security     AdminAPIKey
+// @Param        userId path string true "User ID"
+// @Success      200 {object} response.APIResponse{data=[]dto.AdminUserDeviceResponse} "Devices"
+// @Router       /admin/users/{userId}/devices [get]
+func (h *ExtendedAdminHandler) ListUserDevices(c *gin.Context) {
+	devices, err := h.deviceRiskService.ListUserDevices(c.Request.Context(), c.Param("userId"))
+	if err != nil {
+		response.Error(c, http.StatusInternalServerError, "LIST_FAILED", err.Error())
+		return
+	}
+	response.JSON(c, http.StatusOK, devices)
+}
+
 // ========== AUDIT LOGS ==========
 
 // ListAuditLogs godoc
@@ -497,6 +881,7 @@ func (h *ExtendedAdminHandler) DeleteRevealMilestone(c *gin.Context) {
 // @Security     AdminAPIKey
 // @Param        page query int false "Page number" default(1)
 // @Param        pageSize query int false "Page size" default(50)
+// @Param        admin query string false "Admin email filter"
 // @Param        action query string false "Action filter"
 // @Param        resourceType query string false "Resource type filter"
 // @Success      200 {object} response.APIResponse{data=dto.AuditLogListResponse} "Audit logs"
@@ -504,9 +889,10 @@ func (h *ExtendedAdminHandler) DeleteRevealMilestone(c *gin.Context) {
 func (h *ExtendedAdminHandler) ListAuditLogs(c *gin.Context) {
 	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
 	pageSize, _ := strconv.Atoi(c.DefaultQuery("pageSize", "50"))
+	admin := c.Query("admin")
 	action := c.Query("action")
 	resourceType := c.Query("resourceType")
-	logs, err := h.auditService.ListAuditLogs(c.Request.Context(), page, pageSize, action, resourceType)
+	logs, err := h.auditService.ListAuditLogs(c.Request.Context(), page, pageSize, admin, action, resourceType)
 	if err != nil {
 		response.Error(c, http.StatusInternalServerError, "LIST_FAILED", err.Error())
 		return
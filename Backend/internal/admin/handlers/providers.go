@@ -6,4 +6,5 @@ import "github.com/google/wire"
 // ProviderSet is the wire provider set for admin handlers
 var ProviderSet = wire.NewSet(
 	NewAdminHandler,
+	NewAdminAccountHandler,
 )
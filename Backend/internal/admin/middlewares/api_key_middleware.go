@@ -2,10 +2,11 @@
 package middlewares
 
 import (
-	"os"
+	"errors"
 
 	"github.com/gin-gonic/gin"
 
+	"github.com/UnoraApp/be/internal/admin/services"
 	"github.com/UnoraApp/be/pkg/apperror"
 )
 
@@ -14,17 +15,10 @@ const (
 	AdminAPIKeyHeader = "X-Admin-API-Key"
 )
 
-// AdminAPIKeyMiddleware validates the admin API key
-func AdminAPIKeyMiddleware() gin.HandlerFunc {
-	adminAPIKey := os.Getenv("ADMIN_API_KEY")
-
+// AdminAPIKeyMiddleware authenticates the admin by their own API key and
+// sets adminID, adminEmail and adminRole in the context
+func AdminAPIKeyMiddleware(accountService *services.AdminAccountService) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		// Check if admin key is configured
-		if adminAPIKey == "" {
-			apperror.AbortWithError(c, apperror.Unavailable("Admin API is not configured"))
-			return
-		}
-
 		// Get API key from header
 		providedKey := c.GetHeader(AdminAPIKeyHeader)
 		if providedKey == "" {
@@ -33,13 +27,21 @@ func AdminAPIKeyMiddleware() gin.HandlerFunc {
 		}
 
 		// Validate API key
-		if providedKey != adminAPIKey {
+		admin, err := accountService.Authenticate(c.Request.Context(), providedKey)
+		if errors.Is(err, services.ErrInvalidAdminAPIKey) {
 			apperror.AbortWithError(c, apperror.Forbidden("Invalid admin API key"))
 			return
 		}
+		if err != nil {
+			apperror.AbortWithError(c, apperror.InternalError(err))
+			return
+		}
 
 		// Set admin context
 		c.Set("isAdmin", true)
+		c.Set("adminID", admin.ID)
+		c.Set("adminEmail", admin.Email)
+		c.Set("adminRole", admin.Role)
 		c.Next()
 	}
 }
@@ -0,0 +1,154 @@
+// internal/admin/middlewares/audit_middleware.go
+package middlewares
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"unicode"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/UnoraApp/be/internal/admin/services"
+	"github.com/UnoraApp/be/pkg/logger"
+	"github.com/UnoraApp/be/pkg/response"
+)
+
+const (
+	// maxAuditedBodySize is the largest request body kept in an audit log
+	maxAuditedBodySize = 16 << 10
+	// maxAuditedErrorSize is the most of an error response read for its
+	// message
+	maxAuditedErrorSize = 4 << 10
+)
+
+// AuditLogMiddleware records every change an admin makes, successful or
+// not, including ones their role does not allow, under the admin's email.
+// The action is named after the handler, e.g. suspend_user, and the
+// resource after the path, e.g. user. It runs after AdminAPIKeyMiddleware.
+func AuditLogMiddleware(auditService *services.AuditLogService) gin.HandlerFunc {
+	log := logger.GetLogger("admin")
+
+	return func(c *gin.Context) {
+		if c.Request.Method == http.MethodGet || c.Request.Method == http.MethodHead || c.Request.Method == http.MethodOptions {
+			c.Next()
+			return
+		}
+
+		// Keep the body for the log and put it back for the handler
+		var body interface{}
+		if c.Request.Body != nil {
+			data, err := io.ReadAll(io.LimitReader(c.Request.Body, maxAuditedBodySize+1))
+			if err == nil {
+				c.Request.Body = io.NopCloser(io.MultiReader(bytes.NewReader(data), c.Request.Body))
+				if len(data) <= maxAuditedBodySize && json.Valid(data) {
+					body = json.RawMessage(data)
+				}
+			}
+		}
+
+		recorder := &errorRecorder{ResponseWriter: c.Writer}
+		c.Writer = recorder
+
+		c.Next()
+
+		var resourceID *string
+		if len(c.Params) > 0 {
+			id := c.Params[0].Value
+			if len(id) > 36 {
+				id = id[:36]
+			}
+			resourceID = &id
+		}
+
+		success := c.Writer.Status() < http.StatusBadRequest
+		errorMsg := ""
+		if !success {
+			errorMsg = recorder.message()
+		}
+
+		err := auditService.LogAction(
+			c.Request.Context(),
+			c.GetString("adminEmail"),
+			auditAction(c.HandlerName()),
+			auditResourceType(c.FullPath()),
+			resourceID,
+			body,
+			success,
+			errorMsg,
+			c.ClientIP(),
+			truncate(c.Request.UserAgent(), 255),
+		)
+		if err != nil {
+			log.Error().Err(err).Str("admin", c.GetString("adminEmail")).Str("path", c.FullPath()).Msg("Failed to write audit log")
+		}
+	}
+}
+
+// errorRecorder keeps the start of an error response to log its message
+type errorRecorder struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *errorRecorder) Write(data []byte) (int, error) {
+	if w.Status() >= http.StatusBadRequest && w.body.Len() < maxAuditedErrorSize {
+		w.body.Write(data[:min(len(data), maxAuditedErrorSize-w.body.Len())])
+	}
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *errorRecorder) WriteString(s string) (int, error) {
+	return w.Write([]byte(s))
+}
+
+func (w *errorRecorder) message() string {
+	var resp response.APIResponse
+	if err := json.Unmarshal(w.body.Bytes(), &resp); err == nil && resp.Error != nil {
+		return resp.Error.Message
+	}
+	return http.StatusText(w.Status())
+}
+
+// auditAction turns a handler name such as
+// ".../handlers.(*AdminHandler).SuspendUser-fm" into suspend_user
+func auditAction(handlerName string) string {
+	name := handlerName[strings.LastIndex(handlerName, ".")+1:]
+	name = strings.TrimSuffix(name, "-fm")
+
+	var b strings.Builder
+	for i, r := range name {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return truncate(b.String(), 50)
+}
+
+// auditResourceType turns a route such as /api/v1/admin/hobby-options/:id
+// into hobby_option
+func auditResourceType(fullPath string) string {
+	_, rest, _ := strings.Cut(fullPath, "/admin/")
+	segment, _, _ := strings.Cut(rest, "/")
+	if segment == "me" {
+		return "admin"
+	}
+	segment = strings.ReplaceAll(segment, "-", "_")
+	if strings.HasSuffix(segment, "s") && !strings.HasSuffix(segment, "ss") {
+		segment = segment[:len(segment)-1]
+	}
+	return truncate(segment, 50)
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n]
+}
@@ -0,0 +1,25 @@
+// internal/admin/middlewares/role_middleware.go
+package middlewares
+
+import (
+	"slices"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/UnoraApp/be/ent/generated/adminuser"
+	"github.com/UnoraApp/be/pkg/apperror"
+)
+
+// RequireRole lets through admins with one of the roles, and superadmins,
+// who may do everything. It runs after AdminAPIKeyMiddleware.
+func RequireRole(roles ...adminuser.Role) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		role, _ := c.Get("adminRole")
+		r, _ := role.(adminuser.Role)
+		if r != adminuser.RoleSuperadmin && !slices.Contains(roles, r) {
+			apperror.AbortWithError(c, apperror.Forbidden("Your admin role does not allow this"))
+			return
+		}
+		c.Next()
+	}
+}
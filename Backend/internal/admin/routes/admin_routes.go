@@ -5,28 +5,50 @@ import (
 	"github.com/gin-gonic/gin"
 
 	ent "github.com/UnoraApp/be/ent/generated"
+	"github.com/UnoraApp/be/ent/generated/adminuser"
+	accountservices "github.com/UnoraApp/be/internal/account/services"
 	"github.com/UnoraApp/be/internal/admin/handlers"
 	"github.com/UnoraApp/be/internal/admin/middlewares"
 	"github.com/UnoraApp/be/internal/admin/services"
+	authservices "github.com/UnoraApp/be/internal/auth/services"
+	chatservices "github.com/UnoraApp/be/internal/chat/services"
+	entitlementservices "github.com/UnoraApp/be/internal/entitlement/services"
+	monetizationservices "github.com/UnoraApp/be/internal/monetization/services"
+	"github.com/UnoraApp/be/pkg/metrics"
+	"github.com/UnoraApp/be/pkg/ratelimit"
 )
 
 // RegisterAdminRoutes registers all admin routes
 func RegisterAdminRoutes(
 	router *gin.RouterGroup,
 	entClient *ent.Client,
+	authService *authservices.AuthService,
+	chatService *chatservices.ChatService,
+	creditsService *monetizationservices.CreditsService,
+	refundService *monetizationservices.RefundService,
+	webhookService *monetizationservices.WebhookService,
+	reconciliationService *monetizationservices.ReconciliationService,
+	paymentReconciliationService *monetizationservices.PaymentReconciliationService,
+	promoService *monetizationservices.PromoService,
+	invoiceService *monetizationservices.InvoiceService,
+	entitlementService *entitlementservices.EntitlementService,
+	deletionService *accountservices.DeletionService,
+	limiter *ratelimit.Limiter,
 ) {
 	// Create services
-	userMgmtService := services.NewUserManagementService(entClient)
+	userMgmtService := services.NewUserManagementService(entClient, creditsService, authService, deletionService, chatService, limiter)
 	reportMgmtService := services.NewReportManagementService(entClient, userMgmtService)
-	analyticsService := services.NewAnalyticsService(entClient)
-	contentMgmtService := services.NewContentManagementService(entClient)
+	analyticsService := services.NewAnalyticsService(entClient, entitlementService)
+	contentMgmtService := services.NewContentManagementService(entClient, entitlementService, promoService)
 	serverService := services.NewServerManagementService(entClient)
-	connectionService := services.NewConnectionManagementService(entClient)
+	connectionService := services.NewConnectionManagementService(entClient, chatService)
 	streakService := services.NewStreakManagementService(entClient)
-	paymentService := services.NewPaymentManagementService(entClient)
+	paymentService := services.NewPaymentManagementService(entClient, refundService, webhookService, reconciliationService, paymentReconciliationService, invoiceService)
 	photoService := services.NewPhotoModerationService(entClient)
 	auditService := services.NewAuditLogService(entClient)
 	revealMilestoneService := services.NewRevealMilestoneService(entClient)
+	chatModerationService := services.NewChatModerationService(entClient, chatService)
+	deviceRiskService := services.NewDeviceRiskService(entClient)
 
 	// Create handlers
 	handler := handlers.NewAdminHandler(
@@ -43,72 +65,132 @@ func RegisterAdminRoutes(
 		photoService,
 		auditService,
 		revealMilestoneService,
+		chatModerationService,
+		deviceRiskService,
 	)
 
-	// Admin routes with API key authentication
+	accountService := services.NewAdminAccountService(entClient)
+	accountHandler := handlers.NewAdminAccountHandler(accountService)
+
+	// Route permissions by admin role; superadmins may do everything.
+	// Support handles users' account problems, moderators safety and
+	// content review, and finance payments and credits.
+	anyRole := middlewares.RequireRole(adminuser.RoleSupport, adminuser.RoleModerator, adminuser.RoleFinance)
+	support := middlewares.RequireRole(adminuser.RoleSupport)
+	supportOrModerator := middlewares.RequireRole(adminuser.RoleSupport, adminuser.RoleModerator)
+	moderator := middlewares.RequireRole(adminuser.RoleModerator)
+	finance := middlewares.RequireRole(adminuser.RoleFinance)
+	superadmin := middlewares.RequireRole()
+
+	// Admin routes, authenticated by each admin's API key; every change is
+	// audit-logged under the admin
 	admin := router.Group("/admin")
-	admin.Use(middlewares.AdminAPIKeyMiddleware())
+	admin.Use(middlewares.AdminAPIKeyMiddleware(accountService), middlewares.AuditLogMiddleware(auditService))
 	{
+		// Own account
+		admin.GET("/me", anyRole, accountHandler.GetMe)
+		admin.POST("/me/rotate-key", anyRole, accountHandler.RotateMyKey)
+
+		// Admin accounts
+		admin.GET("/admins", superadmin, accountHandler.ListAdmins)
+		admin.POST("/admins", superadmin, accountHandler.CreateAdmin)
+		admin.PATCH("/admins/:adminId", superadmin, accountHandler.UpdateAdmin)
+		admin.POST("/admins/:adminId/rotate-key", superadmin, accountHandler.RotateAdminKey)
+
 		// Dashboard
-		admin.GET("/dashboard", handler.GetDashboardStats)
+		admin.GET("/dashboard", anyRole, handler.GetDashboardStats)
+		admin.GET("/analytics/reveals", anyRole, handler.GetRevealFunnel)
+
+		// Counters of this instance, e.g. rate limit rejections
+		admin.GET("/metrics", superadmin, gin.WrapH(metrics.Handler()))
 
 		// User management
-		admin.GET("/users", handler.ListUsers)
-		admin.GET("/users/:userId", handler.GetUser)
-		admin.POST("/users/:userId/suspend", handler.SuspendUser)
-		admin.POST("/users/:userId/unsuspend", handler.UnsuspendUser)
-		admin.DELETE("/users/:userId", handler.DeleteUser)
-		admin.POST("/users/:userId/credits", handler.AdjustCredits)
+		admin.GET("/users", anyRole, handler.ListUsers)
+		admin.GET("/users/:userId", anyRole, handler.GetUser)
+		admin.POST("/users/:userId/suspend", supportOrModerator, handler.SuspendUser)
+		admin.POST("/users/:userId/unsuspend", supportOrModerator, handler.UnsuspendUser)
+		admin.POST("/users/:userId/throttle", moderator, handler.ThrottleUser)
+		admin.DELETE("/users/:userId/throttle", moderator, handler.UnthrottleUser)
+		admin.DELETE("/users/:userId", superadmin, handler.DeleteUser)
+		admin.POST("/users/:userId/credits", finance, handler.AdjustCredits)
 
 		// Report management
-		admin.GET("/reports", handler.ListReports)
-		admin.GET("/reports/:reportId", handler.GetReport)
-		admin.POST("/reports/:reportId/review", handler.ReviewReport)
+		admin.GET("/reports", supportOrModerator, handler.ListReports)
+		admin.GET("/reports/:reportId", supportOrModerator, handler.GetReport)
+		admin.POST("/reports/:reportId/review", moderator, handler.ReviewReport)
 
 		// Content management
-		admin.GET("/hobby-options", handler.ListHobbyOptions)
-		admin.POST("/hobby-options", handler.CreateHobbyOption)
-		admin.DELETE("/hobby-options/:optionId", handler.DeleteHobbyOption)
-		admin.GET("/credit-packages", handler.ListCreditPackages)
-		admin.PATCH("/credit-packages/:packageId", handler.UpdateCreditPackage)
+		admin.GET("/hobby-options", anyRole, handler.ListHobbyOptions)
+		admin.POST("/hobby-options", superadmin, handler.CreateHobbyOption)
+		admin.DELETE("/hobby-options/:optionId", superadmin, handler.DeleteHobbyOption)
+		admin.GET("/credit-packages", anyRole, handler.ListCreditPackages)
+		admin.PATCH("/credit-packages/:packageId", finance, handler.UpdateCreditPackage)
+		admin.GET("/subscription-plans", anyRole, handler.ListSubscriptionPlans)
+		admin.PATCH("/subscription-plans/:planId", finance, handler.UpdateSubscriptionPlan)
+		admin.GET("/tier-entitlements", anyRole, handler.ListTierEntitlements)
+		admin.PATCH("/tier-entitlements/:tier", superadmin, handler.UpdateTierEntitlement)
+		admin.GET("/promo-codes", finance, handler.ListPromoCodes)
+		admin.POST("/promo-codes", finance, handler.CreatePromoCode)
+		admin.PATCH("/promo-codes/:promoCodeId", finance, handler.UpdatePromoCode)
 
 		// ========== EXTENDED ADMIN ROUTES ==========
 
 		// Server management
-		admin.GET("/servers", extHandler.ListServers)
-		admin.POST("/servers", extHandler.CreateServer)
-		admin.PATCH("/servers/:serverId", extHandler.UpdateServer)
-		admin.DELETE("/servers/:serverId", extHandler.DeleteServer)
+		admin.GET("/servers", anyRole, extHandler.ListServers)
+		admin.POST("/servers", superadmin, extHandler.CreateServer)
+		admin.PATCH("/servers/:serverId", superadmin, extHandler.UpdateServer)
+		admin.DELETE("/servers/:serverId", superadmin, extHandler.DeleteServer)
 
 		// Connection management
-		admin.GET("/connections", extHandler.ListConnections)
-		admin.GET("/connections/:connectionId", extHandler.GetConnection)
-		admin.POST("/connections/:connectionId/terminate", extHandler.TerminateConnection)
+		admin.GET("/connections", supportOrModerator, extHandler.ListConnections)
+		admin.GET("/connections/:connectionId", supportOrModerator, extHandler.GetConnection)
+		admin.POST("/connections/:connectionId/terminate", moderator, extHandler.TerminateConnection)
 
 		// Streak management
-		admin.GET("/streaks", extHandler.ListStreaks)
-		admin.GET("/streaks/:streakId", extHandler.GetStreak)
-		admin.POST("/streaks/:streakId/adjust", extHandler.AdjustStreak)
-		admin.POST("/streaks/:streakId/reset", extHandler.ResetStreak)
+		admin.GET("/streaks", support, extHandler.ListStreaks)
+		admin.GET("/streaks/:streakId", support, extHandler.GetStreak)
+		admin.POST("/streaks/:streakId/adjust", support, extHandler.AdjustStreak)
+		admin.POST("/streaks/:streakId/reset", support, extHandler.ResetStreak)
 
 		// Payment management
-		admin.GET("/payments", extHandler.ListPaymentOrders)
-		admin.POST("/payments/:orderId/refund", extHandler.RefundPayment)
+		admin.GET("/payments", finance, extHandler.ListPaymentOrders)
+		admin.POST("/payments/:orderId/refund", finance, extHandler.RefundPayment)
+		admin.GET("/payments/:orderId/refunds", finance, extHandler.ListOrderRefunds)
+		admin.GET("/webhook-events", finance, extHandler.ListWebhookEvents)
+		admin.GET("/webhook-events/:eventId", finance, extHandler.GetWebhookEvent)
+		admin.POST("/webhook-events/:eventId/replay", finance, extHandler.ReplayWebhookEvent)
+		admin.GET("/ledger/drift", finance, extHandler.GetLedgerDrift)
+		admin.POST("/ledger/reconcile", finance, extHandler.ReconcileLedger)
+		admin.GET("/payment-reconciliation/report", finance, extHandler.GetPaymentReconciliation)
+		admin.POST("/payment-reconciliation/reconcile", finance, extHandler.ReconcilePayments)
+		admin.POST("/payment-reconciliation/sweep", finance, extHandler.SweepPendingOrders)
+		admin.GET("/invoices/export", finance, extHandler.ExportInvoices)
 
 		// Photo moderation
-		admin.GET("/photos/pending", extHandler.ListPendingPhotos)
-		admin.GET("/photos/flagged", extHandler.ListFlaggedPhotos)
-		admin.POST("/photos/:photoId/approve", extHandler.ApprovePhoto)
-		admin.POST("/photos/:photoId/reject", extHandler.RejectPhoto)
+		admin.GET("/photos/pending", moderator, extHandler.ListPendingPhotos)
+		admin.GET("/photos/flagged", moderator, extHandler.ListFlaggedPhotos)
+		admin.POST("/photos/:photoId/approve", moderator, extHandler.ApprovePhoto)
+		admin.POST("/photos/:photoId/reject", moderator, extHandler.RejectPhoto)
+
+		// Chat moderation
+		admin.GET("/messages/held", moderator, extHandler.ListHeldMessages)
+		admin.POST("/messages/:messageId/approve", moderator, extHandler.ApproveMessage)
+		admin.POST("/messages/:messageId/reject", moderator, extHandler.RejectMessage)
+		admin.GET("/moderation-actions", moderator, extHandler.ListModerationActions)
+
+		// Device risk review
+		admin.GET("/device-risk-flags", moderator, extHandler.ListDeviceRiskFlags)
+		admin.POST("/device-risk-flags/:flagId/review", moderator, extHandler.ReviewDeviceRiskFlag)
+		admin.GET("/users/:userId/devices", supportOrModerator, extHandler.ListUserDevices)
 
 		// Reveal milestone management
-		admin.GET("/reveal-milestones", extHandler.ListRevealMilestones)
-		admin.POST("/reveal-milestones", extHandler.CreateRevealMilestone)
-		admin.PATCH("/reveal-milestones/:milestoneId", extHandler.UpdateRevealMilestone)
-		admin.DELETE("/reveal-milestones/:milestoneId", extHandler.DeleteRevealMilestone)
+		admin.GET("/reveal-milestones", anyRole, extHandler.ListRevealMilestones)
+		admin.POST("/reveal-milestones", superadmin, extHandler.CreateRevealMilestone)
+		admin.PATCH("/reveal-milestones/:milestoneId", superadmin, extHandler.UpdateRevealMilestone)
+		admin.DELETE("/reveal-milestones/:milestoneId", superadmin, extHandler.DeleteRevealMilestone)
 
 		// Audit logs
-		admin.GET("/audit-logs", extHandler.ListAuditLogs)
-		admin.GET("/audit-logs/:logId", extHandler.GetAuditLog)
+		admin.GET("/audit-logs", superadmin, extHandler.ListAuditLogs)
+		admin.GET("/audit-logs/:logId", superadmin, extHandler.GetAuditLog)
 	}
 }
@@ -0,0 +1,240 @@
+// internal/admin/services/admin_account_service.go
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	ent "github.com/UnoraApp/be/ent/generated"
+	"github.com/UnoraApp/be/ent/generated/adminuser"
+	"github.com/UnoraApp/be/internal/admin/dto"
+)
+
+const (
+	// adminKeyPrefix marks admin API keys, so a leaked one is recognisable
+	adminKeyPrefix = "uadm_"
+	// adminKeyDisplayLength is how much of a key is kept to tell keys apart
+	adminKeyDisplayLength = 10
+	// adminLastUsedInterval limits how often last_used_at is written
+	adminLastUsedInterval = time.Minute
+)
+
+// Admin account errors
+var (
+	ErrAdminNotFound       = errors.New("admin not found")
+	ErrAdminEmailInUse     = errors.New("an admin with this email already exists")
+	ErrInvalidAdminRole    = errors.New("invalid admin role")
+	ErrInvalidAdminStatus  = errors.New("invalid admin status")
+	ErrInvalidAdminAPIKey  = errors.New("invalid admin API key")
+	ErrCannotChangeOwnRole = errors.New("admins cannot change their own role or status")
+)
+
+// AdminAccountService manages admin accounts. Each admin signs requests
+// with their own API key, of which only the SHA-256 is stored; a key is
+// looked up by its hash, so it is never compared directly.
+type AdminAccountService struct {
+	entClient *ent.Client
+}
+
+// NewAdminAccountService creates a new admin account service
+func NewAdminAccountService(entClient *ent.Client) *AdminAccountService {
+	return &AdminAccountService{
+		entClient: entClient,
+	}
+}
+
+// Authenticate returns the active admin with the API key
+func (s *AdminAccountService) Authenticate(ctx context.Context, apiKey string) (*ent.AdminUser, error) {
+	if !strings.HasPrefix(apiKey, adminKeyPrefix) {
+		return nil, ErrInvalidAdminAPIKey
+	}
+
+	a, err := s.entClient.AdminUser.
+		Query().
+		Where(adminuser.APIKeyHashEQ(hashAdminKey(apiKey))).
+		Where(adminuser.AdminStatusEQ(adminuser.AdminStatusActive)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrInvalidAdminAPIKey
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get admin: %w", err)
+	}
+
+	now := time.Now()
+	if a.LastUsedAt == nil || now.Sub(*a.LastUsedAt) > adminLastUsedInterval {
+		_ = s.entClient.AdminUser.UpdateOneID(a.ID).SetLastUsedAt(now).Exec(ctx)
+	}
+	return a, nil
+}
+
+// CreateAdmin creates an admin account and returns its API key. createdBy
+// is empty for an account created from the command line.
+func (s *AdminAccountService) CreateAdmin(ctx context.Context, createdBy string, req *dto.CreateAdminRequest) (*dto.AdminAPIKeyResponse, error) {
+	email := strings.ToLower(strings.TrimSpace(req.Email))
+	name := strings.TrimSpace(req.Name)
+	if email == "" || name == "" {
+		return nil, fmt.Errorf("email and name are required")
+	}
+	role := adminuser.Role(req.Role)
+	if adminuser.RoleValidator(role) != nil {
+		return nil, ErrInvalidAdminRole
+	}
+
+	key, err := generateAdminKey()
+	if err != nil {
+		return nil, err
+	}
+
+	a, err := s.entClient.AdminUser.
+		Create().
+		SetID(uuid.New().String()).
+		SetEmail(email).
+		SetName(name).
+		SetRole(role).
+		SetAPIKeyHash(hashAdminKey(key)).
+		SetAPIKeyPrefix(key[:adminKeyDisplayLength]).
+		SetNillableCreatedBy(strPtr(createdBy)).
+		Save(ctx)
+	if ent.IsConstraintError(err) {
+		return nil, ErrAdminEmailInUse
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create admin: %w", err)
+	}
+
+	return &dto.AdminAPIKeyResponse{
+		Admin:  adminToResponse(a),
+		APIKey: key,
+	}, nil
+}
+
+// ListAdmins returns every admin account
+func (s *AdminAccountService) ListAdmins(ctx context.Context) ([]dto.AdminAccountResponse, error) {
+	admins, err := s.entClient.AdminUser.
+		Query().
+		Order(ent.Asc(adminuser.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get admins: %w", err)
+	}
+
+	result := make([]dto.AdminAccountResponse, len(admins))
+	for i, a := range admins {
+		result[i] = adminToResponse(a)
+	}
+	return result, nil
+}
+
+// GetAdmin returns an admin account
+func (s *AdminAccountService) GetAdmin(ctx context.Context, adminID string) (*dto.AdminAccountResponse, error) {
+	a, err := s.entClient.AdminUser.Get(ctx, adminID)
+	if ent.IsNotFound(err) {
+		return nil, ErrAdminNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get admin: %w", err)
+	}
+
+	resp := adminToResponse(a)
+	return &resp, nil
+}
+
+// UpdateAdmin changes an admin's role or status. Admins cannot change their
+// own, so the last superadmin cannot lock everyone out.
+func (s *AdminAccountService) UpdateAdmin(ctx context.Context, actorID, adminID string, req *dto.UpdateAdminRequest) (*dto.AdminAccountResponse, error) {
+	if actorID == adminID {
+		return nil, ErrCannotChangeOwnRole
+	}
+
+	update := s.entClient.AdminUser.UpdateOneID(adminID)
+	if req.Role != nil {
+		role := adminuser.Role(*req.Role)
+		if adminuser.RoleValidator(role) != nil {
+			return nil, ErrInvalidAdminRole
+		}
+		update = update.SetRole(role)
+	}
+	if req.Status != nil {
+		status := adminuser.AdminStatus(*req.Status)
+		if adminuser.AdminStatusValidator(status) != nil {
+			return nil, ErrInvalidAdminStatus
+		}
+		update = update.SetAdminStatus(status)
+	}
+
+	a, err := update.Save(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrAdminNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to update admin: %w", err)
+	}
+
+	resp := adminToResponse(a)
+	return &resp, nil
+}
+
+// RotateKey replaces an admin's API key; the old one stops working at once
+func (s *AdminAccountService) RotateKey(ctx context.Context, adminID string) (*dto.AdminAPIKeyResponse, error) {
+	key, err := generateAdminKey()
+	if err != nil {
+		return nil, err
+	}
+
+	a, err := s.entClient.AdminUser.
+		UpdateOneID(adminID).
+		SetAPIKeyHash(hashAdminKey(key)).
+		SetAPIKeyPrefix(key[:adminKeyDisplayLength]).
+		SetAPIKeyRotatedAt(time.Now()).
+		Save(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrAdminNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to rotate admin API key: %w", err)
+	}
+
+	return &dto.AdminAPIKeyResponse{
+		Admin:  adminToResponse(a),
+		APIKey: key,
+	}, nil
+}
+
+// generateAdminKey returns a new random API key
+func generateAdminKey() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate admin API key: %w", err)
+	}
+	return adminKeyPrefix + base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func hashAdminKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+func adminToResponse(a *ent.AdminUser) dto.AdminAccountResponse {
+	return dto.AdminAccountResponse{
+		ID:           a.ID,
+		Email:        a.Email,
+		Name:         a.Name,
+		Role:         string(a.Role),
+		Status:       string(a.AdminStatus),
+		APIKeyPrefix: a.APIKeyPrefix,
+		KeyRotatedAt: a.APIKeyRotatedAt,
+		LastUsedAt:   a.LastUsedAt,
+		CreatedBy:    ptrToString(a.CreatedBy),
+		CreatedAt:    a.CreatedAt,
+	}
+}
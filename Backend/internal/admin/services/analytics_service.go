@@ -4,26 +4,33 @@ package services
 import (
 	"context"
 	"fmt"
+	"sort"
 	"time"
 
 	ent "github.com/UnoraApp/be/ent/generated"
 	"github.com/UnoraApp/be/ent/generated/connection"
+	"github.com/UnoraApp/be/ent/generated/credittransaction"
 	"github.com/UnoraApp/be/ent/generated/paymentorder"
+	"github.com/UnoraApp/be/ent/generated/reveal"
+	"github.com/UnoraApp/be/ent/generated/revealview"
 	"github.com/UnoraApp/be/ent/generated/streak"
 	"github.com/UnoraApp/be/ent/generated/user"
 	"github.com/UnoraApp/be/ent/generated/userreport"
 	"github.com/UnoraApp/be/internal/admin/dto"
+	entitlementservices "github.com/UnoraApp/be/internal/entitlement/services"
 )
 
 // AnalyticsService handles admin analytics
 type AnalyticsService struct {
-	entClient *ent.Client
+	entClient          *ent.Client
+	entitlementService *entitlementservices.EntitlementService
 }
 
 // NewAnalyticsService creates a new analytics service
-func NewAnalyticsService(entClient *ent.Client) *AnalyticsService {
+func NewAnalyticsService(entClient *ent.Client, entitlementService *entitlementservices.EntitlementService) *AnalyticsService {
 	return &AnalyticsService{
-		entClient: entClient,
+		entClient:          entClient,
+		entitlementService: entitlementService,
 	}
 }
 
@@ -223,3 +230,231 @@ func (s *AnalyticsService) getReportStats(ctx context.Context) (*dto.ReportStats
 		DismissedReports: dismissedReports,
 	}, nil
 }
+
+// revealFunnelDefaultPeriod is the look-back when no period is given
+const revealFunnelDefaultPeriod = 90 * 24 * time.Hour
+
+// tierOrder sorts funnel stages by tier
+var tierOrder = map[string]int{"free": 0, "plus": 1, "pro": 2}
+
+type funnelKey struct {
+	milestoneID string
+	tier        string
+}
+
+type funnelAccumulator struct {
+	stage       dto.RevealFunnelStage
+	timesToView []float64
+	durations   []float64
+}
+
+// GetRevealFunnel reports reveal funnels for reveals unlocked in [from, to).
+// Each unlocked reveal counts once per participant; the participant's tier is
+// the one recorded on their first view, or their current tier if they haven't
+// viewed it.
+func (s *AnalyticsService) GetRevealFunnel(ctx context.Context, from, to *time.Time) (*dto.RevealFunnelResponse, error) {
+	end := time.Now()
+	if to != nil {
+		end = *to
+	}
+	start := end.Add(-revealFunnelDefaultPeriod)
+	if from != nil {
+		start = *from
+	}
+	if !start.Before(end) {
+		return nil, fmt.Errorf("from must be before to")
+	}
+
+	reveals, err := s.entClient.Reveal.
+		Query().
+		Where(reveal.RevealStatusNEQ(reveal.RevealStatusLocked)).
+		Where(reveal.UnlockedAtGTE(start)).
+		Where(reveal.UnlockedAtLT(end)).
+		WithMilestone().
+		WithConnection().
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reveals: %w", err)
+	}
+
+	result := &dto.RevealFunnelResponse{
+		From:   start,
+		To:     end,
+		Stages: []dto.RevealFunnelStage{},
+	}
+	if len(reveals) == 0 {
+		return result, nil
+	}
+
+	revealIDs := make([]string, len(reveals))
+	userIDSet := make(map[string]struct{})
+	for i, r := range reveals {
+		revealIDs[i] = r.ID
+		userIDSet[r.Edges.Connection.UserAID] = struct{}{}
+		userIDSet[r.Edges.Connection.UserBID] = struct{}{}
+	}
+	userIDs := make([]string, 0, len(userIDSet))
+	for id := range userIDSet {
+		userIDs = append(userIDs, id)
+	}
+
+	// Current tiers, for participants who haven't viewed
+	users, err := s.entClient.User.
+		Query().
+		Where(user.IDIn(userIDs...)).
+		Select(user.FieldID, user.FieldSubscriptionTier).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get users: %w", err)
+	}
+	currentTier := make(map[string]string, len(users))
+	for _, u := range users {
+		currentTier[u.ID] = string(u.SubscriptionTier)
+	}
+
+	// Views per reveal and viewer, oldest first
+	views, err := s.entClient.RevealView.
+		Query().
+		Where(revealview.RevealIDIn(revealIDs...)).
+		Order(ent.Asc(revealview.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reveal views: %w", err)
+	}
+	viewsBy := make(map[string][]*ent.RevealView)
+	for _, v := range views {
+		key := v.RevealID + "/" + v.ViewerUserID
+		viewsBy[key] = append(viewsBy[key], v)
+	}
+
+	// Who paid for early unlocks
+	purchases, err := s.entClient.CreditTransaction.
+		Query().
+		Where(credittransaction.TransactionTypeEQ(credittransaction.TransactionTypeEarlyReveal)).
+		Where(credittransaction.ReferenceTypeEQ("reveal")).
+		Where(credittransaction.ReferenceIDIn(revealIDs...)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reveal purchases: %w", err)
+	}
+	purchasedBy := make(map[string]string, len(purchases))
+	for _, p := range purchases {
+		if p.ReferenceID != nil {
+			purchasedBy[*p.ReferenceID] = p.UserID
+		}
+	}
+
+	tierLimits, err := s.entitlementService.ListLimits(ctx)
+	if err != nil {
+		return nil, err
+	}
+	revealDays := make(map[string][]int, len(tierLimits))
+	for _, l := range tierLimits {
+		revealDays[l.Tier] = l.RevealDays
+	}
+
+	acc := make(map[funnelKey]*funnelAccumulator)
+	for _, r := range reveals {
+		m := r.Edges.Milestone
+		conn := r.Edges.Connection
+		for _, participant := range []string{conn.UserAID, conn.UserBID} {
+			pv := viewsBy[r.ID+"/"+participant]
+
+			tier := currentTier[participant]
+			if len(pv) > 0 {
+				tier = string(pv[0].ViewerTier)
+			}
+
+			key := funnelKey{milestoneID: m.ID, tier: tier}
+			a, ok := acc[key]
+			if !ok {
+				a = &funnelAccumulator{stage: dto.RevealFunnelStage{
+					MilestoneID:  m.ID,
+					RevealNumber: m.RevealNumber,
+					Title:        m.Title,
+					Tier:         tier,
+					ScheduledDay: scheduledRevealDay(revealDays[tier], m.RevealNumber),
+				}}
+				acc[key] = a
+			}
+
+			a.stage.Unlocked++
+			if purchasedBy[r.ID] == participant {
+				a.stage.PurchasedUnlocks++
+			}
+
+			if len(pv) == 0 {
+				continue
+			}
+			a.stage.Viewed++
+			a.stage.ReViews += len(pv) - 1
+			if r.UnlockedAt != nil {
+				wait := pv[0].CreatedAt.Sub(*r.UnlockedAt).Seconds()
+				if wait < 0 {
+					wait = 0
+				}
+				a.timesToView = append(a.timesToView, wait)
+			}
+			for _, v := range pv {
+				if v.DurationSeconds != nil {
+					a.durations = append(a.durations, float64(*v.DurationSeconds))
+				}
+			}
+		}
+	}
+
+	for _, a := range acc {
+		st := a.stage
+		if st.Unlocked > 0 {
+			st.ViewRate = float64(st.Viewed) / float64(st.Unlocked)
+			st.PurchaseConversionRate = float64(st.PurchasedUnlocks) / float64(st.Unlocked)
+		}
+		st.AvgTimeToViewSeconds = mean(a.timesToView)
+		st.MedianTimeToViewSeconds = median(a.timesToView)
+		st.AvgViewDurationSeconds = mean(a.durations)
+		result.Stages = append(result.Stages, st)
+	}
+
+	sort.Slice(result.Stages, func(i, j int) bool {
+		a, b := result.Stages[i], result.Stages[j]
+		if a.RevealNumber != b.RevealNumber {
+			return a.RevealNumber < b.RevealNumber
+		}
+		return tierOrder[a.Tier] < tierOrder[b.Tier]
+	})
+
+	return result, nil
+}
+
+// scheduledRevealDay is the day the tier currently earns this reveal, if it does
+func scheduledRevealDay(days []int, revealNumber int) *int {
+	if revealNumber < 1 || revealNumber > len(days) {
+		return nil
+	}
+	day := days[revealNumber-1]
+	return &day
+}
+
+func mean(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	sum := 0.0
+	for _, v := range values {
+		sum += v
+	}
+	return sum / float64(len(values))
+}
+
+func median(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[mid-1] + sorted[mid]) / 2
+	}
+	return sorted[mid]
+}
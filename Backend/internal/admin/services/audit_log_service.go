@@ -53,7 +53,7 @@ func (s *AuditLogService) LogAction(ctx context.Context, adminIdentifier, action
 }
 
 // ListAuditLogs returns paginated list of audit logs
-func (s *AuditLogService) ListAuditLogs(ctx context.Context, page, pageSize int, action, resourceType string) (*dto.AuditLogListResponse, error) {
+func (s *AuditLogService) ListAuditLogs(ctx context.Context, page, pageSize int, adminIdentifier, action, resourceType string) (*dto.AuditLogListResponse, error) {
 	if page < 1 {
 		page = 1
 	}
@@ -64,6 +64,9 @@ func (s *AuditLogService) ListAuditLogs(ctx context.Context, page, pageSize int,
 
 	query := s.entClient.AuditLog.Query()
 
+	if adminIdentifier != "" {
+		query = query.Where(auditlog.AdminIdentifierEQ(adminIdentifier))
+	}
 	if action != "" {
 		query = query.Where(auditlog.ActionEQ(action))
 	}
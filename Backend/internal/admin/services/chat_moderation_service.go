@@ -0,0 +1,161 @@
+// internal/admin/services/chat_moderation_service.go
+package services
+
+import (
+	"context"
+	"fmt"
+
+	ent "github.com/UnoraApp/be/ent/generated"
+	"github.com/UnoraApp/be/ent/generated/message"
+	"github.com/UnoraApp/be/ent/generated/moderationaction"
+	"github.com/UnoraApp/be/internal/admin/dto"
+	chatservices "github.com/UnoraApp/be/internal/chat/services"
+)
+
+// ChatModerationService handles admin review of held chat messages
+type ChatModerationService struct {
+	entClient   *ent.Client
+	chatService *chatservices.ChatService
+}
+
+// NewChatModerationService creates a new chat moderation service
+func NewChatModerationService(entClient *ent.Client, chatService *chatservices.ChatService) *ChatModerationService {
+	return &ChatModerationService{
+		entClient:   entClient,
+		chatService: chatService,
+	}
+}
+
+// ListHeldMessages returns messages held by moderation, oldest first
+func (s *ChatModerationService) ListHeldMessages(ctx context.Context, page, pageSize int) (*dto.AdminHeldMessageListResponse, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 20
+	}
+	offset := (page - 1) * pageSize
+
+	query := s.entClient.Message.Query().
+		Where(message.ModerationStatusEQ(message.ModerationStatusHeld))
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count messages: %w", err)
+	}
+
+	messages, err := query.
+		Order(ent.Asc(message.FieldCreatedAt)).
+		Offset(offset).
+		Limit(pageSize).
+		WithModerationActions(func(q *ent.ModerationActionQuery) {
+			q.Order(ent.Asc(moderationaction.FieldCreatedAt))
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get messages: %w", err)
+	}
+
+	result := make([]dto.AdminHeldMessageResponse, len(messages))
+	for i, m := range messages {
+		sender, _ := s.entClient.User.Get(ctx, m.SenderUserID)
+		senderName := ""
+		if sender != nil && sender.FirstName != nil {
+			senderName = *sender.FirstName
+		}
+
+		actions := make([]dto.AdminModerationAction, len(m.Edges.ModerationActions))
+		for j, a := range m.Edges.ModerationActions {
+			actions[j] = moderationActionToAdminResponse(a)
+		}
+
+		result[i] = dto.AdminHeldMessageResponse{
+			ID:                m.ID,
+			ConversationID:    m.ConversationID,
+			SenderID:          m.SenderUserID,
+			SenderName:        senderName,
+			Body:              m.Body,
+			CreatedAt:         m.CreatedAt,
+			ModerationActions: actions,
+		}
+	}
+
+	totalPages := (total + pageSize - 1) / pageSize
+	return &dto.AdminHeldMessageListResponse{
+		Messages:   result,
+		Total:      total,
+		Page:       page,
+		PageSize:   pageSize,
+		TotalPages: totalPages,
+	}, nil
+}
+
+// ApproveMessage delivers a held message
+func (s *ChatModerationService) ApproveMessage(ctx context.Context, messageID string, req *dto.ModerateMessageRequest) error {
+	return s.chatService.ApproveHeldMessage(ctx, messageID, req.Reason)
+}
+
+// RejectMessage keeps a held message from ever being delivered
+func (s *ChatModerationService) RejectMessage(ctx context.Context, messageID string, req *dto.ModerateMessageRequest) error {
+	return s.chatService.RejectHeldMessage(ctx, messageID, req.Reason)
+}
+
+// ListModerationActions returns the moderation log, optionally for one user
+func (s *ChatModerationService) ListModerationActions(ctx context.Context, page, pageSize int, userID, action string) (*dto.AdminModerationActionListResponse, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 20
+	}
+	offset := (page - 1) * pageSize
+
+	query := s.entClient.ModerationAction.Query()
+	if userID != "" {
+		query = query.Where(moderationaction.UserIDEQ(userID))
+	}
+	if action != "" {
+		query = query.Where(moderationaction.ActionEQ(moderationaction.Action(action)))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count moderation actions: %w", err)
+	}
+
+	actions, err := query.
+		Order(ent.Desc(moderationaction.FieldCreatedAt)).
+		Offset(offset).
+		Limit(pageSize).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get moderation actions: %w", err)
+	}
+
+	result := make([]dto.AdminModerationAction, len(actions))
+	for i, a := range actions {
+		result[i] = moderationActionToAdminResponse(a)
+	}
+
+	totalPages := (total + pageSize - 1) / pageSize
+	return &dto.AdminModerationActionListResponse{
+		Actions:    result,
+		Total:      total,
+		Page:       page,
+		PageSize:   pageSize,
+		TotalPages: totalPages,
+	}, nil
+}
+
+func moderationActionToAdminResponse(a *ent.ModerationAction) dto.AdminModerationAction {
+	return dto.AdminModerationAction{
+		ID:             a.ID,
+		UserID:         a.UserID,
+		MessageID:      ptrToString(a.MessageID),
+		ConversationID: ptrToString(a.ConversationID),
+		Action:         string(a.Action),
+		Source:         a.Source,
+		Reason:         ptrToString(a.Reason),
+		CreatedAt:      a.CreatedAt,
+	}
+}
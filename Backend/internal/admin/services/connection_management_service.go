@@ -8,18 +8,22 @@ import (
 
 	ent "github.com/UnoraApp/be/ent/generated"
 	"github.com/UnoraApp/be/ent/generated/connection"
+	"github.com/UnoraApp/be/ent/generated/conversation"
 	"github.com/UnoraApp/be/internal/admin/dto"
+	chatservices "github.com/UnoraApp/be/internal/chat/services"
 )
 
 // ConnectionManagementService handles admin connection management
 type ConnectionManagementService struct {
-	entClient *ent.Client
+	entClient   *ent.Client
+	chatService *chatservices.ChatService
 }
 
 // NewConnectionManagementService creates a new connection management service
-func NewConnectionManagementService(entClient *ent.Client) *ConnectionManagementService {
+func NewConnectionManagementService(entClient *ent.Client, chatService *chatservices.ChatService) *ConnectionManagementService {
 	return &ConnectionManagementService{
-		entClient: entClient,
+		entClient:   entClient,
+		chatService: chatService,
 	}
 }
 
@@ -153,5 +157,8 @@ func (s *ConnectionManagementService) TerminateConnection(ctx context.Context, i
 		SetConnectionStatus(connection.ConnectionStatusTerminated).
 		SetTerminatedAt(now).
 		Save(ctx)
-	return err
+	if err != nil {
+		return err
+	}
+	return s.chatService.CloseConversation(ctx, id, conversation.ClosedReasonTerminated)
 }
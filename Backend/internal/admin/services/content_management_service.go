@@ -4,24 +4,32 @@ package services
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 
 	ent "github.com/UnoraApp/be/ent/generated"
 	"github.com/UnoraApp/be/ent/generated/creditpackage"
 	"github.com/UnoraApp/be/ent/generated/hobbyoption"
+	"github.com/UnoraApp/be/ent/generated/subscriptionplan"
 	"github.com/UnoraApp/be/internal/admin/dto"
+	entitlementservices "github.com/UnoraApp/be/internal/entitlement/services"
+	monetizationservices "github.com/UnoraApp/be/internal/monetization/services"
 )
 
 // ContentManagementService handles admin content management
 type ContentManagementService struct {
-	entClient *ent.Client
+	entClient          *ent.Client
+	entitlementService *entitlementservices.EntitlementService
+	promoService       *monetizationservices.PromoService
 }
 
 // NewContentManagementService creates a new content management service
-func NewContentManagementService(entClient *ent.Client) *ContentManagementService {
+func NewContentManagementService(entClient *ent.Client, entitlementService *entitlementservices.EntitlementService, promoService *monetizationservices.PromoService) *ContentManagementService {
 	return &ContentManagementService{
-		entClient: entClient,
+		entClient:          entClient,
+		entitlementService: entitlementService,
+		promoService:       promoService,
 	}
 }
 
@@ -154,3 +162,182 @@ func (s *ContentManagementService) ListCreditPackages(ctx context.Context, inclu
 	}
 	return query.Order(ent.Asc(creditpackage.FieldSortOrder)).All(ctx)
 }
+
+// ListSubscriptionPlans returns all subscription plans
+func (s *ContentManagementService) ListSubscriptionPlans(ctx context.Context, includeInactive bool) ([]*ent.SubscriptionPlan, error) {
+	query := s.entClient.SubscriptionPlan.Query()
+	if !includeInactive {
+		query = query.Where(subscriptionplan.IsActiveEQ(true))
+	}
+	return query.Order(ent.Asc(subscriptionplan.FieldSortOrder)).All(ctx)
+}
+
+// UpdateSubscriptionPlan updates a subscription plan. Price changes only apply
+// to new subscriptions, and must match the linked Razorpay plan.
+func (s *ContentManagementService) UpdateSubscriptionPlan(ctx context.Context, id string, req *dto.UpdateSubscriptionPlanRequest) error {
+	plan, err := s.entClient.SubscriptionPlan.Get(ctx, id)
+	if err != nil {
+		return fmt.Errorf("plan not found: %w", err)
+	}
+
+	update := plan.Update()
+
+	if req.Name != "" {
+		update.SetName(req.Name)
+	}
+	if req.PriceAmount != nil {
+		update.SetPriceAmount(*req.PriceAmount)
+	}
+	if req.RazorpayPlanID != nil {
+		if *req.RazorpayPlanID == "" {
+			update.ClearRazorpayPlanID()
+		} else {
+			update.SetRazorpayPlanID(*req.RazorpayPlanID)
+		}
+	}
+	if req.IsActive != nil {
+		update.SetIsActive(*req.IsActive)
+	}
+	if req.SortOrder != nil {
+		update.SetSortOrder(*req.SortOrder)
+	}
+
+	_, err = update.Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update plan: %w", err)
+	}
+
+	return nil
+}
+
+// ListTierEntitlements returns the limits of every tier
+func (s *ContentManagementService) ListTierEntitlements(ctx context.Context) ([]*dto.TierEntitlementResponse, error) {
+	limits, err := s.entitlementService.ListLimits(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*dto.TierEntitlementResponse, len(limits))
+	for i, l := range limits {
+		result[i] = tierEntitlementToResponse(l)
+	}
+	return result, nil
+}
+
+// UpdateTierEntitlement changes a tier's limits without a deploy
+func (s *ContentManagementService) UpdateTierEntitlement(ctx context.Context, tier string, req *dto.UpdateTierEntitlementRequest) (*dto.TierEntitlementResponse, error) {
+	limits, err := s.entitlementService.UpdateLimits(ctx, tier, &entitlementservices.LimitsUpdate{
+		ConnectionSlots:             req.ConnectionSlots,
+		RefreshCooldownMinutes:      req.RefreshCooldownMinutes,
+		NudgesPerAtRisk:             req.NudgesPerAtRisk,
+		NudgeIntervalMinutes:        req.NudgeIntervalMinutes,
+		FreeRecoveriesPerConnection: req.FreeRecoveriesPerConnection,
+		PurchasableReveals:          req.PurchasableReveals,
+		RevealDays:                  req.RevealDays,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return tierEntitlementToResponse(limits), nil
+}
+
+// ListPromoCodes returns promo codes with their redemption counts
+func (s *ContentManagementService) ListPromoCodes(ctx context.Context, includeInactive bool) ([]*dto.PromoCodeResponse, error) {
+	promos, err := s.promoService.ListPromoCodes(ctx, includeInactive)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*dto.PromoCodeResponse, len(promos))
+	for i, p := range promos {
+		result[i], err = s.promoCodeToResponse(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// CreatePromoCode creates a promo code
+func (s *ContentManagementService) CreatePromoCode(ctx context.Context, req *dto.CreatePromoCodeRequest) (*dto.PromoCodeResponse, error) {
+	promo, err := s.promoService.CreatePromoCode(ctx, &monetizationservices.PromoCodeInput{
+		Code:            req.Code,
+		Description:     req.Description,
+		Kind:            req.Kind,
+		CreditAmount:    req.CreditAmount,
+		DiscountPercent: req.DiscountPercent,
+		PackageID:       req.PackageID,
+		MaxRedemptions:  req.MaxRedemptions,
+		PerUserLimit:    req.PerUserLimit,
+		StartsAt:        req.StartsAt,
+		ExpiresAt:       req.ExpiresAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.promoCodeToResponse(ctx, promo)
+}
+
+// UpdatePromoCode changes a promo code's limits, window or status
+func (s *ContentManagementService) UpdatePromoCode(ctx context.Context, id string, req *dto.UpdatePromoCodeRequest) (*dto.PromoCodeResponse, error) {
+	promo, err := s.promoService.UpdatePromoCode(ctx, id, &monetizationservices.PromoCodeUpdate{
+		Description:    req.Description,
+		MaxRedemptions: req.MaxRedemptions,
+		PerUserLimit:   req.PerUserLimit,
+		StartsAt:       req.StartsAt,
+		ExpiresAt:      req.ExpiresAt,
+		IsActive:       req.IsActive,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.promoCodeToResponse(ctx, promo)
+}
+
+func (s *ContentManagementService) promoCodeToResponse(ctx context.Context, p *ent.PromoCode) (*dto.PromoCodeResponse, error) {
+	redemptions, err := s.promoService.CountRedemptions(ctx, p.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &dto.PromoCodeResponse{
+		ID:              p.ID,
+		Code:            p.Code,
+		Kind:            string(p.Kind),
+		CreditAmount:    p.CreditAmount,
+		DiscountPercent: p.DiscountPercent,
+		MaxRedemptions:  p.MaxRedemptions,
+		PerUserLimit:    p.PerUserLimit,
+		Redemptions:     redemptions,
+		StartsAt:        p.StartsAt,
+		ExpiresAt:       p.ExpiresAt,
+		IsActive:        p.IsActive,
+		CreatedAt:       p.CreatedAt,
+	}
+	if p.Description != nil {
+		resp.Description = *p.Description
+	}
+	if p.PackageID != nil {
+		resp.PackageID = *p.PackageID
+	}
+	return resp, nil
+}
+
+func tierEntitlementToResponse(l entitlementservices.Limits) *dto.TierEntitlementResponse {
+	resp := &dto.TierEntitlementResponse{
+		Tier:                        l.Tier,
+		ConnectionSlots:             l.ConnectionSlots,
+		RefreshCooldownMinutes:      int(l.RefreshCooldown / time.Minute),
+		NudgesPerAtRisk:             l.NudgesPerAtRisk,
+		NudgeIntervalMinutes:        int(l.NudgeInterval / time.Minute),
+		FreeRecoveriesPerConnection: l.FreeRecoveriesPerConnection,
+		PurchasableReveals:          l.PurchasableReveals,
+		RevealDays:                  l.RevealDays,
+	}
+	// Tiers still on built-in defaults have never been saved
+	if !l.UpdatedAt.IsZero() {
+		updatedAt := l.UpdatedAt
+		resp.UpdatedAt = &updatedAt
+	}
+	return resp
+}
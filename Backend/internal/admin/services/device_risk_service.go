@@ -0,0 +1,167 @@
+// internal/admin/services/device_risk_service.go
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	ent "github.com/UnoraApp/be/ent/generated"
+	"github.com/UnoraApp/be/ent/generated/deviceriskflag"
+	"github.com/UnoraApp/be/ent/generated/userdevice"
+	"github.com/UnoraApp/be/ent/generated/userreport"
+	"github.com/UnoraApp/be/internal/admin/dto"
+	authservices "github.com/UnoraApp/be/internal/auth/services"
+	"github.com/UnoraApp/be/pkg/database"
+)
+
+// DeviceRiskService handles admin review of suspicious device patterns
+// flagged at login, and the device registry behind them
+type DeviceRiskService struct {
+	entClient *ent.Client
+}
+
+// NewDeviceRiskService creates a new device risk service
+func NewDeviceRiskService(entClient *ent.Client) *DeviceRiskService {
+	return &DeviceRiskService{
+		entClient: entClient,
+	}
+}
+
+// ListRiskFlags returns device risk flags, oldest first
+func (s *DeviceRiskService) ListRiskFlags(ctx context.Context, page, pageSize int, status, rule string) (*dto.AdminDeviceRiskFlagListResponse, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 20
+	}
+	offset := (page - 1) * pageSize
+
+	query := s.entClient.DeviceRiskFlag.Query()
+	if status != "" {
+		query = query.Where(deviceriskflag.FlagStatusEQ(deviceriskflag.FlagStatus(status)))
+	}
+	if rule != "" {
+		query = query.Where(deviceriskflag.RuleEQ(deviceriskflag.Rule(rule)))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count risk flags: %w", err)
+	}
+
+	flags, err := query.
+		Order(ent.Asc(deviceriskflag.FieldCreatedAt)).
+		Offset(offset).
+		Limit(pageSize).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get risk flags: %w", err)
+	}
+
+	result := make([]dto.AdminDeviceRiskFlagResponse, len(flags))
+	for i, f := range flags {
+		userName := ""
+		if u, err := s.entClient.User.Get(ctx, f.UserID); err == nil {
+			userName = ptrToString(u.Name)
+		}
+		result[i] = dto.AdminDeviceRiskFlagResponse{
+			ID:         f.ID,
+			UserID:     f.UserID,
+			UserName:   userName,
+			DeviceID:   f.DeviceID,
+			Rule:       string(f.Rule),
+			Details:    ptrToString(f.Details),
+			Status:     string(f.FlagStatus),
+			AdminNotes: ptrToString(f.AdminNotes),
+			CreatedAt:  f.CreatedAt,
+			ReviewedAt: f.ReviewedAt,
+		}
+	}
+
+	totalPages := (total + pageSize - 1) / pageSize
+	return &dto.AdminDeviceRiskFlagListResponse{
+		Flags:      result,
+		Total:      total,
+		Page:       page,
+		PageSize:   pageSize,
+		TotalPages: totalPages,
+	}, nil
+}
+
+// ReviewRiskFlag closes a risk flag and its report. Acting on the account is
+// done through the user management endpoints.
+func (s *DeviceRiskService) ReviewRiskFlag(ctx context.Context, flagID string, req *dto.ReviewDeviceRiskFlagRequest) error {
+	status := deviceriskflag.FlagStatus(req.Status)
+	if status == deviceriskflag.FlagStatusPending || deviceriskflag.FlagStatusValidator(status) != nil {
+		return fmt.Errorf("invalid status: %s", req.Status)
+	}
+
+	f, err := s.entClient.DeviceRiskFlag.Get(ctx, flagID)
+	if err != nil {
+		return fmt.Errorf("risk flag not found: %w", err)
+	}
+
+	// The flag's report in the safety review queue is closed with it
+	now := time.Now()
+	return database.WithTx(ctx, s.entClient, func(tx *ent.Tx) error {
+		_, err := tx.DeviceRiskFlag.
+			UpdateOneID(f.ID).
+			SetFlagStatus(status).
+			SetNillableAdminNotes(strPtr(req.AdminNotes)).
+			SetReviewedAt(now).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to update risk flag: %w", err)
+		}
+
+		_, err = tx.UserReport.
+			Update().
+			Where(userreport.ReferenceTypeEQ(authservices.ReferenceTypeDeviceRiskFlag)).
+			Where(userreport.ReferenceIDEQ(f.ID)).
+			SetReportStatus(userreport.ReportStatus(status)).
+			SetNillableAdminNotes(strPtr(req.AdminNotes)).
+			SetReviewedAt(now).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to update risk report: %w", err)
+		}
+		return nil
+	})
+}
+
+// ListUserDevices returns the devices a user has logged in on, most recent
+// first, with how many other accounts each was seen with
+func (s *DeviceRiskService) ListUserDevices(ctx context.Context, userID string) ([]dto.AdminUserDeviceResponse, error) {
+	devices, err := s.entClient.UserDevice.
+		Query().
+		Where(userdevice.UserIDEQ(userID)).
+		Order(ent.Desc(userdevice.FieldLastSeenAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get devices: %w", err)
+	}
+
+	result := make([]dto.AdminUserDeviceResponse, len(devices))
+	for i, d := range devices {
+		others, err := s.entClient.UserDevice.
+			Query().
+			Where(userdevice.DeviceIDEQ(d.DeviceID)).
+			Where(userdevice.UserIDNEQ(userID)).
+			Count(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to count device accounts: %w", err)
+		}
+		result[i] = dto.AdminUserDeviceResponse{
+			DeviceID:          d.DeviceID,
+			Platform:          string(d.Platform),
+			AttestationStatus: string(d.AttestationStatus),
+			LoginCount:        d.LoginCount,
+			OtherAccounts:     others,
+			FirstSeenAt:       d.FirstSeenAt,
+			LastSeenAt:        d.LastSeenAt,
+		}
+	}
+	return result, nil
+}
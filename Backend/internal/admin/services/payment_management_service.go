@@ -4,21 +4,42 @@ package services
 import (
 	"context"
 	"fmt"
+	"io"
+	"time"
 
 	ent "github.com/UnoraApp/be/ent/generated"
 	"github.com/UnoraApp/be/ent/generated/paymentorder"
+	"github.com/UnoraApp/be/ent/generated/webhookevent"
 	"github.com/UnoraApp/be/internal/admin/dto"
+	monetizationservices "github.com/UnoraApp/be/internal/monetization/services"
 )
 
 // PaymentManagementService handles admin payment management
 type PaymentManagementService struct {
-	entClient *ent.Client
+	entClient             *ent.Client
+	refundService         *monetizationservices.RefundService
+	webhookService        *monetizationservices.WebhookService
+	reconciliationService *monetizationservices.ReconciliationService
+	paymentReconciliation *monetizationservices.PaymentReconciliationService
+	invoiceService        *monetizationservices.InvoiceService
 }
 
 // NewPaymentManagementService creates a new payment management service
-func NewPaymentManagementService(entClient *ent.Client) *PaymentManagementService {
+func NewPaymentManagementService(
+	entClient *ent.Client,
+	refundService *monetizationservices.RefundService,
+	webhookService *monetizationservices.WebhookService,
+	reconciliationService *monetizationservices.ReconciliationService,
+	paymentReconciliation *monetizationservices.PaymentReconciliationService,
+	invoiceService *monetizationservices.InvoiceService,
+) *PaymentManagementService {
 	return &PaymentManagementService{
-		entClient: entClient,
+		entClient:             entClient,
+		refundService:         refundService,
+		webhookService:        webhookService,
+		reconciliationService: reconciliationService,
+		paymentReconciliation: paymentReconciliation,
+		invoiceService:        invoiceService,
 	}
 }
 
@@ -80,6 +101,7 @@ func (s *PaymentManagementService) ListPaymentOrders(ctx context.Context, page,
 			Currency:          o.Currency,
 			CreditsToAdd:      o.CreditsToAdd,
 			OrderStatus:       string(o.OrderStatus),
+			AmountRefunded:    o.AmountRefunded,
 			FailureReason:     ptrToString(o.FailureReason),
 			CreatedAt:         o.CreatedAt,
 			PaidAt:            o.PaidAt,
@@ -96,34 +118,266 @@ func (s *PaymentManagementService) ListPaymentOrders(ctx context.Context, page,
 	}, nil
 }
 
-// RefundPayment marks a payment as refunded (actual refund via Razorpay dashboard)
-func (s *PaymentManagementService) RefundPayment(ctx context.Context, id string, req *dto.RefundPaymentRequest) error {
-	order, err := s.entClient.PaymentOrder.Get(ctx, id)
+// RefundPayment refunds a payment, fully or partially, through Razorpay and
+// reverses the purchased credits through the credit ledger
+func (s *PaymentManagementService) RefundPayment(ctx context.Context, id string, req *dto.RefundPaymentRequest) (*dto.AdminRefundResponse, error) {
+	input := &monetizationservices.RefundInput{
+		Reason:       req.Reason,
+		SpentCredits: monetizationservices.SpentCreditsPolicy(req.SpentCredits),
+	}
+	if req.Amount != nil {
+		input.Amount = *req.Amount
+	}
+
+	refund, err := s.refundService.RefundOrder(ctx, id, input)
 	if err != nil {
-		return fmt.Errorf("order not found: %w", err)
+		return nil, err
 	}
+	return toAdminRefundResponse(refund), nil
+}
 
-	if order.OrderStatus != paymentorder.OrderStatusPaid {
-		return fmt.Errorf("can only refund paid orders")
+// ListOrderRefunds returns the refunds issued against an order, newest first
+func (s *PaymentManagementService) ListOrderRefunds(ctx context.Context, id string) ([]dto.AdminRefundResponse, error) {
+	exists, err := s.entClient.PaymentOrder.Query().Where(paymentorder.IDEQ(id)).Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get order: %w", err)
+	}
+	if !exists {
+		return nil, monetizationservices.ErrOrderNotFound
 	}
 
-	// Mark as refunded
-	_, err = order.Update().
-		SetOrderStatus(paymentorder.OrderStatusRefunded).
-		Save(ctx)
+	refunds, err := s.refundService.ListRefunds(ctx, id)
 	if err != nil {
-		return fmt.Errorf("failed to update order: %w", err)
+		return nil, err
 	}
 
-	// Deduct credits from user (if they were added)
-	user, _ := s.entClient.User.Get(ctx, order.UserID)
-	if user != nil {
-		newBalance := user.CreditBalance - order.CreditsToAdd
-		if newBalance < 0 {
-			newBalance = 0
+	result := make([]dto.AdminRefundResponse, len(refunds))
+	for i, r := range refunds {
+		result[i] = *toAdminRefundResponse(r)
+	}
+	return result, nil
+}
+
+func toAdminRefundResponse(r *ent.PaymentRefund) *dto.AdminRefundResponse {
+	return &dto.AdminRefundResponse{
+		ID:                r.ID,
+		OrderID:           r.OrderID,
+		UserID:            r.UserID,
+		RazorpayRefundID:  ptrToString(r.RazorpayRefundID),
+		Amount:            r.Amount,
+		Currency:          r.Currency,
+		Status:            string(r.RefundStatus),
+		Source:            string(r.Source),
+		CreditsReversed:   r.CreditsReversed,
+		CreditsWrittenOff: r.CreditsWrittenOff,
+		Reason:            ptrToString(r.Reason),
+		FailureReason:     ptrToString(r.FailureReason),
+		CreatedAt:         r.CreatedAt,
+		ProcessedAt:       r.ProcessedAt,
+	}
+}
+
+// ListWebhookEvents returns paginated list of stored webhook deliveries
+func (s *PaymentManagementService) ListWebhookEvents(ctx context.Context, page, pageSize int, status, eventType string) (*dto.AdminWebhookEventListResponse, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 20
+	}
+	offset := (page - 1) * pageSize
+
+	query := s.entClient.WebhookEvent.Query()
+
+	if status != "" {
+		query = query.Where(webhookevent.ProcessingStatusEQ(webhookevent.ProcessingStatus(status)))
+	}
+	if eventType != "" {
+		query = query.Where(webhookevent.EventTypeEQ(eventType))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count webhook events: %w", err)
+	}
+
+	events, err := query.
+		Order(ent.Desc(webhookevent.FieldReceivedAt)).
+		Offset(offset).
+		Limit(pageSize).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhook events: %w", err)
+	}
+
+	result := make([]dto.AdminWebhookEventResponse, len(events))
+	for i, e := range events {
+		result[i] = webhookEventToResponse(e, false)
+	}
+
+	totalPages := (total + pageSize - 1) / pageSize
+	return &dto.AdminWebhookEventListResponse{
+		Events:     result,
+		Total:      total,
+		Page:       page,
+		PageSize:   pageSize,
+		TotalPages: totalPages,
+	}, nil
+}
+
+// GetWebhookEvent returns a stored webhook delivery with its raw payload
+func (s *PaymentManagementService) GetWebhookEvent(ctx context.Context, id string) (*dto.AdminWebhookEventResponse, error) {
+	e, err := s.entClient.WebhookEvent.Get(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("webhook event not found: %w", err)
+	}
+	resp := webhookEventToResponse(e, true)
+	return &resp, nil
+}
+
+// ReplayWebhookEvent processes a stored, verified webhook delivery again
+func (s *PaymentManagementService) ReplayWebhookEvent(ctx context.Context, id string) (*dto.AdminWebhookEventResponse, error) {
+	e, err := s.webhookService.ReplayEvent(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	resp := webhookEventToResponse(e, true)
+	return &resp, nil
+}
+
+// GetLedgerDrift returns the balance drifts recorded by reconciliation
+func (s *PaymentManagementService) GetLedgerDrift(ctx context.Context, includeResolved bool) (*dto.AdminLedgerDriftReportResponse, error) {
+	drifts, err := s.reconciliationService.ListDrifts(ctx, includeResolved)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.AdminLedgerDriftReportResponse{
+		Drifts: driftsToResponse(drifts),
+		Total:  len(drifts),
+	}, nil
+}
+
+// ReconcileLedger runs a reconciliation now and returns its report
+func (s *PaymentManagementService) ReconcileLedger(ctx context.Context) (*dto.AdminLedgerDriftReportResponse, error) {
+	report, err := s.reconciliationService.Reconcile(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.AdminLedgerDriftReportResponse{
+		Drifts:          driftsToResponse(report.Drifts),
+		Total:           len(report.Drifts),
+		UsersChecked:    report.UsersChecked,
+		Detected:        report.Detected,
+		Resolved:        report.Resolved,
+		LedgerImbalance: report.LedgerImbalance,
+		CheckedAt:       &report.FinishedAt,
+	}, nil
+}
+
+func driftsToResponse(drifts []*ent.BalanceDrift) []dto.AdminBalanceDriftResponse {
+	result := make([]dto.AdminBalanceDriftResponse, len(drifts))
+	for i, d := range drifts {
+		result[i] = dto.AdminBalanceDriftResponse{
+			ID:            d.ID,
+			UserID:        d.UserID,
+			StoredBalance: d.StoredBalance,
+			LedgerBalance: d.LedgerBalance,
+			Difference:    d.Difference,
+			DetectedAt:    d.DetectedAt,
+			LastCheckedAt: d.LastCheckedAt,
+			ResolvedAt:    d.ResolvedAt,
+		}
+	}
+	return result
+}
+
+func webhookEventToResponse(e *ent.WebhookEvent, withPayload bool) dto.AdminWebhookEventResponse {
+	resp := dto.AdminWebhookEventResponse{
+		ID:               e.ID,
+		Provider:         e.Provider,
+		EventID:          ptrToString(e.EventID),
+		EventType:        e.EventType,
+		SignatureValid:   e.SignatureValid,
+		ProcessingStatus: string(e.ProcessingStatus),
+		ErrorMessage:     ptrToString(e.ErrorMessage),
+		Attempts:         e.Attempts,
+		ReceivedAt:       e.ReceivedAt,
+		ProcessedAt:      e.ProcessedAt,
+	}
+	if withPayload {
+		resp.Payload = e.Payload
+	}
+	return resp
+}
+
+// GetPaymentReconciliation returns the payment reconciliation report for a
+// date (YYYY-MM-DD), or the latest one when date is empty
+func (s *PaymentManagementService) GetPaymentReconciliation(ctx context.Context, date string) (*dto.AdminPaymentReconciliationResponse, error) {
+	report, err := s.paymentReconciliation.GetReport(ctx, date)
+	if err != nil {
+		return nil, err
+	}
+	return paymentReportToResponse(report), nil
+}
+
+// ReconcilePayments builds the payment reconciliation report for a day now,
+// replacing any earlier report for it
+func (s *PaymentManagementService) ReconcilePayments(ctx context.Context, day time.Time) (*dto.AdminPaymentReconciliationResponse, error) {
+	report, err := s.paymentReconciliation.GenerateDailyReport(ctx, day)
+	if err != nil {
+		return nil, err
+	}
+	return paymentReportToResponse(report), nil
+}
+
+// SweepPendingOrders polls Razorpay for orders awaiting payment now, fulfilling
+// captured ones and expiring stale ones
+func (s *PaymentManagementService) SweepPendingOrders(ctx context.Context) (*dto.AdminOrderSweepResponse, error) {
+	result, err := s.paymentReconciliation.SweepPendingOrders(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &dto.AdminOrderSweepResponse{
+		Checked:   result.Checked,
+		Fulfilled: result.Fulfilled,
+		Expired:   result.Expired,
+		Errors:    result.Errors,
+	}, nil
+}
+
+func paymentReportToResponse(r *ent.PaymentReconciliationReport) *dto.AdminPaymentReconciliationResponse {
+	discrepancies := make([]dto.AdminPaymentDiscrepancyResponse, len(r.Edges.Discrepancies))
+	for i, d := range r.Edges.Discrepancies {
+		discrepancies[i] = dto.AdminPaymentDiscrepancyResponse{
+			ID:                d.ID,
+			OrderID:           ptrToString(d.OrderID),
+			RazorpayOrderID:   d.RazorpayOrderID,
+			RazorpayPaymentID: ptrToString(d.RazorpayPaymentID),
+			Kind:              string(d.Kind),
+			DBStatus:          d.DbStatus,
+			GatewayStatus:     d.GatewayStatus,
+			DBAmount:          d.DbAmount,
+			GatewayAmount:     d.GatewayAmount,
 		}
-		user.Update().SetCreditBalance(newBalance).Save(ctx)
 	}
 
-	return nil
+	return &dto.AdminPaymentReconciliationResponse{
+		ReportDate:            r.ReportDate,
+		WindowStart:           r.WindowStart,
+		WindowEnd:             r.WindowEnd,
+		OrdersChecked:         r.OrdersChecked,
+		GatewayPayments:       r.GatewayPayments,
+		Matched:               r.Matched,
+		DBPaidAmount:          r.DbPaidAmount,
+		GatewayCapturedAmount: r.GatewayCapturedAmount,
+		Discrepancies:         discrepancies,
+		GeneratedAt:           r.GeneratedAt,
+	}
+}
+
+// ExportInvoices writes the GST invoices dated within a date range as CSV
+func (s *PaymentManagementService) ExportInvoices(ctx context.Context, from, to string, w io.Writer) error {
+	return s.invoiceService.ExportCSV(ctx, from, to, w)
 }
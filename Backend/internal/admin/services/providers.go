@@ -9,4 +9,5 @@ var ProviderSet = wire.NewSet(
 	NewReportManagementService,
 	NewAnalyticsService,
 	NewContentManagementService,
+	NewAdminAccountService,
 )
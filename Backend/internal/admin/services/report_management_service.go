@@ -7,8 +7,13 @@ import (
 	"time"
 
 	ent "github.com/UnoraApp/be/ent/generated"
+	"github.com/UnoraApp/be/ent/generated/deviceriskflag"
+	"github.com/UnoraApp/be/ent/generated/moderationaction"
+	"github.com/UnoraApp/be/ent/generated/reportevidence"
 	"github.com/UnoraApp/be/ent/generated/userreport"
 	"github.com/UnoraApp/be/internal/admin/dto"
+	authservices "github.com/UnoraApp/be/internal/auth/services"
+	"github.com/UnoraApp/be/pkg/database"
 )
 
 // ReportManagementService handles admin report management
@@ -85,6 +90,39 @@ func (s *ReportManagementService) GetReport(ctx context.Context, reportID string
 	}
 
 	result := s.reportToAdminResponse(ctx, r)
+
+	// Evidence captured with the report, oldest first
+	evidence, err := r.QueryEvidence().
+		Order(ent.Asc(reportevidence.FieldSentAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get report evidence: %w", err)
+	}
+	for _, e := range evidence {
+		result.Evidence = append(result.Evidence, dto.AdminReportEvidence{
+			MessageID:         e.MessageID,
+			SenderID:          e.SenderUserID,
+			Body:              e.Body,
+			ModerationStatus:  e.ModerationStatus,
+			IsReportedMessage: e.IsReportedMessage,
+			SentAt:            e.SentAt,
+		})
+	}
+
+	// What chat moderation has already done about the reported user
+	actions, err := s.entClient.ModerationAction.
+		Query().
+		Where(moderationaction.UserIDEQ(r.ReportedUserID)).
+		Order(ent.Desc(moderationaction.FieldCreatedAt)).
+		Limit(50).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get moderation actions: %w", err)
+	}
+	for _, a := range actions {
+		result.ModerationActions = append(result.ModerationActions, moderationActionToAdminResponse(a))
+	}
+
 	return &result, nil
 }
 
@@ -97,14 +135,33 @@ func (s *ReportManagementService) ReviewReport(ctx context.Context, reportID str
 
 	now := time.Now()
 
-	// Update report status
-	_, err = r.Update().
-		SetReportStatus(userreport.ReportStatus(req.Status)).
-		SetNillableAdminNotes(strPtr(req.AdminNotes)).
-		SetReviewedAt(now).
-		Save(ctx)
+	// Update report status, and the device risk flag behind a system report
+	err = database.WithTx(ctx, s.entClient, func(tx *ent.Tx) error {
+		_, err := tx.UserReport.
+			UpdateOneID(r.ID).
+			SetReportStatus(userreport.ReportStatus(req.Status)).
+			SetNillableAdminNotes(strPtr(req.AdminNotes)).
+			SetReviewedAt(now).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to update report: %w", err)
+		}
+
+		if ptrToString(r.ReferenceType) == authservices.ReferenceTypeDeviceRiskFlag && r.ReferenceID != nil {
+			_, err = tx.DeviceRiskFlag.
+				UpdateOneID(*r.ReferenceID).
+				SetFlagStatus(deviceriskflag.FlagStatus(req.Status)).
+				SetNillableAdminNotes(strPtr(req.AdminNotes)).
+				SetReviewedAt(now).
+				Save(ctx)
+			if err != nil && !ent.IsNotFound(err) {
+				return fmt.Errorf("failed to update risk flag: %w", err)
+			}
+		}
+		return nil
+	})
 	if err != nil {
-		return fmt.Errorf("failed to update report: %w", err)
+		return err
 	}
 
 	// Take action if specified
@@ -136,11 +193,13 @@ func (s *ReportManagementService) ReviewReport(ctx context.Context, reportID str
 }
 
 func (s *ReportManagementService) reportToAdminResponse(ctx context.Context, r *ent.UserReport) dto.AdminReportResponse {
-	// Get reporter name
-	reporter, _ := s.entClient.User.Get(ctx, r.ReporterUserID)
+	// Get reporter name; system reports have no reporter
 	reporterName := ""
-	if reporter != nil && reporter.FirstName != nil {
-		reporterName = *reporter.FirstName
+	if r.ReporterUserID != nil {
+		reporter, _ := s.entClient.User.Get(ctx, *r.ReporterUserID)
+		if reporter != nil && reporter.FirstName != nil {
+			reporterName = *reporter.FirstName
+		}
 	}
 
 	// Get reported name
@@ -152,7 +211,7 @@ func (s *ReportManagementService) reportToAdminResponse(ctx context.Context, r *
 
 	return dto.AdminReportResponse{
 		ID:            r.ID,
-		ReporterID:    r.ReporterUserID,
+		ReporterID:    ptrToString(r.ReporterUserID),
 		ReporterName:  reporterName,
 		ReportedID:    r.ReportedUserID,
 		ReportedName:  reportedName,
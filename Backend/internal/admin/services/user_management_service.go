@@ -3,7 +3,10 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	ent "github.com/UnoraApp/be/ent/generated"
@@ -11,19 +14,36 @@ import (
 	"github.com/UnoraApp/be/ent/generated/credittransaction"
 	"github.com/UnoraApp/be/ent/generated/user"
 	"github.com/UnoraApp/be/ent/generated/userreport"
+	accountservices "github.com/UnoraApp/be/internal/account/services"
 	"github.com/UnoraApp/be/internal/admin/dto"
-	"github.com/google/uuid"
+	authservices "github.com/UnoraApp/be/internal/auth/services"
+	chatservices "github.com/UnoraApp/be/internal/chat/services"
+	monetizationservices "github.com/UnoraApp/be/internal/monetization/services"
+	"github.com/UnoraApp/be/pkg/ratelimit"
 )
 
+// maxThrottleDuration is the longest a user can be throttled at once
+const maxThrottleDuration = 90 * 24 * time.Hour
+
 // UserManagementService handles admin user management
 type UserManagementService struct {
-	entClient *ent.Client
+	entClient       *ent.Client
+	creditsService  *monetizationservices.CreditsService
+	authService     *authservices.AuthService
+	deletionService *accountservices.DeletionService
+	chatService     *chatservices.ChatService
+	limiter         *ratelimit.Limiter
 }
 
 // NewUserManagementService creates a new user management service
-func NewUserManagementService(entClient *ent.Client) *UserManagementService {
+func NewUserManagementService(entClient *ent.Client, creditsService *monetizationservices.CreditsService, authService *authservices.AuthService, deletionService *accountservices.DeletionService, chatService *chatservices.ChatService, limiter *ratelimit.Limiter) *UserManagementService {
 	return &UserManagementService{
-		entClient: entClient,
+		entClient:       entClient,
+		creditsService:  creditsService,
+		authService:     authService,
+		deletionService: deletionService,
+		chatService:     chatService,
+		limiter:         limiter,
 	}
 }
 
@@ -101,7 +121,7 @@ func (s *UserManagementService) GetUser(ctx context.Context, userID string) (*dt
 	return &result, nil
 }
 
-// SuspendUser suspends a user account
+// SuspendUser suspends a user account and logs them out everywhere
 func (s *UserManagementService) SuspendUser(ctx context.Context, userID string, req *dto.SuspendUserRequest) error {
 	u, err := s.entClient.User.Get(ctx, userID)
 	if err != nil {
@@ -117,6 +137,14 @@ func (s *UserManagementService) SuspendUser(ctx context.Context, userID string,
 		return fmt.Errorf("failed to suspend user: %w", err)
 	}
 
+	if err := s.authService.RevokeAccountAccess(ctx, userID, authservices.SessionRevokedSuspended); err != nil {
+		return fmt.Errorf("user suspended but sessions not revoked: %w", err)
+	}
+	// Open chat sockets were authorized before the suspension
+	if err := s.chatService.Hub().DisconnectUser(ctx, userID); err != nil {
+		return fmt.Errorf("user suspended but chat not disconnected: %w", err)
+	}
+
 	return nil
 }
 
@@ -135,62 +163,85 @@ func (s *UserManagementService) UnsuspendUser(ctx context.Context, userID string
 		return fmt.Errorf("failed to unsuspend user: %w", err)
 	}
 
+	if err := s.authService.InvalidateAccountStatus(ctx, userID); err != nil {
+		return fmt.Errorf("user unsuspended but status cache not cleared: %w", err)
+	}
+
 	return nil
 }
 
-// DeleteUser soft deletes a user
-func (s *UserManagementService) DeleteUser(ctx context.Context, userID string) error {
-	u, err := s.entClient.User.Get(ctx, userID)
+// ThrottleUser silently throttles a user flagged by safety: their requests
+// to rate-limited routes get a fraction of the limits and are slowed down,
+// without them being told
+func (s *UserManagementService) ThrottleUser(ctx context.Context, userID string, req *dto.ThrottleUserRequest) (*dto.ThrottleUserResponse, error) {
+	if _, err := s.entClient.User.Get(ctx, userID); err != nil {
+		return nil, fmt.Errorf("user not found: %w", err)
+	}
+	if req.Reason == "" {
+		return nil, fmt.Errorf("reason is required")
+	}
+	d, err := parseThrottleDuration(req.Duration)
 	if err != nil {
-		return fmt.Errorf("user not found: %w", err)
+		return nil, err
 	}
 
-	now := time.Now()
-	_, err = u.Update().
-		SetAccountStatus(user.AccountStatusDeleted).
-		SetDeletedAt(now).
-		Save(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to delete user: %w", err)
+	if err := s.limiter.Throttle(ctx, userID, req.Reason, d); err != nil {
+		return nil, err
 	}
+	return &dto.ThrottleUserResponse{ThrottledUntil: time.Now().Add(d)}, nil
+}
 
-	return nil
+// UnthrottleUser lifts a user's throttle
+func (s *UserManagementService) UnthrottleUser(ctx context.Context, userID string) error {
+	return s.limiter.Unthrottle(ctx, userID)
 }
 
-// AdjustCredits adjusts user credit balance
-func (s *UserManagementService) AdjustCredits(ctx context.Context, userID string, req *dto.AdjustCreditsRequest) error {
-	u, err := s.entClient.User.Get(ctx, userID)
-	if err != nil {
-		return fmt.Errorf("user not found: %w", err)
+// parseThrottleDuration parses a duration such as "12h" or "7d"
+func parseThrottleDuration(value string) (time.Duration, error) {
+	var d time.Duration
+	if days, found := strings.CutSuffix(value, "d"); found {
+		n, err := strconv.Atoi(days)
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration %q", value)
+		}
+		d = time.Duration(n) * 24 * time.Hour
+	} else {
+		var err error
+		if d, err = time.ParseDuration(value); err != nil {
+			return 0, fmt.Errorf("invalid duration %q", value)
+		}
+	}
+	if d <= 0 || d > maxThrottleDuration {
+		return 0, fmt.Errorf("duration must be positive and at most 90 days")
 	}
+	return d, nil
+}
 
-	newBalance := u.CreditBalance + req.Amount
-	if newBalance < 0 {
-		return fmt.Errorf("cannot reduce balance below zero")
+// DeleteUser deletes a user account at once, without the cooling-off
+// period of a self-serve deletion
+func (s *UserManagementService) DeleteUser(ctx context.Context, userID string) error {
+	if _, err := s.entClient.User.Get(ctx, userID); err != nil {
+		return fmt.Errorf("user not found: %w", err)
 	}
+	return s.deletionService.DeleteNow(ctx, userID, "")
+}
 
-	// Update balance
-	_, err = u.Update().
-		SetCreditBalance(newBalance).
-		Save(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to update balance: %w", err)
-	}
-
-	// Create transaction record
-	txID := uuid.New().String()
-	_, err = s.entClient.CreditTransaction.
-		Create().
-		SetID(txID).
-		SetUserID(userID).
-		SetTransactionType(credittransaction.TransactionTypeAdminAdjustment).
-		SetCreditAmount(req.Amount).
-		SetBalanceAfter(newBalance).
-		SetNillableDescription(strPtr(fmt.Sprintf("%s: %s", req.Reason, req.Description))).
-		SetNillableReferenceType(strPtr("admin_adjustment")).
-		Save(ctx)
+// AdjustCredits adjusts user credit balance through the credit ledger
+func (s *UserManagementService) AdjustCredits(ctx context.Context, userID string, req *dto.AdjustCreditsRequest) error {
+	_, err := s.creditsService.AddCredits(
+		ctx,
+		userID,
+		req.Amount,
+		credittransaction.TransactionTypeAdminAdjustment,
+		fmt.Sprintf("%s: %s", req.Reason, req.Description),
+		"admin_adjustment",
+		"",
+	)
 	if err != nil {
-		return fmt.Errorf("failed to record transaction: %w", err)
+		if errors.Is(err, monetizationservices.ErrInsufficientCredits) {
+			return fmt.Errorf("cannot reduce balance below zero")
+		}
+		return err
 	}
 
 	return nil
@@ -1,11 +1,93 @@
 // internal/auth/dto/auth_dto.go
 package dto
 
+import "time"
+
 // GoogleOAuthRequest is the request body for Google OAuth login
 // @Description Google OAuth login request - send ID token from mobile app
 type GoogleOAuthRequest struct {
 	// Google ID token obtained from Google Sign-In SDK on mobile
 	IDToken string `json:"idToken" validate:"required" example:"eyJhbGciOiJSUzI1NiIsInR5cCI6IkpXVCJ9..."`
+	// Referral code entered at signup; ignored for existing users
+	ReferralCode string `json:"referralCode,omitempty" example:"K7QM2XPA"`
+	// Stable per-install device identifier, used for referral fraud checks
+	DeviceID string `json:"deviceId,omitempty" example:"7c9e6679-7425-40de-944b-e07fc1f90ae7"`
+	// Device shown in the session list
+	DeviceName string `json:"deviceName,omitempty" example:"Pixel 8"`
+	// android, ios or web
+	Platform string `json:"platform,omitempty" example:"android"`
+}
+
+// AppleSignInRequest is the request body for Sign in with Apple
+// @Description Sign in with Apple request - send the identity token from the app
+type AppleSignInRequest struct {
+	// Identity token obtained from Sign in with Apple
+	IdentityToken string `json:"identityToken" validate:"required" example:"eyJraWQiOiJXNldjT0tCIiwiYWxnIjoiUlMyNTYifQ..."`
+	// Raw nonce, if the app passed its SHA-256 hash to Apple
+	Nonce string `json:"nonce,omitempty" example:"3d5f1c9a0b7e4e2d"`
+	// Name the user shared; Apple only gives it to the app on first sign-in
+	FirstName string `json:"firstName,omitempty" example:"John"`
+	LastName  string `json:"lastName,omitempty" example:"Doe"`
+	// Referral code entered at signup; ignored for existing users
+	ReferralCode string `json:"referralCode,omitempty" example:"K7QM2XPA"`
+	// Stable per-install device identifier, used for referral fraud checks
+	DeviceID string `json:"deviceId,omitempty" example:"7c9e6679-7425-40de-944b-e07fc1f90ae7"`
+	// Device shown in the session list
+	DeviceName string `json:"deviceName,omitempty" example:"iPhone 15"`
+	// android, ios or web
+	Platform string `json:"platform,omitempty" example:"ios"`
+}
+
+// OTPRequest is the request body for sending a login code by SMS
+// @Description Phone OTP request - send a login code to a phone number
+type OTPRequest struct {
+	// Phone number, with or without country code (+91 is assumed without)
+	Phone string `json:"phone" validate:"required" example:"+919876543210"`
+}
+
+// OTPRequestResponse is the response body after a login code was sent
+// @Description Login code sent
+type OTPRequestResponse struct {
+	// Phone number the code was sent to, in E.164 format
+	Phone string `json:"phone" example:"+919876543210"`
+	// Seconds until the code expires
+	ExpiresIn int `json:"expiresIn" example:"300"`
+	// Seconds before another code may be requested
+	ResendAfter int `json:"resendAfter" example:"30"`
+}
+
+// OTPVerifyRequest is the request body for logging in with an SMS code
+// @Description Phone OTP login request
+type OTPVerifyRequest struct {
+	// Phone number the code was sent to
+	Phone string `json:"phone" validate:"required" example:"+919876543210"`
+	// Code received by SMS
+	Code string `json:"code" validate:"required" example:"482913"`
+	// Referral code entered at signup; ignored for existing users
+	ReferralCode string `json:"referralCode,omitempty" example:"K7QM2XPA"`
+	// Stable per-install device identifier, used for referral fraud checks
+	DeviceID string `json:"deviceId,omitempty" example:"7c9e6679-7425-40de-944b-e07fc1f90ae7"`
+	// Device shown in the session list
+	DeviceName string `json:"deviceName,omitempty" example:"Pixel 8"`
+	// android, ios or web
+	Platform string `json:"platform,omitempty" example:"android"`
+}
+
+// LinkPhoneRequest is the request body for verifying a phone number on a
+// signed-in account
+// @Description Link a phone number - send a code requested with /auth/otp/request
+type LinkPhoneRequest struct {
+	// Phone number the code was sent to
+	Phone string `json:"phone" validate:"required" example:"+919876543210"`
+	// Code received by SMS
+	Code string `json:"code" validate:"required" example:"482913"`
+}
+
+// LinkPhoneResponse is the response body after a phone number was linked
+// @Description Linked phone number
+type LinkPhoneResponse struct {
+	// Phone number in E.164 format
+	Phone string `json:"phone" example:"+919876543210"`
 }
 
 // GoogleUserInfo represents user info extracted from Google token
@@ -20,6 +102,17 @@ type GoogleUserInfo struct {
 	Picture       string `json:"picture" example:"https://lh3.googleusercontent.com/..."`
 }
 
+// AppleUserInfo represents user info extracted from an Apple identity token
+// @Description User information extracted from verified Apple identity token
+type AppleUserInfo struct {
+	ID string `json:"id" example:"001234.5f6e7d8c9b0a4f1e.1234"`
+	// Verified email the user shared, possibly a private relay address;
+	// empty if none
+	Email          string `json:"email,omitempty" example:"abc123@privaterelay.appleid.com"`
+	EmailVerified  bool   `json:"emailVerified" example:"true"`
+	IsPrivateEmail bool   `json:"isPrivateEmail" example:"true"`
+}
+
 // AuthResponse is the response body for successful authentication
 // @Description Successful authentication response with tokens and user info
 type AuthResponse struct {
@@ -44,6 +137,7 @@ type UserResponse struct {
 	FirstName string `json:"firstName" example:"John"`
 	LastName  string `json:"lastName" example:"Doe"`
 	Picture   string `json:"picture" example:"https://lh3.googleusercontent.com/..."`
+	Phone     string `json:"phone,omitempty" example:"+919876543210"`
 	Provider  string `json:"provider" example:"google"`
 	CreatedAt string `json:"createdAt" example:"2024-01-01T00:00:00Z"`
 }
@@ -56,10 +150,12 @@ type RefreshTokenRequest struct {
 }
 
 // RefreshTokenResponse is the response body for token refresh
-// @Description Token refresh response with new access token
+// @Description Token refresh response with new access and refresh tokens
 type RefreshTokenResponse struct {
 	// New JWT access token
 	AccessToken string `json:"accessToken" example:"eyJhbGciOiJIUzI1NiIs..."`
+	// New refresh token; the one sent is revoked and must not be used again
+	RefreshToken string `json:"refreshToken" example:"eyJhbGciOiJIUzI1NiIs..."`
 	// Token expiry time in seconds
 	ExpiresIn int64 `json:"expiresIn" example:"86400"`
 	// Token type - always "Bearer"
@@ -69,7 +165,48 @@ type RefreshTokenResponse struct {
 // TokenPayload is the JWT payload structure
 // @Description JWT token payload containing user identification
 type TokenPayload struct {
-	UserID   string `json:"userId" example:"550e8400-e29b-41d4-a716-446655440000"`
-	Email    string `json:"email" example:"user@gmail.com"`
-	Provider string `json:"provider" example:"google"`
+	UserID    string `json:"userId" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Email     string `json:"email" example:"user@gmail.com"`
+	Provider  string `json:"provider" example:"google"`
+	SessionID string `json:"sessionId,omitempty" example:"9b2f6c1e-3d4a-4f5b-8c7d-1e2f3a4b5c6d"`
+}
+
+// SessionResponse is a signed-in device
+// @Description Active session of the user
+type SessionResponse struct {
+	ID         string    `json:"id" example:"9b2f6c1e-3d4a-4f5b-8c7d-1e2f3a4b5c6d"`
+	DeviceName string    `json:"deviceName,omitempty" example:"Pixel 8"`
+	Platform   string    `json:"platform" example:"android"`
+	Provider   string    `json:"provider" example:"google"`
+	IPAddress  string    `json:"ipAddress,omitempty" example:"203.0.113.7"`
+	CreatedAt  time.Time `json:"createdAt" example:"2024-01-01T00:00:00Z"`
+	LastUsedAt time.Time `json:"lastUsedAt" example:"2024-01-02T00:00:00Z"`
+	// Whether this is the session making the request
+	Current bool `json:"current" example:"true"`
+}
+
+// RevokeSessionsResponse is the response body after revoking sessions
+// @Description Number of sessions revoked
+type RevokeSessionsResponse struct {
+	Revoked int `json:"revoked" example:"2"`
+}
+
+// LinkIdentityRequest is the request body for linking a Google or Apple
+// account
+// @Description Link a sign-in provider - send an ID token for the account to link
+type LinkIdentityRequest struct {
+	// Google ID token or Apple identity token
+	IDToken string `json:"idToken" validate:"required" example:"eyJhbGciOiJSUzI1NiIsInR5cCI6IkpXVCJ9..."`
+	// Raw nonce, for Apple tokens requested with one
+	Nonce string `json:"nonce,omitempty" example:"3d5f1c9a0b7e4e2d"`
+}
+
+// IdentityResponse is a Google or Apple account the user signs in with
+// @Description Linked sign-in provider
+type IdentityResponse struct {
+	Provider       string     `json:"provider" example:"apple"`
+	Email          string     `json:"email,omitempty" example:"abc123@privaterelay.appleid.com"`
+	IsPrivateEmail bool       `json:"isPrivateEmail" example:"true"`
+	LinkedAt       time.Time  `json:"linkedAt" example:"2024-01-01T00:00:00Z"`
+	LastUsedAt     *time.Time `json:"lastUsedAt,omitempty" example:"2024-01-02T00:00:00Z"`
 }
@@ -2,6 +2,7 @@
 package handlers
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
@@ -34,6 +35,8 @@ func NewAuthHandler(authService *services.AuthService) *AuthHandler {
 // @Success      200 {object} response.APIResponse{data=dto.AuthResponse} "Login successful"
 // @Failure      400 {object} response.APIResponse "Invalid request body"
 // @Failure      401 {object} response.APIResponse "Authentication failed - invalid token"
+// @Failure      403 {object} response.APIResponse "Account suspended (ACCOUNT_SUSPENDED)"
+// @Failure      409 {object} response.APIResponse "Email belongs to an account that has not linked this Google account"
 // @Router       /auth/google [post]
 func (h *AuthHandler) GoogleLogin(c *gin.Context) {
 	var req dto.GoogleOAuthRequest
@@ -42,18 +45,168 @@ func (h *AuthHandler) GoogleLogin(c *gin.Context) {
 		return
 	}
 
-	authResponse, err := h.authService.GoogleLogin(c.Request.Context(), &req)
+	authResponse, err := h.authService.GoogleLogin(c.Request.Context(), &req, clientInfo(c))
 	if err != nil {
+		handleIdentityLoginError(c, err)
+		return
+	}
+
+	response.JSON(c, http.StatusOK, authResponse)
+}
+
+// AppleLogin godoc
+// @Summary      Sign in with Apple
+// @Description  Authenticate user using the identity token from Sign in with Apple. Apple gives the app the user's name only on first sign-in, so send it along then. If the app passed a hashed nonce to Apple, send the raw nonce. Returns access token, refresh token, and user info.
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Param        request body dto.AppleSignInRequest true "Apple identity token from the app"
+// @Success      200 {object} response.APIResponse{data=dto.AuthResponse} "Login successful"
+// @Failure      400 {object} response.APIResponse "Invalid request body"
+// @Failure      401 {object} response.APIResponse "Authentication failed - invalid token"
+// @Failure      403 {object} response.APIResponse "Account suspended (ACCOUNT_SUSPENDED)"
+// @Failure      409 {object} response.APIResponse "Email belongs to an account that has not linked this Apple ID"
+// @Router       /auth/apple [post]
+func (h *AuthHandler) AppleLogin(c *gin.Context) {
+	var req dto.AppleSignInRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apperror.HandleError(c, apperror.BadRequest("Invalid request body: "+err.Error()))
+		return
+	}
+
+	authResponse, err := h.authService.AppleLogin(c.Request.Context(), &req, clientInfo(c))
+	if err != nil {
+		handleIdentityLoginError(c, err)
+		return
+	}
+
+	response.JSON(c, http.StatusOK, authResponse)
+}
+
+func handleIdentityLoginError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, services.ErrAccountSuspended):
+		apperror.HandleError(c, apperror.AccountSuspended())
+	case errors.Is(err, services.ErrEmailInUse):
+		apperror.HandleError(c, apperror.Conflict(services.ErrEmailInUse.Error()))
+	default:
 		apperror.HandleError(c, apperror.Unauthorized("Authentication failed: "+err.Error()))
+	}
+}
+
+// RequestOTP godoc
+// @Summary      Request SMS login code
+// @Description  Send a one-time login code by SMS. Numbers without a country code are taken to be Indian. Requests are limited per phone number and per IP.
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Param        request body dto.OTPRequest true "Phone number"
+// @Success      200 {object} response.APIResponse{data=dto.OTPRequestResponse} "Code sent"
+// @Failure      400 {object} response.APIResponse "Invalid phone number"
+// @Failure      429 {object} response.APIResponse "Resend cooldown or request limit reached"
+// @Router       /auth/otp/request [post]
+func (h *AuthHandler) RequestOTP(c *gin.Context) {
+	var req dto.OTPRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apperror.HandleError(c, apperror.BadRequest("Invalid request body: "+err.Error()))
+		return
+	}
+
+	result, err := h.authService.RequestPhoneOTP(c.Request.Context(), &req, c.ClientIP())
+	if err != nil {
+		handleOTPError(c, err)
+		return
+	}
+
+	response.JSON(c, http.StatusOK, result)
+}
+
+// VerifyOTP godoc
+// @Summary      Login with SMS code
+// @Description  Verify a code sent by /auth/otp/request. The phone number is marked verified and the account is created if it does not exist. Returns the same tokens as Google login.
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Param        request body dto.OTPVerifyRequest true "Phone number and code"
+// @Success      200 {object} response.APIResponse{data=dto.AuthResponse} "Login successful"
+// @Failure      400 {object} response.APIResponse "Invalid phone number"
+// @Failure      401 {object} response.APIResponse "Incorrect or expired code"
+// @Failure      403 {object} response.APIResponse "Account suspended (ACCOUNT_SUSPENDED)"
+// @Failure      429 {object} response.APIResponse "Too many attempts"
+// @Router       /auth/otp/verify [post]
+func (h *AuthHandler) VerifyOTP(c *gin.Context) {
+	var req dto.OTPVerifyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apperror.HandleError(c, apperror.BadRequest("Invalid request body: "+err.Error()))
+		return
+	}
+
+	authResponse, err := h.authService.PhoneLogin(c.Request.Context(), &req, clientInfo(c))
+	if err != nil {
+		handleOTPError(c, err)
 		return
 	}
 
 	response.JSON(c, http.StatusOK, authResponse)
 }
 
+func handleOTPError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, services.ErrInvalidPhoneNumber):
+		apperror.HandleError(c, apperror.BadRequest(err.Error()))
+	case errors.Is(err, services.ErrOTPCooldown),
+		errors.Is(err, services.ErrOTPRateLimited),
+		errors.Is(err, services.ErrOTPAttemptsExceeded):
+		apperror.HandleError(c, apperror.RateLimited(err.Error()))
+	case errors.Is(err, services.ErrOTPInvalid),
+		errors.Is(err, services.ErrOTPExpired):
+		apperror.HandleError(c, apperror.Unauthorized(err.Error()))
+	case errors.Is(err, services.ErrAccountSuspended):
+		apperror.HandleError(c, apperror.AccountSuspended())
+	default:
+		apperror.HandleError(c, apperror.InternalError(err))
+	}
+}
+
+// LinkPhone godoc
+// @Summary      Link a phone number
+// @Description  Verifies a phone number for the signed-in user with a code requested with /auth/otp/request, and marks them phone-verified. Referral bonuses are only paid to phone-verified users. A number of another account cannot be linked, nor a second number.
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request body dto.LinkPhoneRequest true "Phone number and code"
+// @Success      200 {object} response.APIResponse{data=dto.LinkPhoneResponse} "Phone linked"
+// @Failure      400 {object} response.APIResponse "Invalid request body or phone number"
+// @Failure      401 {object} response.APIResponse "Not authenticated, or incorrect or expired code"
+// @Failure      409 {object} response.APIResponse "Number of another account, or another number linked"
+// @Failure      429 {object} response.APIResponse "Too many incorrect codes"
+// @Router       /auth/phone [post]
+func (h *AuthHandler) LinkPhone(c *gin.Context) {
+	userID := c.GetString("userID")
+
+	var req dto.LinkPhoneRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apperror.HandleError(c, apperror.BadRequest("Invalid request body: "+err.Error()))
+		return
+	}
+
+	result, err := h.authService.LinkPhone(c.Request.Context(), userID, &req, c.ClientIP())
+	if err != nil {
+		if errors.Is(err, services.ErrPhoneInUse) || errors.Is(err, services.ErrPhoneAlreadyLinked) {
+			apperror.HandleError(c, apperror.Conflict(err.Error()))
+			return
+		}
+		handleOTPError(c, err)
+		return
+	}
+
+	response.JSON(c, http.StatusOK, result)
+}
+
 // RefreshToken godoc
 // @Summary      Refresh access token
-// @Description  Get a new access token using a valid refresh token. Use this when access token expires.
+// @Description  Get a new token pair using a valid refresh token. Use this when access token expires. The refresh token is rotated: store the new one, the old one stops working, and presenting it again logs the device out.
 // @Tags         auth
 // @Accept       json
 // @Produce      json
@@ -61,6 +214,7 @@ func (h *AuthHandler) GoogleLogin(c *gin.Context) {
 // @Success      200 {object} response.APIResponse{data=dto.RefreshTokenResponse} "Token refreshed"
 // @Failure      400 {object} response.APIResponse "Invalid request body"
 // @Failure      401 {object} response.APIResponse "Invalid or expired refresh token"
+// @Failure      403 {object} response.APIResponse "Account suspended (ACCOUNT_SUSPENDED)"
 // @Router       /auth/refresh [post]
 func (h *AuthHandler) RefreshToken(c *gin.Context) {
 	var req dto.RefreshTokenRequest
@@ -69,7 +223,11 @@ func (h *AuthHandler) RefreshToken(c *gin.Context) {
 		return
 	}
 
-	tokenResponse, err := h.authService.RefreshToken(c.Request.Context(), &req)
+	tokenResponse, err := h.authService.RefreshToken(c.Request.Context(), &req, clientInfo(c))
+	if errors.Is(err, services.ErrAccountSuspended) {
+		apperror.HandleError(c, apperror.AccountSuspended())
+		return
+	}
 	if err != nil {
 		apperror.HandleError(c, apperror.Unauthorized("Token refresh failed: "+err.Error()))
 		return
@@ -80,7 +238,7 @@ func (h *AuthHandler) RefreshToken(c *gin.Context) {
 
 // Logout godoc
 // @Summary      Logout user
-// @Description  Revoke refresh token for the authenticated user. Requires valid access token.
+// @Description  End the session of the access token, logging this device out. Requires valid access token.
 // @Tags         auth
 // @Accept       json
 // @Produce      json
@@ -96,7 +254,7 @@ func (h *AuthHandler) Logout(c *gin.Context) {
 		return
 	}
 
-	if err := h.authService.Logout(c.Request.Context(), userID.(string)); err != nil {
+	if err := h.authService.Logout(c.Request.Context(), userID.(string), c.GetString("sessionID")); err != nil {
 		apperror.HandleError(c, apperror.InternalError(err))
 		return
 	}
@@ -104,6 +262,172 @@ func (h *AuthHandler) Logout(c *gin.Context) {
 	response.JSON(c, http.StatusOK, gin.H{"message": "Successfully logged out"})
 }
 
+// ListSessions godoc
+// @Summary      List active sessions
+// @Description  Returns the devices the user is signed in on, most recently used first. The session of the access token is marked current.
+// @Tags         auth
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200 {object} response.APIResponse{data=[]dto.SessionResponse} "Active sessions"
+// @Failure      401 {object} response.APIResponse "Not authenticated"
+// @Router       /auth/sessions [get]
+func (h *AuthHandler) ListSessions(c *gin.Context) {
+	userID := c.GetString("userID")
+
+	sessions, err := h.authService.ListSessions(c.Request.Context(), userID, c.GetString("sessionID"))
+	if err != nil {
+		apperror.HandleError(c, apperror.InternalError(err))
+		return
+	}
+
+	response.JSON(c, http.StatusOK, sessions)
+}
+
+// RevokeSession godoc
+// @Summary      Revoke a session
+// @Description  Logs one of the user's devices out. Its refresh token stops working and its access token is refused.
+// @Tags         auth
+// @Produce      json
+// @Security     BearerAuth
+// @Param        sessionId path string true "Session ID"
+// @Success      200 {object} response.APIResponse{data=map[string]string} "Session revoked"
+// @Failure      401 {object} response.APIResponse "Not authenticated"
+// @Failure      404 {object} response.APIResponse "Session not found"
+// @Router       /auth/sessions/{sessionId} [delete]
+func (h *AuthHandler) RevokeSession(c *gin.Context) {
+	userID := c.GetString("userID")
+
+	if err := h.authService.RevokeSession(c.Request.Context(), userID, c.Param("sessionId")); err != nil {
+		if errors.Is(err, services.ErrSessionNotFound) {
+			apperror.HandleError(c, apperror.NotFound("Session"))
+			return
+		}
+		apperror.HandleError(c, apperror.InternalError(err))
+		return
+	}
+
+	response.JSON(c, http.StatusOK, gin.H{"message": "Session revoked"})
+}
+
+// RevokeOtherSessions godoc
+// @Summary      Revoke all other sessions
+// @Description  Logs out every device of the user except the one making the request.
+// @Tags         auth
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200 {object} response.APIResponse{data=dto.RevokeSessionsResponse} "Sessions revoked"
+// @Failure      401 {object} response.APIResponse "Not authenticated"
+// @Router       /auth/sessions [delete]
+func (h *AuthHandler) RevokeOtherSessions(c *gin.Context) {
+	userID := c.GetString("userID")
+
+	result, err := h.authService.RevokeOtherSessions(c.Request.Context(), userID, c.GetString("sessionID"))
+	if err != nil {
+		apperror.HandleError(c, apperror.InternalError(err))
+		return
+	}
+
+	response.JSON(c, http.StatusOK, result)
+}
+
+// ListIdentities godoc
+// @Summary      List linked sign-in providers
+// @Description  Returns the Google and Apple accounts the user can sign in with.
+// @Tags         auth
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200 {object} response.APIResponse{data=[]dto.IdentityResponse} "Linked providers"
+// @Failure      401 {object} response.APIResponse "Not authenticated"
+// @Router       /auth/identities [get]
+func (h *AuthHandler) ListIdentities(c *gin.Context) {
+	userID := c.GetString("userID")
+
+	identities, err := h.authService.ListIdentities(c.Request.Context(), userID)
+	if err != nil {
+		apperror.HandleError(c, apperror.InternalError(err))
+		return
+	}
+
+	response.JSON(c, http.StatusOK, identities)
+}
+
+// LinkIdentity godoc
+// @Summary      Link a sign-in provider
+// @Description  Links a Google or Apple account to the user so they can sign in with it, proven by an ID token for it. An account linked to another user cannot be linked, and only one account per provider can be linked. A user without an email gets the account's.
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        provider path string true "google or apple"
+// @Param        request body dto.LinkIdentityRequest true "ID token for the account"
+// @Success      200 {object} response.APIResponse{data=dto.IdentityResponse} "Provider linked"
+// @Failure      400 {object} response.APIResponse "Invalid request body, token or provider"
+// @Failure      401 {object} response.APIResponse "Not authenticated"
+// @Failure      409 {object} response.APIResponse "Account linked to another user, or another account of the provider linked"
+// @Router       /auth/identities/{provider} [post]
+func (h *AuthHandler) LinkIdentity(c *gin.Context) {
+	userID := c.GetString("userID")
+
+	var req dto.LinkIdentityRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apperror.HandleError(c, apperror.BadRequest("Invalid request body: "+err.Error()))
+		return
+	}
+
+	identity, err := h.authService.LinkIdentity(c.Request.Context(), userID, c.Param("provider"), &req)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrIdentityInUse),
+			errors.Is(err, services.ErrProviderAlreadyLinked):
+			apperror.HandleError(c, apperror.Conflict(err.Error()))
+		case errors.Is(err, services.ErrUnsupportedProvider):
+			apperror.HandleError(c, apperror.BadRequest(err.Error()))
+		case errors.Is(err, services.ErrGoogleClientIDsNotConfigured),
+			errors.Is(err, services.ErrAppleClientIDsNotConfigured):
+			apperror.HandleError(c, apperror.InternalError(err))
+		default:
+			// The token did not verify
+			apperror.HandleError(c, apperror.BadRequest(err.Error()))
+		}
+		return
+	}
+
+	response.JSON(c, http.StatusOK, identity)
+}
+
+// UnlinkIdentity godoc
+// @Summary      Unlink a sign-in provider
+// @Description  Unlinks the user's Google or Apple account. The user must keep another way to sign in: another linked account or a phone number.
+// @Tags         auth
+// @Produce      json
+// @Security     BearerAuth
+// @Param        provider path string true "google or apple"
+// @Success      200 {object} response.APIResponse{data=map[string]string} "Provider unlinked"
+// @Failure      400 {object} response.APIResponse "Unsupported provider"
+// @Failure      401 {object} response.APIResponse "Not authenticated"
+// @Failure      404 {object} response.APIResponse "Provider not linked"
+// @Failure      409 {object} response.APIResponse "Only way to sign in"
+// @Router       /auth/identities/{provider} [delete]
+func (h *AuthHandler) UnlinkIdentity(c *gin.Context) {
+	userID := c.GetString("userID")
+
+	if err := h.authService.UnlinkIdentity(c.Request.Context(), userID, c.Param("provider")); err != nil {
+		switch {
+		case errors.Is(err, services.ErrUnsupportedProvider):
+			apperror.HandleError(c, apperror.BadRequest(err.Error()))
+		case errors.Is(err, services.ErrIdentityNotLinked):
+			apperror.HandleError(c, apperror.NotFound("Identity"))
+		case errors.Is(err, services.ErrLastSignInMethod):
+			apperror.HandleError(c, apperror.Conflict(err.Error()))
+		default:
+			apperror.HandleError(c, apperror.InternalError(err))
+		}
+		return
+	}
+
+	response.JSON(c, http.StatusOK, gin.H{"message": "Provider unlinked"})
+}
+
 // GetMe godoc
 // @Summary      Get current user information
 // @Description  Returns the profile information of the currently authenticated user.
@@ -123,3 +447,16 @@ func (h *AuthHandler) GetMe(c *gin.Context) {
 
 	response.JSON(c, http.StatusOK, payload)
 }
+
+// clientInfo describes the connection and device of the request. The app
+// identifies its device with the X-Device-* headers.
+func clientInfo(c *gin.Context) services.ClientInfo {
+	return services.ClientInfo{
+		IP:          c.ClientIP(),
+		UserAgent:   c.Request.UserAgent(),
+		DeviceID:    c.GetHeader("X-Device-ID"),
+		DeviceName:  c.GetHeader("X-Device-Name"),
+		Platform:    c.GetHeader("X-Device-Platform"),
+		Attestation: c.GetHeader("X-Device-Attestation"),
+	}
+}
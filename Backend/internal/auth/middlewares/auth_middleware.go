@@ -2,6 +2,7 @@
 package middlewares
 
 import (
+	"errors"
 	"strings"
 
 	"github.com/gin-gonic/gin"
@@ -30,9 +31,9 @@ func AuthMiddleware(authService *services.AuthService) gin.HandlerFunc {
 		token := parts[1]
 
 		// Validate token
-		payload, err := authService.ValidateAccessToken(token)
+		payload, err := authService.ValidateAccessToken(c.Request.Context(), token)
 		if err != nil {
-			apperror.AbortWithError(c, apperror.Unauthorized("Invalid or expired token"))
+			apperror.AbortWithError(c, tokenError(err))
 			return
 		}
 
@@ -40,6 +41,7 @@ func AuthMiddleware(authService *services.AuthService) gin.HandlerFunc {
 		c.Set("userID", payload.UserID)
 		c.Set("userEmail", payload.Email)
 		c.Set("userProvider", payload.Provider)
+		c.Set("sessionID", payload.SessionID)
 		c.Set("userPayload", payload)
 
 		c.Next()
@@ -62,7 +64,7 @@ func OptionalAuthMiddleware(authService *services.AuthService) gin.HandlerFunc {
 		}
 
 		token := parts[1]
-		payload, err := authService.ValidateAccessToken(token)
+		payload, err := authService.ValidateAccessToken(c.Request.Context(), token)
 		if err != nil {
 			c.Next()
 			return
@@ -71,8 +73,22 @@ func OptionalAuthMiddleware(authService *services.AuthService) gin.HandlerFunc {
 		c.Set("userID", payload.UserID)
 		c.Set("userEmail", payload.Email)
 		c.Set("userProvider", payload.Provider)
+		c.Set("sessionID", payload.SessionID)
 		c.Set("userPayload", payload)
 
 		c.Next()
 	}
 }
+
+// tokenError maps a refused access token to its response. Suspended users get
+// a dedicated code so the app can explain instead of asking them to log in.
+func tokenError(err error) *apperror.AppError {
+	switch {
+	case errors.Is(err, services.ErrAccountSuspended):
+		return apperror.AccountSuspended()
+	case errors.Is(err, services.ErrAccountDeleted):
+		return apperror.Unauthorized("Account no longer exists")
+	default:
+		return apperror.Unauthorized("Invalid or expired token")
+	}
+}
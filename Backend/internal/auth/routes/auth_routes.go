@@ -9,6 +9,7 @@ import (
 	"github.com/UnoraApp/be/internal/auth/middlewares"
 	"github.com/UnoraApp/be/internal/config"
 	"github.com/UnoraApp/be/internal/di"
+	"github.com/UnoraApp/be/pkg/ratelimit"
 )
 
 // RegisterAuthRoutes registers all authentication routes
@@ -18,6 +19,7 @@ func RegisterAuthRoutes(
 	entClient *ent.Client,
 	redisClient *redis.Client,
 	cfg *config.Config,
+	limiter *ratelimit.Limiter,
 ) {
 	// Use Wire-generated injector to create handler with all dependencies
 	authHandler := di.InitializeAuthHandler(entClient, redisClient, cfg)
@@ -27,19 +29,39 @@ func RegisterAuthRoutes(
 	authRoutes := router.Group("/auth")
 	{
 		// Google OAuth login - mobile app sends ID token
-		authRoutes.POST("/google", authHandler.GoogleLogin)
+		authRoutes.POST("/google", limiter.Middleware("auth_login"), authHandler.GoogleLogin)
+
+		// Sign in with Apple - app sends identity token
+		authRoutes.POST("/apple", limiter.Middleware("auth_login"), authHandler.AppleLogin)
+
+		// Phone login - code sent by SMS
+		authRoutes.POST("/otp/request", authHandler.RequestOTP)
+		authRoutes.POST("/otp/verify", authHandler.VerifyOTP)
 
 		// Refresh token
-		authRoutes.POST("/refresh", authHandler.RefreshToken)
+		authRoutes.POST("/refresh", limiter.Middleware("auth_refresh"), authHandler.RefreshToken)
 	}
 
 	// Protected auth routes (requires valid access token)
 	protectedRoutes := router.Group("/auth")
 	protectedRoutes.Use(middlewares.AuthMiddleware(authService))
 	{
-		// Logout - revokes refresh token
+		// Logout - ends the current session
 		protectedRoutes.POST("/logout", authHandler.Logout)
 
+		// Sessions - devices the user is signed in on
+		protectedRoutes.GET("/sessions", authHandler.ListSessions)
+		protectedRoutes.DELETE("/sessions", authHandler.RevokeOtherSessions)
+		protectedRoutes.DELETE("/sessions/:sessionId", authHandler.RevokeSession)
+
+		// Identities - Google and Apple accounts the user signs in with
+		protectedRoutes.GET("/identities", authHandler.ListIdentities)
+		protectedRoutes.POST("/identities/:provider", authHandler.LinkIdentity)
+		protectedRoutes.DELETE("/identities/:provider", authHandler.UnlinkIdentity)
+
+		// Phone - verify a phone number on an account without one
+		protectedRoutes.POST("/phone", authHandler.LinkPhone)
+
 		// Get current user info from token
 		protectedRoutes.GET("/me", authHandler.GetMe)
 	}
@@ -0,0 +1,98 @@
+// internal/auth/services/account_status.go
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	entgen "github.com/UnoraApp/be/ent/generated"
+	entuser "github.com/UnoraApp/be/ent/generated/user"
+	"github.com/UnoraApp/be/pkg/logger"
+)
+
+// Account status errors
+var (
+	ErrAccountSuspended = errors.New("account suspended")
+	ErrAccountDeleted   = errors.New("account deleted")
+)
+
+// accountStatusCacheTTL bounds how long a status change made without
+// invalidating the cache (e.g. directly in the database) takes to apply
+const accountStatusCacheTTL = time.Minute
+
+// AccountStatusCache looks up users' account status for every authenticated
+// request, caching it briefly in Redis. Status changes made through the
+// admin API invalidate the cache so they apply at once.
+type AccountStatusCache struct {
+	entClient   *entgen.Client
+	redisClient *redis.Client
+}
+
+// NewAccountStatusCache creates a new account status cache
+func NewAccountStatusCache(entClient *entgen.Client, redisClient *redis.Client) *AccountStatusCache {
+	return &AccountStatusCache{
+		entClient:   entClient,
+		redisClient: redisClient,
+	}
+}
+
+// Check returns ErrAccountSuspended or ErrAccountDeleted if the user may
+// not use the API
+func (c *AccountStatusCache) Check(ctx context.Context, userID string) error {
+	key := accountStatusKey(userID)
+
+	status, err := c.redisClient.Get(ctx, key).Result()
+	switch {
+	case err == nil:
+		return accountStatusError(entuser.AccountStatus(status))
+	case err != redis.Nil:
+		// Fall back to the database rather than failing every request
+		log := logger.GetLogger("auth")
+		log.Warn().Err(err).Str("user_id", userID).Msg("Failed to get cached account status")
+	}
+
+	u, err := c.entClient.User.
+		Query().
+		Where(entuser.IDEQ(userID)).
+		Select(entuser.FieldAccountStatus, entuser.FieldDeletedAt).
+		Only(ctx)
+	if err != nil && !entgen.IsNotFound(err) {
+		return fmt.Errorf("failed to get account status: %w", err)
+	}
+
+	accountStatus := entuser.AccountStatusDeleted
+	if u != nil && u.DeletedAt == nil {
+		accountStatus = u.AccountStatus
+	}
+
+	c.redisClient.Set(ctx, key, string(accountStatus), accountStatusCacheTTL)
+	return accountStatusError(accountStatus)
+}
+
+// Invalidate drops the user's cached status after it changed
+func (c *AccountStatusCache) Invalidate(ctx context.Context, userID string) error {
+	if err := c.redisClient.Del(ctx, accountStatusKey(userID)).Err(); err != nil {
+		return fmt.Errorf("failed to invalidate account status: %w", err)
+	}
+	return nil
+}
+
+func accountStatusKey(userID string) string {
+	return "account_status:" + userID
+}
+
+// accountStatusError maps an account status to the error it is refused with
+func accountStatusError(status entuser.AccountStatus) error {
+	switch status {
+	case entuser.AccountStatusSuspended:
+		return ErrAccountSuspended
+	case entuser.AccountStatusDeleted:
+		return ErrAccountDeleted
+	default:
+		return nil
+	}
+}
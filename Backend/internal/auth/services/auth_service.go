@@ -3,7 +3,9 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -11,16 +13,28 @@ import (
 
 	entgen "github.com/UnoraApp/be/ent/generated"
 	entuser "github.com/UnoraApp/be/ent/generated/user"
+	"github.com/UnoraApp/be/ent/generated/useridentity"
 	"github.com/UnoraApp/be/internal/auth/dto"
 	"github.com/UnoraApp/be/internal/config"
+	"github.com/UnoraApp/be/pkg/database"
+	"github.com/UnoraApp/be/pkg/logger"
 )
 
+// maxDeviceIDLength is the longest device ID stored with a new user
+const maxDeviceIDLength = 128
+
 // AuthService handles authentication business logic
 type AuthService struct {
 	entClient     *entgen.Client
 	redisClient   *redis.Client
 	googleService *GoogleService
+	appleService  *AppleService
+	identities    *IdentityService
 	tokenService  *TokenService
+	otpService    *OTPService
+	sessions      *SessionService
+	devices       *DeviceService
+	accountStatus *AccountStatusCache
 	cfg           *config.Config
 }
 
@@ -30,17 +44,24 @@ func NewAuthService(
 	redisClient *redis.Client,
 	cfg *config.Config,
 ) *AuthService {
+	tokenService := NewTokenService(cfg)
 	return &AuthService{
 		entClient:     entClient,
 		redisClient:   redisClient,
-		googleService: NewGoogleService(),
-		tokenService:  NewTokenService(cfg),
+		googleService: NewGoogleService(cfg),
+		appleService:  NewAppleService(cfg),
+		identities:    NewIdentityService(entClient),
+		tokenService:  tokenService,
+		otpService:    NewOTPService(redisClient, NewSMSProvider(cfg), cfg),
+		sessions:      NewSessionService(entClient, redisClient, tokenService),
+		devices:       NewDeviceService(entClient, cfg),
+		accountStatus: NewAccountStatusCache(entClient, redisClient),
 		cfg:           cfg,
 	}
 }
 
 // GoogleLogin handles Google OAuth login flow for mobile apps
-func (s *AuthService) GoogleLogin(ctx context.Context, req *dto.GoogleOAuthRequest) (*dto.AuthResponse, error) {
+func (s *AuthService) GoogleLogin(ctx context.Context, req *dto.GoogleOAuthRequest, client ClientInfo) (*dto.AuthResponse, error) {
 	// 1. Verify Google ID token and extract user info
 	googleUser, err := s.googleService.VerifyIDToken(ctx, req.IDToken)
 	if err != nil {
@@ -48,29 +69,214 @@ func (s *AuthService) GoogleLogin(ctx context.Context, req *dto.GoogleOAuthReque
 	}
 
 	// 2. Find or create user in database
-	user, err := s.findOrCreateUser(ctx, googleUser)
+	client = client.withDevice(req.DeviceID, req.DeviceName, req.Platform)
+	user, err := s.findOrCreateIdentityUser(ctx, googleIdentity(googleUser), req.ReferralCode, client.DeviceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find/create user: %w", err)
+	}
+
+	// 3. Start a session for the device
+	return s.issueTokens(ctx, user, "google", client)
+}
+
+// AppleLogin handles Sign in with Apple. Apple shares the user's name with
+// the app only on first sign-in, so the app sends it along for a new user.
+func (s *AuthService) AppleLogin(ctx context.Context, req *dto.AppleSignInRequest, client ClientInfo) (*dto.AuthResponse, error) {
+	// 1. Verify Apple identity token and extract user info
+	appleUser, err := s.appleService.VerifyIDToken(ctx, req.IdentityToken, req.Nonce)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify Apple token: %w", err)
+	}
+
+	// 2. Find or create user in database
+	ext := appleIdentity(appleUser)
+	ext.FirstName = strings.TrimSpace(req.FirstName)
+	ext.LastName = strings.TrimSpace(req.LastName)
+	ext.Name = strings.TrimSpace(ext.FirstName + " " + ext.LastName)
+
+	client = client.withDevice(req.DeviceID, req.DeviceName, req.Platform)
+	user, err := s.findOrCreateIdentityUser(ctx, ext, req.ReferralCode, client.DeviceID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to find/create user: %w", err)
 	}
 
-	// 3. Generate token pair
+	// 3. Start a session for the device
+	return s.issueTokens(ctx, user, "apple", client)
+}
+
+// ListIdentities returns the Google and Apple accounts the user signs in with
+func (s *AuthService) ListIdentities(ctx context.Context, userID string) ([]dto.IdentityResponse, error) {
+	return s.identities.List(ctx, userID)
+}
+
+// LinkIdentity links a Google or Apple account to the user, proven by an ID
+// token for it
+func (s *AuthService) LinkIdentity(ctx context.Context, userID, provider string, req *dto.LinkIdentityRequest) (*dto.IdentityResponse, error) {
+	var ext *externalIdentity
+	switch useridentity.Provider(provider) {
+	case useridentity.ProviderGoogle:
+		googleUser, err := s.googleService.VerifyIDToken(ctx, req.IDToken)
+		if err != nil {
+			return nil, fmt.Errorf("failed to verify Google token: %w", err)
+		}
+		ext = googleIdentity(googleUser)
+	case useridentity.ProviderApple:
+		appleUser, err := s.appleService.VerifyIDToken(ctx, req.IDToken, req.Nonce)
+		if err != nil {
+			return nil, fmt.Errorf("failed to verify Apple token: %w", err)
+		}
+		ext = appleIdentity(appleUser)
+	default:
+		return nil, ErrUnsupportedProvider
+	}
+
+	return s.identities.Link(ctx, userID, ext)
+}
+
+// UnlinkIdentity unlinks the user's Google or Apple account
+func (s *AuthService) UnlinkIdentity(ctx context.Context, userID, provider string) error {
+	return s.identities.Unlink(ctx, userID, provider)
+}
+
+func googleIdentity(u *dto.GoogleUserInfo) *externalIdentity {
+	return &externalIdentity{
+		Provider:  useridentity.ProviderGoogle,
+		Subject:   u.ID,
+		Email:     u.Email,
+		Name:      u.Name,
+		FirstName: u.FirstName,
+		LastName:  u.LastName,
+		Picture:   u.Picture,
+	}
+}
+
+func appleIdentity(u *dto.AppleUserInfo) *externalIdentity {
+	return &externalIdentity{
+		Provider:       useridentity.ProviderApple,
+		Subject:        u.ID,
+		Email:          u.Email,
+		IsPrivateEmail: u.IsPrivateEmail,
+	}
+}
+
+// RequestPhoneOTP sends a login code by SMS to the phone number
+func (s *AuthService) RequestPhoneOTP(ctx context.Context, req *dto.OTPRequest, clientIP string) (*dto.OTPRequestResponse, error) {
+	result, err := s.otpService.RequestOTP(ctx, req.Phone, clientIP)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.OTPRequestResponse{
+		Phone:       result.Phone.E164(),
+		ExpiresIn:   result.ExpiresIn,
+		ResendAfter: result.ResendAfter,
+	}, nil
+}
+
+// PhoneLogin logs in with a code sent by RequestPhoneOTP. The user with the
+// phone number is marked phone-verified, or created if there is none, and
+// gets the same token pair as a Google login.
+func (s *AuthService) PhoneLogin(ctx context.Context, req *dto.OTPVerifyRequest, client ClientInfo) (*dto.AuthResponse, error) {
+	// 1. Check the code
+	phone, err := s.otpService.NormalizePhone(req.Phone)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.otpService.VerifyOTP(ctx, phone, req.Code, client.IP); err != nil {
+		return nil, err
+	}
+
+	// 2. Find or create user in database
+	client = client.withDevice(req.DeviceID, req.DeviceName, req.Platform)
+	user, err := s.findOrCreatePhoneUser(ctx, phone, req.ReferralCode, client.DeviceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find/create user: %w", err)
+	}
+
+	// 3. Start a session for the device
+	return s.issueTokens(ctx, user, "phone", client)
+}
+
+// LinkPhone verifies a phone number for a signed-in user, with a code sent by
+// RequestPhoneOTP, and marks them phone-verified. This is how users who
+// signed up with Google or Apple verify a phone. A number of another account
+// cannot be linked, nor a second number.
+func (s *AuthService) LinkPhone(ctx context.Context, userID string, req *dto.LinkPhoneRequest, clientIP string) (*dto.LinkPhoneResponse, error) {
+	phone, err := s.otpService.NormalizePhone(req.Phone)
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := s.entClient.User.Get(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	if u.PhoneNumber != nil && *u.PhoneNumber != "" {
+		if u.PhoneCountryCode+*u.PhoneNumber != phone.E164() {
+			return nil, ErrPhoneAlreadyLinked
+		}
+	}
+
+	if err := s.otpService.VerifyOTP(ctx, phone, req.Code, clientIP); err != nil {
+		return nil, err
+	}
+
+	taken, err := s.entClient.User.
+		Query().
+		Where(entuser.PhoneCountryCodeEQ(phone.CountryCode)).
+		Where(entuser.PhoneNumberEQ(phone.National)).
+		Where(entuser.IDNEQ(userID)).
+		Where(entuser.DeletedAtIsNil()).
+		Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query user: %w", err)
+	}
+	if taken {
+		return nil, ErrPhoneInUse
+	}
+
+	update := s.entClient.User.
+		UpdateOneID(userID).
+		SetPhoneCountryCode(phone.CountryCode).
+		SetPhoneNumber(phone.National).
+		SetPhoneHash(s.PhoneHash(phone))
+	// Later verification steps imply the phone was verified
+	if u.VerificationStatus == entuser.VerificationStatusPending {
+		update.SetVerificationStatus(entuser.VerificationStatusPhoneVerified)
+	}
+	if _, err := update.Save(ctx); err != nil {
+		return nil, fmt.Errorf("failed to link phone number: %w", err)
+	}
+
+	return &dto.LinkPhoneResponse{Phone: phone.E164()}, nil
+}
+
+// PhoneHash returns the keyed hash of the phone number kept for fraud
+// checks, which survives account deletion
+func (s *AuthService) PhoneHash(phone PhoneNumber) string {
+	return HashPhoneNumber(s.cfg.Auth.PhoneHashKey, phone)
+}
+
+// issueTokens starts a session for the user on the client's device and
+// attaches the user's info to its token pair
+func (s *AuthService) issueTokens(ctx context.Context, user *UserRecord, provider string, client ClientInfo) (*dto.AuthResponse, error) {
+	if err := accountStatusError(user.AccountStatus); err != nil {
+		return nil, err
+	}
+
+	s.devices.Record(ctx, user.ID, client, true)
+
 	tokenPayload := dto.TokenPayload{
 		UserID:   user.ID,
 		Email:    user.Email,
-		Provider: "google",
+		Provider: provider,
 	}
 
-	authResponse, err := s.tokenService.GenerateTokenPair(tokenPayload)
+	authResponse, err := s.sessions.Create(ctx, tokenPayload, client)
 	if err != nil {
-		return nil, fmt.Errorf("failed to generate tokens: %w", err)
-	}
-
-	// 4. Store refresh token in Redis for revocation capability
-	if err := s.storeRefreshToken(ctx, user.ID, authResponse.RefreshToken); err != nil {
-		return nil, fmt.Errorf("failed to store refresh token: %w", err)
+		return nil, err
 	}
 
-	// 5. Attach user info to response
 	authResponse.User = dto.UserResponse{
 		ID:        user.ID,
 		Email:     user.Email,
@@ -78,6 +284,7 @@ func (s *AuthService) GoogleLogin(ctx context.Context, req *dto.GoogleOAuthReque
 		FirstName: user.FirstName,
 		LastName:  user.LastName,
 		Picture:   user.Picture,
+		Phone:     user.Phone,
 		Provider:  user.Provider,
 		CreatedAt: user.CreatedAt,
 	}
@@ -85,113 +292,329 @@ func (s *AuthService) GoogleLogin(ctx context.Context, req *dto.GoogleOAuthReque
 	return authResponse, nil
 }
 
-// RefreshToken handles token refresh
-func (s *AuthService) RefreshToken(ctx context.Context, req *dto.RefreshTokenRequest) (*dto.RefreshTokenResponse, error) {
-	// 1. Validate the refresh token exists in Redis (not revoked)
-	payload, err := s.tokenService.ValidateToken(req.RefreshToken, "refresh")
+// RefreshToken rotates the refresh token of a session: the response has a
+// new refresh token and the presented one stops working. Presenting it again
+// revokes the session.
+func (s *AuthService) RefreshToken(ctx context.Context, req *dto.RefreshTokenRequest, client ClientInfo) (*dto.RefreshTokenResponse, error) {
+	payload, tokenID, err := s.tokenService.ValidateRefreshToken(req.RefreshToken)
 	if err != nil {
 		return nil, fmt.Errorf("invalid refresh token: %w", err)
 	}
 
-	// 2. Check if refresh token is in Redis (not revoked)
+	if err := s.accountStatus.Check(ctx, payload.UserID); err != nil {
+		return nil, err
+	}
+
+	var response *dto.RefreshTokenResponse
+	if payload.SessionID == "" {
+		response, err = s.migrateLegacyRefreshToken(ctx, payload, req.RefreshToken, client)
+	} else {
+		response, err = s.sessions.Refresh(ctx, payload, tokenID, client)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	s.devices.Record(ctx, payload.UserID, client, false)
+	return response, nil
+}
+
+// migrateLegacyRefreshToken moves a refresh token issued before sessions
+// existed, one per user in Redis, into a new session
+func (s *AuthService) migrateLegacyRefreshToken(ctx context.Context, payload *dto.TokenPayload, refreshToken string, client ClientInfo) (*dto.RefreshTokenResponse, error) {
 	key := fmt.Sprintf("refresh_token:%s", payload.UserID)
 	storedToken, err := s.redisClient.Get(ctx, key).Result()
-	if err == redis.Nil || storedToken != req.RefreshToken {
-		return nil, fmt.Errorf("refresh token revoked or expired")
+	if err == redis.Nil || (err == nil && storedToken != refreshToken) {
+		return nil, ErrSessionRevoked
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify refresh token: %w", err)
 	}
+
+	// Only the request that deletes the token may use it
+	deleted, err := s.redisClient.Del(ctx, key).Result()
 	if err != nil {
 		return nil, fmt.Errorf("failed to verify refresh token: %w", err)
 	}
+	if deleted == 0 {
+		return nil, ErrSessionRevoked
+	}
 
-	// 3. Generate new access token
-	response, err := s.tokenService.RefreshAccessToken(req.RefreshToken)
+	authResponse, err := s.sessions.Create(ctx, *payload, client)
 	if err != nil {
-		return nil, fmt.Errorf("failed to refresh token: %w", err)
+		return nil, err
 	}
 
-	return response, nil
+	return &dto.RefreshTokenResponse{
+		AccessToken:  authResponse.AccessToken,
+		RefreshToken: authResponse.RefreshToken,
+		ExpiresIn:    authResponse.ExpiresIn,
+		TokenType:    authResponse.TokenType,
+	}, nil
 }
 
-// Logout revokes all tokens for a user
-func (s *AuthService) Logout(ctx context.Context, userID string) error {
-	key := fmt.Sprintf("refresh_token:%s", userID)
-	return s.redisClient.Del(ctx, key).Err()
+// Logout ends the session the access token belongs to. Tokens issued before
+// sessions existed carry no session, so all of the user's sessions end.
+func (s *AuthService) Logout(ctx context.Context, userID, sessionID string) error {
+	if err := s.redisClient.Del(ctx, fmt.Sprintf("refresh_token:%s", userID)).Err(); err != nil {
+		return err
+	}
+
+	if sessionID == "" {
+		_, err := s.sessions.RevokeAll(ctx, userID, "", SessionRevokedLogout)
+		return err
+	}
+
+	err := s.sessions.Revoke(ctx, userID, sessionID, SessionRevokedLogout)
+	if err == ErrSessionNotFound {
+		// Already logged out
+		return nil
+	}
+	return err
 }
 
-// ValidateAccessToken validates an access token and returns the payload
-func (s *AuthService) ValidateAccessToken(token string) (*dto.TokenPayload, error) {
-	return s.tokenService.ValidateToken(token, "access")
+// ListSessions returns the user's active sessions, marking the current one
+func (s *AuthService) ListSessions(ctx context.Context, userID, currentSessionID string) ([]dto.SessionResponse, error) {
+	return s.sessions.List(ctx, userID, currentSessionID)
+}
+
+// RevokeSession logs one of the user's devices out
+func (s *AuthService) RevokeSession(ctx context.Context, userID, sessionID string) error {
+	return s.sessions.Revoke(ctx, userID, sessionID, SessionRevokedByUser)
+}
+
+// RevokeOtherSessions logs out all of the user's devices but the current one
+func (s *AuthService) RevokeOtherSessions(ctx context.Context, userID, currentSessionID string) (*dto.RevokeSessionsResponse, error) {
+	revoked, err := s.sessions.RevokeAll(ctx, userID, currentSessionID, SessionRevokedByUser)
+	if err != nil {
+		return nil, err
+	}
+	return &dto.RevokeSessionsResponse{Revoked: revoked}, nil
+}
+
+// ValidateAccessToken validates an access token and returns the payload.
+// Tokens of revoked sessions are refused; if that cannot be checked the
+// token is let through until it expires rather than failing every request.
+// Suspended and deleted accounts are refused with ErrAccountSuspended and
+// ErrAccountDeleted.
+func (s *AuthService) ValidateAccessToken(ctx context.Context, token string) (*dto.TokenPayload, error) {
+	payload, err := s.tokenService.ValidateToken(token, "access")
+	if err != nil {
+		return nil, err
+	}
+
+	if payload.SessionID != "" {
+		revoked, err := s.sessions.IsRevoked(ctx, payload.SessionID)
+		if err != nil {
+			log := logger.GetLogger("auth")
+			log.Warn().Err(err).Str("session_id", payload.SessionID).Msg("Failed to check session revocation")
+		} else if revoked {
+			return nil, ErrSessionRevoked
+		}
+	}
+
+	if err := s.accountStatus.Check(ctx, payload.UserID); err != nil {
+		if errors.Is(err, ErrAccountSuspended) || errors.Is(err, ErrAccountDeleted) {
+			return nil, err
+		}
+		log := logger.GetLogger("auth")
+		log.Warn().Err(err).Str("user_id", payload.UserID).Msg("Failed to check account status")
+	}
+
+	return payload, nil
+}
+
+// RevokeAccountAccess ends all of the user's sessions after their account
+// was suspended or deleted, so neither their access nor their refresh tokens
+// work any more
+func (s *AuthService) RevokeAccountAccess(ctx context.Context, userID, reason string) error {
+	if err := s.accountStatus.Invalidate(ctx, userID); err != nil {
+		return err
+	}
+	_, err := s.sessions.RevokeAll(ctx, userID, "", reason)
+	return err
+}
+
+// InvalidateAccountStatus applies a change of the user's account status,
+// e.g. unsuspension, to their next request
+func (s *AuthService) InvalidateAccountStatus(ctx context.Context, userID string) error {
+	return s.accountStatus.Invalidate(ctx, userID)
 }
 
 // UserRecord is an internal representation of user data
 type UserRecord struct {
-	ID        string
-	Email     string
-	Name      string
-	FirstName string
-	LastName  string
-	Picture   string
-	Provider  string
-	CreatedAt string
-}
-
-// findOrCreateUser finds an existing user by email or creates a new one using Ent ORM
-func (s *AuthService) findOrCreateUser(ctx context.Context, googleUser *dto.GoogleUserInfo) (*UserRecord, error) {
-	// Try to find existing user by email
+	ID            string
+	Email         string
+	Name          string
+	FirstName     string
+	LastName      string
+	Picture       string
+	Phone         string // E.164
+	Provider      string
+	AccountStatus entuser.AccountStatus
+	CreatedAt     string
+}
+
+// newUserRecord converts an Ent user
+func newUserRecord(u *entgen.User) *UserRecord {
+	phone := ""
+	if u.PhoneNumber != nil && *u.PhoneNumber != "" {
+		phone = u.PhoneCountryCode + *u.PhoneNumber
+	}
+	return &UserRecord{
+		ID:            u.ID,
+		Email:         ptrToString(u.Email),
+		Name:          ptrToString(u.Name),
+		FirstName:     ptrToString(u.FirstName),
+		LastName:      ptrToString(u.LastName),
+		Picture:       ptrToString(u.Picture),
+		Phone:         phone,
+		Provider:      ptrToString(u.Provider),
+		AccountStatus: u.AccountStatus,
+		CreatedAt:     u.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+// findOrCreateIdentityUser finds the user signing in with a Google or Apple
+// account or creates one. A new user is attributed to the referral code they
+// signed up with, if any.
+func (s *AuthService) findOrCreateIdentityUser(ctx context.Context, ext *externalIdentity, referralCode, deviceID string) (*UserRecord, error) {
+	existingUser, err := s.identities.FindUser(ctx, ext)
+	if err != nil {
+		return nil, err
+	}
+	if existingUser != nil {
+		return newUserRecord(existingUser), nil
+	}
+
+	// The email of an existing account is never taken over by a new one
+	if err := s.identities.CheckEmailAvailable(ctx, ext); err != nil {
+		return nil, err
+	}
+
+	// A device ID that does not fit is dropped rather than failing signup
+	if len(deviceID) > maxDeviceIDLength {
+		deviceID = ""
+	}
+
+	var newUser *entgen.User
+	err = database.WithTx(ctx, s.entClient, func(tx *entgen.Tx) error {
+		var err error
+		newUser, err = tx.User.
+			Create().
+			SetID(uuid.New().String()).
+			SetNillableEmail(strPtr(ext.Email)).
+			SetNillableName(strPtr(ext.Name)).
+			SetNillableFirstName(strPtr(ext.FirstName)).
+			SetNillableLastName(strPtr(ext.LastName)).
+			SetNillablePicture(strPtr(ext.Picture)).
+			SetProvider(string(ext.Provider)).
+			SetProviderUserID(ext.Subject).
+			SetNillableSignupDeviceID(strPtr(deviceID)).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to create user: %w", err)
+		}
+		return s.identities.create(ctx, tx.Client(), newUser.ID, ext)
+	})
+	if entgen.IsConstraintError(err) {
+		// A concurrent first sign-in created the user
+		existingUser, ferr := s.identities.FindUser(ctx, ext)
+		if ferr == nil && existingUser != nil {
+			return newUserRecord(existingUser), nil
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if referralCode != "" {
+		s.attributeReferral(ctx, newUser.ID, referralCode, deviceID)
+	}
+
+	return newUserRecord(newUser), nil
+}
+
+// findOrCreatePhoneUser finds the user with a verified phone number or
+// creates one. The phone is stored as country code and national number.
+func (s *AuthService) findOrCreatePhoneUser(ctx context.Context, phone PhoneNumber, referralCode, deviceID string) (*UserRecord, error) {
 	existingUser, err := s.entClient.User.
 		Query().
-		Where(entuser.EmailEQ(googleUser.Email)).
+		Where(entuser.PhoneCountryCodeEQ(phone.CountryCode)).
+		Where(entuser.PhoneNumberEQ(phone.National)).
 		Where(entuser.DeletedAtIsNil()).
-		Only(ctx)
-	
+		Order(entgen.Asc(entuser.FieldCreatedAt)).
+		First(ctx)
 	if err == nil {
-		// User found, return it
-		return &UserRecord{
-			ID:        existingUser.ID,
-			Email:     ptrToString(existingUser.Email),
-			Name:      ptrToString(existingUser.Name),
-			FirstName: ptrToString(existingUser.FirstName),
-			LastName:  ptrToString(existingUser.LastName),
-			Picture:   ptrToString(existingUser.Picture),
-			Provider:  ptrToString(existingUser.Provider),
-			CreatedAt: existingUser.CreatedAt.Format(time.RFC3339),
-		}, nil
-	}
-
-	// Check if error is "not found" - if so, create new user
+		// Later verification steps imply the phone was verified. Users from
+		// before phone hashes get theirs.
+		pending := existingUser.VerificationStatus == entuser.VerificationStatusPending
+		if pending || existingUser.PhoneHash == nil {
+			update := existingUser.Update().SetPhoneHash(s.PhoneHash(phone))
+			if pending {
+				update.SetVerificationStatus(entuser.VerificationStatusPhoneVerified)
+			}
+			existingUser, err = update.Save(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to update verification status: %w", err)
+			}
+		}
+		return newUserRecord(existingUser), nil
+	}
 	if !entgen.IsNotFound(err) {
 		return nil, fmt.Errorf("failed to query user: %w", err)
 	}
 
-	// Create new user in database
-	userID := uuid.New().String()
-	
+	// A device ID that does not fit is dropped rather than failing signup
+	if len(deviceID) > maxDeviceIDLength {
+		deviceID = ""
+	}
+
 	newUser, err := s.entClient.User.
 		Create().
-		SetID(userID).
-		SetEmail(googleUser.Email).
-		SetName(googleUser.Name).
-		SetFirstName(googleUser.FirstName).
-		SetLastName(googleUser.LastName).
-		SetPicture(googleUser.Picture).
-		SetProvider("google").
-		SetProviderUserID(googleUser.ID).
+		SetID(uuid.New().String()).
+		SetPhoneCountryCode(phone.CountryCode).
+		SetPhoneNumber(phone.National).
+		SetPhoneHash(s.PhoneHash(phone)).
+		SetProvider("phone").
+		SetVerificationStatus(entuser.VerificationStatusPhoneVerified).
+		SetNillableSignupDeviceID(strPtr(deviceID)).
 		Save(ctx)
-	
 	if err != nil {
 		return nil, fmt.Errorf("failed to create user: %w", err)
 	}
 
-	return &UserRecord{
-		ID:        newUser.ID,
-		Email:     ptrToString(newUser.Email),
-		Name:      ptrToString(newUser.Name),
-		FirstName: ptrToString(newUser.FirstName),
-		LastName:  ptrToString(newUser.LastName),
-		Picture:   ptrToString(newUser.Picture),
-		Provider:  ptrToString(newUser.Provider),
-		CreatedAt: newUser.CreatedAt.Format(time.RFC3339),
-	}, nil
+	if referralCode != "" {
+		s.attributeReferral(ctx, newUser.ID, referralCode, deviceID)
+	}
+
+	return newUserRecord(newUser), nil
+}
+
+// attributeReferral records that a new user signed up with another user's
+// referral code. The bonus is paid later, once the referee reaches their first
+// streak milestone and passes the fraud checks. An unknown code is ignored so
+// that it never blocks signup.
+func (s *AuthService) attributeReferral(ctx context.Context, refereeID, referralCode, deviceID string) {
+	code := strings.ToUpper(strings.TrimSpace(referralCode))
+
+	referrer, err := s.entClient.User.
+		Query().
+		Where(entuser.ReferralCodeEQ(code)).
+		Where(entuser.DeletedAtIsNil()).
+		Only(ctx)
+	if err != nil {
+		return
+	}
+
+	_, _ = s.entClient.Referral.
+		Create().
+		SetID(uuid.New().String()).
+		SetReferrerID(referrer.ID).
+		SetRefereeID(refereeID).
+		SetCode(code).
+		SetNillableDeviceID(strPtr(deviceID)).
+		Save(ctx)
 }
 
 // ptrToString safely dereferences a string pointer, returning empty string if nil
@@ -202,9 +625,10 @@ func ptrToString(s *string) string {
 	return *s
 }
 
-// storeRefreshToken stores refresh token in Redis for revocation capability
-func (s *AuthService) storeRefreshToken(ctx context.Context, userID string, token string) error {
-	key := fmt.Sprintf("refresh_token:%s", userID)
-	expiry := time.Duration(s.cfg.Auth.JWTRefreshTokenExpiryDays) * 24 * time.Hour
-	return s.redisClient.Set(ctx, key, token, expiry).Err()
+// strPtr returns nil for an empty string
+func strPtr(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
 }
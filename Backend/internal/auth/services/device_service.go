@@ -0,0 +1,249 @@
+// internal/auth/services/device_service.go
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	entgen "github.com/UnoraApp/be/ent/generated"
+	"github.com/UnoraApp/be/ent/generated/deviceriskflag"
+	entuser "github.com/UnoraApp/be/ent/generated/user"
+	"github.com/UnoraApp/be/ent/generated/userdevice"
+	"github.com/UnoraApp/be/ent/generated/userreport"
+	"github.com/UnoraApp/be/internal/config"
+	"github.com/UnoraApp/be/pkg/database"
+	"github.com/UnoraApp/be/pkg/logger"
+)
+
+// ReferenceTypeDeviceRiskFlag is the report reference type for device risk
+// flags
+const ReferenceTypeDeviceRiskFlag = "device_risk_flag"
+
+// DeviceService keeps the registry of devices users log in on and flags
+// suspicious patterns among them for safety review: many accounts on one
+// device, a user churning through new devices, and devices shared with
+// suspended accounts.
+//
+// Device IDs come from the X-Device-ID header and are whatever the client
+// sends; attestation tokens are stored as present but never verified. A
+// flag is a lead for a moderator, not proof: a client can share, rotate or
+// forge its device ID.
+type DeviceService struct {
+	entClient *entgen.Client
+	cfg       *config.AuthConfig
+}
+
+// NewDeviceService creates a new device service
+func NewDeviceService(entClient *entgen.Client, cfg *config.Config) *DeviceService {
+	return &DeviceService{
+		entClient: entClient,
+		cfg:       &cfg.Auth,
+	}
+}
+
+// Record registers the device of a login or token refresh. Logins are
+// checked against the risk rules; refreshes only when the device is new to
+// the user. Failures are logged and never fail the login.
+func (s *DeviceService) Record(ctx context.Context, userID string, client ClientInfo, login bool) {
+	deviceID := strings.TrimSpace(client.DeviceID)
+	if deviceID == "" || len(deviceID) > maxDeviceIDLength {
+		return
+	}
+
+	log := logger.GetLogger("auth")
+
+	isNew, err := s.register(ctx, userID, deviceID, client, login)
+	if err != nil {
+		log.Error().Err(err).Str("user_id", userID).Msg("Failed to register device")
+		return
+	}
+	if !login && !isNew {
+		return
+	}
+
+	if err := s.checkRules(ctx, userID, deviceID); err != nil {
+		log.Error().Err(err).Str("user_id", userID).Msg("Failed to check device risk rules")
+	}
+}
+
+// register links the device to the user, reporting whether it is new to them
+func (s *DeviceService) register(ctx context.Context, userID, deviceID string, client ClientInfo, login bool) (bool, error) {
+	attestation := userdevice.AttestationStatusMissing
+	if client.Attestation != "" {
+		attestation = userdevice.AttestationStatusUnverified
+	}
+	platform := userdevice.Platform(sessionPlatform(client.Platform))
+
+	existing, err := s.entClient.UserDevice.
+		Query().
+		Where(userdevice.DeviceIDEQ(deviceID)).
+		Where(userdevice.UserIDEQ(userID)).
+		Only(ctx)
+	if err != nil && !entgen.IsNotFound(err) {
+		return false, fmt.Errorf("failed to get device: %w", err)
+	}
+
+	if existing == nil {
+		loginCount := 0
+		if login {
+			loginCount = 1
+		}
+		_, err = s.entClient.UserDevice.
+			Create().
+			SetID(uuid.New().String()).
+			SetUserID(userID).
+			SetDeviceID(deviceID).
+			SetPlatform(platform).
+			SetAttestationStatus(attestation).
+			SetLoginCount(loginCount).
+			Save(ctx)
+		if err == nil {
+			return true, nil
+		}
+		if !entgen.IsConstraintError(err) {
+			return false, fmt.Errorf("failed to register device: %w", err)
+		}
+		// Registered by a concurrent request
+		return false, nil
+	}
+
+	update := existing.Update().
+		SetLastSeenAt(time.Now())
+	if platform != userdevice.PlatformUnknown {
+		update = update.SetPlatform(platform)
+	}
+	if login {
+		update = update.
+			AddLoginCount(1).
+			SetAttestationStatus(attestation)
+	}
+	if _, err := update.Save(ctx); err != nil {
+		return false, fmt.Errorf("failed to update device: %w", err)
+	}
+	return false, nil
+}
+
+// checkRules flags the login if the device or the user matches a risk rule
+func (s *DeviceService) checkRules(ctx context.Context, userID, deviceID string) error {
+	// Accounts on the device
+	accountIDs, err := s.entClient.UserDevice.
+		Query().
+		Where(userdevice.DeviceIDEQ(deviceID)).
+		Select(userdevice.FieldUserID).
+		Strings(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get device accounts: %w", err)
+	}
+	if len(accountIDs) > s.cfg.DeviceMaxAccounts {
+		details := fmt.Sprintf("%d accounts on this device: %s", len(accountIDs), strings.Join(accountIDs, ", "))
+		if err := s.flag(ctx, userID, deviceID, deviceriskflag.RuleMultiAccountDevice, details); err != nil {
+			return err
+		}
+	}
+
+	// Other accounts on the device that are suspended
+	var otherIDs []string
+	for _, id := range accountIDs {
+		if id != userID {
+			otherIDs = append(otherIDs, id)
+		}
+	}
+	if len(otherIDs) > 0 {
+		suspendedIDs, err := s.entClient.User.
+			Query().
+			Where(entuser.IDIn(otherIDs...)).
+			Where(entuser.AccountStatusEQ(entuser.AccountStatusSuspended)).
+			IDs(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get suspended accounts: %w", err)
+		}
+		if len(suspendedIDs) > 0 {
+			details := "Device shared with suspended accounts: " + strings.Join(suspendedIDs, ", ")
+			if err := s.flag(ctx, userID, deviceID, deviceriskflag.RuleSuspendedAccountDevice, details); err != nil {
+				return err
+			}
+		}
+	}
+
+	// New devices of the user within the churn window
+	window := time.Duration(s.cfg.DeviceChurnWindowDays) * 24 * time.Hour
+	newDevices, err := s.entClient.UserDevice.
+		Query().
+		Where(userdevice.UserIDEQ(userID)).
+		Where(userdevice.FirstSeenAtGT(time.Now().Add(-window))).
+		Count(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to count user devices: %w", err)
+	}
+	if newDevices > s.cfg.DeviceChurnMaxDevices {
+		details := fmt.Sprintf("%d new devices in the last %d days", newDevices, s.cfg.DeviceChurnWindowDays)
+		if err := s.flag(ctx, userID, deviceID, deviceriskflag.RuleDeviceChurn, details); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// flag queues a rule match for review, unless the same match is already
+// waiting for review
+func (s *DeviceService) flag(ctx context.Context, userID, deviceID string, rule deviceriskflag.Rule, details string) error {
+	query := s.entClient.DeviceRiskFlag.
+		Query().
+		Where(deviceriskflag.UserIDEQ(userID)).
+		Where(deviceriskflag.RuleEQ(rule)).
+		Where(deviceriskflag.FlagStatusEQ(deviceriskflag.FlagStatusPending))
+	// Churn is about the user, not one device
+	if rule != deviceriskflag.RuleDeviceChurn {
+		query = query.Where(deviceriskflag.DeviceIDEQ(deviceID))
+	}
+	pending, err := query.Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to check risk flags: %w", err)
+	}
+	if pending {
+		return nil
+	}
+
+	// The flag also goes into the safety review queue as a report raised by
+	// the system
+	err = database.WithTx(ctx, s.entClient, func(tx *entgen.Tx) error {
+		f, err := tx.DeviceRiskFlag.
+			Create().
+			SetID(uuid.New().String()).
+			SetUserID(userID).
+			SetDeviceID(deviceID).
+			SetRule(rule).
+			SetDetails(details).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to create risk flag: %w", err)
+		}
+
+		_, err = tx.UserReport.
+			Create().
+			SetID(uuid.New().String()).
+			SetReportedUserID(userID).
+			SetReportReason(userreport.ReportReasonDeviceRisk).
+			SetDescription(fmt.Sprintf("%s on device %s (client-reported, not attested): %s", rule, deviceID, details)).
+			SetReferenceType(ReferenceTypeDeviceRiskFlag).
+			SetReferenceID(f.ID).
+			SetReportStatus(userreport.ReportStatusPending).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to create risk report: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	log := logger.GetLogger("auth")
+	log.Warn().Str("user_id", userID).Str("device_id", deviceID).Str("rule", string(rule)).Msg("Device risk flag raised")
+	return nil
+}
@@ -0,0 +1,51 @@
+// internal/auth/services/device_service_test.go
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/UnoraApp/be/ent/generated/deviceriskflag"
+	"github.com/UnoraApp/be/ent/generated/userreport"
+	"github.com/UnoraApp/be/internal/config"
+	"github.com/UnoraApp/be/pkg/database/databasetest"
+)
+
+// TestRecordQueuesRiskReport has a second account log in on a device: the
+// flag must reach the safety review queue as a report, once.
+func TestRecordQueuesRiskReport(t *testing.T) {
+	ctx := context.Background()
+	client := databasetest.NewClient(t)
+	devices := NewDeviceService(client, &config.Config{Auth: config.AuthConfig{
+		DeviceMaxAccounts:     1,
+		DeviceChurnMaxDevices: 10,
+		DeviceChurnWindowDays: 7,
+	}})
+
+	device := ClientInfo{DeviceID: uuid.New().String(), Platform: "android"}
+	var userID string
+	for range 2 {
+		userID = client.User.Create().SetID(uuid.New().String()).SaveX(ctx).ID
+		devices.Record(ctx, userID, device, true)
+	}
+	// Logging in again must not queue the same match twice
+	devices.Record(ctx, userID, device, true)
+
+	flag := client.DeviceRiskFlag.Query().OnlyX(ctx)
+	if flag.UserID != userID || flag.Rule != deviceriskflag.RuleMultiAccountDevice {
+		t.Fatalf("flag = %s %s, want %s multi_account_device", flag.UserID, flag.Rule, userID)
+	}
+
+	report := client.UserReport.Query().OnlyX(ctx)
+	if report.ReporterUserID != nil || report.ReportedUserID != userID {
+		t.Errorf("report reporter = %v, reported = %s", report.ReporterUserID, report.ReportedUserID)
+	}
+	if report.ReportReason != userreport.ReportReasonDeviceRisk || report.ReportStatus != userreport.ReportStatusPending {
+		t.Errorf("report reason = %s, status = %s", report.ReportReason, report.ReportStatus)
+	}
+	if ptrToString(report.ReferenceType) != ReferenceTypeDeviceRiskFlag || ptrToString(report.ReferenceID) != flag.ID {
+		t.Errorf("report reference = %v %v, want flag %s", report.ReferenceType, report.ReferenceID, flag.ID)
+	}
+}
@@ -3,83 +3,79 @@ package services
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
-	"net/http"
-	"time"
 
 	"github.com/UnoraApp/be/internal/auth/dto"
+	"github.com/UnoraApp/be/internal/config"
 )
 
-// GoogleService handles Google OAuth operations
+// ErrGoogleClientIDsNotConfigured is returned when no client ID is set, since
+// tokens could not be checked to be meant for us
+var ErrGoogleClientIDsNotConfigured = errors.New("google client IDs are not configured")
+
+// GoogleService handles Google OAuth operations. ID tokens are verified
+// locally against Google's signing keys, which are cached.
 type GoogleService struct {
-	httpClient *http.Client
+	verifier *idTokenVerifier
+}
+
+// NewGoogleService creates a new Google service that fetches keys from Google
+func NewGoogleService(cfg *config.Config) *GoogleService {
+	return NewGoogleServiceWithKeyFetcher(cfg, NewJWKSKeyFetcher(GoogleCertsURL))
 }
 
-// NewGoogleService creates a new Google service
-func NewGoogleService() *GoogleService {
+// NewGoogleServiceWithKeyFetcher creates a Google service with its own key
+// source, e.g. a local JWKS in tests
+func NewGoogleServiceWithKeyFetcher(cfg *config.Config, keyFetcher KeyFetcher) *GoogleService {
+	var clientIDs []string
+	for _, id := range []string{cfg.Auth.GoogleAndroidClientID, cfg.Auth.GoogleIOSClientID, cfg.Auth.GoogleWebClientID} {
+		if id != "" {
+			clientIDs = append(clientIDs, id)
+		}
+	}
+	issuers := []string{"accounts.google.com", "https://accounts.google.com"}
 	return &GoogleService{
-		httpClient: &http.Client{Timeout: 10 * time.Second},
+		verifier: newIDTokenVerifier("Google", keyFetcher, issuers, clientIDs),
 	}
 }
 
-// googleTokenInfo is the response from Google's token verification endpoint
-type googleTokenInfo struct {
-	Iss           string `json:"iss"`
-	Azp           string `json:"azp"`
-	Aud           string `json:"aud"`
-	Sub           string `json:"sub"`
-	Email         string `json:"email"`
-	EmailVerified string `json:"email_verified"`
-	Name          string `json:"name"`
-	GivenName     string `json:"given_name"`
-	FamilyName    string `json:"family_name"`
-	Picture       string `json:"picture"`
-	Locale        string `json:"locale"`
-	Iat           string `json:"iat"`
-	Exp           string `json:"exp"`
-	Alg           string `json:"alg"`
-	Kid           string `json:"kid"`
-	Typ           string `json:"typ"`
+// googleIDTokenClaims are the ID token claims we use
+type googleIDTokenClaims struct {
+	idTokenClaims
+	Name       string `json:"name"`
+	GivenName  string `json:"given_name"`
+	FamilyName string `json:"family_name"`
+	Picture    string `json:"picture"`
 }
 
-// VerifyIDToken verifies a Google ID token and returns user info
+// VerifyIDToken verifies a Google ID token and returns user info. The token
+// must be RS256-signed by a current Google key, issued by Google to one of our
+// client IDs, unexpired, and for a verified email address.
 func (s *GoogleService) VerifyIDToken(ctx context.Context, idToken string) (*dto.GoogleUserInfo, error) {
-	// Google's token info endpoint for verifying ID tokens
-	url := fmt.Sprintf("https://oauth2.googleapis.com/tokeninfo?id_token=%s", idToken)
-
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
-	}
-
-	resp, err := s.httpClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to verify token: %w", err)
+	if len(s.verifier.audiences) == 0 {
+		return nil, ErrGoogleClientIDsNotConfigured
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("invalid token: status %d", resp.StatusCode)
+	var claims googleIDTokenClaims
+	if err := s.verifier.verify(ctx, idToken, &claims); err != nil {
+		return nil, err
 	}
 
-	var tokenInfo googleTokenInfo
-	if err := json.NewDecoder(resp.Body).Decode(&tokenInfo); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
+	if claims.Email == "" {
+		return nil, fmt.Errorf("invalid token: missing email")
 	}
-
-	// Validate issuer
-	if tokenInfo.Iss != "accounts.google.com" && tokenInfo.Iss != "https://accounts.google.com" {
-		return nil, fmt.Errorf("invalid token issuer: %s", tokenInfo.Iss)
+	if !claims.EmailVerified {
+		return nil, fmt.Errorf("email address is not verified")
 	}
 
 	return &dto.GoogleUserInfo{
-		ID:            tokenInfo.Sub,
-		Email:         tokenInfo.Email,
-		EmailVerified: tokenInfo.EmailVerified == "true",
-		Name:          tokenInfo.Name,
-		FirstName:     tokenInfo.GivenName,
-		LastName:      tokenInfo.FamilyName,
-		Picture:       tokenInfo.Picture,
+		ID:            claims.Sub,
+		Email:         claims.Email,
+		EmailVerified: bool(claims.EmailVerified),
+		Name:          claims.Name,
+		FirstName:     claims.GivenName,
+		LastName:      claims.FamilyName,
+		Picture:       claims.Picture,
 	}, nil
 }
@@ -0,0 +1,240 @@
+// internal/auth/services/google_service_test.go
+package services
+
+import (
+	"context"
+	"crypto"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/UnoraApp/be/internal/config"
+)
+
+const testGoogleClientID = "test-client.apps.googleusercontent.com"
+
+// testJWKS returns a JWKS document publishing key under kid
+func testJWKS(t *testing.T, kid string, key *rsa.PublicKey) []byte {
+	t.Helper()
+
+	doc, err := json.Marshal(map[string]interface{}{
+		"keys": []map[string]string{{
+			"kid": kid,
+			"kty": "RSA",
+			"alg": "RS256",
+			"use": "sig",
+			"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return doc
+}
+
+// mintIDToken signs claims as a JWT with the header; RS256 uses key, HS256
+// uses secret
+func mintIDToken(t *testing.T, header, claims map[string]interface{}, key *rsa.PrivateKey, secret []byte) string {
+	t.Helper()
+
+	encode := func(v interface{}) string {
+		data, err := json.Marshal(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return base64.RawURLEncoding.EncodeToString(data)
+	}
+	signingInput := encode(header) + "." + encode(claims)
+
+	var signature []byte
+	switch header["alg"] {
+	case "RS256":
+		digest := sha256.Sum256([]byte(signingInput))
+		var err error
+		signature, err = rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+		if err != nil {
+			t.Fatal(err)
+		}
+	case "HS256":
+		mac := hmac.New(sha256.New, secret)
+		mac.Write([]byte(signingInput))
+		signature = mac.Sum(nil)
+	}
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func TestGoogleVerifyIDToken(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fetcher, err := NewStaticKeyFetcher(testJWKS(t, "key-1", &key.PublicKey))
+	if err != nil {
+		t.Fatalf("NewStaticKeyFetcher: %v", err)
+	}
+	service := NewGoogleServiceWithKeyFetcher(&config.Config{
+		Auth: config.AuthConfig{GoogleAndroidClientID: testGoogleClientID},
+	}, fetcher)
+
+	validClaims := func() map[string]interface{} {
+		now := time.Now()
+		return map[string]interface{}{
+			"iss":            "https://accounts.google.com",
+			"aud":            testGoogleClientID,
+			"sub":            "google-user-1",
+			"email":          "user@example.com",
+			"email_verified": true,
+			"name":           "Test User",
+			"iat":            now.Unix(),
+			"exp":            now.Add(time.Hour).Unix(),
+		}
+	}
+	rs256 := map[string]interface{}{"alg": "RS256", "kid": "key-1", "typ": "JWT"}
+	publicKeyBytes := key.PublicKey.N.Bytes()
+
+	tests := []struct {
+		name    string
+		token   func() string
+		wantErr string // part of the error, if rejected
+	}{
+		{
+			name:  "valid token",
+			token: func() string { return mintIDToken(t, rs256, validClaims(), key, nil) },
+		},
+		{
+			name: "email_verified as a string",
+			token: func() string {
+				claims := validClaims()
+				claims["email_verified"] = "true"
+				return mintIDToken(t, rs256, claims, key, nil)
+			},
+		},
+		{
+			name: "wrong audience",
+			token: func() string {
+				claims := validClaims()
+				claims["aud"] = "someone-else.apps.googleusercontent.com"
+				return mintIDToken(t, rs256, claims, key, nil)
+			},
+			wantErr: "invalid token audience",
+		},
+		{
+			name: "wrong issuer",
+			token: func() string {
+				claims := validClaims()
+				claims["iss"] = "https://accounts.example.com"
+				return mintIDToken(t, rs256, claims, key, nil)
+			},
+			wantErr: "invalid token issuer",
+		},
+		{
+			name: "expired",
+			token: func() string {
+				claims := validClaims()
+				claims["iat"] = time.Now().Add(-2 * time.Hour).Unix()
+				claims["exp"] = time.Now().Add(-time.Hour).Unix()
+				return mintIDToken(t, rs256, claims, key, nil)
+			},
+			wantErr: "token expired",
+		},
+		{
+			name: "email not verified",
+			token: func() string {
+				claims := validClaims()
+				claims["email_verified"] = false
+				return mintIDToken(t, rs256, claims, key, nil)
+			},
+			wantErr: "email address is not verified",
+		},
+		{
+			name: "unknown key ID",
+			token: func() string {
+				header := map[string]interface{}{"alg": "RS256", "kid": "key-2", "typ": "JWT"}
+				return mintIDToken(t, header, validClaims(), otherKey, nil)
+			},
+			wantErr: "unknown signing key",
+		},
+		{
+			name: "signed by another key under a known key ID",
+			token: func() string {
+				return mintIDToken(t, rs256, validClaims(), otherKey, nil)
+			},
+			wantErr: "invalid token signature",
+		},
+		{
+			name: "HS256 keyed with the public key",
+			token: func() string {
+				header := map[string]interface{}{"alg": "HS256", "kid": "key-1", "typ": "JWT"}
+				return mintIDToken(t, header, validClaims(), nil, publicKeyBytes)
+			},
+			wantErr: "unsupported algorithm",
+		},
+		{
+			name: "alg none",
+			token: func() string {
+				header := map[string]interface{}{"alg": "none", "kid": "key-1", "typ": "JWT"}
+				return mintIDToken(t, header, validClaims(), nil, nil)
+			},
+			wantErr: "unsupported algorithm",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info, err := service.VerifyIDToken(context.Background(), tt.token())
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("VerifyIDToken error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("VerifyIDToken: %v", err)
+			}
+			if info.ID != "google-user-1" || info.Email != "user@example.com" || !info.EmailVerified {
+				t.Errorf("user info = %+v", info)
+			}
+		})
+	}
+}
+
+func TestJWKSKeyFetcher(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	jwksJSON := testJWKS(t, "key-1", &key.PublicKey)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Cache-Control", "public, max-age=19845, must-revalidate, no-transform")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(jwksJSON)
+	}))
+	defer server.Close()
+
+	keys, maxAge, err := NewJWKSKeyFetcher(server.URL).FetchKeys(context.Background())
+	if err != nil {
+		t.Fatalf("FetchKeys: %v", err)
+	}
+	if maxAge != 19845*time.Second {
+		t.Errorf("max age = %s, want 19845s", maxAge)
+	}
+	if got, ok := keys["key-1"]; !ok || !got.Equal(&key.PublicKey) {
+		t.Errorf("key-1 missing or different: %v", keys)
+	}
+}
@@ -0,0 +1,318 @@
+// internal/auth/services/identity_service.go
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	entgen "github.com/UnoraApp/be/ent/generated"
+	entuser "github.com/UnoraApp/be/ent/generated/user"
+	"github.com/UnoraApp/be/ent/generated/useridentity"
+	"github.com/UnoraApp/be/internal/auth/dto"
+	"github.com/UnoraApp/be/pkg/logger"
+)
+
+// Identity errors
+var (
+	ErrUnsupportedProvider   = errors.New("unsupported sign-in provider")
+	ErrIdentityInUse         = errors.New("this account is already linked to another user")
+	ErrProviderAlreadyLinked = errors.New("another account of this provider is already linked; unlink it first")
+	ErrIdentityNotLinked     = errors.New("provider not linked")
+	ErrLastSignInMethod      = errors.New("cannot unlink the only way to sign in")
+	ErrEmailInUse            = errors.New("an account with this email already exists; sign in to it and link this provider instead")
+	ErrPhoneInUse            = errors.New("this phone number belongs to another account")
+	ErrPhoneAlreadyLinked    = errors.New("another phone number is already linked to this account")
+)
+
+// externalIdentity is a Google or Apple account whose ID token was verified
+type externalIdentity struct {
+	Provider       useridentity.Provider
+	Subject        string
+	Email          string // verified by the provider, or empty
+	IsPrivateEmail bool
+
+	// Profile for a new user
+	Name      string
+	FirstName string
+	LastName  string
+	Picture   string
+}
+
+// IdentityService keeps the Google and Apple accounts users sign in with.
+// A user may link one account per provider, and an account belongs to one
+// user: linking never moves an identity between users, and signing in
+// never attaches an identity to an existing user by email, so holding a
+// token for an address is not enough to enter someone else's account.
+type IdentityService struct {
+	entClient *entgen.Client
+}
+
+// NewIdentityService creates a new identity service
+func NewIdentityService(entClient *entgen.Client) *IdentityService {
+	return &IdentityService{
+		entClient: entClient,
+	}
+}
+
+// FindUser returns the user signing in with the identity, or nil if it is
+// new. Users who signed up with Google before identities existed are found
+// by their Google ID and get the identity attached.
+func (s *IdentityService) FindUser(ctx context.Context, ext *externalIdentity) (*entgen.User, error) {
+	identity, err := s.entClient.UserIdentity.
+		Query().
+		Where(useridentity.ProviderEQ(ext.Provider)).
+		Where(useridentity.SubjectEQ(ext.Subject)).
+		Only(ctx)
+	if err != nil && !entgen.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to get identity: %w", err)
+	}
+
+	if identity != nil {
+		u, err := s.entClient.User.
+			Query().
+			Where(entuser.IDEQ(identity.UserID)).
+			Where(entuser.DeletedAtIsNil()).
+			Only(ctx)
+		if entgen.IsNotFound(err) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get user: %w", err)
+		}
+
+		_, err = identity.Update().
+			SetLastUsedAt(time.Now()).
+			SetNillableEmail(strPtr(ext.Email)).
+			SetIsPrivateEmail(ext.IsPrivateEmail).
+			Save(ctx)
+		if err != nil {
+			log := logger.GetLogger("auth")
+			log.Warn().Err(err).Str("user_id", u.ID).Msg("Failed to update identity")
+		}
+		return u, nil
+	}
+
+	// Signed up before identities existed
+	u, err := s.entClient.User.
+		Query().
+		Where(entuser.ProviderEQ(string(ext.Provider))).
+		Where(entuser.ProviderUserIDEQ(ext.Subject)).
+		Where(entuser.DeletedAtIsNil()).
+		First(ctx)
+	if entgen.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to query user: %w", err)
+	}
+	if err := s.create(ctx, s.entClient, u.ID, ext); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+// CheckEmailAvailable returns ErrEmailInUse if another account has the
+// identity's email, so a new user cannot be created with it
+func (s *IdentityService) CheckEmailAvailable(ctx context.Context, ext *externalIdentity) error {
+	if ext.Email == "" {
+		return nil
+	}
+	taken, err := s.entClient.User.
+		Query().
+		Where(entuser.EmailEQ(ext.Email)).
+		Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query user: %w", err)
+	}
+	if taken {
+		return ErrEmailInUse
+	}
+	return nil
+}
+
+// Link attaches the identity to the user. It fails if the identity belongs
+// to another user, the user has another account of the provider linked, or
+// the identity's email is another user's. A user without an email gets the
+// identity's.
+func (s *IdentityService) Link(ctx context.Context, userID string, ext *externalIdentity) (*dto.IdentityResponse, error) {
+	existing, err := s.entClient.UserIdentity.
+		Query().
+		Where(useridentity.ProviderEQ(ext.Provider)).
+		Where(useridentity.SubjectEQ(ext.Subject)).
+		Only(ctx)
+	if err != nil && !entgen.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to get identity: %w", err)
+	}
+	if existing != nil {
+		if existing.UserID != userID {
+			return nil, ErrIdentityInUse
+		}
+		// Already linked
+		return identityToResponse(existing), nil
+	}
+
+	linked, err := s.entClient.UserIdentity.
+		Query().
+		Where(useridentity.UserIDEQ(userID)).
+		Where(useridentity.ProviderEQ(ext.Provider)).
+		Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get identities: %w", err)
+	}
+	if linked {
+		return nil, ErrProviderAlreadyLinked
+	}
+
+	u, err := s.entClient.User.Get(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	if ext.Email != "" && ptrToString(u.Email) != ext.Email {
+		taken, err := s.entClient.User.
+			Query().
+			Where(entuser.EmailEQ(ext.Email)).
+			Where(entuser.IDNEQ(userID)).
+			Exist(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query user: %w", err)
+		}
+		if taken {
+			return nil, ErrIdentityInUse
+		}
+	}
+
+	err = s.create(ctx, s.entClient, userID, ext)
+	if entgen.IsConstraintError(err) {
+		// Linked by a concurrent request, to this user or another
+		return nil, ErrIdentityInUse
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if u.Email == nil && ext.Email != "" {
+		if _, err := u.Update().SetEmail(ext.Email).Save(ctx); err != nil {
+			log := logger.GetLogger("auth")
+			log.Warn().Err(err).Str("user_id", userID).Msg("Failed to set email from linked identity")
+		}
+	}
+
+	identity, err := s.entClient.UserIdentity.
+		Query().
+		Where(useridentity.UserIDEQ(userID)).
+		Where(useridentity.ProviderEQ(ext.Provider)).
+		Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get identity: %w", err)
+	}
+
+	log := logger.GetLogger("auth")
+	log.Info().Str("user_id", userID).Str("provider", string(ext.Provider)).Msg("Identity linked")
+	return identityToResponse(identity), nil
+}
+
+// Unlink detaches the user's account of the provider. The user must keep a
+// way to sign in: another identity or a phone number.
+func (s *IdentityService) Unlink(ctx context.Context, userID, provider string) error {
+	p := useridentity.Provider(provider)
+	if useridentity.ProviderValidator(p) != nil {
+		return ErrUnsupportedProvider
+	}
+
+	identity, err := s.entClient.UserIdentity.
+		Query().
+		Where(useridentity.UserIDEQ(userID)).
+		Where(useridentity.ProviderEQ(p)).
+		Only(ctx)
+	if entgen.IsNotFound(err) {
+		return ErrIdentityNotLinked
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get identity: %w", err)
+	}
+
+	others, err := s.entClient.UserIdentity.
+		Query().
+		Where(useridentity.UserIDEQ(userID)).
+		Where(useridentity.IDNEQ(identity.ID)).
+		Count(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to count identities: %w", err)
+	}
+	u, err := s.entClient.User.Get(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+	if others == 0 && ptrToString(u.PhoneNumber) == "" {
+		return ErrLastSignInMethod
+	}
+
+	if err := s.entClient.UserIdentity.DeleteOne(identity).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to unlink identity: %w", err)
+	}
+
+	// Otherwise FindUser would attach it again as a pre-identity signup
+	if ptrToString(u.Provider) == provider && ptrToString(u.ProviderUserID) == identity.Subject {
+		if _, err := u.Update().ClearProviderUserID().Save(ctx); err != nil {
+			return fmt.Errorf("failed to unlink identity: %w", err)
+		}
+	}
+
+	log := logger.GetLogger("auth")
+	log.Info().Str("user_id", userID).Str("provider", provider).Msg("Identity unlinked")
+	return nil
+}
+
+// List returns the user's linked identities
+func (s *IdentityService) List(ctx context.Context, userID string) ([]dto.IdentityResponse, error) {
+	identities, err := s.entClient.UserIdentity.
+		Query().
+		Where(useridentity.UserIDEQ(userID)).
+		Order(entgen.Asc(useridentity.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get identities: %w", err)
+	}
+
+	result := make([]dto.IdentityResponse, len(identities))
+	for i, identity := range identities {
+		result[i] = *identityToResponse(identity)
+	}
+	return result, nil
+}
+
+// create records the identity for the user, with client being a
+// transaction when the user is created alongside
+func (s *IdentityService) create(ctx context.Context, client *entgen.Client, userID string, ext *externalIdentity) error {
+	_, err := client.UserIdentity.
+		Create().
+		SetID(uuid.New().String()).
+		SetUserID(userID).
+		SetProvider(ext.Provider).
+		SetSubject(ext.Subject).
+		SetNillableEmail(strPtr(ext.Email)).
+		SetIsPrivateEmail(ext.IsPrivateEmail).
+		SetLastUsedAt(time.Now()).
+		Save(ctx)
+	if entgen.IsConstraintError(err) {
+		return err
+	}
+	if err != nil {
+		return fmt.Errorf("failed to create identity: %w", err)
+	}
+	return nil
+}
+
+func identityToResponse(identity *entgen.UserIdentity) *dto.IdentityResponse {
+	return &dto.IdentityResponse{
+		Provider:       string(identity.Provider),
+		Email:          ptrToString(identity.Email),
+		IsPrivateEmail: identity.IsPrivateEmail,
+		LinkedAt:       identity.CreatedAt,
+		LastUsedAt:     identity.LastUsedAt,
+	}
+}
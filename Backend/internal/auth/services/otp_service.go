@@ -0,0 +1,215 @@
+// internal/auth/services/otp_service.go
+package services
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/UnoraApp/be/internal/config"
+)
+
+// OTP errors
+var (
+	ErrOTPCooldown         = errors.New("a code was sent recently; wait before requesting another")
+	ErrOTPRateLimited      = errors.New("too many code requests; try again later")
+	ErrOTPExpired          = errors.New("code expired or was not requested")
+	ErrOTPInvalid          = errors.New("incorrect code")
+	ErrOTPAttemptsExceeded = errors.New("too many incorrect codes; request a new one")
+)
+
+const (
+	otpDigits         = 6
+	otpSendsWindow    = 24 * time.Hour
+	otpIPLimitsWindow = time.Hour
+)
+
+// OTPService sends one-time login codes by SMS and verifies them. Codes are
+// kept in Redis only as an HMAC, expire after a TTL, and are discarded after
+// too many wrong guesses. Requests are throttled per phone number and per
+// client IP.
+type OTPService struct {
+	redisClient         *redis.Client
+	smsProvider         SMSProvider
+	cfg                 *config.AuthConfig
+	allowedCountryCodes []string
+}
+
+// OTPRequestResult describes a code that was sent
+type OTPRequestResult struct {
+	Phone       PhoneNumber
+	ExpiresIn   int // seconds
+	ResendAfter int // seconds
+}
+
+// NewOTPService creates a new OTP service
+func NewOTPService(redisClient *redis.Client, smsProvider SMSProvider, cfg *config.Config) *OTPService {
+	return &OTPService{
+		redisClient:         redisClient,
+		smsProvider:         smsProvider,
+		cfg:                 &cfg.Auth,
+		allowedCountryCodes: strings.Split(cfg.Auth.AllowedCountryCodes, ","),
+	}
+}
+
+// NormalizePhone parses a phone number as entered by the user
+func (s *OTPService) NormalizePhone(raw string) (PhoneNumber, error) {
+	return NormalizePhoneNumber(raw, s.cfg.DefaultCountryCode, s.allowedCountryCodes)
+}
+
+// RequestOTP sends a new login code to the phone number, replacing any code
+// sent before
+func (s *OTPService) RequestOTP(ctx context.Context, rawPhone, clientIP string) (*OTPRequestResult, error) {
+	phone, err := s.NormalizePhone(rawPhone)
+	if err != nil {
+		return nil, err
+	}
+	e164 := phone.E164()
+
+	sends, err := s.hit(ctx, "otp:sends:ip:"+clientIP, otpIPLimitsWindow)
+	if err != nil {
+		return nil, err
+	}
+	if sends > int64(s.cfg.OTPMaxSendsPerIPPerHour) {
+		return nil, ErrOTPRateLimited
+	}
+
+	cooldown := time.Duration(s.cfg.OTPResendCooldownSeconds) * time.Second
+	cooldownKey := "otp:cooldown:" + e164
+	ok, err := s.redisClient.SetNX(ctx, cooldownKey, 1, cooldown).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to check OTP cooldown: %w", err)
+	}
+	if !ok {
+		return nil, ErrOTPCooldown
+	}
+
+	sends, err = s.hit(ctx, "otp:sends:phone:"+e164, otpSendsWindow)
+	if err != nil {
+		return nil, err
+	}
+	if sends > int64(s.cfg.OTPMaxSendsPerPhonePerDay) {
+		return nil, ErrOTPRateLimited
+	}
+
+	code, err := generateOTP()
+	if err != nil {
+		return nil, err
+	}
+
+	ttl := time.Duration(s.cfg.OTPTTLSeconds) * time.Second
+	_, err = s.redisClient.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, "otp:code:"+e164, s.hashOTP(e164, code), ttl)
+		pipe.Del(ctx, "otp:attempts:"+e164)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to store OTP: %w", err)
+	}
+
+	message := fmt.Sprintf("%s is your Unora login code. It expires in %d minutes. Do not share it with anyone.", code, max(s.cfg.OTPTTLSeconds/60, 1))
+	if err := s.smsProvider.Send(ctx, e164, message); err != nil {
+		// Let the user retry straight away
+		s.redisClient.Del(ctx, cooldownKey, "otp:code:"+e164)
+		return nil, fmt.Errorf("failed to send OTP: %w", err)
+	}
+
+	return &OTPRequestResult{
+		Phone:       phone,
+		ExpiresIn:   s.cfg.OTPTTLSeconds,
+		ResendAfter: s.cfg.OTPResendCooldownSeconds,
+	}, nil
+}
+
+// VerifyOTP checks a code sent to the phone number. A correct code is used up.
+func (s *OTPService) VerifyOTP(ctx context.Context, phone PhoneNumber, code, clientIP string) error {
+	e164 := phone.E164()
+
+	verifies, err := s.hit(ctx, "otp:verifies:ip:"+clientIP, otpIPLimitsWindow)
+	if err != nil {
+		return err
+	}
+	if verifies > int64(s.cfg.OTPMaxVerifiesPerIPPerHour) {
+		return ErrOTPRateLimited
+	}
+
+	codeKey := "otp:code:" + e164
+	attemptsKey := "otp:attempts:" + e164
+
+	stored, err := s.redisClient.Get(ctx, codeKey).Result()
+	if err == redis.Nil {
+		return ErrOTPExpired
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get OTP: %w", err)
+	}
+
+	attempts, err := s.hit(ctx, attemptsKey, time.Duration(s.cfg.OTPTTLSeconds)*time.Second)
+	if err != nil {
+		return err
+	}
+	if attempts > int64(s.cfg.OTPMaxAttempts) {
+		s.redisClient.Del(ctx, codeKey, attemptsKey)
+		return ErrOTPAttemptsExceeded
+	}
+
+	if !hmac.Equal([]byte(stored), []byte(s.hashOTP(e164, strings.TrimSpace(code)))) {
+		return ErrOTPInvalid
+	}
+
+	// Only the request that deletes the code may use it
+	deleted, err := s.redisClient.Del(ctx, codeKey).Result()
+	if err != nil {
+		return fmt.Errorf("failed to consume OTP: %w", err)
+	}
+	if deleted == 0 {
+		return ErrOTPExpired
+	}
+	s.redisClient.Del(ctx, attemptsKey)
+	return nil
+}
+
+// hit counts an event in a fixed window starting at its first event
+func (s *OTPService) hit(ctx context.Context, key string, window time.Duration) (int64, error) {
+	count, err := s.redisClient.Incr(ctx, key).Result()
+	if err != nil {
+		return 0, fmt.Errorf("failed to update rate limit: %w", err)
+	}
+	if count == 1 {
+		if err := s.redisClient.Expire(ctx, key, window).Err(); err != nil {
+			return 0, fmt.Errorf("failed to update rate limit: %w", err)
+		}
+	}
+	return count, nil
+}
+
+// hashOTP keys the code's hash to the phone number and the server secret, so
+// a leaked Redis value can neither be reversed offline nor replayed for
+// another number
+func (s *OTPService) hashOTP(e164, code string) string {
+	mac := hmac.New(sha256.New, []byte(s.cfg.SecretKey))
+	mac.Write([]byte(e164 + ":" + code))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// generateOTP returns a random numeric code
+func generateOTP() (string, error) {
+	limit := big.NewInt(1)
+	for i := 0; i < otpDigits; i++ {
+		limit.Mul(limit, big.NewInt(10))
+	}
+	n, err := rand.Int(rand.Reader, limit)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate OTP: %w", err)
+	}
+	return fmt.Sprintf("%0*d", otpDigits, n), nil
+}
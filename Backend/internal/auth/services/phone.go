@@ -0,0 +1,90 @@
+// internal/auth/services/phone.go
+package services
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+)
+
+// ErrInvalidPhoneNumber is returned for numbers that are not valid E.164 or
+// not in an allowed country
+var ErrInvalidPhoneNumber = errors.New("invalid phone number")
+
+// PhoneNumber is a phone number split into its country calling code and
+// national number
+type PhoneNumber struct {
+	CountryCode string // e.g. +91
+	National    string // e.g. 9876543210
+}
+
+// E164 formats the number as E.164, e.g. +919876543210
+func (p PhoneNumber) E164() string {
+	return p.CountryCode + p.National
+}
+
+// HashPhoneNumber returns the keyed hash of the number stored for fraud
+// checks: hex HMAC-SHA256 of its E.164 form
+func HashPhoneNumber(key string, p PhoneNumber) string {
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write([]byte(p.E164()))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// NormalizePhoneNumber parses a phone number as users type it: with or
+// without a country code, with spaces, dashes, dots or brackets, and with a
+// leading trunk 0. A number without a country code is taken to be in
+// defaultCountryCode. The country code must be one of allowedCountryCodes.
+func NormalizePhoneNumber(raw, defaultCountryCode string, allowedCountryCodes []string) (PhoneNumber, error) {
+	var digits strings.Builder
+	international := false
+	for i, r := range strings.TrimSpace(raw) {
+		switch {
+		case r >= '0' && r <= '9':
+			digits.WriteRune(r)
+		case r == '+' && i == 0:
+			international = true
+		case r == ' ' || r == '-' || r == '.' || r == '(' || r == ')':
+		default:
+			return PhoneNumber{}, ErrInvalidPhoneNumber
+		}
+	}
+
+	number := digits.String()
+	if !international && strings.HasPrefix(number, "00") {
+		international = true
+		number = number[2:]
+	}
+	if !international {
+		number = strings.TrimPrefix(defaultCountryCode, "+") + strings.TrimLeft(number, "0")
+	}
+
+	// E.164 allows at most 15 digits including the country code
+	if len(number) < 8 || len(number) > 15 {
+		return PhoneNumber{}, ErrInvalidPhoneNumber
+	}
+
+	for _, cc := range allowedCountryCodes {
+		code := strings.TrimPrefix(strings.TrimSpace(cc), "+")
+		if code == "" || !strings.HasPrefix(number, code) {
+			continue
+		}
+		phone := PhoneNumber{CountryCode: "+" + code, National: number[len(code):]}
+		if !validNationalNumber(phone) {
+			return PhoneNumber{}, ErrInvalidPhoneNumber
+		}
+		return phone, nil
+	}
+	return PhoneNumber{}, ErrInvalidPhoneNumber
+}
+
+// validNationalNumber checks the national number's length and, for India,
+// that it is a mobile number
+func validNationalNumber(p PhoneNumber) bool {
+	if p.CountryCode == "+91" {
+		return len(p.National) == 10 && p.National[0] >= '6' && p.National[0] <= '9'
+	}
+	return len(p.National) >= 4 && p.National[0] != '0'
+}
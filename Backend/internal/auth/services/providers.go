@@ -8,6 +8,9 @@ import (
 // ProviderSet defines the Wire provider set for auth services
 var ProviderSet = wire.NewSet(
 	NewGoogleService,
+	NewAppleService,
 	NewTokenService,
+	NewSMSProvider,
+	NewOTPService,
 	NewAuthService,
 )
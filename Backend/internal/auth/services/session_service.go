@@ -0,0 +1,296 @@
+// internal/auth/services/session_service.go
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+
+	entgen "github.com/UnoraApp/be/ent/generated"
+	"github.com/UnoraApp/be/ent/generated/usersession"
+	"github.com/UnoraApp/be/internal/auth/dto"
+)
+
+// Session revocation reasons
+const (
+	SessionRevokedLogout     = "logout"
+	SessionRevokedByUser     = "user_revoked"
+	SessionRevokedTokenReuse = "token_reuse"
+	SessionRevokedSuspended  = "account_suspended"
+	SessionRevokedDeleted    = "account_deleted"
+)
+
+// Session errors
+var (
+	ErrSessionNotFound    = errors.New("session not found")
+	ErrSessionRevoked     = errors.New("session revoked or expired")
+	ErrRefreshTokenReused = errors.New("refresh token already used; session revoked")
+)
+
+const (
+	maxDeviceNameLength = 100
+	maxUserAgentLength  = 255
+	maxIPAddressLength  = 45
+)
+
+// ClientInfo describes the device and connection an auth request comes from
+type ClientInfo struct {
+	IP          string
+	UserAgent   string
+	DeviceID    string
+	DeviceName  string
+	Platform    string // android, ios or web
+	Attestation string // Play Integrity / App Attest token, not verified yet
+}
+
+// withDevice fills in device details the request body gave but the device
+// headers did not, for app versions that send them only in the body
+func (c ClientInfo) withDevice(deviceID, deviceName, platform string) ClientInfo {
+	if c.DeviceID == "" {
+		c.DeviceID = deviceID
+	}
+	if c.DeviceName == "" {
+		c.DeviceName = deviceName
+	}
+	if c.Platform == "" {
+		c.Platform = platform
+	}
+	return c
+}
+
+// SessionService manages sessions, one per signed-in device. Each refresh
+// rotates the session's refresh token; presenting a token that was already
+// rotated away means it was copied, so the whole session is revoked.
+type SessionService struct {
+	entClient    *entgen.Client
+	redisClient  *redis.Client
+	tokenService *TokenService
+}
+
+// NewSessionService creates a new session service
+func NewSessionService(entClient *entgen.Client, redisClient *redis.Client, tokenService *TokenService) *SessionService {
+	return &SessionService{
+		entClient:    entClient,
+		redisClient:  redisClient,
+		tokenService: tokenService,
+	}
+}
+
+// Create starts a session for a login and returns its token pair
+func (s *SessionService) Create(ctx context.Context, payload dto.TokenPayload, client ClientInfo) (*dto.AuthResponse, error) {
+	payload.SessionID = uuid.New().String()
+	tokenID := uuid.New().String()
+
+	authResponse, err := s.tokenService.GenerateTokenPair(payload, tokenID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate tokens: %w", err)
+	}
+
+	deviceID := client.DeviceID
+	if len(deviceID) > maxDeviceIDLength {
+		deviceID = ""
+	}
+
+	now := time.Now()
+	_, err = s.entClient.UserSession.
+		Create().
+		SetID(payload.SessionID).
+		SetUserID(payload.UserID).
+		SetRefreshTokenID(tokenID).
+		SetProvider(payload.Provider).
+		SetNillableDeviceID(strPtr(deviceID)).
+		SetNillableDeviceName(strPtr(truncate(client.DeviceName, maxDeviceNameLength))).
+		SetPlatform(sessionPlatform(client.Platform)).
+		SetNillableIPAddress(strPtr(truncate(client.IP, maxIPAddressLength))).
+		SetNillableUserAgent(strPtr(truncate(client.UserAgent, maxUserAgentLength))).
+		SetCreatedAt(now).
+		SetLastUsedAt(now).
+		SetExpiresAt(now.Add(s.tokenService.RefreshTokenTTL())).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create session: %w", err)
+	}
+
+	return authResponse, nil
+}
+
+// Refresh exchanges a refresh token for a new token pair of the same
+// session. The presented token stops working.
+func (s *SessionService) Refresh(ctx context.Context, payload *dto.TokenPayload, tokenID string, client ClientInfo) (*dto.RefreshTokenResponse, error) {
+	session, err := s.entClient.UserSession.Get(ctx, payload.SessionID)
+	if err != nil {
+		if entgen.IsNotFound(err) {
+			return nil, ErrSessionRevoked
+		}
+		return nil, fmt.Errorf("failed to get session: %w", err)
+	}
+	if session.UserID != payload.UserID || session.RevokedAt != nil || time.Now().After(session.ExpiresAt) {
+		return nil, ErrSessionRevoked
+	}
+
+	newTokenID := uuid.New().String()
+	pair, err := s.tokenService.GenerateTokenPair(*payload, newTokenID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate tokens: %w", err)
+	}
+
+	// Only the holder of the current token can rotate it
+	now := time.Now()
+	affected, err := s.entClient.UserSession.
+		Update().
+		Where(usersession.IDEQ(session.ID)).
+		Where(usersession.RefreshTokenIDEQ(tokenID)).
+		Where(usersession.RevokedAtIsNil()).
+		SetRefreshTokenID(newTokenID).
+		SetLastUsedAt(now).
+		SetExpiresAt(now.Add(s.tokenService.RefreshTokenTTL())).
+		SetNillableIPAddress(strPtr(truncate(client.IP, maxIPAddressLength))).
+		SetNillableUserAgent(strPtr(truncate(client.UserAgent, maxUserAgentLength))).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to rotate refresh token: %w", err)
+	}
+	if affected == 0 {
+		if _, err := s.revoke(ctx, []string{session.ID}, SessionRevokedTokenReuse); err != nil {
+			return nil, err
+		}
+		return nil, ErrRefreshTokenReused
+	}
+
+	return &dto.RefreshTokenResponse{
+		AccessToken:  pair.AccessToken,
+		RefreshToken: pair.RefreshToken,
+		ExpiresIn:    pair.ExpiresIn,
+		TokenType:    pair.TokenType,
+	}, nil
+}
+
+// List returns the user's active sessions, most recently used first
+func (s *SessionService) List(ctx context.Context, userID, currentSessionID string) ([]dto.SessionResponse, error) {
+	sessions, err := s.entClient.UserSession.
+		Query().
+		Where(usersession.UserIDEQ(userID)).
+		Where(usersession.RevokedAtIsNil()).
+		Where(usersession.ExpiresAtGT(time.Now())).
+		Order(entgen.Desc(usersession.FieldLastUsedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sessions: %w", err)
+	}
+
+	result := make([]dto.SessionResponse, len(sessions))
+	for i, session := range sessions {
+		result[i] = dto.SessionResponse{
+			ID:         session.ID,
+			DeviceName: ptrToString(session.DeviceName),
+			Platform:   string(session.Platform),
+			Provider:   session.Provider,
+			IPAddress:  ptrToString(session.IPAddress),
+			CreatedAt:  session.CreatedAt,
+			LastUsedAt: session.LastUsedAt,
+			Current:    session.ID == currentSessionID,
+		}
+	}
+	return result, nil
+}
+
+// Revoke ends one of the user's sessions
+func (s *SessionService) Revoke(ctx context.Context, userID, sessionID, reason string) error {
+	exists, err := s.entClient.UserSession.
+		Query().
+		Where(usersession.IDEQ(sessionID)).
+		Where(usersession.UserIDEQ(userID)).
+		Where(usersession.RevokedAtIsNil()).
+		Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get session: %w", err)
+	}
+	if !exists {
+		return ErrSessionNotFound
+	}
+
+	_, err = s.revoke(ctx, []string{sessionID}, reason)
+	return err
+}
+
+// RevokeAll ends all of the user's sessions except exceptSessionID, if set,
+// and returns how many were ended
+func (s *SessionService) RevokeAll(ctx context.Context, userID, exceptSessionID, reason string) (int, error) {
+	query := s.entClient.UserSession.
+		Query().
+		Where(usersession.UserIDEQ(userID)).
+		Where(usersession.RevokedAtIsNil())
+	if exceptSessionID != "" {
+		query = query.Where(usersession.IDNEQ(exceptSessionID))
+	}
+	ids, err := query.IDs(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get sessions: %w", err)
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	return s.revoke(ctx, ids, reason)
+}
+
+// IsRevoked reports whether access tokens of the session must be refused
+func (s *SessionService) IsRevoked(ctx context.Context, sessionID string) (bool, error) {
+	n, err := s.redisClient.Exists(ctx, revokedSessionKey(sessionID)).Result()
+	if err != nil {
+		return false, fmt.Errorf("failed to check session: %w", err)
+	}
+	return n > 0, nil
+}
+
+// revoke marks sessions revoked in the database and, for as long as their
+// access tokens can live, in Redis, where the auth middleware checks it
+func (s *SessionService) revoke(ctx context.Context, ids []string, reason string) (int, error) {
+	affected, err := s.entClient.UserSession.
+		Update().
+		Where(usersession.IDIn(ids...)).
+		Where(usersession.RevokedAtIsNil()).
+		SetRevokedAt(time.Now()).
+		SetRevokeReason(reason).
+		Save(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to revoke sessions: %w", err)
+	}
+
+	_, err = s.redisClient.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, id := range ids {
+			pipe.Set(ctx, revokedSessionKey(id), reason, s.tokenService.AccessTokenTTL())
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to revoke session tokens: %w", err)
+	}
+	return affected, nil
+}
+
+func revokedSessionKey(sessionID string) string {
+	return "session_revoked:" + sessionID
+}
+
+// sessionPlatform maps the platform reported by the app to a known one
+func sessionPlatform(platform string) usersession.Platform {
+	p := usersession.Platform(strings.ToLower(strings.TrimSpace(platform)))
+	if usersession.PlatformValidator(p) != nil {
+		return usersession.PlatformUnknown
+	}
+	return p
+}
+
+// truncate shortens s to at most n runes
+func truncate(s string, n int) string {
+	s = strings.TrimSpace(s)
+	if runes := []rune(s); len(runes) > n {
+		return string(runes[:n])
+	}
+	return s
+}
@@ -0,0 +1,36 @@
+// internal/auth/services/sms_provider.go
+package services
+
+import (
+	"context"
+
+	"github.com/UnoraApp/be/internal/config"
+	"github.com/UnoraApp/be/pkg/logger"
+)
+
+// SMSProvider sends text messages to phone numbers in E.164 format
+type SMSProvider interface {
+	Send(ctx context.Context, to, message string) error
+}
+
+// NewSMSProvider returns the SMS provider selected by SMS_PROVIDER. Console
+// is the only provider so far; the config loader rejects any other value.
+func NewSMSProvider(_ *config.Config) SMSProvider {
+	return NewConsoleSMSProvider()
+}
+
+// ConsoleSMSProvider writes messages to the log instead of sending them, so
+// that OTP login works in local runs without an SMS account
+type ConsoleSMSProvider struct{}
+
+// NewConsoleSMSProvider creates a console SMS provider
+func NewConsoleSMSProvider() *ConsoleSMSProvider {
+	return &ConsoleSMSProvider{}
+}
+
+// Send logs the message
+func (p *ConsoleSMSProvider) Send(_ context.Context, to, message string) error {
+	log := logger.GetLogger("sms")
+	log.Warn().Str("to", to).Str("message", message).Msg("SMS not sent (console provider)")
+	return nil
+}
@@ -0,0 +1,100 @@
+// internal/auth/services/token_keyring.go
+package services
+
+import (
+	"crypto/cipher"
+	"crypto/hkdf"
+	"crypto/sha256"
+	"fmt"
+
+	"golang.org/x/crypto/chacha20poly1305"
+
+	"github.com/UnoraApp/be/internal/config"
+)
+
+const (
+	// tokenKeySalt and tokenKeyInfo separate token keys from anything else
+	// derived from the same secret
+	tokenKeySalt = "unora-auth-token-key"
+	tokenKeyInfo = "xchacha20poly1305:"
+)
+
+// Keyring holds the keys tokens are encrypted with. The active key encrypts
+// new tokens; previous keys only decrypt, so tokens issued before a rotation
+// keep working until their key is retired from the config.
+//
+// Rotating: move the current AUTH_SECRET_KEY_ID and AUTH_SECRET_KEY into
+// AUTH_PREVIOUS_SECRET_KEYS, set a new ID and secret, and remove the old
+// pair once the refresh token lifetime has passed.
+type Keyring struct {
+	activeID string
+	keys     map[string]cipher.AEAD
+	legacy   []cipher.AEAD
+}
+
+// NewKeyring builds the keyring from the auth config
+func NewKeyring(cfg *config.AuthConfig) (*Keyring, error) {
+	k := &Keyring{
+		activeID: cfg.SecretKeyID,
+		keys:     make(map[string]cipher.AEAD, len(cfg.PreviousSecretKeys)+1),
+	}
+
+	secrets := append([]config.SecretKey{{ID: cfg.SecretKeyID, Secret: cfg.SecretKey}}, cfg.PreviousSecretKeys...)
+	for _, secret := range secrets {
+		aead, err := deriveAEAD(secret)
+		if err != nil {
+			return nil, err
+		}
+		k.keys[secret.ID] = aead
+
+		if cfg.AcceptLegacyTokens {
+			legacy, err := chacha20poly1305.NewX(legacyKey(secret.Secret))
+			if err != nil {
+				return nil, fmt.Errorf("failed to create cipher: %w", err)
+			}
+			k.legacy = append(k.legacy, legacy)
+		}
+	}
+
+	return k, nil
+}
+
+// Active returns the key ID and cipher that encrypt new tokens
+func (k *Keyring) Active() (string, cipher.AEAD) {
+	return k.activeID, k.keys[k.activeID]
+}
+
+// Key returns the cipher for a key ID, if it has not been retired
+func (k *Keyring) Key(id string) (cipher.AEAD, bool) {
+	aead, ok := k.keys[id]
+	return aead, ok
+}
+
+// Legacy returns the ciphers for tokens issued before key IDs existed, or
+// none if they are no longer accepted
+func (k *Keyring) Legacy() []cipher.AEAD {
+	return k.legacy
+}
+
+// deriveAEAD derives a key from a secret with HKDF-SHA256. The key ID is
+// part of the derivation, so a secret reused under another ID yields a
+// different key.
+func deriveAEAD(secret config.SecretKey) (cipher.AEAD, error) {
+	key, err := hkdf.Key(sha256.New, []byte(secret.Secret), []byte(tokenKeySalt), tokenKeyInfo+secret.ID, chacha20poly1305.KeySize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive key %s: %w", secret.ID, err)
+	}
+	aead, err := chacha20poly1305.NewX(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+	return aead, nil
+}
+
+// legacyKey is how keys were made before key IDs: the secret zero-padded
+// or truncated to 32 bytes
+func legacyKey(secret string) []byte {
+	key := make([]byte, chacha20poly1305.KeySize)
+	copy(key, []byte(secret))
+	return key
+}
@@ -2,32 +2,37 @@
 package services
 
 import (
+	"crypto/cipher"
 	"crypto/rand"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
-	"golang.org/x/crypto/chacha20poly1305"
-
 	"github.com/UnoraApp/be/internal/auth/dto"
 	"github.com/UnoraApp/be/internal/config"
 )
 
-// TokenService handles JWT token generation and validation
+// TokenService handles JWT token generation and validation. Tokens are
+// encrypted with XChaCha20-Poly1305 and prefixed with the ID of their key:
+// "<keyID>.<base64url(nonce|ciphertext)>".
 type TokenService struct {
-	secretKey              []byte
+	keyring                *Keyring
 	accessTokenExpiryHours int
 	refreshTokenExpiryDays int
 }
 
 // NewTokenService creates a new token service
 func NewTokenService(cfg *config.Config) *TokenService {
-	// Derive a 32-byte key from the secret
-	key := deriveKey(cfg.Auth.SecretKey)
+	keyring, err := NewKeyring(&cfg.Auth)
+	if err != nil {
+		// Keys of any length derive; this only fails on a broken build
+		panic(fmt.Sprintf("failed to create token keyring: %v", err))
+	}
 
 	return &TokenService{
-		secretKey:              key,
+		keyring:                keyring,
 		accessTokenExpiryHours: cfg.Auth.JWTAccessTokenExpiryHours,
 		refreshTokenExpiryDays: cfg.Auth.JWTRefreshTokenExpiryDays,
 	}
@@ -38,23 +43,26 @@ type tokenData struct {
 	Payload   dto.TokenPayload `json:"payload"`
 	ExpiresAt int64            `json:"expiresAt"`
 	IssuedAt  int64            `json:"issuedAt"`
-	Type      string           `json:"type"` // "access" or "refresh"
+	Type      string           `json:"type"`          // "access" or "refresh"
+	TokenID   string           `json:"jti,omitempty"` // refresh tokens only
 }
 
-// GenerateTokenPair generates both access and refresh tokens
-func (s *TokenService) GenerateTokenPair(payload dto.TokenPayload) (*dto.AuthResponse, error) {
+// GenerateTokenPair generates both access and refresh tokens. The refresh
+// token carries refreshTokenID, so that its session can tell it from the
+// tokens it replaced.
+func (s *TokenService) GenerateTokenPair(payload dto.TokenPayload, refreshTokenID string) (*dto.AuthResponse, error) {
 	now := time.Now()
 
 	// Generate access token
 	accessExpiry := now.Add(time.Duration(s.accessTokenExpiryHours) * time.Hour)
-	accessToken, err := s.generateToken(payload, accessExpiry, "access")
+	accessToken, err := s.generateToken(payload, accessExpiry, "access", "")
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate access token: %w", err)
 	}
 
 	// Generate refresh token
-	refreshExpiry := now.Add(time.Duration(s.refreshTokenExpiryDays) * 24 * time.Hour)
-	refreshToken, err := s.generateToken(payload, refreshExpiry, "refresh")
+	refreshExpiry := now.Add(s.RefreshTokenTTL())
+	refreshToken, err := s.generateToken(payload, refreshExpiry, "refresh", refreshTokenID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate refresh token: %w", err)
 	}
@@ -67,52 +75,40 @@ func (s *TokenService) GenerateTokenPair(payload dto.TokenPayload) (*dto.AuthRes
 	}, nil
 }
 
-// RefreshAccessToken generates a new access token from a refresh token
-func (s *TokenService) RefreshAccessToken(refreshToken string) (*dto.RefreshTokenResponse, error) {
-	// Validate refresh token
-	payload, err := s.ValidateToken(refreshToken, "refresh")
-	if err != nil {
-		return nil, fmt.Errorf("invalid refresh token: %w", err)
-	}
-
-	// Generate new access token
-	now := time.Now()
-	accessExpiry := now.Add(time.Duration(s.accessTokenExpiryHours) * time.Hour)
-	accessToken, err := s.generateToken(*payload, accessExpiry, "access")
-	if err != nil {
-		return nil, fmt.Errorf("failed to generate access token: %w", err)
-	}
+// AccessTokenTTL is how long access tokens are valid
+func (s *TokenService) AccessTokenTTL() time.Duration {
+	return time.Duration(s.accessTokenExpiryHours) * time.Hour
+}
 
-	return &dto.RefreshTokenResponse{
-		AccessToken: accessToken,
-		ExpiresIn:   int64(s.accessTokenExpiryHours * 3600),
-		TokenType:   "Bearer",
-	}, nil
+// RefreshTokenTTL is how long refresh tokens are valid
+func (s *TokenService) RefreshTokenTTL() time.Duration {
+	return time.Duration(s.refreshTokenExpiryDays) * 24 * time.Hour
 }
 
 // ValidateToken validates a token and returns its payload
 func (s *TokenService) ValidateToken(token string, expectedType string) (*dto.TokenPayload, error) {
-	// Decode token
-	tokenBytes, err := base64.URLEncoding.DecodeString(token)
+	data, err := s.parseToken(token, expectedType)
 	if err != nil {
-		return nil, fmt.Errorf("invalid token encoding: %w", err)
+		return nil, err
 	}
+	return &data.Payload, nil
+}
 
-	// Decrypt token
-	aead, err := chacha20poly1305.NewX(s.secretKey)
+// ValidateRefreshToken validates a refresh token and returns its payload and
+// token ID
+func (s *TokenService) ValidateRefreshToken(token string) (*dto.TokenPayload, string, error) {
+	data, err := s.parseToken(token, "refresh")
 	if err != nil {
-		return nil, fmt.Errorf("failed to create cipher: %w", err)
-	}
-
-	nonceSize := aead.NonceSize()
-	if len(tokenBytes) < nonceSize {
-		return nil, fmt.Errorf("token too short")
+		return nil, "", err
 	}
+	return &data.Payload, data.TokenID, nil
+}
 
-	nonce, ciphertext := tokenBytes[:nonceSize], tokenBytes[nonceSize:]
-	plaintext, err := aead.Open(nil, nonce, ciphertext, nil)
+// parseToken decrypts a token and checks its type and expiry
+func (s *TokenService) parseToken(token string, expectedType string) (*tokenData, error) {
+	plaintext, err := s.decrypt(token)
 	if err != nil {
-		return nil, fmt.Errorf("failed to decrypt token: %w", err)
+		return nil, err
 	}
 
 	// Parse token data
@@ -131,16 +127,17 @@ func (s *TokenService) ValidateToken(token string, expectedType string) (*dto.To
 		return nil, fmt.Errorf("token expired")
 	}
 
-	return &data.Payload, nil
+	return &data, nil
 }
 
 // generateToken generates an encrypted token
-func (s *TokenService) generateToken(payload dto.TokenPayload, expiry time.Time, tokenType string) (string, error) {
+func (s *TokenService) generateToken(payload dto.TokenPayload, expiry time.Time, tokenType, tokenID string) (string, error) {
 	data := tokenData{
 		Payload:   payload,
 		ExpiresAt: expiry.Unix(),
 		IssuedAt:  time.Now().Unix(),
 		Type:      tokenType,
+		TokenID:   tokenID,
 	}
 
 	plaintext, err := json.Marshal(data)
@@ -148,26 +145,57 @@ func (s *TokenService) generateToken(payload dto.TokenPayload, expiry time.Time,
 		return "", fmt.Errorf("failed to marshal token data: %w", err)
 	}
 
-	// Encrypt token
-	aead, err := chacha20poly1305.NewX(s.secretKey)
-	if err != nil {
-		return "", fmt.Errorf("failed to create cipher: %w", err)
-	}
-
+	// Encrypt token with the active key; the key ID is authenticated too
+	keyID, aead := s.keyring.Active()
 	nonce := make([]byte, aead.NonceSize())
 	if _, err := rand.Read(nonce); err != nil {
 		return "", fmt.Errorf("failed to generate nonce: %w", err)
 	}
 
-	ciphertext := aead.Seal(nonce, nonce, plaintext, nil)
+	ciphertext := aead.Seal(nonce, nonce, plaintext, []byte(keyID))
 
-	return base64.URLEncoding.EncodeToString(ciphertext), nil
+	return keyID + "." + base64.RawURLEncoding.EncodeToString(ciphertext), nil
 }
 
-// deriveKey derives a 32-byte key from a secret string
-func deriveKey(secret string) []byte {
-	// Simple key derivation - in production you might want to use HKDF or similar
-	key := make([]byte, 32)
-	copy(key, []byte(secret))
-	return key
+// decrypt decrypts a token with the key its ID names. Tokens issued before
+// key IDs existed have no ID and are tried against the legacy keys.
+func (s *TokenService) decrypt(token string) ([]byte, error) {
+	keyID, encoded, found := strings.Cut(token, ".")
+	if !found {
+		tokenBytes, err := base64.URLEncoding.DecodeString(token)
+		if err != nil {
+			return nil, fmt.Errorf("invalid token encoding: %w", err)
+		}
+		for _, aead := range s.keyring.Legacy() {
+			if plaintext, err := open(aead, tokenBytes, nil); err == nil {
+				return plaintext, nil
+			}
+		}
+		return nil, fmt.Errorf("failed to decrypt token")
+	}
+
+	aead, ok := s.keyring.Key(keyID)
+	if !ok {
+		return nil, fmt.Errorf("invalid token: unknown key %q", keyID)
+	}
+	tokenBytes, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("invalid token encoding: %w", err)
+	}
+	plaintext, err := open(aead, tokenBytes, []byte(keyID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt token: %w", err)
+	}
+	return plaintext, nil
+}
+
+// open decrypts nonce|ciphertext
+func open(aead cipher.AEAD, tokenBytes, additionalData []byte) ([]byte, error) {
+	nonceSize := aead.NonceSize()
+	if len(tokenBytes) < nonceSize {
+		return nil, fmt.Errorf("token too short")
+	}
+
+	nonce, ciphertext := tokenBytes[:nonceSize], tokenBytes[nonceSize:]
+	return aead.Open(nil, nonce, ciphertext, additionalData)
 }
@@ -0,0 +1,180 @@
+// internal/auth/services/token_service_test.go
+package services
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/chacha20poly1305"
+
+	"github.com/UnoraApp/be/internal/auth/dto"
+	"github.com/UnoraApp/be/internal/config"
+)
+
+var (
+	oldTokenKey = config.SecretKey{ID: "k1", Secret: "old-secret-old-secret-old-secret"}
+	newTokenKey = config.SecretKey{ID: "k2", Secret: "new-secret-new-secret-new-secret"}
+)
+
+func newTestTokenService(active config.SecretKey, previous []config.SecretKey, acceptLegacy bool) *TokenService {
+	return NewTokenService(&config.Config{
+		Auth: config.AuthConfig{
+			SecretKey:                 active.Secret,
+			SecretKeyID:               active.ID,
+			PreviousSecretKeys:        previous,
+			AcceptLegacyTokens:        acceptLegacy,
+			JWTAccessTokenExpiryHours: 1,
+			JWTRefreshTokenExpiryDays: 7,
+		},
+	})
+}
+
+// mintLegacyToken encrypts an access token the way tokens were made before
+// key IDs: under the zero-padded secret, without additional data
+func mintLegacyToken(t *testing.T, secret string, payload dto.TokenPayload) string {
+	t.Helper()
+
+	plaintext, err := json.Marshal(tokenData{
+		Payload:   payload,
+		ExpiresAt: time.Now().Add(time.Hour).Unix(),
+		IssuedAt:  time.Now().Unix(),
+		Type:      "access",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	aead, err := chacha20poly1305.NewX(legacyKey(secret))
+	if err != nil {
+		t.Fatal(err)
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		t.Fatal(err)
+	}
+	return base64.URLEncoding.EncodeToString(aead.Seal(nonce, nonce, plaintext, nil))
+}
+
+func TestTokenServiceKeys(t *testing.T) {
+	payload := dto.TokenPayload{UserID: "user-1", Email: "user@example.com", Provider: "google", SessionID: "session-1"}
+
+	mintWith := func(key config.SecretKey) func(t *testing.T) string {
+		return func(t *testing.T) string {
+			tokens, err := newTestTokenService(key, nil, false).GenerateTokenPair(payload, "refresh-1")
+			if err != nil {
+				t.Fatalf("GenerateTokenPair: %v", err)
+			}
+			return tokens.AccessToken
+		}
+	}
+
+	tests := []struct {
+		name      string
+		mint      func(t *testing.T) string
+		validator *TokenService
+		wantErr   bool
+	}{
+		{
+			name:      "active key round-trip",
+			mint:      mintWith(newTokenKey),
+			validator: newTestTokenService(newTokenKey, nil, false),
+		},
+		{
+			name:      "previous key still accepted",
+			mint:      mintWith(oldTokenKey),
+			validator: newTestTokenService(newTokenKey, []config.SecretKey{oldTokenKey}, false),
+		},
+		{
+			name:      "retired key rejected",
+			mint:      mintWith(oldTokenKey),
+			validator: newTestTokenService(newTokenKey, nil, false),
+			wantErr:   true,
+		},
+		{
+			name: "legacy token accepted during cut-over",
+			mint: func(t *testing.T) string {
+				return mintLegacyToken(t, newTokenKey.Secret, payload)
+			},
+			validator: newTestTokenService(newTokenKey, nil, true),
+		},
+		{
+			name: "legacy token rejected after cut-over",
+			mint: func(t *testing.T) string {
+				return mintLegacyToken(t, newTokenKey.Secret, payload)
+			},
+			validator: newTestTokenService(newTokenKey, nil, false),
+			wantErr:   true,
+		},
+		{
+			name: "key ID swapped for another known key rejected",
+			mint: func(t *testing.T) string {
+				token := mintWith(oldTokenKey)(t)
+				return newTokenKey.ID + strings.TrimPrefix(token, oldTokenKey.ID)
+			},
+			validator: newTestTokenService(newTokenKey, []config.SecretKey{oldTokenKey}, false),
+			wantErr:   true,
+		},
+		{
+			name: "key ID of a token with the same secret under another ID rejected",
+			mint: func(t *testing.T) string {
+				token := mintWith(config.SecretKey{ID: "k3", Secret: newTokenKey.Secret})(t)
+				return newTokenKey.ID + strings.TrimPrefix(token, "k3")
+			},
+			validator: newTestTokenService(newTokenKey, nil, false),
+			wantErr:   true,
+		},
+		{
+			name: "key ID removed rejected",
+			mint: func(t *testing.T) string {
+				_, encoded, _ := strings.Cut(mintWith(newTokenKey)(t), ".")
+				return encoded
+			},
+			validator: newTestTokenService(newTokenKey, nil, true),
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.validator.ValidateToken(tt.mint(t), "access")
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("ValidateToken accepted the token")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ValidateToken: %v", err)
+			}
+			if *got != payload {
+				t.Errorf("payload = %+v, want %+v", *got, payload)
+			}
+		})
+	}
+}
+
+func TestTokenServiceTokenTypes(t *testing.T) {
+	s := newTestTokenService(newTokenKey, nil, false)
+	tokens, err := s.GenerateTokenPair(dto.TokenPayload{UserID: "user-1"}, "refresh-1")
+	if err != nil {
+		t.Fatalf("GenerateTokenPair: %v", err)
+	}
+
+	if _, err := s.ValidateToken(tokens.RefreshToken, "access"); err == nil {
+		t.Error("refresh token accepted as an access token")
+	}
+	if _, err := s.ValidateToken(tokens.AccessToken, "refresh"); err == nil {
+		t.Error("access token accepted as a refresh token")
+	}
+
+	_, tokenID, err := s.ValidateRefreshToken(tokens.RefreshToken)
+	if err != nil {
+		t.Fatalf("ValidateRefreshToken: %v", err)
+	}
+	if tokenID != "refresh-1" {
+		t.Errorf("refresh token ID = %q, want refresh-1", tokenID)
+	}
+}
@@ -19,6 +19,8 @@ var ProviderSet = wire.NewSet(
 	// Services
 	services.NewGoogleService,
 	services.NewTokenService,
+	services.NewSMSProvider,
+	services.NewOTPService,
 	services.NewAuthService,
 	// Handlers
 	handlers.NewAuthHandler,
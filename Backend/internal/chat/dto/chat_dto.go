@@ -0,0 +1,77 @@
+// internal/chat/dto/chat_dto.go
+package dto
+
+import "time"
+
+// ConversationResponse represents a chat conversation for a connection
+// @Description Chat conversation between two connected users
+type ConversationResponse struct {
+	ID            string     `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	ConnectionID  string     `json:"connectionId" example:"550e8400-e29b-41d4-a716-446655440000"`
+	PartnerID     string     `json:"partnerId" example:"550e8400-e29b-41d4-a716-446655440000"`
+	PartnerName   string     `json:"partnerName" example:"Priya"`
+	Status        string     `json:"status" example:"open"`
+	ClosedReason  string     `json:"closedReason,omitempty" example:"blocked"`
+	UnreadCount   int        `json:"unreadCount" example:"2"`
+	CreatedAt     time.Time  `json:"createdAt" example:"2024-01-15T00:00:00Z"`
+	LastMessageAt *time.Time `json:"lastMessageAt,omitempty" example:"2024-01-15T10:30:00Z"`
+	ClosedAt      *time.Time `json:"closedAt,omitempty"`
+}
+
+// MessageResponse represents a chat message
+// @Description Chat message
+type MessageResponse struct {
+	ID               string     `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	ConversationID   string     `json:"conversationId" example:"550e8400-e29b-41d4-a716-446655440000"`
+	SenderID         string     `json:"senderId" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Body             string     `json:"body" example:"Hey! So glad we made it to Day 15."`
+	ClientMessageID  string     `json:"clientMessageId,omitempty" example:"c-1705312200000"`
+	ModerationStatus string     `json:"moderationStatus" example:"visible"`
+	CreatedAt        time.Time  `json:"createdAt" example:"2024-01-15T10:30:00Z"`
+	ReadAt           *time.Time `json:"readAt,omitempty" example:"2024-01-15T10:31:00Z"`
+}
+
+// MessageHistoryResponse is a page of messages, newest first
+// @Description Cursor-paginated message history
+type MessageHistoryResponse struct {
+	Messages   []MessageResponse `json:"messages"`
+	NextCursor string            `json:"nextCursor,omitempty" example:"550e8400-e29b-41d4-a716-446655440000"`
+	HasMore    bool              `json:"hasMore" example:"true"`
+}
+
+// SendMessageRequest is the request to send a chat message
+// @Description Send a chat message
+type SendMessageRequest struct {
+	Body            string `json:"body" validate:"required,max=2000" example:"Hey! So glad we made it to Day 15."`
+	ClientMessageID string `json:"clientMessageId,omitempty" validate:"omitempty,max=64" example:"c-1705312200000"`
+}
+
+// MarkReadRequest marks messages as read up to and including a message
+// @Description Read receipt
+type MarkReadRequest struct {
+	MessageID string `json:"messageId" validate:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
+}
+
+// ClientFrame is a frame sent by the client over the chat WebSocket
+// @Description WebSocket frame from client ("message", "typing" or "read")
+type ClientFrame struct {
+	Type            string `json:"type" example:"message"`
+	Body            string `json:"body,omitempty" example:"Hello!"`
+	ClientMessageID string `json:"clientMessageId,omitempty" example:"c-1705312200000"`
+	MessageID       string `json:"messageId,omitempty" example:"550e8400-e29b-41d4-a716-446655440000"`
+	IsTyping        bool   `json:"isTyping,omitempty" example:"true"`
+}
+
+// ChatEvent is an event delivered to clients over the chat WebSocket
+// @Description WebSocket event ("message", "typing", "read", "closed" or "error")
+type ChatEvent struct {
+	Type           string           `json:"type" example:"message"`
+	ConversationID string           `json:"conversationId" example:"550e8400-e29b-41d4-a716-446655440000"`
+	UserID         string           `json:"userId,omitempty" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Message        *MessageResponse `json:"message,omitempty"`
+	MessageID      string           `json:"messageId,omitempty" example:"550e8400-e29b-41d4-a716-446655440000"`
+	IsTyping       bool             `json:"isTyping,omitempty" example:"true"`
+	Reason         string           `json:"reason,omitempty" example:"blocked"`
+	Error          string           `json:"error,omitempty" example:"conversation is closed"`
+	At             time.Time        `json:"at" example:"2024-01-15T10:30:00Z"`
+}
@@ -0,0 +1,329 @@
+// internal/chat/handlers/chat_handler.go
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"golang.org/x/net/websocket"
+
+	"github.com/UnoraApp/be/internal/chat/dto"
+	"github.com/UnoraApp/be/internal/chat/services"
+	"github.com/UnoraApp/be/pkg/apperror"
+	"github.com/UnoraApp/be/pkg/logger"
+	"github.com/UnoraApp/be/pkg/response"
+)
+
+// frameTimeout bounds how long a single WebSocket frame may take to process
+const frameTimeout = 10 * time.Second
+
+// ChatHandler handles chat-related HTTP and WebSocket requests
+type ChatHandler struct {
+	chatService *services.ChatService
+}
+
+// NewChatHandler creates a new chat handler
+func NewChatHandler(chatService *services.ChatService) *ChatHandler {
+	return &ChatHandler{
+		chatService: chatService,
+	}
+}
+
+// ListConversations godoc
+// @Summary      List conversations
+// @Description  Get the user's chat conversations, most recently active first
+// @Tags         chat
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200 {object} response.APIResponse{data=[]dto.ConversationResponse} "Conversations"
+// @Failure      401 {object} response.APIResponse "Not authenticated"
+// @Router       /chats [get]
+func (h *ChatHandler) ListConversations(c *gin.Context) {
+	userID, _ := c.Get("userID")
+
+	conversations, err := h.chatService.ListConversations(c.Request.Context(), userID.(string))
+	if err != nil {
+		apperror.HandleError(c, apperror.InternalError(err))
+		return
+	}
+	response.JSON(c, http.StatusOK, conversations)
+}
+
+// GetConversation godoc
+// @Summary      Get conversation
+// @Description  Get the chat conversation of a connection. Chat unlocks with the Day-15 identity reveal.
+// @Tags         chat
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        connectionId path string true "Connection ID"
+// @Success      200 {object} response.APIResponse{data=dto.ConversationResponse} "Conversation"
+// @Failure      401 {object} response.APIResponse "Not authenticated"
+// @Failure      403 {object} response.APIResponse "Chat not unlocked yet"
+// @Failure      404 {object} response.APIResponse "Connection not found"
+// @Failure      409 {object} response.APIResponse "Conversation closed"
+// @Router       /connections/{connectionId}/chat [get]
+func (h *ChatHandler) GetConversation(c *gin.Context) {
+	userID, _ := c.Get("userID")
+	connectionID := c.Param("connectionId")
+
+	conversation, err := h.chatService.GetConversation(c.Request.Context(), userID.(string), connectionID)
+	if err != nil {
+		handleChatError(c, err)
+		return
+	}
+	response.JSON(c, http.StatusOK, conversation)
+}
+
+// ListMessages godoc
+// @Summary      Get message history
+// @Description  Get a page of messages, newest first. Pass nextCursor from the previous page as cursor to load older messages.
+// @Tags         chat
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        connectionId path string true "Connection ID"
+// @Param        cursor query string false "Cursor from the previous page"
+// @Param        limit query int false "Page size (max 100)" default(50)
+// @Success      200 {object} response.APIResponse{data=dto.MessageHistoryResponse} "Messages"
+// @Failure      400 {object} response.APIResponse "Invalid cursor"
+// @Failure      401 {object} response.APIResponse "Not authenticated"
+// @Failure      403 {object} response.APIResponse "Chat not unlocked yet"
+// @Failure      404 {object} response.APIResponse "Connection not found"
+// @Failure      409 {object} response.APIResponse "Conversation closed"
+// @Router       /connections/{connectionId}/chat/messages [get]
+func (h *ChatHandler) ListMessages(c *gin.Context) {
+	userID, _ := c.Get("userID")
+	connectionID := c.Param("connectionId")
+	cursor := c.Query("cursor")
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "50"))
+
+	messages, err := h.chatService.ListMessages(c.Request.Context(), userID.(string), connectionID, cursor, limit)
+	if err != nil {
+		handleChatError(c, err)
+		return
+	}
+	response.JSON(c, http.StatusOK, messages)
+}
+
+// SendMessage godoc
+// @Summary      Send message
+// @Description  Send a chat message. Retrying with the same clientMessageId returns the stored message. Messages held by moderation are returned with moderationStatus "held" and are only delivered once approved.
+// @Tags         chat
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        connectionId path string true "Connection ID"
+// @Param        request body dto.SendMessageRequest true "Message"
+// @Success      201 {object} response.APIResponse{data=dto.MessageResponse} "Message sent"
+// @Failure      400 {object} response.APIResponse "Invalid message or rejected by moderation"
+// @Failure      401 {object} response.APIResponse "Not authenticated"
+// @Failure      403 {object} response.APIResponse "Chat not unlocked yet"
+// @Failure      404 {object} response.APIResponse "Connection not found"
+// @Failure      409 {object} response.APIResponse "Conversation closed"
+// @Failure      429 {object} response.APIResponse "Sender rate limited"
+// @Router       /connections/{connectionId}/chat/messages [post]
+func (h *ChatHandler) SendMessage(c *gin.Context) {
+	userID, _ := c.Get("userID")
+	connectionID := c.Param("connectionId")
+
+	var req dto.SendMessageRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apperror.HandleError(c, apperror.BadRequest("invalid request body"))
+		return
+	}
+	if len(req.ClientMessageID) > 64 {
+		apperror.HandleError(c, apperror.BadRequest("clientMessageId must be at most 64 characters"))
+		return
+	}
+
+	message, err := h.chatService.SendMessage(c.Request.Context(), userID.(string), connectionID, &req)
+	if err != nil {
+		handleChatError(c, err)
+		return
+	}
+	response.JSON(c, http.StatusCreated, message)
+}
+
+// MarkRead godoc
+// @Summary      Mark messages read
+// @Description  Mark the partner's messages up to and including the given message as read
+// @Tags         chat
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        connectionId path string true "Connection ID"
+// @Param        request body dto.MarkReadRequest true "Last read message"
+// @Success      200 {object} response.APIResponse{data=map[string]string} "Success"
+// @Failure      400 {object} response.APIResponse "Invalid request"
+// @Failure      401 {object} response.APIResponse "Not authenticated"
+// @Failure      403 {object} response.APIResponse "Chat not unlocked yet"
+// @Failure      404 {object} response.APIResponse "Connection or message not found"
+// @Failure      409 {object} response.APIResponse "Conversation closed"
+// @Router       /connections/{connectionId}/chat/read [post]
+func (h *ChatHandler) MarkRead(c *gin.Context) {
+	userID, _ := c.Get("userID")
+	connectionID := c.Param("connectionId")
+
+	var req dto.MarkReadRequest
+	if err := c.ShouldBindJSON(&req); err != nil || req.MessageID == "" {
+		apperror.HandleError(c, apperror.BadRequest("messageId is required"))
+		return
+	}
+
+	if err := h.chatService.MarkRead(c.Request.Context(), userID.(string), connectionID, req.MessageID); err != nil {
+		handleChatError(c, err)
+		return
+	}
+	response.JSON(c, http.StatusOK, gin.H{"message": "Messages marked as read"})
+}
+
+// Connect godoc
+// @Summary      Chat WebSocket
+// @Description  Upgrade to a WebSocket for live messages, typing indicators and read receipts. Send dto.ClientFrame frames; receive dto.ChatEvent frames.
+// @Tags         chat
+// @Security     BearerAuth
+// @Param        connectionId path string true "Connection ID"
+// @Success      101 "Switching protocols"
+// @Failure      401 {object} response.APIResponse "Not authenticated"
+// @Failure      403 {object} response.APIResponse "Chat not unlocked yet"
+// @Failure      404 {object} response.APIResponse "Connection not found"
+// @Failure      409 {object} response.APIResponse "Conversation closed"
+// @Router       /connections/{connectionId}/chat/ws [get]
+func (h *ChatHandler) Connect(c *gin.Context) {
+	userID := c.GetString("userID")
+	connectionID := c.Param("connectionId")
+
+	// Authorize before upgrading so the client gets a proper HTTP error
+	conversation, err := h.chatService.OpenConversation(c.Request.Context(), userID, connectionID)
+	if err != nil {
+		handleChatError(c, err)
+		return
+	}
+
+	server := websocket.Server{
+		// Clients authenticate with a bearer token, so any origin is accepted
+		Handshake: func(*websocket.Config, *http.Request) error { return nil },
+		Handler: func(ws *websocket.Conn) {
+			h.serveSocket(ws, userID, connectionID, conversation.ID)
+		},
+	}
+	server.ServeHTTP(c.Writer, c.Request)
+}
+
+// serveSocket pumps hub events to the socket and client frames to the service
+func (h *ChatHandler) serveSocket(ws *websocket.Conn, userID, connectionID, conversationID string) {
+	defer ws.Close()
+
+	hub := h.chatService.Hub()
+	client := hub.Register(userID, conversationID)
+	defer hub.Unregister(client)
+
+	go h.readFrames(ws, client, userID, connectionID)
+
+	for {
+		select {
+		case event := <-client.Events():
+			if err := websocket.JSON.Send(ws, event); err != nil {
+				return
+			}
+		case <-client.Done():
+			// Flush whatever was queued, such as the closed event
+			for {
+				select {
+				case event := <-client.Events():
+					if err := websocket.JSON.Send(ws, event); err != nil {
+						return
+					}
+				default:
+					return
+				}
+			}
+		}
+	}
+}
+
+// readFrames handles frames from the client until the socket closes
+func (h *ChatHandler) readFrames(ws *websocket.Conn, client *services.Client, userID, connectionID string) {
+	defer client.Close()
+
+	log := logger.GetLogger("chat")
+	for {
+		var frame dto.ClientFrame
+		if err := websocket.JSON.Receive(ws, &frame); err != nil {
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), frameTimeout)
+		var err error
+		switch frame.Type {
+		case services.EventMessage:
+			var msg *dto.MessageResponse
+			msg, err = h.chatService.SendMessage(ctx, userID, connectionID, &dto.SendMessageRequest{
+				Body:            frame.Body,
+				ClientMessageID: frame.ClientMessageID,
+			})
+			// Held messages aren't broadcast, so echo them to the sender only
+			if err == nil && msg.ModerationStatus != "visible" {
+				client.Reply(&dto.ChatEvent{
+					Type:           services.EventMessage,
+					ConversationID: client.ConversationID,
+					UserID:         userID,
+					Message:        msg,
+					At:             msg.CreatedAt,
+				})
+			}
+		case services.EventTyping:
+			err = h.chatService.SetTyping(ctx, userID, connectionID, frame.IsTyping)
+		case services.EventRead:
+			err = h.chatService.MarkRead(ctx, userID, connectionID, frame.MessageID)
+		default:
+			err = errors.New("unknown frame type")
+		}
+		cancel()
+
+		if err != nil {
+			log.Debug().Err(err).Str("user_id", userID).Str("type", frame.Type).Msg("Chat frame rejected")
+			client.Reply(&dto.ChatEvent{
+				Type:           services.EventError,
+				ConversationID: client.ConversationID,
+				MessageID:      frame.ClientMessageID,
+				Error:          err.Error(),
+				At:             time.Now(),
+			})
+			if errors.Is(err, services.ErrConversationClosed) {
+				return
+			}
+		}
+	}
+}
+
+// handleChatError maps chat service errors to HTTP errors
+func handleChatError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, services.ErrChatLocked):
+		apperror.HandleError(c, apperror.Forbidden(err.Error()))
+	case errors.Is(err, services.ErrConversationClosed):
+		apperror.HandleError(c, apperror.Conflict(err.Error()))
+	case errors.Is(err, services.ErrInvalidMessage):
+		apperror.HandleError(c, apperror.BadRequest(err.Error()))
+	case errors.Is(err, services.ErrMessageRejected):
+		apperror.HandleError(c, apperror.BadRequest(err.Error()))
+	case errors.Is(err, services.ErrSenderRateLimited):
+		apperror.HandleError(c, apperror.RateLimited(err.Error()))
+	case strings.Contains(err.Error(), "message not found"):
+		apperror.HandleError(c, apperror.NotFound("message"))
+	case strings.Contains(err.Error(), "not found"):
+		apperror.HandleError(c, apperror.NotFound("connection"))
+	case strings.Contains(err.Error(), "invalid cursor"):
+		apperror.HandleError(c, apperror.BadRequest(err.Error()))
+	default:
+		apperror.HandleError(c, apperror.InternalError(err))
+	}
+}
@@ -0,0 +1,9 @@
+// internal/chat/handlers/providers.go
+package handlers
+
+import "github.com/google/wire"
+
+// ProviderSet is the wire provider set for chat handlers
+var ProviderSet = wire.NewSet(
+	NewChatHandler,
+)
@@ -0,0 +1,36 @@
+// internal/chat/routes/chat_routes.go
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/UnoraApp/be/internal/chat/handlers"
+	"github.com/UnoraApp/be/internal/chat/services"
+)
+
+// RegisterChatRoutes registers all chat-related routes
+func RegisterChatRoutes(
+	router *gin.RouterGroup,
+	chatService *services.ChatService,
+	authMiddleware gin.HandlerFunc,
+) {
+	// Create handler
+	handler := handlers.NewChatHandler(chatService)
+
+	// All chat routes require authentication
+	protected := router.Group("")
+	protected.Use(authMiddleware)
+	{
+		// Conversations
+		protected.GET("/chats", handler.ListConversations)
+		protected.GET("/connections/:connectionId/chat", handler.GetConversation)
+
+		// Messages
+		protected.GET("/connections/:connectionId/chat/messages", handler.ListMessages)
+		protected.POST("/connections/:connectionId/chat/messages", handler.SendMessage)
+		protected.POST("/connections/:connectionId/chat/read", handler.MarkRead)
+
+		// Live delivery
+		protected.GET("/connections/:connectionId/chat/ws", handler.Connect)
+	}
+}
@@ -0,0 +1,797 @@
+// internal/chat/services/chat_service.go
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	ent "github.com/UnoraApp/be/ent/generated"
+	"github.com/UnoraApp/be/ent/generated/connection"
+	"github.com/UnoraApp/be/ent/generated/conversation"
+	"github.com/UnoraApp/be/ent/generated/message"
+	"github.com/UnoraApp/be/ent/generated/moderationaction"
+	"github.com/UnoraApp/be/ent/generated/predicate"
+	"github.com/UnoraApp/be/ent/generated/reveal"
+	"github.com/UnoraApp/be/ent/generated/revealmilestone"
+	"github.com/UnoraApp/be/ent/generated/userblock"
+	"github.com/UnoraApp/be/internal/chat/dto"
+	revealservices "github.com/UnoraApp/be/internal/reveal/services"
+	"github.com/UnoraApp/be/pkg/logger"
+)
+
+// Chat event types delivered over the WebSocket
+const (
+	EventMessage = "message"
+	EventTyping  = "typing"
+	EventRead    = "read"
+	EventClosed  = "closed"
+	EventError   = "error"
+)
+
+// maxMessageLength is the longest message body accepted, in characters
+const maxMessageLength = 2000
+
+// Chat errors
+var (
+	ErrChatLocked         = errors.New("chat unlocks after the identity reveal")
+	ErrConversationClosed = errors.New("conversation is closed")
+	ErrInvalidMessage     = errors.New("message must be between 1 and 2000 characters")
+	ErrMessageRejected    = errors.New("message was rejected by moderation")
+	ErrSenderRateLimited  = errors.New("you are sending messages too quickly, please wait a moment")
+	ErrMessageNotHeld     = errors.New("message is not held for review")
+)
+
+// evidenceWindow is how many messages either side of a reported message are kept as context
+const evidenceWindow = 10
+
+// ChatService handles chat-related business logic
+type ChatService struct {
+	entClient  *ent.Client
+	hub        *Hub
+	moderation *ModerationPipeline
+}
+
+// NewChatService creates a new chat service
+func NewChatService(entClient *ent.Client, hub *Hub, moderation *ModerationPipeline) *ChatService {
+	return &ChatService{
+		entClient:  entClient,
+		hub:        hub,
+		moderation: moderation,
+	}
+}
+
+// Hub returns the hub used for real-time delivery
+func (s *ChatService) Hub() *Hub {
+	return s.hub
+}
+
+// ListConversations returns the user's conversations, most recently active first
+func (s *ChatService) ListConversations(ctx context.Context, userID string) ([]*dto.ConversationResponse, error) {
+	conversations, err := s.entClient.Conversation.
+		Query().
+		Where(
+			conversation.Or(
+				conversation.UserAIDEQ(userID),
+				conversation.UserBIDEQ(userID),
+			),
+		).
+		Order(ent.Desc(conversation.FieldLastMessageAt), ent.Desc(conversation.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get conversations: %w", err)
+	}
+
+	result := make([]*dto.ConversationResponse, len(conversations))
+	for i, conv := range conversations {
+		result[i] = s.conversationToResponse(ctx, conv, userID)
+	}
+
+	return result, nil
+}
+
+// GetConversation returns the conversation for a connection, opening it on first access
+func (s *ChatService) GetConversation(ctx context.Context, userID, connectionID string) (*dto.ConversationResponse, error) {
+	conv, err := s.resolveConversation(ctx, userID, connectionID)
+	if err != nil {
+		return nil, err
+	}
+	return s.conversationToResponse(ctx, conv, userID), nil
+}
+
+// ListMessages returns a page of messages, newest first. The cursor is the ID
+// of the oldest message of the previous page.
+func (s *ChatService) ListMessages(ctx context.Context, userID, connectionID, cursor string, limit int) (*dto.MessageHistoryResponse, error) {
+	if limit <= 0 || limit > 100 {
+		limit = 50
+	}
+
+	conv, err := s.OpenConversation(ctx, userID, connectionID)
+	if err != nil {
+		return nil, err
+	}
+
+	query := s.entClient.Message.
+		Query().
+		Where(message.ConversationIDEQ(conv.ID)).
+		Where(visibleTo(userID))
+
+	if cursor != "" {
+		cursorMsg, err := s.entClient.Message.
+			Query().
+			Where(message.IDEQ(cursor)).
+			Where(message.ConversationIDEQ(conv.ID)).
+			Where(visibleTo(userID)).
+			Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil, fmt.Errorf("invalid cursor")
+			}
+			return nil, fmt.Errorf("failed to get cursor message: %w", err)
+		}
+		query = query.Where(
+			message.Or(
+				message.CreatedAtLT(cursorMsg.CreatedAt),
+				message.And(
+					message.CreatedAtEQ(cursorMsg.CreatedAt),
+					message.IDLT(cursorMsg.ID),
+				),
+			),
+		)
+	}
+
+	messages, err := query.
+		Order(ent.Desc(message.FieldCreatedAt), ent.Desc(message.FieldID)).
+		Limit(limit + 1).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get messages: %w", err)
+	}
+
+	hasMore := len(messages) > limit
+	if hasMore {
+		messages = messages[:limit]
+	}
+
+	result := &dto.MessageHistoryResponse{
+		Messages: make([]dto.MessageResponse, len(messages)),
+		HasMore:  hasMore,
+	}
+	for i, m := range messages {
+		result.Messages[i] = *messageToResponse(m)
+	}
+	if hasMore {
+		result.NextCursor = messages[len(messages)-1].ID
+	}
+
+	return result, nil
+}
+
+// SendMessage moderates and stores a message, delivering it to both
+// participants unless moderation holds it. A repeated client message ID
+// returns the message already stored.
+func (s *ChatService) SendMessage(ctx context.Context, userID, connectionID string, req *dto.SendMessageRequest) (*dto.MessageResponse, error) {
+	body := strings.TrimSpace(req.Body)
+	if body == "" || utf8.RuneCountInString(body) > maxMessageLength {
+		return nil, ErrInvalidMessage
+	}
+
+	conv, err := s.OpenConversation(ctx, userID, connectionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.ClientMessageID != "" {
+		existing, err := s.entClient.Message.
+			Query().
+			Where(message.ConversationIDEQ(conv.ID)).
+			Where(message.SenderUserIDEQ(userID)).
+			Where(message.ClientMessageIDEQ(req.ClientMessageID)).
+			Only(ctx)
+		if err == nil {
+			if existing.ModerationStatus == message.ModerationStatusRejected {
+				return nil, ErrMessageRejected
+			}
+			return messageToResponse(existing), nil
+		}
+		if !ent.IsNotFound(err) {
+			return nil, fmt.Errorf("failed to check message: %w", err)
+		}
+	}
+
+	if err := s.checkSenderLimits(ctx, userID, conv.ID); err != nil {
+		return nil, err
+	}
+
+	results := s.moderation.Run(ctx, &ModerationInput{
+		SenderID:       userID,
+		ConversationID: conv.ID,
+		Body:           body,
+	})
+	status := message.ModerationStatusVisible
+	switch Decide(results) {
+	case VerdictHold:
+		status = message.ModerationStatusHeld
+	case VerdictReject:
+		status = message.ModerationStatusRejected
+	}
+
+	msgID := uuid.New().String()
+	m, err := s.entClient.Message.
+		Create().
+		SetID(msgID).
+		SetConversationID(conv.ID).
+		SetSenderUserID(userID).
+		SetBody(body).
+		SetNillableClientMessageID(strPtr(req.ClientMessageID)).
+		SetModerationStatus(status).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send message: %w", err)
+	}
+
+	for _, r := range results {
+		s.recordAction(ctx, userID, &m.ID, &conv.ID, verdictAction(r.Verdict), r.Source, r.Reason)
+	}
+
+	switch status {
+	case message.ModerationStatusRejected:
+		return nil, ErrMessageRejected
+	case message.ModerationStatusHeld:
+		// Only the sender sees a held message until an admin approves it
+		return messageToResponse(m), nil
+	}
+
+	resp := messageToResponse(m)
+	if err := s.deliver(ctx, conv.ID, m, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// ApproveHeldMessage releases a held message to the conversation
+func (s *ChatService) ApproveHeldMessage(ctx context.Context, messageID, reason string) error {
+	m, err := s.releaseHeld(ctx, messageID, message.ModerationStatusVisible, moderationaction.ActionApproved, reason)
+	if err != nil {
+		return err
+	}
+	return s.deliver(ctx, m.ConversationID, m, messageToResponse(m))
+}
+
+// RejectHeldMessage permanently hides a held message from the partner
+func (s *ChatService) RejectHeldMessage(ctx context.Context, messageID, reason string) error {
+	_, err := s.releaseHeld(ctx, messageID, message.ModerationStatusRejected, moderationaction.ActionRejected, reason)
+	return err
+}
+
+// MessageEvidence returns a reported message with the messages around it, as
+// the reporter saw them, oldest first. It works on closed conversations so
+// that users can still report after blocking.
+func (s *ChatService) MessageEvidence(ctx context.Context, reporterID, messageID string) (*ent.Message, []*ent.Message, error) {
+	target, err := s.entClient.Message.
+		Query().
+		Where(message.IDEQ(messageID)).
+		WithConversation().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil, fmt.Errorf("message not found")
+		}
+		return nil, nil, fmt.Errorf("failed to get message: %w", err)
+	}
+
+	conv := target.Edges.Conversation
+	if conv == nil || (conv.UserAID != reporterID && conv.UserBID != reporterID) || target.SenderUserID == reporterID {
+		return nil, nil, fmt.Errorf("message not found")
+	}
+
+	before, err := s.entClient.Message.
+		Query().
+		Where(message.ConversationIDEQ(conv.ID)).
+		Where(visibleTo(reporterID)).
+		Where(
+			message.Or(
+				message.CreatedAtLT(target.CreatedAt),
+				message.And(message.CreatedAtEQ(target.CreatedAt), message.IDLT(target.ID)),
+			),
+		).
+		Order(ent.Desc(message.FieldCreatedAt), ent.Desc(message.FieldID)).
+		Limit(evidenceWindow).
+		All(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get context messages: %w", err)
+	}
+
+	after, err := s.entClient.Message.
+		Query().
+		Where(message.ConversationIDEQ(conv.ID)).
+		Where(visibleTo(reporterID)).
+		Where(
+			message.Or(
+				message.CreatedAtGT(target.CreatedAt),
+				message.And(message.CreatedAtEQ(target.CreatedAt), message.IDGT(target.ID)),
+			),
+		).
+		Order(ent.Asc(message.FieldCreatedAt), ent.Asc(message.FieldID)).
+		Limit(evidenceWindow).
+		All(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get context messages: %w", err)
+	}
+
+	thread := make([]*ent.Message, 0, len(before)+1+len(after))
+	for i := len(before) - 1; i >= 0; i-- {
+		thread = append(thread, before[i])
+	}
+	thread = append(thread, target)
+	thread = append(thread, after...)
+
+	return target, thread, nil
+}
+
+// checkSenderLimits rate-limits senders who flood or keep tripping moderation
+func (s *ChatService) checkSenderLimits(ctx context.Context, userID, conversationID string) error {
+	limits := s.moderation.Limits()
+	now := time.Now()
+
+	if limits.ViolationsPerHour > 0 {
+		violations, err := s.entClient.ModerationAction.
+			Query().
+			Where(moderationaction.UserIDEQ(userID)).
+			Where(moderationaction.ActionIn(moderationaction.ActionHeld, moderationaction.ActionRejected)).
+			Where(moderationaction.CreatedAtGTE(now.Add(-time.Hour))).
+			Count(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to check moderation history: %w", err)
+		}
+		if violations >= limits.ViolationsPerHour {
+			s.recordRateLimit(ctx, userID, conversationID, fmt.Sprintf("%d moderation violations in the last hour", violations))
+			return ErrSenderRateLimited
+		}
+	}
+
+	if limits.MessagesPerMinute > 0 {
+		sent, err := s.entClient.Message.
+			Query().
+			Where(message.SenderUserIDEQ(userID)).
+			Where(message.CreatedAtGTE(now.Add(-time.Minute))).
+			Count(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to check send rate: %w", err)
+		}
+		if sent >= limits.MessagesPerMinute {
+			s.recordRateLimit(ctx, userID, conversationID, fmt.Sprintf("%d messages in the last minute", sent))
+			return ErrSenderRateLimited
+		}
+	}
+
+	return nil
+}
+
+// recordRateLimit logs a rate limit at most once a minute per sender
+func (s *ChatService) recordRateLimit(ctx context.Context, userID, conversationID, reason string) {
+	recent, _ := s.entClient.ModerationAction.
+		Query().
+		Where(moderationaction.UserIDEQ(userID)).
+		Where(moderationaction.ActionEQ(moderationaction.ActionRateLimited)).
+		Where(moderationaction.CreatedAtGTE(time.Now().Add(-time.Minute))).
+		Exist(ctx)
+	if !recent {
+		s.recordAction(ctx, userID, nil, &conversationID, moderationaction.ActionRateLimited, "rate_limit", reason)
+	}
+}
+
+// releaseHeld moves a held message to its final status and logs the admin decision
+func (s *ChatService) releaseHeld(ctx context.Context, messageID string, status message.ModerationStatus, action moderationaction.Action, reason string) (*ent.Message, error) {
+	affected, err := s.entClient.Message.
+		Update().
+		Where(message.IDEQ(messageID)).
+		Where(message.ModerationStatusEQ(message.ModerationStatusHeld)).
+		SetModerationStatus(status).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update message: %w", err)
+	}
+	if affected == 0 {
+		exists, _ := s.entClient.Message.Query().Where(message.IDEQ(messageID)).Exist(ctx)
+		if !exists {
+			return nil, fmt.Errorf("message not found")
+		}
+		return nil, ErrMessageNotHeld
+	}
+
+	m, err := s.entClient.Message.Get(ctx, messageID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get message: %w", err)
+	}
+	s.recordAction(ctx, m.SenderUserID, &m.ID, &m.ConversationID, action, "admin", reason)
+	return m, nil
+}
+
+// deliver bumps the conversation and publishes a visible message
+func (s *ChatService) deliver(ctx context.Context, conversationID string, m *ent.Message, resp *dto.MessageResponse) error {
+	if _, err := s.entClient.Conversation.
+		UpdateOneID(conversationID).
+		SetLastMessageAt(m.CreatedAt).
+		Save(ctx); err != nil {
+		return fmt.Errorf("failed to update conversation: %w", err)
+	}
+
+	s.publish(ctx, &dto.ChatEvent{
+		Type:           EventMessage,
+		ConversationID: conversationID,
+		UserID:         m.SenderUserID,
+		Message:        resp,
+		At:             m.CreatedAt,
+	})
+	return nil
+}
+
+// recordAction writes to the moderation log, which admins see alongside reports
+func (s *ChatService) recordAction(ctx context.Context, userID string, messageID, conversationID *string, action moderationaction.Action, source, reason string) {
+	_, err := s.entClient.ModerationAction.
+		Create().
+		SetID(uuid.New().String()).
+		SetUserID(userID).
+		SetNillableMessageID(messageID).
+		SetNillableConversationID(conversationID).
+		SetAction(action).
+		SetSource(source).
+		SetNillableReason(strPtr(reason)).
+		Save(ctx)
+	if err != nil {
+		log := logger.GetLogger("chat")
+		log.Error().Err(err).Str("user_id", userID).Str("action", string(action)).Msg("Failed to record moderation action")
+	}
+}
+
+// MarkRead marks the partner's messages up to and including messageID as read
+func (s *ChatService) MarkRead(ctx context.Context, userID, connectionID, messageID string) error {
+	conv, err := s.OpenConversation(ctx, userID, connectionID)
+	if err != nil {
+		return err
+	}
+
+	target, err := s.entClient.Message.
+		Query().
+		Where(message.IDEQ(messageID)).
+		Where(message.ConversationIDEQ(conv.ID)).
+		Where(visibleTo(userID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return fmt.Errorf("message not found")
+		}
+		return fmt.Errorf("failed to get message: %w", err)
+	}
+
+	now := time.Now()
+	updated, err := s.entClient.Message.
+		Update().
+		Where(message.ConversationIDEQ(conv.ID)).
+		Where(message.SenderUserIDNEQ(userID)).
+		Where(message.ModerationStatusEQ(message.ModerationStatusVisible)).
+		Where(message.ReadAtIsNil()).
+		Where(message.CreatedAtLTE(target.CreatedAt)).
+		SetReadAt(now).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to mark messages read: %w", err)
+	}
+
+	if updated > 0 {
+		s.publish(ctx, &dto.ChatEvent{
+			Type:           EventRead,
+			ConversationID: conv.ID,
+			UserID:         userID,
+			MessageID:      messageID,
+			At:             now,
+		})
+	}
+
+	return nil
+}
+
+// SetTyping broadcasts the user's typing indicator to the partner
+func (s *ChatService) SetTyping(ctx context.Context, userID, connectionID string, isTyping bool) error {
+	conv, err := s.OpenConversation(ctx, userID, connectionID)
+	if err != nil {
+		return err
+	}
+
+	s.publish(ctx, &dto.ChatEvent{
+		Type:           EventTyping,
+		ConversationID: conv.ID,
+		UserID:         userID,
+		IsTyping:       isTyping,
+		At:             time.Now(),
+	})
+	return nil
+}
+
+// OpenConversation returns the conversation only if the user may currently chat in it
+func (s *ChatService) OpenConversation(ctx context.Context, userID, connectionID string) (*ent.Conversation, error) {
+	conv, err := s.resolveConversation(ctx, userID, connectionID)
+	if err != nil {
+		return nil, err
+	}
+	if conv.ConversationStatus != conversation.ConversationStatusOpen {
+		return nil, ErrConversationClosed
+	}
+	return conv, nil
+}
+
+// CloseConversation closes the conversation of a connection and disconnects
+// its live clients. It is a no-op when there is no open conversation.
+func (s *ChatService) CloseConversation(ctx context.Context, connectionID string, reason conversation.ClosedReason) error {
+	conv, err := s.entClient.Conversation.
+		Query().
+		Where(conversation.ConnectionIDEQ(connectionID)).
+		Where(conversation.ConversationStatusEQ(conversation.ConversationStatusOpen)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to get conversation: %w", err)
+	}
+	return s.close(ctx, conv, reason)
+}
+
+// CloseConversationsBetween closes every open conversation between two users
+func (s *ChatService) CloseConversationsBetween(ctx context.Context, userA, userB string, reason conversation.ClosedReason) error {
+	// Conversations copy the connection's ordering (user A < user B)
+	if userA > userB {
+		userA, userB = userB, userA
+	}
+
+	conversations, err := s.entClient.Conversation.
+		Query().
+		Where(conversation.UserAIDEQ(userA)).
+		Where(conversation.UserBIDEQ(userB)).
+		Where(conversation.ConversationStatusEQ(conversation.ConversationStatusOpen)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get conversations: %w", err)
+	}
+
+	for _, conv := range conversations {
+		if err := s.close(ctx, conv, reason); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// close marks a conversation closed and tells its clients
+func (s *ChatService) close(ctx context.Context, conv *ent.Conversation, reason conversation.ClosedReason) error {
+	now := time.Now()
+	affected, err := s.entClient.Conversation.
+		Update().
+		Where(conversation.IDEQ(conv.ID)).
+		Where(conversation.ConversationStatusEQ(conversation.ConversationStatusOpen)).
+		SetConversationStatus(conversation.ConversationStatusClosed).
+		SetClosedReason(reason).
+		SetClosedAt(now).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to close conversation: %w", err)
+	}
+
+	if affected > 0 {
+		s.publish(ctx, &dto.ChatEvent{
+			Type:           EventClosed,
+			ConversationID: conv.ID,
+			Reason:         string(reason),
+			At:             now,
+		})
+	}
+	return nil
+}
+
+// resolveConversation authorizes the user for the connection's chat and
+// returns its conversation, creating it once chat has unlocked
+func (s *ChatService) resolveConversation(ctx context.Context, userID, connectionID string) (*ent.Conversation, error) {
+	conn, err := s.entClient.Connection.
+		Query().
+		Where(connection.IDEQ(connectionID)).
+		Where(
+			connection.Or(
+				connection.UserAIDEQ(userID),
+				connection.UserBIDEQ(userID),
+			),
+		).
+		WithConversation().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("connection not found")
+		}
+		return nil, fmt.Errorf("failed to get connection: %w", err)
+	}
+
+	conv := conn.Edges.Conversation
+	if conv != nil && conv.ConversationStatus == conversation.ConversationStatusClosed {
+		return conv, nil
+	}
+
+	// Connections can end without the chat having been told; close lazily
+	if conn.ConnectionStatus != connection.ConnectionStatusActive {
+		if conv == nil {
+			return nil, ErrConversationClosed
+		}
+		if err := s.close(ctx, conv, conversation.ClosedReasonTerminated); err != nil {
+			return nil, err
+		}
+		return s.entClient.Conversation.Get(ctx, conv.ID)
+	}
+	if s.isBlocked(ctx, conn.UserAID, conn.UserBID) {
+		if conv == nil {
+			return nil, ErrConversationClosed
+		}
+		if err := s.close(ctx, conv, conversation.ClosedReasonBlocked); err != nil {
+			return nil, err
+		}
+		return s.entClient.Conversation.Get(ctx, conv.ID)
+	}
+
+	if conv != nil {
+		return conv, nil
+	}
+
+	// Chat unlocks together with the Day-15 identity reveal
+	unlocked, err := s.entClient.Reveal.
+		Query().
+		Where(reveal.ConnectionIDEQ(connectionID)).
+		Where(reveal.RevealStatusNEQ(reveal.RevealStatusLocked)).
+		Where(reveal.HasMilestoneWith(revealmilestone.DayRequiredGTE(revealservices.IdentityRevealDay))).
+		Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check identity reveal: %w", err)
+	}
+	if !unlocked {
+		return nil, ErrChatLocked
+	}
+
+	convID := uuid.New().String()
+	conv, err = s.entClient.Conversation.
+		Create().
+		SetID(convID).
+		SetConnectionID(conn.ID).
+		SetUserAID(conn.UserAID).
+		SetUserBID(conn.UserBID).
+		Save(ctx)
+	if err != nil {
+		// Both users may open the chat at the same moment
+		if ent.IsConstraintError(err) {
+			return s.entClient.Conversation.
+				Query().
+				Where(conversation.ConnectionIDEQ(conn.ID)).
+				Only(ctx)
+		}
+		return nil, fmt.Errorf("failed to create conversation: %w", err)
+	}
+
+	return conv, nil
+}
+
+// isBlocked checks if there's any active block between two users
+func (s *ChatService) isBlocked(ctx context.Context, userA, userB string) bool {
+	blocked, _ := s.entClient.UserBlock.
+		Query().
+		Where(
+			userblock.Or(
+				userblock.And(
+					userblock.BlockerUserIDEQ(userA),
+					userblock.BlockedUserIDEQ(userB),
+				),
+				userblock.And(
+					userblock.BlockerUserIDEQ(userB),
+					userblock.BlockedUserIDEQ(userA),
+				),
+			),
+		).
+		Where(userblock.UnblockedAtIsNil()).
+		Exist(ctx)
+	return blocked
+}
+
+// publish delivers an event, logging rather than failing the request on error
+func (s *ChatService) publish(ctx context.Context, event *dto.ChatEvent) {
+	if err := s.hub.Publish(ctx, event); err != nil {
+		log := logger.GetLogger("chat")
+		log.Error().Err(err).Str("conversation_id", event.ConversationID).Str("type", event.Type).Msg("Failed to publish chat event")
+	}
+}
+
+func (s *ChatService) conversationToResponse(ctx context.Context, conv *ent.Conversation, userID string) *dto.ConversationResponse {
+	partnerID := conv.UserAID
+	if conv.UserAID == userID {
+		partnerID = conv.UserBID
+	}
+
+	partnerName := ""
+	if partner, _ := s.entClient.User.Get(ctx, partnerID); partner != nil && partner.FirstName != nil {
+		partnerName = *partner.FirstName
+	}
+
+	unread, _ := s.entClient.Message.
+		Query().
+		Where(message.ConversationIDEQ(conv.ID)).
+		Where(message.SenderUserIDEQ(partnerID)).
+		Where(message.ModerationStatusEQ(message.ModerationStatusVisible)).
+		Where(message.ReadAtIsNil()).
+		Count(ctx)
+
+	resp := &dto.ConversationResponse{
+		ID:            conv.ID,
+		ConnectionID:  conv.ConnectionID,
+		PartnerID:     partnerID,
+		PartnerName:   partnerName,
+		Status:        string(conv.ConversationStatus),
+		UnreadCount:   unread,
+		CreatedAt:     conv.CreatedAt,
+		LastMessageAt: conv.LastMessageAt,
+		ClosedAt:      conv.ClosedAt,
+	}
+	if conv.ClosedReason != nil {
+		resp.ClosedReason = string(*conv.ClosedReason)
+	}
+	return resp
+}
+
+func messageToResponse(m *ent.Message) *dto.MessageResponse {
+	return &dto.MessageResponse{
+		ID:               m.ID,
+		ConversationID:   m.ConversationID,
+		SenderID:         m.SenderUserID,
+		Body:             m.Body,
+		ClientMessageID:  ptrToString(m.ClientMessageID),
+		ModerationStatus: string(m.ModerationStatus),
+		CreatedAt:        m.CreatedAt,
+		ReadAt:           m.ReadAt,
+	}
+}
+
+// visibleTo matches messages the user may see: everything delivered, plus
+// their own held messages
+func visibleTo(userID string) predicate.Message {
+	return message.Or(
+		message.ModerationStatusEQ(message.ModerationStatusVisible),
+		message.And(
+			message.SenderUserIDEQ(userID),
+			message.ModerationStatusEQ(message.ModerationStatusHeld),
+		),
+	)
+}
+
+func verdictAction(v Verdict) moderationaction.Action {
+	switch v {
+	case VerdictReject:
+		return moderationaction.ActionRejected
+	case VerdictHold:
+		return moderationaction.ActionHeld
+	default:
+		return moderationaction.ActionFlagged
+	}
+}
+
+// Helper functions
+func ptrToString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func strPtr(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
@@ -0,0 +1,224 @@
+// internal/chat/services/hub.go
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"sync"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/UnoraApp/be/internal/chat/dto"
+	"github.com/UnoraApp/be/pkg/logger"
+)
+
+// chatChannelPrefix is the Redis pub/sub channel prefix for conversation events
+const chatChannelPrefix = "chat:conversation:"
+
+// chatDisconnectChannel carries the IDs of users whose clients every
+// instance must drop
+const chatDisconnectChannel = "chat:disconnect"
+
+// clientBufferSize is how many undelivered events a client may queue before it is dropped
+const clientBufferSize = 64
+
+// Hub fans chat events out to the WebSocket clients connected to this
+// instance. Events are published through Redis so that every instance
+// delivers them to the clients it holds.
+type Hub struct {
+	redisClient *redis.Client
+
+	mu      sync.RWMutex
+	clients map[string]map[*Client]struct{} // conversation ID -> clients
+}
+
+// Client is a single WebSocket session subscribed to one conversation
+type Client struct {
+	UserID         string
+	ConversationID string
+
+	events    chan *dto.ChatEvent
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// NewHub creates a new chat hub. With a nil Redis client events are only
+// delivered locally, which is enough for a single-instance setup.
+func NewHub(redisClient *redis.Client) *Hub {
+	return &Hub{
+		redisClient: redisClient,
+		clients:     make(map[string]map[*Client]struct{}),
+	}
+}
+
+// Run subscribes to conversation events on Redis and delivers them to local
+// clients until the context is cancelled
+func (h *Hub) Run(ctx context.Context) {
+	if h.redisClient == nil {
+		return
+	}
+
+	log := logger.GetLogger("chat")
+	pubsub := h.redisClient.PSubscribe(ctx, chatChannelPrefix+"*")
+	defer pubsub.Close()
+	if err := pubsub.Subscribe(ctx, chatDisconnectChannel); err != nil {
+		log.Error().Err(err).Msg("Failed to subscribe to chat disconnects")
+	}
+
+	for msg := range pubsub.Channel() {
+		if msg.Channel == chatDisconnectChannel {
+			h.disconnect(msg.Payload)
+			continue
+		}
+
+		var event dto.ChatEvent
+		if err := json.Unmarshal([]byte(msg.Payload), &event); err != nil {
+			log.Warn().Err(err).Str("channel", msg.Channel).Msg("Dropping malformed chat event")
+			continue
+		}
+		if event.ConversationID == "" {
+			event.ConversationID = strings.TrimPrefix(msg.Channel, chatChannelPrefix)
+		}
+		h.deliver(&event)
+	}
+}
+
+// Publish sends an event to every client of the conversation on all instances
+func (h *Hub) Publish(ctx context.Context, event *dto.ChatEvent) error {
+	if h.redisClient == nil {
+		h.deliver(event)
+		return nil
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	return h.redisClient.Publish(ctx, chatChannelPrefix+event.ConversationID, payload).Err()
+}
+
+// DisconnectUser drops the user's clients on all instances, e.g. when their
+// account is suspended or deleted; their sockets are closed and reconnecting
+// goes through authentication again
+func (h *Hub) DisconnectUser(ctx context.Context, userID string) error {
+	if h.redisClient == nil {
+		h.disconnect(userID)
+		return nil
+	}
+	return h.redisClient.Publish(ctx, chatDisconnectChannel, userID).Err()
+}
+
+// Register subscribes a new client to a conversation
+func (h *Hub) Register(userID, conversationID string) *Client {
+	client := &Client{
+		UserID:         userID,
+		ConversationID: conversationID,
+		events:         make(chan *dto.ChatEvent, clientBufferSize),
+		done:           make(chan struct{}),
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.clients[conversationID] == nil {
+		h.clients[conversationID] = make(map[*Client]struct{})
+	}
+	h.clients[conversationID][client] = struct{}{}
+
+	return client
+}
+
+// Unregister removes a client from the hub and closes it
+func (h *Hub) Unregister(client *Client) {
+	h.mu.Lock()
+	if clients, ok := h.clients[client.ConversationID]; ok {
+		delete(clients, client)
+		if len(clients) == 0 {
+			delete(h.clients, client.ConversationID)
+		}
+	}
+	h.mu.Unlock()
+
+	client.Close()
+}
+
+// disconnect drops the local clients of a user
+func (h *Hub) disconnect(userID string) {
+	h.mu.RLock()
+	var targets []*Client
+	for _, clients := range h.clients {
+		for client := range clients {
+			if client.UserID == userID {
+				targets = append(targets, client)
+			}
+		}
+	}
+	h.mu.RUnlock()
+
+	for _, client := range targets {
+		h.Unregister(client)
+	}
+}
+
+// deliver hands an event to the local clients of its conversation
+func (h *Hub) deliver(event *dto.ChatEvent) {
+	h.mu.RLock()
+	targets := make([]*Client, 0, len(h.clients[event.ConversationID]))
+	for client := range h.clients[event.ConversationID] {
+		targets = append(targets, client)
+	}
+	h.mu.RUnlock()
+
+	for _, client := range targets {
+		// Senders don't need their own typing indicator echoed back
+		if event.Type == EventTyping && client.UserID == event.UserID {
+			continue
+		}
+		if !client.send(event) {
+			// Slow consumer; drop it rather than block delivery to everyone else
+			h.Unregister(client)
+			continue
+		}
+		if event.Type == EventClosed {
+			h.Unregister(client)
+		}
+	}
+}
+
+// Events returns the channel of events to write to the socket
+func (c *Client) Events() <-chan *dto.ChatEvent {
+	return c.events
+}
+
+// Done is closed when the client has been dropped by the hub
+func (c *Client) Done() <-chan struct{} {
+	return c.done
+}
+
+// Close marks the client as done. It is safe to call more than once.
+func (c *Client) Close() {
+	c.closeOnce.Do(func() {
+		close(c.done)
+	})
+}
+
+// Reply queues an event for this client only, such as an error for a rejected frame
+func (c *Client) Reply(event *dto.ChatEvent) {
+	c.send(event)
+}
+
+// send queues an event without blocking, reporting whether it was queued
+func (c *Client) send(event *dto.ChatEvent) bool {
+	select {
+	case <-c.done:
+		return true
+	default:
+	}
+
+	select {
+	case c.events <- event:
+		return true
+	default:
+		return false
+	}
+}
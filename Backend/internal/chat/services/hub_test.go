@@ -0,0 +1,32 @@
+// internal/chat/services/hub_test.go
+package services
+
+import "testing"
+
+// TestHubDisconnectUser drops only the user's clients, in every conversation
+func TestHubDisconnectUser(t *testing.T) {
+	hub := NewHub(nil)
+	first := hub.Register("u1", "c1")
+	second := hub.Register("u1", "c2")
+	partner := hub.Register("u2", "c1")
+
+	if err := hub.DisconnectUser(t.Context(), "u1"); err != nil {
+		t.Fatalf("DisconnectUser: %v", err)
+	}
+
+	for _, client := range []*Client{first, second} {
+		select {
+		case <-client.Done():
+		default:
+			t.Errorf("client of u1 in %s was not dropped", client.ConversationID)
+		}
+	}
+	select {
+	case <-partner.Done():
+		t.Error("partner's client was dropped")
+	default:
+	}
+	if _, ok := hub.clients["c2"]; ok {
+		t.Error("empty conversation kept in the hub")
+	}
+}
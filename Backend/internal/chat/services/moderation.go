@@ -0,0 +1,354 @@
+// internal/chat/services/moderation.go
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/UnoraApp/be/internal/config"
+	"github.com/UnoraApp/be/pkg/logger"
+)
+
+// Verdict is a moderator's decision on a message, ordered by severity
+type Verdict int
+
+const (
+	// VerdictAllow delivers the message
+	VerdictAllow Verdict = iota
+	// VerdictFlag delivers the message and records it for review
+	VerdictFlag
+	// VerdictHold keeps the message from the partner until an admin approves it
+	VerdictHold
+	// VerdictReject refuses the message
+	VerdictReject
+)
+
+// ModerationInput is the message being moderated
+type ModerationInput struct {
+	SenderID       string
+	ConversationID string
+	Body           string
+}
+
+// ModerationResult is a non-allow decision from a single moderator
+type ModerationResult struct {
+	Verdict Verdict
+	Source  string
+	Reason  string
+}
+
+// Moderator inspects a message. Returning a nil result allows it.
+type Moderator interface {
+	Name() string
+	Moderate(ctx context.Context, input *ModerationInput) (*ModerationResult, error)
+}
+
+// SenderLimits throttles senders. Zero disables a limit.
+type SenderLimits struct {
+	MessagesPerMinute int
+	ViolationsPerHour int
+}
+
+// ModerationPipeline runs every moderator over a message
+type ModerationPipeline struct {
+	moderators []Moderator
+	limits     SenderLimits
+}
+
+// NewModerationPipeline creates a pipeline from moderators
+func NewModerationPipeline(limits SenderLimits, moderators ...Moderator) *ModerationPipeline {
+	return &ModerationPipeline{
+		moderators: moderators,
+		limits:     limits,
+	}
+}
+
+// NewModerationPipelineFromConfig creates the pipeline described by the
+// moderation config: keyword lists, link and phone-number detection, and
+// the AI classifier when an endpoint is configured
+func NewModerationPipelineFromConfig(cfg *config.ModerationConfig) *ModerationPipeline {
+	moderators := []Moderator{}
+
+	if m := NewKeywordModerator(VerdictReject, splitList(cfg.BlockedKeywords)); m != nil {
+		moderators = append(moderators, m)
+	}
+	if m := NewKeywordModerator(VerdictHold, splitList(cfg.HeldKeywords)); m != nil {
+		moderators = append(moderators, m)
+	}
+
+	linkVerdict := VerdictFlag
+	if cfg.HoldLinks {
+		linkVerdict = VerdictHold
+	}
+	moderators = append(moderators, NewLinkModerator(linkVerdict))
+
+	phoneVerdict := VerdictFlag
+	if cfg.HoldPhoneNumbers {
+		phoneVerdict = VerdictHold
+	}
+	moderators = append(moderators, NewPhoneNumberModerator(phoneVerdict))
+
+	if cfg.AIEndpoint != "" {
+		classifier := NewHTTPClassifier(cfg.AIEndpoint, cfg.AIAPIKey)
+		moderators = append(moderators, NewAIModerator(classifier, cfg.AIHoldThreshold))
+	}
+
+	return NewModerationPipeline(SenderLimits{
+		MessagesPerMinute: cfg.MaxMessagesPerMinute,
+		ViolationsPerHour: cfg.MaxViolationsPerHour,
+	}, moderators...)
+}
+
+// Use appends a moderator to the pipeline
+func (p *ModerationPipeline) Use(m Moderator) {
+	p.moderators = append(p.moderators, m)
+}
+
+// Limits returns the sender limits
+func (p *ModerationPipeline) Limits() SenderLimits {
+	return p.limits
+}
+
+// Run returns every non-allow result. A failing moderator is logged and
+// skipped so that an outage never blocks chat.
+func (p *ModerationPipeline) Run(ctx context.Context, input *ModerationInput) []*ModerationResult {
+	log := logger.GetLogger("chat")
+
+	var results []*ModerationResult
+	for _, m := range p.moderators {
+		result, err := m.Moderate(ctx, input)
+		if err != nil {
+			log.Warn().Err(err).Str("moderator", m.Name()).Msg("Moderator failed, skipping")
+			continue
+		}
+		if result == nil || result.Verdict == VerdictAllow {
+			continue
+		}
+		if result.Source == "" {
+			result.Source = m.Name()
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+// Decide returns the most severe verdict among results
+func Decide(results []*ModerationResult) Verdict {
+	verdict := VerdictAllow
+	for _, r := range results {
+		if r.Verdict > verdict {
+			verdict = r.Verdict
+		}
+	}
+	return verdict
+}
+
+// ===== KEYWORDS =====
+
+// KeywordModerator matches whole words or phrases, case-insensitively
+type KeywordModerator struct {
+	verdict Verdict
+	pattern *regexp.Regexp
+}
+
+// NewKeywordModerator creates a keyword moderator, or nil for an empty list
+func NewKeywordModerator(verdict Verdict, keywords []string) *KeywordModerator {
+	if len(keywords) == 0 {
+		return nil
+	}
+	quoted := make([]string, len(keywords))
+	for i, k := range keywords {
+		quoted[i] = regexp.QuoteMeta(k)
+	}
+	return &KeywordModerator{
+		verdict: verdict,
+		pattern: regexp.MustCompile(`(?i)\b(?:` + strings.Join(quoted, "|") + `)\b`),
+	}
+}
+
+// Name returns the moderator name
+func (m *KeywordModerator) Name() string { return "keyword" }
+
+// Moderate checks the message for listed keywords
+func (m *KeywordModerator) Moderate(_ context.Context, input *ModerationInput) (*ModerationResult, error) {
+	match := m.pattern.FindString(input.Body)
+	if match == "" {
+		return nil, nil
+	}
+	return &ModerationResult{
+		Verdict: m.verdict,
+		Reason:  fmt.Sprintf("matched keyword %q", strings.ToLower(match)),
+	}, nil
+}
+
+// ===== LINKS =====
+
+var linkPattern = regexp.MustCompile(`(?i)\b(?:https?://|www\.)\S+|\b[a-z0-9-]+\.(?:com|in|net|org|io|me|app|ly|co|link|gg|xyz)\b(?:/\S*)?`)
+
+// LinkModerator detects URLs, a common vector for scams and off-platform moves
+type LinkModerator struct {
+	verdict Verdict
+}
+
+// NewLinkModerator creates a link moderator
+func NewLinkModerator(verdict Verdict) *LinkModerator {
+	return &LinkModerator{verdict: verdict}
+}
+
+// Name returns the moderator name
+func (m *LinkModerator) Name() string { return "link" }
+
+// Moderate checks the message for links
+func (m *LinkModerator) Moderate(_ context.Context, input *ModerationInput) (*ModerationResult, error) {
+	if !linkPattern.MatchString(input.Body) {
+		return nil, nil
+	}
+	return &ModerationResult{Verdict: m.verdict, Reason: "message contains a link"}, nil
+}
+
+// ===== PHONE NUMBERS =====
+
+var phoneCandidatePattern = regexp.MustCompile(`\+?\d[\d\s().-]{8,}\d`)
+
+// PhoneNumberModerator detects phone numbers, including spaced or dashed ones
+type PhoneNumberModerator struct {
+	verdict Verdict
+}
+
+// NewPhoneNumberModerator creates a phone number moderator
+func NewPhoneNumberModerator(verdict Verdict) *PhoneNumberModerator {
+	return &PhoneNumberModerator{verdict: verdict}
+}
+
+// Name returns the moderator name
+func (m *PhoneNumberModerator) Name() string { return "phone_number" }
+
+// Moderate checks the message for phone numbers
+func (m *PhoneNumberModerator) Moderate(_ context.Context, input *ModerationInput) (*ModerationResult, error) {
+	for _, candidate := range phoneCandidatePattern.FindAllString(input.Body, -1) {
+		digits := 0
+		for _, r := range candidate {
+			if r >= '0' && r <= '9' {
+				digits++
+			}
+		}
+		if digits >= 10 && digits <= 13 {
+			return &ModerationResult{Verdict: m.verdict, Reason: "message contains a phone number"}, nil
+		}
+	}
+	return nil, nil
+}
+
+// ===== AI =====
+
+// Classification is an AI classifier's assessment of a message
+type Classification struct {
+	Score  float64  `json:"score"`
+	Labels []string `json:"labels"`
+}
+
+// Classifier scores how likely a message is abusive or manipulative (0–1)
+type Classifier interface {
+	Classify(ctx context.Context, text string) (*Classification, error)
+}
+
+// AIModerator holds messages an AI classifier scores at or above a threshold
+type AIModerator struct {
+	classifier    Classifier
+	holdThreshold float64
+}
+
+// NewAIModerator creates an AI moderator
+func NewAIModerator(classifier Classifier, holdThreshold float64) *AIModerator {
+	return &AIModerator{
+		classifier:    classifier,
+		holdThreshold: holdThreshold,
+	}
+}
+
+// Name returns the moderator name
+func (m *AIModerator) Name() string { return "ai" }
+
+// Moderate classifies the message
+func (m *AIModerator) Moderate(ctx context.Context, input *ModerationInput) (*ModerationResult, error) {
+	c, err := m.classifier.Classify(ctx, input.Body)
+	if err != nil {
+		return nil, err
+	}
+	if c.Score < m.holdThreshold {
+		return nil, nil
+	}
+	reason := fmt.Sprintf("AI score %.2f", c.Score)
+	if len(c.Labels) > 0 {
+		reason += " (" + strings.Join(c.Labels, ", ") + ")"
+	}
+	return &ModerationResult{Verdict: VerdictHold, Reason: reason}, nil
+}
+
+// HTTPClassifier calls an external classification endpoint. The endpoint
+// receives {"text": "..."} and answers {"score": 0.0-1.0, "labels": [...]}.
+type HTTPClassifier struct {
+	endpoint   string
+	apiKey     string
+	httpClient *http.Client
+}
+
+// NewHTTPClassifier creates an HTTP classifier
+func NewHTTPClassifier(endpoint, apiKey string) *HTTPClassifier {
+	return &HTTPClassifier{
+		endpoint: endpoint,
+		apiKey:   apiKey,
+		httpClient: &http.Client{
+			Timeout: 3 * time.Second,
+		},
+	}
+}
+
+// Classify sends the text to the classification endpoint
+func (c *HTTPClassifier) Classify(ctx context.Context, text string) (*Classification, error) {
+	body, err := json.Marshal(map[string]string{"text": text})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+c.apiKey)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("classifier request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("classifier returned status %d", resp.StatusCode)
+	}
+
+	var result Classification
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode classifier response: %w", err)
+	}
+	return &result, nil
+}
+
+func splitList(s string) []string {
+	var out []string
+	for _, part := range strings.Split(s, ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			out = append(out, part)
+		}
+	}
+	return out
+}
@@ -0,0 +1,11 @@
+// internal/chat/services/providers.go
+package services
+
+import "github.com/google/wire"
+
+// ProviderSet is the wire provider set for chat services
+var ProviderSet = wire.NewSet(
+	NewHub,
+	NewModerationPipelineFromConfig,
+	NewChatService,
+)
@@ -1,17 +1,25 @@
 // internal/config/config.go
 package config
 
+import "time"
+
 // Config holds all configuration for the application
 type Config struct {
-	Server   ServerConfig
-	Database DatabaseConfig
-	Redis    RedisConfig
-	CORS     CORSConfig
-	Logging  LoggingConfig
-	Storage  StorageConfig
-	Email    EmailConfig
-	Auth     AuthConfig
-	Cron     CronConfig
+	Server     ServerConfig
+	Database   DatabaseConfig
+	Redis      RedisConfig
+	CORS       CORSConfig
+	Logging    LoggingConfig
+	Storage    StorageConfig
+	Email      EmailConfig
+	Auth       AuthConfig
+	Cron       CronConfig
+	Moderation ModerationConfig
+	Payment    PaymentConfig
+	Rewards    RewardsConfig
+	Invoice    InvoiceConfig
+	Account    AccountConfig
+	RateLimit  RateLimitConfig
 }
 
 // ServerConfig holds server-specific configuration
@@ -84,14 +92,128 @@ type EmailConfig struct {
 	UseTLS   bool
 }
 
+// SecretKey is a token encryption secret with its key ID
+type SecretKey struct {
+	ID     string
+	Secret string
+}
+
 // AuthConfig holds authentication configuration
 type AuthConfig struct {
 	SecretKey                 string
 	JWTAccessTokenExpiryHours int
 	JWTRefreshTokenExpiryDays int
+
+	// Token encryption keys. SecretKey, named SecretKeyID, encrypts new
+	// tokens; previous keys still decrypt tokens issued before a rotation.
+	SecretKeyID        string
+	PreviousSecretKeys []SecretKey
+	AcceptLegacyTokens bool // tokens issued before key IDs existed; cut-over only
+
+	// Key of the phone number hashes kept for fraud checks; never rotated, as
+	// hashes under another key no longer match
+	PhoneHashKey string
+
+	// Google Sign-In OAuth client IDs; ID tokens must be issued to one of them
+	GoogleAndroidClientID string
+	GoogleIOSClientID     string
+	GoogleWebClientID     string
+
+	// Sign in with Apple client IDs: the iOS app's bundle ID and the services
+	// ID used on web and Android; identity tokens must be issued to one
+	AppleBundleID   string
+	AppleServicesID string
+
+	// Phone OTP login
+	SMSProvider                string // "console" logs messages instead of sending them
+	DefaultCountryCode         string // assumed for numbers entered without one
+	AllowedCountryCodes        string // comma-separated, e.g. "+91"
+	OTPTTLSeconds              int
+	OTPResendCooldownSeconds   int
+	OTPMaxAttempts             int // wrong codes before the OTP is discarded
+	OTPMaxSendsPerPhonePerDay  int
+	OTPMaxSendsPerIPPerHour    int
+	OTPMaxVerifiesPerIPPerHour int
+
+	// Device risk rules; matches are queued for safety review
+	DeviceMaxAccounts     int // accounts on one device before it is flagged
+	DeviceChurnMaxDevices int // new devices per user within the churn window
+	DeviceChurnWindowDays int
 }
 
 // CronConfig holds cron job configuration
 type CronConfig struct {
 	Schedule string
 }
+
+// ModerationConfig holds chat moderation configuration
+type ModerationConfig struct {
+	BlockedKeywords      string // comma-separated; messages are rejected
+	HeldKeywords         string // comma-separated; messages are held for review
+	HoldLinks            bool
+	HoldPhoneNumbers     bool
+	AIEndpoint           string
+	AIAPIKey             string
+	AIHoldThreshold      float64
+	MaxMessagesPerMinute int
+	MaxViolationsPerHour int
+}
+
+// PaymentConfig holds payment provider configuration
+type PaymentConfig struct {
+	Provider string // razorpay, or fake for local development and tests
+
+	RazorpayKeyID         string
+	RazorpayKeySecret     string
+	RazorpayWebhookSecret string
+	RazorpayBaseURL       string
+
+	// Seconds the fake gateway waits before capturing a delayed payment or
+	// processing a refund
+	FakeCaptureDelaySeconds int
+}
+
+// RewardsConfig holds promotional credit amounts
+type RewardsConfig struct {
+	WelcomeBonusCredits  int // granted once when onboarding completes
+	ReferrerBonusCredits int // granted to the referrer when a referral qualifies
+	RefereeBonusCredits  int // granted to the referred user at the same time
+}
+
+// InvoiceConfig holds the supplier details and tax settings printed on GST
+// invoices
+type InvoiceConfig struct {
+	SellerName      string
+	SellerAddress   string
+	SellerGSTIN     string
+	SellerStateCode string  // two-digit GST state code of the seller
+	SACCode         string  // services accounting code of the credits sold
+	GSTRatePercent  float64 // total GST rate; prices are tax-inclusive
+	NumberPrefix    string  // 1 to 3 characters, e.g. UNR
+}
+
+// AccountConfig holds self-serve account deletion and data export settings
+type AccountConfig struct {
+	DeletionCoolingOffDays int // days a deletion request can be cancelled
+	ExportRetentionDays    int // days an export archive can be downloaded
+}
+
+// RateLimitConfig holds API rate limits. Policies are named; the router
+// applies them to routes and RATE_LIMIT_POLICIES overrides their limits.
+type RateLimitConfig struct {
+	Enabled  bool
+	Policies map[string]RateLimitPolicy
+
+	// Accounts throttled by safety silently get a fraction of each per-user
+	// limit, and every rate-limited request of theirs is held for
+	// ThrottleDelay
+	ThrottleFactor int
+	ThrottleDelay  time.Duration
+}
+
+// RateLimitPolicy allows Limit requests per sliding Window for each key
+type RateLimitPolicy struct {
+	Limit  int
+	Window time.Duration
+	Key    string // "user", "ip", or "user_ip" for both at once
+}
@@ -4,7 +4,10 @@ package config
 import (
 	"fmt"
 	"os"
+	"regexp"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/joho/godotenv"
 )
@@ -56,7 +59,7 @@ func LoadConfig() (*Config, error) {
 	cfg.CORS.AllowedOrigins = getEnv("CORS_ALLOWED_ORIGINS", "*")
 	cfg.CORS.AllowedMethods = getEnv("CORS_ALLOWED_METHODS", "GET,POST,PUT,DELETE,OPTIONS,PATCH")
 	cfg.CORS.AllowedHeaders = getEnv("CORS_ALLOWED_HEADERS", "Content-Type,Content-Length,Accept-Encoding,X-CSRF-Token,Authorization,accept,origin,Cache-Control,X-Requested-With")
-	cfg.CORS.ExposedHeaders = getEnv("CORS_EXPOSED_HEADERS", "Content-Length,Content-Type,Authorization")
+	cfg.CORS.ExposedHeaders = getEnv("CORS_EXPOSED_HEADERS", "Content-Length,Content-Type,Authorization,Retry-After")
 	cfg.CORS.MaxAge = getEnvAsInt("CORS_MAX_AGE", 86400)
 
 	// Logging
@@ -90,13 +93,205 @@ func LoadConfig() (*Config, error) {
 	cfg.Auth.SecretKey = getEnv("AUTH_SECRET_KEY", "default-secret-key-change-in-production")
 	cfg.Auth.JWTAccessTokenExpiryHours = getEnvAsInt("JWT_ACCESS_TOKEN_EXPIRY_HOURS", 24)
 	cfg.Auth.JWTRefreshTokenExpiryDays = getEnvAsInt("JWT_REFRESH_TOKEN_EXPIRY_DAYS", 7)
+	cfg.Auth.SecretKeyID = getEnv("AUTH_SECRET_KEY_ID", "k1")
+	cfg.Auth.AcceptLegacyTokens = getEnvAsBool("AUTH_ACCEPT_LEGACY_TOKENS", false)
+	cfg.Auth.PhoneHashKey = getEnv("PHONE_HASH_KEY", "default-phone-hash-key-change-in-production")
+	previousKeys, err := parseSecretKeys(getEnv("AUTH_PREVIOUS_SECRET_KEYS", ""))
+	if err != nil {
+		return nil, fmt.Errorf("invalid AUTH_PREVIOUS_SECRET_KEYS: %w", err)
+	}
+	cfg.Auth.PreviousSecretKeys = previousKeys
+	if !validKeyID.MatchString(cfg.Auth.SecretKeyID) {
+		return nil, fmt.Errorf("AUTH_SECRET_KEY_ID must be 1 to 16 letters, digits, '-' or '_'")
+	}
+	for _, key := range previousKeys {
+		if key.ID == cfg.Auth.SecretKeyID {
+			return nil, fmt.Errorf("AUTH_PREVIOUS_SECRET_KEYS reuses the active key ID %q", key.ID)
+		}
+	}
+	cfg.Auth.GoogleAndroidClientID = getEnv("GOOGLE_ANDROID_CLIENT_ID", "")
+	cfg.Auth.GoogleIOSClientID = getEnv("GOOGLE_IOS_CLIENT_ID", "")
+	cfg.Auth.GoogleWebClientID = getEnv("GOOGLE_WEB_CLIENT_ID", "")
+	cfg.Auth.AppleBundleID = getEnv("APPLE_BUNDLE_ID", "")
+	cfg.Auth.AppleServicesID = getEnv("APPLE_SERVICES_ID", "")
+
+	// Phone OTP login
+	cfg.Auth.SMSProvider = getEnv("SMS_PROVIDER", "console")
+	cfg.Auth.DefaultCountryCode = getEnv("PHONE_DEFAULT_COUNTRY_CODE", "+91")
+	cfg.Auth.AllowedCountryCodes = getEnv("PHONE_ALLOWED_COUNTRY_CODES", "+91")
+	cfg.Auth.OTPTTLSeconds = getEnvAsInt("OTP_TTL_SECONDS", 300)
+	cfg.Auth.OTPResendCooldownSeconds = getEnvAsInt("OTP_RESEND_COOLDOWN_SECONDS", 30)
+	cfg.Auth.OTPMaxAttempts = getEnvAsInt("OTP_MAX_ATTEMPTS", 5)
+	cfg.Auth.OTPMaxSendsPerPhonePerDay = getEnvAsInt("OTP_MAX_SENDS_PER_PHONE_PER_DAY", 10)
+	cfg.Auth.OTPMaxSendsPerIPPerHour = getEnvAsInt("OTP_MAX_SENDS_PER_IP_PER_HOUR", 20)
+	cfg.Auth.OTPMaxVerifiesPerIPPerHour = getEnvAsInt("OTP_MAX_VERIFIES_PER_IP_PER_HOUR", 30)
+
+	// Device risk rules
+	cfg.Auth.DeviceMaxAccounts = getEnvAsInt("DEVICE_MAX_ACCOUNTS", 3)
+	cfg.Auth.DeviceChurnMaxDevices = getEnvAsInt("DEVICE_CHURN_MAX_DEVICES", 4)
+	cfg.Auth.DeviceChurnWindowDays = getEnvAsInt("DEVICE_CHURN_WINDOW_DAYS", 7)
+
+	if cfg.Auth.SMSProvider != "console" {
+		return nil, fmt.Errorf("unknown SMS_PROVIDER %q", cfg.Auth.SMSProvider)
+	}
 
 	// Cron
 	cfg.Cron.Schedule = getEnv("CRON_SCHEDULE", "@daily")
 
+	// Chat moderation
+	cfg.Moderation.BlockedKeywords = getEnv("MODERATION_BLOCKED_KEYWORDS", "")
+	cfg.Moderation.HeldKeywords = getEnv("MODERATION_HELD_KEYWORDS", "")
+	cfg.Moderation.HoldLinks = getEnvAsBool("MODERATION_HOLD_LINKS", true)
+	cfg.Moderation.HoldPhoneNumbers = getEnvAsBool("MODERATION_HOLD_PHONE_NUMBERS", false)
+	cfg.Moderation.AIEndpoint = getEnv("MODERATION_AI_ENDPOINT", "")
+	cfg.Moderation.AIAPIKey = getEnv("MODERATION_AI_API_KEY", "")
+	cfg.Moderation.AIHoldThreshold = getEnvAsFloat("MODERATION_AI_HOLD_THRESHOLD", 0.8)
+	cfg.Moderation.MaxMessagesPerMinute = getEnvAsInt("CHAT_MAX_MESSAGES_PER_MINUTE", 30)
+	cfg.Moderation.MaxViolationsPerHour = getEnvAsInt("CHAT_MAX_VIOLATIONS_PER_HOUR", 5)
+
+	// Payments
+	cfg.Payment.Provider = getEnv("PAYMENT_PROVIDER", "razorpay")
+	cfg.Payment.RazorpayKeyID = getEnv("RAZORPAY_KEY_ID", "")
+	cfg.Payment.RazorpayKeySecret = getEnv("RAZORPAY_KEY_SECRET", "")
+	cfg.Payment.RazorpayWebhookSecret = getEnv("RAZORPAY_WEBHOOK_SECRET", "")
+	cfg.Payment.RazorpayBaseURL = getEnv("RAZORPAY_BASE_URL", "")
+	cfg.Payment.FakeCaptureDelaySeconds = getEnvAsInt("FAKE_PAYMENT_CAPTURE_DELAY_SECONDS", 5)
+
+	if cfg.Payment.Provider == "fake" && cfg.Server.Mode == "production" {
+		return nil, fmt.Errorf("PAYMENT_PROVIDER=fake is not allowed in production")
+	}
+
+	// Promotional credits
+	cfg.Rewards.WelcomeBonusCredits = getEnvAsInt("WELCOME_BONUS_CREDITS", 0)
+	cfg.Rewards.ReferrerBonusCredits = getEnvAsInt("REFERRAL_REFERRER_CREDITS", 0)
+	cfg.Rewards.RefereeBonusCredits = getEnvAsInt("REFERRAL_REFEREE_CREDITS", 0)
+
+	// Invoices
+	cfg.Invoice.SellerName = getEnv("INVOICE_SELLER_NAME", "Unora")
+	cfg.Invoice.SellerAddress = getEnv("INVOICE_SELLER_ADDRESS", "")
+	cfg.Invoice.SellerGSTIN = getEnv("INVOICE_SELLER_GSTIN", "")
+	cfg.Invoice.SellerStateCode = getEnv("INVOICE_SELLER_STATE_CODE", "29")
+	cfg.Invoice.SACCode = getEnv("INVOICE_SAC_CODE", "998439")
+	cfg.Invoice.GSTRatePercent = getEnvAsFloat("INVOICE_GST_RATE_PERCENT", 18)
+	cfg.Invoice.NumberPrefix = getEnv("INVOICE_NUMBER_PREFIX", "UNR")
+
+	// Invoice numbers are PREFIX/YY-YY/NNNNNN and GST caps them at 16 characters
+	if n := len(cfg.Invoice.NumberPrefix); n == 0 || n > 3 {
+		return nil, fmt.Errorf("INVOICE_NUMBER_PREFIX must be 1 to 3 characters")
+	}
+	if len(cfg.Invoice.SellerStateCode) != 2 {
+		return nil, fmt.Errorf("INVOICE_SELLER_STATE_CODE must be a two-digit GST state code")
+	}
+	// A GST invoice must name the supplier's GSTIN and address
+	if cfg.Server.Mode == "production" && (cfg.Invoice.SellerGSTIN == "" || cfg.Invoice.SellerAddress == "") {
+		return nil, fmt.Errorf("INVOICE_SELLER_GSTIN and INVOICE_SELLER_ADDRESS are required in production")
+	}
+
+	// Account deletion and data export
+	cfg.Account.DeletionCoolingOffDays = getEnvAsInt("ACCOUNT_DELETION_COOLING_OFF_DAYS", 14)
+	cfg.Account.ExportRetentionDays = getEnvAsInt("DATA_EXPORT_RETENTION_DAYS", 7)
+
+	// Rate limiting
+	cfg.RateLimit.Enabled = getEnvAsBool("RATE_LIMIT_ENABLED", true)
+	cfg.RateLimit.ThrottleFactor = getEnvAsInt("RATE_LIMIT_THROTTLE_FACTOR", 4)
+	cfg.RateLimit.ThrottleDelay = time.Duration(getEnvAsInt("RATE_LIMIT_THROTTLE_DELAY_MS", 2000)) * time.Millisecond
+	policies, err := parseRateLimitPolicies(getEnv("RATE_LIMIT_POLICIES", ""))
+	if err != nil {
+		return nil, fmt.Errorf("invalid RATE_LIMIT_POLICIES: %w", err)
+	}
+	cfg.RateLimit.Policies = policies
+	if cfg.RateLimit.ThrottleFactor < 1 {
+		return nil, fmt.Errorf("RATE_LIMIT_THROTTLE_FACTOR must be at least 1")
+	}
+
 	return cfg, nil
 }
 
+// validKeyID matches token key IDs, which are embedded in tokens before a "."
+var validKeyID = regexp.MustCompile(`^[A-Za-z0-9_-]{1,16}$`)
+
+// parseSecretKeys parses comma-separated id:secret pairs
+func parseSecretKeys(value string) ([]SecretKey, error) {
+	var keys []SecretKey
+	seen := make(map[string]bool)
+	for i, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		// Errors name the entry by position so secrets are not logged
+		id, secret, found := strings.Cut(entry, ":")
+		if !found || secret == "" {
+			return nil, fmt.Errorf("entry %d is not id:secret", i+1)
+		}
+		if !validKeyID.MatchString(id) {
+			return nil, fmt.Errorf("invalid key ID %q", id)
+		}
+		if seen[id] {
+			return nil, fmt.Errorf("duplicate key ID %q", id)
+		}
+		seen[id] = true
+		keys = append(keys, SecretKey{ID: id, Secret: secret})
+	}
+	return keys, nil
+}
+
+// defaultRateLimitPolicies are the limits of each rate-limited route group
+func defaultRateLimitPolicies() map[string]RateLimitPolicy {
+	return map[string]RateLimitPolicy{
+		"auth_login":      {Limit: 10, Window: time.Minute, Key: "ip"},
+		"auth_refresh":    {Limit: 30, Window: time.Minute, Key: "ip"},
+		"photo_upload":    {Limit: 30, Window: time.Hour, Key: "user"},
+		"storage_presign": {Limit: 60, Window: time.Hour, Key: "ip"},
+		"interests":       {Limit: 60, Window: time.Hour, Key: "user"},
+		"reports":         {Limit: 10, Window: time.Hour, Key: "user_ip"},
+		"blocks":          {Limit: 30, Window: time.Hour, Key: "user_ip"},
+		"payment_webhook": {Limit: 600, Window: time.Minute, Key: "ip"},
+	}
+}
+
+// parseRateLimitPolicies applies comma-separated name=limit/window[/key]
+// overrides, e.g. "interests=100/1h,auth_login=20/1m/ip", to the defaults
+func parseRateLimitPolicies(value string) (map[string]RateLimitPolicy, error) {
+	policies := defaultRateLimitPolicies()
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		name, spec, found := strings.Cut(entry, "=")
+		policy, known := policies[name]
+		if !found || !known {
+			return nil, fmt.Errorf("unknown policy in %q", entry)
+		}
+
+		parts := strings.Split(spec, "/")
+		if len(parts) < 2 || len(parts) > 3 {
+			return nil, fmt.Errorf("%q is not name=limit/window[/key]", entry)
+		}
+		limit, err := strconv.Atoi(parts[0])
+		if err != nil || limit < 1 {
+			return nil, fmt.Errorf("invalid limit in %q", entry)
+		}
+		window, err := time.ParseDuration(parts[1])
+		if err != nil || window < time.Second {
+			return nil, fmt.Errorf("invalid window in %q", entry)
+		}
+		policy.Limit = limit
+		policy.Window = window
+		if len(parts) == 3 {
+			switch parts[2] {
+			case "user", "ip", "user_ip":
+				policy.Key = parts[2]
+			default:
+				return nil, fmt.Errorf("invalid key in %q", entry)
+			}
+		}
+		policies[name] = policy
+	}
+	return policies, nil
+}
+
 func loadEnvFile() error {
 	envFile := ".env"
 	if _, err := os.Stat(envFile); os.IsNotExist(err) {
@@ -121,6 +316,15 @@ func getEnvAsInt(key string, defaultValue int) int {
 	return defaultValue
 }
 
+func getEnvAsFloat(key string, defaultValue float64) float64 {
+	if value := os.Getenv(key); value != "" {
+		if floatVal, err := strconv.ParseFloat(value, 64); err == nil {
+			return floatVal
+		}
+	}
+	return defaultValue
+}
+
 func getEnvAsBool(key string, defaultValue bool) bool {
 	if value := os.Getenv(key); value != "" {
 		if boolVal, err := strconv.ParseBool(value); err == nil {
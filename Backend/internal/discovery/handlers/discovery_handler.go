@@ -187,7 +187,7 @@ func (h *DiscoveryHandler) GetRefreshStatus(c *gin.Context) {
 // @Security     BearerAuth
 // @Param        request body dto.ExpressInterestRequest true "Interest data"
 // @Success      201 {object} response.APIResponse{data=dto.InterestResponse} "Interest created"
-// @Failure      400 {object} response.APIResponse "Invalid request or already interested"
+// @Failure      400 {object} response.APIResponse "Invalid request, already interested or at connection capacity"
 // @Failure      401 {object} response.APIResponse "Not authenticated"
 // @Router       /interests [post]
 func (h *DiscoveryHandler) ExpressInterest(c *gin.Context) {
@@ -5,8 +5,11 @@ import (
 	"github.com/gin-gonic/gin"
 
 	ent "github.com/UnoraApp/be/ent/generated"
+	chatservices "github.com/UnoraApp/be/internal/chat/services"
 	"github.com/UnoraApp/be/internal/discovery/handlers"
 	"github.com/UnoraApp/be/internal/discovery/services"
+	entitlementservices "github.com/UnoraApp/be/internal/entitlement/services"
+	"github.com/UnoraApp/be/pkg/ratelimit"
 	"github.com/UnoraApp/be/pkg/storage"
 )
 
@@ -15,11 +18,14 @@ func RegisterDiscoveryRoutes(
 	router *gin.RouterGroup,
 	entClient *ent.Client,
 	storageClient storage.Client,
+	chatService *chatservices.ChatService,
+	entitlementService *entitlementservices.EntitlementService,
 	authMiddleware gin.HandlerFunc,
+	limiter *ratelimit.Limiter,
 ) {
 	// Create services
-	discoveryService := services.NewDiscoveryService(entClient, storageClient)
-	matchingService := services.NewMatchingService(entClient, storageClient)
+	discoveryService := services.NewDiscoveryService(entClient, storageClient, entitlementService)
+	matchingService := services.NewMatchingService(entClient, storageClient, chatService, entitlementService)
 
 	// Create handler
 	handler := handlers.NewDiscoveryHandler(discoveryService, matchingService)
@@ -41,7 +47,7 @@ func RegisterDiscoveryRoutes(
 		protected.GET("/discover/refresh-status", handler.GetRefreshStatus)
 
 		// Interests
-		protected.POST("/interests", handler.ExpressInterest)
+		protected.POST("/interests", limiter.Middleware("interests"), handler.ExpressInterest)
 		protected.GET("/interests/sent", handler.GetSentInterests)
 		protected.GET("/interests/received", handler.GetReceivedInterests)
 
@@ -18,20 +18,23 @@ import (
 	"github.com/UnoraApp/be/ent/generated/server"
 	"github.com/UnoraApp/be/ent/generated/user"
 	"github.com/UnoraApp/be/internal/discovery/dto"
+	entitlementservices "github.com/UnoraApp/be/internal/entitlement/services"
 	"github.com/UnoraApp/be/pkg/storage"
 )
 
 // DiscoveryService handles discovery-related business logic
 type DiscoveryService struct {
-	entClient     *ent.Client
-	storageClient storage.Client
+	entClient          *ent.Client
+	storageClient      storage.Client
+	entitlementService *entitlementservices.EntitlementService
 }
 
 // NewDiscoveryService creates a new discovery service
-func NewDiscoveryService(entClient *ent.Client, storageClient storage.Client) *DiscoveryService {
+func NewDiscoveryService(entClient *ent.Client, storageClient storage.Client, entitlementService *entitlementservices.EntitlementService) *DiscoveryService {
 	return &DiscoveryService{
-		entClient:     entClient,
-		storageClient: storageClient,
+		entClient:          entClient,
+		storageClient:      storageClient,
+		entitlementService: entitlementService,
 	}
 }
 
@@ -168,16 +171,21 @@ func (s *DiscoveryService) RefreshDiscovery(ctx context.Context, userID, serverT
 		return nil, fmt.Errorf("failed to get user: %w", err)
 	}
 
-	tier := string(u.SubscriptionTier)
-	tierConfig := getTierConfig(tier)
-
 	// Check if at connection capacity (Hard Lock)
-	if u.ActiveConnectionCount >= tierConfig.ConnectionSlots {
-		return nil, fmt.Errorf("at connection capacity (%d/%d slots). Focus on your current connections or upgrade", u.ActiveConnectionCount, tierConfig.ConnectionSlots)
+	slots, err := s.entitlementService.ConnectionSlots(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+	if !slots.Allowed {
+		return nil, fmt.Errorf("at connection capacity (%d/%d slots). Focus on your current connections or upgrade", slots.Used, slots.Limit)
 	}
 
 	// Check refresh cooldown
-	if u.RefreshAvailableAt != nil && time.Now().Before(*u.RefreshAvailableAt) {
+	refresh, err := s.entitlementService.DiscoveryRefresh(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+	if !refresh.Allowed {
 		return nil, fmt.Errorf("refresh not available yet")
 	}
 
@@ -194,10 +202,14 @@ func (s *DiscoveryService) RefreshDiscovery(ctx context.Context, userID, serverT
 	}
 
 	// Set next refresh time based on tier
-	nextRefresh := time.Now().Add(tierConfig.RefreshCooldown)
+	limits, err := s.entitlementService.LimitsFor(ctx, string(u.SubscriptionTier))
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
 	_, err = u.Update().
-		SetRefreshAvailableAt(nextRefresh).
-		SetLastGlobalRefreshAt(time.Now()).
+		SetRefreshAvailableAt(now.Add(limits.RefreshCooldown)).
+		SetLastGlobalRefreshAt(now).
 		Save(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update refresh time: %w", err)
@@ -207,24 +219,6 @@ func (s *DiscoveryService) RefreshDiscovery(ctx context.Context, userID, serverT
 	return s.generateNewBatch(ctx, userID, serverType)
 }
 
-// TierConfig contains tier-specific limits
-type tierConfigInternal struct {
-	ConnectionSlots int
-	RefreshCooldown time.Duration
-}
-
-func getTierConfig(tier string) tierConfigInternal {
-	configs := map[string]tierConfigInternal{
-		"free": {1, 24 * time.Hour},
-		"plus": {2, 12 * time.Hour},
-		"pro":  {4, 6 * time.Hour},
-	}
-	if c, ok := configs[tier]; ok {
-		return c
-	}
-	return configs["free"]
-}
-
 // GetRefreshStatus returns the refresh availability status
 func (s *DiscoveryService) GetRefreshStatus(ctx context.Context, userID string) (*dto.RefreshStatusResponse, error) {
 	u, err := s.entClient.User.Get(ctx, userID)
@@ -232,16 +226,20 @@ func (s *DiscoveryService) GetRefreshStatus(ctx context.Context, userID string)
 		return nil, fmt.Errorf("failed to get user: %w", err)
 	}
 
-	if u.RefreshAvailableAt == nil || time.Now().After(*u.RefreshAvailableAt) {
+	refresh, err := s.entitlementService.DiscoveryRefresh(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+	if refresh.Allowed {
 		return &dto.RefreshStatusResponse{
 			CanRefresh: true,
 		}, nil
 	}
 
-	seconds := int(time.Until(*u.RefreshAvailableAt).Seconds())
+	seconds := int(time.Until(*refresh.AvailableAt).Seconds())
 	return &dto.RefreshStatusResponse{
 		CanRefresh:          false,
-		RefreshAvailableAt:  u.RefreshAvailableAt,
+		RefreshAvailableAt:  refresh.AvailableAt,
 		SecondsUntilRefresh: &seconds,
 	}, nil
 }
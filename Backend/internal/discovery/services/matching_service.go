@@ -10,25 +10,32 @@ import (
 
 	ent "github.com/UnoraApp/be/ent/generated"
 	"github.com/UnoraApp/be/ent/generated/connection"
+	"github.com/UnoraApp/be/ent/generated/conversation"
 	"github.com/UnoraApp/be/ent/generated/interest"
 	"github.com/UnoraApp/be/ent/generated/photo"
 	"github.com/UnoraApp/be/ent/generated/profile"
 	"github.com/UnoraApp/be/ent/generated/streak"
+	chatservices "github.com/UnoraApp/be/internal/chat/services"
 	"github.com/UnoraApp/be/internal/discovery/dto"
+	entitlementservices "github.com/UnoraApp/be/internal/entitlement/services"
 	"github.com/UnoraApp/be/pkg/storage"
 )
 
 // MatchingService handles interest expression and connection management
 type MatchingService struct {
-	entClient     *ent.Client
-	storageClient storage.Client
+	entClient          *ent.Client
+	storageClient      storage.Client
+	chatService        *chatservices.ChatService
+	entitlementService *entitlementservices.EntitlementService
 }
 
 // NewMatchingService creates a new matching service
-func NewMatchingService(entClient *ent.Client, storageClient storage.Client) *MatchingService {
+func NewMatchingService(entClient *ent.Client, storageClient storage.Client, chatService *chatservices.ChatService, entitlementService *entitlementservices.EntitlementService) *MatchingService {
 	return &MatchingService{
-		entClient:     entClient,
-		storageClient: storageClient,
+		entClient:          entClient,
+		storageClient:      storageClient,
+		chatService:        chatService,
+		entitlementService: entitlementService,
 	}
 }
 
@@ -49,6 +56,19 @@ func (s *MatchingService) ExpressInterest(ctx context.Context, senderUserID stri
 	receiverUserID := card.CandidateUserID
 	serverType := string(batch.ServerType)
 
+	// A user at connection capacity cannot start new connections (Hard Lock)
+	sender, err := s.entClient.User.Get(ctx, senderUserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	slots, err := s.entitlementService.ConnectionSlots(ctx, sender)
+	if err != nil {
+		return nil, err
+	}
+	if !slots.Allowed {
+		return nil, fmt.Errorf("at connection capacity (%d/%d slots). Focus on your current connections or upgrade", slots.Used, slots.Limit)
+	}
+
 	// Check if already expressed interest
 	exists, _ := s.entClient.Interest.
 		Query().
@@ -242,7 +262,7 @@ func (s *MatchingService) GetConnection(ctx context.Context, userID, connectionI
 
 // TerminateConnection terminates a connection
 func (s *MatchingService) TerminateConnection(ctx context.Context, userID, connectionID string) error {
-	_, err := s.entClient.Connection.
+	affected, err := s.entClient.Connection.
 		Update().
 		Where(connection.IDEQ(connectionID)).
 		Where(
@@ -257,6 +277,11 @@ func (s *MatchingService) TerminateConnection(ctx context.Context, userID, conne
 	if err != nil {
 		return fmt.Errorf("failed to terminate connection: %w", err)
 	}
+	if affected > 0 {
+		if err := s.chatService.CloseConversation(ctx, connectionID, conversation.ClosedReasonTerminated); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -320,11 +345,13 @@ func (s *MatchingService) executeTotalWipeIfAtCapacity(ctx context.Context, user
 		return
 	}
 
-	tier := string(u.SubscriptionTier)
-	tierConfig := matchingGetTierConfig(tier)
+	slots, err := s.entitlementService.ConnectionSlots(ctx, u)
+	if err != nil {
+		return
+	}
 
 	// Check if at capacity
-	if u.ActiveConnectionCount >= tierConfig.ConnectionSlots {
+	if !slots.Allowed {
 		// Total Wipe: Delete all pending outgoing interests
 		s.entClient.Interest.
 			Update().
@@ -335,25 +362,6 @@ func (s *MatchingService) executeTotalWipeIfAtCapacity(ctx context.Context, user
 	}
 }
 
-// matchingGetTierConfig for matching service
-func matchingGetTierConfig(tier string) struct {
-	ConnectionSlots int
-	RefreshCooldown time.Duration
-} {
-	configs := map[string]struct {
-		ConnectionSlots int
-		RefreshCooldown time.Duration
-	}{
-		"free": {1, 24 * time.Hour},
-		"plus": {2, 12 * time.Hour},
-		"pro":  {4, 6 * time.Hour},
-	}
-	if c, ok := configs[tier]; ok {
-		return c
-	}
-	return configs["free"]
-}
-
 func (s *MatchingService) interestToResponse(ctx context.Context, i *ent.Interest, otherUserID string) (*dto.InterestResponse, error) {
 	userInfo, err := s.getUserInfo(ctx, otherUserID)
 	if err != nil {
@@ -0,0 +1,35 @@
+// internal/entitlement/dto/entitlement_dto.go
+package dto
+
+import "time"
+
+// EntitlementsResponse is the user's tier limits and current usage
+// @Description Tier limits and usage for the current user
+type EntitlementsResponse struct {
+	Tier             string                      `json:"tier" example:"plus"`
+	Limits           TierLimitsResponse          `json:"limits"`
+	ConnectionSlots  EntitlementDecisionResponse `json:"connectionSlots"`
+	DiscoveryRefresh EntitlementDecisionResponse `json:"discoveryRefresh"`
+}
+
+// TierLimitsResponse is the user-facing limits of a tier
+// @Description Limits of a subscription tier
+type TierLimitsResponse struct {
+	ConnectionSlots             int `json:"connectionSlots" example:"2"`
+	RefreshCooldownMinutes      int `json:"refreshCooldownMinutes" example:"720"`
+	NudgesPerAtRisk             int `json:"nudgesPerAtRisk" example:"3"`
+	NudgeIntervalMinutes        int `json:"nudgeIntervalMinutes" example:"420"`
+	FreeRecoveriesPerConnection int `json:"freeRecoveriesPerConnection" example:"1"`
+	PurchasableReveals          int `json:"purchasableReveals" example:"2"`
+	EarnedReveals               int `json:"earnedReveals" example:"3"`
+}
+
+// EntitlementDecisionResponse says whether a limited feature can be used now
+// @Description Usage of a tier-limited feature
+type EntitlementDecisionResponse struct {
+	Allowed     bool       `json:"allowed" example:"true"`
+	Limit       int        `json:"limit" example:"2"`
+	Used        int        `json:"used" example:"1"`
+	Remaining   int        `json:"remaining" example:"1"`
+	AvailableAt *time.Time `json:"availableAt,omitempty" example:"2024-01-01T12:00:00Z"`
+}
@@ -0,0 +1,51 @@
+// internal/entitlement/handlers/entitlement_handler.go
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/UnoraApp/be/internal/entitlement/services"
+	"github.com/UnoraApp/be/pkg/apperror"
+	"github.com/UnoraApp/be/pkg/response"
+)
+
+// EntitlementHandler handles entitlement HTTP requests
+type EntitlementHandler struct {
+	entitlementService *services.EntitlementService
+}
+
+// NewEntitlementHandler creates a new entitlement handler
+func NewEntitlementHandler(entitlementService *services.EntitlementService) *EntitlementHandler {
+	return &EntitlementHandler{
+		entitlementService: entitlementService,
+	}
+}
+
+// GetEntitlements godoc
+// @Summary      Get entitlements
+// @Description  Get the current user's tier limits and how much of each remains
+// @Tags         entitlements
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200 {object} response.APIResponse{data=dto.EntitlementsResponse} "Entitlements"
+// @Failure      401 {object} response.APIResponse "Not authenticated"
+// @Failure      404 {object} response.APIResponse "User not found"
+// @Router       /entitlements [get]
+func (h *EntitlementHandler) GetEntitlements(c *gin.Context) {
+	userID, _ := c.Get("userID")
+
+	entitlements, err := h.entitlementService.GetEntitlements(c.Request.Context(), userID.(string))
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			apperror.HandleError(c, apperror.NotFound("user"))
+			return
+		}
+		apperror.HandleError(c, apperror.InternalError(err))
+		return
+	}
+	response.JSON(c, http.StatusOK, entitlements)
+}
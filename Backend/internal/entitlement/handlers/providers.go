@@ -0,0 +1,9 @@
+// internal/entitlement/handlers/providers.go
+package handlers
+
+import "github.com/google/wire"
+
+// ProviderSet is the wire provider set for entitlement handlers
+var ProviderSet = wire.NewSet(
+	NewEntitlementHandler,
+)
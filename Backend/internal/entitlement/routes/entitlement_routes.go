@@ -0,0 +1,26 @@
+// internal/entitlement/routes/entitlement_routes.go
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/UnoraApp/be/internal/entitlement/handlers"
+	"github.com/UnoraApp/be/internal/entitlement/services"
+)
+
+// RegisterEntitlementRoutes registers all entitlement-related routes
+func RegisterEntitlementRoutes(
+	router *gin.RouterGroup,
+	entitlementService *services.EntitlementService,
+	authMiddleware gin.HandlerFunc,
+) {
+	// Create handler
+	handler := handlers.NewEntitlementHandler(entitlementService)
+
+	// All entitlement routes require authentication
+	protected := router.Group("")
+	protected.Use(authMiddleware)
+	{
+		protected.GET("/entitlements", handler.GetEntitlements)
+	}
+}
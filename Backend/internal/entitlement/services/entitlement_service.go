@@ -0,0 +1,473 @@
+// internal/entitlement/services/entitlement_service.go
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	ent "github.com/UnoraApp/be/ent/generated"
+	"github.com/UnoraApp/be/ent/generated/connection"
+	"github.com/UnoraApp/be/ent/generated/nudge"
+	"github.com/UnoraApp/be/ent/generated/tierentitlement"
+	"github.com/UnoraApp/be/internal/entitlement/dto"
+)
+
+// Feature names a tier-limited action
+type Feature string
+
+const (
+	FeatureConnectionSlots  Feature = "connection_slots"
+	FeatureDiscoveryRefresh Feature = "discovery_refresh"
+	FeatureNudges           Feature = "nudges"
+	FeatureFreeRecoveries   Feature = "free_recoveries"
+	FeaturePurchasedReveals Feature = "purchased_reveals"
+)
+
+// limitsCacheTTL bounds how long an admin change takes to reach every instance
+const limitsCacheTTL = time.Minute
+
+// maxRevealDay is the last streak day a reveal can unlock on
+const maxRevealDay = 15
+
+var (
+	ErrUnknownTier       = errors.New("unknown tier")
+	ErrInvalidRevealDays = errors.New("reveal days must be ascending, unique and between 1 and 15")
+)
+
+// Limits are the entitlements of one subscription tier
+type Limits struct {
+	Tier                        string
+	ConnectionSlots             int
+	RefreshCooldown             time.Duration
+	NudgesPerAtRisk             int
+	NudgeInterval               time.Duration
+	FreeRecoveriesPerConnection int
+	PurchasableReveals          int
+	// Streak days on which earned reveals unlock; never surfaced to users
+	RevealDays []int
+	UpdatedAt  time.Time
+}
+
+// EarnedReveals is the number of reveals unlocked by streak milestones
+func (l Limits) EarnedReveals() int {
+	return len(l.RevealDays)
+}
+
+// tierOrder lists tiers from lowest to highest
+var tierOrder = []string{"free", "plus", "pro"}
+
+// defaultLimits apply to a tier whose tier_entitlements row is missing
+var defaultLimits = map[string]Limits{
+	"free": {
+		Tier:                        "free",
+		ConnectionSlots:             1,
+		RefreshCooldown:             24 * time.Hour,
+		NudgesPerAtRisk:             1,
+		NudgeInterval:               24 * time.Hour,
+		FreeRecoveriesPerConnection: 0,
+		PurchasableReveals:          3,
+		RevealDays:                  []int{5, 12},
+	},
+	"plus": {
+		Tier:                        "plus",
+		ConnectionSlots:             2,
+		RefreshCooldown:             12 * time.Hour,
+		NudgesPerAtRisk:             3,
+		NudgeInterval:               7 * time.Hour,
+		FreeRecoveriesPerConnection: 1,
+		PurchasableReveals:          2,
+		RevealDays:                  []int{4, 8, 12},
+	},
+	"pro": {
+		Tier:                        "pro",
+		ConnectionSlots:             4,
+		RefreshCooldown:             6 * time.Hour,
+		NudgesPerAtRisk:             4,
+		NudgeInterval:               5 * time.Hour,
+		FreeRecoveriesPerConnection: 2,
+		PurchasableReveals:          1,
+		RevealDays:                  []int{3, 6, 9, 12},
+	},
+}
+
+// Decision answers whether a user may use a feature and how much is left
+type Decision struct {
+	Feature   Feature
+	Allowed   bool
+	Limit     int
+	Used      int
+	Remaining int
+	// AvailableAt is when a time-gated feature next opens; nil when not gated
+	AvailableAt *time.Time
+}
+
+// LimitsUpdate holds the limits an admin changes; nil fields are left as is
+type LimitsUpdate struct {
+	ConnectionSlots             *int
+	RefreshCooldownMinutes      *int
+	NudgesPerAtRisk             *int
+	NudgeIntervalMinutes        *int
+	FreeRecoveriesPerConnection *int
+	PurchasableReveals          *int
+	RevealDays                  []int
+}
+
+// EntitlementService is the single source of tier limits. It answers whether
+// a user can use a limited feature and how many uses remain.
+type EntitlementService struct {
+	entClient *ent.Client
+
+	mu       sync.RWMutex
+	limits   map[string]Limits
+	loadedAt time.Time
+}
+
+// NewEntitlementService creates a new entitlement service
+func NewEntitlementService(entClient *ent.Client) *EntitlementService {
+	return &EntitlementService{
+		entClient: entClient,
+	}
+}
+
+// LimitsFor returns the limits of a tier; unknown tiers get the free limits
+func (s *EntitlementService) LimitsFor(ctx context.Context, tier string) (Limits, error) {
+	all, err := s.load(ctx)
+	if err != nil {
+		return Limits{}, err
+	}
+	if l, ok := all[tier]; ok {
+		return l, nil
+	}
+	return all["free"], nil
+}
+
+// ListLimits returns the limits of every tier, lowest tier first
+func (s *EntitlementService) ListLimits(ctx context.Context) ([]Limits, error) {
+	all, err := s.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]Limits, 0, len(tierOrder))
+	for _, tier := range tierOrder {
+		result = append(result, all[tier])
+	}
+	return result, nil
+}
+
+// UpdateLimits changes a tier's limits. The change applies on this instance
+// immediately and on others once their cache expires.
+func (s *EntitlementService) UpdateLimits(ctx context.Context, tier string, update *LimitsUpdate) (Limits, error) {
+	defaults, ok := defaultLimits[tier]
+	if !ok {
+		return Limits{}, ErrUnknownTier
+	}
+	if update.RevealDays != nil && !validRevealDays(update.RevealDays) {
+		return Limits{}, ErrInvalidRevealDays
+	}
+
+	row, err := s.entClient.TierEntitlement.
+		Query().
+		Where(tierentitlement.TierEQ(tierentitlement.Tier(tier))).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		// Seed the row from the defaults so the update has something to apply to
+		row, err = s.entClient.TierEntitlement.
+			Create().
+			SetID(uuid.New().String()).
+			SetTier(tierentitlement.Tier(tier)).
+			SetConnectionSlots(defaults.ConnectionSlots).
+			SetRefreshCooldownMinutes(int(defaults.RefreshCooldown / time.Minute)).
+			SetNudgesPerAtRisk(defaults.NudgesPerAtRisk).
+			SetNudgeIntervalMinutes(int(defaults.NudgeInterval / time.Minute)).
+			SetFreeRecoveriesPerConnection(defaults.FreeRecoveriesPerConnection).
+			SetPurchasableReveals(defaults.PurchasableReveals).
+			SetRevealDays(defaults.RevealDays).
+			Save(ctx)
+	}
+	if err != nil {
+		return Limits{}, fmt.Errorf("failed to get tier entitlement: %w", err)
+	}
+
+	upd := row.Update()
+	if update.ConnectionSlots != nil {
+		upd.SetConnectionSlots(*update.ConnectionSlots)
+	}
+	if update.RefreshCooldownMinutes != nil {
+		upd.SetRefreshCooldownMinutes(*update.RefreshCooldownMinutes)
+	}
+	if update.NudgesPerAtRisk != nil {
+		upd.SetNudgesPerAtRisk(*update.NudgesPerAtRisk)
+	}
+	if update.NudgeIntervalMinutes != nil {
+		upd.SetNudgeIntervalMinutes(*update.NudgeIntervalMinutes)
+	}
+	if update.FreeRecoveriesPerConnection != nil {
+		upd.SetFreeRecoveriesPerConnection(*update.FreeRecoveriesPerConnection)
+	}
+	if update.PurchasableReveals != nil {
+		upd.SetPurchasableReveals(*update.PurchasableReveals)
+	}
+	if update.RevealDays != nil {
+		upd.SetRevealDays(update.RevealDays)
+	}
+
+	row, err = upd.Save(ctx)
+	if err != nil {
+		return Limits{}, fmt.Errorf("failed to update tier entitlement: %w", err)
+	}
+
+	s.invalidate()
+	return limitsFromEntity(row), nil
+}
+
+// ConnectionSlots reports whether u can take on another active connection
+func (s *EntitlementService) ConnectionSlots(ctx context.Context, u *ent.User) (*Decision, error) {
+	l, err := s.LimitsFor(ctx, string(u.SubscriptionTier))
+	if err != nil {
+		return nil, err
+	}
+
+	active, err := s.entClient.Connection.
+		Query().
+		Where(
+			connection.Or(
+				connection.UserAIDEQ(u.ID),
+				connection.UserBIDEQ(u.ID),
+			),
+		).
+		Where(connection.ConnectionStatusEQ(connection.ConnectionStatusActive)).
+		Where(connection.DeletedAtIsNil()).
+		Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count connections: %w", err)
+	}
+
+	return newDecision(FeatureConnectionSlots, l.ConnectionSlots, active), nil
+}
+
+// DiscoveryRefresh reports whether u can refresh discovery now. The cooldown is
+// measured from the last refresh, so an admin change applies to waiting users.
+func (s *EntitlementService) DiscoveryRefresh(ctx context.Context, u *ent.User) (*Decision, error) {
+	l, err := s.LimitsFor(ctx, string(u.SubscriptionTier))
+	if err != nil {
+		return nil, err
+	}
+
+	d := newDecision(FeatureDiscoveryRefresh, 1, 0)
+	if u.LastGlobalRefreshAt != nil {
+		availableAt := u.LastGlobalRefreshAt.Add(l.RefreshCooldown)
+		if time.Now().Before(availableAt) {
+			d = newDecision(FeatureDiscoveryRefresh, 1, 1)
+			d.AvailableAt = &availableAt
+		}
+	}
+	return d, nil
+}
+
+// Nudges reports whether u can nudge their partner on st. Nudges are capped
+// per at-risk period (the streak's current day) and spaced by the tier interval.
+func (s *EntitlementService) Nudges(ctx context.Context, u *ent.User, st *ent.Streak) (*Decision, error) {
+	l, err := s.LimitsFor(ctx, string(u.SubscriptionTier))
+	if err != nil {
+		return nil, err
+	}
+
+	sent, err := s.entClient.Nudge.
+		Query().
+		Where(nudge.StreakIDEQ(st.ID)).
+		Where(nudge.SenderUserIDEQ(u.ID)).
+		Where(nudge.DayNumberEQ(st.CurrentDay)).
+		Order(ent.Desc(nudge.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get nudges: %w", err)
+	}
+
+	d := newDecision(FeatureNudges, l.NudgesPerAtRisk, len(sent))
+	if d.Allowed && len(sent) > 0 {
+		availableAt := sent[0].CreatedAt.Add(l.NudgeInterval)
+		if time.Now().Before(availableAt) {
+			d.Allowed = false
+			d.AvailableAt = &availableAt
+		}
+	}
+	return d, nil
+}
+
+// FreeRecoveries reports whether u's tier covers recovering st without credits
+func (s *EntitlementService) FreeRecoveries(ctx context.Context, u *ent.User, st *ent.Streak) (*Decision, error) {
+	l, err := s.LimitsFor(ctx, string(u.SubscriptionTier))
+	if err != nil {
+		return nil, err
+	}
+	return newDecision(FeatureFreeRecoveries, l.FreeRecoveriesPerConnection, st.FreeRecoveriesUsed), nil
+}
+
+// PurchasedReveals reports whether another early reveal can be bought on a
+// connection that already has used of them. With several tiers, as for a gift
+// between two users, the lowest limit applies.
+func (s *EntitlementService) PurchasedReveals(ctx context.Context, used int, tiers ...string) (*Decision, error) {
+	limit := -1
+	for _, tier := range tiers {
+		l, err := s.LimitsFor(ctx, tier)
+		if err != nil {
+			return nil, err
+		}
+		if limit < 0 || l.PurchasableReveals < limit {
+			limit = l.PurchasableReveals
+		}
+	}
+	if limit < 0 {
+		limit = 0
+	}
+	return newDecision(FeaturePurchasedReveals, limit, used), nil
+}
+
+// RevealDays returns the streak days on which a tier's earned reveals unlock
+func (s *EntitlementService) RevealDays(ctx context.Context, tier string) ([]int, error) {
+	l, err := s.LimitsFor(ctx, tier)
+	if err != nil {
+		return nil, err
+	}
+	return l.RevealDays, nil
+}
+
+// GetEntitlements returns the user's tier limits and account-wide usage
+func (s *EntitlementService) GetEntitlements(ctx context.Context, userID string) (*dto.EntitlementsResponse, error) {
+	u, err := s.entClient.User.Get(ctx, userID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("user not found")
+		}
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	l, err := s.LimitsFor(ctx, string(u.SubscriptionTier))
+	if err != nil {
+		return nil, err
+	}
+	slots, err := s.ConnectionSlots(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+	refresh, err := s.DiscoveryRefresh(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.EntitlementsResponse{
+		Tier: string(u.SubscriptionTier),
+		Limits: dto.TierLimitsResponse{
+			ConnectionSlots:             l.ConnectionSlots,
+			RefreshCooldownMinutes:      int(l.RefreshCooldown / time.Minute),
+			NudgesPerAtRisk:             l.NudgesPerAtRisk,
+			NudgeIntervalMinutes:        int(l.NudgeInterval / time.Minute),
+			FreeRecoveriesPerConnection: l.FreeRecoveriesPerConnection,
+			PurchasableReveals:          l.PurchasableReveals,
+			EarnedReveals:               l.EarnedReveals(),
+		},
+		ConnectionSlots:  decisionToResponse(slots),
+		DiscoveryRefresh: decisionToResponse(refresh),
+	}, nil
+}
+
+// load returns the cached tier limits, reloading them once the cache expires.
+// The map is replaced, never mutated, so callers may hold on to it.
+func (s *EntitlementService) load(ctx context.Context) (map[string]Limits, error) {
+	s.mu.RLock()
+	if s.limits != nil && time.Since(s.loadedAt) < limitsCacheTTL {
+		limits := s.limits
+		s.mu.RUnlock()
+		return limits, nil
+	}
+	s.mu.RUnlock()
+
+	rows, err := s.entClient.TierEntitlement.
+		Query().
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tier entitlements: %w", err)
+	}
+
+	limits := make(map[string]Limits, len(defaultLimits))
+	for tier, l := range defaultLimits {
+		limits[tier] = l
+	}
+	for _, row := range rows {
+		limits[string(row.Tier)] = limitsFromEntity(row)
+	}
+
+	s.mu.Lock()
+	s.limits = limits
+	s.loadedAt = time.Now()
+	s.mu.Unlock()
+
+	return limits, nil
+}
+
+// invalidate drops the cached limits so the next lookup reloads them
+func (s *EntitlementService) invalidate() {
+	s.mu.Lock()
+	s.limits = nil
+	s.mu.Unlock()
+}
+
+func newDecision(feature Feature, limit, used int) *Decision {
+	remaining := limit - used
+	if remaining < 0 {
+		remaining = 0
+	}
+	return &Decision{
+		Feature:   feature,
+		Allowed:   remaining > 0,
+		Limit:     limit,
+		Used:      used,
+		Remaining: remaining,
+	}
+}
+
+func validRevealDays(days []int) bool {
+	if !sort.IntsAreSorted(days) {
+		return false
+	}
+	for i, day := range days {
+		if day < 1 || day > maxRevealDay {
+			return false
+		}
+		if i > 0 && days[i-1] == day {
+			return false
+		}
+	}
+	return true
+}
+
+func limitsFromEntity(row *ent.TierEntitlement) Limits {
+	return Limits{
+		Tier:                        string(row.Tier),
+		ConnectionSlots:             row.ConnectionSlots,
+		RefreshCooldown:             time.Duration(row.RefreshCooldownMinutes) * time.Minute,
+		NudgesPerAtRisk:             row.NudgesPerAtRisk,
+		NudgeInterval:               time.Duration(row.NudgeIntervalMinutes) * time.Minute,
+		FreeRecoveriesPerConnection: row.FreeRecoveriesPerConnection,
+		PurchasableReveals:          row.PurchasableReveals,
+		RevealDays:                  row.RevealDays,
+		UpdatedAt:                   row.UpdatedAt,
+	}
+}
+
+func decisionToResponse(d *Decision) dto.EntitlementDecisionResponse {
+	return dto.EntitlementDecisionResponse{
+		Allowed:     d.Allowed,
+		Limit:       d.Limit,
+		Used:        d.Used,
+		Remaining:   d.Remaining,
+		AvailableAt: d.AvailableAt,
+	}
+}
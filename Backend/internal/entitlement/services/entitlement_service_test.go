@@ -0,0 +1,346 @@
+// internal/entitlement/services/entitlement_service_test.go
+package services
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	ent "github.com/UnoraApp/be/ent/generated"
+	"github.com/UnoraApp/be/ent/generated/connection"
+	"github.com/UnoraApp/be/ent/generated/tierentitlement"
+	"github.com/UnoraApp/be/ent/generated/user"
+	"github.com/UnoraApp/be/pkg/database/databasetest"
+)
+
+// overriddenLimits are stored for plus and pro; free keeps its defaults
+var overriddenLimits = map[string]Limits{
+	"plus": {
+		Tier:                        "plus",
+		ConnectionSlots:             3,
+		RefreshCooldown:             8 * time.Hour,
+		NudgesPerAtRisk:             2,
+		NudgeInterval:               3 * time.Hour,
+		FreeRecoveriesPerConnection: 2,
+		PurchasableReveals:          5,
+		RevealDays:                  []int{2, 7, 14},
+	},
+	"pro": {
+		Tier:                        "pro",
+		ConnectionSlots:             6,
+		RefreshCooldown:             time.Hour,
+		NudgesPerAtRisk:             1,
+		NudgeInterval:               time.Hour,
+		FreeRecoveriesPerConnection: 0,
+		PurchasableReveals:          4,
+		RevealDays:                  []int{1, 5, 10, 15},
+	},
+}
+
+func TestEntitlementTiers(t *testing.T) {
+	sources := []struct {
+		name      string
+		overrides map[string]Limits
+	}{
+		{name: "defaults", overrides: nil},
+		{name: "db overrides", overrides: overriddenLimits},
+	}
+
+	for _, src := range sources {
+		t.Run(src.name, func(t *testing.T) {
+			ctx := context.Background()
+			client := databasetest.NewClient(t)
+			for tier, l := range src.overrides {
+				client.TierEntitlement.
+					Create().
+					SetID(uuid.New().String()).
+					SetTier(tierentitlement.Tier(tier)).
+					SetConnectionSlots(l.ConnectionSlots).
+					SetRefreshCooldownMinutes(int(l.RefreshCooldown / time.Minute)).
+					SetNudgesPerAtRisk(l.NudgesPerAtRisk).
+					SetNudgeIntervalMinutes(int(l.NudgeInterval / time.Minute)).
+					SetFreeRecoveriesPerConnection(l.FreeRecoveriesPerConnection).
+					SetPurchasableReveals(l.PurchasableReveals).
+					SetRevealDays(l.RevealDays).
+					SaveX(ctx)
+			}
+			s := NewEntitlementService(client)
+
+			want := make(map[string]Limits, len(tierOrder))
+			for _, tier := range tierOrder {
+				want[tier] = defaultLimits[tier]
+				if l, ok := src.overrides[tier]; ok {
+					want[tier] = l
+				}
+			}
+
+			for _, tier := range tierOrder {
+				t.Run(tier, func(t *testing.T) {
+					testTierLimits(t, ctx, client, s, tier, want[tier])
+				})
+			}
+
+			t.Run("purchased reveals take the lowest tier", func(t *testing.T) {
+				for _, a := range tierOrder {
+					for _, b := range tierOrder {
+						d, err := s.PurchasedReveals(ctx, 0, a, b)
+						if err != nil {
+							t.Fatalf("PurchasedReveals: %v", err)
+						}
+						if wantLimit := min(want[a].PurchasableReveals, want[b].PurchasableReveals); d.Limit != wantLimit {
+							t.Errorf("%s and %s: limit = %d, want %d", a, b, d.Limit, wantLimit)
+						}
+					}
+				}
+
+				d, err := s.PurchasedReveals(ctx, 0)
+				if err != nil {
+					t.Fatalf("PurchasedReveals: %v", err)
+				}
+				if d.Allowed || d.Limit != 0 {
+					t.Errorf("no tiers: got %+v, want nothing allowed", d)
+				}
+			})
+
+			t.Run("unknown tier gets free limits", func(t *testing.T) {
+				l, err := s.LimitsFor(ctx, "platinum")
+				if err != nil {
+					t.Fatalf("LimitsFor: %v", err)
+				}
+				if l.ConnectionSlots != want["free"].ConnectionSlots || l.PurchasableReveals != want["free"].PurchasableReveals {
+					t.Errorf("got %+v, want the free limits", l)
+				}
+			})
+		})
+	}
+}
+
+// testTierLimits checks every feature decision of a tier against want
+func testTierLimits(t *testing.T, ctx context.Context, client *ent.Client, s *EntitlementService, tier string, want Limits) {
+	newUser := func() *ent.User {
+		return client.User.
+			Create().
+			SetID(uuid.New().String()).
+			SetSubscriptionTier(user.SubscriptionTier(tier)).
+			SaveX(ctx)
+	}
+	connect := func(a, b *ent.User, status connection.ConnectionStatus) *ent.Connection {
+		return client.Connection.
+			Create().
+			SetID(uuid.New().String()).
+			SetUserAID(a.ID).
+			SetUserBID(b.ID).
+			SetServerType(connection.ServerTypePartner).
+			SetConnectionStatus(status).
+			SaveX(ctx)
+	}
+
+	t.Run("connection slots", func(t *testing.T) {
+		u := newUser()
+		for i := 0; i < want.ConnectionSlots-1; i++ {
+			connect(u, newUser(), connection.ConnectionStatusActive)
+		}
+		// Terminated connections free their slot
+		connect(newUser(), u, connection.ConnectionStatusTerminated)
+
+		d, err := s.ConnectionSlots(ctx, u)
+		if err != nil {
+			t.Fatalf("ConnectionSlots: %v", err)
+		}
+		if !d.Allowed || d.Limit != want.ConnectionSlots || d.Remaining != 1 {
+			t.Errorf("one slot left: got %+v", d)
+		}
+
+		connect(newUser(), u, connection.ConnectionStatusActive)
+		d, err = s.ConnectionSlots(ctx, u)
+		if err != nil {
+			t.Fatalf("ConnectionSlots: %v", err)
+		}
+		if d.Allowed || d.Remaining != 0 {
+			t.Errorf("all slots taken: got %+v", d)
+		}
+	})
+
+	t.Run("discovery refresh", func(t *testing.T) {
+		u := newUser()
+		d, err := s.DiscoveryRefresh(ctx, u)
+		if err != nil {
+			t.Fatalf("DiscoveryRefresh: %v", err)
+		}
+		if !d.Allowed {
+			t.Errorf("never refreshed: got %+v", d)
+		}
+
+		lastRefresh := time.Now().Add(-want.RefreshCooldown + time.Minute)
+		u = u.Update().SetLastGlobalRefreshAt(lastRefresh).SaveX(ctx)
+		d, err = s.DiscoveryRefresh(ctx, u)
+		if err != nil {
+			t.Fatalf("DiscoveryRefresh: %v", err)
+		}
+		if d.Allowed || d.AvailableAt == nil || !d.AvailableAt.Equal(u.LastGlobalRefreshAt.Add(want.RefreshCooldown)) {
+			t.Errorf("inside cooldown: got %+v", d)
+		}
+
+		u = u.Update().SetLastGlobalRefreshAt(time.Now().Add(-want.RefreshCooldown - time.Minute)).SaveX(ctx)
+		d, err = s.DiscoveryRefresh(ctx, u)
+		if err != nil {
+			t.Fatalf("DiscoveryRefresh: %v", err)
+		}
+		if !d.Allowed {
+			t.Errorf("cooldown over: got %+v", d)
+		}
+	})
+
+	t.Run("nudges", func(t *testing.T) {
+		u, partner := newUser(), newUser()
+		conn := connect(u, partner, connection.ConnectionStatusActive)
+		st := client.Streak.Create().SetID(uuid.New().String()).SetConnectionID(conn.ID).SetCurrentDay(3).SaveX(ctx)
+		nudgeAt := func(day int, at time.Time) {
+			client.Nudge.
+				Create().
+				SetID(uuid.New().String()).
+				SetStreakID(st.ID).
+				SetSenderUserID(u.ID).
+				SetReceiverUserID(partner.ID).
+				SetDayNumber(day).
+				SetCreatedAt(at).
+				SaveX(ctx)
+		}
+
+		// Nudges of an earlier at-risk period do not count
+		nudgeAt(2, time.Now().Add(-time.Minute))
+		d, err := s.Nudges(ctx, u, st)
+		if err != nil {
+			t.Fatalf("Nudges: %v", err)
+		}
+		if !d.Allowed || d.Limit != want.NudgesPerAtRisk || d.Used != 0 {
+			t.Errorf("none sent today: got %+v", d)
+		}
+
+		// Spaced out, every allowed nudge is used
+		for i := want.NudgesPerAtRisk; i > 0; i-- {
+			nudgeAt(3, time.Now().Add(-time.Duration(i)*(want.NudgeInterval+time.Minute)))
+		}
+		d, err = s.Nudges(ctx, u, st)
+		if err != nil {
+			t.Fatalf("Nudges: %v", err)
+		}
+		if d.Allowed || d.Remaining != 0 || d.AvailableAt != nil {
+			t.Errorf("all used: got %+v", d)
+		}
+	})
+
+	t.Run("nudge interval", func(t *testing.T) {
+		if want.NudgesPerAtRisk < 2 {
+			t.Skip("tier allows one nudge per at-risk period")
+		}
+		u, partner := newUser(), newUser()
+		conn := connect(u, partner, connection.ConnectionStatusActive)
+		st := client.Streak.Create().SetID(uuid.New().String()).SetConnectionID(conn.ID).SetCurrentDay(3).SaveX(ctx)
+		client.Nudge.
+			Create().
+			SetID(uuid.New().String()).
+			SetStreakID(st.ID).
+			SetSenderUserID(u.ID).
+			SetReceiverUserID(partner.ID).
+			SetDayNumber(3).
+			SaveX(ctx)
+
+		d, err := s.Nudges(ctx, u, st)
+		if err != nil {
+			t.Fatalf("Nudges: %v", err)
+		}
+		if d.Allowed || d.Remaining != want.NudgesPerAtRisk-1 || d.AvailableAt == nil {
+			t.Errorf("just nudged: got %+v", d)
+		}
+	})
+
+	t.Run("free recoveries", func(t *testing.T) {
+		u := newUser()
+		conn := connect(u, newUser(), connection.ConnectionStatusActive)
+		st := client.Streak.Create().SetID(uuid.New().String()).SetConnectionID(conn.ID).SaveX(ctx)
+
+		d, err := s.FreeRecoveries(ctx, u, st)
+		if err != nil {
+			t.Fatalf("FreeRecoveries: %v", err)
+		}
+		if d.Allowed != (want.FreeRecoveriesPerConnection > 0) || d.Limit != want.FreeRecoveriesPerConnection {
+			t.Errorf("none used: got %+v", d)
+		}
+
+		st = st.Update().SetFreeRecoveriesUsed(want.FreeRecoveriesPerConnection).SaveX(ctx)
+		d, err = s.FreeRecoveries(ctx, u, st)
+		if err != nil {
+			t.Fatalf("FreeRecoveries: %v", err)
+		}
+		if d.Allowed {
+			t.Errorf("all used: got %+v", d)
+		}
+	})
+
+	t.Run("purchased reveals", func(t *testing.T) {
+		d, err := s.PurchasedReveals(ctx, want.PurchasableReveals-1, tier)
+		if err != nil {
+			t.Fatalf("PurchasedReveals: %v", err)
+		}
+		if !d.Allowed || d.Limit != want.PurchasableReveals || d.Remaining != 1 {
+			t.Errorf("one left: got %+v", d)
+		}
+
+		d, err = s.PurchasedReveals(ctx, want.PurchasableReveals, tier)
+		if err != nil {
+			t.Fatalf("PurchasedReveals: %v", err)
+		}
+		if d.Allowed {
+			t.Errorf("all bought: got %+v", d)
+		}
+	})
+
+	t.Run("reveal days", func(t *testing.T) {
+		days, err := s.RevealDays(ctx, tier)
+		if err != nil {
+			t.Fatalf("RevealDays: %v", err)
+		}
+		if !reflect.DeepEqual(days, want.RevealDays) {
+			t.Errorf("reveal days = %v, want %v", days, want.RevealDays)
+		}
+	})
+}
+
+func TestUpdateLimitsAppliesImmediately(t *testing.T) {
+	ctx := context.Background()
+	client := databasetest.NewClient(t)
+	s := NewEntitlementService(client)
+
+	// Load and cache the defaults
+	if _, err := s.LimitsFor(ctx, "plus"); err != nil {
+		t.Fatalf("LimitsFor: %v", err)
+	}
+
+	slots := 5
+	if _, err := s.UpdateLimits(ctx, "plus", &LimitsUpdate{ConnectionSlots: &slots}); err != nil {
+		t.Fatalf("UpdateLimits: %v", err)
+	}
+
+	l, err := s.LimitsFor(ctx, "plus")
+	if err != nil {
+		t.Fatalf("LimitsFor: %v", err)
+	}
+	if l.ConnectionSlots != slots {
+		t.Errorf("connection slots = %d, want %d", l.ConnectionSlots, slots)
+	}
+	// The other limits are seeded from the defaults
+	if l.PurchasableReveals != defaultLimits["plus"].PurchasableReveals {
+		t.Errorf("purchasable reveals = %d, want the default %d", l.PurchasableReveals, defaultLimits["plus"].PurchasableReveals)
+	}
+
+	if _, err := s.UpdateLimits(ctx, "platinum", &LimitsUpdate{ConnectionSlots: &slots}); err != ErrUnknownTier {
+		t.Errorf("unknown tier: err = %v, want ErrUnknownTier", err)
+	}
+	if _, err := s.UpdateLimits(ctx, "plus", &LimitsUpdate{RevealDays: []int{8, 4}}); err != ErrInvalidRevealDays {
+		t.Errorf("unsorted reveal days: err = %v, want ErrInvalidRevealDays", err)
+	}
+}
@@ -0,0 +1,9 @@
+// internal/entitlement/services/providers.go
+package services
+
+import "github.com/google/wire"
+
+// ProviderSet is the wire provider set for entitlement services
+var ProviderSet = wire.NewSet(
+	NewEntitlementService,
+)
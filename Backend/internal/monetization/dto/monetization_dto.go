@@ -46,19 +46,22 @@ type CreditTransactionResponse struct {
 // @Description Create payment order for credit package
 type CreateOrderRequest struct {
 	PackageID string `json:"packageId" validate:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
+	// Optional package discount code
+	PromoCode string `json:"promoCode,omitempty" example:"DIWALI20"`
 }
 
 // CreateOrderResponse returns the Razorpay order details
 // @Description Razorpay order details for payment
 type CreateOrderResponse struct {
-	OrderID       string `json:"orderId" example:"order_ABC123"`
-	RazorpayKey   string `json:"razorpayKey" example:"rzp_test_xxx"`
-	Amount        int    `json:"amount" example:"9900"`
-	Currency      string `json:"currency" example:"INR"`
-	PackageName   string `json:"packageName" example:"Starter Pack"`
-	CreditsToAdd  int    `json:"creditsToAdd" example:"55"`
-	UserEmail     string `json:"userEmail" example:"user@example.com"`
-	UserPhone     string `json:"userPhone,omitempty" example:"9876543210"`
+	OrderID        string `json:"orderId" example:"order_ABC123"`
+	RazorpayKey    string `json:"razorpayKey" example:"rzp_test_xxx"`
+	Amount         int    `json:"amount" example:"9900"`
+	DiscountAmount int    `json:"discountAmount,omitempty" example:"1980"`
+	Currency       string `json:"currency" example:"INR"`
+	PackageName    string `json:"packageName" example:"Starter Pack"`
+	CreditsToAdd   int    `json:"creditsToAdd" example:"55"`
+	UserEmail      string `json:"userEmail" example:"user@example.com"`
+	UserPhone      string `json:"userPhone,omitempty" example:"9876543210"`
 }
 
 // VerifyPaymentRequest is the request to verify payment
@@ -85,9 +88,124 @@ type PaymentOrderResponse struct {
 	ID                string     `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
 	RazorpayOrderID   string     `json:"razorpayOrderId" example:"order_ABC123"`
 	Amount            int        `json:"amount" example:"9900"`
+	DiscountAmount    int        `json:"discountAmount,omitempty" example:"1980"`
 	Currency          string     `json:"currency" example:"INR"`
 	CreditsToAdd      int        `json:"creditsToAdd" example:"55"`
 	Status            string     `json:"status" example:"paid"`
 	CreatedAt         time.Time  `json:"createdAt" example:"2024-01-01T00:00:00Z"`
 	PaidAt            *time.Time `json:"paidAt,omitempty" example:"2024-01-01T00:05:00Z"`
 }
+
+// SubscriptionPlanResponse represents a Plus/Pro subscription plan
+// @Description Subscription plan available for purchase
+type SubscriptionPlanResponse struct {
+	ID            string `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Name          string `json:"name" example:"Plus Monthly"`
+	Tier          string `json:"tier" example:"plus"`
+	BillingPeriod string `json:"billingPeriod" example:"monthly"`
+	PriceAmount   int    `json:"priceAmount" example:"19900"`
+	PriceDisplay  string `json:"priceDisplay" example:"₹199"`
+	Currency      string `json:"currency" example:"INR"`
+}
+
+// CreateSubscriptionRequest is the request to subscribe to a plan
+// @Description Start a subscription to a plan
+type CreateSubscriptionRequest struct {
+	PlanID string `json:"planId" validate:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
+}
+
+// CreateSubscriptionResponse returns the Razorpay subscription to authorise
+// @Description Razorpay subscription details for Checkout
+type CreateSubscriptionResponse struct {
+	SubscriptionID         string `json:"subscriptionId" example:"550e8400-e29b-41d4-a716-446655440000"`
+	RazorpaySubscriptionID string `json:"razorpaySubscriptionId" example:"sub_00000000000001"`
+	RazorpayKey            string `json:"razorpayKey" example:"rzp_test_xxx"`
+	ShortURL               string `json:"shortUrl,omitempty" example:"https://rzp.io/i/z3b1R61A9"`
+	Status                 string `json:"status" example:"created"`
+}
+
+// CancelSubscriptionRequest is the request to cancel the current subscription
+// @Description Cancel now or at the end of the current billing cycle (default)
+type CancelSubscriptionRequest struct {
+	Immediately bool `json:"immediately" example:"false"`
+}
+
+// SubscriptionResponse represents a user's subscription
+// @Description Subscription status and billing cycle
+type SubscriptionResponse struct {
+	ID                string     `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	PlanID            string     `json:"planId" example:"550e8400-e29b-41d4-a716-446655440000"`
+	PlanName          string     `json:"planName" example:"Plus Monthly"`
+	Tier              string     `json:"tier" example:"plus"`
+	BillingPeriod     string     `json:"billingPeriod" example:"monthly"`
+	Status            string     `json:"status" example:"active"`
+	CurrentStart      *time.Time `json:"currentStart,omitempty" example:"2024-01-01T00:00:00Z"`
+	CurrentEnd        *time.Time `json:"currentEnd,omitempty" example:"2024-02-01T00:00:00Z"`
+	GraceUntil        *time.Time `json:"graceUntil,omitempty" example:"2024-02-04T00:00:00Z"`
+	CancelAtPeriodEnd bool       `json:"cancelAtPeriodEnd" example:"false"`
+	AutoRenew         bool       `json:"autoRenew" example:"true"`
+	CreatedAt         time.Time  `json:"createdAt" example:"2024-01-01T00:00:00Z"`
+}
+
+// SubscriptionStateResponse returns the user's tier and current subscription
+// @Description Current tier and subscription, if any
+type SubscriptionStateResponse struct {
+	CurrentTier  string                `json:"currentTier" example:"plus"`
+	Subscription *SubscriptionResponse `json:"subscription,omitempty"`
+}
+
+// FakePaymentRequest drives a payment on the fake gateway
+// @Description Simulate paying an order: success captures now, failure declines, delayed captures after FAKE_PAYMENT_CAPTURE_DELAY_SECONDS
+type FakePaymentRequest struct {
+	Outcome string `json:"outcome" validate:"required,oneof=success failure delayed" example:"success"`
+}
+
+// FakePaymentResponse is what checkout would return after a simulated payment
+// @Description Pass the IDs and signature to /payments/verify as checkout's response; failed payments carry no signature
+type FakePaymentResponse struct {
+	RazorpayOrderID   string `json:"razorpayOrderId" example:"order_3f9c1a7b20d84e"`
+	RazorpayPaymentID string `json:"razorpayPaymentId" example:"pay_8b1e0c44f2a913"`
+	RazorpaySignature string `json:"razorpaySignature,omitempty" example:"signature_hash"`
+	Status            string `json:"status" example:"captured"`
+}
+
+// FakeSubscriptionChargeRequest drives a subscription charge on the fake gateway
+// @Description Simulate billing the next cycle: success activates or renews, failure leaves the subscription pending
+type FakeSubscriptionChargeRequest struct {
+	Outcome string `json:"outcome" validate:"required,oneof=success failure" example:"success"`
+}
+
+// FakeSubscriptionChargeResponse is the fake gateway's subscription after a charge
+// @Description Gateway-side subscription state after the simulated charge
+type FakeSubscriptionChargeResponse struct {
+	RazorpaySubscriptionID string `json:"razorpaySubscriptionId" example:"sub_5d2c7e91a0b4f3"`
+	Status                 string `json:"status" example:"active"`
+	PaidCount              int    `json:"paidCount" example:"1"`
+	CurrentEnd             int64  `json:"currentEnd" example:"1735689600"`
+}
+
+// RedeemPromoCodeRequest is the request to redeem a credit promo code
+// @Description Redeem a promo code for credits; discount codes are applied at create-order instead
+type RedeemPromoCodeRequest struct {
+	Code string `json:"code" validate:"required" example:"WELCOME50"`
+}
+
+// RedeemPromoCodeResponse returns the credits granted by a promo code
+// @Description Promo code redemption result
+type RedeemPromoCodeResponse struct {
+	Code          string `json:"code" example:"WELCOME50"`
+	CreditsAdded  int    `json:"creditsAdded" example:"50"`
+	NewBalance    int    `json:"newBalance" example:"150"`
+	TransactionID string `json:"transactionId" example:"550e8400-e29b-41d4-a716-446655440000"`
+}
+
+// ReferralSummaryResponse returns the user's referral code and referrals
+// @Description Referral code to share, bonus amounts and referral counts
+type ReferralSummaryResponse struct {
+	Code            string `json:"code" example:"K7QM2XPA"`
+	ReferrerCredits int    `json:"referrerCredits" example:"50"`
+	RefereeCredits  int    `json:"refereeCredits" example:"25"`
+	PendingCount    int    `json:"pendingCount" example:"2"`
+	RewardedCount   int    `json:"rewardedCount" example:"3"`
+	CreditsEarned   int    `json:"creditsEarned" example:"150"`
+}
@@ -0,0 +1,106 @@
+// internal/monetization/handlers/fake_gateway_handler.go
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/UnoraApp/be/internal/monetization/dto"
+	"github.com/UnoraApp/be/internal/monetization/services"
+	"github.com/UnoraApp/be/pkg/apperror"
+	"github.com/UnoraApp/be/pkg/response"
+)
+
+// FakeGatewayHandler stands in for Razorpay Checkout when the fake payment
+// provider is configured. Its routes exist only then.
+type FakeGatewayHandler struct {
+	gateway *services.FakeGateway
+}
+
+// NewFakeGatewayHandler creates a new fake gateway handler
+func NewFakeGatewayHandler(gateway *services.FakeGateway) *FakeGatewayHandler {
+	return &FakeGatewayHandler{
+		gateway: gateway,
+	}
+}
+
+// SimulatePayment godoc
+// @Summary      Simulate a payment (fake provider only)
+// @Description  Pay an order on the fake gateway as Checkout would. Webhooks are delivered in process; a delayed payment is captured later. Only available with PAYMENT_PROVIDER=fake.
+// @Tags         payments
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        orderId path string true "Razorpay order ID from create-order"
+// @Param        request body dto.FakePaymentRequest true "Outcome"
+// @Success      200 {object} response.APIResponse{data=dto.FakePaymentResponse} "Checkout result"
+// @Failure      400 {object} response.APIResponse "Invalid outcome or order already paid"
+// @Failure      404 {object} response.APIResponse "Order not found"
+// @Router       /payments/fake/orders/{orderId}/pay [post]
+func (h *FakeGatewayHandler) SimulatePayment(c *gin.Context) {
+	var req dto.FakePaymentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apperror.HandleError(c, apperror.BadRequest(err.Error()))
+		return
+	}
+
+	result, err := h.gateway.SimulatePayment(c.Request.Context(), c.Param("orderId"), services.FakePaymentOutcome(req.Outcome))
+	if err != nil {
+		handleFakeGatewayError(c, err, "Order")
+		return
+	}
+
+	response.JSON(c, http.StatusOK, dto.FakePaymentResponse{
+		RazorpayOrderID:   result.OrderID,
+		RazorpayPaymentID: result.PaymentID,
+		RazorpaySignature: result.Signature,
+		Status:            result.Status,
+	})
+}
+
+// ChargeSubscription godoc
+// @Summary      Simulate a subscription charge (fake provider only)
+// @Description  Bill a subscription's next cycle on the fake gateway. The subscription webhook is delivered in process. Only available with PAYMENT_PROVIDER=fake.
+// @Tags         subscriptions
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        subscriptionId path string true "Razorpay subscription ID"
+// @Param        request body dto.FakeSubscriptionChargeRequest true "Outcome"
+// @Success      200 {object} response.APIResponse{data=dto.FakeSubscriptionChargeResponse} "Subscription after the charge"
+// @Failure      400 {object} response.APIResponse "Invalid outcome or subscription not chargeable"
+// @Failure      404 {object} response.APIResponse "Subscription not found"
+// @Router       /payments/fake/subscriptions/{subscriptionId}/charge [post]
+func (h *FakeGatewayHandler) ChargeSubscription(c *gin.Context) {
+	var req dto.FakeSubscriptionChargeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apperror.HandleError(c, apperror.BadRequest(err.Error()))
+		return
+	}
+
+	sub, err := h.gateway.SimulateSubscriptionCharge(c.Request.Context(), c.Param("subscriptionId"), services.FakePaymentOutcome(req.Outcome))
+	if err != nil {
+		handleFakeGatewayError(c, err, "Subscription")
+		return
+	}
+
+	response.JSON(c, http.StatusOK, dto.FakeSubscriptionChargeResponse{
+		RazorpaySubscriptionID: sub.ID,
+		Status:                 sub.Status,
+		PaidCount:              sub.PaidCount,
+		CurrentEnd:             sub.CurrentEnd,
+	})
+}
+
+func handleFakeGatewayError(c *gin.Context, err error, resource string) {
+	switch {
+	case errors.Is(err, services.ErrFakeEntityNotFound):
+		apperror.HandleError(c, apperror.NotFound(resource))
+	case errors.Is(err, services.ErrFakeInvalidRequest):
+		apperror.HandleError(c, apperror.BadRequest(err.Error()))
+	default:
+		apperror.HandleError(c, apperror.InternalError(err))
+	}
+}
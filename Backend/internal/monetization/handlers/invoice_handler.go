@@ -0,0 +1,62 @@
+// internal/monetization/handlers/invoice_handler.go
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/UnoraApp/be/internal/monetization/services"
+	"github.com/UnoraApp/be/pkg/apperror"
+)
+
+// InvoiceHandler serves GST invoices of the user's payment orders
+type InvoiceHandler struct {
+	invoiceService *services.InvoiceService
+}
+
+// NewInvoiceHandler creates a new invoice handler
+func NewInvoiceHandler(invoiceService *services.InvoiceService) *InvoiceHandler {
+	return &InvoiceHandler{
+		invoiceService: invoiceService,
+	}
+}
+
+// GetOrderInvoice godoc
+// @Summary      Download invoice
+// @Description  Download the GST tax invoice of a paid payment order as a PDF
+// @Tags         payments
+// @Produce      application/pdf
+// @Security     BearerAuth
+// @Param        id path string true "Payment order ID"
+// @Success      200 {file} file "Invoice PDF"
+// @Failure      400 {object} response.APIResponse "Order is not paid"
+// @Failure      401 {object} response.APIResponse "Not authenticated"
+// @Failure      404 {object} response.APIResponse "Order not found"
+// @Router       /payments/orders/{id}/invoice [get]
+func (h *InvoiceHandler) GetOrderInvoice(c *gin.Context) {
+	userID, _ := c.Get("userID")
+
+	inv, reader, err := h.invoiceService.GetInvoicePDF(c.Request.Context(), userID.(string), c.Param("id"))
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrOrderNotFound):
+			apperror.HandleError(c, apperror.NotFound("Order"))
+		case errors.Is(err, services.ErrOrderNotPaid):
+			apperror.HandleError(c, apperror.BadRequest(err.Error()))
+		default:
+			apperror.HandleError(c, apperror.InternalError(err))
+		}
+		return
+	}
+	defer reader.Close()
+
+	// Invoice numbers contain slashes, which are not allowed in file names
+	fileName := strings.ReplaceAll(inv.InvoiceNumber, "/", "-") + ".pdf"
+	c.DataFromReader(http.StatusOK, -1, "application/pdf", reader, map[string]string{
+		"Content-Disposition": fmt.Sprintf(`attachment; filename="%s"`, fileName),
+	})
+}
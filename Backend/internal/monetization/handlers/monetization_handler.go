@@ -2,6 +2,7 @@
 package handlers
 
 import (
+	"errors"
 	"net/http"
 	"strconv"
 
@@ -13,17 +14,29 @@ import (
 	"github.com/UnoraApp/be/pkg/response"
 )
 
+// maxWebhookBodyBytes caps the webhook body read into memory
+const maxWebhookBodyBytes = 1 << 20
+
 // MonetizationHandler handles monetization HTTP requests
 type MonetizationHandler struct {
-	creditsService *services.CreditsService
-	paymentService *services.PaymentService
+	creditsService      *services.CreditsService
+	paymentService      *services.PaymentService
+	webhookService      *services.WebhookService
+	subscriptionService *services.SubscriptionService
 }
 
 // NewMonetizationHandler creates a new monetization handler
-func NewMonetizationHandler(creditsService *services.CreditsService, paymentService *services.PaymentService) *MonetizationHandler {
+func NewMonetizationHandler(
+	creditsService *services.CreditsService,
+	paymentService *services.PaymentService,
+	webhookService *services.WebhookService,
+	subscriptionService *services.SubscriptionService,
+) *MonetizationHandler {
 	return &MonetizationHandler{
-		creditsService: creditsService,
-		paymentService: paymentService,
+		creditsService:      creditsService,
+		paymentService:      paymentService,
+		webhookService:      webhookService,
+		subscriptionService: subscriptionService,
 	}
 }
 
@@ -175,25 +188,205 @@ func (h *MonetizationHandler) GetPaymentOrders(c *gin.Context) {
 	response.JSON(c, http.StatusOK, orders)
 }
 
+// ========== Subscription Endpoints ==========
+
+// GetSubscriptionPlans godoc
+// @Summary      Get subscription plans
+// @Description  Get all available Plus/Pro subscription plans
+// @Tags         subscriptions
+// @Accept       json
+// @Produce      json
+// @Success      200 {object} response.APIResponse{data=[]dto.SubscriptionPlanResponse} "Subscription plans"
+// @Router       /subscription-plans [get]
+func (h *MonetizationHandler) GetSubscriptionPlans(c *gin.Context) {
+	plans, err := h.subscriptionService.GetPlans(c.Request.Context())
+	if err != nil {
+		apperror.HandleError(c, apperror.InternalError(err))
+		return
+	}
+	response.JSON(c, http.StatusOK, plans)
+}
+
+// GetSubscription godoc
+// @Summary      Get subscription
+// @Description  Get user's current tier and subscription
+// @Tags         subscriptions
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200 {object} response.APIResponse{data=dto.SubscriptionStateResponse} "Subscription state"
+// @Failure      401 {object} response.APIResponse "Not authenticated"
+// @Router       /subscriptions/me [get]
+func (h *MonetizationHandler) GetSubscription(c *gin.Context) {
+	userID, _ := c.Get("userID")
+
+	state, err := h.subscriptionService.GetSubscription(c.Request.Context(), userID.(string))
+	if err != nil {
+		apperror.HandleError(c, apperror.InternalError(err))
+		return
+	}
+	response.JSON(c, http.StatusOK, state)
+}
+
+// CreateSubscription godoc
+// @Summary      Create subscription
+// @Description  Start a Razorpay subscription to a plan. The tier is granted once the first charge succeeds.
+// @Tags         subscriptions
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request body dto.CreateSubscriptionRequest true "Plan to subscribe to"
+// @Success      200 {object} response.APIResponse{data=dto.CreateSubscriptionResponse} "Subscription details"
+// @Failure      400 {object} response.APIResponse "Invalid request"
+// @Failure      401 {object} response.APIResponse "Not authenticated"
+// @Failure      409 {object} response.APIResponse "Already subscribed"
+// @Router       /subscriptions [post]
+func (h *MonetizationHandler) CreateSubscription(c *gin.Context) {
+	userID, _ := c.Get("userID")
+
+	var req dto.CreateSubscriptionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apperror.HandleError(c, apperror.BadRequest(err.Error()))
+		return
+	}
+
+	sub, err := h.subscriptionService.CreateSubscription(c.Request.Context(), userID.(string), &req)
+	if err != nil {
+		handleSubscriptionError(c, err)
+		return
+	}
+	response.JSON(c, http.StatusOK, sub)
+}
+
+// CancelSubscription godoc
+// @Summary      Cancel subscription
+// @Description  Cancel the current subscription at the end of the billing cycle, or immediately
+// @Tags         subscriptions
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request body dto.CancelSubscriptionRequest false "Cancellation options"
+// @Success      200 {object} response.APIResponse{data=dto.SubscriptionResponse} "Updated subscription"
+// @Failure      401 {object} response.APIResponse "Not authenticated"
+// @Failure      404 {object} response.APIResponse "No subscription"
+// @Router       /subscriptions/cancel [post]
+func (h *MonetizationHandler) CancelSubscription(c *gin.Context) {
+	userID, _ := c.Get("userID")
+
+	var req dto.CancelSubscriptionRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			apperror.HandleError(c, apperror.BadRequest(err.Error()))
+			return
+		}
+	}
+
+	sub, err := h.subscriptionService.CancelSubscription(c.Request.Context(), userID.(string), &req)
+	if err != nil {
+		handleSubscriptionError(c, err)
+		return
+	}
+	response.JSON(c, http.StatusOK, sub)
+}
+
+// PauseSubscription godoc
+// @Summary      Pause subscription
+// @Description  Pause the active subscription; the tier is removed until it is resumed
+// @Tags         subscriptions
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200 {object} response.APIResponse{data=dto.SubscriptionResponse} "Updated subscription"
+// @Failure      401 {object} response.APIResponse "Not authenticated"
+// @Failure      404 {object} response.APIResponse "No subscription"
+// @Failure      409 {object} response.APIResponse "Subscription is not active"
+// @Router       /subscriptions/pause [post]
+func (h *MonetizationHandler) PauseSubscription(c *gin.Context) {
+	userID, _ := c.Get("userID")
+
+	sub, err := h.subscriptionService.PauseSubscription(c.Request.Context(), userID.(string))
+	if err != nil {
+		handleSubscriptionError(c, err)
+		return
+	}
+	response.JSON(c, http.StatusOK, sub)
+}
+
+// ResumeSubscription godoc
+// @Summary      Resume subscription
+// @Description  Resume a paused subscription
+// @Tags         subscriptions
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200 {object} response.APIResponse{data=dto.SubscriptionResponse} "Updated subscription"
+// @Failure      401 {object} response.APIResponse "Not authenticated"
+// @Failure      404 {object} response.APIResponse "No subscription"
+// @Failure      409 {object} response.APIResponse "Subscription is not paused"
+// @Router       /subscriptions/resume [post]
+func (h *MonetizationHandler) ResumeSubscription(c *gin.Context) {
+	userID, _ := c.Get("userID")
+
+	sub, err := h.subscriptionService.ResumeSubscription(c.Request.Context(), userID.(string))
+	if err != nil {
+		handleSubscriptionError(c, err)
+		return
+	}
+	response.JSON(c, http.StatusOK, sub)
+}
+
+func handleSubscriptionError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, services.ErrNoSubscription):
+		apperror.HandleError(c, apperror.NotFound("Subscription"))
+	case errors.Is(err, services.ErrSubscriptionExists), errors.Is(err, services.ErrSubscriptionNotActive):
+		apperror.HandleError(c, apperror.Conflict(err.Error()))
+	case errors.Is(err, services.ErrPlanUnavailable):
+		apperror.HandleError(c, apperror.BadRequest(err.Error()))
+	default:
+		apperror.HandleError(c, apperror.InternalError(err))
+	}
+}
+
 // RazorpayWebhook godoc
 // @Summary      Razorpay webhook
-// @Description  Handle Razorpay webhook events
+// @Description  Handle Razorpay webhook events (payment.captured, order.paid, payment.failed, refund.processed, subscription.*). The X-Razorpay-Signature header must be an HMAC-SHA256 of the raw body keyed with the webhook secret. Deliveries are deduplicated on X-Razorpay-Event-Id.
 // @Tags         payments
 // @Accept       json
 // @Produce      json
+// @Param        X-Razorpay-Signature header string true "Webhook signature"
+// @Param        X-Razorpay-Event-Id header string false "Razorpay event ID"
 // @Success      200 {object} response.APIResponse "Webhook processed"
+// @Failure      400 {object} response.APIResponse "Invalid signature"
+// @Failure      413 {object} response.APIResponse "Body over 1 MiB"
+// @Failure      500 {object} response.APIResponse "Processing failed; Razorpay will retry"
 // @Router       /payments/webhook [post]
 func (h *MonetizationHandler) RazorpayWebhook(c *gin.Context) {
-	var payload map[string]interface{}
-	if err := c.ShouldBindJSON(&payload); err != nil {
+	// The signature covers the exact bytes received, so read the raw body;
+	// Razorpay's are a few kilobytes
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxWebhookBodyBytes)
+	body, err := c.GetRawData()
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			apperror.HandleError(c, apperror.New(apperror.ErrCodeInvalidRequest, "Webhook body too large", http.StatusRequestEntityTooLarge))
+			return
+		}
 		apperror.HandleError(c, apperror.BadRequest(err.Error()))
 		return
 	}
 
-	event, _ := payload["event"].(string)
-	payloadData, _ := payload["payload"].(map[string]interface{})
-
-	if err := h.paymentService.HandleWebhook(c.Request.Context(), event, payloadData); err != nil {
+	err = h.webhookService.HandleRazorpayWebhook(
+		c.Request.Context(),
+		body,
+		c.GetHeader("X-Razorpay-Signature"),
+		c.GetHeader("X-Razorpay-Event-Id"),
+	)
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidWebhookSignature) {
+			apperror.HandleError(c, apperror.BadRequest(err.Error()))
+			return
+		}
 		apperror.HandleError(c, apperror.InternalError(err))
 		return
 	}
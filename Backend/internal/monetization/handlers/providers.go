@@ -6,4 +6,6 @@ import "github.com/google/wire"
 // ProviderSet is the wire provider set for monetization handlers
 var ProviderSet = wire.NewSet(
 	NewMonetizationHandler,
+	NewFakeGatewayHandler,
+	NewRewardsHandler,
 )
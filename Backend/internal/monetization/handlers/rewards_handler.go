@@ -0,0 +1,103 @@
+// internal/monetization/handlers/rewards_handler.go
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/UnoraApp/be/internal/monetization/dto"
+	"github.com/UnoraApp/be/internal/monetization/services"
+	"github.com/UnoraApp/be/pkg/apperror"
+	"github.com/UnoraApp/be/pkg/response"
+)
+
+// RewardsHandler handles promo code redemption and referral HTTP requests
+type RewardsHandler struct {
+	promoService   *services.PromoService
+	rewardsService *services.RewardsService
+}
+
+// NewRewardsHandler creates a new rewards handler
+func NewRewardsHandler(
+	promoService *services.PromoService,
+	rewardsService *services.RewardsService,
+) *RewardsHandler {
+	return &RewardsHandler{
+		promoService:   promoService,
+		rewardsService: rewardsService,
+	}
+}
+
+// RedeemPromoCode godoc
+// @Summary      Redeem promo code
+// @Description  Redeem a promo code for credits. Package discount codes are passed to create-order instead.
+// @Tags         credits
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request body dto.RedeemPromoCodeRequest true "Promo code"
+// @Success      200 {object} response.APIResponse{data=dto.RedeemPromoCodeResponse} "Credits added"
+// @Failure      400 {object} response.APIResponse "Code expired, used up, already used or not a credit code"
+// @Failure      401 {object} response.APIResponse "Not authenticated"
+// @Failure      404 {object} response.APIResponse "Promo code not found"
+// @Router       /promo-codes/redeem [post]
+func (h *RewardsHandler) RedeemPromoCode(c *gin.Context) {
+	userID, _ := c.Get("userID")
+
+	var req dto.RedeemPromoCodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apperror.HandleError(c, apperror.BadRequest(err.Error()))
+		return
+	}
+	if strings.TrimSpace(req.Code) == "" {
+		apperror.HandleError(c, apperror.BadRequest("code is required"))
+		return
+	}
+
+	tx, err := h.promoService.RedeemCredits(c.Request.Context(), userID.(string), req.Code)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrPromoCodeNotFound):
+			apperror.HandleError(c, apperror.NotFound("Promo code"))
+		case errors.Is(err, services.ErrPromoCodeUnavailable),
+			errors.Is(err, services.ErrPromoCodeExhausted),
+			errors.Is(err, services.ErrPromoCodeLimitReached),
+			errors.Is(err, services.ErrPromoCodeNotApplicable):
+			apperror.HandleError(c, apperror.BadRequest(err.Error()))
+		default:
+			apperror.HandleError(c, apperror.InternalError(err))
+		}
+		return
+	}
+
+	response.JSON(c, http.StatusOK, dto.RedeemPromoCodeResponse{
+		Code:          services.NormalizePromoCode(req.Code),
+		CreditsAdded:  tx.CreditAmount,
+		NewBalance:    tx.BalanceAfter,
+		TransactionID: tx.ID,
+	})
+}
+
+// GetReferralSummary godoc
+// @Summary      Get referral code
+// @Description  Get the user's referral code to share, the bonus amounts and how many referrals are pending or rewarded. Referral bonuses are paid when the referred user reaches their first streak milestone.
+// @Tags         credits
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200 {object} response.APIResponse{data=dto.ReferralSummaryResponse} "Referral summary"
+// @Failure      401 {object} response.APIResponse "Not authenticated"
+// @Router       /referrals/me [get]
+func (h *RewardsHandler) GetReferralSummary(c *gin.Context) {
+	userID, _ := c.Get("userID")
+
+	summary, err := h.rewardsService.GetReferralSummary(c.Request.Context(), userID.(string))
+	if err != nil {
+		apperror.HandleError(c, apperror.InternalError(err))
+		return
+	}
+	response.JSON(c, http.StatusOK, summary)
+}
@@ -4,31 +4,39 @@ package routes
 import (
 	"github.com/gin-gonic/gin"
 
-	ent "github.com/UnoraApp/be/ent/generated"
 	"github.com/UnoraApp/be/internal/monetization/handlers"
 	"github.com/UnoraApp/be/internal/monetization/services"
+	"github.com/UnoraApp/be/pkg/ratelimit"
 )
 
-// RegisterMonetizationRoutes registers all monetization routes
+// RegisterMonetizationRoutes registers all monetization routes. With the fake
+// payment provider it also connects the gateway's webhooks to the webhook
+// service and registers the endpoints that simulate Checkout.
 func RegisterMonetizationRoutes(
 	router *gin.RouterGroup,
-	entClient *ent.Client,
-	razorpayConfig *services.RazorpayConfig,
+	gateway services.PaymentGateway,
+	creditsService *services.CreditsService,
+	paymentService *services.PaymentService,
+	webhookService *services.WebhookService,
+	subscriptionService *services.SubscriptionService,
+	promoService *services.PromoService,
+	rewardsService *services.RewardsService,
+	invoiceService *services.InvoiceService,
 	authMiddleware gin.HandlerFunc,
+	limiter *ratelimit.Limiter,
 ) {
-	// Create services
-	creditsService := services.NewCreditsService(entClient)
-	razorpayClient := services.NewRazorpayClient(razorpayConfig)
-	paymentService := services.NewPaymentService(entClient, razorpayClient, creditsService)
-
-	// Create handler
-	handler := handlers.NewMonetizationHandler(creditsService, paymentService)
+	// Create handlers
+	handler := handlers.NewMonetizationHandler(creditsService, paymentService, webhookService, subscriptionService)
+	rewardsHandler := handlers.NewRewardsHandler(promoService, rewardsService)
+	invoiceHandler := handlers.NewInvoiceHandler(invoiceService)
 
 	// Public routes
 	router.GET("/credit-packages", handler.GetPackages)
+	router.GET("/subscription-plans", handler.GetSubscriptionPlans)
 
-	// Webhook (no auth, but Razorpay validates via signature)
-	router.POST("/payments/webhook", handler.RazorpayWebhook)
+	// Webhook (no auth; verified by X-Razorpay-Signature, and limited per IP
+	// since every delivery, even a forged one, is recorded)
+	router.POST("/payments/webhook", limiter.Middleware("payment_webhook"), handler.RazorpayWebhook)
 
 	// Protected routes
 	protected := router.Group("")
@@ -38,9 +46,30 @@ func RegisterMonetizationRoutes(
 		protected.GET("/credits/balance", handler.GetBalance)
 		protected.GET("/credits/transactions", handler.GetTransactionHistory)
 
+		// Promo codes and referrals
+		protected.POST("/promo-codes/redeem", rewardsHandler.RedeemPromoCode)
+		protected.GET("/referrals/me", rewardsHandler.GetReferralSummary)
+
 		// Payments
 		protected.POST("/payments/create-order", handler.CreateOrder)
 		protected.POST("/payments/verify", handler.VerifyPayment)
 		protected.GET("/payments/orders", handler.GetPaymentOrders)
+		protected.GET("/payments/orders/:id/invoice", invoiceHandler.GetOrderInvoice)
+
+		// Subscriptions
+		protected.GET("/subscriptions/me", handler.GetSubscription)
+		protected.POST("/subscriptions", handler.CreateSubscription)
+		protected.POST("/subscriptions/cancel", handler.CancelSubscription)
+		protected.POST("/subscriptions/pause", handler.PauseSubscription)
+		protected.POST("/subscriptions/resume", handler.ResumeSubscription)
+	}
+
+	// Fake provider: webhooks are delivered in process, Checkout is simulated
+	if fake, ok := gateway.(*services.FakeGateway); ok {
+		fake.SetWebhookDeliverer(webhookService.HandleRazorpayWebhook)
+
+		fakeHandler := handlers.NewFakeGatewayHandler(fake)
+		protected.POST("/payments/fake/orders/:orderId/pay", fakeHandler.SimulatePayment)
+		protected.POST("/payments/fake/subscriptions/:subscriptionId/charge", fakeHandler.ChargeSubscription)
 	}
 }
@@ -3,6 +3,7 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/google/uuid"
@@ -10,10 +11,13 @@ import (
 	ent "github.com/UnoraApp/be/ent/generated"
 	"github.com/UnoraApp/be/ent/generated/creditpackage"
 	"github.com/UnoraApp/be/ent/generated/credittransaction"
+	"github.com/UnoraApp/be/ent/generated/user"
 	"github.com/UnoraApp/be/internal/monetization/dto"
+	"github.com/UnoraApp/be/pkg/database"
 )
 
-// CreditsService handles credit-related business logic
+// CreditsService handles credit-related business logic. It is the credit
+// ledger: every change to a user's credit balance goes through it.
 type CreditsService struct {
 	entClient *ent.Client
 }
@@ -128,26 +132,105 @@ func (s *CreditsService) GetTransactionHistory(ctx context.Context, userID strin
 	return result, nil
 }
 
+// ErrInsufficientCredits is returned when a debit would take the balance below zero
+var ErrInsufficientCredits = errors.New("insufficient credits")
+
 // AddCredits adds credits to user's balance and records transaction
 func (s *CreditsService) AddCredits(ctx context.Context, userID string, amount int, txType credittransaction.TransactionType, description, refType, refID string) (*ent.CreditTransaction, error) {
-	// Get user
-	user, err := s.entClient.User.Get(ctx, userID)
+	var tx *ent.CreditTransaction
+	err := database.WithTx(ctx, s.entClient, func(dbTx *ent.Tx) error {
+		var err error
+		tx, err = s.applyCredits(ctx, dbTx.Client(), userID, amount, txType, description, refType, refID, "")
+		return err
+	})
 	if err != nil {
-		return nil, fmt.Errorf("user not found: %w", err)
+		return nil, err
 	}
+	return tx, nil
+}
 
-	// Update balance
-	newBalance := user.CreditBalance + amount
-	_, err = user.Update().
-		SetCreditBalance(newBalance).
-		Save(ctx)
+// DeductCredits deducts credits from user's balance
+func (s *CreditsService) DeductCredits(ctx context.Context, userID string, amount int, txType credittransaction.TransactionType, description, refType, refID string) (*ent.CreditTransaction, error) {
+	return s.AddCredits(ctx, userID, -amount, txType, description, refType, refID)
+}
+
+// SpendCredits debits credits using the given client, which is expected to be
+// bound to the caller's transaction (tx.Client()) so the debit commits or rolls
+// back together with the purchase it pays for. The user row is locked for the
+// balance check and debit, so concurrent spends can never overdraw.
+func (s *CreditsService) SpendCredits(ctx context.Context, client *ent.Client, userID string, amount int, txType credittransaction.TransactionType, description, refType, refID, idempotencyKey string) (*ent.CreditTransaction, error) {
+	if amount <= 0 {
+		return nil, fmt.Errorf("spend amount must be positive")
+	}
+	return s.applyCredits(ctx, client, userID, -amount, txType, description, refType, refID, idempotencyKey)
+}
+
+// FindByIdempotencyKey returns the ledger entry previously written with the key, or nil
+func (s *CreditsService) FindByIdempotencyKey(ctx context.Context, userID, idempotencyKey string) (*ent.CreditTransaction, error) {
+	if idempotencyKey == "" {
+		return nil, nil
+	}
+
+	tx, err := s.entClient.CreditTransaction.
+		Query().
+		Where(credittransaction.UserIDEQ(userID)).
+		Where(credittransaction.IdempotencyKeyEQ(idempotencyKey)).
+		Only(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to update balance: %w", err)
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get transaction: %w", err)
+	}
+	return tx, nil
+}
+
+// FindByReference returns the ledger entry for a reference, or nil. The
+// (reference_type, reference_id) pair is unique across the ledger.
+func (s *CreditsService) FindByReference(ctx context.Context, refType, refID string) (*ent.CreditTransaction, error) {
+	tx, err := s.entClient.CreditTransaction.
+		Query().
+		Where(credittransaction.ReferenceTypeEQ(refType)).
+		Where(credittransaction.ReferenceIDEQ(refID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get transaction: %w", err)
+	}
+	return tx, nil
+}
+
+// applyCredits is the single place a credit balance changes. It locks the
+// user row, refuses to go below zero, and writes the transaction with its two
+// ledger entries (user account and system counter-account) together with the
+// new balance. The client must be bound to a transaction for the lock to hold.
+func (s *CreditsService) applyCredits(ctx context.Context, client *ent.Client, userID string, amount int, txType credittransaction.TransactionType, description, refType, refID, idempotencyKey string) (*ent.CreditTransaction, error) {
+	u, err := lockUser(ctx, client, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	newBalance := u.CreditBalance + amount
+	if newBalance < 0 {
+		return nil, ErrInsufficientCredits
+	}
+
+	// A zero amount still writes a ledger entry
+	if amount != 0 {
+		_, err = client.User.
+			UpdateOneID(userID).
+			SetCreditBalance(newBalance).
+			Save(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to update balance: %w", err)
+		}
 	}
 
 	// Create transaction record
 	txID := uuid.New().String()
-	tx, err := s.entClient.CreditTransaction.
+	tx, err := client.CreditTransaction.
 		Create().
 		SetID(txID).
 		SetUserID(userID).
@@ -157,17 +240,71 @@ func (s *CreditsService) AddCredits(ctx context.Context, userID string, amount i
 		SetNillableDescription(strPtr(description)).
 		SetNillableReferenceType(strPtr(refType)).
 		SetNillableReferenceID(strPtr(refID)).
+		SetNillableIdempotencyKey(strPtr(idempotencyKey)).
 		Save(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create transaction: %w", err)
 	}
 
+	// Double entry: the user's account moves by amount, the system account by -amount
+	_, err = client.LedgerEntry.
+		CreateBulk(
+			client.LedgerEntry.Create().
+				SetID(uuid.New().String()).
+				SetTransactionID(txID).
+				SetAccount(UserAccount(userID)).
+				SetAmount(amount),
+			client.LedgerEntry.Create().
+				SetID(uuid.New().String()).
+				SetTransactionID(txID).
+				SetAccount(SystemAccount(txType)).
+				SetAmount(-amount),
+		).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create ledger entries: %w", err)
+	}
+
 	return tx, nil
 }
 
-// DeductCredits deducts credits from user's balance
-func (s *CreditsService) DeductCredits(ctx context.Context, userID string, amount int, txType credittransaction.TransactionType, description, refType, refID string) (*ent.CreditTransaction, error) {
-	return s.AddCredits(ctx, userID, -amount, txType, description, refType, refID)
+// lockUser reads the user row with SELECT ... FOR UPDATE
+func lockUser(ctx context.Context, client *ent.Client, userID string) (*ent.User, error) {
+	u, err := client.User.
+		Query().
+		Where(user.IDEQ(userID)).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("user not found")
+		}
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	return u, nil
+}
+
+// UserAccount is the ledger account holding a user's credits
+func UserAccount(userID string) string {
+	return "user:" + userID
+}
+
+// SystemAccount is the ledger counter-account for a transaction type
+func SystemAccount(txType credittransaction.TransactionType) string {
+	switch txType {
+	case credittransaction.TransactionTypePurchase:
+		return "system:purchases"
+	case credittransaction.TransactionTypeStreakRecovery:
+		return "system:streak_recovery"
+	case credittransaction.TransactionTypeEarlyReveal, credittransaction.TransactionTypeRevealGift:
+		return "system:reveals"
+	case credittransaction.TransactionTypeReferralBonus, credittransaction.TransactionTypeWelcomeBonus, credittransaction.TransactionTypePromoCode:
+		return "system:promotions"
+	case credittransaction.TransactionTypeRefund:
+		return "system:refunds"
+	default:
+		return "system:admin"
+	}
 }
 
 // Helper functions
@@ -0,0 +1,592 @@
+// internal/monetization/services/fake_gateway.go
+package services
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/UnoraApp/be/pkg/logger"
+)
+
+// Defaults the fake gateway signs with when no secrets are configured
+const (
+	fakeKeyID         = "rzp_test_fake"
+	fakeKeySecret     = "fake_key_secret"
+	fakeWebhookSecret = "fake_webhook_secret"
+)
+
+// fakeSubscriptionPeriod is the billing cycle of fake subscriptions
+const fakeSubscriptionPeriod = 30 * 24 * time.Hour
+
+var (
+	ErrFakeEntityNotFound = errors.New("fake gateway: entity not found")
+	ErrFakeInvalidRequest = errors.New("fake gateway: invalid request")
+)
+
+// FakePaymentOutcome is how a simulated payment or charge ends
+type FakePaymentOutcome string
+
+const (
+	// FakePaymentSuccess captures the payment immediately
+	FakePaymentSuccess FakePaymentOutcome = "success"
+	// FakePaymentFailure fails the payment
+	FakePaymentFailure FakePaymentOutcome = "failure"
+	// FakePaymentDelayed authorises the payment and captures it after the
+	// configured capture delay
+	FakePaymentDelayed FakePaymentOutcome = "delayed"
+)
+
+// FakeGatewayConfig holds fake gateway configuration
+type FakeGatewayConfig struct {
+	// Secrets payments and webhooks are signed with; defaults when empty
+	KeySecret     string
+	WebhookSecret string
+	// CaptureDelay is how long a delayed payment stays authorised, and how
+	// long a refund takes to process
+	CaptureDelay time.Duration
+}
+
+// WebhookDeliverer receives the webhooks the fake gateway sends, exactly as
+// the webhook endpoint would: raw body, signature and event ID
+type WebhookDeliverer func(ctx context.Context, body []byte, signature, eventID string) error
+
+// FakePaymentResult is what checkout would hand the client after a payment
+type FakePaymentResult struct {
+	OrderID   string
+	PaymentID string
+	// Empty for a failed payment
+	Signature string
+	Status    string
+}
+
+// FakeGateway is an in-process PaymentGateway for development and tests. It
+// keeps orders, payments, refunds and subscriptions in memory, signs payments
+// and webhooks the way Razorpay does, and delivers webhooks to a
+// WebhookDeliverer instead of over the network. Payments are driven with
+// SimulatePayment and SimulateSubscriptionCharge in place of Checkout.
+type FakeGateway struct {
+	keySecret     string
+	webhookSecret string
+	captureDelay  time.Duration
+
+	mu            sync.Mutex
+	orders        map[string]*RazorpayOrder
+	payments      map[string]*RazorpayPayment
+	refunds       map[string]*RazorpayRefund
+	subscriptions map[string]*RazorpaySubscription
+	deliver       WebhookDeliverer
+}
+
+// fakeWebhook is an event waiting to be delivered once the lock is released
+type fakeWebhook struct {
+	event   string
+	payload map[string]interface{}
+}
+
+// NewFakeGateway creates a new fake gateway
+func NewFakeGateway(cfg *FakeGatewayConfig) *FakeGateway {
+	g := &FakeGateway{
+		keySecret:     cfg.KeySecret,
+		webhookSecret: cfg.WebhookSecret,
+		captureDelay:  cfg.CaptureDelay,
+		orders:        make(map[string]*RazorpayOrder),
+		payments:      make(map[string]*RazorpayPayment),
+		refunds:       make(map[string]*RazorpayRefund),
+		subscriptions: make(map[string]*RazorpaySubscription),
+	}
+	if g.keySecret == "" {
+		g.keySecret = fakeKeySecret
+	}
+	if g.webhookSecret == "" {
+		g.webhookSecret = fakeWebhookSecret
+	}
+	return g
+}
+
+// SetWebhookDeliverer sets where webhooks go; without one they are dropped
+func (g *FakeGateway) SetWebhookDeliverer(deliver WebhookDeliverer) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.deliver = deliver
+}
+
+// Name identifies the provider
+func (g *FakeGateway) Name() string {
+	return PaymentProviderFake
+}
+
+// GetKeyID returns the public key ID for frontend
+func (g *FakeGateway) GetKeyID() string {
+	return fakeKeyID
+}
+
+// CreateOrder creates an order awaiting payment
+func (g *FakeGateway) CreateOrder(input *CreateOrderInput) (*RazorpayOrder, error) {
+	if input.Amount <= 0 {
+		return nil, fmt.Errorf("%w: amount must be positive", ErrFakeInvalidRequest)
+	}
+
+	order := &RazorpayOrder{
+		ID:        fakeID("order"),
+		Entity:    "order",
+		Amount:    input.Amount,
+		AmountDue: input.Amount,
+		Currency:  input.Currency,
+		Receipt:   input.Receipt,
+		Status:    "created",
+		CreatedAt: time.Now().Unix(),
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.orders[order.ID] = order
+	copied := *order
+	return &copied, nil
+}
+
+// FetchPayment fetches a payment
+func (g *FakeGateway) FetchPayment(paymentID string) (*RazorpayPayment, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	payment, ok := g.payments[paymentID]
+	if !ok {
+		return nil, fmt.Errorf("%w: payment %s", ErrFakeEntityNotFound, paymentID)
+	}
+	copied := *payment
+	return &copied, nil
+}
+
+// FetchOrderPayments fetches every payment attempt made against an order
+func (g *FakeGateway) FetchOrderPayments(orderID string) ([]RazorpayPayment, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if _, ok := g.orders[orderID]; !ok {
+		return nil, fmt.Errorf("%w: order %s", ErrFakeEntityNotFound, orderID)
+	}
+	return g.paymentsWhere(func(p *RazorpayPayment) bool {
+		return p.OrderID == orderID
+	}), nil
+}
+
+// ListPayments fetches every payment created in [from, to)
+func (g *FakeGateway) ListPayments(from, to time.Time) ([]RazorpayPayment, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return g.paymentsWhere(func(p *RazorpayPayment) bool {
+		return p.CreatedAt >= from.Unix() && p.CreatedAt < to.Unix()
+	}), nil
+}
+
+// CreateRefund refunds a captured payment. The refund is returned pending and
+// processed, with a refund.processed webhook, after the capture delay.
+func (g *FakeGateway) CreateRefund(paymentID string, input *CreateRefundInput) (*RazorpayRefund, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	payment, ok := g.payments[paymentID]
+	if !ok {
+		return nil, fmt.Errorf("%w: payment %s", ErrFakeEntityNotFound, paymentID)
+	}
+	if !payment.Captured {
+		return nil, fmt.Errorf("%w: payment %s is not captured", ErrFakeInvalidRequest, paymentID)
+	}
+
+	remaining := payment.Amount - payment.AmountRefunded
+	amount := input.Amount
+	if amount == 0 {
+		amount = remaining
+	}
+	if amount <= 0 || amount > remaining {
+		return nil, fmt.Errorf("%w: refund of %d exceeds the %d refundable", ErrFakeInvalidRequest, amount, remaining)
+	}
+
+	payment.AmountRefunded += amount
+	if payment.AmountRefunded == payment.Amount {
+		payment.Status = "refunded"
+	}
+
+	refund := &RazorpayRefund{
+		ID:        fakeID("rfnd"),
+		Entity:    "refund",
+		Amount:    amount,
+		Currency:  payment.Currency,
+		PaymentID: paymentID,
+		Notes:     input.Notes,
+		Receipt:   input.Receipt,
+		Status:    "pending",
+		CreatedAt: time.Now().Unix(),
+	}
+	g.refunds[refund.ID] = refund
+
+	refundID := refund.ID
+	g.after(func() []fakeWebhook {
+		r := g.refunds[refundID]
+		r.Status = "processed"
+		return []fakeWebhook{{
+			event: "refund.processed",
+			payload: map[string]interface{}{
+				"refund":  entity(*r),
+				"payment": entity(*g.payments[r.PaymentID]),
+			},
+		}}
+	})
+
+	copied := *refund
+	return &copied, nil
+}
+
+// CreateSubscription creates a subscription awaiting its first charge
+func (g *FakeGateway) CreateSubscription(input *CreateSubscriptionInput) (*RazorpaySubscription, error) {
+	if input.PlanID == "" {
+		return nil, fmt.Errorf("%w: plan_id is required", ErrFakeInvalidRequest)
+	}
+
+	id := fakeID("sub")
+	sub := &RazorpaySubscription{
+		ID:         id,
+		Entity:     "subscription",
+		PlanID:     input.PlanID,
+		Status:     "created",
+		TotalCount: input.TotalCount,
+		ShortURL:   "https://fake.gateway.local/subscriptions/" + id,
+		CreatedAt:  time.Now().Unix(),
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.subscriptions[id] = sub
+	copied := *sub
+	return &copied, nil
+}
+
+// FetchSubscription fetches a subscription
+func (g *FakeGateway) FetchSubscription(subscriptionID string) (*RazorpaySubscription, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	sub, ok := g.subscriptions[subscriptionID]
+	if !ok {
+		return nil, fmt.Errorf("%w: subscription %s", ErrFakeEntityNotFound, subscriptionID)
+	}
+	copied := *sub
+	return &copied, nil
+}
+
+// CancelSubscription cancels a subscription now, or leaves it active until
+// the end of the current cycle
+func (g *FakeGateway) CancelSubscription(subscriptionID string, atCycleEnd bool) (*RazorpaySubscription, error) {
+	return g.updateSubscription(subscriptionID, func(sub *RazorpaySubscription) (string, error) {
+		switch sub.Status {
+		case "cancelled", "completed", "expired":
+			return "", fmt.Errorf("%w: subscription is %s", ErrFakeInvalidRequest, sub.Status)
+		}
+		if atCycleEnd && sub.Status == "active" {
+			return "", nil
+		}
+		sub.Status = "cancelled"
+		sub.EndedAt = time.Now().Unix()
+		return "subscription.cancelled", nil
+	})
+}
+
+// PauseSubscription pauses an active subscription
+func (g *FakeGateway) PauseSubscription(subscriptionID string) (*RazorpaySubscription, error) {
+	return g.updateSubscription(subscriptionID, func(sub *RazorpaySubscription) (string, error) {
+		if sub.Status != "active" {
+			return "", fmt.Errorf("%w: subscription is %s", ErrFakeInvalidRequest, sub.Status)
+		}
+		sub.Status = "paused"
+		return "subscription.paused", nil
+	})
+}
+
+// ResumeSubscription resumes a paused subscription
+func (g *FakeGateway) ResumeSubscription(subscriptionID string) (*RazorpaySubscription, error) {
+	return g.updateSubscription(subscriptionID, func(sub *RazorpaySubscription) (string, error) {
+		if sub.Status != "paused" {
+			return "", fmt.Errorf("%w: subscription is %s", ErrFakeInvalidRequest, sub.Status)
+		}
+		sub.Status = "active"
+		return "subscription.resumed", nil
+	})
+}
+
+// VerifyPaymentSignature verifies a payment signature issued by SimulatePayment
+func (g *FakeGateway) VerifyPaymentSignature(orderID, paymentID, signature string) bool {
+	return hmac.Equal([]byte(fakeSign(g.keySecret, []byte(orderID+"|"+paymentID))), []byte(signature))
+}
+
+// VerifyWebhookSignature verifies a webhook sent by the fake gateway
+func (g *FakeGateway) VerifyWebhookSignature(body []byte, signature string) bool {
+	return hmac.Equal([]byte(fakeSign(g.webhookSecret, body)), []byte(signature))
+}
+
+// SimulatePayment pays an order as the customer would through Checkout. The
+// webhooks of the outcome are delivered before it returns, except for the
+// capture of a delayed payment.
+func (g *FakeGateway) SimulatePayment(ctx context.Context, orderID string, outcome FakePaymentOutcome) (*FakePaymentResult, error) {
+	g.mu.Lock()
+
+	order, ok := g.orders[orderID]
+	if !ok {
+		g.mu.Unlock()
+		return nil, fmt.Errorf("%w: order %s", ErrFakeEntityNotFound, orderID)
+	}
+	if order.Status == "paid" {
+		g.mu.Unlock()
+		return nil, fmt.Errorf("%w: order %s is already paid", ErrFakeInvalidRequest, orderID)
+	}
+
+	payment := &RazorpayPayment{
+		ID:        fakeID("pay"),
+		Entity:    "payment",
+		Amount:    order.Amount,
+		Currency:  order.Currency,
+		OrderID:   order.ID,
+		Method:    "upi",
+		CreatedAt: time.Now().Unix(),
+	}
+	g.payments[payment.ID] = payment
+	order.Attempts++
+	order.Status = "attempted"
+
+	var webhooks []fakeWebhook
+	switch outcome {
+	case FakePaymentSuccess:
+		webhooks = g.capture(payment)
+	case FakePaymentFailure:
+		payment.Status = "failed"
+		payment.ErrorCode = "BAD_REQUEST_ERROR"
+		payment.ErrorDescription = "Payment failed (simulated)"
+		webhooks = []fakeWebhook{{
+			event:   "payment.failed",
+			payload: map[string]interface{}{"payment": entity(*payment)},
+		}}
+	case FakePaymentDelayed:
+		payment.Status = "authorized"
+		paymentID := payment.ID
+		g.after(func() []fakeWebhook {
+			return g.capture(g.payments[paymentID])
+		})
+	default:
+		delete(g.payments, payment.ID)
+		g.mu.Unlock()
+		return nil, fmt.Errorf("%w: unknown outcome %q", ErrFakeInvalidRequest, outcome)
+	}
+
+	result := &FakePaymentResult{
+		OrderID:   order.ID,
+		PaymentID: payment.ID,
+		Status:    payment.Status,
+	}
+	if outcome != FakePaymentFailure {
+		result.Signature = fakeSign(g.keySecret, []byte(order.ID+"|"+payment.ID))
+	}
+	g.mu.Unlock()
+
+	g.send(ctx, webhooks)
+	return result, nil
+}
+
+// SimulateSubscriptionCharge bills a subscription's next cycle. A success
+// activates it, or renews it when already active; a failure leaves it pending
+// as a declined renewal would.
+func (g *FakeGateway) SimulateSubscriptionCharge(ctx context.Context, subscriptionID string, outcome FakePaymentOutcome) (*RazorpaySubscription, error) {
+	g.mu.Lock()
+
+	sub, ok := g.subscriptions[subscriptionID]
+	if !ok {
+		g.mu.Unlock()
+		return nil, fmt.Errorf("%w: subscription %s", ErrFakeEntityNotFound, subscriptionID)
+	}
+	switch sub.Status {
+	case "cancelled", "completed", "expired", "paused":
+		g.mu.Unlock()
+		return nil, fmt.Errorf("%w: subscription is %s", ErrFakeInvalidRequest, sub.Status)
+	}
+
+	var event string
+	switch outcome {
+	case FakePaymentSuccess:
+		now := time.Now()
+		event = "subscription.charged"
+		if sub.PaidCount == 0 {
+			event = "subscription.activated"
+		}
+		sub.Status = "active"
+		sub.PaidCount++
+		sub.CurrentStart = now.Unix()
+		sub.CurrentEnd = now.Add(fakeSubscriptionPeriod).Unix()
+		sub.ChargeAt = sub.CurrentEnd
+		if sub.TotalCount > 0 && sub.PaidCount >= sub.TotalCount {
+			sub.Status = "completed"
+			sub.EndedAt = sub.CurrentEnd
+		}
+	case FakePaymentFailure:
+		event = "subscription.pending"
+		sub.Status = "pending"
+	default:
+		g.mu.Unlock()
+		return nil, fmt.Errorf("%w: unsupported outcome %q for a subscription charge", ErrFakeInvalidRequest, outcome)
+	}
+
+	copied := *sub
+	g.mu.Unlock()
+
+	g.send(ctx, []fakeWebhook{{
+		event:   event,
+		payload: map[string]interface{}{"subscription": entity(copied)},
+	}})
+	return &copied, nil
+}
+
+// capture captures an authorised or new payment and pays its order. The
+// caller holds the lock.
+func (g *FakeGateway) capture(payment *RazorpayPayment) []fakeWebhook {
+	payment.Status = "captured"
+	payment.Captured = true
+
+	order := g.orders[payment.OrderID]
+	order.Status = "paid"
+	order.AmountPaid = order.Amount
+	order.AmountDue = 0
+
+	return []fakeWebhook{
+		{
+			event:   "payment.captured",
+			payload: map[string]interface{}{"payment": entity(*payment)},
+		},
+		{
+			event: "order.paid",
+			payload: map[string]interface{}{
+				"payment": entity(*payment),
+				"order":   entity(*order),
+			},
+		},
+	}
+}
+
+// updateSubscription applies change under the lock and delivers the webhook
+// it names, if any
+func (g *FakeGateway) updateSubscription(subscriptionID string, change func(*RazorpaySubscription) (string, error)) (*RazorpaySubscription, error) {
+	g.mu.Lock()
+	sub, ok := g.subscriptions[subscriptionID]
+	if !ok {
+		g.mu.Unlock()
+		return nil, fmt.Errorf("%w: subscription %s", ErrFakeEntityNotFound, subscriptionID)
+	}
+	event, err := change(sub)
+	if err != nil {
+		g.mu.Unlock()
+		return nil, err
+	}
+	copied := *sub
+	g.mu.Unlock()
+
+	// Razorpay sends these after the API call returns
+	if event != "" {
+		go g.send(context.Background(), []fakeWebhook{{
+			event:   event,
+			payload: map[string]interface{}{"subscription": entity(copied)},
+		}})
+	}
+	return &copied, nil
+}
+
+// after runs change under the lock once the capture delay has passed and
+// delivers the webhooks it returns
+func (g *FakeGateway) after(change func() []fakeWebhook) {
+	time.AfterFunc(g.captureDelay, func() {
+		g.mu.Lock()
+		webhooks := change()
+		g.mu.Unlock()
+		g.send(context.Background(), webhooks)
+	})
+}
+
+// send signs and delivers webhooks one by one. A failed delivery is logged and
+// not retried.
+func (g *FakeGateway) send(ctx context.Context, webhooks []fakeWebhook) {
+	g.mu.Lock()
+	deliver := g.deliver
+	g.mu.Unlock()
+
+	log := logger.GetLogger("payments")
+	for _, w := range webhooks {
+		contains := make([]string, 0, len(w.payload))
+		for name := range w.payload {
+			contains = append(contains, name)
+		}
+		sort.Strings(contains)
+
+		body, err := json.Marshal(map[string]interface{}{
+			"entity":     "event",
+			"account_id": "acc_fake",
+			"event":      w.event,
+			"contains":   contains,
+			"payload":    w.payload,
+			"created_at": time.Now().Unix(),
+		})
+		if err != nil {
+			log.Error().Err(err).Str("event", w.event).Msg("Fake gateway failed to encode webhook")
+			continue
+		}
+
+		if deliver == nil {
+			log.Debug().Str("event", w.event).Msg("Fake gateway has no webhook deliverer, dropping webhook")
+			continue
+		}
+		if err := deliver(ctx, body, fakeSign(g.webhookSecret, body), fakeID("evt")); err != nil {
+			log.Error().Err(err).Str("event", w.event).Msg("Fake gateway webhook delivery failed")
+		}
+	}
+}
+
+// paymentsWhere returns copies of the matching payments, oldest first. The
+// caller holds the lock.
+func (g *FakeGateway) paymentsWhere(match func(*RazorpayPayment) bool) []RazorpayPayment {
+	var result []RazorpayPayment
+	for _, p := range g.payments {
+		if match(p) {
+			result = append(result, *p)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].CreatedAt != result[j].CreatedAt {
+			return result[i].CreatedAt < result[j].CreatedAt
+		}
+		return result[i].ID < result[j].ID
+	})
+	return result
+}
+
+// entity wraps an entity the way webhook payloads nest it
+func entity(v interface{}) map[string]interface{} {
+	return map[string]interface{}{"entity": v}
+}
+
+// fakeID returns a Razorpay-style ID, e.g. pay_3f9c1a7b20d84e
+func fakeID(prefix string) string {
+	return prefix + "_" + strings.ReplaceAll(uuid.New().String(), "-", "")[:14]
+}
+
+// fakeSign is the HMAC-SHA256 signature Razorpay uses for payments and webhooks
+func fakeSign(secret string, data []byte) string {
+	h := hmac.New(sha256.New, []byte(secret))
+	h.Write(data)
+	return hex.EncodeToString(h.Sum(nil))
+}
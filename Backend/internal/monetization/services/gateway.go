@@ -0,0 +1,68 @@
+// internal/monetization/services/gateway.go
+package services
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/UnoraApp/be/internal/config"
+)
+
+// Payment providers selectable with PAYMENT_PROVIDER
+const (
+	PaymentProviderRazorpay = "razorpay"
+	PaymentProviderFake     = "fake"
+)
+
+// ErrUnknownPaymentProvider is returned for an unsupported PAYMENT_PROVIDER
+var ErrUnknownPaymentProvider = errors.New("unknown payment provider")
+
+// PaymentGateway is the payment provider behind credit purchases, refunds and
+// subscriptions. Entities use Razorpay's shapes, which the schema and webhook
+// format already follow; another provider maps its own onto them.
+type PaymentGateway interface {
+	// Name identifies the provider, e.g. "razorpay"
+	Name() string
+	// GetKeyID returns the public key the client's checkout is opened with
+	GetKeyID() string
+
+	CreateOrder(input *CreateOrderInput) (*RazorpayOrder, error)
+	FetchPayment(paymentID string) (*RazorpayPayment, error)
+	FetchOrderPayments(orderID string) ([]RazorpayPayment, error)
+	ListPayments(from, to time.Time) ([]RazorpayPayment, error)
+
+	CreateRefund(paymentID string, input *CreateRefundInput) (*RazorpayRefund, error)
+
+	CreateSubscription(input *CreateSubscriptionInput) (*RazorpaySubscription, error)
+	FetchSubscription(subscriptionID string) (*RazorpaySubscription, error)
+	CancelSubscription(subscriptionID string, atCycleEnd bool) (*RazorpaySubscription, error)
+	PauseSubscription(subscriptionID string) (*RazorpaySubscription, error)
+	ResumeSubscription(subscriptionID string) (*RazorpaySubscription, error)
+
+	// VerifyPaymentSignature checks the signature checkout returns with a payment
+	VerifyPaymentSignature(orderID, paymentID, signature string) bool
+	// VerifyWebhookSignature checks a webhook delivery against its raw body
+	VerifyWebhookSignature(body []byte, signature string) bool
+}
+
+// NewPaymentGateway creates the gateway selected by cfg.Provider
+func NewPaymentGateway(cfg *config.PaymentConfig) (PaymentGateway, error) {
+	switch cfg.Provider {
+	case PaymentProviderRazorpay, "":
+		return NewRazorpayClient(&RazorpayConfig{
+			KeyID:         cfg.RazorpayKeyID,
+			KeySecret:     cfg.RazorpayKeySecret,
+			WebhookSecret: cfg.RazorpayWebhookSecret,
+			BaseURL:       cfg.RazorpayBaseURL,
+		}), nil
+	case PaymentProviderFake:
+		return NewFakeGateway(&FakeGatewayConfig{
+			KeySecret:     cfg.RazorpayKeySecret,
+			WebhookSecret: cfg.RazorpayWebhookSecret,
+			CaptureDelay:  time.Duration(cfg.FakeCaptureDelaySeconds) * time.Second,
+		}), nil
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnknownPaymentProvider, cfg.Provider)
+	}
+}
@@ -0,0 +1,448 @@
+// internal/monetization/services/invoice_service.go
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	ent "github.com/UnoraApp/be/ent/generated"
+	"github.com/UnoraApp/be/ent/generated/invoice"
+	"github.com/UnoraApp/be/ent/generated/invoicesequence"
+	"github.com/UnoraApp/be/ent/generated/paymentorder"
+	"github.com/UnoraApp/be/internal/config"
+	"github.com/UnoraApp/be/pkg/database"
+	"github.com/UnoraApp/be/pkg/pdf"
+	"github.com/UnoraApp/be/pkg/storage"
+)
+
+// Invoice errors
+var (
+	ErrOrderNotPaid            = errors.New("order is not paid")
+	ErrInvalidInvoiceDateRange = errors.New("invalid date range: from and to must be YYYY-MM-DD with from not after to")
+)
+
+// invoiceIssueAttempts bounds retries when a concurrent issue for the same
+// order wins the unique order_id
+const invoiceIssueAttempts = 3
+
+// istLocation is Indian Standard Time; invoice dates and financial years
+// follow it
+var istLocation = time.FixedZone("IST", 5*60*60+30*60)
+
+// InvoiceService issues GST tax invoices for paid payment orders. Prices are
+// tax-inclusive, so an invoice splits the amount paid into the taxable value
+// and GST. Numbers run consecutively within each Indian financial year.
+type InvoiceService struct {
+	entClient     *ent.Client
+	storageClient storage.Client
+	cfg           *config.InvoiceConfig
+}
+
+// NewInvoiceService creates a new invoice service
+func NewInvoiceService(entClient *ent.Client, storageClient storage.Client, cfg *config.InvoiceConfig) *InvoiceService {
+	return &InvoiceService{
+		entClient:     entClient,
+		storageClient: storageClient,
+		cfg:           cfg,
+	}
+}
+
+// IssueForOrder returns the invoice for a paid order, issuing it and storing
+// its PDF on first call. It is safe to call repeatedly and concurrently: an
+// order has at most one invoice.
+func (s *InvoiceService) IssueForOrder(ctx context.Context, orderID string) (*ent.Invoice, error) {
+	var inv *ent.Invoice
+	var err error
+	for attempt := 0; attempt < invoiceIssueAttempts; attempt++ {
+		inv, err = s.issue(ctx, orderID)
+		if err == nil || !ent.IsConstraintError(err) {
+			break
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if inv.PdfStorageKey == nil {
+		if inv, err = s.storePDF(ctx, inv); err != nil {
+			return nil, err
+		}
+	}
+	return inv, nil
+}
+
+// GetInvoicePDF returns the user's invoice for an order and a reader for its
+// PDF, which the caller must close. An invoice missed at fulfilment is issued
+// here.
+func (s *InvoiceService) GetInvoicePDF(ctx context.Context, userID, orderID string) (*ent.Invoice, io.ReadCloser, error) {
+	order, err := s.entClient.PaymentOrder.
+		Query().
+		Where(paymentorder.IDEQ(orderID)).
+		Where(paymentorder.UserIDEQ(userID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil, ErrOrderNotFound
+		}
+		return nil, nil, fmt.Errorf("failed to get order: %w", err)
+	}
+	if order.PaidAt == nil {
+		return nil, nil, ErrOrderNotPaid
+	}
+
+	inv, err := s.IssueForOrder(ctx, order.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reader, err := s.storageClient.Download(ctx, *inv.PdfStorageKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to download invoice: %w", err)
+	}
+	return inv, reader, nil
+}
+
+// ExportCSV writes the invoices dated from fromDate to toDate (YYYY-MM-DD,
+// both inclusive, in IST) as CSV for accounting, in invoice number order.
+// Amounts are in rupees.
+func (s *InvoiceService) ExportCSV(ctx context.Context, fromDate, toDate string, w io.Writer) error {
+	from, err := time.ParseInLocation("2006-01-02", fromDate, istLocation)
+	if err != nil {
+		return ErrInvalidInvoiceDateRange
+	}
+	to, err := time.ParseInLocation("2006-01-02", toDate, istLocation)
+	if err != nil || to.Before(from) {
+		return ErrInvalidInvoiceDateRange
+	}
+	to = to.AddDate(0, 0, 1)
+
+	invoices, err := s.entClient.Invoice.
+		Query().
+		Where(invoice.IssuedAtGTE(from)).
+		Where(invoice.IssuedAtLT(to)).
+		Order(ent.Asc(invoice.FieldFinancialYear), ent.Asc(invoice.FieldSequenceNumber)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get invoices: %w", err)
+	}
+
+	out := csv.NewWriter(w)
+	out.Write([]string{
+		"invoice_number", "invoice_date", "order_id", "buyer_name", "buyer_email",
+		"place_of_supply", "sac_code", "currency", "discount", "taxable_value",
+		"gst_rate", "cgst", "sgst", "igst", "total",
+	})
+	for _, inv := range invoices {
+		out.Write([]string{
+			inv.InvoiceNumber,
+			inv.IssuedAt.In(istLocation).Format("2006-01-02"),
+			inv.OrderID,
+			ptrToString(inv.BuyerName),
+			ptrToString(inv.BuyerEmail),
+			inv.PlaceOfSupply,
+			inv.SacCode,
+			inv.Currency,
+			formatPaise(inv.DiscountAmount),
+			formatPaise(inv.TaxableAmount),
+			formatRate(inv.GstRate),
+			formatPaise(inv.CgstAmount),
+			formatPaise(inv.SgstAmount),
+			formatPaise(inv.IgstAmount),
+			formatPaise(inv.TotalAmount),
+		})
+	}
+	out.Flush()
+	return out.Error()
+}
+
+// issue returns the order's invoice, creating it with the next number of the
+// current financial year if it has none
+func (s *InvoiceService) issue(ctx context.Context, orderID string) (*ent.Invoice, error) {
+	existing, err := s.entClient.Invoice.
+		Query().
+		Where(invoice.OrderIDEQ(orderID)).
+		Only(ctx)
+	if err == nil {
+		return existing, nil
+	}
+	if !ent.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to get invoice: %w", err)
+	}
+
+	order, err := s.entClient.PaymentOrder.Get(ctx, orderID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrOrderNotFound
+		}
+		return nil, fmt.Errorf("failed to get order: %w", err)
+	}
+	if order.PaidAt == nil {
+		return nil, ErrOrderNotPaid
+	}
+
+	buyer, err := s.entClient.User.Get(ctx, order.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("user not found: %w", err)
+	}
+
+	description := fmt.Sprintf("%d Unora credits", order.CreditsToAdd)
+	if order.PackageID != nil {
+		if pkg, err := s.entClient.CreditPackage.Get(ctx, *order.PackageID); err == nil {
+			description = fmt.Sprintf("%s - %d Unora credits", pkg.Name, order.CreditsToAdd)
+		}
+	}
+
+	// Consumers have no address on file, so the place of supply is the
+	// supplier's location and the tax is intra-state CGST and SGST
+	placeOfSupply := s.cfg.SellerStateCode
+	tax := splitGST(order.Amount, s.cfg.GSTRatePercent, placeOfSupply == s.cfg.SellerStateCode)
+
+	issuedAt := time.Now()
+	fy := financialYear(issuedAt)
+	if err := ensureInvoiceSequence(ctx, s.entClient, fy); err != nil {
+		return nil, err
+	}
+
+	var inv *ent.Invoice
+	err = database.WithTx(ctx, s.entClient, func(tx *ent.Tx) error {
+		client := tx.Client()
+
+		// The row lock serialises numbering, so numbers have no gaps
+		seq, err := client.InvoiceSequence.
+			Query().
+			Where(invoicesequence.IDEQ(fy)).
+			ForUpdate().
+			Only(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to lock invoice sequence: %w", err)
+		}
+		number := seq.LastNumber + 1
+
+		inv, err = client.Invoice.
+			Create().
+			SetID(uuid.New().String()).
+			SetInvoiceNumber(s.invoiceNumber(fy, number)).
+			SetFinancialYear(fy).
+			SetSequenceNumber(number).
+			SetOrderID(order.ID).
+			SetUserID(order.UserID).
+			SetSellerName(s.cfg.SellerName).
+			SetSellerAddress(s.cfg.SellerAddress).
+			SetSellerGstin(s.cfg.SellerGSTIN).
+			SetNillableBuyerName(buyerName(buyer)).
+			SetNillableBuyerEmail(buyer.Email).
+			SetPlaceOfSupply(placeOfSupply).
+			SetDescription(description).
+			SetSacCode(s.cfg.SACCode).
+			SetCurrency(order.Currency).
+			SetDiscountAmount(order.DiscountAmount).
+			SetTaxableAmount(tax.taxable).
+			SetGstRate(s.cfg.GSTRatePercent).
+			SetCgstAmount(tax.cgst).
+			SetSgstAmount(tax.sgst).
+			SetIgstAmount(tax.igst).
+			SetTotalAmount(order.Amount).
+			SetIssuedAt(issuedAt).
+			Save(ctx)
+		if err != nil {
+			// A constraint error here is a concurrent issue for this order;
+			// the caller retries and finds its invoice
+			if ent.IsConstraintError(err) {
+				return err
+			}
+			return fmt.Errorf("failed to create invoice: %w", err)
+		}
+
+		_, err = seq.Update().
+			SetLastNumber(number).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to update invoice sequence: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return inv, nil
+}
+
+// storePDF renders the invoice, uploads it and records where it is stored
+func (s *InvoiceService) storePDF(ctx context.Context, inv *ent.Invoice) (*ent.Invoice, error) {
+	content := renderInvoicePDF(inv)
+	key := fmt.Sprintf("invoices/%s/%s.pdf", inv.UserID, inv.ID)
+
+	if _, err := s.storageClient.Upload(ctx, key, bytes.NewReader(content), int64(len(content)), "application/pdf"); err != nil {
+		return nil, fmt.Errorf("failed to upload invoice: %w", err)
+	}
+
+	inv, err := inv.Update().
+		SetPdfStorageKey(key).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update invoice: %w", err)
+	}
+	return inv, nil
+}
+
+// invoiceNumber formats e.g. UNR/26-27/000042, at most 16 characters
+func (s *InvoiceService) invoiceNumber(fy string, number int) string {
+	return fmt.Sprintf("%s/%s/%06d", s.cfg.NumberPrefix, fy[2:], number)
+}
+
+// ensureInvoiceSequence creates the financial year's sequence row if it does
+// not exist yet. Doing this outside the numbering transaction means that
+// transaction always locks an existing row.
+func ensureInvoiceSequence(ctx context.Context, client *ent.Client, fy string) error {
+	exists, err := client.InvoiceSequence.
+		Query().
+		Where(invoicesequence.IDEQ(fy)).
+		Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get invoice sequence: %w", err)
+	}
+	if exists {
+		return nil
+	}
+
+	_, err = client.InvoiceSequence.
+		Create().
+		SetID(fy).
+		Save(ctx)
+	if err != nil && !ent.IsConstraintError(err) {
+		return fmt.Errorf("failed to create invoice sequence: %w", err)
+	}
+	return nil
+}
+
+// financialYear returns the Indian financial year (April to March) of t,
+// e.g. 2026-27
+func financialYear(t time.Time) string {
+	t = t.In(istLocation)
+	start := t.Year()
+	if t.Month() < time.April {
+		start--
+	}
+	return fmt.Sprintf("%d-%02d", start, (start+1)%100)
+}
+
+// gstBreakup is a tax-inclusive amount split into taxable value and GST
+type gstBreakup struct {
+	taxable int
+	cgst    int
+	sgst    int
+	igst    int
+}
+
+// splitGST splits a tax-inclusive amount in paise. Intra-state supplies
+// divide the tax equally between CGST and SGST; inter-state ones charge IGST.
+func splitGST(amount int, ratePercent float64, intraState bool) gstBreakup {
+	taxable := int(math.Round(float64(amount) * 100 / (100 + ratePercent)))
+	tax := amount - taxable
+	if !intraState {
+		return gstBreakup{taxable: taxable, igst: tax}
+	}
+	cgst := tax / 2
+	return gstBreakup{taxable: taxable, cgst: cgst, sgst: tax - cgst}
+}
+
+// buyerName is the user's full name, if known
+func buyerName(u *ent.User) *string {
+	if u.Name != nil && *u.Name != "" {
+		return u.Name
+	}
+	name := strings.TrimSpace(ptrToString(u.FirstName) + " " + ptrToString(u.LastName))
+	if name == "" {
+		return nil
+	}
+	return &name
+}
+
+// formatPaise formats an amount in paise as rupees, e.g. 9900 as 99.00
+func formatPaise(amount int) string {
+	return fmt.Sprintf("%d.%02d", amount/100, amount%100)
+}
+
+// renderInvoicePDF lays out the invoice on one A4 page
+func renderInvoicePDF(inv *ent.Invoice) []byte {
+	const (
+		left  = 50.0
+		right = pdf.PageWidth - 50
+	)
+	doc := pdf.New()
+
+	doc.Text(left, 70, pdf.FontBold, 18, "TAX INVOICE")
+	doc.Text(left, 100, pdf.FontBold, 11, inv.SellerName)
+	doc.Text(left, 115, pdf.FontRegular, 9, inv.SellerAddress)
+	doc.Text(left, 130, pdf.FontRegular, 9, "GSTIN: "+inv.SellerGstin)
+
+	doc.Text(350, 100, pdf.FontRegular, 9, "Invoice No: "+inv.InvoiceNumber)
+	doc.Text(350, 115, pdf.FontRegular, 9, "Date: "+inv.IssuedAt.In(istLocation).Format("02 Jan 2006"))
+	doc.Text(350, 130, pdf.FontRegular, 9, "Place of supply: "+inv.PlaceOfSupply)
+
+	doc.Line(left, 145, right, 145, 0.5)
+
+	doc.Text(left, 165, pdf.FontBold, 10, "Billed to")
+	y := 180.0
+	if inv.BuyerName != nil {
+		doc.Text(left, y, pdf.FontRegular, 9, *inv.BuyerName)
+		y += 15
+	}
+	if inv.BuyerEmail != nil {
+		doc.Text(left, y, pdf.FontRegular, 9, *inv.BuyerEmail)
+		y += 15
+	}
+	doc.Text(left, y, pdf.FontRegular, 9, "Order: "+inv.OrderID)
+
+	// Line item at its tax-inclusive list price
+	doc.Line(left, 230, right, 230, 0.5)
+	doc.Text(left, 245, pdf.FontBold, 9, "Description")
+	doc.Text(330, 245, pdf.FontBold, 9, "SAC")
+	doc.Text(420, 245, pdf.FontBold, 9, "Amount ("+inv.Currency+", incl. GST)")
+	doc.Line(left, 252, right, 252, 0.5)
+	doc.Text(left, 270, pdf.FontRegular, 9, inv.Description)
+	doc.Text(330, 270, pdf.FontRegular, 9, inv.SacCode)
+	doc.TextRight(right, 270, 9, formatPaise(inv.TotalAmount+inv.DiscountAmount))
+
+	y = 300.0
+	row := func(label, value string, font pdf.Font) {
+		doc.Text(330, y, font, 9, label)
+		doc.TextRight(right, y, 9, value)
+		y += 15
+	}
+	if inv.DiscountAmount > 0 {
+		row("Discount", "-"+formatPaise(inv.DiscountAmount), pdf.FontRegular)
+	}
+	row("Total", formatPaise(inv.TotalAmount), pdf.FontBold)
+
+	// Tax breakup of the total
+	doc.Line(330, y-5, right, y-5, 0.5)
+	y += 5
+	row("Taxable value", formatPaise(inv.TaxableAmount), pdf.FontRegular)
+	if inv.IgstAmount > 0 {
+		row("IGST @ "+formatRate(inv.GstRate)+"%", formatPaise(inv.IgstAmount), pdf.FontRegular)
+	} else {
+		row("CGST @ "+formatRate(inv.GstRate/2)+"%", formatPaise(inv.CgstAmount), pdf.FontRegular)
+		row("SGST @ "+formatRate(inv.GstRate/2)+"%", formatPaise(inv.SgstAmount), pdf.FontRegular)
+	}
+
+	doc.Text(left, y+30, pdf.FontRegular, 8, "Prices are inclusive of GST. Tax is not payable on reverse charge.")
+	doc.Text(left, y+42, pdf.FontRegular, 8, "This is a computer-generated invoice and needs no signature.")
+
+	return doc.Bytes()
+}
+
+// formatRate formats a percentage without trailing zeros, e.g. 9 or 2.5
+func formatRate(rate float64) string {
+	return strconv.FormatFloat(rate, 'f', -1, 64)
+}
@@ -0,0 +1,439 @@
+// internal/monetization/services/payment_reconciliation_service.go
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	ent "github.com/UnoraApp/be/ent/generated"
+	"github.com/UnoraApp/be/ent/generated/paymentdiscrepancy"
+	"github.com/UnoraApp/be/ent/generated/paymentorder"
+	"github.com/UnoraApp/be/ent/generated/paymentreconciliationreport"
+	"github.com/UnoraApp/be/pkg/database"
+	"github.com/UnoraApp/be/pkg/logger"
+)
+
+const (
+	// orderSweepBatchSize is how many pending orders one sweep polls
+	orderSweepBatchSize = 200
+	// pendingOrderMinAge leaves the client's own verify call time to land
+	// before the sweep polls Razorpay for an order
+	pendingOrderMinAge = 2 * time.Minute
+	// reportDateFormat is the layout of PaymentReconciliationReport.report_date
+	reportDateFormat = "2006-01-02"
+)
+
+// Razorpay payment statuses the reconciliation acts on
+const (
+	razorpayPaymentCreated    = "created"
+	razorpayPaymentAuthorized = "authorized"
+	razorpayPaymentCaptured   = "captured"
+	razorpayPaymentRefunded   = "refunded"
+)
+
+var (
+	// ErrReportDayNotOver is returned when a report is requested for a day
+	// that has not ended yet
+	ErrReportDayNotOver = errors.New("the day is not over yet")
+	ErrReportNotFound   = errors.New("reconciliation report not found")
+)
+
+// OrderSweepResult summarises one sweep of pending orders
+type OrderSweepResult struct {
+	Checked   int
+	Fulfilled int
+	Expired   int
+	// Orders that could not be checked, e.g. because Razorpay was unreachable;
+	// the next sweep tries them again
+	Errors int
+}
+
+// PaymentReconciliationService keeps payment orders in step with Razorpay.
+// A frequent sweep polls orders still awaiting payment, fulfils the ones
+// Razorpay captured and expires the rest once their time is up; it is the
+// safety net for a client that paid but never called verify while the
+// webhook was also lost. A daily report compares a day's orders with the
+// payments Razorpay recorded and stores every discrepancy for review.
+type PaymentReconciliationService struct {
+	entClient      *ent.Client
+	gateway        PaymentGateway
+	paymentService *PaymentService
+}
+
+// NewPaymentReconciliationService creates a new payment reconciliation service
+func NewPaymentReconciliationService(entClient *ent.Client, gateway PaymentGateway, paymentService *PaymentService) *PaymentReconciliationService {
+	return &PaymentReconciliationService{
+		entClient:      entClient,
+		gateway:        gateway,
+		paymentService: paymentService,
+	}
+}
+
+// SweepPendingOrders polls Razorpay for orders still awaiting payment. Created
+// orders are polled until they are fulfilled or expired; failed orders only
+// until their expiry, as the user may retry within the same checkout.
+func (s *PaymentReconciliationService) SweepPendingOrders(ctx context.Context) (*OrderSweepResult, error) {
+	now := time.Now()
+	orders, err := s.entClient.PaymentOrder.
+		Query().
+		Where(paymentorder.CreatedAtLT(now.Add(-pendingOrderMinAge))).
+		Where(paymentorder.Or(
+			paymentorder.OrderStatusEQ(paymentorder.OrderStatusCreated),
+			paymentorder.And(
+				paymentorder.OrderStatusEQ(paymentorder.OrderStatusFailed),
+				paymentorder.ExpiresAtGT(now),
+			),
+		)).
+		Order(ent.Asc(paymentorder.FieldCreatedAt)).
+		Limit(orderSweepBatchSize).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pending orders: %w", err)
+	}
+
+	log := logger.GetLogger("payments")
+	result := &OrderSweepResult{Checked: len(orders)}
+	for _, order := range orders {
+		fulfilled, expired, err := s.checkPendingOrder(ctx, order, now)
+		if err != nil {
+			log.Error().Err(err).Str("order_id", order.ID).Msg("Failed to check pending order")
+			result.Errors++
+			continue
+		}
+		if fulfilled {
+			log.Warn().Str("order_id", order.ID).Msg("Fulfilled captured order missed by verify and webhook")
+			result.Fulfilled++
+		}
+		if expired {
+			result.Expired++
+		}
+	}
+	return result, nil
+}
+
+// checkPendingOrder fulfils an order Razorpay has captured, or expires it when
+// its time is up and no payment is still in progress
+func (s *PaymentReconciliationService) checkPendingOrder(ctx context.Context, order *ent.PaymentOrder, now time.Time) (bool, bool, error) {
+	payments, err := s.gateway.FetchOrderPayments(order.RazorpayOrderID)
+	if err != nil {
+		return false, false, fmt.Errorf("failed to fetch payments: %w", err)
+	}
+
+	inProgress := false
+	for _, p := range payments {
+		switch p.Status {
+		case razorpayPaymentCaptured:
+			_, err := s.paymentService.FulfilOrder(ctx, order, p.ID, "")
+			if errors.Is(err, ErrPaymentAlreadyProcessed) {
+				return false, false, nil
+			}
+			if err != nil {
+				return false, false, err
+			}
+			return true, false, nil
+		case razorpayPaymentCreated, razorpayPaymentAuthorized:
+			inProgress = true
+		}
+	}
+
+	if inProgress || order.OrderStatus != paymentorder.OrderStatusCreated || now.Before(orderExpiry(order)) {
+		return false, false, nil
+	}
+
+	// A payment captured after this still fulfils the order: FulfilOrder
+	// accepts expired orders
+	affected, err := s.entClient.PaymentOrder.
+		Update().
+		Where(paymentorder.IDEQ(order.ID)).
+		Where(paymentorder.OrderStatusEQ(paymentorder.OrderStatusCreated)).
+		SetOrderStatus(paymentorder.OrderStatusExpired).
+		Save(ctx)
+	if err != nil {
+		return false, false, fmt.Errorf("failed to expire order: %w", err)
+	}
+	return false, affected > 0, nil
+}
+
+// orderExpiry is when an order stops waiting for payment
+func orderExpiry(order *ent.PaymentOrder) time.Time {
+	if order.ExpiresAt != nil {
+		return *order.ExpiresAt
+	}
+	return order.CreatedAt.Add(paymentOrderTTL)
+}
+
+// discrepancyRecord is a discrepancy found while building a report
+type discrepancyRecord struct {
+	orderID         *string
+	razorpayOrderID string
+	paymentID       string
+	kind            paymentdiscrepancy.Kind
+	dbStatus        string
+	gatewayStatus   string
+	dbAmount        int
+	gatewayAmount   int
+}
+
+// GenerateDailyReport compares the orders created or paid on day (server time)
+// with the payments Razorpay recorded that day, and stores the result as the
+// report for that date, replacing an earlier run. Payments belonging to
+// subscription invoices are not payment orders and are left out.
+func (s *PaymentReconciliationService) GenerateDailyReport(ctx context.Context, day time.Time) (*ent.PaymentReconciliationReport, error) {
+	start := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, day.Location())
+	end := start.AddDate(0, 0, 1)
+	if end.After(time.Now()) {
+		return nil, ErrReportDayNotOver
+	}
+
+	gatewayPayments, err := s.gateway.ListPayments(start, end)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list razorpay payments: %w", err)
+	}
+
+	// Captured payments per Razorpay order; a refunded payment was captured first
+	gatewayCapturedAmount := 0
+	paymentsByID := make(map[string]RazorpayPayment)
+	captured := make(map[string][]RazorpayPayment)
+	for _, p := range gatewayPayments {
+		if p.InvoiceID != "" {
+			continue
+		}
+		paymentsByID[p.ID] = p
+		if p.Status == razorpayPaymentCaptured || p.Status == razorpayPaymentRefunded {
+			captured[p.OrderID] = append(captured[p.OrderID], p)
+			gatewayCapturedAmount += p.Amount
+		}
+	}
+
+	orders, err := s.entClient.PaymentOrder.
+		Query().
+		Where(paymentorder.Or(
+			paymentorder.And(paymentorder.CreatedAtGTE(start), paymentorder.CreatedAtLT(end)),
+			paymentorder.And(paymentorder.PaidAtGTE(start), paymentorder.PaidAtLT(end)),
+		)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get orders: %w", err)
+	}
+
+	// Orders paid that day may have been created earlier
+	known := make(map[string]bool, len(orders))
+	for _, o := range orders {
+		known[o.RazorpayOrderID] = true
+	}
+	var missing []string
+	for rzpOrderID := range captured {
+		if rzpOrderID != "" && !known[rzpOrderID] {
+			missing = append(missing, rzpOrderID)
+		}
+	}
+	if len(missing) > 0 {
+		earlier, err := s.entClient.PaymentOrder.
+			Query().
+			Where(paymentorder.RazorpayOrderIDIn(missing...)).
+			All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get orders: %w", err)
+		}
+		for _, o := range earlier {
+			known[o.RazorpayOrderID] = true
+		}
+		orders = append(orders, earlier...)
+	}
+
+	var found []discrepancyRecord
+	matched, dbPaidAmount := 0, 0
+	for _, order := range orders {
+		if order.PaidAt != nil && !order.PaidAt.Before(start) && order.PaidAt.Before(end) {
+			dbPaidAmount += order.Amount
+		}
+
+		records, err := s.compareOrder(ctx, order, captured[order.RazorpayOrderID], paymentsByID)
+		if err != nil {
+			return nil, err
+		}
+		if len(records) == 0 {
+			matched++
+		}
+		found = append(found, records...)
+	}
+
+	for rzpOrderID, payments := range captured {
+		if known[rzpOrderID] {
+			continue
+		}
+		for _, p := range payments {
+			found = append(found, discrepancyRecord{
+				razorpayOrderID: rzpOrderID,
+				paymentID:       p.ID,
+				kind:            paymentdiscrepancy.KindUnknownOrder,
+				gatewayStatus:   p.Status,
+				gatewayAmount:   p.Amount,
+			})
+		}
+	}
+
+	var report *ent.PaymentReconciliationReport
+	err = database.WithTx(ctx, s.entClient, func(tx *ent.Tx) error {
+		client := tx.Client()
+		date := start.Format(reportDateFormat)
+
+		// Upserted, so that concurrent runs for a day queue on its row
+		// rather than both finding none and racing to create it
+		err := client.PaymentReconciliationReport.
+			Create().
+			SetID(uuid.New().String()).
+			SetReportDate(date).
+			SetWindowStart(start).
+			SetWindowEnd(end).
+			SetOrdersChecked(len(orders)).
+			SetGatewayPayments(len(paymentsByID)).
+			SetMatched(matched).
+			SetDiscrepancyCount(len(found)).
+			SetDbPaidAmount(dbPaidAmount).
+			SetGatewayCapturedAmount(gatewayCapturedAmount).
+			SetGeneratedAt(time.Now()).
+			OnConflictColumns(paymentreconciliationreport.FieldReportDate).
+			UpdateNewValues().
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to save report: %w", err)
+		}
+
+		report, err = client.PaymentReconciliationReport.
+			Query().
+			Where(paymentreconciliationreport.ReportDateEQ(date)).
+			Only(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get report: %w", err)
+		}
+
+		_, err = client.PaymentDiscrepancy.
+			Delete().
+			Where(paymentdiscrepancy.ReportIDEQ(report.ID)).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to clear discrepancies: %w", err)
+		}
+
+		if len(found) == 0 {
+			return nil
+		}
+		builders := make([]*ent.PaymentDiscrepancyCreate, len(found))
+		for i, d := range found {
+			builders[i] = client.PaymentDiscrepancy.
+				Create().
+				SetID(uuid.New().String()).
+				SetReportID(report.ID).
+				SetNillableOrderID(d.orderID).
+				SetRazorpayOrderID(d.razorpayOrderID).
+				SetNillableRazorpayPaymentID(strPtr(d.paymentID)).
+				SetKind(d.kind).
+				SetDbStatus(d.dbStatus).
+				SetGatewayStatus(d.gatewayStatus).
+				SetDbAmount(d.dbAmount).
+				SetGatewayAmount(d.gatewayAmount)
+		}
+		if err := client.PaymentDiscrepancy.CreateBulk(builders...).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to save discrepancies: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetReport(ctx, report.ReportDate)
+}
+
+// compareOrder checks one order against the payments Razorpay captured for it.
+// A paid order's own payment is fetched when it was captured outside the window.
+func (s *PaymentReconciliationService) compareOrder(ctx context.Context, order *ent.PaymentOrder, captured []RazorpayPayment, paymentsByID map[string]RazorpayPayment) ([]discrepancyRecord, error) {
+	var records []discrepancyRecord
+	record := func(kind paymentdiscrepancy.Kind, p *RazorpayPayment, dbAmount, gatewayAmount int) {
+		d := discrepancyRecord{
+			orderID:         &order.ID,
+			razorpayOrderID: order.RazorpayOrderID,
+			kind:            kind,
+			dbStatus:        string(order.OrderStatus),
+			dbAmount:        dbAmount,
+			gatewayAmount:   gatewayAmount,
+		}
+		if p != nil {
+			d.paymentID = p.ID
+			d.gatewayStatus = p.Status
+		}
+		records = append(records, d)
+	}
+
+	paid := order.OrderStatus == paymentorder.OrderStatusPaid || order.OrderStatus == paymentorder.OrderStatusRefunded
+	if !paid || order.RazorpayPaymentID == nil {
+		// Money taken for an order that never granted credits
+		for i := range captured {
+			record(paymentdiscrepancy.KindCapturedNotFulfilled, &captured[i], order.Amount, captured[i].Amount)
+		}
+		return records, nil
+	}
+
+	paymentID := *order.RazorpayPaymentID
+	payment, ok := paymentsByID[paymentID]
+	if !ok {
+		fetched, err := s.gateway.FetchPayment(paymentID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch payment %s: %w", paymentID, err)
+		}
+		payment = *fetched
+	}
+
+	// A second payment captured against an already paid order
+	for i := range captured {
+		if captured[i].ID != paymentID {
+			record(paymentdiscrepancy.KindCapturedNotFulfilled, &captured[i], 0, captured[i].Amount)
+		}
+	}
+
+	if payment.Status != razorpayPaymentCaptured && payment.Status != razorpayPaymentRefunded {
+		record(paymentdiscrepancy.KindPaidNotCaptured, &payment, order.Amount, 0)
+		return records, nil
+	}
+	if payment.Amount != order.Amount {
+		record(paymentdiscrepancy.KindAmountMismatch, &payment, order.Amount, payment.Amount)
+	}
+
+	refunded, err := committedRefundAmount(ctx, s.entClient, order)
+	if err != nil {
+		return nil, err
+	}
+	if payment.AmountRefunded != refunded {
+		record(paymentdiscrepancy.KindRefundMismatch, &payment, refunded, payment.AmountRefunded)
+	}
+	return records, nil
+}
+
+// GetReport returns the report for a date (YYYY-MM-DD) with its
+// discrepancies, or the latest report when date is empty
+func (s *PaymentReconciliationService) GetReport(ctx context.Context, date string) (*ent.PaymentReconciliationReport, error) {
+	query := s.entClient.PaymentReconciliationReport.
+		Query().
+		WithDiscrepancies(func(q *ent.PaymentDiscrepancyQuery) {
+			q.Order(ent.Asc(paymentdiscrepancy.FieldKind))
+		})
+	if date != "" {
+		query = query.Where(paymentreconciliationreport.ReportDateEQ(date))
+	} else {
+		query = query.Order(ent.Desc(paymentreconciliationreport.FieldReportDate))
+	}
+
+	report, err := query.First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrReportNotFound
+		}
+		return nil, fmt.Errorf("failed to get report: %w", err)
+	}
+	return report, nil
+}
@@ -0,0 +1,60 @@
+// internal/monetization/services/payment_reconciliation_service_test.go
+package services
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/UnoraApp/be/pkg/database/databasetest"
+)
+
+// TestGenerateDailyReportConcurrently runs one day's report several times at
+// once, as overlapping cron runs or an admin re-run would: each must succeed
+// and the day must keep a single report.
+func TestGenerateDailyReportConcurrently(t *testing.T) {
+	ctx := context.Background()
+	client := databasetest.NewClient(t)
+	payments, gateway := newTestPaymentService(client)
+	reconciliation := NewPaymentReconciliationService(client, gateway, payments)
+
+	day := time.Now().AddDate(0, 0, -1)
+
+	const runs = 4
+	var wg sync.WaitGroup
+	start := make(chan struct{})
+	errs := make(chan error, runs)
+	for i := 0; i < runs; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+			_, err := reconciliation.GenerateDailyReport(ctx, day)
+			errs <- err
+		}()
+	}
+	close(start)
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Errorf("GenerateDailyReport: %v", err)
+		}
+	}
+
+	if n := client.PaymentReconciliationReport.Query().CountX(ctx); n != 1 {
+		t.Fatalf("got %d reports, want 1", n)
+	}
+
+	// A re-run replaces the day's report
+	before := client.PaymentReconciliationReport.Query().OnlyX(ctx)
+	report, err := reconciliation.GenerateDailyReport(ctx, day)
+	if err != nil {
+		t.Fatalf("GenerateDailyReport: %v", err)
+	}
+	if report.ID != before.ID || report.ReportDate != before.ReportDate {
+		t.Errorf("re-run report = %s %s, want %s %s", report.ID, report.ReportDate, before.ID, before.ReportDate)
+	}
+}
@@ -3,6 +3,7 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -12,25 +13,43 @@ import (
 	"github.com/UnoraApp/be/ent/generated/credittransaction"
 	"github.com/UnoraApp/be/ent/generated/paymentorder"
 	"github.com/UnoraApp/be/internal/monetization/dto"
+	"github.com/UnoraApp/be/pkg/database"
+	"github.com/UnoraApp/be/pkg/logger"
 )
 
+// Payment errors
+var (
+	ErrOrderNotFound           = errors.New("order not found")
+	ErrPaymentAlreadyProcessed = errors.New("payment already processed")
+	ErrPaymentAmountMismatch   = errors.New("payment amount does not match order")
+)
+
+// paymentOrderTTL is how long a created order waits for payment before the
+// order sweep expires it
+const paymentOrderTTL = 30 * time.Minute
+
 // PaymentService handles payment-related business logic
 type PaymentService struct {
-	entClient       *ent.Client
-	razorpay        *RazorpayClient
-	creditsService  *CreditsService
+	entClient      *ent.Client
+	gateway        PaymentGateway
+	creditsService *CreditsService
+	promoService   *PromoService
+	invoiceService *InvoiceService
 }
 
 // NewPaymentService creates a new payment service
-func NewPaymentService(entClient *ent.Client, razorpay *RazorpayClient, creditsService *CreditsService) *PaymentService {
+func NewPaymentService(entClient *ent.Client, gateway PaymentGateway, creditsService *CreditsService, promoService *PromoService, invoiceService *InvoiceService) *PaymentService {
 	return &PaymentService{
 		entClient:      entClient,
-		razorpay:       razorpay,
+		gateway:       gateway,
 		creditsService: creditsService,
+		promoService:   promoService,
+		invoiceService: invoiceService,
 	}
 }
 
-// CreateOrder creates a Razorpay order for a credit package
+// CreateOrder creates a Razorpay order for a credit package, discounted by
+// the request's promo code if it has one
 func (s *PaymentService) CreateOrder(ctx context.Context, userID string, req *dto.CreateOrderRequest) (*dto.CreateOrderResponse, error) {
 	// Get user
 	user, err := s.entClient.User.Get(ctx, userID)
@@ -48,9 +67,19 @@ func (s *PaymentService) CreateOrder(ctx context.Context, userID string, req *dt
 		return nil, fmt.Errorf("package is not available")
 	}
 
+	// Check the promo code; it is reserved when the order is stored
+	discount := 0
+	if req.PromoCode != "" {
+		discount, err = s.promoService.QuoteDiscount(ctx, userID, req.PromoCode, pkg)
+		if err != nil {
+			return nil, err
+		}
+	}
+	amount := pkg.PriceAmount - discount
+
 	// Create Razorpay order
 	orderInput := &CreateOrderInput{
-		Amount:         pkg.PriceAmount,
+		Amount:         amount,
 		Currency:       pkg.Currency,
 		Receipt:        uuid.New().String(),
 		PartialPayment: false,
@@ -60,7 +89,7 @@ func (s *PaymentService) CreateOrder(ctx context.Context, userID string, req *dt
 		},
 	}
 
-	razorpayOrder, err := s.razorpay.CreateOrder(orderInput)
+	razorpayOrder, err := s.gateway.CreateOrder(orderInput)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create razorpay order: %w", err)
 	}
@@ -68,22 +97,35 @@ func (s *PaymentService) CreateOrder(ctx context.Context, userID string, req *dt
 	// Store order in database
 	orderID := uuid.New().String()
 	totalCredits := pkg.CreditAmount + pkg.BonusCredits
-	expiresAt := time.Now().Add(30 * time.Minute)
-
-	_, err = s.entClient.PaymentOrder.
-		Create().
-		SetID(orderID).
-		SetUserID(userID).
-		SetPackageID(pkg.ID).
-		SetRazorpayOrderID(razorpayOrder.ID).
-		SetAmount(pkg.PriceAmount).
-		SetCurrency(pkg.Currency).
-		SetCreditsToAdd(totalCredits).
-		SetOrderStatus(paymentorder.OrderStatusCreated).
-		SetExpiresAt(expiresAt).
-		Save(ctx)
+	expiresAt := time.Now().Add(paymentOrderTTL)
+
+	err = database.WithTx(ctx, s.entClient, func(tx *ent.Tx) error {
+		client := tx.Client()
+
+		order, err := client.PaymentOrder.
+			Create().
+			SetID(orderID).
+			SetUserID(userID).
+			SetPackageID(pkg.ID).
+			SetRazorpayOrderID(razorpayOrder.ID).
+			SetAmount(amount).
+			SetDiscountAmount(discount).
+			SetCurrency(pkg.Currency).
+			SetCreditsToAdd(totalCredits).
+			SetOrderStatus(paymentorder.OrderStatusCreated).
+			SetExpiresAt(expiresAt).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to save order: %w", err)
+		}
+
+		if req.PromoCode != "" {
+			return s.promoService.ReserveDiscount(ctx, client, userID, req.PromoCode, pkg, order)
+		}
+		return nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to save order: %w", err)
+		return nil, err
 	}
 
 	// Get user contact info
@@ -97,21 +139,22 @@ func (s *PaymentService) CreateOrder(ctx context.Context, userID string, req *dt
 	}
 
 	return &dto.CreateOrderResponse{
-		OrderID:      razorpayOrder.ID,
-		RazorpayKey:  s.razorpay.GetKeyID(),
-		Amount:       pkg.PriceAmount,
-		Currency:     pkg.Currency,
-		PackageName:  pkg.Name,
-		CreditsToAdd: totalCredits,
-		UserEmail:    email,
-		UserPhone:    phone,
+		OrderID:        razorpayOrder.ID,
+		RazorpayKey:    s.gateway.GetKeyID(),
+		Amount:         amount,
+		DiscountAmount: discount,
+		Currency:       pkg.Currency,
+		PackageName:    pkg.Name,
+		CreditsToAdd:   totalCredits,
+		UserEmail:      email,
+		UserPhone:      phone,
 	}, nil
 }
 
 // VerifyPayment verifies Razorpay payment and adds credits
 func (s *PaymentService) VerifyPayment(ctx context.Context, userID string, req *dto.VerifyPaymentRequest) (*dto.VerifyPaymentResponse, error) {
 	// Verify signature
-	if !s.razorpay.VerifyPaymentSignature(req.RazorpayOrderID, req.RazorpayPaymentID, req.RazorpaySignature) {
+	if !s.gateway.VerifyPaymentSignature(req.RazorpayOrderID, req.RazorpayPaymentID, req.RazorpaySignature) {
 		return nil, fmt.Errorf("invalid payment signature")
 	}
 
@@ -125,9 +168,9 @@ func (s *PaymentService) VerifyPayment(ctx context.Context, userID string, req *
 		return nil, fmt.Errorf("order not found: %w", err)
 	}
 
-	// Check if already processed
+	// Already fulfilled, by an earlier verify or by the webhook
 	if order.OrderStatus == paymentorder.OrderStatusPaid {
-		return nil, fmt.Errorf("payment already processed")
+		return s.replayVerify(ctx, order, req.RazorpayPaymentID)
 	}
 
 	// Check if expired
@@ -135,31 +178,20 @@ func (s *PaymentService) VerifyPayment(ctx context.Context, userID string, req *
 		return nil, fmt.Errorf("order has expired")
 	}
 
-	// Update order status
-	now := time.Now()
-	_, err = order.Update().
-		SetOrderStatus(paymentorder.OrderStatusPaid).
-		SetRazorpayPaymentID(req.RazorpayPaymentID).
-		SetRazorpaySignature(req.RazorpaySignature).
-		SetPaidAt(now).
-		Save(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to update order: %w", err)
-	}
-
-	// Add credits to user
-	description := fmt.Sprintf("Purchased credits via Razorpay")
-	tx, err := s.creditsService.AddCredits(
-		ctx,
-		userID,
-		order.CreditsToAdd,
-		credittransaction.TransactionTypePurchase,
-		description,
-		"payment_order",
-		order.ID,
-	)
+	tx, err := s.FulfilOrder(ctx, order, req.RazorpayPaymentID, req.RazorpaySignature)
 	if err != nil {
-		return nil, fmt.Errorf("failed to add credits: %w", err)
+		if errors.Is(err, ErrPaymentAlreadyProcessed) {
+			// Lost the race to a concurrent verify or webhook
+			order, err = s.entClient.PaymentOrder.Get(ctx, order.ID)
+			if err != nil {
+				return nil, fmt.Errorf("order not found: %w", err)
+			}
+			if order.OrderStatus == paymentorder.OrderStatusPaid {
+				return s.replayVerify(ctx, order, req.RazorpayPaymentID)
+			}
+			return nil, ErrPaymentAlreadyProcessed
+		}
+		return nil, err
 	}
 
 	return &dto.VerifyPaymentResponse{
@@ -171,6 +203,35 @@ func (s *PaymentService) VerifyPayment(ctx context.Context, userID string, req *
 	}, nil
 }
 
+// replayVerify returns the original result for a verify of an order that was
+// already paid with the same payment
+func (s *PaymentService) replayVerify(ctx context.Context, order *ent.PaymentOrder, paymentID string) (*dto.VerifyPaymentResponse, error) {
+	if order.RazorpayPaymentID == nil || *order.RazorpayPaymentID != paymentID {
+		return nil, ErrPaymentAlreadyProcessed
+	}
+
+	tx, err := s.creditsService.FindByReference(ctx, "payment_order", order.ID)
+	if err != nil {
+		return nil, err
+	}
+	if tx == nil {
+		return nil, ErrPaymentAlreadyProcessed
+	}
+
+	u, err := s.entClient.User.Get(ctx, order.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("user not found: %w", err)
+	}
+
+	return &dto.VerifyPaymentResponse{
+		Success:       true,
+		CreditsAdded:  tx.CreditAmount,
+		NewBalance:    u.CreditBalance,
+		TransactionID: tx.ID,
+		Message:       fmt.Sprintf("Payment successful! %d credits added.", tx.CreditAmount),
+	}, nil
+}
+
 // GetPaymentOrders returns user's payment order history
 func (s *PaymentService) GetPaymentOrders(ctx context.Context, userID string, limit int) ([]*dto.PaymentOrderResponse, error) {
 	if limit <= 0 {
@@ -193,6 +254,7 @@ func (s *PaymentService) GetPaymentOrders(ctx context.Context, userID string, li
 			ID:              o.ID,
 			RazorpayOrderID: o.RazorpayOrderID,
 			Amount:          o.Amount,
+			DiscountAmount:  o.DiscountAmount,
 			Currency:        o.Currency,
 			CreditsToAdd:    o.CreditsToAdd,
 			Status:          string(o.OrderStatus),
@@ -204,33 +266,96 @@ func (s *PaymentService) GetPaymentOrders(ctx context.Context, userID string, li
 	return result, nil
 }
 
-// HandleWebhook handles Razorpay webhook events
-func (s *PaymentService) HandleWebhook(ctx context.Context, event string, payload map[string]interface{}) error {
-	switch event {
-	case "payment.captured":
-		// Payment was captured successfully
-		// This is handled by VerifyPayment, but webhook provides backup
-		return nil
-	case "payment.failed":
-		// Payment failed
-		paymentEntity, ok := payload["payment"].(map[string]interface{})
-		if !ok {
-			return nil
-		}
-		orderID, ok := paymentEntity["order_id"].(string)
-		if !ok {
-			return nil
-		}
-		reason, _ := paymentEntity["error_description"].(string)
+// FulfilOrder marks an order paid and credits the user as one transaction.
+// It is reached from the client's verify call and from the payment.captured
+// and order.paid webhooks, possibly concurrently: the conditional update on
+// order_status lets exactly one caller win, and the unique ledger reference
+// on (reference_type, reference_id) makes a second credit impossible even so.
+// The GST invoice is issued once the payment is recorded; if that fails it is
+// issued when the user first downloads it.
+func (s *PaymentService) FulfilOrder(ctx context.Context, order *ent.PaymentOrder, paymentID, signature string) (*ent.CreditTransaction, error) {
+	var ledgerTx *ent.CreditTransaction
+	err := database.WithTx(ctx, s.entClient, func(tx *ent.Tx) error {
+		client := tx.Client()
 
-		_, err := s.entClient.PaymentOrder.
+		affected, err := client.PaymentOrder.
 			Update().
-			Where(paymentorder.RazorpayOrderIDEQ(orderID)).
-			SetOrderStatus(paymentorder.OrderStatusFailed).
-			SetFailureReason(reason).
+			Where(paymentorder.IDEQ(order.ID)).
+			Where(paymentorder.OrderStatusIn(
+				paymentorder.OrderStatusCreated,
+				paymentorder.OrderStatusFailed,
+				paymentorder.OrderStatusExpired,
+			)).
+			SetOrderStatus(paymentorder.OrderStatusPaid).
+			SetRazorpayPaymentID(paymentID).
+			SetNillableRazorpaySignature(strPtr(signature)).
+			SetPaidAt(time.Now()).
 			Save(ctx)
-		return err
-	default:
-		return nil
+		if err != nil {
+			return fmt.Errorf("failed to update order: %w", err)
+		}
+		if affected == 0 {
+			return ErrPaymentAlreadyProcessed
+		}
+
+		ledgerTx, err = s.creditsService.applyCredits(
+			ctx,
+			client,
+			order.UserID,
+			order.CreditsToAdd,
+			credittransaction.TransactionTypePurchase,
+			"Purchased credits via Razorpay",
+			"payment_order",
+			order.ID,
+			"",
+		)
+		if err != nil {
+			if ent.IsConstraintError(err) {
+				return ErrPaymentAlreadyProcessed
+			}
+			return fmt.Errorf("failed to add credits: %w", err)
+		}
+
+		return redeemOrderDiscount(ctx, client, order.ID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.invoiceService.IssueForOrder(ctx, order.ID); err != nil {
+		log := logger.GetLogger("payments")
+		log.Error().Err(err).Str("order_id", order.ID).Msg("Failed to issue invoice")
+	}
+	return ledgerTx, nil
+}
+
+// GetOrderByRazorpayOrderID returns the order for a Razorpay order ID
+func (s *PaymentService) GetOrderByRazorpayOrderID(ctx context.Context, razorpayOrderID string) (*ent.PaymentOrder, error) {
+	order, err := s.entClient.PaymentOrder.
+		Query().
+		Where(paymentorder.RazorpayOrderIDEQ(razorpayOrderID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrOrderNotFound
+		}
+		return nil, fmt.Errorf("failed to get order: %w", err)
+	}
+	return order, nil
+}
+
+// MarkOrderFailed records a failed payment attempt. Only orders still awaiting
+// payment are touched, so a failed retry never overrides a successful payment.
+func (s *PaymentService) MarkOrderFailed(ctx context.Context, razorpayOrderID, reason string) (bool, error) {
+	affected, err := s.entClient.PaymentOrder.
+		Update().
+		Where(paymentorder.RazorpayOrderIDEQ(razorpayOrderID)).
+		Where(paymentorder.OrderStatusEQ(paymentorder.OrderStatusCreated)).
+		SetOrderStatus(paymentorder.OrderStatusFailed).
+		SetNillableFailureReason(strPtr(reason)).
+		Save(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to update order: %w", err)
 	}
+	return affected > 0, nil
 }
@@ -0,0 +1,164 @@
+// internal/monetization/services/payment_service_test.go
+package services
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+
+	ent "github.com/UnoraApp/be/ent/generated"
+	"github.com/UnoraApp/be/ent/generated/credittransaction"
+	"github.com/UnoraApp/be/ent/generated/ledgerentry"
+	"github.com/UnoraApp/be/ent/generated/paymentorder"
+	"github.com/UnoraApp/be/internal/config"
+	"github.com/UnoraApp/be/internal/monetization/dto"
+	"github.com/UnoraApp/be/pkg/database/databasetest"
+	"github.com/UnoraApp/be/pkg/storage"
+)
+
+// memStorage keeps uploaded objects in memory
+type memStorage struct {
+	storage.Client
+
+	mu      sync.Mutex
+	objects map[string][]byte
+}
+
+func (m *memStorage) Upload(ctx context.Context, objectName string, reader io.Reader, size int64, contentType string) (string, error) {
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return "", err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.objects == nil {
+		m.objects = make(map[string][]byte)
+	}
+	m.objects[objectName] = data
+	return objectName, nil
+}
+
+func (m *memStorage) Download(ctx context.Context, objectName string) (io.ReadCloser, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	data, ok := m.objects[objectName]
+	if !ok {
+		return nil, errors.New("object not found")
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+// newTestPaymentService returns a payment service on the fake gateway
+func newTestPaymentService(client *ent.Client) (*PaymentService, *FakeGateway) {
+	gateway := NewFakeGateway(&FakeGatewayConfig{})
+	creditsService := NewCreditsService(client)
+	invoiceService := NewInvoiceService(client, &memStorage{}, &config.InvoiceConfig{
+		SellerName:      "Unora",
+		SellerAddress:   "Bengaluru, Karnataka",
+		SellerGSTIN:     "29ABCDE1234F1Z5",
+		SellerStateCode: "29",
+		SACCode:         "998439",
+		GSTRatePercent:  18,
+		NumberPrefix:    "TST",
+	})
+	return NewPaymentService(client, gateway, creditsService, NewPromoService(client, creditsService), invoiceService), gateway
+}
+
+// TestFulfilOrderConcurrently races the client's verify calls against the
+// payment webhooks for one paid order: the user must be credited once.
+func TestFulfilOrderConcurrently(t *testing.T) {
+	ctx := context.Background()
+	client := databasetest.NewClient(t)
+	payments, gateway := newTestPaymentService(client)
+
+	u := client.User.Create().SetID(uuid.New().String()).SaveX(ctx)
+	pkg := client.CreditPackage.
+		Create().
+		SetID(uuid.New().String()).
+		SetName("Starter").
+		SetCreditAmount(50).
+		SetBonusCredits(5).
+		SetPriceAmount(9900).
+		SaveX(ctx)
+
+	created, err := payments.CreateOrder(ctx, u.ID, &dto.CreateOrderRequest{PackageID: pkg.ID})
+	if err != nil {
+		t.Fatalf("CreateOrder: %v", err)
+	}
+	paid, err := gateway.SimulatePayment(ctx, created.OrderID, FakePaymentSuccess)
+	if err != nil {
+		t.Fatalf("SimulatePayment: %v", err)
+	}
+	order, err := payments.GetOrderByRazorpayOrderID(ctx, created.OrderID)
+	if err != nil {
+		t.Fatalf("GetOrderByRazorpayOrderID: %v", err)
+	}
+
+	const callers = 16
+	var wg sync.WaitGroup
+	start := make(chan struct{})
+	errs := make(chan error, callers)
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func(verify bool) {
+			defer wg.Done()
+			<-start
+
+			if verify {
+				// The app's verify call after Checkout
+				resp, err := payments.VerifyPayment(ctx, u.ID, &dto.VerifyPaymentRequest{
+					RazorpayOrderID:   paid.OrderID,
+					RazorpayPaymentID: paid.PaymentID,
+					RazorpaySignature: paid.Signature,
+				})
+				if err == nil && resp.NewBalance != order.CreditsToAdd {
+					err = errors.New("verify returned a balance other than the order's credits")
+				}
+				errs <- err
+				return
+			}
+
+			// A payment.captured or order.paid webhook
+			_, err := payments.FulfilOrder(ctx, order, paid.PaymentID, "")
+			if errors.Is(err, ErrPaymentAlreadyProcessed) {
+				err = nil
+			}
+			errs <- err
+		}(i%2 == 0)
+	}
+	close(start)
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Errorf("concurrent fulfilment: %v", err)
+		}
+	}
+
+	ledger := client.CreditTransaction.
+		Query().
+		Where(credittransaction.ReferenceTypeEQ("payment_order")).
+		Where(credittransaction.ReferenceIDEQ(order.ID)).
+		AllX(ctx)
+	if len(ledger) != 1 {
+		t.Fatalf("got %d payment_order ledger rows, want 1", len(ledger))
+	}
+	if n := client.LedgerEntry.Query().Where(ledgerentry.TransactionIDEQ(ledger[0].ID)).CountX(ctx); n != 2 {
+		t.Errorf("got %d ledger entries for the purchase, want 2", n)
+	}
+
+	if balance := client.User.GetX(ctx, u.ID).CreditBalance; balance != order.CreditsToAdd {
+		t.Errorf("balance = %d, want %d", balance, order.CreditsToAdd)
+	}
+
+	fulfilled := client.PaymentOrder.GetX(ctx, order.ID)
+	if fulfilled.OrderStatus != paymentorder.OrderStatusPaid {
+		t.Errorf("order status = %s, want paid", fulfilled.OrderStatus)
+	}
+}
@@ -0,0 +1,432 @@
+// internal/monetization/services/promo_service.go
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	ent "github.com/UnoraApp/be/ent/generated"
+	"github.com/UnoraApp/be/ent/generated/creditpackage"
+	"github.com/UnoraApp/be/ent/generated/credittransaction"
+	"github.com/UnoraApp/be/ent/generated/promocode"
+	"github.com/UnoraApp/be/ent/generated/promoredemption"
+	"github.com/UnoraApp/be/pkg/database"
+)
+
+// Promo code errors
+var (
+	ErrPromoCodeNotFound      = errors.New("promo code not found")
+	ErrPromoCodeExists        = errors.New("promo code already exists")
+	ErrInvalidPromoCode       = errors.New("invalid promo code")
+	ErrPromoCodeUnavailable   = errors.New("promo code is not active")
+	ErrPromoCodeExhausted     = errors.New("promo code has been fully redeemed")
+	ErrPromoCodeLimitReached  = errors.New("promo code already used")
+	ErrPromoCodeNotApplicable = errors.New("promo code does not apply")
+)
+
+// minOrderAmount is the smallest amount Razorpay accepts for an order (₹1);
+// a discount never takes an order below it
+const minOrderAmount = 100
+
+var promoCodePattern = regexp.MustCompile(`^[A-Z0-9_-]{3,32}$`)
+
+// PromoService manages promo codes and their redemptions. Credit codes are
+// redeemed directly; package discounts are reserved by a payment order and
+// redeemed when the order is paid.
+type PromoService struct {
+	entClient      *ent.Client
+	creditsService *CreditsService
+}
+
+// NewPromoService creates a new promo service
+func NewPromoService(entClient *ent.Client, creditsService *CreditsService) *PromoService {
+	return &PromoService{
+		entClient:      entClient,
+		creditsService: creditsService,
+	}
+}
+
+// PromoCodeInput describes a new promo code
+type PromoCodeInput struct {
+	Code            string
+	Description     string
+	Kind            string
+	CreditAmount    int
+	DiscountPercent int
+	PackageID       string
+	MaxRedemptions  *int
+	PerUserLimit    int
+	StartsAt        *time.Time
+	ExpiresAt       *time.Time
+}
+
+// PromoCodeUpdate holds the changes to a promo code; nil fields are left as
+// they are. The code, kind and amounts are fixed once created.
+type PromoCodeUpdate struct {
+	Description    *string
+	MaxRedemptions *int
+	PerUserLimit   *int
+	StartsAt       *time.Time
+	ExpiresAt      *time.Time
+	IsActive       *bool
+}
+
+// NormalizePromoCode returns the stored form of a code
+func NormalizePromoCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// CreatePromoCode creates a promo code
+func (s *PromoService) CreatePromoCode(ctx context.Context, in *PromoCodeInput) (*ent.PromoCode, error) {
+	code := NormalizePromoCode(in.Code)
+	if !promoCodePattern.MatchString(code) {
+		return nil, fmt.Errorf("%w: code must be 3-32 letters, digits, '-' or '_'", ErrInvalidPromoCode)
+	}
+
+	perUserLimit := in.PerUserLimit
+	if perUserLimit == 0 {
+		perUserLimit = 1
+	}
+	if perUserLimit < 0 {
+		return nil, fmt.Errorf("%w: perUserLimit must be positive", ErrInvalidPromoCode)
+	}
+	if in.MaxRedemptions != nil && *in.MaxRedemptions <= 0 {
+		return nil, fmt.Errorf("%w: maxRedemptions must be positive", ErrInvalidPromoCode)
+	}
+	if in.StartsAt != nil && in.ExpiresAt != nil && !in.ExpiresAt.After(*in.StartsAt) {
+		return nil, fmt.Errorf("%w: expiresAt must be after startsAt", ErrInvalidPromoCode)
+	}
+
+	create := s.entClient.PromoCode.
+		Create().
+		SetID(uuid.New().String()).
+		SetCode(code).
+		SetNillableDescription(strPtr(in.Description)).
+		SetNillableMaxRedemptions(in.MaxRedemptions).
+		SetPerUserLimit(perUserLimit).
+		SetNillableStartsAt(in.StartsAt).
+		SetNillableExpiresAt(in.ExpiresAt)
+
+	switch promocode.Kind(in.Kind) {
+	case promocode.KindCredits:
+		if in.CreditAmount <= 0 {
+			return nil, fmt.Errorf("%w: creditAmount must be positive", ErrInvalidPromoCode)
+		}
+		create.SetKind(promocode.KindCredits).SetCreditAmount(in.CreditAmount)
+	case promocode.KindPackageDiscount:
+		if in.DiscountPercent < 1 || in.DiscountPercent > 100 {
+			return nil, fmt.Errorf("%w: discountPercent must be between 1 and 100", ErrInvalidPromoCode)
+		}
+		if in.PackageID != "" {
+			exists, err := s.entClient.CreditPackage.Query().Where(creditpackage.IDEQ(in.PackageID)).Exist(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get package: %w", err)
+			}
+			if !exists {
+				return nil, fmt.Errorf("%w: package not found", ErrInvalidPromoCode)
+			}
+		}
+		create.SetKind(promocode.KindPackageDiscount).
+			SetDiscountPercent(in.DiscountPercent).
+			SetNillablePackageID(strPtr(in.PackageID))
+	default:
+		return nil, fmt.Errorf("%w: kind must be credits or package_discount", ErrInvalidPromoCode)
+	}
+
+	promo, err := create.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, ErrPromoCodeExists
+		}
+		return nil, fmt.Errorf("failed to create promo code: %w", err)
+	}
+	return promo, nil
+}
+
+// UpdatePromoCode changes a promo code's limits, window or status
+func (s *PromoService) UpdatePromoCode(ctx context.Context, id string, upd *PromoCodeUpdate) (*ent.PromoCode, error) {
+	promo, err := s.entClient.PromoCode.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrPromoCodeNotFound
+		}
+		return nil, fmt.Errorf("failed to get promo code: %w", err)
+	}
+
+	update := promo.Update()
+
+	if upd.Description != nil {
+		if *upd.Description == "" {
+			update.ClearDescription()
+		} else {
+			update.SetDescription(*upd.Description)
+		}
+	}
+	if upd.MaxRedemptions != nil {
+		// Zero removes the cap
+		switch {
+		case *upd.MaxRedemptions < 0:
+			return nil, fmt.Errorf("%w: maxRedemptions must not be negative", ErrInvalidPromoCode)
+		case *upd.MaxRedemptions == 0:
+			update.ClearMaxRedemptions()
+		default:
+			update.SetMaxRedemptions(*upd.MaxRedemptions)
+		}
+	}
+	if upd.PerUserLimit != nil {
+		if *upd.PerUserLimit <= 0 {
+			return nil, fmt.Errorf("%w: perUserLimit must be positive", ErrInvalidPromoCode)
+		}
+		update.SetPerUserLimit(*upd.PerUserLimit)
+	}
+	if upd.StartsAt != nil {
+		update.SetStartsAt(*upd.StartsAt)
+	}
+	if upd.ExpiresAt != nil {
+		update.SetExpiresAt(*upd.ExpiresAt)
+	}
+	if upd.IsActive != nil {
+		update.SetIsActive(*upd.IsActive)
+	}
+
+	promo, err = update.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update promo code: %w", err)
+	}
+	return promo, nil
+}
+
+// ListPromoCodes returns promo codes, newest first
+func (s *PromoService) ListPromoCodes(ctx context.Context, includeInactive bool) ([]*ent.PromoCode, error) {
+	query := s.entClient.PromoCode.Query()
+	if !includeInactive {
+		query = query.Where(promocode.IsActiveEQ(true))
+	}
+	promos, err := query.Order(ent.Desc(promocode.FieldCreatedAt)).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get promo codes: %w", err)
+	}
+	return promos, nil
+}
+
+// CountRedemptions returns how many times a promo code has been redeemed
+func (s *PromoService) CountRedemptions(ctx context.Context, promoCodeID string) (int, error) {
+	count, err := s.entClient.PromoRedemption.
+		Query().
+		Where(promoredemption.PromoCodeIDEQ(promoCodeID)).
+		Where(promoredemption.RedemptionStatusEQ(promoredemption.RedemptionStatusRedeemed)).
+		Count(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count redemptions: %w", err)
+	}
+	return count, nil
+}
+
+// RedeemCredits redeems a credit promo code for the user
+func (s *PromoService) RedeemCredits(ctx context.Context, userID, code string) (*ent.CreditTransaction, error) {
+	var ledgerTx *ent.CreditTransaction
+	err := database.WithTx(ctx, s.entClient, func(tx *ent.Tx) error {
+		client := tx.Client()
+		now := time.Now()
+
+		promo, err := lockPromoCode(ctx, client, code)
+		if err != nil {
+			return err
+		}
+		if promo.Kind != promocode.KindCredits {
+			return fmt.Errorf("%w: use it when buying a credit package", ErrPromoCodeNotApplicable)
+		}
+		if err := checkPromoAvailable(ctx, client, promo, userID, now); err != nil {
+			return err
+		}
+
+		redemption, err := client.PromoRedemption.
+			Create().
+			SetID(uuid.New().String()).
+			SetPromoCodeID(promo.ID).
+			SetUserID(userID).
+			SetRedemptionStatus(promoredemption.RedemptionStatusRedeemed).
+			SetCreditsGranted(promo.CreditAmount).
+			SetRedeemedAt(now).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to record redemption: %w", err)
+		}
+
+		ledgerTx, err = s.creditsService.applyCredits(
+			ctx,
+			client,
+			userID,
+			promo.CreditAmount,
+			credittransaction.TransactionTypePromoCode,
+			fmt.Sprintf("Promo code %s", promo.Code),
+			"promo_redemption",
+			redemption.ID,
+			"",
+		)
+		if err != nil {
+			return fmt.Errorf("failed to add credits: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ledgerTx, nil
+}
+
+// QuoteDiscount checks that a discount code applies to the package and returns
+// the amount it takes off. Nothing is reserved; ReserveDiscount re-checks the
+// limits under lock when the order is stored.
+func (s *PromoService) QuoteDiscount(ctx context.Context, userID, code string, pkg *ent.CreditPackage) (int, error) {
+	promo, err := s.entClient.PromoCode.
+		Query().
+		Where(promocode.CodeEQ(NormalizePromoCode(code))).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return 0, ErrPromoCodeNotFound
+		}
+		return 0, fmt.Errorf("failed to get promo code: %w", err)
+	}
+	if err := checkDiscountApplies(promo, pkg); err != nil {
+		return 0, err
+	}
+	if err := checkPromoAvailable(ctx, s.entClient, promo, userID, time.Now()); err != nil {
+		return 0, err
+	}
+	return discountAmount(promo, pkg.PriceAmount), nil
+}
+
+// ReserveDiscount records a pending redemption of a discount code for an order.
+// It counts against the code's limits until the order is paid or expires. The
+// client must be bound to the transaction that stores the order.
+func (s *PromoService) ReserveDiscount(ctx context.Context, client *ent.Client, userID, code string, pkg *ent.CreditPackage, order *ent.PaymentOrder) error {
+	now := time.Now()
+
+	promo, err := lockPromoCode(ctx, client, code)
+	if err != nil {
+		return err
+	}
+	if err := checkDiscountApplies(promo, pkg); err != nil {
+		return err
+	}
+	if err := checkPromoAvailable(ctx, client, promo, userID, now); err != nil {
+		return err
+	}
+
+	_, err = client.PromoRedemption.
+		Create().
+		SetID(uuid.New().String()).
+		SetPromoCodeID(promo.ID).
+		SetUserID(userID).
+		SetOrderID(order.ID).
+		SetDiscountAmount(order.DiscountAmount).
+		SetNillableExpiresAt(order.ExpiresAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to reserve promo code: %w", err)
+	}
+	return nil
+}
+
+// redeemOrderDiscount marks the discount reserved by a paid order as redeemed.
+// A payment captured after its order expired still redeems the code, since the
+// user paid the discounted price.
+func redeemOrderDiscount(ctx context.Context, client *ent.Client, orderID string) error {
+	_, err := client.PromoRedemption.
+		Update().
+		Where(promoredemption.OrderIDEQ(orderID)).
+		Where(promoredemption.RedemptionStatusEQ(promoredemption.RedemptionStatusPending)).
+		SetRedemptionStatus(promoredemption.RedemptionStatusRedeemed).
+		SetRedeemedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to redeem promo code: %w", err)
+	}
+	return nil
+}
+
+// lockPromoCode reads a promo code with SELECT ... FOR UPDATE, so concurrent
+// redemptions are counted one at a time
+func lockPromoCode(ctx context.Context, client *ent.Client, code string) (*ent.PromoCode, error) {
+	promo, err := client.PromoCode.
+		Query().
+		Where(promocode.CodeEQ(NormalizePromoCode(code))).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrPromoCodeNotFound
+		}
+		return nil, fmt.Errorf("failed to get promo code: %w", err)
+	}
+	return promo, nil
+}
+
+// checkPromoAvailable checks a code's window and limits. Redeemed uses count,
+// as do pending reservations until their order expires.
+func checkPromoAvailable(ctx context.Context, client *ent.Client, promo *ent.PromoCode, userID string, now time.Time) error {
+	if !promo.IsActive ||
+		(promo.StartsAt != nil && now.Before(*promo.StartsAt)) ||
+		(promo.ExpiresAt != nil && !now.Before(*promo.ExpiresAt)) {
+		return ErrPromoCodeUnavailable
+	}
+
+	live := client.PromoRedemption.
+		Query().
+		Where(promoredemption.PromoCodeIDEQ(promo.ID)).
+		Where(promoredemption.Or(
+			promoredemption.RedemptionStatusEQ(promoredemption.RedemptionStatusRedeemed),
+			promoredemption.ExpiresAtGT(now),
+		))
+
+	if promo.MaxRedemptions != nil {
+		total, err := live.Clone().Count(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to count redemptions: %w", err)
+		}
+		if total >= *promo.MaxRedemptions {
+			return ErrPromoCodeExhausted
+		}
+	}
+
+	used, err := live.Where(promoredemption.UserIDEQ(userID)).Count(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to count redemptions: %w", err)
+	}
+	if used >= promo.PerUserLimit {
+		return ErrPromoCodeLimitReached
+	}
+	return nil
+}
+
+// checkDiscountApplies checks that a code is a discount valid for the package
+func checkDiscountApplies(promo *ent.PromoCode, pkg *ent.CreditPackage) error {
+	if promo.Kind != promocode.KindPackageDiscount {
+		return fmt.Errorf("%w: redeem it from the credits screen", ErrPromoCodeNotApplicable)
+	}
+	if promo.PackageID != nil && *promo.PackageID != pkg.ID {
+		return fmt.Errorf("%w: not valid for this package", ErrPromoCodeNotApplicable)
+	}
+	return nil
+}
+
+// discountAmount is the discount in paise, never taking the price below
+// minOrderAmount
+func discountAmount(promo *ent.PromoCode, price int) int {
+	discount := price * promo.DiscountPercent / 100
+	if price-discount < minOrderAmount {
+		discount = price - minOrderAmount
+	}
+	if discount < 0 {
+		return 0
+	}
+	return discount
+}
@@ -6,6 +6,14 @@ import "github.com/google/wire"
 // ProviderSet is the wire provider set for monetization services
 var ProviderSet = wire.NewSet(
 	NewCreditsService,
-	NewRazorpayClient,
+	NewPaymentGateway,
 	NewPaymentService,
+	NewWebhookService,
+	NewSubscriptionService,
+	NewReconciliationService,
+	NewRefundService,
+	NewPaymentReconciliationService,
+	NewPromoService,
+	NewRewardsService,
+	NewInvoiceService,
 )
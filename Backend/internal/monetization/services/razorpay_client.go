@@ -15,30 +15,48 @@ import (
 
 // RazorpayClient handles Razorpay API interactions
 type RazorpayClient struct {
-	keyID     string
-	keySecret string
-	baseURL   string
-	client    *http.Client
+	keyID         string
+	keySecret     string
+	webhookSecret string
+	baseURL       string
+	client        *http.Client
 }
 
 // RazorpayConfig holds Razorpay configuration
 type RazorpayConfig struct {
 	KeyID     string
 	KeySecret string
+	// WebhookSecret is set per webhook in the Razorpay dashboard and is
+	// distinct from the API key secret
+	WebhookSecret string
+	// BaseURL overrides the Razorpay API endpoint, e.g. to point at a local
+	// fake server; empty means the live API
+	BaseURL string
 }
 
 // NewRazorpayClient creates a new Razorpay client
 func NewRazorpayClient(cfg *RazorpayConfig) *RazorpayClient {
+	baseURL := "https://api.razorpay.com/v1"
+	if cfg.BaseURL != "" {
+		baseURL = strings.TrimRight(cfg.BaseURL, "/")
+	}
+
 	return &RazorpayClient{
-		keyID:     cfg.KeyID,
-		keySecret: cfg.KeySecret,
-		baseURL:   "https://api.razorpay.com/v1",
+		keyID:         cfg.KeyID,
+		keySecret:     cfg.KeySecret,
+		webhookSecret: cfg.WebhookSecret,
+		baseURL:       baseURL,
 		client: &http.Client{
 			Timeout: 30 * time.Second,
 		},
 	}
 }
 
+// Name identifies the provider
+func (c *RazorpayClient) Name() string {
+	return PaymentProviderRazorpay
+}
+
 // GetKeyID returns the public key ID for frontend
 func (c *RazorpayClient) GetKeyID() string {
 	return c.keyID
@@ -67,6 +85,54 @@ type RazorpayOrder struct {
 	CreatedAt     int64  `json:"created_at"`
 }
 
+// RazorpayPayment is a Razorpay payment entity
+type RazorpayPayment struct {
+	ID               string `json:"id"`
+	Entity           string `json:"entity"`
+	Amount           int    `json:"amount"`
+	Currency         string `json:"currency"`
+	Status           string `json:"status"`
+	OrderID          string `json:"order_id"`
+	InvoiceID        string `json:"invoice_id"`
+	Method           string `json:"method"`
+	Captured         bool   `json:"captured"`
+	AmountRefunded   int    `json:"amount_refunded"`
+	ErrorCode        string `json:"error_code"`
+	ErrorDescription string `json:"error_description"`
+	CreatedAt        int64  `json:"created_at"`
+}
+
+// RazorpayRefund is a Razorpay refund entity
+type RazorpayRefund struct {
+	ID        string        `json:"id"`
+	Entity    string        `json:"entity"`
+	Amount    int           `json:"amount"`
+	Currency  string        `json:"currency"`
+	PaymentID string        `json:"payment_id"`
+	Notes     RazorpayNotes `json:"notes"`
+	Receipt   string        `json:"receipt"`
+	Status    string        `json:"status"`
+	CreatedAt int64         `json:"created_at"`
+}
+
+// RazorpayNotes are the key-value notes attached to a Razorpay entity.
+// Razorpay sends an empty JSON array rather than an object when there are none.
+type RazorpayNotes map[string]string
+
+// UnmarshalJSON accepts both an object and the empty array
+func (n *RazorpayNotes) UnmarshalJSON(data []byte) error {
+	if strings.HasPrefix(strings.TrimSpace(string(data)), "[") {
+		*n = nil
+		return nil
+	}
+	var notes map[string]string
+	if err := json.Unmarshal(data, &notes); err != nil {
+		return err
+	}
+	*n = notes
+	return nil
+}
+
 // CreateOrder creates a new Razorpay order
 func (c *RazorpayClient) CreateOrder(input *CreateOrderInput) (*RazorpayOrder, error) {
 	body, err := json.Marshal(input)
@@ -114,34 +180,201 @@ func (c *RazorpayClient) VerifyPaymentSignature(orderID, paymentID, signature st
 	return hmac.Equal([]byte(expectedSignature), []byte(signature))
 }
 
+// VerifyWebhookSignature verifies the X-Razorpay-Signature header, an HMAC-SHA256
+// of the raw request body keyed with the webhook secret. Without a configured
+// secret every delivery is rejected.
+func (c *RazorpayClient) VerifyWebhookSignature(body []byte, signature string) bool {
+	if c.webhookSecret == "" || signature == "" {
+		return false
+	}
+	h := hmac.New(sha256.New, []byte(c.webhookSecret))
+	h.Write(body)
+	expectedSignature := hex.EncodeToString(h.Sum(nil))
+	return hmac.Equal([]byte(expectedSignature), []byte(signature))
+}
+
 // FetchPayment fetches payment details from Razorpay
-func (c *RazorpayClient) FetchPayment(paymentID string) (map[string]interface{}, error) {
-	req, err := http.NewRequest("GET", c.baseURL+"/payments/"+paymentID, nil)
+func (c *RazorpayClient) FetchPayment(paymentID string) (*RazorpayPayment, error) {
+	var payment RazorpayPayment
+	if err := c.doRequest("GET", "/payments/"+paymentID, nil, &payment); err != nil {
+		return nil, err
+	}
+	return &payment, nil
+}
+
+// razorpayPaymentCollection is a page of payments from a Razorpay list API
+type razorpayPaymentCollection struct {
+	Count int               `json:"count"`
+	Items []RazorpayPayment `json:"items"`
+}
+
+// FetchOrderPayments fetches every payment attempt made against an order
+func (c *RazorpayClient) FetchOrderPayments(orderID string) ([]RazorpayPayment, error) {
+	var payments razorpayPaymentCollection
+	if err := c.doRequest("GET", "/orders/"+orderID+"/payments", nil, &payments); err != nil {
+		return nil, err
+	}
+	return payments.Items, nil
+}
+
+// razorpayMaxPageSize is the largest page the Razorpay list APIs return
+const razorpayMaxPageSize = 100
+
+// ListPayments fetches every payment created in [from, to), page by page
+func (c *RazorpayClient) ListPayments(from, to time.Time) ([]RazorpayPayment, error) {
+	var all []RazorpayPayment
+	for skip := 0; ; skip += razorpayMaxPageSize {
+		// Razorpay's to bound is inclusive
+		path := fmt.Sprintf("/payments?from=%d&to=%d&count=%d&skip=%d",
+			from.Unix(), to.Unix()-1, razorpayMaxPageSize, skip)
+
+		var page razorpayPaymentCollection
+		if err := c.doRequest("GET", path, nil, &page); err != nil {
+			return nil, err
+		}
+		all = append(all, page.Items...)
+		if len(page.Items) < razorpayMaxPageSize {
+			return all, nil
+		}
+	}
+}
+
+// CreateRefundInput is the request to Razorpay create refund API
+type CreateRefundInput struct {
+	// Amount in paise; the full captured amount when zero
+	Amount  int               `json:"amount,omitempty"`
+	Speed   string            `json:"speed,omitempty"`
+	Receipt string            `json:"receipt,omitempty"`
+	Notes   map[string]string `json:"notes,omitempty"`
+}
+
+// CreateRefund refunds a captured payment, fully or partially
+func (c *RazorpayClient) CreateRefund(paymentID string, input *CreateRefundInput) (*RazorpayRefund, error) {
+	var refund RazorpayRefund
+	if err := c.doRequest("POST", "/payments/"+paymentID+"/refund", input, &refund); err != nil {
+		return nil, err
+	}
+	return &refund, nil
+}
+
+// CreateSubscriptionInput is the request to Razorpay create subscription API
+type CreateSubscriptionInput struct {
+	PlanID         string            `json:"plan_id"`
+	TotalCount     int               `json:"total_count"`
+	Quantity       int               `json:"quantity,omitempty"`
+	CustomerNotify int               `json:"customer_notify"`
+	Notes          map[string]string `json:"notes,omitempty"`
+}
+
+// RazorpaySubscription is a Razorpay subscription entity
+type RazorpaySubscription struct {
+	ID           string `json:"id"`
+	Entity       string `json:"entity"`
+	PlanID       string `json:"plan_id"`
+	CustomerID   string `json:"customer_id"`
+	Status       string `json:"status"`
+	CurrentStart int64  `json:"current_start"`
+	CurrentEnd   int64  `json:"current_end"`
+	EndedAt      int64  `json:"ended_at"`
+	ChargeAt     int64  `json:"charge_at"`
+	TotalCount   int    `json:"total_count"`
+	PaidCount    int    `json:"paid_count"`
+	ShortURL     string `json:"short_url"`
+	CreatedAt    int64  `json:"created_at"`
+}
+
+// CreateSubscription creates a Razorpay subscription for a plan. The customer
+// authorises the mandate through the returned short URL or Checkout.
+func (c *RazorpayClient) CreateSubscription(input *CreateSubscriptionInput) (*RazorpaySubscription, error) {
+	var sub RazorpaySubscription
+	if err := c.doRequest("POST", "/subscriptions", input, &sub); err != nil {
+		return nil, err
+	}
+	return &sub, nil
+}
+
+// FetchSubscription fetches subscription details from Razorpay
+func (c *RazorpayClient) FetchSubscription(subscriptionID string) (*RazorpaySubscription, error) {
+	var sub RazorpaySubscription
+	if err := c.doRequest("GET", "/subscriptions/"+subscriptionID, nil, &sub); err != nil {
+		return nil, err
+	}
+	return &sub, nil
+}
+
+// CancelSubscription cancels a subscription, either now or at the end of the
+// current billing cycle
+func (c *RazorpayClient) CancelSubscription(subscriptionID string, atCycleEnd bool) (*RazorpaySubscription, error) {
+	body := map[string]int{"cancel_at_cycle_end": 0}
+	if atCycleEnd {
+		body["cancel_at_cycle_end"] = 1
+	}
+
+	var sub RazorpaySubscription
+	if err := c.doRequest("POST", "/subscriptions/"+subscriptionID+"/cancel", body, &sub); err != nil {
+		return nil, err
+	}
+	return &sub, nil
+}
+
+// PauseSubscription pauses an active subscription immediately
+func (c *RazorpayClient) PauseSubscription(subscriptionID string) (*RazorpaySubscription, error) {
+	var sub RazorpaySubscription
+	body := map[string]string{"pause_at": "now"}
+	if err := c.doRequest("POST", "/subscriptions/"+subscriptionID+"/pause", body, &sub); err != nil {
+		return nil, err
+	}
+	return &sub, nil
+}
+
+// ResumeSubscription resumes a paused subscription immediately
+func (c *RazorpayClient) ResumeSubscription(subscriptionID string) (*RazorpaySubscription, error) {
+	var sub RazorpaySubscription
+	body := map[string]string{"resume_at": "now"}
+	if err := c.doRequest("POST", "/subscriptions/"+subscriptionID+"/resume", body, &sub); err != nil {
+		return nil, err
+	}
+	return &sub, nil
+}
+
+// doRequest sends an authenticated JSON request and decodes the response into out
+func (c *RazorpayClient) doRequest(method, path string, in, out interface{}) error {
+	var reqBody io.Reader
+	if in != nil {
+		body, err := json.Marshal(in)
+		if err != nil {
+			return fmt.Errorf("failed to marshal request: %w", err)
+		}
+		reqBody = strings.NewReader(string(body))
+	}
+
+	req, err := http.NewRequest(method, c.baseURL+path, reqBody)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+		return fmt.Errorf("failed to create request: %w", err)
 	}
 
 	req.SetBasicAuth(c.keyID, c.keySecret)
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
 
 	resp, err := c.client.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to make request: %w", err)
+		return fmt.Errorf("failed to make request: %w", err)
 	}
 	defer resp.Body.Close()
 
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response: %w", err)
+		return fmt.Errorf("failed to read response: %w", err)
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("razorpay error: %s", string(respBody))
+		return fmt.Errorf("razorpay error: %s", string(respBody))
 	}
 
-	var payment map[string]interface{}
-	if err := json.Unmarshal(respBody, &payment); err != nil {
-		return nil, fmt.Errorf("failed to parse response: %w", err)
+	if err := json.Unmarshal(respBody, out); err != nil {
+		return fmt.Errorf("failed to parse response: %w", err)
 	}
-
-	return payment, nil
+	return nil
 }
@@ -0,0 +1,249 @@
+// internal/monetization/services/reconciliation_service.go
+package services
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	ent "github.com/UnoraApp/be/ent/generated"
+	"github.com/UnoraApp/be/ent/generated/balancedrift"
+	"github.com/UnoraApp/be/ent/generated/ledgerentry"
+	"github.com/UnoraApp/be/ent/generated/user"
+	"github.com/UnoraApp/be/pkg/database"
+)
+
+// reconcileBatchSize is how many users are compared per query
+const reconcileBatchSize = 1000
+
+// ReconciliationReport summarises one reconciliation run
+type ReconciliationReport struct {
+	StartedAt    time.Time
+	FinishedAt   time.Time
+	UsersChecked int
+	// Sum of every ledger entry; anything but zero means a one-sided posting
+	LedgerImbalance int
+	// Unresolved drifts after the run
+	Drifts   []*ent.BalanceDrift
+	Detected int
+	Resolved int
+}
+
+// ReconciliationService recomputes credit balances from the ledger and
+// records users whose stored balance disagrees with it
+type ReconciliationService struct {
+	entClient *ent.Client
+}
+
+// NewReconciliationService creates a new reconciliation service
+func NewReconciliationService(entClient *ent.Client) *ReconciliationService {
+	return &ReconciliationService{
+		entClient: entClient,
+	}
+}
+
+// Reconcile compares every user's credit_balance with the sum of their ledger
+// account. Mismatches are re-checked under the user's row lock before they are
+// flagged, so postings in flight during the scan are not reported as drift.
+// Drifts that no longer reproduce are marked resolved.
+func (s *ReconciliationService) Reconcile(ctx context.Context) (*ReconciliationReport, error) {
+	report := &ReconciliationReport{StartedAt: time.Now()}
+
+	ledger, err := s.userLedgerBalances(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var total []struct {
+		Sum sql.NullInt64 `json:"sum"`
+	}
+	err = s.entClient.LedgerEntry.
+		Query().
+		Aggregate(ent.Sum(ledgerentry.FieldAmount)).
+		Scan(ctx, &total)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sum ledger: %w", err)
+	}
+	if len(total) > 0 {
+		report.LedgerImbalance = int(total[0].Sum.Int64)
+	}
+
+	// Users with an open drift are always re-checked so they can be resolved
+	open, err := s.entClient.BalanceDrift.
+		Query().
+		Where(balancedrift.ResolvedAtIsNil()).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get drifts: %w", err)
+	}
+	candidates := make(map[string]bool, len(open))
+	for _, d := range open {
+		candidates[d.UserID] = true
+	}
+
+	// Scan balances in id order
+	lastID := ""
+	for {
+		users, err := s.entClient.User.
+			Query().
+			Where(user.IDGT(lastID)).
+			Order(ent.Asc(user.FieldID)).
+			Limit(reconcileBatchSize).
+			Select(user.FieldID, user.FieldCreditBalance).
+			All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get users: %w", err)
+		}
+		for _, u := range users {
+			if u.CreditBalance != ledger[u.ID] {
+				candidates[u.ID] = true
+			}
+		}
+		report.UsersChecked += len(users)
+		if len(users) < reconcileBatchSize {
+			break
+		}
+		lastID = users[len(users)-1].ID
+	}
+
+	for userID := range candidates {
+		detected, resolved, err := s.checkUser(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		if detected {
+			report.Detected++
+		}
+		if resolved {
+			report.Resolved++
+		}
+	}
+
+	report.Drifts, err = s.ListDrifts(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+	report.FinishedAt = time.Now()
+	return report, nil
+}
+
+// ListDrifts returns recorded drifts, newest first; resolved ones only when asked
+func (s *ReconciliationService) ListDrifts(ctx context.Context, includeResolved bool) ([]*ent.BalanceDrift, error) {
+	query := s.entClient.BalanceDrift.Query()
+	if !includeResolved {
+		query = query.Where(balancedrift.ResolvedAtIsNil())
+	}
+
+	drifts, err := query.
+		Order(ent.Desc(balancedrift.FieldDetectedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get drifts: %w", err)
+	}
+	return drifts, nil
+}
+
+// checkUser compares one user's balance with their ledger account while holding
+// the user's row lock, and opens, refreshes or resolves their drift record.
+// It reports whether a new drift was detected or an open one resolved.
+func (s *ReconciliationService) checkUser(ctx context.Context, userID string) (bool, bool, error) {
+	var detected, resolved bool
+	err := database.WithTx(ctx, s.entClient, func(tx *ent.Tx) error {
+		client := tx.Client()
+
+		u, err := lockUser(ctx, client, userID)
+		if err != nil {
+			return err
+		}
+
+		var sums []struct {
+			Sum sql.NullInt64 `json:"sum"`
+		}
+		err = client.LedgerEntry.
+			Query().
+			Where(ledgerentry.AccountEQ(UserAccount(userID))).
+			Aggregate(ent.Sum(ledgerentry.FieldAmount)).
+			Scan(ctx, &sums)
+		if err != nil {
+			return fmt.Errorf("failed to sum ledger: %w", err)
+		}
+		ledgerBalance := 0
+		if len(sums) > 0 {
+			ledgerBalance = int(sums[0].Sum.Int64)
+		}
+
+		drift, err := client.BalanceDrift.
+			Query().
+			Where(balancedrift.UserIDEQ(userID)).
+			Where(balancedrift.ResolvedAtIsNil()).
+			First(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return fmt.Errorf("failed to get drift: %w", err)
+		}
+
+		now := time.Now()
+		err = nil
+		switch {
+		case u.CreditBalance == ledgerBalance && drift != nil:
+			_, err = drift.Update().
+				SetLastCheckedAt(now).
+				SetResolvedAt(now).
+				Save(ctx)
+			resolved = true
+		case u.CreditBalance != ledgerBalance && drift != nil:
+			_, err = drift.Update().
+				SetStoredBalance(u.CreditBalance).
+				SetLedgerBalance(ledgerBalance).
+				SetDifference(u.CreditBalance - ledgerBalance).
+				SetLastCheckedAt(now).
+				Save(ctx)
+		case u.CreditBalance != ledgerBalance:
+			_, err = client.BalanceDrift.
+				Create().
+				SetID(uuid.New().String()).
+				SetUserID(userID).
+				SetStoredBalance(u.CreditBalance).
+				SetLedgerBalance(ledgerBalance).
+				SetDifference(u.CreditBalance - ledgerBalance).
+				SetDetectedAt(now).
+				SetLastCheckedAt(now).
+				Save(ctx)
+			detected = true
+		}
+		if err != nil {
+			return fmt.Errorf("failed to record drift: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return false, false, err
+	}
+	return detected, resolved, nil
+}
+
+// userLedgerBalances sums the ledger per user account
+func (s *ReconciliationService) userLedgerBalances(ctx context.Context) (map[string]int, error) {
+	var rows []struct {
+		Account string        `json:"account"`
+		Sum     sql.NullInt64 `json:"sum"`
+	}
+	err := s.entClient.LedgerEntry.
+		Query().
+		Where(ledgerentry.AccountHasPrefix(UserAccount(""))).
+		GroupBy(ledgerentry.FieldAccount).
+		Aggregate(ent.Sum(ledgerentry.FieldAmount)).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sum ledger: %w", err)
+	}
+
+	balances := make(map[string]int, len(rows))
+	for _, r := range rows {
+		balances[strings.TrimPrefix(r.Account, UserAccount(""))] = int(r.Sum.Int64)
+	}
+	return balances, nil
+}
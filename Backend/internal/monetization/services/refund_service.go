@@ -0,0 +1,520 @@
+// internal/monetization/services/refund_service.go
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	ent "github.com/UnoraApp/be/ent/generated"
+	"github.com/UnoraApp/be/ent/generated/credittransaction"
+	"github.com/UnoraApp/be/ent/generated/paymentorder"
+	"github.com/UnoraApp/be/ent/generated/paymentrefund"
+	"github.com/UnoraApp/be/ent/generated/predicate"
+	"github.com/UnoraApp/be/pkg/database"
+	"github.com/UnoraApp/be/pkg/logger"
+)
+
+// Refund errors
+var (
+	ErrOrderNotRefundable  = errors.New("only paid orders can be refunded")
+	ErrRefundAmountInvalid = errors.New("refund amount exceeds the refundable amount")
+	ErrCreditsAlreadySpent = errors.New("user has already spent credits from this order")
+	ErrRefundRejected      = errors.New("razorpay rejected the refund")
+)
+
+// Ledger reference types for refund credit movements. Each refund moves
+// credits at most once per type, which the ledger's unique reference enforces.
+const (
+	refundReferenceReverse = "payment_refund"
+	refundReferenceRestore = "payment_refund_restore"
+	refundReferenceReapply = "payment_refund_reapply"
+)
+
+// SpentCreditsPolicy decides what happens when the user no longer holds all
+// the credits a refund should take back
+type SpentCreditsPolicy string
+
+const (
+	// SpentCreditsReject refuses the refund
+	SpentCreditsReject SpentCreditsPolicy = "reject"
+	// SpentCreditsWriteOff refunds anyway, takes back what the user still holds
+	// and records the rest on the refund as written off
+	SpentCreditsWriteOff SpentCreditsPolicy = "write_off"
+)
+
+// RefundInput describes a refund issued by an admin
+type RefundInput struct {
+	// Amount in paise; zero refunds everything still refundable
+	Amount       int
+	Reason       string
+	SpentCredits SpentCreditsPolicy
+}
+
+// CreditsShortfallError reports a refund rejected because the user has spent
+// credits it would take back
+type CreditsShortfallError struct {
+	Required  int
+	Available int
+}
+
+func (e *CreditsShortfallError) Error() string {
+	return fmt.Sprintf("%s: %d credits to reverse, %d held", ErrCreditsAlreadySpent, e.Required, e.Available)
+}
+
+func (e *CreditsShortfallError) Unwrap() error {
+	return ErrCreditsAlreadySpent
+}
+
+// RefundService issues Razorpay refunds and tracks them until Razorpay reports
+// them processed or failed. The purchased credits are reversed through the
+// ledger when the refund is requested, so they cannot be spent while Razorpay
+// processes it, and given back if the refund fails.
+type RefundService struct {
+	entClient      *ent.Client
+	gateway        PaymentGateway
+	creditsService *CreditsService
+}
+
+// NewRefundService creates a new refund service
+func NewRefundService(entClient *ent.Client, gateway PaymentGateway, creditsService *CreditsService) *RefundService {
+	return &RefundService{
+		entClient:      entClient,
+		gateway:        gateway,
+		creditsService: creditsService,
+	}
+}
+
+// RefundOrder refunds a paid order, fully or partially, through the Razorpay
+// refunds API. The returned refund is usually still processing; the
+// refund.processed webhook completes it.
+func (s *RefundService) RefundOrder(ctx context.Context, orderID string, input *RefundInput) (*ent.PaymentRefund, error) {
+	var refund *ent.PaymentRefund
+	var paymentID string
+
+	err := database.WithTx(ctx, s.entClient, func(tx *ent.Tx) error {
+		client := tx.Client()
+
+		order, err := lockOrder(ctx, client, paymentorder.IDEQ(orderID))
+		if err != nil {
+			return err
+		}
+		if order.OrderStatus != paymentorder.OrderStatusPaid || order.RazorpayPaymentID == nil {
+			return ErrOrderNotRefundable
+		}
+
+		committed, err := committedRefundAmount(ctx, client, order)
+		if err != nil {
+			return err
+		}
+		refundable := order.Amount - committed
+		amount := input.Amount
+		if amount == 0 {
+			amount = refundable
+		}
+		if amount <= 0 || amount > refundable {
+			return fmt.Errorf("%w: %d of %d paise refundable", ErrRefundAmountInvalid, refundable, order.Amount)
+		}
+
+		refundID := uuid.New().String()
+		reversed, writtenOff, err := s.reverseCredits(
+			ctx,
+			client,
+			order.UserID,
+			creditsForRefund(order, committed, amount),
+			input.SpentCredits,
+			fmt.Sprintf("Refund of order %s: %s", order.ID, input.Reason),
+			refundReferenceReverse,
+			refundID,
+		)
+		if err != nil {
+			return err
+		}
+
+		refund, err = client.PaymentRefund.
+			Create().
+			SetID(refundID).
+			SetOrderID(order.ID).
+			SetUserID(order.UserID).
+			SetAmount(amount).
+			SetCurrency(order.Currency).
+			SetSource(paymentrefund.SourceAdmin).
+			SetCreditsReversed(reversed).
+			SetCreditsWrittenOff(writtenOff).
+			SetNillableReason(strPtr(input.Reason)).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to create refund: %w", err)
+		}
+
+		paymentID = *order.RazorpayPaymentID
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The refund ID in the notes lets webhooks find the refund even if they
+	// arrive before the Razorpay refund ID is stored
+	rzpRefund, err := s.gateway.CreateRefund(paymentID, &CreateRefundInput{
+		Amount:  refund.Amount,
+		Speed:   "normal",
+		Receipt: refund.ID,
+		Notes: map[string]string{
+			"refund_id": refund.ID,
+			"order_id":  refund.OrderID,
+		},
+	})
+	if err != nil {
+		if _, failErr := s.failRefund(ctx, refund.ID, "", err.Error()); failErr != nil {
+			log := logger.GetLogger("payments")
+			log.Error().Err(failErr).Str("refund_id", refund.ID).Msg("Failed to restore credits of rejected refund")
+		}
+		return nil, fmt.Errorf("%w: %v", ErrRefundRejected, err)
+	}
+
+	switch rzpRefund.Status {
+	case "processed":
+		if _, err := s.HandleRefundProcessed(ctx, rzpRefund); err != nil {
+			return nil, err
+		}
+	case "failed":
+		if _, err := s.failRefund(ctx, refund.ID, rzpRefund.ID, "refund failed at Razorpay"); err != nil {
+			return nil, err
+		}
+	default:
+		_, err = s.entClient.PaymentRefund.
+			Update().
+			Where(paymentrefund.IDEQ(refund.ID)).
+			Where(paymentrefund.RefundStatusEQ(paymentrefund.RefundStatusPending)).
+			SetRazorpayRefundID(rzpRefund.ID).
+			SetRefundStatus(paymentrefund.RefundStatusProcessing).
+			Save(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to update refund: %w", err)
+		}
+	}
+
+	return s.entClient.PaymentRefund.Get(ctx, refund.ID)
+}
+
+// HandleRefundProcessed completes a refund Razorpay has processed. Refunds
+// issued outside the app, e.g. from the Razorpay dashboard, are recorded here;
+// the money is already returned, so credits the user spent are written off.
+// It reports false when the refund was already applied.
+func (s *RefundService) HandleRefundProcessed(ctx context.Context, rzpRefund *RazorpayRefund) (bool, error) {
+	applied := false
+	err := database.WithTx(ctx, s.entClient, func(tx *ent.Tx) error {
+		client := tx.Client()
+
+		order, err := lockOrder(ctx, client, paymentorder.RazorpayPaymentIDEQ(rzpRefund.PaymentID))
+		if err != nil {
+			return err
+		}
+
+		refund, err := findRefund(ctx, client, rzpRefund)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		switch {
+		case refund == nil:
+			// Refunds applied before refunds were tracked left only a ledger entry
+			prior, err := s.creditsService.FindByReference(ctx, "refund", rzpRefund.ID)
+			if err != nil {
+				return err
+			}
+			if prior != nil {
+				return nil
+			}
+
+			committed, err := committedRefundAmount(ctx, client, order)
+			if err != nil {
+				return err
+			}
+			refundID := uuid.New().String()
+			reversed, writtenOff, err := s.reverseCredits(
+				ctx,
+				client,
+				order.UserID,
+				creditsForRefund(order, committed, rzpRefund.Amount),
+				SpentCreditsWriteOff,
+				fmt.Sprintf("Refund of Razorpay payment %s for order %s", rzpRefund.PaymentID, order.ID),
+				refundReferenceReverse,
+				refundID,
+			)
+			if err != nil {
+				return err
+			}
+
+			_, err = client.PaymentRefund.
+				Create().
+				SetID(refundID).
+				SetOrderID(order.ID).
+				SetUserID(order.UserID).
+				SetRazorpayRefundID(rzpRefund.ID).
+				SetAmount(rzpRefund.Amount).
+				SetCurrency(order.Currency).
+				SetSource(paymentrefund.SourceGateway).
+				SetRefundStatus(paymentrefund.RefundStatusProcessed).
+				SetCreditsReversed(reversed).
+				SetCreditsWrittenOff(writtenOff).
+				SetProcessedAt(now).
+				Save(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to create refund: %w", err)
+			}
+
+		case refund.RefundStatus == paymentrefund.RefundStatusProcessed:
+			return nil
+
+		default:
+			update := refund.Update().
+				SetRazorpayRefundID(rzpRefund.ID).
+				SetRefundStatus(paymentrefund.RefundStatusProcessed).
+				SetProcessedAt(now).
+				ClearFailureReason()
+
+			// A refund given up as failed went through after all, e.g. the
+			// API call timed out; its credits were restored and are due again
+			if refund.RefundStatus == paymentrefund.RefundStatusFailed {
+				committed, err := committedRefundAmount(ctx, client, order)
+				if err != nil {
+					return err
+				}
+				reversed, writtenOff, err := s.reverseCredits(
+					ctx,
+					client,
+					order.UserID,
+					creditsForRefund(order, committed, refund.Amount),
+					SpentCreditsWriteOff,
+					fmt.Sprintf("Refund of order %s processed after failing", order.ID),
+					refundReferenceReapply,
+					refund.ID,
+				)
+				if err != nil {
+					return err
+				}
+				update = update.
+					SetCreditsReversed(reversed).
+					SetCreditsWrittenOff(writtenOff)
+			}
+
+			if _, err := update.Save(ctx); err != nil {
+				return fmt.Errorf("failed to update refund: %w", err)
+			}
+		}
+
+		orderUpdate := client.PaymentOrder.
+			UpdateOneID(order.ID).
+			AddAmountRefunded(rzpRefund.Amount)
+		if order.AmountRefunded+rzpRefund.Amount >= order.Amount {
+			orderUpdate = orderUpdate.SetOrderStatus(paymentorder.OrderStatusRefunded)
+		}
+		if _, err := orderUpdate.Save(ctx); err != nil {
+			return fmt.Errorf("failed to update order: %w", err)
+		}
+
+		applied = true
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return applied, nil
+}
+
+// HandleRefundFailed records a refund Razorpay could not complete and gives
+// the user back the credits it reversed. It reports false for refunds that
+// are unknown or already settled.
+func (s *RefundService) HandleRefundFailed(ctx context.Context, rzpRefund *RazorpayRefund) (bool, error) {
+	refund, err := findRefund(ctx, s.entClient, rzpRefund)
+	if err != nil {
+		return false, err
+	}
+	if refund == nil {
+		return false, nil
+	}
+	return s.failRefund(ctx, refund.ID, rzpRefund.ID, "refund failed at Razorpay")
+}
+
+// ListRefunds returns an order's refunds, newest first
+func (s *RefundService) ListRefunds(ctx context.Context, orderID string) ([]*ent.PaymentRefund, error) {
+	refunds, err := s.entClient.PaymentRefund.
+		Query().
+		Where(paymentrefund.OrderIDEQ(orderID)).
+		Order(ent.Desc(paymentrefund.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get refunds: %w", err)
+	}
+	return refunds, nil
+}
+
+// failRefund marks an unsettled refund failed and restores its reversed credits
+func (s *RefundService) failRefund(ctx context.Context, refundID, razorpayRefundID, reason string) (bool, error) {
+	failed := false
+	err := database.WithTx(ctx, s.entClient, func(tx *ent.Tx) error {
+		client := tx.Client()
+
+		refund, err := client.PaymentRefund.
+			Query().
+			Where(paymentrefund.IDEQ(refundID)).
+			ForUpdate().
+			Only(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get refund: %w", err)
+		}
+		if refund.RefundStatus != paymentrefund.RefundStatusPending && refund.RefundStatus != paymentrefund.RefundStatusProcessing {
+			return nil
+		}
+
+		if refund.CreditsReversed > 0 {
+			_, err = s.creditsService.applyCredits(
+				ctx,
+				client,
+				refund.UserID,
+				refund.CreditsReversed,
+				credittransaction.TransactionTypeRefund,
+				fmt.Sprintf("Refund of order %s failed, credits restored", refund.OrderID),
+				refundReferenceRestore,
+				refund.ID,
+				"",
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		update := refund.Update().
+			SetRefundStatus(paymentrefund.RefundStatusFailed).
+			SetCreditsReversed(0).
+			SetCreditsWrittenOff(0).
+			SetFailureReason(reason)
+		if razorpayRefundID != "" {
+			update = update.SetRazorpayRefundID(razorpayRefundID)
+		}
+		if _, err := update.Save(ctx); err != nil {
+			return fmt.Errorf("failed to update refund: %w", err)
+		}
+
+		failed = true
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return failed, nil
+}
+
+// reverseCredits takes back the credits a refund covers. When the user has
+// spent some of them, the policy either rejects the refund or writes the
+// shortfall off. It returns the credits reversed and written off.
+func (s *RefundService) reverseCredits(ctx context.Context, client *ent.Client, userID string, credits int, policy SpentCreditsPolicy, description, refType, refID string) (int, int, error) {
+	u, err := lockUser(ctx, client, userID)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	reversed := credits
+	if u.CreditBalance < credits {
+		if policy != SpentCreditsWriteOff {
+			return 0, 0, &CreditsShortfallError{Required: credits, Available: u.CreditBalance}
+		}
+		reversed = u.CreditBalance
+	}
+
+	if reversed > 0 {
+		_, err = s.creditsService.applyCredits(
+			ctx,
+			client,
+			userID,
+			-reversed,
+			credittransaction.TransactionTypeRefund,
+			description,
+			refType,
+			refID,
+			"",
+		)
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+	return reversed, credits - reversed, nil
+}
+
+// lockOrder reads a payment order with SELECT ... FOR UPDATE
+func lockOrder(ctx context.Context, client *ent.Client, where predicate.PaymentOrder) (*ent.PaymentOrder, error) {
+	order, err := client.PaymentOrder.
+		Query().
+		Where(where).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrOrderNotFound
+		}
+		return nil, fmt.Errorf("failed to get order: %w", err)
+	}
+	return order, nil
+}
+
+// findRefund looks a Razorpay refund up by its ID, then by the refund ID this
+// service put in its notes. It returns nil for refunds issued elsewhere.
+func findRefund(ctx context.Context, client *ent.Client, rzpRefund *RazorpayRefund) (*ent.PaymentRefund, error) {
+	where := paymentrefund.RazorpayRefundIDEQ(rzpRefund.ID)
+	if refundID := rzpRefund.Notes["refund_id"]; refundID != "" {
+		where = paymentrefund.Or(where, paymentrefund.IDEQ(refundID))
+	}
+
+	refund, err := client.PaymentRefund.
+		Query().
+		Where(where).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get refund: %w", err)
+	}
+	return refund, nil
+}
+
+// committedRefundAmount is the part of an order already refunded or being
+// refunded, in paise
+func committedRefundAmount(ctx context.Context, client *ent.Client, order *ent.PaymentOrder) (int, error) {
+	inFlight, err := client.PaymentRefund.
+		Query().
+		Where(paymentrefund.OrderIDEQ(order.ID)).
+		Where(paymentrefund.RefundStatusIn(paymentrefund.RefundStatusPending, paymentrefund.RefundStatusProcessing)).
+		All(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get refunds: %w", err)
+	}
+
+	committed := order.AmountRefunded
+	for _, r := range inFlight {
+		committed += r.Amount
+	}
+	return committed, nil
+}
+
+// creditsForRefund is the share of an order's credits a refund of amount
+// covers, given what was refunded before. Working from cumulative totals keeps
+// a series of partial refunds from losing credits to rounding.
+func creditsForRefund(order *ent.PaymentOrder, committed, amount int) int {
+	if order.Amount <= 0 {
+		return 0
+	}
+	creditsAt := func(refunded int) int {
+		if refunded > order.Amount {
+			refunded = order.Amount
+		}
+		return order.CreditsToAdd * refunded / order.Amount
+	}
+	return creditsAt(committed+amount) - creditsAt(committed)
+}
@@ -0,0 +1,242 @@
+// internal/monetization/services/refund_service_test.go
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+
+	ent "github.com/UnoraApp/be/ent/generated"
+	"github.com/UnoraApp/be/ent/generated/credittransaction"
+	"github.com/UnoraApp/be/ent/generated/paymentorder"
+	"github.com/UnoraApp/be/ent/generated/paymentrefund"
+	"github.com/UnoraApp/be/internal/monetization/dto"
+	"github.com/UnoraApp/be/pkg/database/databasetest"
+)
+
+// refundTest is a paid order of 55 credits for ₹99 on the fake gateway.
+// Razorpay's outcomes are applied with HandleRefundProcessed and
+// HandleRefundFailed, as their webhooks would be.
+type refundTest struct {
+	ctx     context.Context
+	client  *ent.Client
+	gateway *FakeGateway
+	credits *CreditsService
+	refunds *RefundService
+	order   *ent.PaymentOrder
+}
+
+func newRefundTest(t *testing.T) *refundTest {
+	ctx := context.Background()
+	client := databasetest.NewClient(t)
+	payments, gateway := newTestPaymentService(client)
+	credits := NewCreditsService(client)
+
+	u := client.User.Create().SetID(uuid.New().String()).SaveX(ctx)
+	pkg := client.CreditPackage.
+		Create().
+		SetID(uuid.New().String()).
+		SetName("Starter").
+		SetCreditAmount(50).
+		SetBonusCredits(5).
+		SetPriceAmount(9900).
+		SaveX(ctx)
+
+	created, err := payments.CreateOrder(ctx, u.ID, &dto.CreateOrderRequest{PackageID: pkg.ID})
+	if err != nil {
+		t.Fatalf("CreateOrder: %v", err)
+	}
+	paid, err := gateway.SimulatePayment(ctx, created.OrderID, FakePaymentSuccess)
+	if err != nil {
+		t.Fatalf("SimulatePayment: %v", err)
+	}
+	_, err = payments.VerifyPayment(ctx, u.ID, &dto.VerifyPaymentRequest{
+		RazorpayOrderID:   paid.OrderID,
+		RazorpayPaymentID: paid.PaymentID,
+		RazorpaySignature: paid.Signature,
+	})
+	if err != nil {
+		t.Fatalf("VerifyPayment: %v", err)
+	}
+	order, err := payments.GetOrderByRazorpayOrderID(ctx, created.OrderID)
+	if err != nil {
+		t.Fatalf("GetOrderByRazorpayOrderID: %v", err)
+	}
+
+	return &refundTest{
+		ctx:     ctx,
+		client:  client,
+		gateway: gateway,
+		credits: credits,
+		refunds: NewRefundService(client, gateway, credits),
+		order:   order,
+	}
+}
+
+// razorpayRefund is the refund as Razorpay reports it in a webhook
+func (rt *refundTest) razorpayRefund(refund *ent.PaymentRefund, status string) *RazorpayRefund {
+	return &RazorpayRefund{
+		ID:        ptrToString(refund.RazorpayRefundID),
+		Entity:    "refund",
+		Amount:    refund.Amount,
+		Currency:  refund.Currency,
+		PaymentID: *rt.order.RazorpayPaymentID,
+		Notes:     RazorpayNotes{"refund_id": refund.ID, "order_id": rt.order.ID},
+		Status:    status,
+	}
+}
+
+func (rt *refundTest) expectBalance(t *testing.T, want int) {
+	t.Helper()
+	if got := rt.client.User.GetX(rt.ctx, rt.order.UserID).CreditBalance; got != want {
+		t.Errorf("balance = %d, want %d", got, want)
+	}
+}
+
+// ledgerRows counts the ledger rows of one kind of refund credit movement
+func (rt *refundTest) ledgerRows(refType, refundID string) int {
+	return rt.client.CreditTransaction.
+		Query().
+		Where(credittransaction.ReferenceTypeEQ(refType)).
+		Where(credittransaction.ReferenceIDEQ(refundID)).
+		CountX(rt.ctx)
+}
+
+func TestRefundOrderPartialRefunds(t *testing.T) {
+	rt := newRefundTest(t)
+	rt.expectBalance(t, 55)
+
+	first, err := rt.refunds.RefundOrder(rt.ctx, rt.order.ID, &RefundInput{Amount: 4950, Reason: "half", SpentCredits: SpentCreditsReject})
+	if err != nil {
+		t.Fatalf("first RefundOrder: %v", err)
+	}
+	// The rest of the order, while the first refund is still processing
+	second, err := rt.refunds.RefundOrder(rt.ctx, rt.order.ID, &RefundInput{Reason: "rest", SpentCredits: SpentCreditsReject})
+	if err != nil {
+		t.Fatalf("second RefundOrder: %v", err)
+	}
+
+	if first.Amount+second.Amount != rt.order.Amount {
+		t.Errorf("refunded %d + %d paise of %d", first.Amount, second.Amount, rt.order.Amount)
+	}
+	if first.CreditsReversed+second.CreditsReversed != rt.order.CreditsToAdd {
+		t.Errorf("reversed %d + %d credits of %d", first.CreditsReversed, second.CreditsReversed, rt.order.CreditsToAdd)
+	}
+	rt.expectBalance(t, 0)
+
+	// Nothing is left to refund
+	_, err = rt.refunds.RefundOrder(rt.ctx, rt.order.ID, &RefundInput{Reason: "again", SpentCredits: SpentCreditsReject})
+	if !errors.Is(err, ErrRefundAmountInvalid) {
+		t.Errorf("third RefundOrder: %v, want ErrRefundAmountInvalid", err)
+	}
+
+	for _, refund := range []*ent.PaymentRefund{first, second} {
+		if _, err := rt.refunds.HandleRefundProcessed(rt.ctx, rt.razorpayRefund(refund, "processed")); err != nil {
+			t.Fatalf("HandleRefundProcessed: %v", err)
+		}
+	}
+	order := rt.client.PaymentOrder.GetX(rt.ctx, rt.order.ID)
+	if order.OrderStatus != paymentorder.OrderStatusRefunded || order.AmountRefunded != order.Amount {
+		t.Errorf("order = %s, %d of %d refunded", order.OrderStatus, order.AmountRefunded, order.Amount)
+	}
+	rt.expectBalance(t, 0)
+}
+
+func TestRefundOrderSpentCredits(t *testing.T) {
+	rt := newRefundTest(t)
+	_, err := rt.credits.SpendCredits(rt.ctx, rt.client, rt.order.UserID, 40, credittransaction.TransactionTypeEarlyReveal, "Early reveal", "reveal", uuid.New().String(), "")
+	if err != nil {
+		t.Fatalf("SpendCredits: %v", err)
+	}
+
+	_, err = rt.refunds.RefundOrder(rt.ctx, rt.order.ID, &RefundInput{Reason: "refund", SpentCredits: SpentCreditsReject})
+	var shortfall *CreditsShortfallError
+	if !errors.As(err, &shortfall) {
+		t.Fatalf("RefundOrder: %v, want CreditsShortfallError", err)
+	}
+	if shortfall.Required != 55 || shortfall.Available != 15 {
+		t.Errorf("shortfall = %d required, %d held", shortfall.Required, shortfall.Available)
+	}
+	if n := rt.client.PaymentRefund.Query().CountX(rt.ctx); n != 0 {
+		t.Errorf("rejected refund left %d refunds", n)
+	}
+	rt.expectBalance(t, 15)
+
+	// Writing the spent credits off refunds anyway
+	refund, err := rt.refunds.RefundOrder(rt.ctx, rt.order.ID, &RefundInput{Reason: "refund", SpentCredits: SpentCreditsWriteOff})
+	if err != nil {
+		t.Fatalf("RefundOrder with write-off: %v", err)
+	}
+	if refund.CreditsReversed != 15 || refund.CreditsWrittenOff != 40 {
+		t.Errorf("reversed %d, written off %d", refund.CreditsReversed, refund.CreditsWrittenOff)
+	}
+	rt.expectBalance(t, 0)
+}
+
+func TestRefundOrderRejectedByGateway(t *testing.T) {
+	rt := newRefundTest(t)
+
+	// The payment was refunded from the dashboard, so Razorpay refuses more
+	if _, err := rt.gateway.CreateRefund(*rt.order.RazorpayPaymentID, &CreateRefundInput{}); err != nil {
+		t.Fatalf("CreateRefund: %v", err)
+	}
+
+	_, err := rt.refunds.RefundOrder(rt.ctx, rt.order.ID, &RefundInput{Reason: "refund", SpentCredits: SpentCreditsReject})
+	if !errors.Is(err, ErrRefundRejected) {
+		t.Fatalf("RefundOrder: %v, want ErrRefundRejected", err)
+	}
+	refund := rt.client.PaymentRefund.Query().OnlyX(rt.ctx)
+	if refund.RefundStatus != paymentrefund.RefundStatusFailed || refund.CreditsReversed != 0 {
+		t.Errorf("refund = %s, %d credits reversed", refund.RefundStatus, refund.CreditsReversed)
+	}
+	rt.expectBalance(t, 55)
+
+	// A refund.failed webhook for it changes nothing
+	if failed, err := rt.refunds.HandleRefundFailed(rt.ctx, rt.razorpayRefund(refund, "failed")); err != nil || failed {
+		t.Errorf("HandleRefundFailed = %t, %v; want false", failed, err)
+	}
+	if n := rt.ledgerRows(refundReferenceRestore, refund.ID); n != 1 {
+		t.Errorf("credits restored %d times, want once", n)
+	}
+	rt.expectBalance(t, 55)
+}
+
+func TestRefundProcessedAfterFailure(t *testing.T) {
+	rt := newRefundTest(t)
+
+	refund, err := rt.refunds.RefundOrder(rt.ctx, rt.order.ID, &RefundInput{Reason: "refund", SpentCredits: SpentCreditsReject})
+	if err != nil {
+		t.Fatalf("RefundOrder: %v", err)
+	}
+	rt.expectBalance(t, 0)
+
+	if failed, err := rt.refunds.HandleRefundFailed(rt.ctx, rt.razorpayRefund(refund, "failed")); err != nil || !failed {
+		t.Fatalf("HandleRefundFailed = %t, %v", failed, err)
+	}
+	rt.expectBalance(t, 55)
+
+	// Razorpay processes it after all, and redelivers the webhook
+	for i := 0; i < 2; i++ {
+		applied, err := rt.refunds.HandleRefundProcessed(rt.ctx, rt.razorpayRefund(refund, "processed"))
+		if err != nil {
+			t.Fatalf("HandleRefundProcessed: %v", err)
+		}
+		if applied != (i == 0) {
+			t.Errorf("delivery %d applied = %t", i+1, applied)
+		}
+	}
+
+	processed := rt.client.PaymentRefund.GetX(rt.ctx, refund.ID)
+	if processed.RefundStatus != paymentrefund.RefundStatusProcessed || processed.CreditsReversed != 55 {
+		t.Errorf("refund = %s, %d credits reversed", processed.RefundStatus, processed.CreditsReversed)
+	}
+	if n := rt.ledgerRows(refundReferenceReapply, refund.ID); n != 1 {
+		t.Errorf("credits re-reversed %d times, want once", n)
+	}
+	rt.expectBalance(t, 0)
+	if order := rt.client.PaymentOrder.GetX(rt.ctx, rt.order.ID); order.OrderStatus != paymentorder.OrderStatusRefunded {
+		t.Errorf("order = %s, want refunded", order.OrderStatus)
+	}
+}
@@ -0,0 +1,378 @@
+// internal/monetization/services/rewards_service.go
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"time"
+
+	ent "github.com/UnoraApp/be/ent/generated"
+	"github.com/UnoraApp/be/ent/generated/credittransaction"
+	"github.com/UnoraApp/be/ent/generated/referral"
+	"github.com/UnoraApp/be/ent/generated/revealmilestone"
+	"github.com/UnoraApp/be/ent/generated/user"
+	"github.com/UnoraApp/be/ent/generated/userdevice"
+	"github.com/UnoraApp/be/internal/config"
+	"github.com/UnoraApp/be/internal/monetization/dto"
+	"github.com/UnoraApp/be/pkg/database"
+)
+
+// Referral rejection reasons
+const (
+	ReferralRejectedNoDevice     = "no_device"
+	ReferralRejectedDeviceReused = "device_reused"
+	ReferralRejectedPhoneReused  = "phone_reused"
+	ReferralRejectedReferrerGone = "referrer_deleted"
+)
+
+const (
+	referralCodeLength   = 8
+	referralCodeAttempts = 5
+	// Look-alike characters (0/O, 1/I) are left out; 32 symbols keep the
+	// byte-to-symbol mapping unbiased
+	referralCodeAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+	// Used when no reveal milestone is configured
+	defaultReferralMilestoneDay = 5
+)
+
+// RewardsService grants the promotional credits that are not bought: the
+// welcome bonus when onboarding completes, and referral bonuses once the
+// referred user reaches their first streak milestone.
+type RewardsService struct {
+	entClient      *ent.Client
+	creditsService *CreditsService
+	cfg            *config.RewardsConfig
+}
+
+// NewRewardsService creates a new rewards service
+func NewRewardsService(entClient *ent.Client, creditsService *CreditsService, cfg *config.RewardsConfig) *RewardsService {
+	return &RewardsService{
+		entClient:      entClient,
+		creditsService: creditsService,
+		cfg:            cfg,
+	}
+}
+
+// CompleteOnboarding marks the user's onboarding completed and grants the
+// welcome bonus. Only the call that moves the status to completed grants it,
+// and the ledger reference ("welcome_bonus", userID) is unique, so the bonus
+// is paid at most once however often this is called.
+func (s *RewardsService) CompleteOnboarding(ctx context.Context, userID string) error {
+	return database.WithTx(ctx, s.entClient, func(tx *ent.Tx) error {
+		client := tx.Client()
+
+		affected, err := client.User.
+			Update().
+			Where(user.IDEQ(userID)).
+			Where(user.OnboardingStatusNEQ(user.OnboardingStatusCompleted)).
+			SetOnboardingStatus(user.OnboardingStatusCompleted).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to update onboarding status: %w", err)
+		}
+		if affected == 0 || s.cfg.WelcomeBonusCredits <= 0 {
+			return nil
+		}
+
+		_, err = s.creditsService.applyCredits(
+			ctx,
+			client,
+			userID,
+			s.cfg.WelcomeBonusCredits,
+			credittransaction.TransactionTypeWelcomeBonus,
+			"Welcome bonus",
+			"welcome_bonus",
+			userID,
+			"",
+		)
+		if err != nil {
+			if ent.IsConstraintError(err) {
+				return nil
+			}
+			return fmt.Errorf("failed to grant welcome bonus: %w", err)
+		}
+		return nil
+	})
+}
+
+// GetReferralSummary returns the user's referral code, creating it on first
+// use, with counts of the signups attributed to it
+func (s *RewardsService) GetReferralSummary(ctx context.Context, userID string) (*dto.ReferralSummaryResponse, error) {
+	code, err := s.ensureReferralCode(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	referrals, err := s.entClient.Referral.
+		Query().
+		Where(referral.ReferrerIDEQ(userID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get referrals: %w", err)
+	}
+
+	result := &dto.ReferralSummaryResponse{
+		Code:            code,
+		ReferrerCredits: s.cfg.ReferrerBonusCredits,
+		RefereeCredits:  s.cfg.RefereeBonusCredits,
+	}
+	for _, r := range referrals {
+		switch r.ReferralStatus {
+		case referral.ReferralStatusPending:
+			result.PendingCount++
+		case referral.ReferralStatusRewarded:
+			result.RewardedCount++
+			result.CreditsEarned += r.ReferrerCredits
+		}
+	}
+	return result, nil
+}
+
+// ReleaseReferral pays out the referee's pending referral once their streak
+// has completed daysCompleted days and that reaches the first reveal
+// milestone. It is held until the referee has verified a phone number, so
+// that every paid referee has one. Before paying, the referral is checked for
+// one person referring themselves: none of the referee's devices and not
+// their phone number may belong to any other account, deleted ones included.
+// A referral failing a check is rejected for good.
+func (s *RewardsService) ReleaseReferral(ctx context.Context, refereeID string, daysCompleted int) error {
+	pending, err := s.entClient.Referral.
+		Query().
+		Where(referral.RefereeIDEQ(refereeID)).
+		Where(referral.ReferralStatusEQ(referral.ReferralStatusPending)).
+		Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get referral: %w", err)
+	}
+	if !pending {
+		return nil
+	}
+
+	milestoneDay, err := s.firstMilestoneDay(ctx)
+	if err != nil {
+		return err
+	}
+	if daysCompleted < milestoneDay {
+		return nil
+	}
+
+	return database.WithTx(ctx, s.entClient, func(tx *ent.Tx) error {
+		client := tx.Client()
+
+		ref, err := client.Referral.
+			Query().
+			Where(referral.RefereeIDEQ(refereeID)).
+			Where(referral.ReferralStatusEQ(referral.ReferralStatusPending)).
+			ForUpdate().
+			Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil
+			}
+			return fmt.Errorf("failed to get referral: %w", err)
+		}
+
+		referee, err := client.User.Get(ctx, ref.RefereeID)
+		if err != nil {
+			return fmt.Errorf("failed to get referee: %w", err)
+		}
+		// Held, not rejected: retried on the next streak day
+		if !phoneVerified(referee) {
+			return nil
+		}
+
+		reason, err := referralFraudCheck(ctx, client, ref, referee)
+		if err != nil {
+			return err
+		}
+		if reason != "" {
+			_, err = ref.Update().
+				SetReferralStatus(referral.ReferralStatusRejected).
+				SetRejectionReason(reason).
+				SetResolvedAt(time.Now()).
+				Save(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to reject referral: %w", err)
+			}
+			return nil
+		}
+
+		if err := s.grantReferralBonus(ctx, client, ref.ReferrerID, s.cfg.ReferrerBonusCredits, "referral_referrer", ref.ID); err != nil {
+			return err
+		}
+		if err := s.grantReferralBonus(ctx, client, ref.RefereeID, s.cfg.RefereeBonusCredits, "referral_referee", ref.ID); err != nil {
+			return err
+		}
+
+		_, err = ref.Update().
+			SetReferralStatus(referral.ReferralStatusRewarded).
+			SetReferrerCredits(max(s.cfg.ReferrerBonusCredits, 0)).
+			SetRefereeCredits(max(s.cfg.RefereeBonusCredits, 0)).
+			SetResolvedAt(time.Now()).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to update referral: %w", err)
+		}
+		return nil
+	})
+}
+
+// grantReferralBonus credits one side of a referral; a zero amount grants nothing
+func (s *RewardsService) grantReferralBonus(ctx context.Context, client *ent.Client, userID string, amount int, refType, referralID string) error {
+	if amount <= 0 {
+		return nil
+	}
+	_, err := s.creditsService.applyCredits(
+		ctx,
+		client,
+		userID,
+		amount,
+		credittransaction.TransactionTypeReferralBonus,
+		"Referral bonus",
+		refType,
+		referralID,
+		"",
+	)
+	if err != nil {
+		return fmt.Errorf("failed to grant referral bonus: %w", err)
+	}
+	return nil
+}
+
+// phoneVerified reports whether the user has verified a phone number. Later
+// verification steps imply the phone was verified.
+func phoneVerified(u *ent.User) bool {
+	return u.PhoneNumber != nil && *u.PhoneNumber != "" &&
+		u.VerificationStatus != user.VerificationStatusPending
+}
+
+// referralFraudCheck returns why a referral must be rejected, or "" if it may
+// be paid. Devices are those the referee signed in on, from the device
+// registry, not the device ID sent at signup.
+func referralFraudCheck(ctx context.Context, client *ent.Client, ref *ent.Referral, referee *ent.User) (string, error) {
+	referrer, err := client.User.Get(ctx, ref.ReferrerID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get referrer: %w", err)
+	}
+	if referrer.DeletedAt != nil {
+		return ReferralRejectedReferrerGone, nil
+	}
+
+	// Without a device there is nothing to tie repeat signups together
+	deviceIDs, err := client.UserDevice.
+		Query().
+		Where(userdevice.UserIDEQ(referee.ID)).
+		Select(userdevice.FieldDeviceID).
+		Strings(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to get devices: %w", err)
+	}
+	if len(deviceIDs) == 0 {
+		return ReferralRejectedNoDevice, nil
+	}
+
+	deviceReused, err := client.UserDevice.
+		Query().
+		Where(userdevice.DeviceIDIn(deviceIDs...)).
+		Where(userdevice.UserIDNEQ(referee.ID)).
+		Exist(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to check device: %w", err)
+	}
+	if deviceReused {
+		return ReferralRejectedDeviceReused, nil
+	}
+
+	// Deleted accounts only keep the hash of their number
+	samePhone := user.And(
+		user.PhoneCountryCodeEQ(referee.PhoneCountryCode),
+		user.PhoneNumberEQ(*referee.PhoneNumber),
+	)
+	if referee.PhoneHash != nil {
+		samePhone = user.Or(samePhone, user.PhoneHashEQ(*referee.PhoneHash))
+	}
+	phoneReused, err := client.User.
+		Query().
+		Where(samePhone).
+		Where(user.IDNEQ(referee.ID)).
+		Exist(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to check phone number: %w", err)
+	}
+	if phoneReused {
+		return ReferralRejectedPhoneReused, nil
+	}
+
+	return "", nil
+}
+
+// firstMilestoneDay is the streak day of the first active reveal milestone
+func (s *RewardsService) firstMilestoneDay(ctx context.Context) (int, error) {
+	milestone, err := s.entClient.RevealMilestone.
+		Query().
+		Where(revealmilestone.IsActiveEQ(true)).
+		Order(ent.Asc(revealmilestone.FieldDayRequired)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return defaultReferralMilestoneDay, nil
+		}
+		return 0, fmt.Errorf("failed to get reveal milestones: %w", err)
+	}
+	return milestone.DayRequired, nil
+}
+
+// ensureReferralCode returns the user's referral code, generating one if the
+// user has none yet
+func (s *RewardsService) ensureReferralCode(ctx context.Context, userID string) (string, error) {
+	u, err := s.entClient.User.Get(ctx, userID)
+	if err != nil {
+		return "", fmt.Errorf("user not found: %w", err)
+	}
+	if u.ReferralCode != nil {
+		return *u.ReferralCode, nil
+	}
+
+	for attempt := 0; attempt < referralCodeAttempts; attempt++ {
+		code, err := generateReferralCode()
+		if err != nil {
+			return "", err
+		}
+
+		affected, err := s.entClient.User.
+			Update().
+			Where(user.IDEQ(userID)).
+			Where(user.ReferralCodeIsNil()).
+			SetReferralCode(code).
+			Save(ctx)
+		if err != nil {
+			if ent.IsConstraintError(err) {
+				continue
+			}
+			return "", fmt.Errorf("failed to set referral code: %w", err)
+		}
+		if affected == 0 {
+			// Set concurrently by another request
+			u, err = s.entClient.User.Get(ctx, userID)
+			if err != nil {
+				return "", fmt.Errorf("user not found: %w", err)
+			}
+			return ptrToString(u.ReferralCode), nil
+		}
+		return code, nil
+	}
+	return "", fmt.Errorf("failed to generate a unique referral code")
+}
+
+// generateReferralCode returns a random referral code
+func generateReferralCode() (string, error) {
+	buf := make([]byte, referralCodeLength)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate referral code: %w", err)
+	}
+	for i, b := range buf {
+		buf[i] = referralCodeAlphabet[int(b)%len(referralCodeAlphabet)]
+	}
+	return string(buf), nil
+}
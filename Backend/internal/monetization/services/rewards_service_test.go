@@ -0,0 +1,160 @@
+// internal/monetization/services/rewards_service_test.go
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+
+	ent "github.com/UnoraApp/be/ent/generated"
+	"github.com/UnoraApp/be/ent/generated/referral"
+	"github.com/UnoraApp/be/ent/generated/user"
+	"github.com/UnoraApp/be/internal/config"
+	"github.com/UnoraApp/be/pkg/database/databasetest"
+)
+
+func TestReleaseReferral(t *testing.T) {
+	ctx := context.Background()
+
+	type account struct {
+		countryCode string
+		phone       string
+		phoneHash   string
+		status      user.VerificationStatus
+		devices     []string
+	}
+	verified := func(countryCode, phone string, devices ...string) account {
+		return account{
+			countryCode: countryCode,
+			phone:       phone,
+			phoneHash:   "hash" + countryCode + phone,
+			status:      user.VerificationStatusPhoneVerified,
+			devices:     devices,
+		}
+	}
+
+	tests := []struct {
+		name       string
+		referee    account
+		others     []account
+		wantStatus referral.ReferralStatus
+		wantReason string
+	}{
+		{
+			name:       "clean referral paid",
+			referee:    verified("+91", "9876543210", "device-a"),
+			wantStatus: referral.ReferralStatusRewarded,
+		},
+		{
+			name:       "held until the phone is verified",
+			referee:    account{devices: []string{"device-a"}, status: user.VerificationStatusPending},
+			wantStatus: referral.ReferralStatusPending,
+		},
+		{
+			name:       "later verification step counts as phone verified",
+			referee:    account{countryCode: "+91", phone: "9876543210", status: user.VerificationStatusPhotosSubmitted, devices: []string{"device-a"}},
+			wantStatus: referral.ReferralStatusRewarded,
+		},
+		{
+			name:       "no registered device",
+			referee:    verified("+91", "9876543210"),
+			wantStatus: referral.ReferralStatusRejected,
+			wantReason: ReferralRejectedNoDevice,
+		},
+		{
+			name:       "device used by another account",
+			referee:    verified("+91", "9876543210", "device-a", "device-b"),
+			others:     []account{verified("+91", "9123456789", "device-b")},
+			wantStatus: referral.ReferralStatusRejected,
+			wantReason: ReferralRejectedDeviceReused,
+		},
+		{
+			name:       "phone number of another account",
+			referee:    verified("+91", "9876543210", "device-a"),
+			others:     []account{verified("+91", "9876543210", "device-z")},
+			wantStatus: referral.ReferralStatusRejected,
+			wantReason: ReferralRejectedPhoneReused,
+		},
+		{
+			name:    "phone number of a deleted account",
+			referee: verified("+91", "9876543210", "device-a"),
+			others: []account{{
+				countryCode: "+91",
+				phoneHash:   "hash+919876543210",
+				status:      user.VerificationStatusPhoneVerified,
+			}},
+			wantStatus: referral.ReferralStatusRejected,
+			wantReason: ReferralRejectedPhoneReused,
+		},
+		{
+			name:       "same national number in another country",
+			referee:    verified("+91", "9876543210", "device-a"),
+			others:     []account{verified("+1", "9876543210", "device-z")},
+			wantStatus: referral.ReferralStatusRewarded,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := databasetest.NewClient(t)
+			rewards := NewRewardsService(client, NewCreditsService(client), &config.RewardsConfig{
+				ReferrerBonusCredits: 10,
+				RefereeBonusCredits:  5,
+			})
+
+			create := func(a account) *ent.User {
+				u := client.User.
+					Create().
+					SetID(uuid.New().String()).
+					SetPhoneCountryCode(a.countryCode).
+					SetNillablePhoneNumber(strPtr(a.phone)).
+					SetNillablePhoneHash(strPtr(a.phoneHash)).
+					SetVerificationStatus(a.status).
+					SaveX(ctx)
+				for _, deviceID := range a.devices {
+					client.UserDevice.
+						Create().
+						SetID(uuid.New().String()).
+						SetUserID(u.ID).
+						SetDeviceID(deviceID).
+						SaveX(ctx)
+				}
+				return u
+			}
+
+			referrer := create(verified("+91", "9000000000", "referrer-device"))
+			referee := create(tt.referee)
+			for _, other := range tt.others {
+				create(other)
+			}
+			ref := client.Referral.
+				Create().
+				SetID(uuid.New().String()).
+				SetReferrerID(referrer.ID).
+				SetRefereeID(referee.ID).
+				SetCode("K7QM2XPA").
+				SaveX(ctx)
+
+			if err := rewards.ReleaseReferral(ctx, referee.ID, defaultReferralMilestoneDay); err != nil {
+				t.Fatalf("ReleaseReferral: %v", err)
+			}
+
+			got := client.Referral.GetX(ctx, ref.ID)
+			if got.ReferralStatus != tt.wantStatus {
+				t.Fatalf("status = %s, want %s", got.ReferralStatus, tt.wantStatus)
+			}
+			if reason := ptrToString(got.RejectionReason); reason != tt.wantReason {
+				t.Errorf("rejection reason = %q, want %q", reason, tt.wantReason)
+			}
+
+			wantBalance := 0
+			if tt.wantStatus == referral.ReferralStatusRewarded {
+				wantBalance = 5
+			}
+			if balance := client.User.GetX(ctx, referee.ID).CreditBalance; balance != wantBalance {
+				t.Errorf("referee balance = %d, want %d", balance, wantBalance)
+			}
+		})
+	}
+}
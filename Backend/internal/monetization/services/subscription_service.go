@@ -0,0 +1,561 @@
+// internal/monetization/services/subscription_service.go
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	ent "github.com/UnoraApp/be/ent/generated"
+	"github.com/UnoraApp/be/ent/generated/subscription"
+	"github.com/UnoraApp/be/ent/generated/subscriptionplan"
+	"github.com/UnoraApp/be/ent/generated/user"
+	"github.com/UnoraApp/be/internal/monetization/dto"
+	"github.com/UnoraApp/be/pkg/database"
+	"github.com/UnoraApp/be/pkg/logger"
+)
+
+// SubscriptionGracePeriod is how long a subscription keeps its tier after a
+// renewal charge starts failing
+const SubscriptionGracePeriod = 3 * 24 * time.Hour
+
+// Billing cycles requested from Razorpay; a subscription renews until
+// cancelled or until these run out
+const (
+	monthlyBillingCycles = 120
+	yearlyBillingCycles  = 10
+)
+
+// Subscription errors
+var (
+	ErrPlanUnavailable       = errors.New("plan is not available")
+	ErrSubscriptionExists    = errors.New("user already has a subscription; cancel it before switching plans")
+	ErrNoSubscription        = errors.New("no subscription found")
+	ErrSubscriptionNotActive = errors.New("subscription is not in a state that allows this")
+)
+
+// liveStatuses are subscriptions the user is, or is about to be, paying for
+var liveStatuses = []subscription.Status{
+	subscription.StatusAuthenticated,
+	subscription.StatusActive,
+	subscription.StatusPending,
+	subscription.StatusHalted,
+	subscription.StatusPaused,
+}
+
+// SubscriptionService manages Plus/Pro subscriptions billed through Razorpay
+// and keeps User.subscription_tier in step with them. Razorpay is the source
+// of truth for subscription state; local state follows its API responses and
+// subscription.* webhooks.
+type SubscriptionService struct {
+	entClient *ent.Client
+	gateway   PaymentGateway
+}
+
+// NewSubscriptionService creates a new subscription service
+func NewSubscriptionService(entClient *ent.Client, gateway PaymentGateway) *SubscriptionService {
+	return &SubscriptionService{
+		entClient: entClient,
+		gateway:   gateway,
+	}
+}
+
+// GetPlans returns all active subscription plans
+func (s *SubscriptionService) GetPlans(ctx context.Context) ([]*dto.SubscriptionPlanResponse, error) {
+	plans, err := s.entClient.SubscriptionPlan.
+		Query().
+		Where(subscriptionplan.IsActiveEQ(true)).
+		Order(ent.Asc(subscriptionplan.FieldSortOrder)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get plans: %w", err)
+	}
+
+	result := make([]*dto.SubscriptionPlanResponse, len(plans))
+	for i, p := range plans {
+		result[i] = &dto.SubscriptionPlanResponse{
+			ID:            p.ID,
+			Name:          p.Name,
+			Tier:          string(p.Tier),
+			BillingPeriod: string(p.BillingPeriod),
+			PriceAmount:   p.PriceAmount,
+			PriceDisplay:  fmt.Sprintf("₹%d", p.PriceAmount/100), // Convert paise to rupees
+			Currency:      p.Currency,
+		}
+	}
+
+	return result, nil
+}
+
+// GetSubscription returns the user's tier and their current subscription
+func (s *SubscriptionService) GetSubscription(ctx context.Context, userID string) (*dto.SubscriptionStateResponse, error) {
+	u, err := s.entClient.User.Get(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("user not found: %w", err)
+	}
+
+	result := &dto.SubscriptionStateResponse{
+		CurrentTier: string(u.SubscriptionTier),
+	}
+
+	sub, err := s.liveSubscription(ctx, userID)
+	if err != nil && !errors.Is(err, ErrNoSubscription) {
+		return nil, err
+	}
+	if sub != nil {
+		result.Subscription = subscriptionToResponse(sub)
+	}
+
+	return result, nil
+}
+
+// CreateSubscription starts a Razorpay subscription to a plan, replacing a
+// checkout the user left open. The tier is granted once Razorpay reports the
+// first charge (subscription.activated).
+func (s *SubscriptionService) CreateSubscription(ctx context.Context, userID string, req *dto.CreateSubscriptionRequest) (*dto.CreateSubscriptionResponse, error) {
+	plan, err := s.entClient.SubscriptionPlan.Get(ctx, req.PlanID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrPlanUnavailable
+		}
+		return nil, fmt.Errorf("failed to get plan: %w", err)
+	}
+	if !plan.IsActive || plan.RazorpayPlanID == nil {
+		return nil, ErrPlanUnavailable
+	}
+
+	cycles := monthlyBillingCycles
+	if plan.BillingPeriod == subscriptionplan.BillingPeriodYearly {
+		cycles = yearlyBillingCycles
+	}
+
+	// The user's row lock serializes subscription creation, so concurrent
+	// requests cannot each find no subscription and both start one
+	var sub *ent.Subscription
+	var rzSub *RazorpaySubscription
+	err = database.WithTx(ctx, s.entClient, func(tx *ent.Tx) error {
+		client := tx.Client()
+
+		if _, err := lockUser(ctx, client, userID); err != nil {
+			return err
+		}
+
+		open, err := client.Subscription.
+			Query().
+			Where(subscription.UserIDEQ(userID)).
+			Where(subscription.StatusIn(append(liveStatuses, subscription.StatusCreated)...)).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get subscriptions: %w", err)
+		}
+		for _, o := range open {
+			if o.Status != subscription.StatusCreated {
+				return ErrSubscriptionExists
+			}
+		}
+
+		// A checkout left open is replaced: cancel it at Razorpay first so the
+		// user cannot authorize both
+		now := time.Now()
+		for _, o := range open {
+			if _, err := s.gateway.CancelSubscription(o.RazorpaySubscriptionID, false); err != nil {
+				return fmt.Errorf("failed to cancel open razorpay subscription: %w", err)
+			}
+			_, err := client.Subscription.
+				UpdateOneID(o.ID).
+				SetStatus(subscription.StatusCancelled).
+				SetCancelledAt(now).
+				SetEndedAt(now).
+				Save(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to update subscription: %w", err)
+			}
+		}
+
+		subID := uuid.New().String()
+		rzSub, err = s.gateway.CreateSubscription(&CreateSubscriptionInput{
+			PlanID:         *plan.RazorpayPlanID,
+			TotalCount:     cycles,
+			CustomerNotify: 1,
+			Notes: map[string]string{
+				"user_id":         userID,
+				"plan_id":         plan.ID,
+				"subscription_id": subID,
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create razorpay subscription: %w", err)
+		}
+
+		sub, err = client.Subscription.
+			Create().
+			SetID(subID).
+			SetUserID(userID).
+			SetPlanID(plan.ID).
+			SetRazorpaySubscriptionID(rzSub.ID).
+			SetStatus(subscription.StatusCreated).
+			SetNillableShortURL(strPtr(rzSub.ShortURL)).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to save subscription: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.CreateSubscriptionResponse{
+		SubscriptionID:         sub.ID,
+		RazorpaySubscriptionID: rzSub.ID,
+		RazorpayKey:            s.gateway.GetKeyID(),
+		ShortURL:               rzSub.ShortURL,
+		Status:                 string(sub.Status),
+	}, nil
+}
+
+// CancelSubscription cancels the user's subscription. By default an active
+// subscription runs to the end of the paid cycle; otherwise it ends now and
+// the tier is removed immediately.
+func (s *SubscriptionService) CancelSubscription(ctx context.Context, userID string, req *dto.CancelSubscriptionRequest) (*dto.SubscriptionResponse, error) {
+	sub, err := s.liveSubscription(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Razorpay only supports cancelling at cycle end for active subscriptions
+	atCycleEnd := !req.Immediately && sub.Status == subscription.StatusActive
+
+	rzSub, err := s.gateway.CancelSubscription(sub.RazorpaySubscriptionID, atCycleEnd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to cancel razorpay subscription: %w", err)
+	}
+
+	if atCycleEnd {
+		if _, err := sub.Update().SetCancelAtPeriodEnd(true).Save(ctx); err != nil {
+			return nil, fmt.Errorf("failed to update subscription: %w", err)
+		}
+	}
+
+	return s.applyAndRespond(ctx, sub.ID, rzSub)
+}
+
+// PauseSubscription pauses an active subscription. No charges are made and
+// the tier is removed until it is resumed.
+func (s *SubscriptionService) PauseSubscription(ctx context.Context, userID string) (*dto.SubscriptionResponse, error) {
+	sub, err := s.liveSubscription(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if sub.Status != subscription.StatusActive {
+		return nil, ErrSubscriptionNotActive
+	}
+
+	rzSub, err := s.gateway.PauseSubscription(sub.RazorpaySubscriptionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pause razorpay subscription: %w", err)
+	}
+	return s.applyAndRespond(ctx, sub.ID, rzSub)
+}
+
+// ResumeSubscription resumes a paused subscription
+func (s *SubscriptionService) ResumeSubscription(ctx context.Context, userID string) (*dto.SubscriptionResponse, error) {
+	sub, err := s.liveSubscription(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if sub.Status != subscription.StatusPaused {
+		return nil, ErrSubscriptionNotActive
+	}
+
+	rzSub, err := s.gateway.ResumeSubscription(sub.RazorpaySubscriptionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resume razorpay subscription: %w", err)
+	}
+	return s.applyAndRespond(ctx, sub.ID, rzSub)
+}
+
+// HandleSubscriptionEvent applies a subscription.* webhook. It returns false
+// when the subscription is unknown or the event is stale.
+func (s *SubscriptionService) HandleSubscriptionEvent(ctx context.Context, rzSub *RazorpaySubscription) (bool, error) {
+	sub, err := s.entClient.Subscription.
+		Query().
+		Where(subscription.RazorpaySubscriptionIDEQ(rzSub.ID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to get subscription: %w", err)
+	}
+
+	return s.applyRemoteState(ctx, sub.ID, rzSub)
+}
+
+// ExpireLapsed ends subscriptions whose grace period ran out without a
+// successful charge, active subscriptions a grace period past their cycle end
+// (a missed renewal webhook) and cancellations whose paid cycle has ended, then
+// downgrades their users. A later
+// successful charge reactivates them. It returns the number expired.
+func (s *SubscriptionService) ExpireLapsed(ctx context.Context) (int, error) {
+	now := time.Now()
+	lapsed, err := s.entClient.Subscription.
+		Query().
+		Where(subscription.Or(
+			subscription.And(
+				subscription.StatusIn(subscription.StatusPending, subscription.StatusHalted),
+				subscription.GraceUntilLT(now),
+			),
+			subscription.And(
+				subscription.StatusEQ(subscription.StatusActive),
+				subscription.CurrentEndLT(now.Add(-SubscriptionGracePeriod)),
+			),
+			subscription.And(
+				subscription.StatusEQ(subscription.StatusActive),
+				subscription.CancelAtPeriodEndEQ(true),
+				subscription.CurrentEndLT(now),
+			),
+		)).
+		All(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get lapsed subscriptions: %w", err)
+	}
+
+	expired := 0
+	for _, sub := range lapsed {
+		err := database.WithTx(ctx, s.entClient, func(tx *ent.Tx) error {
+			client := tx.Client()
+
+			affected, err := client.Subscription.
+				Update().
+				Where(subscription.IDEQ(sub.ID)).
+				Where(subscription.StatusEQ(sub.Status)).
+				SetStatus(subscription.StatusExpired).
+				SetEndedAt(now).
+				Save(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to expire subscription: %w", err)
+			}
+			if affected == 0 {
+				return nil
+			}
+			expired++
+			return syncUserTier(ctx, client, sub.UserID)
+		})
+		if err != nil {
+			return expired, err
+		}
+	}
+
+	return expired, nil
+}
+
+// applyAndRespond applies an API response and returns the updated subscription
+func (s *SubscriptionService) applyAndRespond(ctx context.Context, id string, rzSub *RazorpaySubscription) (*dto.SubscriptionResponse, error) {
+	if _, err := s.applyRemoteState(ctx, id, rzSub); err != nil {
+		return nil, err
+	}
+
+	sub, err := s.entClient.Subscription.
+		Query().
+		Where(subscription.IDEQ(id)).
+		WithPlan().
+		Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get subscription: %w", err)
+	}
+	return subscriptionToResponse(sub), nil
+}
+
+// applyRemoteState moves a subscription to the state Razorpay reports and
+// recomputes the user's tier, under the subscription's row lock. Cancelled
+// subscriptions are final, so late or replayed events cannot revive them; an
+// expired one only comes back if Razorpay reports it active again, i.e. a
+// retried charge succeeded after the grace period.
+func (s *SubscriptionService) applyRemoteState(ctx context.Context, id string, rzSub *RazorpaySubscription) (bool, error) {
+	status, err := mapSubscriptionStatus(rzSub.Status)
+	if err != nil {
+		return false, err
+	}
+
+	applied := false
+	err = database.WithTx(ctx, s.entClient, func(tx *ent.Tx) error {
+		client := tx.Client()
+
+		sub, err := client.Subscription.
+			Query().
+			Where(subscription.IDEQ(id)).
+			ForUpdate().
+			Only(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get subscription: %w", err)
+		}
+		if sub.Status == subscription.StatusCancelled ||
+			(sub.Status == subscription.StatusExpired && status != subscription.StatusActive) {
+			return nil
+		}
+
+		now := time.Now()
+		update := sub.Update().
+			SetStatus(status).
+			SetPaidCount(rzSub.PaidCount)
+		if rzSub.CurrentStart > 0 {
+			update = update.SetCurrentStart(time.Unix(rzSub.CurrentStart, 0))
+		}
+		if rzSub.CurrentEnd > 0 {
+			update = update.SetCurrentEnd(time.Unix(rzSub.CurrentEnd, 0))
+		}
+
+		switch status {
+		case subscription.StatusActive:
+			update = update.ClearGraceUntil().ClearPausedAt().ClearEndedAt()
+			if sub.ActivatedAt == nil {
+				update = update.SetActivatedAt(now)
+			}
+		case subscription.StatusPending, subscription.StatusHalted:
+			// The grace period runs from the end of the unpaid cycle
+			if sub.GraceUntil == nil {
+				from := now
+				if rzSub.CurrentEnd > 0 && time.Unix(rzSub.CurrentEnd, 0).After(from) {
+					from = time.Unix(rzSub.CurrentEnd, 0)
+				}
+				update = update.SetGraceUntil(from.Add(SubscriptionGracePeriod))
+			}
+		case subscription.StatusPaused:
+			update = update.SetPausedAt(now)
+		case subscription.StatusCancelled, subscription.StatusExpired:
+			if status == subscription.StatusCancelled {
+				update = update.SetCancelledAt(now)
+			}
+			endedAt := now
+			if rzSub.EndedAt > 0 {
+				endedAt = time.Unix(rzSub.EndedAt, 0)
+			}
+			update = update.SetEndedAt(endedAt)
+		}
+
+		if _, err := update.Save(ctx); err != nil {
+			return fmt.Errorf("failed to update subscription: %w", err)
+		}
+		applied = true
+		return syncUserTier(ctx, client, sub.UserID)
+	})
+	if err != nil {
+		return false, err
+	}
+	return applied, nil
+}
+
+// liveSubscription returns the user's most recent live subscription
+func (s *SubscriptionService) liveSubscription(ctx context.Context, userID string) (*ent.Subscription, error) {
+	sub, err := s.entClient.Subscription.
+		Query().
+		Where(subscription.UserIDEQ(userID)).
+		Where(subscription.StatusIn(liveStatuses...)).
+		Order(ent.Desc(subscription.FieldCreatedAt)).
+		WithPlan().
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrNoSubscription
+		}
+		return nil, fmt.Errorf("failed to get subscription: %w", err)
+	}
+	return sub, nil
+}
+
+// syncUserTier sets the user's tier to the highest tier among subscriptions
+// that currently entitle them: active ones, and failing renewals still within
+// their grace period
+func syncUserTier(ctx context.Context, client *ent.Client, userID string) error {
+	subs, err := client.Subscription.
+		Query().
+		Where(subscription.UserIDEQ(userID)).
+		Where(subscription.Or(
+			subscription.StatusEQ(subscription.StatusActive),
+			subscription.And(
+				subscription.StatusIn(subscription.StatusPending, subscription.StatusHalted),
+				subscription.GraceUntilGT(time.Now()),
+			),
+		)).
+		WithPlan().
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get subscriptions: %w", err)
+	}
+
+	tier := user.SubscriptionTierFree
+	for _, sub := range subs {
+		if sub.Edges.Plan == nil {
+			continue
+		}
+		switch sub.Edges.Plan.Tier {
+		case subscriptionplan.TierPro:
+			tier = user.SubscriptionTierPro
+		case subscriptionplan.TierPlus:
+			if tier == user.SubscriptionTierFree {
+				tier = user.SubscriptionTierPlus
+			}
+		}
+	}
+
+	u, err := client.User.Get(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("user not found: %w", err)
+	}
+	if u.SubscriptionTier == tier {
+		return nil
+	}
+
+	if _, err := u.Update().SetSubscriptionTier(tier).Save(ctx); err != nil {
+		return fmt.Errorf("failed to update tier: %w", err)
+	}
+
+	log := logger.GetLogger("payments")
+	log.Info().
+		Str("user_id", userID).
+		Str("from", string(u.SubscriptionTier)).
+		Str("to", string(tier)).
+		Msg("Subscription tier changed")
+	return nil
+}
+
+// mapSubscriptionStatus maps a Razorpay subscription status to ours. A
+// completed subscription has billed all its cycles and is treated as expired.
+func mapSubscriptionStatus(status string) (subscription.Status, error) {
+	if status == "completed" {
+		return subscription.StatusExpired, nil
+	}
+	s := subscription.Status(status)
+	if err := subscription.StatusValidator(s); err != nil {
+		return "", fmt.Errorf("unknown subscription status %q", status)
+	}
+	return s, nil
+}
+
+func isFinalSubscriptionStatus(status subscription.Status) bool {
+	return status == subscription.StatusCancelled || status == subscription.StatusExpired
+}
+
+func subscriptionToResponse(sub *ent.Subscription) *dto.SubscriptionResponse {
+	resp := &dto.SubscriptionResponse{
+		ID:                sub.ID,
+		PlanID:            sub.PlanID,
+		Status:            string(sub.Status),
+		CurrentStart:      sub.CurrentStart,
+		CurrentEnd:        sub.CurrentEnd,
+		GraceUntil:        sub.GraceUntil,
+		CancelAtPeriodEnd: sub.CancelAtPeriodEnd,
+		AutoRenew:         !sub.CancelAtPeriodEnd && !isFinalSubscriptionStatus(sub.Status) && sub.Status != subscription.StatusPaused,
+		CreatedAt:         sub.CreatedAt,
+	}
+	if plan := sub.Edges.Plan; plan != nil {
+		resp.PlanName = plan.Name
+		resp.Tier = string(plan.Tier)
+		resp.BillingPeriod = string(plan.BillingPeriod)
+	}
+	return resp
+}
@@ -0,0 +1,208 @@
+// internal/monetization/services/subscription_service_test.go
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	ent "github.com/UnoraApp/be/ent/generated"
+	"github.com/UnoraApp/be/ent/generated/subscription"
+	"github.com/UnoraApp/be/ent/generated/subscriptionplan"
+	"github.com/UnoraApp/be/ent/generated/user"
+	"github.com/UnoraApp/be/internal/monetization/dto"
+	"github.com/UnoraApp/be/pkg/database/databasetest"
+)
+
+// subscriptionTest is a free user and a Plus plan on the fake gateway.
+// Gateway responses are applied with HandleSubscriptionEvent, as their
+// webhooks would be.
+type subscriptionTest struct {
+	ctx     context.Context
+	client  *ent.Client
+	gateway *FakeGateway
+	subs    *SubscriptionService
+	userID  string
+	planID  string
+}
+
+func newSubscriptionTest(t *testing.T) *subscriptionTest {
+	ctx := context.Background()
+	client := databasetest.NewClient(t)
+	gateway := NewFakeGateway(&FakeGatewayConfig{})
+
+	return &subscriptionTest{
+		ctx:     ctx,
+		client:  client,
+		gateway: gateway,
+		subs:    NewSubscriptionService(client, gateway),
+		userID:  client.User.Create().SetID(uuid.New().String()).SaveX(ctx).ID,
+		planID: client.SubscriptionPlan.
+			Create().
+			SetID(uuid.New().String()).
+			SetName("Plus Monthly").
+			SetTier(subscriptionplan.TierPlus).
+			SetBillingPeriod(subscriptionplan.BillingPeriodMonthly).
+			SetPriceAmount(19900).
+			SetRazorpayPlanID("plan_plus_monthly").
+			SaveX(ctx).ID,
+	}
+}
+
+// create starts a subscription and returns its Razorpay ID
+func (st *subscriptionTest) create(t *testing.T) string {
+	t.Helper()
+	resp, err := st.subs.CreateSubscription(st.ctx, st.userID, &dto.CreateSubscriptionRequest{PlanID: st.planID})
+	if err != nil {
+		t.Fatalf("CreateSubscription: %v", err)
+	}
+	return resp.RazorpaySubscriptionID
+}
+
+// charge bills the subscription's next cycle and applies the result
+func (st *subscriptionTest) charge(t *testing.T, rzSubID string, outcome FakePaymentOutcome) *RazorpaySubscription {
+	t.Helper()
+	rzSub, err := st.gateway.SimulateSubscriptionCharge(st.ctx, rzSubID, outcome)
+	if err != nil {
+		t.Fatalf("SimulateSubscriptionCharge: %v", err)
+	}
+	if _, err := st.subs.HandleSubscriptionEvent(st.ctx, rzSub); err != nil {
+		t.Fatalf("HandleSubscriptionEvent: %v", err)
+	}
+	return rzSub
+}
+
+func (st *subscriptionTest) subscription(rzSubID string) *ent.Subscription {
+	return st.client.Subscription.
+		Query().
+		Where(subscription.RazorpaySubscriptionIDEQ(rzSubID)).
+		OnlyX(st.ctx)
+}
+
+func (st *subscriptionTest) expectTier(t *testing.T, want user.SubscriptionTier) {
+	t.Helper()
+	if got := st.client.User.GetX(st.ctx, st.userID).SubscriptionTier; got != want {
+		t.Errorf("tier = %s, want %s", got, want)
+	}
+}
+
+func (st *subscriptionTest) expireLapsed(t *testing.T, want int) {
+	t.Helper()
+	expired, err := st.subs.ExpireLapsed(st.ctx)
+	if err != nil {
+		t.Fatalf("ExpireLapsed: %v", err)
+	}
+	if expired != want {
+		t.Errorf("ExpireLapsed expired %d, want %d", expired, want)
+	}
+}
+
+func TestSubscriptionActivation(t *testing.T) {
+	st := newSubscriptionTest(t)
+	rzSubID := st.create(t)
+	st.expectTier(t, user.SubscriptionTierFree)
+
+	st.charge(t, rzSubID, FakePaymentSuccess)
+
+	sub := st.subscription(rzSubID)
+	if sub.Status != subscription.StatusActive || sub.ActivatedAt == nil || sub.PaidCount != 1 {
+		t.Errorf("subscription = %s, activated %v, paid %d", sub.Status, sub.ActivatedAt, sub.PaidCount)
+	}
+	st.expectTier(t, user.SubscriptionTierPlus)
+}
+
+func TestSubscriptionGracePeriod(t *testing.T) {
+	st := newSubscriptionTest(t)
+	rzSubID := st.create(t)
+	st.charge(t, rzSubID, FakePaymentSuccess)
+
+	// A declined renewal keeps the tier while the grace period runs
+	st.charge(t, rzSubID, FakePaymentFailure)
+	sub := st.subscription(rzSubID)
+	if sub.Status != subscription.StatusPending || sub.GraceUntil == nil {
+		t.Fatalf("subscription = %s, grace until %v", sub.Status, sub.GraceUntil)
+	}
+	st.expectTier(t, user.SubscriptionTierPlus)
+	st.expireLapsed(t, 0)
+	st.expectTier(t, user.SubscriptionTierPlus)
+
+	// The grace period runs out without a successful charge
+	sub.Update().SetGraceUntil(time.Now().Add(-time.Minute)).SaveX(st.ctx)
+	st.expireLapsed(t, 1)
+	if sub := st.subscription(rzSubID); sub.Status != subscription.StatusExpired || sub.EndedAt == nil {
+		t.Errorf("subscription = %s, ended %v", sub.Status, sub.EndedAt)
+	}
+	st.expectTier(t, user.SubscriptionTierFree)
+}
+
+func TestCancelSubscriptionAtCycleEnd(t *testing.T) {
+	st := newSubscriptionTest(t)
+	rzSubID := st.create(t)
+	st.charge(t, rzSubID, FakePaymentSuccess)
+
+	resp, err := st.subs.CancelSubscription(st.ctx, st.userID, &dto.CancelSubscriptionRequest{})
+	if err != nil {
+		t.Fatalf("CancelSubscription: %v", err)
+	}
+	if resp.Status != string(subscription.StatusActive) || !resp.CancelAtPeriodEnd || resp.AutoRenew {
+		t.Errorf("cancelled subscription = %s, cancel at period end %t, auto renew %t", resp.Status, resp.CancelAtPeriodEnd, resp.AutoRenew)
+	}
+	// The paid cycle is kept
+	st.expectTier(t, user.SubscriptionTierPlus)
+	st.expireLapsed(t, 0)
+
+	// The cycle ends
+	st.subscription(rzSubID).Update().SetCurrentEnd(time.Now().Add(-time.Minute)).SaveX(st.ctx)
+	st.expireLapsed(t, 1)
+	st.expectTier(t, user.SubscriptionTierFree)
+}
+
+func TestCancelledSubscriptionIgnoresLateEvents(t *testing.T) {
+	st := newSubscriptionTest(t)
+	rzSubID := st.create(t)
+	activated := st.charge(t, rzSubID, FakePaymentSuccess)
+
+	if _, err := st.subs.CancelSubscription(st.ctx, st.userID, &dto.CancelSubscriptionRequest{Immediately: true}); err != nil {
+		t.Fatalf("CancelSubscription: %v", err)
+	}
+	st.expectTier(t, user.SubscriptionTierFree)
+
+	// The activation webhook is redelivered after the cancellation
+	applied, err := st.subs.HandleSubscriptionEvent(st.ctx, activated)
+	if err != nil {
+		t.Fatalf("HandleSubscriptionEvent: %v", err)
+	}
+	if applied {
+		t.Error("late activation was applied to a cancelled subscription")
+	}
+	if sub := st.subscription(rzSubID); sub.Status != subscription.StatusCancelled {
+		t.Errorf("subscription = %s, want cancelled", sub.Status)
+	}
+	st.expectTier(t, user.SubscriptionTierFree)
+}
+
+func TestCreateSubscriptionReplacesOpenCheckout(t *testing.T) {
+	st := newSubscriptionTest(t)
+	first := st.create(t)
+	second := st.create(t)
+
+	// The abandoned checkout can no longer be authorized
+	if sub := st.subscription(first); sub.Status != subscription.StatusCancelled {
+		t.Errorf("first subscription = %s, want cancelled", sub.Status)
+	}
+	if rzSub, _ := st.gateway.FetchSubscription(first); rzSub.Status != "cancelled" {
+		t.Errorf("first razorpay subscription = %s, want cancelled", rzSub.Status)
+	}
+	if _, err := st.gateway.SimulateSubscriptionCharge(st.ctx, first, FakePaymentSuccess); err == nil {
+		t.Error("charged the replaced subscription")
+	}
+
+	st.charge(t, second, FakePaymentSuccess)
+	_, err := st.subs.CreateSubscription(st.ctx, st.userID, &dto.CreateSubscriptionRequest{PlanID: st.planID})
+	if !errors.Is(err, ErrSubscriptionExists) {
+		t.Errorf("CreateSubscription with an active subscription: %v, want ErrSubscriptionExists", err)
+	}
+}
@@ -0,0 +1,330 @@
+// internal/monetization/services/webhook_service.go
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	ent "github.com/UnoraApp/be/ent/generated"
+	"github.com/UnoraApp/be/ent/generated/webhookevent"
+	"github.com/UnoraApp/be/pkg/logger"
+	"github.com/UnoraApp/be/pkg/metrics"
+)
+
+// maxRejectedEventIDLength is the longest event ID recorded for a delivery
+// failing signature verification
+const maxRejectedEventIDLength = 64
+
+// RejectedWebhookRetention is how long deliveries failing signature
+// verification are kept before PurgeRejected deletes them
+const RejectedWebhookRetention = 24 * time.Hour
+
+// Webhook errors
+var (
+	ErrInvalidWebhookSignature = errors.New("invalid webhook signature")
+	ErrWebhookNotReplayable    = errors.New("webhook event failed signature verification and cannot be replayed")
+)
+
+// RazorpayWebhookEvent is the envelope of a Razorpay webhook delivery
+type RazorpayWebhookEvent struct {
+	Entity    string                 `json:"entity"`
+	AccountID string                 `json:"account_id"`
+	Event     string                 `json:"event"`
+	Contains  []string               `json:"contains"`
+	Payload   RazorpayWebhookPayload `json:"payload"`
+	CreatedAt int64                  `json:"created_at"`
+}
+
+// RazorpayWebhookPayload holds the entities a webhook event refers to
+type RazorpayWebhookPayload struct {
+	Payment *struct {
+		Entity RazorpayPayment `json:"entity"`
+	} `json:"payment,omitempty"`
+	Order *struct {
+		Entity RazorpayOrder `json:"entity"`
+	} `json:"order,omitempty"`
+	Refund *struct {
+		Entity RazorpayRefund `json:"entity"`
+	} `json:"refund,omitempty"`
+	Subscription *struct {
+		Entity RazorpaySubscription `json:"entity"`
+	} `json:"subscription,omitempty"`
+}
+
+// WebhookService verifies, stores and processes Razorpay webhook deliveries.
+// Verified deliveries are stored with their raw body, deduplicated on the
+// Razorpay event ID and can be replayed; others are recorded without it.
+type WebhookService struct {
+	entClient           *ent.Client
+	gateway             PaymentGateway
+	paymentService      *PaymentService
+	subscriptionService *SubscriptionService
+	refundService       *RefundService
+}
+
+// NewWebhookService creates a new webhook service
+func NewWebhookService(entClient *ent.Client, gateway PaymentGateway, paymentService *PaymentService, subscriptionService *SubscriptionService, refundService *RefundService) *WebhookService {
+	return &WebhookService{
+		entClient:           entClient,
+		gateway:             gateway,
+		paymentService:      paymentService,
+		subscriptionService: subscriptionService,
+		refundService:       refundService,
+	}
+}
+
+// HandleRazorpayWebhook verifies the X-Razorpay-Signature over the raw body,
+// stores the delivery and processes it unless the event was already handled.
+// A returned processing error should be answered with a 5xx so Razorpay retries.
+func (s *WebhookService) HandleRazorpayWebhook(ctx context.Context, body []byte, signature, eventID string) error {
+	if !s.gateway.VerifyWebhookSignature(body, signature) {
+		// Anyone can send these, so only a fingerprint of the delivery is
+		// kept, never its body, and only for RejectedWebhookRetention; it is
+		// never deduplicated or processed
+		metrics.Inc("payments.webhook.rejected")
+		if len(eventID) > maxRejectedEventIDLength {
+			eventID = eventID[:maxRejectedEventIDLength]
+		}
+		_, err := s.entClient.WebhookEvent.
+			Create().
+			SetID(uuid.New().String()).
+			SetPayload("").
+			SetSignatureValid(false).
+			SetProcessingStatus(webhookevent.ProcessingStatusRejected).
+			SetErrorMessage(fmt.Sprintf("signature verification failed (event id %q, %d bytes, sha256 %x)", eventID, len(body), sha256.Sum256(body))).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to store webhook event: %w", err)
+		}
+		return ErrInvalidWebhookSignature
+	}
+
+	var event RazorpayWebhookEvent
+	parseErr := json.Unmarshal(body, &event)
+
+	stored, err := s.entClient.WebhookEvent.
+		Create().
+		SetID(uuid.New().String()).
+		SetEventType(event.Event).
+		SetPayload(string(body)).
+		SetNillableSignature(strPtr(signature)).
+		SetSignatureValid(true).
+		SetNillableEventID(strPtr(eventID)).
+		Save(ctx)
+	if err != nil {
+		if !ent.IsConstraintError(err) {
+			return fmt.Errorf("failed to store webhook event: %w", err)
+		}
+
+		// Redelivery of an event we have seen
+		stored, err = s.entClient.WebhookEvent.
+			Query().
+			Where(webhookevent.ProviderEQ("razorpay")).
+			Where(webhookevent.EventIDEQ(eventID)).
+			Only(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get webhook event: %w", err)
+		}
+		if stored.ProcessingStatus == webhookevent.ProcessingStatusProcessed ||
+			stored.ProcessingStatus == webhookevent.ProcessingStatusIgnored {
+			return nil
+		}
+	}
+
+	if parseErr != nil {
+		_, err := stored.Update().
+			SetProcessingStatus(webhookevent.ProcessingStatusFailed).
+			SetErrorMessage(fmt.Sprintf("invalid payload: %v", parseErr)).
+			AddAttempts(1).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to update webhook event: %w", err)
+		}
+		// A malformed body will not improve on retry
+		return nil
+	}
+
+	_, err = s.process(ctx, stored, &event)
+	return err
+}
+
+// ReplayEvent processes a stored, verified delivery again. Handlers are
+// idempotent, so replaying an already processed event is a no-op.
+func (s *WebhookService) ReplayEvent(ctx context.Context, id string) (*ent.WebhookEvent, error) {
+	stored, err := s.entClient.WebhookEvent.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("webhook event not found")
+		}
+		return nil, fmt.Errorf("failed to get webhook event: %w", err)
+	}
+	if !stored.SignatureValid {
+		return nil, ErrWebhookNotReplayable
+	}
+
+	var event RazorpayWebhookEvent
+	if err := json.Unmarshal([]byte(stored.Payload), &event); err != nil {
+		return nil, fmt.Errorf("invalid payload: %w", err)
+	}
+
+	updated, err := s.process(ctx, stored, &event)
+	if updated == nil {
+		return nil, err
+	}
+	// The outcome, including any processing error, is recorded on the event
+	return updated, nil
+}
+
+// PurgeRejected deletes deliveries that failed signature verification more
+// than RejectedWebhookRetention ago, so unauthenticated senders cannot grow
+// the table without bound. It returns the number deleted.
+func (s *WebhookService) PurgeRejected(ctx context.Context) (int, error) {
+	deleted, err := s.entClient.WebhookEvent.
+		Delete().
+		Where(webhookevent.ProcessingStatusEQ(webhookevent.ProcessingStatusRejected)).
+		Where(webhookevent.ReceivedAtLT(time.Now().Add(-RejectedWebhookRetention))).
+		Exec(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge rejected webhook events: %w", err)
+	}
+	return deleted, nil
+}
+
+// process dispatches an event and records the outcome on the stored delivery
+func (s *WebhookService) process(ctx context.Context, stored *ent.WebhookEvent, event *RazorpayWebhookEvent) (*ent.WebhookEvent, error) {
+	status := webhookevent.ProcessingStatusProcessed
+	note, err := s.dispatch(ctx, event)
+	if err != nil {
+		status = webhookevent.ProcessingStatusFailed
+		note = err.Error()
+	} else if note != "" {
+		status = webhookevent.ProcessingStatusIgnored
+	}
+
+	update := stored.Update().
+		SetProcessingStatus(status).
+		AddAttempts(1).
+		SetProcessedAt(time.Now())
+	if note != "" {
+		update = update.SetErrorMessage(note)
+	} else {
+		update = update.ClearErrorMessage()
+	}
+	updated, saveErr := update.Save(ctx)
+	if saveErr != nil {
+		log := logger.GetLogger("payments")
+		log.Error().Err(saveErr).Str("webhook_event_id", stored.ID).Msg("Failed to record webhook outcome")
+	}
+
+	return updated, err
+}
+
+// dispatch handles one event. It returns a non-empty note when the event was
+// deliberately ignored, and an error when processing failed and should be retried.
+func (s *WebhookService) dispatch(ctx context.Context, event *RazorpayWebhookEvent) (string, error) {
+	switch event.Event {
+	case "payment.captured", "order.paid":
+		if event.Payload.Payment == nil {
+			return "", fmt.Errorf("%s event without payment entity", event.Event)
+		}
+		return s.handleCaptured(ctx, &event.Payload.Payment.Entity)
+
+	case "payment.failed":
+		if event.Payload.Payment == nil {
+			return "", fmt.Errorf("payment.failed event without payment entity")
+		}
+		payment := event.Payload.Payment.Entity
+		if payment.OrderID == "" {
+			return "payment has no order", nil
+		}
+		updated, err := s.paymentService.MarkOrderFailed(ctx, payment.OrderID, payment.ErrorDescription)
+		if err != nil {
+			return "", err
+		}
+		if !updated {
+			return "order is unknown or no longer awaiting payment", nil
+		}
+		return "", nil
+
+	case "refund.processed":
+		if event.Payload.Refund == nil {
+			return "", fmt.Errorf("refund.processed event without refund entity")
+		}
+		applied, err := s.refundService.HandleRefundProcessed(ctx, &event.Payload.Refund.Entity)
+		if err != nil {
+			if errors.Is(err, ErrOrderNotFound) {
+				return "refund for unknown payment", nil
+			}
+			return "", err
+		}
+		if !applied {
+			return "refund already applied", nil
+		}
+		return "", nil
+
+	case "refund.failed":
+		if event.Payload.Refund == nil {
+			return "", fmt.Errorf("refund.failed event without refund entity")
+		}
+		failed, err := s.refundService.HandleRefundFailed(ctx, &event.Payload.Refund.Entity)
+		if err != nil {
+			return "", err
+		}
+		if !failed {
+			return "refund is unknown or already settled", nil
+		}
+		return "", nil
+
+	case "subscription.authenticated", "subscription.activated", "subscription.charged",
+		"subscription.pending", "subscription.halted", "subscription.paused",
+		"subscription.resumed", "subscription.cancelled", "subscription.completed",
+		"subscription.updated":
+		if event.Payload.Subscription == nil {
+			return "", fmt.Errorf("%s event without subscription entity", event.Event)
+		}
+		applied, err := s.subscriptionService.HandleSubscriptionEvent(ctx, &event.Payload.Subscription.Entity)
+		if err != nil {
+			return "", err
+		}
+		if !applied {
+			return "subscription is unknown or already ended", nil
+		}
+		return "", nil
+
+	default:
+		return fmt.Sprintf("unhandled event type %q", event.Event), nil
+	}
+}
+
+// handleCaptured fulfils the order for a captured payment, covering clients
+// that paid but never called verify
+func (s *WebhookService) handleCaptured(ctx context.Context, payment *RazorpayPayment) (string, error) {
+	if payment.OrderID == "" {
+		return "payment has no order", nil
+	}
+
+	order, err := s.paymentService.GetOrderByRazorpayOrderID(ctx, payment.OrderID)
+	if err != nil {
+		if errors.Is(err, ErrOrderNotFound) {
+			return "payment for unknown order", nil
+		}
+		return "", err
+	}
+	if payment.Amount != order.Amount || (payment.Currency != "" && payment.Currency != order.Currency) {
+		return "", fmt.Errorf("%w: paid %d %s, order %d %s", ErrPaymentAmountMismatch, payment.Amount, payment.Currency, order.Amount, order.Currency)
+	}
+
+	if _, err := s.paymentService.FulfilOrder(ctx, order, payment.ID, ""); err != nil {
+		if errors.Is(err, ErrPaymentAlreadyProcessed) {
+			return "order already paid", nil
+		}
+		return "", err
+	}
+	return "", nil
+}
@@ -0,0 +1,80 @@
+// internal/monetization/services/webhook_service_test.go
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/UnoraApp/be/ent/generated/webhookevent"
+	"github.com/UnoraApp/be/pkg/database/databasetest"
+)
+
+func TestHandleRazorpayWebhookBadSignature(t *testing.T) {
+	ctx := context.Background()
+	client := databasetest.NewClient(t)
+	payments, gateway := newTestPaymentService(client)
+	webhooks := NewWebhookService(client, gateway, payments, nil, nil)
+
+	body := []byte(`{"event":"payment.captured","payload":{"note":"` + strings.Repeat("x", 4096) + `"}}`)
+	longEventID := strings.Repeat("e", 500)
+
+	err := webhooks.HandleRazorpayWebhook(ctx, body, strings.Repeat("s", 1000), longEventID)
+	if !errors.Is(err, ErrInvalidWebhookSignature) {
+		t.Fatalf("HandleRazorpayWebhook error = %v, want ErrInvalidWebhookSignature", err)
+	}
+
+	stored := client.WebhookEvent.Query().OnlyX(ctx)
+	if stored.ProcessingStatus != webhookevent.ProcessingStatusRejected || stored.SignatureValid {
+		t.Errorf("stored status = %s, signature valid = %t", stored.ProcessingStatus, stored.SignatureValid)
+	}
+	if stored.Payload != "" || stored.Signature != nil || stored.EventID != nil {
+		t.Error("rejected delivery stored with its body, signature or event ID")
+	}
+	if msg := *stored.ErrorMessage; len(msg) > 200 || !strings.Contains(msg, fmt.Sprintf("%d bytes", len(body))) {
+		t.Errorf("error message = %q", msg)
+	}
+}
+
+func TestPurgeRejected(t *testing.T) {
+	ctx := context.Background()
+	client := databasetest.NewClient(t)
+	payments, gateway := newTestPaymentService(client)
+	webhooks := NewWebhookService(client, gateway, payments, nil, nil)
+
+	old := time.Now().Add(-RejectedWebhookRetention - time.Hour)
+	event := func(status webhookevent.ProcessingStatus, receivedAt time.Time) string {
+		return client.WebhookEvent.
+			Create().
+			SetID(uuid.New().String()).
+			SetPayload("").
+			SetSignatureValid(status != webhookevent.ProcessingStatusRejected).
+			SetProcessingStatus(status).
+			SetReceivedAt(receivedAt).
+			SaveX(ctx).ID
+	}
+	expired := event(webhookevent.ProcessingStatusRejected, old)
+	recent := event(webhookevent.ProcessingStatusRejected, time.Now())
+	processed := event(webhookevent.ProcessingStatusProcessed, old)
+
+	deleted, err := webhooks.PurgeRejected(ctx)
+	if err != nil {
+		t.Fatalf("PurgeRejected: %v", err)
+	}
+	if deleted != 1 {
+		t.Errorf("PurgeRejected deleted %d, want 1", deleted)
+	}
+	if client.WebhookEvent.Query().Where(webhookevent.IDEQ(expired)).ExistX(ctx) {
+		t.Error("rejected delivery past its retention was kept")
+	}
+	for _, id := range []string{recent, processed} {
+		if !client.WebhookEvent.Query().Where(webhookevent.IDEQ(id)).ExistX(ctx) {
+			t.Errorf("webhook event %s was purged", id)
+		}
+	}
+}
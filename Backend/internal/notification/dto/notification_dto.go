@@ -0,0 +1,24 @@
+// internal/notification/dto/notification_dto.go
+package dto
+
+import "time"
+
+// NotificationResponse represents an in-app notification
+// @Description In-app notification
+type NotificationResponse struct {
+	ID        string            `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Type      string            `json:"type" example:"reveal_gift_offered"`
+	Title     string            `json:"title" example:"Priya wants to show you a reveal early"`
+	Body      string            `json:"body" example:"Accept to unlock the Values reveal now."`
+	Data      map[string]string `json:"data,omitempty"`
+	IsRead    bool              `json:"isRead" example:"false"`
+	CreatedAt time.Time         `json:"createdAt" example:"2024-01-15T10:30:00Z"`
+	ReadAt    *time.Time        `json:"readAt,omitempty"`
+}
+
+// NotificationListResponse is a user's notification inbox
+// @Description Notification inbox, newest first
+type NotificationListResponse struct {
+	Notifications []NotificationResponse `json:"notifications"`
+	UnreadCount   int                    `json:"unreadCount" example:"3"`
+}
@@ -0,0 +1,99 @@
+// internal/notification/handlers/notification_handler.go
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/UnoraApp/be/internal/notification/services"
+	"github.com/UnoraApp/be/pkg/apperror"
+	"github.com/UnoraApp/be/pkg/response"
+)
+
+// NotificationHandler handles notification HTTP requests
+type NotificationHandler struct {
+	notificationService *services.NotificationService
+}
+
+// NewNotificationHandler creates a new notification handler
+func NewNotificationHandler(notificationService *services.NotificationService) *NotificationHandler {
+	return &NotificationHandler{
+		notificationService: notificationService,
+	}
+}
+
+// ListNotifications godoc
+// @Summary      List notifications
+// @Description  Get the authenticated user's notifications, newest first
+// @Tags         notifications
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        unreadOnly query bool false "Only unread notifications"
+// @Param        limit query int false "Number of notifications (default 50, max 100)"
+// @Success      200 {object} response.APIResponse{data=dto.NotificationListResponse} "Notifications"
+// @Failure      401 {object} response.APIResponse "Not authenticated"
+// @Router       /notifications [get]
+func (h *NotificationHandler) ListNotifications(c *gin.Context) {
+	userID, _ := c.Get("userID")
+	unreadOnly := c.Query("unreadOnly") == "true"
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "50"))
+
+	notifications, err := h.notificationService.List(c.Request.Context(), userID.(string), unreadOnly, limit)
+	if err != nil {
+		apperror.HandleError(c, apperror.InternalError(err))
+		return
+	}
+	response.JSON(c, http.StatusOK, notifications)
+}
+
+// MarkRead godoc
+// @Summary      Mark notification read
+// @Description  Mark one notification as read
+// @Tags         notifications
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        notificationId path string true "Notification ID"
+// @Success      200 {object} response.APIResponse{data=dto.NotificationResponse} "Notification"
+// @Failure      401 {object} response.APIResponse "Not authenticated"
+// @Failure      404 {object} response.APIResponse "Notification not found"
+// @Router       /notifications/{notificationId}/read [post]
+func (h *NotificationHandler) MarkRead(c *gin.Context) {
+	userID, _ := c.Get("userID")
+	notificationID := c.Param("notificationId")
+
+	notification, err := h.notificationService.MarkRead(c.Request.Context(), userID.(string), notificationID)
+	if err != nil {
+		if err.Error() == "notification not found" {
+			apperror.HandleError(c, apperror.NotFound("notification"))
+			return
+		}
+		apperror.HandleError(c, apperror.InternalError(err))
+		return
+	}
+	response.JSON(c, http.StatusOK, notification)
+}
+
+// MarkAllRead godoc
+// @Summary      Mark all notifications read
+// @Description  Mark every unread notification as read
+// @Tags         notifications
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200 {object} response.APIResponse{data=map[string]int} "Number of notifications marked read"
+// @Failure      401 {object} response.APIResponse "Not authenticated"
+// @Router       /notifications/read-all [post]
+func (h *NotificationHandler) MarkAllRead(c *gin.Context) {
+	userID, _ := c.Get("userID")
+
+	updated, err := h.notificationService.MarkAllRead(c.Request.Context(), userID.(string))
+	if err != nil {
+		apperror.HandleError(c, apperror.InternalError(err))
+		return
+	}
+	response.JSON(c, http.StatusOK, gin.H{"updated": updated})
+}
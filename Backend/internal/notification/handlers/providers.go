@@ -0,0 +1,9 @@
+// internal/notification/handlers/providers.go
+package handlers
+
+import "github.com/google/wire"
+
+// ProviderSet is the wire provider set for notification handlers
+var ProviderSet = wire.NewSet(
+	NewNotificationHandler,
+)
@@ -0,0 +1,28 @@
+// internal/notification/routes/notification_routes.go
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/UnoraApp/be/internal/notification/handlers"
+	"github.com/UnoraApp/be/internal/notification/services"
+)
+
+// RegisterNotificationRoutes registers all notification routes
+func RegisterNotificationRoutes(
+	router *gin.RouterGroup,
+	notificationService *services.NotificationService,
+	authMiddleware gin.HandlerFunc,
+) {
+	// Create handler
+	handler := handlers.NewNotificationHandler(notificationService)
+
+	// All notification routes require authentication
+	protected := router.Group("")
+	protected.Use(authMiddleware)
+	{
+		protected.GET("/notifications", handler.ListNotifications)
+		protected.POST("/notifications/read-all", handler.MarkAllRead)
+		protected.POST("/notifications/:notificationId/read", handler.MarkRead)
+	}
+}
@@ -0,0 +1,149 @@
+// internal/notification/services/notification_service.go
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	ent "github.com/UnoraApp/be/ent/generated"
+	"github.com/UnoraApp/be/ent/generated/notification"
+	"github.com/UnoraApp/be/internal/notification/dto"
+)
+
+// Notification types
+const (
+	TypeRevealGiftOffered   = "reveal_gift_offered"
+	TypeRevealGiftSent      = "reveal_gift_sent"
+	TypeRevealGiftAccepted  = "reveal_gift_accepted"
+	TypeRevealGiftDeclined  = "reveal_gift_declined"
+	TypeRevealGiftCancelled = "reveal_gift_cancelled"
+	TypeRevealGiftFailed    = "reveal_gift_failed"
+	TypeConnectionEnded     = "connection_ended"
+)
+
+// NotificationService handles the in-app notification inbox
+type NotificationService struct {
+	entClient *ent.Client
+}
+
+// NewNotificationService creates a new notification service
+func NewNotificationService(entClient *ent.Client) *NotificationService {
+	return &NotificationService{
+		entClient: entClient,
+	}
+}
+
+// Notify adds a notification to the user's inbox
+func (s *NotificationService) Notify(ctx context.Context, userID, notificationType, title, body string, data map[string]string) (*ent.Notification, error) {
+	n, err := s.entClient.Notification.
+		Create().
+		SetID(uuid.New().String()).
+		SetUserID(userID).
+		SetNotificationType(notificationType).
+		SetTitle(title).
+		SetBody(body).
+		SetData(data).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create notification: %w", err)
+	}
+	return n, nil
+}
+
+// List returns the user's notifications, newest first
+func (s *NotificationService) List(ctx context.Context, userID string, unreadOnly bool, limit int) (*dto.NotificationListResponse, error) {
+	if limit <= 0 || limit > 100 {
+		limit = 50
+	}
+
+	query := s.entClient.Notification.
+		Query().
+		Where(notification.UserIDEQ(userID))
+	if unreadOnly {
+		query = query.Where(notification.ReadAtIsNil())
+	}
+
+	notifications, err := query.
+		Order(ent.Desc(notification.FieldCreatedAt)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get notifications: %w", err)
+	}
+
+	unread, err := s.entClient.Notification.
+		Query().
+		Where(notification.UserIDEQ(userID)).
+		Where(notification.ReadAtIsNil()).
+		Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count notifications: %w", err)
+	}
+
+	result := make([]dto.NotificationResponse, len(notifications))
+	for i, n := range notifications {
+		result[i] = toNotificationResponse(n)
+	}
+
+	return &dto.NotificationListResponse{
+		Notifications: result,
+		UnreadCount:   unread,
+	}, nil
+}
+
+// MarkRead marks one of the user's notifications as read
+func (s *NotificationService) MarkRead(ctx context.Context, userID, notificationID string) (*dto.NotificationResponse, error) {
+	n, err := s.entClient.Notification.
+		Query().
+		Where(notification.IDEQ(notificationID)).
+		Where(notification.UserIDEQ(userID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("notification not found")
+		}
+		return nil, fmt.Errorf("failed to get notification: %w", err)
+	}
+
+	if n.ReadAt == nil {
+		n, err = n.Update().
+			SetReadAt(time.Now()).
+			Save(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to mark notification read: %w", err)
+		}
+	}
+
+	resp := toNotificationResponse(n)
+	return &resp, nil
+}
+
+// MarkAllRead marks every unread notification of the user as read
+func (s *NotificationService) MarkAllRead(ctx context.Context, userID string) (int, error) {
+	updated, err := s.entClient.Notification.
+		Update().
+		Where(notification.UserIDEQ(userID)).
+		Where(notification.ReadAtIsNil()).
+		SetReadAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to mark notifications read: %w", err)
+	}
+	return updated, nil
+}
+
+func toNotificationResponse(n *ent.Notification) dto.NotificationResponse {
+	return dto.NotificationResponse{
+		ID:        n.ID,
+		Type:      n.NotificationType,
+		Title:     n.Title,
+		Body:      n.Body,
+		Data:      n.Data,
+		IsRead:    n.ReadAt != nil,
+		CreatedAt: n.CreatedAt,
+		ReadAt:    n.ReadAt,
+	}
+}
@@ -0,0 +1,9 @@
+// internal/notification/services/providers.go
+package services
+
+import "github.com/google/wire"
+
+// ProviderSet is the wire provider set for notification services
+var ProviderSet = wire.NewSet(
+	NewNotificationService,
+)
@@ -126,7 +126,7 @@ func (h *ProfileHandler) UpdateProfile(c *gin.Context) {
 
 // DeleteProfile godoc
 // @Summary      Delete user profile
-// @Description  Soft delete the authenticated user's profile
+// @Description  Soft delete the authenticated user's profile. The account itself is deleted through POST /me/deletion.
 // @Tags         profile
 // @Accept       json
 // @Produce      json
@@ -6,8 +6,10 @@ import (
 
 	ent "github.com/UnoraApp/be/ent/generated"
 	authservices "github.com/UnoraApp/be/internal/auth/services"
+	monetizationservices "github.com/UnoraApp/be/internal/monetization/services"
 	"github.com/UnoraApp/be/internal/profile/handlers"
 	"github.com/UnoraApp/be/internal/profile/services"
+	"github.com/UnoraApp/be/pkg/ratelimit"
 	"github.com/UnoraApp/be/pkg/storage"
 )
 
@@ -17,11 +19,13 @@ func RegisterProfileRoutes(
 	entClient *ent.Client,
 	storageClient storage.Client,
 	authService *authservices.AuthService,
+	rewardsService *monetizationservices.RewardsService,
 	authMiddleware gin.HandlerFunc,
+	limiter *ratelimit.Limiter,
 ) {
 	// Create services
-	profileService := services.NewProfileService(entClient, storageClient)
-	photoService := services.NewPhotoService(entClient, storageClient)
+	profileService := services.NewProfileService(entClient, storageClient, rewardsService)
+	photoService := services.NewPhotoService(entClient, storageClient, rewardsService)
 
 	// Create handler
 	profileHandler := handlers.NewProfileHandler(profileService, photoService)
@@ -42,7 +46,7 @@ func RegisterProfileRoutes(
 		protected.GET("/profile/onboarding-status", profileHandler.GetOnboardingStatus)
 
 		// Photos
-		protected.POST("/photos/upload-url", profileHandler.GetUploadURL)
+		protected.POST("/photos/upload-url", limiter.Middleware("photo_upload"), profileHandler.GetUploadURL)
 		protected.POST("/photos", profileHandler.RegisterPhoto)
 		protected.GET("/photos", profileHandler.GetUserPhotos)
 		protected.PUT("/photos/:id/order", profileHandler.UpdatePhotoOrder)
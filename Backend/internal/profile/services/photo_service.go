@@ -10,21 +10,24 @@ import (
 
 	ent "github.com/UnoraApp/be/ent/generated"
 	"github.com/UnoraApp/be/ent/generated/photo"
+	monetizationservices "github.com/UnoraApp/be/internal/monetization/services"
 	"github.com/UnoraApp/be/internal/profile/dto"
 	"github.com/UnoraApp/be/pkg/storage"
 )
 
 // PhotoService handles photo-related business logic
 type PhotoService struct {
-	entClient     *ent.Client
-	storageClient storage.Client
+	entClient      *ent.Client
+	storageClient  storage.Client
+	rewardsService *monetizationservices.RewardsService
 }
 
 // NewPhotoService creates a new photo service
-func NewPhotoService(entClient *ent.Client, storageClient storage.Client) *PhotoService {
+func NewPhotoService(entClient *ent.Client, storageClient storage.Client, rewardsService *monetizationservices.RewardsService) *PhotoService {
 	return &PhotoService{
-		entClient:     entClient,
-		storageClient: storageClient,
+		entClient:      entClient,
+		storageClient:  storageClient,
+		rewardsService: rewardsService,
 	}
 }
 
@@ -76,6 +79,8 @@ func (s *PhotoService) RegisterPhoto(ctx context.Context, userID string, req *dt
 		return nil, fmt.Errorf("failed to register photo: %w", err)
 	}
 
+	checkOnboarding(ctx, s.entClient, s.rewardsService, userID)
+
 	return s.photoToResponse(ctx, p), nil
 }
 
@@ -13,21 +13,25 @@ import (
 	"github.com/UnoraApp/be/ent/generated/hobbyoption"
 	"github.com/UnoraApp/be/ent/generated/photo"
 	"github.com/UnoraApp/be/ent/generated/profile"
+	monetizationservices "github.com/UnoraApp/be/internal/monetization/services"
 	"github.com/UnoraApp/be/internal/profile/dto"
+	"github.com/UnoraApp/be/pkg/logger"
 	"github.com/UnoraApp/be/pkg/storage"
 )
 
 // ProfileService handles profile-related business logic
 type ProfileService struct {
-	entClient     *ent.Client
-	storageClient storage.Client
+	entClient      *ent.Client
+	storageClient  storage.Client
+	rewardsService *monetizationservices.RewardsService
 }
 
 // NewProfileService creates a new profile service
-func NewProfileService(entClient *ent.Client, storageClient storage.Client) *ProfileService {
+func NewProfileService(entClient *ent.Client, storageClient storage.Client, rewardsService *monetizationservices.RewardsService) *ProfileService {
 	return &ProfileService{
-		entClient:     entClient,
-		storageClient: storageClient,
+		entClient:      entClient,
+		storageClient:  storageClient,
+		rewardsService: rewardsService,
 	}
 }
 
@@ -70,6 +74,8 @@ func (s *ProfileService) CreateProfile(ctx context.Context, userID string, req *
 		return nil, fmt.Errorf("failed to create profile: %w", err)
 	}
 
+	checkOnboarding(ctx, s.entClient, s.rewardsService, userID)
+
 	return profileToResponse(p), nil
 }
 
@@ -157,22 +163,31 @@ func (s *ProfileService) DeleteProfile(ctx context.Context, userID string) error
 
 // GetOnboardingStatus returns the onboarding completion status
 func (s *ProfileService) GetOnboardingStatus(ctx context.Context, userID string) (*dto.OnboardingStatusResponse, error) {
+	status := onboardingStatus(ctx, s.entClient, userID)
+	if status.IsComplete {
+		completeOnboarding(ctx, s.rewardsService, userID)
+	}
+	return status, nil
+}
+
+// onboardingStatus works out which onboarding steps the user has done
+func onboardingStatus(ctx context.Context, client *ent.Client, userID string) *dto.OnboardingStatusResponse {
 	// Check profile
-	profileExists, _ := s.entClient.Profile.
+	profileExists, _ := client.Profile.
 		Query().
 		Where(profile.UserIDEQ(userID)).
 		Where(profile.DeletedAtIsNil()).
 		Exist(ctx)
 
 	// Count photos
-	photoCount, _ := s.entClient.Photo.
+	photoCount, _ := client.Photo.
 		Query().
 		Where(photo.UserIDEQ(userID)).
 		Where(photo.DeletedAtIsNil()).
 		Count(ctx)
 
 	// Count hobbies
-	hobbyCount, _ := s.entClient.Hobby.
+	hobbyCount, _ := client.Hobby.
 		Query().
 		Where(hobby.UserIDEQ(userID)).
 		Where(hobby.DeletedAtIsNil()).
@@ -192,7 +207,25 @@ func (s *ProfileService) GetOnboardingStatus(ctx context.Context, userID string)
 	}
 	status.IsComplete = status.ProfileComplete && status.PhotosComplete && status.HobbiesComplete
 
-	return status, nil
+	return status
+}
+
+// checkOnboarding completes onboarding once the step just taken was the last
+// one missing
+func checkOnboarding(ctx context.Context, client *ent.Client, rewardsService *monetizationservices.RewardsService, userID string) {
+	if onboardingStatus(ctx, client, userID).IsComplete {
+		completeOnboarding(ctx, rewardsService, userID)
+	}
+}
+
+// completeOnboarding marks onboarding completed, which grants the welcome
+// bonus the first time. A failure is logged rather than failing the request
+// that finished onboarding; the next status check retries it.
+func completeOnboarding(ctx context.Context, rewardsService *monetizationservices.RewardsService, userID string) {
+	if err := rewardsService.CompleteOnboarding(ctx, userID); err != nil {
+		log := logger.GetLogger("profile")
+		log.Error().Err(err).Str("user_id", userID).Msg("Failed to complete onboarding")
+	}
 }
 
 // GetHobbyOptions returns all active hobby options
@@ -258,6 +291,8 @@ func (s *ProfileService) AddHobby(ctx context.Context, userID string, req *dto.A
 		return nil, fmt.Errorf("failed to add hobby: %w", err)
 	}
 
+	checkOnboarding(ctx, s.entClient, s.rewardsService, userID)
+
 	return &dto.HobbyResponse{
 		ID: h.ID,
 		HobbyOption: dto.HobbyOptionResponse{
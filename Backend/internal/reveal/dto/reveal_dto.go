@@ -57,7 +57,8 @@ type ConnectionRevealsResponse struct {
 // UnlockRevealRequest is the request to unlock a reveal
 // @Description Request to unlock a reveal
 type UnlockRevealRequest struct {
-	UseCredits bool `json:"useCredits" example:"false"`
+	UseCredits     bool   `json:"useCredits" example:"false"`
+	IdempotencyKey string `json:"idempotencyKey,omitempty" validate:"omitempty,max=64" example:"6f1c2a9e-unlock-2"`
 }
 
 // UnlockRevealResponse is the response after unlocking
@@ -67,5 +68,59 @@ type UnlockRevealResponse struct {
 	Reveal          *RevealResponse `json:"reveal"`
 	CreditsUsed     int             `json:"creditsUsed" example:"0"`
 	RemainingCredits int            `json:"remainingCredits" example:"100"`
+	TransactionID   string          `json:"transactionId,omitempty" example:"550e8400-e29b-41d4-a716-446655440000"`
 	Message         string          `json:"message" example:"Reveal unlocked! Check out your new insights."`
 }
+
+// MarkRevealViewedRequest records one viewing of a reveal
+// @Description Reveal view event with optional client-reported time spent
+type MarkRevealViewedRequest struct {
+	DurationSeconds *int `json:"durationSeconds,omitempty" validate:"omitempty,gte=0" example:"42"`
+}
+
+// RevealViewResponse is the result of recording a view
+// @Description Recorded reveal view
+type RevealViewResponse struct {
+	RevealID    string `json:"revealId" example:"550e8400-e29b-41d4-a716-446655440000"`
+	IsFirstView bool   `json:"isFirstView" example:"true"`
+	ViewCount   int    `json:"viewCount" example:"1"`
+}
+
+// GiftRevealRequest offers to unlock one of the connection's upcoming reveals for the partner
+// @Description Gift an early reveal to your connection, paid with your credits
+type GiftRevealRequest struct {
+	ConsentToReveal bool `json:"consentToReveal" validate:"required" example:"true"`
+}
+
+// RevealGiftResponse represents a reveal gift
+// @Description Reveal gift between connected users
+type RevealGiftResponse struct {
+	ID           string     `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	ConnectionID string     `json:"connectionId" example:"550e8400-e29b-41d4-a716-446655440000"`
+	MilestoneID  string     `json:"milestoneId" example:"550e8400-e29b-41d4-a716-446655440000"`
+	RevealNumber int        `json:"revealNumber" example:"2"`
+	Title        string     `json:"title" example:"Values Reveal"`
+	GifterID     string     `json:"gifterId" example:"550e8400-e29b-41d4-a716-446655440000"`
+	RecipientID  string     `json:"recipientId" example:"550e8400-e29b-41d4-a716-446655440000"`
+	CreditCost   int        `json:"creditCost" example:"50"`
+	Status       string     `json:"status" example:"pending"`
+	RevealID     string     `json:"revealId,omitempty" example:"550e8400-e29b-41d4-a716-446655440000"`
+	ExpiresAt    time.Time  `json:"expiresAt" example:"2024-01-07T00:00:00Z"`
+	CreatedAt    time.Time  `json:"createdAt" example:"2024-01-05T00:00:00Z"`
+	RespondedAt  *time.Time `json:"respondedAt,omitempty"`
+}
+
+// RevealGiftListResponse lists the user's reveal gifts
+// @Description Reveal gifts received and sent, newest first
+type RevealGiftListResponse struct {
+	Received []RevealGiftResponse `json:"received"`
+	Sent     []RevealGiftResponse `json:"sent"`
+}
+
+// AcceptRevealGiftResponse is the response after accepting a gift
+// @Description Accepted gift and the reveal it unlocked
+type AcceptRevealGiftResponse struct {
+	Gift    *RevealGiftResponse `json:"gift"`
+	Reveal  *RevealResponse     `json:"reveal"`
+	Message string              `json:"message" example:"Reveal unlocked! Check out your new insights."`
+}
@@ -0,0 +1,185 @@
+// internal/reveal/handlers/gift_handler.go
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	monetizationservices "github.com/UnoraApp/be/internal/monetization/services"
+	"github.com/UnoraApp/be/internal/reveal/dto"
+	"github.com/UnoraApp/be/internal/reveal/services"
+	"github.com/UnoraApp/be/pkg/apperror"
+	"github.com/UnoraApp/be/pkg/response"
+)
+
+// RevealGiftHandler handles reveal gift HTTP requests
+type RevealGiftHandler struct {
+	giftService *services.RevealGiftService
+}
+
+// NewRevealGiftHandler creates a new reveal gift handler
+func NewRevealGiftHandler(giftService *services.RevealGiftService) *RevealGiftHandler {
+	return &RevealGiftHandler{
+		giftService: giftService,
+	}
+}
+
+// GiftReveal godoc
+// @Summary      Gift an early reveal
+// @Description  Offer to let your connection see an upcoming reveal early, paid with your credits. Credits are only used once your connection accepts. The identity reveal cannot be gifted, and gifts count against the early-reveal allowance of both users' tiers.
+// @Tags         reveals
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        connectionId path string true "Connection ID"
+// @Param        milestoneId path string true "Milestone ID"
+// @Param        request body dto.GiftRevealRequest true "Consent to reveal early"
+// @Success      201 {object} response.APIResponse{data=dto.RevealGiftResponse} "Gift offered"
+// @Failure      400 {object} response.APIResponse "Consent missing or insufficient credits"
+// @Failure      401 {object} response.APIResponse "Not authenticated"
+// @Failure      403 {object} response.APIResponse "Early reveal limit reached for tier or identity reveal"
+// @Failure      404 {object} response.APIResponse "Connection or milestone not found"
+// @Failure      409 {object} response.APIResponse "Reveal already unlocked or gift already pending"
+// @Router       /connections/{connectionId}/reveals/{milestoneId}/gift [post]
+func (h *RevealGiftHandler) GiftReveal(c *gin.Context) {
+	userID, _ := c.Get("userID")
+	connectionID := c.Param("connectionId")
+	milestoneID := c.Param("milestoneId")
+
+	var req dto.GiftRevealRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apperror.HandleError(c, apperror.BadRequest(err.Error()))
+		return
+	}
+
+	gift, err := h.giftService.OfferGift(c.Request.Context(), userID.(string), connectionID, milestoneID, &req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+	response.JSON(c, http.StatusCreated, gift)
+}
+
+// ListGifts godoc
+// @Summary      List reveal gifts
+// @Description  Get reveal gifts the authenticated user has received and sent
+// @Tags         reveals
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200 {object} response.APIResponse{data=dto.RevealGiftListResponse} "Reveal gifts"
+// @Failure      401 {object} response.APIResponse "Not authenticated"
+// @Router       /reveal-gifts [get]
+func (h *RevealGiftHandler) ListGifts(c *gin.Context) {
+	userID, _ := c.Get("userID")
+
+	gifts, err := h.giftService.ListGifts(c.Request.Context(), userID.(string))
+	if err != nil {
+		apperror.HandleError(c, apperror.InternalError(err))
+		return
+	}
+	response.JSON(c, http.StatusOK, gifts)
+}
+
+// AcceptGift godoc
+// @Summary      Accept reveal gift
+// @Description  Accept a reveal gift. Unlocks the reveal for both users and debits the sender's credits.
+// @Tags         reveals
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        giftId path string true "Gift ID"
+// @Success      200 {object} response.APIResponse{data=dto.AcceptRevealGiftResponse} "Gift accepted"
+// @Failure      401 {object} response.APIResponse "Not authenticated"
+// @Failure      403 {object} response.APIResponse "Early reveal limit reached for tier"
+// @Failure      404 {object} response.APIResponse "Gift not found"
+// @Failure      409 {object} response.APIResponse "Gift no longer pending, expired or could not be paid for"
+// @Router       /reveal-gifts/{giftId}/accept [post]
+func (h *RevealGiftHandler) AcceptGift(c *gin.Context) {
+	userID, _ := c.Get("userID")
+	giftID := c.Param("giftId")
+
+	result, err := h.giftService.AcceptGift(c.Request.Context(), userID.(string), giftID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+	response.JSON(c, http.StatusOK, result)
+}
+
+// DeclineGift godoc
+// @Summary      Decline reveal gift
+// @Description  Decline a reveal gift. No credits are used.
+// @Tags         reveals
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        giftId path string true "Gift ID"
+// @Success      200 {object} response.APIResponse{data=dto.RevealGiftResponse} "Gift declined"
+// @Failure      401 {object} response.APIResponse "Not authenticated"
+// @Failure      404 {object} response.APIResponse "Gift not found"
+// @Failure      409 {object} response.APIResponse "Gift no longer pending"
+// @Router       /reveal-gifts/{giftId}/decline [post]
+func (h *RevealGiftHandler) DeclineGift(c *gin.Context) {
+	userID, _ := c.Get("userID")
+	giftID := c.Param("giftId")
+
+	gift, err := h.giftService.DeclineGift(c.Request.Context(), userID.(string), giftID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+	response.JSON(c, http.StatusOK, gift)
+}
+
+// CancelGift godoc
+// @Summary      Cancel reveal gift
+// @Description  Withdraw a reveal gift you sent that has not been accepted yet. No credits are used.
+// @Tags         reveals
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        giftId path string true "Gift ID"
+// @Success      200 {object} response.APIResponse{data=dto.RevealGiftResponse} "Gift cancelled"
+// @Failure      401 {object} response.APIResponse "Not authenticated"
+// @Failure      404 {object} response.APIResponse "Gift not found"
+// @Failure      409 {object} response.APIResponse "Gift no longer pending"
+// @Router       /reveal-gifts/{giftId}/cancel [post]
+func (h *RevealGiftHandler) CancelGift(c *gin.Context) {
+	userID, _ := c.Get("userID")
+	giftID := c.Param("giftId")
+
+	gift, err := h.giftService.CancelGift(c.Request.Context(), userID.(string), giftID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+	response.JSON(c, http.StatusOK, gift)
+}
+
+// handleError maps reveal gift errors to HTTP responses
+func (h *RevealGiftHandler) handleError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, services.ErrGiftNotFound):
+		apperror.HandleError(c, apperror.NotFound("gift"))
+	case errors.Is(err, services.ErrGiftNotNeeded),
+		errors.Is(err, services.ErrGiftAlreadyPending),
+		errors.Is(err, services.ErrGiftNotPending),
+		errors.Is(err, services.ErrGiftExpired),
+		errors.Is(err, services.ErrGiftPaymentFailed):
+		apperror.HandleError(c, apperror.Conflict(err.Error()))
+	case errors.Is(err, services.ErrEarlyRevealLimitReached), errors.Is(err, services.ErrIdentityRevealNotPurchasable):
+		apperror.HandleError(c, apperror.Forbidden(err.Error()))
+	case errors.Is(err, services.ErrGiftConsentRequired), errors.Is(err, monetizationservices.ErrInsufficientCredits):
+		apperror.HandleError(c, apperror.BadRequest(err.Error()))
+	case strings.HasPrefix(err.Error(), "connection not found"):
+		apperror.HandleError(c, apperror.NotFound("connection"))
+	case strings.HasPrefix(err.Error(), "milestone not found"):
+		apperror.HandleError(c, apperror.NotFound("milestone"))
+	default:
+		apperror.HandleError(c, apperror.BadRequest(err.Error()))
+	}
+}
@@ -6,4 +6,5 @@ import "github.com/google/wire"
 // ProviderSet is the wire provider set for reveal handlers
 var ProviderSet = wire.NewSet(
 	NewRevealHandler,
+	NewRevealGiftHandler,
 )
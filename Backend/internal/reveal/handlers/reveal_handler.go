@@ -2,6 +2,7 @@
 package handlers
 
 import (
+	"errors"
 	"net/http"
 	"strings"
 
@@ -79,10 +80,13 @@ func (h *RevealHandler) GetConnectionReveals(c *gin.Context) {
 // @Security     BearerAuth
 // @Param        connectionId path string true "Connection ID"
 // @Param        milestoneId path string true "Milestone ID"
+// @Param        Idempotency-Key header string false "Makes a retried early unlock return the original result"
 // @Param        request body dto.UnlockRevealRequest true "Unlock options"
 // @Success      200 {object} response.APIResponse{data=dto.UnlockRevealResponse} "Unlock result"
 // @Failure      400 {object} response.APIResponse "Cannot unlock or insufficient credits"
 // @Failure      401 {object} response.APIResponse "Not authenticated"
+// @Failure      403 {object} response.APIResponse "Early reveal limit reached for tier or identity reveal"
+// @Failure      409 {object} response.APIResponse "Reveal already unlocked"
 // @Router       /connections/{connectionId}/reveals/{milestoneId}/unlock [post]
 func (h *RevealHandler) UnlockReveal(c *gin.Context) {
 	userID, _ := c.Get("userID")
@@ -93,10 +97,24 @@ func (h *RevealHandler) UnlockReveal(c *gin.Context) {
 	if err := c.ShouldBindJSON(&req); err != nil {
 		req = dto.UnlockRevealRequest{UseCredits: false}
 	}
+	if req.IdempotencyKey == "" {
+		req.IdempotencyKey = c.GetHeader("Idempotency-Key")
+	}
+	if len(req.IdempotencyKey) > 64 {
+		apperror.HandleError(c, apperror.BadRequest("idempotency key must be at most 64 characters"))
+		return
+	}
 
 	result, err := h.revealService.UnlockReveal(c.Request.Context(), userID.(string), connectionID, milestoneID, &req)
 	if err != nil {
-		apperror.HandleError(c, apperror.BadRequest(err.Error()))
+		switch {
+		case errors.Is(err, services.ErrRevealAlreadyUnlocked), errors.Is(err, services.ErrIdempotencyKeyReused):
+			apperror.HandleError(c, apperror.Conflict(err.Error()))
+		case errors.Is(err, services.ErrEarlyRevealLimitReached), errors.Is(err, services.ErrIdentityRevealNotPurchasable):
+			apperror.HandleError(c, apperror.Forbidden(err.Error()))
+		default:
+			apperror.HandleError(c, apperror.BadRequest(err.Error()))
+		}
 		return
 	}
 	response.JSON(c, http.StatusOK, result)
@@ -104,13 +122,15 @@ func (h *RevealHandler) UnlockReveal(c *gin.Context) {
 
 // MarkRevealViewed godoc
 // @Summary      Mark reveal viewed
-// @Description  Mark a reveal as viewed
+// @Description  Record a view of a reveal by the authenticated user. Call on every open; the first call per user is the first view, later calls are re-views.
 // @Tags         reveals
 // @Accept       json
 // @Produce      json
 // @Security     BearerAuth
 // @Param        revealId path string true "Reveal ID"
-// @Success      200 {object} response.APIResponse{data=map[string]string} "Success"
+// @Param        request body dto.MarkRevealViewedRequest false "Time spent on the reveal"
+// @Success      200 {object} response.APIResponse{data=dto.RevealViewResponse} "View recorded"
+// @Failure      400 {object} response.APIResponse "Reveal is locked"
 // @Failure      401 {object} response.APIResponse "Not authenticated"
 // @Failure      404 {object} response.APIResponse "Reveal not found"
 // @Router       /reveals/{revealId}/viewed [post]
@@ -118,7 +138,16 @@ func (h *RevealHandler) MarkRevealViewed(c *gin.Context) {
 	userID, _ := c.Get("userID")
 	revealID := c.Param("revealId")
 
-	if err := h.revealService.MarkRevealViewed(c.Request.Context(), userID.(string), revealID); err != nil {
+	var req dto.MarkRevealViewedRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			apperror.HandleError(c, apperror.BadRequest(err.Error()))
+			return
+		}
+	}
+
+	result, err := h.revealService.MarkRevealViewed(c.Request.Context(), userID.(string), revealID, &req)
+	if err != nil {
 		if strings.Contains(err.Error(), "not found") || strings.Contains(err.Error(), "unauthorized") {
 			apperror.HandleError(c, apperror.NotFound("reveal"))
 			return
@@ -126,5 +155,5 @@ func (h *RevealHandler) MarkRevealViewed(c *gin.Context) {
 		apperror.HandleError(c, apperror.BadRequest(err.Error()))
 		return
 	}
-	response.JSON(c, http.StatusOK, gin.H{"message": "Reveal marked as viewed"})
+	response.JSON(c, http.StatusOK, result)
 }
@@ -5,6 +5,9 @@ import (
 	"github.com/gin-gonic/gin"
 
 	ent "github.com/UnoraApp/be/ent/generated"
+	entitlementservices "github.com/UnoraApp/be/internal/entitlement/services"
+	monetizationservices "github.com/UnoraApp/be/internal/monetization/services"
+	notificationservices "github.com/UnoraApp/be/internal/notification/services"
 	"github.com/UnoraApp/be/internal/reveal/handlers"
 	"github.com/UnoraApp/be/internal/reveal/services"
 )
@@ -13,13 +16,18 @@ import (
 func RegisterRevealRoutes(
 	router *gin.RouterGroup,
 	entClient *ent.Client,
+	notificationService *notificationservices.NotificationService,
+	entitlementService *entitlementservices.EntitlementService,
 	authMiddleware gin.HandlerFunc,
 ) {
 	// Create services
-	revealService := services.NewRevealService(entClient)
+	creditsService := monetizationservices.NewCreditsService(entClient)
+	revealService := services.NewRevealService(entClient, creditsService, entitlementService)
+	giftService := services.NewRevealGiftService(entClient, creditsService, notificationService, entitlementService)
 
-	// Create handler
+	// Create handlers
 	handler := handlers.NewRevealHandler(revealService)
+	giftHandler := handlers.NewRevealGiftHandler(giftService)
 
 	// Public routes
 	router.GET("/reveal-milestones", handler.GetMilestones)
@@ -31,6 +39,13 @@ func RegisterRevealRoutes(
 		// Connection reveals
 		protected.GET("/connections/:connectionId/reveals", handler.GetConnectionReveals)
 		protected.POST("/connections/:connectionId/reveals/:milestoneId/unlock", handler.UnlockReveal)
+		protected.POST("/connections/:connectionId/reveals/:milestoneId/gift", giftHandler.GiftReveal)
+
+		// Reveal gifts
+		protected.GET("/reveal-gifts", giftHandler.ListGifts)
+		protected.POST("/reveal-gifts/:giftId/accept", giftHandler.AcceptGift)
+		protected.POST("/reveal-gifts/:giftId/decline", giftHandler.DeclineGift)
+		protected.POST("/reveal-gifts/:giftId/cancel", giftHandler.CancelGift)
 
 		// Reveal actions
 		protected.POST("/reveals/:revealId/viewed", handler.MarkRevealViewed)
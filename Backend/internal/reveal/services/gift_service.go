@@ -0,0 +1,602 @@
+// internal/reveal/services/gift_service.go
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	ent "github.com/UnoraApp/be/ent/generated"
+	"github.com/UnoraApp/be/ent/generated/connection"
+	"github.com/UnoraApp/be/ent/generated/credittransaction"
+	"github.com/UnoraApp/be/ent/generated/predicate"
+	"github.com/UnoraApp/be/ent/generated/reveal"
+	"github.com/UnoraApp/be/ent/generated/revealgift"
+	entitlementservices "github.com/UnoraApp/be/internal/entitlement/services"
+	monetizationservices "github.com/UnoraApp/be/internal/monetization/services"
+	notificationservices "github.com/UnoraApp/be/internal/notification/services"
+	"github.com/UnoraApp/be/internal/reveal/dto"
+	"github.com/UnoraApp/be/pkg/database"
+	"github.com/UnoraApp/be/pkg/logger"
+)
+
+// giftTTL is how long the recipient has to accept a gift
+const giftTTL = 48 * time.Hour
+
+// Reveal gift errors
+var (
+	ErrGiftConsentRequired = errors.New("you must consent to revealing early before gifting")
+	ErrGiftNotNeeded       = errors.New("reveal is already unlocked or reachable by the streak")
+	ErrGiftAlreadyPending  = errors.New("a gift for this reveal is already pending")
+	ErrGiftNotFound        = errors.New("gift not found")
+	ErrGiftNotPending      = errors.New("gift is no longer pending")
+	ErrGiftExpired         = errors.New("gift has expired")
+	ErrGiftPaymentFailed   = errors.New("gift could not be paid for by the sender")
+)
+
+// RevealGiftService lets a user pay for their partner to see one of the
+// connection's upcoming reveals early. The gifter consents when offering; the
+// recipient's acceptance is the partner's consent, and only then are the
+// gifter's credits debited and the reveal unlocked.
+//
+// Tier isolation (PRD §21.4): a gift counts against the early-reveal allowance
+// of both users' tiers, so a higher tier never passes its benefits across, and
+// the gift is always paid for in full at the milestone's credit cost.
+type RevealGiftService struct {
+	entClient           *ent.Client
+	creditsService      *monetizationservices.CreditsService
+	notificationService *notificationservices.NotificationService
+	entitlementService  *entitlementservices.EntitlementService
+}
+
+// NewRevealGiftService creates a new reveal gift service
+func NewRevealGiftService(
+	entClient *ent.Client,
+	creditsService *monetizationservices.CreditsService,
+	notificationService *notificationservices.NotificationService,
+	entitlementService *entitlementservices.EntitlementService,
+) *RevealGiftService {
+	return &RevealGiftService{
+		entClient:           entClient,
+		creditsService:      creditsService,
+		notificationService: notificationService,
+		entitlementService:  entitlementService,
+	}
+}
+
+// OfferGift creates a pending gift of an upcoming reveal to the gifter's partner
+func (s *RevealGiftService) OfferGift(ctx context.Context, gifterID, connectionID, milestoneID string, req *dto.GiftRevealRequest) (*dto.RevealGiftResponse, error) {
+	if !req.ConsentToReveal {
+		return nil, ErrGiftConsentRequired
+	}
+
+	conn, err := s.entClient.Connection.
+		Query().
+		Where(connection.IDEQ(connectionID)).
+		Where(
+			connection.Or(
+				connection.UserAIDEQ(gifterID),
+				connection.UserBIDEQ(gifterID),
+			),
+		).
+		WithStreak().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("connection not found")
+		}
+		return nil, fmt.Errorf("failed to get connection: %w", err)
+	}
+	if conn.ConnectionStatus != connection.ConnectionStatusActive {
+		return nil, fmt.Errorf("connection is no longer active")
+	}
+
+	milestone, err := s.entClient.RevealMilestone.Get(ctx, milestoneID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("milestone not found")
+		}
+		return nil, fmt.Errorf("failed to get milestone: %w", err)
+	}
+	if IsIdentityReveal(milestone) {
+		return nil, ErrIdentityRevealNotPurchasable
+	}
+
+	recipientID := conn.UserAID
+	if recipientID == gifterID {
+		recipientID = conn.UserBID
+	}
+
+	if err := s.checkGiftable(ctx, s.entClient, conn, milestone); err != nil {
+		return nil, err
+	}
+
+	gifter, err := s.entClient.User.Get(ctx, gifterID)
+	if err != nil {
+		return nil, fmt.Errorf("user not found: %w", err)
+	}
+	recipient, err := s.entClient.User.Get(ctx, recipientID)
+	if err != nil {
+		return nil, fmt.Errorf("user not found: %w", err)
+	}
+	if err := s.checkGiftTierLimit(ctx, s.entClient, connectionID, gifter, recipient); err != nil {
+		return nil, err
+	}
+
+	// Fail fast; the ledger debit on accept re-checks the balance atomically
+	if gifter.CreditBalance < milestone.CreditCost {
+		return nil, monetizationservices.ErrInsufficientCredits
+	}
+
+	if err := s.expireStaleGifts(ctx); err != nil {
+		return nil, err
+	}
+	pending, err := s.entClient.RevealGift.
+		Query().
+		Where(revealgift.ConnectionIDEQ(connectionID)).
+		Where(revealgift.MilestoneIDEQ(milestoneID)).
+		Where(revealgift.GiftStatusEQ(revealgift.GiftStatusPending)).
+		Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check pending gifts: %w", err)
+	}
+	if pending {
+		return nil, ErrGiftAlreadyPending
+	}
+
+	now := time.Now()
+	gift, err := s.entClient.RevealGift.
+		Create().
+		SetID(uuid.New().String()).
+		SetConnectionID(connectionID).
+		SetMilestoneID(milestoneID).
+		SetGifterUserID(gifterID).
+		SetRecipientUserID(recipientID).
+		SetCreditCost(milestone.CreditCost).
+		SetGifterConsentedAt(now).
+		SetExpiresAt(now.Add(giftTTL)).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create gift: %w", err)
+	}
+
+	gifterName := firstName(gifter)
+	s.notify(ctx, recipientID, notificationservices.TypeRevealGiftOffered,
+		fmt.Sprintf("%s wants to show you a reveal early", gifterName),
+		fmt.Sprintf("Accept to unlock %s now instead of on Day %d.", milestone.Title, milestone.DayRequired),
+		gift)
+	s.notify(ctx, gifterID, notificationservices.TypeRevealGiftSent,
+		"Reveal gift sent",
+		fmt.Sprintf("%d credits will be used for %s once your connection accepts.", milestone.CreditCost, milestone.Title),
+		gift)
+
+	return giftToResponse(gift, milestone), nil
+}
+
+// AcceptGift unlocks the gifted reveal and debits the gifter's credits in one
+// transaction. Accepting an already accepted gift returns it unchanged.
+func (s *RevealGiftService) AcceptGift(ctx context.Context, recipientID, giftID string) (*dto.AcceptRevealGiftResponse, error) {
+	gift, err := s.getGift(ctx, giftID, revealgift.RecipientUserIDEQ(recipientID))
+	if err != nil {
+		return nil, err
+	}
+	milestone, err := s.entClient.RevealMilestone.Get(ctx, gift.MilestoneID)
+	if err != nil {
+		return nil, fmt.Errorf("milestone not found: %w", err)
+	}
+
+	// Retried accept resolves to the reveal it already unlocked
+	if gift.GiftStatus == revealgift.GiftStatusAccepted && gift.RevealID != nil {
+		r, err := s.entClient.Reveal.
+			Query().
+			Where(reveal.IDEQ(*gift.RevealID)).
+			WithContent().
+			Only(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("reveal not found: %w", err)
+		}
+		return &dto.AcceptRevealGiftResponse{
+			Gift:    giftToResponse(gift, milestone),
+			Reveal:  unlockedRevealToResponse(r, milestone, r.Edges.Content),
+			Message: "Reveal unlocked! Check out your new insights.",
+		}, nil
+	}
+	if gift.GiftStatus != revealgift.GiftStatusPending {
+		return nil, ErrGiftNotPending
+	}
+
+	now := time.Now()
+	if !gift.ExpiresAt.After(now) {
+		if _, err := s.closeGift(ctx, gift, revealgift.GiftStatusExpired); err != nil {
+			return nil, err
+		}
+		return nil, ErrGiftExpired
+	}
+
+	var r *ent.Reveal
+	err = database.WithTx(ctx, s.entClient, func(tx *ent.Tx) error {
+		client := tx.Client()
+
+		// Claim the gift; a concurrent accept, decline or cancel leaves nothing to update
+		affected, err := client.RevealGift.
+			Update().
+			Where(revealgift.IDEQ(gift.ID)).
+			Where(revealgift.GiftStatusEQ(revealgift.GiftStatusPending)).
+			SetGiftStatus(revealgift.GiftStatusAccepted).
+			SetRespondedAt(now).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to accept gift: %w", err)
+		}
+		if affected == 0 {
+			return ErrGiftNotPending
+		}
+
+		// The checks below run under the connection's lock, like an early
+		// unlock's, so that the two cannot both take the last early reveal
+		if err := lockConnection(ctx, client, gift.ConnectionID); err != nil {
+			return err
+		}
+		conn, err := client.Connection.
+			Query().
+			Where(connection.IDEQ(gift.ConnectionID)).
+			WithStreak().
+			Only(ctx)
+		if err != nil {
+			return fmt.Errorf("connection not found: %w", err)
+		}
+		if conn.ConnectionStatus != connection.ConnectionStatusActive {
+			return fmt.Errorf("connection is no longer active")
+		}
+		if err := s.checkGiftable(ctx, client, conn, milestone); err != nil {
+			return err
+		}
+
+		gifter, err := client.User.Get(ctx, gift.GifterUserID)
+		if err != nil {
+			return fmt.Errorf("user not found: %w", err)
+		}
+		recipient, err := client.User.Get(ctx, gift.RecipientUserID)
+		if err != nil {
+			return fmt.Errorf("user not found: %w", err)
+		}
+		if err := s.checkGiftTierLimit(ctx, client, gift.ConnectionID, gifter, recipient); err != nil {
+			return err
+		}
+
+		existing, err := client.Reveal.
+			Query().
+			Where(reveal.ConnectionIDEQ(gift.ConnectionID)).
+			Where(reveal.MilestoneIDEQ(gift.MilestoneID)).
+			Only(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return fmt.Errorf("failed to get reveal: %w", err)
+		}
+		if existing != nil {
+			affected, err := client.Reveal.
+				Update().
+				Where(reveal.IDEQ(existing.ID)).
+				Where(reveal.RevealStatusEQ(reveal.RevealStatusLocked)).
+				SetRevealStatus(reveal.RevealStatusUnlocked).
+				SetUnlockMethod(reveal.UnlockMethodGifted).
+				SetUnlockedAt(now).
+				Save(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to unlock reveal: %w", err)
+			}
+			if affected == 0 {
+				return ErrGiftNotNeeded
+			}
+			r, err = client.Reveal.Get(ctx, existing.ID)
+			if err != nil {
+				return fmt.Errorf("failed to get reveal: %w", err)
+			}
+		} else {
+			r, err = client.Reveal.
+				Create().
+				SetID(uuid.New().String()).
+				SetConnectionID(gift.ConnectionID).
+				SetMilestoneID(gift.MilestoneID).
+				SetUnlockMethod(reveal.UnlockMethodGifted).
+				SetRevealStatus(reveal.RevealStatusUnlocked).
+				SetUnlockedAt(now).
+				Save(ctx)
+			if err != nil {
+				// The (connection, milestone) unique index rejects a concurrent unlock
+				if ent.IsConstraintError(err) {
+					return ErrGiftNotNeeded
+				}
+				return fmt.Errorf("failed to unlock reveal: %w", err)
+			}
+		}
+
+		update := client.RevealGift.
+			UpdateOneID(gift.ID).
+			SetRevealID(r.ID)
+		if gift.CreditCost > 0 {
+			ledgerTx, err := s.creditsService.SpendCredits(
+				ctx,
+				client,
+				gift.GifterUserID,
+				gift.CreditCost,
+				credittransaction.TransactionTypeRevealGift,
+				fmt.Sprintf("Gifted early reveal: %s", milestone.Title),
+				"reveal_gift",
+				gift.ID,
+				"reveal-gift:"+gift.ID,
+			)
+			if err != nil {
+				if errors.Is(err, monetizationservices.ErrInsufficientCredits) {
+					return ErrGiftPaymentFailed
+				}
+				return err
+			}
+			update = update.SetCreditTransactionID(ledgerTx.ID)
+		}
+		gift, err = update.Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to update gift: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		// A gift that can no longer be honoured is closed so it stops blocking new offers
+		if errors.Is(err, ErrGiftPaymentFailed) || errors.Is(err, ErrGiftNotNeeded) || errors.Is(err, ErrEarlyRevealLimitReached) {
+			if closed, closeErr := s.closeGift(ctx, gift, revealgift.GiftStatusCancelled); closeErr == nil && closed != nil {
+				s.notify(ctx, closed.GifterUserID, notificationservices.TypeRevealGiftFailed,
+					"Your reveal gift could not be completed",
+					fmt.Sprintf("Your gift of %s was not delivered and no credits were used.", milestone.Title),
+					closed)
+			}
+		}
+		return nil, err
+	}
+
+	content := generateRevealContent(ctx, s.entClient, r.ID)
+
+	s.notify(ctx, gift.GifterUserID, notificationservices.TypeRevealGiftAccepted,
+		"Your reveal gift was accepted",
+		fmt.Sprintf("%s is now unlocked for both of you.", milestone.Title),
+		gift)
+	s.notify(ctx, gift.RecipientUserID, notificationservices.TypeRevealGiftAccepted,
+		"Reveal unlocked",
+		fmt.Sprintf("%s is now unlocked. Check out your new insights.", milestone.Title),
+		gift)
+
+	return &dto.AcceptRevealGiftResponse{
+		Gift:    giftToResponse(gift, milestone),
+		Reveal:  unlockedRevealToResponse(r, milestone, content),
+		Message: "Reveal unlocked! Check out your new insights.",
+	}, nil
+}
+
+// DeclineGift declines a pending gift; no credits are used
+func (s *RevealGiftService) DeclineGift(ctx context.Context, recipientID, giftID string) (*dto.RevealGiftResponse, error) {
+	gift, err := s.getGift(ctx, giftID, revealgift.RecipientUserIDEQ(recipientID))
+	if err != nil {
+		return nil, err
+	}
+	return s.respond(ctx, gift, revealgift.GiftStatusDeclined)
+}
+
+// CancelGift withdraws a pending gift; no credits are used
+func (s *RevealGiftService) CancelGift(ctx context.Context, gifterID, giftID string) (*dto.RevealGiftResponse, error) {
+	gift, err := s.getGift(ctx, giftID, revealgift.GifterUserIDEQ(gifterID))
+	if err != nil {
+		return nil, err
+	}
+	return s.respond(ctx, gift, revealgift.GiftStatusCancelled)
+}
+
+// ListGifts returns the gifts the user has received and sent
+func (s *RevealGiftService) ListGifts(ctx context.Context, userID string) (*dto.RevealGiftListResponse, error) {
+	if err := s.expireStaleGifts(ctx); err != nil {
+		return nil, err
+	}
+
+	gifts, err := s.entClient.RevealGift.
+		Query().
+		Where(
+			revealgift.Or(
+				revealgift.GifterUserIDEQ(userID),
+				revealgift.RecipientUserIDEQ(userID),
+			),
+		).
+		WithMilestone().
+		Order(ent.Desc(revealgift.FieldCreatedAt)).
+		Limit(100).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get gifts: %w", err)
+	}
+
+	result := &dto.RevealGiftListResponse{
+		Received: []dto.RevealGiftResponse{},
+		Sent:     []dto.RevealGiftResponse{},
+	}
+	for _, g := range gifts {
+		resp := giftToResponse(g, g.Edges.Milestone)
+		if g.RecipientUserID == userID {
+			result.Received = append(result.Received, *resp)
+		} else {
+			result.Sent = append(result.Sent, *resp)
+		}
+	}
+	return result, nil
+}
+
+// respond moves a pending gift to declined or cancelled and tells the other party
+func (s *RevealGiftService) respond(ctx context.Context, gift *ent.RevealGift, status revealgift.GiftStatus) (*dto.RevealGiftResponse, error) {
+	if gift.GiftStatus != revealgift.GiftStatusPending {
+		return nil, ErrGiftNotPending
+	}
+
+	closed, err := s.closeGift(ctx, gift, status)
+	if err != nil {
+		return nil, err
+	}
+	if closed == nil {
+		return nil, ErrGiftNotPending
+	}
+
+	milestone, err := s.entClient.RevealMilestone.Get(ctx, closed.MilestoneID)
+	if err != nil {
+		return nil, fmt.Errorf("milestone not found: %w", err)
+	}
+
+	if status == revealgift.GiftStatusDeclined {
+		s.notify(ctx, closed.GifterUserID, notificationservices.TypeRevealGiftDeclined,
+			"Your reveal gift was declined",
+			fmt.Sprintf("%s will unlock on Day %d as usual. No credits were used.", milestone.Title, milestone.DayRequired),
+			closed)
+	} else {
+		s.notify(ctx, closed.RecipientUserID, notificationservices.TypeRevealGiftCancelled,
+			"A reveal gift was withdrawn",
+			fmt.Sprintf("%s will unlock on Day %d as usual.", milestone.Title, milestone.DayRequired),
+			closed)
+	}
+
+	return giftToResponse(closed, milestone), nil
+}
+
+// closeGift moves a gift out of pending. It returns nil when the gift was no
+// longer pending, so concurrent responses are resolved by whoever gets there first.
+func (s *RevealGiftService) closeGift(ctx context.Context, gift *ent.RevealGift, status revealgift.GiftStatus) (*ent.RevealGift, error) {
+	affected, err := s.entClient.RevealGift.
+		Update().
+		Where(revealgift.IDEQ(gift.ID)).
+		Where(revealgift.GiftStatusEQ(revealgift.GiftStatusPending)).
+		SetGiftStatus(status).
+		SetRespondedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update gift: %w", err)
+	}
+	if affected == 0 {
+		return nil, nil
+	}
+	return s.entClient.RevealGift.Get(ctx, gift.ID)
+}
+
+// expireStaleGifts marks pending gifts past their expiry as expired
+func (s *RevealGiftService) expireStaleGifts(ctx context.Context) error {
+	_, err := s.entClient.RevealGift.
+		Update().
+		Where(revealgift.GiftStatusEQ(revealgift.GiftStatusPending)).
+		Where(revealgift.ExpiresAtLTE(time.Now())).
+		SetGiftStatus(revealgift.GiftStatusExpired).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to expire gifts: %w", err)
+	}
+	return nil
+}
+
+// getGift loads a gift the user is a party to
+func (s *RevealGiftService) getGift(ctx context.Context, giftID string, party ...predicate.RevealGift) (*ent.RevealGift, error) {
+	gift, err := s.entClient.RevealGift.
+		Query().
+		Where(revealgift.IDEQ(giftID)).
+		Where(party...).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrGiftNotFound
+		}
+		return nil, fmt.Errorf("failed to get gift: %w", err)
+	}
+	return gift, nil
+}
+
+// checkGiftable rejects milestones that are already unlocked or already
+// reachable by the connection's streak
+func (s *RevealGiftService) checkGiftable(ctx context.Context, client *ent.Client, conn *ent.Connection, milestone *ent.RevealMilestone) error {
+	currentDay := 0
+	if conn.Edges.Streak != nil {
+		currentDay = conn.Edges.Streak.CurrentDay
+	}
+	if currentDay >= milestone.DayRequired {
+		return ErrGiftNotNeeded
+	}
+
+	unlocked, err := client.Reveal.
+		Query().
+		Where(reveal.ConnectionIDEQ(conn.ID)).
+		Where(reveal.MilestoneIDEQ(milestone.ID)).
+		Where(reveal.RevealStatusNEQ(reveal.RevealStatusLocked)).
+		Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get reveal: %w", err)
+	}
+	if unlocked {
+		return ErrGiftNotNeeded
+	}
+	return nil
+}
+
+// checkGiftTierLimit enforces tier isolation: the connection's early reveals
+// must stay within the allowance of both the gifter's and the recipient's tier
+func (s *RevealGiftService) checkGiftTierLimit(ctx context.Context, client *ent.Client, connectionID string, gifter, recipient *ent.User) error {
+	early, err := countEarlyReveals(ctx, client, connectionID)
+	if err != nil {
+		return err
+	}
+
+	allowance, err := s.entitlementService.PurchasedReveals(ctx, early, string(gifter.SubscriptionTier), string(recipient.SubscriptionTier))
+	if err != nil {
+		return err
+	}
+	if !allowance.Allowed {
+		return ErrEarlyRevealLimitReached
+	}
+	return nil
+}
+
+// notify sends a gift notification; failures are logged and never fail the gift
+func (s *RevealGiftService) notify(ctx context.Context, userID, notificationType, title, body string, gift *ent.RevealGift) {
+	data := map[string]string{
+		"giftId":       gift.ID,
+		"connectionId": gift.ConnectionID,
+		"milestoneId":  gift.MilestoneID,
+	}
+	if gift.RevealID != nil {
+		data["revealId"] = *gift.RevealID
+	}
+
+	if _, err := s.notificationService.Notify(ctx, userID, notificationType, title, body, data); err != nil {
+		log := logger.GetLogger("reveal")
+		log.Error().Err(err).Str("user_id", userID).Str("gift_id", gift.ID).Str("type", notificationType).Msg("Failed to send reveal gift notification")
+	}
+}
+
+// giftToResponse maps a gift to its response
+func giftToResponse(g *ent.RevealGift, milestone *ent.RevealMilestone) *dto.RevealGiftResponse {
+	resp := &dto.RevealGiftResponse{
+		ID:           g.ID,
+		ConnectionID: g.ConnectionID,
+		MilestoneID:  g.MilestoneID,
+		GifterID:     g.GifterUserID,
+		RecipientID:  g.RecipientUserID,
+		CreditCost:   g.CreditCost,
+		Status:       string(g.GiftStatus),
+		RevealID:     ptrToString(g.RevealID),
+		ExpiresAt:    g.ExpiresAt,
+		CreatedAt:    g.CreatedAt,
+		RespondedAt:  g.RespondedAt,
+	}
+	if milestone != nil {
+		resp.RevealNumber = milestone.RevealNumber
+		resp.Title = milestone.Title
+	}
+	return resp
+}
+
+func firstName(u *ent.User) string {
+	if u.FirstName != nil && *u.FirstName != "" {
+		return *u.FirstName
+	}
+	return "Your connection"
+}
@@ -6,4 +6,5 @@ import "github.com/google/wire"
 // ProviderSet is the wire provider set for reveal services
 var ProviderSet = wire.NewSet(
 	NewRevealService,
+	NewRevealGiftService,
 )
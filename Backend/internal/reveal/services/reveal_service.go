@@ -3,6 +3,7 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -11,21 +12,45 @@ import (
 
 	ent "github.com/UnoraApp/be/ent/generated"
 	"github.com/UnoraApp/be/ent/generated/connection"
+	"github.com/UnoraApp/be/ent/generated/credittransaction"
 	"github.com/UnoraApp/be/ent/generated/reveal"
 	"github.com/UnoraApp/be/ent/generated/revealmilestone"
+	"github.com/UnoraApp/be/ent/generated/revealview"
 	"github.com/UnoraApp/be/ent/generated/streak"
+	entitlementservices "github.com/UnoraApp/be/internal/entitlement/services"
+	monetizationservices "github.com/UnoraApp/be/internal/monetization/services"
 	"github.com/UnoraApp/be/internal/reveal/dto"
+	"github.com/UnoraApp/be/pkg/database"
+)
+
+// IdentityRevealDay is the streak day of the identity reveal (Reveal 5), which
+// also unlocks chat. No tier or payment can accelerate it.
+const IdentityRevealDay = 15
+
+// maxViewDurationSeconds caps the client-reported time spent on a reveal
+const maxViewDurationSeconds = 30 * 60
+
+// Reveal unlock errors
+var (
+	ErrIdentityRevealNotPurchasable = errors.New("identity reveal cannot be unlocked early")
+	ErrRevealAlreadyUnlocked        = errors.New("reveal already unlocked")
+	ErrEarlyRevealLimitReached      = errors.New("early reveal limit reached for your tier")
+	ErrIdempotencyKeyReused         = errors.New("idempotency key already used for a different request")
 )
 
 // RevealService handles reveal-related business logic
 type RevealService struct {
-	entClient *ent.Client
+	entClient          *ent.Client
+	creditsService     *monetizationservices.CreditsService
+	entitlementService *entitlementservices.EntitlementService
 }
 
 // NewRevealService creates a new reveal service
-func NewRevealService(entClient *ent.Client) *RevealService {
+func NewRevealService(entClient *ent.Client, creditsService *monetizationservices.CreditsService, entitlementService *entitlementservices.EntitlementService) *RevealService {
 	return &RevealService{
-		entClient: entClient,
+		entClient:          entClient,
+		creditsService:     creditsService,
+		entitlementService: entitlementService,
 	}
 }
 
@@ -164,7 +189,10 @@ func (s *RevealService) GetConnectionReveals(ctx context.Context, userID, connec
 	}, nil
 }
 
-// UnlockReveal unlocks a reveal for a connection
+// UnlockReveal unlocks a reveal for a connection. Early unlocks are paid for
+// through the credit ledger in the same transaction as the reveal write, so a
+// failure never takes credits without unlocking and a retry with the same
+// idempotency key never charges twice.
 func (s *RevealService) UnlockReveal(ctx context.Context, userID, connectionID, milestoneID string, req *dto.UnlockRevealRequest) (*dto.UnlockRevealResponse, error) {
 	// Verify user is part of connection
 	conn, err := s.entClient.Connection.
@@ -188,14 +216,26 @@ func (s *RevealService) UnlockReveal(ctx context.Context, userID, connectionID,
 		return nil, fmt.Errorf("milestone not found: %w", err)
 	}
 
+	// A retried request resolves to the unlock it already paid for
+	prior, err := s.creditsService.FindByIdempotencyKey(ctx, userID, req.IdempotencyKey)
+	if err != nil {
+		return nil, err
+	}
+	if prior != nil {
+		return s.replayUnlock(ctx, userID, connectionID, milestone, prior)
+	}
+
 	// Check if already unlocked
-	existingReveal, _ := s.entClient.Reveal.
+	existingReveal, err := s.entClient.Reveal.
 		Query().
 		Where(reveal.ConnectionIDEQ(connectionID)).
 		Where(reveal.MilestoneIDEQ(milestoneID)).
 		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to get reveal: %w", err)
+	}
 	if existingReveal != nil && existingReveal.RevealStatus != reveal.RevealStatusLocked {
-		return nil, fmt.Errorf("reveal already unlocked")
+		return s.replayConcurrentUnlock(ctx, userID, connectionID, milestone, req, ErrRevealAlreadyUnlocked)
 	}
 
 	// Get current streak day
@@ -204,7 +244,7 @@ func (s *RevealService) UnlockReveal(ctx context.Context, userID, connectionID,
 		currentDay = conn.Edges.Streak.CurrentDay
 	}
 
-	// Get user for credits
+	// Get user for credits and tier
 	user, err := s.entClient.User.Get(ctx, userID)
 	if err != nil {
 		return nil, fmt.Errorf("user not found: %w", err)
@@ -216,67 +256,182 @@ func (s *RevealService) UnlockReveal(ctx context.Context, userID, connectionID,
 
 	if currentDay < milestone.DayRequired {
 		// Need to purchase
+		if IsIdentityReveal(milestone) {
+			return nil, ErrIdentityRevealNotPurchasable
+		}
 		if !req.UseCredits {
 			return nil, fmt.Errorf("not enough streak days to unlock, use credits to unlock early")
 		}
+
+		// Fail fast; the ledger debit re-checks the balance atomically
 		if user.CreditBalance < milestone.CreditCost {
-			return nil, fmt.Errorf("insufficient credits")
+			return nil, monetizationservices.ErrInsufficientCredits
 		}
 		unlockMethod = reveal.UnlockMethodPurchased
 		creditsUsed = milestone.CreditCost
-
-		// Deduct credits
-		_, err = user.Update().
-			SetCreditBalance(user.CreditBalance - creditsUsed).
-			Save(ctx)
-		if err != nil {
-			return nil, fmt.Errorf("failed to deduct credits: %w", err)
-		}
 	}
 
-	// Create or update reveal
+	// Create or update reveal and debit credits atomically
 	now := time.Now()
 	var r *ent.Reveal
+	var ledgerTx *ent.CreditTransaction
+
+	err = database.WithTx(ctx, s.entClient, func(tx *ent.Tx) error {
+		if unlockMethod == reveal.UnlockMethodPurchased {
+			// Counted under the connection's lock, so that concurrent
+			// purchases and gifts cannot both take the last early reveal
+			if err := lockConnection(ctx, tx.Client(), connectionID); err != nil {
+				return err
+			}
+			early, err := countEarlyReveals(ctx, tx.Client(), connectionID)
+			if err != nil {
+				return err
+			}
+			allowance, err := s.entitlementService.PurchasedReveals(ctx, early, string(user.SubscriptionTier))
+			if err != nil {
+				return err
+			}
+			if !allowance.Allowed {
+				return ErrEarlyRevealLimitReached
+			}
+		}
+
+		if existingReveal != nil {
+			// Only a still-locked reveal may be unlocked; a concurrent unlock leaves nothing to update
+			affected, err := tx.Reveal.
+				Update().
+				Where(reveal.IDEQ(existingReveal.ID)).
+				Where(reveal.RevealStatusEQ(reveal.RevealStatusLocked)).
+				SetRevealStatus(reveal.RevealStatusUnlocked).
+				SetUnlockMethod(unlockMethod).
+				SetUnlockedAt(now).
+				Save(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to unlock reveal: %w", err)
+			}
+			if affected == 0 {
+				return ErrRevealAlreadyUnlocked
+			}
+			r, err = tx.Reveal.Get(ctx, existingReveal.ID)
+			if err != nil {
+				return fmt.Errorf("failed to get reveal: %w", err)
+			}
+		} else {
+			revealID := uuid.New().String()
+			r, err = tx.Reveal.
+				Create().
+				SetID(revealID).
+				SetConnectionID(connectionID).
+				SetMilestoneID(milestoneID).
+				SetUnlockMethod(unlockMethod).
+				SetRevealStatus(reveal.RevealStatusUnlocked).
+				SetUnlockedAt(now).
+				Save(ctx)
+			if err != nil {
+				// The (connection, milestone) unique index rejects a concurrent unlock
+				if ent.IsConstraintError(err) {
+					return ErrRevealAlreadyUnlocked
+				}
+				return fmt.Errorf("failed to unlock reveal: %w", err)
+			}
+		}
+
+		if creditsUsed > 0 {
+			ledgerTx, err = s.creditsService.SpendCredits(
+				ctx,
+				tx.Client(),
+				userID,
+				creditsUsed,
+				credittransaction.TransactionTypeEarlyReveal,
+				fmt.Sprintf("Early unlock: %s", milestone.Title),
+				"reveal",
+				r.ID,
+				req.IdempotencyKey,
+			)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return s.replayConcurrentUnlock(ctx, userID, connectionID, milestone, req, err)
+	}
 
-	if existingReveal != nil {
-		r, err = existingReveal.Update().
-			SetRevealStatus(reveal.RevealStatusUnlocked).
-			SetUnlockMethod(unlockMethod).
-			SetUnlockedAt(now).
-			Save(ctx)
-	} else {
-		revealID := uuid.New().String()
-		r, err = s.entClient.Reveal.
-			Create().
-			SetID(revealID).
-			SetConnectionID(connectionID).
-			SetMilestoneID(milestoneID).
-			SetUnlockMethod(unlockMethod).
-			SetRevealStatus(reveal.RevealStatusUnlocked).
-			SetUnlockedAt(now).
-			Save(ctx)
+	content := generateRevealContent(ctx, s.entClient, r.ID)
+
+	remainingCredits := user.CreditBalance
+	transactionID := ""
+	if ledgerTx != nil {
+		remainingCredits = ledgerTx.BalanceAfter
+		transactionID = ledgerTx.ID
 	}
+
+	return &dto.UnlockRevealResponse{
+		Success:          true,
+		Reveal:           unlockedRevealToResponse(r, milestone, content),
+		CreditsUsed:      creditsUsed,
+		RemainingCredits: remainingCredits,
+		TransactionID:    transactionID,
+		Message:          "Reveal unlocked! Check out your new insights.",
+	}, nil
+}
+
+// replayConcurrentUnlock resolves an unlock that lost a race: when a
+// concurrent request with the same idempotency key unlocked the reveal, or
+// wrote the key to the ledger, first, its response is replayed; otherwise
+// err is returned.
+func (s *RevealService) replayConcurrentUnlock(ctx context.Context, userID, connectionID string, milestone *ent.RevealMilestone, req *dto.UnlockRevealRequest, err error) (*dto.UnlockRevealResponse, error) {
+	if !errors.Is(err, ErrRevealAlreadyUnlocked) && !ent.IsConstraintError(err) {
+		return nil, err
+	}
+
+	prior, findErr := s.creditsService.FindByIdempotencyKey(ctx, userID, req.IdempotencyKey)
+	if findErr != nil {
+		return nil, findErr
+	}
+	if prior == nil {
+		return nil, err
+	}
+	return s.replayUnlock(ctx, userID, connectionID, milestone, prior)
+}
+
+// replayUnlock rebuilds the response for an unlock that was already paid for
+// with the same idempotency key
+func (s *RevealService) replayUnlock(ctx context.Context, userID, connectionID string, milestone *ent.RevealMilestone, prior *ent.CreditTransaction) (*dto.UnlockRevealResponse, error) {
+	if prior.TransactionType != credittransaction.TransactionTypeEarlyReveal || prior.ReferenceID == nil {
+		return nil, ErrIdempotencyKeyReused
+	}
+
+	r, err := s.entClient.Reveal.
+		Query().
+		Where(reveal.IDEQ(*prior.ReferenceID)).
+		WithContent().
+		Only(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to unlock reveal: %w", err)
+		return nil, fmt.Errorf("reveal not found: %w", err)
+	}
+	if r.ConnectionID != connectionID || r.MilestoneID != milestone.ID {
+		return nil, ErrIdempotencyKeyReused
 	}
 
-	// Generate content (placeholder - would use AI in production)
-	contentID := uuid.New().String()
-	content, err := s.entClient.RevealContent.
-		Create().
-		SetID(contentID).
-		SetRevealID(r.ID).
-		SetAiSummary("Based on your interactions, you both share a genuine appreciation for meaningful connections. Your communication styles complement each other beautifully.").
-		SetCompatibilityInsight("You both value authenticity and deep conversations. This shared foundation suggests strong potential for a lasting connection.").
-		SetConversationStarters("What's a hobby you've always wanted to try?\nWhat's the most memorable trip you've taken?\nWhat does your ideal weekend look like?").
-		Save(ctx)
+	user, err := s.entClient.User.Get(ctx, userID)
 	if err != nil {
-		// Log error but don't fail the unlock
+		return nil, fmt.Errorf("user not found: %w", err)
 	}
 
-	// Get updated credits
-	user, _ = s.entClient.User.Get(ctx, userID)
+	return &dto.UnlockRevealResponse{
+		Success:          true,
+		Reveal:           unlockedRevealToResponse(r, milestone, r.Edges.Content),
+		CreditsUsed:      -prior.CreditAmount,
+		RemainingCredits: user.CreditBalance,
+		TransactionID:    prior.ID,
+		Message:          "Reveal unlocked! Check out your new insights.",
+	}, nil
+}
 
+// unlockedRevealToResponse maps a freshly unlocked reveal to its response
+func unlockedRevealToResponse(r *ent.Reveal, milestone *ent.RevealMilestone, content *ent.RevealContent) *dto.RevealResponse {
 	revealResp := &dto.RevealResponse{
 		ID:           r.ID,
 		ConnectionID: r.ConnectionID,
@@ -289,6 +444,7 @@ func (s *RevealService) UnlockReveal(ctx context.Context, userID, connectionID,
 		CanUnlock:    false,
 		CreditCost:   milestone.CreditCost,
 		UnlockedAt:   r.UnlockedAt,
+		ViewedAt:     r.ViewedAt,
 	}
 
 	if content != nil {
@@ -300,17 +456,12 @@ func (s *RevealService) UnlockReveal(ctx context.Context, userID, connectionID,
 		}
 	}
 
-	return &dto.UnlockRevealResponse{
-		Success:          true,
-		Reveal:           revealResp,
-		CreditsUsed:      creditsUsed,
-		RemainingCredits: user.CreditBalance,
-		Message:          "Reveal unlocked! Check out your new insights.",
-	}, nil
+	return revealResp
 }
 
-// MarkRevealViewed marks a reveal as viewed
-func (s *RevealService) MarkRevealViewed(ctx context.Context, userID, revealID string) error {
+// MarkRevealViewed records a view of a reveal by one participant. The first
+// view by anyone also flips the reveal to viewed.
+func (s *RevealService) MarkRevealViewed(ctx context.Context, userID, revealID string, req *dto.MarkRevealViewedRequest) (*dto.RevealViewResponse, error) {
 	// Get reveal and verify access
 	r, err := s.entClient.Reveal.
 		Query().
@@ -318,34 +469,151 @@ func (s *RevealService) MarkRevealViewed(ctx context.Context, userID, revealID s
 		WithConnection().
 		Only(ctx)
 	if err != nil {
-		return fmt.Errorf("reveal not found: %w", err)
+		return nil, fmt.Errorf("reveal not found: %w", err)
 	}
 
 	// Verify user is part of connection
 	conn := r.Edges.Connection
 	if conn.UserAID != userID && conn.UserBID != userID {
-		return fmt.Errorf("unauthorized")
+		return nil, fmt.Errorf("unauthorized")
 	}
 
 	if r.RevealStatus == reveal.RevealStatusLocked {
-		return fmt.Errorf("reveal is locked")
+		return nil, fmt.Errorf("reveal is locked")
 	}
 
-	if r.ViewedAt != nil {
-		return nil // Already viewed
+	viewer, err := s.entClient.User.Get(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("user not found: %w", err)
 	}
 
-	_, err = r.Update().
-		SetRevealStatus(reveal.RevealStatusViewed).
-		SetViewedAt(time.Now()).
-		Save(ctx)
+	var duration *int
+	if req != nil && req.DurationSeconds != nil {
+		d := *req.DurationSeconds
+		if d < 0 {
+			return nil, fmt.Errorf("durationSeconds must not be negative")
+		}
+		// Clients left open in the background shouldn't skew time spent
+		if d > maxViewDurationSeconds {
+			d = maxViewDurationSeconds
+		}
+		duration = &d
+	}
+
+	now := time.Now()
+	firstView := true
+	var views int
+	err = database.WithTx(ctx, s.entClient, func(tx *ent.Tx) error {
+		// The first view carries a unique key, so of concurrent views only
+		// one can be recorded as the first; the others are re-views
+		create := func(firstViewKey *string) error {
+			_, err := tx.RevealView.
+				Create().
+				SetID(uuid.New().String()).
+				SetRevealID(r.ID).
+				SetViewerUserID(userID).
+				SetIsFirstView(firstViewKey != nil).
+				SetNillableFirstViewKey(firstViewKey).
+				SetNillableDurationSeconds(duration).
+				SetViewerTier(revealview.ViewerTier(viewer.SubscriptionTier)).
+				Save(ctx)
+			return err
+		}
+		firstViewKey := r.ID + ":" + userID
+		err := create(&firstViewKey)
+		if ent.IsConstraintError(err) {
+			firstView = false
+			err = create(nil)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to record view: %w", err)
+		}
+
+		views, err = tx.RevealView.
+			Query().
+			Where(revealview.RevealIDEQ(r.ID)).
+			Where(revealview.ViewerUserIDEQ(userID)).
+			Count(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to count views: %w", err)
+		}
+
+		if r.ViewedAt == nil {
+			_, err = tx.Reveal.
+				Update().
+				Where(reveal.IDEQ(r.ID)).
+				Where(reveal.ViewedAtIsNil()).
+				SetRevealStatus(reveal.RevealStatusViewed).
+				SetViewedAt(now).
+				Save(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to mark as viewed: %w", err)
+			}
+		}
+		return nil
+	})
 	if err != nil {
-		return fmt.Errorf("failed to mark as viewed: %w", err)
+		return nil, err
 	}
 
+	return &dto.RevealViewResponse{
+		RevealID:    r.ID,
+		IsFirstView: firstView,
+		ViewCount:   views,
+	}, nil
+}
+
+// countEarlyReveals counts the connection's reveals unlocked ahead of the
+// streak, whether bought by either user or gifted between them
+func countEarlyReveals(ctx context.Context, client *ent.Client, connectionID string) (int, error) {
+	count, err := client.Reveal.
+		Query().
+		Where(reveal.ConnectionIDEQ(connectionID)).
+		Where(reveal.UnlockMethodIn(reveal.UnlockMethodPurchased, reveal.UnlockMethodGifted)).
+		Count(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count early reveals: %w", err)
+	}
+	return count, nil
+}
+
+// lockConnection locks the connection's row until the transaction ends.
+// Early reveals of a connection are counted under this lock.
+func lockConnection(ctx context.Context, client *ent.Client, connectionID string) error {
+	_, err := client.Connection.
+		Query().
+		Where(connection.IDEQ(connectionID)).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to lock connection: %w", err)
+	}
 	return nil
 }
 
+// generateRevealContent creates the content of a freshly unlocked reveal.
+// Failures are swallowed so they never fail the unlock itself.
+func generateRevealContent(ctx context.Context, client *ent.Client, revealID string) *ent.RevealContent {
+	// Placeholder - would use AI in production
+	content, err := client.RevealContent.
+		Create().
+		SetID(uuid.New().String()).
+		SetRevealID(revealID).
+		SetAiSummary("Based on your interactions, you both share a genuine appreciation for meaningful connections. Your communication styles complement each other beautifully.").
+		SetCompatibilityInsight("You both value authenticity and deep conversations. This shared foundation suggests strong potential for a lasting connection.").
+		SetConversationStarters("What's a hobby you've always wanted to try?\nWhat's the most memorable trip you've taken?\nWhat does your ideal weekend look like?").
+		Save(ctx)
+	if err != nil {
+		return nil
+	}
+	return content
+}
+
+// IsIdentityReveal reports whether the milestone is the Day-15 identity reveal
+func IsIdentityReveal(m *ent.RevealMilestone) bool {
+	return m.DayRequired >= IdentityRevealDay
+}
+
 // Helper functions
 func ptrToString(s *string) string {
 	if s == nil {
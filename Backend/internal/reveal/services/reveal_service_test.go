@@ -0,0 +1,264 @@
+// internal/reveal/services/reveal_service_test.go
+package services
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/UnoraApp/be/ent/generated/connection"
+	"github.com/UnoraApp/be/ent/generated/reveal"
+	"github.com/UnoraApp/be/ent/generated/revealmilestone"
+	"github.com/UnoraApp/be/ent/generated/revealview"
+	"github.com/UnoraApp/be/ent/generated/user"
+	entitlementservices "github.com/UnoraApp/be/internal/entitlement/services"
+	monetizationservices "github.com/UnoraApp/be/internal/monetization/services"
+	"github.com/UnoraApp/be/internal/reveal/dto"
+	"github.com/UnoraApp/be/pkg/database/databasetest"
+)
+
+// TestUnlockRevealEarlyLimitConcurrently has both users of a plus
+// connection, allowed two early reveals, buy all three reveals at once: only
+// two may be bought.
+func TestUnlockRevealEarlyLimitConcurrently(t *testing.T) {
+	ctx := context.Background()
+	client := databasetest.NewClient(t)
+	reveals := NewRevealService(client, monetizationservices.NewCreditsService(client), entitlementservices.NewEntitlementService(client))
+
+	users := make([]string, 2)
+	for i := range users {
+		users[i] = client.User.
+			Create().
+			SetID(uuid.New().String()).
+			SetSubscriptionTier(user.SubscriptionTierPlus).
+			SetCreditBalance(100).
+			SaveX(ctx).ID
+	}
+	if users[0] > users[1] {
+		users[0], users[1] = users[1], users[0]
+	}
+	conn := client.Connection.
+		Create().
+		SetID(uuid.New().String()).
+		SetUserAID(users[0]).
+		SetUserBID(users[1]).
+		SetServerType(connection.ServerTypePartner).
+		SaveX(ctx)
+	client.Streak.
+		Create().
+		SetID(uuid.New().String()).
+		SetConnectionID(conn.ID).
+		SaveX(ctx)
+
+	var milestones []string
+	for i, day := range []int{5, 8, 12} {
+		milestones = append(milestones, client.RevealMilestone.
+			Create().
+			SetID(uuid.New().String()).
+			SetRevealNumber(i+1).
+			SetDayRequired(day).
+			SetRevealType(revealmilestone.RevealTypePersonality).
+			SetTitle("Reveal").
+			SetCreditCost(10).
+			SaveX(ctx).ID)
+	}
+
+	var wg sync.WaitGroup
+	start := make(chan struct{})
+	errs := make(chan error, len(users)*len(milestones))
+	for _, userID := range users {
+		for _, milestoneID := range milestones {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				<-start
+				_, err := reveals.UnlockReveal(ctx, userID, conn.ID, milestoneID, &dto.UnlockRevealRequest{UseCredits: true})
+				if errors.Is(err, ErrEarlyRevealLimitReached) || errors.Is(err, ErrRevealAlreadyUnlocked) {
+					err = nil
+				}
+				errs <- err
+			}()
+		}
+	}
+	close(start)
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Errorf("UnlockReveal: %v", err)
+		}
+	}
+
+	bought := client.Reveal.
+		Query().
+		Where(reveal.ConnectionIDEQ(conn.ID)).
+		Where(reveal.UnlockMethodEQ(reveal.UnlockMethodPurchased)).
+		CountX(ctx)
+	if bought != 2 {
+		t.Errorf("bought %d early reveals, want 2", bought)
+	}
+
+	spent := 0
+	for _, userID := range users {
+		spent += 100 - client.User.GetX(ctx, userID).CreditBalance
+	}
+	if spent != bought*10 {
+		t.Errorf("spent %d credits for %d reveals", spent, bought)
+	}
+}
+
+// TestUnlockRevealIdempotentConcurrently sends the same paid unlock several
+// times at once, as a client retrying on a slow network would: every request
+// gets the one unlock's response and it is paid for once.
+func TestUnlockRevealIdempotentConcurrently(t *testing.T) {
+	ctx := context.Background()
+	client := databasetest.NewClient(t)
+	reveals := NewRevealService(client, monetizationservices.NewCreditsService(client), entitlementservices.NewEntitlementService(client))
+
+	users := make([]string, 2)
+	for i := range users {
+		users[i] = client.User.
+			Create().
+			SetID(uuid.New().String()).
+			SetCreditBalance(100).
+			SaveX(ctx).ID
+	}
+	if users[0] > users[1] {
+		users[0], users[1] = users[1], users[0]
+	}
+	conn := client.Connection.
+		Create().
+		SetID(uuid.New().String()).
+		SetUserAID(users[0]).
+		SetUserBID(users[1]).
+		SetServerType(connection.ServerTypePartner).
+		SaveX(ctx)
+	client.Streak.
+		Create().
+		SetID(uuid.New().String()).
+		SetConnectionID(conn.ID).
+		SaveX(ctx)
+	milestone := client.RevealMilestone.
+		Create().
+		SetID(uuid.New().String()).
+		SetRevealNumber(1).
+		SetDayRequired(5).
+		SetRevealType(revealmilestone.RevealTypePersonality).
+		SetTitle("Reveal").
+		SetCreditCost(10).
+		SaveX(ctx)
+
+	const requests = 4
+	req := &dto.UnlockRevealRequest{UseCredits: true, IdempotencyKey: uuid.New().String()}
+	var wg sync.WaitGroup
+	start := make(chan struct{})
+	responses := make(chan *dto.UnlockRevealResponse, requests)
+	for range requests {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+			resp, err := reveals.UnlockReveal(ctx, users[0], conn.ID, milestone.ID, req)
+			if err != nil {
+				t.Errorf("UnlockReveal: %v", err)
+				return
+			}
+			responses <- resp
+		}()
+	}
+	close(start)
+	wg.Wait()
+	close(responses)
+
+	transactions := make(map[string]bool)
+	for resp := range responses {
+		transactions[resp.TransactionID] = true
+		if resp.CreditsUsed != 10 || resp.Reveal == nil {
+			t.Errorf("response = %d credits used, reveal %v", resp.CreditsUsed, resp.Reveal)
+		}
+	}
+	if len(transactions) != 1 {
+		t.Errorf("responses name %d transactions, want 1", len(transactions))
+	}
+	if balance := client.User.GetX(ctx, users[0]).CreditBalance; balance != 90 {
+		t.Errorf("balance = %d, want 90", balance)
+	}
+}
+
+// TestMarkRevealViewedConcurrently has a user open a reveal on several
+// devices at once: exactly one of the views is the first.
+func TestMarkRevealViewedConcurrently(t *testing.T) {
+	ctx := context.Background()
+	client := databasetest.NewClient(t)
+	reveals := NewRevealService(client, monetizationservices.NewCreditsService(client), entitlementservices.NewEntitlementService(client))
+
+	users := make([]string, 2)
+	for i := range users {
+		users[i] = client.User.Create().SetID(uuid.New().String()).SaveX(ctx).ID
+	}
+	conn := client.Connection.
+		Create().
+		SetID(uuid.New().String()).
+		SetUserAID(users[0]).
+		SetUserBID(users[1]).
+		SetServerType(connection.ServerTypePartner).
+		SaveX(ctx)
+	milestone := client.RevealMilestone.
+		Create().
+		SetID(uuid.New().String()).
+		SetRevealNumber(1).
+		SetDayRequired(5).
+		SetRevealType(revealmilestone.RevealTypePersonality).
+		SetTitle("Reveal").
+		SaveX(ctx)
+	r := client.Reveal.
+		Create().
+		SetID(uuid.New().String()).
+		SetConnectionID(conn.ID).
+		SetMilestoneID(milestone.ID).
+		SetRevealStatus(reveal.RevealStatusUnlocked).
+		SaveX(ctx)
+
+	const views = 4
+	var wg sync.WaitGroup
+	start := make(chan struct{})
+	results := make(chan *dto.RevealViewResponse, views)
+	for range views {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+			resp, err := reveals.MarkRevealViewed(ctx, users[0], r.ID, nil)
+			if err != nil {
+				t.Errorf("MarkRevealViewed: %v", err)
+				return
+			}
+			results <- resp
+		}()
+	}
+	close(start)
+	wg.Wait()
+	close(results)
+
+	firstViews := 0
+	for resp := range results {
+		if resp.IsFirstView {
+			firstViews++
+		}
+	}
+	if firstViews != 1 {
+		t.Errorf("%d responses were first views, want 1", firstViews)
+	}
+	stored := client.RevealView.
+		Query().
+		Where(revealview.RevealIDEQ(r.ID)).
+		Where(revealview.IsFirstView(true)).
+		CountX(ctx)
+	if stored != 1 {
+		t.Errorf("stored %d first views, want 1", stored)
+	}
+}
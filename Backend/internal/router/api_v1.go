@@ -5,25 +5,33 @@ import (
 	"context"
 	"log"
 	"net/http"
-	"os"
 
 	"github.com/gin-gonic/gin"
 	"github.com/redis/go-redis/v9"
 
 	ent "github.com/UnoraApp/be/ent/generated"
+	accountroutes "github.com/UnoraApp/be/internal/account/routes"
+	accountservices "github.com/UnoraApp/be/internal/account/services"
 	adminroutes "github.com/UnoraApp/be/internal/admin/routes"
 	"github.com/UnoraApp/be/internal/auth/middlewares"
 	authroutes "github.com/UnoraApp/be/internal/auth/routes"
+	chatroutes "github.com/UnoraApp/be/internal/chat/routes"
+	chatservices "github.com/UnoraApp/be/internal/chat/services"
 	"github.com/UnoraApp/be/internal/config"
 	"github.com/UnoraApp/be/internal/di"
 	discoveryroutes "github.com/UnoraApp/be/internal/discovery/routes"
+	entitlementroutes "github.com/UnoraApp/be/internal/entitlement/routes"
+	entitlementservices "github.com/UnoraApp/be/internal/entitlement/services"
 	"github.com/UnoraApp/be/internal/health"
 	monetizationroutes "github.com/UnoraApp/be/internal/monetization/routes"
 	monetizationservices "github.com/UnoraApp/be/internal/monetization/services"
+	notificationroutes "github.com/UnoraApp/be/internal/notification/routes"
+	notificationservices "github.com/UnoraApp/be/internal/notification/services"
 	profileroutes "github.com/UnoraApp/be/internal/profile/routes"
 	revealroutes "github.com/UnoraApp/be/internal/reveal/routes"
 	safetyroutes "github.com/UnoraApp/be/internal/safety/routes"
 	streakroutes "github.com/UnoraApp/be/internal/streak/routes"
+	"github.com/UnoraApp/be/pkg/ratelimit"
 	"github.com/UnoraApp/be/pkg/storage"
 )
 
@@ -70,38 +78,78 @@ func RegisterRoutes(router *gin.Engine, entClient *ent.Client, redisClient *redi
 	// Register module routes
 	// ==========================================================
 
+	// Rate limits, per route and shared by all instances
+	limiter := ratelimit.NewLimiter(redisClient, cfg)
+
 	// Auth routes (Google OAuth, token refresh, logout)
-	authroutes.RegisterAuthRoutes(api, entClient, redisClient, cfg)
+	authroutes.RegisterAuthRoutes(api, entClient, redisClient, cfg, limiter)
+
+	// Credits and promotional rewards, used by onboarding and streaks as well
+	// as the monetization routes
+	creditsService := monetizationservices.NewCreditsService(entClient)
+	promoService := monetizationservices.NewPromoService(entClient, creditsService)
+	rewardsService := monetizationservices.NewRewardsService(entClient, creditsService, &cfg.Rewards)
 
 	// Profile routes (profile, photos, hobbies)
 	authService := di.InitializeAuthService(entClient, redisClient, cfg)
 	authMiddleware := middlewares.AuthMiddleware(authService)
-	profileroutes.RegisterProfileRoutes(api, entClient, storageClient, authService, authMiddleware)
+	profileroutes.RegisterProfileRoutes(api, entClient, storageClient, authService, rewardsService, authMiddleware, limiter)
+
+	// Chat hub fans live events out across instances via Redis pub/sub
+	chatHub := chatservices.NewHub(redisClient)
+	go chatHub.Run(ctx)
+	chatModeration := chatservices.NewModerationPipelineFromConfig(&cfg.Moderation)
+	chatService := chatservices.NewChatService(entClient, chatHub, chatModeration)
+
+	// Entitlement routes (tier limits and remaining usage)
+	entitlementService := entitlementservices.NewEntitlementService(entClient)
+	entitlementroutes.RegisterEntitlementRoutes(api, entitlementService, authMiddleware)
 
 	// Discovery and Matching routes (servers, discover, interests, connections)
-	discoveryroutes.RegisterDiscoveryRoutes(api, entClient, storageClient, authMiddleware)
+	discoveryroutes.RegisterDiscoveryRoutes(api, entClient, storageClient, chatService, entitlementService, authMiddleware, limiter)
 
 	// Streak routes (check-ins, nudges, recovery)
-	streakroutes.RegisterStreakRoutes(api, entClient, storageClient, authMiddleware)
+	streakroutes.RegisterStreakRoutes(api, entClient, storageClient, rewardsService, entitlementService, authMiddleware)
+
+	// Notification routes (in-app inbox)
+	notificationService := notificationservices.NewNotificationService(entClient)
+	notificationroutes.RegisterNotificationRoutes(api, notificationService, authMiddleware)
+
+	// Account routes (self-serve deletion, data export)
+	exportService := accountservices.NewExportService(entClient, storageClient, cfg)
+	deletionService := accountservices.NewDeletionService(entClient, storageClient, authService, chatService, notificationService, exportService, cfg)
+	accountroutes.RegisterAccountRoutes(api, deletionService, exportService, authMiddleware)
+
+	// Reveal routes (milestones, unlock, gifts, content)
+	revealroutes.RegisterRevealRoutes(api, entClient, notificationService, entitlementService, authMiddleware)
 
-	// Reveal routes (milestones, unlock, content)
-	revealroutes.RegisterRevealRoutes(api, entClient, authMiddleware)
+	// Chat routes (post-Day-15 conversations, live delivery)
+	chatroutes.RegisterChatRoutes(api, chatService, authMiddleware)
 
-	// Monetization routes (credits, payments, Razorpay)
-	razorpayConfig := &monetizationservices.RazorpayConfig{
-		KeyID:     os.Getenv("RAZORPAY_KEY_ID"),
-		KeySecret: os.Getenv("RAZORPAY_KEY_SECRET"),
+	// Monetization routes (credits, payments, subscriptions); the payment
+	// provider is chosen by PAYMENT_PROVIDER
+	paymentGateway, err := monetizationservices.NewPaymentGateway(&cfg.Payment)
+	if err != nil {
+		log.Fatalf("Invalid payment configuration: %v", err)
 	}
-	monetizationroutes.RegisterMonetizationRoutes(api, entClient, razorpayConfig, authMiddleware)
+	invoiceService := monetizationservices.NewInvoiceService(entClient, storageClient, &cfg.Invoice)
+	paymentService := monetizationservices.NewPaymentService(entClient, paymentGateway, creditsService, promoService, invoiceService)
+	subscriptionService := monetizationservices.NewSubscriptionService(entClient, paymentGateway)
+	refundService := monetizationservices.NewRefundService(entClient, paymentGateway, creditsService)
+	paymentReconciliationService := monetizationservices.NewPaymentReconciliationService(entClient, paymentGateway, paymentService)
+	webhookService := monetizationservices.NewWebhookService(entClient, paymentGateway, paymentService, subscriptionService, refundService)
+	monetizationroutes.RegisterMonetizationRoutes(api, paymentGateway, creditsService, paymentService, webhookService, subscriptionService, promoService, rewardsService, invoiceService, authMiddleware, limiter)
 
 	// Safety routes (block, report)
-	safetyroutes.RegisterSafetyRoutes(api, entClient, authMiddleware)
+	safetyroutes.RegisterSafetyRoutes(api, entClient, chatService, authMiddleware, limiter)
 
 	// Admin routes (dashboard, user mgmt, reports, content)
-	adminroutes.RegisterAdminRoutes(api, entClient)
+	reconciliationService := monetizationservices.NewReconciliationService(entClient)
+	adminroutes.RegisterAdminRoutes(api, entClient, authService, chatService, creditsService, refundService, webhookService, reconciliationService, paymentReconciliationService, promoService, invoiceService, entitlementService, deletionService, limiter)
 
 	// Storage presigned URL endpoints (for frontend file uploads)
 	storageGroup := api.Group("/storage")
+	storageGroup.Use(limiter.Middleware("storage_presign"))
 	{
 		// Get presigned upload URL
 		storageGroup.POST("/presigned-upload", func(c *gin.Context) {
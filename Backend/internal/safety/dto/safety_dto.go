@@ -27,7 +27,7 @@ type UnblockUserRequest struct {
 }
 
 // ReportUserRequest is the request to report a user
-// @Description Report a user for policy violation
+// @Description Report a user for policy violation. Use referenceType "message" to attach the chat context as evidence.
 type ReportUserRequest struct {
 	UserID        string `json:"userId" validate:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
 	Reason        string `json:"reason" validate:"required,oneof=inappropriate_content harassment spam fake_profile underage offensive_behavior other" example:"harassment"`
@@ -36,6 +36,13 @@ type ReportUserRequest struct {
 	ReferenceID   string `json:"referenceId,omitempty" validate:"max=36" example:"msg_123"`
 }
 
+// ReportMessageRequest is the request to report a chat message
+// @Description Report a chat message; the surrounding conversation is kept as evidence
+type ReportMessageRequest struct {
+	Reason      string `json:"reason" validate:"required,oneof=inappropriate_content harassment spam fake_profile underage offensive_behavior other" example:"harassment"`
+	Description string `json:"description,omitempty" validate:"max=1000" example:"Asked me to send money"`
+}
+
 // ReportResponse represents a user report
 // @Description User report record
 type ReportResponse struct {
@@ -44,6 +51,7 @@ type ReportResponse struct {
 	Reason         string    `json:"reason" example:"harassment"`
 	Description    string    `json:"description,omitempty" example:"User sent threatening messages"`
 	Status         string    `json:"status" example:"pending"`
+	EvidenceCount  int       `json:"evidenceCount,omitempty" example:"21"`
 	CreatedAt      time.Time `json:"createdAt" example:"2024-01-01T00:00:00Z"`
 }
 
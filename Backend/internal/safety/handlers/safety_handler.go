@@ -3,6 +3,7 @@ package handlers
 
 import (
 	"net/http"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 
@@ -153,3 +154,39 @@ func (h *SafetyHandler) ReportUser(c *gin.Context) {
 	}
 	response.JSON(c, http.StatusCreated, report)
 }
+
+// ReportMessage godoc
+// @Summary      Report message
+// @Description  Report a chat message. The message and the conversation around it are stored as evidence for review.
+// @Tags         safety
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        messageId path string true "Message ID"
+// @Param        request body dto.ReportMessageRequest true "Report details"
+// @Success      201 {object} response.APIResponse{data=dto.ReportResponse} "Report submitted"
+// @Failure      400 {object} response.APIResponse "Invalid request"
+// @Failure      401 {object} response.APIResponse "Not authenticated"
+// @Failure      404 {object} response.APIResponse "Message not found"
+// @Router       /messages/{messageId}/report [post]
+func (h *SafetyHandler) ReportMessage(c *gin.Context) {
+	userID, _ := c.Get("userID")
+	messageID := c.Param("messageId")
+
+	var req dto.ReportMessageRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apperror.HandleError(c, apperror.BadRequest(err.Error()))
+		return
+	}
+
+	report, err := h.safetyService.ReportMessage(c.Request.Context(), userID.(string), messageID, &req)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			apperror.HandleError(c, apperror.NotFound("message"))
+			return
+		}
+		apperror.HandleError(c, apperror.BadRequest(err.Error()))
+		return
+	}
+	response.JSON(c, http.StatusCreated, report)
+}
@@ -5,18 +5,22 @@ import (
 	"github.com/gin-gonic/gin"
 
 	ent "github.com/UnoraApp/be/ent/generated"
+	chatservices "github.com/UnoraApp/be/internal/chat/services"
 	"github.com/UnoraApp/be/internal/safety/handlers"
 	"github.com/UnoraApp/be/internal/safety/services"
+	"github.com/UnoraApp/be/pkg/ratelimit"
 )
 
 // RegisterSafetyRoutes registers all safety-related routes
 func RegisterSafetyRoutes(
 	router *gin.RouterGroup,
 	entClient *ent.Client,
+	chatService *chatservices.ChatService,
 	authMiddleware gin.HandlerFunc,
+	limiter *ratelimit.Limiter,
 ) {
 	// Create services
-	safetyService := services.NewSafetyService(entClient)
+	safetyService := services.NewSafetyService(entClient, chatService)
 
 	// Create handler
 	handler := handlers.NewSafetyHandler(safetyService)
@@ -26,12 +30,13 @@ func RegisterSafetyRoutes(
 	protected.Use(authMiddleware)
 	{
 		// Block operations
-		protected.POST("/blocks", handler.BlockUser)
-		protected.POST("/blocks/unblock", handler.UnblockUser)
+		protected.POST("/blocks", limiter.Middleware("blocks"), handler.BlockUser)
+		protected.POST("/blocks/unblock", limiter.Middleware("blocks"), handler.UnblockUser)
 		protected.GET("/blocks", handler.GetBlockedUsers)
 		protected.GET("/blocks/status/:userId", handler.GetBlockStatus)
 
 		// Report operations
-		protected.POST("/reports", handler.ReportUser)
+		protected.POST("/reports", limiter.Middleware("reports"), handler.ReportUser)
+		protected.POST("/messages/:messageId/report", limiter.Middleware("reports"), handler.ReportMessage)
 	}
 }
@@ -10,21 +10,30 @@ import (
 
 	ent "github.com/UnoraApp/be/ent/generated"
 	"github.com/UnoraApp/be/ent/generated/connection"
+	"github.com/UnoraApp/be/ent/generated/conversation"
 	"github.com/UnoraApp/be/ent/generated/interest"
 	"github.com/UnoraApp/be/ent/generated/userblock"
 	"github.com/UnoraApp/be/ent/generated/userreport"
+	chatservices "github.com/UnoraApp/be/internal/chat/services"
 	"github.com/UnoraApp/be/internal/safety/dto"
+	"github.com/UnoraApp/be/pkg/database"
+	"github.com/UnoraApp/be/pkg/logger"
 )
 
+// ReferenceTypeMessage is the report reference type for chat messages
+const ReferenceTypeMessage = "message"
+
 // SafetyService handles safety-related business logic
 type SafetyService struct {
-	entClient *ent.Client
+	entClient   *ent.Client
+	chatService *chatservices.ChatService
 }
 
 // NewSafetyService creates a new safety service
-func NewSafetyService(entClient *ent.Client) *SafetyService {
+func NewSafetyService(entClient *ent.Client, chatService *chatservices.ChatService) *SafetyService {
 	return &SafetyService{
-		entClient: entClient,
+		entClient:   entClient,
+		chatService: chatService,
 	}
 }
 
@@ -222,6 +231,14 @@ func (s *SafetyService) ReportUser(ctx context.Context, reporterID string, req *
 		return nil, fmt.Errorf("cannot report yourself")
 	}
 
+	// Message reports carry the conversation around the message as evidence
+	if req.ReferenceType == ReferenceTypeMessage {
+		if req.ReferenceID == "" {
+			return nil, fmt.Errorf("referenceId is required for message reports")
+		}
+		return s.reportMessage(ctx, reporterID, req.UserID, req.ReferenceID, req.Reason, req.Description)
+	}
+
 	// Create report
 	reportID := uuid.New().String()
 	report, err := s.entClient.UserReport.
@@ -249,6 +266,77 @@ func (s *SafetyService) ReportUser(ctx context.Context, reporterID string, req *
 	}, nil
 }
 
+// ReportMessage reports the sender of a chat message
+func (s *SafetyService) ReportMessage(ctx context.Context, reporterID, messageID string, req *dto.ReportMessageRequest) (*dto.ReportResponse, error) {
+	return s.reportMessage(ctx, reporterID, "", messageID, req.Reason, req.Description)
+}
+
+// reportMessage files a report against a message's sender and snapshots the
+// message and its surrounding context as immutable evidence. An empty
+// reportedID means the sender is taken from the message.
+func (s *SafetyService) reportMessage(ctx context.Context, reporterID, reportedID, messageID, reason, description string) (*dto.ReportResponse, error) {
+	target, thread, err := s.chatService.MessageEvidence(ctx, reporterID, messageID)
+	if err != nil {
+		return nil, err
+	}
+	if reportedID == "" {
+		reportedID = target.SenderUserID
+	}
+	if target.SenderUserID != reportedID {
+		return nil, fmt.Errorf("message was not sent by the reported user")
+	}
+
+	var report *ent.UserReport
+	err = database.WithTx(ctx, s.entClient, func(tx *ent.Tx) error {
+		var err error
+		report, err = tx.UserReport.
+			Create().
+			SetID(uuid.New().String()).
+			SetReporterUserID(reporterID).
+			SetReportedUserID(reportedID).
+			SetReportReason(userreport.ReportReason(reason)).
+			SetNillableDescription(strPtr(description)).
+			SetReferenceType(ReferenceTypeMessage).
+			SetReferenceID(target.ID).
+			SetReportStatus(userreport.ReportStatusPending).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to create report: %w", err)
+		}
+
+		builders := make([]*ent.ReportEvidenceCreate, len(thread))
+		for i, m := range thread {
+			builders[i] = tx.ReportEvidence.
+				Create().
+				SetID(uuid.New().String()).
+				SetReportID(report.ID).
+				SetMessageID(m.ID).
+				SetSenderUserID(m.SenderUserID).
+				SetBody(m.Body).
+				SetModerationStatus(string(m.ModerationStatus)).
+				SetSentAt(m.CreatedAt).
+				SetIsReportedMessage(m.ID == target.ID)
+		}
+		if _, err := tx.ReportEvidence.CreateBulk(builders...).Save(ctx); err != nil {
+			return fmt.Errorf("failed to store report evidence: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.ReportResponse{
+		ID:             report.ID,
+		ReportedUserID: report.ReportedUserID,
+		Reason:         string(report.ReportReason),
+		Description:    ptrToString(report.Description),
+		Status:         string(report.ReportStatus),
+		EvidenceCount:  len(thread),
+		CreatedAt:      report.CreatedAt,
+	}, nil
+}
+
 // Helper: terminate connection between users
 func (s *SafetyService) terminateConnection(ctx context.Context, userA, userB string) {
 	// Ensure ordering
@@ -264,6 +352,12 @@ func (s *SafetyService) terminateConnection(ctx context.Context, userA, userB st
 		SetConnectionStatus(connection.ConnectionStatusTerminated).
 		SetTerminatedAt(time.Now()).
 		Save(ctx)
+
+	// A block ends the chat immediately, including on open sockets
+	if err := s.chatService.CloseConversationsBetween(ctx, userA, userB, conversation.ClosedReasonBlocked); err != nil {
+		log := logger.GetLogger("safety")
+		log.Error().Err(err).Str("user_a", userA).Str("user_b", userB).Msg("Failed to close conversations after block")
+	}
 }
 
 // Helper: cancel pending interests between users
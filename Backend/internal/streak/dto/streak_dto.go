@@ -77,6 +77,8 @@ type NudgeResponse struct {
 	SenderName string     `json:"senderName" example:"John"`
 	CreatedAt  time.Time  `json:"createdAt" example:"2024-01-05T10:00:00Z"`
 	SeenAt     *time.Time `json:"seenAt,omitempty"`
+	// Set on a sent nudge: how many more the sender may send this at-risk period
+	NudgesRemaining *int `json:"nudgesRemaining,omitempty" example:"2"`
 }
 
 // RecoveryOptionsResponse returns available recovery options
@@ -86,12 +88,16 @@ type RecoveryOptionsResponse struct {
 	RecoveryDeadline *time.Time `json:"recoveryDeadline,omitempty" example:"2024-01-06T00:00:00Z"`
 	CreditCost       int        `json:"creditCost" example:"50"`
 	UserCredits      int        `json:"userCredits" example:"100"`
+	// Tier allowance of credit-free recoveries on this connection
+	FreeRecoveryLimit       int `json:"freeRecoveryLimit" example:"1"`
+	FreeRecoveriesRemaining int `json:"freeRecoveriesRemaining" example:"1"`
 }
 
 // RecoverStreakRequest is the request for streak recovery
 // @Description Request to recover a broken streak
 type RecoverStreakRequest struct {
-	PayWithCredits bool `json:"payWithCredits" example:"true"`
+	PayWithCredits  bool `json:"payWithCredits" example:"true"`
+	UseFreeRecovery bool `json:"useFreeRecovery" example:"false"`
 }
 
 // RecoverStreakResponse is the response after recovery
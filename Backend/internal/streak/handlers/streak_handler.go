@@ -112,7 +112,7 @@ func (h *StreakHandler) GetTodayStreaks(c *gin.Context) {
 // @Param        connectionId path string true "Connection ID"
 // @Param        request body dto.SendNudgeRequest false "Nudge message"
 // @Success      201 {object} response.APIResponse{data=dto.NudgeResponse} "Nudge sent"
-// @Failure      400 {object} response.APIResponse "Already nudged today"
+// @Failure      400 {object} response.APIResponse "Nudge limit reached or too soon since the last nudge"
 // @Failure      401 {object} response.APIResponse "Not authenticated"
 // @Router       /connections/{connectionId}/nudge [post]
 func (h *StreakHandler) SendNudge(c *gin.Context) {
@@ -177,7 +177,7 @@ func (h *StreakHandler) GetRecoveryOptions(c *gin.Context) {
 
 // RecoverStreak godoc
 // @Summary      Recover streak
-// @Description  Recover a broken streak using credits
+// @Description  Recover a broken streak using credits or a free recovery from the tier allowance
 // @Tags         streaks
 // @Accept       json
 // @Produce      json
@@ -5,6 +5,8 @@ import (
 	"github.com/gin-gonic/gin"
 
 	ent "github.com/UnoraApp/be/ent/generated"
+	entitlementservices "github.com/UnoraApp/be/internal/entitlement/services"
+	monetizationservices "github.com/UnoraApp/be/internal/monetization/services"
 	"github.com/UnoraApp/be/internal/streak/handlers"
 	"github.com/UnoraApp/be/internal/streak/services"
 	"github.com/UnoraApp/be/pkg/storage"
@@ -15,10 +17,13 @@ func RegisterStreakRoutes(
 	router *gin.RouterGroup,
 	entClient *ent.Client,
 	storageClient storage.Client,
+	rewardsService *monetizationservices.RewardsService,
+	entitlementService *entitlementservices.EntitlementService,
 	authMiddleware gin.HandlerFunc,
 ) {
 	// Create services
-	streakService := services.NewStreakService(entClient, storageClient)
+	creditsService := monetizationservices.NewCreditsService(entClient)
+	streakService := services.NewStreakService(entClient, storageClient, creditsService, rewardsService, entitlementService)
 
 	// Create handler
 	handler := handlers.NewStreakHandler(streakService)
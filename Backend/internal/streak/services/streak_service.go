@@ -3,6 +3,7 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -11,24 +12,35 @@ import (
 	ent "github.com/UnoraApp/be/ent/generated"
 	"github.com/UnoraApp/be/ent/generated/checkin"
 	"github.com/UnoraApp/be/ent/generated/connection"
+	"github.com/UnoraApp/be/ent/generated/credittransaction"
 	"github.com/UnoraApp/be/ent/generated/nudge"
 	"github.com/UnoraApp/be/ent/generated/photo"
 	"github.com/UnoraApp/be/ent/generated/streak"
+	entitlementservices "github.com/UnoraApp/be/internal/entitlement/services"
+	monetizationservices "github.com/UnoraApp/be/internal/monetization/services"
 	"github.com/UnoraApp/be/internal/streak/dto"
+	"github.com/UnoraApp/be/pkg/database"
+	"github.com/UnoraApp/be/pkg/logger"
 	"github.com/UnoraApp/be/pkg/storage"
 )
 
 // StreakService handles streak-related business logic
 type StreakService struct {
-	entClient     *ent.Client
-	storageClient storage.Client
+	entClient          *ent.Client
+	storageClient      storage.Client
+	creditsService     *monetizationservices.CreditsService
+	rewardsService     *monetizationservices.RewardsService
+	entitlementService *entitlementservices.EntitlementService
 }
 
 // NewStreakService creates a new streak service
-func NewStreakService(entClient *ent.Client, storageClient storage.Client) *StreakService {
+func NewStreakService(entClient *ent.Client, storageClient storage.Client, creditsService *monetizationservices.CreditsService, rewardsService *monetizationservices.RewardsService, entitlementService *entitlementservices.EntitlementService) *StreakService {
 	return &StreakService{
-		entClient:     entClient,
-		storageClient: storageClient,
+		entClient:          entClient,
+		storageClient:      storageClient,
+		creditsService:     creditsService,
+		rewardsService:     rewardsService,
+		entitlementService: entitlementService,
 	}
 }
 
@@ -160,6 +172,12 @@ func (s *StreakService) CheckIn(ctx context.Context, userID, connectionID string
 		if err != nil {
 			// Log error but don't fail the check-in
 		}
+
+		// Both users have completed the day, which may be the milestone that
+		// pays out a referral of either of them
+		if err == nil {
+			s.releaseReferrals(ctx, st.CurrentDay, conn.UserAID, conn.UserBID)
+		}
 	}
 
 	activity := ""
@@ -177,6 +195,17 @@ func (s *StreakService) CheckIn(ctx context.Context, userID, connectionID string
 	}, nil
 }
 
+// releaseReferrals releases the pending referral bonuses of users whose streak
+// has completed daysCompleted days. Failures are logged; the check-in stands.
+func (s *StreakService) releaseReferrals(ctx context.Context, daysCompleted int, userIDs ...string) {
+	for _, userID := range userIDs {
+		if err := s.rewardsService.ReleaseReferral(ctx, userID, daysCompleted); err != nil {
+			log := logger.GetLogger("streak")
+			log.Error().Err(err).Str("user_id", userID).Msg("Failed to release referral bonus")
+		}
+	}
+}
+
 // GetTodayStreaks returns all streaks requiring check-in today
 func (s *StreakService) GetTodayStreaks(ctx context.Context, userID string) (*dto.TodayStreaksResponse, error) {
 	// Get all active connections for the user
@@ -304,17 +333,20 @@ func (s *StreakService) SendNudge(ctx context.Context, userID, connectionID stri
 		receiverID = conn.UserBID
 	}
 
-	// Check if already nudged today
-	today := time.Now().Truncate(24 * time.Hour)
-	exists, _ := s.entClient.Nudge.
-		Query().
-		Where(nudge.StreakIDEQ(st.ID)).
-		Where(nudge.SenderUserIDEQ(userID)).
-		Where(nudge.DayNumberEQ(st.CurrentDay)).
-		Where(nudge.CreatedAtGTE(today)).
-		Exist(ctx)
-	if exists {
-		return nil, fmt.Errorf("already sent a nudge today")
+	// Check the sender's tier allowance for this at-risk period
+	sender, err := s.entClient.User.Get(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	allowance, err := s.entitlementService.Nudges(ctx, sender, st)
+	if err != nil {
+		return nil, err
+	}
+	if !allowance.Allowed {
+		if allowance.Remaining == 0 {
+			return nil, fmt.Errorf("nudge limit reached for this at-risk period (%d/%d)", allowance.Used, allowance.Limit)
+		}
+		return nil, fmt.Errorf("next nudge available at %s", allowance.AvailableAt.Format(time.RFC3339))
 	}
 
 	// Create nudge
@@ -338,23 +370,23 @@ func (s *StreakService) SendNudge(ctx context.Context, userID, connectionID stri
 		return nil, fmt.Errorf("failed to send nudge: %w", err)
 	}
 
-	// Get sender info
-	sender, _ := s.entClient.User.Get(ctx, userID)
 	senderName := ""
-	if sender != nil && sender.FirstName != nil {
+	if sender.FirstName != nil {
 		senderName = *sender.FirstName
 	}
+	remaining := allowance.Remaining - 1
 
 	return &dto.NudgeResponse{
-		ID:         n.ID,
-		StreakID:   n.StreakID,
-		DayNumber:  n.DayNumber,
-		Status:     string(n.NudgeStatus),
-		Message:    ptrToString(n.Message),
-		SenderID:   n.SenderUserID,
-		SenderName: senderName,
-		CreatedAt:  n.CreatedAt,
-		SeenAt:     n.SeenAt,
+		ID:              n.ID,
+		StreakID:        n.StreakID,
+		DayNumber:       n.DayNumber,
+		Status:          string(n.NudgeStatus),
+		Message:         ptrToString(n.Message),
+		SenderID:        n.SenderUserID,
+		SenderName:      senderName,
+		CreatedAt:       n.CreatedAt,
+		SeenAt:          n.SeenAt,
+		NudgesRemaining: &remaining,
 	}, nil
 }
 
@@ -419,12 +451,21 @@ func (s *StreakService) GetRecoveryOptions(ctx context.Context, userID, streakID
 	// Recovery cost increases with day
 	recoveryCost := 20 + (st.CurrentDay * 5)
 
-	return &dto.RecoveryOptionsResponse{
+	options := &dto.RecoveryOptionsResponse{
 		CanRecover:       true,
 		RecoveryDeadline: st.RecoveryDeadlineAt,
 		CreditCost:       recoveryCost,
 		UserCredits:      userCredits,
-	}, nil
+	}
+	if u != nil {
+		free, err := s.entitlementService.FreeRecoveries(ctx, u, st)
+		if err != nil {
+			return nil, err
+		}
+		options.FreeRecoveryLimit = free.Limit
+		options.FreeRecoveriesRemaining = free.Remaining
+	}
+	return options, nil
 }
 
 // RecoverStreak recovers a broken streak using credits
@@ -444,37 +485,50 @@ func (s *StreakService) RecoverStreak(ctx context.Context, userID, streakID stri
 		return nil, fmt.Errorf("recovery deadline has passed")
 	}
 
-	// Get user
-	u, err := s.entClient.User.Get(ctx, userID)
-	if err != nil {
-		return nil, fmt.Errorf("user not found: %w", err)
+	if req.UseFreeRecovery {
+		return s.recoverWithAllowance(ctx, userID, st)
 	}
 
 	// Calculate cost
 	recoveryCost := 20 + (st.CurrentDay * 5)
 
 	if req.PayWithCredits {
-		if u.CreditBalance < recoveryCost {
-			return nil, fmt.Errorf("insufficient credits")
-		}
-
-		// Deduct credits
-		newBalance := u.CreditBalance - recoveryCost
-		_, err = u.Update().
-			SetCreditBalance(newBalance).
-			Save(ctx)
-		if err != nil {
-			return nil, fmt.Errorf("failed to deduct credits: %w", err)
-		}
-
-		// Restore streak
-		_, err = st.Update().
-			SetStreakState(streak.StreakStateActive).
-			ClearRecoveryDeadlineAt().
-			ClearBreakerUserID().
-			Save(ctx)
+		// The debit and the restore commit together
+		var newBalance int
+		err = database.WithTx(ctx, s.entClient, func(tx *ent.Tx) error {
+			ledgerTx, err := s.creditsService.SpendCredits(
+				ctx,
+				tx.Client(),
+				userID,
+				recoveryCost,
+				credittransaction.TransactionTypeStreakRecovery,
+				fmt.Sprintf("Streak recovery (day %d)", st.CurrentDay),
+				"streak_recovery",
+				uuid.New().String(),
+				"",
+			)
+			if err != nil {
+				return err
+			}
+			newBalance = ledgerTx.BalanceAfter
+
+			// Restore streak
+			_, err = tx.Streak.
+				UpdateOneID(st.ID).
+				SetStreakState(streak.StreakStateActive).
+				ClearRecoveryDeadlineAt().
+				ClearBreakerUserID().
+				Save(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to restore streak: %w", err)
+			}
+			return nil
+		})
 		if err != nil {
-			return nil, fmt.Errorf("failed to restore streak: %w", err)
+			if errors.Is(err, monetizationservices.ErrInsufficientCredits) {
+				return nil, fmt.Errorf("insufficient credits")
+			}
+			return nil, err
 		}
 
 		return &dto.RecoverStreakResponse{
@@ -488,6 +542,48 @@ func (s *StreakService) RecoverStreak(ctx context.Context, userID, streakID stri
 	return nil, fmt.Errorf("payment method not supported")
 }
 
+// recoverWithAllowance restores a streak using one of the connection's free
+// recoveries from the user's tier
+func (s *StreakService) recoverWithAllowance(ctx context.Context, userID string, st *ent.Streak) (*dto.RecoverStreakResponse, error) {
+	u, err := s.entClient.User.Get(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	free, err := s.entitlementService.FreeRecoveries(ctx, u, st)
+	if err != nil {
+		return nil, err
+	}
+	if !free.Allowed {
+		return nil, fmt.Errorf("no free recoveries left for this connection")
+	}
+
+	// Guard on the counter read above so concurrent requests spend one recovery
+	affected, err := s.entClient.Streak.
+		Update().
+		Where(streak.IDEQ(st.ID)).
+		Where(streak.FreeRecoveriesUsedEQ(st.FreeRecoveriesUsed)).
+		Where(streak.StreakStateIn(streak.StreakStatePaymentWindow, streak.StreakStateAtRisk)).
+		SetStreakState(streak.StreakStateActive).
+		ClearRecoveryDeadlineAt().
+		ClearBreakerUserID().
+		AddFreeRecoveriesUsed(1).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to restore streak: %w", err)
+	}
+	if affected == 0 {
+		return nil, fmt.Errorf("streak cannot be recovered")
+	}
+
+	return &dto.RecoverStreakResponse{
+		Success:        true,
+		NewCredits:     u.CreditBalance,
+		StreakRestored: true,
+		Message:        "Streak recovered with your free recovery!",
+	}, nil
+}
+
 func (s *StreakService) streakToResponse(ctx context.Context, st *ent.Streak, userID string, conn *ent.Connection) (*dto.StreakResponse, error) {
 	today := time.Now().Truncate(24 * time.Hour)
 
@@ -0,0 +1,20 @@
+-- +goose Up
+
+-- ============================================================================
+-- CREDIT TRANSACTIONS: IDEMPOTENT SPENDS
+-- Client-supplied key so a retried spend (e.g. a double-tapped early reveal)
+-- resolves to the original ledger entry instead of charging twice
+-- ============================================================================
+
+ALTER TABLE credit_transactions
+    ADD COLUMN idempotency_key VARCHAR(64) NULL AFTER description;
+
+CREATE UNIQUE INDEX credittransaction_user_id_idempotency_key
+    ON credit_transactions (user_id, idempotency_key);
+
+-- +goose Down
+
+DROP INDEX credittransaction_user_id_idempotency_key ON credit_transactions;
+
+ALTER TABLE credit_transactions
+    DROP COLUMN idempotency_key;
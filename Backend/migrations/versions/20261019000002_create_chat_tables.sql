@@ -0,0 +1,49 @@
+-- +goose Up
+
+-- ============================================================================
+-- MODULE: CHAT
+-- Post-Day-15 conversations between connected users, unlocked by the
+-- identity reveal and closed by blocks or connection termination
+-- ============================================================================
+
+CREATE TABLE conversations (
+    id VARCHAR(36) PRIMARY KEY,
+    connection_id VARCHAR(36) NOT NULL,
+    user_a_id VARCHAR(36) NOT NULL,
+    user_b_id VARCHAR(36) NOT NULL,
+
+    conversation_status ENUM('open', 'closed') NOT NULL DEFAULT 'open',
+    closed_reason ENUM('blocked', 'terminated'),
+
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_message_at TIMESTAMP NULL,
+    closed_at TIMESTAMP NULL,
+
+    CONSTRAINT conversations_connections_conversation FOREIGN KEY (connection_id) REFERENCES connections(id),
+    UNIQUE INDEX conversation_connection_id (connection_id),
+    INDEX conversation_user_a_id_conversation_status (user_a_id, conversation_status),
+    INDEX conversation_user_b_id_conversation_status (user_b_id, conversation_status)
+);
+
+CREATE TABLE messages (
+    id VARCHAR(36) PRIMARY KEY,
+    conversation_id VARCHAR(36) NOT NULL,
+    sender_user_id VARCHAR(36) NOT NULL,
+
+    body VARCHAR(2000) NOT NULL,
+    client_message_id VARCHAR(64),
+
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    read_at TIMESTAMP NULL,
+
+    CONSTRAINT messages_conversations_messages FOREIGN KEY (conversation_id) REFERENCES conversations(id),
+    CONSTRAINT messages_users_chat_messages FOREIGN KEY (sender_user_id) REFERENCES users(id),
+    INDEX message_conversation_id_created_at (conversation_id, created_at),
+    UNIQUE INDEX message_conversation_id_sender_user_id_client_message_id (conversation_id, sender_user_id, client_message_id),
+    INDEX message_sender_user_id (sender_user_id)
+);
+
+-- +goose Down
+
+DROP TABLE IF EXISTS messages;
+DROP TABLE IF EXISTS conversations;
@@ -0,0 +1,62 @@
+-- +goose Up
+
+-- ============================================================================
+-- MODULE: CHAT SAFETY
+-- Moderation status on messages, the moderation action log, and immutable
+-- message evidence attached to reports
+-- ============================================================================
+
+ALTER TABLE messages
+    ADD COLUMN moderation_status ENUM('visible', 'held', 'rejected') NOT NULL DEFAULT 'visible' AFTER client_message_id,
+    ADD INDEX message_moderation_status_created_at (moderation_status, created_at);
+
+CREATE TABLE moderation_actions (
+    id VARCHAR(36) PRIMARY KEY,
+    user_id VARCHAR(36) NOT NULL,
+    message_id VARCHAR(36),
+    conversation_id VARCHAR(36),
+
+    action ENUM('flagged', 'held', 'rejected', 'rate_limited', 'approved') NOT NULL,
+    source VARCHAR(50) NOT NULL,
+    reason TEXT,
+
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT moderation_actions_users_moderation_actions FOREIGN KEY (user_id) REFERENCES users(id),
+    CONSTRAINT moderation_actions_messages_moderation_actions FOREIGN KEY (message_id) REFERENCES messages(id) ON DELETE SET NULL,
+    INDEX moderationaction_user_id_created_at (user_id, created_at),
+    INDEX moderationaction_message_id (message_id)
+);
+
+CREATE TABLE report_evidences (
+    id VARCHAR(36) PRIMARY KEY,
+    report_id VARCHAR(36) NOT NULL,
+
+    message_id VARCHAR(36) NOT NULL,
+    sender_user_id VARCHAR(36) NOT NULL,
+    body TEXT NOT NULL,
+    moderation_status VARCHAR(20) NOT NULL,
+    sent_at TIMESTAMP NOT NULL,
+    is_reported_message BOOLEAN NOT NULL DEFAULT FALSE,
+
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT report_evidences_user_reports_evidence FOREIGN KEY (report_id) REFERENCES user_reports(id),
+    INDEX reportevidence_report_id_sent_at (report_id, sent_at)
+);
+
+-- Evidence is write-once; refuse edits at the database level too
+-- +goose StatementBegin
+CREATE TRIGGER report_evidences_no_update BEFORE UPDATE ON report_evidences
+FOR EACH ROW
+    SIGNAL SQLSTATE '45000' SET MESSAGE_TEXT = 'report evidence is immutable';
+-- +goose StatementEnd
+
+-- +goose Down
+
+DROP TRIGGER IF EXISTS report_evidences_no_update;
+DROP TABLE IF EXISTS report_evidences;
+DROP TABLE IF EXISTS moderation_actions;
+ALTER TABLE messages
+    DROP INDEX message_moderation_status_created_at,
+    DROP COLUMN moderation_status;
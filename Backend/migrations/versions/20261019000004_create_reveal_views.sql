@@ -0,0 +1,28 @@
+-- +goose Up
+
+-- ============================================================================
+-- MODULE: REVEAL ANALYTICS
+-- One row per viewing of a reveal by a participant. reveals.viewed_at keeps
+-- the first view by anyone; views recorded before this table have no rows.
+-- ============================================================================
+
+CREATE TABLE reveal_views (
+    id VARCHAR(36) PRIMARY KEY,
+    reveal_id VARCHAR(36) NOT NULL,
+    viewer_user_id VARCHAR(36) NOT NULL,
+
+    is_first_view BOOLEAN NOT NULL DEFAULT FALSE,
+    duration_seconds INT,
+    viewer_tier ENUM('free', 'plus', 'pro') NOT NULL,
+
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT reveal_views_reveals_views FOREIGN KEY (reveal_id) REFERENCES reveals(id),
+    CONSTRAINT reveal_views_users_reveal_views FOREIGN KEY (viewer_user_id) REFERENCES users(id),
+    INDEX revealview_reveal_id_viewer_user_id (reveal_id, viewer_user_id),
+    INDEX revealview_viewer_user_id_created_at (viewer_user_id, created_at)
+);
+
+-- +goose Down
+
+DROP TABLE IF EXISTS reveal_views;
@@ -0,0 +1,68 @@
+-- +goose Up
+
+-- ============================================================================
+-- MODULE: REVEAL GIFTS
+-- A user pays credits to let their partner see one of their upcoming reveals
+-- early. The partner accepts or declines; the debit happens on accept.
+-- ============================================================================
+
+CREATE TABLE reveal_gifts (
+    id VARCHAR(36) PRIMARY KEY,
+    connection_id VARCHAR(36) NOT NULL,
+    milestone_id VARCHAR(36) NOT NULL,
+    gifter_user_id VARCHAR(36) NOT NULL,
+    recipient_user_id VARCHAR(36) NOT NULL,
+
+    credit_cost INT NOT NULL,
+    gift_status ENUM('pending', 'accepted', 'declined', 'cancelled', 'expired') NOT NULL DEFAULT 'pending',
+    gifter_consented_at TIMESTAMP NOT NULL,
+
+    reveal_id VARCHAR(36),
+    credit_transaction_id VARCHAR(36),
+
+    expires_at TIMESTAMP NOT NULL,
+    responded_at TIMESTAMP NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT reveal_gifts_connections_reveal_gifts FOREIGN KEY (connection_id) REFERENCES connections(id),
+    CONSTRAINT reveal_gifts_reveal_milestones_gifts FOREIGN KEY (milestone_id) REFERENCES reveal_milestones(id),
+    CONSTRAINT reveal_gifts_users_reveal_gifts_sent FOREIGN KEY (gifter_user_id) REFERENCES users(id),
+    CONSTRAINT reveal_gifts_users_reveal_gifts_received FOREIGN KEY (recipient_user_id) REFERENCES users(id),
+    INDEX revealgift_connection_id_milestone_id_gift_status (connection_id, milestone_id, gift_status),
+    INDEX revealgift_recipient_user_id_gift_status (recipient_user_id, gift_status),
+    INDEX revealgift_gifter_user_id_gift_status (gifter_user_id, gift_status)
+);
+
+ALTER TABLE credit_transactions
+    MODIFY COLUMN transaction_type ENUM('purchase', 'streak_recovery', 'early_reveal', 'reveal_gift', 'referral_bonus', 'welcome_bonus', 'refund', 'admin_adjustment') NOT NULL;
+
+-- ============================================================================
+-- MODULE: NOTIFICATIONS
+-- In-app notification inbox
+-- ============================================================================
+
+CREATE TABLE notifications (
+    id VARCHAR(36) PRIMARY KEY,
+    user_id VARCHAR(36) NOT NULL,
+
+    notification_type VARCHAR(50) NOT NULL,
+    title VARCHAR(200) NOT NULL,
+    body LONGTEXT NOT NULL,
+    data JSON,
+
+    read_at TIMESTAMP NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT notifications_users_notifications FOREIGN KEY (user_id) REFERENCES users(id),
+    INDEX notification_user_id_created_at (user_id, created_at),
+    INDEX notification_user_id_read_at (user_id, read_at)
+);
+
+-- +goose Down
+
+DROP TABLE IF EXISTS notifications;
+
+ALTER TABLE credit_transactions
+    MODIFY COLUMN transaction_type ENUM('purchase', 'streak_recovery', 'early_reveal', 'referral_bonus', 'welcome_bonus', 'refund', 'admin_adjustment') NOT NULL;
+
+DROP TABLE IF EXISTS reveal_gifts;
@@ -0,0 +1,40 @@
+-- +goose Up
+
+-- ============================================================================
+-- MODULE: PAYMENT WEBHOOKS
+-- Every Razorpay webhook delivery, verified or not, stored with its raw body
+-- for deduplication, replay and debugging
+-- ============================================================================
+
+CREATE TABLE webhook_events (
+    id VARCHAR(36) PRIMARY KEY,
+    provider VARCHAR(20) NOT NULL DEFAULT 'razorpay',
+    event_id VARCHAR(100),
+    event_type VARCHAR(100),
+
+    payload LONGTEXT NOT NULL,
+    signature VARCHAR(256),
+    signature_valid BOOLEAN NOT NULL DEFAULT FALSE,
+
+    processing_status ENUM('received', 'processed', 'ignored', 'failed', 'rejected') NOT NULL DEFAULT 'received',
+    error_message LONGTEXT,
+    attempts INT NOT NULL DEFAULT 0,
+
+    received_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    processed_at TIMESTAMP NULL,
+
+    UNIQUE INDEX webhookevent_provider_event_id (provider, event_id),
+    INDEX webhookevent_processing_status_received_at (processing_status, received_at),
+    INDEX webhookevent_event_type (event_type)
+);
+
+-- Refunds processed by Razorpay, which may be partial
+ALTER TABLE payment_orders
+    ADD COLUMN amount_refunded INT NOT NULL DEFAULT 0 AFTER order_status;
+
+-- +goose Down
+
+ALTER TABLE payment_orders
+    DROP COLUMN amount_refunded;
+
+DROP TABLE IF EXISTS webhook_events;
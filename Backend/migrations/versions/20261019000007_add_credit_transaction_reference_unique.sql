@@ -0,0 +1,26 @@
+-- +goose Up
+
+-- ============================================================================
+-- CREDIT TRANSACTIONS: ONE LEDGER ENTRY PER REFERENCE
+-- A payment order, reveal or refund can be credited or charged only once.
+-- Duplicates written before this constraint (e.g. an order credited twice by
+-- racing verify calls) keep their amounts but lose the reference, which is
+-- moved into the description so they stay traceable.
+-- ============================================================================
+
+UPDATE credit_transactions ct
+JOIN credit_transactions earlier
+    ON earlier.reference_type = ct.reference_type
+    AND earlier.reference_id = ct.reference_id
+    AND (earlier.created_at < ct.created_at
+        OR (earlier.created_at = ct.created_at AND earlier.id < ct.id))
+SET ct.description = CONCAT(COALESCE(ct.description, ''), ' [duplicate of ', ct.reference_type, ' ', ct.reference_id, ']'),
+    ct.reference_type = NULL,
+    ct.reference_id = NULL;
+
+CREATE UNIQUE INDEX credittransaction_reference_type_reference_id
+    ON credit_transactions (reference_type, reference_id);
+
+-- +goose Down
+
+DROP INDEX credittransaction_reference_type_reference_id ON credit_transactions;
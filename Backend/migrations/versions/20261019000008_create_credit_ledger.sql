@@ -0,0 +1,68 @@
+-- +goose Up
+
+-- ============================================================================
+-- MODULE: CREDIT LEDGER
+-- Double-entry postings behind every credit transaction, and the balance
+-- mismatches found by reconciliation
+-- ============================================================================
+
+-- Two entries per credit transaction: the user's account and a system
+-- counter-account, summing to zero
+CREATE TABLE ledger_entries (
+    id VARCHAR(36) PRIMARY KEY,
+    transaction_id VARCHAR(36) NOT NULL,
+    account VARCHAR(64) NOT NULL,
+    amount INT NOT NULL,
+
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT ledger_entries_credit_transactions_entries FOREIGN KEY (transaction_id) REFERENCES credit_transactions(id),
+    INDEX ledgerentry_transaction_id (transaction_id),
+    INDEX ledgerentry_account_created_at (account, created_at)
+);
+
+CREATE TABLE balance_drifts (
+    id VARCHAR(36) PRIMARY KEY,
+    user_id VARCHAR(36) NOT NULL,
+
+    stored_balance INT NOT NULL,
+    ledger_balance INT NOT NULL,
+    difference INT NOT NULL,
+
+    detected_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_checked_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    resolved_at TIMESTAMP NULL,
+
+    CONSTRAINT balance_drifts_users_balance_drifts FOREIGN KEY (user_id) REFERENCES users(id),
+    INDEX balancedrift_user_id_resolved_at (user_id, resolved_at),
+    INDEX balancedrift_resolved_at_detected_at (resolved_at, detected_at)
+);
+
+-- Backfill both sides of every existing transaction. Balances changed outside
+-- the old transaction log show up as drift on the first reconciliation.
+INSERT INTO ledger_entries (id, transaction_id, account, amount, created_at)
+SELECT UUID(), id, CONCAT('user:', user_id), credit_amount, created_at
+FROM credit_transactions;
+
+INSERT INTO ledger_entries (id, transaction_id, account, amount, created_at)
+SELECT
+    UUID(),
+    id,
+    CASE transaction_type
+        WHEN 'purchase' THEN 'system:purchases'
+        WHEN 'streak_recovery' THEN 'system:streak_recovery'
+        WHEN 'early_reveal' THEN 'system:reveals'
+        WHEN 'reveal_gift' THEN 'system:reveals'
+        WHEN 'referral_bonus' THEN 'system:promotions'
+        WHEN 'welcome_bonus' THEN 'system:promotions'
+        WHEN 'refund' THEN 'system:refunds'
+        ELSE 'system:admin'
+    END,
+    -credit_amount,
+    created_at
+FROM credit_transactions;
+
+-- +goose Down
+
+DROP TABLE IF EXISTS balance_drifts;
+DROP TABLE IF EXISTS ledger_entries;
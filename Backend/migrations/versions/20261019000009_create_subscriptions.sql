@@ -0,0 +1,68 @@
+-- +goose Up
+
+-- ============================================================================
+-- MODULE: SUBSCRIPTIONS
+-- Plus/Pro plan catalog and users' Razorpay recurring subscriptions, which
+-- drive users.subscription_tier
+-- ============================================================================
+
+CREATE TABLE subscription_plans (
+    id VARCHAR(36) PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    tier ENUM('plus', 'pro') NOT NULL,
+    billing_period ENUM('monthly', 'yearly') NOT NULL,
+
+    price_amount INT NOT NULL,
+    currency VARCHAR(3) NOT NULL DEFAULT 'INR',
+    razorpay_plan_id VARCHAR(100),
+
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    sort_order INT NOT NULL DEFAULT 0,
+
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+    UNIQUE INDEX subscriptionplan_tier_billing_period (tier, billing_period),
+    INDEX subscriptionplan_is_active_sort_order (is_active, sort_order)
+);
+
+CREATE TABLE subscriptions (
+    id VARCHAR(36) PRIMARY KEY,
+    user_id VARCHAR(36) NOT NULL,
+    plan_id VARCHAR(36) NOT NULL,
+    razorpay_subscription_id VARCHAR(100) NOT NULL UNIQUE,
+
+    status ENUM('created', 'authenticated', 'active', 'pending', 'halted', 'paused', 'cancelled', 'expired') NOT NULL DEFAULT 'created',
+    current_start TIMESTAMP NULL,
+    current_end TIMESTAMP NULL,
+    grace_until TIMESTAMP NULL,
+    cancel_at_period_end BOOLEAN NOT NULL DEFAULT FALSE,
+    paid_count INT NOT NULL DEFAULT 0,
+    short_url VARCHAR(255),
+
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    activated_at TIMESTAMP NULL,
+    paused_at TIMESTAMP NULL,
+    cancelled_at TIMESTAMP NULL,
+    ended_at TIMESTAMP NULL,
+
+    CONSTRAINT subscriptions_subscription_plans_subscriptions FOREIGN KEY (plan_id) REFERENCES subscription_plans(id),
+    CONSTRAINT subscriptions_users_subscriptions FOREIGN KEY (user_id) REFERENCES users(id),
+    INDEX subscription_user_id_status (user_id, status),
+    INDEX subscription_status_grace_until (status, grace_until),
+    INDEX subscription_status_current_end (status, current_end)
+);
+
+-- PRD §16.2 pricing, in paise. razorpay_plan_id is filled in per environment
+-- from the Razorpay dashboard before a plan can be sold.
+INSERT INTO subscription_plans (id, name, tier, billing_period, price_amount, currency, is_active, sort_order) VALUES
+(UUID(), 'Plus Monthly', 'plus', 'monthly', 19900, 'INR', TRUE, 1),
+(UUID(), 'Plus Yearly', 'plus', 'yearly', 192000, 'INR', TRUE, 2),
+(UUID(), 'Pro Monthly', 'pro', 'monthly', 39900, 'INR', TRUE, 3),
+(UUID(), 'Pro Yearly', 'pro', 'yearly', 384000, 'INR', TRUE, 4);
+
+-- +goose Down
+
+DROP TABLE IF EXISTS subscriptions;
+DROP TABLE IF EXISTS subscription_plans;
@@ -0,0 +1,38 @@
+-- +goose Up
+
+-- ============================================================================
+-- MODULE: ENTITLEMENTS
+-- Per-tier limits (slots, refreshes, nudges, recoveries, reveals), editable by
+-- admins at runtime, and the per-connection free-recovery counter
+-- ============================================================================
+
+CREATE TABLE tier_entitlements (
+    id VARCHAR(36) PRIMARY KEY,
+    tier ENUM('free', 'plus', 'pro') NOT NULL,
+
+    connection_slots INT NOT NULL,
+    refresh_cooldown_minutes INT NOT NULL,
+    nudges_per_at_risk INT NOT NULL,
+    nudge_interval_minutes INT NOT NULL,
+    free_recoveries_per_connection INT NOT NULL,
+    purchasable_reveals INT NOT NULL,
+    reveal_days JSON NOT NULL,
+
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+    UNIQUE INDEX tierentitlement_tier (tier)
+);
+
+-- PRD §14.4 and §16.2 tier structure
+INSERT INTO tier_entitlements (id, tier, connection_slots, refresh_cooldown_minutes, nudges_per_at_risk, nudge_interval_minutes, free_recoveries_per_connection, purchasable_reveals, reveal_days) VALUES
+(UUID(), 'free', 1, 1440, 1, 1440, 0, 3, '[5, 12]'),
+(UUID(), 'plus', 2, 720, 3, 420, 1, 2, '[4, 8, 12]'),
+(UUID(), 'pro', 4, 360, 4, 300, 2, 1, '[3, 6, 9, 12]');
+
+ALTER TABLE streaks
+    ADD COLUMN free_recoveries_used INT NOT NULL DEFAULT 0 AFTER recovery_payment_id;
+
+-- +goose Down
+
+ALTER TABLE streaks DROP COLUMN free_recoveries_used;
+DROP TABLE IF EXISTS tier_entitlements;
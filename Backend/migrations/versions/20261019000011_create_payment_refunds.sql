@@ -0,0 +1,37 @@
+-- +goose Up
+
+-- ============================================================================
+-- MODULE: PAYMENT REFUNDS
+-- Razorpay refunds of payment orders, full or partial, tracked until
+-- Razorpay reports them processed or failed
+-- ============================================================================
+
+CREATE TABLE payment_refunds (
+    id VARCHAR(36) PRIMARY KEY,
+    order_id VARCHAR(36) NOT NULL,
+    user_id VARCHAR(36) NOT NULL,
+    razorpay_refund_id VARCHAR(100) UNIQUE,
+
+    amount INT NOT NULL,
+    currency VARCHAR(3) NOT NULL DEFAULT 'INR',
+    refund_status ENUM('pending', 'processing', 'processed', 'failed') NOT NULL DEFAULT 'pending',
+    source ENUM('admin', 'gateway') NOT NULL,
+
+    credits_reversed INT NOT NULL DEFAULT 0,
+    credits_written_off INT NOT NULL DEFAULT 0,
+
+    reason TEXT,
+    failure_reason TEXT,
+
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    processed_at TIMESTAMP NULL,
+
+    CONSTRAINT payment_refunds_payment_orders_refunds FOREIGN KEY (order_id) REFERENCES payment_orders(id),
+    INDEX paymentrefund_order_id_refund_status (order_id, refund_status),
+    INDEX paymentrefund_refund_status_created_at (refund_status, created_at)
+);
+
+-- +goose Down
+
+DROP TABLE IF EXISTS payment_refunds;
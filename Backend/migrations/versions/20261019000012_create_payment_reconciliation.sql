@@ -0,0 +1,50 @@
+-- +goose Up
+
+-- ============================================================================
+-- MODULE: PAYMENT RECONCILIATION
+-- Daily comparison of payment orders with the payments Razorpay recorded,
+-- and the discrepancies each run found
+-- ============================================================================
+
+CREATE TABLE payment_reconciliation_reports (
+    id VARCHAR(36) PRIMARY KEY,
+    report_date VARCHAR(10) NOT NULL UNIQUE,
+    window_start TIMESTAMP NOT NULL,
+    window_end TIMESTAMP NOT NULL,
+
+    orders_checked INT NOT NULL DEFAULT 0,
+    gateway_payments INT NOT NULL DEFAULT 0,
+    matched INT NOT NULL DEFAULT 0,
+    discrepancy_count INT NOT NULL DEFAULT 0,
+
+    db_paid_amount INT NOT NULL DEFAULT 0,
+    gateway_captured_amount INT NOT NULL DEFAULT 0,
+
+    generated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    INDEX paymentreconciliationreport_generated_at (generated_at)
+);
+
+CREATE TABLE payment_discrepancies (
+    id VARCHAR(36) PRIMARY KEY,
+    report_id VARCHAR(36) NOT NULL,
+    order_id VARCHAR(36),
+    razorpay_order_id VARCHAR(100) NOT NULL,
+    razorpay_payment_id VARCHAR(100),
+
+    kind ENUM('captured_not_fulfilled', 'paid_not_captured', 'amount_mismatch', 'refund_mismatch', 'unknown_order') NOT NULL,
+
+    db_status VARCHAR(20),
+    gateway_status VARCHAR(20),
+    db_amount INT NOT NULL DEFAULT 0,
+    gateway_amount INT NOT NULL DEFAULT 0,
+
+    CONSTRAINT payment_discrepancies_payment_reconciliation_reports_discrepancies FOREIGN KEY (report_id) REFERENCES payment_reconciliation_reports(id),
+    INDEX paymentdiscrepancy_report_id_kind (report_id, kind),
+    INDEX paymentdiscrepancy_order_id (order_id)
+);
+
+-- +goose Down
+
+DROP TABLE IF EXISTS payment_discrepancies;
+DROP TABLE IF EXISTS payment_reconciliation_reports;
@@ -0,0 +1,103 @@
+-- +goose Up
+
+-- ============================================================================
+-- MODULE: PROMO CODES
+-- Admin-created codes granting credits or a discount on a credit package,
+-- and each use of one
+-- ============================================================================
+
+CREATE TABLE promo_codes (
+    id VARCHAR(36) PRIMARY KEY,
+    code VARCHAR(32) NOT NULL UNIQUE,
+    description VARCHAR(255),
+
+    kind ENUM('credits', 'package_discount') NOT NULL,
+    credit_amount INT NOT NULL DEFAULT 0,
+    discount_percent INT NOT NULL DEFAULT 0,
+    package_id VARCHAR(36),
+
+    max_redemptions INT,
+    per_user_limit INT NOT NULL DEFAULT 1,
+    starts_at TIMESTAMP NULL,
+    expires_at TIMESTAMP NULL,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+    INDEX promocode_is_active_created_at (is_active, created_at)
+);
+
+CREATE TABLE promo_redemptions (
+    id VARCHAR(36) PRIMARY KEY,
+    promo_code_id VARCHAR(36) NOT NULL,
+    user_id VARCHAR(36) NOT NULL,
+    order_id VARCHAR(36) UNIQUE,
+
+    redemption_status ENUM('pending', 'redeemed') NOT NULL DEFAULT 'pending',
+    credits_granted INT NOT NULL DEFAULT 0,
+    discount_amount INT NOT NULL DEFAULT 0,
+    expires_at TIMESTAMP NULL,
+
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    redeemed_at TIMESTAMP NULL,
+
+    CONSTRAINT promo_redemptions_promo_codes_redemptions FOREIGN KEY (promo_code_id) REFERENCES promo_codes(id),
+    INDEX promoredemption_promo_code_id_redemption_status (promo_code_id, redemption_status),
+    INDEX promoredemption_promo_code_id_user_id (promo_code_id, user_id),
+    INDEX promoredemption_user_id_created_at (user_id, created_at)
+);
+
+-- Discount taken off a payment order by a promo code
+ALTER TABLE payment_orders
+    ADD COLUMN discount_amount INT NOT NULL DEFAULT 0 AFTER amount;
+
+ALTER TABLE credit_transactions
+    MODIFY COLUMN transaction_type ENUM('purchase', 'streak_recovery', 'early_reveal', 'reveal_gift', 'referral_bonus', 'welcome_bonus', 'promo_code', 'refund', 'admin_adjustment') NOT NULL;
+
+-- ============================================================================
+-- MODULE: REFERRALS
+-- Per-user referral codes and signups attributed to them; bonuses are
+-- released once the referee reaches their first streak milestone
+-- ============================================================================
+
+ALTER TABLE users
+    ADD COLUMN referral_code VARCHAR(16) NULL UNIQUE AFTER suspension_reason,
+    ADD COLUMN signup_device_id VARCHAR(128) NULL AFTER referral_code,
+    ADD INDEX user_signup_device_id (signup_device_id);
+
+CREATE TABLE referrals (
+    id VARCHAR(36) PRIMARY KEY,
+    referrer_id VARCHAR(36) NOT NULL,
+    referee_id VARCHAR(36) NOT NULL UNIQUE,
+    code VARCHAR(16) NOT NULL,
+    device_id VARCHAR(128),
+
+    referral_status ENUM('pending', 'rewarded', 'rejected') NOT NULL DEFAULT 'pending',
+    rejection_reason VARCHAR(50),
+    referrer_credits INT NOT NULL DEFAULT 0,
+    referee_credits INT NOT NULL DEFAULT 0,
+
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    resolved_at TIMESTAMP NULL,
+
+    INDEX referral_referrer_id_referral_status (referrer_id, referral_status)
+);
+
+-- +goose Down
+
+DROP TABLE IF EXISTS referrals;
+
+ALTER TABLE users
+    DROP INDEX user_signup_device_id,
+    DROP COLUMN signup_device_id,
+    DROP COLUMN referral_code;
+
+ALTER TABLE credit_transactions
+    MODIFY COLUMN transaction_type ENUM('purchase', 'streak_recovery', 'early_reveal', 'reveal_gift', 'referral_bonus', 'welcome_bonus', 'refund', 'admin_adjustment') NOT NULL;
+
+ALTER TABLE payment_orders
+    DROP COLUMN discount_amount;
+
+DROP TABLE IF EXISTS promo_redemptions;
+DROP TABLE IF EXISTS promo_codes;
@@ -0,0 +1,58 @@
+-- +goose Up
+
+-- ============================================================================
+-- MODULE: INVOICES
+-- GST tax invoices issued for paid payment orders, numbered consecutively
+-- within each financial year
+-- ============================================================================
+
+-- One row per financial year holding the last invoice number issued in it
+CREATE TABLE invoice_sequences (
+    id VARCHAR(7) PRIMARY KEY,
+    last_number INT NOT NULL DEFAULT 0,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP
+);
+
+CREATE TABLE invoices (
+    id VARCHAR(36) PRIMARY KEY,
+    invoice_number VARCHAR(16) NOT NULL UNIQUE,
+    financial_year VARCHAR(7) NOT NULL,
+    sequence_number INT NOT NULL,
+    order_id VARCHAR(36) NOT NULL UNIQUE,
+    user_id VARCHAR(36) NOT NULL,
+
+    seller_name VARCHAR(255) NOT NULL,
+    seller_address VARCHAR(500) NOT NULL,
+    seller_gstin VARCHAR(15) NOT NULL,
+    buyer_name VARCHAR(255),
+    buyer_email VARCHAR(255),
+    place_of_supply VARCHAR(2) NOT NULL,
+
+    description VARCHAR(255) NOT NULL,
+    sac_code VARCHAR(8) NOT NULL,
+
+    -- Amounts in paise; total_amount is tax-inclusive
+    currency VARCHAR(3) NOT NULL DEFAULT 'INR',
+    discount_amount INT NOT NULL DEFAULT 0,
+    taxable_amount INT NOT NULL,
+    gst_rate DOUBLE NOT NULL,
+    cgst_amount INT NOT NULL DEFAULT 0,
+    sgst_amount INT NOT NULL DEFAULT 0,
+    igst_amount INT NOT NULL DEFAULT 0,
+    total_amount INT NOT NULL,
+
+    pdf_storage_key VARCHAR(500),
+
+    issued_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT invoices_payment_orders_invoice FOREIGN KEY (order_id) REFERENCES payment_orders(id),
+    UNIQUE INDEX invoice_financial_year_sequence_number (financial_year, sequence_number),
+    INDEX invoice_issued_at (issued_at),
+    INDEX invoice_user_id_issued_at (user_id, issued_at)
+);
+
+-- +goose Down
+
+DROP TABLE IF EXISTS invoices;
+DROP TABLE IF EXISTS invoice_sequences;
@@ -0,0 +1,34 @@
+-- +goose Up
+
+-- ============================================================================
+-- MODULE: SESSIONS
+-- One row per signed-in device. Refresh tokens rotate on every use; only the
+-- latest one of a session is valid, and presenting an older one revokes it.
+-- Replaces the single refresh_token:<userID> key in Redis.
+-- ============================================================================
+
+CREATE TABLE user_sessions (
+    id VARCHAR(36) PRIMARY KEY,
+    user_id VARCHAR(36) NOT NULL,
+    refresh_token_id VARCHAR(36) NOT NULL,
+    provider VARCHAR(20) NOT NULL,
+
+    device_id VARCHAR(128),
+    device_name VARCHAR(100),
+    platform ENUM('android', 'ios', 'web', 'unknown') NOT NULL DEFAULT 'unknown',
+    ip_address VARCHAR(45),
+    user_agent VARCHAR(255),
+
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_used_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at TIMESTAMP NOT NULL,
+    revoked_at TIMESTAMP NULL,
+    revoke_reason VARCHAR(30),
+
+    INDEX usersession_user_id_revoked_at (user_id, revoked_at),
+    INDEX usersession_expires_at (expires_at)
+);
+
+-- +goose Down
+
+DROP TABLE IF EXISTS user_sessions;
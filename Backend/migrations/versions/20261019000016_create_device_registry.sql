@@ -0,0 +1,45 @@
+-- +goose Up
+
+-- ============================================================================
+-- MODULE: DEVICE REGISTRY
+-- Devices users log in on, from the X-Device-ID header, and suspicious
+-- patterns found among them (many accounts on one device, rapid device
+-- churn, devices shared with suspended accounts) queued for safety review
+-- ============================================================================
+
+CREATE TABLE user_devices (
+    id VARCHAR(36) PRIMARY KEY,
+    user_id VARCHAR(36) NOT NULL,
+    device_id VARCHAR(128) NOT NULL,
+    platform ENUM('android', 'ios', 'web', 'unknown') NOT NULL DEFAULT 'unknown',
+    attestation_status ENUM('missing', 'unverified') NOT NULL DEFAULT 'missing',
+    login_count INT NOT NULL DEFAULT 0,
+    first_seen_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_seen_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    UNIQUE INDEX userdevice_device_id_user_id (device_id, user_id),
+    INDEX userdevice_user_id_first_seen_at (user_id, first_seen_at)
+);
+
+CREATE TABLE device_risk_flags (
+    id VARCHAR(36) PRIMARY KEY,
+    user_id VARCHAR(36) NOT NULL,
+    device_id VARCHAR(128) NOT NULL,
+    rule ENUM('multi_account_device', 'device_churn', 'suspended_account_device') NOT NULL,
+    details TEXT,
+
+    flag_status ENUM('pending', 'reviewed', 'action_taken', 'dismissed') NOT NULL DEFAULT 'pending',
+    reviewed_by VARCHAR(36),
+    admin_notes TEXT,
+
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    reviewed_at TIMESTAMP NULL,
+
+    INDEX deviceriskflag_flag_status_created_at (flag_status, created_at),
+    INDEX deviceriskflag_user_id_rule_flag_status (user_id, rule, flag_status)
+);
+
+-- +goose Down
+
+DROP TABLE IF EXISTS device_risk_flags;
+DROP TABLE IF EXISTS user_devices;
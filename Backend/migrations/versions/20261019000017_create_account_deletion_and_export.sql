@@ -0,0 +1,45 @@
+-- +goose Up
+
+-- ============================================================================
+-- MODULE: ACCOUNT DELETION AND DATA EXPORT
+-- Self-serve deletion requests, carried out after a cooling-off period, and
+-- archives of a user's data built for download
+-- ============================================================================
+
+CREATE TABLE account_deletions (
+    id VARCHAR(36) PRIMARY KEY,
+    user_id VARCHAR(36) NOT NULL,
+    deletion_status ENUM('scheduled', 'cancelled', 'completed') NOT NULL DEFAULT 'scheduled',
+    requested_by ENUM('user', 'admin') NOT NULL DEFAULT 'user',
+    reason VARCHAR(500),
+
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    scheduled_for TIMESTAMP NOT NULL,
+    cancelled_at TIMESTAMP NULL,
+    completed_at TIMESTAMP NULL,
+
+    INDEX accountdeletion_user_id_deletion_status (user_id, deletion_status),
+    INDEX accountdeletion_deletion_status_scheduled_for (deletion_status, scheduled_for)
+);
+
+CREATE TABLE data_exports (
+    id VARCHAR(36) PRIMARY KEY,
+    user_id VARCHAR(36) NOT NULL,
+    export_status ENUM('pending', 'processing', 'ready', 'failed', 'expired') NOT NULL DEFAULT 'pending',
+    storage_key VARCHAR(255),
+    size_bytes BIGINT,
+    error_message VARCHAR(500),
+
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    started_at TIMESTAMP NULL,
+    completed_at TIMESTAMP NULL,
+    expires_at TIMESTAMP NULL,
+
+    INDEX dataexport_user_id_created_at (user_id, created_at),
+    INDEX dataexport_export_status_created_at (export_status, created_at)
+);
+
+-- +goose Down
+
+DROP TABLE IF EXISTS data_exports;
+DROP TABLE IF EXISTS account_deletions;
@@ -0,0 +1,36 @@
+-- +goose Up
+
+-- ============================================================================
+-- MODULE: AUTH - PROVIDER IDENTITIES
+-- Google and Apple accounts users sign in with, several per user, replacing
+-- the single users.provider / users.provider_user_id pair for login
+-- ============================================================================
+
+CREATE TABLE user_identities (
+    id VARCHAR(36) PRIMARY KEY,
+    user_id VARCHAR(36) NOT NULL,
+    provider ENUM('google', 'apple') NOT NULL,
+    subject VARCHAR(255) NOT NULL,
+    email VARCHAR(255),
+    is_private_email BOOLEAN NOT NULL DEFAULT FALSE,
+
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_used_at TIMESTAMP NULL,
+
+    UNIQUE INDEX useridentity_provider_subject (provider, subject),
+    UNIQUE INDEX useridentity_user_id_provider (user_id, provider)
+);
+
+-- Existing Google users keep signing in to the same account. IGNORE skips
+-- a Google account recorded on more than one user; the oldest keeps it.
+INSERT IGNORE INTO user_identities (id, user_id, provider, subject, email, created_at)
+SELECT UUID(), id, 'google', provider_user_id, email, created_at
+FROM users
+WHERE provider = 'google'
+  AND provider_user_id IS NOT NULL
+  AND deleted_at IS NULL
+ORDER BY created_at;
+
+-- +goose Down
+
+DROP TABLE IF EXISTS user_identities;
@@ -0,0 +1,33 @@
+-- +goose Up
+
+-- ============================================================================
+-- MODULE: ADMIN - ADMIN ACCOUNTS
+-- People with access to the admin API, each with their own hashed API key
+-- and a role, replacing the shared ADMIN_API_KEY
+-- ============================================================================
+
+CREATE TABLE admin_users (
+    id VARCHAR(36) PRIMARY KEY,
+    email VARCHAR(100) NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    role ENUM('support', 'moderator', 'finance', 'superadmin') NOT NULL,
+    admin_status ENUM('active', 'disabled') NOT NULL DEFAULT 'active',
+
+    -- SHA-256 of the API key, and its first characters for display
+    api_key_hash VARCHAR(64) NOT NULL,
+    api_key_prefix VARCHAR(16) NOT NULL,
+    api_key_rotated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    created_by VARCHAR(36),
+
+    last_used_at TIMESTAMP NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+    UNIQUE INDEX adminuser_email (email),
+    UNIQUE INDEX adminuser_api_key_hash (api_key_hash)
+);
+
+-- +goose Down
+
+DROP TABLE IF EXISTS admin_users;
@@ -0,0 +1,18 @@
+-- +goose Up
+
+-- ============================================================================
+-- MODULE: ACCOUNT - PHONE NUMBER HASH
+-- Keyed hash of the phone number, kept when a deleted account is anonymized
+-- so that referral fraud checks still see its number. Set on signup and
+-- filled in for existing users at their next phone login.
+-- ============================================================================
+
+ALTER TABLE users
+    ADD COLUMN phone_hash VARCHAR(64) NULL AFTER signup_device_id,
+    ADD INDEX user_phone_hash (phone_hash);
+
+-- +goose Down
+
+ALTER TABLE users
+    DROP INDEX user_phone_hash,
+    DROP COLUMN phone_hash;
@@ -0,0 +1,27 @@
+-- +goose Up
+
+-- ============================================================================
+-- MODULE: DEVICE RISK REPORTS
+-- Device risk flags also go into the safety review queue as reports raised
+-- by the system, which have no reporter
+-- ============================================================================
+
+ALTER TABLE user_reports
+    MODIFY COLUMN reporter_user_id VARCHAR(36) NULL,
+    MODIFY COLUMN report_reason ENUM('inappropriate_content', 'harassment', 'spam', 'fake_profile', 'underage', 'offensive_behavior', 'other', 'device_risk') NOT NULL;
+
+-- Queue the flags still waiting for review
+INSERT INTO user_reports (id, reported_user_id, report_reason, description, reference_type, reference_id, report_status, created_at)
+SELECT UUID(), f.user_id, 'device_risk',
+       CONCAT(f.rule, ' on device ', f.device_id, ' (client-reported, not attested): ', COALESCE(f.details, '')),
+       'device_risk_flag', f.id, 'pending', f.created_at
+FROM device_risk_flags f
+WHERE f.flag_status = 'pending';
+
+-- +goose Down
+
+DELETE FROM user_reports WHERE report_reason = 'device_risk';
+
+ALTER TABLE user_reports
+    MODIFY COLUMN report_reason ENUM('inappropriate_content', 'harassment', 'spam', 'fake_profile', 'underage', 'offensive_behavior', 'other') NOT NULL,
+    MODIFY COLUMN reporter_user_id VARCHAR(36) NOT NULL;
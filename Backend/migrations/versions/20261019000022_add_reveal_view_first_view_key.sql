@@ -0,0 +1,33 @@
+-- +goose Up
+
+-- ============================================================================
+-- REVEAL VIEWS: ONE FIRST VIEW PER VIEWER
+-- first_view_key is set on a viewer's first view of a reveal and NULL on
+-- re-views; NULLs don't collide, so the unique index allows exactly one
+-- first view. Only the earliest view of each viewer keeps is_first_view
+-- (racing requests could both have recorded a first view before this).
+-- ============================================================================
+
+ALTER TABLE reveal_views
+    ADD COLUMN first_view_key VARCHAR(73) NULL AFTER is_first_view;
+
+UPDATE reveal_views rv
+JOIN reveal_views earlier
+    ON earlier.reveal_id = rv.reveal_id
+    AND earlier.viewer_user_id = rv.viewer_user_id
+    AND (earlier.created_at < rv.created_at
+        OR (earlier.created_at = rv.created_at AND earlier.id < rv.id))
+SET rv.is_first_view = FALSE;
+
+UPDATE reveal_views
+SET first_view_key = CONCAT(reveal_id, ':', viewer_user_id)
+WHERE is_first_view = TRUE;
+
+CREATE UNIQUE INDEX first_view_key ON reveal_views (first_view_key);
+
+-- +goose Down
+
+DROP INDEX first_view_key ON reveal_views;
+
+ALTER TABLE reveal_views
+    DROP COLUMN first_view_key;
@@ -37,6 +37,10 @@ const (
 	ErrCodeRateLimited    = "RATE_LIMITED"
 	ErrCodeInvalidRequest = "INVALID_REQUEST"
 
+	// ErrCodeAccountSuspended lets the app show its suspended-account screen
+	// instead of sending the user back to login
+	ErrCodeAccountSuspended = "ACCOUNT_SUSPENDED"
+
 	// Server errors (5xx)
 	ErrCodeInternal    = "INTERNAL_ERROR"
 	ErrCodeDatabase    = "DATABASE_ERROR"
@@ -97,6 +101,11 @@ func Forbidden(message string) *AppError {
 	return New(ErrCodeForbidden, message, http.StatusForbidden)
 }
 
+// AccountSuspended returns a 403 error for a suspended account
+func AccountSuspended() *AppError {
+	return New(ErrCodeAccountSuspended, "Your account has been suspended", http.StatusForbidden)
+}
+
 // NotFound returns a 404 error
 func NotFound(resource string) *AppError {
 	return New(ErrCodeNotFound, fmt.Sprintf("%s not found", resource), http.StatusNotFound)
@@ -0,0 +1,58 @@
+// pkg/database/databasetest/databasetest.go
+package databasetest
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/google/uuid"
+
+	ent "github.com/UnoraApp/be/ent/generated"
+	"github.com/UnoraApp/be/ent/generated/enttest"
+)
+
+// DSNEnv names the MySQL server that database tests run against, as a DSN
+// without a database, e.g. "root:root@tcp(127.0.0.1:3306)/". Tests that need
+// a database are skipped when it is unset.
+const DSNEnv = "TEST_DATABASE_DSN"
+
+// NewClient returns an Ent client on a new, empty database with the current
+// schema. The database is dropped when the test ends.
+func NewClient(t testing.TB) *ent.Client {
+	t.Helper()
+
+	dsn := os.Getenv(DSNEnv)
+	if dsn == "" {
+		t.Skipf("%s is not set", DSNEnv)
+	}
+	cfg, err := mysql.ParseDSN(dsn)
+	if err != nil {
+		t.Fatalf("invalid %s: %v", DSNEnv, err)
+	}
+
+	server, err := sql.Open("mysql", cfg.FormatDSN())
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	t.Cleanup(func() { server.Close() })
+
+	name := "unora_test_" + strings.ReplaceAll(uuid.NewString(), "-", "")[:12]
+	if _, err := server.Exec(fmt.Sprintf("CREATE DATABASE `%s`", name)); err != nil {
+		t.Fatalf("failed to create database: %v", err)
+	}
+	t.Cleanup(func() {
+		if _, err := server.Exec(fmt.Sprintf("DROP DATABASE `%s`", name)); err != nil {
+			t.Errorf("failed to drop database %s: %v", name, err)
+		}
+	})
+
+	cfg.DBName = name
+	cfg.ParseTime = true
+	client := enttest.Open(t, "mysql", cfg.FormatDSN())
+	t.Cleanup(func() { client.Close() })
+	return client
+}
@@ -2,6 +2,7 @@
 package database
 
 import (
+	"context"
 	"fmt"
 
 	_ "github.com/go-sql-driver/mysql"
@@ -27,3 +28,30 @@ func SetupEntClient(cfg *config.Config) (*ent.Client, error) {
 
 	return client, nil
 }
+
+// WithTx runs fn inside an Ent transaction. The transaction is committed when
+// fn returns nil and rolled back when it returns an error or panics.
+func WithTx(ctx context.Context, client *ent.Client, fn func(tx *ent.Tx) error) error {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	if err := fn(tx); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			return fmt.Errorf("%w: rollback failed: %v", err, rerr)
+		}
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
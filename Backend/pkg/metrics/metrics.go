@@ -0,0 +1,20 @@
+// pkg/metrics/metrics.go
+package metrics
+
+import (
+	"expvar"
+	"net/http"
+)
+
+// counters are this instance's event counts, published with expvar
+var counters = expvar.NewMap("counters")
+
+// Inc adds one to the named counter
+func Inc(name string) {
+	counters.Add(name, 1)
+}
+
+// Handler serves every expvar variable, the counters included, as JSON
+func Handler() http.Handler {
+	return expvar.Handler()
+}
@@ -0,0 +1,124 @@
+// pkg/pdf/document.go
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// Page size of A4 in points
+const (
+	PageWidth  = 595.0
+	PageHeight = 842.0
+)
+
+// Font is one of the PDF standard fonts, which need no embedding
+type Font string
+
+// Standard fonts available to a Document
+const (
+	FontRegular Font = "F1" // Helvetica
+	FontBold    Font = "F2" // Helvetica-Bold
+	FontMono    Font = "F3" // Courier, fixed width, so it can be right-aligned
+)
+
+var fontNames = []struct {
+	key  Font
+	base string
+}{
+	{FontRegular, "Helvetica"},
+	{FontBold, "Helvetica-Bold"},
+	{FontMono, "Courier"},
+}
+
+// courierAdvance is the advance width of every Courier glyph, per point of size
+const courierAdvance = 0.6
+
+// Document is a single-page A4 document of text and lines. It covers what
+// generated paperwork such as invoices needs, nothing more. Coordinates are in
+// points from the top-left corner.
+type Document struct {
+	content bytes.Buffer
+}
+
+// New creates an empty A4 document
+func New() *Document {
+	return &Document{}
+}
+
+// Text draws s with its baseline starting at (x, y). Characters outside
+// Latin-1 are replaced with '?'.
+func (d *Document) Text(x, y float64, font Font, size float64, s string) {
+	fmt.Fprintf(&d.content, "BT /%s %.1f Tf %.2f %.2f Td (%s) Tj ET\n", font, size, x, PageHeight-y, escape(s))
+}
+
+// TextRight draws s in FontMono so that it ends at x
+func (d *Document) TextRight(x, y float64, size float64, s string) {
+	width := float64(len([]rune(s))) * size * courierAdvance
+	d.Text(x-width, y, FontMono, size, s)
+}
+
+// Line draws a straight line of the given width
+func (d *Document) Line(x1, y1, x2, y2, width float64) {
+	fmt.Fprintf(&d.content, "%.2f w %.2f %.2f m %.2f %.2f l S\n", width, x1, PageHeight-y1, x2, PageHeight-y2)
+}
+
+// Bytes renders the document as a PDF file
+func (d *Document) Bytes() []byte {
+	var out bytes.Buffer
+	var offsets []int
+
+	object := func(body string) {
+		offsets = append(offsets, out.Len())
+		fmt.Fprintf(&out, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	out.WriteString("%PDF-1.4\n")
+
+	// 1: catalog, 2: page tree, 3: the page, 4: its content, 5+: fonts
+	fonts := make([]string, len(fontNames))
+	for i, f := range fontNames {
+		fonts[i] = fmt.Sprintf("/%s %d 0 R", f.key, 5+i)
+	}
+
+	object("<< /Type /Catalog /Pages 2 0 R >>")
+	object("<< /Type /Pages /Kids [3 0 R] /Count 1 >>")
+	object(fmt.Sprintf(
+		"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %.0f %.0f] /Resources << /Font << %s >> >> /Contents 4 0 R >>",
+		PageWidth, PageHeight, strings.Join(fonts, " "),
+	))
+	object(fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", d.content.Len(), d.content.String()))
+	for _, f := range fontNames {
+		object(fmt.Sprintf("<< /Type /Font /Subtype /Type1 /BaseFont /%s /Encoding /WinAnsiEncoding >>", f.base))
+	}
+
+	xref := out.Len()
+	fmt.Fprintf(&out, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, off := range offsets {
+		fmt.Fprintf(&out, "%010d 00000 n \n", off)
+	}
+	fmt.Fprintf(&out, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+
+	return out.Bytes()
+}
+
+// escape encodes s as the body of a PDF literal string in WinAnsi
+func escape(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r >= 0x20 && r < 0x7f:
+			b.WriteRune(r)
+		case r >= 0xa0 && r <= 0xff:
+			// Latin-1 matches WinAnsi here; write the byte as an octal escape
+			fmt.Fprintf(&b, "\\%03o", r)
+		default:
+			b.WriteByte('?')
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,112 @@
+// pkg/ratelimit/limiter.go
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/UnoraApp/be/internal/config"
+)
+
+// slidingWindowScript records a request in every window key (sorted sets of
+// request times in milliseconds) if each of them is under its own limit.
+// Rejected requests are not recorded, so retrying does not extend the wait.
+// ARGV holds the time, the window and the request ID, then one limit per key.
+// Returns {allowed, retry after ms}.
+var slidingWindowScript = redis.NewScript(`
+local now = tonumber(ARGV[1])
+local window = tonumber(ARGV[2])
+local retry = 0
+for i, key in ipairs(KEYS) do
+	local limit = tonumber(ARGV[3 + i])
+	redis.call('ZREMRANGEBYSCORE', key, '-inf', now - window)
+	local n = redis.call('ZCARD', key)
+	if n >= limit then
+		-- A slot frees up when the request n - limit + 1 from the end expires
+		local oldest = redis.call('ZRANGE', key, n - limit, n - limit, 'WITHSCORES')
+		local wait = tonumber(oldest[2]) + window - now
+		if wait > retry then
+			retry = wait
+		end
+	end
+end
+if retry > 0 then
+	return {0, retry}
+end
+for _, key in ipairs(KEYS) do
+	redis.call('ZADD', key, now, ARGV[3])
+	redis.call('PEXPIRE', key, window)
+end
+return {1, 0}
+`)
+
+// Limiter enforces the rate limit policies of the config with sliding
+// windows kept in Redis, so limits hold across instances. It also keeps the
+// accounts safety has throttled.
+type Limiter struct {
+	redisClient *redis.Client
+	cfg         *config.RateLimitConfig
+}
+
+// NewLimiter creates a new limiter
+func NewLimiter(redisClient *redis.Client, cfg *config.Config) *Limiter {
+	return &Limiter{
+		redisClient: redisClient,
+		cfg:         &cfg.RateLimit,
+	}
+}
+
+// Allow records a request against every key if each has had fewer requests
+// in the window than its limit (limits[i] for keys[i]). Otherwise it returns
+// how long until one more would be allowed.
+func (l *Limiter) Allow(ctx context.Context, keys []string, limits []int, window time.Duration) (bool, time.Duration, error) {
+	if len(limits) != len(keys) {
+		return false, 0, fmt.Errorf("got %d rate limits for %d keys", len(limits), len(keys))
+	}
+	now := time.Now().UnixMilli()
+	args := []any{now, window.Milliseconds(), fmt.Sprintf("%d-%s", now, uuid.New().String())}
+	for _, limit := range limits {
+		args = append(args, limit)
+	}
+	res, err := slidingWindowScript.Run(ctx, l.redisClient, keys, args...).Int64Slice()
+	if err != nil {
+		return false, 0, fmt.Errorf("failed to check rate limit: %w", err)
+	}
+	return res[0] == 1, time.Duration(res[1]) * time.Millisecond, nil
+}
+
+// Throttle silently throttles the user for the duration: they get a
+// fraction of every per-user limit and their rate-limited requests are
+// slowed down
+func (l *Limiter) Throttle(ctx context.Context, userID, reason string, d time.Duration) error {
+	if err := l.redisClient.Set(ctx, throttleKey(userID), reason, d).Err(); err != nil {
+		return fmt.Errorf("failed to throttle user: %w", err)
+	}
+	return nil
+}
+
+// Unthrottle lifts the user's throttle
+func (l *Limiter) Unthrottle(ctx context.Context, userID string) error {
+	if err := l.redisClient.Del(ctx, throttleKey(userID)).Err(); err != nil {
+		return fmt.Errorf("failed to unthrottle user: %w", err)
+	}
+	return nil
+}
+
+// ThrottledFor returns how much longer the user is throttled, or zero
+func (l *Limiter) ThrottledFor(ctx context.Context, userID string) (time.Duration, error) {
+	ttl, err := l.redisClient.PTTL(ctx, throttleKey(userID)).Result()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get user throttle: %w", err)
+	}
+	// Negative when the key does not exist
+	return max(ttl, 0), nil
+}
+
+func throttleKey(userID string) string {
+	return "ratelimit:throttle:" + userID
+}
@@ -0,0 +1,38 @@
+// pkg/ratelimit/middleware_test.go
+package ratelimit
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/UnoraApp/be/internal/config"
+)
+
+func TestPolicyKeys(t *testing.T) {
+	const userKey = "ratelimit:p:user:u1"
+	const ipKey = "ratelimit:p:ip:10.0.0.1"
+
+	tests := []struct {
+		name       string
+		key        string
+		userID     string
+		wantKeys   []string
+		wantLimits []int
+	}{
+		{"ip policy", "ip", "u1", []string{ipKey}, []int{20}},
+		{"user policy", "user", "u1", []string{userKey}, []int{5}},
+		{"user policy without user", "user", "", []string{ipKey}, []int{20}},
+		// The reduced limit must not shrink the IP window shared with others
+		{"user_ip policy", "user_ip", "u1", []string{userKey, ipKey}, []int{5, 20}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := config.RateLimitPolicy{Limit: 20, Window: time.Minute, Key: tt.key}
+			keys, limits := policyKeys("p", policy, tt.userID, "10.0.0.1", 5)
+			if !reflect.DeepEqual(keys, tt.wantKeys) || !reflect.DeepEqual(limits, tt.wantLimits) {
+				t.Errorf("policyKeys = %v %v, want %v %v", keys, limits, tt.wantKeys, tt.wantLimits)
+			}
+		})
+	}
+}